@@ -106,6 +106,9 @@ type retryData struct {
 	n        int
 	f        func() error
 	id       string
+	// failed is called with the last error when no longer retrying, and
+	// returns any error encountered while handling it.
+	failed func(error) error
 }
 
 func (r retryData) NextRetry(factor float64, max time.Duration) retryData {
@@ -118,6 +121,7 @@ func (r retryData) NextRetry(factor float64, max time.Duration) retryData {
 		n:        r.n + 1,
 		f:        r.f,
 		id:       r.id,
+		failed:   r.failed,
 	}
 }
 
@@ -129,6 +133,16 @@ func (r retryData) ShouldRetry(max int) bool {
 // behavior is determined by the DeliveryOptions passed to the DelivererPool
 // upon construction.
 func (d *DelivererPool) Do(b []byte, to *url.URL, sendFn func([]byte, *url.URL) error) {
+	d.DoOrDeadLetter(b, to, sendFn, nil)
+}
+
+var _ pub.DeadLetterDeliverer = &DelivererPool{}
+
+// DoOrDeadLetter behaves like Do, additionally calling failed with the last
+// error if the message is undeliverable or the pool is stopped before sending
+// it. Any error returned by failed is sent on the Errors channel. The failed
+// function is optional.
+func (d *DelivererPool) DoOrDeadLetter(b []byte, to *url.URL, sendFn func([]byte, *url.URL) error, failed func(error) error) {
 	f := func() error {
 		return sendFn(b, to)
 	}
@@ -142,6 +156,7 @@ func (d *DelivererPool) Do(b []byte, to *url.URL, sendFn func([]byte, *url.URL)
 			n:        0,
 			f:        f,
 			id:       id,
+			failed:   failed,
 		})
 	}()
 }
@@ -176,12 +191,20 @@ func (d *DelivererPool) Errors() <-chan error {
 	return d.errChan
 }
 
+// QueueDepth returns the number of deliveries waiting to be retried.
+func (d *DelivererPool) QueueDepth() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.timerMap)
+}
+
 func (d *DelivererPool) do(r retryData) {
 	if err := d.limiter.Wait(d.ctx); err != nil {
 		if d.persister != nil {
 			d.persister.Cancel(r.id)
 		}
 		d.errChan <- err
+		d.fail(r, err)
 		return
 	}
 	if err := r.f(); err != nil {
@@ -196,6 +219,7 @@ func (d *DelivererPool) do(r retryData) {
 			if d.persister != nil {
 				d.persister.Undeliverable(r.id)
 			}
+			d.fail(r, err)
 		}
 		return
 	}
@@ -204,6 +228,17 @@ func (d *DelivererPool) do(r retryData) {
 	}
 }
 
+// fail calls the failed function of the delivery, if any, with the last error
+// and sends any error it returns on the Errors channel.
+func (d *DelivererPool) fail(r retryData, err error) {
+	if r.failed == nil {
+		return
+	}
+	if err := r.failed(err); err != nil {
+		d.errChan <- err
+	}
+}
+
 func (d *DelivererPool) addClosableTimer(r retryData) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -248,6 +248,74 @@ func TestDelivererPoolUndeliverable(t *testing.T) {
 	}
 }
 
+func TestDelivererPoolDoOrDeadLetter(t *testing.T) {
+	testSendFn := func(b []byte, u *url.URL) error {
+		return fmt.Errorf("expected")
+	}
+	failed := make(chan error, 1)
+	pool := NewDelivererPool(DeliveryOptions{
+		InitialRetryTime: time.Microsecond,
+		MaximumRetryTime: time.Microsecond,
+		BackoffFactor:    2,
+		MaxRetries:       1,
+		RateLimit:        rate.NewLimiter(1000000, 10000000),
+	})
+	pool.DoOrDeadLetter(testBytes, testURL, testSendFn, func(err error) error {
+		failed <- err
+		return fmt.Errorf("store")
+	})
+	<-pool.Errors()
+	<-pool.Errors()
+	<-pool.Errors()
+	select {
+	case err := <-failed:
+		if err == nil || err.Error() != "expected" {
+			t.Fatalf("want: expected, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed was not called")
+	}
+	if err := <-pool.Errors(); err.Error() != "store" {
+		t.Fatalf("want: store, got %v", err)
+	}
+}
+
+func TestDelivererPoolDoOrDeadLetterStopped(t *testing.T) {
+	testSendFn := func(b []byte, u *url.URL) error {
+		t.Fatal("sent after the pool stopped")
+		return nil
+	}
+	failed := make(chan error, 1)
+	p := newMockDeliveryPersister(t)
+	pool := NewDelivererPool(DeliveryOptions{
+		InitialRetryTime: time.Microsecond,
+		MaximumRetryTime: time.Microsecond,
+		BackoffFactor:    2,
+		MaxRetries:       1,
+		RateLimit:        rate.NewLimiter(1000000, 10000000),
+		Persister:        p,
+	})
+	pool.Stop()
+	pool.DoOrDeadLetter(testBytes, testURL, testSendFn, func(err error) error {
+		failed <- err
+		return nil
+	})
+	<-pool.Errors()
+	select {
+	case err := <-failed:
+		if err == nil {
+			t.Fatal("want: error, got nil")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed was not called")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.id1State != cancel {
+		t.Fatalf("want: %s, got %s", cancel, p.id1State)
+	}
+}
+
 func TestRestartRetrying(t *testing.T) {
 	testSendFn := func(b []byte, u *url.URL) error {
 		if diff := deep.Equal(b, testBytes); diff != nil {
@@ -309,3 +377,26 @@ func TestRestartUndeliverable(t *testing.T) {
 		t.Fatalf("want: %s, got %s", undeliverable, p.id2State)
 	}
 }
+
+func TestDelivererPoolQueueDepth(t *testing.T) {
+	testSendFn := func(b []byte, u *url.URL) error {
+		return fmt.Errorf("expected")
+	}
+	pool := NewDelivererPool(DeliveryOptions{
+		InitialRetryTime: time.Hour,
+		MaximumRetryTime: time.Hour,
+		BackoffFactor:    2,
+		MaxRetries:       1,
+		RateLimit:        rate.NewLimiter(1000000, 10000000),
+	})
+	defer pool.Stop()
+	if n := pool.QueueDepth(); n != 0 {
+		t.Fatalf("want: 0, got %d", n)
+	}
+	pool.Do(testBytes, testURL, testSendFn)
+	<-pool.Errors()
+	time.Sleep(time.Millisecond)
+	if n := pool.QueueDepth(); n != 1 {
+		t.Fatalf("want: 1, got %d", n)
+	}
+}
@@ -0,0 +1,158 @@
+//
+package litepub
+
+import (
+	"net/url"
+)
+
+// Extensible is implemented by every type, including those of the core package, which keep the properties of this package they do not know of as unknown properties.
+type Extensible interface {
+	HasUnknown(k string) (b bool)
+	GetUnknown(k string) (i interface{})
+	SetUnknownProperty(k string, i interface{})
+}
+
+// attachedCapabilities deserializes the 'capabilities' of the value, or returns nil if it has none or it cannot be deserialized. A value without a type is deserialized as a Capabilities, the only type the property takes
+func attachedCapabilities(t Extensible) (i *capabilitiesIntermediateType) {
+	if !t.HasUnknown("capabilities") {
+		return nil
+	}
+	v := t.GetUnknown("capabilities")
+	if m, ok := v.(map[string]interface{}); ok && m["type"] == nil {
+		tmp := &Capabilities{}
+		if err := tmp.Deserialize(m); err != nil {
+			return nil
+		}
+		return &capabilitiesIntermediateType{Capabilities: tmp}
+	}
+	i, err := deserializeCapabilitiesIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsCapabilities determines whether the call to GetCapabilities is safe, which is whether the 'capabilities' of the value is of CapabilitiesType type
+func IsCapabilities(t Extensible) (ok bool) {
+	i := attachedCapabilities(t)
+	return i != nil && i.Capabilities != nil
+
+}
+
+// GetCapabilities returns the 'capabilities' of the value safely if IsCapabilities returned true
+func GetCapabilities(t Extensible) (v CapabilitiesType) {
+	return attachedCapabilities(t).Capabilities
+
+}
+
+// SetCapabilities sets the 'capabilities' of the value to be of CapabilitiesType type, as an unknown property of the value
+func SetCapabilities(t Extensible, v CapabilitiesType) (err error) {
+	i, err := serializeCapabilitiesIntermediateType(&capabilitiesIntermediateType{Capabilities: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("capabilities", i)
+	return
+
+}
+
+// IsCapabilitiesIRI determines whether the call to GetCapabilitiesIRI is safe, which is whether the 'capabilities' of the value is of *url.URL type
+func IsCapabilitiesIRI(t Extensible) (ok bool) {
+	i := attachedCapabilities(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetCapabilitiesIRI returns the 'capabilities' of the value safely if IsCapabilitiesIRI returned true
+func GetCapabilitiesIRI(t Extensible) (v *url.URL) {
+	return attachedCapabilities(t).IRI
+
+}
+
+// SetCapabilitiesIRI sets the 'capabilities' of the value to be of *url.URL type, as an unknown property of the value
+func SetCapabilitiesIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializeCapabilitiesIntermediateType(&capabilitiesIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("capabilities", i)
+	return
+
+}
+
+// attachedListMessage deserializes the 'listMessage' of the value, or returns nil if it has none or it cannot be deserialized
+func attachedListMessage(t Extensible) (i *listMessageIntermediateType) {
+	if !t.HasUnknown("listMessage") {
+		return nil
+	}
+	v := t.GetUnknown("listMessage")
+	i, err := deserializeListMessageIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsListMessage determines whether the call to GetListMessage is safe, which is whether the 'listMessage' of the value is of *url.URL type
+func IsListMessage(t Extensible) (ok bool) {
+	i := attachedListMessage(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetListMessage returns the 'listMessage' of the value safely if IsListMessage returned true
+func GetListMessage(t Extensible) (v *url.URL) {
+	return attachedListMessage(t).IRI
+
+}
+
+// SetListMessage sets the 'listMessage' of the value to be of *url.URL type, as an unknown property of the value
+func SetListMessage(t Extensible, v *url.URL) (err error) {
+	i, err := serializeListMessageIntermediateType(&listMessageIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("listMessage", i)
+	return
+
+}
+
+// attachedOauthRegistrationEndpoint deserializes the 'oauthRegistrationEndpoint' of the value, or returns nil if it has none or it cannot be deserialized
+func attachedOauthRegistrationEndpoint(t Extensible) (i *oauthRegistrationEndpointIntermediateType) {
+	if !t.HasUnknown("oauthRegistrationEndpoint") {
+		return nil
+	}
+	v := t.GetUnknown("oauthRegistrationEndpoint")
+	i, err := deserializeOauthRegistrationEndpointIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsOauthRegistrationEndpoint determines whether the call to GetOauthRegistrationEndpoint is safe, which is whether the 'oauthRegistrationEndpoint' of the value is of *url.URL type
+func IsOauthRegistrationEndpoint(t Extensible) (ok bool) {
+	i := attachedOauthRegistrationEndpoint(t)
+	return i != nil && i.anyURI != nil
+
+}
+
+// GetOauthRegistrationEndpoint returns the 'oauthRegistrationEndpoint' of the value safely if IsOauthRegistrationEndpoint returned true
+func GetOauthRegistrationEndpoint(t Extensible) (v *url.URL) {
+	return attachedOauthRegistrationEndpoint(t).anyURI
+
+}
+
+// SetOauthRegistrationEndpoint sets the 'oauthRegistrationEndpoint' of the value to be of *url.URL type, as an unknown property of the value
+func SetOauthRegistrationEndpoint(t Extensible, v *url.URL) (err error) {
+	i, err := serializeOauthRegistrationEndpointIntermediateType(&oauthRegistrationEndpointIntermediateType{anyURI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("oauthRegistrationEndpoint", i)
+	return
+
+}
@@ -0,0 +1,53 @@
+//
+package litepub
+
+// DeserializeManyEmojiReact deserializes each of the maps as a EmojiReact. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyEmojiReact(ms []map[string]interface{}) (t []*EmojiReact, err error) {
+	backing := make([]EmojiReact, len(ms))
+	t = make([]*EmojiReact, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyChatMessage deserializes each of the maps as a ChatMessage. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyChatMessage(ms []map[string]interface{}) (t []*ChatMessage, err error) {
+	backing := make([]ChatMessage, len(ms))
+	t = make([]*ChatMessage, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyCapabilities deserializes each of the maps as a Capabilities. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyCapabilities(ms []map[string]interface{}) (t []*Capabilities, err error) {
+	backing := make([]Capabilities, len(ms))
+	t = make([]*Capabilities, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
@@ -0,0 +1,212 @@
+//
+package litepub
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// encodeJCS encodes the serialized form of a value as canonical JSON by the
+// JSON Canonicalization Scheme of RFC 8785: keys of objects are sorted by their
+// UTF-16 code units, numbers are formatted as ECMAScript does, strings only
+// escape what JSON requires, and there is no whitespace. Equal values always
+// have the same bytes, which signatures and content addressing rely on. It
+// supports the values that Serialize creates.
+func encodeJCS(v interface{}) ([]byte, error) {
+	return appendJCS(nil, v)
+}
+
+// appendJCS appends the canonical JSON encoding of the value to the bytes.
+func appendJCS(b []byte, v interface{}) ([]byte, error) {
+	var err error
+	switch x := v.(type) {
+	case nil:
+		b = append(b, "null"...)
+	case bool:
+		b = strconv.AppendBool(b, x)
+	case string:
+		return appendJCSString(b, x)
+	case float64:
+		return appendJCSNumber(b, x)
+	case float32:
+		return appendJCSNumber(b, float64(x))
+	case int:
+		return appendJCSNumber(b, float64(x))
+	case int32:
+		return appendJCSNumber(b, float64(x))
+	case int64:
+		return appendJCSNumber(b, float64(x))
+	case uint64:
+		return appendJCSNumber(b, float64(x))
+	case []interface{}:
+		b = append(b, '[')
+		for i, e := range x {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendJCS(b, e); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	case []string:
+		b = append(b, '[')
+		for i, e := range x {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendJCSString(b, e); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	case map[string]string:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sortJCSKeys(keys)
+		b = append(b, '{')
+		for i, k := range keys {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendJCSString(b, k); err != nil {
+				return nil, err
+			}
+			b = append(b, ':')
+			if b, err = appendJCSString(b, x[k]); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, '}')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sortJCSKeys(keys)
+		b = append(b, '{')
+		for i, k := range keys {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendJCSString(b, k); err != nil {
+				return nil, err
+			}
+			b = append(b, ':')
+			if b, err = appendJCS(b, x[k]); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, '}')
+	default:
+		return nil, fmt.Errorf("cannot encode value of type %T as canonical JSON", v)
+	}
+	return b, nil
+}
+
+// sortJCSKeys sorts the keys of an object by their UTF-16 code units.
+func sortJCSKeys(keys []string) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := utf16.Encode([]rune(keys[i])), utf16.Encode([]rune(keys[j]))
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
+
+// appendJCSNumber appends the number formatted as ECMAScript's
+// Number.prototype.toString does.
+func appendJCSNumber(b []byte, f float64) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("cannot encode %v as canonical JSON", f)
+	}
+	if f == 0 {
+		return append(b, '0'), nil
+	}
+	if f < 0 {
+		b = append(b, '-')
+		f = -f
+	}
+	// The shortest digits that round trip, and the exponent n such that
+	// the number is 0.digits times ten to the n.
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp := e[:strings.IndexByte(e, 'e')], e[strings.IndexByte(e, 'e')+1:]
+	digits := strings.Replace(mantissa, ".", "", 1)
+	n, err := strconv.Atoi(exp)
+	if err != nil {
+		return nil, err
+	}
+	n++
+	k := len(digits)
+	switch {
+	case k <= n && n <= 21:
+		b = append(b, digits...)
+		for i := 0; i < n-k; i++ {
+			b = append(b, '0')
+		}
+	case 0 < n && n <= 21:
+		b = append(b, digits[:n]...)
+		b = append(b, '.')
+		b = append(b, digits[n:]...)
+	case -6 < n && n <= 0:
+		b = append(b, '0', '.')
+		for i := 0; i < -n; i++ {
+			b = append(b, '0')
+		}
+		b = append(b, digits...)
+	default:
+		b = append(b, digits[0])
+		if k > 1 {
+			b = append(b, '.')
+			b = append(b, digits[1:]...)
+		}
+		b = append(b, 'e')
+		if n-1 >= 0 {
+			b = append(b, '+')
+		}
+		b = strconv.AppendInt(b, int64(n-1), 10)
+	}
+	return b, nil
+}
+
+// appendJCSString appends the string quoted as canonical JSON, escaping only
+// quotes, backslashes, and control characters. Strings that are not valid
+// UTF-8 cannot be canonicalized.
+func appendJCSString(b []byte, s string) ([]byte, error) {
+	const hex = "0123456789abcdef"
+	if !utf8.ValidString(s) {
+		return nil, fmt.Errorf("cannot encode invalid UTF-8 %q as canonical JSON", s)
+	}
+	b = append(b, '"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b = append(b, '\\', c)
+		case c == '\b':
+			b = append(b, '\\', 'b')
+		case c == '\t':
+			b = append(b, '\\', 't')
+		case c == '\n':
+			b = append(b, '\\', 'n')
+		case c == '\f':
+			b = append(b, '\\', 'f')
+		case c == '\r':
+			b = append(b, '\\', 'r')
+		case c < 0x20:
+			b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+		default:
+			b = append(b, c)
+		}
+	}
+	return append(b, '"'), nil
+}
@@ -0,0 +1,109 @@
+//
+//go:generate go install github.com/go-fed/activity/tools/litepub
+//go:generate litepub
+package litepub
+
+import (
+	"encoding/json"
+	"github.com/go-fed/activity/vocab"
+	"testing"
+)
+
+func TestAttachedProperties(t *testing.T) {
+	const actor = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"litepub": "http://litepub.social/ns#"}],
+  "type": "Person",
+  "id": "https://example.com/users/alice",
+  "capabilities": {"acceptsChatMessages": true},
+  "endpoints": {
+    "sharedInbox": "https://example.com/inbox",
+    "oauthRegistrationEndpoint": "https://example.com/api/v1/apps"
+  }
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(actor), &m); err != nil {
+		t.Fatal(err)
+	}
+	p := &vocab.Person{}
+	if err := p.Deserialize(m); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	if !IsCapabilities(p) {
+		t.Fatalf("Expected the capabilities without a type")
+	}
+	c, ok := GetCapabilities(p).(*Capabilities)
+	if !ok {
+		t.Fatalf("Expected *Capabilities, got %T", GetCapabilities(p))
+	} else if !c.IsAcceptsChatMessages() || !c.GetAcceptsChatMessages() {
+		t.Errorf("Expected the actor to accept chat messages")
+	}
+	// The endpoints have no type either, which the vocab types keep as an
+	// unknown value.
+	em, ok := p.GetUnknownEndpoints().(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected the endpoints, got %T", p.GetUnknownEndpoints())
+	}
+	e := &vocab.Object{}
+	if err := e.Deserialize(em); err != nil {
+		t.Fatalf("Cannot Deserialize the endpoints: %s", err)
+	} else if !IsOauthRegistrationEndpoint(e) {
+		t.Fatalf("Expected the OAuth registration endpoint")
+	} else if got := GetOauthRegistrationEndpoint(e).String(); got != "https://example.com/api/v1/apps" {
+		t.Errorf("Expected the OAuth registration endpoint, got %s", got)
+	}
+
+	n := &vocab.Note{}
+	if IsListMessage(n) {
+		t.Errorf("Expected the note to have no 'listMessage'")
+	}
+	if err := SetListMessage(n, p.GetId()); err != nil {
+		t.Fatalf("Cannot SetListMessage: %s", err)
+	}
+	s, err := n.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	} else if s["listMessage"] != "https://example.com/users/alice" {
+		t.Errorf("Expected the list to be serialized, got %v", s["listMessage"])
+	}
+}
+
+func TestEmojiReact(t *testing.T) {
+	const create = `{
+  "type": "Create",
+  "actor": "https://example.com/users/alice",
+  "object": {
+    "type": "ChatMessage",
+    "id": "https://example.com/objects/1",
+    "to": "https://example.com/users/bob",
+    "content": "Hi!"
+  }
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(create), &m); err != nil {
+		t.Fatal(err)
+	}
+	c := &vocab.Create{}
+	if err := c.Deserialize(m); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	if c.ObjectLen() != 1 || !c.IsObject(0) {
+		t.Fatalf("Expected the chat message as the object")
+	}
+	msg, ok := c.GetObject(0).(*ChatMessage)
+	if !ok {
+		t.Fatalf("Expected *ChatMessage, got %T", c.GetObject(0))
+	} else if msg.ContentLen() != 1 || msg.GetContentString(0) != "Hi!" {
+		t.Errorf("Expected the content of the chat message")
+	}
+
+	r := &EmojiReact{}
+	r.AppendContentString("🔥")
+	r.AppendObjectIRI(msg.GetId())
+	var a vocab.ActivityType = r
+	s, err := a.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	} else if s["type"] != "EmojiReact" || s["content"] != "🔥" {
+		t.Errorf("Expected the emoji reaction, got %v", s)
+	}
+}
@@ -0,0 +1,134 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// Actor serves the inboxes and outboxes of the actors of an application as
+// http.HandlerFuncs, ready to mount on an http.ServeMux. Whether it implements
+// the Social API, the Federating API, or both depends only on the interfaces
+// the Application passed to NewActor implements, so that the same handlers
+// serve every kind of deployment.
+//
+// The handlers use the context of the request, which middleware may populate
+// with application specific information.
+type Actor struct {
+	// NotActivityPub handles the requests that are not ActivityPub
+	// requests, such as by responding with an HTML representation with
+	// correct view permissions. If nil, they are responded to with
+	// http.StatusNotFound.
+	NotActivityPub http.Handler
+	// OnError handles the errors of handling ActivityPub requests, for
+	// which no response has been written yet. If nil, they are responded to
+	// with http.StatusInternalServerError.
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+	pubber  Pubber
+}
+
+// NewActor provides an Actor that implements the Social API if the app is also
+// a SocialAPI and a client Callbacker is given, and the Federating API if the
+// app is also a FederateAPI and a server Callbacker is given. The Deliverer,
+// HttpClient, user agent, and depths are only required for the Federating API,
+// as in NewFederatingPubber.
+//
+// An Actor implementing neither API responds to ActivityPub POSTs with
+// http.StatusMethodNotAllowed.
+//
+// An error is returned if the clock or app is nil, or if the Federating API is
+// implemented without a Deliverer or HttpClient, instead of an Actor failing
+// on its first delivery.
+func NewActor(clock Clock, app Application, client, server Callbacker, d Deliverer, httpClient HttpClient, userAgent string, maxDeliveryDepth, maxForwardingDepth int) (*Actor, error) {
+	if clock == nil {
+		return nil, errors.New("actor requires a Clock")
+	} else if app == nil {
+		return nil, errors.New("actor requires an Application")
+	}
+	f := &federator{
+		Clock:                   clock,
+		App:                     app,
+		Client:                  httpClient,
+		Agent:                   userAgent,
+		MaxDeliveryDepth:        maxDeliveryDepth,
+		MaxInboxForwardingDepth: maxForwardingDepth,
+		ServerCallbacker:        server,
+		ClientCallbacker:        client,
+		deliverer:               d,
+	}
+	if s, ok := app.(SocialAPI); ok && client != nil {
+		f.SocialAPI = s
+		f.EnableClient = true
+	}
+	if fed, ok := app.(FederateAPI); ok && server != nil {
+		if d == nil {
+			return nil, errors.New("actor implementing the Federating API requires a Deliverer")
+		} else if httpClient == nil {
+			return nil, errors.New("actor implementing the Federating API requires an HttpClient")
+		}
+		f.FederateAPI = fed
+		f.EnableServer = true
+	}
+	return &Actor{pubber: f}, nil
+}
+
+// Pubber returns the Pubber handling the requests of the Actor. It is also a
+// Reprocessor, to Reprocess a DeadLetter.
+func (a *Actor) Pubber() Pubber {
+	return a.pubber
+}
+
+// PostInbox handles an ActivityPub POST to an actor's inbox.
+func (a *Actor) PostInbox(w http.ResponseWriter, r *http.Request) {
+	a.serve(w, r, a.pubber.PostInbox)
+}
+
+// GetInbox handles an ActivityPub GET of an actor's inbox.
+func (a *Actor) GetInbox(w http.ResponseWriter, r *http.Request) {
+	a.serve(w, r, a.pubber.GetInbox)
+}
+
+// PostOutbox handles an ActivityPub POST to an actor's outbox.
+func (a *Actor) PostOutbox(w http.ResponseWriter, r *http.Request) {
+	a.serve(w, r, a.pubber.PostOutbox)
+}
+
+// GetOutbox handles an ActivityPub GET of an actor's outbox.
+func (a *Actor) GetOutbox(w http.ResponseWriter, r *http.Request) {
+	a.serve(w, r, a.pubber.GetOutbox)
+}
+
+// Inbox handles both the ActivityPub POSTs to and GETs of an actor's inbox,
+// for mounting at the path of the inbox.
+func (a *Actor) Inbox(w http.ResponseWriter, r *http.Request) {
+	a.serve(w, r, a.pubber.PostInbox, a.pubber.GetInbox)
+}
+
+// Outbox handles both the ActivityPub POSTs to and GETs of an actor's outbox,
+// for mounting at the path of the outbox.
+func (a *Actor) Outbox(w http.ResponseWriter, r *http.Request) {
+	a.serve(w, r, a.pubber.PostOutbox, a.pubber.GetOutbox)
+}
+
+// serve handles the request with the first of the handlers that handles it as
+// an ActivityPub request, or with NotActivityPub if none does.
+func (a *Actor) serve(w http.ResponseWriter, r *http.Request, handlers ...func(context.Context, http.ResponseWriter, *http.Request) (bool, error)) {
+	for _, h := range handlers {
+		handled, err := h(r.Context(), w, r)
+		if err != nil {
+			if a.OnError != nil {
+				a.OnError(w, r, err)
+			} else {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+			return
+		} else if handled {
+			return
+		}
+	}
+	if a.NotActivityPub != nil {
+		a.NotActivityPub.ServeHTTP(w, r)
+	} else {
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,153 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestActor_Modes(t *testing.T) {
+	tables := []struct {
+		name         string
+		client       bool
+		server       bool
+		expectInbox  int
+		expectOutbox int
+	}{
+		{"social", true, false, http.StatusMethodNotAllowed, http.StatusBadRequest},
+		{"federating", false, true, http.StatusOK, http.StatusMethodNotAllowed},
+		{"both", true, true, http.StatusOK, http.StatusBadRequest},
+		{"neither", false, false, http.StatusMethodNotAllowed, http.StatusMethodNotAllowed},
+	}
+	for _, r := range tables {
+		app, socialApp, fedApp, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+		PreparePubberPostInboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, nil)
+		fedCb.create = func(c context.Context, s *streams.Create) error {
+			return nil
+		}
+		socialApp.getSocialAPIVerifier = func(c context.Context) SocialAPIVerifier {
+			return &MockSocialAPIVerifier{
+				verifyForOutbox: func(r *http.Request, outbox *url.URL) (bool, bool, error) {
+					return false, false, nil
+				},
+			}
+		}
+		var client, server Callbacker
+		if r.client {
+			client = socialCb
+		}
+		if r.server {
+			server = fedCb
+		}
+		a, err := NewActor(&MockClock{now}, app, client, server, d, httpClient, testAgent, 1, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := httptest.NewRecorder()
+		a.Inbox(resp, ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(MustSerialize(testCreateNote)))))
+		if resp.Code != r.expectInbox {
+			t.Fatalf("%s: expected inbox %d, got %d", r.name, r.expectInbox, resp.Code)
+		}
+		resp = httptest.NewRecorder()
+		a.Outbox(resp, ActivityPubRequest(httptest.NewRequest("POST", testOutboxURI, bytes.NewBuffer(MustSerialize(testCreateNote)))))
+		if resp.Code != r.expectOutbox {
+			t.Fatalf("%s: expected outbox %d, got %d", r.name, r.expectOutbox, resp.Code)
+		}
+		gotNotActivityPub := 0
+		a.NotActivityPub = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotNotActivityPub++
+		})
+		a.Inbox(httptest.NewRecorder(), httptest.NewRequest("GET", testInboxURI, nil))
+		a.Outbox(httptest.NewRecorder(), httptest.NewRequest("GET", testOutboxURI, nil))
+		if gotNotActivityPub != 2 {
+			t.Fatalf("%s: expected %d, got %d", r.name, 2, gotNotActivityPub)
+		}
+		if !r.server {
+			continue
+		}
+		expectErr := errors.New("blocked")
+		fedApp.unblocked = func(c context.Context, actorIRIs []*url.URL) error {
+			return expectErr
+		}
+		var gotErr error
+		a.OnError = func(w http.ResponseWriter, r *http.Request, err error) {
+			gotErr = err
+		}
+		a.Inbox(httptest.NewRecorder(), ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(MustSerialize(testCreateNote)))))
+		if gotErr != expectErr {
+			t.Fatalf("%s: expected %v, got %v", r.name, expectErr, gotErr)
+		}
+	}
+}
+
+func TestActor_NotActivityPub(t *testing.T) {
+	app, _, _, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	a, err := NewActor(&MockClock{now}, app, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := httptest.NewRecorder()
+	a.Inbox(resp, httptest.NewRequest("GET", testInboxURI, nil))
+	if resp.Code != http.StatusNotFound {
+		t.Fatalf("expected %d, got %d", http.StatusNotFound, resp.Code)
+	}
+	gotNotActivityPub := 0
+	a.NotActivityPub = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotNotActivityPub++
+		w.WriteHeader(http.StatusNotAcceptable)
+	})
+	resp = httptest.NewRecorder()
+	a.Outbox(resp, httptest.NewRequest("GET", testOutboxURI, nil))
+	if gotNotActivityPub != 1 {
+		t.Fatalf("expected %d, got %d", 1, gotNotActivityPub)
+	} else if resp.Code != http.StatusNotAcceptable {
+		t.Fatalf("expected %d, got %d", http.StatusNotAcceptable, resp.Code)
+	}
+}
+
+func TestActor_OnError(t *testing.T) {
+	app, _, fedApp, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	expectErr := errors.New("blocked")
+	fedApp.unblocked = func(c context.Context, actorIRIs []*url.URL) error {
+		return expectErr
+	}
+	a, err := NewActor(&MockClock{now}, app, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := httptest.NewRecorder()
+	a.PostInbox(resp, ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(MustSerialize(testCreateNote)))))
+	if resp.Code != http.StatusInternalServerError {
+		t.Fatalf("expected %d, got %d", http.StatusInternalServerError, resp.Code)
+	}
+	var gotErr error
+	a.OnError = func(w http.ResponseWriter, r *http.Request, err error) {
+		gotErr = err
+		w.WriteHeader(http.StatusForbidden)
+	}
+	resp = httptest.NewRecorder()
+	a.PostInbox(resp, ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(MustSerialize(testCreateNote)))))
+	if gotErr != expectErr {
+		t.Fatalf("expected %v, got %v", expectErr, gotErr)
+	} else if resp.Code != http.StatusForbidden {
+		t.Fatalf("expected %d, got %d", http.StatusForbidden, resp.Code)
+	}
+}
+
+func TestNewActor_RequiresFederatingDependencies(t *testing.T) {
+	app, _, _, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	if _, err := NewActor(&MockClock{now}, app, socialCb, fedCb, nil, httpClient, testAgent, 1, 1); err == nil {
+		t.Fatalf("expected error without a Deliverer, got none")
+	}
+	if _, err := NewActor(&MockClock{now}, app, socialCb, fedCb, d, nil, testAgent, 1, 1); err == nil {
+		t.Fatalf("expected error without an HttpClient, got none")
+	}
+	if _, err := NewActor(&MockClock{now}, app, socialCb, nil, nil, nil, "", 0, 0); err != nil {
+		t.Fatalf("expected an Actor only implementing the Social API, got %s", err)
+	}
+}
@@ -0,0 +1,259 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	securityContext = "https://w3id.org/security/v1"
+	tootNamespace   = "http://joinmastodon.org/ns#"
+	typeProperty    = "type"
+)
+
+// ContextProfile is a JSON-LD context that served and delivered ActivityStreams
+// data is compacted against.
+//
+// The data itself is always serialized using the ActivityStreams terms, so a
+// profile may only extend the ActivityStreams context and never redefine its
+// terms. Peers that only understand a particular shape of '@context' will then
+// accept the payload while strict validators see the same data.
+type ContextProfile struct {
+	// Name uniquely identifies the profile.
+	Name string
+	// Context is the value of the '@context' property. It is either a
+	// string, a map[string]interface{}, or a []interface{} of these.
+	Context interface{}
+}
+
+var (
+	// MinimalContextProfile is the plain ActivityStreams context. It is the
+	// default when an Application does not implement ContextProfiler.
+	MinimalContextProfile = ContextProfile{
+		Name:    "minimal",
+		Context: activityPubContext,
+	}
+	// MastodonContextProfile extends the ActivityStreams context with the
+	// security vocabulary and the extension terms expected by Mastodon
+	// compatible peers.
+	MastodonContextProfile = ContextProfile{
+		Name: "mastodon",
+		Context: []interface{}{
+			activityPubContext,
+			securityContext,
+			map[string]interface{}{
+				"manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+				"sensitive":                 "as:sensitive",
+				"Hashtag":                   "as:Hashtag",
+				"toot":                      tootNamespace,
+				"Emoji":                     "toot:Emoji",
+				"featured": map[string]interface{}{
+					"@id":   "toot:featured",
+					"@type": "@id",
+				},
+			},
+		},
+	}
+)
+
+// ContextProfiler is an optional interface an Application may implement in
+// order to choose the ContextProfile used for each peer. It is the hook for an
+// application's own capability detection of peer software.
+//
+// If an Application does not implement this interface, MinimalContextProfile
+// is always used.
+type ContextProfiler interface {
+	// ContextProfileForRequest returns the profile to serve data with in
+	// response to the given request.
+	ContextProfileForRequest(c context.Context, r *http.Request) ContextProfile
+	// ContextProfileForInbox returns the profile to deliver data with to
+	// the given inbox.
+	ContextProfileForInbox(inbox *url.URL) ContextProfile
+}
+
+// ContextProfiles is a simple ContextProfiler that selects profiles based on
+// previously detected peer capabilities.
+type ContextProfiles struct {
+	// Default is used when no other profile matches. If its Name is empty,
+	// MinimalContextProfile is used instead.
+	Default ContextProfile
+	// UserAgents maps a substring of a requester's User-Agent header to the
+	// profile to serve it. The longest matching substring wins.
+	UserAgents map[string]ContextProfile
+	// Hosts maps the host of a peer's inbox to the profile to deliver to
+	// it with.
+	Hosts map[string]ContextProfile
+}
+
+var _ ContextProfiler = &ContextProfiles{}
+
+// ContextProfileForRequest returns the profile of the longest matching
+// User-Agent substring, or the default.
+func (p *ContextProfiles) ContextProfileForRequest(c context.Context, r *http.Request) ContextProfile {
+	ua := r.Header.Get(userAgentHeader)
+	var keys []string
+	for k := range p.UserAgents {
+		if strings.Contains(ua, k) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return p.defaultProfile()
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return p.UserAgents[keys[0]]
+}
+
+// ContextProfileForInbox returns the profile of the inbox's host, or the
+// default.
+func (p *ContextProfiles) ContextProfileForInbox(inbox *url.URL) ContextProfile {
+	if prof, ok := p.Hosts[inbox.Host]; ok {
+		return prof
+	}
+	return p.defaultProfile()
+}
+
+func (p *ContextProfiles) defaultProfile() ContextProfile {
+	if len(p.Default.Name) == 0 {
+		return MinimalContextProfile
+	}
+	return p.Default
+}
+
+// contextProfileForRequest determines the profile to serve a response with.
+func contextProfileForRequest(c context.Context, a Application, r *http.Request) ContextProfile {
+	if p, ok := a.(ContextProfiler); ok {
+		return p.ContextProfileForRequest(c, r)
+	}
+	return MinimalContextProfile
+}
+
+// contextProfileForInbox determines the profile to deliver to an inbox with.
+func contextProfileForInbox(a Application, inbox *url.URL) ContextProfile {
+	if p, ok := a.(ContextProfiler); ok {
+		return p.ContextProfileForInbox(inbox)
+	}
+	return MinimalContextProfile
+}
+
+// addContextProfile sets the profile's '@context' on the serialized data.
+func addContextProfile(m map[string]interface{}, p ContextProfile) {
+	if p.Context == nil {
+		addJSONLDContext(m)
+		return
+	}
+	m[jsonLDContext] = p.Context
+}
+
+// preservePrefixes extends the '@context' of the serialized data with the
+// declarations of prefixes, such as "toot" in "toot:featured", that its compact
+// IRIs use and that only the received '@context' declares. Relayed payloads
+// then keep their prefixed terms recognizable to the software that produced
+// them, instead of leaving them undefined.
+func preservePrefixes(m map[string]interface{}, received interface{}) {
+	declared := contextTerms(received)
+	if len(declared) == 0 {
+		return
+	}
+	used := make(map[string]bool)
+	usedPrefixes(m, used)
+	current := contextTerms(m[jsonLDContext])
+	extra := make(map[string]interface{})
+	for prefix := range used {
+		if _, ok := current[prefix]; ok {
+			continue
+		} else if d, ok := declared[prefix]; ok {
+			extra[prefix] = d
+		}
+	}
+	if len(extra) == 0 {
+		return
+	}
+	switch ctx := m[jsonLDContext].(type) {
+	case nil:
+		m[jsonLDContext] = extra
+	case []interface{}:
+		// Copy, as the context may be shared by a ContextProfile.
+		extended := make([]interface{}, 0, len(ctx)+1)
+		extended = append(extended, ctx...)
+		m[jsonLDContext] = append(extended, extra)
+	default:
+		m[jsonLDContext] = []interface{}{ctx, extra}
+	}
+}
+
+// contextTerms collects the term definitions of an '@context'. Remote contexts
+// referenced by IRI are not retrieved, so their terms are not included.
+func contextTerms(ctx interface{}) map[string]interface{} {
+	terms := make(map[string]interface{})
+	switch v := ctx.(type) {
+	case map[string]interface{}:
+		for k, d := range v {
+			terms[k] = d
+		}
+	case []interface{}:
+		for _, elem := range v {
+			for k, d := range contextTerms(elem) {
+				terms[k] = d
+			}
+		}
+	}
+	return terms
+}
+
+// usedPrefixes records the prefixes of the compact IRIs used as property names
+// or types within the serialized data.
+func usedPrefixes(i interface{}, used map[string]bool) {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		for k, elem := range v {
+			if k == jsonLDContext {
+				continue
+			} else if prefix, ok := compactIRIPrefix(k); ok {
+				used[prefix] = true
+			}
+			if k == typeProperty {
+				typePrefixes(elem, used)
+				continue
+			}
+			usedPrefixes(elem, used)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			usedPrefixes(elem, used)
+		}
+	}
+}
+
+// typePrefixes records the prefixes of the compact IRIs among the values of a
+// 'type' property.
+func typePrefixes(i interface{}, used map[string]bool) {
+	switch v := i.(type) {
+	case string:
+		if prefix, ok := compactIRIPrefix(v); ok {
+			used[prefix] = true
+		}
+	case []interface{}:
+		for _, elem := range v {
+			typePrefixes(elem, used)
+		}
+	}
+}
+
+// compactIRIPrefix returns the prefix of a compact IRI such as "sec:publicKey".
+// Absolute IRIs and plain terms have no prefix.
+func compactIRIPrefix(s string) (string, bool) {
+	i := strings.Index(s, ":")
+	if i <= 0 || strings.HasPrefix(s[i+1:], "//") {
+		return "", false
+	}
+	return s[:i], true
+}
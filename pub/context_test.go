@@ -0,0 +1,212 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/vocab"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+var _ ContextProfiler = &MockContextProfilerApp{}
+
+type MockContextProfilerApp struct {
+	*MockApplication
+	t                        *testing.T
+	contextProfileForRequest func(c context.Context, r *http.Request) ContextProfile
+	contextProfileForInbox   func(inbox *url.URL) ContextProfile
+}
+
+func (m *MockContextProfilerApp) ContextProfileForRequest(c context.Context, r *http.Request) ContextProfile {
+	if m.contextProfileForRequest == nil {
+		m.t.Fatal("unexpected call to MockContextProfilerApp ContextProfileForRequest")
+	}
+	return m.contextProfileForRequest(c, r)
+}
+
+func (m *MockContextProfilerApp) ContextProfileForInbox(inbox *url.URL) ContextProfile {
+	if m.contextProfileForInbox == nil {
+		m.t.Fatal("unexpected call to MockContextProfilerApp ContextProfileForInbox")
+	}
+	return m.contextProfileForInbox(inbox)
+}
+
+func TestContextProfiles(t *testing.T) {
+	strict := ContextProfile{Name: "strict", Context: activityPubContext}
+	p := &ContextProfiles{
+		UserAgents: map[string]ContextProfile{
+			"Mastodon":        MastodonContextProfile,
+			"Mastodon-Strict": strict,
+		},
+		Hosts: map[string]ContextProfile{
+			"mastodon.example.com": MastodonContextProfile,
+		},
+	}
+	tests := []struct {
+		name      string
+		userAgent string
+		expected  string
+	}{
+		{
+			name:      "no match uses default",
+			userAgent: "go-fed ActivityPub",
+			expected:  MinimalContextProfile.Name,
+		},
+		{
+			name:      "match",
+			userAgent: "http.rb/3.0 (Mastodon/2.4.0; +https://mastodon.example.com/)",
+			expected:  MastodonContextProfile.Name,
+		},
+		{
+			name:      "longest match wins",
+			userAgent: "Mastodon-Strict/1.0",
+			expected:  strict.Name,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running table test case %q", test.name)
+		r := httptest.NewRequest("GET", noteURIString, nil)
+		r.Header.Set("User-Agent", test.userAgent)
+		if got := p.ContextProfileForRequest(context.Background(), r); got.Name != test.expected {
+			t.Fatalf("(%q) expected %s, got %s", test.name, test.expected, got.Name)
+		}
+	}
+	u, err := url.Parse("https://mastodon.example.com/inbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.ContextProfileForInbox(u); got.Name != MastodonContextProfile.Name {
+		t.Fatalf("expected %s, got %s", MastodonContextProfile.Name, got.Name)
+	}
+	p.Default = strict
+	if got := p.ContextProfileForInbox(samIRI); got.Name != strict.Name {
+		t.Fatalf("expected %s, got %s", strict.Name, got.Name)
+	}
+}
+
+func TestServeActivityPubObject_ContextProfiler(t *testing.T) {
+	app := &MockContextProfilerApp{
+		MockApplication: &MockApplication{
+			t: t,
+			get: func(c context.Context, id *url.URL, rw RWType) (PubObject, error) {
+				note := &vocab.Note{}
+				note.SetId(noteIRI)
+				note.AppendNameString(noteName)
+				return note, nil
+			},
+			owns: func(c context.Context, id *url.URL) bool {
+				return true
+			},
+		},
+		t: t,
+		contextProfileForRequest: func(c context.Context, r *http.Request) ContextProfile {
+			return MastodonContextProfile
+		},
+	}
+	resp := httptest.NewRecorder()
+	fnUnderTest := ServeActivityPubObject(app, &MockClock{now})
+	handled, err := fnUnderTest(context.Background(), resp, ActivityPubRequest(httptest.NewRequest("GET", noteURIString, nil)))
+	if err != nil {
+		t.Fatal(err)
+	} else if !handled {
+		t.Fatalf("expected handled, got !handled")
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(resp.Body.Bytes(), &m); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(MastodonContextProfile.Context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected interface{}
+	if err := json.Unmarshal(b, &expected); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m["@context"], expected) {
+		t.Fatalf("expected %v, got %v", expected, m["@context"])
+	} else if m["name"] != noteName {
+		t.Fatalf("expected %s, got %v", noteName, m["name"])
+	}
+}
+
+func TestPreservePrefixes(t *testing.T) {
+	shared := ContextProfile{Name: "shared", Context: []interface{}{activityPubContext}}
+	tests := []struct {
+		name     string
+		profile  ContextProfile
+		received string
+		data     string
+		expected string
+	}{
+		{
+			name:     "adds undeclared prefix",
+			profile:  MinimalContextProfile,
+			received: `["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#", "sec": "https://w3id.org/security#"}]`,
+			data:     `{"type": "Person", "toot:featured": "https://example.com/featured"}`,
+			expected: `["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}]`,
+		},
+		{
+			name:     "adds prefix of nested type",
+			profile:  MinimalContextProfile,
+			received: `["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}]`,
+			data:     `{"type": "Note", "tag": [{"type": "toot:Emoji", "name": ":blob:"}]}`,
+			expected: `["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}]`,
+		},
+		{
+			name:     "copies profile context",
+			profile:  shared,
+			received: `["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}]`,
+			data:     `{"type": "Person", "toot:featured": "https://example.com/featured"}`,
+			expected: `["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}]`,
+		},
+		{
+			name:     "keeps prefix declared by profile",
+			profile:  MastodonContextProfile,
+			received: `["https://www.w3.org/ns/activitystreams", {"toot": "http://example.com/other#"}]`,
+			data:     `{"type": "Person", "toot:featured": "https://example.com/featured"}`,
+		},
+		{
+			name:     "ignores unused prefixes",
+			profile:  MinimalContextProfile,
+			received: `["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}]`,
+			data:     `{"type": "Note", "content": "https://example.com/not:a-prefix"}`,
+			expected: `"https://www.w3.org/ns/activitystreams"`,
+		},
+		{
+			name:     "received remote context only",
+			profile:  MinimalContextProfile,
+			received: `"https://www.w3.org/ns/activitystreams"`,
+			data:     `{"type": "Person", "sec:publicKey": "https://example.com/key"}`,
+			expected: `"https://www.w3.org/ns/activitystreams"`,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running table test case %q", test.name)
+		var received interface{}
+		if err := json.Unmarshal([]byte(test.received), &received); err != nil {
+			t.Fatal(err)
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(test.data), &m); err != nil {
+			t.Fatal(err)
+		}
+		addContextProfile(m, test.profile)
+		preservePrefixes(m, received)
+		var expected interface{} = test.profile.Context
+		if len(test.expected) > 0 {
+			if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if !reflect.DeepEqual(m[jsonLDContext], expected) {
+			t.Fatalf("(%q) expected %v, got %v", test.name, expected, m[jsonLDContext])
+		}
+	}
+	if l := len(shared.Context.([]interface{})); l != 1 {
+		t.Fatalf("expected shared profile to be unchanged, got %d elements", l)
+	}
+}
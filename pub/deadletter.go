@@ -0,0 +1,150 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DeadLetterStage is the stage of processing at which an activity permanently
+// failed.
+type DeadLetterStage string
+
+const (
+	// InboxStage is the processing of the side effects of an activity
+	// received in an inbox via the Federating Protocol.
+	InboxStage DeadLetterStage = "inbox"
+	// OutboxStage is the processing of the side effects of an activity
+	// posted to an outbox via the Social API.
+	OutboxStage DeadLetterStage = "outbox"
+	// DeliveryStage is the delivery of an activity to a federated peer.
+	DeliveryStage DeadLetterStage = "delivery"
+)
+
+// DeadLetter is an activity that could not be processed or delivered, along
+// with enough information to reprocess it later.
+type DeadLetter struct {
+	// Stage is where processing failed.
+	Stage DeadLetterStage
+	// Box is the inbox or outbox the activity was posted to, or for the
+	// DeliveryStage the inbox it was being delivered to.
+	Box *url.URL
+	// Sender is the outbox whose actor signs the delivery. It is only set
+	// for the DeliveryStage, and is nil when deliveries are not signed,
+	// such as when forwarding from an inbox.
+	Sender *url.URL
+	// Payload is the raw JSON of the activity.
+	Payload []byte
+	// Error is the reason processing failed.
+	Error string
+	// Failed is when processing failed.
+	Failed time.Time
+}
+
+// DeadLetterStore is an optional interface an Application may implement in
+// order to keep activities that permanently failed to be processed or
+// delivered. These may be reprocessed with the Pubber once the cause of the
+// failure is fixed.
+type DeadLetterStore interface {
+	// PutDeadLetter persists the dead letter.
+	PutDeadLetter(c context.Context, d DeadLetter) error
+}
+
+// DeadLetterDeliverer is an optional interface a Deliverer may implement in
+// order to report deliveries that it has stopped retrying. Without it,
+// deliveries are never written to the DeadLetterStore.
+type DeadLetterDeliverer interface {
+	Deliverer
+	// DoOrDeadLetter behaves like Do, but calls failed with the last error
+	// once the message will no longer be sent, including when it is
+	// dropped without being sent. Any error failed returns is reported
+	// like the other errors of the Deliverer.
+	DoOrDeadLetter(b []byte, to *url.URL, toDo func(b []byte, u *url.URL) error, failed func(err error) error)
+}
+
+// Reprocessor is an optional interface a Pubber may implement in order to
+// reprocess DeadLetters. Every Pubber provided by this package implements it.
+type Reprocessor interface {
+	// Reprocess attempts the failed stage of a DeadLetter again, such as
+	// after fixing the cause of the failure. Deliveries are scheduled with
+	// the Deliverer, while inbox and outbox side effects are applied
+	// before returning.
+	//
+	// A DeadLetter for the inbox or outbox that fails again is not written
+	// to the DeadLetterStore a second time.
+	Reprocess(c context.Context, d DeadLetter) error
+}
+
+var _ Reprocessor = &federator{}
+
+// putDeadLetter writes the dead letter to the Application, if it is a
+// DeadLetterStore. The original processing error is returned, annotated with
+// any error encountered while storing it.
+func (f *federator) putDeadLetter(c context.Context, d DeadLetter, err error) error {
+	if storeErr := f.storeDeadLetter(c, d, err); storeErr != nil {
+		return fmt.Errorf("%s; failed to store dead letter: %s", err, storeErr)
+	}
+	return err
+}
+
+// storeDeadLetter writes the dead letter failed with the error to the
+// Application, if it is a DeadLetterStore, returning only any error
+// encountered while storing it.
+func (f *federator) storeDeadLetter(c context.Context, d DeadLetter, err error) error {
+	s, ok := f.App.(DeadLetterStore)
+	if !ok {
+		return nil
+	}
+	d.Error = err.Error()
+	d.Failed = f.Clock.Now()
+	return s.PutDeadLetter(c, d)
+}
+
+// Reprocess attempts the failed stage of the dead letter again.
+func (f *federator) Reprocess(c context.Context, d DeadLetter) error {
+	if d.Box == nil {
+		return fmt.Errorf("dead letter has no box")
+	}
+	switch d.Stage {
+	case DeliveryStage:
+		if !f.EnableServer {
+			return fmt.Errorf("cannot reprocess %s dead letter: federating is not enabled", d.Stage)
+		}
+		var cr *creds
+		if d.Sender != nil {
+			var err error
+			cr, err = f.credsFor(d.Sender)
+			if err != nil {
+				return err
+			}
+		}
+		f.deliverBytes(c, d.Payload, d.Box, cr)
+		return nil
+	case InboxStage, OutboxStage:
+		var m map[string]interface{}
+		if err := json.Unmarshal(d.Payload, &m); err != nil {
+			return err
+		}
+		r, err := http.NewRequest("POST", d.Box.String(), nil)
+		if err != nil {
+			return err
+		}
+		r = r.WithContext(c)
+		if d.Stage == InboxStage {
+			if !f.EnableServer {
+				return fmt.Errorf("cannot reprocess %s dead letter: federating is not enabled", d.Stage)
+			}
+			return f.postInboxSideEffects(c, r, m)
+		}
+		if !f.EnableClient {
+			return fmt.Errorf("cannot reprocess %s dead letter: the social API is not enabled", d.Stage)
+		}
+		_, err = f.postOutboxSideEffects(c, r, m)
+		return err
+	default:
+		return fmt.Errorf("unknown dead letter stage: %q", d.Stage)
+	}
+}
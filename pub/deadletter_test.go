@@ -0,0 +1,136 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+var _ DeadLetterStore = &MockDeadLetterApp{}
+
+type MockDeadLetterApp struct {
+	*MockSocialFederateApp
+	t             *testing.T
+	putDeadLetter func(c context.Context, d DeadLetter) error
+}
+
+func (m *MockDeadLetterApp) PutDeadLetter(c context.Context, d DeadLetter) error {
+	if m.putDeadLetter == nil {
+		m.t.Fatal("unexpected call to MockDeadLetterApp PutDeadLetter")
+	}
+	return m.putDeadLetter(c, d)
+}
+
+func TestPostInbox_FailedSideEffectIsDeadLettered(t *testing.T) {
+	app, socialApp, fedApp, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	dlApp := &MockDeadLetterApp{MockSocialFederateApp: app, t: t}
+	p := NewPubber(&MockClock{now}, dlApp, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+	PreparePubberPostInboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
+	b := MustSerialize(testCreateNote)
+	resp := httptest.NewRecorder()
+	req := ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(b)))
+	fedCb.create = func(c context.Context, s *streams.Create) error {
+		return fmt.Errorf("expected")
+	}
+	var gotLetter DeadLetter
+	gotPut := 0
+	dlApp.putDeadLetter = func(c context.Context, d DeadLetter) error {
+		gotPut++
+		gotLetter = d
+		return nil
+	}
+	handled, err := p.PostInbox(context.Background(), resp, req)
+	if err == nil || err.Error() != "expected" {
+		t.Fatalf("expected error %q, got %v", "expected", err)
+	} else if !handled {
+		t.Fatalf("expected handled, got !handled")
+	} else if gotPut != 1 {
+		t.Fatalf("expected %d, got %d", 1, gotPut)
+	} else if gotLetter.Stage != InboxStage {
+		t.Fatalf("expected %s, got %s", InboxStage, gotLetter.Stage)
+	} else if s := gotLetter.Box.String(); s != testInboxURI {
+		t.Fatalf("expected %s, got %s", testInboxURI, s)
+	} else if !bytes.Equal(gotLetter.Payload, b) {
+		t.Fatalf("expected %s, got %s", b, gotLetter.Payload)
+	} else if gotLetter.Error != "expected" {
+		t.Fatalf("expected %s, got %s", "expected", gotLetter.Error)
+	} else if !gotLetter.Failed.Equal(now) {
+		t.Fatalf("expected %s, got %s", now, gotLetter.Failed)
+	}
+	gotCreate := 0
+	fedCb.create = func(c context.Context, s *streams.Create) error {
+		gotCreate++
+		return nil
+	}
+	if err := p.(Reprocessor).Reprocess(context.Background(), gotLetter); err != nil {
+		t.Fatal(err)
+	} else if gotCreate != 1 {
+		t.Fatalf("expected %d, got %d", 1, gotCreate)
+	} else if gotPut != 1 {
+		t.Fatalf("expected %d, got %d", 1, gotPut)
+	}
+}
+
+var _ DeadLetterDeliverer = &MockDeadLetterDeliverer{}
+
+type MockDeadLetterDeliverer struct {
+	*MockDeliverer
+	doOrDeadLetter func(b []byte, to *url.URL, toDo func(b []byte, u *url.URL) error, failed func(err error) error)
+}
+
+func (m *MockDeadLetterDeliverer) DoOrDeadLetter(b []byte, to *url.URL, toDo func(b []byte, u *url.URL) error, failed func(err error) error) {
+	if m.doOrDeadLetter == nil {
+		m.t.Fatal("unexpected call to MockDeadLetterDeliverer DoOrDeadLetter")
+	}
+	m.doOrDeadLetter(b, to, toDo, failed)
+}
+
+func TestReprocess_UndeliverableIsDeadLetteredWithContext(t *testing.T) {
+	type key struct{}
+	app, _, _, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	dlApp := &MockDeadLetterApp{MockSocialFederateApp: app, t: t}
+	dlDeliverer := &MockDeadLetterDeliverer{MockDeliverer: d}
+	p := NewPubber(&MockClock{now}, dlApp, socialCb, fedCb, dlDeliverer, httpClient, testAgent, 1, 1)
+	var failed func(err error) error
+	dlDeliverer.doOrDeadLetter = func(b []byte, to *url.URL, toDo func(b []byte, u *url.URL) error, f func(err error) error) {
+		failed = f
+	}
+	var gotLetter DeadLetter
+	dlApp.putDeadLetter = func(c context.Context, d DeadLetter) error {
+		if c.Value(key{}) == nil {
+			t.Fatalf("expected the context of the delivery")
+		}
+		gotLetter = d
+		return fmt.Errorf("store")
+	}
+	b := MustSerialize(testCreateNote)
+	c := context.WithValue(context.Background(), key{}, true)
+	if err := p.(Reprocessor).Reprocess(c, DeadLetter{Stage: DeliveryStage, Box: samIRIInbox, Payload: b}); err != nil {
+		t.Fatal(err)
+	} else if failed == nil {
+		t.Fatalf("expected delivery with a dead letter")
+	}
+	if err := failed(fmt.Errorf("expected")); err == nil || !strings.Contains(err.Error(), "store") {
+		t.Fatalf("expected error storing the dead letter, got %v", err)
+	} else if gotLetter.Stage != DeliveryStage {
+		t.Fatalf("expected %s, got %s", DeliveryStage, gotLetter.Stage)
+	} else if gotLetter.Error != "expected" {
+		t.Fatalf("expected %s, got %s", "expected", gotLetter.Error)
+	}
+}
+
+func TestReprocess_UnknownStage(t *testing.T) {
+	_, _, _, _, _, _, _, p := NewPubberTest(t)
+	err := p.(Reprocessor).Reprocess(context.Background(), DeadLetter{
+		Stage: DeadLetterStage("unknown"),
+		Box:   samIRI,
+	})
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
@@ -1,6 +1,7 @@
 package pub
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -174,14 +175,19 @@ func (f *federator) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
-	b, err := ioutil.ReadAll(r.Body)
+	l := f.limits()
+	b, err := l.ReadAll(r.Body)
 	if err != nil {
 		return true, err
 	}
-	var m map[string]interface{}
-	if err = json.Unmarshal(b, &m); err != nil {
+	m, err := l.Unmarshal(b)
+	if err != nil {
 		return true, err
 	}
+	if err = validateIRIs(f.App, m); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return true, nil
+	}
 	ao, err := getActorObject(m)
 	if err != nil {
 		return true, err
@@ -202,29 +208,101 @@ func (f *federator) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 			iris = append(iris, ao.GetActorIRI(i))
 		}
 	}
+	if verifier, ok := f.FederateAPI.(InboxVerifier); ok {
+		if ok, err := f.verifyForInbox(c, w, r, verifier, b, iris); !ok {
+			return true, err
+		}
+	}
 	if err = f.FederateAPI.Unblocked(c, iris); err != nil {
 		return true, err
 	}
-	if err := f.addToInboxIfNew(c, r, m, func() error {
-		if err = f.getPostInboxResolver(c, r.URL).Deserialize(m); err != nil {
-			return err
-		}
-		return nil
-	}); err != nil {
+	if err := f.postInboxSideEffects(c, r, m); err != nil {
 		if err == errObjectRequired || err == errTargetRequired {
 			w.WriteHeader(http.StatusBadRequest)
 			return true, nil
 		} else {
-			return true, err
+			return true, f.putDeadLetter(c, DeadLetter{
+				Stage:   InboxStage,
+				Box:     r.URL,
+				Payload: b,
+			}, err)
 		}
 	}
-	if err := f.inboxForwarding(c, m); err != nil {
-		return true, err
-	}
 	w.WriteHeader(http.StatusOK)
 	return true, nil
 }
 
+// verifyForInbox authenticates the server posting the body to the inbox with
+// the InboxVerifier, falling back to HTTP Signatures. A signature must cover
+// the Digest of the body and be made with the key of one of the actors of the
+// activity. It returns false if the request is rejected, in which case the
+// response has already been written unless there is an error.
+func (f *federator) verifyForInbox(c context.Context, w http.ResponseWriter, r *http.Request, verifier InboxVerifier, b []byte, actors []*url.URL) (bool, error) {
+	// The body has already been read, so the InboxVerifier is given it
+	// anew.
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	authenticated, authorized, err := verifier.VerifyForInbox(r, r.URL)
+	if err != nil {
+		return false, err
+	} else if authenticated && !authorized {
+		w.WriteHeader(http.StatusForbidden)
+		return false, nil
+	} else if !authenticated && !authorized {
+		w.WriteHeader(http.StatusBadRequest)
+		return false, nil
+	} else if authenticated {
+		return true, nil
+	}
+	// Use HTTP Signatures to authenticate and authorize.
+	v, err := httpsig.NewVerifier(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return false, nil
+	}
+	if err = validateKeyId(f.App, v.KeyId()); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return false, nil
+	}
+	if !isSignedHeader(r, digestHeader) {
+		w.WriteHeader(http.StatusBadRequest)
+		return false, nil
+	}
+	if err = verifyDigest(r, b); err != nil {
+		w.WriteHeader(http.StatusForbidden)
+		return false, nil
+	}
+	pk, algo, user, err := f.App.GetPublicKey(c, v.KeyId())
+	if err != nil {
+		return false, err
+	}
+	if err = verifySignature(f.App, v, r, b, pk, algo); err != nil {
+		w.WriteHeader(http.StatusForbidden)
+		return false, nil
+	}
+	for _, actor := range actors {
+		if user != nil && actor.String() == user.String() {
+			return true, nil
+		}
+	}
+	// The key is not that of an actor of the activity.
+	w.WriteHeader(http.StatusForbidden)
+	return false, nil
+}
+
+// postInboxSideEffects adds a new activity to the inbox, applies its side
+// effects, and forwards it if needed.
+func (f *federator) postInboxSideEffects(c context.Context, r *http.Request, m map[string]interface{}) error {
+	if err := f.addToInboxIfNew(c, r, m, func() error {
+		if err := f.getPostInboxResolver(c, r.URL).Deserialize(m); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return f.inboxForwarding(c, m)
+}
+
 func (f *federator) GetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
 	if !isActivityPubGet(r) {
 		return false, nil
@@ -241,7 +319,7 @@ func (f *federator) GetInbox(c context.Context, w http.ResponseWriter, r *http.R
 	if err != nil {
 		return true, err
 	}
-	addJSONLDContext(m)
+	addContextProfile(m, contextProfileForRequest(c, f.App, r))
 	b, err := json.Marshal(m)
 	if err != nil {
 		return true, err
@@ -265,11 +343,17 @@ func (f *federator) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
+	l := f.limits()
+	b, err := l.ReadAll(r.Body)
+	if err != nil {
+		return true, err
+	}
+	// The body has already been read, so the verifier is given it anew.
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
 	// By default, enforce HTTP Signatures.
 	authenticated := false
 	authorized := true
 	if verifier := f.SocialAPI.GetSocialAPIVerifier(c); verifier != nil {
-		var err error
 		// Use custom Social API method to authenticate and authorize.
 		authenticated, authorized, err = verifier.VerifyForOutbox(r, r.URL)
 		if err != nil {
@@ -289,23 +373,27 @@ func (f *federator) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 			w.WriteHeader(http.StatusBadRequest)
 			return true, nil
 		}
+		if err = validateKeyId(f.App, v.KeyId()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return true, nil
+		}
 		pk, algo, err := f.SocialAPI.GetPublicKeyForOutbox(c, v.KeyId(), r.URL)
 		if err != nil {
 			return true, err
 		}
-		err = v.Verify(pk, algo)
+		err = verifySignature(f.App, v, r, b, pk, algo)
 		if err != nil {
 			w.WriteHeader(http.StatusForbidden)
 			return true, nil
 		}
 	}
-	b, err := ioutil.ReadAll(r.Body)
+	m, err := l.Unmarshal(b)
 	if err != nil {
 		return true, err
 	}
-	var m map[string]interface{}
-	if err = json.Unmarshal(b, &m); err != nil {
-		return true, err
+	if err = validateIRIs(f.App, m); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return true, nil
 	}
 	typer, err := toTypeIder(m)
 	if err != nil {
@@ -333,38 +421,61 @@ func (f *federator) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 		iActivity, ok = typer.(vocab.IntransitiveActivityType)
 		if !ok {
 			return true, fmt.Errorf("assigning new ids: cannot convert to vocab.ActivityType nor vocab.IntransitiveActivityType: %T", typer)
-		} else {
-			f.addNewIdsIntransitive(c, iActivity)
+		} else if err = f.addNewIdsIntransitive(c, iActivity); err != nil {
+			return true, err
 		}
-	} else {
-		f.addNewIds(c, activity)
+	} else if err = f.addNewIds(c, activity); err != nil {
+		return true, err
 	}
+	received := m[jsonLDContext]
 	if m, err = typer.Serialize(); err != nil {
 		return true, err
 	}
-	deliverable := false
-	if err = f.getPostOutboxResolver(c, m, &deliverable, &m, r.URL).Deserialize(m); err != nil {
+	if received != nil {
+		m[jsonLDContext] = received
+	}
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return true, err
+	}
+	if _, err = f.postOutboxSideEffects(c, r, m); err != nil {
 		if err == errObjectRequired || err == errTargetRequired {
 			w.WriteHeader(http.StatusBadRequest)
 			return true, nil
 		}
-		return true, err
+		return true, f.putDeadLetter(c, DeadLetter{
+			Stage:   OutboxStage,
+			Box:     r.URL,
+			Payload: payload,
+		}, err)
 	}
-	if err = f.addToOutbox(c, r, m); err != nil {
-		return true, err
+	w.Header().Set("Location", activity.GetId().String())
+	w.WriteHeader(http.StatusCreated)
+	return true, nil
+}
+
+// postOutboxSideEffects applies the side effects of an activity with its new
+// ids assigned, adds it to the outbox, and delivers it if needed. The
+// resulting activity is returned.
+func (f *federator) postOutboxSideEffects(c context.Context, r *http.Request, m map[string]interface{}) (map[string]interface{}, error) {
+	deliverable := false
+	received := m[jsonLDContext]
+	if err := f.getPostOutboxResolver(c, m, &deliverable, &m, r.URL).Deserialize(m); err != nil {
+		return m, err
+	}
+	if err := f.addToOutbox(c, r, m); err != nil {
+		return m, err
 	}
 	if f.EnableServer && deliverable {
 		obj, err := toAnyActivity(m)
 		if err != nil {
-			return true, err
+			return m, err
 		}
-		if err := f.deliver(obj, r.URL); err != nil {
-			return true, err
+		if err := f.deliver(c, obj, r.URL, received); err != nil {
+			return m, err
 		}
 	}
-	w.Header().Set("Location", activity.GetId().String())
-	w.WriteHeader(http.StatusCreated)
-	return true, nil
+	return m, nil
 }
 
 func (f *federator) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
@@ -379,7 +490,7 @@ func (f *federator) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 	if err != nil {
 		return true, err
 	}
-	addJSONLDContext(m)
+	addContextProfile(m, contextProfileForRequest(c, f.App, r))
 	b, err := json.Marshal(m)
 	if err != nil {
 		return true, err
@@ -811,31 +922,7 @@ func (f *federator) handleClientLike(ctx context.Context, deliverable *bool) fun
 		if s.LenObject() == 0 {
 			return errObjectRequired
 		}
-		getter := func(actor vocab.ObjectType, lc *vocab.CollectionType, loc *vocab.OrderedCollectionType) (bool, error) {
-			if actor.IsLikedAnyURI() {
-				pObj, err := f.App.Get(ctx, actor.GetLikedAnyURI(), ReadWrite)
-				if err != nil {
-					return true, err
-				}
-				ok := false
-				if *lc, ok = pObj.(vocab.CollectionType); !ok {
-					if *loc, ok = pObj.(vocab.OrderedCollectionType); !ok {
-						return true, fmt.Errorf("actors liked collection not CollectionType nor OrderedCollectionType")
-					}
-				}
-				return true, nil
-			} else if actor.IsLikedCollection() {
-				*lc = actor.GetLikedCollection()
-				return false, nil
-			} else if actor.IsLikedOrderedCollection() {
-				*loc = actor.GetLikedOrderedCollection()
-				return false, nil
-			}
-			*loc = &vocab.OrderedCollection{}
-			actor.SetLikedOrderedCollection(*loc)
-			return false, nil
-		}
-		if err := f.addAllObjectsToActorCollection(ctx, getter, s.Raw(), true); err != nil {
+		if err := f.addAllObjectsToActorCollection(ctx, f.likedGetter(ctx), s.Raw(), true); err != nil {
 			return err
 		}
 		return f.ClientCallbacker.Like(ctx, s)
@@ -852,7 +939,9 @@ func (f *federator) handleClientUndo(c context.Context, deliverable *bool) func(
 		if err := f.ensureActivityActorsMatchObjectActors(raw); err != nil {
 			return err
 		}
-		// TODO: Determine if we can support common forms of undo natively.
+		if err := f.undoObjects(c, raw); err != nil {
+			return err
+		}
 		return f.ClientCallbacker.Undo(c, s)
 	}
 }
@@ -1225,7 +1314,16 @@ func (f *federator) handleFollow(c context.Context, inboxURL *url.URL) func(s *s
 				}
 			}
 			if ownsAny {
-				if err := f.deliver(activity, inboxURL); err != nil {
+				// Only applications reserving ids get them minted for
+				// automatic responses.
+				if r, ok := f.App.(IdReserver); ok {
+					id, err := r.ReserveNewId(c, activity)
+					if err != nil {
+						return err
+					}
+					activity.SetId(id)
+				}
+				if err := f.deliver(c, activity, inboxURL, nil); err != nil {
 					return err
 				}
 			}
@@ -1247,31 +1345,7 @@ func (f *federator) handleAccept(c context.Context) func(s *streams.Accept) erro
 				if !ok {
 					continue
 				}
-				getter := func(actor vocab.ObjectType, lc *vocab.CollectionType, loc *vocab.OrderedCollectionType) (bool, error) {
-					if actor.IsFollowingAnyURI() {
-						pObj, err := f.App.Get(c, actor.GetFollowingAnyURI(), ReadWrite)
-						if err != nil {
-							return true, err
-						}
-						ok := false
-						if *lc, ok = pObj.(vocab.CollectionType); !ok {
-							if *loc, ok = pObj.(vocab.OrderedCollectionType); !ok {
-								return true, fmt.Errorf("actors following collection not CollectionType nor OrderedCollectionType")
-							}
-						}
-						return true, nil
-					} else if actor.IsFollowingCollection() {
-						*lc = actor.GetFollowingCollection()
-						return false, nil
-					} else if actor.IsFollowingOrderedCollection() {
-						*loc = actor.GetFollowingOrderedCollection()
-						return false, nil
-					}
-					*loc = &vocab.OrderedCollection{}
-					actor.SetFollowingOrderedCollection(*loc)
-					return false, nil
-				}
-				if err := f.addAllObjectsToActorCollection(c, getter, follow, true); err != nil {
+				if err := f.addAllObjectsToActorCollection(c, f.followingGetter(c), follow, true); err != nil {
 					return err
 				}
 			}
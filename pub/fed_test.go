@@ -6,6 +6,8 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -468,6 +470,36 @@ func Sign(r *http.Request) *http.Request {
 	return r
 }
 
+// SignWithDigest adds the Digest of the body to the request and signs it along
+// with the Date and request target.
+func SignWithDigest(r *http.Request) *http.Request {
+	return signWithDigest(testPrivateKey, r)
+}
+
+// BadSignatureWithDigest is SignWithDigest with the wrong key.
+func BadSignatureWithDigest(r *http.Request) *http.Request {
+	return signWithDigest(testOtherPrivateKey, r)
+}
+
+func signWithDigest(key crypto.PrivateKey, r *http.Request) *http.Request {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		panic(err)
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	hashed := sha256.Sum256(b)
+	r.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(hashed[:]))
+	s, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "digest"}, httpsig.Signature)
+	if err != nil {
+		panic(err)
+	}
+	err = s.SignRequest(key, testPublicKeyId, r)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
 func ActivityPubRequest(r *http.Request) *http.Request {
 	if r.Method == "POST" {
 		existing, ok := r.Header["Content-Type"]
@@ -893,6 +925,21 @@ func (m *MockSocialFederateApp) CanRemove(c context.Context, o vocab.ObjectType,
 	return m.MockFederateApp.CanRemove(c, o, t)
 }
 
+var _ IdReserver = &MockIdReserverApp{}
+
+type MockIdReserverApp struct {
+	*MockSocialFederateApp
+	t            *testing.T
+	reserveNewId func(c context.Context, t Typer) (*url.URL, error)
+}
+
+func (m *MockIdReserverApp) ReserveNewId(c context.Context, t Typer) (*url.URL, error) {
+	if m.reserveNewId == nil {
+		m.t.Fatal("unexpected call to MockIdReserverApp ReserveNewId")
+	}
+	return m.reserveNewId(c, t)
+}
+
 var _ Deliverer = &MockDeliverer{}
 
 type MockDeliverer struct {
@@ -943,6 +990,17 @@ func (m *MockSocialAPIVerifier) VerifyForOutbox(r *http.Request, outbox *url.URL
 	return m.verifyForOutbox(r, outbox)
 }
 
+var _ InboxVerifier = &MockInboxVerifierApp{}
+
+type MockInboxVerifierApp struct {
+	*MockSocialFederateApp
+	verifyForInbox func(r *http.Request, inbox *url.URL) (authn, authz bool, err error)
+}
+
+func (m *MockInboxVerifierApp) VerifyForInbox(r *http.Request, inbox *url.URL) (authn, authz bool, err error) {
+	return m.verifyForInbox(r, inbox)
+}
+
 func NewSocialPubberTest(t *testing.T) (app *MockApplication, socialApp *MockSocialApp, cb *MockCallbacker, p Pubber) {
 	clock := &MockClock{now}
 	app = &MockApplication{t: t}
@@ -4835,6 +4893,62 @@ func TestPostOutbox_Create_CallsCallback(t *testing.T) {
 	}
 }
 
+func TestPostOutbox_Create_ReservesNewIds(t *testing.T) {
+	app, socialApp, fedApp, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	reserver := &MockIdReserverApp{MockSocialFederateApp: app, t: t}
+	p := NewPubber(&MockClock{now}, reserver, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+	PreparePubberPostOutboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
+	app.MockFederateApp.newId = nil
+	resp := httptest.NewRecorder()
+	req := Sign(ActivityPubRequest(httptest.NewRequest("POST", testOutboxURI, bytes.NewBuffer(MustSerialize(testCreateNote)))))
+	socialCb.create = func(c context.Context, s *streams.Create) error {
+		return nil
+	}
+	gotReserve := 0
+	reserver.reserveNewId = func(c context.Context, t Typer) (*url.URL, error) {
+		gotReserve++
+		if gotReserve == 1 {
+			return testNewIRI, nil
+		}
+		return testNewIRI2, nil
+	}
+	var gotSetCreate PubObject
+	app.MockFederateApp.set = func(c context.Context, o PubObject) error {
+		if gotSetCreate == nil {
+			gotSetCreate = o
+		}
+		return nil
+	}
+	handled, err := p.PostOutbox(context.Background(), resp, req)
+	if err != nil {
+		t.Fatal(err)
+	} else if !handled {
+		t.Fatalf("expected handled, got !handled")
+	} else if gotReserve != 2 {
+		t.Fatalf("expected %d, got %d", 2, gotReserve)
+	} else if err := PubObjectEquals(gotSetCreate, testClientExpectedNote); err != nil {
+		t.Fatalf("unexpected set object: %s", err)
+	}
+}
+
+func TestPostOutbox_Create_ReserveNewIdError(t *testing.T) {
+	app, socialApp, fedApp, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	reserver := &MockIdReserverApp{MockSocialFederateApp: app, t: t}
+	p := NewPubber(&MockClock{now}, reserver, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+	PreparePubberPostOutboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
+	resp := httptest.NewRecorder()
+	req := Sign(ActivityPubRequest(httptest.NewRequest("POST", testOutboxURI, bytes.NewBuffer(MustSerialize(testCreateNote)))))
+	reserver.reserveNewId = func(c context.Context, t Typer) (*url.URL, error) {
+		return nil, fmt.Errorf("expected")
+	}
+	handled, err := p.PostOutbox(context.Background(), resp, req)
+	if err == nil || err.Error() != "expected" {
+		t.Fatalf("expected error %q, got %v", "expected", err)
+	} else if !handled {
+		t.Fatalf("expected handled, got !handled")
+	}
+}
+
 func TestPostOutbox_Create_IsDelivered(t *testing.T) {
 	app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p := NewPubberTest(t)
 	PreparePubberPostOutboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
@@ -6372,6 +6486,9 @@ func TestPostOutbox_Undo_CallsCallback(t *testing.T) {
 	req := Sign(ActivityPubRequest(httptest.NewRequest("POST", testOutboxURI, bytes.NewBuffer(MustSerialize(testUndoLike)))))
 	gotCallback := 0
 	var gotCallbackObject *streams.Undo
+	app.MockFederateApp.owns = func(c context.Context, iri *url.URL) bool {
+		return false
+	}
 	socialCb.undo = func(c context.Context, s *streams.Undo) error {
 		gotCallback++
 		gotCallbackObject = s
@@ -6389,11 +6506,131 @@ func TestPostOutbox_Undo_CallsCallback(t *testing.T) {
 	}
 }
 
+func TestPostOutbox_Undo_RemovesFromLikedCollection(t *testing.T) {
+	app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p := NewPubberTest(t)
+	PreparePubberPostOutboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
+	resp := httptest.NewRecorder()
+	req := Sign(ActivityPubRequest(httptest.NewRequest("POST", testOutboxURI, bytes.NewBuffer(MustSerialize(testUndoLike)))))
+	gotOwns := 0
+	var gotOwnsIri *url.URL
+	app.MockFederateApp.owns = func(c context.Context, iri *url.URL) bool {
+		gotOwns++
+		gotOwnsIri = iri
+		return true
+	}
+	gotGet := 0
+	var gotGetIri *url.URL
+	app.MockFederateApp.get = func(c context.Context, iri *url.URL, rw RWType) (PubObject, error) {
+		if rw != ReadWrite {
+			t.Fatalf("expected RWType of %v, got %v", ReadWrite, rw)
+		}
+		gotGet++
+		gotGetIri = iri
+		likes := &vocab.Collection{}
+		likes.AppendItemsIRI(noteIRI)
+		likes.AppendItemsIRI(samIRI)
+		v := &vocab.Person{}
+		v.AppendNameString("Sally")
+		v.SetId(sallyIRI)
+		v.SetLikedCollection(likes)
+		return v, nil
+	}
+	gotSet := 0
+	var gotSetObj PubObject
+	app.MockFederateApp.set = func(c context.Context, o PubObject) error {
+		gotSet++
+		if gotSet == 1 {
+			gotSetObj = o
+		}
+		return nil
+	}
+	socialCb.undo = func(c context.Context, s *streams.Undo) error {
+		return nil
+	}
+	handled, err := p.PostOutbox(context.Background(), resp, req)
+	expectedLikes := &vocab.Collection{}
+	expectedLikes.AppendItemsIRI(samIRI)
+	expectedActor := &vocab.Person{}
+	expectedActor.AppendNameString("Sally")
+	expectedActor.SetId(sallyIRI)
+	expectedActor.SetLikedCollection(expectedLikes)
+	if err != nil {
+		t.Fatal(err)
+	} else if !handled {
+		t.Fatalf("expected handled, got !handled")
+	} else if gotOwns != 1 {
+		t.Fatalf("expected %d, got %d", 1, gotOwns)
+	} else if gotOwnsString := gotOwnsIri.String(); gotOwnsString != sallyIRIString {
+		t.Fatalf("expected %s, got %s", sallyIRIString, gotOwnsString)
+	} else if gotGet != 1 {
+		t.Fatalf("expected %d, got %d", 1, gotGet)
+	} else if gotGetString := gotGetIri.String(); gotGetString != sallyIRIString {
+		t.Fatalf("expected %s, got %s", sallyIRIString, gotGetString)
+	} else if gotSet != 3 {
+		t.Fatalf("expected %d, got %d", 3, gotSet)
+	} else if err := PubObjectEquals(gotSetObj, expectedActor); err != nil {
+		t.Fatalf("set obj: %s", err)
+	}
+}
+
+func TestPostOutbox_Undo_RemovesFromFollowingCollection(t *testing.T) {
+	app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p := NewPubberTest(t)
+	PreparePubberPostOutboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
+	resp := httptest.NewRecorder()
+	undo := &vocab.Undo{}
+	undo.SetId(noteActivityIRI)
+	undo.AppendActorObject(sallyActor)
+	undo.AppendObject(testFollow)
+	undo.AppendToObject(samActor)
+	req := Sign(ActivityPubRequest(httptest.NewRequest("POST", testOutboxURI, bytes.NewBuffer(MustSerialize(undo)))))
+	app.MockFederateApp.owns = func(c context.Context, iri *url.URL) bool {
+		return true
+	}
+	app.MockFederateApp.get = func(c context.Context, iri *url.URL, rw RWType) (PubObject, error) {
+		following := &vocab.OrderedCollection{}
+		following.AppendOrderedItemsIRI(samIRI)
+		v := &vocab.Person{}
+		v.AppendNameString("Sally")
+		v.SetId(sallyIRI)
+		v.SetFollowingOrderedCollection(following)
+		return v, nil
+	}
+	gotSet := 0
+	var gotSetObj PubObject
+	app.MockFederateApp.set = func(c context.Context, o PubObject) error {
+		gotSet++
+		if gotSet == 1 {
+			gotSetObj = o
+		}
+		return nil
+	}
+	socialCb.undo = func(c context.Context, s *streams.Undo) error {
+		return nil
+	}
+	handled, err := p.PostOutbox(context.Background(), resp, req)
+	expectedActor := &vocab.Person{}
+	expectedActor.AppendNameString("Sally")
+	expectedActor.SetId(sallyIRI)
+	expectedActor.SetFollowingOrderedCollection(&vocab.OrderedCollection{})
+	if err != nil {
+		t.Fatal(err)
+	} else if !handled {
+		t.Fatalf("expected handled, got !handled")
+	} else if gotSet != 3 {
+		t.Fatalf("expected %d, got %d", 3, gotSet)
+	} else if err := PubObjectEquals(gotSetObj, expectedActor); err != nil {
+		t.Fatalf("set obj: %s", err)
+	}
+}
+
 func TestPostOutbox_Undo_IsDelivered(t *testing.T) {
 	app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p := NewPubberTest(t)
 	PreparePubberPostOutboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
 	resp := httptest.NewRecorder()
 	req := Sign(ActivityPubRequest(httptest.NewRequest("POST", testOutboxURI, bytes.NewBuffer(MustSerialize(testUndoLike)))))
+	app.MockFederateApp.owns = func(c context.Context, iri *url.URL) bool {
+		return false
+	}
 	socialCb.undo = func(c context.Context, s *streams.Undo) error {
 		return nil
 	}
@@ -8314,3 +8551,69 @@ func TestPostInbox_Announce_AddsToSharesIRI(t *testing.T) {
 		t.Fatalf("unexpected callback object: %s", err)
 	}
 }
+
+func TestPostInbox_InboxVerifier(t *testing.T) {
+	tables := []struct {
+		name   string
+		authn  bool
+		authz  bool
+		sign   func(r *http.Request) *http.Request
+		user   *url.URL
+		expect int
+	}{
+		{"authorized", true, true, nil, sallyIRI, http.StatusOK},
+		{"unauthorized", true, false, nil, sallyIRI, http.StatusForbidden},
+		{"unauthenticated", false, false, nil, sallyIRI, http.StatusBadRequest},
+		{"fallback signed", false, true, SignWithDigest, sallyIRI, http.StatusOK},
+		{"fallback not signed", false, true, nil, sallyIRI, http.StatusBadRequest},
+		{"fallback wrong signature", false, true, BadSignatureWithDigest, sallyIRI, http.StatusForbidden},
+		{"fallback digest not signed", false, true, Sign, sallyIRI, http.StatusBadRequest},
+		{"fallback tampered body", false, true, func(r *http.Request) *http.Request {
+			r = SignWithDigest(r)
+			r.Body = ioutil.NopCloser(bytes.NewBuffer(MustSerialize(testUpdateNote)))
+			return r
+		}, sallyIRI, http.StatusForbidden},
+		{"fallback key not of actor", false, true, SignWithDigest, samIRI, http.StatusForbidden},
+	}
+	for _, r := range tables {
+		app, socialApp, fedApp, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+		gotVerifyForInbox := 0
+		var gotInbox *url.URL
+		vApp := &MockInboxVerifierApp{
+			MockSocialFederateApp: app,
+			verifyForInbox: func(req *http.Request, inbox *url.URL) (bool, bool, error) {
+				gotVerifyForInbox++
+				gotInbox = inbox
+				return r.authn, r.authz, nil
+			},
+		}
+		p := NewPubber(&MockClock{now}, vApp, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+		PreparePubberPostInboxTest(t, app, socialApp, fedApp, socialCb, fedCb, d, httpClient, p)
+		app.MockFederateApp.getPublicKey = func(c context.Context, publicKeyId string) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+			return testPrivateKey.Public(), httpsig.RSA_SHA256, r.user, nil
+		}
+		fedCb.create = func(c context.Context, s *streams.Create) error {
+			return nil
+		}
+		fedCb.update = func(c context.Context, s *streams.Update) error {
+			return nil
+		}
+		resp := httptest.NewRecorder()
+		req := ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(MustSerialize(testCreateNote))))
+		if r.sign != nil {
+			req = r.sign(req)
+		}
+		handled, err := p.PostInbox(context.Background(), resp, req)
+		if err != nil {
+			t.Fatalf("%s: %s", r.name, err)
+		} else if !handled {
+			t.Fatalf("%s: expected handled, got !handled", r.name)
+		} else if gotVerifyForInbox != 1 {
+			t.Fatalf("%s: expected %d, got %d", r.name, 1, gotVerifyForInbox)
+		} else if s := gotInbox.String(); s != testInboxURI {
+			t.Fatalf("%s: expected %s, got %s", r.name, testInboxURI, s)
+		} else if resp.Code != r.expect {
+			t.Fatalf("%s: expected %d, got %d", r.name, r.expect, resp.Code)
+		}
+	}
+}
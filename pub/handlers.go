@@ -87,11 +87,16 @@ func serveActivityPubObject(c context.Context, a Application, clock Clock, w htt
 			var publicKey crypto.PublicKey
 			var algo httpsig.Algorithm
 			var user *url.URL
+			if err = validateKeyId(a, v.KeyId()); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				err = nil
+				return
+			}
 			publicKey, algo, user, err = a.GetPublicKey(c, v.KeyId())
 			if err != nil {
 				return
 			}
-			err = v.Verify(publicKey, algo)
+			err = verifySignature(a, v, r, nil, publicKey, algo)
 			if err != nil && !authenticated { // Failed and must pass HTTP Signature verification
 				w.WriteHeader(http.StatusForbidden)
 				err = nil
@@ -118,7 +123,7 @@ func serveActivityPubObject(c context.Context, a Application, clock Clock, w htt
 	if err != nil {
 		return
 	}
-	addJSONLDContext(m)
+	addContextProfile(m, contextProfileForRequest(c, a, r))
 	var b []byte
 	b, err = json.Marshal(m)
 	if err != nil {
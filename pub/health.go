@@ -0,0 +1,191 @@
+package pub
+
+import (
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultDeadPeerFailures is the number of consecutive failed deliveries after
+// which a HealthMonitor considers a peer dead, when it is not given a number.
+const DefaultDeadPeerFailures = 10
+
+// HealthReport summarizes the health of federation with peers. It is meant to
+// be marshalled as JSON for dashboards or periodic emails to administrators.
+type HealthReport struct {
+	// Generated is when the report was created.
+	Generated time.Time `json:"generated"`
+	// Deliveries are the totals of all delivery attempts.
+	Deliveries DeliveryStats `json:"deliveries"`
+	// DeadPeers are the hosts whose recent deliveries have all failed,
+	// sorted by host.
+	DeadPeers []PeerHealth `json:"deadPeers"`
+	// SignatureFailures are the hosts that sent requests failing HTTP
+	// Signature verification, sorted by decreasing failure rate.
+	SignatureFailures []SignatureStats `json:"signatureFailures"`
+	// Queues are the number of items waiting in each named queue.
+	Queues map[string]int `json:"queues"`
+}
+
+// DeliveryStats counts delivery attempts.
+type DeliveryStats struct {
+	Attempted int `json:"attempted"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// PeerHealth is the delivery history of a single peer host.
+type PeerHealth struct {
+	Host                string        `json:"host"`
+	Deliveries          DeliveryStats `json:"deliveries"`
+	ConsecutiveFailures int           `json:"consecutiveFailures"`
+	LastSuccess         time.Time     `json:"lastSuccess"`
+	LastFailure         time.Time     `json:"lastFailure"`
+	LastError           string        `json:"lastError,omitempty"`
+}
+
+// SignatureStats counts the HTTP Signature verifications of requests from a
+// single peer host.
+type SignatureStats struct {
+	Host        string  `json:"host"`
+	Verified    int     `json:"verified"`
+	Failed      int     `json:"failed"`
+	FailureRate float64 `json:"failureRate"`
+}
+
+// HealthMonitor collects statistics about federation with peers, and
+// aggregates them into a HealthReport. It is safe for concurrent use.
+type HealthMonitor struct {
+	clock      Clock
+	deadAfter  int
+	mu         sync.Mutex
+	peers      map[string]*PeerHealth
+	signatures map[string]*SignatureStats
+	queues     map[string]func() int
+}
+
+// NewHealthMonitor creates a HealthMonitor that considers a peer dead once
+// deadAfter consecutive deliveries to it have failed. If deadAfter is not
+// positive then DefaultDeadPeerFailures is used.
+func NewHealthMonitor(clock Clock, deadAfter int) *HealthMonitor {
+	if deadAfter <= 0 {
+		deadAfter = DefaultDeadPeerFailures
+	}
+	return &HealthMonitor{
+		clock:      clock,
+		deadAfter:  deadAfter,
+		peers:      make(map[string]*PeerHealth),
+		signatures: make(map[string]*SignatureStats),
+		queues:     make(map[string]func() int),
+	}
+}
+
+// RecordDelivery records the result of an attempt to deliver to the inbox.
+func (h *HealthMonitor) RecordDelivery(inbox *url.URL, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p, ok := h.peers[inbox.Host]
+	if !ok {
+		p = &PeerHealth{Host: inbox.Host}
+		h.peers[inbox.Host] = p
+	}
+	p.Deliveries.Attempted++
+	if err != nil {
+		p.Deliveries.Failed++
+		p.ConsecutiveFailures++
+		p.LastFailure = h.clock.Now()
+		p.LastError = err.Error()
+	} else {
+		p.Deliveries.Succeeded++
+		p.ConsecutiveFailures = 0
+		p.LastSuccess = h.clock.Now()
+	}
+}
+
+// RecordSignature records the result of verifying the HTTP Signature of a
+// request signed with the key id.
+func (h *HealthMonitor) RecordSignature(keyId string, err error) {
+	u, parseErr := url.Parse(keyId)
+	if parseErr != nil || len(u.Host) == 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.signatures[u.Host]
+	if !ok {
+		s = &SignatureStats{Host: u.Host}
+		h.signatures[u.Host] = s
+	}
+	if err != nil {
+		s.Failed++
+	} else {
+		s.Verified++
+	}
+}
+
+// AddQueue includes the depth of a named queue in reports, such as the
+// QueueDepth of a DelivererPool.
+func (h *HealthMonitor) AddQueue(name string, depth func() int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.queues[name] = depth
+}
+
+// Report aggregates the statistics collected so far.
+func (h *HealthMonitor) Report() HealthReport {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r := HealthReport{
+		Generated:         h.clock.Now(),
+		DeadPeers:         []PeerHealth{},
+		SignatureFailures: []SignatureStats{},
+		Queues:            make(map[string]int, len(h.queues)),
+	}
+	for _, p := range h.peers {
+		r.Deliveries.Attempted += p.Deliveries.Attempted
+		r.Deliveries.Succeeded += p.Deliveries.Succeeded
+		r.Deliveries.Failed += p.Deliveries.Failed
+		if p.ConsecutiveFailures >= h.deadAfter {
+			r.DeadPeers = append(r.DeadPeers, *p)
+		}
+	}
+	sort.Slice(r.DeadPeers, func(i, j int) bool {
+		return r.DeadPeers[i].Host < r.DeadPeers[j].Host
+	})
+	for _, s := range h.signatures {
+		if s.Failed == 0 {
+			continue
+		}
+		stats := *s
+		stats.FailureRate = float64(s.Failed) / float64(s.Failed+s.Verified)
+		r.SignatureFailures = append(r.SignatureFailures, stats)
+	}
+	sort.Slice(r.SignatureFailures, func(i, j int) bool {
+		if r.SignatureFailures[i].FailureRate != r.SignatureFailures[j].FailureRate {
+			return r.SignatureFailures[i].FailureRate > r.SignatureFailures[j].FailureRate
+		}
+		return r.SignatureFailures[i].Host < r.SignatureFailures[j].Host
+	})
+	for name, depth := range h.queues {
+		r.Queues[name] = depth()
+	}
+	return r
+}
+
+// HealthMonitored is an optional interface an Application may implement in
+// order to have the outcome of its deliveries and HTTP Signature
+// verifications recorded for federation health reports.
+type HealthMonitored interface {
+	// HealthMonitor returns the monitor to record statistics with. It may
+	// return nil to not record them.
+	HealthMonitor() *HealthMonitor
+}
+
+// healthMonitor obtains the Application's HealthMonitor, if it has one.
+func healthMonitor(a Application) *HealthMonitor {
+	if hm, ok := a.(HealthMonitored); ok {
+		return hm.HealthMonitor()
+	}
+	return nil
+}
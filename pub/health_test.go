@@ -0,0 +1,71 @@
+package pub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestHealthMonitorReport(t *testing.T) {
+	clock := &MockClock{now}
+	h := NewHealthMonitor(clock, 2)
+	alive, err := url.Parse("https://alive.example.com/inbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dead, err := url.Parse("https://dead.example.com/inbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.RecordDelivery(alive, fmt.Errorf("expected"))
+	h.RecordDelivery(alive, nil)
+	h.RecordDelivery(dead, nil)
+	clock.now = now.Add(time.Minute)
+	h.RecordDelivery(dead, fmt.Errorf("first"))
+	h.RecordDelivery(dead, fmt.Errorf("second"))
+	h.RecordSignature("https://alive.example.com/actor#main-key", nil)
+	h.RecordSignature("https://alive.example.com/actor#main-key", fmt.Errorf("expected"))
+	h.RecordSignature("https://bad.example.com/actor#main-key", fmt.Errorf("expected"))
+	h.RecordSignature("https://good.example.com/actor#main-key", nil)
+	h.RecordSignature("no host", fmt.Errorf("expected"))
+	h.AddQueue("delivery", func() int { return 3 })
+	r := h.Report()
+	if !r.Generated.Equal(clock.now) {
+		t.Fatalf("expected %s, got %s", clock.now, r.Generated)
+	}
+	expectedDeliveries := DeliveryStats{Attempted: 5, Succeeded: 2, Failed: 3}
+	if r.Deliveries != expectedDeliveries {
+		t.Fatalf("expected %v, got %v", expectedDeliveries, r.Deliveries)
+	}
+	if len(r.DeadPeers) != 1 {
+		t.Fatalf("expected 1 dead peer, got %d", len(r.DeadPeers))
+	} else if p := r.DeadPeers[0]; p.Host != dead.Host {
+		t.Fatalf("expected %s, got %s", dead.Host, p.Host)
+	} else if p.ConsecutiveFailures != 2 {
+		t.Fatalf("expected %d, got %d", 2, p.ConsecutiveFailures)
+	} else if p.LastError != "second" {
+		t.Fatalf("expected %s, got %s", "second", p.LastError)
+	} else if !p.LastSuccess.Equal(now) {
+		t.Fatalf("expected %s, got %s", now, p.LastSuccess)
+	}
+	expectedSignatures := []SignatureStats{
+		{Host: "bad.example.com", Failed: 1, FailureRate: 1},
+		{Host: "alive.example.com", Verified: 1, Failed: 1, FailureRate: 0.5},
+	}
+	if len(r.SignatureFailures) != len(expectedSignatures) {
+		t.Fatalf("expected %v, got %v", expectedSignatures, r.SignatureFailures)
+	}
+	for i, s := range expectedSignatures {
+		if r.SignatureFailures[i] != s {
+			t.Fatalf("expected %v, got %v", s, r.SignatureFailures[i])
+		}
+	}
+	if r.Queues["delivery"] != 3 {
+		t.Fatalf("expected %d, got %d", 3, r.Queues["delivery"])
+	}
+	if _, err := json.Marshal(r); err != nil {
+		t.Fatal(err)
+	}
+}
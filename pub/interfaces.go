@@ -115,6 +115,22 @@ type Application interface {
 	CanRemove(c context.Context, o vocab.ObjectType, t vocab.ObjectType) bool
 }
 
+// IdReserver is an optional interface an Application may implement in order to
+// mint and reserve new ids in a single step. This prevents a mismatch between
+// the IRIs this library assigns to new objects and the IRIs the application
+// actually serves.
+//
+// When implemented, it is used instead of the Application's NewId for new
+// activities and the objects of a Create. It is also used to give ids to the
+// Accept and Reject activities automatically sent in response to a Follow,
+// which otherwise have none.
+type IdReserver interface {
+	// ReserveNewId returns a new ActivityStreams IRI for the object after
+	// reserving it in the application's router and storage. Returning an
+	// error aborts handling the request.
+	ReserveNewId(c context.Context, t Typer) (*url.URL, error)
+}
+
 // RWType indicates the kind of reading being done.
 type RWType bool
 
@@ -199,6 +215,26 @@ type FederateAPI interface {
 	PrivateKey(boxIRI *url.URL) (privKey crypto.PrivateKey, pubKeyId string, err error)
 }
 
+// InboxVerifier is an optional interface a FederateAPI may implement in order
+// to authenticate the servers posting activities to inboxes. Without it,
+// PostInbox does not authenticate the requests, leaving it to the application.
+type InboxVerifier interface {
+	// VerifyForInbox determines whether the request may post to the
+	// provided inbox IRI, such as by consulting the peers the application
+	// trusts. The request may fall back to HTTP Signatures, whose public
+	// keys are fetched with the Application's GetPublicKey. Such a
+	// signature must cover the Digest of the body, and the user of its key
+	// must be an 'actor' of the activity.
+	//
+	// Return values are interpreted as follows:
+	//     (true,  true,   <nil>) => the server passed authentication and is authorized
+	//     (true,  false,  <nil>) => the server passed authentication but failed authorization for this inbox (Permission denied)
+	//     (false, true,   <nil>) => authentication failed: must pass HTTP Signature verification or will be Permission Denied
+	//     (false, false,  <nil>) => authentication failed: deny access (Bad request)
+	//     (<any>, <any>,  error) => an internal error occurred during validation
+	VerifyForInbox(r *http.Request, inbox *url.URL) (authn, authz bool, err error)
+}
+
 // SocialApp is an implementation only for the Social API part of the
 // ActivityPub specification.
 type SocialApplication interface {
@@ -272,7 +308,9 @@ type Callbacker interface {
 	Follow(c context.Context, s *streams.Follow) error
 	// Undo Activity callback. It is up to the client to provide support
 	// for all 'Undo' operations; this implementation does not attempt to
-	// provide a generic implementation.
+	// provide a generic implementation, except that for a client this
+	// library removes the 'object' of an embedded 'Like' or 'Follow' from
+	// the 'liked' or 'following' collection of its 'actor'.
 	Undo(c context.Context, s *streams.Undo) error
 	// Accept Activity callback. In the special case that this 'Accept'
 	// activity has an 'object' of 'Follow' type, then the library will
@@ -351,6 +389,48 @@ type actorObject interface {
 	GetActorIRI(index int) (v *url.URL)
 }
 
+// addressedObject is an object addressed to recipients via the "to", "bto",
+// "cc", "bcc", and "audience" objects and/or links and/or IRIs.
+type addressedObject interface {
+	ToLen() (l int)
+	IsToObject(index int) (ok bool)
+	GetToObject(index int) (v vocab.ObjectType)
+	IsToLink(index int) (ok bool)
+	GetToLink(index int) (v vocab.LinkType)
+	IsToIRI(index int) (ok bool)
+	GetToIRI(index int) (v *url.URL)
+	BtoLen() (l int)
+	IsBtoObject(index int) (ok bool)
+	GetBtoObject(index int) (v vocab.ObjectType)
+	IsBtoLink(index int) (ok bool)
+	GetBtoLink(index int) (v vocab.LinkType)
+	IsBtoIRI(index int) (ok bool)
+	GetBtoIRI(index int) (v *url.URL)
+	CcLen() (l int)
+	IsCcObject(index int) (ok bool)
+	GetCcObject(index int) (v vocab.ObjectType)
+	IsCcLink(index int) (ok bool)
+	GetCcLink(index int) (v vocab.LinkType)
+	IsCcIRI(index int) (ok bool)
+	GetCcIRI(index int) (v *url.URL)
+	BccLen() (l int)
+	IsBccObject(index int) (ok bool)
+	GetBccObject(index int) (v vocab.ObjectType)
+	IsBccLink(index int) (ok bool)
+	GetBccLink(index int) (v vocab.LinkType)
+	IsBccIRI(index int) (ok bool)
+	GetBccIRI(index int) (v *url.URL)
+	AudienceLen() (l int)
+	IsAudienceObject(index int) (ok bool)
+	GetAudienceObject(index int) (v vocab.ObjectType)
+	IsAudienceLink(index int) (ok bool)
+	GetAudienceLink(index int) (v vocab.LinkType)
+	IsAudienceIRI(index int) (ok bool)
+	GetAudienceIRI(index int) (v *url.URL)
+}
+
+var _ addressedObject = &vocab.Object{}
+
 // deliverableObject is an object that is able to be sent to recipients via the
 // "to", "bto", "cc", "bcc", and "audience" objects and/or links and/or IRIs.
 type deliverableObject interface {
@@ -11,7 +11,6 @@ import (
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/vocab"
 	"github.com/go-fed/httpsig"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -26,9 +25,7 @@ const (
 	dateHeader                = "Date"
 	digestHeader              = "Digest"
 	acceptHeader              = "Accept"
-	publicActivityPub         = "https://www.w3.org/ns/activitystreams#Public"
-	publicJsonLD              = "Public"
-	publicJsonLDAS            = "as:Public"
+	userAgentHeader           = "User-Agent"
 	jsonLDContext             = "@context"
 	activityPubContext        = "https://www.w3.org/ns/activitystreams"
 	sha256Digest              = "SHA-256"
@@ -77,7 +74,7 @@ func isActivityPubGet(r *http.Request) bool {
 // isPublic determines if a target is the Public collection as defined in the
 // spec, including JSON-LD compliant collections.
 func isPublic(s string) bool {
-	return s == publicActivityPub || s == publicJsonLD || s == publicJsonLDAS
+	return vocab.IsPublicIRI(s)
 }
 
 func addJSONLDContext(m map[string]interface{}) {
@@ -97,11 +94,28 @@ func addResponseHeaders(h http.Header, c Clock, responseContent []byte) {
 	h.Set(digestHeader, b.String())
 }
 
+// verifyDigest returns an error unless the SHA-256 Digest header of the request
+// matches the body, as defined by RFC 3230 and RFC 5843.
+func verifyDigest(r *http.Request, body []byte) error {
+	hashed := sha256.Sum256(body)
+	expected := base64.StdEncoding.EncodeToString(hashed[:])
+	for _, d := range strings.Split(r.Header.Get(digestHeader), ",") {
+		kv := strings.SplitN(strings.TrimSpace(d), digestDelimiter, 2)
+		if len(kv) != 2 || !strings.EqualFold(kv[0], sha256Digest) {
+			continue
+		} else if kv[1] != expected {
+			return fmt.Errorf("digest %s does not match the body", kv[1])
+		}
+		return nil
+	}
+	return fmt.Errorf("no %s digest", sha256Digest)
+}
+
 // dereference makes an HTTP GET request to an IRI in order to obtain the
-// ActivityStream representation.
+// ActivityStream representation, which must be within the limits.
 //
 // creds is allowed to be nil.
-func dereference(c HttpClient, u *url.URL, agent string, creds *creds, clock Clock) ([]byte, error) {
+func dereference(c HttpClient, u *url.URL, agent string, creds *creds, clock Clock, limits streams.Limits) ([]byte, error) {
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
@@ -125,13 +139,21 @@ func dereference(c HttpClient, u *url.URL, agent string, creds *creds, clock Clo
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("Request to %s failed (%d): %s", u.String(), resp.StatusCode, resp.Status)
 	}
-	return ioutil.ReadAll(resp.Body)
+	b, err := limits.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	} else if err = limits.Check(b); err != nil {
+		return nil, err
+	}
+	return b, nil
 }
 
 type creds struct {
 	signer   httpsig.Signer
 	privKey  crypto.PrivateKey
 	pubKeyId string
+	// boxIRI is the outbox of the actor the credentials belong to.
+	boxIRI *url.URL
 }
 
 // dereferenceAsUser is meant to be used by the activity handlers that need to
@@ -151,7 +173,7 @@ func (f *federator) dereferenceAsUser(boxIRI, fetchIRI *url.URL) (obj vocab.Obje
 	if err != nil {
 		return
 	}
-	resp, err := dereference(f.Client, fetchIRI, f.Agent, creds, f.Clock)
+	resp, err := dereference(f.Client, fetchIRI, f.Agent, creds, f.Clock, f.limits())
 	if err != nil {
 		return
 	}
@@ -196,25 +218,46 @@ func postToOutbox(c HttpClient, b []byte, to *url.URL, agent string, creds *cred
 	return nil
 }
 
+// newId mints a new IRI for the object. If the application is an IdReserver,
+// the IRI is also reserved by it.
+func (f *federator) newId(c context.Context, t Typer) (*url.URL, error) {
+	if r, ok := f.App.(IdReserver); ok {
+		return r.ReserveNewId(c, t)
+	}
+	return f.App.NewId(c, t), nil
+}
+
 // addNewIds will add new IDs not just for an activity, but all objects
 // contained within the activity if it is a Create activity.
-func (f *federator) addNewIds(c context.Context, a vocab.ActivityType) {
-	newId := f.App.NewId(c, a)
+func (f *federator) addNewIds(c context.Context, a vocab.ActivityType) error {
+	newId, err := f.newId(c, a)
+	if err != nil {
+		return err
+	}
 	a.SetId(newId)
 	if vocab.HasTypeCreate(a) {
 		for i := 0; i < a.ObjectLen(); i++ {
 			if a.IsObject(i) {
 				obj := a.GetObject(i)
-				obj.SetId(f.App.NewId(c, obj))
+				objId, err := f.newId(c, obj)
+				if err != nil {
+					return err
+				}
+				obj.SetId(objId)
 			}
 		}
 	}
+	return nil
 }
 
 // addNewIdsIntransitive will add new IDs for an intransitive activity.
-func (f *federator) addNewIdsIntransitive(c context.Context, a vocab.IntransitiveActivityType) {
-	newId := f.App.NewId(c, a)
+func (f *federator) addNewIdsIntransitive(c context.Context, a vocab.IntransitiveActivityType) error {
+	newId, err := f.newId(c, a)
+	if err != nil {
+		return err
+	}
 	a.SetId(newId)
+	return nil
 }
 
 // wrapInCreate will automatically wrap the provided object in a Create
@@ -720,44 +763,97 @@ func (f *federator) sameRecipients(a vocab.ActivityType) error {
 // TODO: (Section 7) HTTP caching mechanisms [RFC7234] SHOULD be respected when appropriate, both when receiving responses from other servers as well as sending responses to other servers.
 
 // deliver will complete the peer-to-peer sending of a federated message to
-// another server.
-func (f *federator) deliver(obj vocab.ActivityType, boxIRI *url.URL) error {
+// another server. The received '@context' is the one the activity was posted
+// with, if any.
+func (f *federator) deliver(c context.Context, obj vocab.ActivityType, boxIRI *url.URL, received interface{}) error {
 	recipients, err := f.prepare(boxIRI, obj)
 	if err != nil {
 		return err
 	}
-	creds := &creds{}
-	creds.signer, err = f.FederateAPI.NewSigner()
+	creds, err := f.credsFor(boxIRI)
 	if err != nil {
 		return err
 	}
+	return f.deliverToRecipients(c, obj, recipients, creds, received)
+}
+
+// credsFor obtains the credentials to sign deliveries on behalf of the actor
+// that owns the given outbox.
+func (f *federator) credsFor(boxIRI *url.URL) (*creds, error) {
+	var err error
+	creds := &creds{boxIRI: boxIRI}
+	creds.signer, err = f.FederateAPI.NewSigner()
+	if err != nil {
+		return nil, err
+	}
 	creds.privKey, creds.pubKeyId, err = f.FederateAPI.PrivateKey(boxIRI)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return f.deliverToRecipients(obj, recipients, creds)
+	return creds, nil
 }
 
 // deliverToRecipients will take a prepared Activity and send it to specific
-// recipients without examining the activity.
-func (f *federator) deliverToRecipients(obj vocab.ActivityType, recipients []*url.URL, creds *creds) error {
-	m, err := obj.Serialize()
-	if err != nil {
-		return err
-	}
-	addJSONLDContext(m)
-	b, err := json.Marshal(m)
+// recipients without examining the activity. The hidden recipients of the
+// objects it embeds are left out, as are its own. Prefixes declared by the
+// received '@context' are preserved in the delivered one.
+func (f *federator) deliverToRecipients(c context.Context, obj vocab.ActivityType, recipients []*url.URL, creds *creds, received interface{}) error {
+	m, err := PrepareForDelivery(obj)
 	if err != nil {
 		return err
 	}
+	// Marshal once per distinct context profile of the recipients.
+	payloads := make(map[string][]byte, 1)
 	for _, to := range recipients {
-		f.deliverer.Do(b, to, func(b []byte, u *url.URL) error {
-			return postToOutbox(f.Client, b, u, f.Agent, creds, f.Clock)
-		})
+		profile := contextProfileForInbox(f.App, to)
+		b, ok := payloads[profile.Name]
+		if !ok {
+			addContextProfile(m, profile)
+			preservePrefixes(m, received)
+			b, err = json.Marshal(m)
+			if err != nil {
+				return err
+			}
+			payloads[profile.Name] = b
+		}
+		f.deliverBytes(c, b, to, creds)
 	}
 	return nil
 }
 
+// deliverBytes schedules the serialized activity to be sent to a single
+// recipient. If the Deliverer gives up on it, it is written to the
+// DeadLetterStore with the context of the delivery.
+func (f *federator) deliverBytes(c context.Context, b []byte, to *url.URL, creds *creds) {
+	hm := healthMonitor(f.App)
+	toDo := func(b []byte, u *url.URL) error {
+		err := postToOutbox(f.Client, b, u, f.Agent, creds, f.Clock)
+		if hm != nil {
+			hm.RecordDelivery(u, err)
+		}
+		return err
+	}
+	d, ok := f.deliverer.(DeadLetterDeliverer)
+	if !ok {
+		f.deliverer.Do(b, to, toDo)
+		return
+	}
+	letter := DeadLetter{
+		Stage:   DeliveryStage,
+		Box:     to,
+		Payload: b,
+	}
+	if creds != nil {
+		letter.Sender = creds.boxIRI
+	}
+	d.DoOrDeadLetter(b, to, toDo, func(err error) error {
+		if storeErr := f.storeDeadLetter(c, letter, err); storeErr != nil {
+			return fmt.Errorf("failed to store dead letter for %s: %s", to, storeErr)
+		}
+		return nil
+	})
+}
+
 // prepare takes a deliverableObject and returns a list of the proper recipient
 // target URIs. Additionally, the deliverableObject will have any hidden
 // hidden recipients ("bto" and "bcc") stripped from it.
@@ -837,7 +933,7 @@ func (c *federator) resolveInboxes(boxIRI *url.URL, r []*url.URL, depth int, max
 				uris = getURIsInItemer(c)
 				return nil
 			}
-			err := doForCollectionPage(c.Client, c.Agent, cb, cp.Raw(), cr, c.Clock)
+			err := doForCollectionPage(c.Client, c.Agent, cb, cp.Raw(), cr, c.Clock, c.limits())
 			if err != nil {
 				return nil, err
 			}
@@ -851,7 +947,7 @@ func (c *federator) resolveInboxes(boxIRI *url.URL, r []*url.URL, depth int, max
 				uris = getURIsInOrderedItemer(c)
 				return nil
 			}
-			err := doForOrderedCollectionPage(c.Client, c.Agent, cb, ocp.Raw(), cr, c.Clock)
+			err := doForOrderedCollectionPage(c.Client, c.Agent, cb, ocp.Raw(), cr, c.Clock, c.limits())
 			if err != nil {
 				return nil, err
 			}
@@ -871,7 +967,7 @@ func (c *federator) dereferenceForResolvingInboxes(u, boxIRI *url.URL, cr *creds
 	// To pass back to calling function, since may be set recursively:
 	cred = cr
 	var resp []byte
-	resp, err = dereference(c.Client, u, c.Agent, cr, c.Clock)
+	resp, err = dereference(c.Client, u, c.Agent, cr, c.Clock, c.limits())
 	if err != nil {
 		return
 	}
@@ -1047,7 +1143,7 @@ func filterURLs(u []*url.URL, fn func(s string) bool) []*url.URL {
 	return u
 }
 
-func getToIRIs(o deliverableObject) []*url.URL {
+func getToIRIs(o addressedObject) []*url.URL {
 	var r []*url.URL
 	for i := 0; i < o.ToLen(); i++ {
 		if o.IsToObject(i) {
@@ -1067,7 +1163,7 @@ func getToIRIs(o deliverableObject) []*url.URL {
 	return r
 }
 
-func getBToIRIs(o deliverableObject) []*url.URL {
+func getBToIRIs(o addressedObject) []*url.URL {
 	var r []*url.URL
 	for i := 0; i < o.BtoLen(); i++ {
 		if o.IsBtoObject(i) {
@@ -1087,7 +1183,7 @@ func getBToIRIs(o deliverableObject) []*url.URL {
 	return r
 }
 
-func getCcIRIs(o deliverableObject) []*url.URL {
+func getCcIRIs(o addressedObject) []*url.URL {
 	var r []*url.URL
 	for i := 0; i < o.CcLen(); i++ {
 		if o.IsCcObject(i) {
@@ -1107,7 +1203,7 @@ func getCcIRIs(o deliverableObject) []*url.URL {
 	return r
 }
 
-func getBccIRIs(o deliverableObject) []*url.URL {
+func getBccIRIs(o addressedObject) []*url.URL {
 	var r []*url.URL
 	for i := 0; i < o.BccLen(); i++ {
 		if o.IsBccObject(i) {
@@ -1127,7 +1223,7 @@ func getBccIRIs(o deliverableObject) []*url.URL {
 	return r
 }
 
-func getAudienceIRIs(o deliverableObject) []*url.URL {
+func getAudienceIRIs(o addressedObject) []*url.URL {
 	var r []*url.URL
 	for i := 0; i < o.AudienceLen(); i++ {
 		if o.IsAudienceObject(i) {
@@ -1149,7 +1245,7 @@ func getAudienceIRIs(o deliverableObject) []*url.URL {
 
 // doForCollectionPage applies a function over a collection and its subsequent
 // pages recursively. It returns the first non-nil error it encounters.
-func doForCollectionPage(h HttpClient, agent string, cb func(c vocab.CollectionPageType) error, c vocab.CollectionPageType, creds *creds, clock Clock) error {
+func doForCollectionPage(h HttpClient, agent string, cb func(c vocab.CollectionPageType) error, c vocab.CollectionPageType, creds *creds, clock Clock, limits streams.Limits) error {
 	err := cb(c)
 	if err != nil {
 		return err
@@ -1157,12 +1253,12 @@ func doForCollectionPage(h HttpClient, agent string, cb func(c vocab.CollectionP
 	if c.IsNextCollectionPage() {
 		// Handle this one weird trick that other peers HATE federating
 		// with.
-		return doForCollectionPage(h, agent, cb, c.GetNextCollectionPage(), creds, clock)
+		return doForCollectionPage(h, agent, cb, c.GetNextCollectionPage(), creds, clock, limits)
 	} else if c.IsNextLink() {
 		l := c.GetNextLink()
 		if l.HasHref() {
 			u := l.GetHref()
-			resp, err := dereference(h, u, agent, creds, clock)
+			resp, err := dereference(h, u, agent, creds, clock, limits)
 			if err != nil {
 				return err
 			}
@@ -1176,12 +1272,12 @@ func doForCollectionPage(h HttpClient, agent string, cb func(c vocab.CollectionP
 				return err
 			}
 			if next != nil {
-				return doForCollectionPage(h, agent, cb, next.Raw(), creds, clock)
+				return doForCollectionPage(h, agent, cb, next.Raw(), creds, clock, limits)
 			}
 		}
 	} else if c.IsNextIRI() {
 		u := c.GetNextIRI()
-		resp, err := dereference(h, u, agent, creds, clock)
+		resp, err := dereference(h, u, agent, creds, clock, limits)
 		if err != nil {
 			return err
 		}
@@ -1195,7 +1291,7 @@ func doForCollectionPage(h HttpClient, agent string, cb func(c vocab.CollectionP
 			return err
 		}
 		if next != nil {
-			return doForCollectionPage(h, agent, cb, next.Raw(), creds, clock)
+			return doForCollectionPage(h, agent, cb, next.Raw(), creds, clock, limits)
 		}
 	}
 	return nil
@@ -1204,7 +1300,7 @@ func doForCollectionPage(h HttpClient, agent string, cb func(c vocab.CollectionP
 // doForOrderedCollectionPage applies a function over a collection and its
 // subsequent pages recursively. It returns the first non-nil error it
 // encounters.
-func doForOrderedCollectionPage(h HttpClient, agent string, cb func(c vocab.OrderedCollectionPageType) error, c vocab.OrderedCollectionPageType, creds *creds, clock Clock) error {
+func doForOrderedCollectionPage(h HttpClient, agent string, cb func(c vocab.OrderedCollectionPageType) error, c vocab.OrderedCollectionPageType, creds *creds, clock Clock, limits streams.Limits) error {
 	err := cb(c)
 	if err != nil {
 		return err
@@ -1212,12 +1308,12 @@ func doForOrderedCollectionPage(h HttpClient, agent string, cb func(c vocab.Orde
 	if c.IsNextOrderedCollectionPage() {
 		// Handle this one weird trick that other peers HATE federating
 		// with.
-		return doForOrderedCollectionPage(h, agent, cb, c.GetNextOrderedCollectionPage(), creds, clock)
+		return doForOrderedCollectionPage(h, agent, cb, c.GetNextOrderedCollectionPage(), creds, clock, limits)
 	} else if c.IsNextLink() {
 		l := c.GetNextLink()
 		if l.HasHref() {
 			u := l.GetHref()
-			resp, err := dereference(h, u, agent, creds, clock)
+			resp, err := dereference(h, u, agent, creds, clock, limits)
 			if err != nil {
 				return err
 			}
@@ -1231,12 +1327,12 @@ func doForOrderedCollectionPage(h HttpClient, agent string, cb func(c vocab.Orde
 				return err
 			}
 			if next != nil {
-				return doForOrderedCollectionPage(h, agent, cb, next.Raw(), creds, clock)
+				return doForOrderedCollectionPage(h, agent, cb, next.Raw(), creds, clock, limits)
 			}
 		}
 	} else if c.IsNextIRI() {
 		u := c.GetNextIRI()
-		resp, err := dereference(h, u, agent, creds, clock)
+		resp, err := dereference(h, u, agent, creds, clock, limits)
 		if err != nil {
 			return err
 		}
@@ -1250,7 +1346,7 @@ func doForOrderedCollectionPage(h HttpClient, agent string, cb func(c vocab.Orde
 			return err
 		}
 		if next != nil {
-			return doForOrderedCollectionPage(h, agent, cb, next.Raw(), creds, clock)
+			return doForOrderedCollectionPage(h, agent, cb, next.Raw(), creds, clock, limits)
 		}
 	}
 	return nil
@@ -1459,6 +1555,64 @@ func toTombstone(obj vocab.ObjectType, id *url.URL, now time.Time) vocab.Tombsto
 
 type getActorCollectionFn func(actor vocab.ObjectType, lc *vocab.CollectionType, loc *vocab.OrderedCollectionType) (isIRI bool, e error)
 
+// likedGetter obtains the 'liked' collection of an actor, creating it if the
+// actor has none.
+func (f *federator) likedGetter(ctx context.Context) getActorCollectionFn {
+	return func(actor vocab.ObjectType, lc *vocab.CollectionType, loc *vocab.OrderedCollectionType) (bool, error) {
+		if actor.IsLikedAnyURI() {
+			pObj, err := f.App.Get(ctx, actor.GetLikedAnyURI(), ReadWrite)
+			if err != nil {
+				return true, err
+			}
+			ok := false
+			if *lc, ok = pObj.(vocab.CollectionType); !ok {
+				if *loc, ok = pObj.(vocab.OrderedCollectionType); !ok {
+					return true, fmt.Errorf("actors liked collection not CollectionType nor OrderedCollectionType")
+				}
+			}
+			return true, nil
+		} else if actor.IsLikedCollection() {
+			*lc = actor.GetLikedCollection()
+			return false, nil
+		} else if actor.IsLikedOrderedCollection() {
+			*loc = actor.GetLikedOrderedCollection()
+			return false, nil
+		}
+		*loc = &vocab.OrderedCollection{}
+		actor.SetLikedOrderedCollection(*loc)
+		return false, nil
+	}
+}
+
+// followingGetter obtains the 'following' collection of an actor, creating it
+// if the actor has none.
+func (f *federator) followingGetter(ctx context.Context) getActorCollectionFn {
+	return func(actor vocab.ObjectType, lc *vocab.CollectionType, loc *vocab.OrderedCollectionType) (bool, error) {
+		if actor.IsFollowingAnyURI() {
+			pObj, err := f.App.Get(ctx, actor.GetFollowingAnyURI(), ReadWrite)
+			if err != nil {
+				return true, err
+			}
+			ok := false
+			if *lc, ok = pObj.(vocab.CollectionType); !ok {
+				if *loc, ok = pObj.(vocab.OrderedCollectionType); !ok {
+					return true, fmt.Errorf("actors following collection not CollectionType nor OrderedCollectionType")
+				}
+			}
+			return true, nil
+		} else if actor.IsFollowingCollection() {
+			*lc = actor.GetFollowingCollection()
+			return false, nil
+		} else if actor.IsFollowingOrderedCollection() {
+			*loc = actor.GetFollowingOrderedCollection()
+			return false, nil
+		}
+		*loc = &vocab.OrderedCollection{}
+		actor.SetFollowingOrderedCollection(*loc)
+		return false, nil
+	}
+}
+
 func (f *federator) addAllObjectsToActorCollection(ctx context.Context, getter getActorCollectionFn, c vocab.ActivityType, prepend bool) error {
 	for i := 0; i < c.ActorLen(); i++ {
 		var iri *url.URL
@@ -1553,6 +1707,103 @@ func (f *federator) addAllObjectsToActorCollection(ctx context.Context, getter g
 	return nil
 }
 
+// removeAllObjectsFromActorCollection removes the objects of the activity from
+// the collection of each of its actors that this server owns, undoing
+// addAllObjectsToActorCollection.
+func (f *federator) removeAllObjectsFromActorCollection(ctx context.Context, getter getActorCollectionFn, c vocab.ActivityType) error {
+	for i := 0; i < c.ActorLen(); i++ {
+		var iri *url.URL
+		if c.IsActorObject(i) {
+			obj := c.GetActorObject(i)
+			if !obj.HasId() {
+				return fmt.Errorf("actor does not have id")
+			}
+			iri = obj.GetId()
+		} else if c.IsActorLink(i) {
+			l := c.GetActorLink(i)
+			if !l.HasHref() {
+				return fmt.Errorf("actor Link href required")
+			}
+			iri = l.GetHref()
+		} else if c.IsActorIRI(i) {
+			iri = c.GetActorIRI(i)
+		}
+		if !f.App.Owns(ctx, iri) {
+			continue
+		}
+		pObj, err := f.App.Get(ctx, iri, ReadWrite)
+		if err != nil {
+			return err
+		}
+		actor, ok := pObj.(vocab.ObjectType)
+		if !ok {
+			return fmt.Errorf("actor is not vocab.ObjectType")
+		}
+		var lc vocab.CollectionType
+		var loc vocab.OrderedCollectionType
+		isIRI := false
+		if isIRI, err = getter(actor, &lc, &loc); err != nil {
+			return err
+		}
+		for i := 0; i < c.ObjectLen(); i++ {
+			var iri *url.URL
+			if c.IsObjectIRI(i) {
+				iri = c.GetObjectIRI(i)
+			} else if c.IsObject(i) {
+				obj := c.GetObject(i)
+				if !obj.HasId() {
+					return fmt.Errorf("object at index %d has no id", i)
+				}
+				iri = obj.GetId()
+			}
+			if lc != nil {
+				removeCollectionItemWithId(lc, iri)
+			} else if loc != nil {
+				removeOrderedCollectionItemWithId(loc, iri)
+			}
+		}
+		if isIRI {
+			if lc != nil {
+				err = f.App.Set(ctx, lc)
+			} else if loc != nil {
+				err = f.App.Set(ctx, loc)
+			}
+			if err != nil {
+				return err
+			}
+		} else if err := f.App.Set(ctx, actor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// undoObjects reverses the side effects of the 'Like' and 'Follow' activities
+// embedded in an 'Undo', removing their objects from the 'liked' and
+// 'following' collections of their actors. The activities given by IRI are
+// left to the application, as their actors are not known to match those of the
+// 'Undo'.
+func (f *federator) undoObjects(ctx context.Context, u vocab.UndoType) error {
+	for i := 0; i < u.ObjectLen(); i++ {
+		if !u.IsObject(i) {
+			continue
+		}
+		// The activity interfaces share their methods, so only the
+		// concrete types tell a 'Like' from a 'Follow'.
+		var err error
+		switch obj := u.GetObject(i).(type) {
+		case *vocab.Like:
+			err = f.removeAllObjectsFromActorCollection(ctx, f.likedGetter(ctx), obj)
+		case *vocab.Follow:
+			err = f.removeAllObjectsFromActorCollection(ctx, f.followingGetter(ctx), obj)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type getObjectCollectionFn func(object vocab.ObjectType, lc *vocab.CollectionType, loc *vocab.OrderedCollectionType) (isIRI bool, e error)
 
 func (f *federator) addAllActorsToObjectCollection(ctx context.Context, getter getObjectCollectionFn, c vocab.ActivityType, prepend bool) (bool, error) {
@@ -1907,7 +2158,7 @@ func (f *federator) inboxForwarding(c context.Context, m map[string]interface{})
 			}
 		}
 	}
-	return f.deliverToRecipients(a, recipients, nil)
+	return f.deliverToRecipients(c, a, recipients, nil, m[jsonLDContext])
 }
 
 // Given an 'inReplyTo', 'object', 'target', or 'tag' object, recursively
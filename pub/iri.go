@@ -0,0 +1,39 @@
+package pub
+
+import (
+	"github.com/go-fed/activity/vocab"
+	"net/url"
+)
+
+// IRIPolicer is an optional interface an Application may implement in order to
+// reject the activities posted to inboxes and outboxes with IRIs of schemes or
+// structures that it does not expect, such as "javascript:" IRIs, and the HTTP
+// Signatures whose keyId is not such an IRI. Without it, IRIs are not checked.
+type IRIPolicer interface {
+	// IRIPolicy returns the policy that the IRIs must satisfy, such as
+	// vocab.DefaultIRIPolicy.
+	IRIPolicy() vocab.IRIPolicy
+}
+
+// validateIRIs returns an error if the IRIPolicy of the Application does not
+// allow an IRI of the activity.
+func validateIRIs(a Application, m map[string]interface{}) error {
+	if p, ok := a.(IRIPolicer); ok {
+		return p.IRIPolicy().Validate(m)
+	}
+	return nil
+}
+
+// validateKeyId returns an error if the IRIPolicy of the Application does not
+// allow the keyId of an HTTP Signature as a "publicKeyId".
+func validateKeyId(a Application, keyId string) error {
+	p, ok := a.(IRIPolicer)
+	if !ok {
+		return nil
+	}
+	iri, err := url.Parse(keyId)
+	if err != nil {
+		return err
+	}
+	return p.IRIPolicy().ValidateIRI("publicKeyId", iri)
+}
@@ -0,0 +1,52 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-fed/activity/vocab"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var _ IRIPolicer = &MockIRIPolicerApp{}
+
+type MockIRIPolicerApp struct {
+	*MockSocialFederateApp
+	policy vocab.IRIPolicy
+}
+
+func (m *MockIRIPolicerApp) IRIPolicy() vocab.IRIPolicy {
+	return m.policy
+}
+
+func TestPostInbox_RejectsDisallowedIRIs(t *testing.T) {
+	app, _, _, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	iApp := &MockIRIPolicerApp{MockSocialFederateApp: app, policy: vocab.IRIPolicy{Schemes: []string{"ftp"}}}
+	p := NewPubber(&MockClock{now}, iApp, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+	resp := httptest.NewRecorder()
+	req := ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(MustSerialize(testCreateNote))))
+	handled, err := p.PostInbox(context.Background(), resp, req)
+	if !handled {
+		t.Fatalf("expected handled, got !handled")
+	} else if err != nil {
+		t.Fatal(err)
+	} else if resp.Code != http.StatusBadRequest {
+		t.Fatalf("expected %d, got %d", http.StatusBadRequest, resp.Code)
+	}
+}
+
+func TestValidateKeyId(t *testing.T) {
+	app, _, _, _, _, _, _, _ := NewPubberTest(t)
+	iApp := &MockIRIPolicerApp{MockSocialFederateApp: app, policy: vocab.DefaultIRIPolicy}
+	if err := validateKeyId(app, "javascript:alert(1)"); err != nil {
+		t.Fatalf("expected no policy to allow every keyId, got %s", err)
+	} else if err = validateKeyId(iApp, "https://example.com/users/alice#main-key"); err != nil {
+		t.Fatal(err)
+	}
+	for _, keyId := range []string{"http://example.com/users/alice#main-key", "javascript:alert(1)"} {
+		if err := validateKeyId(iApp, keyId); err == nil {
+			t.Errorf("expected keyId %q to be rejected", keyId)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package pub
+
+import (
+	"github.com/go-fed/activity/streams"
+)
+
+// Limiter is an optional interface an Application may implement in order to
+// bound the JSON received in inboxes and outboxes and fetched from other
+// servers, so that a hostile server cannot exhaust its memory. Without it, the
+// JSON is not bounded. Returning streams.DefaultLimits bounds it to far more than
+// federated servers send.
+type Limiter interface {
+	// Limits returns the limits of the JSON received or fetched.
+	Limits() streams.Limits
+}
+
+// limits returns the limits of the Application, or no limits.
+func (f *federator) limits() streams.Limits {
+	if l, ok := f.App.(Limiter); ok {
+		return l.Limits()
+	}
+	return streams.Limits{}
+}
@@ -0,0 +1,57 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"net/http/httptest"
+	"testing"
+)
+
+var _ Limiter = &MockLimiterApp{}
+
+type MockLimiterApp struct {
+	*MockSocialFederateApp
+	limits streams.Limits
+}
+
+func (m *MockLimiterApp) Limits() streams.Limits {
+	return m.limits
+}
+
+func TestPostInbox_RejectsBeyondLimits(t *testing.T) {
+	app, _, _, socialCb, fedCb, d, httpClient, _ := NewPubberTest(t)
+	b := MustSerialize(testCreateNote)
+	tables := []struct {
+		name   string
+		limits streams.Limits
+	}{
+		{"bytes", streams.Limits{MaxBytes: int64(len(b)) - 1}},
+		{"depth", streams.Limits{MaxDepth: 1}},
+		{"items", streams.Limits{MaxItems: 1}},
+	}
+	for _, r := range tables {
+		lApp := &MockLimiterApp{MockSocialFederateApp: app, limits: r.limits}
+		p := NewPubber(&MockClock{now}, lApp, socialCb, fedCb, d, httpClient, testAgent, 1, 1)
+		resp := httptest.NewRecorder()
+		req := ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(b)))
+		handled, err := p.PostInbox(context.Background(), resp, req)
+		if !handled {
+			t.Fatalf("%s: expected handled, got !handled", r.name)
+		} else if !errors.Is(err, streams.ErrLimitExceeded) {
+			t.Fatalf("%s: expected %v, got %v", r.name, streams.ErrLimitExceeded, err)
+		}
+	}
+}
+
+func TestLimits_NoneWithoutLimiter(t *testing.T) {
+	app, _, _, _, _, _, _, _ := NewPubberTest(t)
+	if l := (&federator{App: app}).limits(); l != (streams.Limits{}) {
+		t.Fatalf("expected no limits, got %v", l)
+	}
+	lApp := &MockLimiterApp{MockSocialFederateApp: app, limits: streams.DefaultLimits}
+	if l := (&federator{App: lApp}).limits(); l != streams.DefaultLimits {
+		t.Fatalf("expected %v, got %v", streams.DefaultLimits, l)
+	}
+}
@@ -0,0 +1,109 @@
+package pub
+
+import (
+	"github.com/go-fed/activity/vocab"
+	"net/url"
+)
+
+// Recipients are the effective recipients of an activity, by the property
+// addressing them. Each IRI appears once, in the most visible of the
+// properties addressing it: "to", then "cc", "audience", "bto", and "bcc".
+type Recipients struct {
+	To       []*url.URL
+	Cc       []*url.URL
+	Audience []*url.URL
+	Bto      []*url.URL
+	Bcc      []*url.URL
+}
+
+// All returns every recipient, in the order of the properties addressing them.
+func (r *Recipients) All() []*url.URL {
+	var all []*url.URL
+	all = append(all, r.To...)
+	all = append(all, r.Cc...)
+	all = append(all, r.Audience...)
+	all = append(all, r.Bto...)
+	all = append(all, r.Bcc...)
+	return all
+}
+
+// IsPublic returns true if the recipients include the special Public
+// collection.
+func (r *Recipients) IsPublic() bool {
+	for _, u := range r.All() {
+		if isPublic(u.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeRecipients merges the "to", "bto", "cc", "bcc", and "audience" of the
+// activity and of the objects it embeds into the recipients it is effectively
+// addressed to, deduplicating their IRIs. The recipients of an object that the
+// activity does not address are included too, since clients commonly address
+// only the object of a Create. Objects referred to by their IRI are not
+// fetched, so only the recipients of the activity count for them.
+func ComputeRecipients(a vocab.ActivityType) *Recipients {
+	objects := make([]addressedObject, 0, 1+a.ObjectLen())
+	objects = append(objects, a)
+	for i := 0; i < a.ObjectLen(); i++ {
+		if a.IsObject(i) {
+			objects = append(objects, a.GetObject(i))
+		}
+	}
+	r := &Recipients{}
+	seen := make(map[string]bool)
+	add := func(dst *[]*url.URL, get func(o addressedObject) []*url.URL) {
+		for _, o := range objects {
+			for _, u := range get(o) {
+				if s := u.String(); !seen[s] {
+					*dst = append(*dst, u)
+					seen[s] = true
+				}
+			}
+		}
+	}
+	add(&r.To, getToIRIs)
+	add(&r.Cc, getCcIRIs)
+	add(&r.Audience, getAudienceIRIs)
+	add(&r.Bto, getBToIRIs)
+	add(&r.Bcc, getBccIRIs)
+	return r
+}
+
+// NormalizeRecipients addresses the activity to the recipients of the objects
+// it embeds that it does not address itself, in the same properties, so that
+// delivering the activity reaches everyone its objects are addressed to. It
+// returns the recipients the activity is then addressed to, as computed by
+// ComputeRecipients.
+func NormalizeRecipients(a vocab.ActivityType) *Recipients {
+	r := ComputeRecipients(a)
+	addressed := make(map[string]bool)
+	for _, get := range []func(o addressedObject) []*url.URL{getToIRIs, getCcIRIs, getAudienceIRIs, getBToIRIs, getBccIRIs} {
+		for _, u := range get(a) {
+			addressed[u.String()] = true
+		}
+	}
+	appendMissing := func(recipients []*url.URL, appendFn func(v *url.URL)) {
+		for _, u := range recipients {
+			if !addressed[u.String()] {
+				appendFn(u)
+			}
+		}
+	}
+	appendMissing(r.To, a.AppendToIRI)
+	appendMissing(r.Cc, a.AppendCcIRI)
+	appendMissing(r.Audience, a.AppendAudienceIRI)
+	appendMissing(r.Bto, a.AppendBtoIRI)
+	appendMissing(r.Bcc, a.AppendBccIRI)
+	return r
+}
+
+// PrepareForDelivery serializes the activity to deliver it to other servers,
+// leaving out the "bto" and "bcc" of it and of every object it embeds, which
+// must not be disclosed to the recipients. The activity itself is unchanged,
+// and the '@context' is left for the caller to set.
+func PrepareForDelivery(a vocab.Serializer) (map[string]interface{}, error) {
+	return vocab.SerializeWith(a, vocab.OmitContext(), vocab.OmitHiddenRecipients())
+}
@@ -0,0 +1,102 @@
+package pub
+
+import (
+	"github.com/go-fed/activity/vocab"
+	"net/url"
+	"testing"
+)
+
+func mustParse(t *testing.T, s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func iriStrings(u []*url.URL) []string {
+	s := make([]string, len(u))
+	for i, v := range u {
+		s[i] = v.String()
+	}
+	return s
+}
+
+func TestComputeRecipients(t *testing.T) {
+	alice := mustParse(t, "https://example.com/users/alice")
+	bob := mustParse(t, "https://example.com/users/bob")
+	followers := mustParse(t, "https://example.com/users/carol/followers")
+	note := &vocab.Note{}
+	note.AppendToIRI(alice)
+	note.AppendCcIRI(mustParse(t, vocab.PublicIRI))
+	note.AppendBccIRI(bob)
+	create := &vocab.Create{}
+	create.AppendObject(note)
+	create.AppendToIRI(alice)
+	create.AppendCcIRI(followers)
+	create.AppendBtoIRI(alice)
+
+	r := ComputeRecipients(create)
+	if got := iriStrings(r.To); len(got) != 1 || got[0] != alice.String() {
+		t.Errorf("Expected alice once in to, got %v", got)
+	}
+	if got := iriStrings(r.Cc); len(got) != 2 || got[0] != followers.String() || got[1] != vocab.PublicIRI {
+		t.Errorf("Expected the followers and the Public collection in cc, got %v", got)
+	}
+	if len(r.Bto) != 0 || len(r.Audience) != 0 {
+		t.Errorf("Expected no bto nor audience, got %v and %v", r.Bto, r.Audience)
+	}
+	if got := iriStrings(r.Bcc); len(got) != 1 || got[0] != bob.String() {
+		t.Errorf("Expected bob in bcc, got %v", got)
+	}
+	if len(r.All()) != 4 {
+		t.Errorf("Expected 4 recipients, got %v", r.All())
+	}
+	if !r.IsPublic() {
+		t.Errorf("Expected the recipients to be public")
+	}
+	if create.CcLen() != 1 || create.BccLen() != 0 {
+		t.Errorf("Expected ComputeRecipients not to change the activity")
+	}
+
+	NormalizeRecipients(create)
+	if got := iriStrings(getCcIRIs(create)); len(got) != 2 || got[1] != vocab.PublicIRI {
+		t.Errorf("Expected the Public collection to be added to cc, got %v", got)
+	}
+	if got := iriStrings(getBccIRIs(create)); len(got) != 1 || got[0] != bob.String() {
+		t.Errorf("Expected bob to be added to bcc, got %v", got)
+	}
+	if create.ToLen() != 1 || create.BtoLen() != 1 {
+		t.Errorf("Expected the addressing of the activity to be kept")
+	}
+}
+
+func TestPrepareForDelivery(t *testing.T) {
+	alice := mustParse(t, "https://example.com/users/alice")
+	bob := mustParse(t, "https://example.com/users/bob")
+	note := &vocab.Note{}
+	note.AppendToIRI(alice)
+	note.AppendBccIRI(bob)
+	create := &vocab.Create{}
+	create.AppendObject(note)
+	create.AppendToIRI(alice)
+	create.AppendBtoIRI(bob)
+	m, err := PrepareForDelivery(create)
+	if err != nil {
+		t.Fatalf("Cannot PrepareForDelivery: %s", err)
+	}
+	if _, ok := m["bto"]; ok {
+		t.Errorf("Expected the bto of the activity to be removed, got %v", m)
+	}
+	o, ok := m["object"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected the embedded object, got %v", m["object"])
+	} else if _, ok = o["bcc"]; ok {
+		t.Errorf("Expected the bcc of the object to be removed, got %v", o)
+	} else if o["to"] != alice.String() {
+		t.Errorf("Expected the to of the object to be kept, got %v", o["to"])
+	}
+	if create.BtoLen() != 1 || note.BccLen() != 1 {
+		t.Errorf("Expected the activity to be unchanged")
+	}
+}
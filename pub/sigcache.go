@@ -0,0 +1,262 @@
+package pub
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	signatureHeader     = "Signature"
+	authorizationHeader = "Authorization"
+	requestTargetHeader = "(request-target)"
+	hostHeader          = "host"
+	// DefaultSignatureCacheSize is the number of verified signatures a
+	// SignatureCache remembers when it is not given a size.
+	DefaultSignatureCacheSize = 4096
+)
+
+// signatureParam extracts the 'signature' parameter of an HTTP Signature.
+var signatureParam = regexp.MustCompile(`(?:^|[\s,])signature="([^"]*)"`)
+
+// headersParam extracts the 'headers' parameter of an HTTP Signature.
+var headersParam = regexp.MustCompile(`(?:^|[\s,])headers="([^"]*)"`)
+
+// signedHeaders returns the names of the headers the HTTP Signature of the
+// request covers. A signature without a 'headers' parameter only covers the
+// Date.
+func signedHeaders(r *http.Request) []string {
+	h := r.Header.Get(signatureHeader)
+	if len(h) == 0 {
+		h = r.Header.Get(authorizationHeader)
+	}
+	if match := headersParam.FindStringSubmatch(h); match != nil {
+		return strings.Fields(match[1])
+	}
+	return []string{dateHeader}
+}
+
+// isSignedHeader returns true if the HTTP Signature of the request covers the
+// header.
+func isSignedHeader(r *http.Request, header string) bool {
+	for _, s := range signedHeaders(r) {
+		if strings.EqualFold(s, header) {
+			return true
+		}
+	}
+	return false
+}
+
+// signingString returns the string the HTTP Signature of the request was
+// computed over: the values of the headers it covers, in order.
+func signingString(r *http.Request) string {
+	var b strings.Builder
+	for i, name := range signedHeaders(r) {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		name = strings.ToLower(name)
+		b.WriteString(name + ": ")
+		switch name {
+		case requestTargetHeader:
+			b.WriteString(strings.ToLower(r.Method) + " " + r.URL.RequestURI())
+		case hostHeader:
+			if v := r.Header.Get(hostHeader); len(v) > 0 {
+				b.WriteString(v)
+			} else {
+				b.WriteString(r.Host)
+			}
+		default:
+			b.WriteString(strings.Join(r.Header[http.CanonicalHeaderKey(name)], ", "))
+		}
+	}
+	return b.String()
+}
+
+// signatureCacheKey identifies a verified HTTP Signature. The request target
+// is part of the key so that a remembered signature is never accepted for a
+// request to a different resource, the signing string so that it is never
+// accepted for a request whose signed headers, such as its Date, differ, and
+// the public key so that it is no longer accepted once the key is rotated.
+type signatureCacheKey struct {
+	keyId     string
+	publicKey [sha256.Size]byte
+	signature string
+	digest    string
+	target    string
+	signed    string
+}
+
+// newSignatureCacheKey creates the key for the request signed with the public
+// key. It returns false if the request does not carry a signature, or if the
+// public key cannot be marshalled.
+func newSignatureCacheKey(keyId string, pKey crypto.PublicKey, r *http.Request) (signatureCacheKey, bool) {
+	h := r.Header.Get(signatureHeader)
+	if len(h) == 0 {
+		h = r.Header.Get(authorizationHeader)
+	}
+	match := signatureParam.FindStringSubmatch(h)
+	if match == nil || len(match[1]) == 0 {
+		return signatureCacheKey{}, false
+	}
+	der, err := x509.MarshalPKIXPublicKey(pKey)
+	if err != nil {
+		return signatureCacheKey{}, false
+	}
+	return signatureCacheKey{
+		keyId:     keyId,
+		publicKey: sha256.Sum256(der),
+		signature: match[1],
+		digest:    r.Header.Get(digestHeader),
+		target:    r.Method + " " + r.URL.RequestURI(),
+		signed:    signingString(r),
+	}, true
+}
+
+// SignatureCache remembers successfully verified HTTP Signatures for a short
+// window. A request to the same target carrying the same signature over the
+// same signed headers, verified with the same key id and public key as one
+// already verified within the window, such as a duplicate delivery of the same
+// activity, then skips the public key operation. The public key is still
+// obtained for every request, so that the signatures remembered for a key stop
+// matching once it is rotated, and stop being accepted once it is revoked and
+// can no longer be obtained. Only signatures covering the Date, and the Digest
+// of a body, are remembered, and a remembered signature is rejected once its
+// Date is no longer within the window.
+//
+// Only successful verifications are remembered. It is safe for concurrent use.
+type SignatureCache struct {
+	clock   Clock
+	window  time.Duration
+	size    int
+	mu      sync.Mutex
+	entries map[signatureCacheKey]time.Time
+}
+
+// NewSignatureCache creates a SignatureCache remembering verified signatures
+// for the window. At most size signatures are remembered at once; if size is
+// not positive then DefaultSignatureCacheSize is used.
+func NewSignatureCache(clock Clock, window time.Duration, size int) *SignatureCache {
+	if size <= 0 {
+		size = DefaultSignatureCacheSize
+	}
+	return &SignatureCache{
+		clock:   clock,
+		window:  window,
+		size:    size,
+		entries: make(map[signatureCacheKey]time.Time, size),
+	}
+}
+
+// has returns true if the signature was verified within the window.
+func (s *SignatureCache) has(k signatureCacheKey) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	verified, ok := s.entries[k]
+	if !ok {
+		return false
+	} else if s.clock.Now().Sub(verified) >= s.window {
+		delete(s.entries, k)
+		return false
+	}
+	return true
+}
+
+// isFresh returns true if the Date of the request is within the window of now,
+// so that a remembered signature is not accepted long after it was made.
+func (s *SignatureCache) isFresh(r *http.Request) bool {
+	date, err := http.ParseTime(r.Header.Get(dateHeader))
+	if err != nil {
+		return false
+	}
+	skew := s.clock.Now().Sub(date)
+	if skew < 0 {
+		skew = -skew
+	}
+	return skew < s.window
+}
+
+// add remembers the signature as verified now. When full, expired signatures
+// are forgotten first, then the oldest one.
+func (s *SignatureCache) add(k signatureCacheKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	if _, ok := s.entries[k]; !ok && len(s.entries) >= s.size {
+		var oldest signatureCacheKey
+		var oldestTime time.Time
+		first := true
+		for key, verified := range s.entries {
+			if now.Sub(verified) >= s.window {
+				delete(s.entries, key)
+			} else if first || verified.Before(oldestTime) {
+				oldest, oldestTime, first = key, verified, false
+			}
+		}
+		if len(s.entries) >= s.size {
+			delete(s.entries, oldest)
+		}
+	}
+	s.entries[k] = now
+}
+
+// SignatureCacher is an optional interface an Application may implement in
+// order to skip verifying the same HTTP Signature repeatedly.
+type SignatureCacher interface {
+	// SignatureCache returns the cache of verified signatures to use. It
+	// may return nil to verify every signature.
+	SignatureCache() *SignatureCache
+}
+
+// verifySignature verifies the HTTP Signature of the request, consulting the
+// Application's SignatureCache if it has one, and records the outcome with its
+// HealthMonitor. The body is the body of the request, or nil if it has none.
+func verifySignature(a Application, v httpsig.Verifier, r *http.Request, body []byte, pKey crypto.PublicKey, algo httpsig.Algorithm) error {
+	err := verifySignatureWithCache(a, v, r, body, pKey, algo)
+	if hm := healthMonitor(a); hm != nil {
+		hm.RecordSignature(v.KeyId(), err)
+	}
+	return err
+}
+
+// verifySignatureWithCache verifies the HTTP Signature of the request,
+// consulting the Application's SignatureCache if it has one.
+//
+// The cache is only consulted for signatures covering the Date, and for
+// requests with a body only if the signature also covers a Digest matching
+// it, as the key does not otherwise identify the content signed.
+func verifySignatureWithCache(a Application, v httpsig.Verifier, r *http.Request, body []byte, pKey crypto.PublicKey, algo httpsig.Algorithm) error {
+	sc, ok := a.(SignatureCacher)
+	if !ok {
+		return v.Verify(pKey, algo)
+	}
+	cache := sc.SignatureCache()
+	if cache == nil {
+		return v.Verify(pKey, algo)
+	}
+	k, ok := newSignatureCacheKey(v.KeyId(), pKey, r)
+	if !ok || !isSignedHeader(r, dateHeader) {
+		return v.Verify(pKey, algo)
+	} else if body != nil && (!isSignedHeader(r, digestHeader) || verifyDigest(r, body) != nil) {
+		return v.Verify(pKey, algo)
+	} else if cache.has(k) {
+		if !cache.isFresh(r) {
+			return fmt.Errorf("signature date %q outside of the cache window", r.Header.Get(dateHeader))
+		}
+		return nil
+	}
+	if err := v.Verify(pKey, algo); err != nil {
+		return err
+	}
+	if cache.isFresh(r) {
+		cache.add(k)
+	}
+	return nil
+}
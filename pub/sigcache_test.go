@@ -0,0 +1,209 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+var _ SignatureCacher = &MockSignatureCacherApp{}
+
+type MockSignatureCacherApp struct {
+	*MockApplication
+	cache *SignatureCache
+}
+
+func (m *MockSignatureCacherApp) SignatureCache() *SignatureCache {
+	return m.cache
+}
+
+// countingVerifier counts the signatures verified with a public key.
+type countingVerifier struct {
+	httpsig.Verifier
+	n int
+}
+
+func (c *countingVerifier) Verify(pKey crypto.PublicKey, algo httpsig.Algorithm) error {
+	c.n++
+	return c.Verifier.Verify(pKey, algo)
+}
+
+func TestServeActivityPubObject_SignatureCache(t *testing.T) {
+	clock := &MockClock{now}
+	publicKey := testPrivateKey.Public()
+	app := &MockSignatureCacherApp{
+		MockApplication: &MockApplication{
+			t: t,
+			getPublicKey: func(c context.Context, publicKeyId string) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+				return publicKey, httpsig.RSA_SHA256, samIRI, nil
+			},
+			getAsVerifiedUser: func(c context.Context, id, user *url.URL, rw RWType) (PubObject, error) {
+				return testNote, nil
+			},
+			owns: func(c context.Context, id *url.URL) bool {
+				return true
+			},
+		},
+		cache: NewSignatureCache(clock, time.Minute, 0),
+	}
+	handler := ServeActivityPubObject(app, clock)
+	req := Sign(ActivityPubRequest(httptest.NewRequest("GET", noteURIString, nil)))
+	serve := func() int {
+		resp := httptest.NewRecorder()
+		handled, err := handler(context.Background(), resp, req)
+		if err != nil {
+			t.Fatal(err)
+		} else if !handled {
+			t.Fatalf("expected handled, got !handled")
+		}
+		return resp.Code
+	}
+	if code := serve(); code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, code)
+	}
+	if code := serve(); code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, code)
+	}
+	// A remembered signature does not match once the public key is
+	// rotated.
+	publicKey = testOtherPrivateKey.Public()
+	if code := serve(); code != http.StatusForbidden {
+		t.Fatalf("expected %d, got %d", http.StatusForbidden, code)
+	}
+}
+
+func TestSignatureCache(t *testing.T) {
+	clock := &MockClock{now}
+	s := NewSignatureCache(clock, time.Minute, 2)
+	req := Sign(ActivityPubRequest(httptest.NewRequest("GET", noteURIString, nil)))
+	k, ok := newSignatureCacheKey(testPublicKeyId, testPrivateKey.Public(), req)
+	if !ok {
+		t.Fatalf("expected key for signed request")
+	}
+	other := httptest.NewRequest("GET", samIRIString, nil)
+	other.Header = req.Header
+	if otherKey, ok := newSignatureCacheKey(testPublicKeyId, testPrivateKey.Public(), other); !ok {
+		t.Fatalf("expected key for signed request")
+	} else if otherKey == k {
+		t.Fatalf("expected keys for different targets to differ")
+	}
+	if rotatedKey, ok := newSignatureCacheKey(testPublicKeyId, testOtherPrivateKey.Public(), req); !ok {
+		t.Fatalf("expected key for signed request")
+	} else if rotatedKey == k {
+		t.Fatalf("expected keys for different public keys to differ")
+	}
+	if _, ok := newSignatureCacheKey(testPublicKeyId, testPrivateKey.Public(), httptest.NewRequest("GET", noteURIString, nil)); ok {
+		t.Fatalf("expected no key for unsigned request")
+	}
+	s.add(k)
+	if !s.has(k) {
+		t.Fatalf("expected cached signature")
+	}
+	clock.now = now.Add(time.Second)
+	k2, k3 := k, k
+	k2.signature = "second"
+	k3.signature = "third"
+	s.add(k2)
+	s.add(k3)
+	if s.has(k) {
+		t.Fatalf("expected oldest signature to be evicted")
+	} else if !s.has(k2) || !s.has(k3) {
+		t.Fatalf("expected newest signatures to be cached")
+	}
+	clock.now = now.Add(time.Second + time.Minute)
+	if s.has(k2) {
+		t.Fatalf("expected expired signature")
+	}
+}
+
+func TestVerifySignatureWithCache_AlteredBody(t *testing.T) {
+	clock := &MockClock{now}
+	app := &MockSignatureCacherApp{
+		MockApplication: &MockApplication{t: t},
+		cache:           NewSignatureCache(clock, time.Minute, 0),
+	}
+	body := MustSerialize(testCreateNote)
+	req := SignWithDigest(ActivityPubRequest(httptest.NewRequest("POST", testInboxURI, bytes.NewBuffer(body))))
+	v, err := httpsig.NewVerifier(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cv := &countingVerifier{Verifier: v}
+	verify := func(b []byte) error {
+		return verifySignatureWithCache(app, cv, req, b, testPrivateKey.Public(), httpsig.RSA_SHA256)
+	}
+	if err := verify(body); err != nil {
+		t.Fatal(err)
+	}
+	// A remembered signature is not verified against the public key again.
+	if err := verify(body); err != nil {
+		t.Fatal(err)
+	} else if cv.n != 1 {
+		t.Fatalf("expected %d, got %d", 1, cv.n)
+	}
+	// But a replay with another body does not match the remembered digest.
+	verify(MustSerialize(testUpdateNote))
+	if cv.n != 2 {
+		t.Fatalf("expected %d, got %d", 2, cv.n)
+	}
+}
+
+func TestVerifySignatureWithCache_DateSkew(t *testing.T) {
+	clock := &MockClock{now.Add(50 * time.Second)}
+	app := &MockSignatureCacherApp{
+		MockApplication: &MockApplication{t: t},
+		cache:           NewSignatureCache(clock, time.Minute, 0),
+	}
+	req := Sign(ActivityPubRequest(httptest.NewRequest("GET", noteURIString, nil)))
+	verify := func() error {
+		v, err := httpsig.NewVerifier(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return verifySignatureWithCache(app, v, req, nil, testPrivateKey.Public(), httpsig.RSA_SHA256)
+	}
+	if err := verify(); err != nil {
+		t.Fatal(err)
+	}
+	clock.now = now.Add(55 * time.Second)
+	if err := verify(); err != nil {
+		t.Fatal(err)
+	}
+	// The signature is still remembered, but its Date is too old.
+	clock.now = now.Add(70 * time.Second)
+	if err := verify(); err == nil {
+		t.Fatalf("expected error, got none")
+	}
+}
+
+func TestVerifySignatureWithCache_RewrittenDate(t *testing.T) {
+	clock := &MockClock{now}
+	app := &MockSignatureCacherApp{
+		MockApplication: &MockApplication{t: t},
+		cache:           NewSignatureCache(clock, 2*time.Minute, 0),
+	}
+	req := Sign(ActivityPubRequest(httptest.NewRequest("GET", noteURIString, nil)))
+	verify := func() error {
+		v, err := httpsig.NewVerifier(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return verifySignatureWithCache(app, v, req, nil, testPrivateKey.Public(), httpsig.RSA_SHA256)
+	}
+	if err := verify(); err != nil {
+		t.Fatal(err)
+	}
+	// A replay of the remembered signature with a fresher Date is verified
+	// again, and the signature does not cover the rewritten Date.
+	clock.now = now.Add(time.Minute)
+	req.Header.Set(dateHeader, clock.now.UTC().Format(http.TimeFormat))
+	if err := verify(); err == nil {
+		t.Fatalf("expected error, got none")
+	}
+}
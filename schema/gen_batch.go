@@ -0,0 +1,19 @@
+//
+package schema
+
+// DeserializeManyPropertyValue deserializes each of the maps as a PropertyValue. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyPropertyValue(ms []map[string]interface{}) (t []*PropertyValue, err error) {
+	backing := make([]PropertyValue, len(ms))
+	t = make([]*PropertyValue, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
@@ -0,0 +1,341 @@
+//
+package schema
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxCBORDepth is the deepest nesting of arrays and maps decodeCBOR decodes,
+// so that malicious input cannot exhaust the stack.
+const maxCBORDepth = 1000
+
+// The major types of CBOR data items.
+const (
+	cborUnsigned = 0 << 5
+	cborNegative = 1 << 5
+	cborBytes    = 2 << 5
+	cborText     = 3 << 5
+	cborArray    = 4 << 5
+	cborMap      = 5 << 5
+	cborTag      = 6 << 5
+	cborSimple   = 7 << 5
+)
+
+// encodeCBOR encodes the serialized form of a value as CBOR. The encoding is
+// deterministic: keys of maps are sorted, shorter keys first, and numbers use
+// their shortest exact encoding, with integers encoded as such. It supports
+// the values that Serialize and decodeCBOR create.
+func encodeCBOR(v interface{}) ([]byte, error) {
+	return appendCBOR(nil, v)
+}
+
+// appendCBOR appends the CBOR encoding of the value to the bytes.
+func appendCBOR(b []byte, v interface{}) ([]byte, error) {
+	var err error
+	switch x := v.(type) {
+	case nil:
+		b = append(b, cborSimple|22)
+	case bool:
+		if x {
+			b = append(b, cborSimple|21)
+		} else {
+			b = append(b, cborSimple|20)
+		}
+	case string:
+		b = appendCBORText(b, x)
+	case float64:
+		b = appendCBORFloat(b, x)
+	case float32:
+		b = appendCBORFloat(b, float64(x))
+	case int:
+		b = appendCBORInt(b, int64(x))
+	case int32:
+		b = appendCBORInt(b, int64(x))
+	case int64:
+		b = appendCBORInt(b, x)
+	case uint64:
+		b = appendCBORHead(b, cborUnsigned, x)
+	case []interface{}:
+		b = appendCBORHead(b, cborArray, uint64(len(x)))
+		for _, e := range x {
+			if b, err = appendCBOR(b, e); err != nil {
+				return nil, err
+			}
+		}
+	case []string:
+		b = appendCBORHead(b, cborArray, uint64(len(x)))
+		for _, e := range x {
+			b = appendCBORText(b, e)
+		}
+	case map[string]string:
+		b = appendCBORHead(b, cborMap, uint64(len(x)))
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sortCBORKeys(keys)
+		for _, k := range keys {
+			b = appendCBORText(b, k)
+			b = appendCBORText(b, x[k])
+		}
+	case map[string]interface{}:
+		b = appendCBORHead(b, cborMap, uint64(len(x)))
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sortCBORKeys(keys)
+		for _, k := range keys {
+			b = appendCBORText(b, k)
+			if b, err = appendCBOR(b, x[k]); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("cannot encode value of type %T as CBOR", v)
+	}
+	return b, nil
+}
+
+// sortCBORKeys sorts the keys of a map in the order of their encodings:
+// shorter keys first, then bytewise.
+func sortCBORKeys(keys []string) {
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+}
+
+// appendCBORHead appends the head of a data item of the major type with the
+// argument, in its shortest form.
+func appendCBORHead(b []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return append(b, major|25, byte(n>>8), byte(n))
+	case n <= math.MaxUint32:
+		return append(b, major|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(b, major|27, byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32), byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
+
+// appendCBORInt appends the integer.
+func appendCBORInt(b []byte, i int64) []byte {
+	if i < 0 {
+		return appendCBORHead(b, cborNegative, uint64(-(i + 1)))
+	}
+	return appendCBORHead(b, cborUnsigned, uint64(i))
+}
+
+// appendCBORFloat appends the number as an integer if it is one that a float64
+// represents exactly, and otherwise as the shortest float that does.
+func appendCBORFloat(b []byte, f float64) []byte {
+	const maxExact = 1 << 53
+	if f == math.Trunc(f) && f >= -maxExact && f <= maxExact && !(f == 0 && math.Signbit(f)) {
+		return appendCBORInt(b, int64(f))
+	}
+	if f32 := float32(f); float64(f32) == f || math.IsNaN(f) {
+		n := math.Float32bits(f32)
+		return append(b, cborSimple|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	n := math.Float64bits(f)
+	return append(b, cborSimple|27, byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32), byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
+
+// appendCBORText appends the string. Invalid UTF-8 is replaced by the
+// replacement character, as CBOR text must be valid UTF-8.
+func appendCBORText(b []byte, s string) []byte {
+	if !utf8.ValidString(s) {
+		s = strings.ToValidUTF8(s, "�")
+	}
+	b = appendCBORHead(b, cborText, uint64(len(s)))
+	return append(b, s...)
+}
+
+// decodeCBOR decodes CBOR into maps, slices, strings, float64 numbers, bools,
+// and nil, like decoding the equivalent JSON into an interface{}. Tags are
+// ignored in favor of the data items they tag. Byte strings, maps with keys
+// other than text, and data items of indefinite length are not supported.
+func decodeCBOR(b []byte) (interface{}, error) {
+	d := &cborDecoder{b: b}
+	v, err := d.value(0)
+	if err != nil {
+		return nil, err
+	}
+	if d.i < len(d.b) {
+		return nil, d.errorf("unexpected data after the top-level value")
+	}
+	return v, nil
+}
+
+// cborDecoder decodes the CBOR bytes from an offset.
+type cborDecoder struct {
+	b []byte
+	i int
+}
+
+func (d *cborDecoder) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("invalid CBOR at offset %d: %s", d.i, fmt.Sprintf(format, a...))
+}
+
+// head decodes the major type and the argument of a data item.
+func (d *cborDecoder) head() (major byte, info byte, n uint64, err error) {
+	if d.i >= len(d.b) {
+		err = d.errorf("unexpected end of input")
+		return
+	}
+	major, info = d.b[d.i]&0xe0, d.b[d.i]&0x1f
+	d.i++
+	var size int
+	switch {
+	case info < 24:
+		n = uint64(info)
+		return
+	case info <= 27:
+		size = 1 << (info - 24)
+	case info == 31:
+		err = d.errorf("indefinite length data items are not supported")
+		return
+	default:
+		err = d.errorf("reserved additional information %d", info)
+		return
+	}
+	if d.i+size > len(d.b) {
+		err = d.errorf("unexpected end of input")
+		return
+	}
+	for _, c := range d.b[d.i : d.i+size] {
+		n = n<<8 | uint64(c)
+	}
+	d.i += size
+	return
+}
+
+// length converts the argument of a string, array, or map to a length, which
+// cannot exceed the number of bytes left, since each element takes at least
+// one.
+func (d *cborDecoder) length(n uint64) (int, error) {
+	if n > uint64(len(d.b)-d.i) {
+		return 0, d.errorf("length %d exceeds the input", n)
+	}
+	return int(n), nil
+}
+
+func (d *cborDecoder) value(depth int) (interface{}, error) {
+	if depth > maxCBORDepth {
+		return nil, d.errorf("nested too deeply")
+	}
+	major, info, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case cborUnsigned:
+		return float64(n), nil
+	case cborNegative:
+		return -1 - float64(n), nil
+	case cborBytes:
+		return nil, d.errorf("byte strings are not supported")
+	case cborText:
+		return d.text(n)
+	case cborArray:
+		l, err := d.length(n)
+		if err != nil {
+			return nil, err
+		}
+		a := make([]interface{}, 0, l)
+		for j := 0; j < l; j++ {
+			v, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, v)
+		}
+		return a, nil
+	case cborMap:
+		l, err := d.length(n)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, l)
+		for j := 0; j < l; j++ {
+			major, _, n, err := d.head()
+			if err != nil {
+				return nil, err
+			} else if major != cborText {
+				return nil, d.errorf("keys of maps must be text")
+			}
+			k, err := d.text(n)
+			if err != nil {
+				return nil, err
+			}
+			if m[k], err = d.value(depth + 1); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case cborTag:
+		return d.value(depth + 1)
+	}
+	switch info {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22, 23:
+		return nil, nil
+	case 25:
+		return cborHalf(uint16(n)), nil
+	case 26:
+		return float64(math.Float32frombits(uint32(n))), nil
+	case 27:
+		return math.Float64frombits(n), nil
+	}
+	return nil, d.errorf("unsupported simple value %d", n)
+}
+
+// text decodes a text string of the length.
+func (d *cborDecoder) text(n uint64) (string, error) {
+	l, err := d.length(n)
+	if err != nil {
+		return "", err
+	}
+	s := d.b[d.i : d.i+l]
+	if !utf8.Valid(s) {
+		return "", d.errorf("invalid UTF-8 in text")
+	}
+	d.i += l
+	return string(s), nil
+}
+
+// cborHalf converts a half-precision float.
+func cborHalf(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	var f float64
+	switch exp {
+	case 0:
+		f = math.Ldexp(mant, -24)
+	case 31:
+		if mant == 0 {
+			f = math.Inf(1)
+		} else {
+			f = math.NaN()
+		}
+	default:
+		f = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -f
+	}
+	return f
+}
@@ -0,0 +1,10569 @@
+//
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	core "github.com/go-fed/activity/vocab"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// PropertyValueType is an interface for accepting types that extend from 'PropertyValue'.
+type PropertyValueType interface {
+	Serializer
+	Deserializer
+	IsValue() (ok bool)
+	GetValue() (v string)
+	SetValue(v string)
+	IsValueIRI() (ok bool)
+	GetValueIRI() (v *url.URL)
+	SetValueIRI(v *url.URL)
+	HasUnknownValue() (ok bool)
+	GetUnknownValue() (v interface{})
+	SetUnknownValue(i interface{})
+	IsAltitude() (ok bool)
+	GetAltitude() (v float64)
+	SetAltitude(v float64)
+	IsAltitudeIRI() (ok bool)
+	GetAltitudeIRI() (v *url.URL)
+	SetAltitudeIRI(v *url.URL)
+	HasUnknownAltitude() (ok bool)
+	GetUnknownAltitude() (v interface{})
+	SetUnknownAltitude(i interface{})
+	AttachmentLen() (l int)
+	IsAttachmentObject(index int) (ok bool)
+	GetAttachmentObject(index int) (v ObjectType)
+	AppendAttachmentObject(v ObjectType)
+	PrependAttachmentObject(v ObjectType)
+	RemoveAttachmentObject(index int)
+	AttachmentObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsAttachmentLink(index int) (ok bool)
+	GetAttachmentLink(index int) (v LinkType)
+	AppendAttachmentLink(v LinkType)
+	PrependAttachmentLink(v LinkType)
+	RemoveAttachmentLink(index int)
+	AttachmentLinkValues() (seq func(yield func(v LinkType) bool))
+	IsAttachmentIRI(index int) (ok bool)
+	GetAttachmentIRI(index int) (v *url.URL)
+	AppendAttachmentIRI(v *url.URL)
+	PrependAttachmentIRI(v *url.URL)
+	RemoveAttachmentIRI(index int)
+	AttachmentIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownAttachment() (ok bool)
+	GetUnknownAttachment() (v interface{})
+	SetUnknownAttachment(i interface{})
+	AttributedToLen() (l int)
+	IsAttributedToObject(index int) (ok bool)
+	GetAttributedToObject(index int) (v ObjectType)
+	AppendAttributedToObject(v ObjectType)
+	PrependAttributedToObject(v ObjectType)
+	RemoveAttributedToObject(index int)
+	AttributedToObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsAttributedToLink(index int) (ok bool)
+	GetAttributedToLink(index int) (v LinkType)
+	AppendAttributedToLink(v LinkType)
+	PrependAttributedToLink(v LinkType)
+	RemoveAttributedToLink(index int)
+	AttributedToLinkValues() (seq func(yield func(v LinkType) bool))
+	IsAttributedToIRI(index int) (ok bool)
+	GetAttributedToIRI(index int) (v *url.URL)
+	AppendAttributedToIRI(v *url.URL)
+	PrependAttributedToIRI(v *url.URL)
+	RemoveAttributedToIRI(index int)
+	AttributedToIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownAttributedTo() (ok bool)
+	GetUnknownAttributedTo() (v interface{})
+	SetUnknownAttributedTo(i interface{})
+	AudienceLen() (l int)
+	IsAudienceObject(index int) (ok bool)
+	GetAudienceObject(index int) (v ObjectType)
+	AppendAudienceObject(v ObjectType)
+	PrependAudienceObject(v ObjectType)
+	RemoveAudienceObject(index int)
+	AudienceObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsAudienceLink(index int) (ok bool)
+	GetAudienceLink(index int) (v LinkType)
+	AppendAudienceLink(v LinkType)
+	PrependAudienceLink(v LinkType)
+	RemoveAudienceLink(index int)
+	AudienceLinkValues() (seq func(yield func(v LinkType) bool))
+	IsAudienceIRI(index int) (ok bool)
+	GetAudienceIRI(index int) (v *url.URL)
+	AppendAudienceIRI(v *url.URL)
+	PrependAudienceIRI(v *url.URL)
+	RemoveAudienceIRI(index int)
+	AudienceIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownAudience() (ok bool)
+	GetUnknownAudience() (v interface{})
+	SetUnknownAudience(i interface{})
+	ContentLen() (l int)
+	IsContentString(index int) (ok bool)
+	GetContentString(index int) (v string)
+	AppendContentString(v string)
+	PrependContentString(v string)
+	RemoveContentString(index int)
+	ContentStringValues() (seq func(yield func(v string) bool))
+	IsContentLangString(index int) (ok bool)
+	GetContentLangString(index int) (v string)
+	AppendContentLangString(v string)
+	PrependContentLangString(v string)
+	RemoveContentLangString(index int)
+	ContentLangStringValues() (seq func(yield func(v string) bool))
+	IsContentIRI(index int) (ok bool)
+	GetContentIRI(index int) (v *url.URL)
+	AppendContentIRI(v *url.URL)
+	PrependContentIRI(v *url.URL)
+	RemoveContentIRI(index int)
+	ContentIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownContent() (ok bool)
+	GetUnknownContent() (v interface{})
+	SetUnknownContent(i interface{})
+	ContentMapLanguages() (l []string)
+	GetContentMap(l string) (v string)
+	SetContentMap(l string, v string)
+	GetContentLanguage(tag string) (v string, ok bool)
+	SetContentLanguage(tag string, v string)
+	PreferredContentLanguage(tags ...string) (v string, tag string)
+	ContextLen() (l int)
+	IsContextObject(index int) (ok bool)
+	GetContextObject(index int) (v ObjectType)
+	AppendContextObject(v ObjectType)
+	PrependContextObject(v ObjectType)
+	RemoveContextObject(index int)
+	ContextObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsContextLink(index int) (ok bool)
+	GetContextLink(index int) (v LinkType)
+	AppendContextLink(v LinkType)
+	PrependContextLink(v LinkType)
+	RemoveContextLink(index int)
+	ContextLinkValues() (seq func(yield func(v LinkType) bool))
+	IsContextIRI(index int) (ok bool)
+	GetContextIRI(index int) (v *url.URL)
+	AppendContextIRI(v *url.URL)
+	PrependContextIRI(v *url.URL)
+	RemoveContextIRI(index int)
+	ContextIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownContext() (ok bool)
+	GetUnknownContext() (v interface{})
+	SetUnknownContext(i interface{})
+	NameLen() (l int)
+	IsNameString(index int) (ok bool)
+	GetNameString(index int) (v string)
+	AppendNameString(v string)
+	PrependNameString(v string)
+	RemoveNameString(index int)
+	NameStringValues() (seq func(yield func(v string) bool))
+	IsNameLangString(index int) (ok bool)
+	GetNameLangString(index int) (v string)
+	AppendNameLangString(v string)
+	PrependNameLangString(v string)
+	RemoveNameLangString(index int)
+	NameLangStringValues() (seq func(yield func(v string) bool))
+	IsNameIRI(index int) (ok bool)
+	GetNameIRI(index int) (v *url.URL)
+	AppendNameIRI(v *url.URL)
+	PrependNameIRI(v *url.URL)
+	RemoveNameIRI(index int)
+	NameIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownName() (ok bool)
+	GetUnknownName() (v interface{})
+	SetUnknownName(i interface{})
+	NameMapLanguages() (l []string)
+	GetNameMap(l string) (v string)
+	SetNameMap(l string, v string)
+	GetNameLanguage(tag string) (v string, ok bool)
+	SetNameLanguage(tag string, v string)
+	PreferredNameLanguage(tags ...string) (v string, tag string)
+	IsEndTime() (ok bool)
+	GetEndTime() (v time.Time)
+	SetEndTime(v time.Time)
+	IsEndTimeIRI() (ok bool)
+	GetEndTimeIRI() (v *url.URL)
+	SetEndTimeIRI(v *url.URL)
+	HasUnknownEndTime() (ok bool)
+	GetUnknownEndTime() (v interface{})
+	SetUnknownEndTime(i interface{})
+	GeneratorLen() (l int)
+	IsGeneratorObject(index int) (ok bool)
+	GetGeneratorObject(index int) (v ObjectType)
+	AppendGeneratorObject(v ObjectType)
+	PrependGeneratorObject(v ObjectType)
+	RemoveGeneratorObject(index int)
+	GeneratorObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsGeneratorLink(index int) (ok bool)
+	GetGeneratorLink(index int) (v LinkType)
+	AppendGeneratorLink(v LinkType)
+	PrependGeneratorLink(v LinkType)
+	RemoveGeneratorLink(index int)
+	GeneratorLinkValues() (seq func(yield func(v LinkType) bool))
+	IsGeneratorIRI(index int) (ok bool)
+	GetGeneratorIRI(index int) (v *url.URL)
+	AppendGeneratorIRI(v *url.URL)
+	PrependGeneratorIRI(v *url.URL)
+	RemoveGeneratorIRI(index int)
+	GeneratorIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownGenerator() (ok bool)
+	GetUnknownGenerator() (v interface{})
+	SetUnknownGenerator(i interface{})
+	IconLen() (l int)
+	IsIconImage(index int) (ok bool)
+	GetIconImage(index int) (v ImageType)
+	AppendIconImage(v ImageType)
+	PrependIconImage(v ImageType)
+	RemoveIconImage(index int)
+	IconImageValues() (seq func(yield func(v ImageType) bool))
+	IsIconLink(index int) (ok bool)
+	GetIconLink(index int) (v LinkType)
+	AppendIconLink(v LinkType)
+	PrependIconLink(v LinkType)
+	RemoveIconLink(index int)
+	IconLinkValues() (seq func(yield func(v LinkType) bool))
+	IsIconIRI(index int) (ok bool)
+	GetIconIRI(index int) (v *url.URL)
+	AppendIconIRI(v *url.URL)
+	PrependIconIRI(v *url.URL)
+	RemoveIconIRI(index int)
+	IconIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownIcon() (ok bool)
+	GetUnknownIcon() (v interface{})
+	SetUnknownIcon(i interface{})
+	HasId() (ok bool)
+	GetId() (v *url.URL)
+	SetId(v *url.URL)
+	HasUnknownId() (ok bool)
+	GetUnknownId() (v interface{})
+	SetUnknownId(i interface{})
+	ImageLen() (l int)
+	IsImageImage(index int) (ok bool)
+	GetImageImage(index int) (v ImageType)
+	AppendImageImage(v ImageType)
+	PrependImageImage(v ImageType)
+	RemoveImageImage(index int)
+	ImageImageValues() (seq func(yield func(v ImageType) bool))
+	IsImageLink(index int) (ok bool)
+	GetImageLink(index int) (v LinkType)
+	AppendImageLink(v LinkType)
+	PrependImageLink(v LinkType)
+	RemoveImageLink(index int)
+	ImageLinkValues() (seq func(yield func(v LinkType) bool))
+	IsImageIRI(index int) (ok bool)
+	GetImageIRI(index int) (v *url.URL)
+	AppendImageIRI(v *url.URL)
+	PrependImageIRI(v *url.URL)
+	RemoveImageIRI(index int)
+	ImageIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownImage() (ok bool)
+	GetUnknownImage() (v interface{})
+	SetUnknownImage(i interface{})
+	InReplyToLen() (l int)
+	IsInReplyToObject(index int) (ok bool)
+	GetInReplyToObject(index int) (v ObjectType)
+	AppendInReplyToObject(v ObjectType)
+	PrependInReplyToObject(v ObjectType)
+	RemoveInReplyToObject(index int)
+	InReplyToObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsInReplyToLink(index int) (ok bool)
+	GetInReplyToLink(index int) (v LinkType)
+	AppendInReplyToLink(v LinkType)
+	PrependInReplyToLink(v LinkType)
+	RemoveInReplyToLink(index int)
+	InReplyToLinkValues() (seq func(yield func(v LinkType) bool))
+	IsInReplyToIRI(index int) (ok bool)
+	GetInReplyToIRI(index int) (v *url.URL)
+	AppendInReplyToIRI(v *url.URL)
+	PrependInReplyToIRI(v *url.URL)
+	RemoveInReplyToIRI(index int)
+	InReplyToIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownInReplyTo() (ok bool)
+	GetUnknownInReplyTo() (v interface{})
+	SetUnknownInReplyTo(i interface{})
+	LocationLen() (l int)
+	IsLocationObject(index int) (ok bool)
+	GetLocationObject(index int) (v ObjectType)
+	AppendLocationObject(v ObjectType)
+	PrependLocationObject(v ObjectType)
+	RemoveLocationObject(index int)
+	LocationObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsLocationLink(index int) (ok bool)
+	GetLocationLink(index int) (v LinkType)
+	AppendLocationLink(v LinkType)
+	PrependLocationLink(v LinkType)
+	RemoveLocationLink(index int)
+	LocationLinkValues() (seq func(yield func(v LinkType) bool))
+	IsLocationIRI(index int) (ok bool)
+	GetLocationIRI(index int) (v *url.URL)
+	AppendLocationIRI(v *url.URL)
+	PrependLocationIRI(v *url.URL)
+	RemoveLocationIRI(index int)
+	LocationIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownLocation() (ok bool)
+	GetUnknownLocation() (v interface{})
+	SetUnknownLocation(i interface{})
+	PreviewLen() (l int)
+	IsPreviewObject(index int) (ok bool)
+	GetPreviewObject(index int) (v ObjectType)
+	AppendPreviewObject(v ObjectType)
+	PrependPreviewObject(v ObjectType)
+	RemovePreviewObject(index int)
+	PreviewObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsPreviewLink(index int) (ok bool)
+	GetPreviewLink(index int) (v LinkType)
+	AppendPreviewLink(v LinkType)
+	PrependPreviewLink(v LinkType)
+	RemovePreviewLink(index int)
+	PreviewLinkValues() (seq func(yield func(v LinkType) bool))
+	IsPreviewIRI(index int) (ok bool)
+	GetPreviewIRI(index int) (v *url.URL)
+	AppendPreviewIRI(v *url.URL)
+	PrependPreviewIRI(v *url.URL)
+	RemovePreviewIRI(index int)
+	PreviewIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownPreview() (ok bool)
+	GetUnknownPreview() (v interface{})
+	SetUnknownPreview(i interface{})
+	IsPublished() (ok bool)
+	GetPublished() (v time.Time)
+	SetPublished(v time.Time)
+	IsPublishedIRI() (ok bool)
+	GetPublishedIRI() (v *url.URL)
+	SetPublishedIRI(v *url.URL)
+	HasUnknownPublished() (ok bool)
+	GetUnknownPublished() (v interface{})
+	SetUnknownPublished(i interface{})
+	IsReplies() (ok bool)
+	GetReplies() (v CollectionType)
+	SetReplies(v CollectionType)
+	IsRepliesIRI() (ok bool)
+	GetRepliesIRI() (v *url.URL)
+	SetRepliesIRI(v *url.URL)
+	HasUnknownReplies() (ok bool)
+	GetUnknownReplies() (v interface{})
+	SetUnknownReplies(i interface{})
+	IsStartTime() (ok bool)
+	GetStartTime() (v time.Time)
+	SetStartTime(v time.Time)
+	IsStartTimeIRI() (ok bool)
+	GetStartTimeIRI() (v *url.URL)
+	SetStartTimeIRI(v *url.URL)
+	HasUnknownStartTime() (ok bool)
+	GetUnknownStartTime() (v interface{})
+	SetUnknownStartTime(i interface{})
+	SummaryLen() (l int)
+	IsSummaryString(index int) (ok bool)
+	GetSummaryString(index int) (v string)
+	AppendSummaryString(v string)
+	PrependSummaryString(v string)
+	RemoveSummaryString(index int)
+	SummaryStringValues() (seq func(yield func(v string) bool))
+	IsSummaryLangString(index int) (ok bool)
+	GetSummaryLangString(index int) (v string)
+	AppendSummaryLangString(v string)
+	PrependSummaryLangString(v string)
+	RemoveSummaryLangString(index int)
+	SummaryLangStringValues() (seq func(yield func(v string) bool))
+	IsSummaryIRI(index int) (ok bool)
+	GetSummaryIRI(index int) (v *url.URL)
+	AppendSummaryIRI(v *url.URL)
+	PrependSummaryIRI(v *url.URL)
+	RemoveSummaryIRI(index int)
+	SummaryIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownSummary() (ok bool)
+	GetUnknownSummary() (v interface{})
+	SetUnknownSummary(i interface{})
+	SummaryMapLanguages() (l []string)
+	GetSummaryMap(l string) (v string)
+	SetSummaryMap(l string, v string)
+	GetSummaryLanguage(tag string) (v string, ok bool)
+	SetSummaryLanguage(tag string, v string)
+	PreferredSummaryLanguage(tags ...string) (v string, tag string)
+	TagLen() (l int)
+	IsTagObject(index int) (ok bool)
+	GetTagObject(index int) (v ObjectType)
+	AppendTagObject(v ObjectType)
+	PrependTagObject(v ObjectType)
+	RemoveTagObject(index int)
+	TagObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsTagLink(index int) (ok bool)
+	GetTagLink(index int) (v LinkType)
+	AppendTagLink(v LinkType)
+	PrependTagLink(v LinkType)
+	RemoveTagLink(index int)
+	TagLinkValues() (seq func(yield func(v LinkType) bool))
+	IsTagIRI(index int) (ok bool)
+	GetTagIRI(index int) (v *url.URL)
+	AppendTagIRI(v *url.URL)
+	PrependTagIRI(v *url.URL)
+	RemoveTagIRI(index int)
+	TagIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownTag() (ok bool)
+	GetUnknownTag() (v interface{})
+	SetUnknownTag(i interface{})
+	TypeLen() (l int)
+	GetType(index int) (v interface{})
+	AppendType(v interface{})
+	PrependType(v interface{})
+	RemoveType(index int)
+	IsUpdated() (ok bool)
+	GetUpdated() (v time.Time)
+	SetUpdated(v time.Time)
+	IsUpdatedIRI() (ok bool)
+	GetUpdatedIRI() (v *url.URL)
+	SetUpdatedIRI(v *url.URL)
+	HasUnknownUpdated() (ok bool)
+	GetUnknownUpdated() (v interface{})
+	SetUnknownUpdated(i interface{})
+	UrlLen() (l int)
+	IsUrlAnyURI(index int) (ok bool)
+	GetUrlAnyURI(index int) (v *url.URL)
+	AppendUrlAnyURI(v *url.URL)
+	PrependUrlAnyURI(v *url.URL)
+	RemoveUrlAnyURI(index int)
+	UrlAnyURIValues() (seq func(yield func(v *url.URL) bool))
+	IsUrlLink(index int) (ok bool)
+	GetUrlLink(index int) (v LinkType)
+	AppendUrlLink(v LinkType)
+	PrependUrlLink(v LinkType)
+	RemoveUrlLink(index int)
+	UrlLinkValues() (seq func(yield func(v LinkType) bool))
+	HasUnknownUrl() (ok bool)
+	GetUnknownUrl() (v interface{})
+	SetUnknownUrl(i interface{})
+	ToLen() (l int)
+	IsToObject(index int) (ok bool)
+	GetToObject(index int) (v ObjectType)
+	AppendToObject(v ObjectType)
+	PrependToObject(v ObjectType)
+	RemoveToObject(index int)
+	ToObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsToLink(index int) (ok bool)
+	GetToLink(index int) (v LinkType)
+	AppendToLink(v LinkType)
+	PrependToLink(v LinkType)
+	RemoveToLink(index int)
+	ToLinkValues() (seq func(yield func(v LinkType) bool))
+	IsToIRI(index int) (ok bool)
+	GetToIRI(index int) (v *url.URL)
+	AppendToIRI(v *url.URL)
+	PrependToIRI(v *url.URL)
+	RemoveToIRI(index int)
+	ToIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownTo() (ok bool)
+	GetUnknownTo() (v interface{})
+	SetUnknownTo(i interface{})
+	BtoLen() (l int)
+	IsBtoObject(index int) (ok bool)
+	GetBtoObject(index int) (v ObjectType)
+	AppendBtoObject(v ObjectType)
+	PrependBtoObject(v ObjectType)
+	RemoveBtoObject(index int)
+	BtoObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsBtoLink(index int) (ok bool)
+	GetBtoLink(index int) (v LinkType)
+	AppendBtoLink(v LinkType)
+	PrependBtoLink(v LinkType)
+	RemoveBtoLink(index int)
+	BtoLinkValues() (seq func(yield func(v LinkType) bool))
+	IsBtoIRI(index int) (ok bool)
+	GetBtoIRI(index int) (v *url.URL)
+	AppendBtoIRI(v *url.URL)
+	PrependBtoIRI(v *url.URL)
+	RemoveBtoIRI(index int)
+	BtoIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownBto() (ok bool)
+	GetUnknownBto() (v interface{})
+	SetUnknownBto(i interface{})
+	CcLen() (l int)
+	IsCcObject(index int) (ok bool)
+	GetCcObject(index int) (v ObjectType)
+	AppendCcObject(v ObjectType)
+	PrependCcObject(v ObjectType)
+	RemoveCcObject(index int)
+	CcObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsCcLink(index int) (ok bool)
+	GetCcLink(index int) (v LinkType)
+	AppendCcLink(v LinkType)
+	PrependCcLink(v LinkType)
+	RemoveCcLink(index int)
+	CcLinkValues() (seq func(yield func(v LinkType) bool))
+	IsCcIRI(index int) (ok bool)
+	GetCcIRI(index int) (v *url.URL)
+	AppendCcIRI(v *url.URL)
+	PrependCcIRI(v *url.URL)
+	RemoveCcIRI(index int)
+	CcIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownCc() (ok bool)
+	GetUnknownCc() (v interface{})
+	SetUnknownCc(i interface{})
+	BccLen() (l int)
+	IsBccObject(index int) (ok bool)
+	GetBccObject(index int) (v ObjectType)
+	AppendBccObject(v ObjectType)
+	PrependBccObject(v ObjectType)
+	RemoveBccObject(index int)
+	BccObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsBccLink(index int) (ok bool)
+	GetBccLink(index int) (v LinkType)
+	AppendBccLink(v LinkType)
+	PrependBccLink(v LinkType)
+	RemoveBccLink(index int)
+	BccLinkValues() (seq func(yield func(v LinkType) bool))
+	IsBccIRI(index int) (ok bool)
+	GetBccIRI(index int) (v *url.URL)
+	AppendBccIRI(v *url.URL)
+	PrependBccIRI(v *url.URL)
+	RemoveBccIRI(index int)
+	BccIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownBcc() (ok bool)
+	GetUnknownBcc() (v interface{})
+	SetUnknownBcc(i interface{})
+	IsMediaType() (ok bool)
+	GetMediaType() (v string)
+	SetMediaType(v string)
+	IsMediaTypeIRI() (ok bool)
+	GetMediaTypeIRI() (v *url.URL)
+	SetMediaTypeIRI(v *url.URL)
+	HasUnknownMediaType() (ok bool)
+	GetUnknownMediaType() (v interface{})
+	SetUnknownMediaType(i interface{})
+	IsDuration() (ok bool)
+	GetDuration() (v time.Duration)
+	SetDuration(v time.Duration)
+	IsDurationIRI() (ok bool)
+	GetDurationIRI() (v *url.URL)
+	SetDurationIRI(v *url.URL)
+	HasUnknownDuration() (ok bool)
+	GetUnknownDuration() (v interface{})
+	SetUnknownDuration(i interface{})
+	IsSource() (ok bool)
+	GetSource() (v ObjectType)
+	SetSource(v ObjectType)
+	IsSourceIRI() (ok bool)
+	GetSourceIRI() (v *url.URL)
+	SetSourceIRI(v *url.URL)
+	HasUnknownSource() (ok bool)
+	GetUnknownSource() (v interface{})
+	SetUnknownSource(i interface{})
+	IsInboxOrderedCollection() (ok bool)
+	GetInboxOrderedCollection() (v OrderedCollectionType)
+	SetInboxOrderedCollection(v OrderedCollectionType)
+	IsInboxAnyURI() (ok bool)
+	GetInboxAnyURI() (v *url.URL)
+	SetInboxAnyURI(v *url.URL)
+	HasUnknownInbox() (ok bool)
+	GetUnknownInbox() (v interface{})
+	SetUnknownInbox(i interface{})
+	IsOutboxOrderedCollection() (ok bool)
+	GetOutboxOrderedCollection() (v OrderedCollectionType)
+	SetOutboxOrderedCollection(v OrderedCollectionType)
+	IsOutboxAnyURI() (ok bool)
+	GetOutboxAnyURI() (v *url.URL)
+	SetOutboxAnyURI(v *url.URL)
+	HasUnknownOutbox() (ok bool)
+	GetUnknownOutbox() (v interface{})
+	SetUnknownOutbox(i interface{})
+	IsFollowingCollection() (ok bool)
+	GetFollowingCollection() (v CollectionType)
+	SetFollowingCollection(v CollectionType)
+	IsFollowingOrderedCollection() (ok bool)
+	GetFollowingOrderedCollection() (v OrderedCollectionType)
+	SetFollowingOrderedCollection(v OrderedCollectionType)
+	IsFollowingAnyURI() (ok bool)
+	GetFollowingAnyURI() (v *url.URL)
+	SetFollowingAnyURI(v *url.URL)
+	HasUnknownFollowing() (ok bool)
+	GetUnknownFollowing() (v interface{})
+	SetUnknownFollowing(i interface{})
+	IsFollowersCollection() (ok bool)
+	GetFollowersCollection() (v CollectionType)
+	SetFollowersCollection(v CollectionType)
+	IsFollowersOrderedCollection() (ok bool)
+	GetFollowersOrderedCollection() (v OrderedCollectionType)
+	SetFollowersOrderedCollection(v OrderedCollectionType)
+	IsFollowersAnyURI() (ok bool)
+	GetFollowersAnyURI() (v *url.URL)
+	SetFollowersAnyURI(v *url.URL)
+	HasUnknownFollowers() (ok bool)
+	GetUnknownFollowers() (v interface{})
+	SetUnknownFollowers(i interface{})
+	IsLikedCollection() (ok bool)
+	GetLikedCollection() (v CollectionType)
+	SetLikedCollection(v CollectionType)
+	IsLikedOrderedCollection() (ok bool)
+	GetLikedOrderedCollection() (v OrderedCollectionType)
+	SetLikedOrderedCollection(v OrderedCollectionType)
+	IsLikedAnyURI() (ok bool)
+	GetLikedAnyURI() (v *url.URL)
+	SetLikedAnyURI(v *url.URL)
+	HasUnknownLiked() (ok bool)
+	GetUnknownLiked() (v interface{})
+	SetUnknownLiked(i interface{})
+	IsLikesCollection() (ok bool)
+	GetLikesCollection() (v CollectionType)
+	SetLikesCollection(v CollectionType)
+	IsLikesOrderedCollection() (ok bool)
+	GetLikesOrderedCollection() (v OrderedCollectionType)
+	SetLikesOrderedCollection(v OrderedCollectionType)
+	IsLikesAnyURI() (ok bool)
+	GetLikesAnyURI() (v *url.URL)
+	SetLikesAnyURI(v *url.URL)
+	HasUnknownLikes() (ok bool)
+	GetUnknownLikes() (v interface{})
+	SetUnknownLikes(i interface{})
+	StreamsLen() (l int)
+	GetStreams(index int) (v *url.URL)
+	AppendStreams(v *url.URL)
+	PrependStreams(v *url.URL)
+	RemoveStreams(index int)
+	HasUnknownStreams() (ok bool)
+	GetUnknownStreams() (v interface{})
+	SetUnknownStreams(i interface{})
+	StreamsValues() (seq func(yield func(v *url.URL) bool))
+	IsPreferredUsername() (ok bool)
+	GetPreferredUsername() (v string)
+	SetPreferredUsername(v string)
+	IsPreferredUsernameIRI() (ok bool)
+	GetPreferredUsernameIRI() (v *url.URL)
+	SetPreferredUsernameIRI(v *url.URL)
+	HasUnknownPreferredUsername() (ok bool)
+	GetUnknownPreferredUsername() (v interface{})
+	SetUnknownPreferredUsername(i interface{})
+	PreferredUsernameMapLanguages() (l []string)
+	GetPreferredUsernameMap(l string) (v string)
+	SetPreferredUsernameMap(l string, v string)
+	GetPreferredUsernameLanguage(tag string) (v string, ok bool)
+	SetPreferredUsernameLanguage(tag string, v string)
+	PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string)
+	IsEndpoints() (ok bool)
+	GetEndpoints() (v ObjectType)
+	SetEndpoints(v ObjectType)
+	IsEndpointsIRI() (ok bool)
+	GetEndpointsIRI() (v *url.URL)
+	SetEndpointsIRI(v *url.URL)
+	HasUnknownEndpoints() (ok bool)
+	GetUnknownEndpoints() (v interface{})
+	SetUnknownEndpoints(i interface{})
+	HasProxyUrl() (ok bool)
+	GetProxyUrl() (v *url.URL)
+	SetProxyUrl(v *url.URL)
+	HasUnknownProxyUrl() (ok bool)
+	GetUnknownProxyUrl() (v interface{})
+	SetUnknownProxyUrl(i interface{})
+	HasOauthAuthorizationEndpoint() (ok bool)
+	GetOauthAuthorizationEndpoint() (v *url.URL)
+	SetOauthAuthorizationEndpoint(v *url.URL)
+	HasUnknownOauthAuthorizationEndpoint() (ok bool)
+	GetUnknownOauthAuthorizationEndpoint() (v interface{})
+	SetUnknownOauthAuthorizationEndpoint(i interface{})
+	HasOauthTokenEndpoint() (ok bool)
+	GetOauthTokenEndpoint() (v *url.URL)
+	SetOauthTokenEndpoint(v *url.URL)
+	HasUnknownOauthTokenEndpoint() (ok bool)
+	GetUnknownOauthTokenEndpoint() (v interface{})
+	SetUnknownOauthTokenEndpoint(i interface{})
+	HasProvideClientKey() (ok bool)
+	GetProvideClientKey() (v *url.URL)
+	SetProvideClientKey(v *url.URL)
+	HasUnknownProvideClientKey() (ok bool)
+	GetUnknownProvideClientKey() (v interface{})
+	SetUnknownProvideClientKey(i interface{})
+	HasSignClientKey() (ok bool)
+	GetSignClientKey() (v *url.URL)
+	SetSignClientKey(v *url.URL)
+	HasUnknownSignClientKey() (ok bool)
+	GetUnknownSignClientKey() (v interface{})
+	SetUnknownSignClientKey(i interface{})
+	HasSharedInbox() (ok bool)
+	GetSharedInbox() (v *url.URL)
+	SetSharedInbox(v *url.URL)
+	HasUnknownSharedInbox() (ok bool)
+	GetUnknownSharedInbox() (v interface{})
+	SetUnknownSharedInbox(i interface{})
+	IsSharesCollection() (ok bool)
+	GetSharesCollection() (v CollectionType)
+	SetSharesCollection(v CollectionType)
+	IsSharesOrderedCollection() (ok bool)
+	GetSharesOrderedCollection() (v OrderedCollectionType)
+	SetSharesOrderedCollection(v OrderedCollectionType)
+	IsSharesAnyURI() (ok bool)
+	GetSharesAnyURI() (v *url.URL)
+	SetSharesAnyURI(v *url.URL)
+	HasUnknownShares() (ok bool)
+	GetUnknownShares() (v interface{})
+	SetUnknownShares(i interface{})
+	IsPublic() (b bool)
+	Validate() (err error)
+	ValidateStrict() (err error)
+	TryGetValue() (v string, err error)
+	TryGetValueIRI() (v *url.URL, err error)
+	TryGetUnknownValue() (v interface{}, err error)
+	TryGetAltitude() (v float64, err error)
+	TryGetAltitudeIRI() (v *url.URL, err error)
+	TryGetUnknownAltitude() (v interface{}, err error)
+	TryGetAttachmentObject(index int) (v ObjectType, err error)
+	TryGetAttachmentLink(index int) (v LinkType, err error)
+	TryGetAttachmentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttachment() (v interface{}, err error)
+	TryGetAttributedToObject(index int) (v ObjectType, err error)
+	TryGetAttributedToLink(index int) (v LinkType, err error)
+	TryGetAttributedToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttributedTo() (v interface{}, err error)
+	TryGetAudienceObject(index int) (v ObjectType, err error)
+	TryGetAudienceLink(index int) (v LinkType, err error)
+	TryGetAudienceIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAudience() (v interface{}, err error)
+	TryGetContentString(index int) (v string, err error)
+	TryGetContentLangString(index int) (v string, err error)
+	TryGetContentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContent() (v interface{}, err error)
+	TryGetContextObject(index int) (v ObjectType, err error)
+	TryGetContextLink(index int) (v LinkType, err error)
+	TryGetContextIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContext() (v interface{}, err error)
+	TryGetNameString(index int) (v string, err error)
+	TryGetNameLangString(index int) (v string, err error)
+	TryGetNameIRI(index int) (v *url.URL, err error)
+	TryGetUnknownName() (v interface{}, err error)
+	TryGetEndTime() (v time.Time, err error)
+	TryGetEndTimeIRI() (v *url.URL, err error)
+	TryGetUnknownEndTime() (v interface{}, err error)
+	TryGetGeneratorObject(index int) (v ObjectType, err error)
+	TryGetGeneratorLink(index int) (v LinkType, err error)
+	TryGetGeneratorIRI(index int) (v *url.URL, err error)
+	TryGetUnknownGenerator() (v interface{}, err error)
+	TryGetIconImage(index int) (v ImageType, err error)
+	TryGetIconLink(index int) (v LinkType, err error)
+	TryGetIconIRI(index int) (v *url.URL, err error)
+	TryGetUnknownIcon() (v interface{}, err error)
+	TryGetId() (v *url.URL, err error)
+	TryGetUnknownId() (v interface{}, err error)
+	TryGetImageImage(index int) (v ImageType, err error)
+	TryGetImageLink(index int) (v LinkType, err error)
+	TryGetImageIRI(index int) (v *url.URL, err error)
+	TryGetUnknownImage() (v interface{}, err error)
+	TryGetInReplyToObject(index int) (v ObjectType, err error)
+	TryGetInReplyToLink(index int) (v LinkType, err error)
+	TryGetInReplyToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownInReplyTo() (v interface{}, err error)
+	TryGetLocationObject(index int) (v ObjectType, err error)
+	TryGetLocationLink(index int) (v LinkType, err error)
+	TryGetLocationIRI(index int) (v *url.URL, err error)
+	TryGetUnknownLocation() (v interface{}, err error)
+	TryGetPreviewObject(index int) (v ObjectType, err error)
+	TryGetPreviewLink(index int) (v LinkType, err error)
+	TryGetPreviewIRI(index int) (v *url.URL, err error)
+	TryGetUnknownPreview() (v interface{}, err error)
+	TryGetPublished() (v time.Time, err error)
+	TryGetPublishedIRI() (v *url.URL, err error)
+	TryGetUnknownPublished() (v interface{}, err error)
+	TryGetReplies() (v CollectionType, err error)
+	TryGetRepliesIRI() (v *url.URL, err error)
+	TryGetUnknownReplies() (v interface{}, err error)
+	TryGetStartTime() (v time.Time, err error)
+	TryGetStartTimeIRI() (v *url.URL, err error)
+	TryGetUnknownStartTime() (v interface{}, err error)
+	TryGetSummaryString(index int) (v string, err error)
+	TryGetSummaryLangString(index int) (v string, err error)
+	TryGetSummaryIRI(index int) (v *url.URL, err error)
+	TryGetUnknownSummary() (v interface{}, err error)
+	TryGetTagObject(index int) (v ObjectType, err error)
+	TryGetTagLink(index int) (v LinkType, err error)
+	TryGetTagIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTag() (v interface{}, err error)
+	TryGetType(index int) (v interface{}, err error)
+	TryGetUpdated() (v time.Time, err error)
+	TryGetUpdatedIRI() (v *url.URL, err error)
+	TryGetUnknownUpdated() (v interface{}, err error)
+	TryGetUrlAnyURI(index int) (v *url.URL, err error)
+	TryGetUrlLink(index int) (v LinkType, err error)
+	TryGetUnknownUrl() (v interface{}, err error)
+	TryGetToObject(index int) (v ObjectType, err error)
+	TryGetToLink(index int) (v LinkType, err error)
+	TryGetToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTo() (v interface{}, err error)
+	TryGetBtoObject(index int) (v ObjectType, err error)
+	TryGetBtoLink(index int) (v LinkType, err error)
+	TryGetBtoIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBto() (v interface{}, err error)
+	TryGetCcObject(index int) (v ObjectType, err error)
+	TryGetCcLink(index int) (v LinkType, err error)
+	TryGetCcIRI(index int) (v *url.URL, err error)
+	TryGetUnknownCc() (v interface{}, err error)
+	TryGetBccObject(index int) (v ObjectType, err error)
+	TryGetBccLink(index int) (v LinkType, err error)
+	TryGetBccIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBcc() (v interface{}, err error)
+	TryGetMediaType() (v string, err error)
+	TryGetMediaTypeIRI() (v *url.URL, err error)
+	TryGetUnknownMediaType() (v interface{}, err error)
+	TryGetDuration() (v time.Duration, err error)
+	TryGetDurationIRI() (v *url.URL, err error)
+	TryGetUnknownDuration() (v interface{}, err error)
+	TryGetSource() (v ObjectType, err error)
+	TryGetSourceIRI() (v *url.URL, err error)
+	TryGetUnknownSource() (v interface{}, err error)
+	TryGetInboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetInboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownInbox() (v interface{}, err error)
+	TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetOutboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownOutbox() (v interface{}, err error)
+	TryGetFollowingCollection() (v CollectionType, err error)
+	TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowingAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowing() (v interface{}, err error)
+	TryGetFollowersCollection() (v CollectionType, err error)
+	TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowersAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowers() (v interface{}, err error)
+	TryGetLikedCollection() (v CollectionType, err error)
+	TryGetLikedOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikedAnyURI() (v *url.URL, err error)
+	TryGetUnknownLiked() (v interface{}, err error)
+	TryGetLikesCollection() (v CollectionType, err error)
+	TryGetLikesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikesAnyURI() (v *url.URL, err error)
+	TryGetUnknownLikes() (v interface{}, err error)
+	TryGetStreams(index int) (v *url.URL, err error)
+	TryGetUnknownStreams() (v interface{}, err error)
+	TryGetPreferredUsername() (v string, err error)
+	TryGetPreferredUsernameIRI() (v *url.URL, err error)
+	TryGetUnknownPreferredUsername() (v interface{}, err error)
+	TryGetEndpoints() (v ObjectType, err error)
+	TryGetEndpointsIRI() (v *url.URL, err error)
+	TryGetUnknownEndpoints() (v interface{}, err error)
+	TryGetProxyUrl() (v *url.URL, err error)
+	TryGetUnknownProxyUrl() (v interface{}, err error)
+	TryGetOauthAuthorizationEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error)
+	TryGetOauthTokenEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthTokenEndpoint() (v interface{}, err error)
+	TryGetProvideClientKey() (v *url.URL, err error)
+	TryGetUnknownProvideClientKey() (v interface{}, err error)
+	TryGetSignClientKey() (v *url.URL, err error)
+	TryGetUnknownSignClientKey() (v interface{}, err error)
+	TryGetSharedInbox() (v *url.URL, err error)
+	TryGetUnknownSharedInbox() (v interface{}, err error)
+	TryGetSharesCollection() (v CollectionType, err error)
+	TryGetSharesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetSharesAnyURI() (v *url.URL, err error)
+	TryGetUnknownShares() (v interface{}, err error)
+}
+
+var _ PropertyValueType = (*PropertyValue)(nil)
+
+// A name and value pair, such as a field of the metadata of an actor's profile, which is found in the 'attachment' of the actor. Its 'name' is the name of the field.
+type PropertyValue struct {
+	// An unknown value.
+	unknown_ map[string]interface{}
+	// The order of the properties of the JSON this was unmarshalled from.
+	order_ *propertyOrder
+	// The functional 'value' value could have multiple types, but only a single value
+	value *valueIntermediateType
+	// The functional 'altitude' value could have multiple types, but only a single value
+	altitude *altitudeIntermediateType
+	// The 'attachment' value could have multiple types and values
+	attachment []*attachmentIntermediateType
+	// The 'attributedTo' value could have multiple types and values
+	attributedTo []*attributedToIntermediateType
+	// The 'audience' value could have multiple types and values
+	audience []*audienceIntermediateType
+	// The 'content' value could have multiple types and values
+	content []*contentIntermediateType
+	// The 'contentMap' value holds language-specific values for property 'content'
+	contentMap map[string]string
+	// The 'context' value could have multiple types and values
+	context []*contextIntermediateType
+	// The 'name' value could have multiple types and values
+	name []*nameIntermediateType
+	// The 'nameMap' value holds language-specific values for property 'name'
+	nameMap map[string]string
+	// The functional 'endTime' value could have multiple types, but only a single value
+	endTime *endTimeIntermediateType
+	// The 'generator' value could have multiple types and values
+	generator []*generatorIntermediateType
+	// The 'icon' value could have multiple types and values
+	icon []*iconIntermediateType
+	// The functional 'id' value holds a single type and a single value
+	id *url.URL
+	// The 'image' value could have multiple types and values
+	image []*imageIntermediateType
+	// The 'inReplyTo' value could have multiple types and values
+	inReplyTo []*inReplyToIntermediateType
+	// The 'location' value could have multiple types and values
+	location []*locationIntermediateType
+	// The 'preview' value could have multiple types and values
+	preview []*previewIntermediateType
+	// The functional 'published' value could have multiple types, but only a single value
+	published *publishedIntermediateType
+	// The functional 'replies' value could have multiple types, but only a single value
+	replies *repliesIntermediateType
+	// The functional 'startTime' value could have multiple types, but only a single value
+	startTime *startTimeIntermediateType
+	// The 'summary' value could have multiple types and values
+	summary []*summaryIntermediateType
+	// The 'summaryMap' value holds language-specific values for property 'summary'
+	summaryMap map[string]string
+	// The 'tag' value could have multiple types and values
+	tag []*tagIntermediateType
+	// The 'type' value can hold any type and any number of values
+	typeName []interface{}
+	// The functional 'updated' value could have multiple types, but only a single value
+	updated *updatedIntermediateType
+	// The 'url' value could have multiple types and values
+	url []*urlIntermediateType
+	// The 'to' value could have multiple types and values
+	to []*toIntermediateType
+	// The 'bto' value could have multiple types and values
+	bto []*btoIntermediateType
+	// The 'cc' value could have multiple types and values
+	cc []*ccIntermediateType
+	// The 'bcc' value could have multiple types and values
+	bcc []*bccIntermediateType
+	// The functional 'mediaType' value could have multiple types, but only a single value
+	mediaType *mediaTypeIntermediateType
+	// The functional 'duration' value could have multiple types, but only a single value
+	duration *durationIntermediateType
+	// The functional 'source' value could have multiple types, but only a single value
+	source *sourceIntermediateType
+	// The functional 'inbox' value could have multiple types, but only a single value
+	inbox *inboxIntermediateType
+	// The functional 'outbox' value could have multiple types, but only a single value
+	outbox *outboxIntermediateType
+	// The functional 'following' value could have multiple types, but only a single value
+	following *followingIntermediateType
+	// The functional 'followers' value could have multiple types, but only a single value
+	followers *followersIntermediateType
+	// The functional 'liked' value could have multiple types, but only a single value
+	liked *likedIntermediateType
+	// The functional 'likes' value could have multiple types, but only a single value
+	likes *likesIntermediateType
+	// The 'streams' value holds a single type and any number of values
+	streams []*url.URL
+	// The functional 'preferredUsername' value could have multiple types, but only a single value
+	preferredUsername *preferredUsernameIntermediateType
+	// The 'preferredUsernameMap' value holds language-specific values for property 'preferredUsername'
+	preferredUsernameMap map[string]string
+	// The functional 'endpoints' value could have multiple types, but only a single value
+	endpoints *endpointsIntermediateType
+	// The functional 'proxyUrl' value holds a single type and a single value
+	proxyUrl *url.URL
+	// The functional 'oauthAuthorizationEndpoint' value holds a single type and a single value
+	oauthAuthorizationEndpoint *url.URL
+	// The functional 'oauthTokenEndpoint' value holds a single type and a single value
+	oauthTokenEndpoint *url.URL
+	// The functional 'provideClientKey' value holds a single type and a single value
+	provideClientKey *url.URL
+	// The functional 'signClientKey' value holds a single type and a single value
+	signClientKey *url.URL
+	// The functional 'sharedInbox' value holds a single type and a single value
+	sharedInbox *url.URL
+	// The functional 'shares' value could have multiple types, but only a single value
+	shares *sharesIntermediateType
+	// The bits of the members that are set, in the order of the members.
+	present_ [1]uint64
+}
+
+// IsValue determines whether the call to GetValue is safe
+func (t *PropertyValue) IsValue() (ok bool) {
+	return t.present_[0]&(1<<0) != 0 && t.value.stringName != nil
+
+}
+
+// GetValue returns the value safely if IsValue returned true
+func (t *PropertyValue) GetValue() (v string) {
+	return *t.value.stringName
+
+}
+
+// SetValue sets the value of value to be of string type
+func (t *PropertyValue) SetValue(v string) {
+	t.value = &valueIntermediateType{stringName: &v}
+	t.markPresent_(0, true)
+
+}
+
+// IsValueIRI determines whether the call to GetValueIRI is safe
+func (t *PropertyValue) IsValueIRI() (ok bool) {
+	return t.present_[0]&(1<<0) != 0 && t.value.IRI != nil
+
+}
+
+// GetValueIRI returns the value safely if IsValueIRI returned true
+func (t *PropertyValue) GetValueIRI() (v *url.URL) {
+	return t.value.IRI
+
+}
+
+// SetValueIRI sets the value of value to be of *url.URL type
+func (t *PropertyValue) SetValueIRI(v *url.URL) {
+	t.value = &valueIntermediateType{IRI: v}
+	t.markPresent_(0, true)
+
+}
+
+// HasUnknownValue determines whether the call to GetUnknownValue is safe
+func (t *PropertyValue) HasUnknownValue() (ok bool) {
+	return t.present_[0]&(1<<0) != 0 && t.value.unknown_ != nil
+
+}
+
+// GetUnknownValue returns the unknown value for value
+func (t *PropertyValue) GetUnknownValue() (v interface{}) {
+	return t.value.unknown_
+
+}
+
+// SetUnknownValue sets the unknown value of value
+func (t *PropertyValue) SetUnknownValue(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &valueIntermediateType{}
+	tmp.unknown_ = i
+	t.value = tmp
+	t.markPresent_(0, true)
+
+}
+
+// IsAltitude determines whether the call to GetAltitude is safe
+func (t *PropertyValue) IsAltitude() (ok bool) {
+	return t.present_[0]&(1<<1) != 0 && t.altitude.float != nil
+
+}
+
+// GetAltitude returns the value safely if IsAltitude returned true
+func (t *PropertyValue) GetAltitude() (v float64) {
+	return *t.altitude.float
+
+}
+
+// SetAltitude sets the value of altitude to be of float64 type
+func (t *PropertyValue) SetAltitude(v float64) {
+	t.altitude = &altitudeIntermediateType{float: &v}
+	t.markPresent_(1, true)
+
+}
+
+// IsAltitudeIRI determines whether the call to GetAltitudeIRI is safe
+func (t *PropertyValue) IsAltitudeIRI() (ok bool) {
+	return t.present_[0]&(1<<1) != 0 && t.altitude.IRI != nil
+
+}
+
+// GetAltitudeIRI returns the value safely if IsAltitudeIRI returned true
+func (t *PropertyValue) GetAltitudeIRI() (v *url.URL) {
+	return t.altitude.IRI
+
+}
+
+// SetAltitudeIRI sets the value of altitude to be of *url.URL type
+func (t *PropertyValue) SetAltitudeIRI(v *url.URL) {
+	t.altitude = &altitudeIntermediateType{IRI: v}
+	t.markPresent_(1, true)
+
+}
+
+// HasUnknownAltitude determines whether the call to GetUnknownAltitude is safe
+func (t *PropertyValue) HasUnknownAltitude() (ok bool) {
+	return t.present_[0]&(1<<1) != 0 && t.altitude.unknown_ != nil
+
+}
+
+// GetUnknownAltitude returns the unknown value for altitude
+func (t *PropertyValue) GetUnknownAltitude() (v interface{}) {
+	return t.altitude.unknown_
+
+}
+
+// SetUnknownAltitude sets the unknown value of altitude
+func (t *PropertyValue) SetUnknownAltitude(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &altitudeIntermediateType{}
+	tmp.unknown_ = i
+	t.altitude = tmp
+	t.markPresent_(1, true)
+
+}
+
+// AttachmentLen determines the number of elements able to be used for the IsAttachmentObject, GetAttachmentObject, and RemoveAttachmentObject functions
+func (t *PropertyValue) AttachmentLen() (l int) {
+	return len(t.attachment)
+
+}
+
+// IsAttachmentObject determines whether the call to GetAttachmentObject is safe for the specified index
+func (t *PropertyValue) IsAttachmentObject(index int) (ok bool) {
+	return t.attachment[index].Object != nil
+
+}
+
+// GetAttachmentObject returns the value safely if IsAttachmentObject returned true for the specified index
+func (t *PropertyValue) GetAttachmentObject(index int) (v ObjectType) {
+	return t.attachment[index].Object
+
+}
+
+// AppendAttachmentObject adds to the back of attachment a ObjectType type
+func (t *PropertyValue) AppendAttachmentObject(v ObjectType) {
+	t.attachment = append(t.attachment, &attachmentIntermediateType{Object: v})
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// PrependAttachmentObject adds to the front of attachment a ObjectType type
+func (t *PropertyValue) PrependAttachmentObject(v ObjectType) {
+	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Object: v}}, t.attachment...)
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// RemoveAttachmentObject deletes the value from the specified index
+func (t *PropertyValue) RemoveAttachmentObject(index int) {
+	copy(t.attachment[index:], t.attachment[index+1:])
+	t.attachment[len(t.attachment)-1] = nil
+	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// AttachmentObjectValues returns an iterator over the values that GetAttachmentObject returns for each index where IsAttachmentObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) AttachmentObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentObject(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAttachmentLink determines whether the call to GetAttachmentLink is safe for the specified index
+func (t *PropertyValue) IsAttachmentLink(index int) (ok bool) {
+	return t.attachment[index].Link != nil
+
+}
+
+// GetAttachmentLink returns the value safely if IsAttachmentLink returned true for the specified index
+func (t *PropertyValue) GetAttachmentLink(index int) (v LinkType) {
+	return t.attachment[index].Link
+
+}
+
+// AppendAttachmentLink adds to the back of attachment a LinkType type
+func (t *PropertyValue) AppendAttachmentLink(v LinkType) {
+	t.attachment = append(t.attachment, &attachmentIntermediateType{Link: v})
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// PrependAttachmentLink adds to the front of attachment a LinkType type
+func (t *PropertyValue) PrependAttachmentLink(v LinkType) {
+	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Link: v}}, t.attachment...)
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// RemoveAttachmentLink deletes the value from the specified index
+func (t *PropertyValue) RemoveAttachmentLink(index int) {
+	copy(t.attachment[index:], t.attachment[index+1:])
+	t.attachment[len(t.attachment)-1] = nil
+	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// AttachmentLinkValues returns an iterator over the values that GetAttachmentLink returns for each index where IsAttachmentLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) AttachmentLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentLink(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAttachmentIRI determines whether the call to GetAttachmentIRI is safe for the specified index
+func (t *PropertyValue) IsAttachmentIRI(index int) (ok bool) {
+	return t.attachment[index].IRI != nil
+
+}
+
+// GetAttachmentIRI returns the value safely if IsAttachmentIRI returned true for the specified index
+func (t *PropertyValue) GetAttachmentIRI(index int) (v *url.URL) {
+	return t.attachment[index].IRI
+
+}
+
+// AppendAttachmentIRI adds to the back of attachment a *url.URL type
+func (t *PropertyValue) AppendAttachmentIRI(v *url.URL) {
+	t.attachment = append(t.attachment, &attachmentIntermediateType{IRI: v})
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// PrependAttachmentIRI adds to the front of attachment a *url.URL type
+func (t *PropertyValue) PrependAttachmentIRI(v *url.URL) {
+	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{IRI: v}}, t.attachment...)
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// RemoveAttachmentIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveAttachmentIRI(index int) {
+	copy(t.attachment[index:], t.attachment[index+1:])
+	t.attachment[len(t.attachment)-1] = nil
+	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// AttachmentIRIValues returns an iterator over the values that GetAttachmentIRI returns for each index where IsAttachmentIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) AttachmentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownAttachment determines whether the call to GetUnknownAttachment is safe
+func (t *PropertyValue) HasUnknownAttachment() (ok bool) {
+	return t.present_[0]&(1<<2) != 0 && t.attachment[0].unknown_ != nil
+
+}
+
+// GetUnknownAttachment returns the unknown value for attachment
+func (t *PropertyValue) GetUnknownAttachment() (v interface{}) {
+	return t.attachment[0].unknown_
+
+}
+
+// SetUnknownAttachment sets the unknown value of attachment
+func (t *PropertyValue) SetUnknownAttachment(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &attachmentIntermediateType{}
+	tmp.unknown_ = i
+	t.attachment = append(t.attachment, tmp)
+	t.markPresent_(2, len(t.attachment) > 0)
+
+}
+
+// AttributedToLen determines the number of elements able to be used for the IsAttributedToObject, GetAttributedToObject, and RemoveAttributedToObject functions
+func (t *PropertyValue) AttributedToLen() (l int) {
+	return len(t.attributedTo)
+
+}
+
+// IsAttributedToObject determines whether the call to GetAttributedToObject is safe for the specified index
+func (t *PropertyValue) IsAttributedToObject(index int) (ok bool) {
+	return t.attributedTo[index].Object != nil
+
+}
+
+// GetAttributedToObject returns the value safely if IsAttributedToObject returned true for the specified index
+func (t *PropertyValue) GetAttributedToObject(index int) (v ObjectType) {
+	return t.attributedTo[index].Object
+
+}
+
+// AppendAttributedToObject adds to the back of attributedTo a ObjectType type
+func (t *PropertyValue) AppendAttributedToObject(v ObjectType) {
+	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Object: v})
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// PrependAttributedToObject adds to the front of attributedTo a ObjectType type
+func (t *PropertyValue) PrependAttributedToObject(v ObjectType) {
+	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Object: v}}, t.attributedTo...)
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// RemoveAttributedToObject deletes the value from the specified index
+func (t *PropertyValue) RemoveAttributedToObject(index int) {
+	copy(t.attributedTo[index:], t.attributedTo[index+1:])
+	t.attributedTo[len(t.attributedTo)-1] = nil
+	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToObjectValues returns an iterator over the values that GetAttributedToObject returns for each index where IsAttributedToObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) AttributedToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToObject(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAttributedToLink determines whether the call to GetAttributedToLink is safe for the specified index
+func (t *PropertyValue) IsAttributedToLink(index int) (ok bool) {
+	return t.attributedTo[index].Link != nil
+
+}
+
+// GetAttributedToLink returns the value safely if IsAttributedToLink returned true for the specified index
+func (t *PropertyValue) GetAttributedToLink(index int) (v LinkType) {
+	return t.attributedTo[index].Link
+
+}
+
+// AppendAttributedToLink adds to the back of attributedTo a LinkType type
+func (t *PropertyValue) AppendAttributedToLink(v LinkType) {
+	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Link: v})
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// PrependAttributedToLink adds to the front of attributedTo a LinkType type
+func (t *PropertyValue) PrependAttributedToLink(v LinkType) {
+	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Link: v}}, t.attributedTo...)
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// RemoveAttributedToLink deletes the value from the specified index
+func (t *PropertyValue) RemoveAttributedToLink(index int) {
+	copy(t.attributedTo[index:], t.attributedTo[index+1:])
+	t.attributedTo[len(t.attributedTo)-1] = nil
+	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToLinkValues returns an iterator over the values that GetAttributedToLink returns for each index where IsAttributedToLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) AttributedToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToLink(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAttributedToIRI determines whether the call to GetAttributedToIRI is safe for the specified index
+func (t *PropertyValue) IsAttributedToIRI(index int) (ok bool) {
+	return t.attributedTo[index].IRI != nil
+
+}
+
+// GetAttributedToIRI returns the value safely if IsAttributedToIRI returned true for the specified index
+func (t *PropertyValue) GetAttributedToIRI(index int) (v *url.URL) {
+	return t.attributedTo[index].IRI
+
+}
+
+// AppendAttributedToIRI adds to the back of attributedTo a *url.URL type
+func (t *PropertyValue) AppendAttributedToIRI(v *url.URL) {
+	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{IRI: v})
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// PrependAttributedToIRI adds to the front of attributedTo a *url.URL type
+func (t *PropertyValue) PrependAttributedToIRI(v *url.URL) {
+	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{IRI: v}}, t.attributedTo...)
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// RemoveAttributedToIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveAttributedToIRI(index int) {
+	copy(t.attributedTo[index:], t.attributedTo[index+1:])
+	t.attributedTo[len(t.attributedTo)-1] = nil
+	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToIRIValues returns an iterator over the values that GetAttributedToIRI returns for each index where IsAttributedToIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) AttributedToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownAttributedTo determines whether the call to GetUnknownAttributedTo is safe
+func (t *PropertyValue) HasUnknownAttributedTo() (ok bool) {
+	return t.present_[0]&(1<<3) != 0 && t.attributedTo[0].unknown_ != nil
+
+}
+
+// GetUnknownAttributedTo returns the unknown value for attributedTo
+func (t *PropertyValue) GetUnknownAttributedTo() (v interface{}) {
+	return t.attributedTo[0].unknown_
+
+}
+
+// SetUnknownAttributedTo sets the unknown value of attributedTo
+func (t *PropertyValue) SetUnknownAttributedTo(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &attributedToIntermediateType{}
+	tmp.unknown_ = i
+	t.attributedTo = append(t.attributedTo, tmp)
+	t.markPresent_(3, len(t.attributedTo) > 0)
+
+}
+
+// AudienceLen determines the number of elements able to be used for the IsAudienceObject, GetAudienceObject, and RemoveAudienceObject functions
+func (t *PropertyValue) AudienceLen() (l int) {
+	return len(t.audience)
+
+}
+
+// IsAudienceObject determines whether the call to GetAudienceObject is safe for the specified index
+func (t *PropertyValue) IsAudienceObject(index int) (ok bool) {
+	return t.audience[index].Object != nil
+
+}
+
+// GetAudienceObject returns the value safely if IsAudienceObject returned true for the specified index
+func (t *PropertyValue) GetAudienceObject(index int) (v ObjectType) {
+	return t.audience[index].Object
+
+}
+
+// AppendAudienceObject adds to the back of audience a ObjectType type
+func (t *PropertyValue) AppendAudienceObject(v ObjectType) {
+	t.audience = append(t.audience, &audienceIntermediateType{Object: v})
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// PrependAudienceObject adds to the front of audience a ObjectType type
+func (t *PropertyValue) PrependAudienceObject(v ObjectType) {
+	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Object: v}}, t.audience...)
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// RemoveAudienceObject deletes the value from the specified index
+func (t *PropertyValue) RemoveAudienceObject(index int) {
+	copy(t.audience[index:], t.audience[index+1:])
+	t.audience[len(t.audience)-1] = nil
+	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// AudienceObjectValues returns an iterator over the values that GetAudienceObject returns for each index where IsAudienceObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) AudienceObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceObject(i) {
+				continue
+			}
+			if !yield(t.GetAudienceObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAudienceLink determines whether the call to GetAudienceLink is safe for the specified index
+func (t *PropertyValue) IsAudienceLink(index int) (ok bool) {
+	return t.audience[index].Link != nil
+
+}
+
+// GetAudienceLink returns the value safely if IsAudienceLink returned true for the specified index
+func (t *PropertyValue) GetAudienceLink(index int) (v LinkType) {
+	return t.audience[index].Link
+
+}
+
+// AppendAudienceLink adds to the back of audience a LinkType type
+func (t *PropertyValue) AppendAudienceLink(v LinkType) {
+	t.audience = append(t.audience, &audienceIntermediateType{Link: v})
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// PrependAudienceLink adds to the front of audience a LinkType type
+func (t *PropertyValue) PrependAudienceLink(v LinkType) {
+	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Link: v}}, t.audience...)
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// RemoveAudienceLink deletes the value from the specified index
+func (t *PropertyValue) RemoveAudienceLink(index int) {
+	copy(t.audience[index:], t.audience[index+1:])
+	t.audience[len(t.audience)-1] = nil
+	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// AudienceLinkValues returns an iterator over the values that GetAudienceLink returns for each index where IsAudienceLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) AudienceLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceLink(i) {
+				continue
+			}
+			if !yield(t.GetAudienceLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAudienceIRI determines whether the call to GetAudienceIRI is safe for the specified index
+func (t *PropertyValue) IsAudienceIRI(index int) (ok bool) {
+	return t.audience[index].IRI != nil
+
+}
+
+// GetAudienceIRI returns the value safely if IsAudienceIRI returned true for the specified index
+func (t *PropertyValue) GetAudienceIRI(index int) (v *url.URL) {
+	return t.audience[index].IRI
+
+}
+
+// AppendAudienceIRI adds to the back of audience a *url.URL type
+func (t *PropertyValue) AppendAudienceIRI(v *url.URL) {
+	t.audience = append(t.audience, &audienceIntermediateType{IRI: v})
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// PrependAudienceIRI adds to the front of audience a *url.URL type
+func (t *PropertyValue) PrependAudienceIRI(v *url.URL) {
+	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{IRI: v}}, t.audience...)
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// RemoveAudienceIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveAudienceIRI(index int) {
+	copy(t.audience[index:], t.audience[index+1:])
+	t.audience[len(t.audience)-1] = nil
+	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// AudienceIRIValues returns an iterator over the values that GetAudienceIRI returns for each index where IsAudienceIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) AudienceIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceIRI(i) {
+				continue
+			}
+			if !yield(t.GetAudienceIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownAudience determines whether the call to GetUnknownAudience is safe
+func (t *PropertyValue) HasUnknownAudience() (ok bool) {
+	return t.present_[0]&(1<<4) != 0 && t.audience[0].unknown_ != nil
+
+}
+
+// GetUnknownAudience returns the unknown value for audience
+func (t *PropertyValue) GetUnknownAudience() (v interface{}) {
+	return t.audience[0].unknown_
+
+}
+
+// SetUnknownAudience sets the unknown value of audience
+func (t *PropertyValue) SetUnknownAudience(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &audienceIntermediateType{}
+	tmp.unknown_ = i
+	t.audience = append(t.audience, tmp)
+	t.markPresent_(4, len(t.audience) > 0)
+
+}
+
+// ContentLen determines the number of elements able to be used for the IsContentString, GetContentString, and RemoveContentString functions
+func (t *PropertyValue) ContentLen() (l int) {
+	return len(t.content)
+
+}
+
+// IsContentString determines whether the call to GetContentString is safe for the specified index
+func (t *PropertyValue) IsContentString(index int) (ok bool) {
+	return t.content[index].stringName != nil
+
+}
+
+// GetContentString returns the value safely if IsContentString returned true for the specified index
+func (t *PropertyValue) GetContentString(index int) (v string) {
+	return *t.content[index].stringName
+
+}
+
+// AppendContentString adds to the back of content a string type
+func (t *PropertyValue) AppendContentString(v string) {
+	t.content = append(t.content, &contentIntermediateType{stringName: &v})
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// PrependContentString adds to the front of content a string type
+func (t *PropertyValue) PrependContentString(v string) {
+	t.content = append([]*contentIntermediateType{&contentIntermediateType{stringName: &v}}, t.content...)
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// RemoveContentString deletes the value from the specified index
+func (t *PropertyValue) RemoveContentString(index int) {
+	copy(t.content[index:], t.content[index+1:])
+	t.content[len(t.content)-1] = nil
+	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// ContentStringValues returns an iterator over the values that GetContentString returns for each index where IsContentString is true, which can be used as an iter.Seq
+func (t *PropertyValue) ContentStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentString(i) {
+				continue
+			}
+			if !yield(t.GetContentString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsContentLangString determines whether the call to GetContentLangString is safe for the specified index
+func (t *PropertyValue) IsContentLangString(index int) (ok bool) {
+	return t.content[index].langString != nil
+
+}
+
+// GetContentLangString returns the value safely if IsContentLangString returned true for the specified index
+func (t *PropertyValue) GetContentLangString(index int) (v string) {
+	return *t.content[index].langString
+
+}
+
+// AppendContentLangString adds to the back of content a string type
+func (t *PropertyValue) AppendContentLangString(v string) {
+	t.content = append(t.content, &contentIntermediateType{langString: &v})
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// PrependContentLangString adds to the front of content a string type
+func (t *PropertyValue) PrependContentLangString(v string) {
+	t.content = append([]*contentIntermediateType{&contentIntermediateType{langString: &v}}, t.content...)
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// RemoveContentLangString deletes the value from the specified index
+func (t *PropertyValue) RemoveContentLangString(index int) {
+	copy(t.content[index:], t.content[index+1:])
+	t.content[len(t.content)-1] = nil
+	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// ContentLangStringValues returns an iterator over the values that GetContentLangString returns for each index where IsContentLangString is true, which can be used as an iter.Seq
+func (t *PropertyValue) ContentLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentLangString(i) {
+				continue
+			}
+			if !yield(t.GetContentLangString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsContentIRI determines whether the call to GetContentIRI is safe for the specified index
+func (t *PropertyValue) IsContentIRI(index int) (ok bool) {
+	return t.content[index].IRI != nil
+
+}
+
+// GetContentIRI returns the value safely if IsContentIRI returned true for the specified index
+func (t *PropertyValue) GetContentIRI(index int) (v *url.URL) {
+	return t.content[index].IRI
+
+}
+
+// AppendContentIRI adds to the back of content a *url.URL type
+func (t *PropertyValue) AppendContentIRI(v *url.URL) {
+	t.content = append(t.content, &contentIntermediateType{IRI: v})
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// PrependContentIRI adds to the front of content a *url.URL type
+func (t *PropertyValue) PrependContentIRI(v *url.URL) {
+	t.content = append([]*contentIntermediateType{&contentIntermediateType{IRI: v}}, t.content...)
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// RemoveContentIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveContentIRI(index int) {
+	copy(t.content[index:], t.content[index+1:])
+	t.content[len(t.content)-1] = nil
+	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// ContentIRIValues returns an iterator over the values that GetContentIRI returns for each index where IsContentIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) ContentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentIRI(i) {
+				continue
+			}
+			if !yield(t.GetContentIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownContent determines whether the call to GetUnknownContent is safe
+func (t *PropertyValue) HasUnknownContent() (ok bool) {
+	return t.present_[0]&(1<<5) != 0 && t.content[0].unknown_ != nil
+
+}
+
+// GetUnknownContent returns the unknown value for content
+func (t *PropertyValue) GetUnknownContent() (v interface{}) {
+	return t.content[0].unknown_
+
+}
+
+// SetUnknownContent sets the unknown value of content
+func (t *PropertyValue) SetUnknownContent(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &contentIntermediateType{}
+	tmp.unknown_ = i
+	t.content = append(t.content, tmp)
+	t.markPresent_(5, len(t.content) > 0)
+
+}
+
+// ContentMapLanguages returns all languages for this property's language mapping, or nil if there are none.
+func (t *PropertyValue) ContentMapLanguages() (l []string) {
+	if t.contentMap == nil || len(t.contentMap) == 0 {
+		return nil
+	}
+	for k := range t.contentMap {
+		l = append(l, k)
+	}
+	return
+
+}
+
+// GetContentMap retrieves the value of the property for the specified language, or an empty string if it does not exist
+func (t *PropertyValue) GetContentMap(l string) (v string) {
+	if t.contentMap == nil {
+		return ""
+	}
+	ok := false
+	v, ok = t.contentMap[l]
+	if !ok {
+		return ""
+	}
+	return v
+
+}
+
+// SetContentMap sets the value of the property for the specified language
+func (t *PropertyValue) SetContentMap(l string, v string) {
+	if t.contentMap == nil {
+		t.contentMap = make(map[string]string)
+		t.markPresent_(6, true)
+	}
+	t.contentMap[l] = v
+
+}
+
+// GetContentLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *PropertyValue) GetContentLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.contentMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.contentMap[k], true
+
+}
+
+// SetContentLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *PropertyValue) SetContentLanguage(tag string, v string) {
+	if t.contentMap == nil {
+		t.contentMap = make(map[string]string)
+		t.markPresent_(6, true)
+	} else if k, ok := languageKey(t.contentMap, tag); ok {
+		delete(t.contentMap, k)
+	}
+	t.contentMap[tag] = v
+
+}
+
+// PreferredContentLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *PropertyValue) PreferredContentLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.contentMap, tags)
+	return t.contentMap[tag], tag
+
+}
+
+// ContextLen determines the number of elements able to be used for the IsContextObject, GetContextObject, and RemoveContextObject functions
+func (t *PropertyValue) ContextLen() (l int) {
+	return len(t.context)
+
+}
+
+// IsContextObject determines whether the call to GetContextObject is safe for the specified index
+func (t *PropertyValue) IsContextObject(index int) (ok bool) {
+	return t.context[index].Object != nil
+
+}
+
+// GetContextObject returns the value safely if IsContextObject returned true for the specified index
+func (t *PropertyValue) GetContextObject(index int) (v ObjectType) {
+	return t.context[index].Object
+
+}
+
+// AppendContextObject adds to the back of context a ObjectType type
+func (t *PropertyValue) AppendContextObject(v ObjectType) {
+	t.context = append(t.context, &contextIntermediateType{Object: v})
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// PrependContextObject adds to the front of context a ObjectType type
+func (t *PropertyValue) PrependContextObject(v ObjectType) {
+	t.context = append([]*contextIntermediateType{&contextIntermediateType{Object: v}}, t.context...)
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// RemoveContextObject deletes the value from the specified index
+func (t *PropertyValue) RemoveContextObject(index int) {
+	copy(t.context[index:], t.context[index+1:])
+	t.context[len(t.context)-1] = nil
+	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// ContextObjectValues returns an iterator over the values that GetContextObject returns for each index where IsContextObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) ContextObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextObject(i) {
+				continue
+			}
+			if !yield(t.GetContextObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsContextLink determines whether the call to GetContextLink is safe for the specified index
+func (t *PropertyValue) IsContextLink(index int) (ok bool) {
+	return t.context[index].Link != nil
+
+}
+
+// GetContextLink returns the value safely if IsContextLink returned true for the specified index
+func (t *PropertyValue) GetContextLink(index int) (v LinkType) {
+	return t.context[index].Link
+
+}
+
+// AppendContextLink adds to the back of context a LinkType type
+func (t *PropertyValue) AppendContextLink(v LinkType) {
+	t.context = append(t.context, &contextIntermediateType{Link: v})
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// PrependContextLink adds to the front of context a LinkType type
+func (t *PropertyValue) PrependContextLink(v LinkType) {
+	t.context = append([]*contextIntermediateType{&contextIntermediateType{Link: v}}, t.context...)
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// RemoveContextLink deletes the value from the specified index
+func (t *PropertyValue) RemoveContextLink(index int) {
+	copy(t.context[index:], t.context[index+1:])
+	t.context[len(t.context)-1] = nil
+	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// ContextLinkValues returns an iterator over the values that GetContextLink returns for each index where IsContextLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) ContextLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextLink(i) {
+				continue
+			}
+			if !yield(t.GetContextLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsContextIRI determines whether the call to GetContextIRI is safe for the specified index
+func (t *PropertyValue) IsContextIRI(index int) (ok bool) {
+	return t.context[index].IRI != nil
+
+}
+
+// GetContextIRI returns the value safely if IsContextIRI returned true for the specified index
+func (t *PropertyValue) GetContextIRI(index int) (v *url.URL) {
+	return t.context[index].IRI
+
+}
+
+// AppendContextIRI adds to the back of context a *url.URL type
+func (t *PropertyValue) AppendContextIRI(v *url.URL) {
+	t.context = append(t.context, &contextIntermediateType{IRI: v})
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// PrependContextIRI adds to the front of context a *url.URL type
+func (t *PropertyValue) PrependContextIRI(v *url.URL) {
+	t.context = append([]*contextIntermediateType{&contextIntermediateType{IRI: v}}, t.context...)
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// RemoveContextIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveContextIRI(index int) {
+	copy(t.context[index:], t.context[index+1:])
+	t.context[len(t.context)-1] = nil
+	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// ContextIRIValues returns an iterator over the values that GetContextIRI returns for each index where IsContextIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) ContextIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextIRI(i) {
+				continue
+			}
+			if !yield(t.GetContextIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownContext determines whether the call to GetUnknownContext is safe
+func (t *PropertyValue) HasUnknownContext() (ok bool) {
+	return t.present_[0]&(1<<7) != 0 && t.context[0].unknown_ != nil
+
+}
+
+// GetUnknownContext returns the unknown value for context
+func (t *PropertyValue) GetUnknownContext() (v interface{}) {
+	return t.context[0].unknown_
+
+}
+
+// SetUnknownContext sets the unknown value of context
+func (t *PropertyValue) SetUnknownContext(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &contextIntermediateType{}
+	tmp.unknown_ = i
+	t.context = append(t.context, tmp)
+	t.markPresent_(7, len(t.context) > 0)
+
+}
+
+// NameLen determines the number of elements able to be used for the IsNameString, GetNameString, and RemoveNameString functions
+func (t *PropertyValue) NameLen() (l int) {
+	return len(t.name)
+
+}
+
+// IsNameString determines whether the call to GetNameString is safe for the specified index
+func (t *PropertyValue) IsNameString(index int) (ok bool) {
+	return t.name[index].stringName != nil
+
+}
+
+// GetNameString returns the value safely if IsNameString returned true for the specified index
+func (t *PropertyValue) GetNameString(index int) (v string) {
+	return *t.name[index].stringName
+
+}
+
+// AppendNameString adds to the back of name a string type
+func (t *PropertyValue) AppendNameString(v string) {
+	t.name = append(t.name, &nameIntermediateType{stringName: &v})
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// PrependNameString adds to the front of name a string type
+func (t *PropertyValue) PrependNameString(v string) {
+	t.name = append([]*nameIntermediateType{&nameIntermediateType{stringName: &v}}, t.name...)
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// RemoveNameString deletes the value from the specified index
+func (t *PropertyValue) RemoveNameString(index int) {
+	copy(t.name[index:], t.name[index+1:])
+	t.name[len(t.name)-1] = nil
+	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// NameStringValues returns an iterator over the values that GetNameString returns for each index where IsNameString is true, which can be used as an iter.Seq
+func (t *PropertyValue) NameStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameString(i) {
+				continue
+			}
+			if !yield(t.GetNameString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsNameLangString determines whether the call to GetNameLangString is safe for the specified index
+func (t *PropertyValue) IsNameLangString(index int) (ok bool) {
+	return t.name[index].langString != nil
+
+}
+
+// GetNameLangString returns the value safely if IsNameLangString returned true for the specified index
+func (t *PropertyValue) GetNameLangString(index int) (v string) {
+	return *t.name[index].langString
+
+}
+
+// AppendNameLangString adds to the back of name a string type
+func (t *PropertyValue) AppendNameLangString(v string) {
+	t.name = append(t.name, &nameIntermediateType{langString: &v})
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// PrependNameLangString adds to the front of name a string type
+func (t *PropertyValue) PrependNameLangString(v string) {
+	t.name = append([]*nameIntermediateType{&nameIntermediateType{langString: &v}}, t.name...)
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// RemoveNameLangString deletes the value from the specified index
+func (t *PropertyValue) RemoveNameLangString(index int) {
+	copy(t.name[index:], t.name[index+1:])
+	t.name[len(t.name)-1] = nil
+	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// NameLangStringValues returns an iterator over the values that GetNameLangString returns for each index where IsNameLangString is true, which can be used as an iter.Seq
+func (t *PropertyValue) NameLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameLangString(i) {
+				continue
+			}
+			if !yield(t.GetNameLangString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsNameIRI determines whether the call to GetNameIRI is safe for the specified index
+func (t *PropertyValue) IsNameIRI(index int) (ok bool) {
+	return t.name[index].IRI != nil
+
+}
+
+// GetNameIRI returns the value safely if IsNameIRI returned true for the specified index
+func (t *PropertyValue) GetNameIRI(index int) (v *url.URL) {
+	return t.name[index].IRI
+
+}
+
+// AppendNameIRI adds to the back of name a *url.URL type
+func (t *PropertyValue) AppendNameIRI(v *url.URL) {
+	t.name = append(t.name, &nameIntermediateType{IRI: v})
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// PrependNameIRI adds to the front of name a *url.URL type
+func (t *PropertyValue) PrependNameIRI(v *url.URL) {
+	t.name = append([]*nameIntermediateType{&nameIntermediateType{IRI: v}}, t.name...)
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// RemoveNameIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveNameIRI(index int) {
+	copy(t.name[index:], t.name[index+1:])
+	t.name[len(t.name)-1] = nil
+	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// NameIRIValues returns an iterator over the values that GetNameIRI returns for each index where IsNameIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) NameIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameIRI(i) {
+				continue
+			}
+			if !yield(t.GetNameIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownName determines whether the call to GetUnknownName is safe
+func (t *PropertyValue) HasUnknownName() (ok bool) {
+	return t.present_[0]&(1<<8) != 0 && t.name[0].unknown_ != nil
+
+}
+
+// GetUnknownName returns the unknown value for name
+func (t *PropertyValue) GetUnknownName() (v interface{}) {
+	return t.name[0].unknown_
+
+}
+
+// SetUnknownName sets the unknown value of name
+func (t *PropertyValue) SetUnknownName(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &nameIntermediateType{}
+	tmp.unknown_ = i
+	t.name = append(t.name, tmp)
+	t.markPresent_(8, len(t.name) > 0)
+
+}
+
+// NameMapLanguages returns all languages for this property's language mapping, or nil if there are none.
+func (t *PropertyValue) NameMapLanguages() (l []string) {
+	if t.nameMap == nil || len(t.nameMap) == 0 {
+		return nil
+	}
+	for k := range t.nameMap {
+		l = append(l, k)
+	}
+	return
+
+}
+
+// GetNameMap retrieves the value of the property for the specified language, or an empty string if it does not exist
+func (t *PropertyValue) GetNameMap(l string) (v string) {
+	if t.nameMap == nil {
+		return ""
+	}
+	ok := false
+	v, ok = t.nameMap[l]
+	if !ok {
+		return ""
+	}
+	return v
+
+}
+
+// SetNameMap sets the value of the property for the specified language
+func (t *PropertyValue) SetNameMap(l string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(9, true)
+	}
+	t.nameMap[l] = v
+
+}
+
+// GetNameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *PropertyValue) GetNameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.nameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.nameMap[k], true
+
+}
+
+// SetNameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *PropertyValue) SetNameLanguage(tag string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(9, true)
+	} else if k, ok := languageKey(t.nameMap, tag); ok {
+		delete(t.nameMap, k)
+	}
+	t.nameMap[tag] = v
+
+}
+
+// PreferredNameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *PropertyValue) PreferredNameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.nameMap, tags)
+	return t.nameMap[tag], tag
+
+}
+
+// IsEndTime determines whether the call to GetEndTime is safe
+func (t *PropertyValue) IsEndTime() (ok bool) {
+	return t.present_[0]&(1<<10) != 0 && t.endTime.dateTime != nil
+
+}
+
+// GetEndTime returns the value safely if IsEndTime returned true
+func (t *PropertyValue) GetEndTime() (v time.Time) {
+	return *t.endTime.dateTime
+
+}
+
+// SetEndTime sets the value of endTime to be of time.Time type
+func (t *PropertyValue) SetEndTime(v time.Time) {
+	t.endTime = &endTimeIntermediateType{dateTime: &v}
+	t.markPresent_(10, true)
+
+}
+
+// IsEndTimeIRI determines whether the call to GetEndTimeIRI is safe
+func (t *PropertyValue) IsEndTimeIRI() (ok bool) {
+	return t.present_[0]&(1<<10) != 0 && t.endTime.IRI != nil
+
+}
+
+// GetEndTimeIRI returns the value safely if IsEndTimeIRI returned true
+func (t *PropertyValue) GetEndTimeIRI() (v *url.URL) {
+	return t.endTime.IRI
+
+}
+
+// SetEndTimeIRI sets the value of endTime to be of *url.URL type
+func (t *PropertyValue) SetEndTimeIRI(v *url.URL) {
+	t.endTime = &endTimeIntermediateType{IRI: v}
+	t.markPresent_(10, true)
+
+}
+
+// HasUnknownEndTime determines whether the call to GetUnknownEndTime is safe
+func (t *PropertyValue) HasUnknownEndTime() (ok bool) {
+	return t.present_[0]&(1<<10) != 0 && t.endTime.unknown_ != nil
+
+}
+
+// GetUnknownEndTime returns the unknown value for endTime
+func (t *PropertyValue) GetUnknownEndTime() (v interface{}) {
+	return t.endTime.unknown_
+
+}
+
+// SetUnknownEndTime sets the unknown value of endTime
+func (t *PropertyValue) SetUnknownEndTime(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &endTimeIntermediateType{}
+	tmp.unknown_ = i
+	t.endTime = tmp
+	t.markPresent_(10, true)
+
+}
+
+// GeneratorLen determines the number of elements able to be used for the IsGeneratorObject, GetGeneratorObject, and RemoveGeneratorObject functions
+func (t *PropertyValue) GeneratorLen() (l int) {
+	return len(t.generator)
+
+}
+
+// IsGeneratorObject determines whether the call to GetGeneratorObject is safe for the specified index
+func (t *PropertyValue) IsGeneratorObject(index int) (ok bool) {
+	return t.generator[index].Object != nil
+
+}
+
+// GetGeneratorObject returns the value safely if IsGeneratorObject returned true for the specified index
+func (t *PropertyValue) GetGeneratorObject(index int) (v ObjectType) {
+	return t.generator[index].Object
+
+}
+
+// AppendGeneratorObject adds to the back of generator a ObjectType type
+func (t *PropertyValue) AppendGeneratorObject(v ObjectType) {
+	t.generator = append(t.generator, &generatorIntermediateType{Object: v})
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// PrependGeneratorObject adds to the front of generator a ObjectType type
+func (t *PropertyValue) PrependGeneratorObject(v ObjectType) {
+	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Object: v}}, t.generator...)
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// RemoveGeneratorObject deletes the value from the specified index
+func (t *PropertyValue) RemoveGeneratorObject(index int) {
+	copy(t.generator[index:], t.generator[index+1:])
+	t.generator[len(t.generator)-1] = nil
+	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// GeneratorObjectValues returns an iterator over the values that GetGeneratorObject returns for each index where IsGeneratorObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) GeneratorObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorObject(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsGeneratorLink determines whether the call to GetGeneratorLink is safe for the specified index
+func (t *PropertyValue) IsGeneratorLink(index int) (ok bool) {
+	return t.generator[index].Link != nil
+
+}
+
+// GetGeneratorLink returns the value safely if IsGeneratorLink returned true for the specified index
+func (t *PropertyValue) GetGeneratorLink(index int) (v LinkType) {
+	return t.generator[index].Link
+
+}
+
+// AppendGeneratorLink adds to the back of generator a LinkType type
+func (t *PropertyValue) AppendGeneratorLink(v LinkType) {
+	t.generator = append(t.generator, &generatorIntermediateType{Link: v})
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// PrependGeneratorLink adds to the front of generator a LinkType type
+func (t *PropertyValue) PrependGeneratorLink(v LinkType) {
+	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Link: v}}, t.generator...)
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// RemoveGeneratorLink deletes the value from the specified index
+func (t *PropertyValue) RemoveGeneratorLink(index int) {
+	copy(t.generator[index:], t.generator[index+1:])
+	t.generator[len(t.generator)-1] = nil
+	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// GeneratorLinkValues returns an iterator over the values that GetGeneratorLink returns for each index where IsGeneratorLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) GeneratorLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorLink(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsGeneratorIRI determines whether the call to GetGeneratorIRI is safe for the specified index
+func (t *PropertyValue) IsGeneratorIRI(index int) (ok bool) {
+	return t.generator[index].IRI != nil
+
+}
+
+// GetGeneratorIRI returns the value safely if IsGeneratorIRI returned true for the specified index
+func (t *PropertyValue) GetGeneratorIRI(index int) (v *url.URL) {
+	return t.generator[index].IRI
+
+}
+
+// AppendGeneratorIRI adds to the back of generator a *url.URL type
+func (t *PropertyValue) AppendGeneratorIRI(v *url.URL) {
+	t.generator = append(t.generator, &generatorIntermediateType{IRI: v})
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// PrependGeneratorIRI adds to the front of generator a *url.URL type
+func (t *PropertyValue) PrependGeneratorIRI(v *url.URL) {
+	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{IRI: v}}, t.generator...)
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// RemoveGeneratorIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveGeneratorIRI(index int) {
+	copy(t.generator[index:], t.generator[index+1:])
+	t.generator[len(t.generator)-1] = nil
+	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// GeneratorIRIValues returns an iterator over the values that GetGeneratorIRI returns for each index where IsGeneratorIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) GeneratorIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorIRI(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownGenerator determines whether the call to GetUnknownGenerator is safe
+func (t *PropertyValue) HasUnknownGenerator() (ok bool) {
+	return t.present_[0]&(1<<11) != 0 && t.generator[0].unknown_ != nil
+
+}
+
+// GetUnknownGenerator returns the unknown value for generator
+func (t *PropertyValue) GetUnknownGenerator() (v interface{}) {
+	return t.generator[0].unknown_
+
+}
+
+// SetUnknownGenerator sets the unknown value of generator
+func (t *PropertyValue) SetUnknownGenerator(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &generatorIntermediateType{}
+	tmp.unknown_ = i
+	t.generator = append(t.generator, tmp)
+	t.markPresent_(11, len(t.generator) > 0)
+
+}
+
+// IconLen determines the number of elements able to be used for the IsIconImage, GetIconImage, and RemoveIconImage functions
+func (t *PropertyValue) IconLen() (l int) {
+	return len(t.icon)
+
+}
+
+// IsIconImage determines whether the call to GetIconImage is safe for the specified index
+func (t *PropertyValue) IsIconImage(index int) (ok bool) {
+	return t.icon[index].Image != nil
+
+}
+
+// GetIconImage returns the value safely if IsIconImage returned true for the specified index
+func (t *PropertyValue) GetIconImage(index int) (v ImageType) {
+	return t.icon[index].Image
+
+}
+
+// AppendIconImage adds to the back of icon a ImageType type
+func (t *PropertyValue) AppendIconImage(v ImageType) {
+	t.icon = append(t.icon, &iconIntermediateType{Image: v})
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// PrependIconImage adds to the front of icon a ImageType type
+func (t *PropertyValue) PrependIconImage(v ImageType) {
+	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Image: v}}, t.icon...)
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// RemoveIconImage deletes the value from the specified index
+func (t *PropertyValue) RemoveIconImage(index int) {
+	copy(t.icon[index:], t.icon[index+1:])
+	t.icon[len(t.icon)-1] = nil
+	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// IconImageValues returns an iterator over the values that GetIconImage returns for each index where IsIconImage is true, which can be used as an iter.Seq
+func (t *PropertyValue) IconImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconImage(i) {
+				continue
+			}
+			if !yield(t.GetIconImage(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsIconLink determines whether the call to GetIconLink is safe for the specified index
+func (t *PropertyValue) IsIconLink(index int) (ok bool) {
+	return t.icon[index].Link != nil
+
+}
+
+// GetIconLink returns the value safely if IsIconLink returned true for the specified index
+func (t *PropertyValue) GetIconLink(index int) (v LinkType) {
+	return t.icon[index].Link
+
+}
+
+// AppendIconLink adds to the back of icon a LinkType type
+func (t *PropertyValue) AppendIconLink(v LinkType) {
+	t.icon = append(t.icon, &iconIntermediateType{Link: v})
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// PrependIconLink adds to the front of icon a LinkType type
+func (t *PropertyValue) PrependIconLink(v LinkType) {
+	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Link: v}}, t.icon...)
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// RemoveIconLink deletes the value from the specified index
+func (t *PropertyValue) RemoveIconLink(index int) {
+	copy(t.icon[index:], t.icon[index+1:])
+	t.icon[len(t.icon)-1] = nil
+	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// IconLinkValues returns an iterator over the values that GetIconLink returns for each index where IsIconLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) IconLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconLink(i) {
+				continue
+			}
+			if !yield(t.GetIconLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsIconIRI determines whether the call to GetIconIRI is safe for the specified index
+func (t *PropertyValue) IsIconIRI(index int) (ok bool) {
+	return t.icon[index].IRI != nil
+
+}
+
+// GetIconIRI returns the value safely if IsIconIRI returned true for the specified index
+func (t *PropertyValue) GetIconIRI(index int) (v *url.URL) {
+	return t.icon[index].IRI
+
+}
+
+// AppendIconIRI adds to the back of icon a *url.URL type
+func (t *PropertyValue) AppendIconIRI(v *url.URL) {
+	t.icon = append(t.icon, &iconIntermediateType{IRI: v})
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// PrependIconIRI adds to the front of icon a *url.URL type
+func (t *PropertyValue) PrependIconIRI(v *url.URL) {
+	t.icon = append([]*iconIntermediateType{&iconIntermediateType{IRI: v}}, t.icon...)
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// RemoveIconIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveIconIRI(index int) {
+	copy(t.icon[index:], t.icon[index+1:])
+	t.icon[len(t.icon)-1] = nil
+	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// IconIRIValues returns an iterator over the values that GetIconIRI returns for each index where IsIconIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) IconIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconIRI(i) {
+				continue
+			}
+			if !yield(t.GetIconIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownIcon determines whether the call to GetUnknownIcon is safe
+func (t *PropertyValue) HasUnknownIcon() (ok bool) {
+	return t.present_[0]&(1<<12) != 0 && t.icon[0].unknown_ != nil
+
+}
+
+// GetUnknownIcon returns the unknown value for icon
+func (t *PropertyValue) GetUnknownIcon() (v interface{}) {
+	return t.icon[0].unknown_
+
+}
+
+// SetUnknownIcon sets the unknown value of icon
+func (t *PropertyValue) SetUnknownIcon(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &iconIntermediateType{}
+	tmp.unknown_ = i
+	t.icon = append(t.icon, tmp)
+	t.markPresent_(12, len(t.icon) > 0)
+
+}
+
+// HasId determines whether the call to GetId is safe
+func (t *PropertyValue) HasId() (ok bool) {
+	return t.present_[0]&(1<<13) != 0
+
+}
+
+// GetId returns the value for id
+func (t *PropertyValue) GetId() (v *url.URL) {
+	return t.id
+
+}
+
+// SetId sets the value of id
+func (t *PropertyValue) SetId(v *url.URL) {
+	t.id = v
+	t.markPresent_(13, true)
+
+}
+
+// HasUnknownId determines whether the call to GetUnknownId is safe
+func (t *PropertyValue) HasUnknownId() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["id"] != nil
+
+}
+
+// GetUnknownId returns the unknown value for id
+func (t *PropertyValue) GetUnknownId() (v interface{}) {
+	return t.unknown_["id"]
+
+}
+
+// SetUnknownId sets the unknown value of id
+func (t *PropertyValue) SetUnknownId(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["id"] = i
+
+}
+
+// ImageLen determines the number of elements able to be used for the IsImageImage, GetImageImage, and RemoveImageImage functions
+func (t *PropertyValue) ImageLen() (l int) {
+	return len(t.image)
+
+}
+
+// IsImageImage determines whether the call to GetImageImage is safe for the specified index
+func (t *PropertyValue) IsImageImage(index int) (ok bool) {
+	return t.image[index].Image != nil
+
+}
+
+// GetImageImage returns the value safely if IsImageImage returned true for the specified index
+func (t *PropertyValue) GetImageImage(index int) (v ImageType) {
+	return t.image[index].Image
+
+}
+
+// AppendImageImage adds to the back of image a ImageType type
+func (t *PropertyValue) AppendImageImage(v ImageType) {
+	t.image = append(t.image, &imageIntermediateType{Image: v})
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// PrependImageImage adds to the front of image a ImageType type
+func (t *PropertyValue) PrependImageImage(v ImageType) {
+	t.image = append([]*imageIntermediateType{&imageIntermediateType{Image: v}}, t.image...)
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// RemoveImageImage deletes the value from the specified index
+func (t *PropertyValue) RemoveImageImage(index int) {
+	copy(t.image[index:], t.image[index+1:])
+	t.image[len(t.image)-1] = nil
+	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// ImageImageValues returns an iterator over the values that GetImageImage returns for each index where IsImageImage is true, which can be used as an iter.Seq
+func (t *PropertyValue) ImageImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageImage(i) {
+				continue
+			}
+			if !yield(t.GetImageImage(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsImageLink determines whether the call to GetImageLink is safe for the specified index
+func (t *PropertyValue) IsImageLink(index int) (ok bool) {
+	return t.image[index].Link != nil
+
+}
+
+// GetImageLink returns the value safely if IsImageLink returned true for the specified index
+func (t *PropertyValue) GetImageLink(index int) (v LinkType) {
+	return t.image[index].Link
+
+}
+
+// AppendImageLink adds to the back of image a LinkType type
+func (t *PropertyValue) AppendImageLink(v LinkType) {
+	t.image = append(t.image, &imageIntermediateType{Link: v})
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// PrependImageLink adds to the front of image a LinkType type
+func (t *PropertyValue) PrependImageLink(v LinkType) {
+	t.image = append([]*imageIntermediateType{&imageIntermediateType{Link: v}}, t.image...)
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// RemoveImageLink deletes the value from the specified index
+func (t *PropertyValue) RemoveImageLink(index int) {
+	copy(t.image[index:], t.image[index+1:])
+	t.image[len(t.image)-1] = nil
+	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// ImageLinkValues returns an iterator over the values that GetImageLink returns for each index where IsImageLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) ImageLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageLink(i) {
+				continue
+			}
+			if !yield(t.GetImageLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsImageIRI determines whether the call to GetImageIRI is safe for the specified index
+func (t *PropertyValue) IsImageIRI(index int) (ok bool) {
+	return t.image[index].IRI != nil
+
+}
+
+// GetImageIRI returns the value safely if IsImageIRI returned true for the specified index
+func (t *PropertyValue) GetImageIRI(index int) (v *url.URL) {
+	return t.image[index].IRI
+
+}
+
+// AppendImageIRI adds to the back of image a *url.URL type
+func (t *PropertyValue) AppendImageIRI(v *url.URL) {
+	t.image = append(t.image, &imageIntermediateType{IRI: v})
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// PrependImageIRI adds to the front of image a *url.URL type
+func (t *PropertyValue) PrependImageIRI(v *url.URL) {
+	t.image = append([]*imageIntermediateType{&imageIntermediateType{IRI: v}}, t.image...)
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// RemoveImageIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveImageIRI(index int) {
+	copy(t.image[index:], t.image[index+1:])
+	t.image[len(t.image)-1] = nil
+	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// ImageIRIValues returns an iterator over the values that GetImageIRI returns for each index where IsImageIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) ImageIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageIRI(i) {
+				continue
+			}
+			if !yield(t.GetImageIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownImage determines whether the call to GetUnknownImage is safe
+func (t *PropertyValue) HasUnknownImage() (ok bool) {
+	return t.present_[0]&(1<<14) != 0 && t.image[0].unknown_ != nil
+
+}
+
+// GetUnknownImage returns the unknown value for image
+func (t *PropertyValue) GetUnknownImage() (v interface{}) {
+	return t.image[0].unknown_
+
+}
+
+// SetUnknownImage sets the unknown value of image
+func (t *PropertyValue) SetUnknownImage(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &imageIntermediateType{}
+	tmp.unknown_ = i
+	t.image = append(t.image, tmp)
+	t.markPresent_(14, len(t.image) > 0)
+
+}
+
+// InReplyToLen determines the number of elements able to be used for the IsInReplyToObject, GetInReplyToObject, and RemoveInReplyToObject functions
+func (t *PropertyValue) InReplyToLen() (l int) {
+	return len(t.inReplyTo)
+
+}
+
+// IsInReplyToObject determines whether the call to GetInReplyToObject is safe for the specified index
+func (t *PropertyValue) IsInReplyToObject(index int) (ok bool) {
+	return t.inReplyTo[index].Object != nil
+
+}
+
+// GetInReplyToObject returns the value safely if IsInReplyToObject returned true for the specified index
+func (t *PropertyValue) GetInReplyToObject(index int) (v ObjectType) {
+	return t.inReplyTo[index].Object
+
+}
+
+// AppendInReplyToObject adds to the back of inReplyTo a ObjectType type
+func (t *PropertyValue) AppendInReplyToObject(v ObjectType) {
+	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Object: v})
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// PrependInReplyToObject adds to the front of inReplyTo a ObjectType type
+func (t *PropertyValue) PrependInReplyToObject(v ObjectType) {
+	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Object: v}}, t.inReplyTo...)
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// RemoveInReplyToObject deletes the value from the specified index
+func (t *PropertyValue) RemoveInReplyToObject(index int) {
+	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
+	t.inReplyTo[len(t.inReplyTo)-1] = nil
+	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToObjectValues returns an iterator over the values that GetInReplyToObject returns for each index where IsInReplyToObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) InReplyToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToObject(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsInReplyToLink determines whether the call to GetInReplyToLink is safe for the specified index
+func (t *PropertyValue) IsInReplyToLink(index int) (ok bool) {
+	return t.inReplyTo[index].Link != nil
+
+}
+
+// GetInReplyToLink returns the value safely if IsInReplyToLink returned true for the specified index
+func (t *PropertyValue) GetInReplyToLink(index int) (v LinkType) {
+	return t.inReplyTo[index].Link
+
+}
+
+// AppendInReplyToLink adds to the back of inReplyTo a LinkType type
+func (t *PropertyValue) AppendInReplyToLink(v LinkType) {
+	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Link: v})
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// PrependInReplyToLink adds to the front of inReplyTo a LinkType type
+func (t *PropertyValue) PrependInReplyToLink(v LinkType) {
+	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Link: v}}, t.inReplyTo...)
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// RemoveInReplyToLink deletes the value from the specified index
+func (t *PropertyValue) RemoveInReplyToLink(index int) {
+	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
+	t.inReplyTo[len(t.inReplyTo)-1] = nil
+	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToLinkValues returns an iterator over the values that GetInReplyToLink returns for each index where IsInReplyToLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) InReplyToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToLink(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsInReplyToIRI determines whether the call to GetInReplyToIRI is safe for the specified index
+func (t *PropertyValue) IsInReplyToIRI(index int) (ok bool) {
+	return t.inReplyTo[index].IRI != nil
+
+}
+
+// GetInReplyToIRI returns the value safely if IsInReplyToIRI returned true for the specified index
+func (t *PropertyValue) GetInReplyToIRI(index int) (v *url.URL) {
+	return t.inReplyTo[index].IRI
+
+}
+
+// AppendInReplyToIRI adds to the back of inReplyTo a *url.URL type
+func (t *PropertyValue) AppendInReplyToIRI(v *url.URL) {
+	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{IRI: v})
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// PrependInReplyToIRI adds to the front of inReplyTo a *url.URL type
+func (t *PropertyValue) PrependInReplyToIRI(v *url.URL) {
+	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{IRI: v}}, t.inReplyTo...)
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// RemoveInReplyToIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveInReplyToIRI(index int) {
+	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
+	t.inReplyTo[len(t.inReplyTo)-1] = nil
+	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToIRIValues returns an iterator over the values that GetInReplyToIRI returns for each index where IsInReplyToIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) InReplyToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToIRI(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownInReplyTo determines whether the call to GetUnknownInReplyTo is safe
+func (t *PropertyValue) HasUnknownInReplyTo() (ok bool) {
+	return t.present_[0]&(1<<15) != 0 && t.inReplyTo[0].unknown_ != nil
+
+}
+
+// GetUnknownInReplyTo returns the unknown value for inReplyTo
+func (t *PropertyValue) GetUnknownInReplyTo() (v interface{}) {
+	return t.inReplyTo[0].unknown_
+
+}
+
+// SetUnknownInReplyTo sets the unknown value of inReplyTo
+func (t *PropertyValue) SetUnknownInReplyTo(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &inReplyToIntermediateType{}
+	tmp.unknown_ = i
+	t.inReplyTo = append(t.inReplyTo, tmp)
+	t.markPresent_(15, len(t.inReplyTo) > 0)
+
+}
+
+// LocationLen determines the number of elements able to be used for the IsLocationObject, GetLocationObject, and RemoveLocationObject functions
+func (t *PropertyValue) LocationLen() (l int) {
+	return len(t.location)
+
+}
+
+// IsLocationObject determines whether the call to GetLocationObject is safe for the specified index
+func (t *PropertyValue) IsLocationObject(index int) (ok bool) {
+	return t.location[index].Object != nil
+
+}
+
+// GetLocationObject returns the value safely if IsLocationObject returned true for the specified index
+func (t *PropertyValue) GetLocationObject(index int) (v ObjectType) {
+	return t.location[index].Object
+
+}
+
+// AppendLocationObject adds to the back of location a ObjectType type
+func (t *PropertyValue) AppendLocationObject(v ObjectType) {
+	t.location = append(t.location, &locationIntermediateType{Object: v})
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// PrependLocationObject adds to the front of location a ObjectType type
+func (t *PropertyValue) PrependLocationObject(v ObjectType) {
+	t.location = append([]*locationIntermediateType{&locationIntermediateType{Object: v}}, t.location...)
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// RemoveLocationObject deletes the value from the specified index
+func (t *PropertyValue) RemoveLocationObject(index int) {
+	copy(t.location[index:], t.location[index+1:])
+	t.location[len(t.location)-1] = nil
+	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// LocationObjectValues returns an iterator over the values that GetLocationObject returns for each index where IsLocationObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) LocationObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationObject(i) {
+				continue
+			}
+			if !yield(t.GetLocationObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsLocationLink determines whether the call to GetLocationLink is safe for the specified index
+func (t *PropertyValue) IsLocationLink(index int) (ok bool) {
+	return t.location[index].Link != nil
+
+}
+
+// GetLocationLink returns the value safely if IsLocationLink returned true for the specified index
+func (t *PropertyValue) GetLocationLink(index int) (v LinkType) {
+	return t.location[index].Link
+
+}
+
+// AppendLocationLink adds to the back of location a LinkType type
+func (t *PropertyValue) AppendLocationLink(v LinkType) {
+	t.location = append(t.location, &locationIntermediateType{Link: v})
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// PrependLocationLink adds to the front of location a LinkType type
+func (t *PropertyValue) PrependLocationLink(v LinkType) {
+	t.location = append([]*locationIntermediateType{&locationIntermediateType{Link: v}}, t.location...)
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// RemoveLocationLink deletes the value from the specified index
+func (t *PropertyValue) RemoveLocationLink(index int) {
+	copy(t.location[index:], t.location[index+1:])
+	t.location[len(t.location)-1] = nil
+	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// LocationLinkValues returns an iterator over the values that GetLocationLink returns for each index where IsLocationLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) LocationLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationLink(i) {
+				continue
+			}
+			if !yield(t.GetLocationLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsLocationIRI determines whether the call to GetLocationIRI is safe for the specified index
+func (t *PropertyValue) IsLocationIRI(index int) (ok bool) {
+	return t.location[index].IRI != nil
+
+}
+
+// GetLocationIRI returns the value safely if IsLocationIRI returned true for the specified index
+func (t *PropertyValue) GetLocationIRI(index int) (v *url.URL) {
+	return t.location[index].IRI
+
+}
+
+// AppendLocationIRI adds to the back of location a *url.URL type
+func (t *PropertyValue) AppendLocationIRI(v *url.URL) {
+	t.location = append(t.location, &locationIntermediateType{IRI: v})
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// PrependLocationIRI adds to the front of location a *url.URL type
+func (t *PropertyValue) PrependLocationIRI(v *url.URL) {
+	t.location = append([]*locationIntermediateType{&locationIntermediateType{IRI: v}}, t.location...)
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// RemoveLocationIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveLocationIRI(index int) {
+	copy(t.location[index:], t.location[index+1:])
+	t.location[len(t.location)-1] = nil
+	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// LocationIRIValues returns an iterator over the values that GetLocationIRI returns for each index where IsLocationIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) LocationIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationIRI(i) {
+				continue
+			}
+			if !yield(t.GetLocationIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownLocation determines whether the call to GetUnknownLocation is safe
+func (t *PropertyValue) HasUnknownLocation() (ok bool) {
+	return t.present_[0]&(1<<16) != 0 && t.location[0].unknown_ != nil
+
+}
+
+// GetUnknownLocation returns the unknown value for location
+func (t *PropertyValue) GetUnknownLocation() (v interface{}) {
+	return t.location[0].unknown_
+
+}
+
+// SetUnknownLocation sets the unknown value of location
+func (t *PropertyValue) SetUnknownLocation(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &locationIntermediateType{}
+	tmp.unknown_ = i
+	t.location = append(t.location, tmp)
+	t.markPresent_(16, len(t.location) > 0)
+
+}
+
+// PreviewLen determines the number of elements able to be used for the IsPreviewObject, GetPreviewObject, and RemovePreviewObject functions
+func (t *PropertyValue) PreviewLen() (l int) {
+	return len(t.preview)
+
+}
+
+// IsPreviewObject determines whether the call to GetPreviewObject is safe for the specified index
+func (t *PropertyValue) IsPreviewObject(index int) (ok bool) {
+	return t.preview[index].Object != nil
+
+}
+
+// GetPreviewObject returns the value safely if IsPreviewObject returned true for the specified index
+func (t *PropertyValue) GetPreviewObject(index int) (v ObjectType) {
+	return t.preview[index].Object
+
+}
+
+// AppendPreviewObject adds to the back of preview a ObjectType type
+func (t *PropertyValue) AppendPreviewObject(v ObjectType) {
+	t.preview = append(t.preview, &previewIntermediateType{Object: v})
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// PrependPreviewObject adds to the front of preview a ObjectType type
+func (t *PropertyValue) PrependPreviewObject(v ObjectType) {
+	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Object: v}}, t.preview...)
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// RemovePreviewObject deletes the value from the specified index
+func (t *PropertyValue) RemovePreviewObject(index int) {
+	copy(t.preview[index:], t.preview[index+1:])
+	t.preview[len(t.preview)-1] = nil
+	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// PreviewObjectValues returns an iterator over the values that GetPreviewObject returns for each index where IsPreviewObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) PreviewObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewObject(i) {
+				continue
+			}
+			if !yield(t.GetPreviewObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsPreviewLink determines whether the call to GetPreviewLink is safe for the specified index
+func (t *PropertyValue) IsPreviewLink(index int) (ok bool) {
+	return t.preview[index].Link != nil
+
+}
+
+// GetPreviewLink returns the value safely if IsPreviewLink returned true for the specified index
+func (t *PropertyValue) GetPreviewLink(index int) (v LinkType) {
+	return t.preview[index].Link
+
+}
+
+// AppendPreviewLink adds to the back of preview a LinkType type
+func (t *PropertyValue) AppendPreviewLink(v LinkType) {
+	t.preview = append(t.preview, &previewIntermediateType{Link: v})
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// PrependPreviewLink adds to the front of preview a LinkType type
+func (t *PropertyValue) PrependPreviewLink(v LinkType) {
+	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Link: v}}, t.preview...)
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// RemovePreviewLink deletes the value from the specified index
+func (t *PropertyValue) RemovePreviewLink(index int) {
+	copy(t.preview[index:], t.preview[index+1:])
+	t.preview[len(t.preview)-1] = nil
+	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// PreviewLinkValues returns an iterator over the values that GetPreviewLink returns for each index where IsPreviewLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) PreviewLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewLink(i) {
+				continue
+			}
+			if !yield(t.GetPreviewLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsPreviewIRI determines whether the call to GetPreviewIRI is safe for the specified index
+func (t *PropertyValue) IsPreviewIRI(index int) (ok bool) {
+	return t.preview[index].IRI != nil
+
+}
+
+// GetPreviewIRI returns the value safely if IsPreviewIRI returned true for the specified index
+func (t *PropertyValue) GetPreviewIRI(index int) (v *url.URL) {
+	return t.preview[index].IRI
+
+}
+
+// AppendPreviewIRI adds to the back of preview a *url.URL type
+func (t *PropertyValue) AppendPreviewIRI(v *url.URL) {
+	t.preview = append(t.preview, &previewIntermediateType{IRI: v})
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// PrependPreviewIRI adds to the front of preview a *url.URL type
+func (t *PropertyValue) PrependPreviewIRI(v *url.URL) {
+	t.preview = append([]*previewIntermediateType{&previewIntermediateType{IRI: v}}, t.preview...)
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// RemovePreviewIRI deletes the value from the specified index
+func (t *PropertyValue) RemovePreviewIRI(index int) {
+	copy(t.preview[index:], t.preview[index+1:])
+	t.preview[len(t.preview)-1] = nil
+	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// PreviewIRIValues returns an iterator over the values that GetPreviewIRI returns for each index where IsPreviewIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) PreviewIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewIRI(i) {
+				continue
+			}
+			if !yield(t.GetPreviewIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownPreview determines whether the call to GetUnknownPreview is safe
+func (t *PropertyValue) HasUnknownPreview() (ok bool) {
+	return t.present_[0]&(1<<17) != 0 && t.preview[0].unknown_ != nil
+
+}
+
+// GetUnknownPreview returns the unknown value for preview
+func (t *PropertyValue) GetUnknownPreview() (v interface{}) {
+	return t.preview[0].unknown_
+
+}
+
+// SetUnknownPreview sets the unknown value of preview
+func (t *PropertyValue) SetUnknownPreview(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &previewIntermediateType{}
+	tmp.unknown_ = i
+	t.preview = append(t.preview, tmp)
+	t.markPresent_(17, len(t.preview) > 0)
+
+}
+
+// IsPublished determines whether the call to GetPublished is safe
+func (t *PropertyValue) IsPublished() (ok bool) {
+	return t.present_[0]&(1<<18) != 0 && t.published.dateTime != nil
+
+}
+
+// GetPublished returns the value safely if IsPublished returned true
+func (t *PropertyValue) GetPublished() (v time.Time) {
+	return *t.published.dateTime
+
+}
+
+// SetPublished sets the value of published to be of time.Time type
+func (t *PropertyValue) SetPublished(v time.Time) {
+	t.published = &publishedIntermediateType{dateTime: &v}
+	t.markPresent_(18, true)
+
+}
+
+// IsPublishedIRI determines whether the call to GetPublishedIRI is safe
+func (t *PropertyValue) IsPublishedIRI() (ok bool) {
+	return t.present_[0]&(1<<18) != 0 && t.published.IRI != nil
+
+}
+
+// GetPublishedIRI returns the value safely if IsPublishedIRI returned true
+func (t *PropertyValue) GetPublishedIRI() (v *url.URL) {
+	return t.published.IRI
+
+}
+
+// SetPublishedIRI sets the value of published to be of *url.URL type
+func (t *PropertyValue) SetPublishedIRI(v *url.URL) {
+	t.published = &publishedIntermediateType{IRI: v}
+	t.markPresent_(18, true)
+
+}
+
+// HasUnknownPublished determines whether the call to GetUnknownPublished is safe
+func (t *PropertyValue) HasUnknownPublished() (ok bool) {
+	return t.present_[0]&(1<<18) != 0 && t.published.unknown_ != nil
+
+}
+
+// GetUnknownPublished returns the unknown value for published
+func (t *PropertyValue) GetUnknownPublished() (v interface{}) {
+	return t.published.unknown_
+
+}
+
+// SetUnknownPublished sets the unknown value of published
+func (t *PropertyValue) SetUnknownPublished(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &publishedIntermediateType{}
+	tmp.unknown_ = i
+	t.published = tmp
+	t.markPresent_(18, true)
+
+}
+
+// IsReplies determines whether the call to GetReplies is safe
+func (t *PropertyValue) IsReplies() (ok bool) {
+	return t.present_[0]&(1<<19) != 0 && t.replies.Collection != nil
+
+}
+
+// GetReplies returns the value safely if IsReplies returned true
+func (t *PropertyValue) GetReplies() (v CollectionType) {
+	return t.replies.Collection
+
+}
+
+// SetReplies sets the value of replies to be of CollectionType type
+func (t *PropertyValue) SetReplies(v CollectionType) {
+	t.replies = &repliesIntermediateType{Collection: v}
+	t.markPresent_(19, true)
+
+}
+
+// IsRepliesIRI determines whether the call to GetRepliesIRI is safe
+func (t *PropertyValue) IsRepliesIRI() (ok bool) {
+	return t.present_[0]&(1<<19) != 0 && t.replies.IRI != nil
+
+}
+
+// GetRepliesIRI returns the value safely if IsRepliesIRI returned true
+func (t *PropertyValue) GetRepliesIRI() (v *url.URL) {
+	return t.replies.IRI
+
+}
+
+// SetRepliesIRI sets the value of replies to be of *url.URL type
+func (t *PropertyValue) SetRepliesIRI(v *url.URL) {
+	t.replies = &repliesIntermediateType{IRI: v}
+	t.markPresent_(19, true)
+
+}
+
+// HasUnknownReplies determines whether the call to GetUnknownReplies is safe
+func (t *PropertyValue) HasUnknownReplies() (ok bool) {
+	return t.present_[0]&(1<<19) != 0 && t.replies.unknown_ != nil
+
+}
+
+// GetUnknownReplies returns the unknown value for replies
+func (t *PropertyValue) GetUnknownReplies() (v interface{}) {
+	return t.replies.unknown_
+
+}
+
+// SetUnknownReplies sets the unknown value of replies
+func (t *PropertyValue) SetUnknownReplies(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &repliesIntermediateType{}
+	tmp.unknown_ = i
+	t.replies = tmp
+	t.markPresent_(19, true)
+
+}
+
+// IsStartTime determines whether the call to GetStartTime is safe
+func (t *PropertyValue) IsStartTime() (ok bool) {
+	return t.present_[0]&(1<<20) != 0 && t.startTime.dateTime != nil
+
+}
+
+// GetStartTime returns the value safely if IsStartTime returned true
+func (t *PropertyValue) GetStartTime() (v time.Time) {
+	return *t.startTime.dateTime
+
+}
+
+// SetStartTime sets the value of startTime to be of time.Time type
+func (t *PropertyValue) SetStartTime(v time.Time) {
+	t.startTime = &startTimeIntermediateType{dateTime: &v}
+	t.markPresent_(20, true)
+
+}
+
+// IsStartTimeIRI determines whether the call to GetStartTimeIRI is safe
+func (t *PropertyValue) IsStartTimeIRI() (ok bool) {
+	return t.present_[0]&(1<<20) != 0 && t.startTime.IRI != nil
+
+}
+
+// GetStartTimeIRI returns the value safely if IsStartTimeIRI returned true
+func (t *PropertyValue) GetStartTimeIRI() (v *url.URL) {
+	return t.startTime.IRI
+
+}
+
+// SetStartTimeIRI sets the value of startTime to be of *url.URL type
+func (t *PropertyValue) SetStartTimeIRI(v *url.URL) {
+	t.startTime = &startTimeIntermediateType{IRI: v}
+	t.markPresent_(20, true)
+
+}
+
+// HasUnknownStartTime determines whether the call to GetUnknownStartTime is safe
+func (t *PropertyValue) HasUnknownStartTime() (ok bool) {
+	return t.present_[0]&(1<<20) != 0 && t.startTime.unknown_ != nil
+
+}
+
+// GetUnknownStartTime returns the unknown value for startTime
+func (t *PropertyValue) GetUnknownStartTime() (v interface{}) {
+	return t.startTime.unknown_
+
+}
+
+// SetUnknownStartTime sets the unknown value of startTime
+func (t *PropertyValue) SetUnknownStartTime(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &startTimeIntermediateType{}
+	tmp.unknown_ = i
+	t.startTime = tmp
+	t.markPresent_(20, true)
+
+}
+
+// SummaryLen determines the number of elements able to be used for the IsSummaryString, GetSummaryString, and RemoveSummaryString functions
+func (t *PropertyValue) SummaryLen() (l int) {
+	return len(t.summary)
+
+}
+
+// IsSummaryString determines whether the call to GetSummaryString is safe for the specified index
+func (t *PropertyValue) IsSummaryString(index int) (ok bool) {
+	return t.summary[index].stringName != nil
+
+}
+
+// GetSummaryString returns the value safely if IsSummaryString returned true for the specified index
+func (t *PropertyValue) GetSummaryString(index int) (v string) {
+	return *t.summary[index].stringName
+
+}
+
+// AppendSummaryString adds to the back of summary a string type
+func (t *PropertyValue) AppendSummaryString(v string) {
+	t.summary = append(t.summary, &summaryIntermediateType{stringName: &v})
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// PrependSummaryString adds to the front of summary a string type
+func (t *PropertyValue) PrependSummaryString(v string) {
+	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{stringName: &v}}, t.summary...)
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// RemoveSummaryString deletes the value from the specified index
+func (t *PropertyValue) RemoveSummaryString(index int) {
+	copy(t.summary[index:], t.summary[index+1:])
+	t.summary[len(t.summary)-1] = nil
+	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// SummaryStringValues returns an iterator over the values that GetSummaryString returns for each index where IsSummaryString is true, which can be used as an iter.Seq
+func (t *PropertyValue) SummaryStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsSummaryLangString determines whether the call to GetSummaryLangString is safe for the specified index
+func (t *PropertyValue) IsSummaryLangString(index int) (ok bool) {
+	return t.summary[index].langString != nil
+
+}
+
+// GetSummaryLangString returns the value safely if IsSummaryLangString returned true for the specified index
+func (t *PropertyValue) GetSummaryLangString(index int) (v string) {
+	return *t.summary[index].langString
+
+}
+
+// AppendSummaryLangString adds to the back of summary a string type
+func (t *PropertyValue) AppendSummaryLangString(v string) {
+	t.summary = append(t.summary, &summaryIntermediateType{langString: &v})
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// PrependSummaryLangString adds to the front of summary a string type
+func (t *PropertyValue) PrependSummaryLangString(v string) {
+	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{langString: &v}}, t.summary...)
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// RemoveSummaryLangString deletes the value from the specified index
+func (t *PropertyValue) RemoveSummaryLangString(index int) {
+	copy(t.summary[index:], t.summary[index+1:])
+	t.summary[len(t.summary)-1] = nil
+	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// SummaryLangStringValues returns an iterator over the values that GetSummaryLangString returns for each index where IsSummaryLangString is true, which can be used as an iter.Seq
+func (t *PropertyValue) SummaryLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryLangString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryLangString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsSummaryIRI determines whether the call to GetSummaryIRI is safe for the specified index
+func (t *PropertyValue) IsSummaryIRI(index int) (ok bool) {
+	return t.summary[index].IRI != nil
+
+}
+
+// GetSummaryIRI returns the value safely if IsSummaryIRI returned true for the specified index
+func (t *PropertyValue) GetSummaryIRI(index int) (v *url.URL) {
+	return t.summary[index].IRI
+
+}
+
+// AppendSummaryIRI adds to the back of summary a *url.URL type
+func (t *PropertyValue) AppendSummaryIRI(v *url.URL) {
+	t.summary = append(t.summary, &summaryIntermediateType{IRI: v})
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// PrependSummaryIRI adds to the front of summary a *url.URL type
+func (t *PropertyValue) PrependSummaryIRI(v *url.URL) {
+	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{IRI: v}}, t.summary...)
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// RemoveSummaryIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveSummaryIRI(index int) {
+	copy(t.summary[index:], t.summary[index+1:])
+	t.summary[len(t.summary)-1] = nil
+	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// SummaryIRIValues returns an iterator over the values that GetSummaryIRI returns for each index where IsSummaryIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) SummaryIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryIRI(i) {
+				continue
+			}
+			if !yield(t.GetSummaryIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownSummary determines whether the call to GetUnknownSummary is safe
+func (t *PropertyValue) HasUnknownSummary() (ok bool) {
+	return t.present_[0]&(1<<21) != 0 && t.summary[0].unknown_ != nil
+
+}
+
+// GetUnknownSummary returns the unknown value for summary
+func (t *PropertyValue) GetUnknownSummary() (v interface{}) {
+	return t.summary[0].unknown_
+
+}
+
+// SetUnknownSummary sets the unknown value of summary
+func (t *PropertyValue) SetUnknownSummary(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &summaryIntermediateType{}
+	tmp.unknown_ = i
+	t.summary = append(t.summary, tmp)
+	t.markPresent_(21, len(t.summary) > 0)
+
+}
+
+// SummaryMapLanguages returns all languages for this property's language mapping, or nil if there are none.
+func (t *PropertyValue) SummaryMapLanguages() (l []string) {
+	if t.summaryMap == nil || len(t.summaryMap) == 0 {
+		return nil
+	}
+	for k := range t.summaryMap {
+		l = append(l, k)
+	}
+	return
+
+}
+
+// GetSummaryMap retrieves the value of the property for the specified language, or an empty string if it does not exist
+func (t *PropertyValue) GetSummaryMap(l string) (v string) {
+	if t.summaryMap == nil {
+		return ""
+	}
+	ok := false
+	v, ok = t.summaryMap[l]
+	if !ok {
+		return ""
+	}
+	return v
+
+}
+
+// SetSummaryMap sets the value of the property for the specified language
+func (t *PropertyValue) SetSummaryMap(l string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(22, true)
+	}
+	t.summaryMap[l] = v
+
+}
+
+// GetSummaryLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *PropertyValue) GetSummaryLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.summaryMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.summaryMap[k], true
+
+}
+
+// SetSummaryLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *PropertyValue) SetSummaryLanguage(tag string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(22, true)
+	} else if k, ok := languageKey(t.summaryMap, tag); ok {
+		delete(t.summaryMap, k)
+	}
+	t.summaryMap[tag] = v
+
+}
+
+// PreferredSummaryLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *PropertyValue) PreferredSummaryLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.summaryMap, tags)
+	return t.summaryMap[tag], tag
+
+}
+
+// TagLen determines the number of elements able to be used for the IsTagObject, GetTagObject, and RemoveTagObject functions
+func (t *PropertyValue) TagLen() (l int) {
+	return len(t.tag)
+
+}
+
+// IsTagObject determines whether the call to GetTagObject is safe for the specified index
+func (t *PropertyValue) IsTagObject(index int) (ok bool) {
+	return t.tag[index].Object != nil
+
+}
+
+// GetTagObject returns the value safely if IsTagObject returned true for the specified index
+func (t *PropertyValue) GetTagObject(index int) (v ObjectType) {
+	return t.tag[index].Object
+
+}
+
+// AppendTagObject adds to the back of tag a ObjectType type
+func (t *PropertyValue) AppendTagObject(v ObjectType) {
+	t.tag = append(t.tag, &tagIntermediateType{Object: v})
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// PrependTagObject adds to the front of tag a ObjectType type
+func (t *PropertyValue) PrependTagObject(v ObjectType) {
+	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Object: v}}, t.tag...)
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// RemoveTagObject deletes the value from the specified index
+func (t *PropertyValue) RemoveTagObject(index int) {
+	copy(t.tag[index:], t.tag[index+1:])
+	t.tag[len(t.tag)-1] = nil
+	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// TagObjectValues returns an iterator over the values that GetTagObject returns for each index where IsTagObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) TagObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagObject(i) {
+				continue
+			}
+			if !yield(t.GetTagObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsTagLink determines whether the call to GetTagLink is safe for the specified index
+func (t *PropertyValue) IsTagLink(index int) (ok bool) {
+	return t.tag[index].Link != nil
+
+}
+
+// GetTagLink returns the value safely if IsTagLink returned true for the specified index
+func (t *PropertyValue) GetTagLink(index int) (v LinkType) {
+	return t.tag[index].Link
+
+}
+
+// AppendTagLink adds to the back of tag a LinkType type
+func (t *PropertyValue) AppendTagLink(v LinkType) {
+	t.tag = append(t.tag, &tagIntermediateType{Link: v})
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// PrependTagLink adds to the front of tag a LinkType type
+func (t *PropertyValue) PrependTagLink(v LinkType) {
+	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Link: v}}, t.tag...)
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// RemoveTagLink deletes the value from the specified index
+func (t *PropertyValue) RemoveTagLink(index int) {
+	copy(t.tag[index:], t.tag[index+1:])
+	t.tag[len(t.tag)-1] = nil
+	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// TagLinkValues returns an iterator over the values that GetTagLink returns for each index where IsTagLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) TagLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagLink(i) {
+				continue
+			}
+			if !yield(t.GetTagLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsTagIRI determines whether the call to GetTagIRI is safe for the specified index
+func (t *PropertyValue) IsTagIRI(index int) (ok bool) {
+	return t.tag[index].IRI != nil
+
+}
+
+// GetTagIRI returns the value safely if IsTagIRI returned true for the specified index
+func (t *PropertyValue) GetTagIRI(index int) (v *url.URL) {
+	return t.tag[index].IRI
+
+}
+
+// AppendTagIRI adds to the back of tag a *url.URL type
+func (t *PropertyValue) AppendTagIRI(v *url.URL) {
+	t.tag = append(t.tag, &tagIntermediateType{IRI: v})
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// PrependTagIRI adds to the front of tag a *url.URL type
+func (t *PropertyValue) PrependTagIRI(v *url.URL) {
+	t.tag = append([]*tagIntermediateType{&tagIntermediateType{IRI: v}}, t.tag...)
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// RemoveTagIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveTagIRI(index int) {
+	copy(t.tag[index:], t.tag[index+1:])
+	t.tag[len(t.tag)-1] = nil
+	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// TagIRIValues returns an iterator over the values that GetTagIRI returns for each index where IsTagIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) TagIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagIRI(i) {
+				continue
+			}
+			if !yield(t.GetTagIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownTag determines whether the call to GetUnknownTag is safe
+func (t *PropertyValue) HasUnknownTag() (ok bool) {
+	return t.present_[0]&(1<<23) != 0 && t.tag[0].unknown_ != nil
+
+}
+
+// GetUnknownTag returns the unknown value for tag
+func (t *PropertyValue) GetUnknownTag() (v interface{}) {
+	return t.tag[0].unknown_
+
+}
+
+// SetUnknownTag sets the unknown value of tag
+func (t *PropertyValue) SetUnknownTag(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &tagIntermediateType{}
+	tmp.unknown_ = i
+	t.tag = append(t.tag, tmp)
+	t.markPresent_(23, len(t.tag) > 0)
+
+}
+
+// TypeLen determines the number of elements able to be used for the GetType and RemoveType functions
+func (t *PropertyValue) TypeLen() (l int) {
+	return len(t.typeName)
+
+}
+
+// GetType returns the value for the specified index
+func (t *PropertyValue) GetType(index int) (v interface{}) {
+	return t.typeName[index]
+
+}
+
+// AppendType adds a value to the back of type
+func (t *PropertyValue) AppendType(v interface{}) {
+	t.typeName = append(t.typeName, v)
+	t.markPresent_(24, len(t.typeName) > 0)
+
+}
+
+// PrependType adds a value to the front of type
+func (t *PropertyValue) PrependType(v interface{}) {
+	t.typeName = append([]interface{}{v}, t.typeName...)
+	t.markPresent_(24, len(t.typeName) > 0)
+
+}
+
+// RemoveType deletes the value from the specified index
+func (t *PropertyValue) RemoveType(index int) {
+	copy(t.typeName[index:], t.typeName[index+1:])
+	t.typeName[len(t.typeName)-1] = nil
+	t.typeName = t.typeName[:len(t.typeName)-1]
+	t.markPresent_(24, len(t.typeName) > 0)
+
+}
+
+// IsUpdated determines whether the call to GetUpdated is safe
+func (t *PropertyValue) IsUpdated() (ok bool) {
+	return t.present_[0]&(1<<25) != 0 && t.updated.dateTime != nil
+
+}
+
+// GetUpdated returns the value safely if IsUpdated returned true
+func (t *PropertyValue) GetUpdated() (v time.Time) {
+	return *t.updated.dateTime
+
+}
+
+// SetUpdated sets the value of updated to be of time.Time type
+func (t *PropertyValue) SetUpdated(v time.Time) {
+	t.updated = &updatedIntermediateType{dateTime: &v}
+	t.markPresent_(25, true)
+
+}
+
+// IsUpdatedIRI determines whether the call to GetUpdatedIRI is safe
+func (t *PropertyValue) IsUpdatedIRI() (ok bool) {
+	return t.present_[0]&(1<<25) != 0 && t.updated.IRI != nil
+
+}
+
+// GetUpdatedIRI returns the value safely if IsUpdatedIRI returned true
+func (t *PropertyValue) GetUpdatedIRI() (v *url.URL) {
+	return t.updated.IRI
+
+}
+
+// SetUpdatedIRI sets the value of updated to be of *url.URL type
+func (t *PropertyValue) SetUpdatedIRI(v *url.URL) {
+	t.updated = &updatedIntermediateType{IRI: v}
+	t.markPresent_(25, true)
+
+}
+
+// HasUnknownUpdated determines whether the call to GetUnknownUpdated is safe
+func (t *PropertyValue) HasUnknownUpdated() (ok bool) {
+	return t.present_[0]&(1<<25) != 0 && t.updated.unknown_ != nil
+
+}
+
+// GetUnknownUpdated returns the unknown value for updated
+func (t *PropertyValue) GetUnknownUpdated() (v interface{}) {
+	return t.updated.unknown_
+
+}
+
+// SetUnknownUpdated sets the unknown value of updated
+func (t *PropertyValue) SetUnknownUpdated(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &updatedIntermediateType{}
+	tmp.unknown_ = i
+	t.updated = tmp
+	t.markPresent_(25, true)
+
+}
+
+// UrlLen determines the number of elements able to be used for the IsUrlAnyURI, GetUrlAnyURI, and RemoveUrlAnyURI functions
+func (t *PropertyValue) UrlLen() (l int) {
+	return len(t.url)
+
+}
+
+// IsUrlAnyURI determines whether the call to GetUrlAnyURI is safe for the specified index
+func (t *PropertyValue) IsUrlAnyURI(index int) (ok bool) {
+	return t.url[index].anyURI != nil
+
+}
+
+// GetUrlAnyURI returns the value safely if IsUrlAnyURI returned true for the specified index
+func (t *PropertyValue) GetUrlAnyURI(index int) (v *url.URL) {
+	return t.url[index].anyURI
+
+}
+
+// AppendUrlAnyURI adds to the back of url a *url.URL type
+func (t *PropertyValue) AppendUrlAnyURI(v *url.URL) {
+	t.url = append(t.url, &urlIntermediateType{anyURI: v})
+	t.markPresent_(26, len(t.url) > 0)
+
+}
+
+// PrependUrlAnyURI adds to the front of url a *url.URL type
+func (t *PropertyValue) PrependUrlAnyURI(v *url.URL) {
+	t.url = append([]*urlIntermediateType{&urlIntermediateType{anyURI: v}}, t.url...)
+	t.markPresent_(26, len(t.url) > 0)
+
+}
+
+// RemoveUrlAnyURI deletes the value from the specified index
+func (t *PropertyValue) RemoveUrlAnyURI(index int) {
+	copy(t.url[index:], t.url[index+1:])
+	t.url[len(t.url)-1] = nil
+	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(26, len(t.url) > 0)
+
+}
+
+// UrlAnyURIValues returns an iterator over the values that GetUrlAnyURI returns for each index where IsUrlAnyURI is true, which can be used as an iter.Seq
+func (t *PropertyValue) UrlAnyURIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlAnyURI(i) {
+				continue
+			}
+			if !yield(t.GetUrlAnyURI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsUrlLink determines whether the call to GetUrlLink is safe for the specified index
+func (t *PropertyValue) IsUrlLink(index int) (ok bool) {
+	return t.url[index].Link != nil
+
+}
+
+// GetUrlLink returns the value safely if IsUrlLink returned true for the specified index
+func (t *PropertyValue) GetUrlLink(index int) (v LinkType) {
+	return t.url[index].Link
+
+}
+
+// AppendUrlLink adds to the back of url a LinkType type
+func (t *PropertyValue) AppendUrlLink(v LinkType) {
+	t.url = append(t.url, &urlIntermediateType{Link: v})
+	t.markPresent_(26, len(t.url) > 0)
+
+}
+
+// PrependUrlLink adds to the front of url a LinkType type
+func (t *PropertyValue) PrependUrlLink(v LinkType) {
+	t.url = append([]*urlIntermediateType{&urlIntermediateType{Link: v}}, t.url...)
+	t.markPresent_(26, len(t.url) > 0)
+
+}
+
+// RemoveUrlLink deletes the value from the specified index
+func (t *PropertyValue) RemoveUrlLink(index int) {
+	copy(t.url[index:], t.url[index+1:])
+	t.url[len(t.url)-1] = nil
+	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(26, len(t.url) > 0)
+
+}
+
+// UrlLinkValues returns an iterator over the values that GetUrlLink returns for each index where IsUrlLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) UrlLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlLink(i) {
+				continue
+			}
+			if !yield(t.GetUrlLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownUrl determines whether the call to GetUnknownUrl is safe
+func (t *PropertyValue) HasUnknownUrl() (ok bool) {
+	return t.present_[0]&(1<<26) != 0 && t.url[0].unknown_ != nil
+
+}
+
+// GetUnknownUrl returns the unknown value for url
+func (t *PropertyValue) GetUnknownUrl() (v interface{}) {
+	return t.url[0].unknown_
+
+}
+
+// SetUnknownUrl sets the unknown value of url
+func (t *PropertyValue) SetUnknownUrl(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &urlIntermediateType{}
+	tmp.unknown_ = i
+	t.url = append(t.url, tmp)
+	t.markPresent_(26, len(t.url) > 0)
+
+}
+
+// ToLen determines the number of elements able to be used for the IsToObject, GetToObject, and RemoveToObject functions
+func (t *PropertyValue) ToLen() (l int) {
+	return len(t.to)
+
+}
+
+// IsToObject determines whether the call to GetToObject is safe for the specified index
+func (t *PropertyValue) IsToObject(index int) (ok bool) {
+	return t.to[index].Object != nil
+
+}
+
+// GetToObject returns the value safely if IsToObject returned true for the specified index
+func (t *PropertyValue) GetToObject(index int) (v ObjectType) {
+	return t.to[index].Object
+
+}
+
+// AppendToObject adds to the back of to a ObjectType type
+func (t *PropertyValue) AppendToObject(v ObjectType) {
+	t.to = append(t.to, &toIntermediateType{Object: v})
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// PrependToObject adds to the front of to a ObjectType type
+func (t *PropertyValue) PrependToObject(v ObjectType) {
+	t.to = append([]*toIntermediateType{&toIntermediateType{Object: v}}, t.to...)
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// RemoveToObject deletes the value from the specified index
+func (t *PropertyValue) RemoveToObject(index int) {
+	copy(t.to[index:], t.to[index+1:])
+	t.to[len(t.to)-1] = nil
+	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// ToObjectValues returns an iterator over the values that GetToObject returns for each index where IsToObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) ToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToObject(i) {
+				continue
+			}
+			if !yield(t.GetToObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsToLink determines whether the call to GetToLink is safe for the specified index
+func (t *PropertyValue) IsToLink(index int) (ok bool) {
+	return t.to[index].Link != nil
+
+}
+
+// GetToLink returns the value safely if IsToLink returned true for the specified index
+func (t *PropertyValue) GetToLink(index int) (v LinkType) {
+	return t.to[index].Link
+
+}
+
+// AppendToLink adds to the back of to a LinkType type
+func (t *PropertyValue) AppendToLink(v LinkType) {
+	t.to = append(t.to, &toIntermediateType{Link: v})
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// PrependToLink adds to the front of to a LinkType type
+func (t *PropertyValue) PrependToLink(v LinkType) {
+	t.to = append([]*toIntermediateType{&toIntermediateType{Link: v}}, t.to...)
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// RemoveToLink deletes the value from the specified index
+func (t *PropertyValue) RemoveToLink(index int) {
+	copy(t.to[index:], t.to[index+1:])
+	t.to[len(t.to)-1] = nil
+	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// ToLinkValues returns an iterator over the values that GetToLink returns for each index where IsToLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) ToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToLink(i) {
+				continue
+			}
+			if !yield(t.GetToLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsToIRI determines whether the call to GetToIRI is safe for the specified index
+func (t *PropertyValue) IsToIRI(index int) (ok bool) {
+	return t.to[index].IRI != nil
+
+}
+
+// GetToIRI returns the value safely if IsToIRI returned true for the specified index
+func (t *PropertyValue) GetToIRI(index int) (v *url.URL) {
+	return t.to[index].IRI
+
+}
+
+// AppendToIRI adds to the back of to a *url.URL type
+func (t *PropertyValue) AppendToIRI(v *url.URL) {
+	t.to = append(t.to, &toIntermediateType{IRI: v})
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// PrependToIRI adds to the front of to a *url.URL type
+func (t *PropertyValue) PrependToIRI(v *url.URL) {
+	t.to = append([]*toIntermediateType{&toIntermediateType{IRI: v}}, t.to...)
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// RemoveToIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveToIRI(index int) {
+	copy(t.to[index:], t.to[index+1:])
+	t.to[len(t.to)-1] = nil
+	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// ToIRIValues returns an iterator over the values that GetToIRI returns for each index where IsToIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) ToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToIRI(i) {
+				continue
+			}
+			if !yield(t.GetToIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownTo determines whether the call to GetUnknownTo is safe
+func (t *PropertyValue) HasUnknownTo() (ok bool) {
+	return t.present_[0]&(1<<27) != 0 && t.to[0].unknown_ != nil
+
+}
+
+// GetUnknownTo returns the unknown value for to
+func (t *PropertyValue) GetUnknownTo() (v interface{}) {
+	return t.to[0].unknown_
+
+}
+
+// SetUnknownTo sets the unknown value of to
+func (t *PropertyValue) SetUnknownTo(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &toIntermediateType{}
+	tmp.unknown_ = i
+	t.to = append(t.to, tmp)
+	t.markPresent_(27, len(t.to) > 0)
+
+}
+
+// BtoLen determines the number of elements able to be used for the IsBtoObject, GetBtoObject, and RemoveBtoObject functions
+func (t *PropertyValue) BtoLen() (l int) {
+	return len(t.bto)
+
+}
+
+// IsBtoObject determines whether the call to GetBtoObject is safe for the specified index
+func (t *PropertyValue) IsBtoObject(index int) (ok bool) {
+	return t.bto[index].Object != nil
+
+}
+
+// GetBtoObject returns the value safely if IsBtoObject returned true for the specified index
+func (t *PropertyValue) GetBtoObject(index int) (v ObjectType) {
+	return t.bto[index].Object
+
+}
+
+// AppendBtoObject adds to the back of bto a ObjectType type
+func (t *PropertyValue) AppendBtoObject(v ObjectType) {
+	t.bto = append(t.bto, &btoIntermediateType{Object: v})
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// PrependBtoObject adds to the front of bto a ObjectType type
+func (t *PropertyValue) PrependBtoObject(v ObjectType) {
+	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Object: v}}, t.bto...)
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// RemoveBtoObject deletes the value from the specified index
+func (t *PropertyValue) RemoveBtoObject(index int) {
+	copy(t.bto[index:], t.bto[index+1:])
+	t.bto[len(t.bto)-1] = nil
+	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// BtoObjectValues returns an iterator over the values that GetBtoObject returns for each index where IsBtoObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) BtoObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoObject(i) {
+				continue
+			}
+			if !yield(t.GetBtoObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsBtoLink determines whether the call to GetBtoLink is safe for the specified index
+func (t *PropertyValue) IsBtoLink(index int) (ok bool) {
+	return t.bto[index].Link != nil
+
+}
+
+// GetBtoLink returns the value safely if IsBtoLink returned true for the specified index
+func (t *PropertyValue) GetBtoLink(index int) (v LinkType) {
+	return t.bto[index].Link
+
+}
+
+// AppendBtoLink adds to the back of bto a LinkType type
+func (t *PropertyValue) AppendBtoLink(v LinkType) {
+	t.bto = append(t.bto, &btoIntermediateType{Link: v})
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// PrependBtoLink adds to the front of bto a LinkType type
+func (t *PropertyValue) PrependBtoLink(v LinkType) {
+	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Link: v}}, t.bto...)
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// RemoveBtoLink deletes the value from the specified index
+func (t *PropertyValue) RemoveBtoLink(index int) {
+	copy(t.bto[index:], t.bto[index+1:])
+	t.bto[len(t.bto)-1] = nil
+	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// BtoLinkValues returns an iterator over the values that GetBtoLink returns for each index where IsBtoLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) BtoLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoLink(i) {
+				continue
+			}
+			if !yield(t.GetBtoLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsBtoIRI determines whether the call to GetBtoIRI is safe for the specified index
+func (t *PropertyValue) IsBtoIRI(index int) (ok bool) {
+	return t.bto[index].IRI != nil
+
+}
+
+// GetBtoIRI returns the value safely if IsBtoIRI returned true for the specified index
+func (t *PropertyValue) GetBtoIRI(index int) (v *url.URL) {
+	return t.bto[index].IRI
+
+}
+
+// AppendBtoIRI adds to the back of bto a *url.URL type
+func (t *PropertyValue) AppendBtoIRI(v *url.URL) {
+	t.bto = append(t.bto, &btoIntermediateType{IRI: v})
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// PrependBtoIRI adds to the front of bto a *url.URL type
+func (t *PropertyValue) PrependBtoIRI(v *url.URL) {
+	t.bto = append([]*btoIntermediateType{&btoIntermediateType{IRI: v}}, t.bto...)
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// RemoveBtoIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveBtoIRI(index int) {
+	copy(t.bto[index:], t.bto[index+1:])
+	t.bto[len(t.bto)-1] = nil
+	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// BtoIRIValues returns an iterator over the values that GetBtoIRI returns for each index where IsBtoIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) BtoIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoIRI(i) {
+				continue
+			}
+			if !yield(t.GetBtoIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownBto determines whether the call to GetUnknownBto is safe
+func (t *PropertyValue) HasUnknownBto() (ok bool) {
+	return t.present_[0]&(1<<28) != 0 && t.bto[0].unknown_ != nil
+
+}
+
+// GetUnknownBto returns the unknown value for bto
+func (t *PropertyValue) GetUnknownBto() (v interface{}) {
+	return t.bto[0].unknown_
+
+}
+
+// SetUnknownBto sets the unknown value of bto
+func (t *PropertyValue) SetUnknownBto(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &btoIntermediateType{}
+	tmp.unknown_ = i
+	t.bto = append(t.bto, tmp)
+	t.markPresent_(28, len(t.bto) > 0)
+
+}
+
+// CcLen determines the number of elements able to be used for the IsCcObject, GetCcObject, and RemoveCcObject functions
+func (t *PropertyValue) CcLen() (l int) {
+	return len(t.cc)
+
+}
+
+// IsCcObject determines whether the call to GetCcObject is safe for the specified index
+func (t *PropertyValue) IsCcObject(index int) (ok bool) {
+	return t.cc[index].Object != nil
+
+}
+
+// GetCcObject returns the value safely if IsCcObject returned true for the specified index
+func (t *PropertyValue) GetCcObject(index int) (v ObjectType) {
+	return t.cc[index].Object
+
+}
+
+// AppendCcObject adds to the back of cc a ObjectType type
+func (t *PropertyValue) AppendCcObject(v ObjectType) {
+	t.cc = append(t.cc, &ccIntermediateType{Object: v})
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// PrependCcObject adds to the front of cc a ObjectType type
+func (t *PropertyValue) PrependCcObject(v ObjectType) {
+	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Object: v}}, t.cc...)
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// RemoveCcObject deletes the value from the specified index
+func (t *PropertyValue) RemoveCcObject(index int) {
+	copy(t.cc[index:], t.cc[index+1:])
+	t.cc[len(t.cc)-1] = nil
+	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// CcObjectValues returns an iterator over the values that GetCcObject returns for each index where IsCcObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) CcObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcObject(i) {
+				continue
+			}
+			if !yield(t.GetCcObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsCcLink determines whether the call to GetCcLink is safe for the specified index
+func (t *PropertyValue) IsCcLink(index int) (ok bool) {
+	return t.cc[index].Link != nil
+
+}
+
+// GetCcLink returns the value safely if IsCcLink returned true for the specified index
+func (t *PropertyValue) GetCcLink(index int) (v LinkType) {
+	return t.cc[index].Link
+
+}
+
+// AppendCcLink adds to the back of cc a LinkType type
+func (t *PropertyValue) AppendCcLink(v LinkType) {
+	t.cc = append(t.cc, &ccIntermediateType{Link: v})
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// PrependCcLink adds to the front of cc a LinkType type
+func (t *PropertyValue) PrependCcLink(v LinkType) {
+	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Link: v}}, t.cc...)
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// RemoveCcLink deletes the value from the specified index
+func (t *PropertyValue) RemoveCcLink(index int) {
+	copy(t.cc[index:], t.cc[index+1:])
+	t.cc[len(t.cc)-1] = nil
+	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// CcLinkValues returns an iterator over the values that GetCcLink returns for each index where IsCcLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) CcLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcLink(i) {
+				continue
+			}
+			if !yield(t.GetCcLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsCcIRI determines whether the call to GetCcIRI is safe for the specified index
+func (t *PropertyValue) IsCcIRI(index int) (ok bool) {
+	return t.cc[index].IRI != nil
+
+}
+
+// GetCcIRI returns the value safely if IsCcIRI returned true for the specified index
+func (t *PropertyValue) GetCcIRI(index int) (v *url.URL) {
+	return t.cc[index].IRI
+
+}
+
+// AppendCcIRI adds to the back of cc a *url.URL type
+func (t *PropertyValue) AppendCcIRI(v *url.URL) {
+	t.cc = append(t.cc, &ccIntermediateType{IRI: v})
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// PrependCcIRI adds to the front of cc a *url.URL type
+func (t *PropertyValue) PrependCcIRI(v *url.URL) {
+	t.cc = append([]*ccIntermediateType{&ccIntermediateType{IRI: v}}, t.cc...)
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// RemoveCcIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveCcIRI(index int) {
+	copy(t.cc[index:], t.cc[index+1:])
+	t.cc[len(t.cc)-1] = nil
+	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// CcIRIValues returns an iterator over the values that GetCcIRI returns for each index where IsCcIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) CcIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcIRI(i) {
+				continue
+			}
+			if !yield(t.GetCcIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownCc determines whether the call to GetUnknownCc is safe
+func (t *PropertyValue) HasUnknownCc() (ok bool) {
+	return t.present_[0]&(1<<29) != 0 && t.cc[0].unknown_ != nil
+
+}
+
+// GetUnknownCc returns the unknown value for cc
+func (t *PropertyValue) GetUnknownCc() (v interface{}) {
+	return t.cc[0].unknown_
+
+}
+
+// SetUnknownCc sets the unknown value of cc
+func (t *PropertyValue) SetUnknownCc(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &ccIntermediateType{}
+	tmp.unknown_ = i
+	t.cc = append(t.cc, tmp)
+	t.markPresent_(29, len(t.cc) > 0)
+
+}
+
+// BccLen determines the number of elements able to be used for the IsBccObject, GetBccObject, and RemoveBccObject functions
+func (t *PropertyValue) BccLen() (l int) {
+	return len(t.bcc)
+
+}
+
+// IsBccObject determines whether the call to GetBccObject is safe for the specified index
+func (t *PropertyValue) IsBccObject(index int) (ok bool) {
+	return t.bcc[index].Object != nil
+
+}
+
+// GetBccObject returns the value safely if IsBccObject returned true for the specified index
+func (t *PropertyValue) GetBccObject(index int) (v ObjectType) {
+	return t.bcc[index].Object
+
+}
+
+// AppendBccObject adds to the back of bcc a ObjectType type
+func (t *PropertyValue) AppendBccObject(v ObjectType) {
+	t.bcc = append(t.bcc, &bccIntermediateType{Object: v})
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// PrependBccObject adds to the front of bcc a ObjectType type
+func (t *PropertyValue) PrependBccObject(v ObjectType) {
+	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Object: v}}, t.bcc...)
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// RemoveBccObject deletes the value from the specified index
+func (t *PropertyValue) RemoveBccObject(index int) {
+	copy(t.bcc[index:], t.bcc[index+1:])
+	t.bcc[len(t.bcc)-1] = nil
+	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// BccObjectValues returns an iterator over the values that GetBccObject returns for each index where IsBccObject is true, which can be used as an iter.Seq
+func (t *PropertyValue) BccObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccObject(i) {
+				continue
+			}
+			if !yield(t.GetBccObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsBccLink determines whether the call to GetBccLink is safe for the specified index
+func (t *PropertyValue) IsBccLink(index int) (ok bool) {
+	return t.bcc[index].Link != nil
+
+}
+
+// GetBccLink returns the value safely if IsBccLink returned true for the specified index
+func (t *PropertyValue) GetBccLink(index int) (v LinkType) {
+	return t.bcc[index].Link
+
+}
+
+// AppendBccLink adds to the back of bcc a LinkType type
+func (t *PropertyValue) AppendBccLink(v LinkType) {
+	t.bcc = append(t.bcc, &bccIntermediateType{Link: v})
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// PrependBccLink adds to the front of bcc a LinkType type
+func (t *PropertyValue) PrependBccLink(v LinkType) {
+	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Link: v}}, t.bcc...)
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// RemoveBccLink deletes the value from the specified index
+func (t *PropertyValue) RemoveBccLink(index int) {
+	copy(t.bcc[index:], t.bcc[index+1:])
+	t.bcc[len(t.bcc)-1] = nil
+	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// BccLinkValues returns an iterator over the values that GetBccLink returns for each index where IsBccLink is true, which can be used as an iter.Seq
+func (t *PropertyValue) BccLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccLink(i) {
+				continue
+			}
+			if !yield(t.GetBccLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsBccIRI determines whether the call to GetBccIRI is safe for the specified index
+func (t *PropertyValue) IsBccIRI(index int) (ok bool) {
+	return t.bcc[index].IRI != nil
+
+}
+
+// GetBccIRI returns the value safely if IsBccIRI returned true for the specified index
+func (t *PropertyValue) GetBccIRI(index int) (v *url.URL) {
+	return t.bcc[index].IRI
+
+}
+
+// AppendBccIRI adds to the back of bcc a *url.URL type
+func (t *PropertyValue) AppendBccIRI(v *url.URL) {
+	t.bcc = append(t.bcc, &bccIntermediateType{IRI: v})
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// PrependBccIRI adds to the front of bcc a *url.URL type
+func (t *PropertyValue) PrependBccIRI(v *url.URL) {
+	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{IRI: v}}, t.bcc...)
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// RemoveBccIRI deletes the value from the specified index
+func (t *PropertyValue) RemoveBccIRI(index int) {
+	copy(t.bcc[index:], t.bcc[index+1:])
+	t.bcc[len(t.bcc)-1] = nil
+	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// BccIRIValues returns an iterator over the values that GetBccIRI returns for each index where IsBccIRI is true, which can be used as an iter.Seq
+func (t *PropertyValue) BccIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccIRI(i) {
+				continue
+			}
+			if !yield(t.GetBccIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownBcc determines whether the call to GetUnknownBcc is safe
+func (t *PropertyValue) HasUnknownBcc() (ok bool) {
+	return t.present_[0]&(1<<30) != 0 && t.bcc[0].unknown_ != nil
+
+}
+
+// GetUnknownBcc returns the unknown value for bcc
+func (t *PropertyValue) GetUnknownBcc() (v interface{}) {
+	return t.bcc[0].unknown_
+
+}
+
+// SetUnknownBcc sets the unknown value of bcc
+func (t *PropertyValue) SetUnknownBcc(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &bccIntermediateType{}
+	tmp.unknown_ = i
+	t.bcc = append(t.bcc, tmp)
+	t.markPresent_(30, len(t.bcc) > 0)
+
+}
+
+// IsMediaType determines whether the call to GetMediaType is safe
+func (t *PropertyValue) IsMediaType() (ok bool) {
+	return t.present_[0]&(1<<31) != 0 && t.mediaType.mimeMediaTypeValue != nil
+
+}
+
+// GetMediaType returns the value safely if IsMediaType returned true
+func (t *PropertyValue) GetMediaType() (v string) {
+	return *t.mediaType.mimeMediaTypeValue
+
+}
+
+// SetMediaType sets the value of mediaType to be of string type
+func (t *PropertyValue) SetMediaType(v string) {
+	t.mediaType = &mediaTypeIntermediateType{mimeMediaTypeValue: &v}
+	t.markPresent_(31, true)
+
+}
+
+// IsMediaTypeIRI determines whether the call to GetMediaTypeIRI is safe
+func (t *PropertyValue) IsMediaTypeIRI() (ok bool) {
+	return t.present_[0]&(1<<31) != 0 && t.mediaType.IRI != nil
+
+}
+
+// GetMediaTypeIRI returns the value safely if IsMediaTypeIRI returned true
+func (t *PropertyValue) GetMediaTypeIRI() (v *url.URL) {
+	return t.mediaType.IRI
+
+}
+
+// SetMediaTypeIRI sets the value of mediaType to be of *url.URL type
+func (t *PropertyValue) SetMediaTypeIRI(v *url.URL) {
+	t.mediaType = &mediaTypeIntermediateType{IRI: v}
+	t.markPresent_(31, true)
+
+}
+
+// HasUnknownMediaType determines whether the call to GetUnknownMediaType is safe
+func (t *PropertyValue) HasUnknownMediaType() (ok bool) {
+	return t.present_[0]&(1<<31) != 0 && t.mediaType.unknown_ != nil
+
+}
+
+// GetUnknownMediaType returns the unknown value for mediaType
+func (t *PropertyValue) GetUnknownMediaType() (v interface{}) {
+	return t.mediaType.unknown_
+
+}
+
+// SetUnknownMediaType sets the unknown value of mediaType
+func (t *PropertyValue) SetUnknownMediaType(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &mediaTypeIntermediateType{}
+	tmp.unknown_ = i
+	t.mediaType = tmp
+	t.markPresent_(31, true)
+
+}
+
+// IsDuration determines whether the call to GetDuration is safe
+func (t *PropertyValue) IsDuration() (ok bool) {
+	return t.present_[0]&(1<<32) != 0 && t.duration.duration != nil
+
+}
+
+// GetDuration returns the value safely if IsDuration returned true
+func (t *PropertyValue) GetDuration() (v time.Duration) {
+	return *t.duration.duration
+
+}
+
+// SetDuration sets the value of duration to be of time.Duration type
+func (t *PropertyValue) SetDuration(v time.Duration) {
+	t.duration = &durationIntermediateType{duration: &v}
+	t.markPresent_(32, true)
+
+}
+
+// IsDurationIRI determines whether the call to GetDurationIRI is safe
+func (t *PropertyValue) IsDurationIRI() (ok bool) {
+	return t.present_[0]&(1<<32) != 0 && t.duration.IRI != nil
+
+}
+
+// GetDurationIRI returns the value safely if IsDurationIRI returned true
+func (t *PropertyValue) GetDurationIRI() (v *url.URL) {
+	return t.duration.IRI
+
+}
+
+// SetDurationIRI sets the value of duration to be of *url.URL type
+func (t *PropertyValue) SetDurationIRI(v *url.URL) {
+	t.duration = &durationIntermediateType{IRI: v}
+	t.markPresent_(32, true)
+
+}
+
+// HasUnknownDuration determines whether the call to GetUnknownDuration is safe
+func (t *PropertyValue) HasUnknownDuration() (ok bool) {
+	return t.present_[0]&(1<<32) != 0 && t.duration.unknown_ != nil
+
+}
+
+// GetUnknownDuration returns the unknown value for duration
+func (t *PropertyValue) GetUnknownDuration() (v interface{}) {
+	return t.duration.unknown_
+
+}
+
+// SetUnknownDuration sets the unknown value of duration
+func (t *PropertyValue) SetUnknownDuration(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &durationIntermediateType{}
+	tmp.unknown_ = i
+	t.duration = tmp
+	t.markPresent_(32, true)
+
+}
+
+// IsSource determines whether the call to GetSource is safe
+func (t *PropertyValue) IsSource() (ok bool) {
+	return t.present_[0]&(1<<33) != 0 && t.source.Object != nil
+
+}
+
+// GetSource returns the value safely if IsSource returned true
+func (t *PropertyValue) GetSource() (v ObjectType) {
+	return t.source.Object
+
+}
+
+// SetSource sets the value of source to be of ObjectType type
+func (t *PropertyValue) SetSource(v ObjectType) {
+	t.source = &sourceIntermediateType{Object: v}
+	t.markPresent_(33, true)
+
+}
+
+// IsSourceIRI determines whether the call to GetSourceIRI is safe
+func (t *PropertyValue) IsSourceIRI() (ok bool) {
+	return t.present_[0]&(1<<33) != 0 && t.source.IRI != nil
+
+}
+
+// GetSourceIRI returns the value safely if IsSourceIRI returned true
+func (t *PropertyValue) GetSourceIRI() (v *url.URL) {
+	return t.source.IRI
+
+}
+
+// SetSourceIRI sets the value of source to be of *url.URL type
+func (t *PropertyValue) SetSourceIRI(v *url.URL) {
+	t.source = &sourceIntermediateType{IRI: v}
+	t.markPresent_(33, true)
+
+}
+
+// HasUnknownSource determines whether the call to GetUnknownSource is safe
+func (t *PropertyValue) HasUnknownSource() (ok bool) {
+	return t.present_[0]&(1<<33) != 0 && t.source.unknown_ != nil
+
+}
+
+// GetUnknownSource returns the unknown value for source
+func (t *PropertyValue) GetUnknownSource() (v interface{}) {
+	return t.source.unknown_
+
+}
+
+// SetUnknownSource sets the unknown value of source
+func (t *PropertyValue) SetUnknownSource(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &sourceIntermediateType{}
+	tmp.unknown_ = i
+	t.source = tmp
+	t.markPresent_(33, true)
+
+}
+
+// IsInboxOrderedCollection determines whether the call to GetInboxOrderedCollection is safe
+func (t *PropertyValue) IsInboxOrderedCollection() (ok bool) {
+	return t.present_[0]&(1<<34) != 0 && t.inbox.OrderedCollection != nil
+
+}
+
+// GetInboxOrderedCollection returns the value safely if IsInboxOrderedCollection returned true
+func (t *PropertyValue) GetInboxOrderedCollection() (v OrderedCollectionType) {
+	return t.inbox.OrderedCollection
+
+}
+
+// SetInboxOrderedCollection sets the value of inbox to be of OrderedCollectionType type
+func (t *PropertyValue) SetInboxOrderedCollection(v OrderedCollectionType) {
+	t.inbox = &inboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(34, true)
+
+}
+
+// IsInboxAnyURI determines whether the call to GetInboxAnyURI is safe
+func (t *PropertyValue) IsInboxAnyURI() (ok bool) {
+	return t.present_[0]&(1<<34) != 0 && t.inbox.anyURI != nil
+
+}
+
+// GetInboxAnyURI returns the value safely if IsInboxAnyURI returned true
+func (t *PropertyValue) GetInboxAnyURI() (v *url.URL) {
+	return t.inbox.anyURI
+
+}
+
+// SetInboxAnyURI sets the value of inbox to be of *url.URL type
+func (t *PropertyValue) SetInboxAnyURI(v *url.URL) {
+	t.inbox = &inboxIntermediateType{anyURI: v}
+	t.markPresent_(34, true)
+
+}
+
+// HasUnknownInbox determines whether the call to GetUnknownInbox is safe
+func (t *PropertyValue) HasUnknownInbox() (ok bool) {
+	return t.present_[0]&(1<<34) != 0 && t.inbox.unknown_ != nil
+
+}
+
+// GetUnknownInbox returns the unknown value for inbox
+func (t *PropertyValue) GetUnknownInbox() (v interface{}) {
+	return t.inbox.unknown_
+
+}
+
+// SetUnknownInbox sets the unknown value of inbox
+func (t *PropertyValue) SetUnknownInbox(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &inboxIntermediateType{}
+	tmp.unknown_ = i
+	t.inbox = tmp
+	t.markPresent_(34, true)
+
+}
+
+// IsOutboxOrderedCollection determines whether the call to GetOutboxOrderedCollection is safe
+func (t *PropertyValue) IsOutboxOrderedCollection() (ok bool) {
+	return t.present_[0]&(1<<35) != 0 && t.outbox.OrderedCollection != nil
+
+}
+
+// GetOutboxOrderedCollection returns the value safely if IsOutboxOrderedCollection returned true
+func (t *PropertyValue) GetOutboxOrderedCollection() (v OrderedCollectionType) {
+	return t.outbox.OrderedCollection
+
+}
+
+// SetOutboxOrderedCollection sets the value of outbox to be of OrderedCollectionType type
+func (t *PropertyValue) SetOutboxOrderedCollection(v OrderedCollectionType) {
+	t.outbox = &outboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(35, true)
+
+}
+
+// IsOutboxAnyURI determines whether the call to GetOutboxAnyURI is safe
+func (t *PropertyValue) IsOutboxAnyURI() (ok bool) {
+	return t.present_[0]&(1<<35) != 0 && t.outbox.anyURI != nil
+
+}
+
+// GetOutboxAnyURI returns the value safely if IsOutboxAnyURI returned true
+func (t *PropertyValue) GetOutboxAnyURI() (v *url.URL) {
+	return t.outbox.anyURI
+
+}
+
+// SetOutboxAnyURI sets the value of outbox to be of *url.URL type
+func (t *PropertyValue) SetOutboxAnyURI(v *url.URL) {
+	t.outbox = &outboxIntermediateType{anyURI: v}
+	t.markPresent_(35, true)
+
+}
+
+// HasUnknownOutbox determines whether the call to GetUnknownOutbox is safe
+func (t *PropertyValue) HasUnknownOutbox() (ok bool) {
+	return t.present_[0]&(1<<35) != 0 && t.outbox.unknown_ != nil
+
+}
+
+// GetUnknownOutbox returns the unknown value for outbox
+func (t *PropertyValue) GetUnknownOutbox() (v interface{}) {
+	return t.outbox.unknown_
+
+}
+
+// SetUnknownOutbox sets the unknown value of outbox
+func (t *PropertyValue) SetUnknownOutbox(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &outboxIntermediateType{}
+	tmp.unknown_ = i
+	t.outbox = tmp
+	t.markPresent_(35, true)
+
+}
+
+// IsFollowingCollection determines whether the call to GetFollowingCollection is safe
+func (t *PropertyValue) IsFollowingCollection() (ok bool) {
+	return t.present_[0]&(1<<36) != 0 && t.following.Collection != nil
+
+}
+
+// GetFollowingCollection returns the value safely if IsFollowingCollection returned true
+func (t *PropertyValue) GetFollowingCollection() (v CollectionType) {
+	return t.following.Collection
+
+}
+
+// SetFollowingCollection sets the value of following to be of CollectionType type
+func (t *PropertyValue) SetFollowingCollection(v CollectionType) {
+	t.following = &followingIntermediateType{Collection: v}
+	t.markPresent_(36, true)
+
+}
+
+// IsFollowingOrderedCollection determines whether the call to GetFollowingOrderedCollection is safe
+func (t *PropertyValue) IsFollowingOrderedCollection() (ok bool) {
+	return t.present_[0]&(1<<36) != 0 && t.following.OrderedCollection != nil
+
+}
+
+// GetFollowingOrderedCollection returns the value safely if IsFollowingOrderedCollection returned true
+func (t *PropertyValue) GetFollowingOrderedCollection() (v OrderedCollectionType) {
+	return t.following.OrderedCollection
+
+}
+
+// SetFollowingOrderedCollection sets the value of following to be of OrderedCollectionType type
+func (t *PropertyValue) SetFollowingOrderedCollection(v OrderedCollectionType) {
+	t.following = &followingIntermediateType{OrderedCollection: v}
+	t.markPresent_(36, true)
+
+}
+
+// IsFollowingAnyURI determines whether the call to GetFollowingAnyURI is safe
+func (t *PropertyValue) IsFollowingAnyURI() (ok bool) {
+	return t.present_[0]&(1<<36) != 0 && t.following.anyURI != nil
+
+}
+
+// GetFollowingAnyURI returns the value safely if IsFollowingAnyURI returned true
+func (t *PropertyValue) GetFollowingAnyURI() (v *url.URL) {
+	return t.following.anyURI
+
+}
+
+// SetFollowingAnyURI sets the value of following to be of *url.URL type
+func (t *PropertyValue) SetFollowingAnyURI(v *url.URL) {
+	t.following = &followingIntermediateType{anyURI: v}
+	t.markPresent_(36, true)
+
+}
+
+// HasUnknownFollowing determines whether the call to GetUnknownFollowing is safe
+func (t *PropertyValue) HasUnknownFollowing() (ok bool) {
+	return t.present_[0]&(1<<36) != 0 && t.following.unknown_ != nil
+
+}
+
+// GetUnknownFollowing returns the unknown value for following
+func (t *PropertyValue) GetUnknownFollowing() (v interface{}) {
+	return t.following.unknown_
+
+}
+
+// SetUnknownFollowing sets the unknown value of following
+func (t *PropertyValue) SetUnknownFollowing(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &followingIntermediateType{}
+	tmp.unknown_ = i
+	t.following = tmp
+	t.markPresent_(36, true)
+
+}
+
+// IsFollowersCollection determines whether the call to GetFollowersCollection is safe
+func (t *PropertyValue) IsFollowersCollection() (ok bool) {
+	return t.present_[0]&(1<<37) != 0 && t.followers.Collection != nil
+
+}
+
+// GetFollowersCollection returns the value safely if IsFollowersCollection returned true
+func (t *PropertyValue) GetFollowersCollection() (v CollectionType) {
+	return t.followers.Collection
+
+}
+
+// SetFollowersCollection sets the value of followers to be of CollectionType type
+func (t *PropertyValue) SetFollowersCollection(v CollectionType) {
+	t.followers = &followersIntermediateType{Collection: v}
+	t.markPresent_(37, true)
+
+}
+
+// IsFollowersOrderedCollection determines whether the call to GetFollowersOrderedCollection is safe
+func (t *PropertyValue) IsFollowersOrderedCollection() (ok bool) {
+	return t.present_[0]&(1<<37) != 0 && t.followers.OrderedCollection != nil
+
+}
+
+// GetFollowersOrderedCollection returns the value safely if IsFollowersOrderedCollection returned true
+func (t *PropertyValue) GetFollowersOrderedCollection() (v OrderedCollectionType) {
+	return t.followers.OrderedCollection
+
+}
+
+// SetFollowersOrderedCollection sets the value of followers to be of OrderedCollectionType type
+func (t *PropertyValue) SetFollowersOrderedCollection(v OrderedCollectionType) {
+	t.followers = &followersIntermediateType{OrderedCollection: v}
+	t.markPresent_(37, true)
+
+}
+
+// IsFollowersAnyURI determines whether the call to GetFollowersAnyURI is safe
+func (t *PropertyValue) IsFollowersAnyURI() (ok bool) {
+	return t.present_[0]&(1<<37) != 0 && t.followers.anyURI != nil
+
+}
+
+// GetFollowersAnyURI returns the value safely if IsFollowersAnyURI returned true
+func (t *PropertyValue) GetFollowersAnyURI() (v *url.URL) {
+	return t.followers.anyURI
+
+}
+
+// SetFollowersAnyURI sets the value of followers to be of *url.URL type
+func (t *PropertyValue) SetFollowersAnyURI(v *url.URL) {
+	t.followers = &followersIntermediateType{anyURI: v}
+	t.markPresent_(37, true)
+
+}
+
+// HasUnknownFollowers determines whether the call to GetUnknownFollowers is safe
+func (t *PropertyValue) HasUnknownFollowers() (ok bool) {
+	return t.present_[0]&(1<<37) != 0 && t.followers.unknown_ != nil
+
+}
+
+// GetUnknownFollowers returns the unknown value for followers
+func (t *PropertyValue) GetUnknownFollowers() (v interface{}) {
+	return t.followers.unknown_
+
+}
+
+// SetUnknownFollowers sets the unknown value of followers
+func (t *PropertyValue) SetUnknownFollowers(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &followersIntermediateType{}
+	tmp.unknown_ = i
+	t.followers = tmp
+	t.markPresent_(37, true)
+
+}
+
+// IsLikedCollection determines whether the call to GetLikedCollection is safe
+func (t *PropertyValue) IsLikedCollection() (ok bool) {
+	return t.present_[0]&(1<<38) != 0 && t.liked.Collection != nil
+
+}
+
+// GetLikedCollection returns the value safely if IsLikedCollection returned true
+func (t *PropertyValue) GetLikedCollection() (v CollectionType) {
+	return t.liked.Collection
+
+}
+
+// SetLikedCollection sets the value of liked to be of CollectionType type
+func (t *PropertyValue) SetLikedCollection(v CollectionType) {
+	t.liked = &likedIntermediateType{Collection: v}
+	t.markPresent_(38, true)
+
+}
+
+// IsLikedOrderedCollection determines whether the call to GetLikedOrderedCollection is safe
+func (t *PropertyValue) IsLikedOrderedCollection() (ok bool) {
+	return t.present_[0]&(1<<38) != 0 && t.liked.OrderedCollection != nil
+
+}
+
+// GetLikedOrderedCollection returns the value safely if IsLikedOrderedCollection returned true
+func (t *PropertyValue) GetLikedOrderedCollection() (v OrderedCollectionType) {
+	return t.liked.OrderedCollection
+
+}
+
+// SetLikedOrderedCollection sets the value of liked to be of OrderedCollectionType type
+func (t *PropertyValue) SetLikedOrderedCollection(v OrderedCollectionType) {
+	t.liked = &likedIntermediateType{OrderedCollection: v}
+	t.markPresent_(38, true)
+
+}
+
+// IsLikedAnyURI determines whether the call to GetLikedAnyURI is safe
+func (t *PropertyValue) IsLikedAnyURI() (ok bool) {
+	return t.present_[0]&(1<<38) != 0 && t.liked.anyURI != nil
+
+}
+
+// GetLikedAnyURI returns the value safely if IsLikedAnyURI returned true
+func (t *PropertyValue) GetLikedAnyURI() (v *url.URL) {
+	return t.liked.anyURI
+
+}
+
+// SetLikedAnyURI sets the value of liked to be of *url.URL type
+func (t *PropertyValue) SetLikedAnyURI(v *url.URL) {
+	t.liked = &likedIntermediateType{anyURI: v}
+	t.markPresent_(38, true)
+
+}
+
+// HasUnknownLiked determines whether the call to GetUnknownLiked is safe
+func (t *PropertyValue) HasUnknownLiked() (ok bool) {
+	return t.present_[0]&(1<<38) != 0 && t.liked.unknown_ != nil
+
+}
+
+// GetUnknownLiked returns the unknown value for liked
+func (t *PropertyValue) GetUnknownLiked() (v interface{}) {
+	return t.liked.unknown_
+
+}
+
+// SetUnknownLiked sets the unknown value of liked
+func (t *PropertyValue) SetUnknownLiked(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &likedIntermediateType{}
+	tmp.unknown_ = i
+	t.liked = tmp
+	t.markPresent_(38, true)
+
+}
+
+// IsLikesCollection determines whether the call to GetLikesCollection is safe
+func (t *PropertyValue) IsLikesCollection() (ok bool) {
+	return t.present_[0]&(1<<39) != 0 && t.likes.Collection != nil
+
+}
+
+// GetLikesCollection returns the value safely if IsLikesCollection returned true
+func (t *PropertyValue) GetLikesCollection() (v CollectionType) {
+	return t.likes.Collection
+
+}
+
+// SetLikesCollection sets the value of likes to be of CollectionType type
+func (t *PropertyValue) SetLikesCollection(v CollectionType) {
+	t.likes = &likesIntermediateType{Collection: v}
+	t.markPresent_(39, true)
+
+}
+
+// IsLikesOrderedCollection determines whether the call to GetLikesOrderedCollection is safe
+func (t *PropertyValue) IsLikesOrderedCollection() (ok bool) {
+	return t.present_[0]&(1<<39) != 0 && t.likes.OrderedCollection != nil
+
+}
+
+// GetLikesOrderedCollection returns the value safely if IsLikesOrderedCollection returned true
+func (t *PropertyValue) GetLikesOrderedCollection() (v OrderedCollectionType) {
+	return t.likes.OrderedCollection
+
+}
+
+// SetLikesOrderedCollection sets the value of likes to be of OrderedCollectionType type
+func (t *PropertyValue) SetLikesOrderedCollection(v OrderedCollectionType) {
+	t.likes = &likesIntermediateType{OrderedCollection: v}
+	t.markPresent_(39, true)
+
+}
+
+// IsLikesAnyURI determines whether the call to GetLikesAnyURI is safe
+func (t *PropertyValue) IsLikesAnyURI() (ok bool) {
+	return t.present_[0]&(1<<39) != 0 && t.likes.anyURI != nil
+
+}
+
+// GetLikesAnyURI returns the value safely if IsLikesAnyURI returned true
+func (t *PropertyValue) GetLikesAnyURI() (v *url.URL) {
+	return t.likes.anyURI
+
+}
+
+// SetLikesAnyURI sets the value of likes to be of *url.URL type
+func (t *PropertyValue) SetLikesAnyURI(v *url.URL) {
+	t.likes = &likesIntermediateType{anyURI: v}
+	t.markPresent_(39, true)
+
+}
+
+// HasUnknownLikes determines whether the call to GetUnknownLikes is safe
+func (t *PropertyValue) HasUnknownLikes() (ok bool) {
+	return t.present_[0]&(1<<39) != 0 && t.likes.unknown_ != nil
+
+}
+
+// GetUnknownLikes returns the unknown value for likes
+func (t *PropertyValue) GetUnknownLikes() (v interface{}) {
+	return t.likes.unknown_
+
+}
+
+// SetUnknownLikes sets the unknown value of likes
+func (t *PropertyValue) SetUnknownLikes(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &likesIntermediateType{}
+	tmp.unknown_ = i
+	t.likes = tmp
+	t.markPresent_(39, true)
+
+}
+
+// StreamsLen determines the number of elements able to be used for the GetStreams and RemoveStreams functions
+func (t *PropertyValue) StreamsLen() (l int) {
+	return len(t.streams)
+
+}
+
+// GetStreams returns the value for the specified index
+func (t *PropertyValue) GetStreams(index int) (v *url.URL) {
+	return t.streams[index]
+
+}
+
+// AppendStreams adds a value to the back of streams
+func (t *PropertyValue) AppendStreams(v *url.URL) {
+	t.streams = append(t.streams, v)
+	t.markPresent_(40, len(t.streams) > 0)
+
+}
+
+// PrependStreams adds a value to the front of streams
+func (t *PropertyValue) PrependStreams(v *url.URL) {
+	t.streams = append([]*url.URL{v}, t.streams...)
+	t.markPresent_(40, len(t.streams) > 0)
+
+}
+
+// RemoveStreams deletes the value from the specified index
+func (t *PropertyValue) RemoveStreams(index int) {
+	copy(t.streams[index:], t.streams[index+1:])
+	t.streams[len(t.streams)-1] = nil
+	t.streams = t.streams[:len(t.streams)-1]
+	t.markPresent_(40, len(t.streams) > 0)
+
+}
+
+// HasUnknownStreams determines whether the call to GetUnknownStreams is safe
+func (t *PropertyValue) HasUnknownStreams() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["streams"] != nil
+
+}
+
+// GetUnknownStreams returns the unknown value for streams
+func (t *PropertyValue) GetUnknownStreams() (v interface{}) {
+	return t.unknown_["streams"]
+
+}
+
+// SetUnknownStreams sets the unknown value of streams
+func (t *PropertyValue) SetUnknownStreams(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["streams"] = i
+
+}
+
+// StreamsValues returns an iterator over the values that GetStreams returns, which can be used as an iter.Seq
+func (t *PropertyValue) StreamsValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.StreamsLen(); i++ {
+			if !yield(t.GetStreams(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsPreferredUsername determines whether the call to GetPreferredUsername is safe
+func (t *PropertyValue) IsPreferredUsername() (ok bool) {
+	return t.present_[0]&(1<<41) != 0 && t.preferredUsername.stringName != nil
+
+}
+
+// GetPreferredUsername returns the value safely if IsPreferredUsername returned true
+func (t *PropertyValue) GetPreferredUsername() (v string) {
+	return *t.preferredUsername.stringName
+
+}
+
+// SetPreferredUsername sets the value of preferredUsername to be of string type
+func (t *PropertyValue) SetPreferredUsername(v string) {
+	t.preferredUsername = &preferredUsernameIntermediateType{stringName: &v}
+	t.markPresent_(41, true)
+
+}
+
+// IsPreferredUsernameIRI determines whether the call to GetPreferredUsernameIRI is safe
+func (t *PropertyValue) IsPreferredUsernameIRI() (ok bool) {
+	return t.present_[0]&(1<<41) != 0 && t.preferredUsername.IRI != nil
+
+}
+
+// GetPreferredUsernameIRI returns the value safely if IsPreferredUsernameIRI returned true
+func (t *PropertyValue) GetPreferredUsernameIRI() (v *url.URL) {
+	return t.preferredUsername.IRI
+
+}
+
+// SetPreferredUsernameIRI sets the value of preferredUsername to be of *url.URL type
+func (t *PropertyValue) SetPreferredUsernameIRI(v *url.URL) {
+	t.preferredUsername = &preferredUsernameIntermediateType{IRI: v}
+	t.markPresent_(41, true)
+
+}
+
+// HasUnknownPreferredUsername determines whether the call to GetUnknownPreferredUsername is safe
+func (t *PropertyValue) HasUnknownPreferredUsername() (ok bool) {
+	return t.present_[0]&(1<<41) != 0 && t.preferredUsername.unknown_ != nil
+
+}
+
+// GetUnknownPreferredUsername returns the unknown value for preferredUsername
+func (t *PropertyValue) GetUnknownPreferredUsername() (v interface{}) {
+	return t.preferredUsername.unknown_
+
+}
+
+// SetUnknownPreferredUsername sets the unknown value of preferredUsername
+func (t *PropertyValue) SetUnknownPreferredUsername(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &preferredUsernameIntermediateType{}
+	tmp.unknown_ = i
+	t.preferredUsername = tmp
+	t.markPresent_(41, true)
+
+}
+
+// PreferredUsernameMapLanguages returns all languages for this property's language mapping, or nil if there are none.
+func (t *PropertyValue) PreferredUsernameMapLanguages() (l []string) {
+	if t.preferredUsernameMap == nil || len(t.preferredUsernameMap) == 0 {
+		return nil
+	}
+	for k := range t.preferredUsernameMap {
+		l = append(l, k)
+	}
+	return
+
+}
+
+// GetPreferredUsernameMap retrieves the value of the property for the specified language, or an empty string if it does not exist
+func (t *PropertyValue) GetPreferredUsernameMap(l string) (v string) {
+	if t.preferredUsernameMap == nil {
+		return ""
+	}
+	ok := false
+	v, ok = t.preferredUsernameMap[l]
+	if !ok {
+		return ""
+	}
+	return v
+
+}
+
+// SetPreferredUsernameMap sets the value of the property for the specified language
+func (t *PropertyValue) SetPreferredUsernameMap(l string, v string) {
+	if t.preferredUsernameMap == nil {
+		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(42, true)
+	}
+	t.preferredUsernameMap[l] = v
+
+}
+
+// GetPreferredUsernameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *PropertyValue) GetPreferredUsernameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.preferredUsernameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.preferredUsernameMap[k], true
+
+}
+
+// SetPreferredUsernameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *PropertyValue) SetPreferredUsernameLanguage(tag string, v string) {
+	if t.preferredUsernameMap == nil {
+		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(42, true)
+	} else if k, ok := languageKey(t.preferredUsernameMap, tag); ok {
+		delete(t.preferredUsernameMap, k)
+	}
+	t.preferredUsernameMap[tag] = v
+
+}
+
+// PreferredPreferredUsernameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *PropertyValue) PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.preferredUsernameMap, tags)
+	return t.preferredUsernameMap[tag], tag
+
+}
+
+// IsEndpoints determines whether the call to GetEndpoints is safe
+func (t *PropertyValue) IsEndpoints() (ok bool) {
+	return t.present_[0]&(1<<43) != 0 && t.endpoints.Object != nil
+
+}
+
+// GetEndpoints returns the value safely if IsEndpoints returned true
+func (t *PropertyValue) GetEndpoints() (v ObjectType) {
+	return t.endpoints.Object
+
+}
+
+// SetEndpoints sets the value of endpoints to be of ObjectType type
+func (t *PropertyValue) SetEndpoints(v ObjectType) {
+	t.endpoints = &endpointsIntermediateType{Object: v}
+	t.markPresent_(43, true)
+
+}
+
+// IsEndpointsIRI determines whether the call to GetEndpointsIRI is safe
+func (t *PropertyValue) IsEndpointsIRI() (ok bool) {
+	return t.present_[0]&(1<<43) != 0 && t.endpoints.IRI != nil
+
+}
+
+// GetEndpointsIRI returns the value safely if IsEndpointsIRI returned true
+func (t *PropertyValue) GetEndpointsIRI() (v *url.URL) {
+	return t.endpoints.IRI
+
+}
+
+// SetEndpointsIRI sets the value of endpoints to be of *url.URL type
+func (t *PropertyValue) SetEndpointsIRI(v *url.URL) {
+	t.endpoints = &endpointsIntermediateType{IRI: v}
+	t.markPresent_(43, true)
+
+}
+
+// HasUnknownEndpoints determines whether the call to GetUnknownEndpoints is safe
+func (t *PropertyValue) HasUnknownEndpoints() (ok bool) {
+	return t.present_[0]&(1<<43) != 0 && t.endpoints.unknown_ != nil
+
+}
+
+// GetUnknownEndpoints returns the unknown value for endpoints
+func (t *PropertyValue) GetUnknownEndpoints() (v interface{}) {
+	return t.endpoints.unknown_
+
+}
+
+// SetUnknownEndpoints sets the unknown value of endpoints
+func (t *PropertyValue) SetUnknownEndpoints(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &endpointsIntermediateType{}
+	tmp.unknown_ = i
+	t.endpoints = tmp
+	t.markPresent_(43, true)
+
+}
+
+// HasProxyUrl determines whether the call to GetProxyUrl is safe
+func (t *PropertyValue) HasProxyUrl() (ok bool) {
+	return t.present_[0]&(1<<44) != 0
+
+}
+
+// GetProxyUrl returns the value for proxyUrl
+func (t *PropertyValue) GetProxyUrl() (v *url.URL) {
+	return t.proxyUrl
+
+}
+
+// SetProxyUrl sets the value of proxyUrl
+func (t *PropertyValue) SetProxyUrl(v *url.URL) {
+	t.proxyUrl = v
+	t.markPresent_(44, true)
+
+}
+
+// HasUnknownProxyUrl determines whether the call to GetUnknownProxyUrl is safe
+func (t *PropertyValue) HasUnknownProxyUrl() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["proxyUrl"] != nil
+
+}
+
+// GetUnknownProxyUrl returns the unknown value for proxyUrl
+func (t *PropertyValue) GetUnknownProxyUrl() (v interface{}) {
+	return t.unknown_["proxyUrl"]
+
+}
+
+// SetUnknownProxyUrl sets the unknown value of proxyUrl
+func (t *PropertyValue) SetUnknownProxyUrl(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["proxyUrl"] = i
+
+}
+
+// HasOauthAuthorizationEndpoint determines whether the call to GetOauthAuthorizationEndpoint is safe
+func (t *PropertyValue) HasOauthAuthorizationEndpoint() (ok bool) {
+	return t.present_[0]&(1<<45) != 0
+
+}
+
+// GetOauthAuthorizationEndpoint returns the value for oauthAuthorizationEndpoint
+func (t *PropertyValue) GetOauthAuthorizationEndpoint() (v *url.URL) {
+	return t.oauthAuthorizationEndpoint
+
+}
+
+// SetOauthAuthorizationEndpoint sets the value of oauthAuthorizationEndpoint
+func (t *PropertyValue) SetOauthAuthorizationEndpoint(v *url.URL) {
+	t.oauthAuthorizationEndpoint = v
+	t.markPresent_(45, true)
+
+}
+
+// HasUnknownOauthAuthorizationEndpoint determines whether the call to GetUnknownOauthAuthorizationEndpoint is safe
+func (t *PropertyValue) HasUnknownOauthAuthorizationEndpoint() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["oauthAuthorizationEndpoint"] != nil
+
+}
+
+// GetUnknownOauthAuthorizationEndpoint returns the unknown value for oauthAuthorizationEndpoint
+func (t *PropertyValue) GetUnknownOauthAuthorizationEndpoint() (v interface{}) {
+	return t.unknown_["oauthAuthorizationEndpoint"]
+
+}
+
+// SetUnknownOauthAuthorizationEndpoint sets the unknown value of oauthAuthorizationEndpoint
+func (t *PropertyValue) SetUnknownOauthAuthorizationEndpoint(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["oauthAuthorizationEndpoint"] = i
+
+}
+
+// HasOauthTokenEndpoint determines whether the call to GetOauthTokenEndpoint is safe
+func (t *PropertyValue) HasOauthTokenEndpoint() (ok bool) {
+	return t.present_[0]&(1<<46) != 0
+
+}
+
+// GetOauthTokenEndpoint returns the value for oauthTokenEndpoint
+func (t *PropertyValue) GetOauthTokenEndpoint() (v *url.URL) {
+	return t.oauthTokenEndpoint
+
+}
+
+// SetOauthTokenEndpoint sets the value of oauthTokenEndpoint
+func (t *PropertyValue) SetOauthTokenEndpoint(v *url.URL) {
+	t.oauthTokenEndpoint = v
+	t.markPresent_(46, true)
+
+}
+
+// HasUnknownOauthTokenEndpoint determines whether the call to GetUnknownOauthTokenEndpoint is safe
+func (t *PropertyValue) HasUnknownOauthTokenEndpoint() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["oauthTokenEndpoint"] != nil
+
+}
+
+// GetUnknownOauthTokenEndpoint returns the unknown value for oauthTokenEndpoint
+func (t *PropertyValue) GetUnknownOauthTokenEndpoint() (v interface{}) {
+	return t.unknown_["oauthTokenEndpoint"]
+
+}
+
+// SetUnknownOauthTokenEndpoint sets the unknown value of oauthTokenEndpoint
+func (t *PropertyValue) SetUnknownOauthTokenEndpoint(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["oauthTokenEndpoint"] = i
+
+}
+
+// HasProvideClientKey determines whether the call to GetProvideClientKey is safe
+func (t *PropertyValue) HasProvideClientKey() (ok bool) {
+	return t.present_[0]&(1<<47) != 0
+
+}
+
+// GetProvideClientKey returns the value for provideClientKey
+func (t *PropertyValue) GetProvideClientKey() (v *url.URL) {
+	return t.provideClientKey
+
+}
+
+// SetProvideClientKey sets the value of provideClientKey
+func (t *PropertyValue) SetProvideClientKey(v *url.URL) {
+	t.provideClientKey = v
+	t.markPresent_(47, true)
+
+}
+
+// HasUnknownProvideClientKey determines whether the call to GetUnknownProvideClientKey is safe
+func (t *PropertyValue) HasUnknownProvideClientKey() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["provideClientKey"] != nil
+
+}
+
+// GetUnknownProvideClientKey returns the unknown value for provideClientKey
+func (t *PropertyValue) GetUnknownProvideClientKey() (v interface{}) {
+	return t.unknown_["provideClientKey"]
+
+}
+
+// SetUnknownProvideClientKey sets the unknown value of provideClientKey
+func (t *PropertyValue) SetUnknownProvideClientKey(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["provideClientKey"] = i
+
+}
+
+// HasSignClientKey determines whether the call to GetSignClientKey is safe
+func (t *PropertyValue) HasSignClientKey() (ok bool) {
+	return t.present_[0]&(1<<48) != 0
+
+}
+
+// GetSignClientKey returns the value for signClientKey
+func (t *PropertyValue) GetSignClientKey() (v *url.URL) {
+	return t.signClientKey
+
+}
+
+// SetSignClientKey sets the value of signClientKey
+func (t *PropertyValue) SetSignClientKey(v *url.URL) {
+	t.signClientKey = v
+	t.markPresent_(48, true)
+
+}
+
+// HasUnknownSignClientKey determines whether the call to GetUnknownSignClientKey is safe
+func (t *PropertyValue) HasUnknownSignClientKey() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["signClientKey"] != nil
+
+}
+
+// GetUnknownSignClientKey returns the unknown value for signClientKey
+func (t *PropertyValue) GetUnknownSignClientKey() (v interface{}) {
+	return t.unknown_["signClientKey"]
+
+}
+
+// SetUnknownSignClientKey sets the unknown value of signClientKey
+func (t *PropertyValue) SetUnknownSignClientKey(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["signClientKey"] = i
+
+}
+
+// HasSharedInbox determines whether the call to GetSharedInbox is safe
+func (t *PropertyValue) HasSharedInbox() (ok bool) {
+	return t.present_[0]&(1<<49) != 0
+
+}
+
+// GetSharedInbox returns the value for sharedInbox
+func (t *PropertyValue) GetSharedInbox() (v *url.URL) {
+	return t.sharedInbox
+
+}
+
+// SetSharedInbox sets the value of sharedInbox
+func (t *PropertyValue) SetSharedInbox(v *url.URL) {
+	t.sharedInbox = v
+	t.markPresent_(49, true)
+
+}
+
+// HasUnknownSharedInbox determines whether the call to GetUnknownSharedInbox is safe
+func (t *PropertyValue) HasUnknownSharedInbox() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["sharedInbox"] != nil
+
+}
+
+// GetUnknownSharedInbox returns the unknown value for sharedInbox
+func (t *PropertyValue) GetUnknownSharedInbox() (v interface{}) {
+	return t.unknown_["sharedInbox"]
+
+}
+
+// SetUnknownSharedInbox sets the unknown value of sharedInbox
+func (t *PropertyValue) SetUnknownSharedInbox(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["sharedInbox"] = i
+
+}
+
+// IsSharesCollection determines whether the call to GetSharesCollection is safe
+func (t *PropertyValue) IsSharesCollection() (ok bool) {
+	return t.present_[0]&(1<<50) != 0 && t.shares.Collection != nil
+
+}
+
+// GetSharesCollection returns the value safely if IsSharesCollection returned true
+func (t *PropertyValue) GetSharesCollection() (v CollectionType) {
+	return t.shares.Collection
+
+}
+
+// SetSharesCollection sets the value of shares to be of CollectionType type
+func (t *PropertyValue) SetSharesCollection(v CollectionType) {
+	t.shares = &sharesIntermediateType{Collection: v}
+	t.markPresent_(50, true)
+
+}
+
+// IsSharesOrderedCollection determines whether the call to GetSharesOrderedCollection is safe
+func (t *PropertyValue) IsSharesOrderedCollection() (ok bool) {
+	return t.present_[0]&(1<<50) != 0 && t.shares.OrderedCollection != nil
+
+}
+
+// GetSharesOrderedCollection returns the value safely if IsSharesOrderedCollection returned true
+func (t *PropertyValue) GetSharesOrderedCollection() (v OrderedCollectionType) {
+	return t.shares.OrderedCollection
+
+}
+
+// SetSharesOrderedCollection sets the value of shares to be of OrderedCollectionType type
+func (t *PropertyValue) SetSharesOrderedCollection(v OrderedCollectionType) {
+	t.shares = &sharesIntermediateType{OrderedCollection: v}
+	t.markPresent_(50, true)
+
+}
+
+// IsSharesAnyURI determines whether the call to GetSharesAnyURI is safe
+func (t *PropertyValue) IsSharesAnyURI() (ok bool) {
+	return t.present_[0]&(1<<50) != 0 && t.shares.anyURI != nil
+
+}
+
+// GetSharesAnyURI returns the value safely if IsSharesAnyURI returned true
+func (t *PropertyValue) GetSharesAnyURI() (v *url.URL) {
+	return t.shares.anyURI
+
+}
+
+// SetSharesAnyURI sets the value of shares to be of *url.URL type
+func (t *PropertyValue) SetSharesAnyURI(v *url.URL) {
+	t.shares = &sharesIntermediateType{anyURI: v}
+	t.markPresent_(50, true)
+
+}
+
+// HasUnknownShares determines whether the call to GetUnknownShares is safe
+func (t *PropertyValue) HasUnknownShares() (ok bool) {
+	return t.present_[0]&(1<<50) != 0 && t.shares.unknown_ != nil
+
+}
+
+// GetUnknownShares returns the unknown value for shares
+func (t *PropertyValue) GetUnknownShares() (v interface{}) {
+	return t.shares.unknown_
+
+}
+
+// SetUnknownShares sets the unknown value of shares
+func (t *PropertyValue) SetUnknownShares(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &sharesIntermediateType{}
+	tmp.unknown_ = i
+	t.shares = tmp
+	t.markPresent_(50, true)
+
+}
+
+// AddUnknown adds an unknown property to this object with the specified key
+func (t *PropertyValue) AddUnknown(k string, i interface{}) (this *PropertyValue) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+	return t
+
+}
+
+// HasUnknown returns true if there is an unknown property with the specified key
+func (t *PropertyValue) HasUnknown(k string) (b bool) {
+	if t.unknown_ == nil {
+		return false
+	}
+	_, ok := t.unknown_[k]
+	return ok
+
+}
+
+// RemoveUnknown removes a raw extension from this object with the specified key
+func (t *PropertyValue) RemoveUnknown(k string) (this *PropertyValue) {
+	delete(t.unknown_, k)
+	return t
+
+}
+
+// GetUnknown fetches an unknown property from this object with the specified key. Note that this will panic if HasUnknown would return false.
+func (t *PropertyValue) GetUnknown(k string) (i interface{}) {
+	return t.unknown_[k]
+
+}
+
+// GetUnknownProperties returns a copy of every unknown property of this object by key, such as the extension properties it was deserialized with, or nil if there are none. Serialize writes them back, so they are never discarded.
+func (t *PropertyValue) GetUnknownProperties() (m map[string]interface{}) {
+	if len(t.unknown_) == 0 {
+		return nil
+	}
+	m = make(map[string]interface{}, len(t.unknown_))
+	for k, v := range t.unknown_ {
+		m[k] = v
+	}
+	return
+
+}
+
+// SetUnknownProperty sets the unknown property of this object with the specified key, or removes it if i is nil. Unlike AddUnknown, it does not return the object, so that every type shares its signature and extension vocabularies can set their properties of any type.
+func (t *PropertyValue) SetUnknownProperty(k string, i interface{}) {
+	if i == nil {
+		delete(t.unknown_, k)
+		return
+	}
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+
+}
+
+// Serialize turns this object into a map[string]interface{}. Note that the "type" property will automatically be populated with "PropertyValue" if not manually set by the caller
+func (t *PropertyValue) Serialize() (m map[string]interface{}, err error) {
+	m = make(map[string]interface{})
+	for k, v := range t.unknown_ {
+		m[k] = unknownValueSerialize(v)
+	}
+	var typeAlreadySet bool
+	for _, k := range t.typeName {
+		if ks, ok := k.(string); ok {
+			if ks == "PropertyValue" {
+				typeAlreadySet = true
+				break
+			}
+		}
+	}
+	if !typeAlreadySet {
+		t.typeName = append(t.typeName, "PropertyValue")
+		t.markPresent_(24, len(t.typeName) > 0)
+	}
+	if t.present_[0]&(1<<0) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.value != nil {
+			if v, err := serializeValueIntermediateType(t.value); err == nil {
+				m["value"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<1) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.altitude != nil {
+			if v, err := serializeAltitudeIntermediateType(t.altitude); err == nil {
+				m["altitude"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<2) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttachmentIntermediateType(t.attachment); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attachment", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<3) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attributedTo", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<4) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAudienceIntermediateType(t.audience); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "audience", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<5) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContentIntermediateType(t.content); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "content", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+
+	if t.present_[0]&(1<<6) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.contentMap != nil && len(t.contentMap) >= 0 {
+			m["contentMap"] = t.contentMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<7) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContextIntermediateType(t.context); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "context", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<8) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceNameIntermediateType(t.name); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "name", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+
+	if t.present_[0]&(1<<9) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.nameMap != nil && len(t.nameMap) >= 0 {
+			m["nameMap"] = t.nameMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<10) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endTime != nil {
+			if v, err := serializeEndTimeIntermediateType(t.endTime); err == nil {
+				m["endTime"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<11) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceGeneratorIntermediateType(t.generator); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "generator", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<12) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceIconIntermediateType(t.icon); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "icon", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<13) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.id != nil {
+			idSerializeFunc := func() (interface{}, error) {
+				v := t.id
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			idResult, err := idSerializeFunc()
+			if err == nil {
+				m["id"] = idResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<14) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceImageIntermediateType(t.image); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "image", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<15) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceInReplyToIntermediateType(t.inReplyTo); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "inReplyTo", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<16) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceLocationIntermediateType(t.location); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "location", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<17) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSlicePreviewIntermediateType(t.preview); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "preview", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<18) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.published != nil {
+			if v, err := serializePublishedIntermediateType(t.published); err == nil {
+				m["published"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<19) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.replies != nil {
+			if v, err := serializeRepliesIntermediateType(t.replies); err == nil {
+				m["replies"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<20) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.startTime != nil {
+			if v, err := serializeStartTimeIntermediateType(t.startTime); err == nil {
+				m["startTime"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<21) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceSummaryIntermediateType(t.summary); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "summary", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+
+	if t.present_[0]&(1<<22) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.summaryMap != nil && len(t.summaryMap) >= 0 {
+			m["summaryMap"] = t.summaryMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<23) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceTagIntermediateType(t.tag); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "tag", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<24) != 0 {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if t.typeName != nil {
+			if len(t.typeName) == 1 {
+				m["type"] = t.typeName[0]
+			} else {
+				m["type"] = t.typeName
+			}
+		}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if t.present_[0]&(1<<25) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.updated != nil {
+			if v, err := serializeUpdatedIntermediateType(t.updated); err == nil {
+				m["updated"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<26) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceUrlIntermediateType(t.url); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "url", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<27) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceToIntermediateType(t.to); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "to", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<28) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBtoIntermediateType(t.bto); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bto", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<29) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceCcIntermediateType(t.cc); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "cc", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<30) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBccIntermediateType(t.bcc); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bcc", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<31) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.mediaType != nil {
+			if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
+				m["mediaType"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<32) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.duration != nil {
+			if v, err := serializeDurationIntermediateType(t.duration); err == nil {
+				m["duration"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<33) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.source != nil {
+			if v, err := serializeSourceIntermediateType(t.source); err == nil {
+				m["source"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<34) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.inbox != nil {
+			if v, err := serializeInboxIntermediateType(t.inbox); err == nil {
+				m["inbox"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<35) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.outbox != nil {
+			if v, err := serializeOutboxIntermediateType(t.outbox); err == nil {
+				m["outbox"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<36) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.following != nil {
+			if v, err := serializeFollowingIntermediateType(t.following); err == nil {
+				m["following"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<37) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.followers != nil {
+			if v, err := serializeFollowersIntermediateType(t.followers); err == nil {
+				m["followers"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<38) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.liked != nil {
+			if v, err := serializeLikedIntermediateType(t.liked); err == nil {
+				m["liked"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<39) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.likes != nil {
+			if v, err := serializeLikesIntermediateType(t.likes); err == nil {
+				m["likes"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<40) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		var streamsTemp []interface{}
+		for _, v := range t.streams {
+			tmp := anyURISerialize(v)
+			streamsTemp = append(streamsTemp, tmp)
+		}
+		if streamsTemp != nil {
+			if len(streamsTemp) == 1 {
+				m["streams"] = streamsTemp[0]
+			} else {
+				m["streams"] = streamsTemp
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<41) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.preferredUsername != nil {
+			if v, err := serializePreferredUsernameIntermediateType(t.preferredUsername); err == nil {
+				m["preferredUsername"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+
+	if t.present_[0]&(1<<42) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.preferredUsernameMap != nil && len(t.preferredUsernameMap) >= 0 {
+			m["preferredUsernameMap"] = t.preferredUsernameMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<43) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endpoints != nil {
+			if v, err := serializeEndpointsIntermediateType(t.endpoints); err == nil {
+				m["endpoints"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<44) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.proxyUrl != nil {
+			proxyUrlSerializeFunc := func() (interface{}, error) {
+				v := t.proxyUrl
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			proxyUrlResult, err := proxyUrlSerializeFunc()
+			if err == nil {
+				m["proxyUrl"] = proxyUrlResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<45) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthAuthorizationEndpoint != nil {
+			oauthAuthorizationEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthAuthorizationEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			oauthAuthorizationEndpointResult, err := oauthAuthorizationEndpointSerializeFunc()
+			if err == nil {
+				m["oauthAuthorizationEndpoint"] = oauthAuthorizationEndpointResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<46) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthTokenEndpoint != nil {
+			oauthTokenEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthTokenEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			oauthTokenEndpointResult, err := oauthTokenEndpointSerializeFunc()
+			if err == nil {
+				m["oauthTokenEndpoint"] = oauthTokenEndpointResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<47) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.provideClientKey != nil {
+			provideClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.provideClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			provideClientKeyResult, err := provideClientKeySerializeFunc()
+			if err == nil {
+				m["provideClientKey"] = provideClientKeyResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<48) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.signClientKey != nil {
+			signClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.signClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			signClientKeyResult, err := signClientKeySerializeFunc()
+			if err == nil {
+				m["signClientKey"] = signClientKeyResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<49) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.sharedInbox != nil {
+			sharedInboxSerializeFunc := func() (interface{}, error) {
+				v := t.sharedInbox
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			sharedInboxResult, err := sharedInboxSerializeFunc()
+			if err == nil {
+				m["sharedInbox"] = sharedInboxResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<50) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.shares != nil {
+			if v, err := serializeSharesIntermediateType(t.shares); err == nil {
+				m["shares"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	return
+
+}
+
+// Deserialize populates this object from a map[string]interface{}, renaming the properties its '@context' aliases with ResolveAliases
+func (t *PropertyValue) Deserialize(m map[string]interface{}) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	m = core.ResolveAliases(m)
+	for k, v := range m {
+		if err = t.deserializeProperty_(k, v); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	return
+
+}
+
+// deserializeProperty_ populates the property of this object with the key, or its unknown property if it has none with the key
+func (t *PropertyValue) deserializeProperty_(k string, v interface{}) (err error) {
+	handled := false
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "value" {
+			t.value, err = deserializeValueIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "altitude" {
+			t.altitude, err = deserializeAltitudeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attachment" {
+			t.attachment, err = deserializeValuesAttachmentIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attributedTo" {
+			t.attributedTo, err = deserializeValuesAttributedToIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "audience" {
+			t.audience, err = deserializeValuesAudienceIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "content" {
+			t.content, err = deserializeValuesContentIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "contentMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
+				}
+				t.contentMap = val
+				handled = true
+			}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "context" {
+			t.context, err = deserializeValuesContextIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "name" {
+			t.name, err = deserializeValuesNameIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "nameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
+				}
+				t.nameMap = val
+				handled = true
+			}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endTime" {
+			t.endTime, err = deserializeEndTimeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "generator" {
+			t.generator, err = deserializeValuesGeneratorIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "icon" {
+			t.icon, err = deserializeValuesIconIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "id" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.id = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "image" {
+			t.image, err = deserializeValuesImageIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "inReplyTo" {
+			t.inReplyTo, err = deserializeValuesInReplyToIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "location" {
+			t.location, err = deserializeValuesLocationIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "preview" {
+			t.preview, err = deserializeValuesPreviewIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "published" {
+			t.published, err = deserializePublishedIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "replies" {
+			t.replies, err = deserializeRepliesIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "startTime" {
+			t.startTime, err = deserializeStartTimeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "summary" {
+			t.summary, err = deserializeValuesSummaryIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "summaryMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
+				}
+				t.summaryMap = val
+				handled = true
+			}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "tag" {
+			t.tag, err = deserializeValuesTagIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if k == "type" {
+			if tmpTypeSlice, ok := v.([]interface{}); ok {
+				t.typeName = tmpTypeSlice
+				handled = true
+			} else {
+				t.typeName = []interface{}{v}
+				handled = true
+			}
+		}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "updated" {
+			t.updated, err = deserializeUpdatedIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "url" {
+			t.url, err = deserializeValuesUrlIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "to" {
+			t.to, err = deserializeValuesToIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bto" {
+			t.bto, err = deserializeValuesBtoIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "cc" {
+			t.cc, err = deserializeValuesCcIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bcc" {
+			t.bcc, err = deserializeValuesBccIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "mediaType" {
+			t.mediaType, err = deserializeMediaTypeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "duration" {
+			t.duration, err = deserializeDurationIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "source" {
+			t.source, err = deserializeSourceIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "inbox" {
+			t.inbox, err = deserializeInboxIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "outbox" {
+			t.outbox, err = deserializeOutboxIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "following" {
+			t.following, err = deserializeFollowingIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "followers" {
+			t.followers, err = deserializeFollowersIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "liked" {
+			t.liked, err = deserializeLikedIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "likes" {
+			t.likes, err = deserializeLikesIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "streams" {
+			if tmpSlice, ok := v.([]interface{}); ok {
+				for idx, tmpElem := range tmpSlice {
+					if v, ok := tmpElem.(interface{}); ok {
+						tmp, err := anyURIDeserialize(v)
+						if err != nil {
+							return deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+						}
+						t.streams = append(t.streams, tmp)
+						handled = true
+					}
+				}
+			} else if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.streams = append(t.streams, tmp)
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "preferredUsername" {
+			t.preferredUsername, err = deserializePreferredUsernameIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "preferredUsernameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
+				}
+				t.preferredUsernameMap = val
+				handled = true
+			}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endpoints" {
+			t.endpoints, err = deserializeEndpointsIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "proxyUrl" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.proxyUrl = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthAuthorizationEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.oauthAuthorizationEndpoint = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthTokenEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.oauthTokenEndpoint = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "provideClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.provideClientKey = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "signClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.signClientKey = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "sharedInbox" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.sharedInbox = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "shares" {
+			t.shares, err = deserializeSharesIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled && k != "@context" {
+		if t.unknown_ == nil {
+			t.unknown_ = make(map[string]interface{})
+		}
+		t.unknown_[k] = unknownValueDeserialize(v)
+	}
+	return
+
+}
+
+// MarshalJSON implements json.Marshaler by encoding this PropertyValue as JSON, with its properties in the order of the JSON it was unmarshalled from, if any
+func (t *PropertyValue) MarshalJSON() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	if t.order_ != nil {
+		b, err = encodeOrderedJSON(m, t.order_)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	return
+
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding JSON into this PropertyValue, recording the order of its properties for MarshalJSON
+func (t *PropertyValue) UnmarshalJSON(b []byte) (err error) {
+	m := make(map[string]interface{})
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	t.order_ = scanPropertyOrder(b)
+	return
+
+}
+
+// DecodeJSON populates this PropertyValue from the JSON object read from the decoder, deserializing each property as soon as it is decoded instead of decoding the whole object into a map first, which takes far less memory for large documents. Properties are renamed as Deserialize does, provided the '@context' comes before the properties it aliases. Unlike UnmarshalJSON, it does not record the order of the properties
+func (t *PropertyValue) DecodeJSON(dec *json.Decoder) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	return core.DecodeJSONProperties(dec, t.deserializeProperty_)
+
+}
+
+// SerializeCBOR encodes this PropertyValue as CBOR, which DeserializeCBOR decodes. The encoding is of the same data as JSON, so values can be exchanged in CBOR internally and in JSON with other servers.
+func (t *PropertyValue) SerializeCBOR() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeCBOR(m)
+
+}
+
+// DeserializeCBOR decodes CBOR, such as that of SerializeCBOR, into this PropertyValue
+func (t *PropertyValue) DeserializeCBOR(b []byte) (err error) {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode %T into PropertyValue", v)
+	}
+	return t.Deserialize(m)
+
+}
+
+// SerializeCanonical encodes this PropertyValue as canonical JSON by RFC 8785, so that equal values always have the same bytes, as signing them or addressing them by their content requires.
+func (t *PropertyValue) SerializeCanonical() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeJCS(m)
+
+}
+
+// Clone returns a deep copy of this PropertyValue, including its unknown properties and the values of all its properties, so that the copy can be modified without affecting the original
+func (t *PropertyValue) Clone() (c *PropertyValue, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	c = &PropertyValue{}
+	err = c.Deserialize(cloneValue(m).(map[string]interface{}))
+	c.order_ = t.order_
+	return
+
+}
+
+// Equals determines whether this PropertyValue and the other one have the same canonical serialized form, such as when the same activity is delivered more than once
+func (t *PropertyValue) Equals(other *PropertyValue) (eq bool) {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return canonicalEquals(t, other)
+
+}
+
+// Hash returns the SHA-256 hash of the canonical serialized form of this PropertyValue, so that equal values have the same hash. It returns the zero hash if this PropertyValue cannot be serialized
+func (t *PropertyValue) Hash() (h [32]byte) {
+	return canonicalHash(t)
+
+}
+
+// IsPublic returns true if the 'to', 'bto', 'cc', or 'bcc' properties address the special Public ActivityPub collection
+func (t *PropertyValue) IsPublic() (b bool) {
+	for i := 0; i < t.ToLen(); i++ {
+		if t.IsToIRI(i) && t.GetToIRI(i).String() == "https://www.w3.org/ns/activitystreams#Public" {
+			return true
+		}
+	}
+	for i := 0; i < t.BtoLen(); i++ {
+		if t.IsBtoIRI(i) && t.GetBtoIRI(i).String() == "https://www.w3.org/ns/activitystreams#Public" {
+			return true
+		}
+	}
+	for i := 0; i < t.CcLen(); i++ {
+		if t.IsCcIRI(i) && t.GetCcIRI(i).String() == "https://www.w3.org/ns/activitystreams#Public" {
+			return true
+		}
+	}
+	for i := 0; i < t.BccLen(); i++ {
+		if t.IsBccIRI(i) && t.GetBccIRI(i).String() == "https://www.w3.org/ns/activitystreams#Public" {
+			return true
+		}
+	}
+	return false
+
+}
+
+// Kind returns UnknownKind, since PropertyValue is not a type of the core package.
+func (t *PropertyValue) Kind() (k TypeKind) {
+	return core.UnknownKind
+
+}
+
+// Validate returns ValidationErrors listing every property required by the specification that this PropertyValue is missing, or nil if it has them all
+func (t *PropertyValue) Validate() (err error) {
+	return
+
+}
+
+// ValidateStrict returns ValidationErrors listing what Validate does, and every unknown property of this PropertyValue, every property with a value of none of the types it takes, and every inlined value that is itself invalid, or nil if there are none. It is meant for rejecting values that are not exactly as specified, such as those posted by clients
+func (t *PropertyValue) ValidateStrict() (err error) {
+	errs, _ := t.Validate().(ValidationErrors)
+	if t.value != nil {
+		errs = t.value.strict(errs, "PropertyValue", "value")
+	}
+	if t.altitude != nil {
+		errs = t.altitude.strict(errs, "PropertyValue", "altitude")
+	}
+	for _, v := range t.attachment {
+		errs = v.strict(errs, "PropertyValue", "attachment")
+	}
+	for _, v := range t.attributedTo {
+		errs = v.strict(errs, "PropertyValue", "attributedTo")
+	}
+	for _, v := range t.audience {
+		errs = v.strict(errs, "PropertyValue", "audience")
+	}
+	for _, v := range t.content {
+		errs = v.strict(errs, "PropertyValue", "content")
+	}
+	for _, v := range t.context {
+		errs = v.strict(errs, "PropertyValue", "context")
+	}
+	for _, v := range t.name {
+		errs = v.strict(errs, "PropertyValue", "name")
+	}
+	if t.endTime != nil {
+		errs = t.endTime.strict(errs, "PropertyValue", "endTime")
+	}
+	for _, v := range t.generator {
+		errs = v.strict(errs, "PropertyValue", "generator")
+	}
+	for _, v := range t.icon {
+		errs = v.strict(errs, "PropertyValue", "icon")
+	}
+	for _, v := range t.image {
+		errs = v.strict(errs, "PropertyValue", "image")
+	}
+	for _, v := range t.inReplyTo {
+		errs = v.strict(errs, "PropertyValue", "inReplyTo")
+	}
+	for _, v := range t.location {
+		errs = v.strict(errs, "PropertyValue", "location")
+	}
+	for _, v := range t.preview {
+		errs = v.strict(errs, "PropertyValue", "preview")
+	}
+	if t.published != nil {
+		errs = t.published.strict(errs, "PropertyValue", "published")
+	}
+	if t.replies != nil {
+		errs = t.replies.strict(errs, "PropertyValue", "replies")
+	}
+	if t.startTime != nil {
+		errs = t.startTime.strict(errs, "PropertyValue", "startTime")
+	}
+	for _, v := range t.summary {
+		errs = v.strict(errs, "PropertyValue", "summary")
+	}
+	for _, v := range t.tag {
+		errs = v.strict(errs, "PropertyValue", "tag")
+	}
+	if t.updated != nil {
+		errs = t.updated.strict(errs, "PropertyValue", "updated")
+	}
+	for _, v := range t.url {
+		errs = v.strict(errs, "PropertyValue", "url")
+	}
+	for _, v := range t.to {
+		errs = v.strict(errs, "PropertyValue", "to")
+	}
+	for _, v := range t.bto {
+		errs = v.strict(errs, "PropertyValue", "bto")
+	}
+	for _, v := range t.cc {
+		errs = v.strict(errs, "PropertyValue", "cc")
+	}
+	for _, v := range t.bcc {
+		errs = v.strict(errs, "PropertyValue", "bcc")
+	}
+	if t.mediaType != nil {
+		errs = t.mediaType.strict(errs, "PropertyValue", "mediaType")
+	}
+	if t.duration != nil {
+		errs = t.duration.strict(errs, "PropertyValue", "duration")
+	}
+	if t.source != nil {
+		errs = t.source.strict(errs, "PropertyValue", "source")
+	}
+	if t.inbox != nil {
+		errs = t.inbox.strict(errs, "PropertyValue", "inbox")
+	}
+	if t.outbox != nil {
+		errs = t.outbox.strict(errs, "PropertyValue", "outbox")
+	}
+	if t.following != nil {
+		errs = t.following.strict(errs, "PropertyValue", "following")
+	}
+	if t.followers != nil {
+		errs = t.followers.strict(errs, "PropertyValue", "followers")
+	}
+	if t.liked != nil {
+		errs = t.liked.strict(errs, "PropertyValue", "liked")
+	}
+	if t.likes != nil {
+		errs = t.likes.strict(errs, "PropertyValue", "likes")
+	}
+	if t.preferredUsername != nil {
+		errs = t.preferredUsername.strict(errs, "PropertyValue", "preferredUsername")
+	}
+	if t.endpoints != nil {
+		errs = t.endpoints.strict(errs, "PropertyValue", "endpoints")
+	}
+	if t.shares != nil {
+		errs = t.shares.strict(errs, "PropertyValue", "shares")
+	}
+	keys := make([]string, 0, len(t.unknown_))
+	for k := range t.unknown_ {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch k {
+		case "id", "streams", "proxyUrl", "oauthAuthorizationEndpoint", "oauthTokenEndpoint", "provideClientKey", "signClientKey", "sharedInbox":
+			errs = append(errs, &MismatchedPropertyError{Type: "PropertyValue", Property: k})
+		default:
+			errs = append(errs, &UnknownPropertyError{Type: "PropertyValue", Property: k})
+		}
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// DeserializeStrict populates this PropertyValue from a map[string]interface{} like Deserialize, then returns the problems ValidateStrict finds with it, if any
+func (t *PropertyValue) DeserializeStrict(m map[string]interface{}) (err error) {
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	return t.ValidateStrict()
+
+}
+
+// DeserializeLenient populates this PropertyValue from a map[string]interface{} like Deserialize, but parses the xsd:dateTime values of its properties, and of the values it contains, with ParseDateTimeLenient when ParseDateTimeStrict rejects them. It is meant for values from servers emitting malformed dates, and leaves the map unchanged
+func (t *PropertyValue) DeserializeLenient(m map[string]interface{}) (err error) {
+	return t.Deserialize(lenientDateTimes(m).(map[string]interface{}))
+
+}
+
+// TryGetValue returns the value GetValue returns, or an AccessorError if IsValue returns false
+func (t *PropertyValue) TryGetValue() (v string, err error) {
+	if !t.IsValue() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetValue", Index: -1}
+		return
+	}
+	return t.GetValue(), nil
+
+}
+
+// TryGetValueIRI returns the value GetValueIRI returns, or an AccessorError if IsValueIRI returns false
+func (t *PropertyValue) TryGetValueIRI() (v *url.URL, err error) {
+	if !t.IsValueIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetValueIRI", Index: -1}
+		return
+	}
+	return t.GetValueIRI(), nil
+
+}
+
+// TryGetUnknownValue returns the value GetUnknownValue returns, or an AccessorError if HasUnknownValue returns false
+func (t *PropertyValue) TryGetUnknownValue() (v interface{}, err error) {
+	if !t.HasUnknownValue() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownValue", Index: -1}
+		return
+	}
+	return t.GetUnknownValue(), nil
+
+}
+
+// TryGetAltitude returns the value GetAltitude returns, or an AccessorError if IsAltitude returns false
+func (t *PropertyValue) TryGetAltitude() (v float64, err error) {
+	if !t.IsAltitude() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAltitude", Index: -1}
+		return
+	}
+	return t.GetAltitude(), nil
+
+}
+
+// TryGetAltitudeIRI returns the value GetAltitudeIRI returns, or an AccessorError if IsAltitudeIRI returns false
+func (t *PropertyValue) TryGetAltitudeIRI() (v *url.URL, err error) {
+	if !t.IsAltitudeIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAltitudeIRI", Index: -1}
+		return
+	}
+	return t.GetAltitudeIRI(), nil
+
+}
+
+// TryGetUnknownAltitude returns the value GetUnknownAltitude returns, or an AccessorError if HasUnknownAltitude returns false
+func (t *PropertyValue) TryGetUnknownAltitude() (v interface{}, err error) {
+	if !t.HasUnknownAltitude() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownAltitude", Index: -1}
+		return
+	}
+	return t.GetUnknownAltitude(), nil
+
+}
+
+// TryGetAttachmentObject returns the value GetAttachmentObject returns, or an AccessorError if IsAttachmentObject returns false
+func (t *PropertyValue) TryGetAttachmentObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAttachmentObject", Index: index}
+		return
+	}
+	return t.GetAttachmentObject(index), nil
+
+}
+
+// TryGetAttachmentLink returns the value GetAttachmentLink returns, or an AccessorError if IsAttachmentLink returns false
+func (t *PropertyValue) TryGetAttachmentLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAttachmentLink", Index: index}
+		return
+	}
+	return t.GetAttachmentLink(index), nil
+
+}
+
+// TryGetAttachmentIRI returns the value GetAttachmentIRI returns, or an AccessorError if IsAttachmentIRI returns false
+func (t *PropertyValue) TryGetAttachmentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAttachmentIRI", Index: index}
+		return
+	}
+	return t.GetAttachmentIRI(index), nil
+
+}
+
+// TryGetUnknownAttachment returns the value GetUnknownAttachment returns, or an AccessorError if HasUnknownAttachment returns false
+func (t *PropertyValue) TryGetUnknownAttachment() (v interface{}, err error) {
+	if !t.HasUnknownAttachment() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownAttachment", Index: -1}
+		return
+	}
+	return t.GetUnknownAttachment(), nil
+
+}
+
+// TryGetAttributedToObject returns the value GetAttributedToObject returns, or an AccessorError if IsAttributedToObject returns false
+func (t *PropertyValue) TryGetAttributedToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAttributedToObject", Index: index}
+		return
+	}
+	return t.GetAttributedToObject(index), nil
+
+}
+
+// TryGetAttributedToLink returns the value GetAttributedToLink returns, or an AccessorError if IsAttributedToLink returns false
+func (t *PropertyValue) TryGetAttributedToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAttributedToLink", Index: index}
+		return
+	}
+	return t.GetAttributedToLink(index), nil
+
+}
+
+// TryGetAttributedToIRI returns the value GetAttributedToIRI returns, or an AccessorError if IsAttributedToIRI returns false
+func (t *PropertyValue) TryGetAttributedToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAttributedToIRI", Index: index}
+		return
+	}
+	return t.GetAttributedToIRI(index), nil
+
+}
+
+// TryGetUnknownAttributedTo returns the value GetUnknownAttributedTo returns, or an AccessorError if HasUnknownAttributedTo returns false
+func (t *PropertyValue) TryGetUnknownAttributedTo() (v interface{}, err error) {
+	if !t.HasUnknownAttributedTo() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownAttributedTo", Index: -1}
+		return
+	}
+	return t.GetUnknownAttributedTo(), nil
+
+}
+
+// TryGetAudienceObject returns the value GetAudienceObject returns, or an AccessorError if IsAudienceObject returns false
+func (t *PropertyValue) TryGetAudienceObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAudienceObject", Index: index}
+		return
+	}
+	return t.GetAudienceObject(index), nil
+
+}
+
+// TryGetAudienceLink returns the value GetAudienceLink returns, or an AccessorError if IsAudienceLink returns false
+func (t *PropertyValue) TryGetAudienceLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAudienceLink", Index: index}
+		return
+	}
+	return t.GetAudienceLink(index), nil
+
+}
+
+// TryGetAudienceIRI returns the value GetAudienceIRI returns, or an AccessorError if IsAudienceIRI returns false
+func (t *PropertyValue) TryGetAudienceIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetAudienceIRI", Index: index}
+		return
+	}
+	return t.GetAudienceIRI(index), nil
+
+}
+
+// TryGetUnknownAudience returns the value GetUnknownAudience returns, or an AccessorError if HasUnknownAudience returns false
+func (t *PropertyValue) TryGetUnknownAudience() (v interface{}, err error) {
+	if !t.HasUnknownAudience() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownAudience", Index: -1}
+		return
+	}
+	return t.GetUnknownAudience(), nil
+
+}
+
+// TryGetContentString returns the value GetContentString returns, or an AccessorError if IsContentString returns false
+func (t *PropertyValue) TryGetContentString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentString(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetContentString", Index: index}
+		return
+	}
+	return t.GetContentString(index), nil
+
+}
+
+// TryGetContentLangString returns the value GetContentLangString returns, or an AccessorError if IsContentLangString returns false
+func (t *PropertyValue) TryGetContentLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentLangString(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetContentLangString", Index: index}
+		return
+	}
+	return t.GetContentLangString(index), nil
+
+}
+
+// TryGetContentIRI returns the value GetContentIRI returns, or an AccessorError if IsContentIRI returns false
+func (t *PropertyValue) TryGetContentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetContentIRI", Index: index}
+		return
+	}
+	return t.GetContentIRI(index), nil
+
+}
+
+// TryGetUnknownContent returns the value GetUnknownContent returns, or an AccessorError if HasUnknownContent returns false
+func (t *PropertyValue) TryGetUnknownContent() (v interface{}, err error) {
+	if !t.HasUnknownContent() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownContent", Index: -1}
+		return
+	}
+	return t.GetUnknownContent(), nil
+
+}
+
+// TryGetContextObject returns the value GetContextObject returns, or an AccessorError if IsContextObject returns false
+func (t *PropertyValue) TryGetContextObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetContextObject", Index: index}
+		return
+	}
+	return t.GetContextObject(index), nil
+
+}
+
+// TryGetContextLink returns the value GetContextLink returns, or an AccessorError if IsContextLink returns false
+func (t *PropertyValue) TryGetContextLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetContextLink", Index: index}
+		return
+	}
+	return t.GetContextLink(index), nil
+
+}
+
+// TryGetContextIRI returns the value GetContextIRI returns, or an AccessorError if IsContextIRI returns false
+func (t *PropertyValue) TryGetContextIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetContextIRI", Index: index}
+		return
+	}
+	return t.GetContextIRI(index), nil
+
+}
+
+// TryGetUnknownContext returns the value GetUnknownContext returns, or an AccessorError if HasUnknownContext returns false
+func (t *PropertyValue) TryGetUnknownContext() (v interface{}, err error) {
+	if !t.HasUnknownContext() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownContext", Index: -1}
+		return
+	}
+	return t.GetUnknownContext(), nil
+
+}
+
+// TryGetNameString returns the value GetNameString returns, or an AccessorError if IsNameString returns false
+func (t *PropertyValue) TryGetNameString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameString(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetNameString", Index: index}
+		return
+	}
+	return t.GetNameString(index), nil
+
+}
+
+// TryGetNameLangString returns the value GetNameLangString returns, or an AccessorError if IsNameLangString returns false
+func (t *PropertyValue) TryGetNameLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameLangString(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetNameLangString", Index: index}
+		return
+	}
+	return t.GetNameLangString(index), nil
+
+}
+
+// TryGetNameIRI returns the value GetNameIRI returns, or an AccessorError if IsNameIRI returns false
+func (t *PropertyValue) TryGetNameIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetNameIRI", Index: index}
+		return
+	}
+	return t.GetNameIRI(index), nil
+
+}
+
+// TryGetUnknownName returns the value GetUnknownName returns, or an AccessorError if HasUnknownName returns false
+func (t *PropertyValue) TryGetUnknownName() (v interface{}, err error) {
+	if !t.HasUnknownName() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownName", Index: -1}
+		return
+	}
+	return t.GetUnknownName(), nil
+
+}
+
+// TryGetEndTime returns the value GetEndTime returns, or an AccessorError if IsEndTime returns false
+func (t *PropertyValue) TryGetEndTime() (v time.Time, err error) {
+	if !t.IsEndTime() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetEndTime", Index: -1}
+		return
+	}
+	return t.GetEndTime(), nil
+
+}
+
+// TryGetEndTimeIRI returns the value GetEndTimeIRI returns, or an AccessorError if IsEndTimeIRI returns false
+func (t *PropertyValue) TryGetEndTimeIRI() (v *url.URL, err error) {
+	if !t.IsEndTimeIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetEndTimeIRI", Index: -1}
+		return
+	}
+	return t.GetEndTimeIRI(), nil
+
+}
+
+// TryGetUnknownEndTime returns the value GetUnknownEndTime returns, or an AccessorError if HasUnknownEndTime returns false
+func (t *PropertyValue) TryGetUnknownEndTime() (v interface{}, err error) {
+	if !t.HasUnknownEndTime() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownEndTime", Index: -1}
+		return
+	}
+	return t.GetUnknownEndTime(), nil
+
+}
+
+// TryGetGeneratorObject returns the value GetGeneratorObject returns, or an AccessorError if IsGeneratorObject returns false
+func (t *PropertyValue) TryGetGeneratorObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetGeneratorObject", Index: index}
+		return
+	}
+	return t.GetGeneratorObject(index), nil
+
+}
+
+// TryGetGeneratorLink returns the value GetGeneratorLink returns, or an AccessorError if IsGeneratorLink returns false
+func (t *PropertyValue) TryGetGeneratorLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetGeneratorLink", Index: index}
+		return
+	}
+	return t.GetGeneratorLink(index), nil
+
+}
+
+// TryGetGeneratorIRI returns the value GetGeneratorIRI returns, or an AccessorError if IsGeneratorIRI returns false
+func (t *PropertyValue) TryGetGeneratorIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetGeneratorIRI", Index: index}
+		return
+	}
+	return t.GetGeneratorIRI(index), nil
+
+}
+
+// TryGetUnknownGenerator returns the value GetUnknownGenerator returns, or an AccessorError if HasUnknownGenerator returns false
+func (t *PropertyValue) TryGetUnknownGenerator() (v interface{}, err error) {
+	if !t.HasUnknownGenerator() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownGenerator", Index: -1}
+		return
+	}
+	return t.GetUnknownGenerator(), nil
+
+}
+
+// TryGetIconImage returns the value GetIconImage returns, or an AccessorError if IsIconImage returns false
+func (t *PropertyValue) TryGetIconImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconImage(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetIconImage", Index: index}
+		return
+	}
+	return t.GetIconImage(index), nil
+
+}
+
+// TryGetIconLink returns the value GetIconLink returns, or an AccessorError if IsIconLink returns false
+func (t *PropertyValue) TryGetIconLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetIconLink", Index: index}
+		return
+	}
+	return t.GetIconLink(index), nil
+
+}
+
+// TryGetIconIRI returns the value GetIconIRI returns, or an AccessorError if IsIconIRI returns false
+func (t *PropertyValue) TryGetIconIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetIconIRI", Index: index}
+		return
+	}
+	return t.GetIconIRI(index), nil
+
+}
+
+// TryGetUnknownIcon returns the value GetUnknownIcon returns, or an AccessorError if HasUnknownIcon returns false
+func (t *PropertyValue) TryGetUnknownIcon() (v interface{}, err error) {
+	if !t.HasUnknownIcon() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownIcon", Index: -1}
+		return
+	}
+	return t.GetUnknownIcon(), nil
+
+}
+
+// TryGetId returns the value GetId returns, or an AccessorError if HasId returns false
+func (t *PropertyValue) TryGetId() (v *url.URL, err error) {
+	if !t.HasId() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetId", Index: -1}
+		return
+	}
+	return t.GetId(), nil
+
+}
+
+// TryGetUnknownId returns the value GetUnknownId returns, or an AccessorError if HasUnknownId returns false
+func (t *PropertyValue) TryGetUnknownId() (v interface{}, err error) {
+	if !t.HasUnknownId() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownId", Index: -1}
+		return
+	}
+	return t.GetUnknownId(), nil
+
+}
+
+// TryGetImageImage returns the value GetImageImage returns, or an AccessorError if IsImageImage returns false
+func (t *PropertyValue) TryGetImageImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageImage(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetImageImage", Index: index}
+		return
+	}
+	return t.GetImageImage(index), nil
+
+}
+
+// TryGetImageLink returns the value GetImageLink returns, or an AccessorError if IsImageLink returns false
+func (t *PropertyValue) TryGetImageLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetImageLink", Index: index}
+		return
+	}
+	return t.GetImageLink(index), nil
+
+}
+
+// TryGetImageIRI returns the value GetImageIRI returns, or an AccessorError if IsImageIRI returns false
+func (t *PropertyValue) TryGetImageIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetImageIRI", Index: index}
+		return
+	}
+	return t.GetImageIRI(index), nil
+
+}
+
+// TryGetUnknownImage returns the value GetUnknownImage returns, or an AccessorError if HasUnknownImage returns false
+func (t *PropertyValue) TryGetUnknownImage() (v interface{}, err error) {
+	if !t.HasUnknownImage() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownImage", Index: -1}
+		return
+	}
+	return t.GetUnknownImage(), nil
+
+}
+
+// TryGetInReplyToObject returns the value GetInReplyToObject returns, or an AccessorError if IsInReplyToObject returns false
+func (t *PropertyValue) TryGetInReplyToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetInReplyToObject", Index: index}
+		return
+	}
+	return t.GetInReplyToObject(index), nil
+
+}
+
+// TryGetInReplyToLink returns the value GetInReplyToLink returns, or an AccessorError if IsInReplyToLink returns false
+func (t *PropertyValue) TryGetInReplyToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetInReplyToLink", Index: index}
+		return
+	}
+	return t.GetInReplyToLink(index), nil
+
+}
+
+// TryGetInReplyToIRI returns the value GetInReplyToIRI returns, or an AccessorError if IsInReplyToIRI returns false
+func (t *PropertyValue) TryGetInReplyToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetInReplyToIRI", Index: index}
+		return
+	}
+	return t.GetInReplyToIRI(index), nil
+
+}
+
+// TryGetUnknownInReplyTo returns the value GetUnknownInReplyTo returns, or an AccessorError if HasUnknownInReplyTo returns false
+func (t *PropertyValue) TryGetUnknownInReplyTo() (v interface{}, err error) {
+	if !t.HasUnknownInReplyTo() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownInReplyTo", Index: -1}
+		return
+	}
+	return t.GetUnknownInReplyTo(), nil
+
+}
+
+// TryGetLocationObject returns the value GetLocationObject returns, or an AccessorError if IsLocationObject returns false
+func (t *PropertyValue) TryGetLocationObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLocationObject", Index: index}
+		return
+	}
+	return t.GetLocationObject(index), nil
+
+}
+
+// TryGetLocationLink returns the value GetLocationLink returns, or an AccessorError if IsLocationLink returns false
+func (t *PropertyValue) TryGetLocationLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLocationLink", Index: index}
+		return
+	}
+	return t.GetLocationLink(index), nil
+
+}
+
+// TryGetLocationIRI returns the value GetLocationIRI returns, or an AccessorError if IsLocationIRI returns false
+func (t *PropertyValue) TryGetLocationIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLocationIRI", Index: index}
+		return
+	}
+	return t.GetLocationIRI(index), nil
+
+}
+
+// TryGetUnknownLocation returns the value GetUnknownLocation returns, or an AccessorError if HasUnknownLocation returns false
+func (t *PropertyValue) TryGetUnknownLocation() (v interface{}, err error) {
+	if !t.HasUnknownLocation() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownLocation", Index: -1}
+		return
+	}
+	return t.GetUnknownLocation(), nil
+
+}
+
+// TryGetPreviewObject returns the value GetPreviewObject returns, or an AccessorError if IsPreviewObject returns false
+func (t *PropertyValue) TryGetPreviewObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetPreviewObject", Index: index}
+		return
+	}
+	return t.GetPreviewObject(index), nil
+
+}
+
+// TryGetPreviewLink returns the value GetPreviewLink returns, or an AccessorError if IsPreviewLink returns false
+func (t *PropertyValue) TryGetPreviewLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetPreviewLink", Index: index}
+		return
+	}
+	return t.GetPreviewLink(index), nil
+
+}
+
+// TryGetPreviewIRI returns the value GetPreviewIRI returns, or an AccessorError if IsPreviewIRI returns false
+func (t *PropertyValue) TryGetPreviewIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetPreviewIRI", Index: index}
+		return
+	}
+	return t.GetPreviewIRI(index), nil
+
+}
+
+// TryGetUnknownPreview returns the value GetUnknownPreview returns, or an AccessorError if HasUnknownPreview returns false
+func (t *PropertyValue) TryGetUnknownPreview() (v interface{}, err error) {
+	if !t.HasUnknownPreview() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownPreview", Index: -1}
+		return
+	}
+	return t.GetUnknownPreview(), nil
+
+}
+
+// TryGetPublished returns the value GetPublished returns, or an AccessorError if IsPublished returns false
+func (t *PropertyValue) TryGetPublished() (v time.Time, err error) {
+	if !t.IsPublished() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetPublished", Index: -1}
+		return
+	}
+	return t.GetPublished(), nil
+
+}
+
+// TryGetPublishedIRI returns the value GetPublishedIRI returns, or an AccessorError if IsPublishedIRI returns false
+func (t *PropertyValue) TryGetPublishedIRI() (v *url.URL, err error) {
+	if !t.IsPublishedIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetPublishedIRI", Index: -1}
+		return
+	}
+	return t.GetPublishedIRI(), nil
+
+}
+
+// TryGetUnknownPublished returns the value GetUnknownPublished returns, or an AccessorError if HasUnknownPublished returns false
+func (t *PropertyValue) TryGetUnknownPublished() (v interface{}, err error) {
+	if !t.HasUnknownPublished() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownPublished", Index: -1}
+		return
+	}
+	return t.GetUnknownPublished(), nil
+
+}
+
+// TryGetReplies returns the value GetReplies returns, or an AccessorError if IsReplies returns false
+func (t *PropertyValue) TryGetReplies() (v CollectionType, err error) {
+	if !t.IsReplies() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetReplies", Index: -1}
+		return
+	}
+	return t.GetReplies(), nil
+
+}
+
+// TryGetRepliesIRI returns the value GetRepliesIRI returns, or an AccessorError if IsRepliesIRI returns false
+func (t *PropertyValue) TryGetRepliesIRI() (v *url.URL, err error) {
+	if !t.IsRepliesIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetRepliesIRI", Index: -1}
+		return
+	}
+	return t.GetRepliesIRI(), nil
+
+}
+
+// TryGetUnknownReplies returns the value GetUnknownReplies returns, or an AccessorError if HasUnknownReplies returns false
+func (t *PropertyValue) TryGetUnknownReplies() (v interface{}, err error) {
+	if !t.HasUnknownReplies() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownReplies", Index: -1}
+		return
+	}
+	return t.GetUnknownReplies(), nil
+
+}
+
+// TryGetStartTime returns the value GetStartTime returns, or an AccessorError if IsStartTime returns false
+func (t *PropertyValue) TryGetStartTime() (v time.Time, err error) {
+	if !t.IsStartTime() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetStartTime", Index: -1}
+		return
+	}
+	return t.GetStartTime(), nil
+
+}
+
+// TryGetStartTimeIRI returns the value GetStartTimeIRI returns, or an AccessorError if IsStartTimeIRI returns false
+func (t *PropertyValue) TryGetStartTimeIRI() (v *url.URL, err error) {
+	if !t.IsStartTimeIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetStartTimeIRI", Index: -1}
+		return
+	}
+	return t.GetStartTimeIRI(), nil
+
+}
+
+// TryGetUnknownStartTime returns the value GetUnknownStartTime returns, or an AccessorError if HasUnknownStartTime returns false
+func (t *PropertyValue) TryGetUnknownStartTime() (v interface{}, err error) {
+	if !t.HasUnknownStartTime() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownStartTime", Index: -1}
+		return
+	}
+	return t.GetUnknownStartTime(), nil
+
+}
+
+// TryGetSummaryString returns the value GetSummaryString returns, or an AccessorError if IsSummaryString returns false
+func (t *PropertyValue) TryGetSummaryString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryString(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSummaryString", Index: index}
+		return
+	}
+	return t.GetSummaryString(index), nil
+
+}
+
+// TryGetSummaryLangString returns the value GetSummaryLangString returns, or an AccessorError if IsSummaryLangString returns false
+func (t *PropertyValue) TryGetSummaryLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryLangString(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSummaryLangString", Index: index}
+		return
+	}
+	return t.GetSummaryLangString(index), nil
+
+}
+
+// TryGetSummaryIRI returns the value GetSummaryIRI returns, or an AccessorError if IsSummaryIRI returns false
+func (t *PropertyValue) TryGetSummaryIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSummaryIRI", Index: index}
+		return
+	}
+	return t.GetSummaryIRI(index), nil
+
+}
+
+// TryGetUnknownSummary returns the value GetUnknownSummary returns, or an AccessorError if HasUnknownSummary returns false
+func (t *PropertyValue) TryGetUnknownSummary() (v interface{}, err error) {
+	if !t.HasUnknownSummary() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownSummary", Index: -1}
+		return
+	}
+	return t.GetUnknownSummary(), nil
+
+}
+
+// TryGetTagObject returns the value GetTagObject returns, or an AccessorError if IsTagObject returns false
+func (t *PropertyValue) TryGetTagObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetTagObject", Index: index}
+		return
+	}
+	return t.GetTagObject(index), nil
+
+}
+
+// TryGetTagLink returns the value GetTagLink returns, or an AccessorError if IsTagLink returns false
+func (t *PropertyValue) TryGetTagLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetTagLink", Index: index}
+		return
+	}
+	return t.GetTagLink(index), nil
+
+}
+
+// TryGetTagIRI returns the value GetTagIRI returns, or an AccessorError if IsTagIRI returns false
+func (t *PropertyValue) TryGetTagIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetTagIRI", Index: index}
+		return
+	}
+	return t.GetTagIRI(index), nil
+
+}
+
+// TryGetUnknownTag returns the value GetUnknownTag returns, or an AccessorError if HasUnknownTag returns false
+func (t *PropertyValue) TryGetUnknownTag() (v interface{}, err error) {
+	if !t.HasUnknownTag() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownTag", Index: -1}
+		return
+	}
+	return t.GetUnknownTag(), nil
+
+}
+
+// TryGetType returns the value GetType returns, or an AccessorError if the index is out of range
+func (t *PropertyValue) TryGetType(index int) (v interface{}, err error) {
+	if index < 0 || index >= t.TypeLen() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetType", Index: index}
+		return
+	}
+	return t.GetType(index), nil
+
+}
+
+// TryGetUpdated returns the value GetUpdated returns, or an AccessorError if IsUpdated returns false
+func (t *PropertyValue) TryGetUpdated() (v time.Time, err error) {
+	if !t.IsUpdated() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUpdated", Index: -1}
+		return
+	}
+	return t.GetUpdated(), nil
+
+}
+
+// TryGetUpdatedIRI returns the value GetUpdatedIRI returns, or an AccessorError if IsUpdatedIRI returns false
+func (t *PropertyValue) TryGetUpdatedIRI() (v *url.URL, err error) {
+	if !t.IsUpdatedIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUpdatedIRI", Index: -1}
+		return
+	}
+	return t.GetUpdatedIRI(), nil
+
+}
+
+// TryGetUnknownUpdated returns the value GetUnknownUpdated returns, or an AccessorError if HasUnknownUpdated returns false
+func (t *PropertyValue) TryGetUnknownUpdated() (v interface{}, err error) {
+	if !t.HasUnknownUpdated() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownUpdated", Index: -1}
+		return
+	}
+	return t.GetUnknownUpdated(), nil
+
+}
+
+// TryGetUrlAnyURI returns the value GetUrlAnyURI returns, or an AccessorError if IsUrlAnyURI returns false
+func (t *PropertyValue) TryGetUrlAnyURI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlAnyURI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUrlAnyURI", Index: index}
+		return
+	}
+	return t.GetUrlAnyURI(index), nil
+
+}
+
+// TryGetUrlLink returns the value GetUrlLink returns, or an AccessorError if IsUrlLink returns false
+func (t *PropertyValue) TryGetUrlLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUrlLink", Index: index}
+		return
+	}
+	return t.GetUrlLink(index), nil
+
+}
+
+// TryGetUnknownUrl returns the value GetUnknownUrl returns, or an AccessorError if HasUnknownUrl returns false
+func (t *PropertyValue) TryGetUnknownUrl() (v interface{}, err error) {
+	if !t.HasUnknownUrl() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownUrl(), nil
+
+}
+
+// TryGetToObject returns the value GetToObject returns, or an AccessorError if IsToObject returns false
+func (t *PropertyValue) TryGetToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetToObject", Index: index}
+		return
+	}
+	return t.GetToObject(index), nil
+
+}
+
+// TryGetToLink returns the value GetToLink returns, or an AccessorError if IsToLink returns false
+func (t *PropertyValue) TryGetToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetToLink", Index: index}
+		return
+	}
+	return t.GetToLink(index), nil
+
+}
+
+// TryGetToIRI returns the value GetToIRI returns, or an AccessorError if IsToIRI returns false
+func (t *PropertyValue) TryGetToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetToIRI", Index: index}
+		return
+	}
+	return t.GetToIRI(index), nil
+
+}
+
+// TryGetUnknownTo returns the value GetUnknownTo returns, or an AccessorError if HasUnknownTo returns false
+func (t *PropertyValue) TryGetUnknownTo() (v interface{}, err error) {
+	if !t.HasUnknownTo() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownTo", Index: -1}
+		return
+	}
+	return t.GetUnknownTo(), nil
+
+}
+
+// TryGetBtoObject returns the value GetBtoObject returns, or an AccessorError if IsBtoObject returns false
+func (t *PropertyValue) TryGetBtoObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetBtoObject", Index: index}
+		return
+	}
+	return t.GetBtoObject(index), nil
+
+}
+
+// TryGetBtoLink returns the value GetBtoLink returns, or an AccessorError if IsBtoLink returns false
+func (t *PropertyValue) TryGetBtoLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetBtoLink", Index: index}
+		return
+	}
+	return t.GetBtoLink(index), nil
+
+}
+
+// TryGetBtoIRI returns the value GetBtoIRI returns, or an AccessorError if IsBtoIRI returns false
+func (t *PropertyValue) TryGetBtoIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetBtoIRI", Index: index}
+		return
+	}
+	return t.GetBtoIRI(index), nil
+
+}
+
+// TryGetUnknownBto returns the value GetUnknownBto returns, or an AccessorError if HasUnknownBto returns false
+func (t *PropertyValue) TryGetUnknownBto() (v interface{}, err error) {
+	if !t.HasUnknownBto() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownBto", Index: -1}
+		return
+	}
+	return t.GetUnknownBto(), nil
+
+}
+
+// TryGetCcObject returns the value GetCcObject returns, or an AccessorError if IsCcObject returns false
+func (t *PropertyValue) TryGetCcObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetCcObject", Index: index}
+		return
+	}
+	return t.GetCcObject(index), nil
+
+}
+
+// TryGetCcLink returns the value GetCcLink returns, or an AccessorError if IsCcLink returns false
+func (t *PropertyValue) TryGetCcLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetCcLink", Index: index}
+		return
+	}
+	return t.GetCcLink(index), nil
+
+}
+
+// TryGetCcIRI returns the value GetCcIRI returns, or an AccessorError if IsCcIRI returns false
+func (t *PropertyValue) TryGetCcIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetCcIRI", Index: index}
+		return
+	}
+	return t.GetCcIRI(index), nil
+
+}
+
+// TryGetUnknownCc returns the value GetUnknownCc returns, or an AccessorError if HasUnknownCc returns false
+func (t *PropertyValue) TryGetUnknownCc() (v interface{}, err error) {
+	if !t.HasUnknownCc() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownCc", Index: -1}
+		return
+	}
+	return t.GetUnknownCc(), nil
+
+}
+
+// TryGetBccObject returns the value GetBccObject returns, or an AccessorError if IsBccObject returns false
+func (t *PropertyValue) TryGetBccObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccObject(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetBccObject", Index: index}
+		return
+	}
+	return t.GetBccObject(index), nil
+
+}
+
+// TryGetBccLink returns the value GetBccLink returns, or an AccessorError if IsBccLink returns false
+func (t *PropertyValue) TryGetBccLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccLink(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetBccLink", Index: index}
+		return
+	}
+	return t.GetBccLink(index), nil
+
+}
+
+// TryGetBccIRI returns the value GetBccIRI returns, or an AccessorError if IsBccIRI returns false
+func (t *PropertyValue) TryGetBccIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccIRI(index) {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetBccIRI", Index: index}
+		return
+	}
+	return t.GetBccIRI(index), nil
+
+}
+
+// TryGetUnknownBcc returns the value GetUnknownBcc returns, or an AccessorError if HasUnknownBcc returns false
+func (t *PropertyValue) TryGetUnknownBcc() (v interface{}, err error) {
+	if !t.HasUnknownBcc() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownBcc", Index: -1}
+		return
+	}
+	return t.GetUnknownBcc(), nil
+
+}
+
+// TryGetMediaType returns the value GetMediaType returns, or an AccessorError if IsMediaType returns false
+func (t *PropertyValue) TryGetMediaType() (v string, err error) {
+	if !t.IsMediaType() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetMediaType", Index: -1}
+		return
+	}
+	return t.GetMediaType(), nil
+
+}
+
+// TryGetMediaTypeIRI returns the value GetMediaTypeIRI returns, or an AccessorError if IsMediaTypeIRI returns false
+func (t *PropertyValue) TryGetMediaTypeIRI() (v *url.URL, err error) {
+	if !t.IsMediaTypeIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetMediaTypeIRI", Index: -1}
+		return
+	}
+	return t.GetMediaTypeIRI(), nil
+
+}
+
+// TryGetUnknownMediaType returns the value GetUnknownMediaType returns, or an AccessorError if HasUnknownMediaType returns false
+func (t *PropertyValue) TryGetUnknownMediaType() (v interface{}, err error) {
+	if !t.HasUnknownMediaType() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownMediaType", Index: -1}
+		return
+	}
+	return t.GetUnknownMediaType(), nil
+
+}
+
+// TryGetDuration returns the value GetDuration returns, or an AccessorError if IsDuration returns false
+func (t *PropertyValue) TryGetDuration() (v time.Duration, err error) {
+	if !t.IsDuration() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetDuration", Index: -1}
+		return
+	}
+	return t.GetDuration(), nil
+
+}
+
+// TryGetDurationIRI returns the value GetDurationIRI returns, or an AccessorError if IsDurationIRI returns false
+func (t *PropertyValue) TryGetDurationIRI() (v *url.URL, err error) {
+	if !t.IsDurationIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetDurationIRI", Index: -1}
+		return
+	}
+	return t.GetDurationIRI(), nil
+
+}
+
+// TryGetUnknownDuration returns the value GetUnknownDuration returns, or an AccessorError if HasUnknownDuration returns false
+func (t *PropertyValue) TryGetUnknownDuration() (v interface{}, err error) {
+	if !t.HasUnknownDuration() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownDuration", Index: -1}
+		return
+	}
+	return t.GetUnknownDuration(), nil
+
+}
+
+// TryGetSource returns the value GetSource returns, or an AccessorError if IsSource returns false
+func (t *PropertyValue) TryGetSource() (v ObjectType, err error) {
+	if !t.IsSource() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSource", Index: -1}
+		return
+	}
+	return t.GetSource(), nil
+
+}
+
+// TryGetSourceIRI returns the value GetSourceIRI returns, or an AccessorError if IsSourceIRI returns false
+func (t *PropertyValue) TryGetSourceIRI() (v *url.URL, err error) {
+	if !t.IsSourceIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSourceIRI", Index: -1}
+		return
+	}
+	return t.GetSourceIRI(), nil
+
+}
+
+// TryGetUnknownSource returns the value GetUnknownSource returns, or an AccessorError if HasUnknownSource returns false
+func (t *PropertyValue) TryGetUnknownSource() (v interface{}, err error) {
+	if !t.HasUnknownSource() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownSource", Index: -1}
+		return
+	}
+	return t.GetUnknownSource(), nil
+
+}
+
+// TryGetInboxOrderedCollection returns the value GetInboxOrderedCollection returns, or an AccessorError if IsInboxOrderedCollection returns false
+func (t *PropertyValue) TryGetInboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsInboxOrderedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetInboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetInboxOrderedCollection(), nil
+
+}
+
+// TryGetInboxAnyURI returns the value GetInboxAnyURI returns, or an AccessorError if IsInboxAnyURI returns false
+func (t *PropertyValue) TryGetInboxAnyURI() (v *url.URL, err error) {
+	if !t.IsInboxAnyURI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetInboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetInboxAnyURI(), nil
+
+}
+
+// TryGetUnknownInbox returns the value GetUnknownInbox returns, or an AccessorError if HasUnknownInbox returns false
+func (t *PropertyValue) TryGetUnknownInbox() (v interface{}, err error) {
+	if !t.HasUnknownInbox() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownInbox(), nil
+
+}
+
+// TryGetOutboxOrderedCollection returns the value GetOutboxOrderedCollection returns, or an AccessorError if IsOutboxOrderedCollection returns false
+func (t *PropertyValue) TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsOutboxOrderedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetOutboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetOutboxOrderedCollection(), nil
+
+}
+
+// TryGetOutboxAnyURI returns the value GetOutboxAnyURI returns, or an AccessorError if IsOutboxAnyURI returns false
+func (t *PropertyValue) TryGetOutboxAnyURI() (v *url.URL, err error) {
+	if !t.IsOutboxAnyURI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetOutboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetOutboxAnyURI(), nil
+
+}
+
+// TryGetUnknownOutbox returns the value GetUnknownOutbox returns, or an AccessorError if HasUnknownOutbox returns false
+func (t *PropertyValue) TryGetUnknownOutbox() (v interface{}, err error) {
+	if !t.HasUnknownOutbox() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownOutbox", Index: -1}
+		return
+	}
+	return t.GetUnknownOutbox(), nil
+
+}
+
+// TryGetFollowingCollection returns the value GetFollowingCollection returns, or an AccessorError if IsFollowingCollection returns false
+func (t *PropertyValue) TryGetFollowingCollection() (v CollectionType, err error) {
+	if !t.IsFollowingCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetFollowingCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingCollection(), nil
+
+}
+
+// TryGetFollowingOrderedCollection returns the value GetFollowingOrderedCollection returns, or an AccessorError if IsFollowingOrderedCollection returns false
+func (t *PropertyValue) TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowingOrderedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetFollowingOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingOrderedCollection(), nil
+
+}
+
+// TryGetFollowingAnyURI returns the value GetFollowingAnyURI returns, or an AccessorError if IsFollowingAnyURI returns false
+func (t *PropertyValue) TryGetFollowingAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowingAnyURI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetFollowingAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowingAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowing returns the value GetUnknownFollowing returns, or an AccessorError if HasUnknownFollowing returns false
+func (t *PropertyValue) TryGetUnknownFollowing() (v interface{}, err error) {
+	if !t.HasUnknownFollowing() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownFollowing", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowing(), nil
+
+}
+
+// TryGetFollowersCollection returns the value GetFollowersCollection returns, or an AccessorError if IsFollowersCollection returns false
+func (t *PropertyValue) TryGetFollowersCollection() (v CollectionType, err error) {
+	if !t.IsFollowersCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetFollowersCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersCollection(), nil
+
+}
+
+// TryGetFollowersOrderedCollection returns the value GetFollowersOrderedCollection returns, or an AccessorError if IsFollowersOrderedCollection returns false
+func (t *PropertyValue) TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowersOrderedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetFollowersOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersOrderedCollection(), nil
+
+}
+
+// TryGetFollowersAnyURI returns the value GetFollowersAnyURI returns, or an AccessorError if IsFollowersAnyURI returns false
+func (t *PropertyValue) TryGetFollowersAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowersAnyURI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetFollowersAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowersAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowers returns the value GetUnknownFollowers returns, or an AccessorError if HasUnknownFollowers returns false
+func (t *PropertyValue) TryGetUnknownFollowers() (v interface{}, err error) {
+	if !t.HasUnknownFollowers() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownFollowers", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowers(), nil
+
+}
+
+// TryGetLikedCollection returns the value GetLikedCollection returns, or an AccessorError if IsLikedCollection returns false
+func (t *PropertyValue) TryGetLikedCollection() (v CollectionType, err error) {
+	if !t.IsLikedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLikedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedCollection(), nil
+
+}
+
+// TryGetLikedOrderedCollection returns the value GetLikedOrderedCollection returns, or an AccessorError if IsLikedOrderedCollection returns false
+func (t *PropertyValue) TryGetLikedOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikedOrderedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLikedOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedOrderedCollection(), nil
+
+}
+
+// TryGetLikedAnyURI returns the value GetLikedAnyURI returns, or an AccessorError if IsLikedAnyURI returns false
+func (t *PropertyValue) TryGetLikedAnyURI() (v *url.URL, err error) {
+	if !t.IsLikedAnyURI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLikedAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikedAnyURI(), nil
+
+}
+
+// TryGetUnknownLiked returns the value GetUnknownLiked returns, or an AccessorError if HasUnknownLiked returns false
+func (t *PropertyValue) TryGetUnknownLiked() (v interface{}, err error) {
+	if !t.HasUnknownLiked() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownLiked", Index: -1}
+		return
+	}
+	return t.GetUnknownLiked(), nil
+
+}
+
+// TryGetLikesCollection returns the value GetLikesCollection returns, or an AccessorError if IsLikesCollection returns false
+func (t *PropertyValue) TryGetLikesCollection() (v CollectionType, err error) {
+	if !t.IsLikesCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLikesCollection", Index: -1}
+		return
+	}
+	return t.GetLikesCollection(), nil
+
+}
+
+// TryGetLikesOrderedCollection returns the value GetLikesOrderedCollection returns, or an AccessorError if IsLikesOrderedCollection returns false
+func (t *PropertyValue) TryGetLikesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikesOrderedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLikesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikesOrderedCollection(), nil
+
+}
+
+// TryGetLikesAnyURI returns the value GetLikesAnyURI returns, or an AccessorError if IsLikesAnyURI returns false
+func (t *PropertyValue) TryGetLikesAnyURI() (v *url.URL, err error) {
+	if !t.IsLikesAnyURI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetLikesAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikesAnyURI(), nil
+
+}
+
+// TryGetUnknownLikes returns the value GetUnknownLikes returns, or an AccessorError if HasUnknownLikes returns false
+func (t *PropertyValue) TryGetUnknownLikes() (v interface{}, err error) {
+	if !t.HasUnknownLikes() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownLikes", Index: -1}
+		return
+	}
+	return t.GetUnknownLikes(), nil
+
+}
+
+// TryGetStreams returns the value GetStreams returns, or an AccessorError if the index is out of range
+func (t *PropertyValue) TryGetStreams(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.StreamsLen() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetStreams", Index: index}
+		return
+	}
+	return t.GetStreams(index), nil
+
+}
+
+// TryGetUnknownStreams returns the value GetUnknownStreams returns, or an AccessorError if HasUnknownStreams returns false
+func (t *PropertyValue) TryGetUnknownStreams() (v interface{}, err error) {
+	if !t.HasUnknownStreams() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownStreams", Index: -1}
+		return
+	}
+	return t.GetUnknownStreams(), nil
+
+}
+
+// TryGetPreferredUsername returns the value GetPreferredUsername returns, or an AccessorError if IsPreferredUsername returns false
+func (t *PropertyValue) TryGetPreferredUsername() (v string, err error) {
+	if !t.IsPreferredUsername() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetPreferredUsername(), nil
+
+}
+
+// TryGetPreferredUsernameIRI returns the value GetPreferredUsernameIRI returns, or an AccessorError if IsPreferredUsernameIRI returns false
+func (t *PropertyValue) TryGetPreferredUsernameIRI() (v *url.URL, err error) {
+	if !t.IsPreferredUsernameIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetPreferredUsernameIRI", Index: -1}
+		return
+	}
+	return t.GetPreferredUsernameIRI(), nil
+
+}
+
+// TryGetUnknownPreferredUsername returns the value GetUnknownPreferredUsername returns, or an AccessorError if HasUnknownPreferredUsername returns false
+func (t *PropertyValue) TryGetUnknownPreferredUsername() (v interface{}, err error) {
+	if !t.HasUnknownPreferredUsername() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetUnknownPreferredUsername(), nil
+
+}
+
+// TryGetEndpoints returns the value GetEndpoints returns, or an AccessorError if IsEndpoints returns false
+func (t *PropertyValue) TryGetEndpoints() (v ObjectType, err error) {
+	if !t.IsEndpoints() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetEndpoints", Index: -1}
+		return
+	}
+	return t.GetEndpoints(), nil
+
+}
+
+// TryGetEndpointsIRI returns the value GetEndpointsIRI returns, or an AccessorError if IsEndpointsIRI returns false
+func (t *PropertyValue) TryGetEndpointsIRI() (v *url.URL, err error) {
+	if !t.IsEndpointsIRI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetEndpointsIRI", Index: -1}
+		return
+	}
+	return t.GetEndpointsIRI(), nil
+
+}
+
+// TryGetUnknownEndpoints returns the value GetUnknownEndpoints returns, or an AccessorError if HasUnknownEndpoints returns false
+func (t *PropertyValue) TryGetUnknownEndpoints() (v interface{}, err error) {
+	if !t.HasUnknownEndpoints() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownEndpoints", Index: -1}
+		return
+	}
+	return t.GetUnknownEndpoints(), nil
+
+}
+
+// TryGetProxyUrl returns the value GetProxyUrl returns, or an AccessorError if HasProxyUrl returns false
+func (t *PropertyValue) TryGetProxyUrl() (v *url.URL, err error) {
+	if !t.HasProxyUrl() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetProxyUrl", Index: -1}
+		return
+	}
+	return t.GetProxyUrl(), nil
+
+}
+
+// TryGetUnknownProxyUrl returns the value GetUnknownProxyUrl returns, or an AccessorError if HasUnknownProxyUrl returns false
+func (t *PropertyValue) TryGetUnknownProxyUrl() (v interface{}, err error) {
+	if !t.HasUnknownProxyUrl() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownProxyUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownProxyUrl(), nil
+
+}
+
+// TryGetOauthAuthorizationEndpoint returns the value GetOauthAuthorizationEndpoint returns, or an AccessorError if HasOauthAuthorizationEndpoint returns false
+func (t *PropertyValue) TryGetOauthAuthorizationEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthAuthorizationEndpoint returns the value GetUnknownOauthAuthorizationEndpoint returns, or an AccessorError if HasUnknownOauthAuthorizationEndpoint returns false
+func (t *PropertyValue) TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetOauthTokenEndpoint returns the value GetOauthTokenEndpoint returns, or an AccessorError if HasOauthTokenEndpoint returns false
+func (t *PropertyValue) TryGetOauthTokenEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthTokenEndpoint() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthTokenEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthTokenEndpoint returns the value GetUnknownOauthTokenEndpoint returns, or an AccessorError if HasUnknownOauthTokenEndpoint returns false
+func (t *PropertyValue) TryGetUnknownOauthTokenEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthTokenEndpoint() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthTokenEndpoint(), nil
+
+}
+
+// TryGetProvideClientKey returns the value GetProvideClientKey returns, or an AccessorError if HasProvideClientKey returns false
+func (t *PropertyValue) TryGetProvideClientKey() (v *url.URL, err error) {
+	if !t.HasProvideClientKey() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetProvideClientKey(), nil
+
+}
+
+// TryGetUnknownProvideClientKey returns the value GetUnknownProvideClientKey returns, or an AccessorError if HasUnknownProvideClientKey returns false
+func (t *PropertyValue) TryGetUnknownProvideClientKey() (v interface{}, err error) {
+	if !t.HasUnknownProvideClientKey() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownProvideClientKey(), nil
+
+}
+
+// TryGetSignClientKey returns the value GetSignClientKey returns, or an AccessorError if HasSignClientKey returns false
+func (t *PropertyValue) TryGetSignClientKey() (v *url.URL, err error) {
+	if !t.HasSignClientKey() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSignClientKey", Index: -1}
+		return
+	}
+	return t.GetSignClientKey(), nil
+
+}
+
+// TryGetUnknownSignClientKey returns the value GetUnknownSignClientKey returns, or an AccessorError if HasUnknownSignClientKey returns false
+func (t *PropertyValue) TryGetUnknownSignClientKey() (v interface{}, err error) {
+	if !t.HasUnknownSignClientKey() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownSignClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownSignClientKey(), nil
+
+}
+
+// TryGetSharedInbox returns the value GetSharedInbox returns, or an AccessorError if HasSharedInbox returns false
+func (t *PropertyValue) TryGetSharedInbox() (v *url.URL, err error) {
+	if !t.HasSharedInbox() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSharedInbox", Index: -1}
+		return
+	}
+	return t.GetSharedInbox(), nil
+
+}
+
+// TryGetUnknownSharedInbox returns the value GetUnknownSharedInbox returns, or an AccessorError if HasUnknownSharedInbox returns false
+func (t *PropertyValue) TryGetUnknownSharedInbox() (v interface{}, err error) {
+	if !t.HasUnknownSharedInbox() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownSharedInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownSharedInbox(), nil
+
+}
+
+// TryGetSharesCollection returns the value GetSharesCollection returns, or an AccessorError if IsSharesCollection returns false
+func (t *PropertyValue) TryGetSharesCollection() (v CollectionType, err error) {
+	if !t.IsSharesCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSharesCollection", Index: -1}
+		return
+	}
+	return t.GetSharesCollection(), nil
+
+}
+
+// TryGetSharesOrderedCollection returns the value GetSharesOrderedCollection returns, or an AccessorError if IsSharesOrderedCollection returns false
+func (t *PropertyValue) TryGetSharesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsSharesOrderedCollection() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSharesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetSharesOrderedCollection(), nil
+
+}
+
+// TryGetSharesAnyURI returns the value GetSharesAnyURI returns, or an AccessorError if IsSharesAnyURI returns false
+func (t *PropertyValue) TryGetSharesAnyURI() (v *url.URL, err error) {
+	if !t.IsSharesAnyURI() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetSharesAnyURI", Index: -1}
+		return
+	}
+	return t.GetSharesAnyURI(), nil
+
+}
+
+// TryGetUnknownShares returns the value GetUnknownShares returns, or an AccessorError if HasUnknownShares returns false
+func (t *PropertyValue) TryGetUnknownShares() (v interface{}, err error) {
+	if !t.HasUnknownShares() {
+		err = &AccessorError{Type: "PropertyValue", Getter: "GetUnknownShares", Index: -1}
+		return
+	}
+	return t.GetUnknownShares(), nil
+
+}
+
+// WithValue calls SetValue and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithValue(v string) *PropertyValue {
+	t.SetValue(v)
+	return t
+
+}
+
+// WithValueIRI calls SetValueIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithValueIRI(v *url.URL) *PropertyValue {
+	t.SetValueIRI(v)
+	return t
+
+}
+
+// WithUnknownValue calls SetUnknownValue and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownValue(i interface{}) *PropertyValue {
+	t.SetUnknownValue(i)
+	return t
+
+}
+
+// WithAltitude calls SetAltitude and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAltitude(v float64) *PropertyValue {
+	t.SetAltitude(v)
+	return t
+
+}
+
+// WithAltitudeIRI calls SetAltitudeIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAltitudeIRI(v *url.URL) *PropertyValue {
+	t.SetAltitudeIRI(v)
+	return t
+
+}
+
+// WithUnknownAltitude calls SetUnknownAltitude and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownAltitude(i interface{}) *PropertyValue {
+	t.SetUnknownAltitude(i)
+	return t
+
+}
+
+// WithAttachmentObject calls AppendAttachmentObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAttachmentObject(v ObjectType) *PropertyValue {
+	t.AppendAttachmentObject(v)
+	return t
+
+}
+
+// WithAttachmentLink calls AppendAttachmentLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAttachmentLink(v LinkType) *PropertyValue {
+	t.AppendAttachmentLink(v)
+	return t
+
+}
+
+// WithAttachmentIRI calls AppendAttachmentIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAttachmentIRI(v *url.URL) *PropertyValue {
+	t.AppendAttachmentIRI(v)
+	return t
+
+}
+
+// WithUnknownAttachment calls SetUnknownAttachment and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownAttachment(i interface{}) *PropertyValue {
+	t.SetUnknownAttachment(i)
+	return t
+
+}
+
+// WithAttributedToObject calls AppendAttributedToObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAttributedToObject(v ObjectType) *PropertyValue {
+	t.AppendAttributedToObject(v)
+	return t
+
+}
+
+// WithAttributedToLink calls AppendAttributedToLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAttributedToLink(v LinkType) *PropertyValue {
+	t.AppendAttributedToLink(v)
+	return t
+
+}
+
+// WithAttributedToIRI calls AppendAttributedToIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAttributedToIRI(v *url.URL) *PropertyValue {
+	t.AppendAttributedToIRI(v)
+	return t
+
+}
+
+// WithUnknownAttributedTo calls SetUnknownAttributedTo and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownAttributedTo(i interface{}) *PropertyValue {
+	t.SetUnknownAttributedTo(i)
+	return t
+
+}
+
+// WithAudienceObject calls AppendAudienceObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAudienceObject(v ObjectType) *PropertyValue {
+	t.AppendAudienceObject(v)
+	return t
+
+}
+
+// WithAudienceLink calls AppendAudienceLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAudienceLink(v LinkType) *PropertyValue {
+	t.AppendAudienceLink(v)
+	return t
+
+}
+
+// WithAudienceIRI calls AppendAudienceIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithAudienceIRI(v *url.URL) *PropertyValue {
+	t.AppendAudienceIRI(v)
+	return t
+
+}
+
+// WithUnknownAudience calls SetUnknownAudience and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownAudience(i interface{}) *PropertyValue {
+	t.SetUnknownAudience(i)
+	return t
+
+}
+
+// WithContentString calls AppendContentString and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContentString(v string) *PropertyValue {
+	t.AppendContentString(v)
+	return t
+
+}
+
+// WithContentLangString calls AppendContentLangString and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContentLangString(v string) *PropertyValue {
+	t.AppendContentLangString(v)
+	return t
+
+}
+
+// WithContentIRI calls AppendContentIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContentIRI(v *url.URL) *PropertyValue {
+	t.AppendContentIRI(v)
+	return t
+
+}
+
+// WithUnknownContent calls SetUnknownContent and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownContent(i interface{}) *PropertyValue {
+	t.SetUnknownContent(i)
+	return t
+
+}
+
+// WithContentMap calls SetContentMap and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContentMap(l string, v string) *PropertyValue {
+	t.SetContentMap(l, v)
+	return t
+
+}
+
+// WithContentLanguage calls SetContentLanguage and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContentLanguage(tag string, v string) *PropertyValue {
+	t.SetContentLanguage(tag, v)
+	return t
+
+}
+
+// WithContextObject calls AppendContextObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContextObject(v ObjectType) *PropertyValue {
+	t.AppendContextObject(v)
+	return t
+
+}
+
+// WithContextLink calls AppendContextLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContextLink(v LinkType) *PropertyValue {
+	t.AppendContextLink(v)
+	return t
+
+}
+
+// WithContextIRI calls AppendContextIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithContextIRI(v *url.URL) *PropertyValue {
+	t.AppendContextIRI(v)
+	return t
+
+}
+
+// WithUnknownContext calls SetUnknownContext and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownContext(i interface{}) *PropertyValue {
+	t.SetUnknownContext(i)
+	return t
+
+}
+
+// WithNameString calls AppendNameString and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithNameString(v string) *PropertyValue {
+	t.AppendNameString(v)
+	return t
+
+}
+
+// WithNameLangString calls AppendNameLangString and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithNameLangString(v string) *PropertyValue {
+	t.AppendNameLangString(v)
+	return t
+
+}
+
+// WithNameIRI calls AppendNameIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithNameIRI(v *url.URL) *PropertyValue {
+	t.AppendNameIRI(v)
+	return t
+
+}
+
+// WithUnknownName calls SetUnknownName and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownName(i interface{}) *PropertyValue {
+	t.SetUnknownName(i)
+	return t
+
+}
+
+// WithNameMap calls SetNameMap and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithNameMap(l string, v string) *PropertyValue {
+	t.SetNameMap(l, v)
+	return t
+
+}
+
+// WithNameLanguage calls SetNameLanguage and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithNameLanguage(tag string, v string) *PropertyValue {
+	t.SetNameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndTime calls SetEndTime and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithEndTime(v time.Time) *PropertyValue {
+	t.SetEndTime(v)
+	return t
+
+}
+
+// WithEndTimeIRI calls SetEndTimeIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithEndTimeIRI(v *url.URL) *PropertyValue {
+	t.SetEndTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownEndTime calls SetUnknownEndTime and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownEndTime(i interface{}) *PropertyValue {
+	t.SetUnknownEndTime(i)
+	return t
+
+}
+
+// WithGeneratorObject calls AppendGeneratorObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithGeneratorObject(v ObjectType) *PropertyValue {
+	t.AppendGeneratorObject(v)
+	return t
+
+}
+
+// WithGeneratorLink calls AppendGeneratorLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithGeneratorLink(v LinkType) *PropertyValue {
+	t.AppendGeneratorLink(v)
+	return t
+
+}
+
+// WithGeneratorIRI calls AppendGeneratorIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithGeneratorIRI(v *url.URL) *PropertyValue {
+	t.AppendGeneratorIRI(v)
+	return t
+
+}
+
+// WithUnknownGenerator calls SetUnknownGenerator and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownGenerator(i interface{}) *PropertyValue {
+	t.SetUnknownGenerator(i)
+	return t
+
+}
+
+// WithIconImage calls AppendIconImage and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithIconImage(v ImageType) *PropertyValue {
+	t.AppendIconImage(v)
+	return t
+
+}
+
+// WithIconLink calls AppendIconLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithIconLink(v LinkType) *PropertyValue {
+	t.AppendIconLink(v)
+	return t
+
+}
+
+// WithIconIRI calls AppendIconIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithIconIRI(v *url.URL) *PropertyValue {
+	t.AppendIconIRI(v)
+	return t
+
+}
+
+// WithUnknownIcon calls SetUnknownIcon and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownIcon(i interface{}) *PropertyValue {
+	t.SetUnknownIcon(i)
+	return t
+
+}
+
+// WithId calls SetId and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithId(v *url.URL) *PropertyValue {
+	t.SetId(v)
+	return t
+
+}
+
+// WithUnknownId calls SetUnknownId and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownId(i interface{}) *PropertyValue {
+	t.SetUnknownId(i)
+	return t
+
+}
+
+// WithImageImage calls AppendImageImage and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithImageImage(v ImageType) *PropertyValue {
+	t.AppendImageImage(v)
+	return t
+
+}
+
+// WithImageLink calls AppendImageLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithImageLink(v LinkType) *PropertyValue {
+	t.AppendImageLink(v)
+	return t
+
+}
+
+// WithImageIRI calls AppendImageIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithImageIRI(v *url.URL) *PropertyValue {
+	t.AppendImageIRI(v)
+	return t
+
+}
+
+// WithUnknownImage calls SetUnknownImage and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownImage(i interface{}) *PropertyValue {
+	t.SetUnknownImage(i)
+	return t
+
+}
+
+// WithInReplyToObject calls AppendInReplyToObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithInReplyToObject(v ObjectType) *PropertyValue {
+	t.AppendInReplyToObject(v)
+	return t
+
+}
+
+// WithInReplyToLink calls AppendInReplyToLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithInReplyToLink(v LinkType) *PropertyValue {
+	t.AppendInReplyToLink(v)
+	return t
+
+}
+
+// WithInReplyToIRI calls AppendInReplyToIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithInReplyToIRI(v *url.URL) *PropertyValue {
+	t.AppendInReplyToIRI(v)
+	return t
+
+}
+
+// WithUnknownInReplyTo calls SetUnknownInReplyTo and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownInReplyTo(i interface{}) *PropertyValue {
+	t.SetUnknownInReplyTo(i)
+	return t
+
+}
+
+// WithLocationObject calls AppendLocationObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLocationObject(v ObjectType) *PropertyValue {
+	t.AppendLocationObject(v)
+	return t
+
+}
+
+// WithLocationLink calls AppendLocationLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLocationLink(v LinkType) *PropertyValue {
+	t.AppendLocationLink(v)
+	return t
+
+}
+
+// WithLocationIRI calls AppendLocationIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLocationIRI(v *url.URL) *PropertyValue {
+	t.AppendLocationIRI(v)
+	return t
+
+}
+
+// WithUnknownLocation calls SetUnknownLocation and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownLocation(i interface{}) *PropertyValue {
+	t.SetUnknownLocation(i)
+	return t
+
+}
+
+// WithPreviewObject calls AppendPreviewObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPreviewObject(v ObjectType) *PropertyValue {
+	t.AppendPreviewObject(v)
+	return t
+
+}
+
+// WithPreviewLink calls AppendPreviewLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPreviewLink(v LinkType) *PropertyValue {
+	t.AppendPreviewLink(v)
+	return t
+
+}
+
+// WithPreviewIRI calls AppendPreviewIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPreviewIRI(v *url.URL) *PropertyValue {
+	t.AppendPreviewIRI(v)
+	return t
+
+}
+
+// WithUnknownPreview calls SetUnknownPreview and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownPreview(i interface{}) *PropertyValue {
+	t.SetUnknownPreview(i)
+	return t
+
+}
+
+// WithPublished calls SetPublished and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPublished(v time.Time) *PropertyValue {
+	t.SetPublished(v)
+	return t
+
+}
+
+// WithPublishedIRI calls SetPublishedIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPublishedIRI(v *url.URL) *PropertyValue {
+	t.SetPublishedIRI(v)
+	return t
+
+}
+
+// WithUnknownPublished calls SetUnknownPublished and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownPublished(i interface{}) *PropertyValue {
+	t.SetUnknownPublished(i)
+	return t
+
+}
+
+// WithReplies calls SetReplies and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithReplies(v CollectionType) *PropertyValue {
+	t.SetReplies(v)
+	return t
+
+}
+
+// WithRepliesIRI calls SetRepliesIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithRepliesIRI(v *url.URL) *PropertyValue {
+	t.SetRepliesIRI(v)
+	return t
+
+}
+
+// WithUnknownReplies calls SetUnknownReplies and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownReplies(i interface{}) *PropertyValue {
+	t.SetUnknownReplies(i)
+	return t
+
+}
+
+// WithStartTime calls SetStartTime and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithStartTime(v time.Time) *PropertyValue {
+	t.SetStartTime(v)
+	return t
+
+}
+
+// WithStartTimeIRI calls SetStartTimeIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithStartTimeIRI(v *url.URL) *PropertyValue {
+	t.SetStartTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownStartTime calls SetUnknownStartTime and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownStartTime(i interface{}) *PropertyValue {
+	t.SetUnknownStartTime(i)
+	return t
+
+}
+
+// WithSummaryString calls AppendSummaryString and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSummaryString(v string) *PropertyValue {
+	t.AppendSummaryString(v)
+	return t
+
+}
+
+// WithSummaryLangString calls AppendSummaryLangString and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSummaryLangString(v string) *PropertyValue {
+	t.AppendSummaryLangString(v)
+	return t
+
+}
+
+// WithSummaryIRI calls AppendSummaryIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSummaryIRI(v *url.URL) *PropertyValue {
+	t.AppendSummaryIRI(v)
+	return t
+
+}
+
+// WithUnknownSummary calls SetUnknownSummary and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownSummary(i interface{}) *PropertyValue {
+	t.SetUnknownSummary(i)
+	return t
+
+}
+
+// WithSummaryMap calls SetSummaryMap and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSummaryMap(l string, v string) *PropertyValue {
+	t.SetSummaryMap(l, v)
+	return t
+
+}
+
+// WithSummaryLanguage calls SetSummaryLanguage and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSummaryLanguage(tag string, v string) *PropertyValue {
+	t.SetSummaryLanguage(tag, v)
+	return t
+
+}
+
+// WithTagObject calls AppendTagObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithTagObject(v ObjectType) *PropertyValue {
+	t.AppendTagObject(v)
+	return t
+
+}
+
+// WithTagLink calls AppendTagLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithTagLink(v LinkType) *PropertyValue {
+	t.AppendTagLink(v)
+	return t
+
+}
+
+// WithTagIRI calls AppendTagIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithTagIRI(v *url.URL) *PropertyValue {
+	t.AppendTagIRI(v)
+	return t
+
+}
+
+// WithUnknownTag calls SetUnknownTag and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownTag(i interface{}) *PropertyValue {
+	t.SetUnknownTag(i)
+	return t
+
+}
+
+// WithType calls AppendType and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithType(v interface{}) *PropertyValue {
+	t.AppendType(v)
+	return t
+
+}
+
+// WithUpdated calls SetUpdated and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUpdated(v time.Time) *PropertyValue {
+	t.SetUpdated(v)
+	return t
+
+}
+
+// WithUpdatedIRI calls SetUpdatedIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUpdatedIRI(v *url.URL) *PropertyValue {
+	t.SetUpdatedIRI(v)
+	return t
+
+}
+
+// WithUnknownUpdated calls SetUnknownUpdated and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownUpdated(i interface{}) *PropertyValue {
+	t.SetUnknownUpdated(i)
+	return t
+
+}
+
+// WithUrlAnyURI calls AppendUrlAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUrlAnyURI(v *url.URL) *PropertyValue {
+	t.AppendUrlAnyURI(v)
+	return t
+
+}
+
+// WithUrlLink calls AppendUrlLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUrlLink(v LinkType) *PropertyValue {
+	t.AppendUrlLink(v)
+	return t
+
+}
+
+// WithUnknownUrl calls SetUnknownUrl and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownUrl(i interface{}) *PropertyValue {
+	t.SetUnknownUrl(i)
+	return t
+
+}
+
+// WithToObject calls AppendToObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithToObject(v ObjectType) *PropertyValue {
+	t.AppendToObject(v)
+	return t
+
+}
+
+// WithToLink calls AppendToLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithToLink(v LinkType) *PropertyValue {
+	t.AppendToLink(v)
+	return t
+
+}
+
+// WithToIRI calls AppendToIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithToIRI(v *url.URL) *PropertyValue {
+	t.AppendToIRI(v)
+	return t
+
+}
+
+// WithUnknownTo calls SetUnknownTo and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownTo(i interface{}) *PropertyValue {
+	t.SetUnknownTo(i)
+	return t
+
+}
+
+// WithBtoObject calls AppendBtoObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithBtoObject(v ObjectType) *PropertyValue {
+	t.AppendBtoObject(v)
+	return t
+
+}
+
+// WithBtoLink calls AppendBtoLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithBtoLink(v LinkType) *PropertyValue {
+	t.AppendBtoLink(v)
+	return t
+
+}
+
+// WithBtoIRI calls AppendBtoIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithBtoIRI(v *url.URL) *PropertyValue {
+	t.AppendBtoIRI(v)
+	return t
+
+}
+
+// WithUnknownBto calls SetUnknownBto and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownBto(i interface{}) *PropertyValue {
+	t.SetUnknownBto(i)
+	return t
+
+}
+
+// WithCcObject calls AppendCcObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithCcObject(v ObjectType) *PropertyValue {
+	t.AppendCcObject(v)
+	return t
+
+}
+
+// WithCcLink calls AppendCcLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithCcLink(v LinkType) *PropertyValue {
+	t.AppendCcLink(v)
+	return t
+
+}
+
+// WithCcIRI calls AppendCcIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithCcIRI(v *url.URL) *PropertyValue {
+	t.AppendCcIRI(v)
+	return t
+
+}
+
+// WithUnknownCc calls SetUnknownCc and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownCc(i interface{}) *PropertyValue {
+	t.SetUnknownCc(i)
+	return t
+
+}
+
+// WithBccObject calls AppendBccObject and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithBccObject(v ObjectType) *PropertyValue {
+	t.AppendBccObject(v)
+	return t
+
+}
+
+// WithBccLink calls AppendBccLink and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithBccLink(v LinkType) *PropertyValue {
+	t.AppendBccLink(v)
+	return t
+
+}
+
+// WithBccIRI calls AppendBccIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithBccIRI(v *url.URL) *PropertyValue {
+	t.AppendBccIRI(v)
+	return t
+
+}
+
+// WithUnknownBcc calls SetUnknownBcc and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownBcc(i interface{}) *PropertyValue {
+	t.SetUnknownBcc(i)
+	return t
+
+}
+
+// WithMediaType calls SetMediaType and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithMediaType(v string) *PropertyValue {
+	t.SetMediaType(v)
+	return t
+
+}
+
+// WithMediaTypeIRI calls SetMediaTypeIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithMediaTypeIRI(v *url.URL) *PropertyValue {
+	t.SetMediaTypeIRI(v)
+	return t
+
+}
+
+// WithUnknownMediaType calls SetUnknownMediaType and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownMediaType(i interface{}) *PropertyValue {
+	t.SetUnknownMediaType(i)
+	return t
+
+}
+
+// WithDuration calls SetDuration and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithDuration(v time.Duration) *PropertyValue {
+	t.SetDuration(v)
+	return t
+
+}
+
+// WithDurationIRI calls SetDurationIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithDurationIRI(v *url.URL) *PropertyValue {
+	t.SetDurationIRI(v)
+	return t
+
+}
+
+// WithUnknownDuration calls SetUnknownDuration and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownDuration(i interface{}) *PropertyValue {
+	t.SetUnknownDuration(i)
+	return t
+
+}
+
+// WithSource calls SetSource and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSource(v ObjectType) *PropertyValue {
+	t.SetSource(v)
+	return t
+
+}
+
+// WithSourceIRI calls SetSourceIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSourceIRI(v *url.URL) *PropertyValue {
+	t.SetSourceIRI(v)
+	return t
+
+}
+
+// WithUnknownSource calls SetUnknownSource and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownSource(i interface{}) *PropertyValue {
+	t.SetUnknownSource(i)
+	return t
+
+}
+
+// WithInboxOrderedCollection calls SetInboxOrderedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithInboxOrderedCollection(v OrderedCollectionType) *PropertyValue {
+	t.SetInboxOrderedCollection(v)
+	return t
+
+}
+
+// WithInboxAnyURI calls SetInboxAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithInboxAnyURI(v *url.URL) *PropertyValue {
+	t.SetInboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownInbox calls SetUnknownInbox and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownInbox(i interface{}) *PropertyValue {
+	t.SetUnknownInbox(i)
+	return t
+
+}
+
+// WithOutboxOrderedCollection calls SetOutboxOrderedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithOutboxOrderedCollection(v OrderedCollectionType) *PropertyValue {
+	t.SetOutboxOrderedCollection(v)
+	return t
+
+}
+
+// WithOutboxAnyURI calls SetOutboxAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithOutboxAnyURI(v *url.URL) *PropertyValue {
+	t.SetOutboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownOutbox calls SetUnknownOutbox and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownOutbox(i interface{}) *PropertyValue {
+	t.SetUnknownOutbox(i)
+	return t
+
+}
+
+// WithFollowingCollection calls SetFollowingCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithFollowingCollection(v CollectionType) *PropertyValue {
+	t.SetFollowingCollection(v)
+	return t
+
+}
+
+// WithFollowingOrderedCollection calls SetFollowingOrderedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithFollowingOrderedCollection(v OrderedCollectionType) *PropertyValue {
+	t.SetFollowingOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowingAnyURI calls SetFollowingAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithFollowingAnyURI(v *url.URL) *PropertyValue {
+	t.SetFollowingAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowing calls SetUnknownFollowing and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownFollowing(i interface{}) *PropertyValue {
+	t.SetUnknownFollowing(i)
+	return t
+
+}
+
+// WithFollowersCollection calls SetFollowersCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithFollowersCollection(v CollectionType) *PropertyValue {
+	t.SetFollowersCollection(v)
+	return t
+
+}
+
+// WithFollowersOrderedCollection calls SetFollowersOrderedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithFollowersOrderedCollection(v OrderedCollectionType) *PropertyValue {
+	t.SetFollowersOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowersAnyURI calls SetFollowersAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithFollowersAnyURI(v *url.URL) *PropertyValue {
+	t.SetFollowersAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowers calls SetUnknownFollowers and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownFollowers(i interface{}) *PropertyValue {
+	t.SetUnknownFollowers(i)
+	return t
+
+}
+
+// WithLikedCollection calls SetLikedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLikedCollection(v CollectionType) *PropertyValue {
+	t.SetLikedCollection(v)
+	return t
+
+}
+
+// WithLikedOrderedCollection calls SetLikedOrderedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLikedOrderedCollection(v OrderedCollectionType) *PropertyValue {
+	t.SetLikedOrderedCollection(v)
+	return t
+
+}
+
+// WithLikedAnyURI calls SetLikedAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLikedAnyURI(v *url.URL) *PropertyValue {
+	t.SetLikedAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLiked calls SetUnknownLiked and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownLiked(i interface{}) *PropertyValue {
+	t.SetUnknownLiked(i)
+	return t
+
+}
+
+// WithLikesCollection calls SetLikesCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLikesCollection(v CollectionType) *PropertyValue {
+	t.SetLikesCollection(v)
+	return t
+
+}
+
+// WithLikesOrderedCollection calls SetLikesOrderedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLikesOrderedCollection(v OrderedCollectionType) *PropertyValue {
+	t.SetLikesOrderedCollection(v)
+	return t
+
+}
+
+// WithLikesAnyURI calls SetLikesAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithLikesAnyURI(v *url.URL) *PropertyValue {
+	t.SetLikesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLikes calls SetUnknownLikes and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownLikes(i interface{}) *PropertyValue {
+	t.SetUnknownLikes(i)
+	return t
+
+}
+
+// WithStreams calls AppendStreams and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithStreams(v *url.URL) *PropertyValue {
+	t.AppendStreams(v)
+	return t
+
+}
+
+// WithUnknownStreams calls SetUnknownStreams and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownStreams(i interface{}) *PropertyValue {
+	t.SetUnknownStreams(i)
+	return t
+
+}
+
+// WithPreferredUsername calls SetPreferredUsername and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPreferredUsername(v string) *PropertyValue {
+	t.SetPreferredUsername(v)
+	return t
+
+}
+
+// WithPreferredUsernameIRI calls SetPreferredUsernameIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPreferredUsernameIRI(v *url.URL) *PropertyValue {
+	t.SetPreferredUsernameIRI(v)
+	return t
+
+}
+
+// WithUnknownPreferredUsername calls SetUnknownPreferredUsername and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownPreferredUsername(i interface{}) *PropertyValue {
+	t.SetUnknownPreferredUsername(i)
+	return t
+
+}
+
+// WithPreferredUsernameMap calls SetPreferredUsernameMap and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPreferredUsernameMap(l string, v string) *PropertyValue {
+	t.SetPreferredUsernameMap(l, v)
+	return t
+
+}
+
+// WithPreferredUsernameLanguage calls SetPreferredUsernameLanguage and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithPreferredUsernameLanguage(tag string, v string) *PropertyValue {
+	t.SetPreferredUsernameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndpoints calls SetEndpoints and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithEndpoints(v ObjectType) *PropertyValue {
+	t.SetEndpoints(v)
+	return t
+
+}
+
+// WithEndpointsIRI calls SetEndpointsIRI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithEndpointsIRI(v *url.URL) *PropertyValue {
+	t.SetEndpointsIRI(v)
+	return t
+
+}
+
+// WithUnknownEndpoints calls SetUnknownEndpoints and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownEndpoints(i interface{}) *PropertyValue {
+	t.SetUnknownEndpoints(i)
+	return t
+
+}
+
+// WithProxyUrl calls SetProxyUrl and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithProxyUrl(v *url.URL) *PropertyValue {
+	t.SetProxyUrl(v)
+	return t
+
+}
+
+// WithUnknownProxyUrl calls SetUnknownProxyUrl and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownProxyUrl(i interface{}) *PropertyValue {
+	t.SetUnknownProxyUrl(i)
+	return t
+
+}
+
+// WithOauthAuthorizationEndpoint calls SetOauthAuthorizationEndpoint and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithOauthAuthorizationEndpoint(v *url.URL) *PropertyValue {
+	t.SetOauthAuthorizationEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthAuthorizationEndpoint calls SetUnknownOauthAuthorizationEndpoint and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownOauthAuthorizationEndpoint(i interface{}) *PropertyValue {
+	t.SetUnknownOauthAuthorizationEndpoint(i)
+	return t
+
+}
+
+// WithOauthTokenEndpoint calls SetOauthTokenEndpoint and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithOauthTokenEndpoint(v *url.URL) *PropertyValue {
+	t.SetOauthTokenEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthTokenEndpoint calls SetUnknownOauthTokenEndpoint and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownOauthTokenEndpoint(i interface{}) *PropertyValue {
+	t.SetUnknownOauthTokenEndpoint(i)
+	return t
+
+}
+
+// WithProvideClientKey calls SetProvideClientKey and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithProvideClientKey(v *url.URL) *PropertyValue {
+	t.SetProvideClientKey(v)
+	return t
+
+}
+
+// WithUnknownProvideClientKey calls SetUnknownProvideClientKey and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownProvideClientKey(i interface{}) *PropertyValue {
+	t.SetUnknownProvideClientKey(i)
+	return t
+
+}
+
+// WithSignClientKey calls SetSignClientKey and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSignClientKey(v *url.URL) *PropertyValue {
+	t.SetSignClientKey(v)
+	return t
+
+}
+
+// WithUnknownSignClientKey calls SetUnknownSignClientKey and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownSignClientKey(i interface{}) *PropertyValue {
+	t.SetUnknownSignClientKey(i)
+	return t
+
+}
+
+// WithSharedInbox calls SetSharedInbox and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSharedInbox(v *url.URL) *PropertyValue {
+	t.SetSharedInbox(v)
+	return t
+
+}
+
+// WithUnknownSharedInbox calls SetUnknownSharedInbox and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownSharedInbox(i interface{}) *PropertyValue {
+	t.SetUnknownSharedInbox(i)
+	return t
+
+}
+
+// WithSharesCollection calls SetSharesCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSharesCollection(v CollectionType) *PropertyValue {
+	t.SetSharesCollection(v)
+	return t
+
+}
+
+// WithSharesOrderedCollection calls SetSharesOrderedCollection and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSharesOrderedCollection(v OrderedCollectionType) *PropertyValue {
+	t.SetSharesOrderedCollection(v)
+	return t
+
+}
+
+// WithSharesAnyURI calls SetSharesAnyURI and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithSharesAnyURI(v *url.URL) *PropertyValue {
+	t.SetSharesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownShares calls SetUnknownShares and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownShares(i interface{}) *PropertyValue {
+	t.SetUnknownShares(i)
+	return t
+
+}
+
+// WithUnknownProperty calls SetUnknownProperty and returns this PropertyValue, so that calls can be chained
+func (t *PropertyValue) WithUnknownProperty(k string, i interface{}) *PropertyValue {
+	t.SetUnknownProperty(k, i)
+	return t
+
+}
+
+// markPresent_ sets or clears the bit of a member in the presence bitset.
+func (t *PropertyValue) markPresent_(i uint, set bool) {
+	if set {
+		t.present_[i/64] |= 1 << (i % 64)
+	} else {
+		t.present_[i/64] &^= 1 << (i % 64)
+	}
+
+}
+
+// markAllPresent_ sets the bits of every member in the presence bitset that is set, and clears the others.
+func (t *PropertyValue) markAllPresent_() {
+	t.markPresent_(0, t.value != nil)
+	t.markPresent_(1, t.altitude != nil)
+	t.markPresent_(2, t.attachment != nil)
+	t.markPresent_(3, t.attributedTo != nil)
+	t.markPresent_(4, t.audience != nil)
+	t.markPresent_(5, t.content != nil)
+	t.markPresent_(6, t.contentMap != nil)
+	t.markPresent_(7, t.context != nil)
+	t.markPresent_(8, t.name != nil)
+	t.markPresent_(9, t.nameMap != nil)
+	t.markPresent_(10, t.endTime != nil)
+	t.markPresent_(11, t.generator != nil)
+	t.markPresent_(12, t.icon != nil)
+	t.markPresent_(13, t.id != nil)
+	t.markPresent_(14, t.image != nil)
+	t.markPresent_(15, t.inReplyTo != nil)
+	t.markPresent_(16, t.location != nil)
+	t.markPresent_(17, t.preview != nil)
+	t.markPresent_(18, t.published != nil)
+	t.markPresent_(19, t.replies != nil)
+	t.markPresent_(20, t.startTime != nil)
+	t.markPresent_(21, t.summary != nil)
+	t.markPresent_(22, t.summaryMap != nil)
+	t.markPresent_(23, t.tag != nil)
+	t.markPresent_(24, t.typeName != nil)
+	t.markPresent_(25, t.updated != nil)
+	t.markPresent_(26, t.url != nil)
+	t.markPresent_(27, t.to != nil)
+	t.markPresent_(28, t.bto != nil)
+	t.markPresent_(29, t.cc != nil)
+	t.markPresent_(30, t.bcc != nil)
+	t.markPresent_(31, t.mediaType != nil)
+	t.markPresent_(32, t.duration != nil)
+	t.markPresent_(33, t.source != nil)
+	t.markPresent_(34, t.inbox != nil)
+	t.markPresent_(35, t.outbox != nil)
+	t.markPresent_(36, t.following != nil)
+	t.markPresent_(37, t.followers != nil)
+	t.markPresent_(38, t.liked != nil)
+	t.markPresent_(39, t.likes != nil)
+	t.markPresent_(40, t.streams != nil)
+	t.markPresent_(41, t.preferredUsername != nil)
+	t.markPresent_(42, t.preferredUsernameMap != nil)
+	t.markPresent_(43, t.endpoints != nil)
+	t.markPresent_(44, t.proxyUrl != nil)
+	t.markPresent_(45, t.oauthAuthorizationEndpoint != nil)
+	t.markPresent_(46, t.oauthTokenEndpoint != nil)
+	t.markPresent_(47, t.provideClientKey != nil)
+	t.markPresent_(48, t.signClientKey != nil)
+	t.markPresent_(49, t.sharedInbox != nil)
+	t.markPresent_(50, t.shares != nil)
+
+}
@@ -0,0 +1,62 @@
+//
+//go:generate go install github.com/go-fed/activity/tools/schema
+//go:generate schema
+package schema
+
+import (
+	"encoding/json"
+	"github.com/go-fed/activity/vocab"
+	"testing"
+)
+
+func TestProfileFields(t *testing.T) {
+	const actor = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"schema": "http://schema.org#", "PropertyValue": "schema:PropertyValue", "value": "schema:value"}],
+  "type": "Person",
+  "id": "https://example.com/users/alice",
+  "attachment": [
+    {"type": "PropertyValue", "name": "Website", "value": "<a href=\"https://alice.example\">alice.example</a>"},
+    {"type": "PropertyValue", "name": "Pronouns", "value": "they/them"}
+  ]
+}`
+	p := &vocab.Person{}
+	if err := json.Unmarshal([]byte(actor), p); err != nil {
+		t.Fatalf("Cannot unmarshal: %s", err)
+	}
+	if p.AttachmentLen() != 2 {
+		t.Fatalf("Expected 2 attachments, got %d", p.AttachmentLen())
+	}
+	want := [][2]string{{"Website", "<a href=\"https://alice.example\">alice.example</a>"}, {"Pronouns", "they/them"}}
+	for i, w := range want {
+		if !p.IsAttachmentObject(i) {
+			t.Fatalf("Expected attachment %d to be an object", i)
+		}
+		v, ok := p.GetAttachmentObject(i).(*PropertyValue)
+		if !ok {
+			t.Fatalf("Expected attachment %d to be a *PropertyValue, got %T", i, p.GetAttachmentObject(i))
+		}
+		if v.NameLen() != 1 || v.GetNameString(0) != w[0] {
+			t.Errorf("Expected the name %q", w[0])
+		}
+		if !v.IsValue() || v.GetValue() != w[1] {
+			t.Errorf("Expected the value %q, got %q", w[1], v.GetValue())
+		}
+	}
+
+	field := &PropertyValue{}
+	field.AppendNameString("Matrix")
+	field.SetValue("@alice:example.com")
+	p.AppendAttachmentObject(field)
+	m, err := p.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	}
+	a, ok := m["attachment"].([]interface{})
+	if !ok || len(a) != 3 {
+		t.Fatalf("Expected 3 serialized attachments, got %v", m["attachment"])
+	}
+	f, ok := a[2].(map[string]interface{})
+	if !ok || f["type"] != "PropertyValue" || f["value"] != "@alice:example.com" {
+		t.Errorf("Expected the new field, got %v", a[2])
+	}
+}
@@ -0,0 +1,151 @@
+//
+package security
+
+import (
+	"net/url"
+)
+
+// Extensible is implemented by every type, including those of the core package, which keep the properties of this package they do not know of as unknown properties.
+type Extensible interface {
+	HasUnknown(k string) (b bool)
+	GetUnknown(k string) (i interface{})
+	SetUnknownProperty(k string, i interface{})
+}
+
+// attachedPublicKey deserializes the 'publicKey' of the value, or returns nil if it has none or it cannot be deserialized. A value without a type is deserialized as a Key, the only type the property takes
+func attachedPublicKey(t Extensible) (i *publicKeyIntermediateType) {
+	if !t.HasUnknown("publicKey") {
+		return nil
+	}
+	v := t.GetUnknown("publicKey")
+	if m, ok := v.(map[string]interface{}); ok && m["type"] == nil {
+		tmp := &Key{}
+		if err := tmp.Deserialize(m); err != nil {
+			return nil
+		}
+		return &publicKeyIntermediateType{Key: tmp}
+	}
+	i, err := deserializePublicKeyIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsPublicKey determines whether the call to GetPublicKey is safe, which is whether the 'publicKey' of the value is of KeyType type
+func IsPublicKey(t Extensible) (ok bool) {
+	i := attachedPublicKey(t)
+	return i != nil && i.Key != nil
+
+}
+
+// GetPublicKey returns the 'publicKey' of the value safely if IsPublicKey returned true
+func GetPublicKey(t Extensible) (v KeyType) {
+	return attachedPublicKey(t).Key
+
+}
+
+// SetPublicKey sets the 'publicKey' of the value to be of KeyType type, as an unknown property of the value
+func SetPublicKey(t Extensible, v KeyType) (err error) {
+	i, err := serializePublicKeyIntermediateType(&publicKeyIntermediateType{Key: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("publicKey", i)
+	return
+
+}
+
+// IsPublicKeyIRI determines whether the call to GetPublicKeyIRI is safe, which is whether the 'publicKey' of the value is of *url.URL type
+func IsPublicKeyIRI(t Extensible) (ok bool) {
+	i := attachedPublicKey(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetPublicKeyIRI returns the 'publicKey' of the value safely if IsPublicKeyIRI returned true
+func GetPublicKeyIRI(t Extensible) (v *url.URL) {
+	return attachedPublicKey(t).IRI
+
+}
+
+// SetPublicKeyIRI sets the 'publicKey' of the value to be of *url.URL type, as an unknown property of the value
+func SetPublicKeyIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializePublicKeyIntermediateType(&publicKeyIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("publicKey", i)
+	return
+
+}
+
+// attachedProof deserializes the 'proof' of the value, or returns nil if it has none or it cannot be deserialized. A value without a type is deserialized as a DataIntegrityProof, the only type the property takes
+func attachedProof(t Extensible) (i *proofIntermediateType) {
+	if !t.HasUnknown("proof") {
+		return nil
+	}
+	v := t.GetUnknown("proof")
+	if m, ok := v.(map[string]interface{}); ok && m["type"] == nil {
+		tmp := &DataIntegrityProof{}
+		if err := tmp.Deserialize(m); err != nil {
+			return nil
+		}
+		return &proofIntermediateType{DataIntegrityProof: tmp}
+	}
+	i, err := deserializeProofIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsProof determines whether the call to GetProof is safe, which is whether the 'proof' of the value is of DataIntegrityProofType type
+func IsProof(t Extensible) (ok bool) {
+	i := attachedProof(t)
+	return i != nil && i.DataIntegrityProof != nil
+
+}
+
+// GetProof returns the 'proof' of the value safely if IsProof returned true
+func GetProof(t Extensible) (v DataIntegrityProofType) {
+	return attachedProof(t).DataIntegrityProof
+
+}
+
+// SetProof sets the 'proof' of the value to be of DataIntegrityProofType type, as an unknown property of the value
+func SetProof(t Extensible, v DataIntegrityProofType) (err error) {
+	i, err := serializeProofIntermediateType(&proofIntermediateType{DataIntegrityProof: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("proof", i)
+	return
+
+}
+
+// IsProofIRI determines whether the call to GetProofIRI is safe, which is whether the 'proof' of the value is of *url.URL type
+func IsProofIRI(t Extensible) (ok bool) {
+	i := attachedProof(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetProofIRI returns the 'proof' of the value safely if IsProofIRI returned true
+func GetProofIRI(t Extensible) (v *url.URL) {
+	return attachedProof(t).IRI
+
+}
+
+// SetProofIRI sets the 'proof' of the value to be of *url.URL type, as an unknown property of the value
+func SetProofIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializeProofIntermediateType(&proofIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("proof", i)
+	return
+
+}
@@ -0,0 +1,36 @@
+//
+package security
+
+// DeserializeManyKey deserializes each of the maps as a Key. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyKey(ms []map[string]interface{}) (t []*Key, err error) {
+	backing := make([]Key, len(ms))
+	t = make([]*Key, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyDataIntegrityProof deserializes each of the maps as a DataIntegrityProof. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyDataIntegrityProof(ms []map[string]interface{}) (t []*DataIntegrityProof, err error) {
+	backing := make([]DataIntegrityProof, len(ms))
+	t = make([]*DataIntegrityProof, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
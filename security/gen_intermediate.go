@@ -0,0 +1,6438 @@
+//
+package security
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// altitudeIntermediateType will only have one of its values set at most
+type altitudeIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *float64 type for altitude property
+	float *float64
+	// Stores possible *url.URL type for altitude property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *altitudeIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.float, err = floatDeserialize(i)
+			if err != nil {
+				t.float = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *altitudeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.float != nil {
+		i = floatSerialize(*t.float)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *altitudeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// attachmentIntermediateType will only have one of its values set at most
+type attachmentIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for attachment property
+	Object ObjectType
+	// Stores possible LinkType type for attachment property
+	Link LinkType
+	// Stores possible *url.URL type for attachment property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *attachmentIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *attachmentIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *attachmentIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// attributedToIntermediateType will only have one of its values set at most
+type attributedToIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for attributedTo property
+	Object ObjectType
+	// Stores possible LinkType type for attributedTo property
+	Link LinkType
+	// Stores possible *url.URL type for attributedTo property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *attributedToIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *attributedToIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *attributedToIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// audienceIntermediateType will only have one of its values set at most
+type audienceIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for audience property
+	Object ObjectType
+	// Stores possible LinkType type for audience property
+	Link LinkType
+	// Stores possible *url.URL type for audience property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *audienceIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *audienceIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *audienceIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// bccIntermediateType will only have one of its values set at most
+type bccIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for bcc property
+	Object ObjectType
+	// Stores possible LinkType type for bcc property
+	Link LinkType
+	// Stores possible *url.URL type for bcc property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *bccIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *bccIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *bccIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// btoIntermediateType will only have one of its values set at most
+type btoIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for bto property
+	Object ObjectType
+	// Stores possible LinkType type for bto property
+	Link LinkType
+	// Stores possible *url.URL type for bto property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *btoIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *btoIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *btoIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// ccIntermediateType will only have one of its values set at most
+type ccIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for cc property
+	Object ObjectType
+	// Stores possible LinkType type for cc property
+	Link LinkType
+	// Stores possible *url.URL type for cc property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *ccIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *ccIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *ccIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// contentIntermediateType will only have one of its values set at most
+type contentIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for content property
+	stringName *string
+	// Stores possible *string type for content property
+	langString *string
+	// Stores possible *url.URL type for content property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *contentIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.langString, err = langStringDeserialize(i)
+			if err != nil {
+				t.langString = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *contentIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.langString != nil {
+		i = langStringSerialize(*t.langString)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *contentIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// contextIntermediateType will only have one of its values set at most
+type contextIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for context property
+	Object ObjectType
+	// Stores possible LinkType type for context property
+	Link LinkType
+	// Stores possible *url.URL type for context property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *contextIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *contextIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *contextIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// createdIntermediateType will only have one of its values set at most
+type createdIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *time.Time type for created property
+	dateTime *time.Time
+	// Stores possible *url.URL type for created property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *createdIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *createdIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *createdIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// cryptosuiteIntermediateType will only have one of its values set at most
+type cryptosuiteIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for cryptosuite property
+	stringName *string
+	// Stores possible *url.URL type for cryptosuite property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *cryptosuiteIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *cryptosuiteIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *cryptosuiteIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// durationIntermediateType will only have one of its values set at most
+type durationIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *time.Duration type for duration property
+	duration *time.Duration
+	// Stores possible *url.URL type for duration property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *durationIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.duration, err = durationDeserialize(i)
+			if err != nil {
+				t.duration = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *durationIntermediateType) Serialize() (i interface{}, err error) {
+	if t.duration != nil {
+		i = durationSerialize(*t.duration)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *durationIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// endTimeIntermediateType will only have one of its values set at most
+type endTimeIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *time.Time type for endTime property
+	dateTime *time.Time
+	// Stores possible *url.URL type for endTime property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *endTimeIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *endTimeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *endTimeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// endpointsIntermediateType will only have one of its values set at most
+type endpointsIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for endpoints property
+	Object ObjectType
+	// Stores possible *url.URL type for endpoints property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *endpointsIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *endpointsIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *endpointsIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// followersIntermediateType will only have one of its values set at most
+type followersIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible CollectionType type for followers property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for followers property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for followers property
+	anyURI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *followersIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *followersIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
+		return
+	}
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *followersIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// followingIntermediateType will only have one of its values set at most
+type followingIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible CollectionType type for following property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for following property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for following property
+	anyURI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *followingIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *followingIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
+		return
+	}
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *followingIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// generatorIntermediateType will only have one of its values set at most
+type generatorIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for generator property
+	Object ObjectType
+	// Stores possible LinkType type for generator property
+	Link LinkType
+	// Stores possible *url.URL type for generator property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *generatorIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *generatorIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *generatorIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// iconIntermediateType will only have one of its values set at most
+type iconIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ImageType type for icon property
+	Image ImageType
+	// Stores possible LinkType type for icon property
+	Link LinkType
+	// Stores possible *url.URL type for icon property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *iconIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Image, ok = resolveObject(kind).(ImageType); t.Image != nil && ok {
+						err = t.Image.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *iconIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Image != nil {
+		i, err = t.Image.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *iconIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Image != nil {
+		if err := t.Image.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// imageIntermediateType will only have one of its values set at most
+type imageIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ImageType type for image property
+	Image ImageType
+	// Stores possible LinkType type for image property
+	Link LinkType
+	// Stores possible *url.URL type for image property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *imageIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Image, ok = resolveObject(kind).(ImageType); t.Image != nil && ok {
+						err = t.Image.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *imageIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Image != nil {
+		i, err = t.Image.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *imageIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Image != nil {
+		if err := t.Image.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// inReplyToIntermediateType will only have one of its values set at most
+type inReplyToIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for inReplyTo property
+	Object ObjectType
+	// Stores possible LinkType type for inReplyTo property
+	Link LinkType
+	// Stores possible *url.URL type for inReplyTo property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *inReplyToIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *inReplyToIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *inReplyToIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// inboxIntermediateType will only have one of its values set at most
+type inboxIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible OrderedCollectionType type for inbox property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for inbox property
+	anyURI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *inboxIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *inboxIntermediateType) Serialize() (i interface{}, err error) {
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *inboxIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// likedIntermediateType will only have one of its values set at most
+type likedIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible CollectionType type for liked property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for liked property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for liked property
+	anyURI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *likedIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *likedIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
+		return
+	}
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *likedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// likesIntermediateType will only have one of its values set at most
+type likesIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible CollectionType type for likes property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for likes property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for likes property
+	anyURI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *likesIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *likesIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
+		return
+	}
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *likesIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// locationIntermediateType will only have one of its values set at most
+type locationIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for location property
+	Object ObjectType
+	// Stores possible LinkType type for location property
+	Link LinkType
+	// Stores possible *url.URL type for location property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *locationIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *locationIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *locationIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// mediaTypeIntermediateType will only have one of its values set at most
+type mediaTypeIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for mediaType property
+	mimeMediaTypeValue *string
+	// Stores possible *url.URL type for mediaType property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *mediaTypeIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.mimeMediaTypeValue, err = mimeMediaTypeValueDeserialize(i)
+			if err != nil {
+				t.mimeMediaTypeValue = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *mediaTypeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.mimeMediaTypeValue != nil {
+		i = mimeMediaTypeValueSerialize(*t.mimeMediaTypeValue)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *mediaTypeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// nameIntermediateType will only have one of its values set at most
+type nameIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for name property
+	stringName *string
+	// Stores possible *string type for name property
+	langString *string
+	// Stores possible *url.URL type for name property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *nameIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.langString, err = langStringDeserialize(i)
+			if err != nil {
+				t.langString = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *nameIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.langString != nil {
+		i = langStringSerialize(*t.langString)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *nameIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// outboxIntermediateType will only have one of its values set at most
+type outboxIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible OrderedCollectionType type for outbox property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for outbox property
+	anyURI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *outboxIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *outboxIntermediateType) Serialize() (i interface{}, err error) {
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *outboxIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// preferredUsernameIntermediateType will only have one of its values set at most
+type preferredUsernameIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for preferredUsername property
+	stringName *string
+	// Stores possible *url.URL type for preferredUsername property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *preferredUsernameIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *preferredUsernameIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *preferredUsernameIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// previewIntermediateType will only have one of its values set at most
+type previewIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for preview property
+	Object ObjectType
+	// Stores possible LinkType type for preview property
+	Link LinkType
+	// Stores possible *url.URL type for preview property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *previewIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *previewIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *previewIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// proofIntermediateType will only have one of its values set at most
+type proofIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible DataIntegrityProofType type for proof property
+	DataIntegrityProof DataIntegrityProofType
+	// Stores possible *url.URL type for proof property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *proofIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.DataIntegrityProof, ok = resolveObject(kind).(DataIntegrityProofType); t.DataIntegrityProof != nil && ok {
+						err = t.DataIntegrityProof.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *proofIntermediateType) Serialize() (i interface{}, err error) {
+	if t.DataIntegrityProof != nil {
+		i, err = t.DataIntegrityProof.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *proofIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.DataIntegrityProof != nil {
+		if err := t.DataIntegrityProof.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// proofPurposeIntermediateType will only have one of its values set at most
+type proofPurposeIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for proofPurpose property
+	stringName *string
+	// Stores possible *url.URL type for proofPurpose property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *proofPurposeIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *proofPurposeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *proofPurposeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// proofValueIntermediateType will only have one of its values set at most
+type proofValueIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for proofValue property
+	stringName *string
+	// Stores possible *url.URL type for proofValue property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *proofValueIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *proofValueIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *proofValueIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// publicKeyIntermediateType will only have one of its values set at most
+type publicKeyIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible KeyType type for publicKey property
+	Key KeyType
+	// Stores possible *url.URL type for publicKey property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *publicKeyIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Key, ok = resolveObject(kind).(KeyType); t.Key != nil && ok {
+						err = t.Key.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *publicKeyIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Key != nil {
+		i, err = t.Key.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *publicKeyIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Key != nil {
+		if err := t.Key.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// publicKeyPemIntermediateType will only have one of its values set at most
+type publicKeyPemIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for publicKeyPem property
+	stringName *string
+	// Stores possible *url.URL type for publicKeyPem property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *publicKeyPemIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *publicKeyPemIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *publicKeyPemIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// publishedIntermediateType will only have one of its values set at most
+type publishedIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *time.Time type for published property
+	dateTime *time.Time
+	// Stores possible *url.URL type for published property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *publishedIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *publishedIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *publishedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// repliesIntermediateType will only have one of its values set at most
+type repliesIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible CollectionType type for replies property
+	Collection CollectionType
+	// Stores possible *url.URL type for replies property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *repliesIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *repliesIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *repliesIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// sharesIntermediateType will only have one of its values set at most
+type sharesIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible CollectionType type for shares property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for shares property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for shares property
+	anyURI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *sharesIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *sharesIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
+		return
+	}
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *sharesIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// sourceIntermediateType will only have one of its values set at most
+type sourceIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for source property
+	Object ObjectType
+	// Stores possible *url.URL type for source property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *sourceIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *sourceIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *sourceIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// startTimeIntermediateType will only have one of its values set at most
+type startTimeIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *time.Time type for startTime property
+	dateTime *time.Time
+	// Stores possible *url.URL type for startTime property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *startTimeIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *startTimeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *startTimeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// summaryIntermediateType will only have one of its values set at most
+type summaryIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *string type for summary property
+	stringName *string
+	// Stores possible *string type for summary property
+	langString *string
+	// Stores possible *url.URL type for summary property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *summaryIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.langString, err = langStringDeserialize(i)
+			if err != nil {
+				t.langString = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *summaryIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
+		return
+	}
+	if t.langString != nil {
+		i = langStringSerialize(*t.langString)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *summaryIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// tagIntermediateType will only have one of its values set at most
+type tagIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for tag property
+	Object ObjectType
+	// Stores possible LinkType type for tag property
+	Link LinkType
+	// Stores possible *url.URL type for tag property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *tagIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *tagIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *tagIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// toIntermediateType will only have one of its values set at most
+type toIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for to property
+	Object ObjectType
+	// Stores possible LinkType type for to property
+	Link LinkType
+	// Stores possible *url.URL type for to property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *toIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *toIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *toIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// updatedIntermediateType will only have one of its values set at most
+type updatedIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *time.Time type for updated property
+	dateTime *time.Time
+	// Stores possible *url.URL type for updated property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *updatedIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *updatedIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *updatedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// urlIntermediateType will only have one of its values set at most
+type urlIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *url.URL type for url property
+	anyURI *url.URL
+	// Stores possible LinkType type for url property
+	Link LinkType
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *urlIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.anyURI, err = anyURIDeserialize(i)
+			if err != nil {
+				t.anyURI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *urlIntermediateType) Serialize() (i interface{}, err error) {
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *urlIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// deserializealtitudeIntermediateType will accept a map to create a altitudeIntermediateType
+func deserializeAltitudeIntermediateType(in interface{}) (t *altitudeIntermediateType, err error) {
+	tmp := &altitudeIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice altitudeIntermediateType will accept a slice to create a slice of altitudeIntermediateType
+func deserializeSliceAltitudeIntermediateType(in []interface{}) (t []*altitudeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]altitudeIntermediateType, len(in))
+	t = make([]*altitudeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesaltitudeIntermediateType will accept a single value or a slice of them to create a slice of altitudeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAltitudeIntermediateType(in interface{}) (t []*altitudeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAltitudeIntermediateType(s)
+	}
+	tmp, err := deserializeAltitudeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*altitudeIntermediateType{tmp}, nil
+
+}
+
+// serializealtitudeIntermediateType will accept a altitudeIntermediateType to create a map
+func serializeAltitudeIntermediateType(t *altitudeIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicealtitudeIntermediateType will accept a slice of altitudeIntermediateType to create a slice result
+func serializeSliceAltitudeIntermediateType(s []*altitudeIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeattachmentIntermediateType will accept a map to create a attachmentIntermediateType
+func deserializeAttachmentIntermediateType(in interface{}) (t *attachmentIntermediateType, err error) {
+	tmp := &attachmentIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice attachmentIntermediateType will accept a slice to create a slice of attachmentIntermediateType
+func deserializeSliceAttachmentIntermediateType(in []interface{}) (t []*attachmentIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]attachmentIntermediateType, len(in))
+	t = make([]*attachmentIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesattachmentIntermediateType will accept a single value or a slice of them to create a slice of attachmentIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAttachmentIntermediateType(in interface{}) (t []*attachmentIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAttachmentIntermediateType(s)
+	}
+	tmp, err := deserializeAttachmentIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*attachmentIntermediateType{tmp}, nil
+
+}
+
+// serializeattachmentIntermediateType will accept a attachmentIntermediateType to create a map
+func serializeAttachmentIntermediateType(t *attachmentIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceattachmentIntermediateType will accept a slice of attachmentIntermediateType to create a slice result
+func serializeSliceAttachmentIntermediateType(s []*attachmentIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeattributedToIntermediateType will accept a map to create a attributedToIntermediateType
+func deserializeAttributedToIntermediateType(in interface{}) (t *attributedToIntermediateType, err error) {
+	tmp := &attributedToIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice attributedToIntermediateType will accept a slice to create a slice of attributedToIntermediateType
+func deserializeSliceAttributedToIntermediateType(in []interface{}) (t []*attributedToIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]attributedToIntermediateType, len(in))
+	t = make([]*attributedToIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesattributedToIntermediateType will accept a single value or a slice of them to create a slice of attributedToIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAttributedToIntermediateType(in interface{}) (t []*attributedToIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAttributedToIntermediateType(s)
+	}
+	tmp, err := deserializeAttributedToIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*attributedToIntermediateType{tmp}, nil
+
+}
+
+// serializeattributedToIntermediateType will accept a attributedToIntermediateType to create a map
+func serializeAttributedToIntermediateType(t *attributedToIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceattributedToIntermediateType will accept a slice of attributedToIntermediateType to create a slice result
+func serializeSliceAttributedToIntermediateType(s []*attributedToIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeaudienceIntermediateType will accept a map to create a audienceIntermediateType
+func deserializeAudienceIntermediateType(in interface{}) (t *audienceIntermediateType, err error) {
+	tmp := &audienceIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice audienceIntermediateType will accept a slice to create a slice of audienceIntermediateType
+func deserializeSliceAudienceIntermediateType(in []interface{}) (t []*audienceIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]audienceIntermediateType, len(in))
+	t = make([]*audienceIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesaudienceIntermediateType will accept a single value or a slice of them to create a slice of audienceIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAudienceIntermediateType(in interface{}) (t []*audienceIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAudienceIntermediateType(s)
+	}
+	tmp, err := deserializeAudienceIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*audienceIntermediateType{tmp}, nil
+
+}
+
+// serializeaudienceIntermediateType will accept a audienceIntermediateType to create a map
+func serializeAudienceIntermediateType(t *audienceIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceaudienceIntermediateType will accept a slice of audienceIntermediateType to create a slice result
+func serializeSliceAudienceIntermediateType(s []*audienceIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializebccIntermediateType will accept a map to create a bccIntermediateType
+func deserializeBccIntermediateType(in interface{}) (t *bccIntermediateType, err error) {
+	tmp := &bccIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice bccIntermediateType will accept a slice to create a slice of bccIntermediateType
+func deserializeSliceBccIntermediateType(in []interface{}) (t []*bccIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]bccIntermediateType, len(in))
+	t = make([]*bccIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesbccIntermediateType will accept a single value or a slice of them to create a slice of bccIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesBccIntermediateType(in interface{}) (t []*bccIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceBccIntermediateType(s)
+	}
+	tmp, err := deserializeBccIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*bccIntermediateType{tmp}, nil
+
+}
+
+// serializebccIntermediateType will accept a bccIntermediateType to create a map
+func serializeBccIntermediateType(t *bccIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicebccIntermediateType will accept a slice of bccIntermediateType to create a slice result
+func serializeSliceBccIntermediateType(s []*bccIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializebtoIntermediateType will accept a map to create a btoIntermediateType
+func deserializeBtoIntermediateType(in interface{}) (t *btoIntermediateType, err error) {
+	tmp := &btoIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice btoIntermediateType will accept a slice to create a slice of btoIntermediateType
+func deserializeSliceBtoIntermediateType(in []interface{}) (t []*btoIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]btoIntermediateType, len(in))
+	t = make([]*btoIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesbtoIntermediateType will accept a single value or a slice of them to create a slice of btoIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesBtoIntermediateType(in interface{}) (t []*btoIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceBtoIntermediateType(s)
+	}
+	tmp, err := deserializeBtoIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*btoIntermediateType{tmp}, nil
+
+}
+
+// serializebtoIntermediateType will accept a btoIntermediateType to create a map
+func serializeBtoIntermediateType(t *btoIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicebtoIntermediateType will accept a slice of btoIntermediateType to create a slice result
+func serializeSliceBtoIntermediateType(s []*btoIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeccIntermediateType will accept a map to create a ccIntermediateType
+func deserializeCcIntermediateType(in interface{}) (t *ccIntermediateType, err error) {
+	tmp := &ccIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice ccIntermediateType will accept a slice to create a slice of ccIntermediateType
+func deserializeSliceCcIntermediateType(in []interface{}) (t []*ccIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]ccIntermediateType, len(in))
+	t = make([]*ccIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesccIntermediateType will accept a single value or a slice of them to create a slice of ccIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesCcIntermediateType(in interface{}) (t []*ccIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceCcIntermediateType(s)
+	}
+	tmp, err := deserializeCcIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*ccIntermediateType{tmp}, nil
+
+}
+
+// serializeccIntermediateType will accept a ccIntermediateType to create a map
+func serializeCcIntermediateType(t *ccIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceccIntermediateType will accept a slice of ccIntermediateType to create a slice result
+func serializeSliceCcIntermediateType(s []*ccIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializecontentIntermediateType will accept a map to create a contentIntermediateType
+func deserializeContentIntermediateType(in interface{}) (t *contentIntermediateType, err error) {
+	tmp := &contentIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice contentIntermediateType will accept a slice to create a slice of contentIntermediateType
+func deserializeSliceContentIntermediateType(in []interface{}) (t []*contentIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]contentIntermediateType, len(in))
+	t = make([]*contentIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuescontentIntermediateType will accept a single value or a slice of them to create a slice of contentIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesContentIntermediateType(in interface{}) (t []*contentIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceContentIntermediateType(s)
+	}
+	tmp, err := deserializeContentIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*contentIntermediateType{tmp}, nil
+
+}
+
+// serializecontentIntermediateType will accept a contentIntermediateType to create a map
+func serializeContentIntermediateType(t *contentIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicecontentIntermediateType will accept a slice of contentIntermediateType to create a slice result
+func serializeSliceContentIntermediateType(s []*contentIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializecontextIntermediateType will accept a map to create a contextIntermediateType
+func deserializeContextIntermediateType(in interface{}) (t *contextIntermediateType, err error) {
+	tmp := &contextIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice contextIntermediateType will accept a slice to create a slice of contextIntermediateType
+func deserializeSliceContextIntermediateType(in []interface{}) (t []*contextIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]contextIntermediateType, len(in))
+	t = make([]*contextIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuescontextIntermediateType will accept a single value or a slice of them to create a slice of contextIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesContextIntermediateType(in interface{}) (t []*contextIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceContextIntermediateType(s)
+	}
+	tmp, err := deserializeContextIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*contextIntermediateType{tmp}, nil
+
+}
+
+// serializecontextIntermediateType will accept a contextIntermediateType to create a map
+func serializeContextIntermediateType(t *contextIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicecontextIntermediateType will accept a slice of contextIntermediateType to create a slice result
+func serializeSliceContextIntermediateType(s []*contextIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializecreatedIntermediateType will accept a map to create a createdIntermediateType
+func deserializeCreatedIntermediateType(in interface{}) (t *createdIntermediateType, err error) {
+	tmp := &createdIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice createdIntermediateType will accept a slice to create a slice of createdIntermediateType
+func deserializeSliceCreatedIntermediateType(in []interface{}) (t []*createdIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]createdIntermediateType, len(in))
+	t = make([]*createdIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuescreatedIntermediateType will accept a single value or a slice of them to create a slice of createdIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesCreatedIntermediateType(in interface{}) (t []*createdIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceCreatedIntermediateType(s)
+	}
+	tmp, err := deserializeCreatedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*createdIntermediateType{tmp}, nil
+
+}
+
+// serializecreatedIntermediateType will accept a createdIntermediateType to create a map
+func serializeCreatedIntermediateType(t *createdIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicecreatedIntermediateType will accept a slice of createdIntermediateType to create a slice result
+func serializeSliceCreatedIntermediateType(s []*createdIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializecryptosuiteIntermediateType will accept a map to create a cryptosuiteIntermediateType
+func deserializeCryptosuiteIntermediateType(in interface{}) (t *cryptosuiteIntermediateType, err error) {
+	tmp := &cryptosuiteIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice cryptosuiteIntermediateType will accept a slice to create a slice of cryptosuiteIntermediateType
+func deserializeSliceCryptosuiteIntermediateType(in []interface{}) (t []*cryptosuiteIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]cryptosuiteIntermediateType, len(in))
+	t = make([]*cryptosuiteIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuescryptosuiteIntermediateType will accept a single value or a slice of them to create a slice of cryptosuiteIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesCryptosuiteIntermediateType(in interface{}) (t []*cryptosuiteIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceCryptosuiteIntermediateType(s)
+	}
+	tmp, err := deserializeCryptosuiteIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*cryptosuiteIntermediateType{tmp}, nil
+
+}
+
+// serializecryptosuiteIntermediateType will accept a cryptosuiteIntermediateType to create a map
+func serializeCryptosuiteIntermediateType(t *cryptosuiteIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicecryptosuiteIntermediateType will accept a slice of cryptosuiteIntermediateType to create a slice result
+func serializeSliceCryptosuiteIntermediateType(s []*cryptosuiteIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializedurationIntermediateType will accept a map to create a durationIntermediateType
+func deserializeDurationIntermediateType(in interface{}) (t *durationIntermediateType, err error) {
+	tmp := &durationIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice durationIntermediateType will accept a slice to create a slice of durationIntermediateType
+func deserializeSliceDurationIntermediateType(in []interface{}) (t []*durationIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]durationIntermediateType, len(in))
+	t = make([]*durationIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesdurationIntermediateType will accept a single value or a slice of them to create a slice of durationIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesDurationIntermediateType(in interface{}) (t []*durationIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceDurationIntermediateType(s)
+	}
+	tmp, err := deserializeDurationIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*durationIntermediateType{tmp}, nil
+
+}
+
+// serializedurationIntermediateType will accept a durationIntermediateType to create a map
+func serializeDurationIntermediateType(t *durationIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicedurationIntermediateType will accept a slice of durationIntermediateType to create a slice result
+func serializeSliceDurationIntermediateType(s []*durationIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeendTimeIntermediateType will accept a map to create a endTimeIntermediateType
+func deserializeEndTimeIntermediateType(in interface{}) (t *endTimeIntermediateType, err error) {
+	tmp := &endTimeIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice endTimeIntermediateType will accept a slice to create a slice of endTimeIntermediateType
+func deserializeSliceEndTimeIntermediateType(in []interface{}) (t []*endTimeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]endTimeIntermediateType, len(in))
+	t = make([]*endTimeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesendTimeIntermediateType will accept a single value or a slice of them to create a slice of endTimeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesEndTimeIntermediateType(in interface{}) (t []*endTimeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceEndTimeIntermediateType(s)
+	}
+	tmp, err := deserializeEndTimeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*endTimeIntermediateType{tmp}, nil
+
+}
+
+// serializeendTimeIntermediateType will accept a endTimeIntermediateType to create a map
+func serializeEndTimeIntermediateType(t *endTimeIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceendTimeIntermediateType will accept a slice of endTimeIntermediateType to create a slice result
+func serializeSliceEndTimeIntermediateType(s []*endTimeIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeendpointsIntermediateType will accept a map to create a endpointsIntermediateType
+func deserializeEndpointsIntermediateType(in interface{}) (t *endpointsIntermediateType, err error) {
+	tmp := &endpointsIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice endpointsIntermediateType will accept a slice to create a slice of endpointsIntermediateType
+func deserializeSliceEndpointsIntermediateType(in []interface{}) (t []*endpointsIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]endpointsIntermediateType, len(in))
+	t = make([]*endpointsIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesendpointsIntermediateType will accept a single value or a slice of them to create a slice of endpointsIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesEndpointsIntermediateType(in interface{}) (t []*endpointsIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceEndpointsIntermediateType(s)
+	}
+	tmp, err := deserializeEndpointsIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*endpointsIntermediateType{tmp}, nil
+
+}
+
+// serializeendpointsIntermediateType will accept a endpointsIntermediateType to create a map
+func serializeEndpointsIntermediateType(t *endpointsIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceendpointsIntermediateType will accept a slice of endpointsIntermediateType to create a slice result
+func serializeSliceEndpointsIntermediateType(s []*endpointsIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializefollowersIntermediateType will accept a map to create a followersIntermediateType
+func deserializeFollowersIntermediateType(in interface{}) (t *followersIntermediateType, err error) {
+	tmp := &followersIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice followersIntermediateType will accept a slice to create a slice of followersIntermediateType
+func deserializeSliceFollowersIntermediateType(in []interface{}) (t []*followersIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]followersIntermediateType, len(in))
+	t = make([]*followersIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesfollowersIntermediateType will accept a single value or a slice of them to create a slice of followersIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesFollowersIntermediateType(in interface{}) (t []*followersIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceFollowersIntermediateType(s)
+	}
+	tmp, err := deserializeFollowersIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*followersIntermediateType{tmp}, nil
+
+}
+
+// serializefollowersIntermediateType will accept a followersIntermediateType to create a map
+func serializeFollowersIntermediateType(t *followersIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicefollowersIntermediateType will accept a slice of followersIntermediateType to create a slice result
+func serializeSliceFollowersIntermediateType(s []*followersIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializefollowingIntermediateType will accept a map to create a followingIntermediateType
+func deserializeFollowingIntermediateType(in interface{}) (t *followingIntermediateType, err error) {
+	tmp := &followingIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice followingIntermediateType will accept a slice to create a slice of followingIntermediateType
+func deserializeSliceFollowingIntermediateType(in []interface{}) (t []*followingIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]followingIntermediateType, len(in))
+	t = make([]*followingIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesfollowingIntermediateType will accept a single value or a slice of them to create a slice of followingIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesFollowingIntermediateType(in interface{}) (t []*followingIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceFollowingIntermediateType(s)
+	}
+	tmp, err := deserializeFollowingIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*followingIntermediateType{tmp}, nil
+
+}
+
+// serializefollowingIntermediateType will accept a followingIntermediateType to create a map
+func serializeFollowingIntermediateType(t *followingIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicefollowingIntermediateType will accept a slice of followingIntermediateType to create a slice result
+func serializeSliceFollowingIntermediateType(s []*followingIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializegeneratorIntermediateType will accept a map to create a generatorIntermediateType
+func deserializeGeneratorIntermediateType(in interface{}) (t *generatorIntermediateType, err error) {
+	tmp := &generatorIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice generatorIntermediateType will accept a slice to create a slice of generatorIntermediateType
+func deserializeSliceGeneratorIntermediateType(in []interface{}) (t []*generatorIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]generatorIntermediateType, len(in))
+	t = make([]*generatorIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesgeneratorIntermediateType will accept a single value or a slice of them to create a slice of generatorIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesGeneratorIntermediateType(in interface{}) (t []*generatorIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceGeneratorIntermediateType(s)
+	}
+	tmp, err := deserializeGeneratorIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*generatorIntermediateType{tmp}, nil
+
+}
+
+// serializegeneratorIntermediateType will accept a generatorIntermediateType to create a map
+func serializeGeneratorIntermediateType(t *generatorIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicegeneratorIntermediateType will accept a slice of generatorIntermediateType to create a slice result
+func serializeSliceGeneratorIntermediateType(s []*generatorIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeiconIntermediateType will accept a map to create a iconIntermediateType
+func deserializeIconIntermediateType(in interface{}) (t *iconIntermediateType, err error) {
+	tmp := &iconIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice iconIntermediateType will accept a slice to create a slice of iconIntermediateType
+func deserializeSliceIconIntermediateType(in []interface{}) (t []*iconIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]iconIntermediateType, len(in))
+	t = make([]*iconIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesiconIntermediateType will accept a single value or a slice of them to create a slice of iconIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesIconIntermediateType(in interface{}) (t []*iconIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceIconIntermediateType(s)
+	}
+	tmp, err := deserializeIconIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*iconIntermediateType{tmp}, nil
+
+}
+
+// serializeiconIntermediateType will accept a iconIntermediateType to create a map
+func serializeIconIntermediateType(t *iconIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceiconIntermediateType will accept a slice of iconIntermediateType to create a slice result
+func serializeSliceIconIntermediateType(s []*iconIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeimageIntermediateType will accept a map to create a imageIntermediateType
+func deserializeImageIntermediateType(in interface{}) (t *imageIntermediateType, err error) {
+	tmp := &imageIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice imageIntermediateType will accept a slice to create a slice of imageIntermediateType
+func deserializeSliceImageIntermediateType(in []interface{}) (t []*imageIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]imageIntermediateType, len(in))
+	t = make([]*imageIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesimageIntermediateType will accept a single value or a slice of them to create a slice of imageIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesImageIntermediateType(in interface{}) (t []*imageIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceImageIntermediateType(s)
+	}
+	tmp, err := deserializeImageIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*imageIntermediateType{tmp}, nil
+
+}
+
+// serializeimageIntermediateType will accept a imageIntermediateType to create a map
+func serializeImageIntermediateType(t *imageIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceimageIntermediateType will accept a slice of imageIntermediateType to create a slice result
+func serializeSliceImageIntermediateType(s []*imageIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeinReplyToIntermediateType will accept a map to create a inReplyToIntermediateType
+func deserializeInReplyToIntermediateType(in interface{}) (t *inReplyToIntermediateType, err error) {
+	tmp := &inReplyToIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice inReplyToIntermediateType will accept a slice to create a slice of inReplyToIntermediateType
+func deserializeSliceInReplyToIntermediateType(in []interface{}) (t []*inReplyToIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]inReplyToIntermediateType, len(in))
+	t = make([]*inReplyToIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesinReplyToIntermediateType will accept a single value or a slice of them to create a slice of inReplyToIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesInReplyToIntermediateType(in interface{}) (t []*inReplyToIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceInReplyToIntermediateType(s)
+	}
+	tmp, err := deserializeInReplyToIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*inReplyToIntermediateType{tmp}, nil
+
+}
+
+// serializeinReplyToIntermediateType will accept a inReplyToIntermediateType to create a map
+func serializeInReplyToIntermediateType(t *inReplyToIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceinReplyToIntermediateType will accept a slice of inReplyToIntermediateType to create a slice result
+func serializeSliceInReplyToIntermediateType(s []*inReplyToIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeinboxIntermediateType will accept a map to create a inboxIntermediateType
+func deserializeInboxIntermediateType(in interface{}) (t *inboxIntermediateType, err error) {
+	tmp := &inboxIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice inboxIntermediateType will accept a slice to create a slice of inboxIntermediateType
+func deserializeSliceInboxIntermediateType(in []interface{}) (t []*inboxIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]inboxIntermediateType, len(in))
+	t = make([]*inboxIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesinboxIntermediateType will accept a single value or a slice of them to create a slice of inboxIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesInboxIntermediateType(in interface{}) (t []*inboxIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceInboxIntermediateType(s)
+	}
+	tmp, err := deserializeInboxIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*inboxIntermediateType{tmp}, nil
+
+}
+
+// serializeinboxIntermediateType will accept a inboxIntermediateType to create a map
+func serializeInboxIntermediateType(t *inboxIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceinboxIntermediateType will accept a slice of inboxIntermediateType to create a slice result
+func serializeSliceInboxIntermediateType(s []*inboxIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializelikedIntermediateType will accept a map to create a likedIntermediateType
+func deserializeLikedIntermediateType(in interface{}) (t *likedIntermediateType, err error) {
+	tmp := &likedIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice likedIntermediateType will accept a slice to create a slice of likedIntermediateType
+func deserializeSliceLikedIntermediateType(in []interface{}) (t []*likedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]likedIntermediateType, len(in))
+	t = make([]*likedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValueslikedIntermediateType will accept a single value or a slice of them to create a slice of likedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLikedIntermediateType(in interface{}) (t []*likedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLikedIntermediateType(s)
+	}
+	tmp, err := deserializeLikedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*likedIntermediateType{tmp}, nil
+
+}
+
+// serializelikedIntermediateType will accept a likedIntermediateType to create a map
+func serializeLikedIntermediateType(t *likedIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicelikedIntermediateType will accept a slice of likedIntermediateType to create a slice result
+func serializeSliceLikedIntermediateType(s []*likedIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializelikesIntermediateType will accept a map to create a likesIntermediateType
+func deserializeLikesIntermediateType(in interface{}) (t *likesIntermediateType, err error) {
+	tmp := &likesIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice likesIntermediateType will accept a slice to create a slice of likesIntermediateType
+func deserializeSliceLikesIntermediateType(in []interface{}) (t []*likesIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]likesIntermediateType, len(in))
+	t = make([]*likesIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValueslikesIntermediateType will accept a single value or a slice of them to create a slice of likesIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLikesIntermediateType(in interface{}) (t []*likesIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLikesIntermediateType(s)
+	}
+	tmp, err := deserializeLikesIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*likesIntermediateType{tmp}, nil
+
+}
+
+// serializelikesIntermediateType will accept a likesIntermediateType to create a map
+func serializeLikesIntermediateType(t *likesIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicelikesIntermediateType will accept a slice of likesIntermediateType to create a slice result
+func serializeSliceLikesIntermediateType(s []*likesIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializelocationIntermediateType will accept a map to create a locationIntermediateType
+func deserializeLocationIntermediateType(in interface{}) (t *locationIntermediateType, err error) {
+	tmp := &locationIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice locationIntermediateType will accept a slice to create a slice of locationIntermediateType
+func deserializeSliceLocationIntermediateType(in []interface{}) (t []*locationIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]locationIntermediateType, len(in))
+	t = make([]*locationIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValueslocationIntermediateType will accept a single value or a slice of them to create a slice of locationIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLocationIntermediateType(in interface{}) (t []*locationIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLocationIntermediateType(s)
+	}
+	tmp, err := deserializeLocationIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*locationIntermediateType{tmp}, nil
+
+}
+
+// serializelocationIntermediateType will accept a locationIntermediateType to create a map
+func serializeLocationIntermediateType(t *locationIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicelocationIntermediateType will accept a slice of locationIntermediateType to create a slice result
+func serializeSliceLocationIntermediateType(s []*locationIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializemediaTypeIntermediateType will accept a map to create a mediaTypeIntermediateType
+func deserializeMediaTypeIntermediateType(in interface{}) (t *mediaTypeIntermediateType, err error) {
+	tmp := &mediaTypeIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice mediaTypeIntermediateType will accept a slice to create a slice of mediaTypeIntermediateType
+func deserializeSliceMediaTypeIntermediateType(in []interface{}) (t []*mediaTypeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]mediaTypeIntermediateType, len(in))
+	t = make([]*mediaTypeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesmediaTypeIntermediateType will accept a single value or a slice of them to create a slice of mediaTypeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesMediaTypeIntermediateType(in interface{}) (t []*mediaTypeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceMediaTypeIntermediateType(s)
+	}
+	tmp, err := deserializeMediaTypeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*mediaTypeIntermediateType{tmp}, nil
+
+}
+
+// serializemediaTypeIntermediateType will accept a mediaTypeIntermediateType to create a map
+func serializeMediaTypeIntermediateType(t *mediaTypeIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicemediaTypeIntermediateType will accept a slice of mediaTypeIntermediateType to create a slice result
+func serializeSliceMediaTypeIntermediateType(s []*mediaTypeIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializenameIntermediateType will accept a map to create a nameIntermediateType
+func deserializeNameIntermediateType(in interface{}) (t *nameIntermediateType, err error) {
+	tmp := &nameIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice nameIntermediateType will accept a slice to create a slice of nameIntermediateType
+func deserializeSliceNameIntermediateType(in []interface{}) (t []*nameIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]nameIntermediateType, len(in))
+	t = make([]*nameIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesnameIntermediateType will accept a single value or a slice of them to create a slice of nameIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesNameIntermediateType(in interface{}) (t []*nameIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceNameIntermediateType(s)
+	}
+	tmp, err := deserializeNameIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*nameIntermediateType{tmp}, nil
+
+}
+
+// serializenameIntermediateType will accept a nameIntermediateType to create a map
+func serializeNameIntermediateType(t *nameIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicenameIntermediateType will accept a slice of nameIntermediateType to create a slice result
+func serializeSliceNameIntermediateType(s []*nameIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeoutboxIntermediateType will accept a map to create a outboxIntermediateType
+func deserializeOutboxIntermediateType(in interface{}) (t *outboxIntermediateType, err error) {
+	tmp := &outboxIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice outboxIntermediateType will accept a slice to create a slice of outboxIntermediateType
+func deserializeSliceOutboxIntermediateType(in []interface{}) (t []*outboxIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]outboxIntermediateType, len(in))
+	t = make([]*outboxIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesoutboxIntermediateType will accept a single value or a slice of them to create a slice of outboxIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesOutboxIntermediateType(in interface{}) (t []*outboxIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceOutboxIntermediateType(s)
+	}
+	tmp, err := deserializeOutboxIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*outboxIntermediateType{tmp}, nil
+
+}
+
+// serializeoutboxIntermediateType will accept a outboxIntermediateType to create a map
+func serializeOutboxIntermediateType(t *outboxIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceoutboxIntermediateType will accept a slice of outboxIntermediateType to create a slice result
+func serializeSliceOutboxIntermediateType(s []*outboxIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializepreferredUsernameIntermediateType will accept a map to create a preferredUsernameIntermediateType
+func deserializePreferredUsernameIntermediateType(in interface{}) (t *preferredUsernameIntermediateType, err error) {
+	tmp := &preferredUsernameIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice preferredUsernameIntermediateType will accept a slice to create a slice of preferredUsernameIntermediateType
+func deserializeSlicePreferredUsernameIntermediateType(in []interface{}) (t []*preferredUsernameIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]preferredUsernameIntermediateType, len(in))
+	t = make([]*preferredUsernameIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuespreferredUsernameIntermediateType will accept a single value or a slice of them to create a slice of preferredUsernameIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPreferredUsernameIntermediateType(in interface{}) (t []*preferredUsernameIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePreferredUsernameIntermediateType(s)
+	}
+	tmp, err := deserializePreferredUsernameIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*preferredUsernameIntermediateType{tmp}, nil
+
+}
+
+// serializepreferredUsernameIntermediateType will accept a preferredUsernameIntermediateType to create a map
+func serializePreferredUsernameIntermediateType(t *preferredUsernameIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicepreferredUsernameIntermediateType will accept a slice of preferredUsernameIntermediateType to create a slice result
+func serializeSlicePreferredUsernameIntermediateType(s []*preferredUsernameIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializepreviewIntermediateType will accept a map to create a previewIntermediateType
+func deserializePreviewIntermediateType(in interface{}) (t *previewIntermediateType, err error) {
+	tmp := &previewIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice previewIntermediateType will accept a slice to create a slice of previewIntermediateType
+func deserializeSlicePreviewIntermediateType(in []interface{}) (t []*previewIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]previewIntermediateType, len(in))
+	t = make([]*previewIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuespreviewIntermediateType will accept a single value or a slice of them to create a slice of previewIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPreviewIntermediateType(in interface{}) (t []*previewIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePreviewIntermediateType(s)
+	}
+	tmp, err := deserializePreviewIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*previewIntermediateType{tmp}, nil
+
+}
+
+// serializepreviewIntermediateType will accept a previewIntermediateType to create a map
+func serializePreviewIntermediateType(t *previewIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicepreviewIntermediateType will accept a slice of previewIntermediateType to create a slice result
+func serializeSlicePreviewIntermediateType(s []*previewIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeproofIntermediateType will accept a map to create a proofIntermediateType
+func deserializeProofIntermediateType(in interface{}) (t *proofIntermediateType, err error) {
+	tmp := &proofIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice proofIntermediateType will accept a slice to create a slice of proofIntermediateType
+func deserializeSliceProofIntermediateType(in []interface{}) (t []*proofIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]proofIntermediateType, len(in))
+	t = make([]*proofIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesproofIntermediateType will accept a single value or a slice of them to create a slice of proofIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesProofIntermediateType(in interface{}) (t []*proofIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceProofIntermediateType(s)
+	}
+	tmp, err := deserializeProofIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*proofIntermediateType{tmp}, nil
+
+}
+
+// serializeproofIntermediateType will accept a proofIntermediateType to create a map
+func serializeProofIntermediateType(t *proofIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceproofIntermediateType will accept a slice of proofIntermediateType to create a slice result
+func serializeSliceProofIntermediateType(s []*proofIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeproofPurposeIntermediateType will accept a map to create a proofPurposeIntermediateType
+func deserializeProofPurposeIntermediateType(in interface{}) (t *proofPurposeIntermediateType, err error) {
+	tmp := &proofPurposeIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice proofPurposeIntermediateType will accept a slice to create a slice of proofPurposeIntermediateType
+func deserializeSliceProofPurposeIntermediateType(in []interface{}) (t []*proofPurposeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]proofPurposeIntermediateType, len(in))
+	t = make([]*proofPurposeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesproofPurposeIntermediateType will accept a single value or a slice of them to create a slice of proofPurposeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesProofPurposeIntermediateType(in interface{}) (t []*proofPurposeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceProofPurposeIntermediateType(s)
+	}
+	tmp, err := deserializeProofPurposeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*proofPurposeIntermediateType{tmp}, nil
+
+}
+
+// serializeproofPurposeIntermediateType will accept a proofPurposeIntermediateType to create a map
+func serializeProofPurposeIntermediateType(t *proofPurposeIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceproofPurposeIntermediateType will accept a slice of proofPurposeIntermediateType to create a slice result
+func serializeSliceProofPurposeIntermediateType(s []*proofPurposeIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeproofValueIntermediateType will accept a map to create a proofValueIntermediateType
+func deserializeProofValueIntermediateType(in interface{}) (t *proofValueIntermediateType, err error) {
+	tmp := &proofValueIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice proofValueIntermediateType will accept a slice to create a slice of proofValueIntermediateType
+func deserializeSliceProofValueIntermediateType(in []interface{}) (t []*proofValueIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]proofValueIntermediateType, len(in))
+	t = make([]*proofValueIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesproofValueIntermediateType will accept a single value or a slice of them to create a slice of proofValueIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesProofValueIntermediateType(in interface{}) (t []*proofValueIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceProofValueIntermediateType(s)
+	}
+	tmp, err := deserializeProofValueIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*proofValueIntermediateType{tmp}, nil
+
+}
+
+// serializeproofValueIntermediateType will accept a proofValueIntermediateType to create a map
+func serializeProofValueIntermediateType(t *proofValueIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceproofValueIntermediateType will accept a slice of proofValueIntermediateType to create a slice result
+func serializeSliceProofValueIntermediateType(s []*proofValueIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializepublicKeyIntermediateType will accept a map to create a publicKeyIntermediateType
+func deserializePublicKeyIntermediateType(in interface{}) (t *publicKeyIntermediateType, err error) {
+	tmp := &publicKeyIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice publicKeyIntermediateType will accept a slice to create a slice of publicKeyIntermediateType
+func deserializeSlicePublicKeyIntermediateType(in []interface{}) (t []*publicKeyIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]publicKeyIntermediateType, len(in))
+	t = make([]*publicKeyIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuespublicKeyIntermediateType will accept a single value or a slice of them to create a slice of publicKeyIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPublicKeyIntermediateType(in interface{}) (t []*publicKeyIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePublicKeyIntermediateType(s)
+	}
+	tmp, err := deserializePublicKeyIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*publicKeyIntermediateType{tmp}, nil
+
+}
+
+// serializepublicKeyIntermediateType will accept a publicKeyIntermediateType to create a map
+func serializePublicKeyIntermediateType(t *publicKeyIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicepublicKeyIntermediateType will accept a slice of publicKeyIntermediateType to create a slice result
+func serializeSlicePublicKeyIntermediateType(s []*publicKeyIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializepublicKeyPemIntermediateType will accept a map to create a publicKeyPemIntermediateType
+func deserializePublicKeyPemIntermediateType(in interface{}) (t *publicKeyPemIntermediateType, err error) {
+	tmp := &publicKeyPemIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice publicKeyPemIntermediateType will accept a slice to create a slice of publicKeyPemIntermediateType
+func deserializeSlicePublicKeyPemIntermediateType(in []interface{}) (t []*publicKeyPemIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]publicKeyPemIntermediateType, len(in))
+	t = make([]*publicKeyPemIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuespublicKeyPemIntermediateType will accept a single value or a slice of them to create a slice of publicKeyPemIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPublicKeyPemIntermediateType(in interface{}) (t []*publicKeyPemIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePublicKeyPemIntermediateType(s)
+	}
+	tmp, err := deserializePublicKeyPemIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*publicKeyPemIntermediateType{tmp}, nil
+
+}
+
+// serializepublicKeyPemIntermediateType will accept a publicKeyPemIntermediateType to create a map
+func serializePublicKeyPemIntermediateType(t *publicKeyPemIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicepublicKeyPemIntermediateType will accept a slice of publicKeyPemIntermediateType to create a slice result
+func serializeSlicePublicKeyPemIntermediateType(s []*publicKeyPemIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializepublishedIntermediateType will accept a map to create a publishedIntermediateType
+func deserializePublishedIntermediateType(in interface{}) (t *publishedIntermediateType, err error) {
+	tmp := &publishedIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice publishedIntermediateType will accept a slice to create a slice of publishedIntermediateType
+func deserializeSlicePublishedIntermediateType(in []interface{}) (t []*publishedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]publishedIntermediateType, len(in))
+	t = make([]*publishedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuespublishedIntermediateType will accept a single value or a slice of them to create a slice of publishedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPublishedIntermediateType(in interface{}) (t []*publishedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePublishedIntermediateType(s)
+	}
+	tmp, err := deserializePublishedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*publishedIntermediateType{tmp}, nil
+
+}
+
+// serializepublishedIntermediateType will accept a publishedIntermediateType to create a map
+func serializePublishedIntermediateType(t *publishedIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicepublishedIntermediateType will accept a slice of publishedIntermediateType to create a slice result
+func serializeSlicePublishedIntermediateType(s []*publishedIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializerepliesIntermediateType will accept a map to create a repliesIntermediateType
+func deserializeRepliesIntermediateType(in interface{}) (t *repliesIntermediateType, err error) {
+	tmp := &repliesIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice repliesIntermediateType will accept a slice to create a slice of repliesIntermediateType
+func deserializeSliceRepliesIntermediateType(in []interface{}) (t []*repliesIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]repliesIntermediateType, len(in))
+	t = make([]*repliesIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesrepliesIntermediateType will accept a single value or a slice of them to create a slice of repliesIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesRepliesIntermediateType(in interface{}) (t []*repliesIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceRepliesIntermediateType(s)
+	}
+	tmp, err := deserializeRepliesIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*repliesIntermediateType{tmp}, nil
+
+}
+
+// serializerepliesIntermediateType will accept a repliesIntermediateType to create a map
+func serializeRepliesIntermediateType(t *repliesIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicerepliesIntermediateType will accept a slice of repliesIntermediateType to create a slice result
+func serializeSliceRepliesIntermediateType(s []*repliesIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializesharesIntermediateType will accept a map to create a sharesIntermediateType
+func deserializeSharesIntermediateType(in interface{}) (t *sharesIntermediateType, err error) {
+	tmp := &sharesIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice sharesIntermediateType will accept a slice to create a slice of sharesIntermediateType
+func deserializeSliceSharesIntermediateType(in []interface{}) (t []*sharesIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]sharesIntermediateType, len(in))
+	t = make([]*sharesIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuessharesIntermediateType will accept a single value or a slice of them to create a slice of sharesIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesSharesIntermediateType(in interface{}) (t []*sharesIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceSharesIntermediateType(s)
+	}
+	tmp, err := deserializeSharesIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*sharesIntermediateType{tmp}, nil
+
+}
+
+// serializesharesIntermediateType will accept a sharesIntermediateType to create a map
+func serializeSharesIntermediateType(t *sharesIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicesharesIntermediateType will accept a slice of sharesIntermediateType to create a slice result
+func serializeSliceSharesIntermediateType(s []*sharesIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializesourceIntermediateType will accept a map to create a sourceIntermediateType
+func deserializeSourceIntermediateType(in interface{}) (t *sourceIntermediateType, err error) {
+	tmp := &sourceIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice sourceIntermediateType will accept a slice to create a slice of sourceIntermediateType
+func deserializeSliceSourceIntermediateType(in []interface{}) (t []*sourceIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]sourceIntermediateType, len(in))
+	t = make([]*sourceIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuessourceIntermediateType will accept a single value or a slice of them to create a slice of sourceIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesSourceIntermediateType(in interface{}) (t []*sourceIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceSourceIntermediateType(s)
+	}
+	tmp, err := deserializeSourceIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*sourceIntermediateType{tmp}, nil
+
+}
+
+// serializesourceIntermediateType will accept a sourceIntermediateType to create a map
+func serializeSourceIntermediateType(t *sourceIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicesourceIntermediateType will accept a slice of sourceIntermediateType to create a slice result
+func serializeSliceSourceIntermediateType(s []*sourceIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializestartTimeIntermediateType will accept a map to create a startTimeIntermediateType
+func deserializeStartTimeIntermediateType(in interface{}) (t *startTimeIntermediateType, err error) {
+	tmp := &startTimeIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice startTimeIntermediateType will accept a slice to create a slice of startTimeIntermediateType
+func deserializeSliceStartTimeIntermediateType(in []interface{}) (t []*startTimeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]startTimeIntermediateType, len(in))
+	t = make([]*startTimeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesstartTimeIntermediateType will accept a single value or a slice of them to create a slice of startTimeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesStartTimeIntermediateType(in interface{}) (t []*startTimeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceStartTimeIntermediateType(s)
+	}
+	tmp, err := deserializeStartTimeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*startTimeIntermediateType{tmp}, nil
+
+}
+
+// serializestartTimeIntermediateType will accept a startTimeIntermediateType to create a map
+func serializeStartTimeIntermediateType(t *startTimeIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicestartTimeIntermediateType will accept a slice of startTimeIntermediateType to create a slice result
+func serializeSliceStartTimeIntermediateType(s []*startTimeIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializesummaryIntermediateType will accept a map to create a summaryIntermediateType
+func deserializeSummaryIntermediateType(in interface{}) (t *summaryIntermediateType, err error) {
+	tmp := &summaryIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice summaryIntermediateType will accept a slice to create a slice of summaryIntermediateType
+func deserializeSliceSummaryIntermediateType(in []interface{}) (t []*summaryIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]summaryIntermediateType, len(in))
+	t = make([]*summaryIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuessummaryIntermediateType will accept a single value or a slice of them to create a slice of summaryIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesSummaryIntermediateType(in interface{}) (t []*summaryIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceSummaryIntermediateType(s)
+	}
+	tmp, err := deserializeSummaryIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*summaryIntermediateType{tmp}, nil
+
+}
+
+// serializesummaryIntermediateType will accept a summaryIntermediateType to create a map
+func serializeSummaryIntermediateType(t *summaryIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicesummaryIntermediateType will accept a slice of summaryIntermediateType to create a slice result
+func serializeSliceSummaryIntermediateType(s []*summaryIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializetagIntermediateType will accept a map to create a tagIntermediateType
+func deserializeTagIntermediateType(in interface{}) (t *tagIntermediateType, err error) {
+	tmp := &tagIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice tagIntermediateType will accept a slice to create a slice of tagIntermediateType
+func deserializeSliceTagIntermediateType(in []interface{}) (t []*tagIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]tagIntermediateType, len(in))
+	t = make([]*tagIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuestagIntermediateType will accept a single value or a slice of them to create a slice of tagIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesTagIntermediateType(in interface{}) (t []*tagIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceTagIntermediateType(s)
+	}
+	tmp, err := deserializeTagIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*tagIntermediateType{tmp}, nil
+
+}
+
+// serializetagIntermediateType will accept a tagIntermediateType to create a map
+func serializeTagIntermediateType(t *tagIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicetagIntermediateType will accept a slice of tagIntermediateType to create a slice result
+func serializeSliceTagIntermediateType(s []*tagIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializetoIntermediateType will accept a map to create a toIntermediateType
+func deserializeToIntermediateType(in interface{}) (t *toIntermediateType, err error) {
+	tmp := &toIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice toIntermediateType will accept a slice to create a slice of toIntermediateType
+func deserializeSliceToIntermediateType(in []interface{}) (t []*toIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]toIntermediateType, len(in))
+	t = make([]*toIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuestoIntermediateType will accept a single value or a slice of them to create a slice of toIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesToIntermediateType(in interface{}) (t []*toIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceToIntermediateType(s)
+	}
+	tmp, err := deserializeToIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*toIntermediateType{tmp}, nil
+
+}
+
+// serializetoIntermediateType will accept a toIntermediateType to create a map
+func serializeToIntermediateType(t *toIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSlicetoIntermediateType will accept a slice of toIntermediateType to create a slice result
+func serializeSliceToIntermediateType(s []*toIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeupdatedIntermediateType will accept a map to create a updatedIntermediateType
+func deserializeUpdatedIntermediateType(in interface{}) (t *updatedIntermediateType, err error) {
+	tmp := &updatedIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice updatedIntermediateType will accept a slice to create a slice of updatedIntermediateType
+func deserializeSliceUpdatedIntermediateType(in []interface{}) (t []*updatedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]updatedIntermediateType, len(in))
+	t = make([]*updatedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesupdatedIntermediateType will accept a single value or a slice of them to create a slice of updatedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesUpdatedIntermediateType(in interface{}) (t []*updatedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceUpdatedIntermediateType(s)
+	}
+	tmp, err := deserializeUpdatedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*updatedIntermediateType{tmp}, nil
+
+}
+
+// serializeupdatedIntermediateType will accept a updatedIntermediateType to create a map
+func serializeUpdatedIntermediateType(t *updatedIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceupdatedIntermediateType will accept a slice of updatedIntermediateType to create a slice result
+func serializeSliceUpdatedIntermediateType(s []*updatedIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
+
+// deserializeurlIntermediateType will accept a map to create a urlIntermediateType
+func deserializeUrlIntermediateType(in interface{}) (t *urlIntermediateType, err error) {
+	tmp := &urlIntermediateType{}
+	err = tmp.Deserialize(in)
+	return tmp, err
+
+}
+
+// deserializeSlice urlIntermediateType will accept a slice to create a slice of urlIntermediateType
+func deserializeSliceUrlIntermediateType(in []interface{}) (t []*urlIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]urlIntermediateType, len(in))
+	t = make([]*urlIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
+		err = tmp.Deserialize(i)
+		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+			return
+		}
+		t = append(t, tmp)
+	}
+	return
+
+}
+
+// deserializeValuesurlIntermediateType will accept a single value or a slice of them to create a slice of urlIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesUrlIntermediateType(in interface{}) (t []*urlIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceUrlIntermediateType(s)
+	}
+	tmp, err := deserializeUrlIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*urlIntermediateType{tmp}, nil
+
+}
+
+// serializeurlIntermediateType will accept a urlIntermediateType to create a map
+func serializeUrlIntermediateType(t *urlIntermediateType) (i interface{}, err error) {
+	i, err = t.Serialize()
+	return
+
+}
+
+// serializeSliceurlIntermediateType will accept a slice of urlIntermediateType to create a slice result
+func serializeSliceUrlIntermediateType(s []*urlIntermediateType) (out []interface{}, err error) {
+	for _, t := range s {
+		v, err := t.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return
+
+}
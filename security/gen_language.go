@@ -0,0 +1,53 @@
+//
+package security
+
+import (
+	"sort"
+	"strings"
+)
+
+// languageKey returns the key of the natural language map that is the language
+// tag, which BCP 47 compares without regard to case.
+func languageKey(m map[string]string, tag string) (string, bool) {
+	if _, ok := m[tag]; ok {
+		return tag, true
+	}
+	for k := range m {
+		if strings.EqualFold(k, tag) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// preferredLanguage returns the key of the natural language map best matching
+// the language tags, which are in order of preference. Each tag is looked up as
+// RFC 4647 describes, removing subtags from its end until it matches, so that
+// "en-GB" matches "en". If none match, it returns the first key in sorted order
+// and false, or an empty key if the map is empty.
+func preferredLanguage(m map[string]string, tags []string) (string, bool) {
+	for _, tag := range tags {
+		for len(tag) > 0 {
+			if k, ok := languageKey(m, tag); ok {
+				return k, true
+			}
+			i := strings.LastIndex(tag, "-")
+			if i < 0 {
+				break
+			}
+			tag = tag[:i]
+			if i = strings.LastIndex(tag, "-"); i >= 0 && i == len(tag)-2 {
+				tag = tag[:i]
+			}
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return "", false
+	}
+	sort.Strings(keys)
+	return keys[0], false
+}
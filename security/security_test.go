@@ -0,0 +1,130 @@
+//
+//go:generate go install github.com/go-fed/activity/tools/security
+//go:generate security
+package security
+
+import (
+	"encoding/json"
+	"github.com/go-fed/activity/vocab"
+	"net/url"
+	"testing"
+)
+
+const pem = "-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAu\n-----END PUBLIC KEY-----\n"
+
+func TestPublicKey(t *testing.T) {
+	actor := map[string]interface{}{
+		"@context": []interface{}{"https://www.w3.org/ns/activitystreams", "https://w3id.org/security/v1"},
+		"type":     "Person",
+		"id":       "https://example.com/users/alice",
+		"publicKey": map[string]interface{}{
+			"id":           "https://example.com/users/alice#main-key",
+			"owner":        "https://example.com/users/alice",
+			"publicKeyPem": pem,
+		},
+	}
+	p := &vocab.Person{}
+	if err := p.Deserialize(actor); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	if !IsPublicKey(p) {
+		t.Fatalf("Expected the untyped publicKey to be a Key")
+	}
+	k := GetPublicKey(p)
+	if k.GetId().String() != "https://example.com/users/alice#main-key" {
+		t.Errorf("Expected the id of the key, got %s", k.GetId())
+	}
+	if !k.HasOwner() || k.GetOwner().String() != "https://example.com/users/alice" {
+		t.Errorf("Expected the owner of the key, got %v", k.GetOwner())
+	}
+	if !k.IsPublicKeyPem() || k.GetPublicKeyPem() != pem {
+		t.Errorf("Expected the PEM of the key, got %q", k.GetPublicKeyPem())
+	}
+
+	id, _ := url.Parse("https://example.com/users/bob#main-key")
+	owner, _ := url.Parse("https://example.com/users/bob")
+	key := &Key{}
+	key.SetId(id)
+	key.SetOwner(owner)
+	key.SetPublicKeyPem(pem)
+	bob := &vocab.Person{}
+	if err := SetPublicKey(bob, key); err != nil {
+		t.Fatalf("Cannot SetPublicKey: %s", err)
+	}
+	b, err := json.Marshal(bob)
+	if err != nil {
+		t.Fatalf("Cannot marshal: %s", err)
+	}
+	bob = &vocab.Person{}
+	if err = json.Unmarshal(b, bob); err != nil {
+		t.Fatalf("Cannot unmarshal: %s", err)
+	} else if !IsPublicKey(bob) || GetPublicKey(bob).GetPublicKeyPem() != pem {
+		t.Errorf("Expected the key after a round trip of %s", b)
+	}
+}
+
+func TestProof(t *testing.T) {
+	const create = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", "https://w3id.org/security/data-integrity/v1"],
+  "type": "Create",
+  "id": "https://example.com/activities/1",
+  "actor": "https://example.com/users/alice",
+  "object": {"type": "Note", "content": "Hello"},
+  "proof": {
+    "type": "DataIntegrityProof",
+    "cryptosuite": "eddsa-jcs-2022",
+    "verificationMethod": "https://example.com/users/alice#ed25519-key",
+    "proofPurpose": "assertionMethod",
+    "proofValue": "z3sXaxjKs4M3BRicwWA9peyNPJvJqxtGsDmRt1cQ4dVXdBLcu6U6L7KbxC1wGpYp1aWEZbpmXtahiU",
+    "created": "2023-02-24T23:36:38Z"
+  }
+}`
+	c := &vocab.Create{}
+	if err := json.Unmarshal([]byte(create), c); err != nil {
+		t.Fatalf("Cannot unmarshal: %s", err)
+	}
+	if !IsProof(c) {
+		t.Fatalf("Expected the proof of the activity")
+	}
+	p, ok := GetProof(c).(*DataIntegrityProof)
+	if !ok {
+		t.Fatalf("Expected *DataIntegrityProof, got %T", GetProof(c))
+	}
+	if !p.IsCryptosuite() || p.GetCryptosuite() != "eddsa-jcs-2022" {
+		t.Errorf("Expected the cryptosuite of the proof, got %q", p.GetCryptosuite())
+	}
+	if !p.HasVerificationMethod() || p.GetVerificationMethod().String() != "https://example.com/users/alice#ed25519-key" {
+		t.Errorf("Expected the verification method of the proof, got %v", p.GetVerificationMethod())
+	}
+	if !p.IsProofPurpose() || p.GetProofPurpose() != "assertionMethod" {
+		t.Errorf("Expected the purpose of the proof, got %q", p.GetProofPurpose())
+	}
+	if !p.IsProofValue() || len(p.GetProofValue()) == 0 {
+		t.Errorf("Expected the value of the proof")
+	}
+	if !p.IsCreated() || p.GetCreated().Unix() != 1677281798 {
+		t.Errorf("Expected the creation time of the proof, got %v", p.GetCreated())
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("Cannot marshal: %s", err)
+	}
+	c = &vocab.Create{}
+	if err = json.Unmarshal(b, c); err != nil {
+		t.Fatalf("Cannot unmarshal: %s", err)
+	} else if !IsProof(c) || GetProof(c).(*DataIntegrityProof).GetProofValue() != p.GetProofValue() {
+		t.Errorf("Expected the proof after a round trip of %s", b)
+	}
+
+	n := &vocab.Note{}
+	if err = SetProof(n, p); err != nil {
+		t.Fatalf("Cannot SetProof: %s", err)
+	}
+	m, err := n.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	} else if proof, ok := m["proof"].(map[string]interface{}); !ok || proof["type"] != "DataIntegrityProof" || proof["created"] != "2023-02-24T23:36:38Z" {
+		t.Errorf("Expected the proof to be serialized, got %v", m["proof"])
+	}
+}
@@ -0,0 +1,855 @@
+// Package storage helps persist the ActivityStream vocabulary types in a SQL database. Each value is stored as a row of a single table, holding its id, its type, and its JSON serialization. This package is code-generated alongside the vocab package. Do not modify this package directly.
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/vocab"
+	"net/url"
+)
+
+// Table is the name of the table the rows are stored in.
+const Table = "activitystreams_objects"
+
+// Schema is the PostgreSQL statement creating the table the rows are stored
+// in. Other databases can store the document as text instead of JSONB.
+const Schema = `CREATE TABLE IF NOT EXISTS activitystreams_objects (
+	id TEXT PRIMARY KEY,
+	type TEXT NOT NULL,
+	document JSONB NOT NULL
+)`
+
+// Columns are the columns of the table, in the order of the values returned by
+// Row.Values and the order the ScanRow functions expect them to be selected in.
+var Columns = []string{"id", "type", "document"}
+
+// Scanner scans the columns of a row, such as *sql.Row and *sql.Rows.
+type Scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Row is a value of the vocabulary stored in the table.
+type Row struct {
+	// Id is the IRI identifying the value.
+	Id string
+	// Type is the name of the vocabulary type of the value.
+	Type string
+	// Document is the value serialized as JSON.
+	Document []byte
+}
+
+// Values returns the values of the columns of the row, in the order of Columns.
+func (r *Row) Values() []interface{} {
+	return []interface{}{r.Id, r.Type, r.Document}
+}
+
+// identified is a value of the vocabulary that can be stored in a row.
+type identified interface {
+	vocab.Serializer
+	HasId() bool
+	GetId() *url.URL
+}
+
+// toRow serializes the value of the named type into a row. Values without an
+// id cannot be stored, since it is the primary key of the table.
+func toRow(v identified, typeName string) (*Row, error) {
+	if !v.HasId() {
+		return nil, fmt.Errorf("Cannot store %s without an id", typeName)
+	}
+	m, err := v.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return &Row{Id: v.GetId().String(), Type: typeName, Document: b}, nil
+}
+
+// scanRow scans a row and deserializes its document into the value, which
+// must be of the type stored in the row.
+func scanRow(s Scanner, typeName string, v vocab.Deserializer) error {
+	r := &Row{}
+	if err := s.Scan(&r.Id, &r.Type, &r.Document); err != nil {
+		return err
+	}
+	if r.Type != typeName {
+		return fmt.Errorf("Cannot scan row of type %s into %s", r.Type, typeName)
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(r.Document, &m); err != nil {
+		return err
+	}
+	return v.Deserialize(m)
+}
+
+// ToRowObject serializes the Object into a Row, returning an error if it has no id.
+func ToRowObject(v *vocab.Object) (r *Row, err error) {
+	return toRow(v, "Object")
+}
+
+// ScanRowObject scans a Row holding a Object, returning an error if the row holds another type.
+func ScanRowObject(s Scanner) (v *vocab.Object, err error) {
+	v = &vocab.Object{}
+	if err = scanRow(s, "Object", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowLink serializes the Link into a Row, returning an error if it has no id.
+func ToRowLink(v *vocab.Link) (r *Row, err error) {
+	return toRow(v, "Link")
+}
+
+// ScanRowLink scans a Row holding a Link, returning an error if the row holds another type.
+func ScanRowLink(s Scanner) (v *vocab.Link, err error) {
+	v = &vocab.Link{}
+	if err = scanRow(s, "Link", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowActivity serializes the Activity into a Row, returning an error if it has no id.
+func ToRowActivity(v *vocab.Activity) (r *Row, err error) {
+	return toRow(v, "Activity")
+}
+
+// ScanRowActivity scans a Row holding a Activity, returning an error if the row holds another type.
+func ScanRowActivity(s Scanner) (v *vocab.Activity, err error) {
+	v = &vocab.Activity{}
+	if err = scanRow(s, "Activity", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowIntransitiveActivity serializes the IntransitiveActivity into a Row, returning an error if it has no id.
+func ToRowIntransitiveActivity(v *vocab.IntransitiveActivity) (r *Row, err error) {
+	return toRow(v, "IntransitiveActivity")
+}
+
+// ScanRowIntransitiveActivity scans a Row holding a IntransitiveActivity, returning an error if the row holds another type.
+func ScanRowIntransitiveActivity(s Scanner) (v *vocab.IntransitiveActivity, err error) {
+	v = &vocab.IntransitiveActivity{}
+	if err = scanRow(s, "IntransitiveActivity", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowCollection serializes the Collection into a Row, returning an error if it has no id.
+func ToRowCollection(v *vocab.Collection) (r *Row, err error) {
+	return toRow(v, "Collection")
+}
+
+// ScanRowCollection scans a Row holding a Collection, returning an error if the row holds another type.
+func ScanRowCollection(s Scanner) (v *vocab.Collection, err error) {
+	v = &vocab.Collection{}
+	if err = scanRow(s, "Collection", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowOrderedCollection serializes the OrderedCollection into a Row, returning an error if it has no id.
+func ToRowOrderedCollection(v *vocab.OrderedCollection) (r *Row, err error) {
+	return toRow(v, "OrderedCollection")
+}
+
+// ScanRowOrderedCollection scans a Row holding a OrderedCollection, returning an error if the row holds another type.
+func ScanRowOrderedCollection(s Scanner) (v *vocab.OrderedCollection, err error) {
+	v = &vocab.OrderedCollection{}
+	if err = scanRow(s, "OrderedCollection", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowCollectionPage serializes the CollectionPage into a Row, returning an error if it has no id.
+func ToRowCollectionPage(v *vocab.CollectionPage) (r *Row, err error) {
+	return toRow(v, "CollectionPage")
+}
+
+// ScanRowCollectionPage scans a Row holding a CollectionPage, returning an error if the row holds another type.
+func ScanRowCollectionPage(s Scanner) (v *vocab.CollectionPage, err error) {
+	v = &vocab.CollectionPage{}
+	if err = scanRow(s, "CollectionPage", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowOrderedCollectionPage serializes the OrderedCollectionPage into a Row, returning an error if it has no id.
+func ToRowOrderedCollectionPage(v *vocab.OrderedCollectionPage) (r *Row, err error) {
+	return toRow(v, "OrderedCollectionPage")
+}
+
+// ScanRowOrderedCollectionPage scans a Row holding a OrderedCollectionPage, returning an error if the row holds another type.
+func ScanRowOrderedCollectionPage(s Scanner) (v *vocab.OrderedCollectionPage, err error) {
+	v = &vocab.OrderedCollectionPage{}
+	if err = scanRow(s, "OrderedCollectionPage", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowAccept serializes the Accept into a Row, returning an error if it has no id.
+func ToRowAccept(v *vocab.Accept) (r *Row, err error) {
+	return toRow(v, "Accept")
+}
+
+// ScanRowAccept scans a Row holding a Accept, returning an error if the row holds another type.
+func ScanRowAccept(s Scanner) (v *vocab.Accept, err error) {
+	v = &vocab.Accept{}
+	if err = scanRow(s, "Accept", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowTentativeAccept serializes the TentativeAccept into a Row, returning an error if it has no id.
+func ToRowTentativeAccept(v *vocab.TentativeAccept) (r *Row, err error) {
+	return toRow(v, "TentativeAccept")
+}
+
+// ScanRowTentativeAccept scans a Row holding a TentativeAccept, returning an error if the row holds another type.
+func ScanRowTentativeAccept(s Scanner) (v *vocab.TentativeAccept, err error) {
+	v = &vocab.TentativeAccept{}
+	if err = scanRow(s, "TentativeAccept", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowAdd serializes the Add into a Row, returning an error if it has no id.
+func ToRowAdd(v *vocab.Add) (r *Row, err error) {
+	return toRow(v, "Add")
+}
+
+// ScanRowAdd scans a Row holding a Add, returning an error if the row holds another type.
+func ScanRowAdd(s Scanner) (v *vocab.Add, err error) {
+	v = &vocab.Add{}
+	if err = scanRow(s, "Add", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowArrive serializes the Arrive into a Row, returning an error if it has no id.
+func ToRowArrive(v *vocab.Arrive) (r *Row, err error) {
+	return toRow(v, "Arrive")
+}
+
+// ScanRowArrive scans a Row holding a Arrive, returning an error if the row holds another type.
+func ScanRowArrive(s Scanner) (v *vocab.Arrive, err error) {
+	v = &vocab.Arrive{}
+	if err = scanRow(s, "Arrive", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowCreate serializes the Create into a Row, returning an error if it has no id.
+func ToRowCreate(v *vocab.Create) (r *Row, err error) {
+	return toRow(v, "Create")
+}
+
+// ScanRowCreate scans a Row holding a Create, returning an error if the row holds another type.
+func ScanRowCreate(s Scanner) (v *vocab.Create, err error) {
+	v = &vocab.Create{}
+	if err = scanRow(s, "Create", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowDelete serializes the Delete into a Row, returning an error if it has no id.
+func ToRowDelete(v *vocab.Delete) (r *Row, err error) {
+	return toRow(v, "Delete")
+}
+
+// ScanRowDelete scans a Row holding a Delete, returning an error if the row holds another type.
+func ScanRowDelete(s Scanner) (v *vocab.Delete, err error) {
+	v = &vocab.Delete{}
+	if err = scanRow(s, "Delete", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowFollow serializes the Follow into a Row, returning an error if it has no id.
+func ToRowFollow(v *vocab.Follow) (r *Row, err error) {
+	return toRow(v, "Follow")
+}
+
+// ScanRowFollow scans a Row holding a Follow, returning an error if the row holds another type.
+func ScanRowFollow(s Scanner) (v *vocab.Follow, err error) {
+	v = &vocab.Follow{}
+	if err = scanRow(s, "Follow", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowIgnore serializes the Ignore into a Row, returning an error if it has no id.
+func ToRowIgnore(v *vocab.Ignore) (r *Row, err error) {
+	return toRow(v, "Ignore")
+}
+
+// ScanRowIgnore scans a Row holding a Ignore, returning an error if the row holds another type.
+func ScanRowIgnore(s Scanner) (v *vocab.Ignore, err error) {
+	v = &vocab.Ignore{}
+	if err = scanRow(s, "Ignore", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowJoin serializes the Join into a Row, returning an error if it has no id.
+func ToRowJoin(v *vocab.Join) (r *Row, err error) {
+	return toRow(v, "Join")
+}
+
+// ScanRowJoin scans a Row holding a Join, returning an error if the row holds another type.
+func ScanRowJoin(s Scanner) (v *vocab.Join, err error) {
+	v = &vocab.Join{}
+	if err = scanRow(s, "Join", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowLeave serializes the Leave into a Row, returning an error if it has no id.
+func ToRowLeave(v *vocab.Leave) (r *Row, err error) {
+	return toRow(v, "Leave")
+}
+
+// ScanRowLeave scans a Row holding a Leave, returning an error if the row holds another type.
+func ScanRowLeave(s Scanner) (v *vocab.Leave, err error) {
+	v = &vocab.Leave{}
+	if err = scanRow(s, "Leave", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowLike serializes the Like into a Row, returning an error if it has no id.
+func ToRowLike(v *vocab.Like) (r *Row, err error) {
+	return toRow(v, "Like")
+}
+
+// ScanRowLike scans a Row holding a Like, returning an error if the row holds another type.
+func ScanRowLike(s Scanner) (v *vocab.Like, err error) {
+	v = &vocab.Like{}
+	if err = scanRow(s, "Like", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowOffer serializes the Offer into a Row, returning an error if it has no id.
+func ToRowOffer(v *vocab.Offer) (r *Row, err error) {
+	return toRow(v, "Offer")
+}
+
+// ScanRowOffer scans a Row holding a Offer, returning an error if the row holds another type.
+func ScanRowOffer(s Scanner) (v *vocab.Offer, err error) {
+	v = &vocab.Offer{}
+	if err = scanRow(s, "Offer", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowInvite serializes the Invite into a Row, returning an error if it has no id.
+func ToRowInvite(v *vocab.Invite) (r *Row, err error) {
+	return toRow(v, "Invite")
+}
+
+// ScanRowInvite scans a Row holding a Invite, returning an error if the row holds another type.
+func ScanRowInvite(s Scanner) (v *vocab.Invite, err error) {
+	v = &vocab.Invite{}
+	if err = scanRow(s, "Invite", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowReject serializes the Reject into a Row, returning an error if it has no id.
+func ToRowReject(v *vocab.Reject) (r *Row, err error) {
+	return toRow(v, "Reject")
+}
+
+// ScanRowReject scans a Row holding a Reject, returning an error if the row holds another type.
+func ScanRowReject(s Scanner) (v *vocab.Reject, err error) {
+	v = &vocab.Reject{}
+	if err = scanRow(s, "Reject", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowTentativeReject serializes the TentativeReject into a Row, returning an error if it has no id.
+func ToRowTentativeReject(v *vocab.TentativeReject) (r *Row, err error) {
+	return toRow(v, "TentativeReject")
+}
+
+// ScanRowTentativeReject scans a Row holding a TentativeReject, returning an error if the row holds another type.
+func ScanRowTentativeReject(s Scanner) (v *vocab.TentativeReject, err error) {
+	v = &vocab.TentativeReject{}
+	if err = scanRow(s, "TentativeReject", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowRemove serializes the Remove into a Row, returning an error if it has no id.
+func ToRowRemove(v *vocab.Remove) (r *Row, err error) {
+	return toRow(v, "Remove")
+}
+
+// ScanRowRemove scans a Row holding a Remove, returning an error if the row holds another type.
+func ScanRowRemove(s Scanner) (v *vocab.Remove, err error) {
+	v = &vocab.Remove{}
+	if err = scanRow(s, "Remove", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowUndo serializes the Undo into a Row, returning an error if it has no id.
+func ToRowUndo(v *vocab.Undo) (r *Row, err error) {
+	return toRow(v, "Undo")
+}
+
+// ScanRowUndo scans a Row holding a Undo, returning an error if the row holds another type.
+func ScanRowUndo(s Scanner) (v *vocab.Undo, err error) {
+	v = &vocab.Undo{}
+	if err = scanRow(s, "Undo", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowUpdate serializes the Update into a Row, returning an error if it has no id.
+func ToRowUpdate(v *vocab.Update) (r *Row, err error) {
+	return toRow(v, "Update")
+}
+
+// ScanRowUpdate scans a Row holding a Update, returning an error if the row holds another type.
+func ScanRowUpdate(s Scanner) (v *vocab.Update, err error) {
+	v = &vocab.Update{}
+	if err = scanRow(s, "Update", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowView serializes the View into a Row, returning an error if it has no id.
+func ToRowView(v *vocab.View) (r *Row, err error) {
+	return toRow(v, "View")
+}
+
+// ScanRowView scans a Row holding a View, returning an error if the row holds another type.
+func ScanRowView(s Scanner) (v *vocab.View, err error) {
+	v = &vocab.View{}
+	if err = scanRow(s, "View", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowListen serializes the Listen into a Row, returning an error if it has no id.
+func ToRowListen(v *vocab.Listen) (r *Row, err error) {
+	return toRow(v, "Listen")
+}
+
+// ScanRowListen scans a Row holding a Listen, returning an error if the row holds another type.
+func ScanRowListen(s Scanner) (v *vocab.Listen, err error) {
+	v = &vocab.Listen{}
+	if err = scanRow(s, "Listen", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowRead serializes the Read into a Row, returning an error if it has no id.
+func ToRowRead(v *vocab.Read) (r *Row, err error) {
+	return toRow(v, "Read")
+}
+
+// ScanRowRead scans a Row holding a Read, returning an error if the row holds another type.
+func ScanRowRead(s Scanner) (v *vocab.Read, err error) {
+	v = &vocab.Read{}
+	if err = scanRow(s, "Read", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowMove serializes the Move into a Row, returning an error if it has no id.
+func ToRowMove(v *vocab.Move) (r *Row, err error) {
+	return toRow(v, "Move")
+}
+
+// ScanRowMove scans a Row holding a Move, returning an error if the row holds another type.
+func ScanRowMove(s Scanner) (v *vocab.Move, err error) {
+	v = &vocab.Move{}
+	if err = scanRow(s, "Move", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowTravel serializes the Travel into a Row, returning an error if it has no id.
+func ToRowTravel(v *vocab.Travel) (r *Row, err error) {
+	return toRow(v, "Travel")
+}
+
+// ScanRowTravel scans a Row holding a Travel, returning an error if the row holds another type.
+func ScanRowTravel(s Scanner) (v *vocab.Travel, err error) {
+	v = &vocab.Travel{}
+	if err = scanRow(s, "Travel", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowAnnounce serializes the Announce into a Row, returning an error if it has no id.
+func ToRowAnnounce(v *vocab.Announce) (r *Row, err error) {
+	return toRow(v, "Announce")
+}
+
+// ScanRowAnnounce scans a Row holding a Announce, returning an error if the row holds another type.
+func ScanRowAnnounce(s Scanner) (v *vocab.Announce, err error) {
+	v = &vocab.Announce{}
+	if err = scanRow(s, "Announce", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowBlock serializes the Block into a Row, returning an error if it has no id.
+func ToRowBlock(v *vocab.Block) (r *Row, err error) {
+	return toRow(v, "Block")
+}
+
+// ScanRowBlock scans a Row holding a Block, returning an error if the row holds another type.
+func ScanRowBlock(s Scanner) (v *vocab.Block, err error) {
+	v = &vocab.Block{}
+	if err = scanRow(s, "Block", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowFlag serializes the Flag into a Row, returning an error if it has no id.
+func ToRowFlag(v *vocab.Flag) (r *Row, err error) {
+	return toRow(v, "Flag")
+}
+
+// ScanRowFlag scans a Row holding a Flag, returning an error if the row holds another type.
+func ScanRowFlag(s Scanner) (v *vocab.Flag, err error) {
+	v = &vocab.Flag{}
+	if err = scanRow(s, "Flag", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowDislike serializes the Dislike into a Row, returning an error if it has no id.
+func ToRowDislike(v *vocab.Dislike) (r *Row, err error) {
+	return toRow(v, "Dislike")
+}
+
+// ScanRowDislike scans a Row holding a Dislike, returning an error if the row holds another type.
+func ScanRowDislike(s Scanner) (v *vocab.Dislike, err error) {
+	v = &vocab.Dislike{}
+	if err = scanRow(s, "Dislike", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowQuestion serializes the Question into a Row, returning an error if it has no id.
+func ToRowQuestion(v *vocab.Question) (r *Row, err error) {
+	return toRow(v, "Question")
+}
+
+// ScanRowQuestion scans a Row holding a Question, returning an error if the row holds another type.
+func ScanRowQuestion(s Scanner) (v *vocab.Question, err error) {
+	v = &vocab.Question{}
+	if err = scanRow(s, "Question", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowApplication serializes the Application into a Row, returning an error if it has no id.
+func ToRowApplication(v *vocab.Application) (r *Row, err error) {
+	return toRow(v, "Application")
+}
+
+// ScanRowApplication scans a Row holding a Application, returning an error if the row holds another type.
+func ScanRowApplication(s Scanner) (v *vocab.Application, err error) {
+	v = &vocab.Application{}
+	if err = scanRow(s, "Application", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowGroup serializes the Group into a Row, returning an error if it has no id.
+func ToRowGroup(v *vocab.Group) (r *Row, err error) {
+	return toRow(v, "Group")
+}
+
+// ScanRowGroup scans a Row holding a Group, returning an error if the row holds another type.
+func ScanRowGroup(s Scanner) (v *vocab.Group, err error) {
+	v = &vocab.Group{}
+	if err = scanRow(s, "Group", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowOrganization serializes the Organization into a Row, returning an error if it has no id.
+func ToRowOrganization(v *vocab.Organization) (r *Row, err error) {
+	return toRow(v, "Organization")
+}
+
+// ScanRowOrganization scans a Row holding a Organization, returning an error if the row holds another type.
+func ScanRowOrganization(s Scanner) (v *vocab.Organization, err error) {
+	v = &vocab.Organization{}
+	if err = scanRow(s, "Organization", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowPerson serializes the Person into a Row, returning an error if it has no id.
+func ToRowPerson(v *vocab.Person) (r *Row, err error) {
+	return toRow(v, "Person")
+}
+
+// ScanRowPerson scans a Row holding a Person, returning an error if the row holds another type.
+func ScanRowPerson(s Scanner) (v *vocab.Person, err error) {
+	v = &vocab.Person{}
+	if err = scanRow(s, "Person", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowService serializes the Service into a Row, returning an error if it has no id.
+func ToRowService(v *vocab.Service) (r *Row, err error) {
+	return toRow(v, "Service")
+}
+
+// ScanRowService scans a Row holding a Service, returning an error if the row holds another type.
+func ScanRowService(s Scanner) (v *vocab.Service, err error) {
+	v = &vocab.Service{}
+	if err = scanRow(s, "Service", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowRelationship serializes the Relationship into a Row, returning an error if it has no id.
+func ToRowRelationship(v *vocab.Relationship) (r *Row, err error) {
+	return toRow(v, "Relationship")
+}
+
+// ScanRowRelationship scans a Row holding a Relationship, returning an error if the row holds another type.
+func ScanRowRelationship(s Scanner) (v *vocab.Relationship, err error) {
+	v = &vocab.Relationship{}
+	if err = scanRow(s, "Relationship", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowArticle serializes the Article into a Row, returning an error if it has no id.
+func ToRowArticle(v *vocab.Article) (r *Row, err error) {
+	return toRow(v, "Article")
+}
+
+// ScanRowArticle scans a Row holding a Article, returning an error if the row holds another type.
+func ScanRowArticle(s Scanner) (v *vocab.Article, err error) {
+	v = &vocab.Article{}
+	if err = scanRow(s, "Article", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowDocument serializes the Document into a Row, returning an error if it has no id.
+func ToRowDocument(v *vocab.Document) (r *Row, err error) {
+	return toRow(v, "Document")
+}
+
+// ScanRowDocument scans a Row holding a Document, returning an error if the row holds another type.
+func ScanRowDocument(s Scanner) (v *vocab.Document, err error) {
+	v = &vocab.Document{}
+	if err = scanRow(s, "Document", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowAudio serializes the Audio into a Row, returning an error if it has no id.
+func ToRowAudio(v *vocab.Audio) (r *Row, err error) {
+	return toRow(v, "Audio")
+}
+
+// ScanRowAudio scans a Row holding a Audio, returning an error if the row holds another type.
+func ScanRowAudio(s Scanner) (v *vocab.Audio, err error) {
+	v = &vocab.Audio{}
+	if err = scanRow(s, "Audio", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowImage serializes the Image into a Row, returning an error if it has no id.
+func ToRowImage(v *vocab.Image) (r *Row, err error) {
+	return toRow(v, "Image")
+}
+
+// ScanRowImage scans a Row holding a Image, returning an error if the row holds another type.
+func ScanRowImage(s Scanner) (v *vocab.Image, err error) {
+	v = &vocab.Image{}
+	if err = scanRow(s, "Image", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowVideo serializes the Video into a Row, returning an error if it has no id.
+func ToRowVideo(v *vocab.Video) (r *Row, err error) {
+	return toRow(v, "Video")
+}
+
+// ScanRowVideo scans a Row holding a Video, returning an error if the row holds another type.
+func ScanRowVideo(s Scanner) (v *vocab.Video, err error) {
+	v = &vocab.Video{}
+	if err = scanRow(s, "Video", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowNote serializes the Note into a Row, returning an error if it has no id.
+func ToRowNote(v *vocab.Note) (r *Row, err error) {
+	return toRow(v, "Note")
+}
+
+// ScanRowNote scans a Row holding a Note, returning an error if the row holds another type.
+func ScanRowNote(s Scanner) (v *vocab.Note, err error) {
+	v = &vocab.Note{}
+	if err = scanRow(s, "Note", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowPage serializes the Page into a Row, returning an error if it has no id.
+func ToRowPage(v *vocab.Page) (r *Row, err error) {
+	return toRow(v, "Page")
+}
+
+// ScanRowPage scans a Row holding a Page, returning an error if the row holds another type.
+func ScanRowPage(s Scanner) (v *vocab.Page, err error) {
+	v = &vocab.Page{}
+	if err = scanRow(s, "Page", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowEvent serializes the Event into a Row, returning an error if it has no id.
+func ToRowEvent(v *vocab.Event) (r *Row, err error) {
+	return toRow(v, "Event")
+}
+
+// ScanRowEvent scans a Row holding a Event, returning an error if the row holds another type.
+func ScanRowEvent(s Scanner) (v *vocab.Event, err error) {
+	v = &vocab.Event{}
+	if err = scanRow(s, "Event", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowPlace serializes the Place into a Row, returning an error if it has no id.
+func ToRowPlace(v *vocab.Place) (r *Row, err error) {
+	return toRow(v, "Place")
+}
+
+// ScanRowPlace scans a Row holding a Place, returning an error if the row holds another type.
+func ScanRowPlace(s Scanner) (v *vocab.Place, err error) {
+	v = &vocab.Place{}
+	if err = scanRow(s, "Place", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowProfile serializes the Profile into a Row, returning an error if it has no id.
+func ToRowProfile(v *vocab.Profile) (r *Row, err error) {
+	return toRow(v, "Profile")
+}
+
+// ScanRowProfile scans a Row holding a Profile, returning an error if the row holds another type.
+func ScanRowProfile(s Scanner) (v *vocab.Profile, err error) {
+	v = &vocab.Profile{}
+	if err = scanRow(s, "Profile", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowTombstone serializes the Tombstone into a Row, returning an error if it has no id.
+func ToRowTombstone(v *vocab.Tombstone) (r *Row, err error) {
+	return toRow(v, "Tombstone")
+}
+
+// ScanRowTombstone scans a Row holding a Tombstone, returning an error if the row holds another type.
+func ScanRowTombstone(s Scanner) (v *vocab.Tombstone, err error) {
+	v = &vocab.Tombstone{}
+	if err = scanRow(s, "Tombstone", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowMention serializes the Mention into a Row, returning an error if it has no id.
+func ToRowMention(v *vocab.Mention) (r *Row, err error) {
+	return toRow(v, "Mention")
+}
+
+// ScanRowMention scans a Row holding a Mention, returning an error if the row holds another type.
+func ScanRowMention(s Scanner) (v *vocab.Mention, err error) {
+	v = &vocab.Mention{}
+	if err = scanRow(s, "Mention", v); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ToRowHashtag serializes the Hashtag into a Row, returning an error if it has no id.
+func ToRowHashtag(v *vocab.Hashtag) (r *Row, err error) {
+	return toRow(v, "Hashtag")
+}
+
+// ScanRowHashtag scans a Row holding a Hashtag, returning an error if the row holds another type.
+func ScanRowHashtag(s Scanner) (v *vocab.Hashtag, err error) {
+	v = &vocab.Hashtag{}
+	if err = scanRow(s, "Hashtag", v); err != nil {
+		return nil, err
+	}
+	return
+}
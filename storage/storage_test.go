@@ -0,0 +1,84 @@
+//go:generate go install github.com/go-fed/activity/tools/storage
+//go:generate storage
+package storage
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/vocab"
+	"net/url"
+	"testing"
+)
+
+// rowScanner scans the values of a Row, as if it were selected from the table.
+type rowScanner struct {
+	r *Row
+}
+
+func (s *rowScanner) Scan(dest ...interface{}) error {
+	if len(dest) != len(Columns) {
+		return fmt.Errorf("Expected %d destinations, got %d", len(Columns), len(dest))
+	}
+	for i, v := range s.r.Values() {
+		switch d := dest[i].(type) {
+		case *string:
+			*d = v.(string)
+		case *[]byte:
+			*d = v.([]byte)
+		default:
+			return fmt.Errorf("Unsupported destination %T", dest[i])
+		}
+	}
+	return nil
+}
+
+func mustParseURL(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func TestRowRoundTrip(t *testing.T) {
+	n := &vocab.Note{}
+	n.SetId(mustParseURL("https://example.com/note/1"))
+	n.AppendNameString("A note")
+	n.AppendContentString("Hello, world")
+	r, err := ToRowNote(n)
+	if err != nil {
+		t.Fatalf("Expected no error from ToRowNote, got %s", err)
+	}
+	if r.Id != "https://example.com/note/1" {
+		t.Fatalf("Expected id https://example.com/note/1, got %s", r.Id)
+	}
+	if r.Type != "Note" {
+		t.Fatalf("Expected type Note, got %s", r.Type)
+	}
+	actual, err := ScanRowNote(&rowScanner{r})
+	if err != nil {
+		t.Fatalf("Expected no error from ScanRowNote, got %s", err)
+	}
+	if !actual.Equals(n) {
+		t.Fatalf("Expected scanned Note to equal the stored one")
+	}
+}
+
+func TestToRowWithoutId(t *testing.T) {
+	n := &vocab.Note{}
+	n.AppendNameString("A note")
+	if _, err := ToRowNote(n); err == nil {
+		t.Fatalf("Expected an error storing a Note without an id")
+	}
+}
+
+func TestScanRowOfAnotherType(t *testing.T) {
+	n := &vocab.Note{}
+	n.SetId(mustParseURL("https://example.com/note/1"))
+	r, err := ToRowNote(n)
+	if err != nil {
+		t.Fatalf("Expected no error from ToRowNote, got %s", err)
+	}
+	if _, err = ScanRowArticle(&rowScanner{r}); err == nil {
+		t.Fatalf("Expected an error scanning a Note into an Article")
+	}
+}
@@ -316,6 +316,12 @@ func (t *Application) SetContentForLanguage(l string, v string) {
 
 }
 
+// PreferredContentLanguage returns the value of 'content' in the language best matching the tags, which are in order of preference, and the tag of that language. If no language matches, the value in the first language in sorted order is returned.
+func (t *Application) PreferredContentLanguage(tags ...string) (v string, tag string) {
+	return t.raw.PreferredContentLanguage(tags...)
+
+}
+
 // LenContext returns the number of values this property contains. Each index be used with HasContext to determine if ResolveContext is safe to call or if raw handling would be needed.
 func (t *Application) LenContext() (idx int) {
 	return t.raw.ContextLen()
@@ -455,6 +461,12 @@ func (t *Application) SetNameForLanguage(l string, v string) {
 
 }
 
+// PreferredNameLanguage returns the value of 'name' in the language best matching the tags, which are in order of preference, and the tag of that language. If no language matches, the value in the first language in sorted order is returned.
+func (t *Application) PreferredNameLanguage(tags ...string) (v string, tag string) {
+	return t.raw.PreferredNameLanguage(tags...)
+
+}
+
 // GetEndTime attempts to get this 'endTime' property as a time.Time. It returns a Resolution appropriate for clients to determine whether it would be necessary to do raw handling.
 func (t *Application) GetEndTime() (r Resolution, k time.Time) {
 	r = Unresolved
@@ -1058,6 +1070,12 @@ func (t *Application) SetSummaryForLanguage(l string, v string) {
 
 }
 
+// PreferredSummaryLanguage returns the value of 'summary' in the language best matching the tags, which are in order of preference, and the tag of that language. If no language matches, the value in the first language in sorted order is returned.
+func (t *Application) PreferredSummaryLanguage(tags ...string) (v string, tag string) {
+	return t.raw.PreferredSummaryLanguage(tags...)
+
+}
+
 // LenTag returns the number of values this property contains. Each index be used with HasTag to determine if ResolveTag is safe to call or if raw handling would be needed.
 func (t *Application) LenTag() (idx int) {
 	return t.raw.TagLen()
@@ -1884,6 +1902,12 @@ func (t *Application) SetPreferredUsernameForLanguage(l string, v string) {
 
 }
 
+// PreferredPreferredUsernameLanguage returns the value of 'preferredUsername' in the language best matching the tags, which are in order of preference, and the tag of that language. If no language matches, the value in the first language in sorted order is returned.
+func (t *Application) PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string) {
+	return t.raw.PreferredPreferredUsernameLanguage(tags...)
+
+}
+
 // ResolveEndpoints passes the actual concrete type to the resolver for handing property endpoints. It returns a Resolution appropriate for clients to determine whether it would be necessary to do raw handling, if desired.
 func (t *Application) ResolveEndpoints(r *Resolver) (s Resolution, err error) {
 	s = Unresolved
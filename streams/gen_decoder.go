@@ -0,0 +1,209 @@
+//
+package streams
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/vocab"
+	"io"
+	"net/url"
+)
+
+// CollectionDecoder decodes the 'orderedItems' or 'items' of a collection
+// document one at a time as they are read, so that large collections such as
+// archives and follower lists are never held in memory all at once. Only the
+// items at the top level of the document are streamed; the other properties are
+// kept, and the items of inlined pages are among them.
+//
+//	d := streams.NewCollectionDecoder(r)
+//	for d.Next() {
+//		item := d.Item()
+//		...
+//	}
+//	if err := d.Err(); err != nil {
+//		...
+//	}
+type CollectionDecoder struct {
+	dec     *json.Decoder
+	started bool
+	inItems bool
+	done    bool
+	props   map[string]interface{}
+	item    interface{}
+	err     error
+}
+
+// NewCollectionDecoder returns a decoder of the collection read from r.
+func NewCollectionDecoder(r io.Reader) *CollectionDecoder {
+	return &CollectionDecoder{
+		dec:   json.NewDecoder(r),
+		props: make(map[string]interface{}),
+	}
+}
+
+// Next reads the document up to the next item. It returns false at the end of
+// the document or on an error, which Err returns.
+func (d *CollectionDecoder) Next() bool {
+	d.item = nil
+	if d.err != nil || d.done {
+		return false
+	}
+	if !d.started {
+		d.started = true
+		if d.err = d.delim('{'); d.err != nil {
+			return false
+		}
+	}
+	for {
+		if d.inItems {
+			if d.dec.More() {
+				var v interface{}
+				if d.err = d.dec.Decode(&v); d.err != nil {
+					return false
+				} else if d.item, d.err = d.convert(v); d.err != nil {
+					return false
+				} else if d.item != nil {
+					return true
+				}
+				continue
+			} else if d.err = d.delim(']'); d.err != nil {
+				return false
+			}
+			d.inItems = false
+		}
+		if !d.dec.More() {
+			d.done = true
+			d.err = d.delim('}')
+			return false
+		}
+		tok, err := d.dec.Token()
+		if err != nil {
+			d.err = err
+			return false
+		}
+		key, ok := tok.(string)
+		if !ok {
+			d.err = fmt.Errorf("CollectionDecoder: expected a property name, got %v", tok)
+			return false
+		}
+		if key != "orderedItems" && key != "items" {
+			var v interface{}
+			if d.err = d.dec.Decode(&v); d.err != nil {
+				return false
+			}
+			d.props[key] = v
+			continue
+		}
+		if tok, d.err = d.dec.Token(); d.err != nil {
+			return false
+		}
+		var v interface{}
+		switch x := tok.(type) {
+		case json.Delim:
+			if x == '[' {
+				d.inItems = true
+				continue
+			} else if x != '{' {
+				d.err = fmt.Errorf("CollectionDecoder: unexpected %v", x)
+				return false
+			} else if v, d.err = d.object(); d.err != nil {
+				return false
+			}
+		default:
+			v = x
+		}
+		if d.item, d.err = d.convert(v); d.err != nil {
+			return false
+		} else if d.item != nil {
+			return true
+		}
+	}
+}
+
+// Item returns the current item, which is a vocab.Type, an IRI as a *url.URL,
+// or the generic map form of an object whose type is not in the Registry.
+func (d *CollectionDecoder) Item() interface{} {
+	return d.item
+}
+
+// Err returns the error that ended the decoding, if any.
+func (d *CollectionDecoder) Err() error {
+	return d.err
+}
+
+// Properties returns the generic map form of the properties read so far, other
+// than the items. Once Next has returned false, it is the whole collection
+// without its items.
+func (d *CollectionDecoder) Properties() map[string]interface{} {
+	return d.props
+}
+
+// Collection deserializes the properties read so far, other than the items,
+// into the vocab type of the collection. Once Next has returned false, it is
+// the whole collection without its items.
+func (d *CollectionDecoder) Collection() (vocab.Type, error) {
+	s, err := Deserialize(d.props)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := unwrap(s).(vocab.Type)
+	if !ok {
+		return nil, fmt.Errorf("CollectionDecoder: collection is not of a vocab type: %T", s)
+	}
+	return v, nil
+}
+
+// delim reads the delimiter.
+func (d *CollectionDecoder) delim(want json.Delim) error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return err
+	} else if tok != want {
+		return fmt.Errorf("CollectionDecoder: expected %v, got %v", want, tok)
+	}
+	return nil
+}
+
+// object reads the rest of an object whose opening delimiter was read.
+func (d *CollectionDecoder) object() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	for d.dec.More() {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("CollectionDecoder: expected a property name, got %v", tok)
+		}
+		var v interface{}
+		if err = d.dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+	return m, d.delim('}')
+}
+
+// convert deserializes an item, which inherits the '@context' of the
+// collection. Values that are neither IRIs nor objects are skipped.
+func (d *CollectionDecoder) convert(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case string:
+		return url.Parse(x)
+	case map[string]interface{}:
+		if c, ok := d.props["@context"]; ok {
+			if _, ok = x["@context"]; !ok {
+				x["@context"] = c
+			}
+		}
+		s, err := Deserialize(x)
+		if err != nil {
+			return x, nil
+		} else if t, ok := unwrap(s).(vocab.Type); ok {
+			return t, nil
+		}
+		return x, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,191 @@
+//
+package streams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/vocab"
+	"net/http"
+	"net/url"
+)
+
+// DefaultMaxDepth is the number of IRIs a Dereferencer fetches for one value
+// when its MaxDepth is zero.
+const DefaultMaxDepth = 3
+
+// dereferenceAccept is the Accept header of the requests of an HTTPTransport.
+const dereferenceAccept = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\", application/activity+json"
+
+// Transport fetches the ActivityStreams representation of an IRI. Applications
+// fetching with HTTP Signatures implement it with their signing client.
+type Transport interface {
+	Dereference(ctx context.Context, iri *url.URL) ([]byte, error)
+}
+
+// HTTPTransport is a Transport fetching IRIs with unsigned HTTP GET requests
+// accepting the ActivityStreams media types.
+type HTTPTransport struct {
+	// Client sends the requests, or http.DefaultClient if it is nil.
+	Client interface {
+		Do(req *http.Request) (*http.Response, error)
+	}
+	// UserAgent is the User-Agent header of the requests, if it is not
+	// empty.
+	UserAgent string
+	// Limits bounds the size of the responses, or DefaultLimits if it is
+	// nil.
+	Limits *Limits
+}
+
+// Dereference fetches the ActivityStreams representation of the IRI, returning
+// an error if the response is not a success.
+func (h *HTTPTransport) Dereference(ctx context.Context, iri *url.URL) ([]byte, error) {
+	req, err := http.NewRequest("GET", iri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", dereferenceAccept)
+	req.Header.Set("Accept-Charset", "utf-8")
+	if len(h.UserAgent) > 0 {
+		req.Header.Set("User-Agent", h.UserAgent)
+	}
+	var c interface {
+		Do(req *http.Request) (*http.Response, error)
+	} = http.DefaultClient
+	if h.Client != nil {
+		c = h.Client
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Request to %s failed (%d): %s", iri, resp.StatusCode, resp.Status)
+	}
+	l := DefaultLimits
+	if h.Limits != nil {
+		l = *h.Limits
+	}
+	return l.ReadAll(resp.Body)
+}
+
+// DereferenceCache caches the values a Dereferencer fetched by their IRI.
+type DereferenceCache interface {
+	// Get returns the value of the IRI, and whether it is cached.
+	Get(iri string) (vocab.Type, bool)
+	// Set caches the value of the IRI.
+	Set(iri string, v vocab.Type)
+}
+
+// ErrMaxDepth is returned by a Dereferencer fetching more IRIs for one value
+// than its MaxDepth.
+var ErrMaxDepth = errors.New("dereferencing exceeded the maximum depth")
+
+// Dereferencer resolves the values of properties holding IRIs, rather than
+// inlined values, by fetching them with a Transport.
+type Dereferencer struct {
+	// Transport fetches the IRIs.
+	Transport Transport
+	// MaxDepth is the number of IRIs fetched for one value, following the
+	// 'href' of the Links fetched, or DefaultMaxDepth if it is zero.
+	MaxDepth int
+	// Cache caches the values fetched, if it is not nil.
+	Cache DereferenceCache
+	// Limits bounds the JSON fetched, or DefaultLimits if it is nil.
+	Limits *Limits
+}
+
+// Dereference resolves the value of a property with the Transport, using no
+// cache and the DefaultMaxDepth. See Dereferencer.Dereference.
+func Dereference(ctx context.Context, prop interface{}, t Transport) (vocab.Type, error) {
+	d := &Dereferencer{Transport: t}
+	return d.Dereference(ctx, prop)
+}
+
+// Dereference resolves the value of a property, which is an IRI as a *url.URL
+// or a string, a Link whose 'href' is fetched, or any other vocab type, which is
+// already resolved and returned as is. The value fetched is deserialized like
+// Deserialize does, and returned as its vocab type.
+func (d *Dereferencer) Dereference(ctx context.Context, prop interface{}) (vocab.Type, error) {
+	max := d.MaxDepth
+	if max <= 0 {
+		max = DefaultMaxDepth
+	}
+	for depth := 0; ; depth++ {
+		var iri *url.URL
+		switch x := prop.(type) {
+		case *url.URL:
+			iri = x
+		case string:
+			u, err := url.Parse(x)
+			if err != nil {
+				return nil, err
+			}
+			iri = u
+		case vocab.LinkType:
+			if x.HasHref() {
+				iri = x.GetHref()
+			} else if t, ok := x.(vocab.Type); ok {
+				return t, nil
+			} else {
+				return nil, fmt.Errorf("Dereference: cannot dereference a value of type %T", prop)
+			}
+		case vocab.Type:
+			return x, nil
+		default:
+			return nil, fmt.Errorf("Dereference: cannot dereference a value of type %T", prop)
+		}
+		if iri == nil {
+			return nil, fmt.Errorf("Dereference: nil IRI")
+		} else if depth >= max {
+			return nil, ErrMaxDepth
+		}
+		v, err := d.fetch(ctx, iri)
+		if err != nil {
+			return nil, err
+		} else if _, ok := v.(vocab.LinkType); !ok {
+			return v, nil
+		}
+		prop = v
+	}
+}
+
+// fetch returns the value of the IRI from the Cache, or fetches it.
+func (d *Dereferencer) fetch(ctx context.Context, iri *url.URL) (vocab.Type, error) {
+	key := iri.String()
+	if d.Cache != nil {
+		if v, ok := d.Cache.Get(key); ok {
+			return v, nil
+		}
+	}
+	if d.Transport == nil {
+		return nil, fmt.Errorf("Dereference: no Transport to fetch %s", key)
+	}
+	b, err := d.Transport.Dereference(ctx, iri)
+	if err != nil {
+		return nil, err
+	}
+	l := DefaultLimits
+	if d.Limits != nil {
+		l = *d.Limits
+	}
+	m, err := l.Unmarshal(b)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Deserialize(m)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := unwrap(s).(vocab.Type)
+	if !ok {
+		return nil, fmt.Errorf("Dereference: %s is not of a vocab type: %T", key, s)
+	}
+	if d.Cache != nil {
+		d.Cache.Set(key, v)
+	}
+	return v, nil
+}
@@ -0,0 +1,130 @@
+//
+package streams
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/vocab"
+	"reflect"
+	"sort"
+)
+
+// ChangeKind is how a property differs between two values.
+type ChangeKind int
+
+const (
+	// Added is a property only the new value has.
+	Added ChangeKind = iota
+	// Removed is a property only the old value has.
+	Removed
+	// Modified is a property both values have, with different values.
+	Modified
+)
+
+// String returns the name of the ChangeKind.
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Modified:
+		return "Modified"
+	}
+	return fmt.Sprintf("ChangeKind(%d)", int(k))
+}
+
+// Change is a property that differs between two values.
+type Change struct {
+	// Path is the path of property names to the property, such as
+	// ["location", "name"] for the name of an embedded location.
+	Path []string
+	// Kind is how the property differs.
+	Kind ChangeKind
+	// Old is the value of the property in the old value, or nil if it is
+	// Added.
+	Old interface{}
+	// New is the value of the property in the new value, or nil if it is
+	// Removed.
+	New interface{}
+}
+
+// Diff returns the properties that differ between the old and the new value,
+// such as to audit what an Update changed, in the order of their paths. The
+// values are of the serialized form of the values as encoding/json decodes
+// it. An embedded value that both values have, with the same 'id' if any, is
+// compared property by property, and any other value as a whole, including
+// the values of properties that have many. The '@context' is not compared.
+func Diff(old, new vocab.Type) ([]Change, error) {
+	o, err := old.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	n, err := new.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return diffProperties(nil, nil, o, n), nil
+}
+
+// diffProperties appends the changes between the properties of the maps.
+func diffProperties(changes []Change, path []string, o, n map[string]interface{}) []Change {
+	keys := make([]string, 0, len(o)+len(n))
+	for k := range o {
+		keys = append(keys, k)
+	}
+	for k := range n {
+		if _, ok := o[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if k == "@context" {
+			continue
+		}
+		p := append(path[:len(path):len(path)], k)
+		ov, inOld := o[k]
+		nv, inNew := n[k]
+		ov, nv = jsonValue(ov), jsonValue(nv)
+		if !inOld {
+			changes = append(changes, Change{Path: p, Kind: Added, New: nv})
+		} else if !inNew {
+			changes = append(changes, Change{Path: p, Kind: Removed, Old: ov})
+		} else if om, nm, ok := sameEmbeddedValues(ov, nv); ok {
+			changes = diffProperties(changes, p, om, nm)
+		} else if !reflect.DeepEqual(ov, nv) {
+			changes = append(changes, Change{Path: p, Kind: Modified, Old: ov, New: nv})
+		}
+	}
+	return changes
+}
+
+// sameEmbeddedValues returns the values if both are embedded values with the
+// same 'id', or both without one.
+func sameEmbeddedValues(o, n interface{}) (om, nm map[string]interface{}, ok bool) {
+	om, oOk := o.(map[string]interface{})
+	nm, nOk := n.(map[string]interface{})
+	ok = oOk && nOk && reflect.DeepEqual(om["id"], nm["id"])
+	return
+}
+
+// jsonValue copies the value with its numbers as the float64s that
+// encoding/json decodes them to, so that values are compared however they
+// were created.
+func jsonValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			m[k] = jsonValue(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(x))
+		for i, e := range x {
+			s[i] = jsonValue(e)
+		}
+		return s
+	}
+	return jsonNumber(v)
+}
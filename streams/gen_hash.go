@@ -0,0 +1,25 @@
+//
+package streams
+
+import (
+	"crypto/sha256"
+	"github.com/go-fed/activity/vocab"
+)
+
+// ContentHash returns the SHA-256 hash of the canonical serialized form of the
+// value, so that servers can detect the same logical activity delivered more
+// than once. Values equal but for the order of their properties, their
+// '@context', or the ActivityStreams terms they write as absolute IRIs, such
+// as "https://www.w3.org/ns/activitystreams#content" for "content", have the
+// same hash. It returns the zero hash if the value cannot be serialized.
+func ContentHash(t vocab.Type) (h [32]byte) {
+	m, err := vocab.SerializeWith(t, vocab.OmitContext(), vocab.CompactIRIs())
+	if err != nil {
+		return
+	}
+	b, err := vocab.EncodeCanonical(m)
+	if err != nil {
+		return
+	}
+	return sha256.Sum256(b)
+}
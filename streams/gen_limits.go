@@ -0,0 +1,119 @@
+//
+package streams
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Limits bounds the JSON decoded from other servers, so that a hostile server
+// cannot exhaust the memory of a federated service with a huge or
+// pathologically nested payload. The limits are checked before any value is
+// decoded. A limit of zero is no limit.
+type Limits struct {
+	// MaxBytes is the number of bytes of the JSON.
+	MaxBytes int64
+	// MaxDepth is the number of objects and arrays nested in each other,
+	// counting the outermost object.
+	MaxDepth int
+	// MaxItems is the number of elements of each array, and of properties
+	// of each object.
+	MaxItems int
+}
+
+// DefaultLimits are the Limits of a Dereferencer or HTTPTransport without
+// their own. They allow far larger values than federated servers send.
+var DefaultLimits = Limits{
+	MaxBytes: 1 << 20,
+	MaxDepth: 64,
+	MaxItems: 10000,
+}
+
+// ErrLimitExceeded is returned, wrapped with the limit exceeded, when JSON is
+// beyond its Limits.
+var ErrLimitExceeded = errors.New("JSON exceeds the deserialization limits")
+
+// ReadAll reads r until EOF, returning an error once it reads more than
+// MaxBytes instead of reading further.
+func (l Limits) ReadAll(r io.Reader) ([]byte, error) {
+	if l.MaxBytes <= 0 {
+		return ioutil.ReadAll(r)
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(r, l.MaxBytes+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(b)) > l.MaxBytes {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrLimitExceeded, l.MaxBytes)
+	}
+	return b, nil
+}
+
+// Check returns an error if the JSON is beyond the Limits, or is not valid. It
+// reads the JSON token by token without decoding its values.
+func (l Limits) Check(b []byte) error {
+	if l.MaxBytes > 0 && int64(len(b)) > l.MaxBytes {
+		return fmt.Errorf("%w: more than %d bytes", ErrLimitExceeded, l.MaxBytes)
+	}
+	type level struct {
+		object bool
+		tokens int
+	}
+	var stack []level
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if d, ok := tok.(json.Delim); ok && (d == '}' || d == ']') {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		if len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			top.tokens++
+			// The tokens of objects alternate between names and
+			// values.
+			n := top.tokens
+			if top.object {
+				n = (n + 1) / 2
+			}
+			if l.MaxItems > 0 && n > l.MaxItems {
+				return fmt.Errorf("%w: more than %d items", ErrLimitExceeded, l.MaxItems)
+			}
+		}
+		if d, ok := tok.(json.Delim); ok {
+			stack = append(stack, level{object: d == '{'})
+			if l.MaxDepth > 0 && len(stack) > l.MaxDepth {
+				return fmt.Errorf("%w: more than %d levels deep", ErrLimitExceeded, l.MaxDepth)
+			}
+		}
+	}
+}
+
+// Unmarshal decodes the JSON object once Check accepts it.
+func (l Limits) Unmarshal(b []byte) (map[string]interface{}, error) {
+	if err := l.Check(b); err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Decode reads the JSON object from r and decodes it within the Limits.
+func (l Limits) Decode(r io.Reader) (map[string]interface{}, error) {
+	b, err := l.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return l.Unmarshal(b)
+}
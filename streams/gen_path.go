@@ -0,0 +1,69 @@
+//
+package streams
+
+import (
+	"github.com/go-fed/activity/vocab"
+)
+
+// Get returns the values found by following the path of property names from
+// the value, such as the ids of the authors of the object of an activity with
+// Get(t, "object", "attributedTo", "id"). Each step follows every value of a
+// property that has many. An IRI stands for the value it identifies, which is
+// not dereferenced, so its "id" is the IRI itself and it has no other property.
+// The values are of the serialized form of the value: strings, numbers,
+// booleans, and the maps of embedded values, which Deserialize resolves.
+func Get(t vocab.Serializer, path ...string) ([]interface{}, error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	values := []interface{}{m}
+	for _, p := range path {
+		var next []interface{}
+		for _, v := range values {
+			next = appendPathValues(next, v, p)
+		}
+		values = next
+	}
+	return values, nil
+}
+
+// GetStrings returns the strings among the values Get finds, such as IRIs or
+// the content of objects.
+func GetStrings(t vocab.Serializer, path ...string) ([]string, error) {
+	values, err := Get(t, path...)
+	if err != nil {
+		return nil, err
+	}
+	var s []string
+	for _, v := range values {
+		if str, ok := v.(string); ok {
+			s = append(s, str)
+		}
+	}
+	return s, nil
+}
+
+// appendPathValues appends the values of the property of the value, or of each
+// of its values if it has many.
+func appendPathValues(values []interface{}, v interface{}, property string) []interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		p, ok := x[property]
+		if !ok {
+			return values
+		} else if a, ok := p.([]interface{}); ok {
+			return append(values, a...)
+		}
+		return append(values, p)
+	case []interface{}:
+		for _, e := range x {
+			values = appendPathValues(values, e, property)
+		}
+	case string:
+		if property == "id" {
+			return append(values, x)
+		}
+	}
+	return values
+}
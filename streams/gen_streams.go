@@ -2,8 +2,11 @@
 package streams
 
 import (
+	"errors"
 	"fmt"
 	"github.com/go-fed/activity/vocab"
+	"net/url"
+	"reflect"
 )
 
 type Resolution int
@@ -22,6 +25,1114 @@ const (
 	RawPresence
 )
 
+// DeserializeFunc deserializes the generic map form of a type into its
+// convenience type.
+type DeserializeFunc func(m map[string]interface{}) (vocab.Serializer, error)
+
+// Registry maps the name of each ActivityStream type to the function
+// deserializing it. Applications may add the types of their own extensions.
+var Registry = map[string]DeserializeFunc{
+	"Object":                deserializeObject,
+	"Link":                  deserializeLink,
+	"Activity":              deserializeActivity,
+	"IntransitiveActivity":  deserializeIntransitiveActivity,
+	"Collection":            deserializeCollection,
+	"OrderedCollection":     deserializeOrderedCollection,
+	"CollectionPage":        deserializeCollectionPage,
+	"OrderedCollectionPage": deserializeOrderedCollectionPage,
+	"Accept":                deserializeAccept,
+	"TentativeAccept":       deserializeTentativeAccept,
+	"Add":                   deserializeAdd,
+	"Arrive":                deserializeArrive,
+	"Create":                deserializeCreate,
+	"Delete":                deserializeDelete,
+	"Follow":                deserializeFollow,
+	"Ignore":                deserializeIgnore,
+	"Join":                  deserializeJoin,
+	"Leave":                 deserializeLeave,
+	"Like":                  deserializeLike,
+	"Offer":                 deserializeOffer,
+	"Invite":                deserializeInvite,
+	"Reject":                deserializeReject,
+	"TentativeReject":       deserializeTentativeReject,
+	"Remove":                deserializeRemove,
+	"Undo":                  deserializeUndo,
+	"Update":                deserializeUpdate,
+	"View":                  deserializeView,
+	"Listen":                deserializeListen,
+	"Read":                  deserializeRead,
+	"Move":                  deserializeMove,
+	"Travel":                deserializeTravel,
+	"Announce":              deserializeAnnounce,
+	"Block":                 deserializeBlock,
+	"Flag":                  deserializeFlag,
+	"Dislike":               deserializeDislike,
+	"Question":              deserializeQuestion,
+	"Application":           deserializeApplication,
+	"Group":                 deserializeGroup,
+	"Organization":          deserializeOrganization,
+	"Person":                deserializePerson,
+	"Service":               deserializeService,
+	"Relationship":          deserializeRelationship,
+	"Article":               deserializeArticle,
+	"Document":              deserializeDocument,
+	"Audio":                 deserializeAudio,
+	"Image":                 deserializeImage,
+	"Video":                 deserializeVideo,
+	"Note":                  deserializeNote,
+	"Page":                  deserializePage,
+	"Event":                 deserializeEvent,
+	"Place":                 deserializePlace,
+	"Profile":               deserializeProfile,
+	"Tombstone":             deserializeTombstone,
+	"Mention":               deserializeMention,
+	"Hashtag":               deserializeHashtag,
+}
+
+// Deserialize deserializes the generic map form of any ActivityStream type into
+// its convenience type, such as a *Note, using the function in the Registry of
+// the first of its types that has one. It returns an error if the value has no
+// type, or none of them is in the Registry.
+func Deserialize(m map[string]interface{}) (vocab.Serializer, error) {
+	names, err := typeNames(m)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if fn, ok := Registry[name]; ok {
+			return fn(m)
+		}
+	}
+	return nil, fmt.Errorf("Cannot determine type: no deserializer is registered for %v", names)
+}
+
+// DeserializeAll deserializes the generic map form of a value of several types,
+// such as ["Person", "toot:FeaturedActor"], into a convenience type for each of
+// its types that has a function in the Registry, in the order of its types. It
+// returns an error if the value has no type, none of them is in the Registry,
+// or the value cannot be deserialized as one of them.
+func DeserializeAll(m map[string]interface{}) ([]vocab.Serializer, error) {
+	names, err := typeNames(m)
+	if err != nil {
+		return nil, err
+	}
+	var all []vocab.Serializer
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		fn, ok := Registry[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		s, err := fn(m)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, s)
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("Cannot determine type: no deserializer is registered for %v", names)
+	}
+	return all, nil
+}
+
+// DeserializeStrict deserializes the generic map form of any ActivityStream type
+// like Deserialize, then returns the problems the ValidateStrict method of its
+// vocab type finds with it, such as unknown properties, if there are any.
+func DeserializeStrict(m map[string]interface{}) (vocab.Serializer, error) {
+	s, err := Deserialize(m)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := unwrap(s).(interface {
+		ValidateStrict() error
+	}); ok {
+		if err = v.ValidateStrict(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// typeNames returns the names of the types of the generic map form of a value,
+// which may have one type or an array of them.
+func typeNames(m map[string]interface{}) (names []string, err error) {
+	switch t := m["type"].(type) {
+	case string:
+		names = append(names, t)
+	case []interface{}:
+		for _, elem := range t {
+			if name, ok := elem.(string); ok {
+				names = append(names, name)
+			}
+		}
+	case nil:
+		return nil, fmt.Errorf("Cannot determine type: missing 'type' property")
+	default:
+		return nil, fmt.Errorf("Cannot determine type: 'type' property is not string nor []interface{}: %T", t)
+	}
+	return
+}
+
+// ErrNoCallbackMatch is returned by JSONResolver.Resolve when none of the types
+// of the value has a callback.
+var ErrNoCallbackMatch = errors.New("activity stream did not match any callback")
+
+// jsonCallback deserializes a value of one type and passes it to its callback.
+type jsonCallback struct {
+	deserialize DeserializeFunc
+	callback    func(vocab.Serializer) error
+}
+
+// JSONResolver dispatches the generic map form of a value directly to the
+// callback of its type, deserializing it only when it has one. Unlike a
+// Resolver, it is built from the callbacks themselves, such as a
+// func(*Note) error.
+type JSONResolver struct {
+	callbacks map[string]jsonCallback
+	// interfaces are the callbacks taking an interface, in the order given.
+	interfaces []reflect.Value
+}
+
+// errorType is the type of the result of the callbacks.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// NewJSONResolver returns a JSONResolver invoking the callbacks, each of which
+// is a function taking one argument and returning an error. The argument is
+// either one of the types of this package, such as in a func(*Note) error, or
+// an interface satisfied by a whole family of types, such as in a
+// func(vocab.ActivityType) error or a func(interface{ LenObject() int }) error.
+// It returns an error if a callback is of any other type, or if several
+// callbacks take the same type.
+func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
+	j := &JSONResolver{callbacks: make(map[string]jsonCallback, len(callbacks))}
+	for _, i := range callbacks {
+		var name string
+		var c jsonCallback
+		switch fn := i.(type) {
+		case func(*Object) error:
+			name = "Object"
+			c.deserialize = deserializeObject
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Object)) }
+		case func(*Link) error:
+			name = "Link"
+			c.deserialize = deserializeLink
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Link)) }
+		case func(*Activity) error:
+			name = "Activity"
+			c.deserialize = deserializeActivity
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Activity)) }
+		case func(*IntransitiveActivity) error:
+			name = "IntransitiveActivity"
+			c.deserialize = deserializeIntransitiveActivity
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*IntransitiveActivity)) }
+		case func(*Collection) error:
+			name = "Collection"
+			c.deserialize = deserializeCollection
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Collection)) }
+		case func(*OrderedCollection) error:
+			name = "OrderedCollection"
+			c.deserialize = deserializeOrderedCollection
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*OrderedCollection)) }
+		case func(*CollectionPage) error:
+			name = "CollectionPage"
+			c.deserialize = deserializeCollectionPage
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*CollectionPage)) }
+		case func(*OrderedCollectionPage) error:
+			name = "OrderedCollectionPage"
+			c.deserialize = deserializeOrderedCollectionPage
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*OrderedCollectionPage)) }
+		case func(*Accept) error:
+			name = "Accept"
+			c.deserialize = deserializeAccept
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Accept)) }
+		case func(*TentativeAccept) error:
+			name = "TentativeAccept"
+			c.deserialize = deserializeTentativeAccept
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*TentativeAccept)) }
+		case func(*Add) error:
+			name = "Add"
+			c.deserialize = deserializeAdd
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Add)) }
+		case func(*Arrive) error:
+			name = "Arrive"
+			c.deserialize = deserializeArrive
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Arrive)) }
+		case func(*Create) error:
+			name = "Create"
+			c.deserialize = deserializeCreate
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Create)) }
+		case func(*Delete) error:
+			name = "Delete"
+			c.deserialize = deserializeDelete
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Delete)) }
+		case func(*Follow) error:
+			name = "Follow"
+			c.deserialize = deserializeFollow
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Follow)) }
+		case func(*Ignore) error:
+			name = "Ignore"
+			c.deserialize = deserializeIgnore
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Ignore)) }
+		case func(*Join) error:
+			name = "Join"
+			c.deserialize = deserializeJoin
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Join)) }
+		case func(*Leave) error:
+			name = "Leave"
+			c.deserialize = deserializeLeave
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Leave)) }
+		case func(*Like) error:
+			name = "Like"
+			c.deserialize = deserializeLike
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Like)) }
+		case func(*Offer) error:
+			name = "Offer"
+			c.deserialize = deserializeOffer
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Offer)) }
+		case func(*Invite) error:
+			name = "Invite"
+			c.deserialize = deserializeInvite
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Invite)) }
+		case func(*Reject) error:
+			name = "Reject"
+			c.deserialize = deserializeReject
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Reject)) }
+		case func(*TentativeReject) error:
+			name = "TentativeReject"
+			c.deserialize = deserializeTentativeReject
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*TentativeReject)) }
+		case func(*Remove) error:
+			name = "Remove"
+			c.deserialize = deserializeRemove
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Remove)) }
+		case func(*Undo) error:
+			name = "Undo"
+			c.deserialize = deserializeUndo
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Undo)) }
+		case func(*Update) error:
+			name = "Update"
+			c.deserialize = deserializeUpdate
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Update)) }
+		case func(*View) error:
+			name = "View"
+			c.deserialize = deserializeView
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*View)) }
+		case func(*Listen) error:
+			name = "Listen"
+			c.deserialize = deserializeListen
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Listen)) }
+		case func(*Read) error:
+			name = "Read"
+			c.deserialize = deserializeRead
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Read)) }
+		case func(*Move) error:
+			name = "Move"
+			c.deserialize = deserializeMove
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Move)) }
+		case func(*Travel) error:
+			name = "Travel"
+			c.deserialize = deserializeTravel
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Travel)) }
+		case func(*Announce) error:
+			name = "Announce"
+			c.deserialize = deserializeAnnounce
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Announce)) }
+		case func(*Block) error:
+			name = "Block"
+			c.deserialize = deserializeBlock
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Block)) }
+		case func(*Flag) error:
+			name = "Flag"
+			c.deserialize = deserializeFlag
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Flag)) }
+		case func(*Dislike) error:
+			name = "Dislike"
+			c.deserialize = deserializeDislike
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Dislike)) }
+		case func(*Question) error:
+			name = "Question"
+			c.deserialize = deserializeQuestion
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Question)) }
+		case func(*Application) error:
+			name = "Application"
+			c.deserialize = deserializeApplication
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Application)) }
+		case func(*Group) error:
+			name = "Group"
+			c.deserialize = deserializeGroup
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Group)) }
+		case func(*Organization) error:
+			name = "Organization"
+			c.deserialize = deserializeOrganization
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Organization)) }
+		case func(*Person) error:
+			name = "Person"
+			c.deserialize = deserializePerson
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Person)) }
+		case func(*Service) error:
+			name = "Service"
+			c.deserialize = deserializeService
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Service)) }
+		case func(*Relationship) error:
+			name = "Relationship"
+			c.deserialize = deserializeRelationship
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Relationship)) }
+		case func(*Article) error:
+			name = "Article"
+			c.deserialize = deserializeArticle
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Article)) }
+		case func(*Document) error:
+			name = "Document"
+			c.deserialize = deserializeDocument
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Document)) }
+		case func(*Audio) error:
+			name = "Audio"
+			c.deserialize = deserializeAudio
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Audio)) }
+		case func(*Image) error:
+			name = "Image"
+			c.deserialize = deserializeImage
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Image)) }
+		case func(*Video) error:
+			name = "Video"
+			c.deserialize = deserializeVideo
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Video)) }
+		case func(*Note) error:
+			name = "Note"
+			c.deserialize = deserializeNote
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Note)) }
+		case func(*Page) error:
+			name = "Page"
+			c.deserialize = deserializePage
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Page)) }
+		case func(*Event) error:
+			name = "Event"
+			c.deserialize = deserializeEvent
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Event)) }
+		case func(*Place) error:
+			name = "Place"
+			c.deserialize = deserializePlace
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Place)) }
+		case func(*Profile) error:
+			name = "Profile"
+			c.deserialize = deserializeProfile
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Profile)) }
+		case func(*Tombstone) error:
+			name = "Tombstone"
+			c.deserialize = deserializeTombstone
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Tombstone)) }
+		case func(*Mention) error:
+			name = "Mention"
+			c.deserialize = deserializeMention
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Mention)) }
+		case func(*Hashtag) error:
+			name = "Hashtag"
+			c.deserialize = deserializeHashtag
+			c.callback = func(s vocab.Serializer) error { return fn(s.(*Hashtag)) }
+		default:
+			v := reflect.ValueOf(i)
+			if v.Kind() != reflect.Func || v.IsNil() {
+				return nil, fmt.Errorf("NewJSONResolver: callback of unsupported type %T", i)
+			}
+			if ft := v.Type(); ft.NumIn() != 1 || ft.In(0).Kind() != reflect.Interface || ft.NumOut() != 1 || ft.Out(0) != errorType {
+				return nil, fmt.Errorf("NewJSONResolver: callback of unsupported type %T", i)
+			}
+			j.interfaces = append(j.interfaces, v)
+			continue
+		}
+		if _, ok := j.callbacks[name]; ok {
+			return nil, fmt.Errorf("NewJSONResolver: several callbacks for type %s", name)
+		}
+		j.callbacks[name] = c
+	}
+	return j, nil
+}
+
+// Resolve deserializes the generic map form of a value and passes it to the
+// callback of each of its types that has one, in the order of its types, so
+// that a value of several types, such as ["Person", "Service"], satisfies the
+// callback of each. It returns the first error of a callback, without calling
+// the others. Otherwise, the value is deserialized with the Registry like
+// Deserialize does, and passed to the first callback taking an interface that
+// either the value or its Raw vocab type satisfies. It returns
+// ErrNoCallbackMatch if no callback applies.
+func (j *JSONResolver) Resolve(m map[string]interface{}) error {
+	names, err := typeNames(m)
+	if err != nil {
+		return err
+	}
+	matched := false
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		c, ok := j.callbacks[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		matched = true
+		s, err := c.deserialize(m)
+		if err != nil {
+			return err
+		}
+		if err = c.callback(s); err != nil {
+			return err
+		}
+	}
+	if matched {
+		return nil
+	} else if len(j.interfaces) == 0 {
+		return ErrNoCallbackMatch
+	}
+	for _, name := range names {
+		if fn, ok := Registry[name]; ok {
+			s, err := fn(m)
+			if err != nil {
+				return err
+			}
+			return j.resolveInterface(s)
+		}
+	}
+	return ErrNoCallbackMatch
+}
+
+// resolveInterface passes the value to the first callback taking an interface
+// that either the value or its Raw vocab type satisfies.
+func (j *JSONResolver) resolveInterface(s vocab.Serializer) error {
+	v := reflect.ValueOf(s)
+	var raw reflect.Value
+	if fn := v.MethodByName("Raw"); fn.IsValid() && fn.Type().NumIn() == 0 && fn.Type().NumOut() == 1 {
+		raw = fn.Call(nil)[0]
+	}
+	for _, fn := range j.interfaces {
+		arg := v
+		if in := fn.Type().In(0); !v.Type().Implements(in) {
+			if !raw.IsValid() || !raw.Type().Implements(in) {
+				continue
+			}
+			arg = raw
+		}
+		err, _ := fn.Call([]reflect.Value{arg})[0].Interface().(error)
+		return err
+	}
+	return ErrNoCallbackMatch
+}
+
+// As sets the target, a non-nil pointer to one of the types of this package, to
+// a vocab type, or to an interface, to the value if it is of that type, and
+// returns whether it did. A vocab type is also converted to the type of this
+// package wrapping it, so that a *vocab.Note can be set to a *Note. Like
+// errors.As, it takes a pointer rather than a type parameter, as this package
+// targets Go versions without generics. It panics if the target is not a
+// non-nil pointer.
+func As(v vocab.Type, target interface{}) bool {
+	return assign(target, v, wrap(v))
+}
+
+// ToType deserializes the generic map form of a value like Deserialize does,
+// and sets the target like As does, either to the value or to its Raw vocab
+// type. It returns an error if the value cannot be deserialized, or is not of
+// the type of the target.
+func ToType(m map[string]interface{}, target interface{}) error {
+	s, err := Deserialize(m)
+	if err != nil {
+		return err
+	}
+	if !assign(target, s, unwrap(s)) {
+		return fmt.Errorf("ToType: cannot set %T to a value of type %T", target, s)
+	}
+	return nil
+}
+
+// assign sets the target to the first of the values assignable to it.
+func assign(target interface{}, values ...interface{}) bool {
+	t := reflect.ValueOf(target)
+	if t.Kind() != reflect.Ptr || t.IsNil() {
+		panic(fmt.Sprintf("streams: target must be a non-nil pointer, got %T", target))
+	}
+	e := t.Elem()
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if rv := reflect.ValueOf(v); rv.Type().AssignableTo(e.Type()) {
+			e.Set(rv)
+			return true
+		}
+	}
+	return false
+}
+
+// wrap returns the type of this package wrapping the vocab type, or nil if
+// there is none.
+func wrap(v vocab.Type) vocab.Serializer {
+	switch x := v.(type) {
+	case *vocab.Object:
+		if x != nil {
+			return &Object{raw: x}
+		}
+	case *vocab.Link:
+		if x != nil {
+			return &Link{raw: x}
+		}
+	case *vocab.Activity:
+		if x != nil {
+			return &Activity{raw: x}
+		}
+	case *vocab.IntransitiveActivity:
+		if x != nil {
+			return &IntransitiveActivity{raw: x}
+		}
+	case *vocab.Collection:
+		if x != nil {
+			return &Collection{raw: x}
+		}
+	case *vocab.OrderedCollection:
+		if x != nil {
+			return &OrderedCollection{raw: x}
+		}
+	case *vocab.CollectionPage:
+		if x != nil {
+			return &CollectionPage{raw: x}
+		}
+	case *vocab.OrderedCollectionPage:
+		if x != nil {
+			return &OrderedCollectionPage{raw: x}
+		}
+	case *vocab.Accept:
+		if x != nil {
+			return &Accept{raw: x}
+		}
+	case *vocab.TentativeAccept:
+		if x != nil {
+			return &TentativeAccept{raw: x}
+		}
+	case *vocab.Add:
+		if x != nil {
+			return &Add{raw: x}
+		}
+	case *vocab.Arrive:
+		if x != nil {
+			return &Arrive{raw: x}
+		}
+	case *vocab.Create:
+		if x != nil {
+			return &Create{raw: x}
+		}
+	case *vocab.Delete:
+		if x != nil {
+			return &Delete{raw: x}
+		}
+	case *vocab.Follow:
+		if x != nil {
+			return &Follow{raw: x}
+		}
+	case *vocab.Ignore:
+		if x != nil {
+			return &Ignore{raw: x}
+		}
+	case *vocab.Join:
+		if x != nil {
+			return &Join{raw: x}
+		}
+	case *vocab.Leave:
+		if x != nil {
+			return &Leave{raw: x}
+		}
+	case *vocab.Like:
+		if x != nil {
+			return &Like{raw: x}
+		}
+	case *vocab.Offer:
+		if x != nil {
+			return &Offer{raw: x}
+		}
+	case *vocab.Invite:
+		if x != nil {
+			return &Invite{raw: x}
+		}
+	case *vocab.Reject:
+		if x != nil {
+			return &Reject{raw: x}
+		}
+	case *vocab.TentativeReject:
+		if x != nil {
+			return &TentativeReject{raw: x}
+		}
+	case *vocab.Remove:
+		if x != nil {
+			return &Remove{raw: x}
+		}
+	case *vocab.Undo:
+		if x != nil {
+			return &Undo{raw: x}
+		}
+	case *vocab.Update:
+		if x != nil {
+			return &Update{raw: x}
+		}
+	case *vocab.View:
+		if x != nil {
+			return &View{raw: x}
+		}
+	case *vocab.Listen:
+		if x != nil {
+			return &Listen{raw: x}
+		}
+	case *vocab.Read:
+		if x != nil {
+			return &Read{raw: x}
+		}
+	case *vocab.Move:
+		if x != nil {
+			return &Move{raw: x}
+		}
+	case *vocab.Travel:
+		if x != nil {
+			return &Travel{raw: x}
+		}
+	case *vocab.Announce:
+		if x != nil {
+			return &Announce{raw: x}
+		}
+	case *vocab.Block:
+		if x != nil {
+			return &Block{raw: x}
+		}
+	case *vocab.Flag:
+		if x != nil {
+			return &Flag{raw: x}
+		}
+	case *vocab.Dislike:
+		if x != nil {
+			return &Dislike{raw: x}
+		}
+	case *vocab.Question:
+		if x != nil {
+			return &Question{raw: x}
+		}
+	case *vocab.Application:
+		if x != nil {
+			return &Application{raw: x}
+		}
+	case *vocab.Group:
+		if x != nil {
+			return &Group{raw: x}
+		}
+	case *vocab.Organization:
+		if x != nil {
+			return &Organization{raw: x}
+		}
+	case *vocab.Person:
+		if x != nil {
+			return &Person{raw: x}
+		}
+	case *vocab.Service:
+		if x != nil {
+			return &Service{raw: x}
+		}
+	case *vocab.Relationship:
+		if x != nil {
+			return &Relationship{raw: x}
+		}
+	case *vocab.Article:
+		if x != nil {
+			return &Article{raw: x}
+		}
+	case *vocab.Document:
+		if x != nil {
+			return &Document{raw: x}
+		}
+	case *vocab.Audio:
+		if x != nil {
+			return &Audio{raw: x}
+		}
+	case *vocab.Image:
+		if x != nil {
+			return &Image{raw: x}
+		}
+	case *vocab.Video:
+		if x != nil {
+			return &Video{raw: x}
+		}
+	case *vocab.Note:
+		if x != nil {
+			return &Note{raw: x}
+		}
+	case *vocab.Page:
+		if x != nil {
+			return &Page{raw: x}
+		}
+	case *vocab.Event:
+		if x != nil {
+			return &Event{raw: x}
+		}
+	case *vocab.Place:
+		if x != nil {
+			return &Place{raw: x}
+		}
+	case *vocab.Profile:
+		if x != nil {
+			return &Profile{raw: x}
+		}
+	case *vocab.Tombstone:
+		if x != nil {
+			return &Tombstone{raw: x}
+		}
+	case *vocab.Mention:
+		if x != nil {
+			return &Mention{raw: x}
+		}
+	case *vocab.Hashtag:
+		if x != nil {
+			return &Hashtag{raw: x}
+		}
+	}
+	return nil
+}
+
+// unwrap returns the vocab type wrapped by the type of this package, or nil if
+// it is of no type of this package.
+func unwrap(s vocab.Serializer) vocab.Serializer {
+	switch x := s.(type) {
+	case *Object:
+		if x != nil {
+			return x.raw
+		}
+	case *Link:
+		if x != nil {
+			return x.raw
+		}
+	case *Activity:
+		if x != nil {
+			return x.raw
+		}
+	case *IntransitiveActivity:
+		if x != nil {
+			return x.raw
+		}
+	case *Collection:
+		if x != nil {
+			return x.raw
+		}
+	case *OrderedCollection:
+		if x != nil {
+			return x.raw
+		}
+	case *CollectionPage:
+		if x != nil {
+			return x.raw
+		}
+	case *OrderedCollectionPage:
+		if x != nil {
+			return x.raw
+		}
+	case *Accept:
+		if x != nil {
+			return x.raw
+		}
+	case *TentativeAccept:
+		if x != nil {
+			return x.raw
+		}
+	case *Add:
+		if x != nil {
+			return x.raw
+		}
+	case *Arrive:
+		if x != nil {
+			return x.raw
+		}
+	case *Create:
+		if x != nil {
+			return x.raw
+		}
+	case *Delete:
+		if x != nil {
+			return x.raw
+		}
+	case *Follow:
+		if x != nil {
+			return x.raw
+		}
+	case *Ignore:
+		if x != nil {
+			return x.raw
+		}
+	case *Join:
+		if x != nil {
+			return x.raw
+		}
+	case *Leave:
+		if x != nil {
+			return x.raw
+		}
+	case *Like:
+		if x != nil {
+			return x.raw
+		}
+	case *Offer:
+		if x != nil {
+			return x.raw
+		}
+	case *Invite:
+		if x != nil {
+			return x.raw
+		}
+	case *Reject:
+		if x != nil {
+			return x.raw
+		}
+	case *TentativeReject:
+		if x != nil {
+			return x.raw
+		}
+	case *Remove:
+		if x != nil {
+			return x.raw
+		}
+	case *Undo:
+		if x != nil {
+			return x.raw
+		}
+	case *Update:
+		if x != nil {
+			return x.raw
+		}
+	case *View:
+		if x != nil {
+			return x.raw
+		}
+	case *Listen:
+		if x != nil {
+			return x.raw
+		}
+	case *Read:
+		if x != nil {
+			return x.raw
+		}
+	case *Move:
+		if x != nil {
+			return x.raw
+		}
+	case *Travel:
+		if x != nil {
+			return x.raw
+		}
+	case *Announce:
+		if x != nil {
+			return x.raw
+		}
+	case *Block:
+		if x != nil {
+			return x.raw
+		}
+	case *Flag:
+		if x != nil {
+			return x.raw
+		}
+	case *Dislike:
+		if x != nil {
+			return x.raw
+		}
+	case *Question:
+		if x != nil {
+			return x.raw
+		}
+	case *Application:
+		if x != nil {
+			return x.raw
+		}
+	case *Group:
+		if x != nil {
+			return x.raw
+		}
+	case *Organization:
+		if x != nil {
+			return x.raw
+		}
+	case *Person:
+		if x != nil {
+			return x.raw
+		}
+	case *Service:
+		if x != nil {
+			return x.raw
+		}
+	case *Relationship:
+		if x != nil {
+			return x.raw
+		}
+	case *Article:
+		if x != nil {
+			return x.raw
+		}
+	case *Document:
+		if x != nil {
+			return x.raw
+		}
+	case *Audio:
+		if x != nil {
+			return x.raw
+		}
+	case *Image:
+		if x != nil {
+			return x.raw
+		}
+	case *Video:
+		if x != nil {
+			return x.raw
+		}
+	case *Note:
+		if x != nil {
+			return x.raw
+		}
+	case *Page:
+		if x != nil {
+			return x.raw
+		}
+	case *Event:
+		if x != nil {
+			return x.raw
+		}
+	case *Place:
+		if x != nil {
+			return x.raw
+		}
+	case *Profile:
+		if x != nil {
+			return x.raw
+		}
+	case *Tombstone:
+		if x != nil {
+			return x.raw
+		}
+	case *Mention:
+		if x != nil {
+			return x.raw
+		}
+	case *Hashtag:
+		if x != nil {
+			return x.raw
+		}
+	}
+	return nil
+}
+
+// Convert returns a new vocab value of the type named by the target, such as
+// "Article" or "Tombstone", with the properties of the value that this type
+// also has, so that a Note can be migrated into an Article when it is edited,
+// or an Object replaced by a Tombstone when it is deleted. The 'type' of the
+// new value is only the target; the properties of the target that depend on the
+// conversion, such as the 'formerType' and 'deleted' of a Tombstone, are left
+// for the caller to set. The unknown properties of the value, such as those of
+// extensions, are copied too. It returns an error if the value is nil, cannot
+// be serialized, or the target is not in the Registry.
+func Convert(src vocab.Type, targetTypeName string) (vocab.Type, error) {
+	if src == nil {
+		return nil, fmt.Errorf("Convert: nil value")
+	}
+	fn, ok := Registry[targetTypeName]
+	if !ok {
+		return nil, fmt.Errorf("Convert: no deserializer is registered for %s", targetTypeName)
+	}
+	m, err := src.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	m["type"] = targetTypeName
+	s, err := fn(m)
+	if err != nil {
+		return nil, err
+	}
+	raw := unwrap(s)
+	if raw == nil {
+		raw = s
+	}
+	t, ok := raw.(vocab.Type)
+	if !ok {
+		return nil, fmt.Errorf("Convert: %T is not a vocab type", raw)
+	}
+	type unknowns interface {
+		HasUnknown(k string) bool
+		SetUnknownProperty(k string, i interface{})
+	}
+	from, fromOk := src.(unknowns)
+	to, toOk := t.(unknowns)
+	if fromOk && toOk {
+		for k := range m {
+			if to.HasUnknown(k) && !from.HasUnknown(k) {
+				to.SetUnknownProperty(k, nil)
+			}
+		}
+	}
+	return t, nil
+}
+
+// GetId returns the 'id' of the value, or the 'href' of a Link without an
+// 'id', such as most Mentions. It returns an error if the value is nil or has
+// neither.
+func GetId(t vocab.Type) (*url.URL, error) {
+	if t == nil {
+		return nil, fmt.Errorf("GetId: nil value")
+	}
+	if i, ok := t.(interface {
+		HasId() bool
+		GetId() *url.URL
+	}); ok && i.HasId() {
+		return i.GetId(), nil
+	}
+	if l, ok := t.(interface {
+		HasHref() bool
+		GetHref() *url.URL
+	}); ok && l.HasHref() {
+		return l.GetHref(), nil
+	}
+	return nil, fmt.Errorf("GetId: %s has no id", GetTypeName(t))
+}
+
+// GetTypeName returns the name of the type of the value, such as "Note". A
+// value of several types, such as ["toot:Extension", "Note"], has the name of
+// the type it was deserialized as, and a value of an extension has the name of
+// its type in the extension. It returns an empty string if the value is nil.
+func GetTypeName(t vocab.Type) string {
+	if t == nil {
+		return ""
+	} else if k := t.Kind(); k != vocab.UnknownKind {
+		return k.String()
+	}
+	rt := reflect.TypeOf(t)
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return rt.Name()
+}
+
+// GetTypeNames returns the names of all the types of the value, such as
+// ["Person", "toot:FeaturedActor"], in the order of its 'type' property. A
+// value without a 'type' property has the name GetTypeName returns. It returns
+// nil if the value is nil.
+func GetTypeNames(t vocab.Type) []string {
+	if t == nil {
+		return nil
+	}
+	var names []string
+	if typer, ok := t.(interface {
+		TypeLen() int
+		GetType(index int) interface{}
+	}); ok {
+		for i := 0; i < typer.TypeLen(); i++ {
+			if s, ok := typer.GetType(i).(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+	if len(names) == 0 {
+		names = append(names, GetTypeName(t))
+	}
+	return names
+}
+
 // Resolver contains callback functions to execute when it Deserializes a raw map[string]interface{} into a concrete type. Clients can set only the callbacks they care about and handle the resulting concrete type.
 type Resolver struct {
 	// Callback function for the Object type
@@ -132,6 +1243,8 @@ type Resolver struct {
 	TombstoneCallback func(*Tombstone) error
 	// Callback function for the Mention type
 	MentionCallback func(*Mention) error
+	// Callback function for the Hashtag type
+	HashtagCallback func(*Hashtag) error
 	// Callback function for any type that satisfies the vocab.ObjectType interface. Note that this will be called in addition to the specific type callbacks.
 	AnyObjectCallback func(vocab.ObjectType) error
 	// Callback function for any type that satisfies the vocab.LinkType interface. Note that this will be called in addition to the specific type callbacks.
@@ -682,6 +1795,16 @@ func (t *Resolver) dispatch(i interface{}) (handled bool, err error) {
 		}
 	}
 	// End generateResolver for type 'Mention'
+	// Begin generateResolver for type 'Hashtag'
+	if rawV, ok := i.(*vocab.Hashtag); ok {
+		if t.HashtagCallback != nil {
+			v := &Hashtag{raw: rawV}
+			return true, t.HashtagCallback(v)
+		} else {
+			return false, nil
+		}
+	}
+	// End generateResolver for type 'Hashtag'
 	if obj, ok := i.(vocab.ObjectType); ok {
 		if t.AnyObjectCallback != nil {
 			return true, t.AnyObjectCallback(obj)
@@ -3044,6 +4167,1015 @@ func (t *Resolver) Deserialize(m map[string]interface{}) (err error) {
 		}
 	}
 	// End generateResolver for type 'Mention'
+	// Begin generateResolver for type 'Hashtag'
+	for _, typeName := range typeStringVals {
+		if typeName == "Hashtag" {
+			if t.HashtagCallback != nil || t.AnyObjectCallback != nil || t.AnyLinkCallback != nil || t.AnyActivityCallback != nil {
+				v := &vocab.Hashtag{}
+				if err := v.Deserialize(m); err != nil {
+					return err
+				}
+				as := &Hashtag{v}
+				if t.HashtagCallback != nil {
+					if err := t.HashtagCallback(as); err != nil {
+						return err
+					}
+				}
+				var i interface{} = v
+				if obj, ok := i.(vocab.ObjectType); ok {
+					if t.AnyObjectCallback != nil {
+						if err := t.AnyObjectCallback(obj); err != nil {
+							return err
+						}
+					}
+				}
+				if link, ok := i.(vocab.LinkType); ok {
+					if t.AnyLinkCallback != nil {
+						if err := t.AnyLinkCallback(link); err != nil {
+							return err
+						}
+					}
+				}
+				if activity, ok := i.(vocab.ActivityType); ok {
+					if t.AnyActivityCallback != nil {
+						if err := t.AnyActivityCallback(activity); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			} else {
+				return nil
+			}
+		}
+	}
+	// End generateResolver for type 'Hashtag'
 	return fmt.Errorf("The 'type' property did not match any known types: %+v", typeStringVals)
 
 }
+
+// NewPredicatedResolver returns a Resolver invoking the callbacks of r only for the values for which the predicate returns true. The predicate is given the value each callback would have been given; the values it rejects are silently ignored, as if r had no callback for them. The callbacks of r are copied, so changing them later does not affect the returned Resolver.
+func NewPredicatedResolver(predicate func(vocab.Serializer) bool, r *Resolver) (p *Resolver) {
+	p = &Resolver{}
+	if fn := r.ObjectCallback; fn != nil {
+		p.ObjectCallback = func(v *Object) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.LinkCallback; fn != nil {
+		p.LinkCallback = func(v *Link) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ActivityCallback; fn != nil {
+		p.ActivityCallback = func(v *Activity) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.IntransitiveActivityCallback; fn != nil {
+		p.IntransitiveActivityCallback = func(v *IntransitiveActivity) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.CollectionCallback; fn != nil {
+		p.CollectionCallback = func(v *Collection) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.OrderedCollectionCallback; fn != nil {
+		p.OrderedCollectionCallback = func(v *OrderedCollection) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.CollectionPageCallback; fn != nil {
+		p.CollectionPageCallback = func(v *CollectionPage) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.OrderedCollectionPageCallback; fn != nil {
+		p.OrderedCollectionPageCallback = func(v *OrderedCollectionPage) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.AcceptCallback; fn != nil {
+		p.AcceptCallback = func(v *Accept) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.TentativeAcceptCallback; fn != nil {
+		p.TentativeAcceptCallback = func(v *TentativeAccept) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.AddCallback; fn != nil {
+		p.AddCallback = func(v *Add) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ArriveCallback; fn != nil {
+		p.ArriveCallback = func(v *Arrive) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.CreateCallback; fn != nil {
+		p.CreateCallback = func(v *Create) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.DeleteCallback; fn != nil {
+		p.DeleteCallback = func(v *Delete) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.FollowCallback; fn != nil {
+		p.FollowCallback = func(v *Follow) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.IgnoreCallback; fn != nil {
+		p.IgnoreCallback = func(v *Ignore) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.JoinCallback; fn != nil {
+		p.JoinCallback = func(v *Join) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.LeaveCallback; fn != nil {
+		p.LeaveCallback = func(v *Leave) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.LikeCallback; fn != nil {
+		p.LikeCallback = func(v *Like) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.OfferCallback; fn != nil {
+		p.OfferCallback = func(v *Offer) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.InviteCallback; fn != nil {
+		p.InviteCallback = func(v *Invite) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.RejectCallback; fn != nil {
+		p.RejectCallback = func(v *Reject) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.TentativeRejectCallback; fn != nil {
+		p.TentativeRejectCallback = func(v *TentativeReject) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.RemoveCallback; fn != nil {
+		p.RemoveCallback = func(v *Remove) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.UndoCallback; fn != nil {
+		p.UndoCallback = func(v *Undo) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.UpdateCallback; fn != nil {
+		p.UpdateCallback = func(v *Update) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ViewCallback; fn != nil {
+		p.ViewCallback = func(v *View) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ListenCallback; fn != nil {
+		p.ListenCallback = func(v *Listen) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ReadCallback; fn != nil {
+		p.ReadCallback = func(v *Read) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.MoveCallback; fn != nil {
+		p.MoveCallback = func(v *Move) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.TravelCallback; fn != nil {
+		p.TravelCallback = func(v *Travel) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.AnnounceCallback; fn != nil {
+		p.AnnounceCallback = func(v *Announce) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.BlockCallback; fn != nil {
+		p.BlockCallback = func(v *Block) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.FlagCallback; fn != nil {
+		p.FlagCallback = func(v *Flag) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.DislikeCallback; fn != nil {
+		p.DislikeCallback = func(v *Dislike) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.QuestionCallback; fn != nil {
+		p.QuestionCallback = func(v *Question) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ApplicationCallback; fn != nil {
+		p.ApplicationCallback = func(v *Application) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.GroupCallback; fn != nil {
+		p.GroupCallback = func(v *Group) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.OrganizationCallback; fn != nil {
+		p.OrganizationCallback = func(v *Organization) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.PersonCallback; fn != nil {
+		p.PersonCallback = func(v *Person) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ServiceCallback; fn != nil {
+		p.ServiceCallback = func(v *Service) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.RelationshipCallback; fn != nil {
+		p.RelationshipCallback = func(v *Relationship) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ArticleCallback; fn != nil {
+		p.ArticleCallback = func(v *Article) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.DocumentCallback; fn != nil {
+		p.DocumentCallback = func(v *Document) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.AudioCallback; fn != nil {
+		p.AudioCallback = func(v *Audio) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ImageCallback; fn != nil {
+		p.ImageCallback = func(v *Image) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.VideoCallback; fn != nil {
+		p.VideoCallback = func(v *Video) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.NoteCallback; fn != nil {
+		p.NoteCallback = func(v *Note) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.PageCallback; fn != nil {
+		p.PageCallback = func(v *Page) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.EventCallback; fn != nil {
+		p.EventCallback = func(v *Event) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.PlaceCallback; fn != nil {
+		p.PlaceCallback = func(v *Place) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.ProfileCallback; fn != nil {
+		p.ProfileCallback = func(v *Profile) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.TombstoneCallback; fn != nil {
+		p.TombstoneCallback = func(v *Tombstone) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.MentionCallback; fn != nil {
+		p.MentionCallback = func(v *Mention) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.HashtagCallback; fn != nil {
+		p.HashtagCallback = func(v *Hashtag) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.AnyObjectCallback; fn != nil {
+		p.AnyObjectCallback = func(v vocab.ObjectType) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.AnyLinkCallback; fn != nil {
+		p.AnyLinkCallback = func(v vocab.LinkType) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	if fn := r.AnyActivityCallback; fn != nil {
+		p.AnyActivityCallback = func(v vocab.ActivityType) error {
+			if !predicate(v) {
+				return nil
+			}
+			return fn(v)
+		}
+	}
+	return
+
+}
+
+// deserializeObject deserializes the generic map form of a Object.
+func deserializeObject(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Object{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Object{raw: v}, nil
+}
+
+// deserializeLink deserializes the generic map form of a Link.
+func deserializeLink(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Link{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Link{raw: v}, nil
+}
+
+// deserializeActivity deserializes the generic map form of a Activity.
+func deserializeActivity(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Activity{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Activity{raw: v}, nil
+}
+
+// deserializeIntransitiveActivity deserializes the generic map form of a IntransitiveActivity.
+func deserializeIntransitiveActivity(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.IntransitiveActivity{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &IntransitiveActivity{raw: v}, nil
+}
+
+// deserializeCollection deserializes the generic map form of a Collection.
+func deserializeCollection(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Collection{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Collection{raw: v}, nil
+}
+
+// deserializeOrderedCollection deserializes the generic map form of a OrderedCollection.
+func deserializeOrderedCollection(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.OrderedCollection{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &OrderedCollection{raw: v}, nil
+}
+
+// deserializeCollectionPage deserializes the generic map form of a CollectionPage.
+func deserializeCollectionPage(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.CollectionPage{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &CollectionPage{raw: v}, nil
+}
+
+// deserializeOrderedCollectionPage deserializes the generic map form of a OrderedCollectionPage.
+func deserializeOrderedCollectionPage(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.OrderedCollectionPage{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &OrderedCollectionPage{raw: v}, nil
+}
+
+// deserializeAccept deserializes the generic map form of a Accept.
+func deserializeAccept(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Accept{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Accept{raw: v}, nil
+}
+
+// deserializeTentativeAccept deserializes the generic map form of a TentativeAccept.
+func deserializeTentativeAccept(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.TentativeAccept{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &TentativeAccept{raw: v}, nil
+}
+
+// deserializeAdd deserializes the generic map form of a Add.
+func deserializeAdd(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Add{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Add{raw: v}, nil
+}
+
+// deserializeArrive deserializes the generic map form of a Arrive.
+func deserializeArrive(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Arrive{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Arrive{raw: v}, nil
+}
+
+// deserializeCreate deserializes the generic map form of a Create.
+func deserializeCreate(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Create{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Create{raw: v}, nil
+}
+
+// deserializeDelete deserializes the generic map form of a Delete.
+func deserializeDelete(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Delete{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Delete{raw: v}, nil
+}
+
+// deserializeFollow deserializes the generic map form of a Follow.
+func deserializeFollow(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Follow{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Follow{raw: v}, nil
+}
+
+// deserializeIgnore deserializes the generic map form of a Ignore.
+func deserializeIgnore(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Ignore{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Ignore{raw: v}, nil
+}
+
+// deserializeJoin deserializes the generic map form of a Join.
+func deserializeJoin(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Join{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Join{raw: v}, nil
+}
+
+// deserializeLeave deserializes the generic map form of a Leave.
+func deserializeLeave(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Leave{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Leave{raw: v}, nil
+}
+
+// deserializeLike deserializes the generic map form of a Like.
+func deserializeLike(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Like{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Like{raw: v}, nil
+}
+
+// deserializeOffer deserializes the generic map form of a Offer.
+func deserializeOffer(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Offer{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Offer{raw: v}, nil
+}
+
+// deserializeInvite deserializes the generic map form of a Invite.
+func deserializeInvite(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Invite{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Invite{raw: v}, nil
+}
+
+// deserializeReject deserializes the generic map form of a Reject.
+func deserializeReject(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Reject{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Reject{raw: v}, nil
+}
+
+// deserializeTentativeReject deserializes the generic map form of a TentativeReject.
+func deserializeTentativeReject(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.TentativeReject{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &TentativeReject{raw: v}, nil
+}
+
+// deserializeRemove deserializes the generic map form of a Remove.
+func deserializeRemove(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Remove{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Remove{raw: v}, nil
+}
+
+// deserializeUndo deserializes the generic map form of a Undo.
+func deserializeUndo(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Undo{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Undo{raw: v}, nil
+}
+
+// deserializeUpdate deserializes the generic map form of a Update.
+func deserializeUpdate(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Update{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Update{raw: v}, nil
+}
+
+// deserializeView deserializes the generic map form of a View.
+func deserializeView(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.View{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &View{raw: v}, nil
+}
+
+// deserializeListen deserializes the generic map form of a Listen.
+func deserializeListen(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Listen{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Listen{raw: v}, nil
+}
+
+// deserializeRead deserializes the generic map form of a Read.
+func deserializeRead(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Read{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Read{raw: v}, nil
+}
+
+// deserializeMove deserializes the generic map form of a Move.
+func deserializeMove(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Move{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Move{raw: v}, nil
+}
+
+// deserializeTravel deserializes the generic map form of a Travel.
+func deserializeTravel(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Travel{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Travel{raw: v}, nil
+}
+
+// deserializeAnnounce deserializes the generic map form of a Announce.
+func deserializeAnnounce(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Announce{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Announce{raw: v}, nil
+}
+
+// deserializeBlock deserializes the generic map form of a Block.
+func deserializeBlock(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Block{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Block{raw: v}, nil
+}
+
+// deserializeFlag deserializes the generic map form of a Flag.
+func deserializeFlag(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Flag{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Flag{raw: v}, nil
+}
+
+// deserializeDislike deserializes the generic map form of a Dislike.
+func deserializeDislike(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Dislike{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Dislike{raw: v}, nil
+}
+
+// deserializeQuestion deserializes the generic map form of a Question.
+func deserializeQuestion(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Question{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Question{raw: v}, nil
+}
+
+// deserializeApplication deserializes the generic map form of a Application.
+func deserializeApplication(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Application{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Application{raw: v}, nil
+}
+
+// deserializeGroup deserializes the generic map form of a Group.
+func deserializeGroup(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Group{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Group{raw: v}, nil
+}
+
+// deserializeOrganization deserializes the generic map form of a Organization.
+func deserializeOrganization(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Organization{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Organization{raw: v}, nil
+}
+
+// deserializePerson deserializes the generic map form of a Person.
+func deserializePerson(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Person{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Person{raw: v}, nil
+}
+
+// deserializeService deserializes the generic map form of a Service.
+func deserializeService(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Service{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Service{raw: v}, nil
+}
+
+// deserializeRelationship deserializes the generic map form of a Relationship.
+func deserializeRelationship(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Relationship{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Relationship{raw: v}, nil
+}
+
+// deserializeArticle deserializes the generic map form of a Article.
+func deserializeArticle(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Article{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Article{raw: v}, nil
+}
+
+// deserializeDocument deserializes the generic map form of a Document.
+func deserializeDocument(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Document{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Document{raw: v}, nil
+}
+
+// deserializeAudio deserializes the generic map form of a Audio.
+func deserializeAudio(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Audio{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Audio{raw: v}, nil
+}
+
+// deserializeImage deserializes the generic map form of a Image.
+func deserializeImage(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Image{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Image{raw: v}, nil
+}
+
+// deserializeVideo deserializes the generic map form of a Video.
+func deserializeVideo(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Video{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Video{raw: v}, nil
+}
+
+// deserializeNote deserializes the generic map form of a Note.
+func deserializeNote(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Note{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Note{raw: v}, nil
+}
+
+// deserializePage deserializes the generic map form of a Page.
+func deserializePage(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Page{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Page{raw: v}, nil
+}
+
+// deserializeEvent deserializes the generic map form of a Event.
+func deserializeEvent(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Event{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Event{raw: v}, nil
+}
+
+// deserializePlace deserializes the generic map form of a Place.
+func deserializePlace(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Place{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Place{raw: v}, nil
+}
+
+// deserializeProfile deserializes the generic map form of a Profile.
+func deserializeProfile(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Profile{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Profile{raw: v}, nil
+}
+
+// deserializeTombstone deserializes the generic map form of a Tombstone.
+func deserializeTombstone(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Tombstone{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Tombstone{raw: v}, nil
+}
+
+// deserializeMention deserializes the generic map form of a Mention.
+func deserializeMention(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Mention{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Mention{raw: v}, nil
+}
+
+// deserializeHashtag deserializes the generic map form of a Hashtag.
+func deserializeHashtag(m map[string]interface{}) (s vocab.Serializer, err error) {
+	v := &vocab.Hashtag{}
+	if err = v.Deserialize(m); err != nil {
+		return nil, err
+	}
+	return &Hashtag{raw: v}, nil
+}
@@ -1,12 +1,20 @@
+//
 //go:generate go install github.com/go-fed/activity/tools/streams
 //go:generate streams
 package streams
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/go-fed/activity/vocab"
 	"github.com/go-test/deep"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -324,6 +332,933 @@ func TestNulls(t *testing.T) {
 	}
 }
 
+func TestDeserialize(t *testing.T) {
+	s, err := Deserialize(map[string]interface{}{
+		"type":    []interface{}{"http://example.com/ns#Extension", "Note"},
+		"content": "Hello",
+	})
+	if err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	n, ok := s.(*Note)
+	if !ok {
+		t.Fatalf("Expected *Note, got %T", s)
+	} else if n.LenContent() != 1 {
+		t.Fatalf("Expected 1 content, got %d", n.LenContent())
+	}
+	if _, err = Deserialize(map[string]interface{}{"content": "Hello"}); err == nil {
+		t.Fatalf("Expected an error deserializing a value without a type")
+	}
+	if _, err = Deserialize(map[string]interface{}{"type": "Extension"}); err == nil {
+		t.Fatalf("Expected an error deserializing an unregistered type")
+	}
+	Registry["Extension"] = Registry["Note"]
+	defer delete(Registry, "Extension")
+	if s, err = Deserialize(map[string]interface{}{"type": "Extension"}); err != nil {
+		t.Fatalf("Cannot Deserialize registered type: %s", err)
+	} else if _, ok := s.(*Note); !ok {
+		t.Fatalf("Expected *Note, got %T", s)
+	}
+}
+
+func TestMultipleTypes(t *testing.T) {
+	m := map[string]interface{}{
+		"type":              []interface{}{"Person", "toot:FeaturedActor", "Service"},
+		"id":                "https://example.com/users/bot",
+		"preferredUsername": "bot",
+	}
+	all, err := DeserializeAll(m)
+	if err != nil {
+		t.Fatalf("Cannot DeserializeAll: %s", err)
+	} else if len(all) != 2 {
+		t.Fatalf("Expected 2 values, got %d", len(all))
+	}
+	p, ok := all[0].(*Person)
+	if !ok {
+		t.Fatalf("Expected *Person, got %T", all[0])
+	} else if _, ok = all[1].(*Service); !ok {
+		t.Fatalf("Expected *Service, got %T", all[1])
+	}
+	if names := GetTypeNames(p.Raw()); !reflect.DeepEqual(names, []string{"Person", "toot:FeaturedActor", "Service"}) {
+		t.Errorf("Expected all the type names, got %v", names)
+	}
+	if names := GetTypeNames(&vocab.Note{}); !reflect.DeepEqual(names, []string{"Note"}) {
+		t.Errorf("Expected the type name of a value without types, got %v", names)
+	}
+	if _, err = DeserializeAll(map[string]interface{}{"type": "toot:FeaturedActor"}); err == nil {
+		t.Errorf("Expected an error for a value of no registered type")
+	}
+
+	var got []string
+	r, err := NewJSONResolver(
+		func(p *Person) error {
+			got = append(got, "Person")
+			return nil
+		},
+		func(s *Service) error {
+			got = append(got, "Service")
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Cannot NewJSONResolver: %s", err)
+	}
+	if err = r.Resolve(m); err != nil {
+		t.Fatalf("Cannot Resolve: %s", err)
+	} else if !reflect.DeepEqual(got, []string{"Person", "Service"}) {
+		t.Fatalf("Expected the callback of each type, got %v", got)
+	}
+	r, err = NewJSONResolver(
+		func(p *Person) error { return fmt.Errorf("person") },
+		func(s *Service) error {
+			t.Errorf("Expected no callback after an error")
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Cannot NewJSONResolver: %s", err)
+	} else if err = r.Resolve(m); err == nil || err.Error() != "person" {
+		t.Fatalf("Expected the error of the first callback, got %v", err)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	n := &vocab.Note{}
+	if err := n.Deserialize(map[string]interface{}{
+		"type":       "Note",
+		"id":         "https://example.com/notes/1",
+		"content":    "Hello",
+		"toot:extra": "kept",
+	}); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	v, err := Convert(n, "Article")
+	if err != nil {
+		t.Fatalf("Cannot Convert: %s", err)
+	}
+	a, ok := v.(*vocab.Article)
+	if !ok {
+		t.Fatalf("Expected *vocab.Article, got %T", v)
+	} else if a.GetId().String() != "https://example.com/notes/1" {
+		t.Errorf("Expected the id to be copied, got %s", a.GetId())
+	} else if a.ContentLen() != 1 || a.GetContentString(0) != "Hello" {
+		t.Errorf("Expected the content to be copied")
+	} else if a.GetUnknown("toot:extra") != "kept" {
+		t.Errorf("Expected the unknown property to be copied")
+	}
+	if names := GetTypeNames(a); len(names) != 1 || names[0] != "Article" {
+		t.Errorf("Expected only the Article type, got %v", names)
+	}
+
+	q := &vocab.Question{}
+	q.AppendNameString("Which?")
+	choice := &vocab.Note{}
+	choice.AppendNameString("This one")
+	q.AppendOneOfObject(choice)
+	if v, err = Convert(q, "Tombstone"); err != nil {
+		t.Fatalf("Cannot Convert: %s", err)
+	}
+	ts := v.(*vocab.Tombstone)
+	ts.AppendFormerTypeString("Question")
+	m, err := ts.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	} else if _, ok := m["oneOf"]; ok {
+		t.Errorf("Expected the oneOf of the Question to be left out, got %v", m)
+	} else if m["name"] != "Which?" || m["formerType"] != "Question" || m["type"] != "Tombstone" {
+		t.Errorf("Expected the Tombstone of the Question, got %v", m)
+	}
+
+	if _, err = Convert(n, "toot:Unknown"); err == nil {
+		t.Errorf("Expected an error for an unregistered type")
+	}
+	if _, err = Convert(nil, "Note"); err == nil {
+		t.Errorf("Expected an error for a nil value")
+	}
+}
+
+func TestNewPredicatedResolver(t *testing.T) {
+	followed := "https://example.com/followed"
+	isNote := false
+	noteResolver := &Resolver{
+		NoteCallback: func(n *Note) error {
+			isNote = true
+			return nil
+		},
+	}
+	predicate := func(s vocab.Serializer) bool {
+		c, ok := s.(*Create)
+		if !ok || c.LenActor() != 1 || c.LenObject() != 1 {
+			return false
+		}
+		if _, a := c.GetActor(0); a == nil || a.String() != followed {
+			return false
+		}
+		isNote = false
+		if r, err := c.ResolveObject(noteResolver, 0); err != nil || r != Resolved {
+			return false
+		}
+		return isNote
+	}
+	var created []*Create
+	r := NewPredicatedResolver(predicate, &Resolver{
+		CreateCallback: func(c *Create) error {
+			created = append(created, c)
+			return nil
+		},
+	})
+	tables := []struct {
+		name   string
+		actor  string
+		object string
+		handle bool
+	}{
+		{"Note from followed actor", followed, "Note", true},
+		{"Image from followed actor", followed, "Image", false},
+		{"Note from other actor", "https://example.com/other", "Note", false},
+	}
+	for _, r2 := range tables {
+		created = nil
+		m := map[string]interface{}{
+			"type":  "Create",
+			"actor": r2.actor,
+			"object": map[string]interface{}{
+				"type": r2.object,
+			},
+		}
+		if err := r.Deserialize(m); err != nil {
+			t.Errorf("%s: Cannot Deserialize: %s", r2.name, err)
+		} else if handled := len(created) == 1; handled != r2.handle {
+			t.Errorf("%s: Expected handled %v, got %v", r2.name, r2.handle, handled)
+		}
+	}
+	if r.NoteCallback != nil {
+		t.Errorf("Expected no NoteCallback")
+	}
+}
+
+func TestNewJSONResolver(t *testing.T) {
+	var notes, creates int
+	r, err := NewJSONResolver(
+		func(n *Note) error {
+			notes++
+			return nil
+		},
+		func(c *Create) error {
+			creates++
+			return fmt.Errorf("create")
+		},
+	)
+	if err != nil {
+		t.Fatalf("Cannot NewJSONResolver: %s", err)
+	}
+	if err = r.Resolve(map[string]interface{}{"type": []interface{}{"http://example.com/ns#Extension", "Note"}}); err != nil {
+		t.Fatalf("Cannot Resolve Note: %s", err)
+	} else if notes != 1 {
+		t.Fatalf("Expected 1 Note, got %d", notes)
+	}
+	if err = r.Resolve(map[string]interface{}{"type": "Create"}); err == nil || err.Error() != "create" {
+		t.Fatalf("Expected the error of the callback, got %v", err)
+	} else if creates != 1 {
+		t.Fatalf("Expected 1 Create, got %d", creates)
+	}
+	if err = r.Resolve(map[string]interface{}{"type": "Image"}); err != ErrNoCallbackMatch {
+		t.Fatalf("Expected ErrNoCallbackMatch, got %v", err)
+	}
+	if err = r.Resolve(map[string]interface{}{}); err == nil {
+		t.Fatalf("Expected an error resolving a value without a type")
+	}
+	if _, err = NewJSONResolver(func(n *Note) error { return nil }, func(n *Note) error { return nil }); err == nil {
+		t.Fatalf("Expected an error for several callbacks of one type")
+	}
+	if _, err = NewJSONResolver(func(n *Note) {}); err == nil {
+		t.Fatalf("Expected an error for a callback of unsupported type")
+	}
+}
+
+func TestNewJSONResolverInterfaces(t *testing.T) {
+	var got []string
+	r, err := NewJSONResolver(
+		func(n *Note) error {
+			got = append(got, "Note")
+			return nil
+		},
+		func(o interface{ LenObject() int }) error {
+			got = append(got, fmt.Sprintf("object %T", o))
+			return nil
+		},
+		func(a vocab.ActivityType) error {
+			got = append(got, fmt.Sprintf("activity %T", a))
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Cannot NewJSONResolver: %s", err)
+	}
+	for _, name := range []string{"Note", "Create", "Arrive"} {
+		if err = r.Resolve(map[string]interface{}{"type": name}); err != nil {
+			t.Fatalf("Cannot Resolve %s: %s", name, err)
+		}
+	}
+	if err = r.Resolve(map[string]interface{}{"type": "Person"}); err != ErrNoCallbackMatch {
+		t.Fatalf("Expected ErrNoCallbackMatch, got %v", err)
+	}
+	expected := []string{"Note", "object *streams.Create", "activity *vocab.Arrive"}
+	if diff := deep.Equal(got, expected); diff != nil {
+		t.Fatalf("Unexpected callbacks: %v", diff)
+	}
+	for _, fn := range []interface{}{
+		nil,
+		func(i int) error { return nil },
+		func(a vocab.ActivityType) {},
+		func(a, b vocab.ActivityType) error { return nil },
+	} {
+		if _, err = NewJSONResolver(fn); err == nil {
+			t.Fatalf("Expected an error for a callback of type %T", fn)
+		}
+	}
+}
+
+func TestAs(t *testing.T) {
+	v := &vocab.Note{}
+	var n *Note
+	if !As(v, &n) {
+		t.Fatalf("Expected As to set a *Note")
+	} else if n.Raw() != v {
+		t.Fatalf("Expected the *Note to wrap the *vocab.Note")
+	}
+	var vn *vocab.Note
+	if !As(v, &vn) || vn != v {
+		t.Fatalf("Expected As to set the *vocab.Note")
+	}
+	var o vocab.ObjectType
+	if !As(v, &o) || o != v {
+		t.Fatalf("Expected As to set the vocab.ObjectType")
+	}
+	var c *Create
+	if As(v, &c) {
+		t.Fatalf("Expected As not to set a *Create")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected As to panic for a target that is not a pointer")
+		}
+	}()
+	As(v, *n)
+}
+
+func TestToType(t *testing.T) {
+	m := map[string]interface{}{
+		"type":    "Note",
+		"content": "Hello",
+	}
+	var n *Note
+	if err := ToType(m, &n); err != nil {
+		t.Fatalf("Cannot ToType *Note: %s", err)
+	} else if n.LenContent() != 1 {
+		t.Fatalf("Expected 1 content, got %d", n.LenContent())
+	}
+	var vn *vocab.Note
+	if err := ToType(m, &vn); err != nil {
+		t.Fatalf("Cannot ToType *vocab.Note: %s", err)
+	} else if vn.ContentLen() != 1 {
+		t.Fatalf("Expected 1 content, got %d", vn.ContentLen())
+	}
+	var c *Create
+	if err := ToType(m, &c); err == nil {
+		t.Fatalf("Expected an error converting a Note to a *Create")
+	}
+	if err := ToType(map[string]interface{}{}, &n); err == nil {
+		t.Fatalf("Expected an error converting a value without a type")
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	alice, _ := url.Parse("https://example.com/users/alice")
+	bob, _ := url.Parse("https://example.com/users/bob")
+	note, _ := url.Parse("https://example.com/notes/1")
+	tables := []struct {
+		name     string
+		s        vocab.Serializer
+		expected map[string]interface{}
+	}{
+		{
+			"Note",
+			NewNoteWith("Hello", alice, bob),
+			map[string]interface{}{"type": "Note", "content": "Hello", "attributedTo": alice.String(), "to": bob.String()},
+		},
+		{
+			"Create",
+			NewCreateWith(alice, NewNoteWith("Hello", alice).Raw()),
+			map[string]interface{}{"type": "Create", "actor": alice.String(), "object": map[string]interface{}{"type": "Note", "content": "Hello", "attributedTo": alice.String()}},
+		},
+		{
+			"Delete",
+			NewDeleteWith(alice, note),
+			map[string]interface{}{"type": "Delete", "actor": alice.String(), "object": note.String()},
+		},
+		{
+			"Follow",
+			NewFollowWith(alice, bob),
+			map[string]interface{}{"type": "Follow", "actor": alice.String(), "object": bob.String(), "to": bob.String()},
+		},
+		{
+			"Accept",
+			NewAcceptWith(NewFollowWith(alice, bob)),
+			map[string]interface{}{"type": "Accept", "actor": bob.String(), "to": alice.String(), "object": map[string]interface{}{"type": "Follow", "actor": alice.String(), "object": bob.String(), "to": bob.String()}},
+		},
+		{
+			"Reject",
+			NewRejectWith(NewFollowWith(alice, bob)),
+			map[string]interface{}{"type": "Reject", "actor": bob.String(), "to": alice.String(), "object": map[string]interface{}{"type": "Follow", "actor": alice.String(), "object": bob.String(), "to": bob.String()}},
+		},
+		{
+			"Undo",
+			NewUndoWith(alice, NewLikeWith(alice, note).Raw()),
+			map[string]interface{}{"type": "Undo", "actor": alice.String(), "object": map[string]interface{}{"type": "Like", "actor": alice.String(), "object": note.String()}},
+		},
+		{
+			"Announce",
+			NewAnnounceWith(alice, note, bob),
+			map[string]interface{}{"type": "Announce", "actor": alice.String(), "object": note.String(), "to": bob.String()},
+		},
+	}
+	for _, r := range tables {
+		m, err := r.s.Serialize()
+		if err != nil {
+			t.Errorf("%s: Cannot Serialize: %s", r.name, err)
+		} else if diff := deep.Equal(m, r.expected); diff != nil {
+			t.Errorf("%s: Unexpected serialized form: %v", r.name, diff)
+		}
+	}
+}
+
+func TestGetIdAndTypeName(t *testing.T) {
+	id, _ := url.Parse("https://example.com/notes/1")
+	href, _ := url.Parse("https://example.com/users/alice")
+	n := &vocab.Note{}
+	n.SetId(id)
+	m := &vocab.Mention{}
+	m.SetHref(href)
+	tables := []struct {
+		name     string
+		t        vocab.Type
+		id       *url.URL
+		typeName string
+	}{
+		{"Note", n, id, "Note"},
+		{"Mention", m, href, "Mention"},
+		{"Empty Link", &vocab.Link{}, nil, "Link"},
+		{"nil", nil, nil, ""},
+	}
+	for _, r := range tables {
+		if u, err := GetId(r.t); r.id == nil && err == nil {
+			t.Errorf("%s: Expected an error, got %s", r.name, u)
+		} else if r.id != nil && (err != nil || u.String() != r.id.String()) {
+			t.Errorf("%s: Expected %s, got %s, %v", r.name, r.id, u, err)
+		}
+		if name := GetTypeName(r.t); name != r.typeName {
+			t.Errorf("%s: Expected type name %q, got %q", r.name, r.typeName, name)
+		}
+	}
+	s, err := Deserialize(map[string]interface{}{"type": []interface{}{"toot:Extension", "Note"}})
+	if err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	} else if name := GetTypeName(s.(*Note).Raw()); name != "Note" {
+		t.Fatalf("Expected type name Note, got %q", name)
+	}
+}
+
+// fakeTransport serves the documents by IRI, counting the fetches.
+type fakeTransport struct {
+	docs    map[string]string
+	fetches int
+}
+
+func (f *fakeTransport) Dereference(ctx context.Context, iri *url.URL) ([]byte, error) {
+	f.fetches++
+	if d, ok := f.docs[iri.String()]; ok {
+		return []byte(d), nil
+	}
+	return nil, fmt.Errorf("not found: %s", iri)
+}
+
+// mapCache is a DereferenceCache in a map.
+type mapCache map[string]vocab.Type
+
+func (m mapCache) Get(iri string) (vocab.Type, bool) {
+	v, ok := m[iri]
+	return v, ok
+}
+
+func (m mapCache) Set(iri string, v vocab.Type) {
+	m[iri] = v
+}
+
+func TestDereference(t *testing.T) {
+	tr := &fakeTransport{docs: map[string]string{
+		"https://example.com/notes/1": `{"type":"Note","id":"https://example.com/notes/1","content":"Hello"}`,
+		"https://example.com/links/1": `{"type":"Link","href":"https://example.com/notes/1"}`,
+		"https://example.com/links/2": `{"type":"Link","href":"https://example.com/links/1"}`,
+		"https://example.com/loop":    `{"type":"Link","href":"https://example.com/loop"}`,
+	}}
+	ctx := context.Background()
+	note, _ := url.Parse("https://example.com/notes/1")
+	v, err := Dereference(ctx, note, tr)
+	if err != nil {
+		t.Fatalf("Cannot Dereference: %s", err)
+	} else if n, ok := v.(*vocab.Note); !ok || n.GetContentString(0) != "Hello" {
+		t.Fatalf("Expected the Note, got %v", v)
+	}
+	if v, err = Dereference(ctx, "https://example.com/links/2", tr); err != nil {
+		t.Fatalf("Cannot Dereference Links: %s", err)
+	} else if _, ok := v.(*vocab.Note); !ok {
+		t.Fatalf("Expected the Note the Links refer to, got %T", v)
+	}
+	if _, err = Dereference(ctx, "https://example.com/loop", tr); err != ErrMaxDepth {
+		t.Fatalf("Expected ErrMaxDepth, got %v", err)
+	}
+	inlined := &vocab.Note{}
+	if v, err = Dereference(ctx, inlined, tr); err != nil || v != inlined {
+		t.Fatalf("Expected the inlined value, got %v, %v", v, err)
+	}
+	if _, err = Dereference(ctx, "https://example.com/missing", tr); err == nil {
+		t.Fatalf("Expected an error dereferencing a missing IRI")
+	}
+	d := &Dereferencer{Transport: tr, Cache: make(mapCache), MaxDepth: 1}
+	tr.fetches = 0
+	for i := 0; i < 2; i++ {
+		if _, err = d.Dereference(ctx, note); err != nil {
+			t.Fatalf("Cannot Dereference: %s", err)
+		}
+	}
+	if tr.fetches != 1 {
+		t.Fatalf("Expected 1 fetch with a cache, got %d", tr.fetches)
+	}
+	if _, err = d.Dereference(ctx, "https://example.com/links/1"); err != ErrMaxDepth {
+		t.Fatalf("Expected ErrMaxDepth for a MaxDepth of 1, got %v", err)
+	}
+}
+
+func TestHTTPTransport(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept"), "application/activity+json") {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		} else if r.Header.Get("User-Agent") != "test" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"type":"Note","content":"Hello"}`))
+	}))
+	defer s.Close()
+	v, err := Dereference(context.Background(), s.URL, &HTTPTransport{UserAgent: "test"})
+	if err != nil {
+		t.Fatalf("Cannot Dereference: %s", err)
+	} else if _, ok := v.(*vocab.Note); !ok {
+		t.Fatalf("Expected a Note, got %T", v)
+	}
+	if _, err = Dereference(context.Background(), s.URL, &HTTPTransport{}); err == nil {
+		t.Fatalf("Expected an error for an unsuccessful response")
+	}
+}
+
+func TestLimits(t *testing.T) {
+	l := Limits{MaxBytes: 64, MaxDepth: 3, MaxItems: 3}
+	tables := []struct {
+		name     string
+		json     string
+		exceeded bool
+	}{
+		{"within", `{"type":"Note","tag":[{"name":"a"},{"name":"b"}]}`, false},
+		{"bytes", `{"type":"Note","content":"` + strings.Repeat("a", 64) + `"}`, true},
+		{"depth", `{"a":{"b":{"c":{}}}}`, true},
+		{"array", `{"a":[1,2,3,4]}`, true},
+		{"object", `{"a":1,"b":2,"c":3,"d":4}`, true},
+		{"nested array", `{"a":[[1,2,3,4]]}`, true},
+	}
+	for _, r := range tables {
+		m, err := l.Unmarshal([]byte(r.json))
+		if r.exceeded && !errors.Is(err, ErrLimitExceeded) {
+			t.Errorf("%s: Expected ErrLimitExceeded, got %v", r.name, err)
+		} else if !r.exceeded && (err != nil || m == nil) {
+			t.Errorf("%s: Cannot Unmarshal: %v", r.name, err)
+		}
+	}
+	if _, err := l.Unmarshal([]byte(`{"a":`)); err == nil || errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expected a syntax error, got %v", err)
+	}
+	if _, err := l.Decode(strings.NewReader(strings.Repeat(" ", 65) + "{}")); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expected ErrLimitExceeded reading, got %v", err)
+	}
+	if _, err := (Limits{}).Unmarshal([]byte(`{"a":{"b":{"c":{"d":[1,2,3,4]}}}}`)); err != nil {
+		t.Errorf("Expected no limits, got %v", err)
+	}
+	tr := &fakeTransport{docs: map[string]string{
+		"https://example.com/notes/1": `{"type":"Note","tag":[{},{},{},{}]}`,
+	}}
+	d := &Dereferencer{Transport: tr, Limits: &l}
+	if _, err := d.Dereference(context.Background(), "https://example.com/notes/1"); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expected ErrLimitExceeded dereferencing, got %v", err)
+	}
+}
+
+func TestIterateCollection(t *testing.T) {
+	tr := &fakeTransport{docs: map[string]string{
+		"https://example.com/outbox":        `{"type":"OrderedCollection","id":"https://example.com/outbox","first":"https://example.com/outbox?page=1"}`,
+		"https://example.com/outbox?page=1": `{"type":"OrderedCollectionPage","id":"https://example.com/outbox?page=1","partOf":"https://example.com/outbox","orderedItems":["https://example.com/notes/1",{"type":"Note","content":"Hello"}],"next":"https://example.com/outbox?page=2"}`,
+		"https://example.com/outbox?page=2": `{"type":"OrderedCollectionPage","id":"https://example.com/outbox?page=2","partOf":"https://example.com/outbox","orderedItems":["https://example.com/notes/3"],"next":"https://example.com/outbox?page=1"}`,
+		"https://example.com/other":         `{"type":"CollectionPage","id":"https://example.com/other","partOf":"https://example.com/elsewhere","items":["https://example.com/notes/4"]}`,
+		"https://example.com/followers":     `{"type":"Collection","id":"https://example.com/followers","items":["https://example.com/a","https://example.com/b"],"first":{"type":"CollectionPage","partOf":"https://example.com/followers","items":["https://example.com/c"],"next":"https://example.com/other"}}`,
+	}}
+	ctx := context.Background()
+	var items []interface{}
+	it := IterateCollection(ctx, "https://example.com/outbox", tr)
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Cannot iterate: %s", err)
+	} else if len(items) != 3 {
+		t.Fatalf("Expected 3 items ending at the visited page, got %v", items)
+	} else if u, ok := items[0].(*url.URL); !ok || u.String() != "https://example.com/notes/1" {
+		t.Fatalf("Expected the IRI of the first item, got %v", items[0])
+	} else if _, ok := items[1].(vocab.ObjectType); !ok {
+		t.Fatalf("Expected the inlined second item, got %T", items[1])
+	}
+	items = nil
+	it = IterateCollection(ctx, "https://example.com/followers", tr)
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Cannot iterate: %s", err)
+	} else if len(items) != 3 {
+		t.Fatalf("Expected 3 items ending at the page of another collection, got %v", items)
+	}
+	it = IterateCollection(ctx, "https://example.com/outbox?page=2", tr)
+	if !it.Next() || it.Item().(*url.URL).String() != "https://example.com/notes/3" {
+		t.Fatalf("Expected to start at the page, got %v", it.Item())
+	}
+	it = IterateCollection(ctx, "https://example.com/outbox", tr)
+	it.MaxPages = 1
+	if it.Next() || it.Err() != ErrMaxPages {
+		t.Fatalf("Expected ErrMaxPages, got %v", it.Err())
+	}
+	it = IterateCollection(ctx, "https://example.com/outbox", tr)
+	it.MaxItems = 2
+	for it.Next() {
+	}
+	if it.Err() != ErrMaxItems {
+		t.Fatalf("Expected ErrMaxItems, got %v", it.Err())
+	}
+}
+
+func TestCollectionDecoder(t *testing.T) {
+	doc := `{"@context":"https://www.w3.org/ns/activitystreams","type":"OrderedCollection","id":"https://example.com/outbox","orderedItems":["https://example.com/notes/1",{"type":"Note","content":"Hello"},{"type":"Unregistered"},null],"totalItems":3}`
+	d := NewCollectionDecoder(strings.NewReader(doc))
+	var items []interface{}
+	for d.Next() {
+		items = append(items, d.Item())
+	}
+	if err := d.Err(); err != nil {
+		t.Fatalf("Cannot decode: %s", err)
+	} else if len(items) != 3 {
+		t.Fatalf("Expected 3 items, got %v", items)
+	} else if u, ok := items[0].(*url.URL); !ok || u.String() != "https://example.com/notes/1" {
+		t.Fatalf("Expected the IRI of the first item, got %v", items[0])
+	} else if n, ok := items[1].(*vocab.Note); !ok || n.GetContentString(0) != "Hello" {
+		t.Fatalf("Expected the Note, got %v", items[1])
+	} else if _, ok := items[2].(map[string]interface{}); !ok {
+		t.Fatalf("Expected the generic map form of the unregistered type, got %T", items[2])
+	}
+	c, err := d.Collection()
+	if err != nil {
+		t.Fatalf("Cannot deserialize the collection: %s", err)
+	} else if o, ok := c.(*vocab.OrderedCollection); !ok || o.GetTotalItems() != 3 || o.OrderedItemsLen() != 0 {
+		t.Fatalf("Expected the OrderedCollection without its items, got %v", c)
+	}
+	d = NewCollectionDecoder(strings.NewReader(`{"type":"Collection","items":{"type":"Note"}}`))
+	if !d.Next() {
+		t.Fatalf("Expected the single item, got %v", d.Err())
+	} else if _, ok := d.Item().(*vocab.Note); !ok {
+		t.Fatalf("Expected the Note, got %T", d.Item())
+	} else if d.Next() || d.Err() != nil {
+		t.Fatalf("Expected the end of the document, got %v", d.Err())
+	}
+	d = NewCollectionDecoder(strings.NewReader(`{"type":"Collection","items":["https://example.com/a",`))
+	for d.Next() {
+	}
+	if d.Err() == nil {
+		t.Fatalf("Expected an error for a truncated document")
+	}
+}
+
+func TestPeek(t *testing.T) {
+	p, err := Peek([]byte(`{"@context":"https://www.w3.org/ns/activitystreams","id":"https://example.com/activities/1","type":["Create","Extension"],"actor":[{"type":"Person","id":"https://example.com/actors/1"},"https://example.com/actors/2"],"object":{"type":"Note","id":"https://example.com/notes/1","content":"Hello"}}`))
+	if err != nil {
+		t.Fatalf("Cannot Peek: %s", err)
+	} else if p.Id == nil || p.Id.String() != "https://example.com/activities/1" {
+		t.Fatalf("Expected the id, got %v", p.Id)
+	} else if len(p.Types) != 2 || p.Types[0] != "Create" {
+		t.Fatalf("Expected the types, got %v", p.Types)
+	} else if len(p.Actors) != 2 || p.Actors[0].String() != "https://example.com/actors/1" || p.Actors[1].String() != "https://example.com/actors/2" {
+		t.Fatalf("Expected the actors, got %v", p.Actors)
+	} else if len(p.ObjectIds) != 1 || p.ObjectIds[0].String() != "https://example.com/notes/1" {
+		t.Fatalf("Expected the object id, got %v", p.ObjectIds)
+	}
+	if p, err = Peek([]byte(`{"type":"Like","object":"https://example.com/notes/1"}`)); err != nil {
+		t.Fatalf("Cannot Peek: %s", err)
+	} else if p.Id != nil || len(p.Actors) != 0 || len(p.ObjectIds) != 1 {
+		t.Fatalf("Expected only the object, got %v", p)
+	}
+	if _, err = Peek([]byte(`{"type":1}`)); err == nil {
+		t.Fatalf("Expected an error for an invalid type")
+	}
+	if _, err = Peek([]byte(`{`)); err == nil {
+		t.Fatalf("Expected an error for invalid JSON")
+	}
+}
+
+func TestPublic(t *testing.T) {
+	for _, public := range []string{PublicIRI, "as:Public", "Public"} {
+		n := &vocab.Note{}
+		if err := n.Deserialize(map[string]interface{}{
+			"type": "Note",
+			"to":   "https://example.com/actors/1",
+			"cc":   []interface{}{"https://example.com/actors/1/followers", public},
+		}); err != nil {
+			t.Fatalf("Cannot Deserialize: %s", err)
+		}
+		if !IsPublic(n) {
+			t.Fatalf("Expected %s to be public", public)
+		} else if err := RemovePublic(n); err != nil {
+			t.Fatalf("Cannot RemovePublic: %s", err)
+		} else if IsPublic(n) {
+			t.Fatalf("Expected %s to be removed", public)
+		} else if n.ToLen() != 1 || n.CcLen() != 1 {
+			t.Fatalf("Expected the other addressing to be kept")
+		}
+	}
+	n := &vocab.Note{}
+	if err := AddPublic(n); err != nil {
+		t.Fatalf("Cannot AddPublic: %s", err)
+	} else if err = AddPublic(n); err != nil {
+		t.Fatalf("Cannot AddPublic: %s", err)
+	} else if n.ToLen() != 1 || n.GetToIRI(0).String() != PublicIRI {
+		t.Fatalf("Expected the Public collection once in 'to'")
+	} else if !n.IsPublic() {
+		t.Fatalf("Expected the IsPublic method to agree")
+	}
+	l := &vocab.Link{}
+	if IsPublic(l) {
+		t.Fatalf("Expected a link not to be public")
+	} else if err := AddPublic(l); err == nil {
+		t.Fatalf("Expected an error adding the Public collection to a link")
+	}
+}
+
+func TestGet(t *testing.T) {
+	c := &vocab.Create{}
+	if err := c.Deserialize(map[string]interface{}{
+		"type":  "Create",
+		"actor": "https://example.com/actors/1",
+		"object": []interface{}{
+			map[string]interface{}{
+				"type":         "Note",
+				"content":      "Hello",
+				"attributedTo": []interface{}{"https://example.com/actors/1", map[string]interface{}{"type": "Person", "id": "https://example.com/actors/2"}},
+			},
+			"https://example.com/notes/2",
+		},
+	}); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	ids, err := GetStrings(c, "object", "attributedTo", "id")
+	if err != nil {
+		t.Fatalf("Cannot GetStrings: %s", err)
+	} else if !reflect.DeepEqual(ids, []string{"https://example.com/actors/1", "https://example.com/actors/2"}) {
+		t.Fatalf("Expected the ids of the authors, got %v", ids)
+	}
+	if ids, err = GetStrings(c, "object", "id"); err != nil {
+		t.Fatalf("Cannot GetStrings: %s", err)
+	} else if !reflect.DeepEqual(ids, []string{"https://example.com/notes/2"}) {
+		t.Fatalf("Expected the IRI of the object, got %v", ids)
+	}
+	v, err := Get(c, "object", "content")
+	if err != nil {
+		t.Fatalf("Cannot Get: %s", err)
+	} else if len(v) != 1 || v[0] != "Hello" {
+		t.Fatalf("Expected the content, got %v", v)
+	}
+	if v, err = Get(c, "target", "id"); err != nil {
+		t.Fatalf("Cannot Get: %s", err)
+	} else if len(v) != 0 {
+		t.Fatalf("Expected nothing for a missing property, got %v", v)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	n := &vocab.Note{}
+	if err := n.Deserialize(map[string]interface{}{
+		"type":    "Note",
+		"content": "Hello",
+		"attachment": []interface{}{
+			map[string]interface{}{"type": "Image", "url": "https://example.com/media/1.png"},
+			map[string]interface{}{"type": "Image", "url": "https://example.com/media/2.png"},
+		},
+		"replies": map[string]interface{}{"type": "Collection", "totalItems": float64(3)},
+	}); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	var paths []string
+	err := Walk(n, func(path []string, v interface{}) error {
+		if path[0] == "replies" {
+			return SkipValue
+		}
+		paths = append(paths, strings.Join(path, "."))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Cannot Walk: %s", err)
+	}
+	expected := []string{"attachment", "attachment.type", "attachment.url", "attachment", "attachment.type", "attachment.url", "content", "type"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Expected %v, got %v", expected, paths)
+	}
+	if err = Walk(n, func(path []string, v interface{}) error { return fmt.Errorf("stop") }); err == nil {
+		t.Fatalf("Expected the error of the function")
+	}
+
+	v, err := Rewrite(n, func(path []string, v interface{}) (interface{}, error) {
+		if s, ok := v.(string); ok && strings.Join(path, ".") == "attachment.url" {
+			return strings.Replace(s, "https://example.com/", "https://cache.example.org/", 1), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		t.Fatalf("Cannot Rewrite: %s", err)
+	}
+	urls, err := GetStrings(v, "attachment", "url")
+	if err != nil {
+		t.Fatalf("Cannot GetStrings: %s", err)
+	} else if !reflect.DeepEqual(urls, []string{"https://cache.example.org/media/1.png", "https://cache.example.org/media/2.png"}) {
+		t.Fatalf("Expected the rewritten URLs, got %v", urls)
+	}
+	if urls, _ = GetStrings(n, "attachment", "url"); urls[0] != "https://example.com/media/1.png" {
+		t.Fatalf("Expected the original to be unchanged, got %v", urls)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	deserialize := func(m map[string]interface{}) vocab.Type {
+		n := &vocab.Note{}
+		if err := n.Deserialize(m); err != nil {
+			t.Fatalf("Cannot Deserialize: %s", err)
+		}
+		return n
+	}
+	old := deserialize(map[string]interface{}{
+		"type":      "Note",
+		"id":        "https://example.com/notes/1",
+		"content":   "Helo",
+		"summary":   "Greetings",
+		"to":        []interface{}{"https://example.com/actors/1", "https://example.com/actors/2"},
+		"location":  map[string]interface{}{"type": "Place", "name": "Home", "latitude": float64(1)},
+		"inReplyTo": map[string]interface{}{"type": "Note", "id": "https://example.com/notes/0"},
+	})
+	new := deserialize(map[string]interface{}{
+		"type":      "Note",
+		"id":        "https://example.com/notes/1",
+		"content":   "Hello",
+		"name":      "A note",
+		"to":        []interface{}{"https://example.com/actors/1"},
+		"location":  map[string]interface{}{"type": "Place", "name": "Work", "latitude": float64(1)},
+		"inReplyTo": map[string]interface{}{"type": "Note", "id": "https://example.com/notes/2"},
+	})
+	changes, err := Diff(old, new)
+	if err != nil {
+		t.Fatalf("Cannot Diff: %s", err)
+	}
+	expected := []Change{
+		{Path: []string{"content"}, Kind: Modified, Old: "Helo", New: "Hello"},
+		{Path: []string{"inReplyTo"}, Kind: Modified, Old: map[string]interface{}{"type": "Note", "id": "https://example.com/notes/0"}, New: map[string]interface{}{"type": "Note", "id": "https://example.com/notes/2"}},
+		{Path: []string{"location", "name"}, Kind: Modified, Old: "Home", New: "Work"},
+		{Path: []string{"name"}, Kind: Added, New: "A note"},
+		{Path: []string{"summary"}, Kind: Removed, Old: "Greetings"},
+		{Path: []string{"to"}, Kind: Modified, Old: []interface{}{"https://example.com/actors/1", "https://example.com/actors/2"}, New: "https://example.com/actors/1"},
+	}
+	if diff := deep.Equal(changes, expected); diff != nil {
+		t.Fatalf("Unexpected changes: %v", diff)
+	}
+	if changes, err = Diff(old, old); err != nil {
+		t.Fatalf("Cannot Diff: %s", err)
+	} else if len(changes) != 0 {
+		t.Fatalf("Expected no changes, got %v", changes)
+	}
+	if Modified.String() != "Modified" {
+		t.Fatalf("Expected the name of the ChangeKind, got %s", Modified)
+	}
+}
+
+func TestContentHash(t *testing.T) {
+	hash := func(b string) [32]byte {
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(b), &m); err != nil {
+			t.Fatal(err)
+		}
+		c := &vocab.Create{}
+		if err := c.Deserialize(m); err != nil {
+			t.Fatalf("Cannot Deserialize: %s", err)
+		}
+		return ContentHash(c)
+	}
+	h := hash(`{"@context":"https://www.w3.org/ns/activitystreams","type":"Create","id":"https://example.com/activities/1","actor":"https://example.com/actors/1","object":{"type":"Note","content":"Hello"}}`)
+	if h == ([32]byte{}) {
+		t.Fatalf("Expected a hash")
+	}
+	same := hash(`{"object":{"content":"Hello","type":"Note"},"actor":"https://example.com/actors/1","id":"https://example.com/activities/1","type":"Create","@context":["https://www.w3.org/ns/activitystreams",{"toot":"http://joinmastodon.org/ns#"}]}`)
+	if h != same {
+		t.Errorf("Expected the same hash regardless of the order of the properties and the context")
+	}
+	other := hash(`{"type":"Create","id":"https://example.com/activities/1","actor":"https://example.com/actors/1","object":{"type":"Note","content":"Goodbye"}}`)
+	if h == other {
+		t.Errorf("Expected a different hash for different content")
+	}
+}
+
+func BenchmarkPeek(b *testing.B) {
+	payload := []byte(`{"@context":"https://www.w3.org/ns/activitystreams","id":"https://example.com/activities/1","type":"Create","actor":"https://example.com/actors/1","to":["https://www.w3.org/ns/activitystreams#Public"],"object":{"type":"Note","id":"https://example.com/notes/1","content":"Hello","attributedTo":"https://example.com/actors/1"}}`)
+	for i := 0; i < b.N; i++ {
+		if _, err := Peek(payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestDeserializeStrict(t *testing.T) {
+	m := map[string]interface{}{
+		"type":   "Create",
+		"actor":  "https://example.com/users/alice",
+		"object": map[string]interface{}{"type": "Note", "content": "Hello"},
+	}
+	if s, err := DeserializeStrict(m); err != nil {
+		t.Fatalf("Expected a valid Create, got %s", err)
+	} else if _, ok := s.(*Create); !ok {
+		t.Fatalf("Expected a *Create, got %T", s)
+	}
+	m["unexpected"] = true
+	if _, err := Deserialize(m); err != nil {
+		t.Fatalf("Expected Deserialize to keep the unknown property, got %s", err)
+	}
+	_, err := DeserializeStrict(m)
+	if errs, ok := err.(vocab.ValidationErrors); !ok || len(errs) != 1 {
+		t.Fatalf("Expected the unknown property, got %v", err)
+	} else if u, ok := errs[0].(*vocab.UnknownPropertyError); !ok || u.Property != "unexpected" {
+		t.Fatalf("Expected an UnknownPropertyError, got %v", errs[0])
+	}
+}
+
 func GetJSONDiff(str1, str2 []byte) ([]string, error) {
 	var i1 interface{}
 	var i2 interface{}
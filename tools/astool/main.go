@@ -0,0 +1,352 @@
+// Command astool parses ActivityStreams vocabulary specifications with the
+// experimental tools/exp parser and renders them with its backends, such as
+// documentation, GraphQL, OpenAPI, Protocol Buffers, and TypeScript.
+//
+// Specifications are read from files or URLs with -spec, or chosen among the
+// bundled ones with -vocab:
+//
+//	astool -vocab activitystreams -profile docs,typescript -out gen
+//	astool -vocab forgefed -package forge -profile all -out forgefed
+//	astool -spec https://example.com/ns -offline=false -cache_dir .cache -dry-run
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/go-fed/activity/tools/exp/docs"
+	"github.com/go-fed/activity/tools/exp/graphql"
+	"github.com/go-fed/activity/tools/exp/openapi"
+	"github.com/go-fed/activity/tools/exp/proto"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	"github.com/go-fed/activity/tools/exp/typescript"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// specs are the -spec flags, which may be repeated.
+type specs []string
+
+func (s *specs) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *specs) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+var (
+	specFlags specs
+	vocabs    = flag.String("vocab", "", "Comma-separated names of bundled vocabularies to generate: "+strings.Join(bundledNames(), ", "))
+	profile   = flag.String("profile", "docs", "Comma-separated backends or profiles to generate: "+strings.Join(profileNames(), ", "))
+	out       = flag.String("out", ".", "Directory to write the generated files in; with several vocabularies, each is in a subdirectory named after it")
+	pkg       = flag.String("package", "vocab", "Name of the generated package, used for the Protocol Buffers package, the OpenAPI title, and the prefix of the JSON-LD context")
+	version   = flag.String("api_version", "1.0.0", "Version of the generated OpenAPI document")
+	offline   = flag.Bool("offline", true, "Use the bundled copies of specifications instead of fetching them")
+	cacheDir  = flag.String("cache_dir", "", "Directory to cache fetched specifications in, revalidating them on later runs; empty caches nothing")
+	dryRun    = flag.Bool("dry-run", false, "Print the files that would be generated, and their sizes, without writing them")
+	report    = flag.Bool("report", false, "Print which ontologies and terms each vocabulary used, and the terms no ontology handled")
+)
+
+func init() {
+	flag.Var(&specFlags, "spec", "File or URL of a vocabulary specification to generate; may be repeated")
+}
+
+// bundled are the names of the vocabularies whose specifications are bundled.
+var bundled = map[string]string{
+	"activitystreams": rdf.ActivityStreamsSpec,
+	"security-v1":     rdf.SecurityV1Spec,
+	"toot":            rdf.TootSpec,
+	"forgefed":        rdf.ForgeFedSpec,
+}
+
+// bundledNames returns the names of the bundled vocabularies, sorted.
+func bundledNames() []string {
+	var n []string
+	for k := range bundled {
+		n = append(n, k)
+	}
+	sort.Strings(n)
+	return n
+}
+
+// file is a generated file, named relative to the output directory of its
+// vocabulary.
+type file struct {
+	Name    string
+	Content []byte
+}
+
+// backend renders a parsed vocabulary.
+type backend func(v *rdf.ParsedVocabulary) ([]file, error)
+
+// backends are the backends by name.
+var backends = map[string]backend{
+	"docs": func(v *rdf.ParsedVocabulary) ([]file, error) {
+		return docsFiles(v, docs.Markdown, "docs")
+	},
+	"html": func(v *rdf.ParsedVocabulary) ([]file, error) {
+		return docsFiles(v, docs.HTML, "html")
+	},
+	"graphql": func(v *rdf.ParsedVocabulary) ([]file, error) {
+		f, err := graphql.Generate(v)
+		if err != nil {
+			return nil, err
+		}
+		return []file{{f.Name, f.Content}}, nil
+	},
+	"openapi": func(v *rdf.ParsedVocabulary) ([]file, error) {
+		f, err := openapi.Generate(v, *pkg, *version)
+		if err != nil {
+			return nil, err
+		}
+		return []file{{f.Name, f.Content}}, nil
+	},
+	"proto": func(v *rdf.ParsedVocabulary) ([]file, error) {
+		f, err := proto.Generate(v, *pkg)
+		if err != nil {
+			return nil, err
+		}
+		return []file{{f.Name, f.Content}}, nil
+	},
+	"typescript": func(v *rdf.ParsedVocabulary) ([]file, error) {
+		f, err := typescript.Generate(v)
+		if err != nil {
+			return nil, err
+		}
+		return []file{{f.Name, f.Content}}, nil
+	},
+	"context": func(v *rdf.ParsedVocabulary) ([]file, error) {
+		c, err := v.Context(*pkg)
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.MarshalIndent(c, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return []file{{"context.jsonld", append(b, '\n')}}, nil
+	},
+}
+
+// profiles are named sets of backends.
+var profiles = map[string][]string{
+	"schemas": {"graphql", "openapi", "proto", "typescript"},
+	"all":     {"docs", "html", "graphql", "openapi", "proto", "typescript", "context"},
+}
+
+// profileNames returns the names of the backends and profiles, sorted.
+func profileNames() []string {
+	var n []string
+	for k := range backends {
+		n = append(n, k)
+	}
+	for k := range profiles {
+		n = append(n, k)
+	}
+	sort.Strings(n)
+	return n
+}
+
+// docsFiles renders the documentation in the format, in the directory.
+func docsFiles(v *rdf.ParsedVocabulary, f docs.Format, dir string) ([]file, error) {
+	pages, err := docs.Generate(v, f)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]file, 0, len(pages))
+	for _, p := range pages {
+		files = append(files, file{path.Join(dir, p.Name), p.Content})
+	}
+	return files, nil
+}
+
+// selectBackends returns the names of the backends of the comma-separated
+// backends and profiles, without duplicates and in the order given.
+func selectBackends(s string) ([]string, error) {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(n string) {
+		if !seen[n] {
+			seen[n] = true
+			names = append(names, n)
+		}
+	}
+	for _, n := range strings.Split(s, ",") {
+		n = strings.TrimSpace(n)
+		if _, ok := backends[n]; ok {
+			add(n)
+		} else if p, ok := profiles[n]; ok {
+			for _, b := range p {
+				add(b)
+			}
+		} else {
+			return nil, fmt.Errorf("unknown backend or profile %q; expected one of %s", n, strings.Join(profileNames(), ", "))
+		}
+	}
+	return names, nil
+}
+
+// input is a specification to generate.
+type input struct {
+	// Name is the subdirectory its files are generated in when there are
+	// several inputs.
+	Name string
+	// Source is the file, URL, or bundled specification URI.
+	Source string
+}
+
+// inputs returns the specifications of the -spec and -vocab flags.
+func inputs() ([]input, error) {
+	var in []input
+	for _, s := range specFlags {
+		name := strings.TrimSuffix(s, "#")
+		name = path.Base(strings.TrimSuffix(name, "/"))
+		name = strings.TrimSuffix(name, path.Ext(name))
+		in = append(in, input{Name: name, Source: s})
+	}
+	if len(*vocabs) > 0 {
+		for _, n := range strings.Split(*vocabs, ",") {
+			n = strings.TrimSpace(n)
+			uri, ok := bundled[n]
+			if !ok {
+				return nil, fmt.Errorf("unknown vocabulary %q; expected one of %s", n, strings.Join(bundledNames(), ", "))
+			}
+			in = append(in, input{Name: n, Source: uri})
+		}
+	}
+	if len(in) == 0 {
+		return nil, fmt.Errorf("no specifications given; use -spec or -vocab")
+	}
+	seen := make(map[string]bool)
+	for _, i := range in {
+		if seen[i.Name] {
+			return nil, fmt.Errorf("several specifications are named %q", i.Name)
+		}
+		seen[i.Name] = true
+	}
+	return in, nil
+}
+
+// isURL determines whether the source is fetched rather than read from a file.
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// load reads the specification from its file or URL.
+func load(l *rdf.ContextLoader, s string) (rdf.JSONLD, error) {
+	if isURL(s) {
+		return l.Load(s)
+	}
+	b, err := ioutil.ReadFile(s)
+	if err != nil {
+		return nil, err
+	}
+	var j rdf.JSONLD
+	if err = json.Unmarshal(b, &j); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %s", s, err)
+	}
+	return j, nil
+}
+
+// ontologies are the ontologies that specifications are parsed with.
+func ontologies() []rdf.Ontology {
+	return []rdf.Ontology{
+		&rdf.RDFOntology{Package: *pkg},
+		&rdf.XSDOntology{},
+		&rdf.ForgeFedOntology{},
+	}
+}
+
+// generate parses the specification and renders it with the backends.
+func generate(l *rdf.ContextLoader, in input, names []string) ([]file, error) {
+	j, err := load(l, in.Source)
+	if err != nil {
+		return nil, err
+	}
+	r := &rdf.RDFRegistry{}
+	for _, o := range ontologies() {
+		if err = r.AddOntology(o.String(), o); err != nil {
+			return nil, err
+		}
+	}
+	v, err := rdf.ParseVocabulary(r, j)
+	if *report {
+		fmt.Fprintf(os.Stderr, "%s:\n%s\n", in.Source, r.Report())
+	}
+	if err != nil {
+		return nil, err
+	}
+	var files []file
+	for _, n := range names {
+		f, err := backends[n](v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", n, err)
+		}
+		files = append(files, f...)
+	}
+	return files, nil
+}
+
+// write writes the file in the directory, or prints what would be written on a
+// dry run.
+func write(dir string, f file) error {
+	name := filepath.Join(dir, filepath.FromSlash(f.Name))
+	if *dryRun {
+		fmt.Printf("%s (%d bytes)\n", name, len(f.Content))
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(name, f.Content, 0666)
+}
+
+func run() error {
+	names, err := selectBackends(*profile)
+	if err != nil {
+		return err
+	}
+	in, err := inputs()
+	if err != nil {
+		return err
+	}
+	l := &rdf.ContextLoader{PreferBundled: *offline}
+	if !*offline {
+		l.Fetch = (&rdf.HTTPFetcher{CacheDir: *cacheDir}).Fetch
+	}
+	for _, i := range in {
+		files, err := generate(l, i, names)
+		if err != nil {
+			return fmt.Errorf("%s: %s", i.Source, err)
+		}
+		dir := *out
+		if len(in) > 1 {
+			dir = filepath.Join(dir, i.Name)
+		}
+		for _, f := range files {
+			if err = write(dir, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "astool: unexpected arguments %s\n", strings.Join(flag.Args(), " "))
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "astool: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update_golden", false, "Write the golden files of TestGenerate instead of comparing against them")
+
+// TestGenerate parses a trimmed copy of the ForgeFed context, renders it with
+// every backend, and compares the files against their golden files. Its forge
+// alias loads the entire ForgeFed ontology. Run it with -update_golden to write
+// the golden files after an intentional change.
+func TestGenerate(t *testing.T) {
+	names, err := selectBackends("all")
+	if err != nil {
+		t.Fatalf("selectBackends returned error: %s", err)
+	}
+	l := &rdf.ContextLoader{PreferBundled: true}
+	files, err := generate(l, input{Name: "forge", Source: filepath.Join("testdata", "forge.jsonld")}, names)
+	if err != nil {
+		t.Fatalf("generate returned error: %s", err)
+	}
+	dir := filepath.Join("testdata", "golden")
+	for _, f := range files {
+		golden := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if *updateGolden {
+			if err = os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+				t.Fatalf("Cannot create golden directory: %s", err)
+			}
+			if err = ioutil.WriteFile(golden, f.Content, 0644); err != nil {
+				t.Errorf("Cannot write golden file: %s", err)
+			}
+			continue
+		}
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Errorf("%s: Cannot read golden file: %s", f.Name, err)
+		} else if !bytes.Equal(expected, f.Content) {
+			t.Errorf("%s: Expected generated file to match %s, got:\n%s", f.Name, golden, f.Content)
+		}
+	}
+	if *updateGolden {
+		return
+	}
+	n := 0
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			n++
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Cannot read golden directory: %s", err)
+	} else if n != len(files) {
+		t.Errorf("Expected %d generated files, got %d", n, len(files))
+	}
+}
@@ -0,0 +1,154 @@
+// Code generated from a parsed vocabulary. DO NOT EDIT.
+
+/** An IRI identifying a value. */
+export type IRI = string;
+
+/** A date and time in the format of RFC 3339. */
+export type DateTime = string;
+
+/** A duration in the format of ISO 8601. */
+export type Duration = string;
+
+/** A single value, or an array of values. */
+export type OneOrMore<T> = T | T[];
+
+/** The values of a property in each language, keyed by the language. */
+export type LanguageMap = { [language: string]: string };
+
+/** A named reference to a version of a Repository, typically used to commit changes in parallel to other development. */
+export interface Branch {
+  "@context"?: unknown;
+  /** The IRI identifying the Branch. */
+  id?: IRI;
+  /** The types of the Branch, including "Branch". */
+  type?: OneOrMore<string>;
+  /** The full name of the reference of the Branch, such as refs/heads/main for Git. */
+  ref?: string;
+  [property: string]: unknown;
+}
+
+/** A named set of changes in the history of a Repository. */
+export interface Commit {
+  "@context"?: unknown;
+  /** The IRI identifying the Commit. */
+  id?: IRI;
+  /** The types of the Commit, including "Commit". */
+  type?: OneOrMore<string>;
+  /** The time at which the Commit was created, which may differ from its 'published' time, when its changes were authored. */
+  committed?: DateTime;
+  /** The actor that created the Commit, which may differ from its 'attributedTo', the author of its changes. */
+  committedBy?: CommittedByProperty;
+  /** The paths of the files the Commit adds. */
+  filesAdded?: OneOrMore<string>;
+  /** The paths of the files the Commit modifies. */
+  filesModified?: OneOrMore<string>;
+  /** The paths of the files the Commit removes. */
+  filesRemoved?: OneOrMore<string>;
+  /** The hash identifying the Commit, such as its SHA-1 for Git. */
+  hash?: string;
+  [property: string]: unknown;
+}
+
+/** Indicates that new content has been pushed to a Repository. Its 'object' is an OrderedCollection of the Commits pushed, its 'target' the Branch, and its 'context' the Repository. */
+export interface Push {
+  "@context"?: unknown;
+  /** The IRI identifying the Push. */
+  id?: IRI;
+  /** The types of the Push, including "Push". */
+  type?: OneOrMore<string>;
+  /** The hash of the head of the Branch after the Push. */
+  hashAfter?: string;
+  /** The hash of the head of the Branch before the Push. */
+  hashBefore?: string;
+  [property: string]: unknown;
+}
+
+/** A version control repository, an actor that receives the pushes and patches sent to it. */
+export interface Repository {
+  "@context"?: unknown;
+  /** The IRI identifying the Repository. */
+  id?: IRI;
+  /** The types of the Repository, including "Repository". */
+  type?: OneOrMore<string>;
+  /** The collection of the forks of the Repository. */
+  forks?: ForksProperty;
+  /** The collection of the actors responsible for the Repository. */
+  team?: TeamProperty;
+  /** The actor tracking the Tickets of the Repository, which may be the Repository itself. */
+  ticketsTrackedBy?: TicketsTrackedByProperty;
+  [property: string]: unknown;
+}
+
+/** An item that requires work, such as a bug report or a feature request, tracked by a TicketTracker. */
+export interface Ticket {
+  "@context"?: unknown;
+  /** The IRI identifying the Ticket. */
+  id?: IRI;
+  /** The types of the Ticket, including "Ticket". */
+  type?: OneOrMore<string>;
+  /** The actor working on the Ticket. */
+  assignedTo?: AssignedToProperty;
+  /** The Tickets that depend on this Ticket being resolved first. */
+  dependedBy?: OneOrMore<DependedByProperty>;
+  /** The Tickets that must be resolved before this Ticket. */
+  dependsOn?: OneOrMore<DependsOnProperty>;
+  /** Whether the work the Ticket requires is done. */
+  isResolved?: boolean;
+  /** The time at which the Ticket was resolved. */
+  resolved?: DateTime;
+  /** The actor that resolved the Ticket. */
+  resolvedBy?: ResolvedByProperty;
+  [property: string]: unknown;
+}
+
+/** A Relationship whose 'subject' is a Ticket that depends on its 'object', another Ticket, being resolved first. */
+export interface TicketDependency {
+  "@context"?: unknown;
+  /** The IRI identifying the TicketDependency. */
+  id?: IRI;
+  /** The types of the TicketDependency, including "TicketDependency". */
+  type?: OneOrMore<string>;
+  [property: string]: unknown;
+}
+
+/** An actor managing a list of Tickets, such as the issues of a project. */
+export interface TicketTracker {
+  "@context"?: unknown;
+  /** The IRI identifying the TicketTracker. */
+  id?: IRI;
+  /** The types of the TicketTracker, including "TicketTracker". */
+  type?: OneOrMore<string>;
+  /** The Repositories whose Tickets the TicketTracker tracks. */
+  tracksTicketsFor?: OneOrMore<TracksTicketsForProperty>;
+  [property: string]: unknown;
+}
+
+/** A value of the 'assignedTo' property. */
+export type AssignedToProperty = IRI;
+
+/** A value of the 'committedBy' property. */
+export type CommittedByProperty = IRI;
+
+/** A value of the 'dependedBy' property. */
+export type DependedByProperty = IRI | Ticket;
+
+/** A value of the 'dependsOn' property. */
+export type DependsOnProperty = IRI | Ticket;
+
+/** A value of the 'earlyItems' property. */
+export type EarlyItemsProperty = IRI;
+
+/** A value of the 'forks' property. */
+export type ForksProperty = IRI;
+
+/** A value of the 'resolvedBy' property. */
+export type ResolvedByProperty = IRI;
+
+/** A value of the 'team' property. */
+export type TeamProperty = IRI;
+
+/** A value of the 'ticketsTrackedBy' property. */
+export type TicketsTrackedByProperty = IRI | Repository | TicketTracker;
+
+/** A value of the 'tracksTicketsFor' property. */
+export type TracksTicketsForProperty = IRI | Repository;
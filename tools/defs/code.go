@@ -48,6 +48,9 @@ type InterfaceDef struct {
 	// Body is ignored
 	O []string // Other interfaceDef Typenames
 	F []*FunctionDef
+	// ImplementedBy is the type asserted at compile time to implement the
+	// interface through its pointer, if any.
+	ImplementedBy string
 }
 
 func (i *InterfaceDef) Generate() string {
@@ -77,6 +80,9 @@ func (i *InterfaceDef) Generate() string {
 		b.WriteString("\n")
 	}
 	b.WriteString("}\n")
+	if len(i.ImplementedBy) > 0 {
+		b.WriteString(fmt.Sprintf("\nvar _ %s = (*%s)(nil)\n", i.Typename, i.ImplementedBy))
+	}
 	return b.String()
 }
 
@@ -88,6 +94,10 @@ type PackageDef struct {
 	F       []*FunctionDef
 	I       []*InterfaceDef
 	Raw     string
+
+	// ImportAliases names the imports, keyed by their path, that are not
+	// referred to by the name of their package.
+	ImportAliases map[string]string
 }
 
 func (p *PackageDef) Generate() string {
@@ -96,7 +106,11 @@ func (p *PackageDef) Generate() string {
 	if len(p.Imports) > 0 {
 		b.WriteString("import (\n")
 		for _, i := range p.Imports {
-			b.WriteString(fmt.Sprintf("\"%s\"\n", i))
+			if alias, ok := p.ImportAliases[i]; ok {
+				b.WriteString(fmt.Sprintf("%s \"%s\"\n", alias, i))
+			} else {
+				b.WriteString(fmt.Sprintf("\"%s\"\n", i))
+			}
 		}
 		b.WriteString(")\n\n")
 	}
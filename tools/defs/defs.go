@@ -8,6 +8,9 @@ import (
 const (
 	baseURI           = "https://www.w3.org/ns/activitystreams#"
 	PublicActivityPub = "https://www.w3.org/ns/activitystreams#Public"
+	// VocabularyVersion is the version of the specification the
+	// definitions are taken from.
+	VocabularyVersion = "https://www.w3.org/TR/2017/REC-activitystreams-vocabulary-20170523/"
 )
 
 var (
@@ -379,6 +382,12 @@ var (
 		Notes:   "A specialized Link that represents an @mention.",
 		Extends: []*Type{linkType},
 	}
+	hashtagExtendedType = &Type{
+		Name:    "Hashtag",
+		URI:     extendedBaseURI + "Hashtag",
+		Notes:   "A specialized Link that represents a #hashtag, whose 'name' is the hashtag and whose 'href' is the page of the objects tagged with it. It is in the namespace but not the specification of the ActivityStreams Vocabulary, and is found in the 'tag' of the objects of nearly every microblogging server.",
+		Extends: []*Type{linkType},
+	}
 
 	AllExtendedTypes = []*Type{
 		acceptExtendedType,
@@ -427,6 +436,7 @@ var (
 		profileExtendedType,
 		tombstoneExtendedType,
 		mentionExtendedType,
+		hashtagExtendedType,
 	}
 )
 
@@ -1207,6 +1217,7 @@ const (
 var (
 	xsdDateTimeValueType = &ValueType{
 		Name:           "dateTime",
+		TextName:       "DateTime",
 		URI:            xsdBaseURI + "dateTime",
 		DefinitionType: "*time.Time",
 		Zero:           "time.Time{}",
@@ -1217,19 +1228,14 @@ var (
 			Return:  []*FunctionVarDef{{"t", "*time.Time"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
-				b.WriteString("var tmp time.Time\n")
+				// parseDateTime is generated with the vocabulary, so
+				// that how leniently values are parsed is configurable.
 				b.WriteString("if s, ok := v.(string); ok {\n")
-				b.WriteString("tmp, err = time.Parse(time.RFC3339, s)\n")
-				b.WriteString("if err != nil {\n")
-				b.WriteString("tmp, err = time.Parse(\"2006-01-02T15:04Z07:00\", s)\n")
+				b.WriteString("tmp, err := parseDateTime(s)\n")
 				b.WriteString("if err != nil {\n")
-				b.WriteString("err = fmt.Errorf(\"%s cannot be interpreted as xsd:dateTime\", s)\n")
-				b.WriteString("} else {\n")
-				b.WriteString("t = &tmp\n")
+				b.WriteString("return nil, err\n")
 				b.WriteString("}\n")
-				b.WriteString("} else {\n")
 				b.WriteString("t = &tmp\n")
-				b.WriteString("}\n")
 				b.WriteString("} else {\n")
 				b.WriteString("err = fmt.Errorf(\"%v cannot be interpreted as a string for xsd:dateTime\", v)\n")
 				b.WriteString("}\n")
@@ -1244,7 +1250,9 @@ var (
 			Return:  []*FunctionVarDef{{"s", "string"}},
 			Body: func() string {
 				var b bytes.Buffer
-				b.WriteString("s = t.Format(time.RFC3339)\n")
+				// formatDateTime is generated with the vocabulary, so
+				// that the offsets of parsed values are kept.
+				b.WriteString("s = formatDateTime(t)\n")
 				b.WriteString("return\n")
 				return b.String()
 			},
@@ -1263,6 +1271,7 @@ var (
 			Return:  []*FunctionVarDef{{"b", "*bool"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
+				b.WriteString("v = stringScalar(v)\n")
 				b.WriteString("if bv, ok := v.(bool); ok {\n")
 				b.WriteString("b = &bv\n")
 				b.WriteString("} else if bv, ok := v.(float64); ok {\n")
@@ -1345,6 +1354,7 @@ var (
 			Return:  []*FunctionVarDef{{"f", "*float64"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
+				b.WriteString("v = stringScalar(v)\n")
 				b.WriteString("if fv, ok := v.(float64); ok {\n")
 				b.WriteString("f = &fv\n")
 				b.WriteString("} else {\n")
@@ -1437,6 +1447,7 @@ var (
 	}
 	xsdDurationValueType = &ValueType{
 		Name:           "duration",
+		TextName:       "Duration",
 		URI:            xsdBaseURI + "duration",
 		DefinitionType: "*time.Duration",
 		Zero:           "0",
@@ -1447,64 +1458,12 @@ var (
 			Return:  []*FunctionVarDef{{"d", "*time.Duration"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
+				// parseDuration and formatDuration are generated with
+				// the vocabulary, which exports them.
 				b.WriteString("if sv, ok := v.(string); ok {\n")
-				b.WriteString("isNeg := false\n")
-				b.WriteString("if sv[0] == '-' {\n")
-				b.WriteString("isNeg = true\n")
-				b.WriteString("sv = sv[1:]\n")
-				b.WriteString("}\n")
-				b.WriteString("if sv[0] != 'P' {\n")
-				b.WriteString("err = fmt.Errorf(\"'%s' malformed: missing 'P' for xsd:duration\", sv)\n")
-				b.WriteString("return\n")
-				b.WriteString("}\n")
-				b.WriteString(`re := regexp.MustCompile("P(\\d*Y)?(\\d*M)?(\\d*D)?(T(\\d*H)?(\\d*M)?(\\d*S)?)?")`)
-				b.WriteString("\n")
-				b.WriteString("res := re.FindStringSubmatch(sv)\n")
-				b.WriteString("var dur time.Duration\n")
-				b.WriteString("nYear := res[1]\n")
-				b.WriteString("if len(nYear) > 0 {\n")
-				b.WriteString("nYear = nYear[:len(nYear)-1]\n")
-				b.WriteString("vYear, err := strconv.ParseInt(nYear, 10, 64)\n")
-				b.WriteString("if err != nil {\nreturn nil, err\n}\n")
-				b.WriteString("dur += time.Duration(vYear) * time.Hour * 8760 // Hours per 365 days -- no way to count leap years here.\n")
-				b.WriteString("}\n")
-				b.WriteString("nMonth := res[2]\n")
-				b.WriteString("if len(nMonth) > 0 {\n")
-				b.WriteString("nMonth = nMonth[:len(nMonth)-1]\n")
-				b.WriteString("vMonth, err := strconv.ParseInt(nMonth, 10, 64)\n")
-				b.WriteString("if err != nil {\nreturn nil, err\n}\n")
-				b.WriteString("dur += time.Duration(vMonth) * time.Hour * 720 // Hours per 30 days -- no way to tell if these months span 31 days, 28, or 29 each.\n")
-				b.WriteString("}\n")
-				b.WriteString("nDay := res[3]\n")
-				b.WriteString("if len(nDay) > 0 {\n")
-				b.WriteString("nDay = nDay[:len(nDay)-1]\n")
-				b.WriteString("vDay, err := strconv.ParseInt(nDay, 10, 64)\n")
-				b.WriteString("if err != nil {\nreturn nil, err\n}\n")
-				b.WriteString("dur += time.Duration(vDay) * time.Hour * 24\n")
-				b.WriteString("}\n")
-				b.WriteString("nHour := res[5]\n")
-				b.WriteString("if len(nHour) > 0 {\n")
-				b.WriteString("nHour = nHour[:len(nHour)-1]\n")
-				b.WriteString("vHour, err := strconv.ParseInt(nHour, 10, 64)\n")
-				b.WriteString("if err != nil {\nreturn nil, err\n}\n")
-				b.WriteString("dur += time.Duration(vHour) * time.Hour\n")
-				b.WriteString("}\n")
-				b.WriteString("nMinute := res[6]\n")
-				b.WriteString("if len(nMinute) > 0 {\n")
-				b.WriteString("nMinute = nMinute[:len(nMinute)-1]\n")
-				b.WriteString("vMinute, err := strconv.ParseInt(nMinute, 10, 64)\n")
-				b.WriteString("if err != nil {\nreturn nil, err\n}\n")
-				b.WriteString("dur += time.Duration(vMinute) * time.Minute\n")
-				b.WriteString("}\n")
-				b.WriteString("nSecond := res[7]\n")
-				b.WriteString("if len(nSecond) > 0 {\n")
-				b.WriteString("nSecond = nSecond[:len(nSecond)-1]\n")
-				b.WriteString("vSecond, err := strconv.ParseInt(nSecond, 10, 64)\n")
-				b.WriteString("if err != nil {\nreturn nil, err\n}\n")
-				b.WriteString("dur += time.Duration(vSecond) * time.Second\n")
-				b.WriteString("}\n")
-				b.WriteString("if isNeg {\n")
-				b.WriteString("dur *= -1\n")
+				b.WriteString("dur, err := parseDuration(sv)\n")
+				b.WriteString("if err != nil {\n")
+				b.WriteString("return nil, err\n")
 				b.WriteString("}\n")
 				b.WriteString("d = &dur\n")
 				b.WriteString("} else {\n")
@@ -1520,48 +1479,7 @@ var (
 			Args:    []*FunctionVarDef{{"d", "time.Duration"}},
 			Return:  []*FunctionVarDef{{"s", "string"}},
 			Body: func() string {
-				var b bytes.Buffer
-				b.WriteString("s = \"P\"\n")
-				b.WriteString("if d < 0 {\n")
-				b.WriteString("s = \"-P\"\n")
-				b.WriteString("d = -1 * d\n")
-				b.WriteString("}\n")
-				b.WriteString("var tally time.Duration\n")
-				b.WriteString("if years := d.Hours() / 8760.0; years >= 1 {\n")
-				b.WriteString("nYears := int64(math.Floor(years))\n")
-				b.WriteString("tally += time.Duration(nYears) * 8760 * time.Hour\n")
-				b.WriteString("s = fmt.Sprintf(\"%s%dY\", s, nYears)\n")
-				b.WriteString("}\n")
-				b.WriteString("if months := (d.Hours() - tally.Hours()) / 720.0; months >= 1 {\n")
-				b.WriteString("nMonths := int64(math.Floor(months))\n")
-				b.WriteString("tally += time.Duration(nMonths) * 720 * time.Hour\n")
-				b.WriteString("s = fmt.Sprintf(\"%s%dM\", s, nMonths)\n")
-				b.WriteString("}\n")
-				b.WriteString("if days := (d.Hours() - tally.Hours()) / 24.0; days >= 1 {\n")
-				b.WriteString("nDays := int64(math.Floor(days))\n")
-				b.WriteString("tally += time.Duration(nDays) * 24 * time.Hour\n")
-				b.WriteString("s = fmt.Sprintf(\"%s%dD\", s, nDays)\n")
-				b.WriteString("}\n")
-				b.WriteString("if tally < d {\n")
-				b.WriteString("s = fmt.Sprintf(\"%sT\", s)\n")
-				b.WriteString("if hours := d.Hours() - tally.Hours(); hours >= 1 {\n")
-				b.WriteString("nHours := int64(math.Floor(hours))\n")
-				b.WriteString("tally += time.Duration(nHours) * time.Hour\n")
-				b.WriteString("s = fmt.Sprintf(\"%s%dH\", s, nHours)\n")
-				b.WriteString("}\n")
-				b.WriteString("if minutes := d.Minutes() - tally.Minutes(); minutes >= 1 {\n")
-				b.WriteString("nMinutes := int64(math.Floor(minutes))\n")
-				b.WriteString("tally += time.Duration(nMinutes) * time.Minute\n")
-				b.WriteString("s = fmt.Sprintf(\"%s%dM\", s, nMinutes)\n")
-				b.WriteString("}\n")
-				b.WriteString("if seconds := d.Seconds() - tally.Seconds(); seconds >= 1 {\n")
-				b.WriteString("nSeconds := int64(math.Floor(seconds))\n")
-				b.WriteString("tally += time.Duration(nSeconds) * time.Second\n")
-				b.WriteString("s = fmt.Sprintf(\"%s%dS\", s, nSeconds)\n")
-				b.WriteString("}\n")
-				b.WriteString("}\n")
-				b.WriteString("return\n")
-				return b.String()
+				return "return formatDuration(d)\n"
 			},
 		},
 		Imports: []string{"time"},
@@ -1578,6 +1496,7 @@ var (
 			Return:  []*FunctionVarDef{{"i", "*int64"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
+				b.WriteString("v = stringScalar(v)\n")
 				b.WriteString("if fv, ok := v.(float64); ok {\n")
 				b.WriteString("iv := int64(fv)\n")
 				b.WriteString("if iv >= 0 {\n")
@@ -1607,6 +1526,7 @@ var (
 	}
 	bcp47LangTag = &ValueType{
 		Name:           "bcp47LanguageTag",
+		TextName:       "LanguageTag",
 		DefinitionType: "*string",
 		Zero:           "\"\"",
 		DeserializeFn: &FunctionDef{
@@ -1640,6 +1560,7 @@ var (
 	}
 	mimeMediaValueType = &ValueType{
 		Name:           "mimeMediaTypeValue",
+		TextName:       "MediaType",
 		DefinitionType: "*string",
 		Zero:           "\"\"",
 		DeserializeFn: &FunctionDef{
@@ -1673,6 +1594,7 @@ var (
 	}
 	linkRelationValueType = &ValueType{
 		Name:           "linkRelation",
+		TextName:       "LinkRelation",
 		DefinitionType: "*string",
 		Zero:           "\"\"",
 		DeserializeFn: &FunctionDef{
@@ -1706,19 +1628,22 @@ var (
 	}
 	unitsValueType = &ValueType{
 		Name:           "unitsValue",
+		TextName:       "Units",
 		DefinitionType: "*string",
 		Zero:           "\"\"",
 		DeserializeFn: &FunctionDef{
 			Name:    "unitsValueDeserialize",
-			Comment: "unitsValueDeserialize turns a interface{} into a string.",
+			Comment: "unitsValueDeserialize turns a interface{} into a string, if it is one of the units defined by the specification.",
 			Args:    []*FunctionVarDef{{"v", "interface{}"}},
 			Return:  []*FunctionVarDef{{"s", "*string"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
-				b.WriteString("if sv, ok := v.(string); ok {\n")
-				b.WriteString("s = &sv\n")
-				b.WriteString("} else {\n")
+				b.WriteString("if sv, ok := v.(string); !ok {\n")
 				b.WriteString("err = fmt.Errorf(\"%v cannot be interpreted as a string for units value\", v)\n")
+				b.WriteString("} else if sv != \"cm\" && sv != \"feet\" && sv != \"inches\" && sv != \"km\" && sv != \"m\" && sv != \"miles\" {\n")
+				b.WriteString("err = fmt.Errorf(\"%v is not a units value\", v)\n")
+				b.WriteString("} else {\n")
+				b.WriteString("s = &sv\n")
 				b.WriteString("}\n")
 				b.WriteString("return\n")
 				return b.String()
@@ -1925,11 +1850,7 @@ var IriValueType = &ValueType{
 }
 
 func init() {
-	for _, p := range AllPropertyTypes {
-		if !HasAnyURI(p.Range) {
-			p.Range = append(p.Range, RangeReference{V: IriValueType})
-		}
-	}
+	AddIRIRange(AllPropertyTypes)
 }
 
 func HasAnyURI(r []RangeReference) bool {
@@ -1975,3 +1896,65 @@ func IsActivity(t *Type) bool {
 	}
 	return recur(t)
 }
+
+// actorDomain is the domain of the extension properties of actors.
+func actorDomain() []DomainReference {
+	return []DomainReference{
+		{T: applicationExtendedType},
+		{T: groupExtendedType},
+		{T: organizationExtendedType},
+		{T: personExtendedType},
+		{T: serviceExtendedType},
+	}
+}
+
+// FindType returns the core or extended type with the name, or nil if there is
+// none. Extension vocabularies use it to extend and refer to the types.
+func FindType(name string) *Type {
+	for _, t := range append(append([]*Type{}, AllCoreTypes...), AllExtendedTypes...) {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// FindPropertyType returns the property with the name, or nil if there is
+// none.
+func FindPropertyType(name string) *PropertyType {
+	for _, p := range AllPropertyTypes {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// FindValueType returns the value with the name, such as "string" or
+// "dateTime", or nil if there is none.
+func FindValueType(name string) *ValueType {
+	for _, v := range AllValueTypes {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// AddIRIRange lets the properties, like the core ones, always take an IRI.
+func AddIRIRange(properties []*PropertyType) {
+	for _, p := range properties {
+		if !HasAnyURI(p.Range) && !hasIRI(p.Range) {
+			p.Range = append(p.Range, RangeReference{V: IriValueType})
+		}
+	}
+}
+
+func hasIRI(r []RangeReference) bool {
+	for _, v := range r {
+		if v.V != nil && IsIRIValueType(v.V) {
+			return true
+		}
+	}
+	return false
+}
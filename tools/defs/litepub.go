@@ -0,0 +1,81 @@
+package defs
+
+// LitepubBaseURI is the namespace of the LitePub vocabulary, the extension of
+// the ActivityStreams Vocabulary used by Pleroma and Akkoma.
+const LitepubBaseURI = "http://litepub.social/ns#"
+
+var (
+	litepubEmojiReactType = &Type{
+		Name:    "EmojiReact",
+		URI:     LitepubBaseURI + "EmojiReact",
+		Notes:   "Indicates that the actor reacts to the object with an emoji, which is its 'content'. A custom emoji is in its 'tag'.",
+		Extends: []*Type{activityType},
+	}
+	litepubChatMessageType = &Type{
+		Name:    "ChatMessage",
+		URI:     LitepubBaseURI + "ChatMessage",
+		Notes:   "A private message of a chat between two actors, addressed only 'to' the other, whose text is its 'content'. It is the object of a Create.",
+		Extends: []*Type{objectType},
+	}
+	litepubCapabilitiesType = &Type{
+		Name:    "Capabilities",
+		URI:     LitepubBaseURI + "Capabilities",
+		Notes:   "The features of the server of an actor that other servers may rely on. It is often left without a type in the 'capabilities' of the actor, which takes no other.",
+		Extends: []*Type{objectType},
+	}
+
+	litepubAcceptsChatMessagesPropertyType = &PropertyType{
+		Name:       "acceptsChatMessages",
+		URI:        LitepubBaseURI + "acceptsChatMessages",
+		Notes:      "Whether the actor accepts ChatMessage objects.",
+		Domain:     []DomainReference{{T: litepubCapabilitiesType}},
+		Range:      []RangeReference{{V: xsdBooleanValueType}},
+		Functional: true,
+	}
+	litepubCapabilitiesPropertyType = &PropertyType{
+		Name:       "capabilities",
+		URI:        LitepubBaseURI + "capabilities",
+		Notes:      "The features of the server of an actor.",
+		Domain:     actorDomain(),
+		Range:      []RangeReference{{T: litepubCapabilitiesType}},
+		Functional: true,
+	}
+	litepubListMessagePropertyType = &PropertyType{
+		Name:       "listMessage",
+		URI:        LitepubBaseURI + "listMessage",
+		Notes:      "The list whose members an object is addressed to, such as that of a Create.",
+		Domain:     []DomainReference{{T: objectType}},
+		Range:      []RangeReference{{V: IriValueType}},
+		Functional: true,
+	}
+	litepubOauthRegistrationEndpointPropertyType = &PropertyType{
+		Name:       "oauthRegistrationEndpoint",
+		URI:        LitepubBaseURI + "oauthRegistrationEndpoint",
+		Notes:      "The endpoint at which a client registers to obtain the OAuth 2.0 credentials of the server, found in the 'endpoints' of an actor.",
+		Domain:     []DomainReference{{T: objectType}},
+		Range:      []RangeReference{{V: xsdAnyURIValueType}},
+		Functional: true,
+	}
+
+	// LitepubTypes are the types of the LitePub vocabulary.
+	LitepubTypes = []*Type{
+		litepubEmojiReactType,
+		litepubChatMessageType,
+		litepubCapabilitiesType,
+	}
+	// LitepubPropertyTypes are the properties of the LitePub vocabulary. The
+	// core types are the domain of all but 'acceptsChatMessages', which
+	// they keep as unknown properties.
+	LitepubPropertyTypes = []*PropertyType{
+		litepubAcceptsChatMessagesPropertyType,
+		litepubCapabilitiesPropertyType,
+		litepubListMessagePropertyType,
+		litepubOauthRegistrationEndpointPropertyType,
+	}
+)
+
+func init() {
+	litepubCapabilitiesType.Properties = []*PropertyType{
+		litepubAcceptsChatMessagesPropertyType,
+	}
+}
@@ -0,0 +1,40 @@
+package defs
+
+// SchemaBaseURI is the namespace of the schema.org vocabulary, as the
+// ActivityPub servers using its terms declare it.
+const SchemaBaseURI = "http://schema.org#"
+
+var (
+	schemaPropertyValueType = &Type{
+		Name:    "PropertyValue",
+		URI:     SchemaBaseURI + "PropertyValue",
+		Notes:   "A name and value pair, such as a field of the metadata of an actor's profile, which is found in the 'attachment' of the actor. Its 'name' is the name of the field.",
+		Extends: []*Type{objectType},
+	}
+
+	schemaValuePropertyType = &PropertyType{
+		Name:       "value",
+		URI:        SchemaBaseURI + "value",
+		Notes:      "The value of the pair, which is often HTML, such as a link to the website of an actor.",
+		Domain:     []DomainReference{{T: schemaPropertyValueType}},
+		Range:      []RangeReference{{V: xsdStringValueType}},
+		Functional: true,
+	}
+
+	// SchemaTypes are the types of the schema.org vocabulary used by
+	// ActivityPub servers.
+	SchemaTypes = []*Type{
+		schemaPropertyValueType,
+	}
+	// SchemaPropertyTypes are the properties of the schema.org vocabulary
+	// used by ActivityPub servers.
+	SchemaPropertyTypes = []*PropertyType{
+		schemaValuePropertyType,
+	}
+)
+
+func init() {
+	schemaPropertyValueType.Properties = []*PropertyType{
+		schemaValuePropertyType,
+	}
+}
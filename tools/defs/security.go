@@ -0,0 +1,133 @@
+package defs
+
+// SecurityBaseURI is the namespace of the W3C security vocabulary, whose keys
+// actors publish to verify their HTTP signatures with, and whose proofs sign
+// objects with Data Integrity.
+const SecurityBaseURI = "https://w3id.org/security#"
+
+// dcTermsBaseURI is the namespace of the Dublin Core terms, which the security
+// vocabulary reuses.
+const dcTermsBaseURI = "http://purl.org/dc/terms/"
+
+var (
+	securityKeyType = &Type{
+		Name:    "Key",
+		URI:     SecurityBaseURI + "Key",
+		Notes:   "A public key of an actor, its 'owner', such as the key its HTTP signatures are verified with. It is often left without a type in the 'publicKey' of the actor, which takes no other.",
+		Extends: []*Type{objectType},
+	}
+	securityDataIntegrityProofType = &Type{
+		Name:    "DataIntegrityProof",
+		URI:     SecurityBaseURI + "DataIntegrityProof",
+		Notes:   "A Data Integrity proof of an object, its 'proof', such as those of FEP-8b32. Its 'proofValue' is the signature of the object without its proof, made with the 'cryptosuite' by the key its 'verificationMethod' refers to.",
+		Extends: []*Type{objectType},
+	}
+
+	securityOwnerPropertyType = &PropertyType{
+		Name:       "owner",
+		URI:        SecurityBaseURI + "owner",
+		Notes:      "The actor that owns the key, which has it as its 'publicKey'.",
+		Domain:     []DomainReference{{T: securityKeyType}},
+		Range:      []RangeReference{{V: IriValueType}},
+		Functional: true,
+	}
+	securityPublicKeyPemPropertyType = &PropertyType{
+		Name:       "publicKeyPem",
+		URI:        SecurityBaseURI + "publicKeyPem",
+		Notes:      "The key, PEM encoded.",
+		Domain:     []DomainReference{{T: securityKeyType}},
+		Range:      []RangeReference{{V: xsdStringValueType}},
+		Functional: true,
+	}
+	securityCryptosuitePropertyType = &PropertyType{
+		Name:       "cryptosuite",
+		URI:        SecurityBaseURI + "cryptosuite",
+		Notes:      "The name of the suite of algorithms the proof is made with, such as 'eddsa-jcs-2022'.",
+		Domain:     []DomainReference{{T: securityDataIntegrityProofType}},
+		Range:      []RangeReference{{V: xsdStringValueType}},
+		Functional: true,
+	}
+	securityVerificationMethodPropertyType = &PropertyType{
+		Name:       "verificationMethod",
+		URI:        SecurityBaseURI + "verificationMethod",
+		Notes:      "The key verifying the proof, such as one of the keys of the actor that made it.",
+		Domain:     []DomainReference{{T: securityDataIntegrityProofType}},
+		Range:      []RangeReference{{V: IriValueType}},
+		Functional: true,
+	}
+	securityProofPurposePropertyType = &PropertyType{
+		Name:       "proofPurpose",
+		URI:        SecurityBaseURI + "proofPurpose",
+		Notes:      "The reason the proof is made, which is 'assertionMethod' for signed objects.",
+		Domain:     []DomainReference{{T: securityDataIntegrityProofType}},
+		Range:      []RangeReference{{V: xsdStringValueType}},
+		Functional: true,
+	}
+	securityProofValuePropertyType = &PropertyType{
+		Name:       "proofValue",
+		URI:        SecurityBaseURI + "proofValue",
+		Notes:      "The signature of the proof, multibase encoded.",
+		Domain:     []DomainReference{{T: securityDataIntegrityProofType}},
+		Range:      []RangeReference{{V: xsdStringValueType}},
+		Functional: true,
+	}
+	securityCreatedPropertyType = &PropertyType{
+		Name:       "created",
+		URI:        dcTermsBaseURI + "created",
+		Notes:      "The time at which the proof was made.",
+		Domain:     []DomainReference{{T: securityDataIntegrityProofType}},
+		Range:      []RangeReference{{V: xsdDateTimeValueType}},
+		Functional: true,
+	}
+	securityProofPropertyType = &PropertyType{
+		Name:       "proof",
+		URI:        SecurityBaseURI + "proof",
+		Notes:      "The Data Integrity proof of an object, such as an activity signed by its actor.",
+		Domain:     []DomainReference{{T: objectType}},
+		Range:      []RangeReference{{T: securityDataIntegrityProofType}},
+		Functional: true,
+	}
+	securityPublicKeyPropertyType = &PropertyType{
+		Name:       "publicKey",
+		URI:        SecurityBaseURI + "publicKey",
+		Notes:      "The public key of an actor.",
+		Domain:     actorDomain(),
+		Range:      []RangeReference{{T: securityKeyType}},
+		Functional: true,
+	}
+
+	// SecurityTypes are the types of the W3C security vocabulary used by
+	// ActivityPub servers.
+	SecurityTypes = []*Type{
+		securityKeyType,
+		securityDataIntegrityProofType,
+	}
+	// SecurityPropertyTypes are the properties of the W3C security
+	// vocabulary used by ActivityPub servers. The core types are the domain
+	// of 'publicKey' and 'proof', which they keep as unknown properties.
+	SecurityPropertyTypes = []*PropertyType{
+		securityOwnerPropertyType,
+		securityPublicKeyPemPropertyType,
+		securityCryptosuitePropertyType,
+		securityVerificationMethodPropertyType,
+		securityProofPurposePropertyType,
+		securityProofValuePropertyType,
+		securityCreatedPropertyType,
+		securityPublicKeyPropertyType,
+		securityProofPropertyType,
+	}
+)
+
+func init() {
+	securityKeyType.Properties = []*PropertyType{
+		securityOwnerPropertyType,
+		securityPublicKeyPemPropertyType,
+	}
+	securityDataIntegrityProofType.Properties = []*PropertyType{
+		securityCryptosuitePropertyType,
+		securityVerificationMethodPropertyType,
+		securityProofPurposePropertyType,
+		securityProofValuePropertyType,
+		securityCreatedPropertyType,
+	}
+}
@@ -0,0 +1,70 @@
+package defs
+
+// TootBaseURI is the namespace of the Mastodon "toot" vocabulary, an extension
+// of the ActivityStreams Vocabulary.
+const TootBaseURI = "http://joinmastodon.org/ns#"
+
+var (
+	tootEmojiType = &Type{
+		Name:    "Emoji",
+		URI:     TootBaseURI + "Emoji",
+		Notes:   "A custom emoji, whose 'name' is its shortcode, such as ':blobcat:', and whose 'icon' is its image. It is found in the 'tag' of the objects whose content uses it.",
+		Extends: []*Type{objectType},
+	}
+
+	tootFeaturedPropertyType = &PropertyType{
+		Name:       "featured",
+		URI:        TootBaseURI + "featured",
+		Notes:      "The collection of the objects an actor has pinned to its profile.",
+		Domain:     actorDomain(),
+		Range:      []RangeReference{{T: orderedCollectionType}},
+		Functional: true,
+	}
+	tootDiscoverablePropertyType = &PropertyType{
+		Name:       "discoverable",
+		URI:        TootBaseURI + "discoverable",
+		Notes:      "Whether an actor consents to being listed in directories and suggested to others.",
+		Domain:     actorDomain(),
+		Range:      []RangeReference{{V: xsdBooleanValueType}},
+		Functional: true,
+	}
+	tootSuspendedPropertyType = &PropertyType{
+		Name:       "suspended",
+		URI:        TootBaseURI + "suspended",
+		Notes:      "Whether an actor has been suspended by the moderators of its server.",
+		Domain:     actorDomain(),
+		Range:      []RangeReference{{V: xsdBooleanValueType}},
+		Functional: true,
+	}
+	tootVotersCountPropertyType = &PropertyType{
+		Name:       "votersCount",
+		URI:        TootBaseURI + "votersCount",
+		Notes:      "The number of actors that have voted in a poll, which is a Question, whichever of its choices they voted for.",
+		Domain:     []DomainReference{{T: questionExtendedType}},
+		Range:      []RangeReference{{V: xsdNonNegativeIntegerValueType}},
+		Functional: true,
+	}
+	tootBlurhashPropertyType = &PropertyType{
+		Name:       "blurhash",
+		URI:        TootBaseURI + "blurhash",
+		Notes:      "A compact representation of a blurred preview of an image or video, shown while it loads or when it is marked sensitive.",
+		Domain:     []DomainReference{{T: documentExtendedType}, {T: imageExtendedType}},
+		Range:      []RangeReference{{V: xsdStringValueType}},
+		Functional: true,
+	}
+
+	// TootTypes are the types of the Mastodon "toot" vocabulary.
+	TootTypes = []*Type{
+		tootEmojiType,
+	}
+	// TootPropertyTypes are the properties of the Mastodon "toot"
+	// vocabulary. The core types are their domain, which keep them as
+	// unknown properties.
+	TootPropertyTypes = []*PropertyType{
+		tootFeaturedPropertyType,
+		tootDiscoverablePropertyType,
+		tootSuspendedPropertyType,
+		tootVotersCountPropertyType,
+		tootBlurhashPropertyType,
+	}
+)
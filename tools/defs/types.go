@@ -108,6 +108,10 @@ type ValueType struct {
 	DeserializeFn  *FunctionDef
 	SerializeFn    *FunctionDef
 	Imports        []string
+	// TextName is the name of a type of the value implementing
+	// encoding.TextMarshaler and encoding.TextUnmarshaler, or empty if it
+	// has none. The SerializeFn of such a value returns a string.
+	TextName string
 }
 
 type DomainReference struct {
@@ -0,0 +1,84 @@
+package codegen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"io"
+)
+
+// Hook post-processes each generated File before it is rendered. It lets
+// advanced users inject methods, struct tags, or instrumentation into the
+// generated types without forking the generator.
+type Hook interface {
+	// ProcessFile is called with each File before it is rendered. Returning
+	// an error stops the File from being rendered.
+	ProcessFile(f *File) error
+}
+
+// HookFunc adapts a function to a Hook.
+type HookFunc func(f *File) error
+
+// ProcessFile calls the function with the File.
+func (h HookFunc) ProcessFile(f *File) error {
+	return h(f)
+}
+
+// File is a generated Go file. The structs of the File are only defined when it
+// is rendered, so that Hooks can still add methods and members to them.
+type File struct {
+	name     string
+	jen      *jen.File
+	structs  []*Struct
+	rendered bool
+}
+
+// NewFile creates an empty File of the package at the path, to be rendered to
+// the named file.
+func NewFile(pkgPath, pkgName, name string) *File {
+	return &File{
+		name: name,
+		jen:  jen.NewFilePathName(pkgPath, pkgName),
+	}
+}
+
+// Name returns the name the File is rendered to.
+func (f *File) Name() string {
+	return f.name
+}
+
+// Jen returns the jen.File being generated. Code added to it is rendered before
+// the definitions of the structs of the File.
+func (f *File) Jen() *jen.File {
+	return f.jen
+}
+
+// AddStruct adds a struct to be defined in the File.
+func (f *File) AddStruct(s *Struct) {
+	f.structs = append(f.structs, s)
+}
+
+// Structs returns the structs to be defined in the File, in the order they were
+// added.
+func (f *File) Structs() []*Struct {
+	return f.structs
+}
+
+// Render processes the File with each of the hooks in turn, then renders it. A
+// File can only be rendered once, since the definitions of its structs are added
+// to its jen.File.
+func (f *File) Render(w io.Writer, hooks ...Hook) error {
+	if f.rendered {
+		return fmt.Errorf("codegen: File %s is already rendered", f.name)
+	}
+	f.rendered = true
+	for _, h := range hooks {
+		if err := h.ProcessFile(f); err != nil {
+			return err
+		}
+	}
+	for _, s := range f.structs {
+		f.jen.Line()
+		f.jen.Add(s.Definition())
+	}
+	return f.jen.Render(w)
+}
@@ -2,6 +2,7 @@ package codegen
 
 import (
 	"github.com/dave/jennifer/jen"
+	"go/ast"
 )
 
 type FunctionSignature struct {
@@ -48,3 +49,21 @@ func (i Interface) Definition() jen.Code {
 	}
 	return stmts.Type().Id(i.name).Interface(defs...)
 }
+
+// ImplementedBy generates a compile-time assertion that a pointer to the named
+// type satisfies this interface.
+func (i Interface) ImplementedBy(name string) jen.Code {
+	return jen.Var().Id("_").Id(i.name).Op("=").Parens(jen.Op("*").Id(name)).Parens(jen.Nil())
+}
+
+// exportedSignatures returns the signatures of the exported methods, sorted by
+// name.
+func exportedSignatures(methods map[string]*Method) []FunctionSignature {
+	var funcs []FunctionSignature
+	for _, m := range sortedMethods(methods) {
+		if ast.IsExported(m.Name()) {
+			funcs = append(funcs, m.ToFunctionSignature())
+		}
+	}
+	return funcs
+}
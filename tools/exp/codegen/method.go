@@ -206,3 +206,13 @@ func (m Method) Call(on string, params ...jen.Code) jen.Code {
 func (m Method) Name() string {
 	return m.function.name
 }
+
+// ToFunctionSignature obtains the signature of this method, for use in an
+// Interface.
+func (m Method) ToFunctionSignature() FunctionSignature {
+	return FunctionSignature{
+		Name:   m.function.name,
+		Params: m.function.params,
+		Ret:    m.function.ret,
+	}
+}
@@ -2,6 +2,7 @@ package codegen
 
 import (
 	"github.com/dave/jennifer/jen"
+	"sort"
 )
 
 // join appends a bunch of Go Code together, each on their own line.
@@ -16,6 +17,36 @@ func join(s []jen.Code) *jen.Statement {
 	return r
 }
 
+// sortedMethods returns the methods sorted by name, so that generated code does
+// not depend on map iteration order.
+func sortedMethods(methods map[string]*Method) []*Method {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sorted := make([]*Method, 0, len(names))
+	for _, name := range names {
+		sorted = append(sorted, methods[name])
+	}
+	return sorted
+}
+
+// sortedFunctions returns the functions sorted by name, so that generated code
+// does not depend on map iteration order.
+func sortedFunctions(funcs map[string]*Function) []*Function {
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sorted := make([]*Function, 0, len(names))
+	for _, name := range names {
+		sorted = append(sorted, funcs[name])
+	}
+	return sorted
+}
+
 // Struct defines a struct-based type, its functions, and its methods for Go
 // code generation.
 type Struct struct {
@@ -49,7 +80,8 @@ func NewStruct(comment jen.Code,
 }
 
 // Definition generates the Go code required to define and implement this
-// struct, its methods, and its functions.
+// struct, its methods, and its functions. The functions and methods are each
+// sorted by name.
 func (s *Struct) Definition() jen.Code {
 	comment := jen.Empty()
 	if s.comment != nil {
@@ -58,15 +90,32 @@ func (s *Struct) Definition() jen.Code {
 	def := comment.Type().Id(s.name).Struct(
 		join(s.members),
 	)
-	for _, c := range s.constructors {
+	for _, c := range sortedFunctions(s.constructors) {
 		def = def.Line().Line().Add(c.Definition())
 	}
-	for _, m := range s.methods {
+	for _, m := range sortedMethods(s.methods) {
 		def = def.Line().Line().Add(m.Definition())
 	}
 	return def
 }
 
+// AddMethod adds a method to the struct, replacing any method with the same
+// name.
+func (s *Struct) AddMethod(m *Method) {
+	s.methods[m.Name()] = m
+}
+
+// Members returns the Go code of the members of the struct.
+func (s *Struct) Members() []jen.Code {
+	return s.members
+}
+
+// SetMembers replaces the Go code of the members of the struct, such as to add
+// tags to them.
+func (s *Struct) SetMembers(members []jen.Code) {
+	s.members = members
+}
+
 // Method obtains the Go code to be generated for the method with a specific
 // name. Panics if no such method exists.
 func (s *Struct) Method(name string) *Method {
@@ -78,3 +127,9 @@ func (s *Struct) Method(name string) *Method {
 func (s *Struct) Constructors(name string) *Function {
 	return s.constructors[name]
 }
+
+// ToInterface creates an interface with the exported methods of this struct,
+// sorted by name, which a pointer to the struct satisfies.
+func (s *Struct) ToInterface(pkg, name, comment string) *Interface {
+	return NewInterface(pkg, name, exportedSignatures(s.methods), comment)
+}
@@ -37,7 +37,8 @@ func NewTypedef(comment jen.Code,
 }
 
 // Definition generates the Go code required to define and implement this type,
-// its methods, and its functions.
+// its methods, and its functions. The functions and methods are each sorted by
+// name.
 func (t *Typedef) Definition() jen.Code {
 	def := jen.Empty().Add(
 		t.comment,
@@ -46,10 +47,10 @@ func (t *Typedef) Definition() jen.Code {
 	).Add(
 		t.concreteType,
 	)
-	for _, c := range t.constructors {
+	for _, c := range sortedFunctions(t.constructors) {
 		def = def.Line().Line().Add(c.Definition())
 	}
-	for _, m := range t.methods {
+	for _, m := range sortedMethods(t.methods) {
 		def = def.Line().Line().Add(m.Definition())
 	}
 	return def
@@ -66,3 +67,9 @@ func (t *Typedef) Method(name string) *Method {
 func (t *Typedef) Constructors(name string) *Function {
 	return t.constructors[name]
 }
+
+// ToInterface creates an interface with the exported methods of this type,
+// sorted by name, which a pointer to the type satisfies.
+func (t *Typedef) ToInterface(pkg, name, comment string) *Interface {
+	return NewInterface(pkg, name, exportedSignatures(t.methods), comment)
+}
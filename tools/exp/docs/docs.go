@@ -0,0 +1,281 @@
+// Package docs renders a parsed vocabulary as human-readable documentation, to
+// be browsed alongside the Go code generated for it.
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	htmltemplate "html/template"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Format is the markup the documentation is rendered in.
+type Format int
+
+const (
+	Markdown Format = iota
+	HTML
+)
+
+// extension is the file extension of pages in the format.
+func (f Format) extension() string {
+	if f == HTML {
+		return ".html"
+	}
+	return ".md"
+}
+
+// indexName is the name of the page listing every type and property.
+const indexName = "index"
+
+// File is a rendered page of documentation.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// reference is a link to a type or property in the documentation.
+type reference struct {
+	Name string
+	// Link is the page documenting the element, or its URI if it belongs
+	// to another vocabulary. Empty if neither is known.
+	Link string
+}
+
+// propertyDoc documents a property.
+type propertyDoc struct {
+	Name               string
+	URI                string
+	Notes              string
+	Functional         bool
+	NaturalLanguageMap bool
+	Domain             []reference
+	Range              []reference
+	SubpropertyOf      *reference
+	ReverseOf          *reference
+}
+
+// inheritedDoc lists the properties a type inherits from one of its ancestors.
+type inheritedDoc struct {
+	From       reference
+	Properties []propertyDoc
+}
+
+// typeDoc documents a type.
+type typeDoc struct {
+	Name              string
+	URI               string
+	Notes             string
+	Extends           []reference
+	ExtendedBy        []reference
+	DisjointWith      []reference
+	Properties        []propertyDoc
+	Inherited         []inheritedDoc
+	WithoutProperties []reference
+}
+
+// indexDoc documents the whole vocabulary.
+type indexDoc struct {
+	Types      []reference
+	Properties []propertyDoc
+}
+
+// Generate renders one page for each type of the vocabulary, and an index
+// page listing its types and properties. Pages are named after the lowercase
+// name of the type, and are returned sorted by name.
+func Generate(v *rdf.ParsedVocabulary, f Format) ([]*File, error) {
+	g := &generator{v: v, f: f}
+	var files []*File
+	for _, name := range g.typeNames() {
+		b, err := g.render(typeTemplates, g.typeDoc(v.Types[name]))
+		if err != nil {
+			return nil, fmt.Errorf("rendering docs for type %s: %s", name, err)
+		}
+		files = append(files, &File{Name: g.page(name), Content: b})
+	}
+	index := indexDoc{}
+	for _, name := range g.typeNames() {
+		index.Types = append(index.Types, g.local(name))
+	}
+	for _, name := range g.propertyNames() {
+		index.Properties = append(index.Properties, g.propertyDoc(v.Properties[name]))
+	}
+	b, err := g.render(indexTemplates, index)
+	if err != nil {
+		return nil, fmt.Errorf("rendering docs index: %s", err)
+	}
+	files = append(files, &File{Name: indexName + f.extension(), Content: b})
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// generator holds the state while rendering the documentation.
+type generator struct {
+	v *rdf.ParsedVocabulary
+	f Format
+}
+
+// typeNames returns the sorted names of the vocabulary's types.
+func (g *generator) typeNames() []string {
+	names := make([]string, 0, len(g.v.Types))
+	for name := range g.v.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// propertyNames returns the sorted names of the vocabulary's properties.
+func (g *generator) propertyNames() []string {
+	names := make([]string, 0, len(g.v.Properties))
+	for name := range g.v.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// page is the name of the page documenting a type.
+func (g *generator) page(name string) string {
+	return strings.ToLower(name) + g.f.extension()
+}
+
+// local refers to a type of the vocabulary by name.
+func (g *generator) local(name string) reference {
+	return reference{Name: name, Link: g.page(name)}
+}
+
+// reference converts a vocabulary reference into a documentation link.
+func (g *generator) reference(r rdf.VocabularyReference) reference {
+	if len(r.Alias) > 0 {
+		return reference{Name: r.Alias + rdf.ALIAS_DELIMITER + r.Name, Link: r.URI}
+	} else if _, ok := g.v.Types[r.Name]; ok {
+		return g.local(r.Name)
+	} else if _, ok := g.v.Properties[r.Name]; ok {
+		return reference{Name: r.Name, Link: indexName + g.f.extension() + "#" + r.Name}
+	} else if v, ok := g.v.Values[r.URI]; ok {
+		return reference{Name: v.Name, Link: v.URI}
+	}
+	return reference{Name: r.Name, Link: r.URI}
+}
+
+// references converts vocabulary references into documentation links.
+func (g *generator) references(rs []rdf.VocabularyReference) []reference {
+	out := make([]reference, 0, len(rs))
+	for _, r := range rs {
+		out = append(out, g.reference(r))
+	}
+	return out
+}
+
+// propertyDoc documents a property of the vocabulary.
+func (g *generator) propertyDoc(p rdf.VocabularyProperty) propertyDoc {
+	d := propertyDoc{
+		Name:               p.Name,
+		URI:                p.URI,
+		Notes:              p.Notes,
+		Functional:         p.Functional,
+		NaturalLanguageMap: p.NaturalLanguageMap,
+		Domain:             g.references(p.Domain),
+		Range:              g.references(p.Range),
+	}
+	if len(p.SubpropertyOf.Name) > 0 {
+		r := g.reference(p.SubpropertyOf)
+		d.SubpropertyOf = &r
+	}
+	if p.ReverseOf != nil {
+		r := g.reference(*p.ReverseOf)
+		d.ReverseOf = &r
+	}
+	return d
+}
+
+// propertyDocs documents the properties referenced by a type.
+func (g *generator) propertyDocs(rs []rdf.VocabularyReference, without map[string]bool) []propertyDoc {
+	var out []propertyDoc
+	for _, r := range rs {
+		if without[r.Name] {
+			continue
+		}
+		if p, ok := g.v.Properties[r.Name]; ok && len(r.Alias) == 0 {
+			out = append(out, g.propertyDoc(p))
+		} else {
+			out = append(out, propertyDoc{Name: g.reference(r).Name, URI: r.URI})
+		}
+	}
+	return out
+}
+
+// typeDoc documents a type of the vocabulary, including the properties it
+// inherits from the types it extends within the vocabulary.
+func (g *generator) typeDoc(t rdf.VocabularyType) typeDoc {
+	without := make(map[string]bool, len(t.WithoutProperties))
+	for _, r := range t.WithoutProperties {
+		without[r.Name] = true
+	}
+	d := typeDoc{
+		Name:              t.Name,
+		URI:               t.URI,
+		Notes:             t.Notes,
+		Extends:           g.references(t.Extends),
+		DisjointWith:      g.references(t.DisjointWith),
+		Properties:        g.propertyDocs(t.Properties, nil),
+		WithoutProperties: g.references(t.WithoutProperties),
+	}
+	for _, name := range g.typeNames() {
+		for _, r := range g.v.Types[name].Extends {
+			if len(r.Alias) == 0 && r.Name == t.Name {
+				d.ExtendedBy = append(d.ExtendedBy, g.local(name))
+				break
+			}
+		}
+	}
+	seen := map[string]bool{t.Name: true}
+	queue := append([]rdf.VocabularyReference(nil), t.Extends...)
+	for len(queue) > 0 {
+		r := queue[0]
+		queue = queue[1:]
+		if len(r.Alias) > 0 || seen[r.Name] {
+			continue
+		}
+		seen[r.Name] = true
+		parent, ok := g.v.Types[r.Name]
+		if !ok {
+			continue
+		}
+		if props := g.propertyDocs(parent.Properties, without); len(props) > 0 {
+			d.Inherited = append(d.Inherited, inheritedDoc{
+				From:       g.local(parent.Name),
+				Properties: props,
+			})
+		}
+		for _, w := range parent.WithoutProperties {
+			without[w.Name] = true
+		}
+		queue = append(queue, parent.Extends...)
+	}
+	return d
+}
+
+// templates renders a kind of page in each of the formats.
+type templates struct {
+	markdown *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+// render renders the data into a page in the generator's format.
+func (g *generator) render(t templates, data interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	var err error
+	if g.f == HTML {
+		err = t.html.Execute(&b, data)
+	} else {
+		err = t.markdown.Execute(&b, data)
+	}
+	return b.Bytes(), err
+}
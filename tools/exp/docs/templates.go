@@ -0,0 +1,160 @@
+package docs
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// markdownFuncs are the helpers available to the Markdown templates.
+var markdownFuncs = texttemplate.FuncMap{
+	"link": func(r reference) string {
+		if len(r.Link) == 0 {
+			return r.Name
+		}
+		return "[" + r.Name + "](" + r.Link + ")"
+	},
+	"cell": func(s string) string {
+		s = strings.Replace(s, "|", "\\|", -1)
+		return strings.Join(strings.Fields(s), " ")
+	},
+	"yesno": yesNo,
+}
+
+// htmlFuncs are the helpers available to the HTML templates.
+var htmlFuncs = htmltemplate.FuncMap{
+	"yesno": yesNo,
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+const markdownLinks = `{{define "links"}}{{range $i, $r := .}}{{if $i}}, {{end}}{{link $r}}{{end}}{{end}}`
+
+const markdownPropertyTable = `{{define "properties"}}| Property | Range | Functional | Notes |
+| --- | --- | --- | --- |
+{{range .}}| {{if .URI}}[{{.Name}}]({{.URI}}){{else}}{{.Name}}{{end}} | {{template "links" .Range}} | {{yesno .Functional}} | {{cell .Notes}} |
+{{end}}{{end}}`
+
+const markdownType = `# {{.Name}}
+{{if .URI}}
+<{{.URI}}>
+{{end}}{{if .Notes}}
+{{.Notes}}
+{{end}}{{if .Extends}}
+## Extends
+
+{{range .Extends}}* {{link .}}
+{{end}}{{end}}{{if .ExtendedBy}}
+## Extended By
+
+{{range .ExtendedBy}}* {{link .}}
+{{end}}{{end}}{{if .DisjointWith}}
+## Disjoint With
+
+{{range .DisjointWith}}* {{link .}}
+{{end}}{{end}}{{if .Properties}}
+## Properties
+
+{{template "properties" .Properties}}{{end}}{{if .Inherited}}
+## Inherited Properties
+{{range .Inherited}}
+### From {{link .From}}
+
+{{template "properties" .Properties}}{{end}}{{end}}{{if .WithoutProperties}}
+## Without Properties
+
+{{range .WithoutProperties}}* {{link .}}
+{{end}}{{end}}`
+
+const markdownIndex = `# Vocabulary
+
+## Types
+
+{{range .Types}}* {{link .}}
+{{end}}{{if .Properties}}
+## Properties
+{{range .Properties}}
+### <a name="{{.Name}}"></a>{{.Name}}
+{{if .URI}}
+<{{.URI}}>
+{{end}}{{if .Notes}}
+{{.Notes}}
+{{end}}
+* Domain: {{template "links" .Domain}}
+* Range: {{template "links" .Range}}
+* Functional: {{yesno .Functional}}
+* Natural language map: {{yesno .NaturalLanguageMap}}
+{{if .SubpropertyOf}}* Subproperty of: {{link .SubpropertyOf}}
+{{end}}{{if .ReverseOf}}* Reverse of: {{link .ReverseOf}}
+{{end}}{{end}}{{end}}`
+
+const htmlLink = `{{define "link"}}{{if .Link}}<a href="{{.Link}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}{{end}}`
+
+const htmlLinks = `{{define "links"}}{{range $i, $r := .}}{{if $i}}, {{end}}{{template "link" $r}}{{end}}{{end}}`
+
+const htmlList = `{{define "list"}}<ul>
+{{range .}}<li>{{template "link" .}}</li>
+{{end}}</ul>
+{{end}}`
+
+const htmlPropertyTable = `{{define "properties"}}<table>
+<tr><th>Property</th><th>Range</th><th>Functional</th><th>Notes</th></tr>
+{{range .}}<tr><td>{{if .URI}}<a href="{{.URI}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}</td><td>{{template "links" .Range}}</td><td>{{yesno .Functional}}</td><td>{{.Notes}}</td></tr>
+{{end}}</table>
+{{end}}`
+
+const htmlType = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Name}}</title></head>
+<body>
+<h1>{{.Name}}</h1>
+{{if .URI}}<p><a href="{{.URI}}">{{.URI}}</a></p>
+{{end}}{{if .Notes}}<p>{{.Notes}}</p>
+{{end}}{{if .Extends}}<h2>Extends</h2>
+{{template "list" .Extends}}{{end}}{{if .ExtendedBy}}<h2>Extended By</h2>
+{{template "list" .ExtendedBy}}{{end}}{{if .DisjointWith}}<h2>Disjoint With</h2>
+{{template "list" .DisjointWith}}{{end}}{{if .Properties}}<h2>Properties</h2>
+{{template "properties" .Properties}}{{end}}{{if .Inherited}}<h2>Inherited Properties</h2>
+{{range .Inherited}}<h3>From {{template "link" .From}}</h3>
+{{template "properties" .Properties}}{{end}}{{end}}{{if .WithoutProperties}}<h2>Without Properties</h2>
+{{template "list" .WithoutProperties}}{{end}}</body>
+</html>
+`
+
+const htmlIndex = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Vocabulary</title></head>
+<body>
+<h1>Vocabulary</h1>
+<h2>Types</h2>
+{{template "list" .Types}}{{if .Properties}}<h2>Properties</h2>
+{{range .Properties}}<h3 id="{{.Name}}">{{.Name}}</h3>
+{{if .URI}}<p><a href="{{.URI}}">{{.URI}}</a></p>
+{{end}}{{if .Notes}}<p>{{.Notes}}</p>
+{{end}}<ul>
+<li>Domain: {{template "links" .Domain}}</li>
+<li>Range: {{template "links" .Range}}</li>
+<li>Functional: {{yesno .Functional}}</li>
+<li>Natural language map: {{yesno .NaturalLanguageMap}}</li>
+{{if .SubpropertyOf}}<li>Subproperty of: {{template "link" .SubpropertyOf}}</li>
+{{end}}{{if .ReverseOf}}<li>Reverse of: {{template "link" .ReverseOf}}</li>
+{{end}}</ul>
+{{end}}{{end}}</body>
+</html>
+`
+
+var (
+	typeTemplates = templates{
+		markdown: texttemplate.Must(texttemplate.New("type").Funcs(markdownFuncs).Parse(markdownLinks + markdownPropertyTable + markdownType)),
+		html:     htmltemplate.Must(htmltemplate.New("type").Funcs(htmlFuncs).Parse(htmlLink + htmlLinks + htmlList + htmlPropertyTable + htmlType)),
+	}
+	indexTemplates = templates{
+		markdown: texttemplate.Must(texttemplate.New("index").Funcs(markdownFuncs).Parse(markdownLinks + markdownIndex)),
+		html:     htmltemplate.Must(htmltemplate.New("index").Funcs(htmlFuncs).Parse(htmlLink + htmlLinks + htmlList + htmlIndex)),
+	}
+)
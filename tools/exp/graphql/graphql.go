@@ -0,0 +1,377 @@
+// Package graphql renders a parsed vocabulary as a GraphQL schema, so that
+// applications can expose their ActivityStreams data over GraphQL without
+// maintaining the schema by hand.
+package graphql
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// schemaName is the name of the rendered file.
+	schemaName = "schema.graphql"
+	// interfaceSuffix is appended to the name of a type to name the
+	// interface implemented by it and every type extending it.
+	interfaceSuffix = "Type"
+	// propertySuffix is appended to the name of a property to name the
+	// union of the values in its range.
+	propertySuffix = "Property"
+	// valueSuffix is appended to the name of a scalar to name the object
+	// type wrapping it within a union.
+	valueSuffix = "Value"
+	// mapSuffix is appended to the name of a property to name the field
+	// holding its natural language map.
+	mapSuffix = "Map"
+	// iriReference is the object type wrapping an IRI within a union.
+	iriReference = "IRIReference"
+	// languageValue is the object type of a value in a natural language
+	// map.
+	languageValue = "LanguageValue"
+	iriScalar     = "IRI"
+	jsonScalar    = "JSON"
+)
+
+// scalars are the GraphQL scalars of the literal values, keyed by their URI.
+// Literal values not listed are held as strings.
+var scalars = map[string]string{
+	rdf.XSDString:             "String",
+	rdf.XSDAnyURI:             iriScalar,
+	rdf.XSDBoolean:            "Boolean",
+	rdf.XSDDateTime:           "DateTime",
+	rdf.XSDDuration:           "Duration",
+	rdf.XSDFloat:              "Float",
+	rdf.XSDNonNegativeInteger: "Int",
+	rdf.RDFLangString:         "String",
+	rdf.RDFJSON:               jsonScalar,
+}
+
+// builtinScalars are the scalars defined by GraphQL itself.
+var builtinScalars = map[string]bool{
+	"String":  true,
+	"Boolean": true,
+	"Float":   true,
+	"Int":     true,
+	"ID":      true,
+}
+
+// File is a rendered GraphQL schema.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate renders the vocabulary as a GraphQL schema. Each type is an object
+// type with a field for each of its properties, including inherited ones, and
+// each type that is extended also has an interface that it and all the types
+// extending it implement. The range of a property holding more than a single
+// literal value is a union of the object types in it or extending them, of
+// object types wrapping its literal values, and of IRIReference.
+func Generate(v *rdf.ParsedVocabulary) (*File, error) {
+	g := &generator{v: v, scalars: make(map[string]bool), wrappers: make(map[string]bool)}
+	var body bytes.Buffer
+	for _, name := range g.typeNames() {
+		t := v.Types[name]
+		if len(v.Descendants(name)) > 0 {
+			if err := g.typeDefinition(&body, t, true); err != nil {
+				return nil, fmt.Errorf("rendering interface for type %s: %s", name, err)
+			}
+		}
+		if err := g.typeDefinition(&body, t, false); err != nil {
+			return nil, fmt.Errorf("rendering type %s: %s", name, err)
+		}
+	}
+	for _, name := range g.propertyNames() {
+		g.propertyUnion(&body, v.Properties[name])
+	}
+	var b bytes.Buffer
+	b.WriteString("# Code generated from a parsed vocabulary. DO NOT EDIT.\n\n")
+	for _, s := range sortedKeys(g.scalars) {
+		b.WriteString(fmt.Sprintf("scalar %s\n\n", s))
+	}
+	if g.iri {
+		description(&b, "", "IRIReference refers to a value by its IRI instead of including it.")
+		b.WriteString(fmt.Sprintf("type %s {\n  iri: %s!\n}\n\n", iriReference, iriScalar))
+	}
+	if g.languages {
+		description(&b, "", "LanguageValue is the value of a property in a language.")
+		b.WriteString(fmt.Sprintf("type %s {\n  language: String!\n  value: String!\n}\n\n", languageValue))
+	}
+	for _, s := range sortedKeys(g.wrappers) {
+		description(&b, "", fmt.Sprintf("%s%s wraps a %s within a union.", s, valueSuffix, s))
+		b.WriteString(fmt.Sprintf("type %s%s {\n  value: %s!\n}\n\n", s, valueSuffix, s))
+	}
+	b.Write(bytes.TrimRight(body.Bytes(), "\n"))
+	b.WriteString("\n")
+	return &File{Name: schemaName, Content: b.Bytes()}, nil
+}
+
+// generator holds the state while rendering the schema.
+type generator struct {
+	v *rdf.ParsedVocabulary
+	// scalars are the custom scalars used.
+	scalars map[string]bool
+	// wrappers are the scalars wrapped in an object type within a union.
+	wrappers map[string]bool
+	// iri is true when IRIReference is used.
+	iri bool
+	// languages is true when LanguageValue is used.
+	languages bool
+}
+
+// typeNames returns the sorted names of the vocabulary's types.
+func (g *generator) typeNames() []string {
+	names := make([]string, 0, len(g.v.Types))
+	for name := range g.v.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// propertyNames returns the sorted names of the vocabulary's properties that
+// need a union of their values.
+func (g *generator) propertyNames() []string {
+	var names []string
+	for name, p := range g.v.Properties {
+		if _, ok := g.inlined(p); !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// implements returns the interfaces implemented by the type, or by its
+// interface when rendering that instead.
+func (g *generator) implements(t rdf.VocabularyType, asInterface bool) []string {
+	var out []string
+	if !asInterface && len(g.v.Descendants(t.Name)) > 0 {
+		out = append(out, t.Name+interfaceSuffix)
+	}
+	for _, a := range g.v.Ancestors(t) {
+		out = append(out, a.Name+interfaceSuffix)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// fields returns the properties that are fields of the type. The interface of
+// a type only has the ones that every type extending it has too, since a type
+// may be without some of the properties of its ancestors.
+func (g *generator) fields(t rdf.VocabularyType, asInterface bool) []rdf.VocabularyReference {
+	all := g.v.AllProperties(t)
+	if !asInterface {
+		return all
+	}
+	var out []rdf.VocabularyReference
+	for _, r := range all {
+		shared := true
+		for _, d := range g.v.Descendants(t.Name) {
+			if !contains(g.v.AllProperties(g.v.Types[d]), r) {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// typeDefinition renders the object type of a type, or its interface.
+func (g *generator) typeDefinition(b *bytes.Buffer, t rdf.VocabularyType, asInterface bool) error {
+	name := t.Name
+	keyword := "type"
+	notes := t.Notes
+	if asInterface {
+		name += interfaceSuffix
+		keyword = "interface"
+		notes = fmt.Sprintf("%s is implemented by %s and every type extending it.", name, t.Name)
+	}
+	description(b, "", notes)
+	b.WriteString(fmt.Sprintf("%s %s", keyword, name))
+	if i := g.implements(t, asInterface); len(i) > 0 {
+		b.WriteString(" implements " + strings.Join(i, " & "))
+	}
+	b.WriteString(" {\n")
+	description(b, "  ", fmt.Sprintf("The IRI identifying the %s.", t.Name))
+	b.WriteString("  id: ID\n")
+	description(b, "  ", "The types of the value.")
+	b.WriteString("  type: [String!]\n")
+	fields := map[string]bool{"id": true, "type": true}
+	for _, r := range g.fields(t, asInterface) {
+		field := fieldName(r)
+		if fields[field] {
+			return fmt.Errorf("more than one field is named %s", field)
+		}
+		fields[field] = true
+		p, ok := g.v.Properties[r.Name]
+		if !ok || len(r.Alias) > 0 {
+			g.scalars[jsonScalar] = true
+			description(b, "  ", fmt.Sprintf("The %s property of another vocabulary.", r.Alias+rdf.ALIAS_DELIMITER+r.Name))
+			b.WriteString(fmt.Sprintf("  %s: %s\n", field, jsonScalar))
+			continue
+		}
+		kind, ok := g.inlined(p)
+		if ok {
+			g.useScalar(kind)
+		} else {
+			kind = camelCase(p.Name) + propertySuffix
+		}
+		if !p.Functional {
+			kind = "[" + kind + "!]"
+		}
+		description(b, "  ", p.Notes)
+		b.WriteString(fmt.Sprintf("  %s: %s\n", field, kind))
+		if p.NaturalLanguageMap {
+			g.languages = true
+			description(b, "  ", fmt.Sprintf("The values of %s in each language.", p.Name))
+			b.WriteString(fmt.Sprintf("  %s: [%s!]\n", field+mapSuffix, languageValue))
+		}
+	}
+	b.WriteString("}\n\n")
+	return nil
+}
+
+// members determines the object types of the union of a property.
+func (g *generator) members(p rdf.VocabularyProperty) []string {
+	seen := make(map[string]bool)
+	var types, values []string
+	iri := false
+	for _, r := range p.Range {
+		if _, ok := g.v.Types[r.Name]; ok && len(r.Alias) == 0 {
+			iri = true
+			for _, name := range append([]string{r.Name}, g.v.Descendants(r.Name)...) {
+				if !seen[name] {
+					seen[name] = true
+					types = append(types, name)
+				}
+			}
+		} else if s, ok := g.scalar(r); ok {
+			if !seen[s+valueSuffix] {
+				seen[s+valueSuffix] = true
+				g.useScalar(s)
+				g.wrappers[s] = true
+				values = append(values, s+valueSuffix)
+			}
+		} else {
+			iri = true
+		}
+	}
+	sort.Strings(types)
+	sort.Strings(values)
+	var out []string
+	if iri {
+		g.iri = true
+		g.scalars[iriScalar] = true
+		out = append(out, iriReference)
+	}
+	return append(append(out, values...), types...)
+}
+
+// scalar determines the GraphQL scalar of a reference to a literal value.
+func (g *generator) scalar(r rdf.VocabularyReference) (string, bool) {
+	if s, ok := scalars[r.URI]; ok {
+		return s, true
+	} else if _, ok := g.v.Values[r.URI]; ok {
+		return "String", true
+	}
+	return "", false
+}
+
+// inlined determines whether the property holds a single kind of literal value
+// that needs no union, and its scalar.
+func (g *generator) inlined(p rdf.VocabularyProperty) (string, bool) {
+	if len(p.Range) == 0 {
+		return jsonScalar, true
+	}
+	if len(p.Range) != 1 {
+		return "", false
+	}
+	return g.scalar(p.Range[0])
+}
+
+// useScalar records that the scalar is used, so that it is declared if it is
+// custom.
+func (g *generator) useScalar(s string) {
+	if !builtinScalars[s] {
+		g.scalars[s] = true
+	}
+}
+
+// propertyUnion renders the union of the values of a property.
+func (g *generator) propertyUnion(b *bytes.Buffer, p rdf.VocabularyProperty) {
+	name := camelCase(p.Name) + propertySuffix
+	members := g.members(p)
+	description(b, "", fmt.Sprintf("%s is a value of the '%s' property.", name, p.Name))
+	b.WriteString(fmt.Sprintf("union %s = %s\n\n", name, strings.Join(members, " | ")))
+}
+
+// description renders the notes as a GraphQL description with the indentation.
+func description(b *bytes.Buffer, indent, notes string) {
+	notes = strings.TrimSpace(notes)
+	if len(notes) == 0 {
+		return
+	}
+	notes = strings.Replace(notes, `"""`, `\"""`, -1)
+	if !strings.Contains(notes, "\n") {
+		b.WriteString(fmt.Sprintf("%s\"\"\"%s\"\"\"\n", indent, notes))
+		return
+	}
+	b.WriteString(indent + "\"\"\"\n")
+	for _, line := range strings.Split(notes, "\n") {
+		b.WriteString(indent + strings.TrimSpace(line) + "\n")
+	}
+	b.WriteString(indent + "\"\"\"\n")
+}
+
+// fieldName is the name of the field of a property, which is prefixed by the
+// alias of its vocabulary if it belongs to another one.
+func fieldName(r rdf.VocabularyReference) string {
+	name := r.Name
+	if len(r.Alias) > 0 {
+		name = r.Alias + "_" + name
+	}
+	return strings.Map(func(c rune) rune {
+		if c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c) {
+			return c
+		}
+		return '_'
+	}, name)
+}
+
+// camelCase converts a name such as "mediaType" into "MediaType".
+func camelCase(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// contains determines whether the reference is in the slice.
+func contains(rs []rdf.VocabularyReference, r rdf.VocabularyReference) bool {
+	for _, o := range rs {
+		if o == r {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the sorted keys of the set.
+func sortedKeys(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
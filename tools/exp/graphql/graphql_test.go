@@ -0,0 +1,125 @@
+package graphql
+
+import (
+	"bytes"
+	"flag"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update_golden", false, "Write the golden file of TestGenerate instead of comparing against it")
+
+// testVocabulary is a small vocabulary with a type hierarchy, functional and
+// non-functional properties, literal values, a natural language map, and a
+// property of another vocabulary.
+func testVocabulary() *rdf.ParsedVocabulary {
+	const ns = "https://example.com/ns#"
+	return &rdf.ParsedVocabulary{
+		Types: map[string]rdf.VocabularyType{
+			"Object": {
+				Name:  "Object",
+				URI:   ns + "Object",
+				Notes: "Any kind of object.",
+				Properties: []rdf.VocabularyReference{
+					{Name: "attachedTo", URI: ns + "attachedTo"},
+					{Name: "content", URI: ns + "content"},
+					{Name: "published", URI: ns + "published"},
+				},
+			},
+			"Note": {
+				Name:         "Note",
+				URI:          ns + "Note",
+				Notes:        "A short written work.",
+				DisjointWith: []rdf.VocabularyReference{{Name: "Link", URI: ns + "Link"}},
+				Extends:      []rdf.VocabularyReference{{Name: "Object", URI: ns + "Object"}},
+				Properties: []rdf.VocabularyReference{
+					{Name: "sensitive", URI: ns + "sensitive"},
+					{Name: "publicKey", URI: "https://w3id.org/security#publicKey", Alias: "sec"},
+				},
+			},
+			"Link": {
+				Name:  "Link",
+				URI:   ns + "Link",
+				Notes: "A reference to a resource.",
+				Properties: []rdf.VocabularyReference{
+					{Name: "href", URI: ns + "href"},
+				},
+			},
+		},
+		Properties: map[string]rdf.VocabularyProperty{
+			"attachedTo": {
+				Name:   "attachedTo",
+				URI:    ns + "attachedTo",
+				Notes:  "What the object is attached to.",
+				Domain: []rdf.VocabularyReference{{Name: "Object", URI: ns + "Object"}},
+				Range: []rdf.VocabularyReference{
+					{Name: "Object", URI: ns + "Object"},
+					{Name: "Link", URI: ns + "Link"},
+				},
+			},
+			"content": {
+				Name:               "content",
+				URI:                ns + "content",
+				Notes:              "The content of the object.",
+				Domain:             []rdf.VocabularyReference{{Name: "Object", URI: ns + "Object"}},
+				Range:              []rdf.VocabularyReference{{Name: "string", URI: rdf.XSDString}},
+				NaturalLanguageMap: true,
+			},
+			"published": {
+				Name:       "published",
+				URI:        ns + "published",
+				Notes:      "When the object was published.",
+				Domain:     []rdf.VocabularyReference{{Name: "Object", URI: ns + "Object"}},
+				Range:      []rdf.VocabularyReference{{Name: "dateTime", URI: rdf.XSDDateTime}},
+				Functional: true,
+			},
+			"sensitive": {
+				Name:       "sensitive",
+				URI:        ns + "sensitive",
+				Notes:      "Whether the note is sensitive.",
+				Domain:     []rdf.VocabularyReference{{Name: "Note", URI: ns + "Note"}},
+				Range:      []rdf.VocabularyReference{{Name: "boolean", URI: rdf.XSDBoolean}},
+				Functional: true,
+			},
+			"href": {
+				Name:       "href",
+				URI:        ns + "href",
+				Notes:      "The target of the link.",
+				Domain:     []rdf.VocabularyReference{{Name: "Link", URI: ns + "Link"}},
+				Range:      []rdf.VocabularyReference{{Name: "anyURI", URI: rdf.XSDAnyURI}},
+				Functional: true,
+			},
+		},
+		Values: map[string]rdf.VocabularyValue{
+			rdf.XSDString:   {Name: "string", URI: rdf.XSDString},
+			rdf.XSDDateTime: {Name: "dateTime", URI: rdf.XSDDateTime},
+			rdf.XSDBoolean:  {Name: "boolean", URI: rdf.XSDBoolean},
+			rdf.XSDAnyURI:   {Name: "anyURI", URI: rdf.XSDAnyURI},
+		},
+	}
+}
+
+// TestGenerate renders the test vocabulary and compares the result against its
+// golden file. Run it with -update_golden to write the golden file after an
+// intentional change.
+func TestGenerate(t *testing.T) {
+	f, err := Generate(testVocabulary())
+	if err != nil {
+		t.Fatalf("Generate returned error: %s", err)
+	}
+	golden := filepath.Join("testdata", "golden", "schema.graphql")
+	if *updateGolden {
+		if err = ioutil.WriteFile(golden, f.Content, 0644); err != nil {
+			t.Fatalf("Cannot write golden file: %s", err)
+		}
+		return
+	}
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Cannot read golden file: %s", err)
+	} else if !bytes.Equal(expected, f.Content) {
+		t.Errorf("Expected generated GraphQL schema to match %s, got:\n%s", golden, f.Content)
+	}
+}
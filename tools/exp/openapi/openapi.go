@@ -0,0 +1,269 @@
+// Package openapi renders a parsed vocabulary as OpenAPI component schemas, so
+// that REST APIs accepting or returning ActivityStreams documents can refer to
+// accurate schemas in their documentation and validators.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	"sort"
+	"unicode"
+)
+
+const (
+	// documentName is the name of the rendered file.
+	documentName = "openapi.json"
+	// openAPIVersion is the version of the OpenAPI specification the
+	// document follows, which uses JSON Schema 2020-12 for its schemas.
+	openAPIVersion = "3.1.0"
+	// schemaRef is the prefix of a reference to a component schema.
+	schemaRef = "#/components/schemas/"
+	// propertySuffix is appended to the name of a property to name the
+	// schema of one of its values.
+	propertySuffix = "Property"
+	// mapSuffix is appended to the name of a property to name the member
+	// holding its natural language map.
+	mapSuffix   = "Map"
+	contextKey  = "@context"
+	idKey       = "id"
+	typeKey     = "type"
+	iriFormat   = "iri"
+	jsonObject  = "object"
+	jsonArray   = "array"
+	jsonString  = "string"
+	jsonNumber  = "number"
+	jsonInteger = "integer"
+	jsonBoolean = "boolean"
+)
+
+// schema is a JSON Schema.
+type schema map[string]interface{}
+
+// scalars are the schemas of the literal values, keyed by their URI. Literal
+// values not listed are held as strings.
+var scalars = map[string]schema{
+	rdf.XSDString:             {"type": jsonString},
+	rdf.XSDAnyURI:             {"type": jsonString, "format": iriFormat},
+	rdf.XSDBoolean:            {"type": jsonBoolean},
+	rdf.XSDDateTime:           {"type": jsonString, "format": "date-time"},
+	rdf.XSDDuration:           {"type": jsonString, "format": "duration"},
+	rdf.XSDFloat:              {"type": jsonNumber},
+	rdf.XSDNonNegativeInteger: {"type": jsonInteger, "minimum": 0},
+	rdf.RDFLangString:         {"type": jsonString},
+	rdf.RDFJSON:               {},
+}
+
+// File is a rendered OpenAPI document.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate renders the vocabulary as an OpenAPI 3.1 document with the title
+// and version, holding a component schema for each type and for each property
+// whose range holds more than a single literal value. The schema of a type
+// lists all of its properties, including inherited ones.
+//
+// A property that is not functional may hold either a single value or an
+// array of them, matching how the values are serialized. The value of a
+// property whose range has types is any of those types or the types extending
+// them, or an IRI.
+func Generate(v *rdf.ParsedVocabulary, title, version string) (*File, error) {
+	g := &generator{v: v}
+	schemas := make(map[string]schema)
+	for name, t := range v.Types {
+		s, err := g.typeSchema(t)
+		if err != nil {
+			return nil, fmt.Errorf("rendering schema for type %s: %s", name, err)
+		}
+		schemas[name] = s
+	}
+	for name, p := range v.Properties {
+		if _, ok := g.inlined(p); ok {
+			continue
+		}
+		s := g.propertySchema(p)
+		if _, ok := schemas[s.name]; ok {
+			return nil, fmt.Errorf("rendering schema for property %s: %s is also a type", name, s.name)
+		}
+		schemas[s.name] = s.schema
+	}
+	doc := map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":   title,
+			"version": version,
+		},
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &File{Name: documentName, Content: append(b, '\n')}, nil
+}
+
+// generator holds the vocabulary while rendering the schemas.
+type generator struct {
+	v *rdf.ParsedVocabulary
+}
+
+// typeSchema renders the schema of a type.
+func (g *generator) typeSchema(t rdf.VocabularyType) (schema, error) {
+	props := map[string]interface{}{
+		contextKey: schema{},
+		idKey:      schema{"type": jsonString, "format": iriFormat},
+		typeKey:    oneOrMore(schema{"type": jsonString}),
+	}
+	for _, r := range g.v.AllProperties(t) {
+		name := r.Name
+		if len(r.Alias) > 0 {
+			name = r.Alias + rdf.ALIAS_DELIMITER + r.Name
+		}
+		if _, ok := props[name]; ok {
+			return nil, fmt.Errorf("more than one property is named %s", name)
+		}
+		p, ok := g.v.Properties[r.Name]
+		if !ok || len(r.Alias) > 0 {
+			props[name] = schema{}
+			continue
+		}
+		s, ok := g.inlined(p)
+		if !ok {
+			s = schema{"$ref": schemaRef + camelCase(p.Name) + propertySuffix}
+		} else {
+			s = copySchema(s)
+		}
+		if !p.Functional {
+			s = oneOrMore(s)
+		}
+		if len(p.Notes) > 0 {
+			s["description"] = p.Notes
+		}
+		props[name] = s
+		if p.NaturalLanguageMap {
+			props[name+mapSuffix] = schema{
+				"type":                 jsonObject,
+				"additionalProperties": schema{"type": jsonString},
+				"description":          fmt.Sprintf("The values of %s in each language.", p.Name),
+			}
+		}
+	}
+	s := schema{
+		"type":       jsonObject,
+		"properties": props,
+	}
+	if len(t.Notes) > 0 {
+		s["description"] = t.Notes
+	}
+	return s, nil
+}
+
+// namedSchema is a component schema and its name.
+type namedSchema struct {
+	name   string
+	schema schema
+}
+
+// propertySchema renders the schema of a value of a property, which is any of
+// the types in its range or extending them, any of its literal values, or an
+// IRI.
+func (g *generator) propertySchema(p rdf.VocabularyProperty) namedSchema {
+	seen := make(map[string]bool)
+	var types []string
+	var values []schema
+	iri := false
+	for _, r := range p.Range {
+		if _, ok := g.v.Types[r.Name]; ok && len(r.Alias) == 0 {
+			iri = true
+			for _, name := range append([]string{r.Name}, g.v.Descendants(r.Name)...) {
+				if !seen[name] {
+					seen[name] = true
+					types = append(types, name)
+				}
+			}
+		} else if s, ok := g.scalar(r); ok {
+			if !seen[r.URI] {
+				seen[r.URI] = true
+				values = append(values, copySchema(s))
+			}
+		} else {
+			iri = true
+		}
+	}
+	sort.Strings(types)
+	var anyOf []schema
+	if iri {
+		anyOf = append(anyOf, schema{"type": jsonString, "format": iriFormat})
+	}
+	anyOf = append(anyOf, values...)
+	for _, t := range types {
+		anyOf = append(anyOf, schema{"$ref": schemaRef + t})
+	}
+	return namedSchema{
+		name: camelCase(p.Name) + propertySuffix,
+		schema: schema{
+			"description": fmt.Sprintf("A value of the '%s' property.", p.Name),
+			"anyOf":       anyOf,
+		},
+	}
+}
+
+// scalar determines the schema of a reference to a literal value.
+func (g *generator) scalar(r rdf.VocabularyReference) (schema, bool) {
+	if s, ok := scalars[r.URI]; ok {
+		return s, true
+	} else if _, ok := g.v.Values[r.URI]; ok {
+		return scalars[rdf.XSDString], true
+	}
+	return nil, false
+}
+
+// inlined determines whether the property holds a single kind of literal value
+// that needs no component schema, and its schema.
+func (g *generator) inlined(p rdf.VocabularyProperty) (schema, bool) {
+	if len(p.Range) == 0 {
+		return schema{}, true
+	}
+	if len(p.Range) != 1 {
+		return nil, false
+	}
+	return g.scalar(p.Range[0])
+}
+
+// oneOrMore returns the schema of either a single value or an array of values
+// of the schema. A schema accepting any value already accepts arrays.
+func oneOrMore(s schema) schema {
+	if len(s) == 0 {
+		return schema{}
+	}
+	return schema{
+		"oneOf": []schema{
+			s,
+			{"type": jsonArray, "items": s},
+		},
+	}
+}
+
+// copySchema returns a shallow copy of the schema, so that shared schemas are
+// not modified when describing a property.
+func copySchema(s schema) schema {
+	c := make(schema, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+// camelCase converts a name such as "mediaType" into "MediaType".
+func camelCase(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
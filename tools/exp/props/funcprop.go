@@ -52,6 +52,15 @@ func (p *FunctionalPropertyGenerator) Definition() *codegen.Struct {
 	return p.cachedStruct
 }
 
+// InterfaceDefinition produces the Go interface that the generated property
+// satisfies, allowing application code to mock it.
+func (p *FunctionalPropertyGenerator) InterfaceDefinition() *codegen.Interface {
+	return p.Definition().ToInterface(
+		p.packageName(),
+		p.InterfaceName(),
+		fmt.Sprintf("%s is satisfied by %s.", p.InterfaceName(), p.StructName()))
+}
+
 // clearNonLanguageMapMembers generates the code required to clear all values,
 // including unknown values, from this property except for the natural language
 // map. If this property can handle a natural language map, then it is up to the
@@ -53,6 +53,21 @@ func (p *NonFunctionalPropertyGenerator) Definitions() (*codegen.Struct, *codege
 	return p.cachedStruct, p.cachedTypedef
 }
 
+// InterfaceDefinitions produces the Go interfaces that the generated iterator
+// and property satisfy, allowing application code to mock them.
+func (p *NonFunctionalPropertyGenerator) InterfaceDefinitions() (*codegen.Interface, *codegen.Interface) {
+	iterator, property := p.Definitions()
+	iteratorGen := p.elementTypeGenerator()
+	return iterator.ToInterface(
+			p.packageName(),
+			iteratorGen.InterfaceName(),
+			fmt.Sprintf("%s is satisfied by %s.", iteratorGen.InterfaceName(), iteratorGen.StructName())),
+		property.ToInterface(
+			p.packageName(),
+			p.InterfaceName(),
+			fmt.Sprintf("%s is satisfied by %s.", p.InterfaceName(), p.StructName()))
+}
+
 // iteratorTypeName determines the identifier to use for the iterator type.
 func (p *NonFunctionalPropertyGenerator) iteratorTypeName() Identifier {
 	return Identifier{
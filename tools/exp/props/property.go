@@ -30,6 +30,8 @@ const (
 	hasLanguageMethod         = "HasLanguage"
 	getLanguageMethod         = "GetLanguage"
 	setLanguageMethod         = "SetLanguage"
+	// Suffix of the names of interfaces for generated code
+	interfaceSuffix = "Interface"
 	// Member names for generated code
 	unknownMemberName = "unknown"
 	langMapMember     = "langMap"
@@ -96,6 +98,12 @@ func (p *PropertyGenerator) StructName() string {
 	return fmt.Sprintf("%sProperty", p.Name.CamelName)
 }
 
+// InterfaceName returns the name of the interface capturing the exported
+// methods of the type generated for this property.
+func (p *PropertyGenerator) InterfaceName() string {
+	return fmt.Sprintf("%s%s", p.StructName(), interfaceSuffix)
+}
+
 // PropertyName returns the name of this property, as defined in
 // specifications. It is not suitable for use in generated code function
 // identifiers.
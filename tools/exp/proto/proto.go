@@ -0,0 +1,302 @@
+// Package proto renders a parsed vocabulary as Protocol Buffers definitions,
+// so that services can pass its types to each other over gRPC without going
+// through JSON.
+package proto
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	durationProto  = "google/protobuf/duration.proto"
+	structProto    = "google/protobuf/struct.proto"
+	timestampProto = "google/protobuf/timestamp.proto"
+	// valueMessage holds any JSON value, for the properties whose range is
+	// not known.
+	valueMessage = "google.protobuf.Value"
+	// propertySuffix is appended to the name of a property to name the
+	// message holding one of its values.
+	propertySuffix = "Property"
+	// mapSuffix is appended to the name of a property to name the field
+	// holding its natural language map.
+	mapSuffix = "_map"
+	// scalarSuffix is appended to the name of a value to name its field in
+	// the oneof of a property.
+	scalarSuffix = "_value"
+	iriField     = "iri"
+	idField      = "id"
+	typeField    = "type"
+)
+
+// scalar is the Protocol Buffers type of a literal value, and the file that
+// must be imported to use it.
+type scalar struct {
+	Type   string
+	Import string
+}
+
+// scalars are the Protocol Buffers types of the literal values, keyed by their
+// URI. Literal values not listed are held as strings.
+var scalars = map[string]scalar{
+	rdf.XSDString:             {Type: "string"},
+	rdf.XSDAnyURI:             {Type: "string"},
+	rdf.XSDBoolean:            {Type: "bool"},
+	rdf.XSDDateTime:           {Type: "google.protobuf.Timestamp", Import: timestampProto},
+	rdf.XSDDuration:           {Type: "google.protobuf.Duration", Import: durationProto},
+	rdf.XSDFloat:              {Type: "double"},
+	rdf.XSDNonNegativeInteger: {Type: "uint64"},
+	rdf.RDFLangString:         {Type: "string"},
+	rdf.RDFJSON:               {Type: valueMessage, Import: structProto},
+}
+
+// File is a rendered Protocol Buffers file.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate renders the vocabulary as a proto3 file in the package, named after
+// the package with underscores instead of dots. Each type is a message with a
+// field for each of its properties, including inherited ones. A property whose
+// range holds more than a single literal value has a message wrapping a oneof
+// of its alternatives: any type in its range or extending one, any literal
+// value, and an IRI.
+//
+// Fields are numbered in the order of their names, so adding properties to
+// the vocabulary renumbers the fields after them.
+func Generate(v *rdf.ParsedVocabulary, pkg string) (*File, error) {
+	g := &generator{v: v, imports: make(map[string]bool)}
+	var body bytes.Buffer
+	for _, name := range g.typeNames() {
+		if err := g.typeMessage(&body, v.Types[name]); err != nil {
+			return nil, fmt.Errorf("rendering message for type %s: %s", name, err)
+		}
+	}
+	for _, name := range g.propertyNames() {
+		g.propertyMessage(&body, v.Properties[name])
+	}
+	var b bytes.Buffer
+	b.WriteString("// Code generated from a parsed vocabulary. DO NOT EDIT.\n\n")
+	b.WriteString("syntax = \"proto3\";\n\n")
+	b.WriteString(fmt.Sprintf("package %s;\n\n", pkg))
+	imports := make([]string, 0, len(g.imports))
+	for i := range g.imports {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+	for _, i := range imports {
+		b.WriteString(fmt.Sprintf("import %q;\n", i))
+	}
+	if len(imports) > 0 {
+		b.WriteString("\n")
+	}
+	b.Write(bytes.TrimRight(body.Bytes(), "\n"))
+	b.WriteString("\n")
+	return &File{Name: strings.Replace(pkg, ".", "_", -1) + ".proto", Content: b.Bytes()}, nil
+}
+
+// generator holds the state while rendering the messages.
+type generator struct {
+	v       *rdf.ParsedVocabulary
+	imports map[string]bool
+}
+
+// typeNames returns the sorted names of the vocabulary's types.
+func (g *generator) typeNames() []string {
+	names := make([]string, 0, len(g.v.Types))
+	for name := range g.v.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// propertyNames returns the sorted names of the vocabulary's properties that
+// need a message wrapping their values.
+func (g *generator) propertyNames() []string {
+	var names []string
+	for name, p := range g.v.Properties {
+		if _, ok := g.inlined(p); !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// typeMessage renders the message of a type.
+func (g *generator) typeMessage(b *bytes.Buffer, t rdf.VocabularyType) error {
+	comment(b, "", t.Notes)
+	b.WriteString(fmt.Sprintf("message %s {\n", t.Name))
+	b.WriteString(fmt.Sprintf("  // The IRI identifying the %s.\n", t.Name))
+	b.WriteString(fmt.Sprintf("  string %s = 1;\n", idField))
+	b.WriteString(fmt.Sprintf("  // The types of the %s, including %q.\n", t.Name, t.Name))
+	b.WriteString(fmt.Sprintf("  repeated string %s = 2;\n", typeField))
+	number := 3
+	fields := map[string]bool{idField: true, typeField: true}
+	for _, r := range g.v.AllProperties(t) {
+		name := snakeCase(r.Name)
+		if len(r.Alias) > 0 {
+			name = snakeCase(r.Alias) + "_" + name
+		}
+		if fields[name] {
+			return fmt.Errorf("more than one field is named %s", name)
+		}
+		fields[name] = true
+		p, ok := g.v.Properties[r.Name]
+		if !ok || len(r.Alias) > 0 {
+			g.imports[structProto] = true
+			b.WriteString(fmt.Sprintf("  // The %s property of another vocabulary.\n", r.Alias+rdf.ALIAS_DELIMITER+r.Name))
+			b.WriteString(fmt.Sprintf("  repeated %s %s = %d;\n", valueMessage, name, number))
+			number++
+			continue
+		}
+		comment(b, "  ", p.Notes)
+		b.WriteString("  ")
+		if !p.Functional {
+			b.WriteString("repeated ")
+		}
+		if s, ok := g.inlined(p); ok {
+			b.WriteString(s.Type)
+			if len(s.Import) > 0 {
+				g.imports[s.Import] = true
+			}
+		} else {
+			b.WriteString(camelCase(p.Name) + propertySuffix)
+		}
+		b.WriteString(fmt.Sprintf(" %s = %d;\n", name, number))
+		number++
+		if p.NaturalLanguageMap {
+			b.WriteString(fmt.Sprintf("  // The values of %s in each language.\n", p.Name))
+			b.WriteString(fmt.Sprintf("  map<string, string> %s = %d;\n", name+mapSuffix, number))
+			number++
+		}
+	}
+	b.WriteString("}\n\n")
+	return nil
+}
+
+// alternative is a field of the oneof of a property.
+type alternative struct {
+	Name string
+	Type string
+}
+
+// alternatives determines the fields of the oneof of a property, and whether
+// its values may be an IRI.
+func (g *generator) alternatives(p rdf.VocabularyProperty) (alts []alternative, iri bool) {
+	seen := make(map[string]bool)
+	add := func(a alternative) {
+		if !seen[a.Name] {
+			seen[a.Name] = true
+			alts = append(alts, a)
+		}
+	}
+	var types []alternative
+	for _, r := range p.Range {
+		if _, ok := g.v.Types[r.Name]; ok && len(r.Alias) == 0 {
+			iri = true
+			for _, name := range append([]string{r.Name}, g.v.Descendants(r.Name)...) {
+				types = append(types, alternative{Name: snakeCase(name), Type: name})
+			}
+		} else if s, name, ok := g.scalar(r); ok {
+			if len(s.Import) > 0 {
+				g.imports[s.Import] = true
+			}
+			add(alternative{Name: snakeCase(name) + scalarSuffix, Type: s.Type})
+		} else {
+			iri = true
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name < types[j].Name
+	})
+	for _, t := range types {
+		add(t)
+	}
+	return
+}
+
+// scalar determines the Protocol Buffers type of a reference to a literal
+// value, and the name of the value.
+func (g *generator) scalar(r rdf.VocabularyReference) (scalar, string, bool) {
+	if s, ok := scalars[r.URI]; ok {
+		return s, r.Name, true
+	} else if v, ok := g.v.Values[r.URI]; ok {
+		return scalar{Type: "string"}, v.Name, true
+	}
+	return scalar{}, "", false
+}
+
+// inlined determines whether the property holds a single kind of literal value
+// that needs no message wrapping it, and its type.
+func (g *generator) inlined(p rdf.VocabularyProperty) (scalar, bool) {
+	if len(p.Range) == 0 {
+		return scalar{Type: valueMessage, Import: structProto}, true
+	}
+	if len(p.Range) != 1 {
+		return scalar{}, false
+	}
+	s, _, ok := g.scalar(p.Range[0])
+	return s, ok
+}
+
+// propertyMessage renders the message wrapping a value of a property.
+func (g *generator) propertyMessage(b *bytes.Buffer, p rdf.VocabularyProperty) {
+	alts, iri := g.alternatives(p)
+	b.WriteString(fmt.Sprintf("// %s%s is a value of the '%s' property.\n", camelCase(p.Name), propertySuffix, p.Name))
+	b.WriteString(fmt.Sprintf("message %s%s {\n", camelCase(p.Name), propertySuffix))
+	b.WriteString("  oneof value {\n")
+	number := 1
+	if iri {
+		b.WriteString(fmt.Sprintf("    string %s = %d;\n", iriField, number))
+		number++
+	}
+	for _, a := range alts {
+		b.WriteString(fmt.Sprintf("    %s %s = %d;\n", a.Type, a.Name, number))
+		number++
+	}
+	b.WriteString("  }\n")
+	b.WriteString("}\n\n")
+}
+
+// comment renders the notes as a comment with the indentation.
+func comment(b *bytes.Buffer, indent, notes string) {
+	for _, line := range strings.Split(strings.TrimSpace(notes), "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			b.WriteString(indent + "// " + line + "\n")
+		}
+	}
+}
+
+// snakeCase converts a name such as "mediaType" or "IRI" into "media_type" or
+// "iri".
+func snakeCase(s string) string {
+	r := []rune(s)
+	var b strings.Builder
+	for i, c := range r {
+		if unicode.IsUpper(c) && i > 0 && (unicode.IsLower(r[i-1]) || unicode.IsDigit(r[i-1]) || (i+1 < len(r) && unicode.IsLower(r[i+1]))) {
+			b.WriteRune('_')
+		}
+		if c == '-' || c == '.' {
+			c = '_'
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+	return b.String()
+}
+
+// camelCase converts a name such as "mediaType" into "MediaType".
+func camelCase(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,183 @@
+package rdf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	JSON_LD_TYPE      = "@type"
+	JSON_LD_CONTAINER = "@container"
+	JSON_LD_LANGUAGE  = "@language"
+	JSON_LD_JSON      = "@json"
+	// naturalLanguageMapSuffix is appended to the name of a property to
+	// obtain the term for its natural language map form.
+	naturalLanguageMapSuffix = "Map"
+)
+
+// splitNamespace splits an IRI into its namespace, ending in '#' or '/', and
+// the local name of the element within it.
+func splitNamespace(uri string) (namespace, local string) {
+	i := strings.LastIndexAny(uri, "#/")
+	if i < 0 {
+		return "", uri
+	}
+	return uri[:i+1], uri[i+1:]
+}
+
+// contextCompactor assigns prefixes to namespaces while building a context,
+// and tracks which of them the context uses.
+type contextCompactor struct {
+	prefixes map[string]string
+	used     map[string]bool
+}
+
+// addPrefix records the prefix for the namespace of the IRI, unless the prefix
+// or the namespace already has one.
+func (c *contextCompactor) addPrefix(prefix, uri string) {
+	namespace, _ := splitNamespace(uri)
+	if len(prefix) == 0 || len(namespace) == 0 {
+		return
+	} else if _, ok := c.prefixes[prefix]; ok {
+		return
+	}
+	for _, ns := range c.prefixes {
+		if ns == namespace {
+			return
+		}
+	}
+	c.prefixes[prefix] = namespace
+}
+
+// compact shortens the IRI into a compact IRI if its namespace has a prefix.
+func (c *contextCompactor) compact(uri string) string {
+	namespace, local := splitNamespace(uri)
+	for prefix, ns := range c.prefixes {
+		if ns == namespace && len(local) > 0 {
+			c.used[prefix] = true
+			return prefix + ALIAS_DELIMITER + local
+		}
+	}
+	return uri
+}
+
+// Context creates the minimal JSON-LD document whose '@context' defines
+// exactly the types and properties of the vocabulary, suitable for servers to
+// host and reference in the payloads they send. The vocabulary's own namespace
+// is given the prefix alias, and elements of other vocabularies the alias they
+// were referenced with.
+//
+// Properties whose range only contains types of the vocabulary are coerced to
+// IRIs, those whose range is a single value are typed with it, and natural
+// language properties also define their map form.
+func (v *ParsedVocabulary) Context(alias string) (JSONLD, error) {
+	c := &contextCompactor{
+		prefixes: make(map[string]string),
+		used:     make(map[string]bool),
+	}
+	var typeNames, propertyNames []string
+	for name := range v.Types {
+		typeNames = append(typeNames, name)
+	}
+	for name := range v.Properties {
+		propertyNames = append(propertyNames, name)
+	}
+	sort.Strings(typeNames)
+	sort.Strings(propertyNames)
+	for _, name := range typeNames {
+		t := v.Types[name]
+		if len(t.URI) == 0 {
+			return nil, fmt.Errorf("type %s has no URI", name)
+		}
+		c.addPrefix(alias, t.URI)
+	}
+	for _, name := range propertyNames {
+		p := v.Properties[name]
+		if len(p.URI) == 0 {
+			return nil, fmt.Errorf("property %s has no URI", name)
+		}
+		c.addPrefix(alias, p.URI)
+		for _, r := range p.Range {
+			if len(r.URI) > 0 {
+				c.addPrefix(r.Alias, r.URI)
+			}
+		}
+		if p.ReverseOf != nil && len(p.ReverseOf.URI) > 0 {
+			c.addPrefix(p.ReverseOf.Alias, p.ReverseOf.URI)
+		}
+	}
+	ctx := make(map[string]interface{}, len(typeNames)+len(propertyNames))
+	define := func(term string, definition interface{}) error {
+		if _, ok := ctx[term]; ok {
+			return fmt.Errorf("term %s is defined more than once", term)
+		}
+		ctx[term] = definition
+		return nil
+	}
+	for _, name := range typeNames {
+		if err := define(name, c.compact(v.Types[name].URI)); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range propertyNames {
+		p := v.Properties[name]
+		var definition interface{}
+		if p.IsReverse() {
+			of := p.ReverseOf.URI
+			if len(of) == 0 {
+				if target, ok := v.Properties[p.ReverseOf.Name]; ok {
+					of = target.URI
+				}
+			}
+			definition = map[string]interface{}{JSON_LD_REVERSE: c.compact(of)}
+		} else if t, ok := v.rangeType(p); ok {
+			definition = map[string]interface{}{
+				ID:           c.compact(p.URI),
+				JSON_LD_TYPE: c.compact(t),
+			}
+		} else {
+			definition = c.compact(p.URI)
+		}
+		if err := define(name, definition); err != nil {
+			return nil, err
+		}
+		if p.NaturalLanguageMap {
+			if err := define(name+naturalLanguageMapSuffix, map[string]interface{}{
+				ID:                c.compact(p.URI),
+				JSON_LD_CONTAINER: JSON_LD_LANGUAGE,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for prefix := range c.used {
+		if err := define(prefix, c.prefixes[prefix]); err != nil {
+			return nil, err
+		}
+	}
+	return JSONLD{JSON_LD_CONTEXT: ctx}, nil
+}
+
+// rangeType determines the type a property's values are coerced to, if any.
+// It is "@id" when the range only contains types of the vocabulary, "@json"
+// for rdf:JSON, and the value's IRI when the range is a single value.
+func (v *ParsedVocabulary) rangeType(p VocabularyProperty) (string, bool) {
+	if len(p.Range) == 0 {
+		return "", false
+	}
+	if len(p.Range) == 1 {
+		if val, ok := v.Values[p.Range[0].URI]; ok {
+			if val.URI == rdfSpec+jsonSpec {
+				return JSON_LD_JSON, true
+			}
+			return val.URI, true
+		}
+	}
+	for _, r := range p.Range {
+		if _, ok := v.Types[r.Name]; !ok || len(r.Alias) > 0 {
+			return "", false
+		}
+	}
+	return ID, true
+}
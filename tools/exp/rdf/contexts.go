@@ -0,0 +1,88 @@
+package rdf
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// bundled contains offline copies of well-known JSON-LD contexts.
+//
+//go:embed contexts/*.jsonld
+var bundled embed.FS
+
+const (
+	ActivityStreamsSpec = "https://www.w3.org/ns/activitystreams"
+	SecurityV1Spec      = "https://w3id.org/security/v1"
+	// TootSpec is the Mastodon namespace. Mastodon does not publish its
+	// context as a document, so the bundled copy contains the terms it
+	// defines inline.
+	TootSpec = "http://joinmastodon.org/ns"
+	// ForgeFedSpec is the ForgeFed namespace, federating code forges.
+	ForgeFedSpec = "https://forgefed.org/ns"
+)
+
+// bundledFiles maps specification URIs to their bundled copies.
+var bundledFiles = map[string]string{
+	ActivityStreamsSpec: "contexts/activitystreams.jsonld",
+	SecurityV1Spec:      "contexts/security-v1.jsonld",
+	TootSpec:            "contexts/toot.jsonld",
+	ForgeFedSpec:        "contexts/forgefed.jsonld",
+}
+
+// normalizeSpec strips the trailing fragment delimiter that specification URIs
+// are sometimes written with.
+func normalizeSpec(uri string) string {
+	return strings.TrimSuffix(uri, "#")
+}
+
+// HasBundledContext returns true if there is a bundled copy of the context for
+// the specification URI.
+func HasBundledContext(uri string) bool {
+	_, ok := bundledFiles[normalizeSpec(uri)]
+	return ok
+}
+
+// BundledContext returns the bundled copy of the context for the specification
+// URI.
+func BundledContext(uri string) (JSONLD, error) {
+	name, ok := bundledFiles[normalizeSpec(uri)]
+	if !ok {
+		return nil, fmt.Errorf("no bundled context for %s", uri)
+	}
+	b, err := bundled.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var j JSONLD
+	if err = json.Unmarshal(b, &j); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// ContextLoader obtains JSON-LD documents by their URI, either from the
+// network or from the bundled copies.
+type ContextLoader struct {
+	// PreferBundled uses the bundled copy of a context when there is one,
+	// without fetching it. This makes parsing and generating fully offline
+	// and deterministic.
+	PreferBundled bool
+	// Fetch retrieves a document from the network, such as the Fetch
+	// method of an HTTPFetcher. If nil, only bundled copies are available.
+	Fetch func(uri string) (JSONLD, error)
+}
+
+// Load obtains the document for the URI. When fetching fails and a bundled
+// copy exists, the bundled copy is returned instead.
+func (l *ContextLoader) Load(uri string) (JSONLD, error) {
+	if l.Fetch == nil || (l.PreferBundled && HasBundledContext(uri)) {
+		return BundledContext(uri)
+	}
+	j, err := l.Fetch(uri)
+	if err != nil && HasBundledContext(uri) {
+		return BundledContext(uri)
+	}
+	return j, err
+}
@@ -0,0 +1,92 @@
+package rdf
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/tools/exp/codegen"
+)
+
+// ParsedVocabulary is the internal data structure produced after parsing the
+// definition of an ActivityStream vocabulary. It is the intermediate
+// understanding of the specification in the context of certain ontologies.
+//
+// At the end of parsing, the ParsedVocabulary is not guaranteed to be
+// semantically valid, just that the parser resolved all important ontological
+// details.
+type ParsedVocabulary struct {
+	Types      map[string]VocabularyType
+	Properties map[string]VocabularyProperty
+	// Values are the literal value types available as the range of
+	// properties, keyed by their URI.
+	Values map[string]VocabularyValue
+}
+
+// newParsedVocabulary creates an empty ParsedVocabulary ready to be populated.
+func newParsedVocabulary() *ParsedVocabulary {
+	return &ParsedVocabulary{
+		Types:      make(map[string]VocabularyType, 0),
+		Properties: make(map[string]VocabularyProperty, 0),
+		Values:     make(map[string]VocabularyValue, 0),
+	}
+}
+
+// VocabularyReference refers to another type or property, which may belong to
+// a different ontology than the one being parsed.
+type VocabularyReference struct {
+	// Name is the name of the referenced type or property.
+	Name string
+	// URI is the full IRI of the referenced element, if known.
+	URI string
+	// Alias is the context alias used for the referenced element's
+	// ontology. Empty if it is in the vocabulary being parsed.
+	Alias string
+}
+
+// VocabularyValue is a literal value type that a property's range may refer
+// to, along with the Go code needed to handle it.
+type VocabularyValue struct {
+	Name string
+	URI  string
+	// DefinitionType is the Go type holding the value.
+	DefinitionType jen.Code
+	// IsNilable is true if the zero value of the DefinitionType is nil.
+	IsNilable     bool
+	SerializeFn   *codegen.Function
+	DeserializeFn *codegen.Function
+	LessFn        *codegen.Function
+}
+
+// VocabularyType is an ActivityStreams type, or its extension equivalent.
+type VocabularyType struct {
+	Name              string
+	URI               string
+	Notes             string
+	DisjointWith      []VocabularyReference
+	Extends           []VocabularyReference
+	Properties        []VocabularyReference
+	WithoutProperties []VocabularyReference
+}
+
+// VocabularyProperty is an ActivityStreams property, or its extension
+// equivalent.
+type VocabularyProperty struct {
+	Name               string
+	URI                string
+	Notes              string
+	Domain             []VocabularyReference
+	Range              []VocabularyReference
+	SubpropertyOf      VocabularyReference
+	Functional         bool
+	NaturalLanguageMap bool
+	// ReverseOf is set when this property is defined in a context as the
+	// "@reverse" of another property. Serializing it uses this property's
+	// own name.
+	ReverseOf *VocabularyReference
+	// Reverses contains the properties that are defined as the reverse of
+	// this one.
+	Reverses []VocabularyReference
+}
+
+// IsReverse returns true if this property is the reverse of another.
+func (p VocabularyProperty) IsReverse() bool {
+	return p.ReverseOf != nil
+}
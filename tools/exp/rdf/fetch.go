@@ -0,0 +1,169 @@
+package rdf
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+const (
+	acceptHeader          = "Accept"
+	etagHeader            = "ETag"
+	lastModifiedHeader    = "Last-Modified"
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+	jsonLDMediaType       = "application/ld+json, application/json;q=0.9"
+)
+
+// cachedSpec is a specification fetched over HTTP and kept on disk, along with
+// the validators used to revalidate it.
+type cachedSpec struct {
+	URI          string
+	ETag         string `json:",omitempty"`
+	LastModified string `json:",omitempty"`
+	Body         []byte
+}
+
+// HTTPFetcher retrieves specifications over HTTP. When CacheDir is set, each
+// fetched document is kept on disk and revalidated with its ETag or
+// Last-Modified time on later fetches. An unchanged document is then read from
+// the cache byte-for-byte, so repeated generation runs produce identical
+// output and do not download the specification again.
+//
+// Its Fetch method may be used as the Fetch function of a ContextLoader.
+type HTTPFetcher struct {
+	// Client makes the requests. If nil, http.DefaultClient is used.
+	Client *http.Client
+	// CacheDir is the directory holding cached documents. If empty,
+	// documents are not cached.
+	CacheDir string
+}
+
+// Fetch obtains the JSON-LD document at the URI. If the request fails but a
+// cached copy exists, the cached copy is used.
+func (h *HTTPFetcher) Fetch(uri string) (JSONLD, error) {
+	b, err := h.fetchBytes(uri)
+	if err != nil {
+		return nil, err
+	}
+	var j JSONLD
+	if err = json.Unmarshal(b, &j); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %s", uri, err)
+	}
+	return j, nil
+}
+
+// fetchBytes obtains the raw document at the URI, revalidating any cached
+// copy.
+func (h *HTTPFetcher) fetchBytes(uri string) ([]byte, error) {
+	cached, err := h.readCache(uri)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(acceptHeader, jsonLDMediaType)
+	if cached != nil {
+		if len(cached.ETag) > 0 {
+			req.Header.Set(ifNoneMatchHeader, cached.ETag)
+		}
+		if len(cached.LastModified) > 0 {
+			req.Header.Set(ifModifiedSinceHeader, cached.LastModified)
+		}
+	}
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		if cached != nil {
+			return cached.Body, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return cached.Body, nil
+	} else if resp.StatusCode != http.StatusOK {
+		if cached != nil {
+			return cached.Body, nil
+		}
+		return nil, fmt.Errorf("fetching %s: unexpected status %s", uri, resp.Status)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	err = h.writeCache(&cachedSpec{
+		URI:          uri,
+		ETag:         resp.Header.Get(etagHeader),
+		LastModified: resp.Header.Get(lastModifiedHeader),
+		Body:         b,
+	})
+	return b, err
+}
+
+// cachePath is the file a URI is cached in.
+func (h *HTTPFetcher) cachePath(uri string) string {
+	sum := sha256.Sum256([]byte(uri))
+	return filepath.Join(h.CacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// readCache reads the cached copy of the URI. It returns nil without an error
+// when caching is disabled or nothing is cached.
+func (h *HTTPFetcher) readCache(uri string) (*cachedSpec, error) {
+	if len(h.CacheDir) == 0 {
+		return nil, nil
+	}
+	b, err := ioutil.ReadFile(h.cachePath(uri))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	c := &cachedSpec{}
+	if err = json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("corrupt cache entry for %s: %s", uri, err)
+	} else if c.URI != uri {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// writeCache writes the copy of the URI to the cache, if caching is enabled.
+// Documents without an ETag or Last-Modified time are still cached, so that
+// they remain available when the network is not.
+func (h *HTTPFetcher) writeCache(c *cachedSpec) error {
+	if len(h.CacheDir) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(h.CacheDir, 0755); err != nil {
+		return err
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(h.CacheDir, "spec-")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), h.cachePath(c.URI))
+}
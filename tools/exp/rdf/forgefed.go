@@ -0,0 +1,269 @@
+package rdf
+
+import (
+	"fmt"
+)
+
+// ForgeFedNamespace is the namespace of the ForgeFed vocabulary, which its
+// context aliases as "forge".
+const ForgeFedNamespace = ForgeFedSpec + "#"
+
+// ForgeFedOntology is the Ontology for the ForgeFed vocabulary, the extension of
+// the ActivityStreams Vocabulary federating code forges. It defines the types
+// and properties of version control and ticket tracking, such as Repository,
+// Commit, Push, and Ticket, which extend the ActivityStreams types.
+type ForgeFedOntology struct{}
+
+var _ Ontology = &ForgeFedOntology{}
+
+// forgeRef refers to an element of the ForgeFed vocabulary.
+func forgeRef(name string) VocabularyReference {
+	return VocabularyReference{Name: name, URI: ForgeFedNamespace + name}
+}
+
+// asRef refers to an element of the ActivityStreams vocabulary.
+func asRef(name string) VocabularyReference {
+	return VocabularyReference{Name: name, URI: ActivityStreamsSpec + "#" + name, Alias: "as"}
+}
+
+// xsdRef refers to an XML Schema datatype.
+func xsdRef(name string) VocabularyReference {
+	return VocabularyReference{Name: name, URI: XMLSchemaSpec + name, Alias: "xsd"}
+}
+
+// forgeFedTypes are the types of the ForgeFed vocabulary. Their properties are
+// those whose domain they are.
+var forgeFedTypes = []VocabularyType{
+	{
+		Name:    "Repository",
+		Notes:   "A version control repository, an actor that receives the pushes and patches sent to it.",
+		Extends: []VocabularyReference{asRef("Object")},
+	},
+	{
+		Name:    "Branch",
+		Notes:   "A named reference to a version of a Repository, typically used to commit changes in parallel to other development.",
+		Extends: []VocabularyReference{asRef("Object")},
+	},
+	{
+		Name:    "Commit",
+		Notes:   "A named set of changes in the history of a Repository.",
+		Extends: []VocabularyReference{asRef("Object")},
+	},
+	{
+		Name:    "Push",
+		Notes:   "Indicates that new content has been pushed to a Repository. Its 'object' is an OrderedCollection of the Commits pushed, its 'target' the Branch, and its 'context' the Repository.",
+		Extends: []VocabularyReference{asRef("Activity")},
+	},
+	{
+		Name:    "TicketTracker",
+		Notes:   "An actor managing a list of Tickets, such as the issues of a project.",
+		Extends: []VocabularyReference{asRef("Object")},
+	},
+	{
+		Name:    "Ticket",
+		Notes:   "An item that requires work, such as a bug report or a feature request, tracked by a TicketTracker.",
+		Extends: []VocabularyReference{asRef("Object")},
+	},
+	{
+		Name:    "TicketDependency",
+		Notes:   "A Relationship whose 'subject' is a Ticket that depends on its 'object', another Ticket, being resolved first.",
+		Extends: []VocabularyReference{asRef("Relationship")},
+	},
+}
+
+// forgeFedProperties are the properties of the ForgeFed vocabulary.
+var forgeFedProperties = []VocabularyProperty{
+	{
+		Name:       "ref",
+		Notes:      "The full name of the reference of the Branch, such as refs/heads/main for Git.",
+		Domain:     []VocabularyReference{forgeRef("Branch")},
+		Range:      []VocabularyReference{xsdRef("string")},
+		Functional: true,
+	},
+	{
+		Name:       "hash",
+		Notes:      "The hash identifying the Commit, such as its SHA-1 for Git.",
+		Domain:     []VocabularyReference{forgeRef("Commit")},
+		Range:      []VocabularyReference{xsdRef("string")},
+		Functional: true,
+	},
+	{
+		Name:       "committedBy",
+		Notes:      "The actor that created the Commit, which may differ from its 'attributedTo', the author of its changes.",
+		Domain:     []VocabularyReference{forgeRef("Commit")},
+		Range:      []VocabularyReference{asRef("Object")},
+		Functional: true,
+	},
+	{
+		Name:       "committed",
+		Notes:      "The time at which the Commit was created, which may differ from its 'published' time, when its changes were authored.",
+		Domain:     []VocabularyReference{forgeRef("Commit")},
+		Range:      []VocabularyReference{xsdRef("dateTime")},
+		Functional: true,
+	},
+	{
+		Name:   "filesAdded",
+		Notes:  "The paths of the files the Commit adds.",
+		Domain: []VocabularyReference{forgeRef("Commit")},
+		Range:  []VocabularyReference{xsdRef("string")},
+	},
+	{
+		Name:   "filesModified",
+		Notes:  "The paths of the files the Commit modifies.",
+		Domain: []VocabularyReference{forgeRef("Commit")},
+		Range:  []VocabularyReference{xsdRef("string")},
+	},
+	{
+		Name:   "filesRemoved",
+		Notes:  "The paths of the files the Commit removes.",
+		Domain: []VocabularyReference{forgeRef("Commit")},
+		Range:  []VocabularyReference{xsdRef("string")},
+	},
+	{
+		Name:       "hashBefore",
+		Notes:      "The hash of the head of the Branch before the Push.",
+		Domain:     []VocabularyReference{forgeRef("Push")},
+		Range:      []VocabularyReference{xsdRef("string")},
+		Functional: true,
+	},
+	{
+		Name:       "hashAfter",
+		Notes:      "The hash of the head of the Branch after the Push.",
+		Domain:     []VocabularyReference{forgeRef("Push")},
+		Range:      []VocabularyReference{xsdRef("string")},
+		Functional: true,
+	},
+	{
+		Name:   "earlyItems",
+		Notes:  "The items of an OrderedCollection listed from least to most recent that come after its 'orderedItems', which are listed from most to least recent, such as the first Commits of a large Push.",
+		Domain: []VocabularyReference{asRef("OrderedCollection"), asRef("OrderedCollectionPage")},
+		Range:  []VocabularyReference{asRef("Object"), asRef("Link")},
+	},
+	{
+		Name:       "forks",
+		Notes:      "The collection of the forks of the Repository.",
+		Domain:     []VocabularyReference{forgeRef("Repository")},
+		Range:      []VocabularyReference{asRef("OrderedCollection")},
+		Functional: true,
+	},
+	{
+		Name:       "team",
+		Notes:      "The collection of the actors responsible for the Repository.",
+		Domain:     []VocabularyReference{forgeRef("Repository")},
+		Range:      []VocabularyReference{asRef("Collection")},
+		Functional: true,
+	},
+	{
+		Name:       "ticketsTrackedBy",
+		Notes:      "The actor tracking the Tickets of the Repository, which may be the Repository itself.",
+		Domain:     []VocabularyReference{forgeRef("Repository")},
+		Range:      []VocabularyReference{forgeRef("TicketTracker"), forgeRef("Repository")},
+		Functional: true,
+	},
+	{
+		Name:   "tracksTicketsFor",
+		Notes:  "The Repositories whose Tickets the TicketTracker tracks.",
+		Domain: []VocabularyReference{forgeRef("TicketTracker")},
+		Range:  []VocabularyReference{forgeRef("Repository")},
+	},
+	{
+		Name:       "assignedTo",
+		Notes:      "The actor working on the Ticket.",
+		Domain:     []VocabularyReference{forgeRef("Ticket")},
+		Range:      []VocabularyReference{asRef("Object")},
+		Functional: true,
+	},
+	{
+		Name:       "isResolved",
+		Notes:      "Whether the work the Ticket requires is done.",
+		Domain:     []VocabularyReference{forgeRef("Ticket")},
+		Range:      []VocabularyReference{xsdRef("boolean")},
+		Functional: true,
+	},
+	{
+		Name:       "resolvedBy",
+		Notes:      "The actor that resolved the Ticket.",
+		Domain:     []VocabularyReference{forgeRef("Ticket")},
+		Range:      []VocabularyReference{asRef("Object")},
+		Functional: true,
+	},
+	{
+		Name:       "resolved",
+		Notes:      "The time at which the Ticket was resolved.",
+		Domain:     []VocabularyReference{forgeRef("Ticket")},
+		Range:      []VocabularyReference{xsdRef("dateTime")},
+		Functional: true,
+	},
+	{
+		Name:   "dependsOn",
+		Notes:  "The Tickets that must be resolved before this Ticket.",
+		Domain: []VocabularyReference{forgeRef("Ticket")},
+		Range:  []VocabularyReference{forgeRef("Ticket")},
+	},
+	{
+		Name:   "dependedBy",
+		Notes:  "The Tickets that depend on this Ticket being resolved first.",
+		Domain: []VocabularyReference{forgeRef("Ticket")},
+		Range:  []VocabularyReference{forgeRef("Ticket")},
+	},
+}
+
+// String returns the namespace of the ForgeFed vocabulary.
+func (o *ForgeFedOntology) String() string {
+	return ForgeFedNamespace
+}
+
+// Load loads all the types and properties of the ForgeFed ontology.
+func (o *ForgeFedOntology) Load() ([]RDFNode, error) {
+	n := make([]RDFNode, 0, len(forgeFedTypes)+len(forgeFedProperties))
+	for _, t := range forgeFedTypes {
+		n = append(n, o.typeNode(t))
+	}
+	for _, p := range forgeFedProperties {
+		n = append(n, o.propertyNode(p))
+	}
+	return n, nil
+}
+
+// LoadAsAlias loads all the types and properties of the ForgeFed ontology. The
+// alias does not affect them.
+func (o *ForgeFedOntology) LoadAsAlias(s string) ([]RDFNode, error) {
+	return o.Load()
+}
+
+// LoadElement loads a single type or property of the ForgeFed ontology.
+func (o *ForgeFedOntology) LoadElement(name string, payload map[string]interface{}) ([]RDFNode, error) {
+	for _, t := range forgeFedTypes {
+		if t.Name == name {
+			return []RDFNode{o.typeNode(t)}, nil
+		}
+	}
+	for _, p := range forgeFedProperties {
+		if p.Name == name {
+			return []RDFNode{o.propertyNode(p)}, nil
+		}
+	}
+	return nil, fmt.Errorf("forgefed ontology has no element %q", name)
+}
+
+// typeNode creates the node of the type, whose properties are those whose
+// domain it is.
+func (o *ForgeFedOntology) typeNode(t VocabularyType) *typeNode {
+	t.URI = ForgeFedNamespace + t.Name
+	t.Properties = nil
+	for _, p := range forgeFedProperties {
+		for _, d := range p.Domain {
+			if len(d.Alias) == 0 && d.Name == t.Name {
+				t.Properties = append(t.Properties, forgeRef(p.Name))
+				break
+			}
+		}
+	}
+	return &typeNode{t}
+}
+
+// propertyNode creates the node of the property.
+func (o *ForgeFedOntology) propertyNode(p VocabularyProperty) *propertyNode {
+	p.URI = ForgeFedNamespace + p.Name
+	return &propertyNode{p}
+}
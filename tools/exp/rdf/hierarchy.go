@@ -0,0 +1,85 @@
+package rdf
+
+import (
+	"sort"
+)
+
+// Ancestors returns the types of the vocabulary that the type extends,
+// directly or not, nearest first. Types of other vocabularies are ignored.
+func (v *ParsedVocabulary) Ancestors(t VocabularyType) []VocabularyType {
+	var out []VocabularyType
+	seen := map[string]bool{t.Name: true}
+	queue := append([]VocabularyReference(nil), t.Extends...)
+	for len(queue) > 0 {
+		r := queue[0]
+		queue = queue[1:]
+		if len(r.Alias) > 0 || seen[r.Name] {
+			continue
+		}
+		seen[r.Name] = true
+		parent, ok := v.Types[r.Name]
+		if !ok {
+			continue
+		}
+		out = append(out, parent)
+		queue = append(queue, parent.Extends...)
+	}
+	return out
+}
+
+// Descendants returns the names of the types of the vocabulary that extend the
+// named type, directly or not, sorted by name.
+func (v *ParsedVocabulary) Descendants(name string) []string {
+	var out []string
+	for _, t := range v.Types {
+		if t.Name == name {
+			continue
+		}
+		for _, a := range v.Ancestors(t) {
+			if a.Name == name {
+				out = append(out, t.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// AllProperties returns the properties of the type, including the ones it
+// inherits from its ancestors in the vocabulary unless it or a nearer ancestor
+// is without them. They are sorted by name, with the properties of other
+// vocabularies after the ones of this vocabulary.
+func (v *ParsedVocabulary) AllProperties(t VocabularyType) []VocabularyReference {
+	without := make(map[string]bool, len(t.WithoutProperties))
+	for _, r := range t.WithoutProperties {
+		without[r.Name] = true
+	}
+	seen := make(map[VocabularyReference]bool)
+	var out []VocabularyReference
+	add := func(rs []VocabularyReference) {
+		for _, r := range rs {
+			if seen[r] || without[r.Name] {
+				continue
+			}
+			seen[r] = true
+			out = append(out, r)
+		}
+	}
+	add(t.Properties)
+	for _, a := range v.Ancestors(t) {
+		add(a.Properties)
+		for _, r := range a.WithoutProperties {
+			without[r.Name] = true
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if (len(out[i].Alias) == 0) != (len(out[j].Alias) == 0) {
+			return len(out[i].Alias) == 0
+		} else if out[i].Alias != out[j].Alias {
+			return out[i].Alias < out[j].Alias
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
@@ -0,0 +1,222 @@
+package rdf
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/tools/exp/codegen"
+)
+
+const (
+	rdfSpec      = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	jsonSpec     = "JSON"
+	encodingJSON = "encoding/json"
+	bytesPkg     = "bytes"
+)
+
+// The URIs of the literal values that properties of the ActivityStreams
+// vocabularies commonly range over.
+const (
+	XMLSchemaSpec         = "http://www.w3.org/2001/XMLSchema#"
+	XSDString             = XMLSchemaSpec + "string"
+	XSDAnyURI             = XMLSchemaSpec + "anyURI"
+	XSDBoolean            = XMLSchemaSpec + "boolean"
+	XSDDateTime           = XMLSchemaSpec + "dateTime"
+	XSDDuration           = XMLSchemaSpec + "duration"
+	XSDFloat              = XMLSchemaSpec + "float"
+	XSDNonNegativeInteger = XMLSchemaSpec + "nonNegativeInteger"
+	RDFLangString         = rdfSpec + "langString"
+	RDFJSON               = rdfSpec + jsonSpec
+)
+
+// RDFOntology is the Ontology for the RDF vocabulary itself. It provides the
+// literal value types defined by RDF, such as rdf:JSON.
+type RDFOntology struct {
+	// Package is the Go package in which the code handling the values is
+	// generated.
+	Package string
+}
+
+var _ Ontology = &RDFOntology{}
+
+// String returns the URI of the RDF vocabulary.
+func (o *RDFOntology) String() string {
+	return rdfSpec
+}
+
+// Load loads all the values of the RDF ontology.
+func (o *RDFOntology) Load() ([]RDFNode, error) {
+	return []RDFNode{o.jsonNode()}, nil
+}
+
+// LoadAsAlias loads all the values of the RDF ontology. The alias does not
+// affect the values.
+func (o *RDFOntology) LoadAsAlias(s string) ([]RDFNode, error) {
+	return o.Load()
+}
+
+// LoadElement loads a single value of the RDF ontology.
+func (o *RDFOntology) LoadElement(name string, payload map[string]interface{}) ([]RDFNode, error) {
+	switch name {
+	case jsonSpec:
+		return []RDFNode{o.jsonNode()}, nil
+	default:
+		return nil, fmt.Errorf("rdf ontology has no element %q", name)
+	}
+}
+
+// jsonNode creates the value for rdf:JSON literals, backed by a
+// json.RawMessage. Values are serialized canonically: object keys are sorted
+// and numbers keep their original text, so that extensions carrying structured
+// JSON payloads round-trip losslessly.
+func (o *RDFOntology) jsonNode() *valueNode {
+	return &valueNode{VocabularyValue{
+		Name:           jsonSpec,
+		URI:            rdfSpec + jsonSpec,
+		DefinitionType: jen.Qual(encodingJSON, "RawMessage"),
+		IsNilable:      true,
+		SerializeFn: codegen.NewCommentedFunction(
+			o.Package,
+			"SerializeJSON",
+			[]jen.Code{jen.Id(codegen.This()).Qual(encodingJSON, "RawMessage")},
+			[]jen.Code{jen.Interface(), jen.Error()},
+			[]jen.Code{
+				jen.Id("d").Op(":=").Qual(encodingJSON, "NewDecoder").Call(
+					jen.Qual(bytesPkg, "NewReader").Call(jen.Id(codegen.This())),
+				),
+				jen.Id("d").Dot("UseNumber").Call(),
+				jen.Var().Id("i").Interface(),
+				jen.If(
+					jen.Err().Op(":=").Id("d").Dot("Decode").Call(jen.Op("&").Id("i")),
+					jen.Err().Op("!=").Nil(),
+				).Block(
+					jen.Return(jen.Nil(), jen.Err()),
+				),
+				jen.Return(jen.Id("i"), jen.Nil()),
+			},
+			jen.Commentf("SerializeJSON converts a JSON value into an interface representation suitable for marshalling into a text or binary format. Marshalling the result produces the canonical form of the value."),
+		),
+		DeserializeFn: codegen.NewCommentedFunction(
+			o.Package,
+			"DeserializeJSON",
+			[]jen.Code{jen.Id(codegen.This()).Interface()},
+			[]jen.Code{jen.Qual(encodingJSON, "RawMessage"), jen.Bool(), jen.Error()},
+			[]jen.Code{
+				jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual(encodingJSON, "Marshal").Call(jen.Id(codegen.This())),
+				jen.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Return(jen.Nil(), jen.False(), jen.Err()),
+				),
+				jen.Return(jen.Qual(encodingJSON, "RawMessage").Call(jen.Id("b")), jen.True(), jen.Nil()),
+			},
+			jen.Commentf("DeserializeJSON creates a JSON value from an interface representation that has been unmarshalled from a text or binary format. Any unmarshalled value is a JSON value."),
+		),
+		LessFn: codegen.NewCommentedFunction(
+			o.Package,
+			"LessJSON",
+			[]jen.Code{jen.List(jen.Id("lhs"), jen.Id("rhs")).Qual(encodingJSON, "RawMessage")},
+			[]jen.Code{jen.Bool()},
+			[]jen.Code{
+				jen.Return(jen.Qual(bytesPkg, "Compare").Call(jen.Id("lhs"), jen.Id("rhs")).Op("<").Lit(0)),
+			},
+			jen.Commentf("LessJSON returns true if the left JSON value is less than the right value, comparing their bytes."),
+		),
+	}}
+}
+
+// XSDOntology is the Ontology for the XML Schema datatypes. Contexts alias it
+// to type the literal values of their properties, such as xsd:boolean. The
+// backends know the literal values by their URI, so it provides no nodes.
+type XSDOntology struct{}
+
+var _ Ontology = &XSDOntology{}
+
+// xsdValues are the names of the XML Schema datatypes the ontology knows of.
+var xsdValues = map[string]bool{
+	"string":             true,
+	"anyURI":             true,
+	"boolean":            true,
+	"dateTime":           true,
+	"duration":           true,
+	"float":              true,
+	"nonNegativeInteger": true,
+}
+
+// String returns the URI of the XML Schema datatypes.
+func (o *XSDOntology) String() string {
+	return XMLSchemaSpec
+}
+
+// Load loads the XML Schema ontology, which has no nodes.
+func (o *XSDOntology) Load() ([]RDFNode, error) {
+	return nil, nil
+}
+
+// LoadAsAlias loads the XML Schema ontology, which has no nodes.
+func (o *XSDOntology) LoadAsAlias(s string) ([]RDFNode, error) {
+	return o.Load()
+}
+
+// LoadElement loads a single datatype of the XML Schema ontology, which has no
+// nodes, returning an error if it is unknown.
+func (o *XSDOntology) LoadElement(name string, payload map[string]interface{}) ([]RDFNode, error) {
+	if !xsdValues[name] {
+		return nil, fmt.Errorf("xsd ontology has no element %q", name)
+	}
+	return nil, nil
+}
+
+// valueNode is an RDFNode that makes a literal value type available to the
+// vocabulary being parsed.
+type valueNode struct {
+	value VocabularyValue
+}
+
+var _ RDFNode = &valueNode{}
+
+// Apply never handles a key, as values are defined before the members of the
+// document are parsed.
+func (v *valueNode) Apply(key string, value interface{}, ctx *ParseContext) (bool, error) {
+	return false, nil
+}
+
+// typeNode is an RDFNode that adds a type defined by an ontology to the
+// vocabulary being parsed.
+type typeNode struct {
+	t VocabularyType
+}
+
+var _ RDFNode = &typeNode{}
+
+// Apply never handles a key, as types are defined before the members of the
+// document are parsed.
+func (t *typeNode) Apply(key string, value interface{}, ctx *ParseContext) (bool, error) {
+	return false, nil
+}
+
+// propertyNode is an RDFNode that adds a property defined by an ontology to the
+// vocabulary being parsed.
+type propertyNode struct {
+	p VocabularyProperty
+}
+
+var _ RDFNode = &propertyNode{}
+
+// Apply never handles a key, as properties are defined before the members of
+// the document are parsed.
+func (p *propertyNode) Apply(key string, value interface{}, ctx *ParseContext) (bool, error) {
+	return false, nil
+}
+
+// defineNodes adds the values, types, and properties provided by any of the
+// nodes to the vocabulary.
+func defineNodes(nodes []RDFNode, v *ParsedVocabulary) {
+	for _, n := range nodes {
+		switch x := n.(type) {
+		case *valueNode:
+			v.Values[x.value.URI] = x.value
+		case *typeNode:
+			v.Types[x.t.Name] = x.t
+		case *propertyNode:
+			v.Properties[x.p.Name] = x.p
+		}
+	}
+}
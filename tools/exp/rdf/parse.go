@@ -2,10 +2,13 @@ package rdf
 
 import (
 	"fmt"
+	"sort"
 )
 
 const (
 	JSON_LD_CONTEXT = "@context"
+	JSON_LD_REVERSE = "@reverse"
+	JSON_LD_GRAPH   = "@graph"
 )
 
 type JSONLD map[string]interface{}
@@ -20,10 +23,121 @@ type RDFGetter interface {
 	GetAliasedObject(alias string, object map[string]interface{}) ([]RDFNode, error)
 }
 
-type ParseContext interface{}
+// ParseContext contains the results of the parsing as well as scratch space
+// required for RDFNodes to be able to statefully apply changes.
+type ParseContext struct {
+	// Result contains the vocabulary being built from the document that
+	// is being parsed.
+	Result *ParsedVocabulary
+	// Current is the element currently being parsed, if any.
+	Current interface{}
+}
 
 type RDFNode interface {
-	Apply(key string, value interface{}, ctx ParseContext) (bool, error)
+	Apply(key string, value interface{}, ctx *ParseContext) (bool, error)
+}
+
+// ParseVocabulary parses the specified input as an ActivityStreams context
+// that specifies a Core, Extended, or Extension vocabulary.
+//
+// Members of the input that are not handled by any of the ontologies are noted
+// in the registry's DependencyReport instead of causing an error. If the input
+// wraps its definitions in a top-level @graph, each member of the graph is
+// parsed in turn.
+func ParseVocabulary(registry *RDFRegistry, input JSONLD) (vocabulary *ParsedVocabulary, err error) {
+	var nodes []RDFNode
+	nodes, err = ParseJSONLDContext(registry, input)
+	if err != nil {
+		return
+	}
+	vocabulary = newParsedVocabulary()
+	defineNodes(nodes, vocabulary)
+	ctx := &ParseContext{Result: vocabulary}
+	if err = applyMembers(registry, nodes, input, ctx); err != nil {
+		return
+	}
+	if g, ok := input[JSON_LD_GRAPH]; ok {
+		if err = applyGraph(registry, nodes, g, ctx); err != nil {
+			return
+		}
+	}
+	registry.applyReverses(vocabulary)
+	return
+}
+
+// applyMembers applies the nodes to every member of the JSON object except
+// for the @context and @graph keywords, in a deterministic order.
+func applyMembers(registry *RDFRegistry, nodes []RDFNode, m map[string]interface{}, ctx *ParseContext) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if k != JSON_LD_CONTEXT && k != JSON_LD_GRAPH {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := apply(registry, nodes, k, m[k], ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyGraph handles documents that wrap their definitions in a top-level
+// @graph. Each member of the graph is applied as if it were its own document,
+// with the ParseContext's Current set to that member.
+func applyGraph(registry *RDFRegistry, nodes []RDFNode, graph interface{}, ctx *ParseContext) error {
+	var members []interface{}
+	if arr, ok := graph.([]interface{}); ok {
+		members = arr
+	} else {
+		members = []interface{}{graph}
+	}
+	defer func() { ctx.Current = nil }()
+	for i, member := range members {
+		m, ok := member.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s member %d is not a JSON object", JSON_LD_GRAPH, i)
+		}
+		ctx.Current = m
+		if err := applyMembers(registry, nodes, m, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply gives each node a chance to handle the key and value, stopping at the
+// first node that does.
+func apply(registry *RDFRegistry, nodes []RDFNode, key string, value interface{}, ctx *ParseContext) error {
+	for _, node := range nodes {
+		if handled, err := node.Apply(key, value, ctx); err != nil {
+			return err
+		} else if handled {
+			return nil
+		}
+	}
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.markUnresolved(key, fmt.Errorf("no ontology handled %q; it is treated as unknown", key))
+	return nil
+}
+
+// contextKeys returns the keys of a context object in a deterministic order
+// such that aliases for absolute IRIs are defined before other definitions
+// that may refer to them.
+func contextKeys(m map[string]interface{}) []string {
+	var absolute, rest []string
+	for k, v := range m {
+		if s, ok := v.(string); ok && len(splitAlias(s)) == 1 {
+			absolute = append(absolute, k)
+		} else {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(absolute)
+	sort.Strings(rest)
+	return append(absolute, rest...)
 }
 
 // ParseJSONLDContext implements a super basic JSON-LD @context parsing
@@ -39,7 +153,8 @@ func ParseJSONLDContext(rdfGetter RDFGetter, input JSONLD) (nodes []RDFNode, err
 		for _, iVal := range inArray {
 			if valMap, ok := iVal.(map[string]interface{}); ok {
 				// Element is a JSON Object (dictionary)
-				for alias, val := range valMap {
+				for _, alias := range contextKeys(valMap) {
+					val := valMap[alias]
 					if s, ok := val.(string); ok {
 						var n []RDFNode
 						n, err = rdfGetter.GetAliased(alias, s)
@@ -74,7 +189,8 @@ func ParseJSONLDContext(rdfGetter RDFGetter, input JSONLD) (nodes []RDFNode, err
 		}
 	} else if inMap, ok := i.(map[string]interface{}); ok {
 		// @context is a JSON object (dictionary)
-		for alias, iVal := range inMap {
+		for _, alias := range contextKeys(inMap) {
+			iVal := inMap[alias]
 			if s, ok := iVal.(string); ok {
 				var n []RDFNode
 				n, err = rdfGetter.GetAliased(alias, s)
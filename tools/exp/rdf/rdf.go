@@ -2,6 +2,7 @@ package rdf
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -26,6 +27,15 @@ type Ontology interface {
 	LoadElement(name string, payload map[string]interface{}) ([]RDFNode, error)
 }
 
+// splitAlias splits a compact IRI such as "as:Note" into its alias and element.
+// Absolute IRIs such as "https://www.w3.org/ns/activitystreams" are not split.
+func splitAlias(s string) []string {
+	if strings.Contains(s, "://") {
+		return []string{s}
+	}
+	return strings.Split(s, ALIAS_DELIMITER)
+}
+
 // aliasedNode represents a context element that has a special reserved alias.
 type aliasedNode struct {
 	Alias string
@@ -38,11 +48,86 @@ type RDFRegistry struct {
 	ontologies   map[string]Ontology
 	aliases      map[string]string
 	aliasedNodes map[string]aliasedNode
+	reverses     map[string]string
+	definitions  map[string]string
+	usage        map[string]*ontologyUsage
+	unresolved   []UnresolvedReference
+	conflicts    []AliasConflict
 	mu           sync.Mutex
 }
 
+// ontologyUsage tracks how a single registered ontology was referenced.
+type ontologyUsage struct {
+	loadedWhole bool
+	terms       map[string]bool
+}
+
+// markWhole records that the entire ontology was loaded.
+func (r *RDFRegistry) markWhole(ontologyName string) {
+	r.usageFor(ontologyName).loadedWhole = true
+}
+
+// markTerm records that a specific element of the ontology was loaded.
+func (r *RDFRegistry) markTerm(ontologyName, term string) {
+	r.usageFor(ontologyName).terms[term] = true
+}
+
+// usageFor obtains the usage tracker for an ontology, creating it if needed.
+func (r *RDFRegistry) usageFor(ontologyName string) *ontologyUsage {
+	if r.usage == nil {
+		r.usage = make(map[string]*ontologyUsage, 1)
+	}
+	u, ok := r.usage[ontologyName]
+	if !ok {
+		u = &ontologyUsage{terms: make(map[string]bool, 0)}
+		r.usage[ontologyName] = u
+	}
+	return u
+}
+
+// markUnresolved records a reference that could not be resolved to any
+// registered ontology, returning the error to propagate.
+func (r *RDFRegistry) markUnresolved(reference string, e error) error {
+	r.unresolved = append(r.unresolved, UnresolvedReference{
+		Reference: reference,
+		Reason:    e.Error(),
+	})
+	return e
+}
+
+// define records the definition of a context term, returning false if the
+// definition should be skipped.
+//
+// Aliases and term definitions share the same namespace. The first definition
+// of a term takes precedence: contexts are parsed in document order, so a term
+// defined by an earlier @context entry, such as the ActivityStreams context,
+// is kept over a conflicting one from a later entry, such as security/v1 or
+// toot. Conflicting definitions are ignored and noted in the DependencyReport.
+// Repeating an identical definition is skipped without being a conflict.
+func (r *RDFRegistry) define(term, definition string) bool {
+	if r.definitions == nil {
+		r.definitions = make(map[string]string, 1)
+	}
+	existing, ok := r.definitions[term]
+	if !ok {
+		r.definitions[term] = definition
+		return true
+	}
+	if existing != definition {
+		r.conflicts = append(r.conflicts, AliasConflict{
+			Alias:   term,
+			Kept:    existing,
+			Ignored: definition,
+		})
+	}
+	return false
+}
+
 // setAlias sets an alias for a string.
 func (r *RDFRegistry) setAlias(alias, s string) error {
+	if r.aliases == nil {
+		r.aliases = make(map[string]string, 1)
+	}
 	if _, ok := r.aliases[alias]; ok {
 		return fmt.Errorf("already have alias for %s", alias)
 	}
@@ -52,6 +137,9 @@ func (r *RDFRegistry) setAlias(alias, s string) error {
 
 // setAliasedNode sets an alias for a node.
 func (r *RDFRegistry) setAliasedNode(alias string, nodes []RDFNode) error {
+	if r.aliasedNodes == nil {
+		r.aliasedNodes = make(map[string]aliasedNode, 1)
+	}
 	if _, ok := r.aliasedNodes[alias]; ok {
 		return fmt.Errorf("already have aliased node for %s", alias)
 	}
@@ -77,13 +165,18 @@ func (r *RDFRegistry) getOntology(alias string) (Ontology, error) {
 // required for a specific element within that ontology.
 func (r *RDFRegistry) loadElement(alias, element string, payload map[string]interface{}) (n []RDFNode, e error) {
 	if ontName, ok := r.aliases[alias]; !ok {
-		e = fmt.Errorf("no alias to ontology for %s", alias)
+		e = r.markUnresolved(alias, fmt.Errorf("no alias to ontology for %s", alias))
 		return
 	} else if ontology, ok := r.ontologies[ontName]; !ok {
-		e = fmt.Errorf("no ontology named %s for alias %s", ontName, alias)
+		e = r.markUnresolved(alias, fmt.Errorf("no ontology named %s for alias %s", ontName, alias))
 		return
 	} else {
 		n, e = ontology.LoadElement(element, payload)
+		if e != nil {
+			e = r.markUnresolved(alias+ALIAS_DELIMITER+element, e)
+			return
+		}
+		r.markTerm(ontName, element)
 		return
 	}
 }
@@ -108,12 +201,24 @@ func (r *RDFRegistry) AddOntology(s string, o Ontology) error {
 func (r *RDFRegistry) GetFor(s string) (n []RDFNode, e error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	return r.getFor(s)
+}
+
+// getFor loads the entire ontology for a context's string. It must be called
+// while holding the lock.
+func (r *RDFRegistry) getFor(s string) (n []RDFNode, e error) {
 	ontology, ok := r.ontologies[s]
 	if !ok {
-		e = fmt.Errorf("no ontology for %s", s)
+		e = r.markUnresolved(s, fmt.Errorf("no ontology for %s", s))
 		return
 	}
-	return ontology.Load()
+	n, e = ontology.Load()
+	if e != nil {
+		e = r.markUnresolved(s, e)
+		return
+	}
+	r.markWhole(s)
+	return
 }
 
 // GetAliased gets RDFKeyers and RDFValuers based on a context string and its
@@ -123,20 +228,17 @@ func (r *RDFRegistry) GetFor(s string) (n []RDFNode, e error) {
 func (r *RDFRegistry) GetAliased(alias, s string) (n []RDFNode, e error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	strs := strings.Split(s, ALIAS_DELIMITER)
+	if !r.define(alias, s) {
+		return
+	}
+	strs := splitAlias(s)
 	if len(strs) == 1 {
 		if e = r.setAlias(alias, s); e != nil {
 			return
 		}
-		return r.GetFor(s)
+		return r.getFor(s)
 	} else if len(strs) == 2 {
-		var o Ontology
-		o, e = r.getOntology(strs[0])
-		if e != nil {
-			return
-		}
-		n, e = o.LoadElement(strs[1], nil)
-		return
+		return r.loadElement(strs[0], strs[1], nil)
 	} else {
 		e = fmt.Errorf("too many delimiters in %s", s)
 		return
@@ -146,39 +248,208 @@ func (r *RDFRegistry) GetAliased(alias, s string) (n []RDFNode, e error) {
 // GetAliasedObject gets RDFKeyers and RDFValuers based on a context object and
 // its alias and definition.
 //
+// The object may be a reverse property definition, using "@reverse" instead of
+// "@id", in which case the relationship is recorded and later applied to the
+// ParsedVocabulary.
+//
 // Implements RDFGetter.
 func (r *RDFRegistry) GetAliasedObject(alias string, object map[string]interface{}) (n []RDFNode, e error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	var iObj interface{} = object
-	if obj, ok := iObj.(map[string]string); !ok {
-		e = fmt.Errorf("object in GetAliasedObject must be a map[string]string")
+	isReverse := false
+	iElement, ok := object[ID]
+	if !ok {
+		iElement, isReverse = object[JSON_LD_REVERSE]
+	}
+	if !ok && !isReverse {
+		e = fmt.Errorf("aliased object does not have %s nor %s value", ID, JSON_LD_REVERSE)
+		return
+	}
+	element, ok := iElement.(string)
+	if !ok {
+		e = fmt.Errorf("aliased object value for %s is not a string: %T", alias, iElement)
+		return
+	}
+	definition := element
+	if isReverse {
+		definition = JSON_LD_REVERSE + " " + element
+	}
+	if !r.define(alias, definition) {
 		return
+	}
+	strs := splitAlias(element)
+	if len(strs) == 1 {
+		n, e = r.getFor(strs[0])
+	} else if len(strs) == 2 {
+		n, e = r.loadElement(strs[0], strs[1], object)
 	} else {
-		element, ok := obj[ID]
-		if !ok {
-			e = fmt.Errorf("aliased object does not have %s value", ID)
-			return
-		}
-		var nodes []RDFNode
-		strs := strings.Split(element, ALIAS_DELIMITER)
-		if len(strs) == 1 {
-			n, e = r.GetFor(strs[0])
-		} else if len(strs) == 2 {
-			var o Ontology
-			o, e = r.getOntology(strs[0])
-			if e != nil {
-				return
+		e = fmt.Errorf("too many delimiters in %s", element)
+	}
+	if e != nil {
+		return
+	}
+	if isReverse {
+		e = r.setReverse(alias, element)
+		return
+	}
+	e = r.setAliasedNode(alias, n)
+	return
+}
+
+// setReverse records that the alias is the reverse of the given element.
+func (r *RDFRegistry) setReverse(alias, element string) error {
+	if r.reverses == nil {
+		r.reverses = make(map[string]string, 1)
+	}
+	if _, ok := r.reverses[alias]; ok {
+		return fmt.Errorf("already have reverse property for %s", alias)
+	}
+	r.reverses[alias] = element
+	return nil
+}
+
+// applyReverses records the reverse property definitions found while parsing
+// contexts into the vocabulary.
+func (r *RDFRegistry) applyReverses(v *ParsedVocabulary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	aliases := make([]string, 0, len(r.reverses))
+	for alias := range r.reverses {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		element := r.reverses[alias]
+		of := VocabularyReference{Name: element}
+		if strs := splitAlias(element); len(strs) == 2 {
+			of = VocabularyReference{
+				Name:  strs[1],
+				Alias: strs[0],
 			}
-			n, e = o.LoadElement(strs[1], object)
-			return
 		}
-		if e != nil {
-			return
+		rev := v.Properties[alias]
+		rev.Name = alias
+		rev.ReverseOf = &of
+		v.Properties[alias] = rev
+		if target, ok := v.Properties[of.Name]; ok {
+			target.Reverses = append(target.Reverses, VocabularyReference{Name: alias})
+			v.Properties[of.Name] = target
 		}
-		if e = r.setAliasedNode(alias, nodes); e != nil {
-			return
+	}
+}
+
+// UnresolvedReference is a context reference that could not be resolved by the
+// ontologies in the registry.
+type UnresolvedReference struct {
+	// Reference is the alias, term, or specification URI as it appeared.
+	Reference string
+	// Reason explains why the reference could not be resolved.
+	Reason string
+}
+
+// AliasConflict is a context term that was defined more than once with
+// different definitions.
+type AliasConflict struct {
+	// Alias is the conflicting term.
+	Alias string
+	// Kept is the definition that took precedence.
+	Kept string
+	// Ignored is the conflicting definition that was not applied.
+	Ignored string
+}
+
+// OntologyUsage describes how a registered ontology was used.
+type OntologyUsage struct {
+	// Name is the specification URI the ontology is registered under.
+	Name string
+	// Aliases are the context aliases that referred to this ontology.
+	Aliases []string
+	// LoadedWhole is true if the entire ontology was loaded, rather than
+	// only specific terms.
+	LoadedWhole bool
+	// Terms are the specific elements of the ontology that were loaded.
+	Terms []string
+}
+
+// DependencyReport describes which ontologies, terms, and specification URIs
+// were actually used while parsing, which were referenced but could not be
+// resolved, which registered ontologies were never used, and which context
+// terms had conflicting definitions.
+//
+// It helps trim the set of registered ontologies and catch terms that silently
+// fell through to being handled as unknown.
+type DependencyReport struct {
+	Used       []OntologyUsage
+	Unresolved []UnresolvedReference
+	Unused     []string
+	Conflicts  []AliasConflict
+}
+
+// String returns a human-readable representation of the report.
+func (d DependencyReport) String() string {
+	var b strings.Builder
+	b.WriteString("Used ontologies:\n")
+	for _, u := range d.Used {
+		b.WriteString(fmt.Sprintf("  %s", u.Name))
+		if len(u.Aliases) > 0 {
+			b.WriteString(fmt.Sprintf(" (as %s)", strings.Join(u.Aliases, ", ")))
 		}
-		return
+		if u.LoadedWhole {
+			b.WriteString(" [entire ontology]")
+		}
+		b.WriteString("\n")
+		for _, t := range u.Terms {
+			b.WriteString(fmt.Sprintf("    %s\n", t))
+		}
+	}
+	b.WriteString("Unresolved references:\n")
+	for _, u := range d.Unresolved {
+		b.WriteString(fmt.Sprintf("  %s: %s\n", u.Reference, u.Reason))
+	}
+	b.WriteString("Unused ontologies:\n")
+	for _, u := range d.Unused {
+		b.WriteString(fmt.Sprintf("  %s\n", u))
+	}
+	b.WriteString("Conflicting aliases:\n")
+	for _, c := range d.Conflicts {
+		b.WriteString(fmt.Sprintf("  %s: kept %s, ignored %s\n", c.Alias, c.Kept, c.Ignored))
+	}
+	return b.String()
+}
+
+// Report generates a DependencyReport based on the lookups performed on this
+// registry so far.
+func (r *RDFRegistry) Report() DependencyReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var d DependencyReport
+	aliasesOf := make(map[string][]string, len(r.aliases))
+	for alias, name := range r.aliases {
+		aliasesOf[name] = append(aliasesOf[name], alias)
+	}
+	for name := range r.ontologies {
+		u, ok := r.usage[name]
+		if !ok {
+			d.Unused = append(d.Unused, name)
+			continue
+		}
+		usage := OntologyUsage{
+			Name:        name,
+			Aliases:     aliasesOf[name],
+			LoadedWhole: u.loadedWhole,
+		}
+		sort.Strings(usage.Aliases)
+		for t := range u.terms {
+			usage.Terms = append(usage.Terms, t)
+		}
+		sort.Strings(usage.Terms)
+		d.Used = append(d.Used, usage)
 	}
+	sort.Slice(d.Used, func(i, j int) bool {
+		return d.Used[i].Name < d.Used[j].Name
+	})
+	sort.Strings(d.Unused)
+	d.Unresolved = append(d.Unresolved, r.unresolved...)
+	d.Conflicts = append(d.Conflicts, r.conflicts...)
+	return d
 }
@@ -0,0 +1,140 @@
+package rdf
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+const (
+	testAS       = "https://www.w3.org/ns/activitystreams"
+	testSecurity = "https://w3id.org/security/v1"
+	testToot     = "http://joinmastodon.org/ns"
+)
+
+// testOntology is an ontology knowing only a fixed set of element names.
+type testOntology struct {
+	name     string
+	elements map[string]bool
+}
+
+func (o *testOntology) String() string { return o.name }
+
+func (o *testOntology) Load() ([]RDFNode, error) { return nil, nil }
+
+func (o *testOntology) LoadAsAlias(s string) ([]RDFNode, error) { return nil, nil }
+
+func (o *testOntology) LoadElement(name string, payload map[string]interface{}) ([]RDFNode, error) {
+	if !o.elements[name] {
+		return nil, fmt.Errorf("%s has no element %s", o.name, name)
+	}
+	return nil, nil
+}
+
+// newTestRegistry registers a small ontology for each of ActivityStreams,
+// security, and toot.
+func newTestRegistry(t *testing.T) *RDFRegistry {
+	r := &RDFRegistry{}
+	for _, o := range []*testOntology{
+		{name: testAS, elements: map[string]bool{"Note": true, "Hashtag": true, "manuallyApprovesFollowers": true}},
+		{name: testSecurity, elements: map[string]bool{"publicKey": true}},
+		{name: testToot, elements: map[string]bool{"Emoji": true, "featured": true}},
+	} {
+		if err := r.AddOntology(o.name, o); err != nil {
+			t.Fatalf("AddOntology(%s) returned error: %s", o.name, err)
+		}
+	}
+	return r
+}
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		lookups func(r *RDFRegistry)
+		want    DependencyReport
+	}{
+		{
+			name:    "no lookups",
+			lookups: func(r *RDFRegistry) {},
+			want: DependencyReport{
+				Unused: []string{testToot, testSecurity, testAS},
+			},
+		},
+		{
+			name: "entire ontology",
+			lookups: func(r *RDFRegistry) {
+				r.GetFor(testAS)
+			},
+			want: DependencyReport{
+				Used: []OntologyUsage{
+					{Name: testAS, LoadedWhole: true},
+				},
+				Unused: []string{testToot, testSecurity},
+			},
+		},
+		{
+			name: "aliased terms",
+			lookups: func(r *RDFRegistry) {
+				r.GetAliased("as", testAS)
+				r.GetAliased("toot", testToot)
+				r.GetAliased("Emoji", "toot:Emoji")
+				r.GetAliasedObject("featured", map[string]interface{}{ID: "toot:featured"})
+				r.GetAliased("Hashtag", "as:Hashtag")
+			},
+			want: DependencyReport{
+				Used: []OntologyUsage{
+					{Name: testToot, Aliases: []string{"toot"}, LoadedWhole: true, Terms: []string{"Emoji", "featured"}},
+					{Name: testAS, Aliases: []string{"as"}, LoadedWhole: true, Terms: []string{"Hashtag"}},
+				},
+				Unused: []string{testSecurity},
+			},
+		},
+		{
+			name: "unresolved references",
+			lookups: func(r *RDFRegistry) {
+				r.GetFor("https://example.com/ns")
+				r.GetAliased("toot", testToot)
+				r.GetAliased("blurhash", "toot:blurhash")
+				r.GetAliased("schema", "schema:PropertyValue")
+			},
+			want: DependencyReport{
+				Used: []OntologyUsage{
+					{Name: testToot, Aliases: []string{"toot"}, LoadedWhole: true},
+				},
+				Unresolved: []UnresolvedReference{
+					{Reference: "https://example.com/ns", Reason: "no ontology for https://example.com/ns"},
+					{Reference: "toot:blurhash", Reason: testToot + " has no element blurhash"},
+					{Reference: "schema", Reason: "no alias to ontology for schema"},
+				},
+				Unused: []string{testSecurity, testAS},
+			},
+		},
+		{
+			name: "conflicting aliases",
+			lookups: func(r *RDFRegistry) {
+				r.GetAliased("as", testAS)
+				r.GetAliased("sec", testSecurity)
+				r.GetAliased("manuallyApprovesFollowers", "as:manuallyApprovesFollowers")
+				r.GetAliased("manuallyApprovesFollowers", "sec:publicKey")
+				r.GetAliased("manuallyApprovesFollowers", "as:manuallyApprovesFollowers")
+			},
+			want: DependencyReport{
+				Used: []OntologyUsage{
+					{Name: testSecurity, Aliases: []string{"sec"}, LoadedWhole: true},
+					{Name: testAS, Aliases: []string{"as"}, LoadedWhole: true, Terms: []string{"manuallyApprovesFollowers"}},
+				},
+				Unused: []string{testToot},
+				Conflicts: []AliasConflict{
+					{Alias: "manuallyApprovesFollowers", Kept: "as:manuallyApprovesFollowers", Ignored: "sec:publicKey"},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		r := newTestRegistry(t)
+		test.lookups(r)
+		if got := r.Report(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/dave/jennifer/jen"
 	"github.com/go-fed/activity/tools/exp/codegen"
+	"sort"
 	"sync"
 )
 
@@ -13,6 +14,7 @@ const (
 	extendsMethod      = "Extends"
 	disjointWithMethod = "IsDisjointWith"
 	nameMethod         = "Name"
+	interfaceSuffix    = "Interface"
 )
 
 // TypeInterface returns the Type Interface that is needed for ActivityStream
@@ -93,6 +95,12 @@ func (t *TypeGenerator) TypeName() string {
 	return t.typeName
 }
 
+// InterfaceName returns the name of the interface capturing the exported
+// methods of this ActivityStreams type.
+func (t *TypeGenerator) InterfaceName() string {
+	return fmt.Sprintf("%s%s", t.TypeName(), interfaceSuffix)
+}
+
 // Extends returns the generators of types that this ActivityStreams type
 // extends from.
 func (t *TypeGenerator) Extends() []*TypeGenerator {
@@ -132,9 +140,14 @@ func (t *TypeGenerator) disjointWithFnName() string {
 // Definition generates the golang code for this ActivityStreams type.
 func (t *TypeGenerator) Definition() *codegen.Struct {
 	t.cacheOnce.Do(func() {
-		members := make([]jen.Code, 0, len(t.properties))
-		for name, property := range t.properties {
-			members = append(members, jen.Id(name).Id(property.StructName()))
+		names := make([]string, 0, len(t.properties))
+		for name := range t.properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		members := make([]jen.Code, 0, len(names))
+		for _, name := range names {
+			members = append(members, jen.Id(name).Id(t.properties[name].StructName()))
 		}
 		t.cachedStruct = codegen.NewStruct(
 			jen.Commentf(t.Comment()),
@@ -152,6 +165,15 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 	return t.cachedStruct
 }
 
+// InterfaceDefinition generates the golang interface that this ActivityStreams
+// type satisfies, allowing application code to mock it.
+func (t *TypeGenerator) InterfaceDefinition() *codegen.Interface {
+	return t.Definition().ToInterface(
+		t.packageName,
+		t.InterfaceName(),
+		fmt.Sprintf("%s is satisfied by %s.", t.InterfaceName(), t.TypeName()))
+}
+
 // nameDefinition generates the golang method for returning the ActivityStreams
 // type name.
 func (t *TypeGenerator) nameDefinition() *codegen.Method {
@@ -0,0 +1,71 @@
+// Code generated from a parsed vocabulary. DO NOT EDIT.
+
+/** An IRI identifying a value. */
+export type IRI = string;
+
+/** A date and time in the format of RFC 3339. */
+export type DateTime = string;
+
+/** A duration in the format of ISO 8601. */
+export type Duration = string;
+
+/** A single value, or an array of values. */
+export type OneOrMore<T> = T | T[];
+
+/** The values of a property in each language, keyed by the language. */
+export type LanguageMap = { [language: string]: string };
+
+/** A reference to a resource. */
+export interface Link {
+  "@context"?: unknown;
+  /** The IRI identifying the Link. */
+  id?: IRI;
+  /** The types of the Link, including "Link". */
+  type?: OneOrMore<string>;
+  /** The target of the link. */
+  href?: IRI;
+  [property: string]: unknown;
+}
+
+/** A short written work. */
+export interface Note {
+  "@context"?: unknown;
+  /** The IRI identifying the Note. */
+  id?: IRI;
+  /** The types of the Note, including "Note". */
+  type?: OneOrMore<string>;
+  /** What the object is attached to. */
+  attachedTo?: OneOrMore<AttachedToProperty>;
+  /** The content of the object. */
+  content?: OneOrMore<string>;
+  /** The values of content in each language. */
+  contentMap?: LanguageMap;
+  /** When the object was published. */
+  published?: DateTime;
+  /** Whether the note is sensitive. */
+  sensitive?: boolean;
+  /** The sec:publicKey property of another vocabulary. */
+  "sec:publicKey"?: unknown;
+  [property: string]: unknown;
+}
+
+/** Any kind of object. */
+export interface Object {
+  "@context"?: unknown;
+  /** The IRI identifying the Object. */
+  id?: IRI;
+  /** The types of the Object, including "Object". */
+  type?: OneOrMore<string>;
+  /** What the object is attached to. */
+  attachedTo?: OneOrMore<AttachedToProperty>;
+  /** The content of the object. */
+  content?: OneOrMore<string>;
+  /** The values of content in each language. */
+  contentMap?: LanguageMap;
+  /** When the object was published. */
+  published?: DateTime;
+  [property: string]: unknown;
+}
+
+/** A value of the 'attachedTo' property. */
+export type AttachedToProperty = IRI | Link | Note | Object;
@@ -0,0 +1,259 @@
+// Package typescript renders a parsed vocabulary as TypeScript declarations
+// matching how its types are serialized, so that clients of servers built on
+// this library get typed access to the same vocabulary.
+package typescript
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/exp/rdf"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// declarationsName is the name of the rendered file.
+	declarationsName = "vocab.d.ts"
+	// propertySuffix is appended to the name of a property to name the type
+	// of one of its values.
+	propertySuffix = "Property"
+	// mapSuffix is appended to the name of a property to name the member
+	// holding its natural language map.
+	mapSuffix   = "Map"
+	iriType     = "IRI"
+	oneOrMore   = "OneOrMore"
+	languageMap = "LanguageMap"
+	unknownType = "unknown"
+)
+
+// scalars are the TypeScript types of the literal values, keyed by their URI.
+// Literal values not listed are held as strings.
+var scalars = map[string]string{
+	rdf.XSDString:             "string",
+	rdf.XSDAnyURI:             iriType,
+	rdf.XSDBoolean:            "boolean",
+	rdf.XSDDateTime:           "DateTime",
+	rdf.XSDDuration:           "Duration",
+	rdf.XSDFloat:              "number",
+	rdf.XSDNonNegativeInteger: "number",
+	rdf.RDFLangString:         "string",
+	rdf.RDFJSON:               unknownType,
+}
+
+// preamble declares the types shared by the declarations of the vocabulary.
+const preamble = `/** An IRI identifying a value. */
+export type IRI = string;
+
+/** A date and time in the format of RFC 3339. */
+export type DateTime = string;
+
+/** A duration in the format of ISO 8601. */
+export type Duration = string;
+
+/** A single value, or an array of values. */
+export type OneOrMore<T> = T | T[];
+
+/** The values of a property in each language, keyed by the language. */
+export type LanguageMap = { [language: string]: string };
+
+`
+
+// File is a rendered TypeScript declarations file.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate renders the vocabulary as TypeScript declarations. Each type is an
+// interface with an optional member for each of its properties, including
+// inherited ones, and allowing any other member for the properties of
+// extensions. A property whose range holds more than a single literal value
+// has a union type of the types in its range or extending them, of its literal
+// values, and of IRI.
+//
+// A property that is not functional may hold either a single value or an
+// array of them, matching how the values are serialized.
+func Generate(v *rdf.ParsedVocabulary) (*File, error) {
+	g := &generator{v: v}
+	var b bytes.Buffer
+	b.WriteString("// Code generated from a parsed vocabulary. DO NOT EDIT.\n\n")
+	b.WriteString(preamble)
+	for _, name := range g.typeNames() {
+		if err := g.typeInterface(&b, v.Types[name]); err != nil {
+			return nil, fmt.Errorf("rendering interface for type %s: %s", name, err)
+		}
+	}
+	for _, name := range g.propertyNames() {
+		g.propertyType(&b, v.Properties[name])
+	}
+	return &File{Name: declarationsName, Content: append(bytes.TrimRight(b.Bytes(), "\n"), '\n')}, nil
+}
+
+// generator holds the vocabulary while rendering the declarations.
+type generator struct {
+	v *rdf.ParsedVocabulary
+}
+
+// typeNames returns the sorted names of the vocabulary's types.
+func (g *generator) typeNames() []string {
+	names := make([]string, 0, len(g.v.Types))
+	for name := range g.v.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// propertyNames returns the sorted names of the vocabulary's properties that
+// need a type of their values.
+func (g *generator) propertyNames() []string {
+	var names []string
+	for name, p := range g.v.Properties {
+		if _, ok := g.inlined(p); !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// typeInterface renders the interface of a type.
+func (g *generator) typeInterface(b *bytes.Buffer, t rdf.VocabularyType) error {
+	comment(b, "", t.Notes)
+	b.WriteString(fmt.Sprintf("export interface %s {\n", t.Name))
+	b.WriteString(fmt.Sprintf("  %q?: %s;\n", "@context", unknownType))
+	comment(b, "  ", fmt.Sprintf("The IRI identifying the %s.", t.Name))
+	b.WriteString(fmt.Sprintf("  id?: %s;\n", iriType))
+	comment(b, "  ", fmt.Sprintf("The types of the %s, including %q.", t.Name, t.Name))
+	b.WriteString(fmt.Sprintf("  type?: %s<string>;\n", oneOrMore))
+	members := map[string]bool{"@context": true, "id": true, "type": true}
+	for _, r := range g.v.AllProperties(t) {
+		name := r.Name
+		if len(r.Alias) > 0 {
+			name = r.Alias + rdf.ALIAS_DELIMITER + r.Name
+		}
+		if members[name] {
+			return fmt.Errorf("more than one member is named %s", name)
+		}
+		members[name] = true
+		p, ok := g.v.Properties[r.Name]
+		if !ok || len(r.Alias) > 0 {
+			comment(b, "  ", fmt.Sprintf("The %s property of another vocabulary.", name))
+			b.WriteString(fmt.Sprintf("  %s?: %s;\n", memberName(name), unknownType))
+			continue
+		}
+		kind, ok := g.inlined(p)
+		if !ok {
+			kind = camelCase(p.Name) + propertySuffix
+		}
+		if !p.Functional && kind != unknownType {
+			kind = fmt.Sprintf("%s<%s>", oneOrMore, kind)
+		}
+		comment(b, "  ", p.Notes)
+		b.WriteString(fmt.Sprintf("  %s?: %s;\n", memberName(name), kind))
+		if p.NaturalLanguageMap {
+			comment(b, "  ", fmt.Sprintf("The values of %s in each language.", p.Name))
+			b.WriteString(fmt.Sprintf("  %s?: %s;\n", memberName(name+mapSuffix), languageMap))
+		}
+	}
+	b.WriteString(fmt.Sprintf("  [property: string]: %s;\n", unknownType))
+	b.WriteString("}\n\n")
+	return nil
+}
+
+// propertyType renders the union type of the values of a property.
+func (g *generator) propertyType(b *bytes.Buffer, p rdf.VocabularyProperty) {
+	seen := make(map[string]bool)
+	var types, values []string
+	iri := false
+	for _, r := range p.Range {
+		if _, ok := g.v.Types[r.Name]; ok && len(r.Alias) == 0 {
+			iri = true
+			for _, name := range append([]string{r.Name}, g.v.Descendants(r.Name)...) {
+				if !seen[name] {
+					seen[name] = true
+					types = append(types, name)
+				}
+			}
+		} else if s, ok := g.scalar(r); ok {
+			if !seen[s] {
+				seen[s] = true
+				values = append(values, s)
+			}
+		} else {
+			iri = true
+		}
+	}
+	sort.Strings(types)
+	sort.Strings(values)
+	var alts []string
+	if iri && !seen[iriType] {
+		alts = append(alts, iriType)
+	}
+	alts = append(append(alts, values...), types...)
+	name := camelCase(p.Name) + propertySuffix
+	comment(b, "", fmt.Sprintf("A value of the '%s' property.", p.Name))
+	b.WriteString(fmt.Sprintf("export type %s = %s;\n\n", name, strings.Join(alts, " | ")))
+}
+
+// scalar determines the TypeScript type of a reference to a literal value.
+func (g *generator) scalar(r rdf.VocabularyReference) (string, bool) {
+	if s, ok := scalars[r.URI]; ok {
+		return s, true
+	} else if _, ok := g.v.Values[r.URI]; ok {
+		return "string", true
+	}
+	return "", false
+}
+
+// inlined determines whether the property holds a single kind of literal value
+// that needs no type of its own, and its type.
+func (g *generator) inlined(p rdf.VocabularyProperty) (string, bool) {
+	if len(p.Range) == 0 {
+		return unknownType, true
+	}
+	if len(p.Range) != 1 {
+		return "", false
+	}
+	return g.scalar(p.Range[0])
+}
+
+// comment renders the notes as a documentation comment with the indentation.
+func comment(b *bytes.Buffer, indent, notes string) {
+	notes = strings.Replace(strings.TrimSpace(notes), "*/", "*\\/", -1)
+	if len(notes) == 0 {
+		return
+	}
+	lines := strings.Split(notes, "\n")
+	if len(lines) == 1 {
+		b.WriteString(fmt.Sprintf("%s/** %s */\n", indent, notes))
+		return
+	}
+	b.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight(indent+" * "+strings.TrimSpace(line), " ") + "\n")
+	}
+	b.WriteString(indent + " */\n")
+}
+
+// memberName quotes the name of a member unless it is an identifier.
+func memberName(s string) string {
+	for i, c := range s {
+		if c == '_' || c == '$' || unicode.IsLetter(c) || (i > 0 && unicode.IsDigit(c)) {
+			continue
+		}
+		return fmt.Sprintf("%q", s)
+	}
+	return s
+}
+
+// camelCase converts a name such as "mediaType" into "MediaType".
+func camelCase(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
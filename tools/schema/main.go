@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"github.com/go-fed/activity/tools/defs"
+	"github.com/go-fed/activity/tools/vocab/gen"
+)
+
+var (
+	pkg       = flag.String("package", "schema", "Name of the generated package")
+	vocabPath = flag.String("vocab_path", "github.com/go-fed/activity/vocab", "Import path of the package generated by the vocab tool")
+	out       = flag.String("out", ".", "Directory to generate the package in")
+)
+
+func main() {
+	flag.Parse()
+	allTypes := append(defs.AllCoreTypes, defs.AllExtendedTypes...)
+	types := append(append([]*defs.Type{}, allTypes...), defs.SchemaTypes...)
+	properties := append(append([]*defs.PropertyType{}, defs.AllPropertyTypes...), defs.SchemaPropertyTypes...)
+	fp, err := gen.Fingerprint(types, properties, defs.AllValueTypes, gen.FlagInputs()...)
+	if err != nil {
+		panic(err)
+	}
+	if gen.IsUpToDate(*out, fp) {
+		return
+	}
+	files, err := gen.GenerateExtension(allTypes, defs.SchemaTypes, defs.SchemaPropertyTypes, *vocabPath, gen.Options{
+		PackageName: *pkg,
+	})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = gen.WriteFiles(*out, files, fp); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,202 @@
+// Package gen contains the libraries and algorithms used to generate the
+// code for the storage package.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"github.com/go-fed/activity/tools/vocab/gen"
+	"go/format"
+)
+
+const (
+	storageFileName = "gen_storage.go"
+	toRowFnName     = "toRow"
+	scanRowFnName   = "scanRow"
+	// DefaultPackageName is the name of the generated package when the
+	// Options do not name it.
+	DefaultPackageName = "storage"
+	// DefaultVocabPath is the import path of the vocab package when the
+	// Options do not name it.
+	DefaultVocabPath = "github.com/go-fed/activity/vocab"
+	// vocabAlias is the name the generated code refers to the vocab package
+	// by, whatever its import path.
+	vocabAlias = "vocab"
+)
+
+// storageCode is the table definition and the helpers shared by the functions
+// of every type.
+const storageCode = `// Table is the name of the table the rows are stored in.
+const Table = "activitystreams_objects"
+
+// Schema is the PostgreSQL statement creating the table the rows are stored
+// in. Other databases can store the document as text instead of JSONB.
+const Schema = ` + "`" + `CREATE TABLE IF NOT EXISTS activitystreams_objects (
+	id TEXT PRIMARY KEY,
+	type TEXT NOT NULL,
+	document JSONB NOT NULL
+)` + "`" + `
+
+// Columns are the columns of the table, in the order of the values returned by
+// Row.Values and the order the ScanRow functions expect them to be selected in.
+var Columns = []string{"id", "type", "document"}
+
+// Scanner scans the columns of a row, such as *sql.Row and *sql.Rows.
+type Scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Row is a value of the vocabulary stored in the table.
+type Row struct {
+	// Id is the IRI identifying the value.
+	Id string
+	// Type is the name of the vocabulary type of the value.
+	Type string
+	// Document is the value serialized as JSON.
+	Document []byte
+}
+
+// Values returns the values of the columns of the row, in the order of Columns.
+func (r *Row) Values() []interface{} {
+	return []interface{}{r.Id, r.Type, r.Document}
+}
+
+// identified is a value of the vocabulary that can be stored in a row.
+type identified interface {
+	vocab.Serializer
+	HasId() bool
+	GetId() *url.URL
+}
+
+// toRow serializes the value of the named type into a row. Values without an
+// id cannot be stored, since it is the primary key of the table.
+func toRow(v identified, typeName string) (*Row, error) {
+	if !v.HasId() {
+		return nil, fmt.Errorf("Cannot store %s without an id", typeName)
+	}
+	m, err := v.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return &Row{Id: v.GetId().String(), Type: typeName, Document: b}, nil
+}
+
+// scanRow scans a row and deserializes its document into the value, which
+// must be of the type stored in the row.
+func scanRow(s Scanner, typeName string, v vocab.Deserializer) error {
+	r := &Row{}
+	if err := s.Scan(&r.Id, &r.Type, &r.Document); err != nil {
+		return err
+	}
+	if r.Type != typeName {
+		return fmt.Errorf("Cannot scan row of type %s into %s", r.Type, typeName)
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(r.Document, &m); err != nil {
+		return err
+	}
+	return v.Deserialize(m)
+}`
+
+// File is a generated file, as written by the WriteFiles of the vocab
+// generator.
+type File = gen.File
+
+// Options configures the generated package.
+type Options struct {
+	// PackageName is the name of the generated package, or 'storage' if it
+	// is empty.
+	PackageName string
+	// VocabPath is the import path of the package generated by the vocab
+	// tool, or the one of this library if it is empty.
+	VocabPath string
+}
+
+// packageName returns the name of the package to generate.
+func (o Options) packageName() string {
+	if len(o.PackageName) > 0 {
+		return o.PackageName
+	}
+	return DefaultPackageName
+}
+
+// vocabPath returns the import path of the vocab package.
+func (o Options) vocabPath() string {
+	if len(o.VocabPath) > 0 {
+		return o.VocabPath
+	}
+	return DefaultVocabPath
+}
+
+// GenerateStorage generates the storage package, which has a ToRow and a
+// ScanRow function for each of the types.
+func GenerateStorage(types []*defs.Type) (f []*File, err error) {
+	return GenerateStorageWithOptions(types, Options{})
+}
+
+// GenerateStorageWithOptions generates the storage package like
+// GenerateStorage, configured by the options.
+func GenerateStorageWithOptions(types []*defs.Type, o Options) (f []*File, err error) {
+	p := generatePackageDefinition(o)
+	for _, t := range types {
+		p.F = append(p.F, generateToRowFunction(t), generateScanRowFunction(t))
+	}
+	var b []byte
+	b, err = format.Source([]byte(p.Generate()))
+	if err != nil {
+		return
+	}
+	f = append(f, &File{
+		Name:    storageFileName,
+		Content: b,
+	})
+	return
+}
+
+func generatePackageDefinition(o Options) *defs.PackageDef {
+	p := &defs.PackageDef{
+		Name:    o.packageName(),
+		Comment: "Package " + o.packageName() + " helps persist the ActivityStream vocabulary types in a SQL database. Each value is stored as a row of a single table, holding its id, its type, and its JSON serialization. This package is code-generated alongside the vocab package. Do not modify this package directly.",
+		Imports: []string{"encoding/json", "fmt", o.vocabPath(), "net/url"},
+		Raw:     storageCode,
+	}
+	if o.vocabPath() != DefaultVocabPath {
+		p.ImportAliases = map[string]string{o.vocabPath(): vocabAlias}
+	}
+	return p
+}
+
+func generateToRowFunction(t *defs.Type) *defs.FunctionDef {
+	return &defs.FunctionDef{
+		Name:    fmt.Sprintf("ToRow%s", t.Name),
+		Comment: fmt.Sprintf("ToRow%s serializes the %s into a Row, returning an error if it has no id.", t.Name, t.Name),
+		Args:    []*defs.FunctionVarDef{{Name: "v", Type: fmt.Sprintf("*vocab.%s", t.Name)}},
+		Return:  []*defs.FunctionVarDef{{Name: "r", Type: "*Row"}, {Name: "err", Type: "error"}},
+		Body: func() string {
+			return fmt.Sprintf("return %s(v, %q)", toRowFnName, t.Name)
+		},
+	}
+}
+
+func generateScanRowFunction(t *defs.Type) *defs.FunctionDef {
+	return &defs.FunctionDef{
+		Name:    fmt.Sprintf("ScanRow%s", t.Name),
+		Comment: fmt.Sprintf("ScanRow%s scans a Row holding a %s, returning an error if the row holds another type.", t.Name, t.Name),
+		Args:    []*defs.FunctionVarDef{{Name: "s", Type: "Scanner"}},
+		Return:  []*defs.FunctionVarDef{{Name: "v", Type: fmt.Sprintf("*vocab.%s", t.Name)}, {Name: "err", Type: "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("v = &vocab.%s{}\n", t.Name))
+			b.WriteString(fmt.Sprintf("if err = %s(s, %q, v); err != nil {\n", scanRowFnName, t.Name))
+			b.WriteString("return nil, err\n")
+			b.WriteString("}\n")
+			b.WriteString("return")
+			return b.String()
+		},
+	}
+}
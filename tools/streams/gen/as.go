@@ -23,14 +23,480 @@ const (
 	UnknownLanguage = "und"
 )
 
-type File struct {
-	Name    string
-	Content []byte
+// registryCode is the Deserialize function dispatching on the 'type' of the
+// value through the Registry. It is formatted with the entries of the
+// Registry.
+const registryCode = `// DeserializeFunc deserializes the generic map form of a type into its
+// convenience type.
+type DeserializeFunc func(m map[string]interface{}) (vocab.Serializer, error)
+
+// Registry maps the name of each ActivityStream type to the function
+// deserializing it. Applications may add the types of their own extensions.
+var Registry = map[string]DeserializeFunc{
+%s}
+
+// Deserialize deserializes the generic map form of any ActivityStream type into
+// its convenience type, such as a *Note, using the function in the Registry of
+// the first of its types that has one. It returns an error if the value has no
+// type, or none of them is in the Registry.
+func Deserialize(m map[string]interface{}) (vocab.Serializer, error) {
+	names, err := typeNames(m)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if fn, ok := Registry[name]; ok {
+			return fn(m)
+		}
+	}
+	return nil, fmt.Errorf("Cannot determine type: no deserializer is registered for %%v", names)
+}
+
+// DeserializeAll deserializes the generic map form of a value of several types,
+// such as ["Person", "toot:FeaturedActor"], into a convenience type for each of
+// its types that has a function in the Registry, in the order of its types. It
+// returns an error if the value has no type, none of them is in the Registry,
+// or the value cannot be deserialized as one of them.
+func DeserializeAll(m map[string]interface{}) ([]vocab.Serializer, error) {
+	names, err := typeNames(m)
+	if err != nil {
+		return nil, err
+	}
+	var all []vocab.Serializer
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		fn, ok := Registry[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		s, err := fn(m)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, s)
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("Cannot determine type: no deserializer is registered for %%v", names)
+	}
+	return all, nil
+}
+
+// DeserializeStrict deserializes the generic map form of any ActivityStream type
+// like Deserialize, then returns the problems the ValidateStrict method of its
+// vocab type finds with it, such as unknown properties, if there are any.
+func DeserializeStrict(m map[string]interface{}) (vocab.Serializer, error) {
+	s, err := Deserialize(m)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := unwrap(s).(interface {
+		ValidateStrict() error
+	}); ok {
+		if err = v.ValidateStrict(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// typeNames returns the names of the types of the generic map form of a value,
+// which may have one type or an array of them.
+func typeNames(m map[string]interface{}) (names []string, err error) {
+	switch t := m["type"].(type) {
+	case string:
+		names = append(names, t)
+	case []interface{}:
+		for _, elem := range t {
+			if name, ok := elem.(string); ok {
+				names = append(names, name)
+			}
+		}
+	case nil:
+		return nil, fmt.Errorf("Cannot determine type: missing 'type' property")
+	default:
+		return nil, fmt.Errorf("Cannot determine type: 'type' property is not string nor []interface{}: %%T", t)
+	}
+	return
+}`
+
+// jsonResolverCode is the JSONResolver dispatching the generic map form of a
+// value to the callback of its type. It is formatted with the cases accepting
+// the callback of each type.
+const jsonResolverCode = `// ErrNoCallbackMatch is returned by JSONResolver.Resolve when none of the types
+// of the value has a callback.
+var ErrNoCallbackMatch = errors.New("activity stream did not match any callback")
+
+// jsonCallback deserializes a value of one type and passes it to its callback.
+type jsonCallback struct {
+	deserialize DeserializeFunc
+	callback    func(vocab.Serializer) error
+}
+
+// JSONResolver dispatches the generic map form of a value directly to the
+// callback of its type, deserializing it only when it has one. Unlike a
+// Resolver, it is built from the callbacks themselves, such as a
+// func(*Note) error.
+type JSONResolver struct {
+	callbacks map[string]jsonCallback
+	// interfaces are the callbacks taking an interface, in the order given.
+	interfaces []reflect.Value
+}
+
+// errorType is the type of the result of the callbacks.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// NewJSONResolver returns a JSONResolver invoking the callbacks, each of which
+// is a function taking one argument and returning an error. The argument is
+// either one of the types of this package, such as in a func(*Note) error, or
+// an interface satisfied by a whole family of types, such as in a
+// func(vocab.ActivityType) error or a func(interface{ LenObject() int }) error.
+// It returns an error if a callback is of any other type, or if several
+// callbacks take the same type.
+func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
+	j := &JSONResolver{callbacks: make(map[string]jsonCallback, len(callbacks))}
+	for _, i := range callbacks {
+		var name string
+		var c jsonCallback
+		switch fn := i.(type) {
+%s		default:
+			v := reflect.ValueOf(i)
+			if v.Kind() != reflect.Func || v.IsNil() {
+				return nil, fmt.Errorf("NewJSONResolver: callback of unsupported type %%T", i)
+			}
+			if ft := v.Type(); ft.NumIn() != 1 || ft.In(0).Kind() != reflect.Interface || ft.NumOut() != 1 || ft.Out(0) != errorType {
+				return nil, fmt.Errorf("NewJSONResolver: callback of unsupported type %%T", i)
+			}
+			j.interfaces = append(j.interfaces, v)
+			continue
+		}
+		if _, ok := j.callbacks[name]; ok {
+			return nil, fmt.Errorf("NewJSONResolver: several callbacks for type %%s", name)
+		}
+		j.callbacks[name] = c
+	}
+	return j, nil
+}
+
+// Resolve deserializes the generic map form of a value and passes it to the
+// callback of each of its types that has one, in the order of its types, so
+// that a value of several types, such as ["Person", "Service"], satisfies the
+// callback of each. It returns the first error of a callback, without calling
+// the others. Otherwise, the value is deserialized with the Registry like
+// Deserialize does, and passed to the first callback taking an interface that
+// either the value or its Raw vocab type satisfies. It returns
+// ErrNoCallbackMatch if no callback applies.
+func (j *JSONResolver) Resolve(m map[string]interface{}) error {
+	names, err := typeNames(m)
+	if err != nil {
+		return err
+	}
+	matched := false
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		c, ok := j.callbacks[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		matched = true
+		s, err := c.deserialize(m)
+		if err != nil {
+			return err
+		}
+		if err = c.callback(s); err != nil {
+			return err
+		}
+	}
+	if matched {
+		return nil
+	} else if len(j.interfaces) == 0 {
+		return ErrNoCallbackMatch
+	}
+	for _, name := range names {
+		if fn, ok := Registry[name]; ok {
+			s, err := fn(m)
+			if err != nil {
+				return err
+			}
+			return j.resolveInterface(s)
+		}
+	}
+	return ErrNoCallbackMatch
+}
+
+// resolveInterface passes the value to the first callback taking an interface
+// that either the value or its Raw vocab type satisfies.
+func (j *JSONResolver) resolveInterface(s vocab.Serializer) error {
+	v := reflect.ValueOf(s)
+	var raw reflect.Value
+	if fn := v.MethodByName("Raw"); fn.IsValid() && fn.Type().NumIn() == 0 && fn.Type().NumOut() == 1 {
+		raw = fn.Call(nil)[0]
+	}
+	for _, fn := range j.interfaces {
+		arg := v
+		if in := fn.Type().In(0); !v.Type().Implements(in) {
+			if !raw.IsValid() || !raw.Type().Implements(in) {
+				continue
+			}
+			arg = raw
+		}
+		err, _ := fn.Call([]reflect.Value{arg})[0].Interface().(error)
+		return err
+	}
+	return ErrNoCallbackMatch
+}`
+
+// conversionCode is As and ToType, converting values to the type of a target.
+// It is formatted with the cases wrapping each vocab type into its convenience
+// type, then with the cases unwrapping them.
+const conversionCode = `// As sets the target, a non-nil pointer to one of the types of this package, to
+// a vocab type, or to an interface, to the value if it is of that type, and
+// returns whether it did. A vocab type is also converted to the type of this
+// package wrapping it, so that a *vocab.Note can be set to a *Note. Like
+// errors.As, it takes a pointer rather than a type parameter, as this package
+// targets Go versions without generics. It panics if the target is not a
+// non-nil pointer.
+func As(v vocab.Type, target interface{}) bool {
+	return assign(target, v, wrap(v))
+}
+
+// ToType deserializes the generic map form of a value like Deserialize does,
+// and sets the target like As does, either to the value or to its Raw vocab
+// type. It returns an error if the value cannot be deserialized, or is not of
+// the type of the target.
+func ToType(m map[string]interface{}, target interface{}) error {
+	s, err := Deserialize(m)
+	if err != nil {
+		return err
+	}
+	if !assign(target, s, unwrap(s)) {
+		return fmt.Errorf("ToType: cannot set %%T to a value of type %%T", target, s)
+	}
+	return nil
+}
+
+// assign sets the target to the first of the values assignable to it.
+func assign(target interface{}, values ...interface{}) bool {
+	t := reflect.ValueOf(target)
+	if t.Kind() != reflect.Ptr || t.IsNil() {
+		panic(fmt.Sprintf("%s: target must be a non-nil pointer, got %%T", target))
+	}
+	e := t.Elem()
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if rv := reflect.ValueOf(v); rv.Type().AssignableTo(e.Type()) {
+			e.Set(rv)
+			return true
+		}
+	}
+	return false
+}
+
+// wrap returns the type of this package wrapping the vocab type, or nil if
+// there is none.
+func wrap(v vocab.Type) vocab.Serializer {
+	switch x := v.(type) {
+%s	}
+	return nil
+}
+
+// unwrap returns the vocab type wrapped by the type of this package, or nil if
+// it is of no type of this package.
+func unwrap(s vocab.Serializer) vocab.Serializer {
+	switch x := s.(type) {
+%s	}
+	return nil
+}`
+
+// convertCode is Convert, which copies a value into one of another type.
+const convertCode = `// Convert returns a new vocab value of the type named by the target, such as
+// "Article" or "Tombstone", with the properties of the value that this type
+// also has, so that a Note can be migrated into an Article when it is edited,
+// or an Object replaced by a Tombstone when it is deleted. The 'type' of the
+// new value is only the target; the properties of the target that depend on the
+// conversion, such as the 'formerType' and 'deleted' of a Tombstone, are left
+// for the caller to set. The unknown properties of the value, such as those of
+// extensions, are copied too. It returns an error if the value is nil, cannot
+// be serialized, or the target is not in the Registry.
+func Convert(src vocab.Type, targetTypeName string) (vocab.Type, error) {
+	if src == nil {
+		return nil, fmt.Errorf("Convert: nil value")
+	}
+	fn, ok := Registry[targetTypeName]
+	if !ok {
+		return nil, fmt.Errorf("Convert: no deserializer is registered for %s", targetTypeName)
+	}
+	m, err := src.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	m["type"] = targetTypeName
+	s, err := fn(m)
+	if err != nil {
+		return nil, err
+	}
+	raw := unwrap(s)
+	if raw == nil {
+		raw = s
+	}
+	t, ok := raw.(vocab.Type)
+	if !ok {
+		return nil, fmt.Errorf("Convert: %T is not a vocab type", raw)
+	}
+	type unknowns interface {
+		HasUnknown(k string) bool
+		SetUnknownProperty(k string, i interface{})
+	}
+	from, fromOk := src.(unknowns)
+	to, toOk := t.(unknowns)
+	if fromOk && toOk {
+		for k := range m {
+			if to.HasUnknown(k) && !from.HasUnknown(k) {
+				to.SetUnknownProperty(k, nil)
+			}
+		}
+	}
+	return t, nil
+}`
+
+// identityCode is GetId, GetTypeName, and GetTypeNames, which work on a value of
+// any type.
+const identityCode = `// GetId returns the 'id' of the value, or the 'href' of a Link without an
+// 'id', such as most Mentions. It returns an error if the value is nil or has
+// neither.
+func GetId(t vocab.Type) (*url.URL, error) {
+	if t == nil {
+		return nil, fmt.Errorf("GetId: nil value")
+	}
+	if i, ok := t.(interface {
+		HasId() bool
+		GetId() *url.URL
+	}); ok && i.HasId() {
+		return i.GetId(), nil
+	}
+	if l, ok := t.(interface {
+		HasHref() bool
+		GetHref() *url.URL
+	}); ok && l.HasHref() {
+		return l.GetHref(), nil
+	}
+	return nil, fmt.Errorf("GetId: %s has no id", GetTypeName(t))
+}
+
+// GetTypeName returns the name of the type of the value, such as "Note". A
+// value of several types, such as ["toot:Extension", "Note"], has the name of
+// the type it was deserialized as, and a value of an extension has the name of
+// its type in the extension. It returns an empty string if the value is nil.
+func GetTypeName(t vocab.Type) string {
+	if t == nil {
+		return ""
+	} else if k := t.Kind(); k != vocab.UnknownKind {
+		return k.String()
+	}
+	rt := reflect.TypeOf(t)
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return rt.Name()
+}
+
+// GetTypeNames returns the names of all the types of the value, such as
+// ["Person", "toot:FeaturedActor"], in the order of its 'type' property. A
+// value without a 'type' property has the name GetTypeName returns. It returns
+// nil if the value is nil.
+func GetTypeNames(t vocab.Type) []string {
+	if t == nil {
+		return nil
+	}
+	var names []string
+	if typer, ok := t.(interface {
+		TypeLen() int
+		GetType(index int) interface{}
+	}); ok {
+		for i := 0; i < typer.TypeLen(); i++ {
+			if s, ok := typer.GetType(i).(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+	if len(names) == 0 {
+		names = append(names, GetTypeName(t))
+	}
+	return names
+}`
+
+const (
+	// DefaultPackageName is the name of the generated package when the
+	// Options do not name it.
+	DefaultPackageName = "streams"
+	// DefaultVocabPath is the import path of the vocab package when the
+	// Options do not name it.
+	DefaultVocabPath = "github.com/go-fed/activity/vocab"
+	// vocabAlias is the name the generated code refers to the vocab package
+	// by, whatever its import path.
+	vocabAlias = "vocab"
+)
+
+// File is a generated file, as written by the WriteFiles of the vocab
+// generator.
+type File = gen.File
+
+// Options configures the generated package.
+type Options struct {
+	// PackageName is the name of the generated package, or 'streams' if it
+	// is empty.
+	PackageName string
+	// VocabPath is the import path of the package generated by the vocab
+	// tool, or the one of this library if it is empty.
+	VocabPath string
+}
+
+// packageName returns the name of the package to generate.
+func (o Options) packageName() string {
+	if len(o.PackageName) > 0 {
+		return o.PackageName
+	}
+	return DefaultPackageName
+}
+
+// vocabPath returns the import path of the vocab package.
+func (o Options) vocabPath() string {
+	if len(o.VocabPath) > 0 {
+		return o.VocabPath
+	}
+	return DefaultVocabPath
+}
+
+// importAliases returns the aliases of the imports, so that the vocab package
+// is referred to as 'vocab' even when it is generated under another name.
+func (o Options) importAliases() map[string]string {
+	if o.vocabPath() == DefaultVocabPath {
+		return nil
+	}
+	return map[string]string{o.vocabPath(): vocabAlias}
 }
 
 func GenerateConvenienceTypes(types []*defs.Type) (f []*File, err error) {
-	p := generatePackageDefinition()
+	return GenerateConvenienceTypesWithOptions(types, Options{})
+}
+
+// GenerateConvenienceTypesWithOptions generates the convenience types like
+// GenerateConvenienceTypes, configured by the options.
+func GenerateConvenienceTypesWithOptions(types []*defs.Type, o Options) (f []*File, err error) {
+	p := generatePackageDefinition(o)
 	p.Defs = append(p.Defs, generateResolver(types))
+	p.F = append(p.F, generatePredicatedResolver(types))
+	p.Raw += "\n\n" + generateRegistry(types)
+	p.Raw += "\n\n" + generateJSONResolver(types)
+	p.Raw += "\n\n" + generateConversions(types, o)
+	p.Raw += "\n\n" + convertCode
+	p.Raw += "\n\n" + identityCode
+	for _, t := range types {
+		p.F = append(p.F, generateRegistryDeserializer(t))
+	}
 
 	var b []byte
 	b, err = format.Source([]byte(p.Generate()))
@@ -38,12 +504,59 @@ func GenerateConvenienceTypes(types []*defs.Type) (f []*File, err error) {
 		Name:    "gen_streams.go",
 		Content: b,
 	})
+	var c *File
+	if c, err = generateConstructorsFile(types, o); err != nil {
+		return
+	} else if c != nil {
+		f = append(f, c)
+	}
+	if c, err = generateLimitsFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generateDereferenceFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generateIteratorFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generateDecoderFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generatePeekFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generatePublicFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generatePathFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generateWalkFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generateDiffFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
+	if c, err = generateHashFile(o); err != nil {
+		return
+	}
+	f = append(f, c)
 	for _, t := range types {
 		p := &defs.PackageDef{
-			Name: "streams",
+			Name:          o.packageName(),
+			ImportAliases: o.importAliases(),
 		}
 		funcs, defs, imports := generateDefinitions(t)
-		imports["github.com/go-fed/activity/vocab"] = true
+		imports[o.vocabPath()] = true
 		for i, _ := range imports {
 			p.Imports = append(p.Imports, i)
 		}
@@ -63,11 +576,12 @@ func GenerateConvenienceTypes(types []*defs.Type) (f []*File, err error) {
 	return
 }
 
-func generatePackageDefinition() *defs.PackageDef {
+func generatePackageDefinition(o Options) *defs.PackageDef {
 	return &defs.PackageDef{
-		Name:    "streams",
-		Comment: "Package streams is a convenience wrapper around the raw ActivityStream vocabulary. This package is code-generated to permit more powerful expressions and manipulations of the ActivityStreams Vocabulary types. This package also does not permit use of 'unknown' properties, or those that are outside of the ActivityStream Vocabulary specification. However, it still correctly propagates them when repeatedly re-and-de-serialized. Custom extensions of the vocabulary are supported by modifying the data definitions in the generation tool and rerunning it. Do not modify this package directly.",
-		Imports: []string{"fmt", "github.com/go-fed/activity/vocab"},
+		Name:          o.packageName(),
+		ImportAliases: o.importAliases(),
+		Comment:       "Package " + o.packageName() + " is a convenience wrapper around the raw ActivityStream vocabulary. This package is code-generated to permit more powerful expressions and manipulations of the ActivityStreams Vocabulary types. This package also does not permit use of 'unknown' properties, or those that are outside of the ActivityStream Vocabulary specification. However, it still correctly propagates them when repeatedly re-and-de-serialized. Custom extensions of the vocabulary are supported by modifying the data definitions in the generation tool and rerunning it. Do not modify this package directly.",
+		Imports:       []string{"errors", "fmt", "net/url", "reflect", o.vocabPath()},
 		Raw: `type Resolution int
 
 const (
@@ -86,6 +600,68 @@ const (
 	}
 }
 
+// generateRegistry generates the Registry of the functions deserializing each
+// type, and Deserialize dispatching through it.
+func generateRegistry(types []*defs.Type) string {
+	var b bytes.Buffer
+	for _, t := range types {
+		b.WriteString(fmt.Sprintf("%q: %s,\n", t.Name, registryDeserializerName(t)))
+	}
+	return fmt.Sprintf(registryCode, b.String())
+}
+
+// generateConversions generates As and ToType, converting values between the
+// vocab types and the types wrapping them.
+func generateConversions(types []*defs.Type, o Options) string {
+	var wrap, unwrap bytes.Buffer
+	for _, t := range types {
+		wrap.WriteString(fmt.Sprintf("case *vocab.%s:\n", t.Name))
+		wrap.WriteString("if x != nil {\n")
+		wrap.WriteString(fmt.Sprintf("return &%s{%s: x}\n", t.Name, rawMemberName))
+		wrap.WriteString("}\n")
+		unwrap.WriteString(fmt.Sprintf("case *%s:\n", t.Name))
+		unwrap.WriteString("if x != nil {\n")
+		unwrap.WriteString(fmt.Sprintf("return x.%s\n", rawMemberName))
+		unwrap.WriteString("}\n")
+	}
+	return fmt.Sprintf(conversionCode, o.packageName(), wrap.String(), unwrap.String())
+}
+
+// generateJSONResolver generates the JSONResolver, accepting a callback for
+// each type, or for the interfaces satisfied by families of types.
+func generateJSONResolver(types []*defs.Type) string {
+	var b bytes.Buffer
+	for _, t := range types {
+		b.WriteString(fmt.Sprintf("case func(*%s) error:\n", t.Name))
+		b.WriteString(fmt.Sprintf("name = %q\n", t.Name))
+		b.WriteString(fmt.Sprintf("c.deserialize = %s\n", registryDeserializerName(t)))
+		b.WriteString(fmt.Sprintf("c.callback = func(s vocab.Serializer) error { return fn(s.(*%s)) }\n", t.Name))
+	}
+	return fmt.Sprintf(jsonResolverCode, b.String())
+}
+
+func registryDeserializerName(t *defs.Type) string {
+	return fmt.Sprintf("deserialize%s", t.Name)
+}
+
+func generateRegistryDeserializer(t *defs.Type) *defs.FunctionDef {
+	return &defs.FunctionDef{
+		Name:    registryDeserializerName(t),
+		Comment: fmt.Sprintf("%s deserializes the generic map form of a %s.", registryDeserializerName(t), t.Name),
+		Args:    []*defs.FunctionVarDef{{Name: "m", Type: "map[string]interface{}"}},
+		Return:  []*defs.FunctionVarDef{{Name: "s", Type: "vocab.Serializer"}, {Name: "err", Type: "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("v := &vocab.%s{}\n", t.Name))
+			b.WriteString("if err = v.Deserialize(m); err != nil {\n")
+			b.WriteString("return nil, err\n")
+			b.WriteString("}\n")
+			b.WriteString(fmt.Sprintf("return &%s{%s: v}, nil", t.Name, rawMemberName))
+			return b.String()
+		},
+	}
+}
+
 func generateResolver(types []*defs.Type) *defs.StructDef {
 	this := &defs.StructDef{
 		Typename: resolverName,
@@ -238,6 +814,40 @@ func generateResolver(types []*defs.Type) *defs.StructDef {
 	return this
 }
 
+// generatePredicatedResolver generates NewPredicatedResolver, which wraps each
+// callback of a Resolver so that it is only invoked for the values satisfying
+// a predicate.
+func generatePredicatedResolver(types []*defs.Type) *defs.FunctionDef {
+	return &defs.FunctionDef{
+		Name:    "NewPredicatedResolver",
+		Comment: fmt.Sprintf("NewPredicatedResolver returns a %s invoking the callbacks of r only for the values for which the predicate returns true. The predicate is given the value each callback would have been given; the values it rejects are silently ignored, as if r had no callback for them. The callbacks of r are copied, so changing them later does not affect the returned %s.", resolverName, resolverName),
+		Args:    []*defs.FunctionVarDef{{"predicate", "func(vocab.Serializer) bool"}, {"r", "*" + resolverName}},
+		Return:  []*defs.FunctionVarDef{{"p", "*" + resolverName}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("p = &%s{}\n", resolverName))
+			callback := func(name, typ string) {
+				b.WriteString(fmt.Sprintf("if fn := r.%s; fn != nil {\n", name))
+				b.WriteString(fmt.Sprintf("p.%s = func(v %s) error {\n", name, typ))
+				b.WriteString("if !predicate(v) {\n")
+				b.WriteString("return nil\n")
+				b.WriteString("}\n")
+				b.WriteString("return fn(v)\n")
+				b.WriteString("}\n")
+				b.WriteString("}\n")
+			}
+			for _, t := range types {
+				callback(fmt.Sprintf("%sCallback", t.Name), "*"+t.Name)
+			}
+			callback("AnyObjectCallback", "vocab.ObjectType")
+			callback("AnyLinkCallback", "vocab.LinkType")
+			callback("AnyActivityCallback", "vocab.ActivityType")
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+}
+
 func generateDefinitions(t *defs.Type) (fd []*defs.FunctionDef, sd []*defs.StructDef, imports map[string]bool) {
 	imports = make(map[string]bool)
 	this := &defs.StructDef{
@@ -348,6 +958,16 @@ func generateNaturalLanguageConvenience(p *defs.PropertyType, this *defs.StructD
 				return fmt.Sprintf("t.%s.Set%sMap(l, v)\n", rawMemberName, titleName)
 			},
 		},
+		{
+			Name:    fmt.Sprintf("Preferred%sLanguage", titleName),
+			Comment: fmt.Sprintf("Preferred%sLanguage returns the value of '%s' in the language best matching the tags, which are in order of preference, and the tag of that language. If no language matches, the value in the first language in sorted order is returned.", titleName, p.Name),
+			P:       this,
+			Args:    []*defs.FunctionVarDef{{Name: "tags", Type: "...string"}},
+			Return:  []*defs.FunctionVarDef{{Name: "v", Type: "string"}, {Name: "tag", Type: "string"}},
+			Body: func() string {
+				return fmt.Sprintf("return t.%s.Preferred%sLanguage(tags...)\n", rawMemberName, titleName)
+			},
+		},
 	}...)
 }
 
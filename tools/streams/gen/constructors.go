@@ -0,0 +1,151 @@
+package gen
+
+import (
+	"bytes"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const constructorsFileName = "gen_constructors.go"
+
+// constructor is the code of a concise constructor of a common value, and the
+// types it needs.
+type constructor struct {
+	types []string
+	code  string
+}
+
+// constructors build the most common values federated with one call each.
+// They are named after the New functions of their types, which take no
+// arguments.
+var constructors = []constructor{
+	{[]string{"Note"}, `// NewNoteWith returns a new Note with the content, attributed to the actor,
+// and addressed to the recipients.
+func NewNoteWith(content string, attributedTo *url.URL, to ...*url.URL) *Note {
+	v := &vocab.Note{}
+	v.AppendContentString(content)
+	v.AppendAttributedToIRI(attributedTo)
+	for _, r := range to {
+		v.AppendToIRI(r)
+	}
+	return &Note{raw: v}
+}`},
+	{[]string{"Create"}, `// NewCreateWith returns a new Create of the object by the actor.
+func NewCreateWith(actor *url.URL, object vocab.ObjectType) *Create {
+	v := &vocab.Create{}
+	v.AppendActorIRI(actor)
+	v.AppendObject(object)
+	return &Create{raw: v}
+}`},
+	{[]string{"Update"}, `// NewUpdateWith returns a new Update of the object by the actor.
+func NewUpdateWith(actor *url.URL, object vocab.ObjectType) *Update {
+	v := &vocab.Update{}
+	v.AppendActorIRI(actor)
+	v.AppendObject(object)
+	return &Update{raw: v}
+}`},
+	{[]string{"Delete"}, `// NewDeleteWith returns a new Delete of the object at the IRI by the actor.
+func NewDeleteWith(actor, object *url.URL) *Delete {
+	v := &vocab.Delete{}
+	v.AppendActorIRI(actor)
+	v.AppendObjectIRI(object)
+	return &Delete{raw: v}
+}`},
+	{[]string{"Follow"}, `// NewFollowWith returns a new Follow of the object, usually another actor, by
+// the actor, addressed to the object.
+func NewFollowWith(actor, object *url.URL) *Follow {
+	v := &vocab.Follow{}
+	v.AppendActorIRI(actor)
+	v.AppendObjectIRI(object)
+	v.AppendToIRI(object)
+	return &Follow{raw: v}
+}`},
+	{[]string{"Accept", "Follow"}, `// NewAcceptWith returns a new Accept of the Follow by the actor it follows,
+// addressed to the follower.
+func NewAcceptWith(follow *Follow) *Accept {
+	v := &vocab.Accept{}
+	replyToFollow(follow.raw, v.AppendActorIRI, v.AppendToIRI)
+	v.AppendObject(follow.raw)
+	return &Accept{raw: v}
+}`},
+	{[]string{"Reject", "Follow"}, `// NewRejectWith returns a new Reject of the Follow by the actor it follows,
+// addressed to the follower.
+func NewRejectWith(follow *Follow) *Reject {
+	v := &vocab.Reject{}
+	replyToFollow(follow.raw, v.AppendActorIRI, v.AppendToIRI)
+	v.AppendObject(follow.raw)
+	return &Reject{raw: v}
+}`},
+	{[]string{"Follow"}, `// replyToFollow sets the actor of a reply to the Follow to the actor it follows,
+// and addresses it to the follower, when they are IRIs.
+func replyToFollow(follow *vocab.Follow, actor, to func(*url.URL)) {
+	if follow.ObjectLen() > 0 && follow.IsObjectIRI(0) {
+		actor(follow.GetObjectIRI(0))
+	}
+	if follow.ActorLen() > 0 && follow.IsActorIRI(0) {
+		to(follow.GetActorIRI(0))
+	}
+}`},
+	{[]string{"Undo"}, `// NewUndoWith returns a new Undo of the activity by the actor.
+func NewUndoWith(actor *url.URL, activity vocab.ObjectType) *Undo {
+	v := &vocab.Undo{}
+	v.AppendActorIRI(actor)
+	v.AppendObject(activity)
+	return &Undo{raw: v}
+}`},
+	{[]string{"Like"}, `// NewLikeWith returns a new Like of the object at the IRI by the actor.
+func NewLikeWith(actor, object *url.URL) *Like {
+	v := &vocab.Like{}
+	v.AppendActorIRI(actor)
+	v.AppendObjectIRI(object)
+	return &Like{raw: v}
+}`},
+	{[]string{"Announce"}, `// NewAnnounceWith returns a new Announce of the object at the IRI by the actor,
+// sharing it with the recipients.
+func NewAnnounceWith(actor, object *url.URL, to ...*url.URL) *Announce {
+	v := &vocab.Announce{}
+	v.AppendActorIRI(actor)
+	v.AppendObjectIRI(object)
+	for _, r := range to {
+		v.AppendToIRI(r)
+	}
+	return &Announce{raw: v}
+}`},
+}
+
+// generateConstructorsFile generates the constructors whose types are among
+// the types, or no file if there are none.
+func generateConstructorsFile(types []*defs.Type, o Options) (*File, error) {
+	names := make(map[string]bool, len(types))
+	for _, t := range types {
+		names[t.Name] = true
+	}
+	var b bytes.Buffer
+	for _, c := range constructors {
+		ok := true
+		for _, t := range c.types {
+			ok = ok && names[t]
+		}
+		if ok {
+			b.WriteString(c.code)
+			b.WriteString("\n\n")
+		}
+	}
+	if b.Len() == 0 {
+		return nil, nil
+	}
+	p := &defs.PackageDef{
+		Name:          o.packageName(),
+		ImportAliases: o.importAliases(),
+		Imports:       []string{"net/url", o.vocabPath()},
+		Raw:           b.String(),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    constructorsFileName,
+		Content: c,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const hashFileName = "gen_hash.go"
+
+// hashCode hashes the content of values, however their JSON was written.
+const hashCode = `// ContentHash returns the SHA-256 hash of the canonical serialized form of the
+// value, so that servers can detect the same logical activity delivered more
+// than once. Values equal but for the order of their properties, their
+// '@context', or the ActivityStreams terms they write as absolute IRIs, such
+// as "https://www.w3.org/ns/activitystreams#content" for "content", have the
+// same hash. It returns the zero hash if the value cannot be serialized.
+func ContentHash(t vocab.Type) (h [32]byte) {
+	m, err := vocab.SerializeWith(t, vocab.OmitContext(), vocab.CompactIRIs())
+	if err != nil {
+		return
+	}
+	b, err := vocab.EncodeCanonical(m)
+	if err != nil {
+		return
+	}
+	return sha256.Sum256(b)
+}`
+
+// generateHashFile generates ContentHash, which hashes the content of values.
+func generateHashFile(o Options) (*File, error) {
+	p := &defs.PackageDef{
+		Name:          o.packageName(),
+		ImportAliases: o.importAliases(),
+		Imports:       []string{"crypto/sha256", o.vocabPath()},
+		Raw:           hashCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    hashFileName,
+		Content: c,
+	}, nil
+}
@@ -0,0 +1,319 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const iteratorFileName = "gen_iterator.go"
+
+// iteratorCode walks the items of collections across their pages.
+const iteratorCode = `// DefaultMaxPages is the number of pages a CollectionIterator visits when its
+// MaxPages is zero.
+const DefaultMaxPages = 100
+
+// DefaultMaxItems is the number of items a CollectionIterator yields when its
+// MaxItems is zero.
+const DefaultMaxItems = 10000
+
+// ErrMaxPages is returned by a CollectionIterator visiting more pages than its
+// MaxPages.
+var ErrMaxPages = errors.New("collection iteration exceeded the maximum pages")
+
+// ErrMaxItems is returned by a CollectionIterator yielding more items than its
+// MaxItems.
+var ErrMaxItems = errors.New("collection iteration exceeded the maximum items")
+
+// CollectionIterator yields the items of a Collection or OrderedCollection one
+// at a time, fetching its 'first' page and the 'next' pages after it with a
+// Dereferencer. Started at a page, it yields the items of that page and of the
+// pages after it. Pages whose 'partOf' is another collection, and pages already
+// visited, end the iteration.
+//
+//	it := streams.IterateCollection(ctx, outboxIRI, transport)
+//	for it.Next() {
+//		item := it.Item()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type CollectionIterator struct {
+	// MaxPages is the number of pages visited, including the collection,
+	// or DefaultMaxPages if it is zero.
+	MaxPages int
+	// MaxItems is the number of items yielded, or DefaultMaxItems if it is
+	// zero.
+	MaxItems int
+
+	ctx        context.Context
+	d          *Dereferencer
+	page       interface{}
+	collection *url.URL
+	visited    map[string]bool
+	pages      int
+	items      []interface{}
+	n          int
+	item       interface{}
+	err        error
+}
+
+// IterateCollection returns an iterator over the items of the collection with
+// the Transport, using no cache and the default limits. See
+// Dereferencer.IterateCollection.
+func IterateCollection(ctx context.Context, collection interface{}, t Transport) *CollectionIterator {
+	d := &Dereferencer{Transport: t}
+	return d.IterateCollection(ctx, collection)
+}
+
+// IterateCollection returns an iterator over the items of the collection, which
+// is a Collection, an OrderedCollection, one of their pages, or their IRI or Link
+// as accepted by Dereference.
+func (d *Dereferencer) IterateCollection(ctx context.Context, collection interface{}) *CollectionIterator {
+	return &CollectionIterator{
+		ctx:     ctx,
+		d:       d,
+		page:    collection,
+		visited: make(map[string]bool),
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// needed. It returns false at the end of the collection or on an error, which
+// Err returns.
+func (c *CollectionIterator) Next() bool {
+	if c.err != nil {
+		return false
+	}
+	max := c.MaxItems
+	if max <= 0 {
+		max = DefaultMaxItems
+	}
+	for len(c.items) == 0 {
+		if c.page == nil {
+			c.item = nil
+			return false
+		} else if c.err = c.visit(); c.err != nil {
+			c.item = nil
+			return false
+		}
+	}
+	if c.n >= max {
+		c.item = nil
+		c.err = ErrMaxItems
+		return false
+	}
+	c.item = c.items[0]
+	c.items = c.items[1:]
+	c.n++
+	return true
+}
+
+// Item returns the current item, which is a vocab.ObjectType, a vocab.LinkType,
+// or an IRI as a *url.URL. Dereference fetches those that are not inlined.
+func (c *CollectionIterator) Item() interface{} {
+	return c.item
+}
+
+// Err returns the error that ended the iteration, if any.
+func (c *CollectionIterator) Err() error {
+	return c.err
+}
+
+// visit resolves the next page, queueing its items and the page after it.
+func (c *CollectionIterator) visit() error {
+	max := c.MaxPages
+	if max <= 0 {
+		max = DefaultMaxPages
+	}
+	if c.pages >= max {
+		return ErrMaxPages
+	}
+	v, err := c.d.Dereference(c.ctx, c.page)
+	if err != nil {
+		return err
+	}
+	c.pages++
+	c.page = nil
+	if id, err := GetId(v); err == nil {
+		if c.visited[id.String()] {
+			return nil
+		}
+		c.visited[id.String()] = true
+	}
+	if p, ok := v.(pagedProperties); ok {
+		partOf := partOfId(p)
+		if partOf != nil && c.collection == nil {
+			c.collection = partOf
+		} else if partOf != nil && partOf.String() != c.collection.String() {
+			return nil
+		}
+		c.page = nextPage(p)
+	} else {
+		if id, err := GetId(v); err == nil {
+			c.collection = id
+		}
+		c.page = firstPage(v)
+	}
+	c.items = collectionItems(v)
+	return nil
+}
+
+// itemsProperties are the accessors of the 'items' of a Collection.
+type itemsProperties interface {
+	ItemsLen() int
+	IsItemsObject(index int) bool
+	GetItemsObject(index int) vocab.ObjectType
+	IsItemsLink(index int) bool
+	GetItemsLink(index int) vocab.LinkType
+	IsItemsIRI(index int) bool
+	GetItemsIRI(index int) *url.URL
+}
+
+// orderedItemsProperties are the accessors of the 'orderedItems' of an
+// OrderedCollection.
+type orderedItemsProperties interface {
+	OrderedItemsLen() int
+	IsOrderedItemsObject(index int) bool
+	GetOrderedItemsObject(index int) vocab.ObjectType
+	IsOrderedItemsLink(index int) bool
+	GetOrderedItemsLink(index int) vocab.LinkType
+	IsOrderedItemsIRI(index int) bool
+	GetOrderedItemsIRI(index int) *url.URL
+}
+
+// collectionItems returns the 'orderedItems' of the value, or else its
+// 'items'.
+func collectionItems(v vocab.Type) (items []interface{}) {
+	if o, ok := v.(orderedItemsProperties); ok && o.OrderedItemsLen() > 0 {
+		for i := 0; i < o.OrderedItemsLen(); i++ {
+			if o.IsOrderedItemsObject(i) {
+				items = append(items, o.GetOrderedItemsObject(i))
+			} else if o.IsOrderedItemsLink(i) {
+				items = append(items, o.GetOrderedItemsLink(i))
+			} else if o.IsOrderedItemsIRI(i) {
+				items = append(items, o.GetOrderedItemsIRI(i))
+			}
+		}
+	} else if o, ok := v.(itemsProperties); ok {
+		for i := 0; i < o.ItemsLen(); i++ {
+			if o.IsItemsObject(i) {
+				items = append(items, o.GetItemsObject(i))
+			} else if o.IsItemsLink(i) {
+				items = append(items, o.GetItemsLink(i))
+			} else if o.IsItemsIRI(i) {
+				items = append(items, o.GetItemsIRI(i))
+			}
+		}
+	}
+	return
+}
+
+// firstProperties are the accessors of the 'first' page of a collection.
+type firstProperties interface {
+	IsFirstLink() bool
+	GetFirstLink() vocab.LinkType
+	IsFirstIRI() bool
+	GetFirstIRI() *url.URL
+}
+
+// firstPage returns the 'first' page of the collection, or nil if it has none.
+func firstPage(v vocab.Type) interface{} {
+	switch x := v.(type) {
+	case interface {
+		IsFirstOrderedCollectionPage() bool
+		GetFirstOrderedCollectionPage() vocab.OrderedCollectionPageType
+	}:
+		if x.IsFirstOrderedCollectionPage() {
+			return x.GetFirstOrderedCollectionPage()
+		}
+	case interface {
+		IsFirstCollectionPage() bool
+		GetFirstCollectionPage() vocab.CollectionPageType
+	}:
+		if x.IsFirstCollectionPage() {
+			return x.GetFirstCollectionPage()
+		}
+	}
+	if x, ok := v.(firstProperties); ok {
+		if x.IsFirstLink() {
+			return x.GetFirstLink()
+		} else if x.IsFirstIRI() {
+			return x.GetFirstIRI()
+		}
+	}
+	return nil
+}
+
+// pagedProperties are the accessors of the 'next' page and of the collection
+// a page is 'partOf'.
+type pagedProperties interface {
+	IsNextLink() bool
+	GetNextLink() vocab.LinkType
+	IsNextIRI() bool
+	GetNextIRI() *url.URL
+	IsPartOfLink() bool
+	GetPartOfLink() vocab.LinkType
+	IsPartOfCollection() bool
+	GetPartOfCollection() vocab.CollectionType
+	IsPartOfIRI() bool
+	GetPartOfIRI() *url.URL
+}
+
+// nextPage returns the 'next' page of the page, or nil if it is the last.
+func nextPage(p pagedProperties) interface{} {
+	switch x := p.(type) {
+	case interface {
+		IsNextOrderedCollectionPage() bool
+		GetNextOrderedCollectionPage() vocab.OrderedCollectionPageType
+	}:
+		if x.IsNextOrderedCollectionPage() {
+			return x.GetNextOrderedCollectionPage()
+		}
+	case interface {
+		IsNextCollectionPage() bool
+		GetNextCollectionPage() vocab.CollectionPageType
+	}:
+		if x.IsNextCollectionPage() {
+			return x.GetNextCollectionPage()
+		}
+	}
+	if p.IsNextLink() {
+		return p.GetNextLink()
+	} else if p.IsNextIRI() {
+		return p.GetNextIRI()
+	}
+	return nil
+}
+
+// partOfId returns the id of the collection the page is 'partOf', or nil if it
+// is unknown.
+func partOfId(p pagedProperties) *url.URL {
+	if p.IsPartOfIRI() {
+		return p.GetPartOfIRI()
+	} else if p.IsPartOfLink() && p.GetPartOfLink().HasHref() {
+		return p.GetPartOfLink().GetHref()
+	} else if p.IsPartOfCollection() && p.GetPartOfCollection().HasId() {
+		return p.GetPartOfCollection().GetId()
+	}
+	return nil
+}`
+
+// generateIteratorFile generates the CollectionIterator, walking the pages of
+// collections.
+func generateIteratorFile(o Options) (*File, error) {
+	p := &defs.PackageDef{
+		Name:          o.packageName(),
+		ImportAliases: o.importAliases(),
+		Imports:       []string{"context", "errors", "net/url", o.vocabPath()},
+		Raw:           iteratorCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    iteratorFileName,
+		Content: c,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const peekFileName = "gen_peek.go"
+
+// peekCode extracts the properties used to route activities without
+// deserializing them.
+const peekCode = `// Peeked are the properties of a raw payload needed to route, deduplicate, or
+// rate-limit it, extracted by Peek.
+type Peeked struct {
+	// Id is the 'id' of the value, or nil if it has none.
+	Id *url.URL
+	// Types are the names of its types.
+	Types []string
+	// Actors are the ids of its 'actor', whether IRIs or inlined.
+	Actors []*url.URL
+	// ObjectIds are the ids of its 'object', whether IRIs or inlined.
+	ObjectIds []*url.URL
+}
+
+// peekFields are the only fields of a payload Peek decodes.
+type peekFields struct {
+	Id      string          ` + "`json:\"id\"`" + `
+	AtId    string          ` + "`json:\"@id\"`" + `
+	Type    json.RawMessage ` + "`json:\"type\"`" + `
+	AtType  json.RawMessage ` + "`json:\"@type\"`" + `
+	Actor   json.RawMessage ` + "`json:\"actor\"`" + `
+	Object  json.RawMessage ` + "`json:\"object\"`" + `
+}
+
+// peekValue is an inlined value, of which only the id is decoded.
+type peekValue struct {
+	Id   string ` + "`json:\"id\"`" + `
+	AtId string ` + "`json:\"@id\"`" + `
+	Href string ` + "`json:\"href\"`" + `
+}
+
+// Peek extracts the 'id', 'type', 'actor', and the ids of the 'object' of a raw
+// JSON payload, skipping the rest of it, without deserializing it into its vocab
+// type. It is much cheaper than Deserialize, but trusts the payload as much:
+// the ids are as claimed, and nothing else is validated.
+func Peek(b []byte) (*Peeked, error) {
+	var f peekFields
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, err
+	}
+	p := &Peeked{}
+	var err error
+	if p.Id, err = peekId(f.Id, f.AtId, ""); err != nil {
+		return nil, err
+	}
+	t := f.Type
+	if len(t) == 0 {
+		t = f.AtType
+	}
+	if len(t) > 0 && t[0] == '[' {
+		err = json.Unmarshal(t, &p.Types)
+	} else if len(t) > 0 && t[0] == '"' {
+		var name string
+		err = json.Unmarshal(t, &name)
+		p.Types = []string{name}
+	} else if len(t) > 0 && string(t) != "null" {
+		err = fmt.Errorf("%s", t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Peek: 'type' is not a string nor an array of them: %s", err)
+	}
+	if p.Actors, err = peekIds(f.Actor); err != nil {
+		return nil, fmt.Errorf("Peek: 'actor': %s", err)
+	}
+	if p.ObjectIds, err = peekIds(f.Object); err != nil {
+		return nil, fmt.Errorf("Peek: 'object': %s", err)
+	}
+	return p, nil
+}
+
+// peekIds returns the ids of the IRIs or inlined values of a property, which
+// may be a single value or an array of them.
+func peekIds(raw json.RawMessage) (ids []*url.URL, err error) {
+	var elems []json.RawMessage
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	} else if raw[0] == '[' {
+		if err = json.Unmarshal(raw, &elems); err != nil {
+			return nil, err
+		}
+	} else {
+		elems = []json.RawMessage{raw}
+	}
+	for _, e := range elems {
+		var id *url.URL
+		if len(e) > 0 && e[0] == '"' {
+			var s string
+			if err = json.Unmarshal(e, &s); err != nil {
+				return nil, err
+			} else if id, err = peekId(s, "", ""); err != nil {
+				return nil, err
+			}
+		} else if len(e) > 0 && e[0] == '{' {
+			var v peekValue
+			if err = json.Unmarshal(e, &v); err != nil {
+				return nil, err
+			} else if id, err = peekId(v.Id, v.AtId, v.Href); err != nil {
+				return nil, err
+			}
+		}
+		if id != nil {
+			ids = append(ids, id)
+		}
+	}
+	return
+}
+
+// peekId parses the first of the ids that is not empty, or returns nil if they
+// all are.
+func peekId(ids ...string) (*url.URL, error) {
+	for _, id := range ids {
+		if len(id) > 0 {
+			return url.Parse(id)
+		}
+	}
+	return nil, nil
+}`
+
+// generatePeekFile generates Peek, extracting the properties used to route
+// activities from their raw payloads.
+func generatePeekFile(o Options) (*File, error) {
+	p := &defs.PackageDef{
+		Name:          o.packageName(),
+		ImportAliases: o.importAliases(),
+		Imports:       []string{"encoding/json", "fmt", "net/url"},
+		Raw:           peekCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    peekFileName,
+		Content: c,
+	}, nil
+}
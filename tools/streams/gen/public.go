@@ -0,0 +1,142 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const publicFileName = "gen_public.go"
+
+// publicCode determines and changes whether values are addressed to the
+// Public collection.
+const publicCode = `// PublicIRI is vocab.PublicIRI, so that code addressing values with this
+// package need not import vocab.
+const PublicIRI = vocab.PublicIRI
+
+// addressed is implemented by the types with the 'to', 'bto', 'cc', and 'bcc'
+// properties, which are all those extending Object.
+type addressed interface {
+	ToLen() int
+	IsToIRI(index int) bool
+	GetToIRI(index int) *url.URL
+	AppendToIRI(v *url.URL)
+	RemoveToIRI(index int)
+	BtoLen() int
+	IsBtoIRI(index int) bool
+	GetBtoIRI(index int) *url.URL
+	RemoveBtoIRI(index int)
+	CcLen() int
+	IsCcIRI(index int) bool
+	GetCcIRI(index int) *url.URL
+	RemoveCcIRI(index int)
+	BccLen() int
+	IsBccIRI(index int) bool
+	GetBccIRI(index int) *url.URL
+	RemoveBccIRI(index int)
+}
+
+// IsPublicIRI is vocab.IsPublicIRI for a parsed IRI, and is false for nil.
+func IsPublicIRI(u *url.URL) bool {
+	return u != nil && vocab.IsPublicIRI(u.String())
+}
+
+// IsPublic returns true if the 'to', 'bto', 'cc', or 'bcc' of the value address
+// the Public collection. Unlike the IsPublic methods of the types, it also
+// recognizes the IRI compacted as "as:Public" or "Public", as other servers
+// may send it. Values without addressing, such as links, are never public.
+func IsPublic(t vocab.Type) bool {
+	a, ok := t.(addressed)
+	if !ok {
+		return false
+	}
+	for i := 0; i < a.ToLen(); i++ {
+		if a.IsToIRI(i) && IsPublicIRI(a.GetToIRI(i)) {
+			return true
+		}
+	}
+	for i := 0; i < a.BtoLen(); i++ {
+		if a.IsBtoIRI(i) && IsPublicIRI(a.GetBtoIRI(i)) {
+			return true
+		}
+	}
+	for i := 0; i < a.CcLen(); i++ {
+		if a.IsCcIRI(i) && IsPublicIRI(a.GetCcIRI(i)) {
+			return true
+		}
+	}
+	for i := 0; i < a.BccLen(); i++ {
+		if a.IsBccIRI(i) && IsPublicIRI(a.GetBccIRI(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddPublic addresses the value to the Public collection by adding PublicIRI
+// to its 'to', unless it is already public. It returns an error if the value
+// has no addressing.
+func AddPublic(t vocab.Type) error {
+	a, ok := t.(addressed)
+	if !ok {
+		return fmt.Errorf("AddPublic: %T has no addressing", t)
+	}
+	if IsPublic(t) {
+		return nil
+	}
+	u, err := url.Parse(PublicIRI)
+	if err != nil {
+		return err
+	}
+	a.AppendToIRI(u)
+	return nil
+}
+
+// RemovePublic removes the Public collection, in any of its spellings, from the
+// 'to', 'bto', 'cc', and 'bcc' of the value, so that it is no longer public.
+// It returns an error if the value has no addressing.
+func RemovePublic(t vocab.Type) error {
+	a, ok := t.(addressed)
+	if !ok {
+		return fmt.Errorf("RemovePublic: %T has no addressing", t)
+	}
+	for i := a.ToLen() - 1; i >= 0; i-- {
+		if a.IsToIRI(i) && IsPublicIRI(a.GetToIRI(i)) {
+			a.RemoveToIRI(i)
+		}
+	}
+	for i := a.BtoLen() - 1; i >= 0; i-- {
+		if a.IsBtoIRI(i) && IsPublicIRI(a.GetBtoIRI(i)) {
+			a.RemoveBtoIRI(i)
+		}
+	}
+	for i := a.CcLen() - 1; i >= 0; i-- {
+		if a.IsCcIRI(i) && IsPublicIRI(a.GetCcIRI(i)) {
+			a.RemoveCcIRI(i)
+		}
+	}
+	for i := a.BccLen() - 1; i >= 0; i-- {
+		if a.IsBccIRI(i) && IsPublicIRI(a.GetBccIRI(i)) {
+			a.RemoveBccIRI(i)
+		}
+	}
+	return nil
+}`
+
+// generatePublicFile generates PublicIRI and the functions determining and
+// changing whether values are public.
+func generatePublicFile(o Options) (*File, error) {
+	p := &defs.PackageDef{
+		Name:          o.packageName(),
+		ImportAliases: o.importAliases(),
+		Imports:       []string{"fmt", "net/url", o.vocabPath()},
+		Raw:           publicCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    publicFileName,
+		Content: c,
+	}, nil
+}
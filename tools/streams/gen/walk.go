@@ -0,0 +1,151 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const walkFileName = "gen_walk.go"
+
+// walkCode traverses every property of values and of the values embedded in
+// them, without knowing their types.
+const walkCode = `// SkipValue is returned by the function called by Walk or Rewrite for an
+// embedded value to skip its properties.
+var SkipValue = errors.New("skip the properties of this value")
+
+// Walk calls the function for every property of the value and of the values
+// embedded in it, with the path of property names to the property, such as
+// ["object", "attachment", "url"]. The properties of a value are walked in the
+// order of their names, and the function is called once for each value of a
+// property that has many, with the same path. The values are of the serialized
+// form of the value as encoding/json decodes it: strings, float64 numbers,
+// booleans, and the maps of embedded values. The function is called for an
+// embedded value before its properties, which it skips by returning SkipValue.
+// Any other error stops the walk and is returned.
+func Walk(t vocab.Type, fn func(path []string, v interface{}) error) error {
+	m, err := t.Serialize()
+	if err != nil {
+		return err
+	}
+	_, err = walkProperties(nil, m, func(path []string, v interface{}) (interface{}, error) {
+		return v, fn(path, v)
+	})
+	return err
+}
+
+// Rewrite returns a copy of the value whose properties, and those of the values
+// embedded in it, are replaced by what the function returns for them, such as
+// to rewrite the URLs of media to those of a cache. The function is called as
+// by Walk, with the properties of an embedded value walked after it is
+// replaced, and the value itself is unchanged. The copy is deserialized from
+// the rewritten form through the Registry, so it returns an error if its type
+// is not in it.
+func Rewrite(t vocab.Type, fn func(path []string, v interface{}) (interface{}, error)) (vocab.Type, error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	if m, err = walkProperties(nil, m, fn); err != nil {
+		return nil, err
+	}
+	s, err := Deserialize(m)
+	if err != nil {
+		return nil, err
+	}
+	raw := unwrap(s)
+	if raw == nil {
+		raw = s
+	}
+	r, ok := raw.(vocab.Type)
+	if !ok {
+		return nil, fmt.Errorf("Rewrite: %T is not a vocab type", raw)
+	}
+	return r, nil
+}
+
+// walkProperties calls the function for every property of the map and walks
+// the maps they are or contain, returning a copy of the map with each property
+// replaced by what the function returns for it. The map itself is unchanged,
+// since it may be shared with the value it is the serialized form of.
+func walkProperties(path []string, m map[string]interface{}, fn func(path []string, v interface{}) (interface{}, error)) (map[string]interface{}, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	r := make(map[string]interface{}, len(m))
+	for _, k := range keys {
+		p := append(path[:len(path):len(path)], k)
+		if a, ok := m[k].([]interface{}); ok {
+			c := make([]interface{}, len(a))
+			for i, e := range a {
+				v, err := walkValue(p, e, fn)
+				if err != nil {
+					return nil, err
+				}
+				c[i] = v
+			}
+			r[k] = c
+			continue
+		}
+		v, err := walkValue(p, m[k], fn)
+		if err != nil {
+			return nil, err
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+// walkValue calls the function for the value and walks the properties of what
+// it returns if it is an embedded value, unless told to skip them.
+func walkValue(path []string, v interface{}, fn func(path []string, v interface{}) (interface{}, error)) (interface{}, error) {
+	v, err := fn(path, jsonNumber(v))
+	v = jsonNumber(v)
+	if err == SkipValue {
+		return v, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		return walkProperties(path, m, fn)
+	}
+	return v, nil
+}
+
+// jsonNumber returns a number as the float64 that encoding/json decodes it to,
+// which Deserialize expects, and any other value as it is.
+func jsonNumber(v interface{}) interface{} {
+	switch x := v.(type) {
+	case int:
+		return float64(x)
+	case int32:
+		return float64(x)
+	case int64:
+		return float64(x)
+	case uint64:
+		return float64(x)
+	case float32:
+		return float64(x)
+	}
+	return v
+}`
+
+// generateWalkFile generates Walk and Rewrite, which traverse every property
+// of values.
+func generateWalkFile(o Options) (*File, error) {
+	p := &defs.PackageDef{
+		Name:          o.packageName(),
+		ImportAliases: o.importAliases(),
+		Imports:       []string{"errors", "fmt", "sort", o.vocabPath()},
+		Raw:           walkCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    walkFileName,
+		Content: c,
+	}, nil
+}
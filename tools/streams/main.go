@@ -1,21 +1,36 @@
 package main
 
 import (
+	"flag"
 	"github.com/go-fed/activity/tools/defs"
 	"github.com/go-fed/activity/tools/streams/gen"
-	"io/ioutil"
+	vocabgen "github.com/go-fed/activity/tools/vocab/gen"
+)
+
+var (
+	pkg       = flag.String("package", gen.DefaultPackageName, "Name of the generated package")
+	vocabPath = flag.String("vocab_path", gen.DefaultVocabPath, "Import path of the package generated by the vocab tool")
+	out       = flag.String("out", ".", "Directory to generate the package in")
 )
 
 func main() {
+	flag.Parse()
 	allTypes := append(defs.AllCoreTypes, defs.AllExtendedTypes...)
-	files, err := gen.GenerateConvenienceTypes(allTypes)
+	fp, err := vocabgen.Fingerprint(allTypes, defs.AllPropertyTypes, defs.AllValueTypes, vocabgen.FlagInputs()...)
 	if err != nil {
 		panic(err)
 	}
-	for _, f := range files {
-		err = ioutil.WriteFile(f.Name, f.Content, 0666)
-		if err != nil {
-			panic(err)
-		}
+	if vocabgen.IsUpToDate(*out, fp) {
+		return
+	}
+	files, err := gen.GenerateConvenienceTypesWithOptions(allTypes, gen.Options{
+		PackageName: *pkg,
+		VocabPath:   *vocabPath,
+	})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = vocabgen.WriteFiles(*out, files, fp); err != nil {
+		panic(err)
 	}
 }
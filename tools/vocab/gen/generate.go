@@ -6,7 +6,6 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/go-fed/activity/tools/defs"
-	"go/format"
 	"strings"
 )
 
@@ -20,14 +19,93 @@ const (
 	resolveObjectName             = "resolveObject"
 	unknownValueDeserializeFnName = "unknownValueDeserialize"
 	unknownValueSerializeFnName   = "unknownValueSerialize"
+	cloneValueFnName              = "cloneValue"
+	canonicalJSONFnName           = "canonicalJSON"
+	canonicalEqualsFnName         = "canonicalEquals"
+	canonicalHashFnName           = "canonicalHash"
+	setSerializedValuesFnName     = "setSerializedValues"
 )
 
+// Options configures the code generated for the types.
+type Options struct {
+	// PooledSerialize generates Serialize methods that take their maps and
+	// slices from a sync.Pool, which ReleaseSerialized returns them to. It
+	// cuts allocations for servers serializing many activities, at the cost
+	// of Serialize copying the unknown values it would otherwise share.
+	PooledSerialize bool
+	// ExamplesDir is the slash-separated directory, relative to the
+	// generated package, of example documents to generate golden tests
+	// for. The golden files are kept in a sibling 'golden' directory. No
+	// tests are generated when it is empty.
+	ExamplesDir string
+	// PackageName is the name of the generated package, or 'vocab' if it
+	// is empty.
+	PackageName string
+	// OutputDir is the directory the package is generated in, which
+	// ExamplesDir is relative to. It is the working directory if empty.
+	OutputDir string
+	// Header is a text/template of a comment, such as a license or a
+	// 'Code generated' marker, that ApplyHeader puts at the top of every
+	// file. It is executed with a HeaderData.
+	Header string
+	// TypeComment is a text/template of the doc comment of every type,
+	// executed with its defs.Type. The notes of the type are its doc
+	// comment if it is empty.
+	TypeComment string
+	// ToolVersion is the version of the generating tool, which the Header
+	// may mention.
+	ToolVersion string
+	// ReflectionFree generates code that encodes and decodes JSON itself
+	// instead of with encoding/json, which relies on reflection, so that
+	// the package can be compiled by TinyGo for WebAssembly and embedded
+	// targets. The tests generated for the package still use encoding/json.
+	ReflectionFree bool
+	// Workers is the number of goroutines generating and formatting the
+	// files at once, or the number of CPUs if it is zero. The files are
+	// the same whatever the number.
+	Workers int
+}
+
+// DefaultPackageName is the name of the generated package when the Options
+// do not name it.
+const DefaultPackageName = "vocab"
+
+// options are the Options of the generation in progress.
+var options Options
+
+// marshalFn returns the function encoding the serialized form of the types.
+func marshalFn() string {
+	if options.ReflectionFree {
+		return encodeJSONFnName
+	}
+	return "json.Marshal"
+}
+
+// packageName returns the name of the package being generated.
+func packageName() string {
+	if len(options.PackageName) > 0 {
+		return options.PackageName
+	}
+	return DefaultPackageName
+}
+
 type File struct {
 	Name    string
 	Content []byte
 }
 
 func GenerateImplementations(types []*defs.Type, properties []*defs.PropertyType, values []*defs.ValueType) (f []*File, err error) {
+	return GenerateImplementationsWithOptions(types, properties, values, Options{})
+}
+
+// GenerateImplementationsWithOptions generates the implementations like
+// GenerateImplementations, configured by the options.
+func GenerateImplementationsWithOptions(types []*defs.Type, properties []*defs.PropertyType, values []*defs.ValueType, o Options) (f []*File, err error) {
+	options = o
+	err = parseTypeComment(types)
+	if err != nil {
+		return
+	}
 	// Validate inputs
 	err = validateDomains(properties)
 	if err != nil {
@@ -43,6 +121,7 @@ func GenerateImplementations(types []*defs.Type, properties []*defs.PropertyType
 	}
 
 	p := generatePackageDefinition()
+	p.Raw = validationCode + "\n\n" + accessorCode + "\n\n" + deserializeErrorCode + "\n\n" + deserializeErrorAtCode + "\n\n" + acceptStringScalarsCode + "\n\n" + stringScalarCode("")
 	p.Defs = append(p.Defs, generateUnknownType())
 
 	// Add ValueType serialize & deserialize functions
@@ -51,6 +130,7 @@ func GenerateImplementations(types []*defs.Type, properties []*defs.PropertyType
 	}
 	p.F = append(p.F, defs.IRIFuncs()...)
 	p.F = append(p.F, generateHasTypeFuncs(types)...)
+	p.F = append(p.F, generateIsActivityTypeFunction(types))
 	p.I = append(p.I, generateTyperInterface())
 
 	// Add functions to resolve string 'name' into concrete types
@@ -58,22 +138,17 @@ func GenerateImplementations(types []*defs.Type, properties []*defs.PropertyType
 	p.F = append(p.F, generateResolveLinkFunction(types))
 	unknown := generateUnknownValueType()
 	p.F = append(p.F, unknown.DeserializeFn, unknown.SerializeFn)
+	p.F = append(p.F, generateCloneValueFunction())
+	p.F = append(p.F, generateSetSerializedValuesFunction())
+	p.F = append(p.F, generateCanonicalFunctions()...)
 
-	var b []byte
-	b, err = format.Source([]byte(p.Generate()))
-	if err != nil {
-		return
-	}
-	f = append(f, &File{
-		Name:    "gen_vocab.go",
-		Content: b,
-	})
+	jobs := []fileJob{packageJob("gen_vocab.go", p)}
 
 	// ActivityStream Types
 	m := make(map[*defs.PropertyType]*intermedDef)
 	for _, t := range types {
 		p := &defs.PackageDef{
-			Name: "vocab",
+			Name: packageName(),
 		}
 		funcs, defs, interfaces, imports := generateDefinitions(t, m)
 		for i, _ := range imports {
@@ -82,36 +157,73 @@ func GenerateImplementations(types []*defs.Type, properties []*defs.PropertyType
 		p.F = append(p.F, funcs...)
 		p.Defs = append(p.Defs, defs...)
 		p.I = append(p.I, interfaces...)
-
-		var b []byte
-		b, err = format.Source([]byte(p.Generate()))
-		if err != nil {
-			return
-		}
-		f = append(f, &File{
-			Name:    fmt.Sprintf("gen_%s.go", strings.ToLower(t.Name)),
-			Content: b,
-		})
+		jobs = append(jobs, packageJob(fmt.Sprintf("gen_%s.go", strings.ToLower(t.Name)), p))
 	}
 
 	// Intermediate definitions
-	p = &defs.PackageDef{
-		Name:    "vocab",
-		Imports: []string{"fmt", "net/url", "time"},
+	jobs = append(jobs, packageJob(intermediateFileName, intermediatePackage(m, []string{"fmt", "net/url", "time"})))
+
+	// Random value generator
+	jobs = append(jobs, func() (*File, error) { return generateRandomFile(types) })
+
+	// Place geolocation helpers
+	if hasTypeNamed(types, placeName) {
+		jobs = append(jobs, generateGeolocationFile)
+	}
+
+	jobs = append(jobs,
+		// Matching language tags against natural language maps
+		generateLanguageFile,
+		// Builders for common activities
+		func() (*File, error) { return generateBuildersFile(types) },
+		// Enumeration of the types
+		func() (*File, error) { return generateKindFile(types) },
+		// Encoding values as text
+		func() (*File, error) { return generateTextFile(values) },
+		// Sorting slices of the types
+		func() (*File, error) { return generateSortFile(types) },
+		// Deserializing many values at once
+		func() (*File, error) { return generateBatchFile(types) },
+		// Encoding values as CBOR
+		generateCBORFile,
+		// Encoding values as canonical JSON
+		generateJCSFile,
+		// Keeping the order of the properties of unmarshalled JSON
+		generateOrderFile,
+		// Parsing xsd:dateTime values strictly or leniently
+		func() (*File, error) { return generateDateTimeFile(properties) },
+		// Converting xsd:duration values to and from time.Duration
+		generateDurationFile,
+		// Computing the '@context' of serialized values
+		func() (*File, error) { return generateContextFile(types, properties) },
+		// Validating the schemes and structure of IRIs
+		func() (*File, error) { return generateIRIFile(properties) },
+		// Serializing values with options for their '@context'
+		func() (*File, error) { return generateSerializeFile(properties) },
+		// Fuzz targets for every type
+		func() (*File, error) { return generateFuzzFile(types) },
+		// Benchmarks for every type
+		func() (*File, error) { return generateBenchFile(types) },
+	)
+
+	// Golden tests of the examples
+	if len(options.ExamplesDir) > 0 {
+		jobs = append(jobs, func() (*File, error) { return generateGoldenFile(types, options.ExamplesDir) })
 	}
-	for _, v := range m {
-		p.F = append(p.F, v.F...)
-		p.Defs = append(p.Defs, v.S)
+
+	// Encoding JSON without reflection
+	if options.ReflectionFree {
+		jobs = append(jobs, generateJSONFile)
+	} else {
+		// Decoding the properties of JSON objects one at a time
+		jobs = append(jobs, generateDecodeFile)
 	}
-	b, err = format.Source([]byte(p.Generate()))
-	if err != nil {
-		return
+
+	// Pools for serializing
+	if options.PooledSerialize {
+		jobs = append(jobs, generatePoolFile)
 	}
-	f = append(f, &File{
-		Name:    fmt.Sprintf("gen_intermediate.go"),
-		Content: b,
-	})
-	return
+	return runFileJobs(jobs)
 }
 
 func generateTyperInterface() *defs.InterfaceDef {
@@ -133,12 +245,8 @@ func generateTyperInterface() *defs.InterfaceDef {
 }
 
 func generateHasTypeFuncs(types []*defs.Type) (f []*defs.FunctionDef) {
-	var activityTypes []string
 	for _, t := range types {
 		t := t
-		if defs.IsActivity(t) {
-			activityTypes = append(activityTypes, t.Name)
-		}
 		f = append(f, &defs.FunctionDef{
 			Name:    fmt.Sprintf("HasType%s", t.Name),
 			Comment: fmt.Sprintf("HasType%s returns true if the Typer has a type of %s.", t.Name, t.Name),
@@ -159,7 +267,17 @@ func generateHasTypeFuncs(types []*defs.Type) (f []*defs.FunctionDef) {
 			},
 		})
 	}
-	f = append(f, &defs.FunctionDef{
+	return
+}
+
+func generateIsActivityTypeFunction(types []*defs.Type) *defs.FunctionDef {
+	var activityTypes []string
+	for _, t := range types {
+		if defs.IsActivity(t) {
+			activityTypes = append(activityTypes, t.Name)
+		}
+	}
+	return &defs.FunctionDef{
 		Name:    "IsActivityType",
 		Comment: "Returns true if the provided Typer is an Activity.",
 		Args:    []*defs.FunctionVarDef{{Name: "t", Type: "Typer"}},
@@ -182,15 +300,18 @@ func generateHasTypeFuncs(types []*defs.Type) (f []*defs.FunctionDef) {
 			b.WriteString("return false\n")
 			return b.String()
 		},
-	})
-	return
+	}
 }
 
 func generatePackageDefinition() *defs.PackageDef {
+	imports := []string{"fmt", "time", "net/url", "bytes", "crypto/sha256", "strings", "strconv", "math"}
+	if !options.ReflectionFree {
+		imports = append(imports, "encoding/json")
+	}
 	return &defs.PackageDef{
-		Name:    "vocab",
-		Comment: "Package vocab provides an implementation of serializing and deserializing activity streams into native golang structs without relying on reflection. This package is code-generated from the vocabulary specification available at https://www.w3.org/TR/activitystreams-vocabulary and by design forgoes full resolution of raw JSON-LD data. However, custom extensions of the vocabulary are supported by modifying the data definitions in the generation tool and rerunning it. Do not modify this package directly.",
-		Imports: []string{"fmt", "time", "net/url", "regexp", "strconv", "math"},
+		Name:    packageName(),
+		Comment: "Package " + packageName() + " provides an implementation of serializing and deserializing activity streams into native golang structs without relying on reflection. This package is code-generated from the vocabulary specification available at https://www.w3.org/TR/activitystreams-vocabulary and by design forgoes full resolution of raw JSON-LD data. However, custom extensions of the vocabulary are supported by modifying the data definitions in the generation tool and rerunning it. Do not modify this package directly.",
+		Imports: imports,
 		I: []*defs.InterfaceDef{
 			{
 				Typename: "Serializer",
@@ -237,7 +358,7 @@ func generateUnknownValueType() *defs.ValueType {
 			Return:  []*defs.FunctionVarDef{{"o", "interface{}"}},
 			Body: func() string {
 				var b bytes.Buffer
-				b.WriteString("o = v\n")
+				b.WriteString(fmt.Sprintf("o = %s\n", ownedSerializeValue("v")))
 				b.WriteString("return\n")
 				return b.String()
 			},
@@ -245,6 +366,113 @@ func generateUnknownValueType() *defs.ValueType {
 	}
 }
 
+// generateSetSerializedValuesFunction generates the function the Serialize
+// functions of every type share to set the serialized values of a property that
+// is not functional.
+func generateSetSerializedValuesFunction() *defs.FunctionDef {
+	return &defs.FunctionDef{
+		Name:    setSerializedValuesFnName,
+		Comment: "setSerializedValues sets the serialized values of a property that is not functional, as the only value if there is one and as an array otherwise.",
+		Args:    []*defs.FunctionVarDef{{"m", "map[string]interface{}"}, {"k", "string"}, {"v", "[]interface{}"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("if len(v) == 1 {\n")
+			b.WriteString("m[k] = v[0]\n")
+			if options.PooledSerialize {
+				b.WriteString(fmt.Sprintf("%s(v)\n", putSerializeSliceFnName))
+			}
+			b.WriteString("} else {\n")
+			b.WriteString("m[k] = v\n")
+			b.WriteString("}\n")
+			return b.String()
+		},
+	}
+}
+
+func generateCloneValueFunction() *defs.FunctionDef {
+	return &defs.FunctionDef{
+		Name:    cloneValueFnName,
+		Comment: "cloneValue deeply copies the generic maps and slices of a value in its map[string]interface{} form.",
+		Args:    []*defs.FunctionVarDef{{"v", "interface{}"}},
+		Return:  []*defs.FunctionVarDef{{"o", "interface{}"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("switch x := v.(type) {\n")
+			b.WriteString("case map[string]interface{}:\n")
+			b.WriteString("m := make(map[string]interface{}, len(x))\n")
+			b.WriteString("for k, e := range x {\n")
+			b.WriteString(fmt.Sprintf("m[k] = %s(e)\n", cloneValueFnName))
+			b.WriteString("}\n")
+			b.WriteString("o = m\n")
+			b.WriteString("case []interface{}:\n")
+			b.WriteString("s := make([]interface{}, len(x))\n")
+			b.WriteString("for i, e := range x {\n")
+			b.WriteString(fmt.Sprintf("s[i] = %s(e)\n", cloneValueFnName))
+			b.WriteString("}\n")
+			b.WriteString("o = s\n")
+			b.WriteString("default:\n")
+			b.WriteString("o = v\n")
+			b.WriteString("}\n")
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+}
+
+func generateCanonicalFunctions() []*defs.FunctionDef {
+	return []*defs.FunctionDef{
+		{
+			Name:    canonicalJSONFnName,
+			Comment: "canonicalJSON encodes the serialized form of a value as JSON with sorted keys, so that equal values always have the same encoding.",
+			Args:    []*defs.FunctionVarDef{{"s", "Serializer"}},
+			Return:  []*defs.FunctionVarDef{{"b", "[]byte"}, {"err", "error"}},
+			Body: func() string {
+				var b bytes.Buffer
+				b.WriteString("m, err := s.Serialize()\n")
+				b.WriteString("if err != nil {\n")
+				b.WriteString("return\n")
+				b.WriteString("}\n")
+				b.WriteString(fmt.Sprintf("return %s(m)\n", marshalFn()))
+				return b.String()
+			},
+		},
+		{
+			Name:    canonicalEqualsFnName,
+			Comment: "canonicalEquals determines whether two values have the same canonical encoding. Values that cannot be serialized are never equal.",
+			Args:    []*defs.FunctionVarDef{{"a", "Serializer"}, {"o", "Serializer"}},
+			Return:  []*defs.FunctionVarDef{{"eq", "bool"}},
+			Body: func() string {
+				var b bytes.Buffer
+				b.WriteString(fmt.Sprintf("ab, err := %s(a)\n", canonicalJSONFnName))
+				b.WriteString("if err != nil {\n")
+				b.WriteString("return false\n")
+				b.WriteString("}\n")
+				b.WriteString(fmt.Sprintf("ob, err := %s(o)\n", canonicalJSONFnName))
+				b.WriteString("if err != nil {\n")
+				b.WriteString("return false\n")
+				b.WriteString("}\n")
+				b.WriteString("return bytes.Equal(ab, ob)\n")
+				return b.String()
+			},
+		},
+		{
+			Name:    canonicalHashFnName,
+			Comment: "canonicalHash is the SHA-256 hash of the canonical encoding of a value, or the zero hash if it cannot be serialized.",
+			Args:    []*defs.FunctionVarDef{{"s", "Serializer"}},
+			Return:  []*defs.FunctionVarDef{{"h", "[32]byte"}},
+			Body: func() string {
+				var b bytes.Buffer
+				b.WriteString(fmt.Sprintf("c, err := %s(s)\n", canonicalJSONFnName))
+				b.WriteString("if err != nil {\n")
+				b.WriteString("return\n")
+				b.WriteString("}\n")
+				b.WriteString("return sha256.Sum256(c)\n")
+				return b.String()
+			},
+		},
+	}
+}
+
 func generateUnknownType() *defs.StructDef {
 	u := &defs.StructDef{
 		Typename: "Unknown",
@@ -263,7 +491,11 @@ func generateUnknownType() *defs.StructDef {
 			Return:  []*defs.FunctionVarDef{{"m", "map[string]interface{}"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
-				b.WriteString("m = t.u\n")
+				if options.PooledSerialize {
+					b.WriteString(fmt.Sprintf("m = %s(t.u).(map[string]interface{})\n", cloneValueFnName))
+				} else {
+					b.WriteString("m = t.u\n")
+				}
 				b.WriteString("return\n")
 				return b.String()
 			},
@@ -324,7 +556,7 @@ func generateUnknownType() *defs.StructDef {
 func generateResolveObjectFunction(types []*defs.Type) *defs.FunctionDef {
 	return &defs.FunctionDef{
 		Name:    resolveObjectName,
-		Comment: fmt.Sprintf("%s turns a string type that extends Object into a concrete type.", resolveObjectName),
+		Comment: fmt.Sprintf("%s turns a string type that extends Object into a concrete type, including those of the ExtensionTypes.", resolveObjectName),
 		Args:    []*defs.FunctionVarDef{{"s", "string"}},
 		Return:  []*defs.FunctionVarDef{{"i", "interface{}"}},
 		Body: func() string {
@@ -336,6 +568,9 @@ func generateResolveObjectFunction(types []*defs.Type) *defs.FunctionDef {
 					b.WriteString("}\n")
 				}
 			}
+			b.WriteString(fmt.Sprintf("if fn, ok := %sExtensionTypes[s]; ok {\n", corePrefix()))
+			b.WriteString("return fn()\n")
+			b.WriteString("}\n")
 			b.WriteString("return nil\n")
 			return b.String()
 		},
@@ -345,7 +580,7 @@ func generateResolveObjectFunction(types []*defs.Type) *defs.FunctionDef {
 func generateResolveLinkFunction(types []*defs.Type) *defs.FunctionDef {
 	return &defs.FunctionDef{
 		Name:    resolveLinkName,
-		Comment: fmt.Sprintf("%s turns a string type that extends Link into a concrete type.", resolveLinkName),
+		Comment: fmt.Sprintf("%s turns a string type that extends Link into a concrete type, including those of the ExtensionTypes.", resolveLinkName),
 		Args:    []*defs.FunctionVarDef{{"s", "string"}},
 		Return:  []*defs.FunctionVarDef{{"i", "interface{}"}},
 		Body: func() string {
@@ -357,6 +592,9 @@ func generateResolveLinkFunction(types []*defs.Type) *defs.FunctionDef {
 					b.WriteString("}\n")
 				}
 			}
+			b.WriteString(fmt.Sprintf("if fn, ok := %sExtensionTypes[s]; ok {\n", corePrefix()))
+			b.WriteString("return fn()\n")
+			b.WriteString("}\n")
 			b.WriteString("return nil\n")
 			return b.String()
 		},
@@ -0,0 +1,223 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"sort"
+	"strings"
+)
+
+const (
+	attachedFileName = "gen_attached.go"
+	// extensibleName is the interface of the values whose unknown
+	// properties the attached properties of an extension are kept in.
+	extensibleName = "Extensible"
+)
+
+// attachedProperties splits the properties of an extension into those of core
+// types, which are attached to the core types as unknown properties, and
+// those of the extension types only.
+func attachedProperties(types []*defs.Type, properties []*defs.PropertyType) (attached, own []*defs.PropertyType, err error) {
+	ext := make(map[*defs.Type]bool, len(types))
+	for _, t := range types {
+		ext[t] = true
+	}
+	for _, p := range properties {
+		isAttached := false
+		for _, d := range p.Domain {
+			if d.T != nil && !ext[d.T] {
+				isAttached = true
+				break
+			}
+		}
+		if !isAttached {
+			own = append(own, p)
+		} else if !p.Functional || p.NaturalLanguageMap || isAny(p) {
+			err = fmt.Errorf("Property %s of core types must be functional, not a natural language map, and not of any type", p.Name)
+			return
+		} else {
+			attached = append(attached, p)
+		}
+	}
+	return
+}
+
+// generateAttachedPackage generates the functions reading and writing the
+// attached properties of core values, with the intermediate types of the
+// properties, which it adds to the map.
+func generateAttachedPackage(attached []*defs.PropertyType, m map[*defs.PropertyType]*intermedDef) *defs.PackageDef {
+	p := &defs.PackageDef{
+		Name: packageName(),
+		I: []*defs.InterfaceDef{{
+			Typename: extensibleName,
+			Comment:  fmt.Sprintf("%s is implemented by every type, including those of the %s package, which keep the properties of this package they do not know of as unknown properties.", extensibleName, CorePackageName),
+			F: []*defs.FunctionDef{
+				{
+					Name:   "HasUnknown",
+					Args:   []*defs.FunctionVarDef{{"k", "string"}},
+					Return: []*defs.FunctionVarDef{{"b", "bool"}},
+				},
+				{
+					Name:   "GetUnknown",
+					Args:   []*defs.FunctionVarDef{{"k", "string"}},
+					Return: []*defs.FunctionVarDef{{"i", "interface{}"}},
+				},
+				{
+					Name: "SetUnknownProperty",
+					Args: []*defs.FunctionVarDef{{"k", "string"}, {"i", "interface{}"}},
+				},
+			},
+		}},
+	}
+	imports := make(map[string]bool)
+	for _, a := range attached {
+		intermed := attachedIntermediate(a, m)
+		p.F = append(p.F, generateAttachedFunctions(a, intermed)...)
+		for _, r := range a.Range {
+			if r.V != nil {
+				for _, i := range r.V.Imports {
+					imports[i] = true
+				}
+			}
+		}
+	}
+	for i := range imports {
+		p.Imports = append(p.Imports, i)
+	}
+	sort.Strings(p.Imports)
+	return p
+}
+
+// attachedIntermediate returns the intermediate type of the attached property,
+// sharing that of an extension type with the property.
+func attachedIntermediate(a *defs.PropertyType, m map[*defs.PropertyType]*intermedDef) *defs.StructDef {
+	if i, ok := m[a]; ok {
+		return i.S
+	}
+	for k := range m {
+		if k.Name == a.Name {
+			m[k] = generateIntermediateTypeDefinition([]*defs.PropertyType{k, a})
+			return m[k].S
+		}
+	}
+	m[a] = generateIntermediateTypeDefinition([]*defs.PropertyType{a})
+	return m[a].S
+}
+
+// generateAttachedFunctions generates the functions determining whether the
+// attached property of a value is of each of its types, and getting and
+// setting it, named like the methods of the types with the property.
+func generateAttachedFunctions(a *defs.PropertyType, intermed *defs.StructDef) (fd []*defs.FunctionDef) {
+	titleName := strings.Title(a.Name)
+	attachedFn := fmt.Sprintf("attached%s", titleName)
+	// The type of a value of a property taking a single type, such as the
+	// 'publicKey' of an actor, is often left out.
+	var only *defs.Type
+	for _, r := range a.Range {
+		if r.T != nil && only == nil {
+			only = r.T
+		} else if r.T != nil {
+			only = nil
+			break
+		}
+	}
+	fd = append(fd, &defs.FunctionDef{
+		Name:    attachedFn,
+		Comment: fmt.Sprintf("%s deserializes the '%s' of the value, or returns nil if it has none or it cannot be deserialized", attachedFn, a.Name) + onlyComment(only),
+		Args:    []*defs.FunctionVarDef{{"t", extensibleName}},
+		Return:  []*defs.FunctionVarDef{{"i", "*" + intermed.Typename}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("if !t.HasUnknown(\"%s\") {\n", a.Name))
+			b.WriteString("return nil\n")
+			b.WriteString("}\n")
+			b.WriteString(fmt.Sprintf("v := t.GetUnknown(\"%s\")\n", a.Name))
+			if only != nil {
+				b.WriteString("if m, ok := v.(map[string]interface{}); ok && m[\"type\"] == nil {\n")
+				b.WriteString(fmt.Sprintf("tmp := &%s{}\n", only.Name))
+				b.WriteString("if err := tmp.Deserialize(m); err != nil {\n")
+				b.WriteString("return nil\n")
+				b.WriteString("}\n")
+				b.WriteString(fmt.Sprintf("return &%s{%s: tmp}\n", intermed.Typename, cleanName(only.Name)))
+				b.WriteString("}\n")
+			}
+			b.WriteString(fmt.Sprintf("i, err := deserialize%s(v)\n", strings.Title(intermed.Typename)))
+			b.WriteString("if err != nil {\n")
+			b.WriteString("return nil\n")
+			b.WriteString("}\n")
+			b.WriteString("return i\n")
+			return b.String()
+		},
+	})
+	for idx, r := range a.Range {
+		memberName := cleanName(Name(r))
+		memberType := Type(r)
+		isIRI := isIRIType(memberType)
+		isPtr := isPtrType(memberType) && !isIRI
+		retKind := deref(memberType)
+		if isIRI {
+			retKind = memberType
+		}
+		typeExtensionName := strings.Title(Name(r))
+		if len(a.Range) == 1 || defs.IsOnlyOtherPropertyBesidesIRI(idx, a.Range) {
+			typeExtensionName = ""
+		}
+		name := titleName + typeExtensionName
+		fd = append(fd, &defs.FunctionDef{
+			Name:    fmt.Sprintf("Is%s", name),
+			Comment: fmt.Sprintf("Is%s determines whether the call to Get%s is safe, which is whether the '%s' of the value is of %s type", name, name, a.Name, retKind),
+			Args:    []*defs.FunctionVarDef{{"t", extensibleName}},
+			Return:  []*defs.FunctionVarDef{{"ok", "bool"}},
+			Body: func() string {
+				var b bytes.Buffer
+				b.WriteString(fmt.Sprintf("i := %s(t)\n", attachedFn))
+				b.WriteString(fmt.Sprintf("return i != nil && i.%s != nil\n", memberName))
+				return b.String()
+			},
+		}, &defs.FunctionDef{
+			Name:    fmt.Sprintf("Get%s", name),
+			Comment: fmt.Sprintf("Get%s returns the '%s' of the value safely if Is%s returned true", name, a.Name, name),
+			Args:    []*defs.FunctionVarDef{{"t", extensibleName}},
+			Return:  []*defs.FunctionVarDef{{"v", retKind}},
+			Body: func() string {
+				var b bytes.Buffer
+				b.WriteString("return ")
+				if isPtr {
+					b.WriteString("*")
+				}
+				b.WriteString(fmt.Sprintf("%s(t).%s\n", attachedFn, memberName))
+				return b.String()
+			},
+		}, &defs.FunctionDef{
+			Name:    fmt.Sprintf("Set%s", name),
+			Comment: fmt.Sprintf("Set%s sets the '%s' of the value to be of %s type, as an unknown property of the value", name, a.Name, retKind),
+			Args:    []*defs.FunctionVarDef{{"t", extensibleName}, {"v", retKind}},
+			Return:  []*defs.FunctionVarDef{{"err", "error"}},
+			Body: func() string {
+				var b bytes.Buffer
+				b.WriteString(fmt.Sprintf("i, err := serialize%s(&%s{%s: ", strings.Title(intermed.Typename), intermed.Typename, memberName))
+				if isPtr {
+					b.WriteString("&")
+				}
+				b.WriteString("v})\n")
+				b.WriteString("if err != nil {\n")
+				b.WriteString("return\n")
+				b.WriteString("}\n")
+				b.WriteString(fmt.Sprintf("t.SetUnknownProperty(\"%s\", i)\n", a.Name))
+				b.WriteString("return\n")
+				return b.String()
+			},
+		})
+	}
+	return
+}
+
+// onlyComment documents that an attached property taking a single type is
+// deserialized as that type when its type is left out.
+func onlyComment(only *defs.Type) string {
+	if only == nil {
+		return ""
+	}
+	return fmt.Sprintf(". A value without a type is deserialized as a %s, the only type the property takes", only.Name)
+}
@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const (
+	batchFileName           = "gen_batch.go"
+	deserializeManyFnPrefix = "DeserializeMany"
+)
+
+// deserializeManyCode is the batch deserialization function of a single type.
+// It is formatted with the name of the type and the name of the function.
+const deserializeManyCode = `// %[2]s deserializes each of the maps as a %[1]s. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func %[2]s(ms []map[string]interface{}) (t []*%[1]s, err error) {
+	backing := make([]%[1]s, len(ms))
+	t = make([]*%[1]s, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}`
+
+// deserializeManyFn is the name of the batch deserialization function of the
+// type.
+func deserializeManyFn(t *defs.Type) string {
+	return deserializeManyFnPrefix + t.Name
+}
+
+// generateBatchFile generates the functions deserializing many values of each
+// type at once.
+func generateBatchFile(types []*defs.Type) (*File, error) {
+	var b bytes.Buffer
+	for i, t := range types {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(fmt.Sprintf(deserializeManyCode, t.Name, deserializeManyFn(t)))
+	}
+	p := &defs.PackageDef{
+		Name: packageName(),
+		Raw:  b.String(),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    batchFileName,
+		Content: c,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const (
+	benchFileName = "gen_bench_test.go"
+	// benchSeed seeds the random documents the benchmarks use, so that every
+	// run measures the same documents.
+	benchSeed = 1
+)
+
+// benchHelperCode is shared by the benchmarks of all the types. It is
+// formatted with the seed.
+const benchHelperCode = `// benchmarkDocument returns the JSON document of the random value of the named
+// type that the benchmarks of the type measure, decoded as it is before being
+// deserialized. It is the same in every run, so that the results of runs can be
+// compared.
+func benchmarkDocument(b *testing.B, typeName string) map[string]interface{} {
+	v, err := NewRandom(typeName, %d)
+	if err != nil {
+		b.Fatal(err)
+	}
+	m, err := v.Serialize()
+	if err != nil {
+		b.Fatal(err)
+	}
+	j, err := json.Marshal(m)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err = json.Unmarshal(j, &doc); err != nil {
+		b.Fatal(err)
+	}
+	return doc
+}`
+
+// benchCode is the benchmarks of a single type. It is formatted with the name
+// of the type and the code releasing a serialized value.
+const benchCode = `// BenchmarkSerialize%[1]s measures serializing a representative %[1]s.
+func BenchmarkSerialize%[1]s(b *testing.B) {
+	v := &%[1]s{}
+	if err := v.Deserialize(benchmarkDocument(b, %[1]q)); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		%[2]s
+	}
+}
+
+// BenchmarkDeserialize%[1]s measures deserializing a representative %[1]s.
+func BenchmarkDeserialize%[1]s(b *testing.B) {
+	m := benchmarkDocument(b, %[1]q)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &%[1]s{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}`
+
+// generateBenchFile generates benchmarks of serializing and deserializing every
+// type, seeded with a random value of it, so that changes to the generated
+// code that slow it down can be caught with 'go test -bench'.
+func generateBenchFile(types []*defs.Type) (*File, error) {
+	release := "_ = m"
+	if options.PooledSerialize {
+		release = fmt.Sprintf("%s(m)", releaseSerializedFnName)
+	}
+	var b bytes.Buffer
+	b.WriteString(fmt.Sprintf(benchHelperCode, benchSeed))
+	for _, t := range types {
+		b.WriteString("\n\n")
+		b.WriteString(fmt.Sprintf(benchCode, t.Name, release))
+	}
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"encoding/json", "testing"},
+		Raw:     b.String(),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    benchFileName,
+		Content: c,
+	}, nil
+}
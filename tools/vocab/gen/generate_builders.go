@@ -0,0 +1,159 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const builderFileName = "gen_builders.go"
+
+// builderTypes are the common composite activities that have a builder.
+var builderTypes = []string{"Accept", "Announce", "Create", "Follow", "Undo"}
+
+// builderRequiredProperties are the properties a builder requires to be set
+// before it builds its activity.
+var builderRequiredProperties = []string{"actor", "object"}
+
+// builderCode is the builder for a single activity. It is formatted with the
+// name of the activity.
+const builderCode = `// %[1]sBuilder builds a %[1]s activity by chaining calls, validating that its
+// 'actor' and 'object' are set when it is built.
+type %[1]sBuilder struct {
+	a *%[1]s
+}
+
+// New%[1]sBuilder creates a builder for a %[1]s activity.
+func New%[1]sBuilder() *%[1]sBuilder {
+	return &%[1]sBuilder{a: &%[1]s{}}
+}
+
+// Id sets the 'id' of the %[1]s.
+func (b *%[1]sBuilder) Id(iri *url.URL) *%[1]sBuilder {
+	b.a.SetId(iri)
+	return b
+}
+
+// Actor adds the IRI of an actor of the %[1]s.
+func (b *%[1]sBuilder) Actor(iri *url.URL) *%[1]sBuilder {
+	b.a.AppendActorIRI(iri)
+	return b
+}
+
+// ActorObject adds an actor of the %[1]s.
+func (b *%[1]sBuilder) ActorObject(v ObjectType) *%[1]sBuilder {
+	b.a.AppendActorObject(v)
+	return b
+}
+
+// Object adds an object of the %[1]s.
+func (b *%[1]sBuilder) Object(v ObjectType) *%[1]sBuilder {
+	b.a.AppendObject(v)
+	return b
+}
+
+// ObjectIRI adds the IRI of an object of the %[1]s.
+func (b *%[1]sBuilder) ObjectIRI(iri *url.URL) *%[1]sBuilder {
+	b.a.AppendObjectIRI(iri)
+	return b
+}
+
+// To adds a primary recipient of the %[1]s.
+func (b *%[1]sBuilder) To(iri *url.URL) *%[1]sBuilder {
+	b.a.AppendToIRI(iri)
+	return b
+}
+
+// Cc adds a secondary recipient of the %[1]s.
+func (b *%[1]sBuilder) Cc(iri *url.URL) *%[1]sBuilder {
+	b.a.AppendCcIRI(iri)
+	return b
+}
+
+// Bto adds a private primary recipient of the %[1]s.
+func (b *%[1]sBuilder) Bto(iri *url.URL) *%[1]sBuilder {
+	b.a.AppendBtoIRI(iri)
+	return b
+}
+
+// Bcc adds a private secondary recipient of the %[1]s.
+func (b *%[1]sBuilder) Bcc(iri *url.URL) *%[1]sBuilder {
+	b.a.AppendBccIRI(iri)
+	return b
+}
+
+// Published sets when the %[1]s was published.
+func (b *%[1]sBuilder) Published(t time.Time) *%[1]sBuilder {
+	b.a.SetPublished(t)
+	return b
+}
+
+// Summary adds a summary of the %[1]s.
+func (b *%[1]sBuilder) Summary(s string) *%[1]sBuilder {
+	b.a.AppendSummaryString(s)
+	return b
+}
+
+// Build returns the %[1]s, or an error if its 'actor' or 'object' is not set.
+// The builder must not be used afterwards.
+func (b *%[1]sBuilder) Build() (*%[1]s, error) {
+	if b.a.ActorLen() == 0 {
+		return nil, fmt.Errorf("%[1]s requires an actor")
+	} else if b.a.ObjectLen() == 0 {
+		return nil, fmt.Errorf("%[1]s requires an object")
+	}
+	return b.a, nil
+}`
+
+// generateBuildersFile generates the builders for the common composite
+// activities that are among the types, such as when they are not pruned. It
+// returns an error if any of them lacks a required property.
+func generateBuildersFile(types []*defs.Type) (*File, error) {
+	byName := make(map[string]*defs.Type, len(types))
+	for _, t := range types {
+		byName[t.Name] = t
+	}
+	var b bytes.Buffer
+	for _, name := range builderTypes {
+		t, ok := byName[name]
+		if !ok {
+			continue
+		}
+		for _, required := range builderRequiredProperties {
+			if !hasProperty(t, required) {
+				return nil, fmt.Errorf("cannot generate builder for %s without property %s", name, required)
+			}
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(fmt.Sprintf(builderCode, name))
+	}
+	p := &defs.PackageDef{
+		Name: packageName(),
+		Raw:  b.String(),
+	}
+	if b.Len() > 0 {
+		p.Imports = []string{"fmt", "net/url", "time"}
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    builderFileName,
+		Content: c,
+	}, nil
+}
+
+// hasProperty determines whether the type has the named property, including
+// those it inherits.
+func hasProperty(t *defs.Type, name string) bool {
+	for _, p := range t.GetProperties() {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+const (
+	dateTimeFileName         = "gen_datetime.go"
+	dateTimeValueName        = "dateTime"
+	parseDateTimeFnComment   = "// parseDateTime parses an xsd:dateTime value with ParseDateTimeStrict.\n"
+	parseDateTimeFnTemplate  = "func parseDateTime(s string) (time.Time, error) {\n\treturn %sParseDateTimeStrict(s)\n}\n"
+	formatDateTimeFnComment  = "// formatDateTime formats an xsd:dateTime value with FormatDateTime.\n"
+	formatDateTimeFnTemplate = "func formatDateTime(t time.Time) string {\n\treturn %sFormatDateTime(t)\n}\n"
+)
+
+// dateTimeCode parses the xsd:dateTime values of properties, strictly or
+// leniently.
+const dateTimeCode = `// ParseDateTimeStrict parses an xsd:dateTime value as RFC 3339 describes, with
+// or without seconds.
+func ParseDateTimeStrict(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t, err = time.Parse("2006-01-02T15:04Z07:00", s)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s cannot be interpreted as xsd:dateTime", s)
+	}
+	return keepOffset(t), nil
+}
+
+// lenientDateTimeLayouts are the layouts ParseDateTimeLenient tries once the
+// value is normalized.
+var lenientDateTimeLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999Z07",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04Z0700",
+	"2006-01-02T15:04Z07",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.ANSIC,
+}
+
+// ParseDateTimeLenient parses the values ParseDateTimeStrict does, and also
+// those with a space or a lowercase letter between the date and the time, a
+// lowercase 'z', numeric zones without a colon or minutes, a space before the
+// zone, a trailing "UTC" or "GMT", no seconds, no time, or the formats of HTTP
+// dates. Values without a zone are in UTC.
+func ParseDateTimeLenient(s string) (time.Time, error) {
+	if t, err := ParseDateTimeStrict(s); err == nil {
+		return t, nil
+	}
+	n := strings.TrimSpace(s)
+	if len(n) > 10 && n[4] == '-' && n[7] == '-' {
+		if n[10] == ' ' || n[10] == 't' {
+			n = n[:10] + "T" + n[11:]
+		}
+		for _, utc := range []string{"UTC", "GMT"} {
+			n = strings.TrimSpace(strings.TrimSuffix(n, utc))
+		}
+		if strings.HasSuffix(n, "z") {
+			n = n[:len(n)-1] + "Z"
+		}
+		if i := strings.LastIndexAny(n, "+-"); i > 11 && n[i-1] == ' ' {
+			n = n[:i-1] + n[i:]
+		}
+	}
+	for _, layout := range lenientDateTimeLayouts {
+		if t, err := time.Parse(layout, n); err == nil {
+			return keepOffset(t), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s cannot be interpreted as xsd:dateTime, even leniently", s)
+}
+
+// keepOffset returns the parsed time in a zone of the offset it was parsed with,
+// rather than in the Local zone that time.Parse uses when the offsets match, so
+// that FormatDateTime writes it as it was received. Times parsed in UTC, from a
+// 'Z' or from no zone at all, are kept in UTC.
+func keepOffset(t time.Time) time.Time {
+	if t.Location() == time.UTC {
+		return t
+	}
+	_, offset := t.Zone()
+	return t.In(time.FixedZone("", offset))
+}
+
+// FormatDateTime formats a time as an xsd:dateTime value, as RFC 3339 describes,
+// keeping its offset rather than normalizing it to UTC and its fractional
+// seconds, if any. Values parsed by ParseDateTimeStrict or ParseDateTimeLenient
+// are formatted with the offset they were received with: a zero offset is
+// written as "Z" unless it was received as "+00:00", which is kept.
+func FormatDateTime(t time.Time) string {
+	if name, offset := t.Zone(); offset == 0 && len(name) == 0 {
+		return t.Format("2006-01-02T15:04:05.999999999-07:00")
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+`
+
+// lenientDateTimesCode rewrites the xsd:dateTime values of the properties
+// named by dateTimeProperties that only ParseDateTimeLenient parses. It is
+// formatted with the prefix of the core package.
+const lenientDateTimesCode = `// lenientDateTimes copies the value with the xsd:dateTime values of its
+// properties, and of the values it contains, that ParseDateTimeStrict rejects
+// but ParseDateTimeLenient parses rewritten as FormatDateTime formats them.
+func lenientDateTimes(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		r := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			if dateTimeProperties[k] {
+				e = lenientDateTime(e)
+			}
+			r[k] = lenientDateTimes(e)
+		}
+		return r
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = lenientDateTimes(e)
+		}
+		return r
+	}
+	return v
+}
+
+// lenientDateTime rewrites the xsd:dateTime value of a property, or each of
+// its values, if only ParseDateTimeLenient parses it.
+func lenientDateTime(v interface{}) interface{} {
+	switch x := v.(type) {
+	case string:
+		if _, err := %[1]sParseDateTimeStrict(x); err != nil {
+			if t, err := %[1]sParseDateTimeLenient(x); err == nil {
+				return %[1]sFormatDateTime(t)
+			}
+		}
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = lenientDateTime(e)
+		}
+		return r
+	}
+	return v
+}
+`
+
+// generateDateTimeFile generates the strict and lenient parsers of the
+// xsd:dateTime values of the properties, FormatDateTime they are serialized
+// with, and the rewriting of the values DeserializeLenient parses leniently.
+func generateDateTimeFile(properties []*defs.PropertyType) (*File, error) {
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"fmt", "strings", "time"},
+		Raw:     dateTimeCode + dateTimeDelegates("") + "\n" + lenientDateTimes("", properties),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    dateTimeFileName,
+		Content: c,
+	}, nil
+}
+
+// dateTimeDelegates returns the parseDateTime and formatDateTime functions,
+// calling the ParseDateTimeStrict and FormatDateTime of the package prefix.
+func dateTimeDelegates(prefix string) string {
+	return parseDateTimeFnComment + fmt.Sprintf(parseDateTimeFnTemplate, prefix) +
+		"\n" + formatDateTimeFnComment + fmt.Sprintf(formatDateTimeFnTemplate, prefix)
+}
+
+// extensionDateTimeCode is the parseDateTime and formatDateTime functions of an
+// extension, which parse with the ParseDateTimeStrict of the core package and
+// format with its FormatDateTime, and the rewriting of the values of the
+// properties DeserializeLenient parses leniently.
+func extensionDateTimeCode(properties []*defs.PropertyType) string {
+	prefix := CorePackageName + "."
+	return dateTimeDelegates(prefix) + "\n" + lenientDateTimes(prefix, properties)
+}
+
+// lenientDateTimes returns the code rewriting the xsd:dateTime values of the
+// properties, calling the parsers and FormatDateTime of the package prefix.
+func lenientDateTimes(prefix string, properties []*defs.PropertyType) string {
+	names := make(map[string]bool)
+	for _, p := range properties {
+		for _, r := range p.Range {
+			if r.V != nil && r.V.Name == dateTimeValueName {
+				names[p.Name] = true
+			}
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for n := range names {
+		sorted = append(sorted, n)
+	}
+	sort.Strings(sorted)
+	var b strings.Builder
+	b.WriteString("// dateTimeProperties are the properties taking xsd:dateTime values.\n")
+	b.WriteString("var dateTimeProperties = map[string]bool{\n")
+	for _, n := range sorted {
+		b.WriteString(fmt.Sprintf("%q: true,\n", n))
+	}
+	b.WriteString("}\n\n")
+	b.WriteString(fmt.Sprintf(lenientDateTimesCode, prefix))
+	return b.String()
+}
+
+// generateLenientFunction adds a DeserializeLenient method to the type, which
+// deserializes it with its xsd:dateTime values parsed leniently.
+func generateLenientFunction(t *defs.Type, this *defs.StructDef) {
+	this.F = append(this.F, &defs.MemberFunctionDef{
+		Name:    "DeserializeLenient",
+		Comment: fmt.Sprintf("DeserializeLenient populates this %s from a map[string]interface{} like Deserialize, but parses the xsd:dateTime values of its properties, and of the values it contains, with ParseDateTimeLenient when ParseDateTimeStrict rejects them. It is meant for values from servers emitting malformed dates, and leaves the map unchanged", t.Name),
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"m", "map[string]interface{}"}},
+		Return:  []*defs.FunctionVarDef{{"err", "error"}},
+		Body: func() string {
+			return "return t.Deserialize(lenientDateTimes(m).(map[string]interface{}))\n"
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const (
+	decodeFileName             = "gen_decode.go"
+	decodeJSONMethodName       = "DecodeJSON"
+	decodeJSONPropertiesFnName = "DecodeJSONProperties"
+	deserializePropertyFnName  = "deserializeProperty_"
+	deserializeErrorAtFnName   = "deserializeErrorAt"
+)
+
+// deserializeErrorCode is the error of the Deserialize methods, locating where
+// in a value deserializing it failed.
+const deserializeErrorCode = `// DeserializeError is returned by Deserialize and DecodeJSON when a value
+// cannot be deserialized, locating the property where it failed, such as
+// "object.attachment[2].url" for the 'url' of the third attachment of the
+// object of an activity.
+type DeserializeError struct {
+	// Path is the path of property names to the value that could not be
+	// deserialized, with the index of the value among the values of the
+	// properties that have several.
+	Path string
+	// Err is the reason the value could not be deserialized.
+	Err error
+}
+
+// Error describes where the value could not be deserialized, and why.
+func (e *DeserializeError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the reason the value could not be deserialized.
+func (e *DeserializeError) Unwrap() error {
+	return e.Err
+}`
+
+// deserializeErrorAtCode locates the errors of deserializing values. Extension
+// packages have their own copy, returning the DeserializeError of the core
+// package.
+const deserializeErrorAtCode = `// deserializeErrorAt returns the error deserializing the value of a property,
+// or of the element of an array at an index such as "[2]", as a
+// DeserializeError whose path begins with it.
+func deserializeErrorAt(k string, err error) error {
+	e, ok := err.(*DeserializeError)
+	if !ok {
+		return &DeserializeError{Path: k, Err: err}
+	} else if strings.HasPrefix(e.Path, "[") {
+		return &DeserializeError{Path: k + e.Path, Err: e.Err}
+	}
+	return &DeserializeError{Path: k + "." + e.Path, Err: e.Err}
+}`
+
+// decodeCode decodes the properties of JSON objects one at a time, for the
+// DecodeJSON methods of the types.
+const decodeCode = `// DecodeJSONProperties reads a JSON object from the decoder token by token,
+// calling fn with each of its properties as soon as it is decoded, so that the
+// object is never held in memory as a whole map. The properties that its
+// '@context' aliases are renamed as ResolveAliases does, which requires the
+// '@context' to come before them, as it usually does. Aliased properties are
+// passed to fn after the others, keeping their alias if the object also has
+// their term. The DecodeJSON methods of the types are built on it.
+func DecodeJSONProperties(dec *json.Decoder, fn func(k string, v interface{}) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	} else if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cannot decode %v as a JSON object", tok)
+	}
+	var aliases map[string]string
+	var aliased []string
+	var aliasedValues []interface{}
+	seen := make(map[string]bool)
+	for dec.More() {
+		if tok, err = dec.Token(); err != nil {
+			return err
+		}
+		k, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("cannot decode %v as a property name", tok)
+		}
+		var v interface{}
+		if err = dec.Decode(&v); err != nil {
+			return err
+		}
+		if k == "@context" {
+			aliases = contextAliases(v, nil)
+		}
+		if len(aliases) > 0 {
+			v = renameAliases(v, aliases)
+			if _, ok := aliases[k]; ok {
+				aliased = append(aliased, k)
+				aliasedValues = append(aliasedValues, v)
+				continue
+			}
+		}
+		seen[k] = true
+		if err = fn(k, v); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	if _, err = dec.Token(); err != nil {
+		return err
+	}
+	for i, k := range aliased {
+		if term := aliases[k]; !seen[term] {
+			k = term
+		}
+		if err = fn(k, aliasedValues[i]); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	return nil
+}`
+
+// generateDecodeFile generates DecodeJSONProperties, which the DecodeJSON
+// methods of the types are built on.
+func generateDecodeFile() (*File, error) {
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"encoding/json", "fmt"},
+		Raw:     decodeCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    decodeFileName,
+		Content: c,
+	}, nil
+}
+
+func generateDecodeJSONFunction(t *defs.Type, this *defs.StructDef) {
+	this.F = append(this.F, &defs.MemberFunctionDef{
+		Name:    decodeJSONMethodName,
+		Comment: fmt.Sprintf("DecodeJSON populates this %s from the JSON object read from the decoder, deserializing each property as soon as it is decoded instead of decoding the whole object into a map first, which takes far less memory for large documents. Properties are renamed as Deserialize does, provided the '@context' comes before the properties it aliases. Unlike UnmarshalJSON, it does not record the order of the properties", t.Name),
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"dec", "*json.Decoder"}},
+		Return:  []*defs.FunctionVarDef{{"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("t.%s = nil\n", orderMember))
+			if extending {
+				b.WriteString(fmt.Sprintf("return %s.%s(dec, t.%s)\n", CorePackageName, decodeJSONPropertiesFnName, deserializePropertyFnName))
+			} else {
+				b.WriteString(fmt.Sprintf("return %s(dec, t.%s)\n", decodeJSONPropertiesFnName, deserializePropertyFnName))
+			}
+			return b.String()
+		},
+	})
+}
@@ -10,14 +10,20 @@ func generateDefinitions(t *defs.Type, m map[*defs.PropertyType]*intermedDef) (f
 	imports = make(map[string]bool)
 	this := &defs.StructDef{
 		Typename: t.Name,
-		Comment:  t.Notes,
-		M:        []*defs.StructMember{{"unknown_", "map[string]interface{}", "An unknown value."}},
+		Comment:  typeComment(t),
+		M: []*defs.StructMember{
+			{"unknown_", "map[string]interface{}", "An unknown value."},
+			{orderMember, "*" + propertyOrderTypeName, "The order of the properties of the JSON this was unmarshalled from."},
+		},
 	}
 	sd = append(sd, this)
 	thisInterface := &defs.InterfaceDef{
 		Typename: InterfaceName(t),
 		Comment:  fmt.Sprintf("%s is an interface for accepting types that extend from '%s'.", InterfaceName(t), t.Name),
 		O:        []string{"Serializer", "Deserializer"},
+		// Keeps every accessor of the type in its interface, so that
+		// application code can mock the type through it.
+		ImplementedBy: t.Name,
 	}
 	x = append(x, thisInterface)
 	var serializeFragments []string
@@ -41,9 +47,31 @@ func generateDefinitions(t *defs.Type, m map[*defs.PropertyType]*intermedDef) (f
 	generateHasUnknownFunction(t, this)
 	generateRemoveUnknownFunction(t, this)
 	generateGetUnknownFunction(t, this)
+	generateGetUnknownPropertiesFunction(t, this)
+	generateSetUnknownPropertyFunction(t, this)
 	generateSerializeFunction(t, this, serializeFragments)
 	generateDeserializeFunction(t, this, deserializeFragments)
+	generateJSONFunctions(t, this)
+	if !options.ReflectionFree {
+		generateDecodeJSONFunction(t, this)
+	}
+	generateCBORFunctions(t, this)
+	generateCanonicalFunction(t, this)
+	generateCloneFunction(t, this)
+	generateEqualsFunctions(t, this)
+	imports["fmt"] = true
+	if !options.ReflectionFree {
+		imports["encoding/json"] = true
+	}
 	generateMetadataFunctions(t, this, thisInterface)
+	generateKindFunction(t, this)
+	generateValidateFunction(t, this, thisInterface)
+	generateStrictFunctions(t, this, thisInterface)
+	generateLenientFunction(t, this)
+	imports["sort"] = true
+	generateTryFunctions(this, thisInterface)
+	generateFluentFunctions(this)
+	generatePresence(this)
 	return
 }
 
@@ -233,16 +261,82 @@ func generateGetUnknownFunction(t *defs.Type, this *defs.StructDef) {
 	this.F = append(this.F, m)
 }
 
+func generateGetUnknownPropertiesFunction(t *defs.Type, this *defs.StructDef) {
+	m := &defs.MemberFunctionDef{
+		Name:    "GetUnknownProperties",
+		Comment: "GetUnknownProperties returns a copy of every unknown property of this object by key, such as the extension properties it was deserialized with, or nil if there are none. Serialize writes them back, so they are never discarded.",
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{"m", "map[string]interface{}"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("if len(t.unknown_) == 0 {\n")
+			b.WriteString("return nil\n")
+			b.WriteString("}\n")
+			b.WriteString("m = make(map[string]interface{}, len(t.unknown_))\n")
+			b.WriteString("for k, v := range t.unknown_ {\n")
+			b.WriteString("m[k] = v\n")
+			b.WriteString("}\n")
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+	this.F = append(this.F, m)
+}
+
+func generateSetUnknownPropertyFunction(t *defs.Type, this *defs.StructDef) {
+	m := &defs.MemberFunctionDef{
+		Name:    "SetUnknownProperty",
+		Comment: "SetUnknownProperty sets the unknown property of this object with the specified key, or removes it if i is nil. Unlike AddUnknown, it does not return the object, so that every type shares its signature and extension vocabularies can set their properties of any type.",
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"k", "string"}, {"i", "interface{}"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("if i == nil {\n")
+			b.WriteString("delete(t.unknown_, k)\n")
+			b.WriteString("return\n")
+			b.WriteString("}\n")
+			b.WriteString("if t.unknown_ == nil {\n")
+			b.WriteString("t.unknown_ = make(map[string]interface{})\n")
+			b.WriteString("}\n")
+			b.WriteString("t.unknown_[k] = i\n")
+			return b.String()
+		},
+	}
+	this.F = append(this.F, m)
+}
+
 func generateDeserializeFunction(t *defs.Type, this *defs.StructDef, fragments []string) {
 	d := &defs.MemberFunctionDef{
 		Name:    "Deserialize",
-		Comment: "Deserialize populates this object from a map[string]interface{}",
+		Comment: "Deserialize populates this object from a map[string]interface{}, renaming the properties its '@context' aliases with ResolveAliases",
 		P:       this,
 		Args:    []*defs.FunctionVarDef{{"m", "map[string]interface{}"}},
 		Return:  []*defs.FunctionVarDef{{"err", "error"}},
 		Body: func() string {
 			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("t.%s = nil\n", orderMember))
+			if extending {
+				b.WriteString(fmt.Sprintf("m = %s.ResolveAliases(m)\n", CorePackageName))
+			} else {
+				b.WriteString("m = ResolveAliases(m)\n")
+			}
 			b.WriteString("for k, v := range m {\n")
+			b.WriteString(fmt.Sprintf("if err = t.%s(k, v); err != nil {\n", deserializePropertyFnName))
+			b.WriteString(fmt.Sprintf("return %s(k, err)\n", deserializeErrorAtFnName))
+			b.WriteString("}\n")
+			b.WriteString("}\n")
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+	p := &defs.MemberFunctionDef{
+		Name:    deserializePropertyFnName,
+		Comment: deserializePropertyFnName + " populates the property of this object with the key, or its unknown property if it has none with the key",
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"k", "string"}, {"v", "interface{}"}},
+		Return:  []*defs.FunctionVarDef{{"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
 			b.WriteString("handled := false\n")
 			for _, s := range fragments {
 				b.WriteString("if !handled {\n")
@@ -255,12 +349,11 @@ func generateDeserializeFunction(t *defs.Type, this *defs.StructDef, fragments [
 			b.WriteString("}\n")
 			b.WriteString(fmt.Sprintf("t.unknown_[k] = %s(v)\n", unknownValueDeserializeFnName))
 			b.WriteString("}\n")
-			b.WriteString("}\n")
 			b.WriteString("return\n")
 			return b.String()
 		},
 	}
-	this.F = append(this.F, d)
+	this.F = append(this.F, d, p)
 }
 
 func generateSerializeFunction(t *defs.Type, this *defs.StructDef, fragments []string) {
@@ -271,7 +364,7 @@ func generateSerializeFunction(t *defs.Type, this *defs.StructDef, fragments []s
 		Return:  []*defs.FunctionVarDef{{"m", "map[string]interface{}"}, {"err", "error"}},
 		Body: func() string {
 			var b bytes.Buffer
-			b.WriteString("m = make(map[string]interface{})\n")
+			b.WriteString(fmt.Sprintf("m = %s\n", newSerializeMap()))
 			b.WriteString("for k, v := range t.unknown_ {\n")
 			b.WriteString(fmt.Sprintf("m[k] = %s(v)\n", unknownValueSerializeFnName))
 			b.WriteString("}\n")
@@ -297,6 +390,116 @@ func generateSerializeFunction(t *defs.Type, this *defs.StructDef, fragments []s
 	this.F = append(this.F, d)
 }
 
+func generateJSONFunctions(t *defs.Type, this *defs.StructDef) {
+	marshal := &defs.MemberFunctionDef{
+		Name:    "MarshalJSON",
+		Comment: fmt.Sprintf("MarshalJSON implements json.Marshaler by encoding this %s as JSON, with its properties in the order of the JSON it was unmarshalled from, if any", t.Name),
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{"b", "[]byte"}, {"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("m, err := t.Serialize()\n")
+			b.WriteString("if err != nil {\n")
+			b.WriteString("return\n")
+			b.WriteString("}\n")
+			b.WriteString(fmt.Sprintf("if t.%s != nil {\n", orderMember))
+			b.WriteString(fmt.Sprintf("b, err = %s(m, t.%s)\n", encodeOrderedJSONFnName, orderMember))
+			b.WriteString("} else {\n")
+			b.WriteString(fmt.Sprintf("b, err = %s(m)\n", marshalFn()))
+			b.WriteString("}\n")
+			if options.PooledSerialize {
+				b.WriteString(fmt.Sprintf("%s(m)\n", releaseSerializedFnName))
+			}
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+	unmarshal := &defs.MemberFunctionDef{
+		Name:    "UnmarshalJSON",
+		Comment: fmt.Sprintf("UnmarshalJSON implements json.Unmarshaler by decoding JSON into this %s, recording the order of its properties for MarshalJSON", t.Name),
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"b", "[]byte"}},
+		Return:  []*defs.FunctionVarDef{{"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			if options.ReflectionFree {
+				b.WriteString(fmt.Sprintf("v, err := %s(b)\n", decodeJSONFnName))
+				b.WriteString("if err != nil {\n")
+				b.WriteString("return\n")
+				b.WriteString("}\n")
+				b.WriteString("m, ok := v.(map[string]interface{})\n")
+				b.WriteString("if !ok {\n")
+				b.WriteString(fmt.Sprintf("return fmt.Errorf(\"cannot unmarshal %%T into %s\", v)\n", t.Name))
+				b.WriteString("}\n")
+			} else {
+				b.WriteString("m := make(map[string]interface{})\n")
+				b.WriteString("if err = json.Unmarshal(b, &m); err != nil {\n")
+				b.WriteString("return\n")
+				b.WriteString("}\n")
+			}
+			b.WriteString("if err = t.Deserialize(m); err != nil {\n")
+			b.WriteString("return\n")
+			b.WriteString("}\n")
+			b.WriteString(fmt.Sprintf("t.%s = %s(b)\n", orderMember, scanPropertyOrderFnName))
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+	this.F = append(this.F, marshal, unmarshal)
+}
+
+func generateCloneFunction(t *defs.Type, this *defs.StructDef) {
+	this.F = append(this.F, &defs.MemberFunctionDef{
+		Name:    "Clone",
+		Comment: fmt.Sprintf("Clone returns a deep copy of this %s, including its unknown properties and the values of all its properties, so that the copy can be modified without affecting the original", t.Name),
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{"c", "*" + t.Name}, {"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("m, err := t.Serialize()\n")
+			b.WriteString("if err != nil {\n")
+			b.WriteString("return\n")
+			b.WriteString("}\n")
+			b.WriteString(fmt.Sprintf("c = &%s{}\n", t.Name))
+			b.WriteString(fmt.Sprintf("err = c.Deserialize(%s(m).(map[string]interface{}))\n", cloneValueFnName))
+			b.WriteString(fmt.Sprintf("c.%s = t.%s\n", orderMember, orderMember))
+			if options.PooledSerialize {
+				b.WriteString(fmt.Sprintf("%s(m)\n", releaseSerializedFnName))
+			}
+			b.WriteString("return\n")
+			return b.String()
+		},
+	})
+}
+
+func generateEqualsFunctions(t *defs.Type, this *defs.StructDef) {
+	this.F = append(this.F, &defs.MemberFunctionDef{
+		Name:    "Equals",
+		Comment: fmt.Sprintf("Equals determines whether this %s and the other one have the same canonical serialized form, such as when the same activity is delivered more than once", t.Name),
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"other", "*" + t.Name}},
+		Return:  []*defs.FunctionVarDef{{"eq", "bool"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("if t == nil || other == nil {\n")
+			b.WriteString("return t == other\n")
+			b.WriteString("}\n")
+			b.WriteString(fmt.Sprintf("return %s(t, other)\n", canonicalEqualsFnName))
+			return b.String()
+		},
+	}, &defs.MemberFunctionDef{
+		Name:    "Hash",
+		Comment: fmt.Sprintf("Hash returns the SHA-256 hash of the canonical serialized form of this %s, so that equal values have the same hash. It returns the zero hash if this %s cannot be serialized", t.Name, t.Name),
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{"h", "[32]byte"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("return %s(t)\n", canonicalHashFnName))
+			return b.String()
+		},
+	})
+}
+
 func generateWithoutProperties(d *defs.Type, this *defs.StructDef, it *defs.InterfaceDef, m map[*defs.PropertyType]*intermedDef) {
 	hasNamed := make(map[string]bool, 0)
 	for _, p := range d.GetProperties() {
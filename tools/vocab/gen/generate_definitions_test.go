@@ -0,0 +1,37 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"strings"
+	"testing"
+)
+
+func TestInterfaceImplementedBy(t *testing.T) {
+	types, properties, values, err := Prune(append(defs.AllCoreTypes, defs.AllExtendedTypes...), defs.AllPropertyTypes, defs.AllValueTypes, []string{"Note", "Like"})
+	if err != nil {
+		t.Fatalf("Prune returned error: %s", err)
+	}
+	files, err := GenerateImplementations(types, properties, values)
+	if err != nil {
+		t.Fatalf("GenerateImplementations returned error: %s", err)
+	}
+	content := make(map[string]string, len(files))
+	for _, f := range files {
+		content[f.Name] = string(f.Content)
+	}
+	for _, typ := range types {
+		name := typeFileName(typ)
+		src, ok := content[name]
+		if !ok {
+			t.Errorf("%s: no %s generated", typ.Name, name)
+			continue
+		}
+		decl := "type " + InterfaceName(typ) + " interface {\n"
+		assertion := "}\n\nvar _ " + InterfaceName(typ) + " = (*" + typ.Name + ")(nil)\n"
+		if i := strings.Index(src, decl); i < 0 {
+			t.Errorf("%s: %s does not declare %s", typ.Name, name, InterfaceName(typ))
+		} else if j := strings.Index(src[i:], assertion); j < 0 {
+			t.Errorf("%s: %s does not assert that %s implements %s", typ.Name, name, typ.Name, InterfaceName(typ))
+		}
+	}
+}
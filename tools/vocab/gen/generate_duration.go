@@ -0,0 +1,180 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const durationFileName = "gen_duration.go"
+
+// durationCode converts xsd:duration values to and from time.Duration.
+const durationCode = `// The lengths of the designators of ISO 8601 durations that have no exact
+// length, which ParseDuration and FormatDuration approximate.
+const (
+	// DurationYear is the length of a year: 365 days, ignoring leap years.
+	DurationYear = 365 * DurationDay
+	// DurationMonth is the length of a month: 30 days, whichever month it
+	// is.
+	DurationMonth = 30 * DurationDay
+	// DurationWeek is the length of a week.
+	DurationWeek = 7 * DurationDay
+	// DurationDay is the length of a day, ignoring daylight saving time.
+	DurationDay = 24 * time.Hour
+)
+
+// durationDesignators are the designators of the date and time parts of an
+// ISO 8601 duration, in the order they appear, and their lengths.
+var durationDesignators = [2]struct {
+	letters string
+	lengths []time.Duration
+}{
+	{"YMWD", []time.Duration{DurationYear, DurationMonth, DurationWeek, DurationDay}},
+	{"HMS", []time.Duration{time.Hour, time.Minute, time.Second}},
+}
+
+// ParseDuration parses an xsd:duration value, which is an ISO 8601 duration
+// such as "P1Y2M10DT2H30M", "PT1.5S", or "-P2W", into a time.Duration. Years,
+// months, and days are approximated as DurationYear, DurationMonth, and
+// DurationDay, since a time.Duration has no calendar to count them by. Any of
+// its numbers may have a fraction, separated by a period or a comma.
+func ParseDuration(s string) (time.Duration, error) {
+	v := s
+	neg := strings.HasPrefix(v, "-")
+	if neg {
+		v = v[1:]
+	}
+	if !strings.HasPrefix(v, "P") {
+		return 0, fmt.Errorf("'%s' malformed: missing 'P' for xsd:duration", s)
+	}
+	v = v[1:]
+	var d time.Duration
+	part, last, n := 0, -1, 0
+	for len(v) > 0 {
+		if v[0] == 'T' {
+			if part > 0 || len(v) == 1 {
+				return 0, fmt.Errorf("'%s' malformed: misplaced 'T' for xsd:duration", s)
+			}
+			part, last = 1, -1
+			v = v[1:]
+			continue
+		}
+		i := strings.IndexFunc(v, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return 0, fmt.Errorf("'%s' malformed: expected a number and a designator for xsd:duration", s)
+		}
+		k := strings.IndexByte(durationDesignators[part].letters, v[i])
+		if k <= last {
+			return 0, fmt.Errorf("'%s' malformed: unexpected designator '%c' for xsd:duration", s, v[i])
+		}
+		f, err := strconv.ParseFloat(strings.Replace(v[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("'%s' malformed: %s", s, err)
+		}
+		f *= float64(durationDesignators[part].lengths[k])
+		if f >= math.MaxInt64-float64(d) {
+			return 0, fmt.Errorf("'%s' is too long for a time.Duration", s)
+		}
+		d += time.Duration(f)
+		last = k
+		n++
+		v = v[i+1:]
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("'%s' malformed: no value for xsd:duration", s)
+	}
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// FormatDuration formats a time.Duration as an xsd:duration value, such as
+// "P1DT2H" or "PT0.5S", counting its years, months, and days as ParseDuration
+// does, so that the values it formats parse to the same time.Duration.
+func FormatDuration(d time.Duration) string {
+	var b bytes.Buffer
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = uint64(-d)
+	}
+	b.WriteByte('P')
+	date := durationDesignators[0]
+	for i, l := range date.lengths {
+		if date.letters[i] == 'W' {
+			continue
+		} else if n := u / uint64(l); n > 0 {
+			fmt.Fprintf(&b, "%d%c", n, date.letters[i])
+			u -= n * uint64(l)
+		}
+	}
+	if u == 0 && d != 0 {
+		return b.String()
+	}
+	b.WriteByte('T')
+	if h := u / uint64(time.Hour); h > 0 {
+		fmt.Fprintf(&b, "%dH", h)
+		u -= h * uint64(time.Hour)
+	}
+	if m := u / uint64(time.Minute); m > 0 {
+		fmt.Fprintf(&b, "%dM", m)
+		u -= m * uint64(time.Minute)
+	}
+	if u > 0 || d == 0 {
+		sec, frac := u/uint64(time.Second), u%uint64(time.Second)
+		fmt.Fprintf(&b, "%d", sec)
+		if frac > 0 {
+			b.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", frac), "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String()
+}
+
+`
+
+// durationDelegatesCode is the functions the duration value type is converted
+// with, formatted with the prefix of the exported functions they call.
+const durationDelegatesCode = `// parseDuration parses an xsd:duration value with ParseDuration.
+func parseDuration(s string) (time.Duration, error) {
+	return %[1]sParseDuration(s)
+}
+
+// formatDuration formats an xsd:duration value with FormatDuration.
+func formatDuration(d time.Duration) string {
+	return %[1]sFormatDuration(d)
+}
+`
+
+// generateDurationFile generates ParseDuration and FormatDuration, converting
+// xsd:duration values to and from time.Duration.
+func generateDurationFile() (*File, error) {
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"bytes", "fmt", "math", "strconv", "strings", "time"},
+		Raw:     durationCode + durationDelegates(""),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    durationFileName,
+		Content: c,
+	}, nil
+}
+
+// durationDelegates returns the parseDuration and formatDuration functions,
+// calling those of the package prefix.
+func durationDelegates(prefix string) string {
+	return fmt.Sprintf(durationDelegatesCode, prefix)
+}
+
+// extensionDurationCode is the parseDuration and formatDuration functions of
+// an extension, which convert with those of the core package.
+func extensionDurationCode() string {
+	return durationDelegates(CorePackageName + ".")
+}
@@ -0,0 +1,229 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/ast"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	extensionFileName = "gen_extension.go"
+	// CorePackageName is the name an extension refers to the core package
+	// it extends by.
+	CorePackageName = "core"
+)
+
+// extending is whether the types being generated are an extension of a core
+// package, whose TypeKind enumeration they are not part of.
+var extending bool
+
+// GenerateExtension generates the types of an extension vocabulary in a package
+// of their own, referring to the core types in the already generated package
+// at the import path instead of generating them again. The types may extend
+// and refer to the core types, which are aliased by the extension, so that the
+// extension types satisfy the core interfaces, like ObjectType, and core values
+// are accepted by their properties. The serializing and deserializing helpers
+// are generated again, since the core package does not export them. Importing
+// the extension package adds its types to the ExtensionTypes of the core
+// package, so that the properties of the core types deserialize them, such as
+// in the 'attachment' of a core Person.
+//
+// The properties whose domain includes core types are attached to them: the
+// core types keep them as unknown properties, which the generated functions
+// named like the methods of the types with the property, such as IsFeatured,
+// GetFeatured, and SetFeatured, read and write. They must be functional.
+//
+// The core types are those of the core package. Only the values the properties
+// of the extension take are generated, including new ones. The
+// extension types are not part of the TypeKind enumeration of the core
+// package, their Kind methods return UnknownKind, and no builders, fuzz
+// targets, nor golden tests are generated for them.
+func GenerateExtension(core, types []*defs.Type, properties []*defs.PropertyType, corePath string, o Options) (f []*File, err error) {
+	options = o
+	extending = true
+	defer func() {
+		extending = false
+	}()
+	err = parseTypeComment(append(append([]*defs.Type{}, core...), types...))
+	if err != nil {
+		return
+	}
+	defs.AddIRIRange(properties)
+	attached, own, err := attachedProperties(types, properties)
+	if err != nil {
+		return
+	}
+	err = validateDomains(own)
+	if err != nil {
+		return
+	}
+	err = validateProperties(types)
+	if err != nil {
+		return
+	}
+
+	p := generatePackageDefinition()
+	p.Comment = fmt.Sprintf("Package %s provides the types of an extension of the ActivityStream vocabulary, which refer to the types of a core package generated from the vocabulary specification. This package is code-generated. Do not modify this package directly.", packageName())
+	p.I = nil
+	p.Imports = append(p.Imports, corePath)
+	p.ImportAliases = coreImportAliases(corePath)
+	var b []byte
+	for _, a := range coreAliases(core) {
+		b = append(b, fmt.Sprintf("// %s is defined by the %s package.\ntype %s = %s.%s\n\n", a, CorePackageName, a, CorePackageName, a)...)
+	}
+	p.Raw = string(b) + extensionDateTimeCode(dateTimeScope(core, properties)) + "\n" + extensionDurationCode() + "\n" + extensionRegistration(types) + "\n\n" + deserializeErrorAtCode + "\n\n" + stringScalarCode(CorePackageName+".")
+	for _, v := range extensionValues(types, attached) {
+		p.F = append(p.F, v.DeserializeFn, v.SerializeFn)
+	}
+	p.F = append(p.F, defs.IRIFuncs()...)
+	p.F = append(p.F, generateHasTypeFuncs(types)...)
+	all := append(append([]*defs.Type{}, core...), types...)
+	p.F = append(p.F, generateResolveObjectFunction(all))
+	p.F = append(p.F, generateResolveLinkFunction(all))
+	unknown := generateUnknownValueType()
+	p.F = append(p.F, unknown.DeserializeFn, unknown.SerializeFn)
+	p.F = append(p.F, generateCloneValueFunction())
+	p.F = append(p.F, generateSetSerializedValuesFunction())
+	p.F = append(p.F, generateCanonicalFunctions()...)
+	jobs := []fileJob{packageJob(extensionFileName, p)}
+
+	m := make(map[*defs.PropertyType]*intermedDef)
+	for _, t := range types {
+		p := &defs.PackageDef{
+			Name: packageName(),
+		}
+		funcs, defs, interfaces, imports := generateDefinitions(t, m)
+		for i, _ := range imports {
+			p.Imports = append(p.Imports, i)
+		}
+		p.Imports = append(p.Imports, corePath)
+		p.ImportAliases = coreImportAliases(corePath)
+		p.F = append(p.F, funcs...)
+		p.Defs = append(p.Defs, defs...)
+		p.I = append(p.I, interfaces...)
+		jobs = append(jobs, packageJob(fmt.Sprintf("gen_%s.go", strings.ToLower(t.Name)), p))
+	}
+
+	if len(attached) > 0 {
+		jobs = append(jobs, packageJob(attachedFileName, generateAttachedPackage(attached, m)))
+	}
+	jobs = append(jobs,
+		packageJob(intermediateFileName, intermediatePackage(m, []string{"fmt", "net/url", "time"})),
+		generateLanguageFile,
+		func() (*File, error) { return generateSortFile(types) },
+		func() (*File, error) { return generateBatchFile(types) },
+		generateCBORFile,
+		generateJCSFile,
+		generateOrderFile,
+	)
+	if options.ReflectionFree {
+		jobs = append(jobs, generateJSONFile)
+	}
+	if options.PooledSerialize {
+		jobs = append(jobs, generatePoolFile)
+	}
+	return runFileJobs(jobs)
+}
+
+// extensionValues returns the values the properties of the types and the
+// attached properties take, other than IRIs.
+func extensionValues(types []*defs.Type, attached []*defs.PropertyType) (v []*defs.ValueType) {
+	seen := make(map[*defs.ValueType]bool)
+	properties := append([]*defs.PropertyType{}, attached...)
+	for _, t := range types {
+		properties = append(properties, t.GetProperties()...)
+	}
+	for _, p := range properties {
+		for _, r := range p.Range {
+			if r.V != nil && !defs.IsIRIValueType(r.V) && !seen[r.V] {
+				seen[r.V] = true
+				v = append(v, r.V)
+			}
+		}
+	}
+	return
+}
+
+// corePrefix returns the prefix of the exported names of the core package
+// referred to by generated code: none in the core package, and its name in an
+// extension.
+func corePrefix() string {
+	if extending {
+		return CorePackageName + "."
+	}
+	return ""
+}
+
+// dateTimeScope returns the properties of the extension and of the core types,
+// whose xsd:dateTime values the types of the extension, and the core values
+// they contain, deserialize leniently.
+func dateTimeScope(core []*defs.Type, properties []*defs.PropertyType) []*defs.PropertyType {
+	all := append([]*defs.PropertyType{}, properties...)
+	for _, t := range core {
+		all = append(all, t.GetProperties()...)
+	}
+	return all
+}
+
+// extensionRegistration is the init function adding the types of an extension
+// that extend Object or Link to the ExtensionTypes of the core package, so that
+// the core types deserialize them when the extension is imported.
+func extensionRegistration(types []*defs.Type) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("// init adds the types of this package to the ExtensionTypes of the %s package.\n", CorePackageName))
+	b.WriteString("func init() {\n")
+	for _, t := range types {
+		if isAnObjectType(t) || isALinkType(t) {
+			b.WriteString(fmt.Sprintf("%s.ExtensionTypes[\"%s\"] = func() %s.Type {\nreturn &%s{}\n}\n", CorePackageName, t.Name, CorePackageName, t.Name))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// coreImportAliases names the core package at the import path CorePackageName,
+// unless it is already named so.
+func coreImportAliases(corePath string) map[string]string {
+	if path.Base(corePath) == CorePackageName {
+		return nil
+	}
+	return map[string]string{corePath: CorePackageName}
+}
+
+// coreAliases returns the exported types of the core package the generated
+// code refers to.
+func coreAliases(core []*defs.Type) []string {
+	names := map[string]bool{
+		"Serializer":              true,
+		"Deserializer":            true,
+		"Typer":                   true,
+		"Unknown":                 true,
+		"MissingPropertyError":    true,
+		"UnknownPropertyError":    true,
+		"MismatchedPropertyError": true,
+		"InvalidPropertyError":    true,
+		"ValidationErrors":        true,
+		"DeserializeError":        true,
+		accessorErrName:           true,
+		kindTypeName:              true,
+	}
+	m := make(map[*defs.PropertyType]*intermedDef)
+	for _, t := range core {
+		_, sd, x, _ := generateDefinitions(t, m)
+		names[sd[0].Typename] = true
+		for _, i := range x {
+			if ast.IsExported(i.Typename) {
+				names[i.Typename] = true
+			}
+		}
+	}
+	aliases := make([]string, 0, len(names))
+	for n := range names {
+		aliases = append(aliases, n)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
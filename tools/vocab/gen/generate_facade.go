@@ -0,0 +1,145 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/ast"
+	"go/format"
+	"path"
+	"regexp"
+	"sort"
+)
+
+const (
+	facadeFileName = "gen_facade.go"
+	// ImplPackageName is the name of the package implementing the types
+	// behind a facade.
+	ImplPackageName = "impl"
+)
+
+// facadeImports are the packages the methods of the types may refer to, keyed
+// by the name they are referred to by.
+var facadeImports = map[string]string{
+	"url":  "net/url",
+	"time": "time",
+}
+
+var (
+	// exportedIdentifier matches the exported identifiers of a Go type that
+	// are not qualified by a package.
+	exportedIdentifier = regexp.MustCompile(`(^|[^.\w])([A-Z]\w*)`)
+	// qualifier matches the packages a Go type refers to.
+	qualifier = regexp.MustCompile(`\b([a-z]\w*)\.`)
+)
+
+// GenerateFacade generates a package exposing the types implemented by the
+// package at the import path, as generated by GenerateImplementations, only
+// through interfaces and constructors. Each type is an interface of its
+// exported methods, created by a New function, and the interfaces of the
+// implementation are aliased. Keeping the implementation in its own package
+// hides the many helpers it needs from the documentation of the facade.
+func GenerateFacade(types []*defs.Type, implPath string, o Options) (*File, error) {
+	options = o
+	if err := parseTypeComment(types); err != nil {
+		return nil, err
+	}
+	m := make(map[*defs.PropertyType]*intermedDef)
+	var interfaces []*defs.InterfaceDef
+	var structs []*defs.StructDef
+	interfaces = append(interfaces, generatePackageDefinition().I...)
+	interfaces = append(interfaces, generateTyperInterface())
+	for _, t := range types {
+		_, sd, x, _ := generateDefinitions(t, m)
+		structs = append(structs, sd[0])
+		interfaces = append(interfaces, x...)
+	}
+	aliased := make(map[string]bool, len(interfaces))
+	var aliases []string
+	for _, i := range interfaces {
+		if ast.IsExported(i.Typename) {
+			aliased[i.Typename] = true
+			aliases = append(aliases, i.Typename)
+		}
+	}
+	sort.Strings(aliases)
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Comment: fmt.Sprintf("Package %s exposes the ActivityStream vocabulary through interfaces and constructors only. The types are implemented by a package of the same vocabulary generated under the name %s. Do not modify this package directly.", packageName(), ImplPackageName),
+	}
+	imports := map[string]bool{implPath: true}
+	if path.Base(implPath) != ImplPackageName {
+		p.ImportAliases = map[string]string{implPath: ImplPackageName}
+	}
+	var b []byte
+	for _, a := range aliases {
+		b = append(b, fmt.Sprintf("// %s is implemented by the %s package.\ntype %s = %s.%s\n\n", a, ImplPackageName, a, ImplPackageName, a)...)
+	}
+	p.Raw = string(b)
+	for _, s := range structs {
+		i := &defs.InterfaceDef{
+			Typename: s.Typename,
+			Comment:  s.Comment,
+		}
+		for _, f := range s.F {
+			if !ast.IsExported(f.Name) {
+				continue
+			}
+			fn := &defs.FunctionDef{Name: f.Name}
+			for _, a := range f.Args {
+				fn.Args = append(fn.Args, &defs.FunctionVarDef{Name: a.Name, Type: qualifyFacadeType(a.Type, aliased)})
+			}
+			for _, r := range f.Return {
+				fn.Return = append(fn.Return, &defs.FunctionVarDef{Name: r.Name, Type: qualifyFacadeType(r.Type, aliased)})
+			}
+			for _, v := range append(append([]*defs.FunctionVarDef{}, fn.Args...), fn.Return...) {
+				for _, q := range qualifier.FindAllStringSubmatch(v.Type, -1) {
+					if q[1] == ImplPackageName {
+						continue
+					} else if imp, ok := facadeImports[q[1]]; ok {
+						imports[imp] = true
+					} else {
+						return nil, fmt.Errorf("%s.%s refers to unknown package %s", s.Typename, f.Name, q[1])
+					}
+				}
+			}
+			i.F = append(i.F, fn)
+		}
+		p.I = append(p.I, i)
+		p.F = append(p.F, generateFacadeConstructor(s.Typename))
+	}
+	for imp := range imports {
+		p.Imports = append(p.Imports, imp)
+	}
+	sort.Strings(p.Imports)
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    facadeFileName,
+		Content: c,
+	}, nil
+}
+
+// qualifyFacadeType refers to the exported identifiers of the Go type that are
+// not aliased by the facade through the implementation package.
+func qualifyFacadeType(t string, aliased map[string]bool) string {
+	return exportedIdentifier.ReplaceAllStringFunc(t, func(s string) string {
+		sub := exportedIdentifier.FindStringSubmatch(s)
+		if aliased[sub[2]] {
+			return s
+		}
+		return sub[1] + ImplPackageName + "." + sub[2]
+	})
+}
+
+func generateFacadeConstructor(name string) *defs.FunctionDef {
+	return &defs.FunctionDef{
+		Name:    fmt.Sprintf("New%s", name),
+		Comment: fmt.Sprintf("New%s creates a new, empty %s.", name, name),
+		Return:  []*defs.FunctionVarDef{{Name: "v", Type: name}},
+		Body: func() string {
+			return fmt.Sprintf("return &%s.%s{}", ImplPackageName, name)
+		},
+	}
+}
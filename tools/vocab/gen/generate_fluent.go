@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"strings"
+)
+
+const (
+	fluentPrefix = "With"
+)
+
+// fluentSetterPrefixes are the prefixes of the setters that have a fluent
+// counterpart.
+var fluentSetterPrefixes = []string{"Set", "Append"}
+
+// generateFluentFunctions adds a fluent counterpart for every setter of the
+// type, which calls it and returns the receiver so calls can be chained. For
+// example, SetPublished has WithPublished and AppendContentString has
+// WithContentString.
+func generateFluentFunctions(this *defs.StructDef) {
+	existing := make(map[string]bool, len(this.F))
+	for _, f := range this.F {
+		existing[f.Name] = true
+	}
+	var fluent []*defs.MemberFunctionDef
+	for _, f := range this.F {
+		for _, prefix := range fluentSetterPrefixes {
+			if !strings.HasPrefix(f.Name, prefix) {
+				continue
+			}
+			name := fluentPrefix + strings.TrimPrefix(f.Name, prefix)
+			if existing[name] {
+				panic(fmt.Sprintf("%s has more than one setter for %s", this.Typename, name))
+			}
+			existing[name] = true
+			fluent = append(fluent, generateFluentFunction(this, f, name))
+			break
+		}
+	}
+	this.F = append(this.F, fluent...)
+}
+
+// generateFluentFunction creates the fluent counterpart of the setter.
+func generateFluentFunction(this *defs.StructDef, setter *defs.MemberFunctionDef, name string) *defs.MemberFunctionDef {
+	args := make([]string, 0, len(setter.Args))
+	for _, a := range setter.Args {
+		args = append(args, a.Name)
+	}
+	return &defs.MemberFunctionDef{
+		Name:    name,
+		Comment: fmt.Sprintf("%s calls %s and returns this %s, so that calls can be chained", name, setter.Name, this.Typename),
+		P:       this,
+		Args:    setter.Args,
+		Return:  []*defs.FunctionVarDef{{"", "*" + this.Typename}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("t.%s(%s)\n", setter.Name, strings.Join(args, ", ")))
+			b.WriteString("return t\n")
+			return b.String()
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const fuzzFileName = "gen_fuzz_test.go"
+
+// fuzzHelperCode is shared by the fuzz targets of all the types.
+const fuzzHelperCode = `// fuzzRoundTrip deserializes the JSON into the first value and, if it is
+// accepted, checks that serializing it as JSON and deserializing that into the
+// second value gives the same canonical form.
+func fuzzRoundTrip(t *testing.T, b []byte, first, second randomValue) {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if err := first.Deserialize(m); err != nil {
+		return
+	}
+	s, err := first.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize deserialized value: %s", err)
+	}
+	if b, err = json.Marshal(s); err != nil {
+		t.Fatalf("Cannot json.Marshal serialized value: %s", err)
+	}
+	m = make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal serialized value: %s", err)
+	}
+	if err := second.Deserialize(m); err != nil {
+		t.Fatalf("Cannot Deserialize serialized value: %s", err)
+	}
+	expected, err := canonicalJSON(first)
+	if err != nil {
+		t.Fatalf("Cannot encode deserialized value: %s", err)
+	}
+	actual, err := canonicalJSON(second)
+	if err != nil {
+		t.Fatalf("Cannot encode round tripped value: %s", err)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Fatalf("Expected round trip to give %s, got %s", expected, actual)
+	}
+}`
+
+// fuzzTargetCode is the fuzz target of a single type. It is formatted with the
+// name of the type.
+const fuzzTargetCode = `// FuzzDeserialize%[1]s checks that any %[1]s that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserialize%[1]s(f *testing.F) {
+	f.Add([]byte("{\"type\": \"%[1]s\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &%[1]s{}, &%[1]s{})
+	})
+}`
+
+// generateFuzzFile generates a fuzz target for every type, seeded with the
+// smallest document of it. Larger seeds, such as random values, slow the
+// fuzzer down to a crawl.
+func generateFuzzFile(types []*defs.Type) (*File, error) {
+	var b bytes.Buffer
+	b.WriteString(fuzzHelperCode)
+	for _, t := range types {
+		b.WriteString("\n\n")
+		b.WriteString(fmt.Sprintf(fuzzTargetCode, t.Name))
+	}
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"bytes", "encoding/json", "testing"},
+		Raw:     b.String(),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    fuzzFileName,
+		Content: c,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	goldenFileName    = "gen_golden_test.go"
+	goldenDirName     = "golden"
+	exampleFileSuffix = ".json"
+	unknownTypeName   = "Unknown"
+)
+
+// goldenCode is the test round tripping the examples. It is formatted with
+// the directory of the examples, the directory of the golden files, and the
+// entries of the goldenExamples table.
+const goldenCode = `var updateGolden = flag.Bool("update_golden", false, "Write the golden files of TestGoldenExamples instead of comparing against them")
+
+// goldenExamples are the example documents of the specification and the type
+// each one is deserialized into.
+var goldenExamples = []struct {
+	name  string
+	value func() randomValue
+}{
+%[3]s}
+
+// TestGoldenExamples deserializes every example document, serializes it
+// again, and compares the result against its golden file. Run it with
+// -update_golden to write the golden files after an intentional change.
+func TestGoldenExamples(t *testing.T) {
+	for _, r := range goldenExamples {
+		b, err := ioutil.ReadFile(filepath.Join(filepath.FromSlash(%[1]q), r.name+%[4]q))
+		if err != nil {
+			t.Errorf("%%s: Cannot read example: %%s", r.name, err)
+			continue
+		}
+		m := make(map[string]interface{})
+		if err = json.Unmarshal(b, &m); err != nil {
+			t.Errorf("%%s: Cannot json.Unmarshal: %%s", r.name, err)
+			continue
+		}
+		v := r.value()
+		if err = v.Deserialize(m); err != nil {
+			t.Errorf("%%s: Cannot Deserialize: %%s", r.name, err)
+			continue
+		}
+		s, err := v.Serialize()
+		if err != nil {
+			t.Errorf("%%s: Cannot Serialize: %%s", r.name, err)
+			continue
+		}
+		actual, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			t.Errorf("%%s: Cannot json.Marshal: %%s", r.name, err)
+			continue
+		}
+		actual = append(actual, '\n')
+		golden := filepath.Join(filepath.FromSlash(%[2]q), r.name+%[4]q)
+		if *updateGolden {
+			if err = ioutil.WriteFile(golden, actual, 0644); err != nil {
+				t.Errorf("%%s: Cannot write golden file: %%s", r.name, err)
+			}
+			continue
+		}
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Errorf("%%s: Cannot read golden file: %%s", r.name, err)
+		} else if !bytes.Equal(expected, actual) {
+			t.Errorf("%%s: Expected serialized example to match %%s, got:\n%%s", r.name, golden, actual)
+		}
+	}
+}`
+
+// generateGoldenFile generates a test round tripping every example document
+// in the slash-separated directory, relative to the generated package, and
+// comparing the result against golden files kept in a sibling directory.
+func generateGoldenFile(types []*defs.Type, examplesDir string) (*File, error) {
+	dir := filepath.Join(options.OutputDir, filepath.FromSlash(examplesDir))
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, info := range infos {
+		if !info.IsDir() && strings.HasSuffix(info.Name(), exampleFileSuffix) {
+			names = append(names, strings.TrimSuffix(info.Name(), exampleFileSuffix))
+		}
+	}
+	// Shorter names first, so example2 comes before example10.
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) < len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	known := make(map[string]bool, len(types))
+	for _, t := range types {
+		known[t.Name] = true
+	}
+	var entries bytes.Buffer
+	for _, name := range names {
+		b, err := ioutil.ReadFile(filepath.Join(dir, name+exampleFileSuffix))
+		if err != nil {
+			return nil, err
+		}
+		typeName, err := exampleTypeName(b, known)
+		if err != nil {
+			return nil, fmt.Errorf("example %s: %s", name, err)
+		}
+		entries.WriteString(fmt.Sprintf("{%q, func() randomValue { return &%s{} }},\n", name, typeName))
+	}
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"bytes", "encoding/json", "flag", "io/ioutil", "path/filepath", "testing"},
+		Raw:     fmt.Sprintf(goldenCode, examplesDir, path.Join(path.Dir(examplesDir), goldenDirName), entries.String(), exampleFileSuffix),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    goldenFileName,
+		Content: c,
+	}, nil
+}
+
+// exampleTypeName determines the type an example document is deserialized
+// into: the first of its types that is generated, or Unknown if there is none.
+func exampleTypeName(b []byte, known map[string]bool) (string, error) {
+	var example struct {
+		Type interface{} `json:"type"`
+	}
+	if err := json.Unmarshal(b, &example); err != nil {
+		return "", err
+	}
+	var candidates []interface{}
+	if s, ok := example.Type.([]interface{}); ok {
+		candidates = s
+	} else {
+		candidates = []interface{}{example.Type}
+	}
+	for _, c := range candidates {
+		if s, ok := c.(string); ok && known[s] {
+			return s, nil
+		}
+	}
+	return unknownTypeName, nil
+}
@@ -0,0 +1,116 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"strings"
+	"text/template"
+)
+
+// ToolName is the name of the generating tool a Header may mention.
+const ToolName = "github.com/go-fed/activity/tools/vocab"
+
+// HeaderData is what the Header template of the Options is executed with.
+type HeaderData struct {
+	// File is the name of the file the header is put at the top of.
+	File string
+	// Package is the name of the generated package.
+	Package string
+	// Tool is the name of the generating tool.
+	Tool string
+	// ToolVersion is the version of the generating tool from the Options.
+	ToolVersion string
+	// VocabularyVersion is the version of the specification the types are
+	// generated from.
+	VocabularyVersion string
+}
+
+// typeCommentTemplate is the parsed TypeComment of the options, or nil if the
+// notes of the types are their doc comments.
+var typeCommentTemplate *template.Template
+
+// parseTypeComment parses the TypeComment of the options, and checks it can be
+// executed with every type.
+func parseTypeComment(types []*defs.Type) error {
+	typeCommentTemplate = nil
+	if len(options.TypeComment) == 0 {
+		return nil
+	}
+	t, err := template.New("TypeComment").Parse(options.TypeComment)
+	if err != nil {
+		return err
+	}
+	for _, ty := range types {
+		if err = t.Execute(&bytes.Buffer{}, ty); err != nil {
+			return err
+		}
+	}
+	typeCommentTemplate = t
+	return nil
+}
+
+// typeComment returns the doc comment of the type. The lines after the first
+// of an executed TypeComment are made into comments too.
+func typeComment(t *defs.Type) string {
+	if typeCommentTemplate == nil {
+		return t.Notes
+	}
+	var b bytes.Buffer
+	if err := typeCommentTemplate.Execute(&b, t); err != nil {
+		panic(fmt.Sprintf("executing TypeComment for %s: %s", t.Name, err))
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	for i := 1; i < len(lines); i++ {
+		lines[i] = strings.TrimRight("// "+strings.TrimSpace(lines[i]), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ApplyHeader puts the Header of the options at the top of every file, as a
+// comment separated from the package documentation. Lines of the executed
+// template that are not already comments are made into comments, so that a
+// line such as "Code generated by {{.Tool}}. DO NOT EDIT." marks the files as
+// generated. It is applied last, after ApplyLayout.
+func ApplyHeader(files []*File, o Options) ([]*File, error) {
+	if len(o.Header) == 0 {
+		return files, nil
+	}
+	t, err := template.New("Header").Parse(o.Header)
+	if err != nil {
+		return nil, err
+	}
+	pkg := o.PackageName
+	if len(pkg) == 0 {
+		pkg = DefaultPackageName
+	}
+	out := make([]*File, 0, len(files))
+	for _, f := range files {
+		var b bytes.Buffer
+		err = t.Execute(&b, HeaderData{
+			File:              f.Name,
+			Package:           pkg,
+			Tool:              ToolName,
+			ToolVersion:       o.ToolVersion,
+			VocabularyVersion: defs.VocabularyVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var c bytes.Buffer
+		for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+			line = strings.TrimRight(line, " \t")
+			if !strings.HasPrefix(line, "//") {
+				line = strings.TrimRight("// "+line, " ")
+			}
+			c.WriteString(line + "\n")
+		}
+		c.WriteString("\n")
+		c.Write(f.Content)
+		out = append(out, &File{
+			Name:    f.Name,
+			Content: c.Bytes(),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,231 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+	"sort"
+)
+
+const iriFileName = "gen_iri.go"
+
+// iriCode validates the IRIs of serialized values, formatted with the
+// properties whose values may be IRIs and the IRI of the Public collection.
+const iriCode = `// InvalidIRIError is returned by an IRIPolicy for an IRI it does not allow.
+type InvalidIRIError struct {
+	// Path is the path of property names to the IRI, as in a
+	// DeserializeError.
+	Path string
+	// IRI is the IRI that is not allowed.
+	IRI string
+	// Reason is why the IRI is not allowed.
+	Reason string
+}
+
+// Error describes the IRI and why it is not allowed.
+func (e *InvalidIRIError) Error() string {
+	return fmt.Sprintf("%%s: IRI %%q %%s", e.Path, e.IRI, e.Reason)
+}
+
+// IRIPolicy is the schemes and structure of the IRIs that an application
+// accepts from other servers, so that a hostile server cannot have it follow
+// or display an IRI such as "javascript:..", "data:..", or "file:///etc". The
+// zero IRIPolicy allows every IRI.
+type IRIPolicy struct {
+	// Schemes are the schemes IRIs may have, such as "https". Any scheme
+	// is allowed if it is empty.
+	Schemes []string
+	// HTTPS are the properties whose IRIs must have the https scheme
+	// whatever the Schemes, such as "inbox".
+	HTTPS []string
+	// Absolute is whether IRIs must have a scheme and a host, and must not
+	// have user information.
+	Absolute bool
+	// Properties are the properties that Validate checks besides the
+	// properties of this package whose values may be IRIs and the HTTPS
+	// properties, such as those of extension vocabularies.
+	Properties []string
+}
+
+// DefaultIRIPolicy allows absolute http and https IRIs, requiring https for
+// the properties that federating deliveries and signatures rely on. The
+// "publicKeyId" property is the keyId of HTTP Signatures.
+var DefaultIRIPolicy = IRIPolicy{
+	Schemes:  []string{"https", "http"},
+	HTTPS:    []string{"inbox", "outbox", "sharedInbox", "publicKeyId"},
+	Absolute: true,
+}
+
+// iriProperties are the properties of this package whose values may be IRIs.
+var iriProperties = map[string]bool{
+%s}
+
+// PublicIRI is the IRI of the special Public collection. Addressing a value to
+// it makes the value visible to everyone, without delivering it to anyone.
+const PublicIRI = %q
+
+// publicIRIs are the spellings of the IRI of the Public collection: in full,
+// compacted with the "as" prefix of the ActivityStreams context, and as the
+// bare term, which all expand to the same IRI.
+var publicIRIs = map[string]bool{
+	PublicIRI:   true,
+	"as:Public": true,
+	"Public":    true,
+}
+
+// IsPublicIRI returns true if the string is the IRI of the Public collection,
+// in any of its spellings. Validate allows them all whatever the policy.
+func IsPublicIRI(s string) bool {
+	return publicIRIs[s]
+}
+
+// ValidateIRI returns an InvalidIRIError if the policy does not allow the IRI
+// as a value of the property.
+func (p IRIPolicy) ValidateIRI(property string, iri *url.URL) error {
+	invalid := func(reason string) error {
+		return &InvalidIRIError{Path: property, IRI: iri.String(), Reason: reason}
+	}
+	scheme := strings.ToLower(iri.Scheme)
+	if len(p.Schemes) > 0 && !containsFold(p.Schemes, scheme) {
+		return invalid(fmt.Sprintf("has scheme %%q, not one of %%s", scheme, strings.Join(p.Schemes, ", ")))
+	} else if containsFold(p.HTTPS, property) && scheme != "https" {
+		return invalid("must have scheme \"https\"")
+	} else if p.Absolute && (len(scheme) == 0 || len(iri.Host) == 0) {
+		return invalid("is not absolute")
+	} else if p.Absolute && iri.User != nil {
+		return invalid("has user information")
+	}
+	return nil
+}
+
+// Validate returns ValidationErrors listing every IRI of the serialized value
+// that the policy does not allow, or nil if it allows them all. It checks the
+// string values of the properties whose values may be IRIs, including those of
+// the objects nested in the value, but not the '@context'. A property named by
+// an alias its '@context' defines, by a compacted or absolute IRI such as
+// "as:inbox", or by '@id' is checked as the term it stands for. Blank node
+// identifiers, such as "_:b0", are allowed whatever the policy, since they are
+// never dereferenced.
+func (p IRIPolicy) Validate(m map[string]interface{}) error {
+	var errs ValidationErrors
+	p.validateObject("", m, nil, &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateObject validates the IRIs of the properties of an object at a path,
+// with the aliases of the '@context' of the values enclosing it.
+func (p IRIPolicy) validateObject(path string, m map[string]interface{}, aliases map[string]string, errs *ValidationErrors) {
+	aliases = contextAliases(m["@context"], aliases)
+	for k, v := range m {
+		if k == "@context" {
+			continue
+		}
+		at := k
+		if len(path) > 0 {
+			at = path + "." + k
+		}
+		term := propertyTerm(k, aliases)
+		iri := iriProperties[term] || containsFold(p.HTTPS, term) || containsFold(p.Properties, term) || containsFold(p.Properties, k)
+		p.validateValue(term, at, v, aliases, iri, errs)
+	}
+}
+
+// propertyTerm returns the term a property name stands for, resolved as
+// Deserialize resolves it: the term of an alias of the '@context', or the
+// ActivityStreams term of a compacted or absolute IRI or of '@id'.
+func propertyTerm(k string, aliases map[string]string) string {
+	if term, ok := aliases[k]; ok {
+		return term
+	} else if term, ok := activityStreamsTerm(k, termPrefixes); ok {
+		return term
+	}
+	return k
+}
+
+// termPrefixes are the prefixes of the compacted IRIs that propertyTerm expands
+// without a '@context' defining them.
+var termPrefixes = map[string]string{"as": activityStreamsNamespaces[0]}
+
+// validateValue validates the IRIs of the value of a property at a path, which
+// are the strings if the property may have IRIs as values.
+func (p IRIPolicy) validateValue(property, path string, v interface{}, aliases map[string]string, iri bool, errs *ValidationErrors) {
+	switch x := v.(type) {
+	case string:
+		if !iri || IsPublicIRI(x) || strings.HasPrefix(x, "_:") {
+			return
+		}
+		u, err := url.Parse(x)
+		if err != nil {
+			*errs = append(*errs, &InvalidIRIError{Path: path, IRI: x, Reason: "cannot be parsed"})
+		} else if err = p.ValidateIRI(property, u); err != nil {
+			err.(*InvalidIRIError).Path = path
+			*errs = append(*errs, err)
+		}
+	case map[string]interface{}:
+		p.validateObject(path, x, aliases, errs)
+	case []interface{}:
+		for idx, elem := range x {
+			p.validateValue(property, fmt.Sprintf("%%s[%%d]", path, idx), elem, aliases, iri, errs)
+		}
+	}
+}
+
+// containsFold is whether the strings contain s, ignoring case.
+func containsFold(strs []string, s string) bool {
+	for _, str := range strs {
+		if strings.EqualFold(str, s) {
+			return true
+		}
+	}
+	return false
+}`
+
+// generateIRIFile generates IRIPolicy, which validates the IRIs of serialized
+// values.
+func generateIRIFile(properties []*defs.PropertyType) (*File, error) {
+	// Only the properties whose values are never strings other than IRIs,
+	// so not 'type' whose values are the names of types.
+	names := map[string]bool{"id": true}
+	for _, p := range properties {
+		if p.Name == "type" {
+			continue
+		}
+		iri := false
+		for _, r := range p.Range {
+			if r.V != nil && !defs.IsIRIValueType(r.V) && !defs.IsAnyURIValueType(r.V) {
+				iri = false
+				break
+			}
+			iri = iri || r.T != nil || r.Any || r.V != nil
+		}
+		if iri {
+			names[p.Name] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for n := range names {
+		sorted = append(sorted, n)
+	}
+	sort.Strings(sorted)
+	var b bytes.Buffer
+	for _, n := range sorted {
+		b.WriteString(fmt.Sprintf("%q: true,\n", n))
+	}
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"fmt", "net/url", "strings"},
+		Raw:     fmt.Sprintf(iriCode, b.String(), defs.PublicActivityPub),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    iriFileName,
+		Content: c,
+	}, nil
+}
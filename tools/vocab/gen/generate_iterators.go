@@ -0,0 +1,57 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+)
+
+// generateValuesFunction adds a method returning an iterator over the values of
+// a non-functional property that are of one kind. The iterator has the same
+// signature as iter.Seq, so on Go 1.23 and later it can be used in a range
+// loop. If there is no 'Is' function, every value is of the kind.
+func generateValuesFunction(this *defs.StructDef, i *defs.InterfaceDef, titleName, kindName, retKind string) {
+	name := fmt.Sprintf("%s%sValues", titleName, kindName)
+	lenFn := fmt.Sprintf("%sLen", titleName)
+	isFn := fmt.Sprintf("Is%s%s", titleName, kindName)
+	getFn := fmt.Sprintf("Get%s%s", titleName, kindName)
+	hasIsFn := false
+	for _, f := range this.F {
+		if f.Name == isFn {
+			hasIsFn = true
+			break
+		}
+	}
+	comment := fmt.Sprintf("%s returns an iterator over the values that %s returns, which can be used as an iter.Seq", name, getFn)
+	if hasIsFn {
+		comment = fmt.Sprintf("%s returns an iterator over the values that %s returns for each index where %s is true, which can be used as an iter.Seq", name, getFn, isFn)
+	}
+	seqType := fmt.Sprintf("func(yield func(v %s) bool)", retKind)
+	this.F = append(this.F, &defs.MemberFunctionDef{
+		Name:    name,
+		Comment: comment,
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{"seq", seqType}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString(fmt.Sprintf("return func(yield func(v %s) bool) {\n", retKind))
+			b.WriteString(fmt.Sprintf("for i := 0; i < t.%s(); i++ {\n", lenFn))
+			if hasIsFn {
+				b.WriteString(fmt.Sprintf("if !t.%s(i) {\n", isFn))
+				b.WriteString("continue\n")
+				b.WriteString("}\n")
+			}
+			b.WriteString(fmt.Sprintf("if !yield(t.%s(i)) {\n", getFn))
+			b.WriteString("return\n")
+			b.WriteString("}\n")
+			b.WriteString("}\n")
+			b.WriteString("}\n")
+			return b.String()
+		},
+	})
+	i.F = append(i.F, &defs.FunctionDef{
+		Name:    name,
+		Comment: comment,
+		Return:  []*defs.FunctionVarDef{{"seq", seqType}},
+	})
+}
@@ -0,0 +1,407 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const (
+	jsonFileName     = "gen_json.go"
+	encodeJSONFnName = "encodeJSON"
+	decodeJSONFnName = "decodeJSON"
+)
+
+// jsonCode encodes and decodes the serialized form of the types as JSON without
+// the reflection encoding/json relies on, for targets such as TinyGo that do
+// not fully support it.
+const jsonCode = `// encodeJSON encodes the serialized form of a value as JSON, with the keys of
+// objects sorted. It supports the values that Serialize and decodeJSON create.
+func encodeJSON(v interface{}) ([]byte, error) {
+	return appendJSON(nil, v)
+}
+
+// appendJSON appends the JSON encoding of the value to the bytes.
+func appendJSON(b []byte, v interface{}) ([]byte, error) {
+	var err error
+	switch x := v.(type) {
+	case nil:
+		b = append(b, "null"...)
+	case bool:
+		b = strconv.AppendBool(b, x)
+	case string:
+		b = appendJSONString(b, x)
+	case float64:
+		return appendJSONNumber(b, x, 64)
+	case float32:
+		return appendJSONNumber(b, float64(x), 32)
+	case int:
+		b = strconv.AppendInt(b, int64(x), 10)
+	case int32:
+		b = strconv.AppendInt(b, int64(x), 10)
+	case int64:
+		b = strconv.AppendInt(b, x, 10)
+	case uint64:
+		b = strconv.AppendUint(b, x, 10)
+	case []interface{}:
+		b = append(b, '[')
+		for i, e := range x {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendJSON(b, e); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	case []string:
+		b = append(b, '[')
+		for i, e := range x {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, e)
+		}
+		b = append(b, ']')
+	case map[string]string:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b = append(b, '{')
+		for i, k := range keys {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, k)
+			b = append(b, ':')
+			b = appendJSONString(b, x[k])
+		}
+		b = append(b, '}')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b = append(b, '{')
+		for i, k := range keys {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, k)
+			b = append(b, ':')
+			if b, err = appendJSON(b, x[k]); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, '}')
+	default:
+		return nil, fmt.Errorf("cannot encode value of type %T as JSON", v)
+	}
+	return b, nil
+}
+
+// appendJSONNumber appends the number formatted as encoding/json does.
+func appendJSONNumber(b []byte, f float64, bits int) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("cannot encode %v as JSON", f)
+	}
+	abs := math.Abs(f)
+	if bits == 32 {
+		abs = float64(float32(abs))
+	}
+	format := byte('f')
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b = strconv.AppendFloat(b, f, format, -1, bits)
+	if format == 'e' {
+		// Shorten an exponent such as e-07 to e-7.
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b, nil
+}
+
+// appendJSONString appends the string quoted as JSON. Invalid UTF-8 is replaced
+// by the replacement character.
+func appendJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	b = append(b, '"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b = append(b, '\\', byte(r))
+		case r == '\n':
+			b = append(b, '\\', 'n')
+		case r == '\r':
+			b = append(b, '\\', 'r')
+		case r == '\t':
+			b = append(b, '\\', 't')
+		case r == '\b':
+			b = append(b, '\\', 'b')
+		case r == '\f':
+			b = append(b, '\\', 'f')
+		case r < 0x20 || r == '\u2028' || r == '\u2029':
+			b = append(b, '\\', 'u', hex[r>>12&0xf], hex[r>>8&0xf], hex[r>>4&0xf], hex[r&0xf])
+		default:
+			b = appendRune(b, r)
+		}
+	}
+	return append(b, '"')
+}
+
+// appendRune appends the UTF-8 encoding of the rune.
+func appendRune(b []byte, r rune) []byte {
+	var e [utf8.UTFMax]byte
+	n := utf8.EncodeRune(e[:], r)
+	return append(b, e[:n]...)
+}
+
+// decodeJSON decodes JSON into maps, slices, strings, float64 numbers, bools,
+// and nil, like encoding/json does into an interface{}.
+func decodeJSON(b []byte) (interface{}, error) {
+	d := &jsonDecoder{b: b}
+	v, err := d.value()
+	if err != nil {
+		return nil, err
+	}
+	d.space()
+	if d.i < len(d.b) {
+		return nil, d.errorf("unexpected %q after the top-level value", d.b[d.i])
+	}
+	return v, nil
+}
+
+// jsonDecoder decodes the JSON bytes from an offset.
+type jsonDecoder struct {
+	b []byte
+	i int
+}
+
+func (d *jsonDecoder) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("invalid JSON at offset %d: %s", d.i, fmt.Sprintf(format, a...))
+}
+
+func (d *jsonDecoder) space() {
+	for d.i < len(d.b) {
+		switch d.b[d.i] {
+		case ' ', '\t', '\n', '\r':
+			d.i++
+		default:
+			return
+		}
+	}
+}
+
+func (d *jsonDecoder) literal(s string, v interface{}) (interface{}, error) {
+	if !bytes.HasPrefix(d.b[d.i:], []byte(s)) {
+		return nil, d.errorf("expected %s", s)
+	}
+	d.i += len(s)
+	return v, nil
+}
+
+func (d *jsonDecoder) value() (interface{}, error) {
+	d.space()
+	if d.i >= len(d.b) {
+		return nil, d.errorf("unexpected end of input")
+	}
+	switch c := d.b[d.i]; {
+	case c == '{':
+		return d.object()
+	case c == '[':
+		return d.array()
+	case c == '"':
+		return d.str()
+	case c == 't':
+		return d.literal("true", true)
+	case c == 'f':
+		return d.literal("false", false)
+	case c == 'n':
+		return d.literal("null", nil)
+	case c == '-' || (c >= '0' && c <= '9'):
+		return d.number()
+	default:
+		return nil, d.errorf("unexpected %q", c)
+	}
+}
+
+func (d *jsonDecoder) object() (interface{}, error) {
+	m := make(map[string]interface{})
+	d.i++
+	d.space()
+	if d.i < len(d.b) && d.b[d.i] == '}' {
+		d.i++
+		return m, nil
+	}
+	for {
+		d.space()
+		if d.i >= len(d.b) || d.b[d.i] != '"' {
+			return nil, d.errorf("expected a key")
+		}
+		k, err := d.str()
+		if err != nil {
+			return nil, err
+		}
+		d.space()
+		if d.i >= len(d.b) || d.b[d.i] != ':' {
+			return nil, d.errorf("expected ':'")
+		}
+		d.i++
+		v, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		m[k] = v
+		d.space()
+		if d.i >= len(d.b) {
+			return nil, d.errorf("unexpected end of input")
+		} else if d.b[d.i] == '}' {
+			d.i++
+			return m, nil
+		} else if d.b[d.i] != ',' {
+			return nil, d.errorf("expected ',' or '}'")
+		}
+		d.i++
+	}
+}
+
+func (d *jsonDecoder) array() (interface{}, error) {
+	a := make([]interface{}, 0)
+	d.i++
+	d.space()
+	if d.i < len(d.b) && d.b[d.i] == ']' {
+		d.i++
+		return a, nil
+	}
+	for {
+		v, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, v)
+		d.space()
+		if d.i >= len(d.b) {
+			return nil, d.errorf("unexpected end of input")
+		} else if d.b[d.i] == ']' {
+			d.i++
+			return a, nil
+		} else if d.b[d.i] != ',' {
+			return nil, d.errorf("expected ',' or ']'")
+		}
+		d.i++
+	}
+}
+
+func (d *jsonDecoder) str() (string, error) {
+	d.i++
+	var s []byte
+	for d.i < len(d.b) {
+		c := d.b[d.i]
+		switch {
+		case c == '"':
+			d.i++
+			return string(s), nil
+		case c < 0x20:
+			return "", d.errorf("control character in string")
+		case c == '\\':
+			if d.i+1 >= len(d.b) {
+				return "", d.errorf("unexpected end of input")
+			}
+			d.i++
+			switch e := d.b[d.i]; e {
+			case '"', '\\', '/':
+				s = append(s, e)
+			case 'b':
+				s = append(s, '\b')
+			case 'f':
+				s = append(s, '\f')
+			case 'n':
+				s = append(s, '\n')
+			case 'r':
+				s = append(s, '\r')
+			case 't':
+				s = append(s, '\t')
+			case 'u':
+				r, ok := d.hex4(d.i + 1)
+				if !ok {
+					return "", d.errorf("invalid unicode escape")
+				}
+				d.i += 4
+				if utf16.IsSurrogate(r) {
+					r2, ok := rune(-1), false
+					if d.i+2 < len(d.b) && d.b[d.i+1] == '\\' && d.b[d.i+2] == 'u' {
+						r2, ok = d.hex4(d.i + 3)
+					}
+					if r = utf16.DecodeRune(r, r2); ok && r != utf8.RuneError {
+						d.i += 6
+					}
+				}
+				s = appendRune(s, r)
+			default:
+				return "", d.errorf("invalid escape %q", e)
+			}
+			d.i++
+		case c < utf8.RuneSelf:
+			s = append(s, c)
+			d.i++
+		default:
+			r, n := utf8.DecodeRune(d.b[d.i:])
+			s = appendRune(s, r)
+			d.i += n
+		}
+	}
+	return "", d.errorf("unterminated string")
+}
+
+// hex4 decodes the four hexadecimal digits at the offset.
+func (d *jsonDecoder) hex4(i int) (rune, bool) {
+	if i+4 > len(d.b) {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(string(d.b[i:i+4]), 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	return rune(n), true
+}
+
+func (d *jsonDecoder) number() (interface{}, error) {
+	start := d.i
+	for d.i < len(d.b) {
+		c := d.b[d.i]
+		if (c >= '0' && c <= '9') || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E' {
+			d.i++
+		} else {
+			break
+		}
+	}
+	f, err := strconv.ParseFloat(string(d.b[start:d.i]), 64)
+	if err != nil {
+		return nil, d.errorf("invalid number %q", d.b[start:d.i])
+	}
+	return f, nil
+}`
+
+// generateJSONFile generates the encoding and decoding of JSON used instead of
+// encoding/json by ReflectionFree options.
+func generateJSONFile() (*File, error) {
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"bytes", "fmt", "math", "sort", "strconv", "unicode/utf16", "unicode/utf8"},
+		Raw:     jsonCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    jsonFileName,
+		Content: c,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const (
+	typeKindFileName = "gen_kind.go"
+	kindTypeName     = "TypeKind"
+	kindFnName       = "Kind"
+	kindConstantName = "%sKind"
+)
+
+// kindCode is the TypeKind enumeration. It is formatted with the constants of
+// the types and the entries of the kindNames table.
+const kindCode = `// TypeKind enumerates the types of this package, so that code dispatching on
+// the type of a value can switch over integers instead of comparing strings or
+// asserting each type in turn.
+type TypeKind int
+
+const (
+	// UnknownKind is the TypeKind of values that are not a type of this
+	// package.
+	UnknownKind TypeKind = iota
+%s)
+
+// kindNames are the names of the types of each TypeKind.
+var kindNames = [...]string{
+	UnknownKind: "Unknown",
+%s}
+
+// String returns the name of the type of the TypeKind.
+func (k TypeKind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return fmt.Sprintf("TypeKind(%%d)", int(k))
+	}
+	return kindNames[k]
+}
+
+// Type is implemented by every type of this package.
+type Type interface {
+	Serializer
+	Deserializer
+	// Kind returns the TypeKind of the type.
+	Kind() TypeKind
+}
+
+// KindOf returns the TypeKind of the value, or UnknownKind if it is nil.
+func KindOf(t Type) TypeKind {
+	if t == nil {
+		return UnknownKind
+	}
+	return t.Kind()
+}
+
+// ExtensionTypes maps the names of the types of extension vocabularies to
+// functions creating one, so that the properties of the types of this package
+// deserialize them rather than keeping them as unknown values. The packages
+// generated for extensions add their types when they are imported. A type
+// extending Object is resolved where objects are taken, and one extending Link
+// where links are.
+var ExtensionTypes = map[string]func() Type{}`
+
+// kindConstant is the name of the TypeKind constant of the type.
+func kindConstant(t *defs.Type) string {
+	return fmt.Sprintf(kindConstantName, t.Name)
+}
+
+// generateKindFile generates the TypeKind enumeration of the types.
+func generateKindFile(types []*defs.Type) (*File, error) {
+	var constants, names bytes.Buffer
+	for _, t := range types {
+		constants.WriteString(fmt.Sprintf("%s\n", kindConstant(t)))
+		names.WriteString(fmt.Sprintf("%s: %q,\n", kindConstant(t), t.Name))
+	}
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"fmt"},
+		Raw:     fmt.Sprintf(kindCode, constants.String(), names.String()),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    typeKindFileName,
+		Content: c,
+	}, nil
+}
+
+// generateKindFunction generates the method returning the TypeKind of the
+// type. The types of an extension return the UnknownKind of the core package.
+func generateKindFunction(t *defs.Type, this *defs.StructDef) {
+	comment := fmt.Sprintf("%s returns %s.", kindFnName, kindConstant(t))
+	kind := kindConstant(t)
+	if extending {
+		comment = fmt.Sprintf("%s returns UnknownKind, since %s is not a type of the %s package.", kindFnName, t.Name, CorePackageName)
+		kind = CorePackageName + ".UnknownKind"
+	}
+	this.F = append(this.F, &defs.MemberFunctionDef{
+		Name:    kindFnName,
+		Comment: comment,
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{Name: "k", Type: kindTypeName}},
+		Body: func() string {
+			return fmt.Sprintf("return %s", kind)
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+	"strings"
+)
+
+const languageFileName = "gen_language.go"
+
+// languageCode matches language tags against the natural language maps of the
+// properties.
+const languageCode = `// languageKey returns the key of the natural language map that is the language
+// tag, which BCP 47 compares without regard to case.
+func languageKey(m map[string]string, tag string) (string, bool) {
+	if _, ok := m[tag]; ok {
+		return tag, true
+	}
+	for k := range m {
+		if strings.EqualFold(k, tag) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// preferredLanguage returns the key of the natural language map best matching
+// the language tags, which are in order of preference. Each tag is looked up as
+// RFC 4647 describes, removing subtags from its end until it matches, so that
+// "en-GB" matches "en". If none match, it returns the first key in sorted order
+// and false, or an empty key if the map is empty.
+func preferredLanguage(m map[string]string, tags []string) (string, bool) {
+	for _, tag := range tags {
+		for len(tag) > 0 {
+			if k, ok := languageKey(m, tag); ok {
+				return k, true
+			}
+			i := strings.LastIndex(tag, "-")
+			if i < 0 {
+				break
+			}
+			tag = tag[:i]
+			if i = strings.LastIndex(tag, "-"); i >= 0 && i == len(tag)-2 {
+				tag = tag[:i]
+			}
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return "", false
+	}
+	sort.Strings(keys)
+	return keys[0], false
+}`
+
+func generateLanguageFile() (*File, error) {
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"sort", "strings"},
+		Raw:     languageCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    languageFileName,
+		Content: c,
+	}, nil
+}
+
+// generateLanguageFunctions generates the methods getting and setting the
+// natural language map of the property by language tag, and negotiating the
+// language of its value.
+func generateLanguageFunctions(t *defs.PropertyType, this *defs.StructDef, i *defs.InterfaceDef) {
+	title := strings.Title(t.Name)
+	fns := []*defs.FunctionDef{
+		{
+			Name:    fmt.Sprintf("Get%sLanguage", title),
+			Comment: fmt.Sprintf("Get%sLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.", title),
+			Args:    []*defs.FunctionVarDef{{Name: "tag", Type: "string"}},
+			Return:  []*defs.FunctionVarDef{{Name: "v", Type: "string"}, {Name: "ok", Type: "bool"}},
+			Body: func() string {
+				return fmt.Sprintf("k, ok := languageKey(t.%sMap, tag)\nif !ok {\nreturn \"\", false\n}\nreturn t.%sMap[k], true\n", t.Name, t.Name)
+			},
+		},
+		{
+			Name:    fmt.Sprintf("Set%sLanguage", title),
+			Comment: fmt.Sprintf("Set%sLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.", title),
+			Args:    []*defs.FunctionVarDef{{Name: "tag", Type: "string"}, {Name: "v", Type: "string"}},
+			Body: func() string {
+				return fmt.Sprintf("if t.%sMap == nil {\nt.%sMap = make(map[string]string)\n} else if k, ok := languageKey(t.%sMap, tag); ok {\ndelete(t.%sMap, k)\n}\nt.%sMap[tag] = v\n", t.Name, t.Name, t.Name, t.Name, t.Name)
+			},
+		},
+		{
+			Name:    fmt.Sprintf("Preferred%sLanguage", title),
+			Comment: fmt.Sprintf("Preferred%sLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as \"en-GB\" also matches \"en\". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.", title),
+			Args:    []*defs.FunctionVarDef{{Name: "tags", Type: "...string"}},
+			Return:  []*defs.FunctionVarDef{{Name: "v", Type: "string"}, {Name: "tag", Type: "string"}},
+			Body: func() string {
+				return fmt.Sprintf("tag, _ = preferredLanguage(t.%sMap, tags)\nreturn t.%sMap[tag], tag\n", t.Name, t.Name)
+			},
+		},
+	}
+	for _, fn := range fns {
+		this.F = append(this.F, &defs.MemberFunctionDef{
+			Name:    fn.Name,
+			Comment: fn.Comment,
+			P:       this,
+			Args:    fn.Args,
+			Return:  fn.Return,
+			Body:    fn.Body,
+		})
+		i.F = append(i.F, &defs.FunctionDef{
+			Name:    fn.Name,
+			Comment: fn.Comment,
+			Args:    fn.Args,
+			Return:  fn.Return,
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+const intermediateFileName = "gen_intermediate.go"
+
+// fileJob generates a single file.
+type fileJob func() (*File, error)
+
+// packageJob renders and formats the package definition as the named file.
+func packageJob(name string, p *defs.PackageDef) fileJob {
+	return func() (*File, error) {
+		b, err := format.Source([]byte(p.Generate()))
+		if err != nil {
+			return nil, err
+		}
+		return &File{
+			Name:    name,
+			Content: b,
+		}, nil
+	}
+}
+
+// intermediatePackage returns the package definition of the intermediate
+// definitions, sorted by name so that the file is the same in every run.
+func intermediatePackage(m map[*defs.PropertyType]*intermedDef, imports []string) *defs.PackageDef {
+	sorted := make([]*intermedDef, 0, len(m))
+	for _, v := range m {
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].S.Typename < sorted[j].S.Typename
+	})
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: imports,
+	}
+	for _, v := range sorted {
+		p.F = append(p.F, v.F...)
+		p.Defs = append(p.Defs, v.S)
+	}
+	return p
+}
+
+// workers returns the number of goroutines to run the file jobs with.
+func workers() int {
+	if options.Workers > 0 {
+		return options.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// runFileJobs runs the jobs across worker goroutines. The files are in the
+// order of the jobs, whichever finishes first, and the error is that of the
+// first failing job in that order, so that the output is the same in every run.
+func runFileJobs(jobs []fileJob) ([]*File, error) {
+	files := make([]*File, len(jobs))
+	errs := make([]error, len(jobs))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	n := workers()
+	if n > len(jobs) {
+		n = len(jobs)
+	}
+	for w := 0; w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				files[i], errs[i] = jobs[i]()
+			}
+		}()
+	}
+	for i := range jobs {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,109 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const (
+	poolFileName            = "gen_pool.go"
+	getSerializeMapFnName   = "getSerializeMap"
+	getSerializeSliceFnName = "getSerializeSlice"
+	putSerializeSliceFnName = "putSerializeSlice"
+	releaseSerializedFnName = "ReleaseSerialized"
+)
+
+// poolCode is the pools and helpers used by the pooled Serialize methods.
+const poolCode = `var (
+	serializeMapPool = sync.Pool{
+		New: func() interface{} {
+			return make(map[string]interface{})
+		},
+	}
+	serializeSlicePool = sync.Pool{
+		New: func() interface{} {
+			return make([]interface{}, 0, 1)
+		},
+	}
+)
+
+// getSerializeMap returns an empty map from the pool.
+func getSerializeMap() map[string]interface{} {
+	return serializeMapPool.Get().(map[string]interface{})
+}
+
+// getSerializeSlice returns an empty slice from the pool.
+func getSerializeSlice() []interface{} {
+	return serializeSlicePool.Get().([]interface{})
+}
+
+// putSerializeSlice empties the slice and returns it to the pool.
+func putSerializeSlice(s []interface{}) {
+	for i := range s {
+		s[i] = nil
+	}
+	serializeSlicePool.Put(s[:0])
+}
+
+// ReleaseSerialized returns the maps and slices of a value returned by
+// Serialize to the pools they were taken from, so later calls to Serialize
+// reuse them instead of allocating. Neither the value nor anything within it
+// may be used afterwards.
+func ReleaseSerialized(m map[string]interface{}) {
+	for k, v := range m {
+		releaseSerializedValue(v)
+		delete(m, k)
+	}
+	serializeMapPool.Put(m)
+}
+
+// releaseSerializedValue returns the maps and slices within the value to the
+// pools.
+func releaseSerializedValue(v interface{}) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		ReleaseSerialized(x)
+	case []interface{}:
+		for _, e := range x {
+			releaseSerializedValue(e)
+		}
+		putSerializeSlice(x)
+	}
+}`
+
+// generatePoolFile generates the pools used when serializing with
+// PooledSerialize.
+func generatePoolFile() (*File, error) {
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"sync"},
+		Raw:     poolCode,
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    poolFileName,
+		Content: c,
+	}, nil
+}
+
+// newSerializeMap is the expression creating the map Serialize returns.
+func newSerializeMap() string {
+	if options.PooledSerialize {
+		return fmt.Sprintf("%s()", getSerializeMapFnName)
+	}
+	return "make(map[string]interface{})"
+}
+
+// ownedSerializeValue is the expression for a value placed into the map
+// Serialize returns when the type does not own it, such as an unknown value.
+// When pooling, it is copied so that releasing the map cannot release it.
+func ownedSerializeValue(expr string) string {
+	if options.PooledSerialize {
+		return fmt.Sprintf("%s(%s)", cloneValueFnName, expr)
+	}
+	return expr
+}
@@ -0,0 +1,238 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"regexp"
+	"strings"
+)
+
+const (
+	presentMember      = "present_"
+	markPresentFnName  = "markPresent_"
+	markAllPresentName = "markAllPresent_"
+	deserializeFnName  = "Deserialize"
+	serializeFnName    = "Serialize"
+	presenceBits       = 64
+)
+
+var (
+	// assignedMember matches a member of the type being assigned to,
+	// including alongside an error, and whether it is assigned nil.
+	assignedMember = regexp.MustCompile(`\bt\.(\w+)(\s*,\s*\w+)?\s*=(?:\s*(nil)$|[^=])`)
+	// nilCheckedMember matches a member of the type being compared to nil.
+	nilCheckedMember = regexp.MustCompile(`\bt\.(\w+) != nil`)
+	// referencedMember matches a member of the type.
+	referencedMember = regexp.MustCompile(`\bt\.(\w+)`)
+)
+
+// presence assigns the members of a type holding properties their bits in the
+// presence bitset of the type.
+type presence struct {
+	bits   map[string]int
+	slices map[string]bool
+}
+
+// test returns the expression testing whether the member is set.
+func (p presence) test(member string) string {
+	i := p.bits[member]
+	return fmt.Sprintf("t.%s[%d]&(1<<%d) != 0", presentMember, i/presenceBits, i%presenceBits)
+}
+
+// mark returns the statement updating the bit of the member to whether it
+// holds a value, as after deserializing.
+func (p presence) mark(member string) string {
+	return fmt.Sprintf("t.%s(%d, t.%s != nil)\n", markPresentFnName, p.bits[member], member)
+}
+
+// assign returns the statement updating the bit of the member after it is
+// assigned: a property explicitly set is present even when set to its zero
+// value, and only assigning nil, or removing every value of a non-functional
+// property, makes it absent.
+func (p presence) assign(member string, isNil bool) string {
+	set := "true"
+	if isNil {
+		set = "false"
+	} else if p.slices[member] {
+		set = fmt.Sprintf("len(t.%s) > 0", member)
+	}
+	return fmt.Sprintf("t.%s(%d, %s)\n", markPresentFnName, p.bits[member], set)
+}
+
+// generatePresence adds a bitset to the type tracking which of its properties
+// are set, so that the Has and Is functions, and Serialize skipping unset
+// properties, are bit tests instead of comparing dozens of members to nil. It
+// is applied once every function of the type is generated: the functions
+// assigning to members update their bits before returning, Deserialize and
+// DecodeJSON update every bit, and comparisons of the members to nil in the
+// Has and Is functions become bit tests.
+func generatePresence(this *defs.StructDef) {
+	p := presence{bits: make(map[string]int), slices: make(map[string]bool)}
+	for _, m := range this.M {
+		if m.Name != "unknown_" && m.Name != orderMember {
+			p.bits[m.Name] = len(p.bits)
+			p.slices[m.Name] = strings.HasPrefix(m.Type, "[]")
+		}
+	}
+	if len(p.bits) == 0 {
+		return
+	}
+	words := (len(p.bits) + presenceBits - 1) / presenceBits
+	this.M = append(this.M, &defs.StructMember{
+		Name:    presentMember,
+		Type:    fmt.Sprintf("[%d]uint64", words),
+		Comment: "The bits of the members that are set, in the order of the members.",
+	})
+	for _, f := range this.F {
+		f := f
+		body := f.Body
+		switch {
+		case f.Name == deserializeFnName || f.Name == decodeJSONMethodName:
+			f.Body = func() string {
+				return fmt.Sprintf("defer t.%s()\n", markAllPresentName) + body()
+			}
+		case f.Name == deserializePropertyFnName:
+			// Deserialize and DecodeJSON update every bit after it.
+		case f.Name == serializeFnName:
+			f.Body = func() string {
+				return markAssigned(skipUnsetBlocks(body(), p), p)
+			}
+		case strings.HasPrefix(f.Name, "Has") || strings.HasPrefix(f.Name, "Is"):
+			f.Body = func() string {
+				return testNilChecks(body(), p)
+			}
+		default:
+			f.Body = func() string {
+				return markAssigned(body(), p)
+			}
+		}
+	}
+	this.F = append(this.F, &defs.MemberFunctionDef{
+		Name:    markPresentFnName,
+		Comment: "markPresent_ sets or clears the bit of a member in the presence bitset.",
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"i", "uint"}, {"set", "bool"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("if set {\n")
+			b.WriteString(fmt.Sprintf("t.%s[i/%d] |= 1 << (i %% %d)\n", presentMember, presenceBits, presenceBits))
+			b.WriteString("} else {\n")
+			b.WriteString(fmt.Sprintf("t.%s[i/%d] &^= 1 << (i %% %d)\n", presentMember, presenceBits, presenceBits))
+			b.WriteString("}\n")
+			return b.String()
+		},
+	}, &defs.MemberFunctionDef{
+		Name:    markAllPresentName,
+		Comment: "markAllPresent_ sets the bits of every member in the presence bitset that is set, and clears the others.",
+		P:       this,
+		Body: func() string {
+			var b bytes.Buffer
+			for _, m := range this.M {
+				if isMember(p, m.Name) {
+					b.WriteString(p.mark(m.Name))
+				}
+			}
+			return b.String()
+		},
+	})
+}
+
+// markAssigned updates the bits of the members the body assigns to, so that
+// the Has functions need only test them. The bit is updated after the line of
+// an assignment, or before each return of the body and at its end if the
+// assignment spans several lines.
+func markAssigned(body string, p presence) string {
+	var deferred bytes.Buffer
+	seen := make(map[string]bool)
+	lines := strings.Split(strings.TrimRight(body, "\n"), "\n")
+	after := make([]string, len(lines))
+	for i, line := range lines {
+		for _, m := range assignedMember.FindAllStringSubmatch(line, -1) {
+			if !isMember(p, m[1]) {
+				continue
+			} else if isBalanced(line) {
+				after[i] += p.assign(m[1], len(m[3]) > 0)
+			} else if !seen[m[1]] {
+				seen[m[1]] = true
+				deferred.WriteString(p.assign(m[1], false))
+			}
+		}
+	}
+	var b bytes.Buffer
+	for i, line := range lines {
+		if deferred.Len() > 0 && strings.HasPrefix(strings.TrimSpace(line), "return") {
+			b.WriteString(deferred.String())
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		b.WriteString(after[i])
+	}
+	if deferred.Len() > 0 && !strings.HasPrefix(strings.TrimSpace(lines[len(lines)-1]), "return") {
+		b.WriteString(deferred.String())
+	}
+	return b.String()
+}
+
+// isBalanced determines whether the line closes every bracket it opens, so
+// that a statement can follow it.
+func isBalanced(line string) bool {
+	depth := 0
+	for _, c := range line {
+		switch c {
+		case '(', '{', '[':
+			depth++
+		case ')', '}', ']':
+			depth--
+		}
+	}
+	return depth == 0
+}
+
+// skipUnsetBlocks puts each block of the body serializing a property, which
+// are delimited by 'Begin generation by' and 'End generation by' comments, in
+// a bit test of the member it serializes.
+func skipUnsetBlocks(body string, p presence) string {
+	var b, block bytes.Buffer
+	inBlock := false
+	for _, line := range strings.SplitAfter(body, "\n") {
+		switch {
+		case strings.HasPrefix(line, "// Begin generation by"):
+			inBlock = true
+			block.Reset()
+			block.WriteString(line)
+		case inBlock && strings.HasPrefix(line, "// End generation by"):
+			inBlock = false
+			block.WriteString(line)
+			if m := referencedMember.FindStringSubmatch(block.String()); m != nil && isMember(p, m[1]) {
+				b.WriteString(fmt.Sprintf("if %s {\n", p.test(m[1])))
+				b.Write(block.Bytes())
+				b.WriteString("}\n")
+			} else {
+				b.Write(block.Bytes())
+			}
+		case inBlock:
+			block.WriteString(line)
+		default:
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+// isMember determines whether the member has a bit.
+func isMember(p presence, member string) bool {
+	_, ok := p.bits[member]
+	return ok
+}
+
+// testNilChecks replaces comparisons of the members to nil with bit tests.
+func testNilChecks(body string, p presence) string {
+	return nilCheckedMember.ReplaceAllStringFunc(body, func(s string) string {
+		member := nilCheckedMember.FindStringSubmatch(s)[1]
+		if !isMember(p, member) {
+			return s
+		}
+		return p.test(member)
+	})
+}
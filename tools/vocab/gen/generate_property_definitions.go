@@ -103,6 +103,7 @@ func generateNaturalLanguageMap(t *defs.PropertyType, this *defs.StructDef, i *d
 			Args:    []*defs.FunctionVarDef{{"l", "string"}, {"v", "string"}},
 		},
 	}...)
+	generateLanguageFunctions(t, this, i)
 	var b bytes.Buffer
 	b.WriteString("// Begin generation by generateNaturalLanguageMap\n")
 	b.WriteString(fmt.Sprintf("if k == \"%sMap\" {\n", t.Name))
@@ -189,7 +190,7 @@ func generateFunctionalAnyDefinition(t *defs.PropertyType, this *defs.StructDef,
 	var bs bytes.Buffer
 	bs.WriteString("// Begin generation by generateFunctionalAnyDefinition\n")
 	bs.WriteString(fmt.Sprintf("if t.%s != nil {\n", anyMember.Name))
-	bs.WriteString(fmt.Sprintf("m[\"%s\"] = t.%s\n", t.Name, anyMember.Name))
+	bs.WriteString(fmt.Sprintf("m[\"%s\"] = %s\n", t.Name, ownedSerializeValue("t."+anyMember.Name)))
 	bs.WriteString("}\n")
 	bs.WriteString("// End generation by generateFunctionalAnyDefinition\n")
 	s = bs.String()
@@ -303,9 +304,9 @@ func generateNonFunctionalAnyDefinition(t *defs.PropertyType, this *defs.StructD
 	bs.WriteString("// Begin generation by generateNonFunctionalAnyDefinition\n")
 	bs.WriteString(fmt.Sprintf("if t.%s != nil {\n", anyMember.Name))
 	bs.WriteString(fmt.Sprintf("if len(t.%s) == 1 {\n", anyMember.Name))
-	bs.WriteString(fmt.Sprintf("m[\"%s\"] = t.%s[0]\n", t.Name, anyMember.Name))
+	bs.WriteString(fmt.Sprintf("m[\"%s\"] = %s\n", t.Name, ownedSerializeValue("t."+anyMember.Name+"[0]")))
 	bs.WriteString("} else {\n")
-	bs.WriteString(fmt.Sprintf("m[\"%s\"] = t.%s\n", t.Name, anyMember.Name))
+	bs.WriteString(fmt.Sprintf("m[\"%s\"] = %s\n", t.Name, ownedSerializeValue("t."+anyMember.Name)))
 	bs.WriteString("}\n")
 	bs.WriteString("}\n")
 	bs.WriteString("// End generation by generateNonFunctionalAnyDefinition\n")
@@ -584,6 +585,7 @@ func generateNonFunctionalSingleTypeDefinition(t *defs.PropertyType, this *defs.
 			Args:    []*defs.FunctionVarDef{{"i", "interface{}"}},
 		},
 	}...)
+	generateValuesFunction(this, i, titleName, "", returnType)
 	d = Deserialize(t.Range[0], t.Name, member.Name, true)
 	s = Serialize(t.Range[0], t.Name, member.Name, true)
 	return
@@ -875,39 +877,23 @@ func generateNonFunctionalMultiTypeDefinition(t *defs.PropertyType, this *defs.S
 				Args:    []*defs.FunctionVarDef{{"index", "int"}},
 			},
 		}...)
+		generateValuesFunction(this, i, titleName, typeExtensionName, retKind)
 	}
 	var b bytes.Buffer
 	b.WriteString("// Begin generation by generateNonFunctionalMultiTypeDefinition\n")
 	b.WriteString(fmt.Sprintf("if k == \"%s\" {\n", t.Name))
-	b.WriteString("if tmpMap, ok := v.(map[string]interface{}); ok {\n")
-	b.WriteString(fmt.Sprintf("tmp, err := deserialize%s(tmpMap)\n", strings.Title(intermed.Typename)))
+	b.WriteString(fmt.Sprintf("t.%s, err = deserializeValues%s(v)\n", thisIntermed.Name, strings.Title(intermed.Typename)))
 	b.WriteString("if err != nil {\nreturn err\n}\n")
-	b.WriteString(fmt.Sprintf("t.%s = []*%s{tmp}\n", thisIntermed.Name, intermed.Typename))
 	b.WriteString("handled = true\n")
-	b.WriteString("} else if tmpSlice, ok := v.([]interface{}); ok {\n")
-	b.WriteString(fmt.Sprintf("t.%s, err = deserializeSlice%s(tmpSlice)\n", thisIntermed.Name, strings.Title(intermed.Typename)))
-	b.WriteString("if err != nil {\nreturn err\n}\n")
-	b.WriteString("handled = true\n")
-	b.WriteString("} else {\n")
-	b.WriteString(fmt.Sprintf("tmp := &%s{}\n", intermed.Typename))
-	b.WriteString("err = tmp.Deserialize(v)\n")
-	b.WriteString("if err != nil {\nreturn err\n}\n")
-	b.WriteString(fmt.Sprintf("t.%s = []*%s{tmp}\n", thisIntermed.Name, intermed.Typename))
-	b.WriteString("handled = true\n")
-	b.WriteString("}\n")
 	b.WriteString("}\n")
 	b.WriteString("// End generation by generateNonFunctionalMultiTypeDefinition\n")
 	d = b.String()
 	var bs bytes.Buffer
 	bs.WriteString("// Begin generation by generateNonFunctionalMultiTypeDefinition\n")
-	bs.WriteString(fmt.Sprintf("if v, err := serializeSlice%s(t.%s); err == nil && v != nil {\n", strings.Title(intermed.Typename), thisIntermed.Name))
-	bs.WriteString("if len(v) == 1 {\n")
-	bs.WriteString(fmt.Sprintf("m[\"%s\"] = v[0]\n", t.Name))
-	bs.WriteString("} else {\n")
-	bs.WriteString(fmt.Sprintf("m[\"%s\"] = v\n", t.Name))
-	bs.WriteString("}\n")
-	bs.WriteString("} else if err != nil {\n")
+	bs.WriteString(fmt.Sprintf("if v, err := serializeSlice%s(t.%s); err != nil {\n", strings.Title(intermed.Typename), thisIntermed.Name))
 	bs.WriteString("return m, err\n")
+	bs.WriteString("} else if v != nil {\n")
+	bs.WriteString(fmt.Sprintf("%s(m, \"%s\", v)\n", setSerializedValuesFnName, t.Name))
 	bs.WriteString("}\n")
 	bs.WriteString("// End generation by generateNonFunctionalMultiTypeDefinition\n")
 	s = bs.String()
@@ -1087,16 +1073,37 @@ func generateIntermediateTypeDefinition(types []*defs.PropertyType) (d *intermed
 			Return:  []*defs.FunctionVarDef{{"t", "[]*" + d.S.Typename}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
-				b.WriteString("for _, i := range in {\n")
-				b.WriteString(fmt.Sprintf("tmp := &%s{}\n", d.S.Typename))
+				// Allocate the values together rather than one at a time,
+				// leaving the slice nil if there are none.
+				b.WriteString("if len(in) == 0 {\nreturn\n}\n")
+				b.WriteString(fmt.Sprintf("backing := make([]%s, len(in))\n", d.S.Typename))
+				b.WriteString(fmt.Sprintf("t = make([]*%s, 0, len(in))\n", d.S.Typename))
+				b.WriteString("for idx, i := range in {\n")
+				b.WriteString("tmp := &backing[idx]\n")
 				b.WriteString("err = tmp.Deserialize(i)\n")
-				b.WriteString("if err != nil {\nreturn\n}\n")
+				b.WriteString(fmt.Sprintf("if err != nil {\nerr = %s(fmt.Sprintf(\"[%%d]\", idx), err)\nreturn\n}\n", deserializeErrorAtFnName))
 				b.WriteString("t = append(t, tmp)\n")
 				b.WriteString("}\n")
 				b.WriteString("return\n")
 				return b.String()
 			},
 		},
+		{
+			Name:    fmt.Sprintf("deserializeValues%s", strings.Title(d.S.Typename)),
+			Comment: fmt.Sprintf("deserializeValues%s will accept a single value or a slice of them to create a slice of %s, shared by the Deserialize functions of every type with the property", d.S.Typename, d.S.Typename),
+			Args:    []*defs.FunctionVarDef{{"in", "interface{}"}},
+			Return:  []*defs.FunctionVarDef{{"t", "[]*" + d.S.Typename}, {"err", "error"}},
+			Body: func() string {
+				var b bytes.Buffer
+				b.WriteString("if s, ok := in.([]interface{}); ok {\n")
+				b.WriteString(fmt.Sprintf("return deserializeSlice%s(s)\n", strings.Title(d.S.Typename)))
+				b.WriteString("}\n")
+				b.WriteString(fmt.Sprintf("tmp, err := deserialize%s(in)\n", strings.Title(d.S.Typename)))
+				b.WriteString("if err != nil {\nreturn\n}\n")
+				b.WriteString(fmt.Sprintf("return []*%s{tmp}, nil\n", d.S.Typename))
+				return b.String()
+			},
+		},
 		{
 			Name:    fmt.Sprintf("serialize%s", strings.Title(d.S.Typename)),
 			Comment: fmt.Sprintf("serialize%s will accept a %s to create a map", d.S.Typename, d.S.Typename),
@@ -1116,6 +1123,11 @@ func generateIntermediateTypeDefinition(types []*defs.PropertyType) (d *intermed
 			Return:  []*defs.FunctionVarDef{{"out", "[]interface{}"}, {"err", "error"}},
 			Body: func() string {
 				var b bytes.Buffer
+				if options.PooledSerialize {
+					b.WriteString("if len(s) > 0 {\n")
+					b.WriteString(fmt.Sprintf("out = %s()\n", getSerializeSliceFnName))
+					b.WriteString("}\n")
+				}
 				b.WriteString("for _, t := range s {\n")
 				b.WriteString("v, err := t.Serialize()\n")
 				b.WriteString("if err != nil {\nreturn nil, err\n}\n")
@@ -1141,6 +1153,7 @@ func generateIntermediateTypeDefinition(types []*defs.PropertyType) (d *intermed
 			})
 		}
 	}
+	generateIntermediateStrictFunction(d, types)
 	return
 }
 
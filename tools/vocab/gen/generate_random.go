@@ -0,0 +1,211 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+const (
+	randomFileName  = "gen_random.go"
+	randomTableName = "randomTypes"
+)
+
+// randomCode is the type-independent part of the random value generator.
+const randomCode = `// randomMaxDepth limits how deeply random values are nested within each
+// other. Beyond it, only IRIs and other non-type values are generated.
+const randomMaxDepth = 2
+
+var (
+	randomLanguages  = []string{"en", "fr", "de", "ja", "es"}
+	randomMediaTypes = []string{"text/html", "text/markdown", "image/png", "video/mp4"}
+	randomRelations  = []string{"canonical", "preview", "alternate"}
+	randomUnits      = []string{"cm", "feet", "inches", "km", "m", "miles"}
+)
+
+// randomProperty describes a property of a type for generating random values.
+type randomProperty struct {
+	name               string
+	functional         bool
+	naturalLanguageMap bool
+	// ranges are the names of the types and values the property may hold.
+	ranges []string
+}
+
+// randomValue is a type that can be generated randomly.
+type randomValue interface {
+	Serializer
+	Deserializer
+}
+
+// NewRandom creates a random but valid value of the named type. It respects
+// the range of each property and whether it is functional, and always sets
+// the 'id' of an Object and the 'href' of a Link. The same seed always
+// creates the same value, which makes it useful for property-based testing.
+func NewRandom(typeName string, seed int64) (Serializer, error) {
+	return newRandom(rand.New(rand.NewSource(seed)), typeName)
+}
+
+// newRandom creates a random value of the named type using the provided
+// source of randomness.
+func newRandom(r *rand.Rand, typeName string) (Serializer, error) {
+	i := resolveObject(typeName)
+	if i == nil {
+		i = resolveLink(typeName)
+	}
+	v, ok := i.(randomValue)
+	if !ok {
+		return nil, fmt.Errorf("cannot generate random value of unknown type %q", typeName)
+	}
+	if err := v.Deserialize(randomMap(r, typeName, 0)); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// randomMap creates the generic map form of a random value of the named type.
+func randomMap(r *rand.Rand, typeName string, depth int) map[string]interface{} {
+	m := map[string]interface{}{"type": typeName}
+	for _, p := range ` + randomTableName + `[typeName] {
+		if p.name == "type" {
+			continue
+		} else if p.name == "id" || p.name == "href" {
+			m[p.name] = randomIRI(r)
+			continue
+		} else if r.Intn(depth+2) != 0 {
+			continue
+		}
+		if p.naturalLanguageMap && r.Intn(2) == 0 {
+			m[p.name+"Map"] = map[string]interface{}{
+				randomLanguages[r.Intn(len(randomLanguages))]: randomString(r),
+			}
+		} else if p.functional {
+			m[p.name] = randomRange(r, p, depth)
+		} else if n := r.Intn(3); n == 0 {
+			m[p.name] = randomRange(r, p, depth)
+		} else {
+			s := make([]interface{}, n+1)
+			for i := range s {
+				s[i] = randomRange(r, p, depth)
+			}
+			m[p.name] = s
+		}
+	}
+	return m
+}
+
+// randomRange creates a random value for one of the property's ranges.
+func randomRange(r *rand.Rand, p randomProperty, depth int) interface{} {
+	ranges := p.ranges
+	if depth >= randomMaxDepth {
+		ranges = nil
+		for _, name := range p.ranges {
+			if _, ok := ` + randomTableName + `[name]; !ok {
+				ranges = append(ranges, name)
+			}
+		}
+	}
+	if len(ranges) == 0 {
+		return randomIRI(r)
+	}
+	name := ranges[r.Intn(len(ranges))]
+	if _, ok := ` + randomTableName + `[name]; ok {
+		return randomMap(r, name, depth+1)
+	}
+	switch name {
+	case "dateTime":
+		return time.Unix(r.Int63n(4000000000), 0).UTC().Format(time.RFC3339)
+	case "boolean":
+		return r.Intn(2) == 0
+	case "float":
+		return float64(r.Intn(2000000)-1000000) / 1000
+	case "duration":
+		return fmt.Sprintf("PT%dS", 1+r.Intn(86400))
+	case "nonNegativeInteger":
+		return float64(r.Intn(1000))
+	case "bcp47LanguageTag":
+		return randomLanguages[r.Intn(len(randomLanguages))]
+	case "mimeMediaTypeValue":
+		return randomMediaTypes[r.Intn(len(randomMediaTypes))]
+	case "linkRelation":
+		return randomRelations[r.Intn(len(randomRelations))]
+	case "unitsValue":
+		return randomUnits[r.Intn(len(randomUnits))]
+	case "string", "langString":
+		return randomString(r)
+	default:
+		return randomIRI(r)
+	}
+}
+
+// randomIRI creates a random IRI.
+func randomIRI(r *rand.Rand) string {
+	return fmt.Sprintf("https://example.com/%d", r.Int63())
+}
+
+// randomString creates a random non-empty string of lowercase letters.
+func randomString(r *rand.Rand) string {
+	b := make([]byte, 1+r.Intn(16))
+	for i := range b {
+		b[i] = byte('a' + r.Intn(26))
+	}
+	return string(b)
+}`
+
+// generateRandomFile generates the random value generator for the given types.
+func generateRandomFile(types []*defs.Type) (*File, error) {
+	sorted := make([]*defs.Type, len(types))
+	copy(sorted, types)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	var b bytes.Buffer
+	b.WriteString(randomCode)
+	b.WriteString("\n\n")
+	b.WriteString(fmt.Sprintf("// %s describes the properties of each type for generating random values.\n", randomTableName))
+	b.WriteString(fmt.Sprintf("var %s = map[string][]randomProperty{\n", randomTableName))
+	for _, t := range sorted {
+		b.WriteString(fmt.Sprintf("%q: {\n", t.Name))
+		for _, p := range t.GetProperties() {
+			b.WriteString(fmt.Sprintf("{%q, %v, %v, []string{%s}},\n", p.Name, p.Functional, p.NaturalLanguageMap, randomRangeNames(p)))
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"fmt", "math/rand", "time"},
+		Raw:     b.String(),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    randomFileName,
+		Content: c,
+	}, nil
+}
+
+// randomRangeNames lists the quoted, unique names of the types and values in
+// a property's range. A range of any value is treated as an IRI.
+func randomRangeNames(p *defs.PropertyType) string {
+	var names []string
+	set := make(map[string]bool)
+	for _, r := range p.Range {
+		name := defs.IriValueType.Name
+		if r.T != nil {
+			name = r.T.Name
+		} else if r.V != nil {
+			name = r.V.Name
+		}
+		if !set[name] {
+			set[name] = true
+			names = append(names, fmt.Sprintf("%q", name))
+		}
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,44 @@
+package gen
+
+import (
+	"fmt"
+)
+
+const acceptStringScalarsName = "AcceptStringScalars"
+
+// acceptStringScalarsCode configures whether the xsd:boolean, xsd:float, and
+// xsd:nonNegativeInteger values are also accepted as JSON strings.
+const acceptStringScalarsCode = `// AcceptStringScalars is whether the xsd:boolean, xsd:float, and
+// xsd:nonNegativeInteger values of properties are also accepted as JSON
+// strings when they are deserialized, such as "true" or "12", as some servers
+// serialize them. It is true by default. Applications that require the values
+// to be JSON booleans and numbers may set it to false before they deserialize
+// any value. Values are always serialized as JSON booleans and numbers.
+var AcceptStringScalars = true`
+
+// stringScalarFnTemplate is formatted with the AcceptStringScalars it obeys.
+const stringScalarFnTemplate = `// stringScalar returns the JSON boolean or number that a string holds, if
+// AcceptStringScalars allows it, or the value as is.
+func stringScalar(v interface{}) interface{} {
+	s, ok := v.(string)
+	if !ok || !%s {
+		return v
+	}
+	switch s = strings.TrimSpace(s); s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	return v
+}`
+
+// stringScalarCode returns the stringScalar function obeying the
+// AcceptStringScalars of the package prefix, which the deserialize functions of
+// the boolean and numeric values call first.
+func stringScalarCode(prefix string) string {
+	return fmt.Sprintf(stringScalarFnTemplate, prefix+acceptStringScalarsName)
+}
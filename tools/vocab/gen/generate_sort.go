@@ -0,0 +1,156 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+)
+
+const (
+	sortFileName      = "gen_sort.go"
+	sortAdapterSuffix = "Slice"
+)
+
+// compareCode is the comparisons the sort.Interface adapters of the types are
+// built with. It is formatted with the name of the canonical encoding function.
+const compareCode = `// ComparePublished orders values by their published dates, earliest first.
+// Values without a published date, such as those with only an IRI for it, are
+// ordered after the values with one. It returns a negative number if a is
+// before b, a positive number if a is after b, and zero otherwise.
+func ComparePublished(a, b Serializer) int {
+	at, aok := publishedOf(a)
+	bt, bok := publishedOf(b)
+	switch {
+	case !aok && !bok:
+		return 0
+	case !aok:
+		return 1
+	case !bok:
+		return -1
+	case at.Before(bt):
+		return -1
+	case at.After(bt):
+		return 1
+	}
+	return 0
+}
+
+// publishedOf returns the published date of the value, if it has one.
+func publishedOf(s Serializer) (t time.Time, ok bool) {
+	p, is := s.(interface {
+		IsPublished() bool
+		GetPublished() time.Time
+	})
+	if !is || !p.IsPublished() {
+		return
+	}
+	return p.GetPublished(), true
+}
+
+// CompareId orders values by their ids as strings. Values without an id are
+// ordered after the values with one. It returns a negative number if a is
+// before b, a positive number if a is after b, and zero otherwise.
+func CompareId(a, b Serializer) int {
+	as, aok := idOf(a)
+	bs, bok := idOf(b)
+	switch {
+	case !aok && !bok:
+		return 0
+	case !aok:
+		return 1
+	case !bok:
+		return -1
+	}
+	return strings.Compare(as, bs)
+}
+
+// idOf returns the id of the value as a string, if it has one.
+func idOf(s Serializer) (id string, ok bool) {
+	i, is := s.(interface {
+		HasId() bool
+		GetId() *url.URL
+	})
+	if !is || !i.HasId() {
+		return
+	}
+	return i.GetId().String(), true
+}
+
+// CompareCanonical orders values by their canonical serialized forms, so that
+// sorting gives the same order however the values were built. Values that
+// cannot be serialized are ordered after the values that can. It returns a
+// negative number if a is before b, a positive number if a is after b, and zero
+// otherwise.
+func CompareCanonical(a, b Serializer) int {
+	ab, aerr := %[1]s(a)
+	bb, berr := %[1]s(b)
+	switch {
+	case aerr != nil && berr != nil:
+		return 0
+	case aerr != nil:
+		return 1
+	case berr != nil:
+		return -1
+	}
+	return bytes.Compare(ab, bb)
+}`
+
+// sortAdapterCode is the sort.Interface adapter of a single type. It is
+// formatted with the name of the type and the name of the adapter.
+const sortAdapterCode = `// %[2]s sorts a slice of %[1]s values with a comparison such as
+// ComparePublished, CompareId, or CompareCanonical:
+//
+//	sort.Stable(%[2]s{Values: s, Compare: ComparePublished})
+type %[2]s struct {
+	// Values are the values being sorted.
+	Values []*%[1]s
+	// Compare orders two of the values, returning a negative number if the
+	// first is before the second.
+	Compare func(a, b Serializer) int
+}
+
+// Len is the number of values.
+func (s %[2]s) Len() int {
+	return len(s.Values)
+}
+
+// Less determines whether the value at i is ordered before the value at j.
+func (s %[2]s) Less(i, j int) bool {
+	return s.Compare(s.Values[i], s.Values[j]) < 0
+}
+
+// Swap swaps the values at i and j.
+func (s %[2]s) Swap(i, j int) {
+	s.Values[i], s.Values[j] = s.Values[j], s.Values[i]
+}`
+
+// sortAdapter is the name of the sort.Interface adapter of the type.
+func sortAdapter(t *defs.Type) string {
+	return t.Name + sortAdapterSuffix
+}
+
+// generateSortFile generates the comparisons of values and the sort.Interface
+// adapters of slices of the types, so that ordered collections can be sorted
+// by published date, id, or canonical form.
+func generateSortFile(types []*defs.Type) (*File, error) {
+	var b bytes.Buffer
+	b.WriteString(fmt.Sprintf(compareCode, canonicalJSONFnName))
+	for _, t := range types {
+		b.WriteString("\n\n")
+		b.WriteString(fmt.Sprintf(sortAdapterCode, t.Name, sortAdapter(t)))
+	}
+	p := &defs.PackageDef{
+		Name:    packageName(),
+		Imports: []string{"bytes", "net/url", "strings", "time"},
+		Raw:     b.String(),
+	}
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    sortFileName,
+		Content: c,
+	}, nil
+}
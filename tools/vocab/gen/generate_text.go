@@ -0,0 +1,93 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+const textFileName = "gen_text.go"
+
+// textCode is the type of a single value implementing encoding.TextMarshaler
+// and encoding.TextUnmarshaler. It is formatted with the name of the type, the
+// name of the value, the Go type of the value, and the names of its serialize
+// and deserialize functions.
+const textCode = `// %[1]s is a %[2]s value, like those of the properties of the types, that
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, so that it can
+// be read from configuration files and written by logging libraries, and a
+// pointer to it implements flag.Value, so that it can be set by flags.
+type %[1]s %[3]s
+
+// MarshalText returns the serialized form of the %[1]s.
+func (v %[1]s) MarshalText() ([]byte, error) {
+	return []byte(%[4]s(%[3]s(v))), nil
+}
+
+// UnmarshalText deserializes the %[1]s from the text, returning an error if it
+// is not a %[2]s value.
+func (v *%[1]s) UnmarshalText(b []byte) error {
+	return v.Set(string(b))
+}
+
+// String returns the serialized form of the %[1]s.
+func (v %[1]s) String() string {
+	return %[4]s(%[3]s(v))
+}
+
+// Set deserializes the %[1]s from the string, returning an error if it is not
+// a %[2]s value.
+func (v *%[1]s) Set(s string) error {
+	d, err := %[5]s(s)
+	if err != nil {
+		return err
+	}
+	*v = %[1]s(*d)
+	return nil
+}`
+
+// generateTextFile generates the types of the values with a TextName, which
+// encode and decode them as text with their serialize and deserialize
+// functions.
+func generateTextFile(values []*defs.ValueType) (*File, error) {
+	var text []*defs.ValueType
+	for _, v := range values {
+		if len(v.TextName) > 0 {
+			text = append(text, v)
+		}
+	}
+	sort.Slice(text, func(i, j int) bool {
+		return text[i].TextName < text[j].TextName
+	})
+	var b bytes.Buffer
+	imports := make(map[string]bool)
+	for i, v := range text {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(fmt.Sprintf(textCode, v.TextName, v.Name, deref(v.DefinitionType), v.SerializeFn.Name, v.DeserializeFn.Name))
+		// The Go types of the values are builtin or of top-level standard
+		// packages, such as time.Duration.
+		if q := strings.Index(deref(v.DefinitionType), "."); q > 0 {
+			imports[deref(v.DefinitionType)[:q]] = true
+		}
+	}
+	p := &defs.PackageDef{
+		Name: packageName(),
+		Raw:  b.String(),
+	}
+	for i := range imports {
+		p.Imports = append(p.Imports, i)
+	}
+	sort.Strings(p.Imports)
+	c, err := format.Source([]byte(p.Generate()))
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:    textFileName,
+		Content: c,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"strings"
+)
+
+// checkPrefixes are the prefixes of the methods determining whether a getter is
+// safe to call.
+var checkPrefixes = []string{"Is", "Has"}
+
+const (
+	tryPrefix       = "TryGet"
+	getterPrefix    = "Get"
+	lenSuffix       = "Len"
+	accessorErrName = "AccessorError"
+)
+
+// accessorCode is the error returned by the TryGet methods.
+const accessorCode = `// AccessorError is returned by a TryGet method when the property has no value of
+// the requested kind, either because it is not set or because its value is of
+// another kind.
+type AccessorError struct {
+	// Type is the name of the type whose property was accessed.
+	Type string
+	// Getter is the name of the method that would have panicked or returned a
+	// zero value, such as 'GetNameString'.
+	Getter string
+	// Index is the index of the value of a non-functional property, or -1
+	// for a functional property.
+	Index int
+}
+
+// Error describes the missing value.
+func (e *AccessorError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("%s has no value for %s", e.Type, e.Getter)
+	}
+	return fmt.Sprintf("%s has no value for %s at index %d", e.Type, e.Getter, e.Index)
+}`
+
+// generateTryFunctions adds a TryGet counterpart for every getter of the type
+// that has a method determining whether it is safe to call, or whose index can
+// be checked against the number of values, which returns an AccessorError
+// instead of panicking or returning a zero value. For example,
+// GetEndTime has TryGetEndTime and GetNameString has TryGetNameString. The
+// counterparts of the getters of the interface are added to it too.
+func generateTryFunctions(this *defs.StructDef, it *defs.InterfaceDef) {
+	methods := make(map[string]*defs.MemberFunctionDef, len(this.F))
+	for _, f := range this.F {
+		methods[f.Name] = f
+	}
+	inInterface := make(map[string]bool, len(it.F))
+	for _, f := range it.F {
+		inInterface[f.Name] = true
+	}
+	var try []*defs.MemberFunctionDef
+	for _, f := range this.F {
+		if !strings.HasPrefix(f.Name, getterPrefix) || len(f.Return) != 1 {
+			continue
+		}
+		name := strings.TrimPrefix(f.Name, getterPrefix)
+		var check *defs.MemberFunctionDef
+		for _, prefix := range checkPrefixes {
+			if c, ok := methods[prefix+name]; ok && sameArgs(f.Args, c.Args) {
+				check = c
+				break
+			}
+		}
+		var length string
+		if len(f.Args) == 1 {
+			if f.Args[0].Type != "int" {
+				continue
+			} else if length = lenFunction(name, methods); len(length) == 0 {
+				continue
+			}
+		} else if len(f.Args) > 1 || check == nil {
+			continue
+		}
+		tf := generateTryFunction(this, f, check, length)
+		try = append(try, tf)
+		if inInterface[f.Name] {
+			it.F = append(it.F, &defs.FunctionDef{
+				Name:    tf.Name,
+				Comment: tf.Comment,
+				Args:    tf.Args,
+				Return:  tf.Return,
+			})
+		}
+	}
+	this.F = append(this.F, try...)
+}
+
+// sameArgs determines whether the arguments of two methods are the same.
+func sameArgs(a, b []*defs.FunctionVarDef) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Type != b[i].Type {
+			return false
+		}
+	}
+	return true
+}
+
+// lenFunction returns the name of the method returning the number of values of
+// the property whose getter is named for the property and a kind of value,
+// such as 'NameLen' for 'NameString', or an empty string if there is none.
+func lenFunction(name string, methods map[string]*defs.MemberFunctionDef) string {
+	for i := len(name); i > 0; i-- {
+		if _, ok := methods[name[:i]+lenSuffix]; ok {
+			return name[:i] + lenSuffix
+		}
+	}
+	return ""
+}
+
+// generateTryFunction creates the TryGet counterpart of the getter, which
+// checks it is safe to call first, if there is a check. The getter of a
+// non-functional property also has its index checked against the length.
+func generateTryFunction(this *defs.StructDef, getter, check *defs.MemberFunctionDef, length string) *defs.MemberFunctionDef {
+	name := tryPrefix + strings.TrimPrefix(getter.Name, getterPrefix)
+	args := make([]string, 0, len(getter.Args))
+	for _, a := range getter.Args {
+		args = append(args, a.Name)
+	}
+	comment := fmt.Sprintf("%s returns the value %s returns, or an %s if the index is out of range", name, getter.Name, accessorErrName)
+	if check != nil {
+		comment = fmt.Sprintf("%s returns the value %s returns, or an %s if %s returns false", name, getter.Name, accessorErrName, check.Name)
+	}
+	return &defs.MemberFunctionDef{
+		Name:    name,
+		Comment: comment,
+		P:       this,
+		Args:    getter.Args,
+		Return:  []*defs.FunctionVarDef{{Name: "v", Type: getter.Return[0].Type}, {Name: "err", Type: "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			call := strings.Join(args, ", ")
+			if len(length) > 0 {
+				if check != nil {
+					b.WriteString(fmt.Sprintf("if %s < 0 || %s >= t.%s() || !t.%s(%s) {\n", args[0], args[0], length, check.Name, call))
+				} else {
+					b.WriteString(fmt.Sprintf("if %s < 0 || %s >= t.%s() {\n", args[0], args[0], length))
+				}
+				b.WriteString(fmt.Sprintf("err = &%s{Type: %q, Getter: %q, Index: %s}\n", accessorErrName, this.Typename, getter.Name, args[0]))
+			} else {
+				b.WriteString(fmt.Sprintf("if !t.%s() {\n", check.Name))
+				b.WriteString(fmt.Sprintf("err = &%s{Type: %q, Getter: %q, Index: -1}\n", accessorErrName, this.Typename, getter.Name))
+			}
+			b.WriteString("return\n")
+			b.WriteString("}\n")
+			b.WriteString(fmt.Sprintf("return t.%s(%s), nil\n", getter.Name, call))
+			return b.String()
+		},
+	}
+}
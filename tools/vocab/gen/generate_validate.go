@@ -0,0 +1,274 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"strings"
+)
+
+// requiredProperties are the properties that the specifications require on a
+// type and all the types extending it.
+var requiredProperties = []struct {
+	Type     string
+	Property string
+}{
+	{activityRootName, "actor"},
+	{linkName, "href"},
+}
+
+// validationCode is the errors returned by the Validate methods.
+const validationCode = `// MissingPropertyError is returned by Validate when a type lacks a property
+// required by the specification.
+type MissingPropertyError struct {
+	// Type is the name of the type missing the property.
+	Type string
+	// Property is the name of the missing property.
+	Property string
+}
+
+// Error describes the missing property.
+func (e *MissingPropertyError) Error() string {
+	return fmt.Sprintf("%s is missing required property '%s'", e.Type, e.Property)
+}
+
+// UnknownPropertyError is returned by ValidateStrict when a type has a property
+// that is not one of its own.
+type UnknownPropertyError struct {
+	// Type is the name of the type with the property.
+	Type string
+	// Property is the name of the unknown property.
+	Property string
+}
+
+// Error describes the unknown property.
+func (e *UnknownPropertyError) Error() string {
+	return fmt.Sprintf("%s has unknown property '%s'", e.Type, e.Property)
+}
+
+// MismatchedPropertyError is returned by ValidateStrict when a property of a
+// type has a value of none of the types it takes.
+type MismatchedPropertyError struct {
+	// Type is the name of the type with the property.
+	Type string
+	// Property is the name of the property with the mismatched value.
+	Property string
+}
+
+// Error describes the mismatched property.
+func (e *MismatchedPropertyError) Error() string {
+	return fmt.Sprintf("%s property '%s' has a value of the wrong type", e.Type, e.Property)
+}
+
+// InvalidPropertyError is returned by ValidateStrict when a property of a type
+// has an inlined value which is itself invalid.
+type InvalidPropertyError struct {
+	// Type is the name of the type with the property.
+	Type string
+	// Property is the name of the property with the invalid value.
+	Property string
+	// Err are the problems of the value.
+	Err error
+}
+
+// Error describes the invalid property and the problems of its value.
+func (e *InvalidPropertyError) Error() string {
+	return fmt.Sprintf("%s property '%s' is invalid: %s", e.Type, e.Property, e.Err)
+}
+
+// ValidationErrors are all the problems Validate found with a type.
+type ValidationErrors []error
+
+// Error describes all the problems.
+func (v ValidationErrors) Error() string {
+	var b bytes.Buffer
+	for i, err := range v {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}`
+
+// generateValidateFunction adds a Validate method to the type, which returns
+// ValidationErrors listing every required property it is missing.
+func generateValidateFunction(t *defs.Type, this *defs.StructDef, it *defs.InterfaceDef) {
+	var required []*defs.PropertyType
+	for _, r := range requiredProperties {
+		if t.Name != r.Type && !isExtending(t, r.Type) {
+			continue
+		}
+		for _, p := range t.GetProperties() {
+			if p.Name == r.Property {
+				required = append(required, p)
+			}
+		}
+	}
+	f := &defs.MemberFunctionDef{
+		Name:    "Validate",
+		Comment: fmt.Sprintf("Validate returns ValidationErrors listing every property required by the specification that this %s is missing, or nil if it has them all", t.Name),
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			if len(required) == 0 {
+				b.WriteString("return\n")
+				return b.String()
+			}
+			b.WriteString("var errs ValidationErrors\n")
+			for _, p := range required {
+				b.WriteString(fmt.Sprintf("if !(%s) {\n", propertyPresentExpression(p)))
+				b.WriteString(fmt.Sprintf("errs = append(errs, &MissingPropertyError{Type: \"%s\", Property: \"%s\"})\n", t.Name, p.Name))
+				b.WriteString("}\n")
+			}
+			b.WriteString("if len(errs) > 0 {\n")
+			b.WriteString("err = errs\n")
+			b.WriteString("}\n")
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+	this.F = append(this.F, f)
+	it.F = append(it.F, &defs.FunctionDef{
+		Name:    f.Name,
+		Comment: f.Comment,
+		Return:  f.Return,
+	})
+}
+
+// propertyPresentExpression is an expression determining whether the property
+// has any value, including an unknown one.
+func propertyPresentExpression(p *defs.PropertyType) string {
+	member := cleanName(p.Name)
+	var expr string
+	if p.Functional {
+		expr = fmt.Sprintf("t.%s != nil", member)
+	} else {
+		expr = fmt.Sprintf("len(t.%s) > 0", member)
+	}
+	if !isAny(p) && isSingleType(p) {
+		expr += fmt.Sprintf(" || t.unknown_[\"%s\"] != nil", p.Name)
+	}
+	if p.NaturalLanguageMap {
+		expr += fmt.Sprintf(" || len(t.%sMap) > 0", p.Name)
+	}
+	return expr
+}
+
+// generateStrictFunctions adds a ValidateStrict method to the type, which also
+// reports its unknown properties and those with values of the wrong types, and a
+// DeserializeStrict method deserializing it and then validating it so.
+func generateStrictFunctions(t *defs.Type, this *defs.StructDef, it *defs.InterfaceDef) {
+	var single []string
+	for _, p := range t.GetProperties() {
+		if !isAny(p) && isSingleType(p) {
+			single = append(single, fmt.Sprintf("%q", p.Name))
+		}
+	}
+	validate := &defs.MemberFunctionDef{
+		Name:    "ValidateStrict",
+		Comment: fmt.Sprintf("ValidateStrict returns ValidationErrors listing what Validate does, and every unknown property of this %s, every property with a value of none of the types it takes, and every inlined value that is itself invalid, or nil if there are none. It is meant for rejecting values that are not exactly as specified, such as those posted by clients", t.Name),
+		P:       this,
+		Return:  []*defs.FunctionVarDef{{"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("errs, _ := t.Validate().(ValidationErrors)\n")
+			for _, p := range t.GetProperties() {
+				if isAny(p) || isSingleType(p) {
+					continue
+				}
+				member := cleanName(p.Name)
+				if p.Functional {
+					b.WriteString(fmt.Sprintf("if t.%s != nil {\n", member))
+					b.WriteString(fmt.Sprintf("errs = t.%s.strict(errs, \"%s\", \"%s\")\n", member, t.Name, p.Name))
+					b.WriteString("}\n")
+				} else {
+					b.WriteString(fmt.Sprintf("for _, v := range t.%s {\n", member))
+					b.WriteString(fmt.Sprintf("errs = v.strict(errs, \"%s\", \"%s\")\n", t.Name, p.Name))
+					b.WriteString("}\n")
+				}
+			}
+			b.WriteString("keys := make([]string, 0, len(t.unknown_))\n")
+			b.WriteString("for k := range t.unknown_ {\n")
+			b.WriteString("keys = append(keys, k)\n")
+			b.WriteString("}\n")
+			b.WriteString("sort.Strings(keys)\n")
+			b.WriteString("for _, k := range keys {\n")
+			if len(single) > 0 {
+				// Values of the wrong type of properties taking a
+				// single type are kept among the unknown properties.
+				b.WriteString("switch k {\n")
+				b.WriteString(fmt.Sprintf("case %s:\n", strings.Join(single, ", ")))
+				b.WriteString(fmt.Sprintf("errs = append(errs, &MismatchedPropertyError{Type: \"%s\", Property: k})\n", t.Name))
+				b.WriteString("default:\n")
+				b.WriteString(fmt.Sprintf("errs = append(errs, &UnknownPropertyError{Type: \"%s\", Property: k})\n", t.Name))
+				b.WriteString("}\n")
+			} else {
+				b.WriteString(fmt.Sprintf("errs = append(errs, &UnknownPropertyError{Type: \"%s\", Property: k})\n", t.Name))
+			}
+			b.WriteString("}\n")
+			b.WriteString("if len(errs) > 0 {\n")
+			b.WriteString("err = errs\n")
+			b.WriteString("}\n")
+			b.WriteString("return\n")
+			return b.String()
+		},
+	}
+	deserialize := &defs.MemberFunctionDef{
+		Name:    "DeserializeStrict",
+		Comment: fmt.Sprintf("DeserializeStrict populates this %s from a map[string]interface{} like Deserialize, then returns the problems ValidateStrict finds with it, if any", t.Name),
+		P:       this,
+		Args:    []*defs.FunctionVarDef{{"m", "map[string]interface{}"}},
+		Return:  []*defs.FunctionVarDef{{"err", "error"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("if err = t.Deserialize(m); err != nil {\n")
+			b.WriteString("return\n")
+			b.WriteString("}\n")
+			b.WriteString("return t.ValidateStrict()\n")
+			return b.String()
+		},
+	}
+	this.F = append(this.F, validate, deserialize)
+	it.F = append(it.F, &defs.FunctionDef{
+		Name:    validate.Name,
+		Comment: validate.Comment,
+		Return:  validate.Return,
+	})
+}
+
+// generateIntermediateStrictFunction adds a method to the intermediate type
+// appending the problems ValidateStrict reports with a value of the property.
+func generateIntermediateStrictFunction(d *intermedDef, types []*defs.PropertyType) {
+	d.S.F = append(d.S.F, &defs.MemberFunctionDef{
+		Name:    "strict",
+		Comment: "strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.",
+		P:       d.S,
+		Args:    []*defs.FunctionVarDef{{"errs", "ValidationErrors"}, {"typeName", "string"}, {"property", "string"}},
+		Return:  []*defs.FunctionVarDef{{"out", "ValidationErrors"}},
+		Body: func() string {
+			var b bytes.Buffer
+			b.WriteString("if t.unknown_ != nil {\n")
+			b.WriteString("return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})\n")
+			b.WriteString("}\n")
+			seen := make(map[string]bool)
+			for _, p := range types {
+				for _, r := range p.Range {
+					member := cleanName(Name(r))
+					if r.T == nil || seen[member] {
+						continue
+					}
+					seen[member] = true
+					b.WriteString(fmt.Sprintf("if t.%s != nil {\n", member))
+					b.WriteString(fmt.Sprintf("if err := t.%s.ValidateStrict(); err != nil {\n", member))
+					b.WriteString("errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})\n")
+					b.WriteString("}\n")
+					b.WriteString("}\n")
+				}
+			}
+			b.WriteString("return errs\n")
+			return b.String()
+		},
+	})
+}
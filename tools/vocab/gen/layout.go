@@ -0,0 +1,355 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Layout determines how the generated code is arranged into files.
+type Layout int
+
+const (
+	// LayoutPerType generates one file for each type, plus files for the
+	// code shared between them. It is the default.
+	LayoutPerType Layout = iota
+	// LayoutPerKind generates one file for each kind of type: activities,
+	// collections, links, and other objects.
+	LayoutPerKind
+	// LayoutSingleFile generates all the code into a single file.
+	LayoutSingleFile
+)
+
+const (
+	vocabFileName       = "gen_vocab.go"
+	activityKindName    = "gen_activities.go"
+	collectionKindName  = "gen_collections.go"
+	linkKindName        = "gen_links.go"
+	objectKindName      = "gen_objects.go"
+	activityRootName    = "Activity"
+	collectionRootName  = "Collection"
+	partFileNameFormat  = "%s_%d%s"
+	generatedFileHeader = "//\n"
+	testFileSuffix      = "_test.go"
+)
+
+// LayoutOptions configures the arrangement of the generated files.
+type LayoutOptions struct {
+	Layout Layout
+	// MaxFileSize is the approximate size in bytes above which a file is
+	// split into several, at the boundaries of its top-level declarations.
+	// A single declaration larger than it is never split. Zero means no
+	// limit.
+	MaxFileSize int
+	// PackageName is the name of the package the files are rewritten in,
+	// which must be the PackageName of the Options they were generated
+	// with. Empty means the DefaultPackageName.
+	PackageName string
+}
+
+// ParseLayout converts the name of a layout, one of "type", "kind", or
+// "single", into the Layout.
+func ParseLayout(s string) (Layout, error) {
+	switch s {
+	case "type":
+		return LayoutPerType, nil
+	case "kind":
+		return LayoutPerKind, nil
+	case "single":
+		return LayoutSingleFile, nil
+	default:
+		return LayoutPerType, fmt.Errorf("unknown layout %q", s)
+	}
+}
+
+// ApplyLayout rearranges the files generated for the types according to the
+// options.
+func ApplyLayout(files []*File, types []*defs.Type, o LayoutOptions) (out []*File, err error) {
+	pkg := o.PackageName
+	if len(pkg) == 0 {
+		pkg = DefaultPackageName
+	}
+	switch o.Layout {
+	case LayoutPerType:
+		out = files
+	case LayoutPerKind:
+		kinds := make(map[string]string, len(types))
+		for _, t := range types {
+			kinds[typeFileName(t)] = kindFileName(t)
+		}
+		out, err = mergeFiles(files, pkg, func(name string) string {
+			if kind, ok := kinds[name]; ok {
+				return kind
+			}
+			return name
+		})
+	case LayoutSingleFile:
+		out, err = mergeFiles(files, pkg, func(string) string {
+			return vocabFileName
+		})
+	default:
+		err = fmt.Errorf("unknown layout %d", o.Layout)
+	}
+	if err != nil || o.MaxFileSize <= 0 {
+		return
+	}
+	var split []*File
+	for _, f := range out {
+		var parts []*File
+		parts, err = splitFile(f, pkg, o.MaxFileSize)
+		if err != nil {
+			return
+		}
+		split = append(split, parts...)
+	}
+	return split, nil
+}
+
+// typeFileName is the name of the file generated for the type.
+func typeFileName(t *defs.Type) string {
+	return fmt.Sprintf("gen_%s.go", strings.ToLower(t.Name))
+}
+
+// kindFileName is the name of the file the type belongs in when generating
+// one file per kind.
+func kindFileName(t *defs.Type) string {
+	if t.Name == linkName || isExtending(t, linkName) {
+		return linkKindName
+	} else if t.Name == activityRootName || isExtending(t, activityRootName) {
+		return activityKindName
+	} else if t.Name == collectionRootName || isExtending(t, collectionRootName) {
+		return collectionKindName
+	}
+	return objectKindName
+}
+
+// isExtending determines whether the type extends the named type, directly or
+// not.
+func isExtending(t *defs.Type, name string) bool {
+	for _, e := range t.Extends {
+		if e.Name == name || isExtending(e, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// parsedFile is a generated file split into its parts.
+type parsedFile struct {
+	doc     string
+	imports []string
+	// decls are the sources of the top-level declarations, including their
+	// comments.
+	decls []string
+	// declImports are the import paths used by each declaration.
+	declImports [][]string
+}
+
+// parseFile splits a generated file into its parts.
+func parseFile(f *File) (*parsedFile, error) {
+	fset := token.NewFileSet()
+	a, err := parser.ParseFile(fset, f.Name, f.Content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	p := &parsedFile{}
+	if a.Doc != nil && strings.TrimSpace(a.Doc.Text()) != "" {
+		p.doc = a.Doc.Text()
+	}
+	names := make(map[string]string, len(a.Imports))
+	for _, i := range a.Imports {
+		importPath, err := strconv.Unquote(i.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		p.imports = append(p.imports, importPath)
+		name := path.Base(importPath)
+		if i.Name != nil {
+			name = i.Name.Name
+		}
+		names[name] = importPath
+	}
+	// Comments between declarations belong to the following one.
+	start := -1
+	for _, d := range a.Decls {
+		if g, ok := d.(*ast.GenDecl); ok && g.Tok == token.IMPORT {
+			start = fset.Position(d.End()).Offset
+			continue
+		}
+		if start < 0 {
+			start = fset.Position(d.Pos()).Offset
+			if g, ok := d.(*ast.GenDecl); ok && g.Doc != nil {
+				start = fset.Position(g.Doc.Pos()).Offset
+			} else if fn, ok := d.(*ast.FuncDecl); ok && fn.Doc != nil {
+				start = fset.Position(fn.Doc.Pos()).Offset
+			}
+		}
+		end := fset.Position(d.End()).Offset
+		p.decls = append(p.decls, strings.TrimSpace(string(f.Content[start:end])))
+		p.declImports = append(p.declImports, usedImports(d, names))
+		start = end
+	}
+	return p, nil
+}
+
+// usedImports determines the paths of the imports the declaration uses.
+func usedImports(d ast.Decl, names map[string]string) []string {
+	used := make(map[string]bool)
+	ast.Inspect(d, func(n ast.Node) bool {
+		if s, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := s.X.(*ast.Ident); ok && x.Obj == nil {
+				if importPath, ok := names[x.Name]; ok {
+					used[importPath] = true
+				}
+			}
+		}
+		return true
+	})
+	paths := make([]string, 0, len(used))
+	for p := range used {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// writeFile formats the declarations into a file of the named package.
+func writeFile(name, pkg, doc string, imports, decls []string) (*File, error) {
+	var b bytes.Buffer
+	if len(doc) > 0 {
+		for _, line := range strings.Split(strings.TrimSpace(doc), "\n") {
+			b.WriteString("// " + line + "\n")
+		}
+	} else {
+		b.WriteString(generatedFileHeader)
+	}
+	b.WriteString("package " + pkg + "\n\n")
+	if len(imports) > 0 {
+		b.WriteString("import (\n")
+		for _, i := range imports {
+			b.WriteString(strconv.Quote(i) + "\n")
+		}
+		b.WriteString(")\n\n")
+	}
+	for _, d := range decls {
+		b.WriteString(d)
+		b.WriteString("\n\n")
+	}
+	c, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %s", name, err)
+	}
+	return &File{Name: name, Content: c}, nil
+}
+
+// mergeFiles combines the files whose names are mapped to the same name, in
+// the order they were generated in, into files of the named package. Test
+// files are never combined.
+func mergeFiles(files []*File, pkg string, to func(name string) string) ([]*File, error) {
+	var order []string
+	var tests []*File
+	merged := make(map[string]*parsedFile)
+	for _, f := range files {
+		if strings.HasSuffix(f.Name, testFileSuffix) {
+			tests = append(tests, f)
+			continue
+		}
+		p, err := parseFile(f)
+		if err != nil {
+			return nil, err
+		}
+		name := to(f.Name)
+		m, ok := merged[name]
+		if !ok {
+			m = &parsedFile{}
+			merged[name] = m
+			order = append(order, name)
+		}
+		if len(p.doc) > 0 {
+			m.doc = p.doc
+		}
+		m.imports = append(m.imports, p.imports...)
+		m.decls = append(m.decls, p.decls...)
+	}
+	out := make([]*File, 0, len(order))
+	for _, name := range order {
+		m := merged[name]
+		f, err := writeFile(name, pkg, m.doc, uniqueStrings(m.imports), m.decls)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	return append(out, tests...), nil
+}
+
+// splitFile splits the file into parts of about the maximum size, except for
+// declarations that are larger by themselves, in the named package. The first
+// part keeps the name of the file.
+func splitFile(f *File, pkg string, max int) ([]*File, error) {
+	if len(f.Content) <= max {
+		return []*File{f}, nil
+	}
+	p, err := parseFile(f)
+	if err != nil {
+		return nil, err
+	}
+	var out []*File
+	var decls, imports []string
+	size := 0
+	flush := func() error {
+		name := f.Name
+		doc := p.doc
+		if len(out) > 0 {
+			suffix := ".go"
+			if strings.HasSuffix(f.Name, testFileSuffix) {
+				suffix = testFileSuffix
+			}
+			name = fmt.Sprintf(partFileNameFormat, strings.TrimSuffix(f.Name, suffix), len(out)+1, suffix)
+			doc = ""
+		}
+		part, err := writeFile(name, pkg, doc, uniqueStrings(imports), decls)
+		if err != nil {
+			return err
+		}
+		out = append(out, part)
+		decls, imports, size = nil, nil, 0
+		return nil
+	}
+	for i, d := range p.decls {
+		if size > 0 && size+len(d) > max {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		decls = append(decls, d)
+		imports = append(imports, p.declImports[i]...)
+		size += len(d)
+	}
+	if len(decls) > 0 {
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// uniqueStrings sorts the strings and removes duplicates.
+func uniqueStrings(s []string) []string {
+	sort.Strings(s)
+	var out []string
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
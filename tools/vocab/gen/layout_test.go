@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"github.com/go-fed/activity/tools/defs"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestApplyLayoutPackageName(t *testing.T) {
+	const pkg = "custom"
+	types, properties, values, err := Prune(append(defs.AllCoreTypes, defs.AllExtendedTypes...), defs.AllPropertyTypes, defs.AllValueTypes, []string{"Note", "Like"})
+	if err != nil {
+		t.Fatalf("Prune returned error: %s", err)
+	}
+	files, err := GenerateImplementationsWithOptions(types, properties, values, Options{PackageName: pkg})
+	if err != nil {
+		t.Fatalf("GenerateImplementationsWithOptions returned error: %s", err)
+	}
+	tests := []struct {
+		name string
+		o    LayoutOptions
+	}{
+		{"type", LayoutOptions{Layout: LayoutPerType}},
+		{"kind", LayoutOptions{Layout: LayoutPerKind}},
+		{"single", LayoutOptions{Layout: LayoutSingleFile}},
+		{"max_file_size", LayoutOptions{Layout: LayoutPerType, MaxFileSize: 4096}},
+	}
+	for _, test := range tests {
+		test.o.PackageName = pkg
+		out, err := ApplyLayout(files, types, test.o)
+		if err != nil {
+			t.Errorf("%s: ApplyLayout returned error: %s", test.name, err)
+			continue
+		}
+		for _, f := range out {
+			a, err := parser.ParseFile(token.NewFileSet(), f.Name, f.Content, parser.PackageClauseOnly)
+			if err != nil {
+				t.Errorf("%s: %s does not parse: %s", test.name, f.Name, err)
+			} else if a.Name.Name != pkg {
+				t.Errorf("%s: %s is in package %q, want %q", test.name, f.Name, a.Name.Name, pkg)
+			}
+		}
+	}
+}
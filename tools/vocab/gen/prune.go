@@ -0,0 +1,71 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"strings"
+)
+
+// Prune keeps only the named types and the types they depend on, with the
+// properties and values those types refer to, so that generating a subset of
+// the vocabulary leaves out everything nothing references. A type depends on
+// the types it extends and the types its properties range over. The values of
+// IRIs are always kept, since every type has an IRI for its 'id'. The kept
+// definitions are in their original order.
+func Prune(types []*defs.Type, properties []*defs.PropertyType, values []*defs.ValueType, names []string) (pt []*defs.Type, pp []*defs.PropertyType, pv []*defs.ValueType, err error) {
+	byName := make(map[string]*defs.Type, len(types))
+	for _, t := range types {
+		byName[t.Name] = t
+	}
+	keptTypes := make(map[*defs.Type]bool)
+	keptProperties := make(map[*defs.PropertyType]bool)
+	keptValues := make(map[*defs.ValueType]bool)
+	var keep func(t *defs.Type)
+	keep = func(t *defs.Type) {
+		if keptTypes[t] {
+			return
+		}
+		keptTypes[t] = true
+		for _, e := range t.Extends {
+			keep(e)
+		}
+		for _, p := range t.GetProperties() {
+			keptProperties[p] = true
+			for _, r := range p.Range {
+				if r.T != nil {
+					keep(r.T)
+				} else if r.V != nil {
+					keptValues[r.V] = true
+				}
+			}
+		}
+	}
+	var unknown []string
+	for _, name := range names {
+		if t, ok := byName[name]; ok {
+			keep(t)
+		} else {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		err = fmt.Errorf("cannot prune to unknown types: %s", strings.Join(unknown, ", "))
+		return
+	}
+	for _, t := range types {
+		if keptTypes[t] {
+			pt = append(pt, t)
+		}
+	}
+	for _, p := range properties {
+		if keptProperties[p] {
+			pp = append(pp, p)
+		}
+	}
+	for _, v := range values {
+		if keptValues[v] || defs.IsIRIValueType(v) {
+			pv = append(pv, v)
+		}
+	}
+	return
+}
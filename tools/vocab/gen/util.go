@@ -51,10 +51,10 @@ func deserializeCode(b *bytes.Buffer, parseCode, varName, typeName, field string
 func sliceDeserializeCode(b *bytes.Buffer, parseCode, mapName, typeName, field string, deref bool) {
 	b.WriteString(fmt.Sprintf("if k == \"%s\" {\n", mapName))
 	b.WriteString("if tmpSlice, ok := v.([]interface{}); ok {\n")
-	b.WriteString(fmt.Sprintf("for _, tmpElem := range tmpSlice {\n"))
+	b.WriteString(fmt.Sprintf("for idx, tmpElem := range tmpSlice {\n"))
 	b.WriteString(fmt.Sprintf("if v, ok := tmpElem.(%s); ok {\n", typeName))
 	b.WriteString(parseCode)
-	b.WriteString("if err != nil {\nreturn err\n}\n")
+	b.WriteString(fmt.Sprintf("if err != nil {\nreturn %s(fmt.Sprintf(\"[%%d]\", idx), err)\n}\n", deserializeErrorAtFnName))
 	if deref {
 		b.WriteString(fmt.Sprintf("t.%s = append(t.%s, *tmp)\n", field, field))
 	} else {
@@ -97,6 +97,11 @@ func serializeCode(b *bytes.Buffer, sCode, mapName, field string, slice bool) {
 
 func sliceSerializeCode(b *bytes.Buffer, serializeCode, mapName, field string) {
 	b.WriteString(fmt.Sprintf("var %sTemp []interface{}\n", mapName))
+	if options.PooledSerialize {
+		b.WriteString(fmt.Sprintf("if len(t.%s) > 0 {\n", field))
+		b.WriteString(fmt.Sprintf("%sTemp = %s()\n", mapName, getSerializeSliceFnName))
+		b.WriteString("}\n")
+	}
 	b.WriteString(fmt.Sprintf("for _, v := range t.%s {\n", field))
 	b.WriteString(serializeCode)
 	b.WriteString(fmt.Sprintf("%sTemp = append(%sTemp, tmp)\n", mapName, mapName))
@@ -104,6 +109,9 @@ func sliceSerializeCode(b *bytes.Buffer, serializeCode, mapName, field string) {
 	b.WriteString(fmt.Sprintf("if %sTemp != nil {\n", mapName))
 	b.WriteString(fmt.Sprintf("if len(%sTemp) == 1 {\n", mapName))
 	b.WriteString(fmt.Sprintf("m[\"%s\"] = %sTemp[0]\n", mapName, mapName))
+	if options.PooledSerialize {
+		b.WriteString(fmt.Sprintf("%s(%sTemp)\n", putSerializeSliceFnName, mapName))
+	}
 	b.WriteString("} else {\n")
 	b.WriteString(fmt.Sprintf("m[\"%s\"] = %sTemp\n", mapName, mapName))
 	b.WriteString("}\n")
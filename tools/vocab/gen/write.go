@@ -0,0 +1,180 @@
+package gen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"github.com/go-fed/activity/tools/defs"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// FingerprintFileName is the name of the file in the output directory
+	// keeping the Fingerprint of the inputs its files were generated from.
+	FingerprintFileName = ".gen_fingerprint"
+	// generatedFilePattern matches the names of the files generators write,
+	// which WriteFiles removes when they are no longer generated.
+	generatedFilePattern = "gen_*.go"
+)
+
+// Fingerprint returns the SHA-256 hash of the inputs of a generator: the
+// definitions of the types, properties, and values, the other inputs, such as
+// its flags and the contents of the files it reads, and the generator itself,
+// so that changing how the code is generated changes it too. Generating from
+// inputs with the same fingerprint generates the same files.
+func Fingerprint(types []*defs.Type, properties []*defs.PropertyType, values []*defs.ValueType, inputs ...string) (fp [sha256.Size]byte, err error) {
+	h := sha256.New()
+	exe, err := os.Executable()
+	if err != nil {
+		return
+	}
+	f, err := os.Open(exe)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	if _, err = io.Copy(h, f); err != nil {
+		return
+	}
+	for _, t := range types {
+		fmt.Fprintf(h, "type %q %q %q %v\n", t.Name, t.URI, t.Notes, t.Meta)
+		fmt.Fprintf(h, "%v %v %v %v\n", typeNames(t.DisjointWith), typeNames(t.Extends), propertyNames(t.Properties), propertyNames(t.WithoutProperties))
+	}
+	for _, p := range properties {
+		fmt.Fprintf(h, "property %q %q %q %v %v %v\n", p.Name, p.URI, p.Notes, p.Functional, p.NaturalLanguageMap, p.PreferIRIConvenience)
+		for _, d := range p.Domain {
+			fmt.Fprintf(h, "domain %v %v\n", typeNames([]*defs.Type{d.T}), d.Any)
+		}
+		for _, r := range p.Range {
+			v := ""
+			if r.V != nil {
+				v = r.V.Name
+			}
+			fmt.Fprintf(h, "range %v %q %v\n", typeNames([]*defs.Type{r.T}), v, r.Any)
+		}
+		if p.SubpropertyOf != nil {
+			fmt.Fprintf(h, "subproperty %q\n", p.SubpropertyOf.Name)
+		}
+	}
+	for _, v := range values {
+		fmt.Fprintf(h, "value %q %q %q %q %q %q %v\n", v.Name, v.URI, v.DefinitionType, v.Zero, v.ZeroValue, v.TextName, v.Imports)
+		for _, fn := range []*defs.FunctionDef{v.DeserializeFn, v.SerializeFn} {
+			if fn != nil {
+				fmt.Fprintf(h, "%s\n", fn.Generate())
+			}
+		}
+	}
+	for _, in := range inputs {
+		fmt.Fprintf(h, "input %d %s\n", len(in), in)
+	}
+	copy(fp[:], h.Sum(nil))
+	return
+}
+
+// typeNames returns the names of the types, with an empty name for nil.
+func typeNames(types []*defs.Type) []string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		if t != nil {
+			names[i] = t.Name
+		}
+	}
+	return names
+}
+
+// propertyNames returns the names of the properties.
+func propertyNames(properties []*defs.PropertyType) []string {
+	names := make([]string, len(properties))
+	for i, p := range properties {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// FlagInputs returns the names and values of the command line flags, as inputs
+// of a Fingerprint.
+func FlagInputs() []string {
+	var inputs []string
+	flag.VisitAll(func(f *flag.Flag) {
+		inputs = append(inputs, f.Name+"="+f.Value.String())
+	})
+	return inputs
+}
+
+// FileInputs returns the names and contents of the files, and of the files in
+// the directories, as inputs of a Fingerprint. Empty names are skipped.
+func FileInputs(names ...string) (inputs []string, err error) {
+	for _, name := range names {
+		if len(name) == 0 {
+			continue
+		}
+		err = filepath.Walk(name, func(p string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			b, err := ioutil.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			inputs = append(inputs, filepath.ToSlash(p)+"\n"+string(b))
+			return nil
+		})
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// IsUpToDate returns true if the files in the directory were generated by
+// WriteFiles from inputs with the fingerprint, so that generating them again
+// can be skipped.
+func IsUpToDate(dir string, fp [sha256.Size]byte) bool {
+	b, err := ioutil.ReadFile(filepath.Join(dir, FingerprintFileName))
+	return err == nil && strings.TrimSpace(string(b)) == hex.EncodeToString(fp[:])
+}
+
+// WriteFiles writes the files in the directory, skipping each one whose content
+// is the same as that of the file already there, so that regenerating the
+// package after a small change to the vocabulary, such as to a single type of
+// an extension, leaves the other files untouched. It removes the generated
+// files in the directory, those named like gen_*.go, that are not among the
+// files, such as those of types since removed, and keeps the fingerprint of the
+// inputs for IsUpToDate. It returns the names of the files it wrote.
+func WriteFiles(dir string, files []*File, fp [sha256.Size]byte) (written []string, err error) {
+	keep := make(map[string]bool, len(files))
+	for _, f := range files {
+		keep[f.Name] = true
+		name := filepath.Join(dir, f.Name)
+		if existing, err := ioutil.ReadFile(name); err == nil {
+			if bytes.Equal(existing, f.Content) {
+				continue
+			}
+		} else if !os.IsNotExist(err) {
+			return written, err
+		}
+		if err = ioutil.WriteFile(name, f.Content, 0666); err != nil {
+			return written, err
+		}
+		written = append(written, f.Name)
+	}
+	existing, err := filepath.Glob(filepath.Join(dir, generatedFilePattern))
+	if err != nil {
+		return
+	}
+	for _, name := range existing {
+		if !keep[filepath.Base(name)] {
+			if err = os.Remove(name); err != nil {
+				return
+			}
+		}
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, FingerprintFileName), []byte(hex.EncodeToString(fp[:])+"\n"), 0666)
+	return
+}
@@ -0,0 +1,54 @@
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, content := range map[string]string{
+		"gen_note.go":    "package vocab\n",
+		"gen_removed.go": "package vocab\n",
+		"vocab_test.go":  "package vocab\n",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fp, err := Fingerprint(nil, nil, nil, "-package=vocab")
+	if err != nil {
+		t.Fatal(err)
+	} else if IsUpToDate(dir, fp) {
+		t.Fatalf("Expected a directory without a fingerprint to be out of date")
+	}
+	written, err := WriteFiles(dir, []*File{
+		{Name: "gen_note.go", Content: []byte("package vocab\n")},
+		{Name: "gen_person.go", Content: []byte("package vocab\n")},
+	}, fp)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(written) != 1 || written[0] != "gen_person.go" {
+		t.Fatalf("Expected only gen_person.go to be written, got %v", written)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "gen_removed.go")); !os.IsNotExist(err) {
+		t.Fatalf("Expected gen_removed.go to be removed, got %v", err)
+	} else if _, err = os.Stat(filepath.Join(dir, "vocab_test.go")); err != nil {
+		t.Fatalf("Expected vocab_test.go to be kept, got %v", err)
+	}
+	if !IsUpToDate(dir, fp) {
+		t.Fatalf("Expected the directory to be up to date")
+	}
+	other, err := Fingerprint(nil, nil, nil, "-package=other")
+	if err != nil {
+		t.Fatal(err)
+	} else if IsUpToDate(dir, other) {
+		t.Fatalf("Expected the directory to be out of date for other inputs")
+	}
+}
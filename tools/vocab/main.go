@@ -1,21 +1,131 @@
 package main
 
 import (
+	"flag"
 	"github.com/go-fed/activity/tools/defs"
 	"github.com/go-fed/activity/tools/vocab/gen"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
 )
 
+var (
+	layout      = flag.String("layout", "type", "How to arrange the generated code into files: one file per \"type\", one per \"kind\" of type, or a \"single\" file")
+	maxFileSize = flag.Int("max_file_size", 0, "Size in bytes above which a generated file is split into several; zero means no limit")
+	examples    = flag.String("examples", "testdata/examples", "Directory of example documents to generate golden round trip tests for; empty generates none")
+	pooled      = flag.Bool("pooled_serialize", false, "Generate Serialize methods that reuse maps and slices from a pool, returned to it by ReleaseSerialized")
+	pkg         = flag.String("package", gen.DefaultPackageName, "Name of the generated package")
+	out         = flag.String("out", ".", "Directory to generate the package in")
+	facadePath  = flag.String("facade_impl_path", "", "Import path of the impl directory under -out; when set, the types are generated there and -out only holds a facade of interfaces and constructors, and -examples is relative to the impl directory")
+	header      = flag.String("header", "", "File of a text/template of a comment to put at the top of every generated file, such as a license or a 'Code generated' marker")
+	noReflect   = flag.Bool("reflection_free", false, "Generate code encoding and decoding JSON without encoding/json and its reflection, so that it can be compiled by TinyGo")
+	only        = flag.String("types", "", "Comma-separated names of the types to generate, with the types, properties, and values they depend on; empty generates them all")
+	workers     = flag.Int("workers", 0, "Number of files to generate and format at once; zero means the number of CPUs")
+	typeComment = flag.String("type_comment", "", "File of a text/template of the doc comment of every type, executed with its definition; by default the notes of the type")
+)
+
+// readTemplate returns the contents of the template file, or an empty string if
+// there is none.
+func readTemplate(name string) string {
+	if len(name) == 0 {
+		return ""
+	}
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// toolVersion returns the version of the module the tool was built from.
+func toolVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		return info.Main.Version
+	}
+	return ""
+}
+
 func main() {
+	flag.Parse()
+	l, err := gen.ParseLayout(*layout)
+	if err != nil {
+		panic(err)
+	}
 	allTypes := append(defs.AllCoreTypes, defs.AllExtendedTypes...)
-	files, err := gen.GenerateImplementations(allTypes, defs.AllPropertyTypes, defs.AllValueTypes)
+	properties, values := defs.AllPropertyTypes, defs.AllValueTypes
+	if len(*only) > 0 {
+		allTypes, properties, values, err = gen.Prune(allTypes, properties, values, strings.Split(*only, ","))
+		if err != nil {
+			panic(err)
+		}
+	}
+	o := gen.Options{
+		PooledSerialize: *pooled,
+		ExamplesDir:     *examples,
+		PackageName:     *pkg,
+		OutputDir:       *out,
+		Header:          readTemplate(*header),
+		TypeComment:     readTemplate(*typeComment),
+		ToolVersion:     toolVersion(),
+		ReflectionFree:  *noReflect,
+		Workers:         *workers,
+	}
+	implDir := *out
+	if len(*facadePath) > 0 {
+		implDir = filepath.Join(*out, gen.ImplPackageName)
+	}
+	examplesDir := ""
+	if len(*examples) > 0 {
+		examplesDir = filepath.Join(implDir, filepath.FromSlash(*examples))
+	}
+	inputs, err := gen.FileInputs(*header, *typeComment, examplesDir)
 	if err != nil {
 		panic(err)
 	}
-	for _, f := range files {
-		err = ioutil.WriteFile(f.Name, f.Content, 0666)
+	fp, err := gen.Fingerprint(allTypes, properties, values, append(gen.FlagInputs(), inputs...)...)
+	if err != nil {
+		panic(err)
+	}
+	if gen.IsUpToDate(implDir, fp) && (len(*facadePath) == 0 || gen.IsUpToDate(*out, fp)) {
+		return
+	}
+	if len(*facadePath) > 0 {
+		facade, err := gen.GenerateFacade(allTypes, *facadePath, o)
+		if err != nil {
+			panic(err)
+		}
+		files, err := gen.ApplyHeader([]*gen.File{facade}, o)
 		if err != nil {
 			panic(err)
 		}
+		if _, err = gen.WriteFiles(*out, files, fp); err != nil {
+			panic(err)
+		}
+		if err = os.MkdirAll(implDir, 0777); err != nil {
+			panic(err)
+		}
+		o.PackageName = gen.ImplPackageName
+		o.OutputDir = implDir
+	}
+	files, err := gen.GenerateImplementationsWithOptions(allTypes, properties, values, o)
+	if err != nil {
+		panic(err)
+	}
+	files, err = gen.ApplyLayout(files, allTypes, gen.LayoutOptions{
+		Layout:      l,
+		MaxFileSize: *maxFileSize,
+		PackageName: o.PackageName,
+	})
+	if err != nil {
+		panic(err)
+	}
+	files, err = gen.ApplyHeader(files, o)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = gen.WriteFiles(implDir, files, fp); err != nil {
+		panic(err)
 	}
 }
@@ -0,0 +1,330 @@
+//
+package toot
+
+import (
+	"net/url"
+)
+
+// Extensible is implemented by every type, including those of the core package, which keep the properties of this package they do not know of as unknown properties.
+type Extensible interface {
+	HasUnknown(k string) (b bool)
+	GetUnknown(k string) (i interface{})
+	SetUnknownProperty(k string, i interface{})
+}
+
+// attachedFeatured deserializes the 'featured' of the value, or returns nil if it has none or it cannot be deserialized. A value without a type is deserialized as a OrderedCollection, the only type the property takes
+func attachedFeatured(t Extensible) (i *featuredIntermediateType) {
+	if !t.HasUnknown("featured") {
+		return nil
+	}
+	v := t.GetUnknown("featured")
+	if m, ok := v.(map[string]interface{}); ok && m["type"] == nil {
+		tmp := &OrderedCollection{}
+		if err := tmp.Deserialize(m); err != nil {
+			return nil
+		}
+		return &featuredIntermediateType{OrderedCollection: tmp}
+	}
+	i, err := deserializeFeaturedIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsFeatured determines whether the call to GetFeatured is safe, which is whether the 'featured' of the value is of OrderedCollectionType type
+func IsFeatured(t Extensible) (ok bool) {
+	i := attachedFeatured(t)
+	return i != nil && i.OrderedCollection != nil
+
+}
+
+// GetFeatured returns the 'featured' of the value safely if IsFeatured returned true
+func GetFeatured(t Extensible) (v OrderedCollectionType) {
+	return attachedFeatured(t).OrderedCollection
+
+}
+
+// SetFeatured sets the 'featured' of the value to be of OrderedCollectionType type, as an unknown property of the value
+func SetFeatured(t Extensible, v OrderedCollectionType) (err error) {
+	i, err := serializeFeaturedIntermediateType(&featuredIntermediateType{OrderedCollection: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("featured", i)
+	return
+
+}
+
+// IsFeaturedIRI determines whether the call to GetFeaturedIRI is safe, which is whether the 'featured' of the value is of *url.URL type
+func IsFeaturedIRI(t Extensible) (ok bool) {
+	i := attachedFeatured(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetFeaturedIRI returns the 'featured' of the value safely if IsFeaturedIRI returned true
+func GetFeaturedIRI(t Extensible) (v *url.URL) {
+	return attachedFeatured(t).IRI
+
+}
+
+// SetFeaturedIRI sets the 'featured' of the value to be of *url.URL type, as an unknown property of the value
+func SetFeaturedIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializeFeaturedIntermediateType(&featuredIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("featured", i)
+	return
+
+}
+
+// attachedDiscoverable deserializes the 'discoverable' of the value, or returns nil if it has none or it cannot be deserialized
+func attachedDiscoverable(t Extensible) (i *discoverableIntermediateType) {
+	if !t.HasUnknown("discoverable") {
+		return nil
+	}
+	v := t.GetUnknown("discoverable")
+	i, err := deserializeDiscoverableIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsDiscoverable determines whether the call to GetDiscoverable is safe, which is whether the 'discoverable' of the value is of bool type
+func IsDiscoverable(t Extensible) (ok bool) {
+	i := attachedDiscoverable(t)
+	return i != nil && i.boolean != nil
+
+}
+
+// GetDiscoverable returns the 'discoverable' of the value safely if IsDiscoverable returned true
+func GetDiscoverable(t Extensible) (v bool) {
+	return *attachedDiscoverable(t).boolean
+
+}
+
+// SetDiscoverable sets the 'discoverable' of the value to be of bool type, as an unknown property of the value
+func SetDiscoverable(t Extensible, v bool) (err error) {
+	i, err := serializeDiscoverableIntermediateType(&discoverableIntermediateType{boolean: &v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("discoverable", i)
+	return
+
+}
+
+// IsDiscoverableIRI determines whether the call to GetDiscoverableIRI is safe, which is whether the 'discoverable' of the value is of *url.URL type
+func IsDiscoverableIRI(t Extensible) (ok bool) {
+	i := attachedDiscoverable(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetDiscoverableIRI returns the 'discoverable' of the value safely if IsDiscoverableIRI returned true
+func GetDiscoverableIRI(t Extensible) (v *url.URL) {
+	return attachedDiscoverable(t).IRI
+
+}
+
+// SetDiscoverableIRI sets the 'discoverable' of the value to be of *url.URL type, as an unknown property of the value
+func SetDiscoverableIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializeDiscoverableIntermediateType(&discoverableIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("discoverable", i)
+	return
+
+}
+
+// attachedSuspended deserializes the 'suspended' of the value, or returns nil if it has none or it cannot be deserialized
+func attachedSuspended(t Extensible) (i *suspendedIntermediateType) {
+	if !t.HasUnknown("suspended") {
+		return nil
+	}
+	v := t.GetUnknown("suspended")
+	i, err := deserializeSuspendedIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsSuspended determines whether the call to GetSuspended is safe, which is whether the 'suspended' of the value is of bool type
+func IsSuspended(t Extensible) (ok bool) {
+	i := attachedSuspended(t)
+	return i != nil && i.boolean != nil
+
+}
+
+// GetSuspended returns the 'suspended' of the value safely if IsSuspended returned true
+func GetSuspended(t Extensible) (v bool) {
+	return *attachedSuspended(t).boolean
+
+}
+
+// SetSuspended sets the 'suspended' of the value to be of bool type, as an unknown property of the value
+func SetSuspended(t Extensible, v bool) (err error) {
+	i, err := serializeSuspendedIntermediateType(&suspendedIntermediateType{boolean: &v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("suspended", i)
+	return
+
+}
+
+// IsSuspendedIRI determines whether the call to GetSuspendedIRI is safe, which is whether the 'suspended' of the value is of *url.URL type
+func IsSuspendedIRI(t Extensible) (ok bool) {
+	i := attachedSuspended(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetSuspendedIRI returns the 'suspended' of the value safely if IsSuspendedIRI returned true
+func GetSuspendedIRI(t Extensible) (v *url.URL) {
+	return attachedSuspended(t).IRI
+
+}
+
+// SetSuspendedIRI sets the 'suspended' of the value to be of *url.URL type, as an unknown property of the value
+func SetSuspendedIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializeSuspendedIntermediateType(&suspendedIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("suspended", i)
+	return
+
+}
+
+// attachedVotersCount deserializes the 'votersCount' of the value, or returns nil if it has none or it cannot be deserialized
+func attachedVotersCount(t Extensible) (i *votersCountIntermediateType) {
+	if !t.HasUnknown("votersCount") {
+		return nil
+	}
+	v := t.GetUnknown("votersCount")
+	i, err := deserializeVotersCountIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsVotersCount determines whether the call to GetVotersCount is safe, which is whether the 'votersCount' of the value is of int64 type
+func IsVotersCount(t Extensible) (ok bool) {
+	i := attachedVotersCount(t)
+	return i != nil && i.nonNegativeInteger != nil
+
+}
+
+// GetVotersCount returns the 'votersCount' of the value safely if IsVotersCount returned true
+func GetVotersCount(t Extensible) (v int64) {
+	return *attachedVotersCount(t).nonNegativeInteger
+
+}
+
+// SetVotersCount sets the 'votersCount' of the value to be of int64 type, as an unknown property of the value
+func SetVotersCount(t Extensible, v int64) (err error) {
+	i, err := serializeVotersCountIntermediateType(&votersCountIntermediateType{nonNegativeInteger: &v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("votersCount", i)
+	return
+
+}
+
+// IsVotersCountIRI determines whether the call to GetVotersCountIRI is safe, which is whether the 'votersCount' of the value is of *url.URL type
+func IsVotersCountIRI(t Extensible) (ok bool) {
+	i := attachedVotersCount(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetVotersCountIRI returns the 'votersCount' of the value safely if IsVotersCountIRI returned true
+func GetVotersCountIRI(t Extensible) (v *url.URL) {
+	return attachedVotersCount(t).IRI
+
+}
+
+// SetVotersCountIRI sets the 'votersCount' of the value to be of *url.URL type, as an unknown property of the value
+func SetVotersCountIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializeVotersCountIntermediateType(&votersCountIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("votersCount", i)
+	return
+
+}
+
+// attachedBlurhash deserializes the 'blurhash' of the value, or returns nil if it has none or it cannot be deserialized
+func attachedBlurhash(t Extensible) (i *blurhashIntermediateType) {
+	if !t.HasUnknown("blurhash") {
+		return nil
+	}
+	v := t.GetUnknown("blurhash")
+	i, err := deserializeBlurhashIntermediateType(v)
+	if err != nil {
+		return nil
+	}
+	return i
+
+}
+
+// IsBlurhash determines whether the call to GetBlurhash is safe, which is whether the 'blurhash' of the value is of string type
+func IsBlurhash(t Extensible) (ok bool) {
+	i := attachedBlurhash(t)
+	return i != nil && i.stringName != nil
+
+}
+
+// GetBlurhash returns the 'blurhash' of the value safely if IsBlurhash returned true
+func GetBlurhash(t Extensible) (v string) {
+	return *attachedBlurhash(t).stringName
+
+}
+
+// SetBlurhash sets the 'blurhash' of the value to be of string type, as an unknown property of the value
+func SetBlurhash(t Extensible, v string) (err error) {
+	i, err := serializeBlurhashIntermediateType(&blurhashIntermediateType{stringName: &v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("blurhash", i)
+	return
+
+}
+
+// IsBlurhashIRI determines whether the call to GetBlurhashIRI is safe, which is whether the 'blurhash' of the value is of *url.URL type
+func IsBlurhashIRI(t Extensible) (ok bool) {
+	i := attachedBlurhash(t)
+	return i != nil && i.IRI != nil
+
+}
+
+// GetBlurhashIRI returns the 'blurhash' of the value safely if IsBlurhashIRI returned true
+func GetBlurhashIRI(t Extensible) (v *url.URL) {
+	return attachedBlurhash(t).IRI
+
+}
+
+// SetBlurhashIRI sets the 'blurhash' of the value to be of *url.URL type, as an unknown property of the value
+func SetBlurhashIRI(t Extensible, v *url.URL) (err error) {
+	i, err := serializeBlurhashIntermediateType(&blurhashIntermediateType{IRI: v})
+	if err != nil {
+		return
+	}
+	t.SetUnknownProperty("blurhash", i)
+	return
+
+}
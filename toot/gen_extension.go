@@ -0,0 +1,985 @@
+// Package toot provides the types of an extension of the ActivityStream vocabulary, which refer to the types of a core package generated from the vocabulary specification. This package is code-generated. Do not modify this package directly.
+package toot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	core "github.com/go-fed/activity/vocab"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Accept is defined by the core package.
+type Accept = core.Accept
+
+// AcceptType is defined by the core package.
+type AcceptType = core.AcceptType
+
+// AccessorError is defined by the core package.
+type AccessorError = core.AccessorError
+
+// Activity is defined by the core package.
+type Activity = core.Activity
+
+// ActivityType is defined by the core package.
+type ActivityType = core.ActivityType
+
+// Add is defined by the core package.
+type Add = core.Add
+
+// AddType is defined by the core package.
+type AddType = core.AddType
+
+// Announce is defined by the core package.
+type Announce = core.Announce
+
+// AnnounceType is defined by the core package.
+type AnnounceType = core.AnnounceType
+
+// Application is defined by the core package.
+type Application = core.Application
+
+// ApplicationType is defined by the core package.
+type ApplicationType = core.ApplicationType
+
+// Arrive is defined by the core package.
+type Arrive = core.Arrive
+
+// ArriveType is defined by the core package.
+type ArriveType = core.ArriveType
+
+// Article is defined by the core package.
+type Article = core.Article
+
+// ArticleType is defined by the core package.
+type ArticleType = core.ArticleType
+
+// Audio is defined by the core package.
+type Audio = core.Audio
+
+// AudioType is defined by the core package.
+type AudioType = core.AudioType
+
+// Block is defined by the core package.
+type Block = core.Block
+
+// BlockType is defined by the core package.
+type BlockType = core.BlockType
+
+// Collection is defined by the core package.
+type Collection = core.Collection
+
+// CollectionPage is defined by the core package.
+type CollectionPage = core.CollectionPage
+
+// CollectionPageType is defined by the core package.
+type CollectionPageType = core.CollectionPageType
+
+// CollectionType is defined by the core package.
+type CollectionType = core.CollectionType
+
+// Create is defined by the core package.
+type Create = core.Create
+
+// CreateType is defined by the core package.
+type CreateType = core.CreateType
+
+// Delete is defined by the core package.
+type Delete = core.Delete
+
+// DeleteType is defined by the core package.
+type DeleteType = core.DeleteType
+
+// DeserializeError is defined by the core package.
+type DeserializeError = core.DeserializeError
+
+// Deserializer is defined by the core package.
+type Deserializer = core.Deserializer
+
+// Dislike is defined by the core package.
+type Dislike = core.Dislike
+
+// DislikeType is defined by the core package.
+type DislikeType = core.DislikeType
+
+// Document is defined by the core package.
+type Document = core.Document
+
+// DocumentType is defined by the core package.
+type DocumentType = core.DocumentType
+
+// Event is defined by the core package.
+type Event = core.Event
+
+// EventType is defined by the core package.
+type EventType = core.EventType
+
+// Flag is defined by the core package.
+type Flag = core.Flag
+
+// FlagType is defined by the core package.
+type FlagType = core.FlagType
+
+// Follow is defined by the core package.
+type Follow = core.Follow
+
+// FollowType is defined by the core package.
+type FollowType = core.FollowType
+
+// Group is defined by the core package.
+type Group = core.Group
+
+// GroupType is defined by the core package.
+type GroupType = core.GroupType
+
+// Hashtag is defined by the core package.
+type Hashtag = core.Hashtag
+
+// HashtagType is defined by the core package.
+type HashtagType = core.HashtagType
+
+// Ignore is defined by the core package.
+type Ignore = core.Ignore
+
+// IgnoreType is defined by the core package.
+type IgnoreType = core.IgnoreType
+
+// Image is defined by the core package.
+type Image = core.Image
+
+// ImageType is defined by the core package.
+type ImageType = core.ImageType
+
+// IntransitiveActivity is defined by the core package.
+type IntransitiveActivity = core.IntransitiveActivity
+
+// IntransitiveActivityType is defined by the core package.
+type IntransitiveActivityType = core.IntransitiveActivityType
+
+// InvalidPropertyError is defined by the core package.
+type InvalidPropertyError = core.InvalidPropertyError
+
+// Invite is defined by the core package.
+type Invite = core.Invite
+
+// InviteType is defined by the core package.
+type InviteType = core.InviteType
+
+// Join is defined by the core package.
+type Join = core.Join
+
+// JoinType is defined by the core package.
+type JoinType = core.JoinType
+
+// Leave is defined by the core package.
+type Leave = core.Leave
+
+// LeaveType is defined by the core package.
+type LeaveType = core.LeaveType
+
+// Like is defined by the core package.
+type Like = core.Like
+
+// LikeType is defined by the core package.
+type LikeType = core.LikeType
+
+// Link is defined by the core package.
+type Link = core.Link
+
+// LinkType is defined by the core package.
+type LinkType = core.LinkType
+
+// Listen is defined by the core package.
+type Listen = core.Listen
+
+// ListenType is defined by the core package.
+type ListenType = core.ListenType
+
+// Mention is defined by the core package.
+type Mention = core.Mention
+
+// MentionType is defined by the core package.
+type MentionType = core.MentionType
+
+// MismatchedPropertyError is defined by the core package.
+type MismatchedPropertyError = core.MismatchedPropertyError
+
+// MissingPropertyError is defined by the core package.
+type MissingPropertyError = core.MissingPropertyError
+
+// Move is defined by the core package.
+type Move = core.Move
+
+// MoveType is defined by the core package.
+type MoveType = core.MoveType
+
+// Note is defined by the core package.
+type Note = core.Note
+
+// NoteType is defined by the core package.
+type NoteType = core.NoteType
+
+// Object is defined by the core package.
+type Object = core.Object
+
+// ObjectType is defined by the core package.
+type ObjectType = core.ObjectType
+
+// Offer is defined by the core package.
+type Offer = core.Offer
+
+// OfferType is defined by the core package.
+type OfferType = core.OfferType
+
+// OrderedCollection is defined by the core package.
+type OrderedCollection = core.OrderedCollection
+
+// OrderedCollectionPage is defined by the core package.
+type OrderedCollectionPage = core.OrderedCollectionPage
+
+// OrderedCollectionPageType is defined by the core package.
+type OrderedCollectionPageType = core.OrderedCollectionPageType
+
+// OrderedCollectionType is defined by the core package.
+type OrderedCollectionType = core.OrderedCollectionType
+
+// Organization is defined by the core package.
+type Organization = core.Organization
+
+// OrganizationType is defined by the core package.
+type OrganizationType = core.OrganizationType
+
+// Page is defined by the core package.
+type Page = core.Page
+
+// PageType is defined by the core package.
+type PageType = core.PageType
+
+// Person is defined by the core package.
+type Person = core.Person
+
+// PersonType is defined by the core package.
+type PersonType = core.PersonType
+
+// Place is defined by the core package.
+type Place = core.Place
+
+// PlaceType is defined by the core package.
+type PlaceType = core.PlaceType
+
+// Profile is defined by the core package.
+type Profile = core.Profile
+
+// ProfileType is defined by the core package.
+type ProfileType = core.ProfileType
+
+// Question is defined by the core package.
+type Question = core.Question
+
+// QuestionType is defined by the core package.
+type QuestionType = core.QuestionType
+
+// Read is defined by the core package.
+type Read = core.Read
+
+// ReadType is defined by the core package.
+type ReadType = core.ReadType
+
+// Reject is defined by the core package.
+type Reject = core.Reject
+
+// RejectType is defined by the core package.
+type RejectType = core.RejectType
+
+// Relationship is defined by the core package.
+type Relationship = core.Relationship
+
+// RelationshipType is defined by the core package.
+type RelationshipType = core.RelationshipType
+
+// Remove is defined by the core package.
+type Remove = core.Remove
+
+// RemoveType is defined by the core package.
+type RemoveType = core.RemoveType
+
+// Serializer is defined by the core package.
+type Serializer = core.Serializer
+
+// Service is defined by the core package.
+type Service = core.Service
+
+// ServiceType is defined by the core package.
+type ServiceType = core.ServiceType
+
+// TentativeAccept is defined by the core package.
+type TentativeAccept = core.TentativeAccept
+
+// TentativeAcceptType is defined by the core package.
+type TentativeAcceptType = core.TentativeAcceptType
+
+// TentativeReject is defined by the core package.
+type TentativeReject = core.TentativeReject
+
+// TentativeRejectType is defined by the core package.
+type TentativeRejectType = core.TentativeRejectType
+
+// Tombstone is defined by the core package.
+type Tombstone = core.Tombstone
+
+// TombstoneType is defined by the core package.
+type TombstoneType = core.TombstoneType
+
+// Travel is defined by the core package.
+type Travel = core.Travel
+
+// TravelType is defined by the core package.
+type TravelType = core.TravelType
+
+// TypeKind is defined by the core package.
+type TypeKind = core.TypeKind
+
+// Typer is defined by the core package.
+type Typer = core.Typer
+
+// Undo is defined by the core package.
+type Undo = core.Undo
+
+// UndoType is defined by the core package.
+type UndoType = core.UndoType
+
+// Unknown is defined by the core package.
+type Unknown = core.Unknown
+
+// UnknownPropertyError is defined by the core package.
+type UnknownPropertyError = core.UnknownPropertyError
+
+// Update is defined by the core package.
+type Update = core.Update
+
+// UpdateType is defined by the core package.
+type UpdateType = core.UpdateType
+
+// ValidationErrors is defined by the core package.
+type ValidationErrors = core.ValidationErrors
+
+// Video is defined by the core package.
+type Video = core.Video
+
+// VideoType is defined by the core package.
+type VideoType = core.VideoType
+
+// View is defined by the core package.
+type View = core.View
+
+// ViewType is defined by the core package.
+type ViewType = core.ViewType
+
+// parseDateTime parses an xsd:dateTime value with ParseDateTimeStrict.
+func parseDateTime(s string) (time.Time, error) {
+	return core.ParseDateTimeStrict(s)
+}
+
+// formatDateTime formats an xsd:dateTime value with FormatDateTime.
+func formatDateTime(t time.Time) string {
+	return core.FormatDateTime(t)
+}
+
+// dateTimeProperties are the properties taking xsd:dateTime values.
+var dateTimeProperties = map[string]bool{
+	"closed":    true,
+	"deleted":   true,
+	"endTime":   true,
+	"published": true,
+	"startTime": true,
+	"updated":   true,
+}
+
+// lenientDateTimes copies the value with the xsd:dateTime values of its
+// properties, and of the values it contains, that ParseDateTimeStrict rejects
+// but ParseDateTimeLenient parses rewritten as FormatDateTime formats them.
+func lenientDateTimes(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		r := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			if dateTimeProperties[k] {
+				e = lenientDateTime(e)
+			}
+			r[k] = lenientDateTimes(e)
+		}
+		return r
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = lenientDateTimes(e)
+		}
+		return r
+	}
+	return v
+}
+
+// lenientDateTime rewrites the xsd:dateTime value of a property, or each of
+// its values, if only ParseDateTimeLenient parses it.
+func lenientDateTime(v interface{}) interface{} {
+	switch x := v.(type) {
+	case string:
+		if _, err := core.ParseDateTimeStrict(x); err != nil {
+			if t, err := core.ParseDateTimeLenient(x); err == nil {
+				return core.FormatDateTime(t)
+			}
+		}
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = lenientDateTime(e)
+		}
+		return r
+	}
+	return v
+}
+
+// parseDuration parses an xsd:duration value with ParseDuration.
+func parseDuration(s string) (time.Duration, error) {
+	return core.ParseDuration(s)
+}
+
+// formatDuration formats an xsd:duration value with FormatDuration.
+func formatDuration(d time.Duration) string {
+	return core.FormatDuration(d)
+}
+
+// init adds the types of this package to the ExtensionTypes of the core package.
+func init() {
+	core.ExtensionTypes["Emoji"] = func() core.Type {
+		return &Emoji{}
+	}
+}
+
+// deserializeErrorAt returns the error deserializing the value of a property,
+// or of the element of an array at an index such as "[2]", as a
+// DeserializeError whose path begins with it.
+func deserializeErrorAt(k string, err error) error {
+	e, ok := err.(*DeserializeError)
+	if !ok {
+		return &DeserializeError{Path: k, Err: err}
+	} else if strings.HasPrefix(e.Path, "[") {
+		return &DeserializeError{Path: k + e.Path, Err: e.Err}
+	}
+	return &DeserializeError{Path: k + "." + e.Path, Err: e.Err}
+}
+
+// stringScalar returns the JSON boolean or number that a string holds, if
+// AcceptStringScalars allows it, or the value as is.
+func stringScalar(v interface{}) interface{} {
+	s, ok := v.(string)
+	if !ok || !core.AcceptStringScalars {
+		return v
+	}
+	switch s = strings.TrimSpace(s); s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	return v
+}
+
+// booleanDeserialize turns a interface{} into a bool.
+func booleanDeserialize(v interface{}) (b *bool, err error) {
+	v = stringScalar(v)
+	if bv, ok := v.(bool); ok {
+		b = &bv
+	} else if bv, ok := v.(float64); ok {
+		if bv == 0 {
+			bvb := false
+			b = &bvb
+		} else if bv == 1 {
+			bvb := true
+			b = &bvb
+		} else {
+			err = fmt.Errorf("%d cannot be interpreted as a bool float64 for xsd:boolean", v)
+		}
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a bool for xsd:boolean", v)
+	}
+	return
+
+}
+
+// booleanSerialize simply returns the bool value
+func booleanSerialize(v bool) (r bool) {
+	r = v
+	return
+
+}
+
+// nonNegativeIntegerDeserialize turns a interface{} into a positive int64 value.
+func nonNegativeIntegerDeserialize(v interface{}) (i *int64, err error) {
+	v = stringScalar(v)
+	if fv, ok := v.(float64); ok {
+		iv := int64(fv)
+		if iv >= 0 {
+			i = &iv
+		} else {
+			err = fmt.Errorf("%d is a negative integer for xsd:nonNegativeInteger", iv)
+		}
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a float for xsd:nonNegativeInteger", v)
+	}
+	return
+
+}
+
+// nonNegativeIntegerSerialize simply returns the int64 value.
+func nonNegativeIntegerSerialize(i int64) (r int64) {
+	r = i
+	return
+
+}
+
+// stringDeserialize turns a interface{} into a string.
+func stringDeserialize(v interface{}) (s *string, err error) {
+	if sv, ok := v.(string); ok {
+		s = &sv
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a string for xsd:string", v)
+	}
+	return
+
+}
+
+// stringSerialize simply returns the string value
+func stringSerialize(s string) (r string) {
+	r = s
+	return
+
+}
+
+// floatDeserialize turns a interface{} into a float64.
+func floatDeserialize(v interface{}) (f *float64, err error) {
+	v = stringScalar(v)
+	if fv, ok := v.(float64); ok {
+		f = &fv
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a float for xsd:float", v)
+	}
+	return
+
+}
+
+// floatSerialize simply returns the float value
+func floatSerialize(f float64) (r float64) {
+	r = f
+	return
+
+}
+
+// langStringDeserialize turns a RDF interface{} into a string.
+func langStringDeserialize(v interface{}) (s *string, err error) {
+	if sv, ok := v.(string); ok {
+		s = &sv
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a string for rdf:langString", v)
+	}
+	return
+
+}
+
+// langStringSerialize returns a formatted RDF value.
+func langStringSerialize(s string) (r string) {
+	r = s
+	return
+
+}
+
+// dateTimeDeserialize turns a string into a time.
+func dateTimeDeserialize(v interface{}) (t *time.Time, err error) {
+	if s, ok := v.(string); ok {
+		tmp, err := parseDateTime(s)
+		if err != nil {
+			return nil, err
+		}
+		t = &tmp
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a string for xsd:dateTime", v)
+	}
+	return
+
+}
+
+// dateTimeSerialize turns a time into a string
+func dateTimeSerialize(t time.Time) (s string) {
+	s = formatDateTime(t)
+	return
+
+}
+
+// anyURIDeserialize turns a string into a URI.
+func anyURIDeserialize(v interface{}) (u *url.URL, err error) {
+	if s, ok := v.(string); ok {
+		u, err = url.Parse(s)
+		if err != nil {
+			err = fmt.Errorf("%s cannot be interpreted as xsd:anyURI", s)
+		}
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a string for xsd:anyURI", v)
+	}
+	return
+
+}
+
+// anyURISerialize turns a URI into a string
+func anyURISerialize(u *url.URL) (s string) {
+	s = u.String()
+	return
+
+}
+
+// mimeMediaTypeValueDeserialize turns a interface{} into a string.
+func mimeMediaTypeValueDeserialize(v interface{}) (s *string, err error) {
+	if sv, ok := v.(string); ok {
+		s = &sv
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a string for MIME media type value", v)
+	}
+	return
+
+}
+
+// mimeMediaTypeValueSerialize simply returns the string value
+func mimeMediaTypeValueSerialize(s string) (r string) {
+	r = s
+	return
+
+}
+
+// durationDeserialize turns a interface{} into a time.Duration.
+func durationDeserialize(v interface{}) (d *time.Duration, err error) {
+	if sv, ok := v.(string); ok {
+		dur, err := parseDuration(sv)
+		if err != nil {
+			return nil, err
+		}
+		d = &dur
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a string for xsd:duration", v)
+	}
+	return
+
+}
+
+// durationSerialize returns the duration as a string.
+func durationSerialize(d time.Duration) (s string) {
+	return formatDuration(d)
+
+}
+
+// IRIDeserialize turns a string into a URI.
+func IRIDeserialize(v interface{}) (u *url.URL, err error) {
+	if s, ok := v.(string); ok {
+		u, err = url.Parse(s)
+		if err != nil {
+			err = fmt.Errorf("%s cannot be interpreted as IRI", s)
+		}
+	} else {
+		err = fmt.Errorf("%v cannot be interpreted as a string for IRI", v)
+	}
+	return
+
+}
+
+// IRISerialize turns an IRI into a string
+func IRISerialize(u *url.URL) (s string) {
+	s = u.String()
+	return
+
+}
+
+// HasTypeEmoji returns true if the Typer has a type of Emoji.
+func HasTypeEmoji(t Typer) (b bool) {
+	for i := 0; i < t.TypeLen(); i++ {
+		v := t.GetType(i)
+		if s, ok := v.(string); ok {
+			if s == "Emoji" {
+				return true
+			}
+		}
+	}
+	return false
+
+}
+
+// resolveObject turns a string type that extends Object into a concrete type, including those of the ExtensionTypes.
+func resolveObject(s string) (i interface{}) {
+	if s == "Object" {
+		return &Object{}
+	}
+	if s == "Activity" {
+		return &Activity{}
+	}
+	if s == "IntransitiveActivity" {
+		return &IntransitiveActivity{}
+	}
+	if s == "Collection" {
+		return &Collection{}
+	}
+	if s == "OrderedCollection" {
+		return &OrderedCollection{}
+	}
+	if s == "CollectionPage" {
+		return &CollectionPage{}
+	}
+	if s == "OrderedCollectionPage" {
+		return &OrderedCollectionPage{}
+	}
+	if s == "Accept" {
+		return &Accept{}
+	}
+	if s == "TentativeAccept" {
+		return &TentativeAccept{}
+	}
+	if s == "Add" {
+		return &Add{}
+	}
+	if s == "Arrive" {
+		return &Arrive{}
+	}
+	if s == "Create" {
+		return &Create{}
+	}
+	if s == "Delete" {
+		return &Delete{}
+	}
+	if s == "Follow" {
+		return &Follow{}
+	}
+	if s == "Ignore" {
+		return &Ignore{}
+	}
+	if s == "Join" {
+		return &Join{}
+	}
+	if s == "Leave" {
+		return &Leave{}
+	}
+	if s == "Like" {
+		return &Like{}
+	}
+	if s == "Offer" {
+		return &Offer{}
+	}
+	if s == "Invite" {
+		return &Invite{}
+	}
+	if s == "Reject" {
+		return &Reject{}
+	}
+	if s == "TentativeReject" {
+		return &TentativeReject{}
+	}
+	if s == "Remove" {
+		return &Remove{}
+	}
+	if s == "Undo" {
+		return &Undo{}
+	}
+	if s == "Update" {
+		return &Update{}
+	}
+	if s == "View" {
+		return &View{}
+	}
+	if s == "Listen" {
+		return &Listen{}
+	}
+	if s == "Read" {
+		return &Read{}
+	}
+	if s == "Move" {
+		return &Move{}
+	}
+	if s == "Travel" {
+		return &Travel{}
+	}
+	if s == "Announce" {
+		return &Announce{}
+	}
+	if s == "Block" {
+		return &Block{}
+	}
+	if s == "Flag" {
+		return &Flag{}
+	}
+	if s == "Dislike" {
+		return &Dislike{}
+	}
+	if s == "Question" {
+		return &Question{}
+	}
+	if s == "Application" {
+		return &Application{}
+	}
+	if s == "Group" {
+		return &Group{}
+	}
+	if s == "Organization" {
+		return &Organization{}
+	}
+	if s == "Person" {
+		return &Person{}
+	}
+	if s == "Service" {
+		return &Service{}
+	}
+	if s == "Relationship" {
+		return &Relationship{}
+	}
+	if s == "Article" {
+		return &Article{}
+	}
+	if s == "Document" {
+		return &Document{}
+	}
+	if s == "Audio" {
+		return &Audio{}
+	}
+	if s == "Image" {
+		return &Image{}
+	}
+	if s == "Video" {
+		return &Video{}
+	}
+	if s == "Note" {
+		return &Note{}
+	}
+	if s == "Page" {
+		return &Page{}
+	}
+	if s == "Event" {
+		return &Event{}
+	}
+	if s == "Place" {
+		return &Place{}
+	}
+	if s == "Profile" {
+		return &Profile{}
+	}
+	if s == "Tombstone" {
+		return &Tombstone{}
+	}
+	if s == "Emoji" {
+		return &Emoji{}
+	}
+	if fn, ok := core.ExtensionTypes[s]; ok {
+		return fn()
+	}
+	return nil
+
+}
+
+// resolveLink turns a string type that extends Link into a concrete type, including those of the ExtensionTypes.
+func resolveLink(s string) (i interface{}) {
+	if s == "Link" {
+		return &Link{}
+	}
+	if s == "Mention" {
+		return &Mention{}
+	}
+	if s == "Hashtag" {
+		return &Hashtag{}
+	}
+	if fn, ok := core.ExtensionTypes[s]; ok {
+		return fn()
+	}
+	return nil
+
+}
+
+// unknownValueDeserialize transparently stores the object.
+func unknownValueDeserialize(v interface{}) (o interface{}) {
+	o = v
+	return
+
+}
+
+// unknownValueSerialize transparently returns the object.
+func unknownValueSerialize(v interface{}) (o interface{}) {
+	o = v
+	return
+
+}
+
+// cloneValue deeply copies the generic maps and slices of a value in its map[string]interface{} form.
+func cloneValue(v interface{}) (o interface{}) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			m[k] = cloneValue(e)
+		}
+		o = m
+	case []interface{}:
+		s := make([]interface{}, len(x))
+		for i, e := range x {
+			s[i] = cloneValue(e)
+		}
+		o = s
+	default:
+		o = v
+	}
+	return
+
+}
+
+// setSerializedValues sets the serialized values of a property that is not functional, as the only value if there is one and as an array otherwise.
+func setSerializedValues(m map[string]interface{}, k string, v []interface{}) {
+	if len(v) == 1 {
+		m[k] = v[0]
+	} else {
+		m[k] = v
+	}
+
+}
+
+// canonicalJSON encodes the serialized form of a value as JSON with sorted keys, so that equal values always have the same encoding.
+func canonicalJSON(s Serializer) (b []byte, err error) {
+	m, err := s.Serialize()
+	if err != nil {
+		return
+	}
+	return json.Marshal(m)
+
+}
+
+// canonicalEquals determines whether two values have the same canonical encoding. Values that cannot be serialized are never equal.
+func canonicalEquals(a Serializer, o Serializer) (eq bool) {
+	ab, err := canonicalJSON(a)
+	if err != nil {
+		return false
+	}
+	ob, err := canonicalJSON(o)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ab, ob)
+
+}
+
+// canonicalHash is the SHA-256 hash of the canonical encoding of a value, or the zero hash if it cannot be serialized.
+func canonicalHash(s Serializer) (h [32]byte) {
+	c, err := canonicalJSON(s)
+	if err != nil {
+		return
+	}
+	return sha256.Sum256(c)
+
+}
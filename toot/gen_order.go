@@ -0,0 +1,217 @@
+//
+package toot
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// propertyOrder is the order of the properties of a JSON object, and of the
+// objects its values contain, as they were unmarshalled.
+type propertyOrder struct {
+	// keys are the properties of the object, in order.
+	keys []string
+	// members are the orders of the values of the properties, if they
+	// contain objects.
+	members map[string]*propertyOrder
+	// elems are the orders of the elements of an array, if they contain
+	// objects.
+	elems []*propertyOrder
+}
+
+// scanPropertyOrder returns the order of the properties of the objects in the
+// JSON, which must be valid, or nil if it contains no object.
+func scanPropertyOrder(b []byte) *propertyOrder {
+	s := &orderScanner{b: b}
+	return s.value()
+}
+
+// orderScanner scans valid JSON for the order of the properties of its objects.
+type orderScanner struct {
+	b []byte
+	i int
+}
+
+// space skips whitespace.
+func (s *orderScanner) space() {
+	for s.i < len(s.b) {
+		switch s.b[s.i] {
+		case ' ', '\t', '\n', '\r':
+			s.i++
+		default:
+			return
+		}
+	}
+}
+
+// value scans a value, returning the order of the objects it contains.
+func (s *orderScanner) value() *propertyOrder {
+	s.space()
+	if s.i >= len(s.b) {
+		return nil
+	}
+	switch s.b[s.i] {
+	case '{':
+		s.i++
+		o := &propertyOrder{}
+		for {
+			s.space()
+			if s.i >= len(s.b) || s.b[s.i] == '}' {
+				s.i++
+				return o
+			} else if s.b[s.i] == ',' {
+				s.i++
+				continue
+			}
+			k := s.str()
+			s.space()
+			s.i++
+			if v := s.value(); v != nil {
+				if o.members == nil {
+					o.members = make(map[string]*propertyOrder)
+				}
+				o.members[k] = v
+			}
+			o.keys = append(o.keys, k)
+		}
+	case '[':
+		s.i++
+		var o *propertyOrder
+		for n := 0; ; {
+			s.space()
+			if s.i >= len(s.b) || s.b[s.i] == ']' {
+				s.i++
+				return o
+			} else if s.b[s.i] == ',' {
+				s.i++
+				continue
+			}
+			if v := s.value(); v != nil {
+				if o == nil {
+					o = &propertyOrder{}
+				}
+				for len(o.elems) < n {
+					o.elems = append(o.elems, nil)
+				}
+				o.elems = append(o.elems, v)
+			}
+			n++
+		}
+	case '"':
+		s.str()
+	default:
+		for s.i < len(s.b) {
+			switch s.b[s.i] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				return nil
+			}
+			s.i++
+		}
+	}
+	return nil
+}
+
+// str scans a string, returning it unquoted.
+func (s *orderScanner) str() (k string) {
+	start := s.i
+	escaped := false
+	for s.i++; s.i < len(s.b); s.i++ {
+		switch s.b[s.i] {
+		case '\\':
+			escaped = true
+			s.i++
+		case '"':
+			s.i++
+			raw := s.b[start:s.i]
+			if !escaped {
+				return string(raw[1 : len(raw)-1])
+			}
+			json.Unmarshal(raw, &k)
+			return
+		}
+	}
+	return
+}
+
+// encodeOrderedJSON encodes the serialized form of a value as JSON, with the
+// properties of its objects in the order, followed by those not in it sorted.
+func encodeOrderedJSON(v interface{}, o *propertyOrder) ([]byte, error) {
+	var b bytes.Buffer
+	err := appendOrderedJSON(&b, v, o)
+	return b.Bytes(), err
+}
+
+// appendOrderedJSON writes the JSON encoding of the value in the order.
+func appendOrderedJSON(b *bytes.Buffer, v interface{}, o *propertyOrder) error {
+	if o != nil {
+		switch x := v.(type) {
+		case map[string]interface{}:
+			if len(o.keys) == 0 && len(o.elems) > 0 {
+				// The single value of a property unmarshalled from an
+				// array.
+				return appendOrderedJSON(b, v, o.elems[0])
+			}
+			keys := make([]string, 0, len(x))
+			seen := make(map[string]bool, len(o.keys))
+			for _, k := range o.keys {
+				if _, ok := x[k]; ok && !seen[k] {
+					seen[k] = true
+					keys = append(keys, k)
+				}
+			}
+			n := len(keys)
+			for k := range x {
+				if !seen[k] {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys[n:])
+			b.WriteByte('{')
+			for i, k := range keys {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				kb, err := json.Marshal(k)
+				if err != nil {
+					return err
+				}
+				b.Write(kb)
+				b.WriteByte(':')
+				if err = appendOrderedJSON(b, x[k], o.members[k]); err != nil {
+					return err
+				}
+			}
+			b.WriteByte('}')
+			return nil
+		case []interface{}:
+			elems := o.elems
+			if len(elems) == 0 && len(o.keys) > 0 {
+				// The values of a property unmarshalled from a single
+				// object.
+				elems = []*propertyOrder{o}
+			}
+			b.WriteByte('[')
+			for i, e := range x {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				var eo *propertyOrder
+				if i < len(elems) {
+					eo = elems[i]
+				}
+				if err := appendOrderedJSON(b, e, eo); err != nil {
+					return err
+				}
+			}
+			b.WriteByte(']')
+			return nil
+		}
+	}
+	vb, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b.Write(vb)
+	return nil
+}
@@ -0,0 +1,118 @@
+//
+package toot
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ComparePublished orders values by their published dates, earliest first.
+// Values without a published date, such as those with only an IRI for it, are
+// ordered after the values with one. It returns a negative number if a is
+// before b, a positive number if a is after b, and zero otherwise.
+func ComparePublished(a, b Serializer) int {
+	at, aok := publishedOf(a)
+	bt, bok := publishedOf(b)
+	switch {
+	case !aok && !bok:
+		return 0
+	case !aok:
+		return 1
+	case !bok:
+		return -1
+	case at.Before(bt):
+		return -1
+	case at.After(bt):
+		return 1
+	}
+	return 0
+}
+
+// publishedOf returns the published date of the value, if it has one.
+func publishedOf(s Serializer) (t time.Time, ok bool) {
+	p, is := s.(interface {
+		IsPublished() bool
+		GetPublished() time.Time
+	})
+	if !is || !p.IsPublished() {
+		return
+	}
+	return p.GetPublished(), true
+}
+
+// CompareId orders values by their ids as strings. Values without an id are
+// ordered after the values with one. It returns a negative number if a is
+// before b, a positive number if a is after b, and zero otherwise.
+func CompareId(a, b Serializer) int {
+	as, aok := idOf(a)
+	bs, bok := idOf(b)
+	switch {
+	case !aok && !bok:
+		return 0
+	case !aok:
+		return 1
+	case !bok:
+		return -1
+	}
+	return strings.Compare(as, bs)
+}
+
+// idOf returns the id of the value as a string, if it has one.
+func idOf(s Serializer) (id string, ok bool) {
+	i, is := s.(interface {
+		HasId() bool
+		GetId() *url.URL
+	})
+	if !is || !i.HasId() {
+		return
+	}
+	return i.GetId().String(), true
+}
+
+// CompareCanonical orders values by their canonical serialized forms, so that
+// sorting gives the same order however the values were built. Values that
+// cannot be serialized are ordered after the values that can. It returns a
+// negative number if a is before b, a positive number if a is after b, and zero
+// otherwise.
+func CompareCanonical(a, b Serializer) int {
+	ab, aerr := canonicalJSON(a)
+	bb, berr := canonicalJSON(b)
+	switch {
+	case aerr != nil && berr != nil:
+		return 0
+	case aerr != nil:
+		return 1
+	case berr != nil:
+		return -1
+	}
+	return bytes.Compare(ab, bb)
+}
+
+// EmojiSlice sorts a slice of Emoji values with a comparison such as
+// ComparePublished, CompareId, or CompareCanonical:
+//
+//	sort.Stable(EmojiSlice{Values: s, Compare: ComparePublished})
+type EmojiSlice struct {
+	// Values are the values being sorted.
+	Values []*Emoji
+	// Compare orders two of the values, returning a negative number if the
+	// first is before the second.
+	Compare func(a, b Serializer) int
+}
+
+// Len is the number of values.
+func (s EmojiSlice) Len() int {
+	return len(s.Values)
+}
+
+// Less determines whether the value at i is ordered before the value at j.
+func (s EmojiSlice) Less(i, j int) bool {
+	return s.Compare(s.Values[i], s.Values[j]) < 0
+}
+
+// Swap swaps the values at i and j.
+func (s EmojiSlice) Swap(i, j int) {
+	s.Values[i], s.Values[j] = s.Values[j], s.Values[i]
+}
@@ -0,0 +1,118 @@
+//
+//go:generate go install github.com/go-fed/activity/tools/toot
+//go:generate toot
+package toot
+
+import (
+	"encoding/json"
+	"github.com/go-fed/activity/vocab"
+	"net/url"
+	"testing"
+)
+
+func TestAttachedProperties(t *testing.T) {
+	const actor = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}],
+  "type": "Person",
+  "id": "https://example.com/users/alice",
+  "featured": "https://example.com/users/alice/collections/featured",
+  "discoverable": true
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(actor), &m); err != nil {
+		t.Fatal(err)
+	}
+	p := &vocab.Person{}
+	if err := p.Deserialize(m); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	if !IsDiscoverable(p) || !GetDiscoverable(p) {
+		t.Errorf("Expected the actor to be discoverable")
+	}
+	if IsSuspended(p) {
+		t.Errorf("Expected the actor to have no 'suspended'")
+	}
+	if !IsFeaturedIRI(p) {
+		t.Fatalf("Expected the IRI of the featured collection")
+	} else if got := GetFeaturedIRI(p).String(); got != "https://example.com/users/alice/collections/featured" {
+		t.Errorf("Expected the featured collection, got %s", got)
+	}
+
+	if err := SetSuspended(p, true); err != nil {
+		t.Fatalf("Cannot SetSuspended: %s", err)
+	}
+	s, err := p.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	}
+	if s["suspended"] != true || s["discoverable"] != true {
+		t.Errorf("Expected the properties to be serialized, got %v", s)
+	}
+	if s["featured"] != "https://example.com/users/alice/collections/featured" {
+		t.Errorf("Expected the featured collection to be kept, got %v", s["featured"])
+	}
+
+	q := &vocab.Question{}
+	if err = SetVotersCount(q, 42); err != nil {
+		t.Fatalf("Cannot SetVotersCount: %s", err)
+	}
+	b, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Cannot marshal: %s", err)
+	}
+	q = &vocab.Question{}
+	if err = json.Unmarshal(b, q); err != nil {
+		t.Fatalf("Cannot unmarshal: %s", err)
+	} else if !IsVotersCount(q) || GetVotersCount(q) != 42 {
+		t.Errorf("Expected 42 voters after a round trip of %s", b)
+	}
+
+	i := &vocab.Image{}
+	if err = SetBlurhash(i, "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH"); err != nil {
+		t.Fatalf("Cannot SetBlurhash: %s", err)
+	} else if GetBlurhash(i) != "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH" {
+		t.Errorf("Expected the blurhash, got %q", GetBlurhash(i))
+	}
+	i.SetUnknownProperty("blurhash", nil)
+	if IsBlurhash(i) {
+		t.Errorf("Expected the blurhash to be removed")
+	}
+}
+
+func TestEmoji(t *testing.T) {
+	const emoji = `{
+  "id": "https://example.com/emojis/1",
+  "type": "Emoji",
+  "name": ":blobcat:",
+  "icon": {
+    "type": "Image",
+    "mediaType": "image/png",
+    "url": "https://example.com/files/blobcat.png"
+  }
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(emoji), &m); err != nil {
+		t.Fatal(err)
+	}
+	e := &Emoji{}
+	if err := e.Deserialize(m); err != nil {
+		t.Fatalf("Cannot Deserialize: %s", err)
+	}
+	var o vocab.ObjectType = e
+	if o.NameLen() != 1 || o.GetNameString(0) != ":blobcat:" {
+		t.Errorf("Expected the shortcode as the name")
+	}
+	if o.IconLen() != 1 || !o.IsIconImage(0) {
+		t.Fatalf("Expected the image as the icon")
+	}
+	u, _ := url.Parse("https://example.com/emojis/1")
+	if e.GetId().String() != u.String() {
+		t.Errorf("Expected the id %s, got %s", u, e.GetId())
+	}
+	s, err := e.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	} else if s["type"] != "Emoji" {
+		t.Errorf("Expected the Emoji type, got %v", s["type"])
+	}
+}
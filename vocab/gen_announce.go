@@ -2,7 +2,10 @@
 package vocab
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"sort"
 	"time"
 )
 
@@ -16,16 +19,19 @@ type AnnounceType interface {
 	AppendActorObject(v ObjectType)
 	PrependActorObject(v ObjectType)
 	RemoveActorObject(index int)
+	ActorObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsActorLink(index int) (ok bool)
 	GetActorLink(index int) (v LinkType)
 	AppendActorLink(v LinkType)
 	PrependActorLink(v LinkType)
 	RemoveActorLink(index int)
+	ActorLinkValues() (seq func(yield func(v LinkType) bool))
 	IsActorIRI(index int) (ok bool)
 	GetActorIRI(index int) (v *url.URL)
 	AppendActorIRI(v *url.URL)
 	PrependActorIRI(v *url.URL)
 	RemoveActorIRI(index int)
+	ActorIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownActor() (ok bool)
 	GetUnknownActor() (v interface{})
 	SetUnknownActor(i interface{})
@@ -35,11 +41,13 @@ type AnnounceType interface {
 	AppendObject(v ObjectType)
 	PrependObject(v ObjectType)
 	RemoveObject(index int)
+	ObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsObjectIRI(index int) (ok bool)
 	GetObjectIRI(index int) (v *url.URL)
 	AppendObjectIRI(v *url.URL)
 	PrependObjectIRI(v *url.URL)
 	RemoveObjectIRI(index int)
+	ObjectIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownObject() (ok bool)
 	GetUnknownObject() (v interface{})
 	SetUnknownObject(i interface{})
@@ -49,16 +57,19 @@ type AnnounceType interface {
 	AppendTargetObject(v ObjectType)
 	PrependTargetObject(v ObjectType)
 	RemoveTargetObject(index int)
+	TargetObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsTargetLink(index int) (ok bool)
 	GetTargetLink(index int) (v LinkType)
 	AppendTargetLink(v LinkType)
 	PrependTargetLink(v LinkType)
 	RemoveTargetLink(index int)
+	TargetLinkValues() (seq func(yield func(v LinkType) bool))
 	IsTargetIRI(index int) (ok bool)
 	GetTargetIRI(index int) (v *url.URL)
 	AppendTargetIRI(v *url.URL)
 	PrependTargetIRI(v *url.URL)
 	RemoveTargetIRI(index int)
+	TargetIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownTarget() (ok bool)
 	GetUnknownTarget() (v interface{})
 	SetUnknownTarget(i interface{})
@@ -68,16 +79,19 @@ type AnnounceType interface {
 	AppendResultObject(v ObjectType)
 	PrependResultObject(v ObjectType)
 	RemoveResultObject(index int)
+	ResultObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsResultLink(index int) (ok bool)
 	GetResultLink(index int) (v LinkType)
 	AppendResultLink(v LinkType)
 	PrependResultLink(v LinkType)
 	RemoveResultLink(index int)
+	ResultLinkValues() (seq func(yield func(v LinkType) bool))
 	IsResultIRI(index int) (ok bool)
 	GetResultIRI(index int) (v *url.URL)
 	AppendResultIRI(v *url.URL)
 	PrependResultIRI(v *url.URL)
 	RemoveResultIRI(index int)
+	ResultIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownResult() (ok bool)
 	GetUnknownResult() (v interface{})
 	SetUnknownResult(i interface{})
@@ -87,16 +101,19 @@ type AnnounceType interface {
 	AppendOriginObject(v ObjectType)
 	PrependOriginObject(v ObjectType)
 	RemoveOriginObject(index int)
+	OriginObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsOriginLink(index int) (ok bool)
 	GetOriginLink(index int) (v LinkType)
 	AppendOriginLink(v LinkType)
 	PrependOriginLink(v LinkType)
 	RemoveOriginLink(index int)
+	OriginLinkValues() (seq func(yield func(v LinkType) bool))
 	IsOriginIRI(index int) (ok bool)
 	GetOriginIRI(index int) (v *url.URL)
 	AppendOriginIRI(v *url.URL)
 	PrependOriginIRI(v *url.URL)
 	RemoveOriginIRI(index int)
+	OriginIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownOrigin() (ok bool)
 	GetUnknownOrigin() (v interface{})
 	SetUnknownOrigin(i interface{})
@@ -106,16 +123,19 @@ type AnnounceType interface {
 	AppendInstrumentObject(v ObjectType)
 	PrependInstrumentObject(v ObjectType)
 	RemoveInstrumentObject(index int)
+	InstrumentObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsInstrumentLink(index int) (ok bool)
 	GetInstrumentLink(index int) (v LinkType)
 	AppendInstrumentLink(v LinkType)
 	PrependInstrumentLink(v LinkType)
 	RemoveInstrumentLink(index int)
+	InstrumentLinkValues() (seq func(yield func(v LinkType) bool))
 	IsInstrumentIRI(index int) (ok bool)
 	GetInstrumentIRI(index int) (v *url.URL)
 	AppendInstrumentIRI(v *url.URL)
 	PrependInstrumentIRI(v *url.URL)
 	RemoveInstrumentIRI(index int)
+	InstrumentIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownInstrument() (ok bool)
 	GetUnknownInstrument() (v interface{})
 	SetUnknownInstrument(i interface{})
@@ -134,16 +154,19 @@ type AnnounceType interface {
 	AppendAttachmentObject(v ObjectType)
 	PrependAttachmentObject(v ObjectType)
 	RemoveAttachmentObject(index int)
+	AttachmentObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAttachmentLink(index int) (ok bool)
 	GetAttachmentLink(index int) (v LinkType)
 	AppendAttachmentLink(v LinkType)
 	PrependAttachmentLink(v LinkType)
 	RemoveAttachmentLink(index int)
+	AttachmentLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAttachmentIRI(index int) (ok bool)
 	GetAttachmentIRI(index int) (v *url.URL)
 	AppendAttachmentIRI(v *url.URL)
 	PrependAttachmentIRI(v *url.URL)
 	RemoveAttachmentIRI(index int)
+	AttachmentIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAttachment() (ok bool)
 	GetUnknownAttachment() (v interface{})
 	SetUnknownAttachment(i interface{})
@@ -153,16 +176,19 @@ type AnnounceType interface {
 	AppendAttributedToObject(v ObjectType)
 	PrependAttributedToObject(v ObjectType)
 	RemoveAttributedToObject(index int)
+	AttributedToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAttributedToLink(index int) (ok bool)
 	GetAttributedToLink(index int) (v LinkType)
 	AppendAttributedToLink(v LinkType)
 	PrependAttributedToLink(v LinkType)
 	RemoveAttributedToLink(index int)
+	AttributedToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAttributedToIRI(index int) (ok bool)
 	GetAttributedToIRI(index int) (v *url.URL)
 	AppendAttributedToIRI(v *url.URL)
 	PrependAttributedToIRI(v *url.URL)
 	RemoveAttributedToIRI(index int)
+	AttributedToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAttributedTo() (ok bool)
 	GetUnknownAttributedTo() (v interface{})
 	SetUnknownAttributedTo(i interface{})
@@ -172,16 +198,19 @@ type AnnounceType interface {
 	AppendAudienceObject(v ObjectType)
 	PrependAudienceObject(v ObjectType)
 	RemoveAudienceObject(index int)
+	AudienceObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAudienceLink(index int) (ok bool)
 	GetAudienceLink(index int) (v LinkType)
 	AppendAudienceLink(v LinkType)
 	PrependAudienceLink(v LinkType)
 	RemoveAudienceLink(index int)
+	AudienceLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAudienceIRI(index int) (ok bool)
 	GetAudienceIRI(index int) (v *url.URL)
 	AppendAudienceIRI(v *url.URL)
 	PrependAudienceIRI(v *url.URL)
 	RemoveAudienceIRI(index int)
+	AudienceIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAudience() (ok bool)
 	GetUnknownAudience() (v interface{})
 	SetUnknownAudience(i interface{})
@@ -191,38 +220,47 @@ type AnnounceType interface {
 	AppendContentString(v string)
 	PrependContentString(v string)
 	RemoveContentString(index int)
+	ContentStringValues() (seq func(yield func(v string) bool))
 	IsContentLangString(index int) (ok bool)
 	GetContentLangString(index int) (v string)
 	AppendContentLangString(v string)
 	PrependContentLangString(v string)
 	RemoveContentLangString(index int)
+	ContentLangStringValues() (seq func(yield func(v string) bool))
 	IsContentIRI(index int) (ok bool)
 	GetContentIRI(index int) (v *url.URL)
 	AppendContentIRI(v *url.URL)
 	PrependContentIRI(v *url.URL)
 	RemoveContentIRI(index int)
+	ContentIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownContent() (ok bool)
 	GetUnknownContent() (v interface{})
 	SetUnknownContent(i interface{})
 	ContentMapLanguages() (l []string)
 	GetContentMap(l string) (v string)
 	SetContentMap(l string, v string)
+	GetContentLanguage(tag string) (v string, ok bool)
+	SetContentLanguage(tag string, v string)
+	PreferredContentLanguage(tags ...string) (v string, tag string)
 	ContextLen() (l int)
 	IsContextObject(index int) (ok bool)
 	GetContextObject(index int) (v ObjectType)
 	AppendContextObject(v ObjectType)
 	PrependContextObject(v ObjectType)
 	RemoveContextObject(index int)
+	ContextObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsContextLink(index int) (ok bool)
 	GetContextLink(index int) (v LinkType)
 	AppendContextLink(v LinkType)
 	PrependContextLink(v LinkType)
 	RemoveContextLink(index int)
+	ContextLinkValues() (seq func(yield func(v LinkType) bool))
 	IsContextIRI(index int) (ok bool)
 	GetContextIRI(index int) (v *url.URL)
 	AppendContextIRI(v *url.URL)
 	PrependContextIRI(v *url.URL)
 	RemoveContextIRI(index int)
+	ContextIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownContext() (ok bool)
 	GetUnknownContext() (v interface{})
 	SetUnknownContext(i interface{})
@@ -232,22 +270,28 @@ type AnnounceType interface {
 	AppendNameString(v string)
 	PrependNameString(v string)
 	RemoveNameString(index int)
+	NameStringValues() (seq func(yield func(v string) bool))
 	IsNameLangString(index int) (ok bool)
 	GetNameLangString(index int) (v string)
 	AppendNameLangString(v string)
 	PrependNameLangString(v string)
 	RemoveNameLangString(index int)
+	NameLangStringValues() (seq func(yield func(v string) bool))
 	IsNameIRI(index int) (ok bool)
 	GetNameIRI(index int) (v *url.URL)
 	AppendNameIRI(v *url.URL)
 	PrependNameIRI(v *url.URL)
 	RemoveNameIRI(index int)
+	NameIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownName() (ok bool)
 	GetUnknownName() (v interface{})
 	SetUnknownName(i interface{})
 	NameMapLanguages() (l []string)
 	GetNameMap(l string) (v string)
 	SetNameMap(l string, v string)
+	GetNameLanguage(tag string) (v string, ok bool)
+	SetNameLanguage(tag string, v string)
+	PreferredNameLanguage(tags ...string) (v string, tag string)
 	IsEndTime() (ok bool)
 	GetEndTime() (v time.Time)
 	SetEndTime(v time.Time)
@@ -263,16 +307,19 @@ type AnnounceType interface {
 	AppendGeneratorObject(v ObjectType)
 	PrependGeneratorObject(v ObjectType)
 	RemoveGeneratorObject(index int)
+	GeneratorObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsGeneratorLink(index int) (ok bool)
 	GetGeneratorLink(index int) (v LinkType)
 	AppendGeneratorLink(v LinkType)
 	PrependGeneratorLink(v LinkType)
 	RemoveGeneratorLink(index int)
+	GeneratorLinkValues() (seq func(yield func(v LinkType) bool))
 	IsGeneratorIRI(index int) (ok bool)
 	GetGeneratorIRI(index int) (v *url.URL)
 	AppendGeneratorIRI(v *url.URL)
 	PrependGeneratorIRI(v *url.URL)
 	RemoveGeneratorIRI(index int)
+	GeneratorIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownGenerator() (ok bool)
 	GetUnknownGenerator() (v interface{})
 	SetUnknownGenerator(i interface{})
@@ -282,16 +329,19 @@ type AnnounceType interface {
 	AppendIconImage(v ImageType)
 	PrependIconImage(v ImageType)
 	RemoveIconImage(index int)
+	IconImageValues() (seq func(yield func(v ImageType) bool))
 	IsIconLink(index int) (ok bool)
 	GetIconLink(index int) (v LinkType)
 	AppendIconLink(v LinkType)
 	PrependIconLink(v LinkType)
 	RemoveIconLink(index int)
+	IconLinkValues() (seq func(yield func(v LinkType) bool))
 	IsIconIRI(index int) (ok bool)
 	GetIconIRI(index int) (v *url.URL)
 	AppendIconIRI(v *url.URL)
 	PrependIconIRI(v *url.URL)
 	RemoveIconIRI(index int)
+	IconIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownIcon() (ok bool)
 	GetUnknownIcon() (v interface{})
 	SetUnknownIcon(i interface{})
@@ -307,16 +357,19 @@ type AnnounceType interface {
 	AppendImageImage(v ImageType)
 	PrependImageImage(v ImageType)
 	RemoveImageImage(index int)
+	ImageImageValues() (seq func(yield func(v ImageType) bool))
 	IsImageLink(index int) (ok bool)
 	GetImageLink(index int) (v LinkType)
 	AppendImageLink(v LinkType)
 	PrependImageLink(v LinkType)
 	RemoveImageLink(index int)
+	ImageLinkValues() (seq func(yield func(v LinkType) bool))
 	IsImageIRI(index int) (ok bool)
 	GetImageIRI(index int) (v *url.URL)
 	AppendImageIRI(v *url.URL)
 	PrependImageIRI(v *url.URL)
 	RemoveImageIRI(index int)
+	ImageIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownImage() (ok bool)
 	GetUnknownImage() (v interface{})
 	SetUnknownImage(i interface{})
@@ -326,16 +379,19 @@ type AnnounceType interface {
 	AppendInReplyToObject(v ObjectType)
 	PrependInReplyToObject(v ObjectType)
 	RemoveInReplyToObject(index int)
+	InReplyToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsInReplyToLink(index int) (ok bool)
 	GetInReplyToLink(index int) (v LinkType)
 	AppendInReplyToLink(v LinkType)
 	PrependInReplyToLink(v LinkType)
 	RemoveInReplyToLink(index int)
+	InReplyToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsInReplyToIRI(index int) (ok bool)
 	GetInReplyToIRI(index int) (v *url.URL)
 	AppendInReplyToIRI(v *url.URL)
 	PrependInReplyToIRI(v *url.URL)
 	RemoveInReplyToIRI(index int)
+	InReplyToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownInReplyTo() (ok bool)
 	GetUnknownInReplyTo() (v interface{})
 	SetUnknownInReplyTo(i interface{})
@@ -345,16 +401,19 @@ type AnnounceType interface {
 	AppendLocationObject(v ObjectType)
 	PrependLocationObject(v ObjectType)
 	RemoveLocationObject(index int)
+	LocationObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsLocationLink(index int) (ok bool)
 	GetLocationLink(index int) (v LinkType)
 	AppendLocationLink(v LinkType)
 	PrependLocationLink(v LinkType)
 	RemoveLocationLink(index int)
+	LocationLinkValues() (seq func(yield func(v LinkType) bool))
 	IsLocationIRI(index int) (ok bool)
 	GetLocationIRI(index int) (v *url.URL)
 	AppendLocationIRI(v *url.URL)
 	PrependLocationIRI(v *url.URL)
 	RemoveLocationIRI(index int)
+	LocationIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownLocation() (ok bool)
 	GetUnknownLocation() (v interface{})
 	SetUnknownLocation(i interface{})
@@ -364,16 +423,19 @@ type AnnounceType interface {
 	AppendPreviewObject(v ObjectType)
 	PrependPreviewObject(v ObjectType)
 	RemovePreviewObject(index int)
+	PreviewObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsPreviewLink(index int) (ok bool)
 	GetPreviewLink(index int) (v LinkType)
 	AppendPreviewLink(v LinkType)
 	PrependPreviewLink(v LinkType)
 	RemovePreviewLink(index int)
+	PreviewLinkValues() (seq func(yield func(v LinkType) bool))
 	IsPreviewIRI(index int) (ok bool)
 	GetPreviewIRI(index int) (v *url.URL)
 	AppendPreviewIRI(v *url.URL)
 	PrependPreviewIRI(v *url.URL)
 	RemovePreviewIRI(index int)
+	PreviewIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownPreview() (ok bool)
 	GetUnknownPreview() (v interface{})
 	SetUnknownPreview(i interface{})
@@ -410,38 +472,47 @@ type AnnounceType interface {
 	AppendSummaryString(v string)
 	PrependSummaryString(v string)
 	RemoveSummaryString(index int)
+	SummaryStringValues() (seq func(yield func(v string) bool))
 	IsSummaryLangString(index int) (ok bool)
 	GetSummaryLangString(index int) (v string)
 	AppendSummaryLangString(v string)
 	PrependSummaryLangString(v string)
 	RemoveSummaryLangString(index int)
+	SummaryLangStringValues() (seq func(yield func(v string) bool))
 	IsSummaryIRI(index int) (ok bool)
 	GetSummaryIRI(index int) (v *url.URL)
 	AppendSummaryIRI(v *url.URL)
 	PrependSummaryIRI(v *url.URL)
 	RemoveSummaryIRI(index int)
+	SummaryIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownSummary() (ok bool)
 	GetUnknownSummary() (v interface{})
 	SetUnknownSummary(i interface{})
 	SummaryMapLanguages() (l []string)
 	GetSummaryMap(l string) (v string)
 	SetSummaryMap(l string, v string)
+	GetSummaryLanguage(tag string) (v string, ok bool)
+	SetSummaryLanguage(tag string, v string)
+	PreferredSummaryLanguage(tags ...string) (v string, tag string)
 	TagLen() (l int)
 	IsTagObject(index int) (ok bool)
 	GetTagObject(index int) (v ObjectType)
 	AppendTagObject(v ObjectType)
 	PrependTagObject(v ObjectType)
 	RemoveTagObject(index int)
+	TagObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsTagLink(index int) (ok bool)
 	GetTagLink(index int) (v LinkType)
 	AppendTagLink(v LinkType)
 	PrependTagLink(v LinkType)
 	RemoveTagLink(index int)
+	TagLinkValues() (seq func(yield func(v LinkType) bool))
 	IsTagIRI(index int) (ok bool)
 	GetTagIRI(index int) (v *url.URL)
 	AppendTagIRI(v *url.URL)
 	PrependTagIRI(v *url.URL)
 	RemoveTagIRI(index int)
+	TagIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownTag() (ok bool)
 	GetUnknownTag() (v interface{})
 	SetUnknownTag(i interface{})
@@ -465,11 +536,13 @@ type AnnounceType interface {
 	AppendUrlAnyURI(v *url.URL)
 	PrependUrlAnyURI(v *url.URL)
 	RemoveUrlAnyURI(index int)
+	UrlAnyURIValues() (seq func(yield func(v *url.URL) bool))
 	IsUrlLink(index int) (ok bool)
 	GetUrlLink(index int) (v LinkType)
 	AppendUrlLink(v LinkType)
 	PrependUrlLink(v LinkType)
 	RemoveUrlLink(index int)
+	UrlLinkValues() (seq func(yield func(v LinkType) bool))
 	HasUnknownUrl() (ok bool)
 	GetUnknownUrl() (v interface{})
 	SetUnknownUrl(i interface{})
@@ -479,16 +552,19 @@ type AnnounceType interface {
 	AppendToObject(v ObjectType)
 	PrependToObject(v ObjectType)
 	RemoveToObject(index int)
+	ToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsToLink(index int) (ok bool)
 	GetToLink(index int) (v LinkType)
 	AppendToLink(v LinkType)
 	PrependToLink(v LinkType)
 	RemoveToLink(index int)
+	ToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsToIRI(index int) (ok bool)
 	GetToIRI(index int) (v *url.URL)
 	AppendToIRI(v *url.URL)
 	PrependToIRI(v *url.URL)
 	RemoveToIRI(index int)
+	ToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownTo() (ok bool)
 	GetUnknownTo() (v interface{})
 	SetUnknownTo(i interface{})
@@ -498,16 +574,19 @@ type AnnounceType interface {
 	AppendBtoObject(v ObjectType)
 	PrependBtoObject(v ObjectType)
 	RemoveBtoObject(index int)
+	BtoObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsBtoLink(index int) (ok bool)
 	GetBtoLink(index int) (v LinkType)
 	AppendBtoLink(v LinkType)
 	PrependBtoLink(v LinkType)
 	RemoveBtoLink(index int)
+	BtoLinkValues() (seq func(yield func(v LinkType) bool))
 	IsBtoIRI(index int) (ok bool)
 	GetBtoIRI(index int) (v *url.URL)
 	AppendBtoIRI(v *url.URL)
 	PrependBtoIRI(v *url.URL)
 	RemoveBtoIRI(index int)
+	BtoIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownBto() (ok bool)
 	GetUnknownBto() (v interface{})
 	SetUnknownBto(i interface{})
@@ -517,16 +596,19 @@ type AnnounceType interface {
 	AppendCcObject(v ObjectType)
 	PrependCcObject(v ObjectType)
 	RemoveCcObject(index int)
+	CcObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsCcLink(index int) (ok bool)
 	GetCcLink(index int) (v LinkType)
 	AppendCcLink(v LinkType)
 	PrependCcLink(v LinkType)
 	RemoveCcLink(index int)
+	CcLinkValues() (seq func(yield func(v LinkType) bool))
 	IsCcIRI(index int) (ok bool)
 	GetCcIRI(index int) (v *url.URL)
 	AppendCcIRI(v *url.URL)
 	PrependCcIRI(v *url.URL)
 	RemoveCcIRI(index int)
+	CcIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownCc() (ok bool)
 	GetUnknownCc() (v interface{})
 	SetUnknownCc(i interface{})
@@ -536,16 +618,19 @@ type AnnounceType interface {
 	AppendBccObject(v ObjectType)
 	PrependBccObject(v ObjectType)
 	RemoveBccObject(index int)
+	BccObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsBccLink(index int) (ok bool)
 	GetBccLink(index int) (v LinkType)
 	AppendBccLink(v LinkType)
 	PrependBccLink(v LinkType)
 	RemoveBccLink(index int)
+	BccLinkValues() (seq func(yield func(v LinkType) bool))
 	IsBccIRI(index int) (ok bool)
 	GetBccIRI(index int) (v *url.URL)
 	AppendBccIRI(v *url.URL)
 	PrependBccIRI(v *url.URL)
 	RemoveBccIRI(index int)
+	BccIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownBcc() (ok bool)
 	GetUnknownBcc() (v interface{})
 	SetUnknownBcc(i interface{})
@@ -650,6 +735,7 @@ type AnnounceType interface {
 	HasUnknownStreams() (ok bool)
 	GetUnknownStreams() (v interface{})
 	SetUnknownStreams(i interface{})
+	StreamsValues() (seq func(yield func(v *url.URL) bool))
 	IsPreferredUsername() (ok bool)
 	GetPreferredUsername() (v string)
 	SetPreferredUsername(v string)
@@ -662,6 +748,9 @@ type AnnounceType interface {
 	PreferredUsernameMapLanguages() (l []string)
 	GetPreferredUsernameMap(l string) (v string)
 	SetPreferredUsernameMap(l string, v string)
+	GetPreferredUsernameLanguage(tag string) (v string, ok bool)
+	SetPreferredUsernameLanguage(tag string, v string)
+	PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string)
 	IsEndpoints() (ok bool)
 	GetEndpoints() (v ObjectType)
 	SetEndpoints(v ObjectType)
@@ -720,12 +809,192 @@ type AnnounceType interface {
 	GetUnknownShares() (v interface{})
 	SetUnknownShares(i interface{})
 	IsPublic() (b bool)
-}
+	Validate() (err error)
+	ValidateStrict() (err error)
+	TryGetActorObject(index int) (v ObjectType, err error)
+	TryGetActorLink(index int) (v LinkType, err error)
+	TryGetActorIRI(index int) (v *url.URL, err error)
+	TryGetUnknownActor() (v interface{}, err error)
+	TryGetObject(index int) (v ObjectType, err error)
+	TryGetObjectIRI(index int) (v *url.URL, err error)
+	TryGetUnknownObject() (v interface{}, err error)
+	TryGetTargetObject(index int) (v ObjectType, err error)
+	TryGetTargetLink(index int) (v LinkType, err error)
+	TryGetTargetIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTarget() (v interface{}, err error)
+	TryGetResultObject(index int) (v ObjectType, err error)
+	TryGetResultLink(index int) (v LinkType, err error)
+	TryGetResultIRI(index int) (v *url.URL, err error)
+	TryGetUnknownResult() (v interface{}, err error)
+	TryGetOriginObject(index int) (v ObjectType, err error)
+	TryGetOriginLink(index int) (v LinkType, err error)
+	TryGetOriginIRI(index int) (v *url.URL, err error)
+	TryGetUnknownOrigin() (v interface{}, err error)
+	TryGetInstrumentObject(index int) (v ObjectType, err error)
+	TryGetInstrumentLink(index int) (v LinkType, err error)
+	TryGetInstrumentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownInstrument() (v interface{}, err error)
+	TryGetAltitude() (v float64, err error)
+	TryGetAltitudeIRI() (v *url.URL, err error)
+	TryGetUnknownAltitude() (v interface{}, err error)
+	TryGetAttachmentObject(index int) (v ObjectType, err error)
+	TryGetAttachmentLink(index int) (v LinkType, err error)
+	TryGetAttachmentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttachment() (v interface{}, err error)
+	TryGetAttributedToObject(index int) (v ObjectType, err error)
+	TryGetAttributedToLink(index int) (v LinkType, err error)
+	TryGetAttributedToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttributedTo() (v interface{}, err error)
+	TryGetAudienceObject(index int) (v ObjectType, err error)
+	TryGetAudienceLink(index int) (v LinkType, err error)
+	TryGetAudienceIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAudience() (v interface{}, err error)
+	TryGetContentString(index int) (v string, err error)
+	TryGetContentLangString(index int) (v string, err error)
+	TryGetContentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContent() (v interface{}, err error)
+	TryGetContextObject(index int) (v ObjectType, err error)
+	TryGetContextLink(index int) (v LinkType, err error)
+	TryGetContextIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContext() (v interface{}, err error)
+	TryGetNameString(index int) (v string, err error)
+	TryGetNameLangString(index int) (v string, err error)
+	TryGetNameIRI(index int) (v *url.URL, err error)
+	TryGetUnknownName() (v interface{}, err error)
+	TryGetEndTime() (v time.Time, err error)
+	TryGetEndTimeIRI() (v *url.URL, err error)
+	TryGetUnknownEndTime() (v interface{}, err error)
+	TryGetGeneratorObject(index int) (v ObjectType, err error)
+	TryGetGeneratorLink(index int) (v LinkType, err error)
+	TryGetGeneratorIRI(index int) (v *url.URL, err error)
+	TryGetUnknownGenerator() (v interface{}, err error)
+	TryGetIconImage(index int) (v ImageType, err error)
+	TryGetIconLink(index int) (v LinkType, err error)
+	TryGetIconIRI(index int) (v *url.URL, err error)
+	TryGetUnknownIcon() (v interface{}, err error)
+	TryGetId() (v *url.URL, err error)
+	TryGetUnknownId() (v interface{}, err error)
+	TryGetImageImage(index int) (v ImageType, err error)
+	TryGetImageLink(index int) (v LinkType, err error)
+	TryGetImageIRI(index int) (v *url.URL, err error)
+	TryGetUnknownImage() (v interface{}, err error)
+	TryGetInReplyToObject(index int) (v ObjectType, err error)
+	TryGetInReplyToLink(index int) (v LinkType, err error)
+	TryGetInReplyToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownInReplyTo() (v interface{}, err error)
+	TryGetLocationObject(index int) (v ObjectType, err error)
+	TryGetLocationLink(index int) (v LinkType, err error)
+	TryGetLocationIRI(index int) (v *url.URL, err error)
+	TryGetUnknownLocation() (v interface{}, err error)
+	TryGetPreviewObject(index int) (v ObjectType, err error)
+	TryGetPreviewLink(index int) (v LinkType, err error)
+	TryGetPreviewIRI(index int) (v *url.URL, err error)
+	TryGetUnknownPreview() (v interface{}, err error)
+	TryGetPublished() (v time.Time, err error)
+	TryGetPublishedIRI() (v *url.URL, err error)
+	TryGetUnknownPublished() (v interface{}, err error)
+	TryGetReplies() (v CollectionType, err error)
+	TryGetRepliesIRI() (v *url.URL, err error)
+	TryGetUnknownReplies() (v interface{}, err error)
+	TryGetStartTime() (v time.Time, err error)
+	TryGetStartTimeIRI() (v *url.URL, err error)
+	TryGetUnknownStartTime() (v interface{}, err error)
+	TryGetSummaryString(index int) (v string, err error)
+	TryGetSummaryLangString(index int) (v string, err error)
+	TryGetSummaryIRI(index int) (v *url.URL, err error)
+	TryGetUnknownSummary() (v interface{}, err error)
+	TryGetTagObject(index int) (v ObjectType, err error)
+	TryGetTagLink(index int) (v LinkType, err error)
+	TryGetTagIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTag() (v interface{}, err error)
+	TryGetType(index int) (v interface{}, err error)
+	TryGetUpdated() (v time.Time, err error)
+	TryGetUpdatedIRI() (v *url.URL, err error)
+	TryGetUnknownUpdated() (v interface{}, err error)
+	TryGetUrlAnyURI(index int) (v *url.URL, err error)
+	TryGetUrlLink(index int) (v LinkType, err error)
+	TryGetUnknownUrl() (v interface{}, err error)
+	TryGetToObject(index int) (v ObjectType, err error)
+	TryGetToLink(index int) (v LinkType, err error)
+	TryGetToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTo() (v interface{}, err error)
+	TryGetBtoObject(index int) (v ObjectType, err error)
+	TryGetBtoLink(index int) (v LinkType, err error)
+	TryGetBtoIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBto() (v interface{}, err error)
+	TryGetCcObject(index int) (v ObjectType, err error)
+	TryGetCcLink(index int) (v LinkType, err error)
+	TryGetCcIRI(index int) (v *url.URL, err error)
+	TryGetUnknownCc() (v interface{}, err error)
+	TryGetBccObject(index int) (v ObjectType, err error)
+	TryGetBccLink(index int) (v LinkType, err error)
+	TryGetBccIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBcc() (v interface{}, err error)
+	TryGetMediaType() (v string, err error)
+	TryGetMediaTypeIRI() (v *url.URL, err error)
+	TryGetUnknownMediaType() (v interface{}, err error)
+	TryGetDuration() (v time.Duration, err error)
+	TryGetDurationIRI() (v *url.URL, err error)
+	TryGetUnknownDuration() (v interface{}, err error)
+	TryGetSource() (v ObjectType, err error)
+	TryGetSourceIRI() (v *url.URL, err error)
+	TryGetUnknownSource() (v interface{}, err error)
+	TryGetInboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetInboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownInbox() (v interface{}, err error)
+	TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetOutboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownOutbox() (v interface{}, err error)
+	TryGetFollowingCollection() (v CollectionType, err error)
+	TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowingAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowing() (v interface{}, err error)
+	TryGetFollowersCollection() (v CollectionType, err error)
+	TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowersAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowers() (v interface{}, err error)
+	TryGetLikedCollection() (v CollectionType, err error)
+	TryGetLikedOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikedAnyURI() (v *url.URL, err error)
+	TryGetUnknownLiked() (v interface{}, err error)
+	TryGetLikesCollection() (v CollectionType, err error)
+	TryGetLikesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikesAnyURI() (v *url.URL, err error)
+	TryGetUnknownLikes() (v interface{}, err error)
+	TryGetStreams(index int) (v *url.URL, err error)
+	TryGetUnknownStreams() (v interface{}, err error)
+	TryGetPreferredUsername() (v string, err error)
+	TryGetPreferredUsernameIRI() (v *url.URL, err error)
+	TryGetUnknownPreferredUsername() (v interface{}, err error)
+	TryGetEndpoints() (v ObjectType, err error)
+	TryGetEndpointsIRI() (v *url.URL, err error)
+	TryGetUnknownEndpoints() (v interface{}, err error)
+	TryGetProxyUrl() (v *url.URL, err error)
+	TryGetUnknownProxyUrl() (v interface{}, err error)
+	TryGetOauthAuthorizationEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error)
+	TryGetOauthTokenEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthTokenEndpoint() (v interface{}, err error)
+	TryGetProvideClientKey() (v *url.URL, err error)
+	TryGetUnknownProvideClientKey() (v interface{}, err error)
+	TryGetSignClientKey() (v *url.URL, err error)
+	TryGetUnknownSignClientKey() (v interface{}, err error)
+	TryGetSharedInbox() (v *url.URL, err error)
+	TryGetUnknownSharedInbox() (v interface{}, err error)
+	TryGetSharesCollection() (v CollectionType, err error)
+	TryGetSharesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetSharesAnyURI() (v *url.URL, err error)
+	TryGetUnknownShares() (v interface{}, err error)
+}
+
+var _ AnnounceType = (*Announce)(nil)
 
 // Indicates that the actor is calling the target's attention the object. The origin typically has no defined meaning.
 type Announce struct {
 	// An unknown value.
 	unknown_ map[string]interface{}
+	// The order of the properties of the JSON this was unmarshalled from.
+	order_ *propertyOrder
 	// The 'actor' value could have multiple types and values
 	actor []*actorIntermediateType
 	// The 'object' value could have multiple types and values
@@ -838,6 +1107,8 @@ type Announce struct {
 	sharedInbox *url.URL
 	// The functional 'shares' value could have multiple types, but only a single value
 	shares *sharesIntermediateType
+	// The bits of the members that are set, in the order of the members.
+	present_ [1]uint64
 }
 
 // ActorLen determines the number of elements able to be used for the IsActorObject, GetActorObject, and RemoveActorObject functions
@@ -861,12 +1132,14 @@ func (t *Announce) GetActorObject(index int) (v ObjectType) {
 // AppendActorObject adds to the back of actor a ObjectType type
 func (t *Announce) AppendActorObject(v ObjectType) {
 	t.actor = append(t.actor, &actorIntermediateType{Object: v})
+	t.markPresent_(0, len(t.actor) > 0)
 
 }
 
 // PrependActorObject adds to the front of actor a ObjectType type
 func (t *Announce) PrependActorObject(v ObjectType) {
 	t.actor = append([]*actorIntermediateType{&actorIntermediateType{Object: v}}, t.actor...)
+	t.markPresent_(0, len(t.actor) > 0)
 
 }
 
@@ -875,6 +1148,22 @@ func (t *Announce) RemoveActorObject(index int) {
 	copy(t.actor[index:], t.actor[index+1:])
 	t.actor[len(t.actor)-1] = nil
 	t.actor = t.actor[:len(t.actor)-1]
+	t.markPresent_(0, len(t.actor) > 0)
+
+}
+
+// ActorObjectValues returns an iterator over the values that GetActorObject returns for each index where IsActorObject is true, which can be used as an iter.Seq
+func (t *Announce) ActorObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ActorLen(); i++ {
+			if !t.IsActorObject(i) {
+				continue
+			}
+			if !yield(t.GetActorObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -893,12 +1182,14 @@ func (t *Announce) GetActorLink(index int) (v LinkType) {
 // AppendActorLink adds to the back of actor a LinkType type
 func (t *Announce) AppendActorLink(v LinkType) {
 	t.actor = append(t.actor, &actorIntermediateType{Link: v})
+	t.markPresent_(0, len(t.actor) > 0)
 
 }
 
 // PrependActorLink adds to the front of actor a LinkType type
 func (t *Announce) PrependActorLink(v LinkType) {
 	t.actor = append([]*actorIntermediateType{&actorIntermediateType{Link: v}}, t.actor...)
+	t.markPresent_(0, len(t.actor) > 0)
 
 }
 
@@ -907,6 +1198,22 @@ func (t *Announce) RemoveActorLink(index int) {
 	copy(t.actor[index:], t.actor[index+1:])
 	t.actor[len(t.actor)-1] = nil
 	t.actor = t.actor[:len(t.actor)-1]
+	t.markPresent_(0, len(t.actor) > 0)
+
+}
+
+// ActorLinkValues returns an iterator over the values that GetActorLink returns for each index where IsActorLink is true, which can be used as an iter.Seq
+func (t *Announce) ActorLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ActorLen(); i++ {
+			if !t.IsActorLink(i) {
+				continue
+			}
+			if !yield(t.GetActorLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -925,12 +1232,14 @@ func (t *Announce) GetActorIRI(index int) (v *url.URL) {
 // AppendActorIRI adds to the back of actor a *url.URL type
 func (t *Announce) AppendActorIRI(v *url.URL) {
 	t.actor = append(t.actor, &actorIntermediateType{IRI: v})
+	t.markPresent_(0, len(t.actor) > 0)
 
 }
 
 // PrependActorIRI adds to the front of actor a *url.URL type
 func (t *Announce) PrependActorIRI(v *url.URL) {
 	t.actor = append([]*actorIntermediateType{&actorIntermediateType{IRI: v}}, t.actor...)
+	t.markPresent_(0, len(t.actor) > 0)
 
 }
 
@@ -939,12 +1248,28 @@ func (t *Announce) RemoveActorIRI(index int) {
 	copy(t.actor[index:], t.actor[index+1:])
 	t.actor[len(t.actor)-1] = nil
 	t.actor = t.actor[:len(t.actor)-1]
+	t.markPresent_(0, len(t.actor) > 0)
+
+}
+
+// ActorIRIValues returns an iterator over the values that GetActorIRI returns for each index where IsActorIRI is true, which can be used as an iter.Seq
+func (t *Announce) ActorIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ActorLen(); i++ {
+			if !t.IsActorIRI(i) {
+				continue
+			}
+			if !yield(t.GetActorIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownActor determines whether the call to GetUnknownActor is safe
 func (t *Announce) HasUnknownActor() (ok bool) {
-	return t.actor != nil && t.actor[0].unknown_ != nil
+	return t.present_[0]&(1<<0) != 0 && t.actor[0].unknown_ != nil
 
 }
 
@@ -962,6 +1287,7 @@ func (t *Announce) SetUnknownActor(i interface{}) {
 	tmp := &actorIntermediateType{}
 	tmp.unknown_ = i
 	t.actor = append(t.actor, tmp)
+	t.markPresent_(0, len(t.actor) > 0)
 
 }
 
@@ -986,12 +1312,14 @@ func (t *Announce) GetObject(index int) (v ObjectType) {
 // AppendObject adds to the back of object a ObjectType type
 func (t *Announce) AppendObject(v ObjectType) {
 	t.object = append(t.object, &objectIntermediateType{Object: v})
+	t.markPresent_(1, len(t.object) > 0)
 
 }
 
 // PrependObject adds to the front of object a ObjectType type
 func (t *Announce) PrependObject(v ObjectType) {
 	t.object = append([]*objectIntermediateType{&objectIntermediateType{Object: v}}, t.object...)
+	t.markPresent_(1, len(t.object) > 0)
 
 }
 
@@ -1000,6 +1328,22 @@ func (t *Announce) RemoveObject(index int) {
 	copy(t.object[index:], t.object[index+1:])
 	t.object[len(t.object)-1] = nil
 	t.object = t.object[:len(t.object)-1]
+	t.markPresent_(1, len(t.object) > 0)
+
+}
+
+// ObjectValues returns an iterator over the values that GetObject returns for each index where IsObject is true, which can be used as an iter.Seq
+func (t *Announce) ObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ObjectLen(); i++ {
+			if !t.IsObject(i) {
+				continue
+			}
+			if !yield(t.GetObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1018,12 +1362,14 @@ func (t *Announce) GetObjectIRI(index int) (v *url.URL) {
 // AppendObjectIRI adds to the back of object a *url.URL type
 func (t *Announce) AppendObjectIRI(v *url.URL) {
 	t.object = append(t.object, &objectIntermediateType{IRI: v})
+	t.markPresent_(1, len(t.object) > 0)
 
 }
 
 // PrependObjectIRI adds to the front of object a *url.URL type
 func (t *Announce) PrependObjectIRI(v *url.URL) {
 	t.object = append([]*objectIntermediateType{&objectIntermediateType{IRI: v}}, t.object...)
+	t.markPresent_(1, len(t.object) > 0)
 
 }
 
@@ -1032,12 +1378,28 @@ func (t *Announce) RemoveObjectIRI(index int) {
 	copy(t.object[index:], t.object[index+1:])
 	t.object[len(t.object)-1] = nil
 	t.object = t.object[:len(t.object)-1]
+	t.markPresent_(1, len(t.object) > 0)
+
+}
+
+// ObjectIRIValues returns an iterator over the values that GetObjectIRI returns for each index where IsObjectIRI is true, which can be used as an iter.Seq
+func (t *Announce) ObjectIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ObjectLen(); i++ {
+			if !t.IsObjectIRI(i) {
+				continue
+			}
+			if !yield(t.GetObjectIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownObject determines whether the call to GetUnknownObject is safe
 func (t *Announce) HasUnknownObject() (ok bool) {
-	return t.object != nil && t.object[0].unknown_ != nil
+	return t.present_[0]&(1<<1) != 0 && t.object[0].unknown_ != nil
 
 }
 
@@ -1055,6 +1417,7 @@ func (t *Announce) SetUnknownObject(i interface{}) {
 	tmp := &objectIntermediateType{}
 	tmp.unknown_ = i
 	t.object = append(t.object, tmp)
+	t.markPresent_(1, len(t.object) > 0)
 
 }
 
@@ -1079,12 +1442,14 @@ func (t *Announce) GetTargetObject(index int) (v ObjectType) {
 // AppendTargetObject adds to the back of target a ObjectType type
 func (t *Announce) AppendTargetObject(v ObjectType) {
 	t.target = append(t.target, &targetIntermediateType{Object: v})
+	t.markPresent_(2, len(t.target) > 0)
 
 }
 
 // PrependTargetObject adds to the front of target a ObjectType type
 func (t *Announce) PrependTargetObject(v ObjectType) {
 	t.target = append([]*targetIntermediateType{&targetIntermediateType{Object: v}}, t.target...)
+	t.markPresent_(2, len(t.target) > 0)
 
 }
 
@@ -1093,6 +1458,22 @@ func (t *Announce) RemoveTargetObject(index int) {
 	copy(t.target[index:], t.target[index+1:])
 	t.target[len(t.target)-1] = nil
 	t.target = t.target[:len(t.target)-1]
+	t.markPresent_(2, len(t.target) > 0)
+
+}
+
+// TargetObjectValues returns an iterator over the values that GetTargetObject returns for each index where IsTargetObject is true, which can be used as an iter.Seq
+func (t *Announce) TargetObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.TargetLen(); i++ {
+			if !t.IsTargetObject(i) {
+				continue
+			}
+			if !yield(t.GetTargetObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1111,12 +1492,14 @@ func (t *Announce) GetTargetLink(index int) (v LinkType) {
 // AppendTargetLink adds to the back of target a LinkType type
 func (t *Announce) AppendTargetLink(v LinkType) {
 	t.target = append(t.target, &targetIntermediateType{Link: v})
+	t.markPresent_(2, len(t.target) > 0)
 
 }
 
 // PrependTargetLink adds to the front of target a LinkType type
 func (t *Announce) PrependTargetLink(v LinkType) {
 	t.target = append([]*targetIntermediateType{&targetIntermediateType{Link: v}}, t.target...)
+	t.markPresent_(2, len(t.target) > 0)
 
 }
 
@@ -1125,6 +1508,22 @@ func (t *Announce) RemoveTargetLink(index int) {
 	copy(t.target[index:], t.target[index+1:])
 	t.target[len(t.target)-1] = nil
 	t.target = t.target[:len(t.target)-1]
+	t.markPresent_(2, len(t.target) > 0)
+
+}
+
+// TargetLinkValues returns an iterator over the values that GetTargetLink returns for each index where IsTargetLink is true, which can be used as an iter.Seq
+func (t *Announce) TargetLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.TargetLen(); i++ {
+			if !t.IsTargetLink(i) {
+				continue
+			}
+			if !yield(t.GetTargetLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1143,12 +1542,14 @@ func (t *Announce) GetTargetIRI(index int) (v *url.URL) {
 // AppendTargetIRI adds to the back of target a *url.URL type
 func (t *Announce) AppendTargetIRI(v *url.URL) {
 	t.target = append(t.target, &targetIntermediateType{IRI: v})
+	t.markPresent_(2, len(t.target) > 0)
 
 }
 
 // PrependTargetIRI adds to the front of target a *url.URL type
 func (t *Announce) PrependTargetIRI(v *url.URL) {
 	t.target = append([]*targetIntermediateType{&targetIntermediateType{IRI: v}}, t.target...)
+	t.markPresent_(2, len(t.target) > 0)
 
 }
 
@@ -1157,12 +1558,28 @@ func (t *Announce) RemoveTargetIRI(index int) {
 	copy(t.target[index:], t.target[index+1:])
 	t.target[len(t.target)-1] = nil
 	t.target = t.target[:len(t.target)-1]
+	t.markPresent_(2, len(t.target) > 0)
+
+}
+
+// TargetIRIValues returns an iterator over the values that GetTargetIRI returns for each index where IsTargetIRI is true, which can be used as an iter.Seq
+func (t *Announce) TargetIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.TargetLen(); i++ {
+			if !t.IsTargetIRI(i) {
+				continue
+			}
+			if !yield(t.GetTargetIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownTarget determines whether the call to GetUnknownTarget is safe
 func (t *Announce) HasUnknownTarget() (ok bool) {
-	return t.target != nil && t.target[0].unknown_ != nil
+	return t.present_[0]&(1<<2) != 0 && t.target[0].unknown_ != nil
 
 }
 
@@ -1180,6 +1597,7 @@ func (t *Announce) SetUnknownTarget(i interface{}) {
 	tmp := &targetIntermediateType{}
 	tmp.unknown_ = i
 	t.target = append(t.target, tmp)
+	t.markPresent_(2, len(t.target) > 0)
 
 }
 
@@ -1204,12 +1622,14 @@ func (t *Announce) GetResultObject(index int) (v ObjectType) {
 // AppendResultObject adds to the back of result a ObjectType type
 func (t *Announce) AppendResultObject(v ObjectType) {
 	t.result = append(t.result, &resultIntermediateType{Object: v})
+	t.markPresent_(3, len(t.result) > 0)
 
 }
 
 // PrependResultObject adds to the front of result a ObjectType type
 func (t *Announce) PrependResultObject(v ObjectType) {
 	t.result = append([]*resultIntermediateType{&resultIntermediateType{Object: v}}, t.result...)
+	t.markPresent_(3, len(t.result) > 0)
 
 }
 
@@ -1218,6 +1638,22 @@ func (t *Announce) RemoveResultObject(index int) {
 	copy(t.result[index:], t.result[index+1:])
 	t.result[len(t.result)-1] = nil
 	t.result = t.result[:len(t.result)-1]
+	t.markPresent_(3, len(t.result) > 0)
+
+}
+
+// ResultObjectValues returns an iterator over the values that GetResultObject returns for each index where IsResultObject is true, which can be used as an iter.Seq
+func (t *Announce) ResultObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ResultLen(); i++ {
+			if !t.IsResultObject(i) {
+				continue
+			}
+			if !yield(t.GetResultObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1236,12 +1672,14 @@ func (t *Announce) GetResultLink(index int) (v LinkType) {
 // AppendResultLink adds to the back of result a LinkType type
 func (t *Announce) AppendResultLink(v LinkType) {
 	t.result = append(t.result, &resultIntermediateType{Link: v})
+	t.markPresent_(3, len(t.result) > 0)
 
 }
 
 // PrependResultLink adds to the front of result a LinkType type
 func (t *Announce) PrependResultLink(v LinkType) {
 	t.result = append([]*resultIntermediateType{&resultIntermediateType{Link: v}}, t.result...)
+	t.markPresent_(3, len(t.result) > 0)
 
 }
 
@@ -1250,6 +1688,22 @@ func (t *Announce) RemoveResultLink(index int) {
 	copy(t.result[index:], t.result[index+1:])
 	t.result[len(t.result)-1] = nil
 	t.result = t.result[:len(t.result)-1]
+	t.markPresent_(3, len(t.result) > 0)
+
+}
+
+// ResultLinkValues returns an iterator over the values that GetResultLink returns for each index where IsResultLink is true, which can be used as an iter.Seq
+func (t *Announce) ResultLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ResultLen(); i++ {
+			if !t.IsResultLink(i) {
+				continue
+			}
+			if !yield(t.GetResultLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1268,12 +1722,14 @@ func (t *Announce) GetResultIRI(index int) (v *url.URL) {
 // AppendResultIRI adds to the back of result a *url.URL type
 func (t *Announce) AppendResultIRI(v *url.URL) {
 	t.result = append(t.result, &resultIntermediateType{IRI: v})
+	t.markPresent_(3, len(t.result) > 0)
 
 }
 
 // PrependResultIRI adds to the front of result a *url.URL type
 func (t *Announce) PrependResultIRI(v *url.URL) {
 	t.result = append([]*resultIntermediateType{&resultIntermediateType{IRI: v}}, t.result...)
+	t.markPresent_(3, len(t.result) > 0)
 
 }
 
@@ -1282,12 +1738,28 @@ func (t *Announce) RemoveResultIRI(index int) {
 	copy(t.result[index:], t.result[index+1:])
 	t.result[len(t.result)-1] = nil
 	t.result = t.result[:len(t.result)-1]
+	t.markPresent_(3, len(t.result) > 0)
+
+}
+
+// ResultIRIValues returns an iterator over the values that GetResultIRI returns for each index where IsResultIRI is true, which can be used as an iter.Seq
+func (t *Announce) ResultIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ResultLen(); i++ {
+			if !t.IsResultIRI(i) {
+				continue
+			}
+			if !yield(t.GetResultIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownResult determines whether the call to GetUnknownResult is safe
 func (t *Announce) HasUnknownResult() (ok bool) {
-	return t.result != nil && t.result[0].unknown_ != nil
+	return t.present_[0]&(1<<3) != 0 && t.result[0].unknown_ != nil
 
 }
 
@@ -1305,6 +1777,7 @@ func (t *Announce) SetUnknownResult(i interface{}) {
 	tmp := &resultIntermediateType{}
 	tmp.unknown_ = i
 	t.result = append(t.result, tmp)
+	t.markPresent_(3, len(t.result) > 0)
 
 }
 
@@ -1329,12 +1802,14 @@ func (t *Announce) GetOriginObject(index int) (v ObjectType) {
 // AppendOriginObject adds to the back of origin a ObjectType type
 func (t *Announce) AppendOriginObject(v ObjectType) {
 	t.origin = append(t.origin, &originIntermediateType{Object: v})
+	t.markPresent_(4, len(t.origin) > 0)
 
 }
 
 // PrependOriginObject adds to the front of origin a ObjectType type
 func (t *Announce) PrependOriginObject(v ObjectType) {
 	t.origin = append([]*originIntermediateType{&originIntermediateType{Object: v}}, t.origin...)
+	t.markPresent_(4, len(t.origin) > 0)
 
 }
 
@@ -1343,6 +1818,22 @@ func (t *Announce) RemoveOriginObject(index int) {
 	copy(t.origin[index:], t.origin[index+1:])
 	t.origin[len(t.origin)-1] = nil
 	t.origin = t.origin[:len(t.origin)-1]
+	t.markPresent_(4, len(t.origin) > 0)
+
+}
+
+// OriginObjectValues returns an iterator over the values that GetOriginObject returns for each index where IsOriginObject is true, which can be used as an iter.Seq
+func (t *Announce) OriginObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.OriginLen(); i++ {
+			if !t.IsOriginObject(i) {
+				continue
+			}
+			if !yield(t.GetOriginObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1361,12 +1852,14 @@ func (t *Announce) GetOriginLink(index int) (v LinkType) {
 // AppendOriginLink adds to the back of origin a LinkType type
 func (t *Announce) AppendOriginLink(v LinkType) {
 	t.origin = append(t.origin, &originIntermediateType{Link: v})
+	t.markPresent_(4, len(t.origin) > 0)
 
 }
 
 // PrependOriginLink adds to the front of origin a LinkType type
 func (t *Announce) PrependOriginLink(v LinkType) {
 	t.origin = append([]*originIntermediateType{&originIntermediateType{Link: v}}, t.origin...)
+	t.markPresent_(4, len(t.origin) > 0)
 
 }
 
@@ -1375,6 +1868,22 @@ func (t *Announce) RemoveOriginLink(index int) {
 	copy(t.origin[index:], t.origin[index+1:])
 	t.origin[len(t.origin)-1] = nil
 	t.origin = t.origin[:len(t.origin)-1]
+	t.markPresent_(4, len(t.origin) > 0)
+
+}
+
+// OriginLinkValues returns an iterator over the values that GetOriginLink returns for each index where IsOriginLink is true, which can be used as an iter.Seq
+func (t *Announce) OriginLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.OriginLen(); i++ {
+			if !t.IsOriginLink(i) {
+				continue
+			}
+			if !yield(t.GetOriginLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1393,12 +1902,14 @@ func (t *Announce) GetOriginIRI(index int) (v *url.URL) {
 // AppendOriginIRI adds to the back of origin a *url.URL type
 func (t *Announce) AppendOriginIRI(v *url.URL) {
 	t.origin = append(t.origin, &originIntermediateType{IRI: v})
+	t.markPresent_(4, len(t.origin) > 0)
 
 }
 
 // PrependOriginIRI adds to the front of origin a *url.URL type
 func (t *Announce) PrependOriginIRI(v *url.URL) {
 	t.origin = append([]*originIntermediateType{&originIntermediateType{IRI: v}}, t.origin...)
+	t.markPresent_(4, len(t.origin) > 0)
 
 }
 
@@ -1407,12 +1918,28 @@ func (t *Announce) RemoveOriginIRI(index int) {
 	copy(t.origin[index:], t.origin[index+1:])
 	t.origin[len(t.origin)-1] = nil
 	t.origin = t.origin[:len(t.origin)-1]
+	t.markPresent_(4, len(t.origin) > 0)
+
+}
+
+// OriginIRIValues returns an iterator over the values that GetOriginIRI returns for each index where IsOriginIRI is true, which can be used as an iter.Seq
+func (t *Announce) OriginIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.OriginLen(); i++ {
+			if !t.IsOriginIRI(i) {
+				continue
+			}
+			if !yield(t.GetOriginIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownOrigin determines whether the call to GetUnknownOrigin is safe
 func (t *Announce) HasUnknownOrigin() (ok bool) {
-	return t.origin != nil && t.origin[0].unknown_ != nil
+	return t.present_[0]&(1<<4) != 0 && t.origin[0].unknown_ != nil
 
 }
 
@@ -1430,6 +1957,7 @@ func (t *Announce) SetUnknownOrigin(i interface{}) {
 	tmp := &originIntermediateType{}
 	tmp.unknown_ = i
 	t.origin = append(t.origin, tmp)
+	t.markPresent_(4, len(t.origin) > 0)
 
 }
 
@@ -1454,12 +1982,14 @@ func (t *Announce) GetInstrumentObject(index int) (v ObjectType) {
 // AppendInstrumentObject adds to the back of instrument a ObjectType type
 func (t *Announce) AppendInstrumentObject(v ObjectType) {
 	t.instrument = append(t.instrument, &instrumentIntermediateType{Object: v})
+	t.markPresent_(5, len(t.instrument) > 0)
 
 }
 
 // PrependInstrumentObject adds to the front of instrument a ObjectType type
 func (t *Announce) PrependInstrumentObject(v ObjectType) {
 	t.instrument = append([]*instrumentIntermediateType{&instrumentIntermediateType{Object: v}}, t.instrument...)
+	t.markPresent_(5, len(t.instrument) > 0)
 
 }
 
@@ -1468,6 +1998,22 @@ func (t *Announce) RemoveInstrumentObject(index int) {
 	copy(t.instrument[index:], t.instrument[index+1:])
 	t.instrument[len(t.instrument)-1] = nil
 	t.instrument = t.instrument[:len(t.instrument)-1]
+	t.markPresent_(5, len(t.instrument) > 0)
+
+}
+
+// InstrumentObjectValues returns an iterator over the values that GetInstrumentObject returns for each index where IsInstrumentObject is true, which can be used as an iter.Seq
+func (t *Announce) InstrumentObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.InstrumentLen(); i++ {
+			if !t.IsInstrumentObject(i) {
+				continue
+			}
+			if !yield(t.GetInstrumentObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1486,12 +2032,14 @@ func (t *Announce) GetInstrumentLink(index int) (v LinkType) {
 // AppendInstrumentLink adds to the back of instrument a LinkType type
 func (t *Announce) AppendInstrumentLink(v LinkType) {
 	t.instrument = append(t.instrument, &instrumentIntermediateType{Link: v})
+	t.markPresent_(5, len(t.instrument) > 0)
 
 }
 
 // PrependInstrumentLink adds to the front of instrument a LinkType type
 func (t *Announce) PrependInstrumentLink(v LinkType) {
 	t.instrument = append([]*instrumentIntermediateType{&instrumentIntermediateType{Link: v}}, t.instrument...)
+	t.markPresent_(5, len(t.instrument) > 0)
 
 }
 
@@ -1500,6 +2048,22 @@ func (t *Announce) RemoveInstrumentLink(index int) {
 	copy(t.instrument[index:], t.instrument[index+1:])
 	t.instrument[len(t.instrument)-1] = nil
 	t.instrument = t.instrument[:len(t.instrument)-1]
+	t.markPresent_(5, len(t.instrument) > 0)
+
+}
+
+// InstrumentLinkValues returns an iterator over the values that GetInstrumentLink returns for each index where IsInstrumentLink is true, which can be used as an iter.Seq
+func (t *Announce) InstrumentLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.InstrumentLen(); i++ {
+			if !t.IsInstrumentLink(i) {
+				continue
+			}
+			if !yield(t.GetInstrumentLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1518,12 +2082,14 @@ func (t *Announce) GetInstrumentIRI(index int) (v *url.URL) {
 // AppendInstrumentIRI adds to the back of instrument a *url.URL type
 func (t *Announce) AppendInstrumentIRI(v *url.URL) {
 	t.instrument = append(t.instrument, &instrumentIntermediateType{IRI: v})
+	t.markPresent_(5, len(t.instrument) > 0)
 
 }
 
 // PrependInstrumentIRI adds to the front of instrument a *url.URL type
 func (t *Announce) PrependInstrumentIRI(v *url.URL) {
 	t.instrument = append([]*instrumentIntermediateType{&instrumentIntermediateType{IRI: v}}, t.instrument...)
+	t.markPresent_(5, len(t.instrument) > 0)
 
 }
 
@@ -1532,12 +2098,28 @@ func (t *Announce) RemoveInstrumentIRI(index int) {
 	copy(t.instrument[index:], t.instrument[index+1:])
 	t.instrument[len(t.instrument)-1] = nil
 	t.instrument = t.instrument[:len(t.instrument)-1]
+	t.markPresent_(5, len(t.instrument) > 0)
+
+}
+
+// InstrumentIRIValues returns an iterator over the values that GetInstrumentIRI returns for each index where IsInstrumentIRI is true, which can be used as an iter.Seq
+func (t *Announce) InstrumentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.InstrumentLen(); i++ {
+			if !t.IsInstrumentIRI(i) {
+				continue
+			}
+			if !yield(t.GetInstrumentIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownInstrument determines whether the call to GetUnknownInstrument is safe
 func (t *Announce) HasUnknownInstrument() (ok bool) {
-	return t.instrument != nil && t.instrument[0].unknown_ != nil
+	return t.present_[0]&(1<<5) != 0 && t.instrument[0].unknown_ != nil
 
 }
 
@@ -1555,12 +2137,13 @@ func (t *Announce) SetUnknownInstrument(i interface{}) {
 	tmp := &instrumentIntermediateType{}
 	tmp.unknown_ = i
 	t.instrument = append(t.instrument, tmp)
+	t.markPresent_(5, len(t.instrument) > 0)
 
 }
 
 // IsAltitude determines whether the call to GetAltitude is safe
 func (t *Announce) IsAltitude() (ok bool) {
-	return t.altitude != nil && t.altitude.float != nil
+	return t.present_[0]&(1<<6) != 0 && t.altitude.float != nil
 
 }
 
@@ -1573,12 +2156,13 @@ func (t *Announce) GetAltitude() (v float64) {
 // SetAltitude sets the value of altitude to be of float64 type
 func (t *Announce) SetAltitude(v float64) {
 	t.altitude = &altitudeIntermediateType{float: &v}
+	t.markPresent_(6, true)
 
 }
 
 // IsAltitudeIRI determines whether the call to GetAltitudeIRI is safe
 func (t *Announce) IsAltitudeIRI() (ok bool) {
-	return t.altitude != nil && t.altitude.IRI != nil
+	return t.present_[0]&(1<<6) != 0 && t.altitude.IRI != nil
 
 }
 
@@ -1591,12 +2175,13 @@ func (t *Announce) GetAltitudeIRI() (v *url.URL) {
 // SetAltitudeIRI sets the value of altitude to be of *url.URL type
 func (t *Announce) SetAltitudeIRI(v *url.URL) {
 	t.altitude = &altitudeIntermediateType{IRI: v}
+	t.markPresent_(6, true)
 
 }
 
 // HasUnknownAltitude determines whether the call to GetUnknownAltitude is safe
 func (t *Announce) HasUnknownAltitude() (ok bool) {
-	return t.altitude != nil && t.altitude.unknown_ != nil
+	return t.present_[0]&(1<<6) != 0 && t.altitude.unknown_ != nil
 
 }
 
@@ -1614,6 +2199,7 @@ func (t *Announce) SetUnknownAltitude(i interface{}) {
 	tmp := &altitudeIntermediateType{}
 	tmp.unknown_ = i
 	t.altitude = tmp
+	t.markPresent_(6, true)
 
 }
 
@@ -1638,12 +2224,14 @@ func (t *Announce) GetAttachmentObject(index int) (v ObjectType) {
 // AppendAttachmentObject adds to the back of attachment a ObjectType type
 func (t *Announce) AppendAttachmentObject(v ObjectType) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{Object: v})
+	t.markPresent_(7, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentObject adds to the front of attachment a ObjectType type
 func (t *Announce) PrependAttachmentObject(v ObjectType) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Object: v}}, t.attachment...)
+	t.markPresent_(7, len(t.attachment) > 0)
 
 }
 
@@ -1652,6 +2240,22 @@ func (t *Announce) RemoveAttachmentObject(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(7, len(t.attachment) > 0)
+
+}
+
+// AttachmentObjectValues returns an iterator over the values that GetAttachmentObject returns for each index where IsAttachmentObject is true, which can be used as an iter.Seq
+func (t *Announce) AttachmentObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentObject(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1670,12 +2274,14 @@ func (t *Announce) GetAttachmentLink(index int) (v LinkType) {
 // AppendAttachmentLink adds to the back of attachment a LinkType type
 func (t *Announce) AppendAttachmentLink(v LinkType) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{Link: v})
+	t.markPresent_(7, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentLink adds to the front of attachment a LinkType type
 func (t *Announce) PrependAttachmentLink(v LinkType) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Link: v}}, t.attachment...)
+	t.markPresent_(7, len(t.attachment) > 0)
 
 }
 
@@ -1684,6 +2290,22 @@ func (t *Announce) RemoveAttachmentLink(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(7, len(t.attachment) > 0)
+
+}
+
+// AttachmentLinkValues returns an iterator over the values that GetAttachmentLink returns for each index where IsAttachmentLink is true, which can be used as an iter.Seq
+func (t *Announce) AttachmentLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentLink(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1702,12 +2324,14 @@ func (t *Announce) GetAttachmentIRI(index int) (v *url.URL) {
 // AppendAttachmentIRI adds to the back of attachment a *url.URL type
 func (t *Announce) AppendAttachmentIRI(v *url.URL) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{IRI: v})
+	t.markPresent_(7, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentIRI adds to the front of attachment a *url.URL type
 func (t *Announce) PrependAttachmentIRI(v *url.URL) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{IRI: v}}, t.attachment...)
+	t.markPresent_(7, len(t.attachment) > 0)
 
 }
 
@@ -1716,12 +2340,28 @@ func (t *Announce) RemoveAttachmentIRI(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(7, len(t.attachment) > 0)
+
+}
+
+// AttachmentIRIValues returns an iterator over the values that GetAttachmentIRI returns for each index where IsAttachmentIRI is true, which can be used as an iter.Seq
+func (t *Announce) AttachmentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAttachment determines whether the call to GetUnknownAttachment is safe
 func (t *Announce) HasUnknownAttachment() (ok bool) {
-	return t.attachment != nil && t.attachment[0].unknown_ != nil
+	return t.present_[0]&(1<<7) != 0 && t.attachment[0].unknown_ != nil
 
 }
 
@@ -1739,6 +2379,7 @@ func (t *Announce) SetUnknownAttachment(i interface{}) {
 	tmp := &attachmentIntermediateType{}
 	tmp.unknown_ = i
 	t.attachment = append(t.attachment, tmp)
+	t.markPresent_(7, len(t.attachment) > 0)
 
 }
 
@@ -1763,12 +2404,14 @@ func (t *Announce) GetAttributedToObject(index int) (v ObjectType) {
 // AppendAttributedToObject adds to the back of attributedTo a ObjectType type
 func (t *Announce) AppendAttributedToObject(v ObjectType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Object: v})
+	t.markPresent_(8, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToObject adds to the front of attributedTo a ObjectType type
 func (t *Announce) PrependAttributedToObject(v ObjectType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Object: v}}, t.attributedTo...)
+	t.markPresent_(8, len(t.attributedTo) > 0)
 
 }
 
@@ -1777,6 +2420,22 @@ func (t *Announce) RemoveAttributedToObject(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(8, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToObjectValues returns an iterator over the values that GetAttributedToObject returns for each index where IsAttributedToObject is true, which can be used as an iter.Seq
+func (t *Announce) AttributedToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToObject(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1795,12 +2454,14 @@ func (t *Announce) GetAttributedToLink(index int) (v LinkType) {
 // AppendAttributedToLink adds to the back of attributedTo a LinkType type
 func (t *Announce) AppendAttributedToLink(v LinkType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Link: v})
+	t.markPresent_(8, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToLink adds to the front of attributedTo a LinkType type
 func (t *Announce) PrependAttributedToLink(v LinkType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Link: v}}, t.attributedTo...)
+	t.markPresent_(8, len(t.attributedTo) > 0)
 
 }
 
@@ -1809,6 +2470,22 @@ func (t *Announce) RemoveAttributedToLink(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(8, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToLinkValues returns an iterator over the values that GetAttributedToLink returns for each index where IsAttributedToLink is true, which can be used as an iter.Seq
+func (t *Announce) AttributedToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToLink(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1827,12 +2504,14 @@ func (t *Announce) GetAttributedToIRI(index int) (v *url.URL) {
 // AppendAttributedToIRI adds to the back of attributedTo a *url.URL type
 func (t *Announce) AppendAttributedToIRI(v *url.URL) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{IRI: v})
+	t.markPresent_(8, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToIRI adds to the front of attributedTo a *url.URL type
 func (t *Announce) PrependAttributedToIRI(v *url.URL) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{IRI: v}}, t.attributedTo...)
+	t.markPresent_(8, len(t.attributedTo) > 0)
 
 }
 
@@ -1841,12 +2520,28 @@ func (t *Announce) RemoveAttributedToIRI(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(8, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToIRIValues returns an iterator over the values that GetAttributedToIRI returns for each index where IsAttributedToIRI is true, which can be used as an iter.Seq
+func (t *Announce) AttributedToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAttributedTo determines whether the call to GetUnknownAttributedTo is safe
 func (t *Announce) HasUnknownAttributedTo() (ok bool) {
-	return t.attributedTo != nil && t.attributedTo[0].unknown_ != nil
+	return t.present_[0]&(1<<8) != 0 && t.attributedTo[0].unknown_ != nil
 
 }
 
@@ -1864,6 +2559,7 @@ func (t *Announce) SetUnknownAttributedTo(i interface{}) {
 	tmp := &attributedToIntermediateType{}
 	tmp.unknown_ = i
 	t.attributedTo = append(t.attributedTo, tmp)
+	t.markPresent_(8, len(t.attributedTo) > 0)
 
 }
 
@@ -1888,12 +2584,14 @@ func (t *Announce) GetAudienceObject(index int) (v ObjectType) {
 // AppendAudienceObject adds to the back of audience a ObjectType type
 func (t *Announce) AppendAudienceObject(v ObjectType) {
 	t.audience = append(t.audience, &audienceIntermediateType{Object: v})
+	t.markPresent_(9, len(t.audience) > 0)
 
 }
 
 // PrependAudienceObject adds to the front of audience a ObjectType type
 func (t *Announce) PrependAudienceObject(v ObjectType) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Object: v}}, t.audience...)
+	t.markPresent_(9, len(t.audience) > 0)
 
 }
 
@@ -1902,6 +2600,22 @@ func (t *Announce) RemoveAudienceObject(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(9, len(t.audience) > 0)
+
+}
+
+// AudienceObjectValues returns an iterator over the values that GetAudienceObject returns for each index where IsAudienceObject is true, which can be used as an iter.Seq
+func (t *Announce) AudienceObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceObject(i) {
+				continue
+			}
+			if !yield(t.GetAudienceObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1920,12 +2634,14 @@ func (t *Announce) GetAudienceLink(index int) (v LinkType) {
 // AppendAudienceLink adds to the back of audience a LinkType type
 func (t *Announce) AppendAudienceLink(v LinkType) {
 	t.audience = append(t.audience, &audienceIntermediateType{Link: v})
+	t.markPresent_(9, len(t.audience) > 0)
 
 }
 
 // PrependAudienceLink adds to the front of audience a LinkType type
 func (t *Announce) PrependAudienceLink(v LinkType) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Link: v}}, t.audience...)
+	t.markPresent_(9, len(t.audience) > 0)
 
 }
 
@@ -1934,6 +2650,22 @@ func (t *Announce) RemoveAudienceLink(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(9, len(t.audience) > 0)
+
+}
+
+// AudienceLinkValues returns an iterator over the values that GetAudienceLink returns for each index where IsAudienceLink is true, which can be used as an iter.Seq
+func (t *Announce) AudienceLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceLink(i) {
+				continue
+			}
+			if !yield(t.GetAudienceLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1952,12 +2684,14 @@ func (t *Announce) GetAudienceIRI(index int) (v *url.URL) {
 // AppendAudienceIRI adds to the back of audience a *url.URL type
 func (t *Announce) AppendAudienceIRI(v *url.URL) {
 	t.audience = append(t.audience, &audienceIntermediateType{IRI: v})
+	t.markPresent_(9, len(t.audience) > 0)
 
 }
 
 // PrependAudienceIRI adds to the front of audience a *url.URL type
 func (t *Announce) PrependAudienceIRI(v *url.URL) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{IRI: v}}, t.audience...)
+	t.markPresent_(9, len(t.audience) > 0)
 
 }
 
@@ -1966,12 +2700,28 @@ func (t *Announce) RemoveAudienceIRI(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(9, len(t.audience) > 0)
+
+}
+
+// AudienceIRIValues returns an iterator over the values that GetAudienceIRI returns for each index where IsAudienceIRI is true, which can be used as an iter.Seq
+func (t *Announce) AudienceIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceIRI(i) {
+				continue
+			}
+			if !yield(t.GetAudienceIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAudience determines whether the call to GetUnknownAudience is safe
 func (t *Announce) HasUnknownAudience() (ok bool) {
-	return t.audience != nil && t.audience[0].unknown_ != nil
+	return t.present_[0]&(1<<9) != 0 && t.audience[0].unknown_ != nil
 
 }
 
@@ -1989,6 +2739,7 @@ func (t *Announce) SetUnknownAudience(i interface{}) {
 	tmp := &audienceIntermediateType{}
 	tmp.unknown_ = i
 	t.audience = append(t.audience, tmp)
+	t.markPresent_(9, len(t.audience) > 0)
 
 }
 
@@ -2013,12 +2764,14 @@ func (t *Announce) GetContentString(index int) (v string) {
 // AppendContentString adds to the back of content a string type
 func (t *Announce) AppendContentString(v string) {
 	t.content = append(t.content, &contentIntermediateType{stringName: &v})
+	t.markPresent_(10, len(t.content) > 0)
 
 }
 
 // PrependContentString adds to the front of content a string type
 func (t *Announce) PrependContentString(v string) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{stringName: &v}}, t.content...)
+	t.markPresent_(10, len(t.content) > 0)
 
 }
 
@@ -2027,6 +2780,22 @@ func (t *Announce) RemoveContentString(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(10, len(t.content) > 0)
+
+}
+
+// ContentStringValues returns an iterator over the values that GetContentString returns for each index where IsContentString is true, which can be used as an iter.Seq
+func (t *Announce) ContentStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentString(i) {
+				continue
+			}
+			if !yield(t.GetContentString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2045,12 +2814,14 @@ func (t *Announce) GetContentLangString(index int) (v string) {
 // AppendContentLangString adds to the back of content a string type
 func (t *Announce) AppendContentLangString(v string) {
 	t.content = append(t.content, &contentIntermediateType{langString: &v})
+	t.markPresent_(10, len(t.content) > 0)
 
 }
 
 // PrependContentLangString adds to the front of content a string type
 func (t *Announce) PrependContentLangString(v string) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{langString: &v}}, t.content...)
+	t.markPresent_(10, len(t.content) > 0)
 
 }
 
@@ -2059,6 +2830,22 @@ func (t *Announce) RemoveContentLangString(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(10, len(t.content) > 0)
+
+}
+
+// ContentLangStringValues returns an iterator over the values that GetContentLangString returns for each index where IsContentLangString is true, which can be used as an iter.Seq
+func (t *Announce) ContentLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentLangString(i) {
+				continue
+			}
+			if !yield(t.GetContentLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2077,12 +2864,14 @@ func (t *Announce) GetContentIRI(index int) (v *url.URL) {
 // AppendContentIRI adds to the back of content a *url.URL type
 func (t *Announce) AppendContentIRI(v *url.URL) {
 	t.content = append(t.content, &contentIntermediateType{IRI: v})
+	t.markPresent_(10, len(t.content) > 0)
 
 }
 
 // PrependContentIRI adds to the front of content a *url.URL type
 func (t *Announce) PrependContentIRI(v *url.URL) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{IRI: v}}, t.content...)
+	t.markPresent_(10, len(t.content) > 0)
 
 }
 
@@ -2091,12 +2880,28 @@ func (t *Announce) RemoveContentIRI(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(10, len(t.content) > 0)
+
+}
+
+// ContentIRIValues returns an iterator over the values that GetContentIRI returns for each index where IsContentIRI is true, which can be used as an iter.Seq
+func (t *Announce) ContentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentIRI(i) {
+				continue
+			}
+			if !yield(t.GetContentIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownContent determines whether the call to GetUnknownContent is safe
 func (t *Announce) HasUnknownContent() (ok bool) {
-	return t.content != nil && t.content[0].unknown_ != nil
+	return t.present_[0]&(1<<10) != 0 && t.content[0].unknown_ != nil
 
 }
 
@@ -2114,6 +2919,7 @@ func (t *Announce) SetUnknownContent(i interface{}) {
 	tmp := &contentIntermediateType{}
 	tmp.unknown_ = i
 	t.content = append(t.content, tmp)
+	t.markPresent_(10, len(t.content) > 0)
 
 }
 
@@ -2147,11 +2953,41 @@ func (t *Announce) GetContentMap(l string) (v string) {
 func (t *Announce) SetContentMap(l string, v string) {
 	if t.contentMap == nil {
 		t.contentMap = make(map[string]string)
+		t.markPresent_(11, true)
 	}
 	t.contentMap[l] = v
 
 }
 
+// GetContentLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Announce) GetContentLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.contentMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.contentMap[k], true
+
+}
+
+// SetContentLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Announce) SetContentLanguage(tag string, v string) {
+	if t.contentMap == nil {
+		t.contentMap = make(map[string]string)
+		t.markPresent_(11, true)
+	} else if k, ok := languageKey(t.contentMap, tag); ok {
+		delete(t.contentMap, k)
+	}
+	t.contentMap[tag] = v
+
+}
+
+// PreferredContentLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Announce) PreferredContentLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.contentMap, tags)
+	return t.contentMap[tag], tag
+
+}
+
 // ContextLen determines the number of elements able to be used for the IsContextObject, GetContextObject, and RemoveContextObject functions
 func (t *Announce) ContextLen() (l int) {
 	return len(t.context)
@@ -2173,12 +3009,14 @@ func (t *Announce) GetContextObject(index int) (v ObjectType) {
 // AppendContextObject adds to the back of context a ObjectType type
 func (t *Announce) AppendContextObject(v ObjectType) {
 	t.context = append(t.context, &contextIntermediateType{Object: v})
+	t.markPresent_(12, len(t.context) > 0)
 
 }
 
 // PrependContextObject adds to the front of context a ObjectType type
 func (t *Announce) PrependContextObject(v ObjectType) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{Object: v}}, t.context...)
+	t.markPresent_(12, len(t.context) > 0)
 
 }
 
@@ -2187,6 +3025,22 @@ func (t *Announce) RemoveContextObject(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(12, len(t.context) > 0)
+
+}
+
+// ContextObjectValues returns an iterator over the values that GetContextObject returns for each index where IsContextObject is true, which can be used as an iter.Seq
+func (t *Announce) ContextObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextObject(i) {
+				continue
+			}
+			if !yield(t.GetContextObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2205,12 +3059,14 @@ func (t *Announce) GetContextLink(index int) (v LinkType) {
 // AppendContextLink adds to the back of context a LinkType type
 func (t *Announce) AppendContextLink(v LinkType) {
 	t.context = append(t.context, &contextIntermediateType{Link: v})
+	t.markPresent_(12, len(t.context) > 0)
 
 }
 
 // PrependContextLink adds to the front of context a LinkType type
 func (t *Announce) PrependContextLink(v LinkType) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{Link: v}}, t.context...)
+	t.markPresent_(12, len(t.context) > 0)
 
 }
 
@@ -2219,6 +3075,22 @@ func (t *Announce) RemoveContextLink(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(12, len(t.context) > 0)
+
+}
+
+// ContextLinkValues returns an iterator over the values that GetContextLink returns for each index where IsContextLink is true, which can be used as an iter.Seq
+func (t *Announce) ContextLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextLink(i) {
+				continue
+			}
+			if !yield(t.GetContextLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2237,12 +3109,14 @@ func (t *Announce) GetContextIRI(index int) (v *url.URL) {
 // AppendContextIRI adds to the back of context a *url.URL type
 func (t *Announce) AppendContextIRI(v *url.URL) {
 	t.context = append(t.context, &contextIntermediateType{IRI: v})
+	t.markPresent_(12, len(t.context) > 0)
 
 }
 
 // PrependContextIRI adds to the front of context a *url.URL type
 func (t *Announce) PrependContextIRI(v *url.URL) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{IRI: v}}, t.context...)
+	t.markPresent_(12, len(t.context) > 0)
 
 }
 
@@ -2251,12 +3125,28 @@ func (t *Announce) RemoveContextIRI(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(12, len(t.context) > 0)
+
+}
+
+// ContextIRIValues returns an iterator over the values that GetContextIRI returns for each index where IsContextIRI is true, which can be used as an iter.Seq
+func (t *Announce) ContextIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextIRI(i) {
+				continue
+			}
+			if !yield(t.GetContextIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownContext determines whether the call to GetUnknownContext is safe
 func (t *Announce) HasUnknownContext() (ok bool) {
-	return t.context != nil && t.context[0].unknown_ != nil
+	return t.present_[0]&(1<<12) != 0 && t.context[0].unknown_ != nil
 
 }
 
@@ -2274,6 +3164,7 @@ func (t *Announce) SetUnknownContext(i interface{}) {
 	tmp := &contextIntermediateType{}
 	tmp.unknown_ = i
 	t.context = append(t.context, tmp)
+	t.markPresent_(12, len(t.context) > 0)
 
 }
 
@@ -2298,12 +3189,14 @@ func (t *Announce) GetNameString(index int) (v string) {
 // AppendNameString adds to the back of name a string type
 func (t *Announce) AppendNameString(v string) {
 	t.name = append(t.name, &nameIntermediateType{stringName: &v})
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
 // PrependNameString adds to the front of name a string type
 func (t *Announce) PrependNameString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{stringName: &v}}, t.name...)
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
@@ -2312,6 +3205,22 @@ func (t *Announce) RemoveNameString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(13, len(t.name) > 0)
+
+}
+
+// NameStringValues returns an iterator over the values that GetNameString returns for each index where IsNameString is true, which can be used as an iter.Seq
+func (t *Announce) NameStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameString(i) {
+				continue
+			}
+			if !yield(t.GetNameString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2330,12 +3239,14 @@ func (t *Announce) GetNameLangString(index int) (v string) {
 // AppendNameLangString adds to the back of name a string type
 func (t *Announce) AppendNameLangString(v string) {
 	t.name = append(t.name, &nameIntermediateType{langString: &v})
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
 // PrependNameLangString adds to the front of name a string type
 func (t *Announce) PrependNameLangString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{langString: &v}}, t.name...)
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
@@ -2344,6 +3255,22 @@ func (t *Announce) RemoveNameLangString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(13, len(t.name) > 0)
+
+}
+
+// NameLangStringValues returns an iterator over the values that GetNameLangString returns for each index where IsNameLangString is true, which can be used as an iter.Seq
+func (t *Announce) NameLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameLangString(i) {
+				continue
+			}
+			if !yield(t.GetNameLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2362,12 +3289,14 @@ func (t *Announce) GetNameIRI(index int) (v *url.URL) {
 // AppendNameIRI adds to the back of name a *url.URL type
 func (t *Announce) AppendNameIRI(v *url.URL) {
 	t.name = append(t.name, &nameIntermediateType{IRI: v})
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
 // PrependNameIRI adds to the front of name a *url.URL type
 func (t *Announce) PrependNameIRI(v *url.URL) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{IRI: v}}, t.name...)
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
@@ -2376,13 +3305,29 @@ func (t *Announce) RemoveNameIRI(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
-// HasUnknownName determines whether the call to GetUnknownName is safe
-func (t *Announce) HasUnknownName() (ok bool) {
-	return t.name != nil && t.name[0].unknown_ != nil
-
+// NameIRIValues returns an iterator over the values that GetNameIRI returns for each index where IsNameIRI is true, which can be used as an iter.Seq
+func (t *Announce) NameIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameIRI(i) {
+				continue
+			}
+			if !yield(t.GetNameIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownName determines whether the call to GetUnknownName is safe
+func (t *Announce) HasUnknownName() (ok bool) {
+	return t.present_[0]&(1<<13) != 0 && t.name[0].unknown_ != nil
+
 }
 
 // GetUnknownName returns the unknown value for name
@@ -2399,6 +3344,7 @@ func (t *Announce) SetUnknownName(i interface{}) {
 	tmp := &nameIntermediateType{}
 	tmp.unknown_ = i
 	t.name = append(t.name, tmp)
+	t.markPresent_(13, len(t.name) > 0)
 
 }
 
@@ -2432,14 +3378,44 @@ func (t *Announce) GetNameMap(l string) (v string) {
 func (t *Announce) SetNameMap(l string, v string) {
 	if t.nameMap == nil {
 		t.nameMap = make(map[string]string)
+		t.markPresent_(14, true)
 	}
 	t.nameMap[l] = v
 
 }
 
+// GetNameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Announce) GetNameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.nameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.nameMap[k], true
+
+}
+
+// SetNameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Announce) SetNameLanguage(tag string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(14, true)
+	} else if k, ok := languageKey(t.nameMap, tag); ok {
+		delete(t.nameMap, k)
+	}
+	t.nameMap[tag] = v
+
+}
+
+// PreferredNameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Announce) PreferredNameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.nameMap, tags)
+	return t.nameMap[tag], tag
+
+}
+
 // IsEndTime determines whether the call to GetEndTime is safe
 func (t *Announce) IsEndTime() (ok bool) {
-	return t.endTime != nil && t.endTime.dateTime != nil
+	return t.present_[0]&(1<<15) != 0 && t.endTime.dateTime != nil
 
 }
 
@@ -2452,12 +3428,13 @@ func (t *Announce) GetEndTime() (v time.Time) {
 // SetEndTime sets the value of endTime to be of time.Time type
 func (t *Announce) SetEndTime(v time.Time) {
 	t.endTime = &endTimeIntermediateType{dateTime: &v}
+	t.markPresent_(15, true)
 
 }
 
 // IsEndTimeIRI determines whether the call to GetEndTimeIRI is safe
 func (t *Announce) IsEndTimeIRI() (ok bool) {
-	return t.endTime != nil && t.endTime.IRI != nil
+	return t.present_[0]&(1<<15) != 0 && t.endTime.IRI != nil
 
 }
 
@@ -2470,12 +3447,13 @@ func (t *Announce) GetEndTimeIRI() (v *url.URL) {
 // SetEndTimeIRI sets the value of endTime to be of *url.URL type
 func (t *Announce) SetEndTimeIRI(v *url.URL) {
 	t.endTime = &endTimeIntermediateType{IRI: v}
+	t.markPresent_(15, true)
 
 }
 
 // HasUnknownEndTime determines whether the call to GetUnknownEndTime is safe
 func (t *Announce) HasUnknownEndTime() (ok bool) {
-	return t.endTime != nil && t.endTime.unknown_ != nil
+	return t.present_[0]&(1<<15) != 0 && t.endTime.unknown_ != nil
 
 }
 
@@ -2493,6 +3471,7 @@ func (t *Announce) SetUnknownEndTime(i interface{}) {
 	tmp := &endTimeIntermediateType{}
 	tmp.unknown_ = i
 	t.endTime = tmp
+	t.markPresent_(15, true)
 
 }
 
@@ -2517,12 +3496,14 @@ func (t *Announce) GetGeneratorObject(index int) (v ObjectType) {
 // AppendGeneratorObject adds to the back of generator a ObjectType type
 func (t *Announce) AppendGeneratorObject(v ObjectType) {
 	t.generator = append(t.generator, &generatorIntermediateType{Object: v})
+	t.markPresent_(16, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorObject adds to the front of generator a ObjectType type
 func (t *Announce) PrependGeneratorObject(v ObjectType) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Object: v}}, t.generator...)
+	t.markPresent_(16, len(t.generator) > 0)
 
 }
 
@@ -2531,6 +3512,22 @@ func (t *Announce) RemoveGeneratorObject(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(16, len(t.generator) > 0)
+
+}
+
+// GeneratorObjectValues returns an iterator over the values that GetGeneratorObject returns for each index where IsGeneratorObject is true, which can be used as an iter.Seq
+func (t *Announce) GeneratorObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorObject(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2549,12 +3546,14 @@ func (t *Announce) GetGeneratorLink(index int) (v LinkType) {
 // AppendGeneratorLink adds to the back of generator a LinkType type
 func (t *Announce) AppendGeneratorLink(v LinkType) {
 	t.generator = append(t.generator, &generatorIntermediateType{Link: v})
+	t.markPresent_(16, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorLink adds to the front of generator a LinkType type
 func (t *Announce) PrependGeneratorLink(v LinkType) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Link: v}}, t.generator...)
+	t.markPresent_(16, len(t.generator) > 0)
 
 }
 
@@ -2563,6 +3562,22 @@ func (t *Announce) RemoveGeneratorLink(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(16, len(t.generator) > 0)
+
+}
+
+// GeneratorLinkValues returns an iterator over the values that GetGeneratorLink returns for each index where IsGeneratorLink is true, which can be used as an iter.Seq
+func (t *Announce) GeneratorLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorLink(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2581,12 +3596,14 @@ func (t *Announce) GetGeneratorIRI(index int) (v *url.URL) {
 // AppendGeneratorIRI adds to the back of generator a *url.URL type
 func (t *Announce) AppendGeneratorIRI(v *url.URL) {
 	t.generator = append(t.generator, &generatorIntermediateType{IRI: v})
+	t.markPresent_(16, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorIRI adds to the front of generator a *url.URL type
 func (t *Announce) PrependGeneratorIRI(v *url.URL) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{IRI: v}}, t.generator...)
+	t.markPresent_(16, len(t.generator) > 0)
 
 }
 
@@ -2595,12 +3612,28 @@ func (t *Announce) RemoveGeneratorIRI(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(16, len(t.generator) > 0)
+
+}
+
+// GeneratorIRIValues returns an iterator over the values that GetGeneratorIRI returns for each index where IsGeneratorIRI is true, which can be used as an iter.Seq
+func (t *Announce) GeneratorIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorIRI(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownGenerator determines whether the call to GetUnknownGenerator is safe
 func (t *Announce) HasUnknownGenerator() (ok bool) {
-	return t.generator != nil && t.generator[0].unknown_ != nil
+	return t.present_[0]&(1<<16) != 0 && t.generator[0].unknown_ != nil
 
 }
 
@@ -2618,6 +3651,7 @@ func (t *Announce) SetUnknownGenerator(i interface{}) {
 	tmp := &generatorIntermediateType{}
 	tmp.unknown_ = i
 	t.generator = append(t.generator, tmp)
+	t.markPresent_(16, len(t.generator) > 0)
 
 }
 
@@ -2642,12 +3676,14 @@ func (t *Announce) GetIconImage(index int) (v ImageType) {
 // AppendIconImage adds to the back of icon a ImageType type
 func (t *Announce) AppendIconImage(v ImageType) {
 	t.icon = append(t.icon, &iconIntermediateType{Image: v})
+	t.markPresent_(17, len(t.icon) > 0)
 
 }
 
 // PrependIconImage adds to the front of icon a ImageType type
 func (t *Announce) PrependIconImage(v ImageType) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Image: v}}, t.icon...)
+	t.markPresent_(17, len(t.icon) > 0)
 
 }
 
@@ -2656,6 +3692,22 @@ func (t *Announce) RemoveIconImage(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(17, len(t.icon) > 0)
+
+}
+
+// IconImageValues returns an iterator over the values that GetIconImage returns for each index where IsIconImage is true, which can be used as an iter.Seq
+func (t *Announce) IconImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconImage(i) {
+				continue
+			}
+			if !yield(t.GetIconImage(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2674,12 +3726,14 @@ func (t *Announce) GetIconLink(index int) (v LinkType) {
 // AppendIconLink adds to the back of icon a LinkType type
 func (t *Announce) AppendIconLink(v LinkType) {
 	t.icon = append(t.icon, &iconIntermediateType{Link: v})
+	t.markPresent_(17, len(t.icon) > 0)
 
 }
 
 // PrependIconLink adds to the front of icon a LinkType type
 func (t *Announce) PrependIconLink(v LinkType) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Link: v}}, t.icon...)
+	t.markPresent_(17, len(t.icon) > 0)
 
 }
 
@@ -2688,6 +3742,22 @@ func (t *Announce) RemoveIconLink(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(17, len(t.icon) > 0)
+
+}
+
+// IconLinkValues returns an iterator over the values that GetIconLink returns for each index where IsIconLink is true, which can be used as an iter.Seq
+func (t *Announce) IconLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconLink(i) {
+				continue
+			}
+			if !yield(t.GetIconLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2706,12 +3776,14 @@ func (t *Announce) GetIconIRI(index int) (v *url.URL) {
 // AppendIconIRI adds to the back of icon a *url.URL type
 func (t *Announce) AppendIconIRI(v *url.URL) {
 	t.icon = append(t.icon, &iconIntermediateType{IRI: v})
+	t.markPresent_(17, len(t.icon) > 0)
 
 }
 
 // PrependIconIRI adds to the front of icon a *url.URL type
 func (t *Announce) PrependIconIRI(v *url.URL) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{IRI: v}}, t.icon...)
+	t.markPresent_(17, len(t.icon) > 0)
 
 }
 
@@ -2720,12 +3792,28 @@ func (t *Announce) RemoveIconIRI(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(17, len(t.icon) > 0)
+
+}
+
+// IconIRIValues returns an iterator over the values that GetIconIRI returns for each index where IsIconIRI is true, which can be used as an iter.Seq
+func (t *Announce) IconIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconIRI(i) {
+				continue
+			}
+			if !yield(t.GetIconIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownIcon determines whether the call to GetUnknownIcon is safe
 func (t *Announce) HasUnknownIcon() (ok bool) {
-	return t.icon != nil && t.icon[0].unknown_ != nil
+	return t.present_[0]&(1<<17) != 0 && t.icon[0].unknown_ != nil
 
 }
 
@@ -2743,12 +3831,13 @@ func (t *Announce) SetUnknownIcon(i interface{}) {
 	tmp := &iconIntermediateType{}
 	tmp.unknown_ = i
 	t.icon = append(t.icon, tmp)
+	t.markPresent_(17, len(t.icon) > 0)
 
 }
 
 // HasId determines whether the call to GetId is safe
 func (t *Announce) HasId() (ok bool) {
-	return t.id != nil
+	return t.present_[0]&(1<<18) != 0
 
 }
 
@@ -2761,6 +3850,7 @@ func (t *Announce) GetId() (v *url.URL) {
 // SetId sets the value of id
 func (t *Announce) SetId(v *url.URL) {
 	t.id = v
+	t.markPresent_(18, true)
 
 }
 
@@ -2806,12 +3896,14 @@ func (t *Announce) GetImageImage(index int) (v ImageType) {
 // AppendImageImage adds to the back of image a ImageType type
 func (t *Announce) AppendImageImage(v ImageType) {
 	t.image = append(t.image, &imageIntermediateType{Image: v})
+	t.markPresent_(19, len(t.image) > 0)
 
 }
 
 // PrependImageImage adds to the front of image a ImageType type
 func (t *Announce) PrependImageImage(v ImageType) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{Image: v}}, t.image...)
+	t.markPresent_(19, len(t.image) > 0)
 
 }
 
@@ -2820,6 +3912,22 @@ func (t *Announce) RemoveImageImage(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(19, len(t.image) > 0)
+
+}
+
+// ImageImageValues returns an iterator over the values that GetImageImage returns for each index where IsImageImage is true, which can be used as an iter.Seq
+func (t *Announce) ImageImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageImage(i) {
+				continue
+			}
+			if !yield(t.GetImageImage(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2838,12 +3946,14 @@ func (t *Announce) GetImageLink(index int) (v LinkType) {
 // AppendImageLink adds to the back of image a LinkType type
 func (t *Announce) AppendImageLink(v LinkType) {
 	t.image = append(t.image, &imageIntermediateType{Link: v})
+	t.markPresent_(19, len(t.image) > 0)
 
 }
 
 // PrependImageLink adds to the front of image a LinkType type
 func (t *Announce) PrependImageLink(v LinkType) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{Link: v}}, t.image...)
+	t.markPresent_(19, len(t.image) > 0)
 
 }
 
@@ -2852,6 +3962,22 @@ func (t *Announce) RemoveImageLink(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(19, len(t.image) > 0)
+
+}
+
+// ImageLinkValues returns an iterator over the values that GetImageLink returns for each index where IsImageLink is true, which can be used as an iter.Seq
+func (t *Announce) ImageLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageLink(i) {
+				continue
+			}
+			if !yield(t.GetImageLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2870,12 +3996,14 @@ func (t *Announce) GetImageIRI(index int) (v *url.URL) {
 // AppendImageIRI adds to the back of image a *url.URL type
 func (t *Announce) AppendImageIRI(v *url.URL) {
 	t.image = append(t.image, &imageIntermediateType{IRI: v})
+	t.markPresent_(19, len(t.image) > 0)
 
 }
 
 // PrependImageIRI adds to the front of image a *url.URL type
 func (t *Announce) PrependImageIRI(v *url.URL) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{IRI: v}}, t.image...)
+	t.markPresent_(19, len(t.image) > 0)
 
 }
 
@@ -2884,12 +4012,28 @@ func (t *Announce) RemoveImageIRI(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(19, len(t.image) > 0)
+
+}
+
+// ImageIRIValues returns an iterator over the values that GetImageIRI returns for each index where IsImageIRI is true, which can be used as an iter.Seq
+func (t *Announce) ImageIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageIRI(i) {
+				continue
+			}
+			if !yield(t.GetImageIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownImage determines whether the call to GetUnknownImage is safe
 func (t *Announce) HasUnknownImage() (ok bool) {
-	return t.image != nil && t.image[0].unknown_ != nil
+	return t.present_[0]&(1<<19) != 0 && t.image[0].unknown_ != nil
 
 }
 
@@ -2907,6 +4051,7 @@ func (t *Announce) SetUnknownImage(i interface{}) {
 	tmp := &imageIntermediateType{}
 	tmp.unknown_ = i
 	t.image = append(t.image, tmp)
+	t.markPresent_(19, len(t.image) > 0)
 
 }
 
@@ -2931,12 +4076,14 @@ func (t *Announce) GetInReplyToObject(index int) (v ObjectType) {
 // AppendInReplyToObject adds to the back of inReplyTo a ObjectType type
 func (t *Announce) AppendInReplyToObject(v ObjectType) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Object: v})
+	t.markPresent_(20, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToObject adds to the front of inReplyTo a ObjectType type
 func (t *Announce) PrependInReplyToObject(v ObjectType) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Object: v}}, t.inReplyTo...)
+	t.markPresent_(20, len(t.inReplyTo) > 0)
 
 }
 
@@ -2945,6 +4092,22 @@ func (t *Announce) RemoveInReplyToObject(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(20, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToObjectValues returns an iterator over the values that GetInReplyToObject returns for each index where IsInReplyToObject is true, which can be used as an iter.Seq
+func (t *Announce) InReplyToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToObject(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2963,12 +4126,14 @@ func (t *Announce) GetInReplyToLink(index int) (v LinkType) {
 // AppendInReplyToLink adds to the back of inReplyTo a LinkType type
 func (t *Announce) AppendInReplyToLink(v LinkType) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Link: v})
+	t.markPresent_(20, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToLink adds to the front of inReplyTo a LinkType type
 func (t *Announce) PrependInReplyToLink(v LinkType) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Link: v}}, t.inReplyTo...)
+	t.markPresent_(20, len(t.inReplyTo) > 0)
 
 }
 
@@ -2977,6 +4142,22 @@ func (t *Announce) RemoveInReplyToLink(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(20, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToLinkValues returns an iterator over the values that GetInReplyToLink returns for each index where IsInReplyToLink is true, which can be used as an iter.Seq
+func (t *Announce) InReplyToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToLink(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2995,12 +4176,14 @@ func (t *Announce) GetInReplyToIRI(index int) (v *url.URL) {
 // AppendInReplyToIRI adds to the back of inReplyTo a *url.URL type
 func (t *Announce) AppendInReplyToIRI(v *url.URL) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{IRI: v})
+	t.markPresent_(20, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToIRI adds to the front of inReplyTo a *url.URL type
 func (t *Announce) PrependInReplyToIRI(v *url.URL) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{IRI: v}}, t.inReplyTo...)
+	t.markPresent_(20, len(t.inReplyTo) > 0)
 
 }
 
@@ -3009,12 +4192,28 @@ func (t *Announce) RemoveInReplyToIRI(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(20, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToIRIValues returns an iterator over the values that GetInReplyToIRI returns for each index where IsInReplyToIRI is true, which can be used as an iter.Seq
+func (t *Announce) InReplyToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToIRI(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownInReplyTo determines whether the call to GetUnknownInReplyTo is safe
 func (t *Announce) HasUnknownInReplyTo() (ok bool) {
-	return t.inReplyTo != nil && t.inReplyTo[0].unknown_ != nil
+	return t.present_[0]&(1<<20) != 0 && t.inReplyTo[0].unknown_ != nil
 
 }
 
@@ -3032,6 +4231,7 @@ func (t *Announce) SetUnknownInReplyTo(i interface{}) {
 	tmp := &inReplyToIntermediateType{}
 	tmp.unknown_ = i
 	t.inReplyTo = append(t.inReplyTo, tmp)
+	t.markPresent_(20, len(t.inReplyTo) > 0)
 
 }
 
@@ -3056,12 +4256,14 @@ func (t *Announce) GetLocationObject(index int) (v ObjectType) {
 // AppendLocationObject adds to the back of location a ObjectType type
 func (t *Announce) AppendLocationObject(v ObjectType) {
 	t.location = append(t.location, &locationIntermediateType{Object: v})
+	t.markPresent_(21, len(t.location) > 0)
 
 }
 
 // PrependLocationObject adds to the front of location a ObjectType type
 func (t *Announce) PrependLocationObject(v ObjectType) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{Object: v}}, t.location...)
+	t.markPresent_(21, len(t.location) > 0)
 
 }
 
@@ -3070,6 +4272,22 @@ func (t *Announce) RemoveLocationObject(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(21, len(t.location) > 0)
+
+}
+
+// LocationObjectValues returns an iterator over the values that GetLocationObject returns for each index where IsLocationObject is true, which can be used as an iter.Seq
+func (t *Announce) LocationObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationObject(i) {
+				continue
+			}
+			if !yield(t.GetLocationObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3088,12 +4306,14 @@ func (t *Announce) GetLocationLink(index int) (v LinkType) {
 // AppendLocationLink adds to the back of location a LinkType type
 func (t *Announce) AppendLocationLink(v LinkType) {
 	t.location = append(t.location, &locationIntermediateType{Link: v})
+	t.markPresent_(21, len(t.location) > 0)
 
 }
 
 // PrependLocationLink adds to the front of location a LinkType type
 func (t *Announce) PrependLocationLink(v LinkType) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{Link: v}}, t.location...)
+	t.markPresent_(21, len(t.location) > 0)
 
 }
 
@@ -3102,6 +4322,22 @@ func (t *Announce) RemoveLocationLink(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(21, len(t.location) > 0)
+
+}
+
+// LocationLinkValues returns an iterator over the values that GetLocationLink returns for each index where IsLocationLink is true, which can be used as an iter.Seq
+func (t *Announce) LocationLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationLink(i) {
+				continue
+			}
+			if !yield(t.GetLocationLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3120,12 +4356,14 @@ func (t *Announce) GetLocationIRI(index int) (v *url.URL) {
 // AppendLocationIRI adds to the back of location a *url.URL type
 func (t *Announce) AppendLocationIRI(v *url.URL) {
 	t.location = append(t.location, &locationIntermediateType{IRI: v})
+	t.markPresent_(21, len(t.location) > 0)
 
 }
 
 // PrependLocationIRI adds to the front of location a *url.URL type
 func (t *Announce) PrependLocationIRI(v *url.URL) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{IRI: v}}, t.location...)
+	t.markPresent_(21, len(t.location) > 0)
 
 }
 
@@ -3134,12 +4372,28 @@ func (t *Announce) RemoveLocationIRI(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(21, len(t.location) > 0)
+
+}
+
+// LocationIRIValues returns an iterator over the values that GetLocationIRI returns for each index where IsLocationIRI is true, which can be used as an iter.Seq
+func (t *Announce) LocationIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationIRI(i) {
+				continue
+			}
+			if !yield(t.GetLocationIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownLocation determines whether the call to GetUnknownLocation is safe
 func (t *Announce) HasUnknownLocation() (ok bool) {
-	return t.location != nil && t.location[0].unknown_ != nil
+	return t.present_[0]&(1<<21) != 0 && t.location[0].unknown_ != nil
 
 }
 
@@ -3157,6 +4411,7 @@ func (t *Announce) SetUnknownLocation(i interface{}) {
 	tmp := &locationIntermediateType{}
 	tmp.unknown_ = i
 	t.location = append(t.location, tmp)
+	t.markPresent_(21, len(t.location) > 0)
 
 }
 
@@ -3181,12 +4436,14 @@ func (t *Announce) GetPreviewObject(index int) (v ObjectType) {
 // AppendPreviewObject adds to the back of preview a ObjectType type
 func (t *Announce) AppendPreviewObject(v ObjectType) {
 	t.preview = append(t.preview, &previewIntermediateType{Object: v})
+	t.markPresent_(22, len(t.preview) > 0)
 
 }
 
 // PrependPreviewObject adds to the front of preview a ObjectType type
 func (t *Announce) PrependPreviewObject(v ObjectType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Object: v}}, t.preview...)
+	t.markPresent_(22, len(t.preview) > 0)
 
 }
 
@@ -3195,6 +4452,22 @@ func (t *Announce) RemovePreviewObject(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(22, len(t.preview) > 0)
+
+}
+
+// PreviewObjectValues returns an iterator over the values that GetPreviewObject returns for each index where IsPreviewObject is true, which can be used as an iter.Seq
+func (t *Announce) PreviewObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewObject(i) {
+				continue
+			}
+			if !yield(t.GetPreviewObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3213,12 +4486,14 @@ func (t *Announce) GetPreviewLink(index int) (v LinkType) {
 // AppendPreviewLink adds to the back of preview a LinkType type
 func (t *Announce) AppendPreviewLink(v LinkType) {
 	t.preview = append(t.preview, &previewIntermediateType{Link: v})
+	t.markPresent_(22, len(t.preview) > 0)
 
 }
 
 // PrependPreviewLink adds to the front of preview a LinkType type
 func (t *Announce) PrependPreviewLink(v LinkType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Link: v}}, t.preview...)
+	t.markPresent_(22, len(t.preview) > 0)
 
 }
 
@@ -3227,6 +4502,22 @@ func (t *Announce) RemovePreviewLink(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(22, len(t.preview) > 0)
+
+}
+
+// PreviewLinkValues returns an iterator over the values that GetPreviewLink returns for each index where IsPreviewLink is true, which can be used as an iter.Seq
+func (t *Announce) PreviewLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewLink(i) {
+				continue
+			}
+			if !yield(t.GetPreviewLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3245,12 +4536,14 @@ func (t *Announce) GetPreviewIRI(index int) (v *url.URL) {
 // AppendPreviewIRI adds to the back of preview a *url.URL type
 func (t *Announce) AppendPreviewIRI(v *url.URL) {
 	t.preview = append(t.preview, &previewIntermediateType{IRI: v})
+	t.markPresent_(22, len(t.preview) > 0)
 
 }
 
 // PrependPreviewIRI adds to the front of preview a *url.URL type
 func (t *Announce) PrependPreviewIRI(v *url.URL) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{IRI: v}}, t.preview...)
+	t.markPresent_(22, len(t.preview) > 0)
 
 }
 
@@ -3259,12 +4552,28 @@ func (t *Announce) RemovePreviewIRI(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(22, len(t.preview) > 0)
+
+}
+
+// PreviewIRIValues returns an iterator over the values that GetPreviewIRI returns for each index where IsPreviewIRI is true, which can be used as an iter.Seq
+func (t *Announce) PreviewIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewIRI(i) {
+				continue
+			}
+			if !yield(t.GetPreviewIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownPreview determines whether the call to GetUnknownPreview is safe
 func (t *Announce) HasUnknownPreview() (ok bool) {
-	return t.preview != nil && t.preview[0].unknown_ != nil
+	return t.present_[0]&(1<<22) != 0 && t.preview[0].unknown_ != nil
 
 }
 
@@ -3282,12 +4591,13 @@ func (t *Announce) SetUnknownPreview(i interface{}) {
 	tmp := &previewIntermediateType{}
 	tmp.unknown_ = i
 	t.preview = append(t.preview, tmp)
+	t.markPresent_(22, len(t.preview) > 0)
 
 }
 
 // IsPublished determines whether the call to GetPublished is safe
 func (t *Announce) IsPublished() (ok bool) {
-	return t.published != nil && t.published.dateTime != nil
+	return t.present_[0]&(1<<23) != 0 && t.published.dateTime != nil
 
 }
 
@@ -3300,12 +4610,13 @@ func (t *Announce) GetPublished() (v time.Time) {
 // SetPublished sets the value of published to be of time.Time type
 func (t *Announce) SetPublished(v time.Time) {
 	t.published = &publishedIntermediateType{dateTime: &v}
+	t.markPresent_(23, true)
 
 }
 
 // IsPublishedIRI determines whether the call to GetPublishedIRI is safe
 func (t *Announce) IsPublishedIRI() (ok bool) {
-	return t.published != nil && t.published.IRI != nil
+	return t.present_[0]&(1<<23) != 0 && t.published.IRI != nil
 
 }
 
@@ -3318,12 +4629,13 @@ func (t *Announce) GetPublishedIRI() (v *url.URL) {
 // SetPublishedIRI sets the value of published to be of *url.URL type
 func (t *Announce) SetPublishedIRI(v *url.URL) {
 	t.published = &publishedIntermediateType{IRI: v}
+	t.markPresent_(23, true)
 
 }
 
 // HasUnknownPublished determines whether the call to GetUnknownPublished is safe
 func (t *Announce) HasUnknownPublished() (ok bool) {
-	return t.published != nil && t.published.unknown_ != nil
+	return t.present_[0]&(1<<23) != 0 && t.published.unknown_ != nil
 
 }
 
@@ -3341,12 +4653,13 @@ func (t *Announce) SetUnknownPublished(i interface{}) {
 	tmp := &publishedIntermediateType{}
 	tmp.unknown_ = i
 	t.published = tmp
+	t.markPresent_(23, true)
 
 }
 
 // IsReplies determines whether the call to GetReplies is safe
 func (t *Announce) IsReplies() (ok bool) {
-	return t.replies != nil && t.replies.Collection != nil
+	return t.present_[0]&(1<<24) != 0 && t.replies.Collection != nil
 
 }
 
@@ -3359,12 +4672,13 @@ func (t *Announce) GetReplies() (v CollectionType) {
 // SetReplies sets the value of replies to be of CollectionType type
 func (t *Announce) SetReplies(v CollectionType) {
 	t.replies = &repliesIntermediateType{Collection: v}
+	t.markPresent_(24, true)
 
 }
 
 // IsRepliesIRI determines whether the call to GetRepliesIRI is safe
 func (t *Announce) IsRepliesIRI() (ok bool) {
-	return t.replies != nil && t.replies.IRI != nil
+	return t.present_[0]&(1<<24) != 0 && t.replies.IRI != nil
 
 }
 
@@ -3377,12 +4691,13 @@ func (t *Announce) GetRepliesIRI() (v *url.URL) {
 // SetRepliesIRI sets the value of replies to be of *url.URL type
 func (t *Announce) SetRepliesIRI(v *url.URL) {
 	t.replies = &repliesIntermediateType{IRI: v}
+	t.markPresent_(24, true)
 
 }
 
 // HasUnknownReplies determines whether the call to GetUnknownReplies is safe
 func (t *Announce) HasUnknownReplies() (ok bool) {
-	return t.replies != nil && t.replies.unknown_ != nil
+	return t.present_[0]&(1<<24) != 0 && t.replies.unknown_ != nil
 
 }
 
@@ -3400,12 +4715,13 @@ func (t *Announce) SetUnknownReplies(i interface{}) {
 	tmp := &repliesIntermediateType{}
 	tmp.unknown_ = i
 	t.replies = tmp
+	t.markPresent_(24, true)
 
 }
 
 // IsStartTime determines whether the call to GetStartTime is safe
 func (t *Announce) IsStartTime() (ok bool) {
-	return t.startTime != nil && t.startTime.dateTime != nil
+	return t.present_[0]&(1<<25) != 0 && t.startTime.dateTime != nil
 
 }
 
@@ -3418,12 +4734,13 @@ func (t *Announce) GetStartTime() (v time.Time) {
 // SetStartTime sets the value of startTime to be of time.Time type
 func (t *Announce) SetStartTime(v time.Time) {
 	t.startTime = &startTimeIntermediateType{dateTime: &v}
+	t.markPresent_(25, true)
 
 }
 
 // IsStartTimeIRI determines whether the call to GetStartTimeIRI is safe
 func (t *Announce) IsStartTimeIRI() (ok bool) {
-	return t.startTime != nil && t.startTime.IRI != nil
+	return t.present_[0]&(1<<25) != 0 && t.startTime.IRI != nil
 
 }
 
@@ -3436,12 +4753,13 @@ func (t *Announce) GetStartTimeIRI() (v *url.URL) {
 // SetStartTimeIRI sets the value of startTime to be of *url.URL type
 func (t *Announce) SetStartTimeIRI(v *url.URL) {
 	t.startTime = &startTimeIntermediateType{IRI: v}
+	t.markPresent_(25, true)
 
 }
 
 // HasUnknownStartTime determines whether the call to GetUnknownStartTime is safe
 func (t *Announce) HasUnknownStartTime() (ok bool) {
-	return t.startTime != nil && t.startTime.unknown_ != nil
+	return t.present_[0]&(1<<25) != 0 && t.startTime.unknown_ != nil
 
 }
 
@@ -3459,6 +4777,7 @@ func (t *Announce) SetUnknownStartTime(i interface{}) {
 	tmp := &startTimeIntermediateType{}
 	tmp.unknown_ = i
 	t.startTime = tmp
+	t.markPresent_(25, true)
 
 }
 
@@ -3483,12 +4802,14 @@ func (t *Announce) GetSummaryString(index int) (v string) {
 // AppendSummaryString adds to the back of summary a string type
 func (t *Announce) AppendSummaryString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{stringName: &v})
+	t.markPresent_(26, len(t.summary) > 0)
 
 }
 
 // PrependSummaryString adds to the front of summary a string type
 func (t *Announce) PrependSummaryString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{stringName: &v}}, t.summary...)
+	t.markPresent_(26, len(t.summary) > 0)
 
 }
 
@@ -3497,6 +4818,22 @@ func (t *Announce) RemoveSummaryString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(26, len(t.summary) > 0)
+
+}
+
+// SummaryStringValues returns an iterator over the values that GetSummaryString returns for each index where IsSummaryString is true, which can be used as an iter.Seq
+func (t *Announce) SummaryStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3515,12 +4852,14 @@ func (t *Announce) GetSummaryLangString(index int) (v string) {
 // AppendSummaryLangString adds to the back of summary a string type
 func (t *Announce) AppendSummaryLangString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{langString: &v})
+	t.markPresent_(26, len(t.summary) > 0)
 
 }
 
 // PrependSummaryLangString adds to the front of summary a string type
 func (t *Announce) PrependSummaryLangString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{langString: &v}}, t.summary...)
+	t.markPresent_(26, len(t.summary) > 0)
 
 }
 
@@ -3529,6 +4868,22 @@ func (t *Announce) RemoveSummaryLangString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(26, len(t.summary) > 0)
+
+}
+
+// SummaryLangStringValues returns an iterator over the values that GetSummaryLangString returns for each index where IsSummaryLangString is true, which can be used as an iter.Seq
+func (t *Announce) SummaryLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryLangString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3547,12 +4902,14 @@ func (t *Announce) GetSummaryIRI(index int) (v *url.URL) {
 // AppendSummaryIRI adds to the back of summary a *url.URL type
 func (t *Announce) AppendSummaryIRI(v *url.URL) {
 	t.summary = append(t.summary, &summaryIntermediateType{IRI: v})
+	t.markPresent_(26, len(t.summary) > 0)
 
 }
 
 // PrependSummaryIRI adds to the front of summary a *url.URL type
 func (t *Announce) PrependSummaryIRI(v *url.URL) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{IRI: v}}, t.summary...)
+	t.markPresent_(26, len(t.summary) > 0)
 
 }
 
@@ -3561,12 +4918,28 @@ func (t *Announce) RemoveSummaryIRI(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(26, len(t.summary) > 0)
+
+}
+
+// SummaryIRIValues returns an iterator over the values that GetSummaryIRI returns for each index where IsSummaryIRI is true, which can be used as an iter.Seq
+func (t *Announce) SummaryIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryIRI(i) {
+				continue
+			}
+			if !yield(t.GetSummaryIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownSummary determines whether the call to GetUnknownSummary is safe
 func (t *Announce) HasUnknownSummary() (ok bool) {
-	return t.summary != nil && t.summary[0].unknown_ != nil
+	return t.present_[0]&(1<<26) != 0 && t.summary[0].unknown_ != nil
 
 }
 
@@ -3584,6 +4957,7 @@ func (t *Announce) SetUnknownSummary(i interface{}) {
 	tmp := &summaryIntermediateType{}
 	tmp.unknown_ = i
 	t.summary = append(t.summary, tmp)
+	t.markPresent_(26, len(t.summary) > 0)
 
 }
 
@@ -3617,11 +4991,41 @@ func (t *Announce) GetSummaryMap(l string) (v string) {
 func (t *Announce) SetSummaryMap(l string, v string) {
 	if t.summaryMap == nil {
 		t.summaryMap = make(map[string]string)
+		t.markPresent_(27, true)
 	}
 	t.summaryMap[l] = v
 
 }
 
+// GetSummaryLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Announce) GetSummaryLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.summaryMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.summaryMap[k], true
+
+}
+
+// SetSummaryLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Announce) SetSummaryLanguage(tag string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(27, true)
+	} else if k, ok := languageKey(t.summaryMap, tag); ok {
+		delete(t.summaryMap, k)
+	}
+	t.summaryMap[tag] = v
+
+}
+
+// PreferredSummaryLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Announce) PreferredSummaryLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.summaryMap, tags)
+	return t.summaryMap[tag], tag
+
+}
+
 // TagLen determines the number of elements able to be used for the IsTagObject, GetTagObject, and RemoveTagObject functions
 func (t *Announce) TagLen() (l int) {
 	return len(t.tag)
@@ -3643,12 +5047,14 @@ func (t *Announce) GetTagObject(index int) (v ObjectType) {
 // AppendTagObject adds to the back of tag a ObjectType type
 func (t *Announce) AppendTagObject(v ObjectType) {
 	t.tag = append(t.tag, &tagIntermediateType{Object: v})
+	t.markPresent_(28, len(t.tag) > 0)
 
 }
 
 // PrependTagObject adds to the front of tag a ObjectType type
 func (t *Announce) PrependTagObject(v ObjectType) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Object: v}}, t.tag...)
+	t.markPresent_(28, len(t.tag) > 0)
 
 }
 
@@ -3657,6 +5063,22 @@ func (t *Announce) RemoveTagObject(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(28, len(t.tag) > 0)
+
+}
+
+// TagObjectValues returns an iterator over the values that GetTagObject returns for each index where IsTagObject is true, which can be used as an iter.Seq
+func (t *Announce) TagObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagObject(i) {
+				continue
+			}
+			if !yield(t.GetTagObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3675,12 +5097,14 @@ func (t *Announce) GetTagLink(index int) (v LinkType) {
 // AppendTagLink adds to the back of tag a LinkType type
 func (t *Announce) AppendTagLink(v LinkType) {
 	t.tag = append(t.tag, &tagIntermediateType{Link: v})
+	t.markPresent_(28, len(t.tag) > 0)
 
 }
 
 // PrependTagLink adds to the front of tag a LinkType type
 func (t *Announce) PrependTagLink(v LinkType) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Link: v}}, t.tag...)
+	t.markPresent_(28, len(t.tag) > 0)
 
 }
 
@@ -3689,6 +5113,22 @@ func (t *Announce) RemoveTagLink(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(28, len(t.tag) > 0)
+
+}
+
+// TagLinkValues returns an iterator over the values that GetTagLink returns for each index where IsTagLink is true, which can be used as an iter.Seq
+func (t *Announce) TagLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagLink(i) {
+				continue
+			}
+			if !yield(t.GetTagLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3707,12 +5147,14 @@ func (t *Announce) GetTagIRI(index int) (v *url.URL) {
 // AppendTagIRI adds to the back of tag a *url.URL type
 func (t *Announce) AppendTagIRI(v *url.URL) {
 	t.tag = append(t.tag, &tagIntermediateType{IRI: v})
+	t.markPresent_(28, len(t.tag) > 0)
 
 }
 
 // PrependTagIRI adds to the front of tag a *url.URL type
 func (t *Announce) PrependTagIRI(v *url.URL) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{IRI: v}}, t.tag...)
+	t.markPresent_(28, len(t.tag) > 0)
 
 }
 
@@ -3721,12 +5163,28 @@ func (t *Announce) RemoveTagIRI(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(28, len(t.tag) > 0)
+
+}
+
+// TagIRIValues returns an iterator over the values that GetTagIRI returns for each index where IsTagIRI is true, which can be used as an iter.Seq
+func (t *Announce) TagIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagIRI(i) {
+				continue
+			}
+			if !yield(t.GetTagIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownTag determines whether the call to GetUnknownTag is safe
 func (t *Announce) HasUnknownTag() (ok bool) {
-	return t.tag != nil && t.tag[0].unknown_ != nil
+	return t.present_[0]&(1<<28) != 0 && t.tag[0].unknown_ != nil
 
 }
 
@@ -3744,6 +5202,7 @@ func (t *Announce) SetUnknownTag(i interface{}) {
 	tmp := &tagIntermediateType{}
 	tmp.unknown_ = i
 	t.tag = append(t.tag, tmp)
+	t.markPresent_(28, len(t.tag) > 0)
 
 }
 
@@ -3762,12 +5221,14 @@ func (t *Announce) GetType(index int) (v interface{}) {
 // AppendType adds a value to the back of type
 func (t *Announce) AppendType(v interface{}) {
 	t.typeName = append(t.typeName, v)
+	t.markPresent_(29, len(t.typeName) > 0)
 
 }
 
 // PrependType adds a value to the front of type
 func (t *Announce) PrependType(v interface{}) {
 	t.typeName = append([]interface{}{v}, t.typeName...)
+	t.markPresent_(29, len(t.typeName) > 0)
 
 }
 
@@ -3776,12 +5237,13 @@ func (t *Announce) RemoveType(index int) {
 	copy(t.typeName[index:], t.typeName[index+1:])
 	t.typeName[len(t.typeName)-1] = nil
 	t.typeName = t.typeName[:len(t.typeName)-1]
+	t.markPresent_(29, len(t.typeName) > 0)
 
 }
 
 // IsUpdated determines whether the call to GetUpdated is safe
 func (t *Announce) IsUpdated() (ok bool) {
-	return t.updated != nil && t.updated.dateTime != nil
+	return t.present_[0]&(1<<30) != 0 && t.updated.dateTime != nil
 
 }
 
@@ -3794,12 +5256,13 @@ func (t *Announce) GetUpdated() (v time.Time) {
 // SetUpdated sets the value of updated to be of time.Time type
 func (t *Announce) SetUpdated(v time.Time) {
 	t.updated = &updatedIntermediateType{dateTime: &v}
+	t.markPresent_(30, true)
 
 }
 
 // IsUpdatedIRI determines whether the call to GetUpdatedIRI is safe
 func (t *Announce) IsUpdatedIRI() (ok bool) {
-	return t.updated != nil && t.updated.IRI != nil
+	return t.present_[0]&(1<<30) != 0 && t.updated.IRI != nil
 
 }
 
@@ -3812,12 +5275,13 @@ func (t *Announce) GetUpdatedIRI() (v *url.URL) {
 // SetUpdatedIRI sets the value of updated to be of *url.URL type
 func (t *Announce) SetUpdatedIRI(v *url.URL) {
 	t.updated = &updatedIntermediateType{IRI: v}
+	t.markPresent_(30, true)
 
 }
 
 // HasUnknownUpdated determines whether the call to GetUnknownUpdated is safe
 func (t *Announce) HasUnknownUpdated() (ok bool) {
-	return t.updated != nil && t.updated.unknown_ != nil
+	return t.present_[0]&(1<<30) != 0 && t.updated.unknown_ != nil
 
 }
 
@@ -3835,6 +5299,7 @@ func (t *Announce) SetUnknownUpdated(i interface{}) {
 	tmp := &updatedIntermediateType{}
 	tmp.unknown_ = i
 	t.updated = tmp
+	t.markPresent_(30, true)
 
 }
 
@@ -3859,12 +5324,14 @@ func (t *Announce) GetUrlAnyURI(index int) (v *url.URL) {
 // AppendUrlAnyURI adds to the back of url a *url.URL type
 func (t *Announce) AppendUrlAnyURI(v *url.URL) {
 	t.url = append(t.url, &urlIntermediateType{anyURI: v})
+	t.markPresent_(31, len(t.url) > 0)
 
 }
 
 // PrependUrlAnyURI adds to the front of url a *url.URL type
 func (t *Announce) PrependUrlAnyURI(v *url.URL) {
 	t.url = append([]*urlIntermediateType{&urlIntermediateType{anyURI: v}}, t.url...)
+	t.markPresent_(31, len(t.url) > 0)
 
 }
 
@@ -3873,6 +5340,22 @@ func (t *Announce) RemoveUrlAnyURI(index int) {
 	copy(t.url[index:], t.url[index+1:])
 	t.url[len(t.url)-1] = nil
 	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(31, len(t.url) > 0)
+
+}
+
+// UrlAnyURIValues returns an iterator over the values that GetUrlAnyURI returns for each index where IsUrlAnyURI is true, which can be used as an iter.Seq
+func (t *Announce) UrlAnyURIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlAnyURI(i) {
+				continue
+			}
+			if !yield(t.GetUrlAnyURI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3891,12 +5374,14 @@ func (t *Announce) GetUrlLink(index int) (v LinkType) {
 // AppendUrlLink adds to the back of url a LinkType type
 func (t *Announce) AppendUrlLink(v LinkType) {
 	t.url = append(t.url, &urlIntermediateType{Link: v})
+	t.markPresent_(31, len(t.url) > 0)
 
 }
 
 // PrependUrlLink adds to the front of url a LinkType type
 func (t *Announce) PrependUrlLink(v LinkType) {
 	t.url = append([]*urlIntermediateType{&urlIntermediateType{Link: v}}, t.url...)
+	t.markPresent_(31, len(t.url) > 0)
 
 }
 
@@ -3905,12 +5390,28 @@ func (t *Announce) RemoveUrlLink(index int) {
 	copy(t.url[index:], t.url[index+1:])
 	t.url[len(t.url)-1] = nil
 	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(31, len(t.url) > 0)
+
+}
+
+// UrlLinkValues returns an iterator over the values that GetUrlLink returns for each index where IsUrlLink is true, which can be used as an iter.Seq
+func (t *Announce) UrlLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlLink(i) {
+				continue
+			}
+			if !yield(t.GetUrlLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownUrl determines whether the call to GetUnknownUrl is safe
 func (t *Announce) HasUnknownUrl() (ok bool) {
-	return t.url != nil && t.url[0].unknown_ != nil
+	return t.present_[0]&(1<<31) != 0 && t.url[0].unknown_ != nil
 
 }
 
@@ -3928,6 +5429,7 @@ func (t *Announce) SetUnknownUrl(i interface{}) {
 	tmp := &urlIntermediateType{}
 	tmp.unknown_ = i
 	t.url = append(t.url, tmp)
+	t.markPresent_(31, len(t.url) > 0)
 
 }
 
@@ -3952,12 +5454,14 @@ func (t *Announce) GetToObject(index int) (v ObjectType) {
 // AppendToObject adds to the back of to a ObjectType type
 func (t *Announce) AppendToObject(v ObjectType) {
 	t.to = append(t.to, &toIntermediateType{Object: v})
+	t.markPresent_(32, len(t.to) > 0)
 
 }
 
 // PrependToObject adds to the front of to a ObjectType type
 func (t *Announce) PrependToObject(v ObjectType) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{Object: v}}, t.to...)
+	t.markPresent_(32, len(t.to) > 0)
 
 }
 
@@ -3966,6 +5470,22 @@ func (t *Announce) RemoveToObject(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(32, len(t.to) > 0)
+
+}
+
+// ToObjectValues returns an iterator over the values that GetToObject returns for each index where IsToObject is true, which can be used as an iter.Seq
+func (t *Announce) ToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToObject(i) {
+				continue
+			}
+			if !yield(t.GetToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3984,12 +5504,14 @@ func (t *Announce) GetToLink(index int) (v LinkType) {
 // AppendToLink adds to the back of to a LinkType type
 func (t *Announce) AppendToLink(v LinkType) {
 	t.to = append(t.to, &toIntermediateType{Link: v})
+	t.markPresent_(32, len(t.to) > 0)
 
 }
 
 // PrependToLink adds to the front of to a LinkType type
 func (t *Announce) PrependToLink(v LinkType) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{Link: v}}, t.to...)
+	t.markPresent_(32, len(t.to) > 0)
 
 }
 
@@ -3998,6 +5520,22 @@ func (t *Announce) RemoveToLink(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(32, len(t.to) > 0)
+
+}
+
+// ToLinkValues returns an iterator over the values that GetToLink returns for each index where IsToLink is true, which can be used as an iter.Seq
+func (t *Announce) ToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToLink(i) {
+				continue
+			}
+			if !yield(t.GetToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4016,12 +5554,14 @@ func (t *Announce) GetToIRI(index int) (v *url.URL) {
 // AppendToIRI adds to the back of to a *url.URL type
 func (t *Announce) AppendToIRI(v *url.URL) {
 	t.to = append(t.to, &toIntermediateType{IRI: v})
+	t.markPresent_(32, len(t.to) > 0)
 
 }
 
 // PrependToIRI adds to the front of to a *url.URL type
 func (t *Announce) PrependToIRI(v *url.URL) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{IRI: v}}, t.to...)
+	t.markPresent_(32, len(t.to) > 0)
 
 }
 
@@ -4030,12 +5570,28 @@ func (t *Announce) RemoveToIRI(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(32, len(t.to) > 0)
+
+}
+
+// ToIRIValues returns an iterator over the values that GetToIRI returns for each index where IsToIRI is true, which can be used as an iter.Seq
+func (t *Announce) ToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToIRI(i) {
+				continue
+			}
+			if !yield(t.GetToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownTo determines whether the call to GetUnknownTo is safe
 func (t *Announce) HasUnknownTo() (ok bool) {
-	return t.to != nil && t.to[0].unknown_ != nil
+	return t.present_[0]&(1<<32) != 0 && t.to[0].unknown_ != nil
 
 }
 
@@ -4053,6 +5609,7 @@ func (t *Announce) SetUnknownTo(i interface{}) {
 	tmp := &toIntermediateType{}
 	tmp.unknown_ = i
 	t.to = append(t.to, tmp)
+	t.markPresent_(32, len(t.to) > 0)
 
 }
 
@@ -4077,12 +5634,14 @@ func (t *Announce) GetBtoObject(index int) (v ObjectType) {
 // AppendBtoObject adds to the back of bto a ObjectType type
 func (t *Announce) AppendBtoObject(v ObjectType) {
 	t.bto = append(t.bto, &btoIntermediateType{Object: v})
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
 // PrependBtoObject adds to the front of bto a ObjectType type
 func (t *Announce) PrependBtoObject(v ObjectType) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Object: v}}, t.bto...)
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
@@ -4091,6 +5650,22 @@ func (t *Announce) RemoveBtoObject(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(33, len(t.bto) > 0)
+
+}
+
+// BtoObjectValues returns an iterator over the values that GetBtoObject returns for each index where IsBtoObject is true, which can be used as an iter.Seq
+func (t *Announce) BtoObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoObject(i) {
+				continue
+			}
+			if !yield(t.GetBtoObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4109,12 +5684,14 @@ func (t *Announce) GetBtoLink(index int) (v LinkType) {
 // AppendBtoLink adds to the back of bto a LinkType type
 func (t *Announce) AppendBtoLink(v LinkType) {
 	t.bto = append(t.bto, &btoIntermediateType{Link: v})
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
 // PrependBtoLink adds to the front of bto a LinkType type
 func (t *Announce) PrependBtoLink(v LinkType) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Link: v}}, t.bto...)
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
@@ -4123,6 +5700,22 @@ func (t *Announce) RemoveBtoLink(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(33, len(t.bto) > 0)
+
+}
+
+// BtoLinkValues returns an iterator over the values that GetBtoLink returns for each index where IsBtoLink is true, which can be used as an iter.Seq
+func (t *Announce) BtoLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoLink(i) {
+				continue
+			}
+			if !yield(t.GetBtoLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4141,12 +5734,14 @@ func (t *Announce) GetBtoIRI(index int) (v *url.URL) {
 // AppendBtoIRI adds to the back of bto a *url.URL type
 func (t *Announce) AppendBtoIRI(v *url.URL) {
 	t.bto = append(t.bto, &btoIntermediateType{IRI: v})
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
 // PrependBtoIRI adds to the front of bto a *url.URL type
 func (t *Announce) PrependBtoIRI(v *url.URL) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{IRI: v}}, t.bto...)
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
@@ -4155,12 +5750,28 @@ func (t *Announce) RemoveBtoIRI(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
-// HasUnknownBto determines whether the call to GetUnknownBto is safe
+// BtoIRIValues returns an iterator over the values that GetBtoIRI returns for each index where IsBtoIRI is true, which can be used as an iter.Seq
+func (t *Announce) BtoIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoIRI(i) {
+				continue
+			}
+			if !yield(t.GetBtoIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownBto determines whether the call to GetUnknownBto is safe
 func (t *Announce) HasUnknownBto() (ok bool) {
-	return t.bto != nil && t.bto[0].unknown_ != nil
+	return t.present_[0]&(1<<33) != 0 && t.bto[0].unknown_ != nil
 
 }
 
@@ -4178,6 +5789,7 @@ func (t *Announce) SetUnknownBto(i interface{}) {
 	tmp := &btoIntermediateType{}
 	tmp.unknown_ = i
 	t.bto = append(t.bto, tmp)
+	t.markPresent_(33, len(t.bto) > 0)
 
 }
 
@@ -4202,12 +5814,14 @@ func (t *Announce) GetCcObject(index int) (v ObjectType) {
 // AppendCcObject adds to the back of cc a ObjectType type
 func (t *Announce) AppendCcObject(v ObjectType) {
 	t.cc = append(t.cc, &ccIntermediateType{Object: v})
+	t.markPresent_(34, len(t.cc) > 0)
 
 }
 
 // PrependCcObject adds to the front of cc a ObjectType type
 func (t *Announce) PrependCcObject(v ObjectType) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Object: v}}, t.cc...)
+	t.markPresent_(34, len(t.cc) > 0)
 
 }
 
@@ -4216,6 +5830,22 @@ func (t *Announce) RemoveCcObject(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(34, len(t.cc) > 0)
+
+}
+
+// CcObjectValues returns an iterator over the values that GetCcObject returns for each index where IsCcObject is true, which can be used as an iter.Seq
+func (t *Announce) CcObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcObject(i) {
+				continue
+			}
+			if !yield(t.GetCcObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4234,12 +5864,14 @@ func (t *Announce) GetCcLink(index int) (v LinkType) {
 // AppendCcLink adds to the back of cc a LinkType type
 func (t *Announce) AppendCcLink(v LinkType) {
 	t.cc = append(t.cc, &ccIntermediateType{Link: v})
+	t.markPresent_(34, len(t.cc) > 0)
 
 }
 
 // PrependCcLink adds to the front of cc a LinkType type
 func (t *Announce) PrependCcLink(v LinkType) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Link: v}}, t.cc...)
+	t.markPresent_(34, len(t.cc) > 0)
 
 }
 
@@ -4248,6 +5880,22 @@ func (t *Announce) RemoveCcLink(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(34, len(t.cc) > 0)
+
+}
+
+// CcLinkValues returns an iterator over the values that GetCcLink returns for each index where IsCcLink is true, which can be used as an iter.Seq
+func (t *Announce) CcLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcLink(i) {
+				continue
+			}
+			if !yield(t.GetCcLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4266,12 +5914,14 @@ func (t *Announce) GetCcIRI(index int) (v *url.URL) {
 // AppendCcIRI adds to the back of cc a *url.URL type
 func (t *Announce) AppendCcIRI(v *url.URL) {
 	t.cc = append(t.cc, &ccIntermediateType{IRI: v})
+	t.markPresent_(34, len(t.cc) > 0)
 
 }
 
 // PrependCcIRI adds to the front of cc a *url.URL type
 func (t *Announce) PrependCcIRI(v *url.URL) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{IRI: v}}, t.cc...)
+	t.markPresent_(34, len(t.cc) > 0)
 
 }
 
@@ -4280,12 +5930,28 @@ func (t *Announce) RemoveCcIRI(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(34, len(t.cc) > 0)
+
+}
+
+// CcIRIValues returns an iterator over the values that GetCcIRI returns for each index where IsCcIRI is true, which can be used as an iter.Seq
+func (t *Announce) CcIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcIRI(i) {
+				continue
+			}
+			if !yield(t.GetCcIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownCc determines whether the call to GetUnknownCc is safe
 func (t *Announce) HasUnknownCc() (ok bool) {
-	return t.cc != nil && t.cc[0].unknown_ != nil
+	return t.present_[0]&(1<<34) != 0 && t.cc[0].unknown_ != nil
 
 }
 
@@ -4303,6 +5969,7 @@ func (t *Announce) SetUnknownCc(i interface{}) {
 	tmp := &ccIntermediateType{}
 	tmp.unknown_ = i
 	t.cc = append(t.cc, tmp)
+	t.markPresent_(34, len(t.cc) > 0)
 
 }
 
@@ -4327,12 +5994,14 @@ func (t *Announce) GetBccObject(index int) (v ObjectType) {
 // AppendBccObject adds to the back of bcc a ObjectType type
 func (t *Announce) AppendBccObject(v ObjectType) {
 	t.bcc = append(t.bcc, &bccIntermediateType{Object: v})
+	t.markPresent_(35, len(t.bcc) > 0)
 
 }
 
 // PrependBccObject adds to the front of bcc a ObjectType type
 func (t *Announce) PrependBccObject(v ObjectType) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Object: v}}, t.bcc...)
+	t.markPresent_(35, len(t.bcc) > 0)
 
 }
 
@@ -4341,6 +6010,22 @@ func (t *Announce) RemoveBccObject(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(35, len(t.bcc) > 0)
+
+}
+
+// BccObjectValues returns an iterator over the values that GetBccObject returns for each index where IsBccObject is true, which can be used as an iter.Seq
+func (t *Announce) BccObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccObject(i) {
+				continue
+			}
+			if !yield(t.GetBccObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4359,12 +6044,14 @@ func (t *Announce) GetBccLink(index int) (v LinkType) {
 // AppendBccLink adds to the back of bcc a LinkType type
 func (t *Announce) AppendBccLink(v LinkType) {
 	t.bcc = append(t.bcc, &bccIntermediateType{Link: v})
+	t.markPresent_(35, len(t.bcc) > 0)
 
 }
 
 // PrependBccLink adds to the front of bcc a LinkType type
 func (t *Announce) PrependBccLink(v LinkType) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Link: v}}, t.bcc...)
+	t.markPresent_(35, len(t.bcc) > 0)
 
 }
 
@@ -4373,6 +6060,22 @@ func (t *Announce) RemoveBccLink(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(35, len(t.bcc) > 0)
+
+}
+
+// BccLinkValues returns an iterator over the values that GetBccLink returns for each index where IsBccLink is true, which can be used as an iter.Seq
+func (t *Announce) BccLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccLink(i) {
+				continue
+			}
+			if !yield(t.GetBccLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4391,12 +6094,14 @@ func (t *Announce) GetBccIRI(index int) (v *url.URL) {
 // AppendBccIRI adds to the back of bcc a *url.URL type
 func (t *Announce) AppendBccIRI(v *url.URL) {
 	t.bcc = append(t.bcc, &bccIntermediateType{IRI: v})
+	t.markPresent_(35, len(t.bcc) > 0)
 
 }
 
 // PrependBccIRI adds to the front of bcc a *url.URL type
 func (t *Announce) PrependBccIRI(v *url.URL) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{IRI: v}}, t.bcc...)
+	t.markPresent_(35, len(t.bcc) > 0)
 
 }
 
@@ -4405,12 +6110,28 @@ func (t *Announce) RemoveBccIRI(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(35, len(t.bcc) > 0)
+
+}
+
+// BccIRIValues returns an iterator over the values that GetBccIRI returns for each index where IsBccIRI is true, which can be used as an iter.Seq
+func (t *Announce) BccIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccIRI(i) {
+				continue
+			}
+			if !yield(t.GetBccIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownBcc determines whether the call to GetUnknownBcc is safe
 func (t *Announce) HasUnknownBcc() (ok bool) {
-	return t.bcc != nil && t.bcc[0].unknown_ != nil
+	return t.present_[0]&(1<<35) != 0 && t.bcc[0].unknown_ != nil
 
 }
 
@@ -4428,12 +6149,13 @@ func (t *Announce) SetUnknownBcc(i interface{}) {
 	tmp := &bccIntermediateType{}
 	tmp.unknown_ = i
 	t.bcc = append(t.bcc, tmp)
+	t.markPresent_(35, len(t.bcc) > 0)
 
 }
 
 // IsMediaType determines whether the call to GetMediaType is safe
 func (t *Announce) IsMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.mimeMediaTypeValue != nil
+	return t.present_[0]&(1<<36) != 0 && t.mediaType.mimeMediaTypeValue != nil
 
 }
 
@@ -4446,12 +6168,13 @@ func (t *Announce) GetMediaType() (v string) {
 // SetMediaType sets the value of mediaType to be of string type
 func (t *Announce) SetMediaType(v string) {
 	t.mediaType = &mediaTypeIntermediateType{mimeMediaTypeValue: &v}
+	t.markPresent_(36, true)
 
 }
 
 // IsMediaTypeIRI determines whether the call to GetMediaTypeIRI is safe
 func (t *Announce) IsMediaTypeIRI() (ok bool) {
-	return t.mediaType != nil && t.mediaType.IRI != nil
+	return t.present_[0]&(1<<36) != 0 && t.mediaType.IRI != nil
 
 }
 
@@ -4464,12 +6187,13 @@ func (t *Announce) GetMediaTypeIRI() (v *url.URL) {
 // SetMediaTypeIRI sets the value of mediaType to be of *url.URL type
 func (t *Announce) SetMediaTypeIRI(v *url.URL) {
 	t.mediaType = &mediaTypeIntermediateType{IRI: v}
+	t.markPresent_(36, true)
 
 }
 
 // HasUnknownMediaType determines whether the call to GetUnknownMediaType is safe
 func (t *Announce) HasUnknownMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.unknown_ != nil
+	return t.present_[0]&(1<<36) != 0 && t.mediaType.unknown_ != nil
 
 }
 
@@ -4487,12 +6211,13 @@ func (t *Announce) SetUnknownMediaType(i interface{}) {
 	tmp := &mediaTypeIntermediateType{}
 	tmp.unknown_ = i
 	t.mediaType = tmp
+	t.markPresent_(36, true)
 
 }
 
 // IsDuration determines whether the call to GetDuration is safe
 func (t *Announce) IsDuration() (ok bool) {
-	return t.duration != nil && t.duration.duration != nil
+	return t.present_[0]&(1<<37) != 0 && t.duration.duration != nil
 
 }
 
@@ -4505,12 +6230,13 @@ func (t *Announce) GetDuration() (v time.Duration) {
 // SetDuration sets the value of duration to be of time.Duration type
 func (t *Announce) SetDuration(v time.Duration) {
 	t.duration = &durationIntermediateType{duration: &v}
+	t.markPresent_(37, true)
 
 }
 
 // IsDurationIRI determines whether the call to GetDurationIRI is safe
 func (t *Announce) IsDurationIRI() (ok bool) {
-	return t.duration != nil && t.duration.IRI != nil
+	return t.present_[0]&(1<<37) != 0 && t.duration.IRI != nil
 
 }
 
@@ -4523,12 +6249,13 @@ func (t *Announce) GetDurationIRI() (v *url.URL) {
 // SetDurationIRI sets the value of duration to be of *url.URL type
 func (t *Announce) SetDurationIRI(v *url.URL) {
 	t.duration = &durationIntermediateType{IRI: v}
+	t.markPresent_(37, true)
 
 }
 
 // HasUnknownDuration determines whether the call to GetUnknownDuration is safe
 func (t *Announce) HasUnknownDuration() (ok bool) {
-	return t.duration != nil && t.duration.unknown_ != nil
+	return t.present_[0]&(1<<37) != 0 && t.duration.unknown_ != nil
 
 }
 
@@ -4546,12 +6273,13 @@ func (t *Announce) SetUnknownDuration(i interface{}) {
 	tmp := &durationIntermediateType{}
 	tmp.unknown_ = i
 	t.duration = tmp
+	t.markPresent_(37, true)
 
 }
 
 // IsSource determines whether the call to GetSource is safe
 func (t *Announce) IsSource() (ok bool) {
-	return t.source != nil && t.source.Object != nil
+	return t.present_[0]&(1<<38) != 0 && t.source.Object != nil
 
 }
 
@@ -4564,12 +6292,13 @@ func (t *Announce) GetSource() (v ObjectType) {
 // SetSource sets the value of source to be of ObjectType type
 func (t *Announce) SetSource(v ObjectType) {
 	t.source = &sourceIntermediateType{Object: v}
+	t.markPresent_(38, true)
 
 }
 
 // IsSourceIRI determines whether the call to GetSourceIRI is safe
 func (t *Announce) IsSourceIRI() (ok bool) {
-	return t.source != nil && t.source.IRI != nil
+	return t.present_[0]&(1<<38) != 0 && t.source.IRI != nil
 
 }
 
@@ -4582,12 +6311,13 @@ func (t *Announce) GetSourceIRI() (v *url.URL) {
 // SetSourceIRI sets the value of source to be of *url.URL type
 func (t *Announce) SetSourceIRI(v *url.URL) {
 	t.source = &sourceIntermediateType{IRI: v}
+	t.markPresent_(38, true)
 
 }
 
 // HasUnknownSource determines whether the call to GetUnknownSource is safe
 func (t *Announce) HasUnknownSource() (ok bool) {
-	return t.source != nil && t.source.unknown_ != nil
+	return t.present_[0]&(1<<38) != 0 && t.source.unknown_ != nil
 
 }
 
@@ -4605,12 +6335,13 @@ func (t *Announce) SetUnknownSource(i interface{}) {
 	tmp := &sourceIntermediateType{}
 	tmp.unknown_ = i
 	t.source = tmp
+	t.markPresent_(38, true)
 
 }
 
 // IsInboxOrderedCollection determines whether the call to GetInboxOrderedCollection is safe
 func (t *Announce) IsInboxOrderedCollection() (ok bool) {
-	return t.inbox != nil && t.inbox.OrderedCollection != nil
+	return t.present_[0]&(1<<39) != 0 && t.inbox.OrderedCollection != nil
 
 }
 
@@ -4623,12 +6354,13 @@ func (t *Announce) GetInboxOrderedCollection() (v OrderedCollectionType) {
 // SetInboxOrderedCollection sets the value of inbox to be of OrderedCollectionType type
 func (t *Announce) SetInboxOrderedCollection(v OrderedCollectionType) {
 	t.inbox = &inboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(39, true)
 
 }
 
 // IsInboxAnyURI determines whether the call to GetInboxAnyURI is safe
 func (t *Announce) IsInboxAnyURI() (ok bool) {
-	return t.inbox != nil && t.inbox.anyURI != nil
+	return t.present_[0]&(1<<39) != 0 && t.inbox.anyURI != nil
 
 }
 
@@ -4641,12 +6373,13 @@ func (t *Announce) GetInboxAnyURI() (v *url.URL) {
 // SetInboxAnyURI sets the value of inbox to be of *url.URL type
 func (t *Announce) SetInboxAnyURI(v *url.URL) {
 	t.inbox = &inboxIntermediateType{anyURI: v}
+	t.markPresent_(39, true)
 
 }
 
 // HasUnknownInbox determines whether the call to GetUnknownInbox is safe
 func (t *Announce) HasUnknownInbox() (ok bool) {
-	return t.inbox != nil && t.inbox.unknown_ != nil
+	return t.present_[0]&(1<<39) != 0 && t.inbox.unknown_ != nil
 
 }
 
@@ -4664,12 +6397,13 @@ func (t *Announce) SetUnknownInbox(i interface{}) {
 	tmp := &inboxIntermediateType{}
 	tmp.unknown_ = i
 	t.inbox = tmp
+	t.markPresent_(39, true)
 
 }
 
 // IsOutboxOrderedCollection determines whether the call to GetOutboxOrderedCollection is safe
 func (t *Announce) IsOutboxOrderedCollection() (ok bool) {
-	return t.outbox != nil && t.outbox.OrderedCollection != nil
+	return t.present_[0]&(1<<40) != 0 && t.outbox.OrderedCollection != nil
 
 }
 
@@ -4682,12 +6416,13 @@ func (t *Announce) GetOutboxOrderedCollection() (v OrderedCollectionType) {
 // SetOutboxOrderedCollection sets the value of outbox to be of OrderedCollectionType type
 func (t *Announce) SetOutboxOrderedCollection(v OrderedCollectionType) {
 	t.outbox = &outboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(40, true)
 
 }
 
 // IsOutboxAnyURI determines whether the call to GetOutboxAnyURI is safe
 func (t *Announce) IsOutboxAnyURI() (ok bool) {
-	return t.outbox != nil && t.outbox.anyURI != nil
+	return t.present_[0]&(1<<40) != 0 && t.outbox.anyURI != nil
 
 }
 
@@ -4700,12 +6435,13 @@ func (t *Announce) GetOutboxAnyURI() (v *url.URL) {
 // SetOutboxAnyURI sets the value of outbox to be of *url.URL type
 func (t *Announce) SetOutboxAnyURI(v *url.URL) {
 	t.outbox = &outboxIntermediateType{anyURI: v}
+	t.markPresent_(40, true)
 
 }
 
 // HasUnknownOutbox determines whether the call to GetUnknownOutbox is safe
 func (t *Announce) HasUnknownOutbox() (ok bool) {
-	return t.outbox != nil && t.outbox.unknown_ != nil
+	return t.present_[0]&(1<<40) != 0 && t.outbox.unknown_ != nil
 
 }
 
@@ -4723,12 +6459,13 @@ func (t *Announce) SetUnknownOutbox(i interface{}) {
 	tmp := &outboxIntermediateType{}
 	tmp.unknown_ = i
 	t.outbox = tmp
+	t.markPresent_(40, true)
 
 }
 
 // IsFollowingCollection determines whether the call to GetFollowingCollection is safe
 func (t *Announce) IsFollowingCollection() (ok bool) {
-	return t.following != nil && t.following.Collection != nil
+	return t.present_[0]&(1<<41) != 0 && t.following.Collection != nil
 
 }
 
@@ -4741,12 +6478,13 @@ func (t *Announce) GetFollowingCollection() (v CollectionType) {
 // SetFollowingCollection sets the value of following to be of CollectionType type
 func (t *Announce) SetFollowingCollection(v CollectionType) {
 	t.following = &followingIntermediateType{Collection: v}
+	t.markPresent_(41, true)
 
 }
 
 // IsFollowingOrderedCollection determines whether the call to GetFollowingOrderedCollection is safe
 func (t *Announce) IsFollowingOrderedCollection() (ok bool) {
-	return t.following != nil && t.following.OrderedCollection != nil
+	return t.present_[0]&(1<<41) != 0 && t.following.OrderedCollection != nil
 
 }
 
@@ -4759,12 +6497,13 @@ func (t *Announce) GetFollowingOrderedCollection() (v OrderedCollectionType) {
 // SetFollowingOrderedCollection sets the value of following to be of OrderedCollectionType type
 func (t *Announce) SetFollowingOrderedCollection(v OrderedCollectionType) {
 	t.following = &followingIntermediateType{OrderedCollection: v}
+	t.markPresent_(41, true)
 
 }
 
 // IsFollowingAnyURI determines whether the call to GetFollowingAnyURI is safe
 func (t *Announce) IsFollowingAnyURI() (ok bool) {
-	return t.following != nil && t.following.anyURI != nil
+	return t.present_[0]&(1<<41) != 0 && t.following.anyURI != nil
 
 }
 
@@ -4777,12 +6516,13 @@ func (t *Announce) GetFollowingAnyURI() (v *url.URL) {
 // SetFollowingAnyURI sets the value of following to be of *url.URL type
 func (t *Announce) SetFollowingAnyURI(v *url.URL) {
 	t.following = &followingIntermediateType{anyURI: v}
+	t.markPresent_(41, true)
 
 }
 
 // HasUnknownFollowing determines whether the call to GetUnknownFollowing is safe
 func (t *Announce) HasUnknownFollowing() (ok bool) {
-	return t.following != nil && t.following.unknown_ != nil
+	return t.present_[0]&(1<<41) != 0 && t.following.unknown_ != nil
 
 }
 
@@ -4800,12 +6540,13 @@ func (t *Announce) SetUnknownFollowing(i interface{}) {
 	tmp := &followingIntermediateType{}
 	tmp.unknown_ = i
 	t.following = tmp
+	t.markPresent_(41, true)
 
 }
 
 // IsFollowersCollection determines whether the call to GetFollowersCollection is safe
 func (t *Announce) IsFollowersCollection() (ok bool) {
-	return t.followers != nil && t.followers.Collection != nil
+	return t.present_[0]&(1<<42) != 0 && t.followers.Collection != nil
 
 }
 
@@ -4818,12 +6559,13 @@ func (t *Announce) GetFollowersCollection() (v CollectionType) {
 // SetFollowersCollection sets the value of followers to be of CollectionType type
 func (t *Announce) SetFollowersCollection(v CollectionType) {
 	t.followers = &followersIntermediateType{Collection: v}
+	t.markPresent_(42, true)
 
 }
 
 // IsFollowersOrderedCollection determines whether the call to GetFollowersOrderedCollection is safe
 func (t *Announce) IsFollowersOrderedCollection() (ok bool) {
-	return t.followers != nil && t.followers.OrderedCollection != nil
+	return t.present_[0]&(1<<42) != 0 && t.followers.OrderedCollection != nil
 
 }
 
@@ -4836,12 +6578,13 @@ func (t *Announce) GetFollowersOrderedCollection() (v OrderedCollectionType) {
 // SetFollowersOrderedCollection sets the value of followers to be of OrderedCollectionType type
 func (t *Announce) SetFollowersOrderedCollection(v OrderedCollectionType) {
 	t.followers = &followersIntermediateType{OrderedCollection: v}
+	t.markPresent_(42, true)
 
 }
 
 // IsFollowersAnyURI determines whether the call to GetFollowersAnyURI is safe
 func (t *Announce) IsFollowersAnyURI() (ok bool) {
-	return t.followers != nil && t.followers.anyURI != nil
+	return t.present_[0]&(1<<42) != 0 && t.followers.anyURI != nil
 
 }
 
@@ -4854,12 +6597,13 @@ func (t *Announce) GetFollowersAnyURI() (v *url.URL) {
 // SetFollowersAnyURI sets the value of followers to be of *url.URL type
 func (t *Announce) SetFollowersAnyURI(v *url.URL) {
 	t.followers = &followersIntermediateType{anyURI: v}
+	t.markPresent_(42, true)
 
 }
 
 // HasUnknownFollowers determines whether the call to GetUnknownFollowers is safe
 func (t *Announce) HasUnknownFollowers() (ok bool) {
-	return t.followers != nil && t.followers.unknown_ != nil
+	return t.present_[0]&(1<<42) != 0 && t.followers.unknown_ != nil
 
 }
 
@@ -4877,12 +6621,13 @@ func (t *Announce) SetUnknownFollowers(i interface{}) {
 	tmp := &followersIntermediateType{}
 	tmp.unknown_ = i
 	t.followers = tmp
+	t.markPresent_(42, true)
 
 }
 
 // IsLikedCollection determines whether the call to GetLikedCollection is safe
 func (t *Announce) IsLikedCollection() (ok bool) {
-	return t.liked != nil && t.liked.Collection != nil
+	return t.present_[0]&(1<<43) != 0 && t.liked.Collection != nil
 
 }
 
@@ -4895,12 +6640,13 @@ func (t *Announce) GetLikedCollection() (v CollectionType) {
 // SetLikedCollection sets the value of liked to be of CollectionType type
 func (t *Announce) SetLikedCollection(v CollectionType) {
 	t.liked = &likedIntermediateType{Collection: v}
+	t.markPresent_(43, true)
 
 }
 
 // IsLikedOrderedCollection determines whether the call to GetLikedOrderedCollection is safe
 func (t *Announce) IsLikedOrderedCollection() (ok bool) {
-	return t.liked != nil && t.liked.OrderedCollection != nil
+	return t.present_[0]&(1<<43) != 0 && t.liked.OrderedCollection != nil
 
 }
 
@@ -4913,12 +6659,13 @@ func (t *Announce) GetLikedOrderedCollection() (v OrderedCollectionType) {
 // SetLikedOrderedCollection sets the value of liked to be of OrderedCollectionType type
 func (t *Announce) SetLikedOrderedCollection(v OrderedCollectionType) {
 	t.liked = &likedIntermediateType{OrderedCollection: v}
+	t.markPresent_(43, true)
 
 }
 
 // IsLikedAnyURI determines whether the call to GetLikedAnyURI is safe
 func (t *Announce) IsLikedAnyURI() (ok bool) {
-	return t.liked != nil && t.liked.anyURI != nil
+	return t.present_[0]&(1<<43) != 0 && t.liked.anyURI != nil
 
 }
 
@@ -4931,12 +6678,13 @@ func (t *Announce) GetLikedAnyURI() (v *url.URL) {
 // SetLikedAnyURI sets the value of liked to be of *url.URL type
 func (t *Announce) SetLikedAnyURI(v *url.URL) {
 	t.liked = &likedIntermediateType{anyURI: v}
+	t.markPresent_(43, true)
 
 }
 
 // HasUnknownLiked determines whether the call to GetUnknownLiked is safe
 func (t *Announce) HasUnknownLiked() (ok bool) {
-	return t.liked != nil && t.liked.unknown_ != nil
+	return t.present_[0]&(1<<43) != 0 && t.liked.unknown_ != nil
 
 }
 
@@ -4954,12 +6702,13 @@ func (t *Announce) SetUnknownLiked(i interface{}) {
 	tmp := &likedIntermediateType{}
 	tmp.unknown_ = i
 	t.liked = tmp
+	t.markPresent_(43, true)
 
 }
 
 // IsLikesCollection determines whether the call to GetLikesCollection is safe
 func (t *Announce) IsLikesCollection() (ok bool) {
-	return t.likes != nil && t.likes.Collection != nil
+	return t.present_[0]&(1<<44) != 0 && t.likes.Collection != nil
 
 }
 
@@ -4972,12 +6721,13 @@ func (t *Announce) GetLikesCollection() (v CollectionType) {
 // SetLikesCollection sets the value of likes to be of CollectionType type
 func (t *Announce) SetLikesCollection(v CollectionType) {
 	t.likes = &likesIntermediateType{Collection: v}
+	t.markPresent_(44, true)
 
 }
 
 // IsLikesOrderedCollection determines whether the call to GetLikesOrderedCollection is safe
 func (t *Announce) IsLikesOrderedCollection() (ok bool) {
-	return t.likes != nil && t.likes.OrderedCollection != nil
+	return t.present_[0]&(1<<44) != 0 && t.likes.OrderedCollection != nil
 
 }
 
@@ -4990,12 +6740,13 @@ func (t *Announce) GetLikesOrderedCollection() (v OrderedCollectionType) {
 // SetLikesOrderedCollection sets the value of likes to be of OrderedCollectionType type
 func (t *Announce) SetLikesOrderedCollection(v OrderedCollectionType) {
 	t.likes = &likesIntermediateType{OrderedCollection: v}
+	t.markPresent_(44, true)
 
 }
 
 // IsLikesAnyURI determines whether the call to GetLikesAnyURI is safe
 func (t *Announce) IsLikesAnyURI() (ok bool) {
-	return t.likes != nil && t.likes.anyURI != nil
+	return t.present_[0]&(1<<44) != 0 && t.likes.anyURI != nil
 
 }
 
@@ -5008,12 +6759,13 @@ func (t *Announce) GetLikesAnyURI() (v *url.URL) {
 // SetLikesAnyURI sets the value of likes to be of *url.URL type
 func (t *Announce) SetLikesAnyURI(v *url.URL) {
 	t.likes = &likesIntermediateType{anyURI: v}
+	t.markPresent_(44, true)
 
 }
 
 // HasUnknownLikes determines whether the call to GetUnknownLikes is safe
 func (t *Announce) HasUnknownLikes() (ok bool) {
-	return t.likes != nil && t.likes.unknown_ != nil
+	return t.present_[0]&(1<<44) != 0 && t.likes.unknown_ != nil
 
 }
 
@@ -5031,6 +6783,7 @@ func (t *Announce) SetUnknownLikes(i interface{}) {
 	tmp := &likesIntermediateType{}
 	tmp.unknown_ = i
 	t.likes = tmp
+	t.markPresent_(44, true)
 
 }
 
@@ -5049,12 +6802,14 @@ func (t *Announce) GetStreams(index int) (v *url.URL) {
 // AppendStreams adds a value to the back of streams
 func (t *Announce) AppendStreams(v *url.URL) {
 	t.streams = append(t.streams, v)
+	t.markPresent_(45, len(t.streams) > 0)
 
 }
 
 // PrependStreams adds a value to the front of streams
 func (t *Announce) PrependStreams(v *url.URL) {
 	t.streams = append([]*url.URL{v}, t.streams...)
+	t.markPresent_(45, len(t.streams) > 0)
 
 }
 
@@ -5063,6 +6818,7 @@ func (t *Announce) RemoveStreams(index int) {
 	copy(t.streams[index:], t.streams[index+1:])
 	t.streams[len(t.streams)-1] = nil
 	t.streams = t.streams[:len(t.streams)-1]
+	t.markPresent_(45, len(t.streams) > 0)
 
 }
 
@@ -5087,9 +6843,21 @@ func (t *Announce) SetUnknownStreams(i interface{}) {
 
 }
 
+// StreamsValues returns an iterator over the values that GetStreams returns, which can be used as an iter.Seq
+func (t *Announce) StreamsValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.StreamsLen(); i++ {
+			if !yield(t.GetStreams(i)) {
+				return
+			}
+		}
+	}
+
+}
+
 // IsPreferredUsername determines whether the call to GetPreferredUsername is safe
 func (t *Announce) IsPreferredUsername() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.stringName != nil
+	return t.present_[0]&(1<<46) != 0 && t.preferredUsername.stringName != nil
 
 }
 
@@ -5102,12 +6870,13 @@ func (t *Announce) GetPreferredUsername() (v string) {
 // SetPreferredUsername sets the value of preferredUsername to be of string type
 func (t *Announce) SetPreferredUsername(v string) {
 	t.preferredUsername = &preferredUsernameIntermediateType{stringName: &v}
+	t.markPresent_(46, true)
 
 }
 
 // IsPreferredUsernameIRI determines whether the call to GetPreferredUsernameIRI is safe
 func (t *Announce) IsPreferredUsernameIRI() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.IRI != nil
+	return t.present_[0]&(1<<46) != 0 && t.preferredUsername.IRI != nil
 
 }
 
@@ -5120,12 +6889,13 @@ func (t *Announce) GetPreferredUsernameIRI() (v *url.URL) {
 // SetPreferredUsernameIRI sets the value of preferredUsername to be of *url.URL type
 func (t *Announce) SetPreferredUsernameIRI(v *url.URL) {
 	t.preferredUsername = &preferredUsernameIntermediateType{IRI: v}
+	t.markPresent_(46, true)
 
 }
 
 // HasUnknownPreferredUsername determines whether the call to GetUnknownPreferredUsername is safe
 func (t *Announce) HasUnknownPreferredUsername() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.unknown_ != nil
+	return t.present_[0]&(1<<46) != 0 && t.preferredUsername.unknown_ != nil
 
 }
 
@@ -5143,6 +6913,7 @@ func (t *Announce) SetUnknownPreferredUsername(i interface{}) {
 	tmp := &preferredUsernameIntermediateType{}
 	tmp.unknown_ = i
 	t.preferredUsername = tmp
+	t.markPresent_(46, true)
 
 }
 
@@ -5176,14 +6947,44 @@ func (t *Announce) GetPreferredUsernameMap(l string) (v string) {
 func (t *Announce) SetPreferredUsernameMap(l string, v string) {
 	if t.preferredUsernameMap == nil {
 		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(47, true)
 	}
 	t.preferredUsernameMap[l] = v
 
 }
 
+// GetPreferredUsernameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Announce) GetPreferredUsernameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.preferredUsernameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.preferredUsernameMap[k], true
+
+}
+
+// SetPreferredUsernameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Announce) SetPreferredUsernameLanguage(tag string, v string) {
+	if t.preferredUsernameMap == nil {
+		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(47, true)
+	} else if k, ok := languageKey(t.preferredUsernameMap, tag); ok {
+		delete(t.preferredUsernameMap, k)
+	}
+	t.preferredUsernameMap[tag] = v
+
+}
+
+// PreferredPreferredUsernameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Announce) PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.preferredUsernameMap, tags)
+	return t.preferredUsernameMap[tag], tag
+
+}
+
 // IsEndpoints determines whether the call to GetEndpoints is safe
 func (t *Announce) IsEndpoints() (ok bool) {
-	return t.endpoints != nil && t.endpoints.Object != nil
+	return t.present_[0]&(1<<48) != 0 && t.endpoints.Object != nil
 
 }
 
@@ -5196,12 +6997,13 @@ func (t *Announce) GetEndpoints() (v ObjectType) {
 // SetEndpoints sets the value of endpoints to be of ObjectType type
 func (t *Announce) SetEndpoints(v ObjectType) {
 	t.endpoints = &endpointsIntermediateType{Object: v}
+	t.markPresent_(48, true)
 
 }
 
 // IsEndpointsIRI determines whether the call to GetEndpointsIRI is safe
 func (t *Announce) IsEndpointsIRI() (ok bool) {
-	return t.endpoints != nil && t.endpoints.IRI != nil
+	return t.present_[0]&(1<<48) != 0 && t.endpoints.IRI != nil
 
 }
 
@@ -5214,12 +7016,13 @@ func (t *Announce) GetEndpointsIRI() (v *url.URL) {
 // SetEndpointsIRI sets the value of endpoints to be of *url.URL type
 func (t *Announce) SetEndpointsIRI(v *url.URL) {
 	t.endpoints = &endpointsIntermediateType{IRI: v}
+	t.markPresent_(48, true)
 
 }
 
 // HasUnknownEndpoints determines whether the call to GetUnknownEndpoints is safe
 func (t *Announce) HasUnknownEndpoints() (ok bool) {
-	return t.endpoints != nil && t.endpoints.unknown_ != nil
+	return t.present_[0]&(1<<48) != 0 && t.endpoints.unknown_ != nil
 
 }
 
@@ -5237,12 +7040,13 @@ func (t *Announce) SetUnknownEndpoints(i interface{}) {
 	tmp := &endpointsIntermediateType{}
 	tmp.unknown_ = i
 	t.endpoints = tmp
+	t.markPresent_(48, true)
 
 }
 
 // HasProxyUrl determines whether the call to GetProxyUrl is safe
 func (t *Announce) HasProxyUrl() (ok bool) {
-	return t.proxyUrl != nil
+	return t.present_[0]&(1<<49) != 0
 
 }
 
@@ -5255,6 +7059,7 @@ func (t *Announce) GetProxyUrl() (v *url.URL) {
 // SetProxyUrl sets the value of proxyUrl
 func (t *Announce) SetProxyUrl(v *url.URL) {
 	t.proxyUrl = v
+	t.markPresent_(49, true)
 
 }
 
@@ -5281,7 +7086,7 @@ func (t *Announce) SetUnknownProxyUrl(i interface{}) {
 
 // HasOauthAuthorizationEndpoint determines whether the call to GetOauthAuthorizationEndpoint is safe
 func (t *Announce) HasOauthAuthorizationEndpoint() (ok bool) {
-	return t.oauthAuthorizationEndpoint != nil
+	return t.present_[0]&(1<<50) != 0
 
 }
 
@@ -5294,6 +7099,7 @@ func (t *Announce) GetOauthAuthorizationEndpoint() (v *url.URL) {
 // SetOauthAuthorizationEndpoint sets the value of oauthAuthorizationEndpoint
 func (t *Announce) SetOauthAuthorizationEndpoint(v *url.URL) {
 	t.oauthAuthorizationEndpoint = v
+	t.markPresent_(50, true)
 
 }
 
@@ -5320,7 +7126,7 @@ func (t *Announce) SetUnknownOauthAuthorizationEndpoint(i interface{}) {
 
 // HasOauthTokenEndpoint determines whether the call to GetOauthTokenEndpoint is safe
 func (t *Announce) HasOauthTokenEndpoint() (ok bool) {
-	return t.oauthTokenEndpoint != nil
+	return t.present_[0]&(1<<51) != 0
 
 }
 
@@ -5333,6 +7139,7 @@ func (t *Announce) GetOauthTokenEndpoint() (v *url.URL) {
 // SetOauthTokenEndpoint sets the value of oauthTokenEndpoint
 func (t *Announce) SetOauthTokenEndpoint(v *url.URL) {
 	t.oauthTokenEndpoint = v
+	t.markPresent_(51, true)
 
 }
 
@@ -5359,7 +7166,7 @@ func (t *Announce) SetUnknownOauthTokenEndpoint(i interface{}) {
 
 // HasProvideClientKey determines whether the call to GetProvideClientKey is safe
 func (t *Announce) HasProvideClientKey() (ok bool) {
-	return t.provideClientKey != nil
+	return t.present_[0]&(1<<52) != 0
 
 }
 
@@ -5372,6 +7179,7 @@ func (t *Announce) GetProvideClientKey() (v *url.URL) {
 // SetProvideClientKey sets the value of provideClientKey
 func (t *Announce) SetProvideClientKey(v *url.URL) {
 	t.provideClientKey = v
+	t.markPresent_(52, true)
 
 }
 
@@ -5398,7 +7206,7 @@ func (t *Announce) SetUnknownProvideClientKey(i interface{}) {
 
 // HasSignClientKey determines whether the call to GetSignClientKey is safe
 func (t *Announce) HasSignClientKey() (ok bool) {
-	return t.signClientKey != nil
+	return t.present_[0]&(1<<53) != 0
 
 }
 
@@ -5411,6 +7219,7 @@ func (t *Announce) GetSignClientKey() (v *url.URL) {
 // SetSignClientKey sets the value of signClientKey
 func (t *Announce) SetSignClientKey(v *url.URL) {
 	t.signClientKey = v
+	t.markPresent_(53, true)
 
 }
 
@@ -5437,7 +7246,7 @@ func (t *Announce) SetUnknownSignClientKey(i interface{}) {
 
 // HasSharedInbox determines whether the call to GetSharedInbox is safe
 func (t *Announce) HasSharedInbox() (ok bool) {
-	return t.sharedInbox != nil
+	return t.present_[0]&(1<<54) != 0
 
 }
 
@@ -5450,6 +7259,7 @@ func (t *Announce) GetSharedInbox() (v *url.URL) {
 // SetSharedInbox sets the value of sharedInbox
 func (t *Announce) SetSharedInbox(v *url.URL) {
 	t.sharedInbox = v
+	t.markPresent_(54, true)
 
 }
 
@@ -5476,7 +7286,7 @@ func (t *Announce) SetUnknownSharedInbox(i interface{}) {
 
 // IsSharesCollection determines whether the call to GetSharesCollection is safe
 func (t *Announce) IsSharesCollection() (ok bool) {
-	return t.shares != nil && t.shares.Collection != nil
+	return t.present_[0]&(1<<55) != 0 && t.shares.Collection != nil
 
 }
 
@@ -5489,12 +7299,13 @@ func (t *Announce) GetSharesCollection() (v CollectionType) {
 // SetSharesCollection sets the value of shares to be of CollectionType type
 func (t *Announce) SetSharesCollection(v CollectionType) {
 	t.shares = &sharesIntermediateType{Collection: v}
+	t.markPresent_(55, true)
 
 }
 
 // IsSharesOrderedCollection determines whether the call to GetSharesOrderedCollection is safe
 func (t *Announce) IsSharesOrderedCollection() (ok bool) {
-	return t.shares != nil && t.shares.OrderedCollection != nil
+	return t.present_[0]&(1<<55) != 0 && t.shares.OrderedCollection != nil
 
 }
 
@@ -5507,12 +7318,13 @@ func (t *Announce) GetSharesOrderedCollection() (v OrderedCollectionType) {
 // SetSharesOrderedCollection sets the value of shares to be of OrderedCollectionType type
 func (t *Announce) SetSharesOrderedCollection(v OrderedCollectionType) {
 	t.shares = &sharesIntermediateType{OrderedCollection: v}
+	t.markPresent_(55, true)
 
 }
 
 // IsSharesAnyURI determines whether the call to GetSharesAnyURI is safe
 func (t *Announce) IsSharesAnyURI() (ok bool) {
-	return t.shares != nil && t.shares.anyURI != nil
+	return t.present_[0]&(1<<55) != 0 && t.shares.anyURI != nil
 
 }
 
@@ -5525,12 +7337,13 @@ func (t *Announce) GetSharesAnyURI() (v *url.URL) {
 // SetSharesAnyURI sets the value of shares to be of *url.URL type
 func (t *Announce) SetSharesAnyURI(v *url.URL) {
 	t.shares = &sharesIntermediateType{anyURI: v}
+	t.markPresent_(55, true)
 
 }
 
 // HasUnknownShares determines whether the call to GetUnknownShares is safe
 func (t *Announce) HasUnknownShares() (ok bool) {
-	return t.shares != nil && t.shares.unknown_ != nil
+	return t.present_[0]&(1<<55) != 0 && t.shares.unknown_ != nil
 
 }
 
@@ -5548,6 +7361,7 @@ func (t *Announce) SetUnknownShares(i interface{}) {
 	tmp := &sharesIntermediateType{}
 	tmp.unknown_ = i
 	t.shares = tmp
+	t.markPresent_(55, true)
 
 }
 
@@ -5584,6 +7398,32 @@ func (t *Announce) GetUnknown(k string) (i interface{}) {
 
 }
 
+// GetUnknownProperties returns a copy of every unknown property of this object by key, such as the extension properties it was deserialized with, or nil if there are none. Serialize writes them back, so they are never discarded.
+func (t *Announce) GetUnknownProperties() (m map[string]interface{}) {
+	if len(t.unknown_) == 0 {
+		return nil
+	}
+	m = make(map[string]interface{}, len(t.unknown_))
+	for k, v := range t.unknown_ {
+		m[k] = v
+	}
+	return
+
+}
+
+// SetUnknownProperty sets the unknown property of this object with the specified key, or removes it if i is nil. Unlike AddUnknown, it does not return the object, so that every type shares its signature and extension vocabularies can set their properties of any type.
+func (t *Announce) SetUnknownProperty(k string, i interface{}) {
+	if i == nil {
+		delete(t.unknown_, k)
+		return
+	}
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+
+}
+
 // Serialize turns this object into a map[string]interface{}. Note that the "type" property will automatically be populated with "Announce" if not manually set by the caller
 func (t *Announce) Serialize() (m map[string]interface{}, err error) {
 	m = make(map[string]interface{})
@@ -5601,1709 +7441,1408 @@ func (t *Announce) Serialize() (m map[string]interface{}, err error) {
 	}
 	if !typeAlreadySet {
 		t.typeName = append(t.typeName, "Announce")
+		t.markPresent_(29, len(t.typeName) > 0)
 	}
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceActorIntermediateType(t.actor); err == nil && v != nil {
-		if len(v) == 1 {
-			m["actor"] = v[0]
-		} else {
-			m["actor"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceObjectIntermediateType(t.object); err == nil && v != nil {
-		if len(v) == 1 {
-			m["object"] = v[0]
-		} else {
-			m["object"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceTargetIntermediateType(t.target); err == nil && v != nil {
-		if len(v) == 1 {
-			m["target"] = v[0]
-		} else {
-			m["target"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceResultIntermediateType(t.result); err == nil && v != nil {
-		if len(v) == 1 {
-			m["result"] = v[0]
-		} else {
-			m["result"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceOriginIntermediateType(t.origin); err == nil && v != nil {
-		if len(v) == 1 {
-			m["origin"] = v[0]
-		} else {
-			m["origin"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceInstrumentIntermediateType(t.instrument); err == nil && v != nil {
-		if len(v) == 1 {
-			m["instrument"] = v[0]
-		} else {
-			m["instrument"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.altitude != nil {
-		if v, err := serializeAltitudeIntermediateType(t.altitude); err == nil {
-			m["altitude"] = v
-		} else {
+	if t.present_[0]&(1<<0) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceActorIntermediateType(t.actor); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "actor", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAttachmentIntermediateType(t.attachment); err == nil && v != nil {
-		if len(v) == 1 {
-			m["attachment"] = v[0]
-		} else {
-			m["attachment"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err == nil && v != nil {
-		if len(v) == 1 {
-			m["attributedTo"] = v[0]
-		} else {
-			m["attributedTo"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAudienceIntermediateType(t.audience); err == nil && v != nil {
-		if len(v) == 1 {
-			m["audience"] = v[0]
-		} else {
-			m["audience"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceContentIntermediateType(t.content); err == nil && v != nil {
-		if len(v) == 1 {
-			m["content"] = v[0]
-		} else {
-			m["content"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.contentMap != nil && len(t.contentMap) >= 0 {
-		m["contentMap"] = t.contentMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceContextIntermediateType(t.context); err == nil && v != nil {
-		if len(v) == 1 {
-			m["context"] = v[0]
-		} else {
-			m["context"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceNameIntermediateType(t.name); err == nil && v != nil {
-		if len(v) == 1 {
-			m["name"] = v[0]
-		} else {
-			m["name"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.nameMap != nil && len(t.nameMap) >= 0 {
-		m["nameMap"] = t.nameMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.endTime != nil {
-		if v, err := serializeEndTimeIntermediateType(t.endTime); err == nil {
-			m["endTime"] = v
-		} else {
+	if t.present_[0]&(1<<1) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceObjectIntermediateType(t.object); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "object", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceGeneratorIntermediateType(t.generator); err == nil && v != nil {
-		if len(v) == 1 {
-			m["generator"] = v[0]
-		} else {
-			m["generator"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceIconIntermediateType(t.icon); err == nil && v != nil {
-		if len(v) == 1 {
-			m["icon"] = v[0]
-		} else {
-			m["icon"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	if t.id != nil {
-		idSerializeFunc := func() (interface{}, error) {
-			v := t.id
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		idResult, err := idSerializeFunc()
-		if err == nil {
-			m["id"] = idResult
-		} else {
+	if t.present_[0]&(1<<2) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceTargetIntermediateType(t.target); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "target", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceImageIntermediateType(t.image); err == nil && v != nil {
-		if len(v) == 1 {
-			m["image"] = v[0]
-		} else {
-			m["image"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceInReplyToIntermediateType(t.inReplyTo); err == nil && v != nil {
-		if len(v) == 1 {
-			m["inReplyTo"] = v[0]
-		} else {
-			m["inReplyTo"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceLocationIntermediateType(t.location); err == nil && v != nil {
-		if len(v) == 1 {
-			m["location"] = v[0]
-		} else {
-			m["location"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSlicePreviewIntermediateType(t.preview); err == nil && v != nil {
-		if len(v) == 1 {
-			m["preview"] = v[0]
-		} else {
-			m["preview"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.published != nil {
-		if v, err := serializePublishedIntermediateType(t.published); err == nil {
-			m["published"] = v
-		} else {
+	if t.present_[0]&(1<<3) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceResultIntermediateType(t.result); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "result", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.replies != nil {
-		if v, err := serializeRepliesIntermediateType(t.replies); err == nil {
-			m["replies"] = v
-		} else {
+	if t.present_[0]&(1<<4) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceOriginIntermediateType(t.origin); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "origin", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.startTime != nil {
-		if v, err := serializeStartTimeIntermediateType(t.startTime); err == nil {
-			m["startTime"] = v
-		} else {
+	if t.present_[0]&(1<<5) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceInstrumentIntermediateType(t.instrument); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "instrument", v)
 		}
-	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceSummaryIntermediateType(t.summary); err == nil && v != nil {
-		if len(v) == 1 {
-			m["summary"] = v[0]
-		} else {
-			m["summary"] = v
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<6) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.altitude != nil {
+			if v, err := serializeAltitudeIntermediateType(t.altitude); err == nil {
+				m["altitude"] = v
+			} else {
+				return m, err
+			}
 		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.summaryMap != nil && len(t.summaryMap) >= 0 {
-		m["summaryMap"] = t.summaryMap
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceTagIntermediateType(t.tag); err == nil && v != nil {
-		if len(v) == 1 {
-			m["tag"] = v[0]
-		} else {
-			m["tag"] = v
+	if t.present_[0]&(1<<7) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttachmentIntermediateType(t.attachment); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attachment", v)
 		}
-	} else if err != nil {
-		return m, err
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalAnyDefinition
-	if t.typeName != nil {
-		if len(t.typeName) == 1 {
-			m["type"] = t.typeName[0]
-		} else {
-			m["type"] = t.typeName
+	if t.present_[0]&(1<<8) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attributedTo", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalAnyDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.updated != nil {
-		if v, err := serializeUpdatedIntermediateType(t.updated); err == nil {
-			m["updated"] = v
-		} else {
+	if t.present_[0]&(1<<9) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAudienceIntermediateType(t.audience); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "audience", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceUrlIntermediateType(t.url); err == nil && v != nil {
-		if len(v) == 1 {
-			m["url"] = v[0]
-		} else {
-			m["url"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceToIntermediateType(t.to); err == nil && v != nil {
-		if len(v) == 1 {
-			m["to"] = v[0]
-		} else {
-			m["to"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceBtoIntermediateType(t.bto); err == nil && v != nil {
-		if len(v) == 1 {
-			m["bto"] = v[0]
-		} else {
-			m["bto"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceCcIntermediateType(t.cc); err == nil && v != nil {
-		if len(v) == 1 {
-			m["cc"] = v[0]
-		} else {
-			m["cc"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceBccIntermediateType(t.bcc); err == nil && v != nil {
-		if len(v) == 1 {
-			m["bcc"] = v[0]
-		} else {
-			m["bcc"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.mediaType != nil {
-		if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
-			m["mediaType"] = v
-		} else {
+	if t.present_[0]&(1<<10) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContentIntermediateType(t.content); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "content", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.duration != nil {
-		if v, err := serializeDurationIntermediateType(t.duration); err == nil {
-			m["duration"] = v
-		} else {
-			return m, err
+
+	if t.present_[0]&(1<<11) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.contentMap != nil && len(t.contentMap) >= 0 {
+			m["contentMap"] = t.contentMap
 		}
+		// End generation by generateNaturalLanguageMap
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.source != nil {
-		if v, err := serializeSourceIntermediateType(t.source); err == nil {
-			m["source"] = v
-		} else {
+	if t.present_[0]&(1<<12) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContextIntermediateType(t.context); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "context", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.inbox != nil {
-		if v, err := serializeInboxIntermediateType(t.inbox); err == nil {
-			m["inbox"] = v
-		} else {
+	if t.present_[0]&(1<<13) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceNameIntermediateType(t.name); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "name", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.outbox != nil {
-		if v, err := serializeOutboxIntermediateType(t.outbox); err == nil {
-			m["outbox"] = v
-		} else {
-			return m, err
+
+	if t.present_[0]&(1<<14) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.nameMap != nil && len(t.nameMap) >= 0 {
+			m["nameMap"] = t.nameMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<15) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endTime != nil {
+			if v, err := serializeEndTimeIntermediateType(t.endTime); err == nil {
+				m["endTime"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.following != nil {
-		if v, err := serializeFollowingIntermediateType(t.following); err == nil {
-			m["following"] = v
-		} else {
+	if t.present_[0]&(1<<16) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceGeneratorIntermediateType(t.generator); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "generator", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.followers != nil {
-		if v, err := serializeFollowersIntermediateType(t.followers); err == nil {
-			m["followers"] = v
-		} else {
+	if t.present_[0]&(1<<17) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceIconIntermediateType(t.icon); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "icon", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<18) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.id != nil {
+			idSerializeFunc := func() (interface{}, error) {
+				v := t.id
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			idResult, err := idSerializeFunc()
+			if err == nil {
+				m["id"] = idResult
+			} else {
+				return m, err
+			}
 		}
+		// End generation by RangeReference.Serialize for Value
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.liked != nil {
-		if v, err := serializeLikedIntermediateType(t.liked); err == nil {
-			m["liked"] = v
-		} else {
+	if t.present_[0]&(1<<19) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceImageIntermediateType(t.image); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "image", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.likes != nil {
-		if v, err := serializeLikesIntermediateType(t.likes); err == nil {
-			m["likes"] = v
-		} else {
+	if t.present_[0]&(1<<20) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceInReplyToIntermediateType(t.inReplyTo); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "inReplyTo", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	var streamsTemp []interface{}
-	for _, v := range t.streams {
-		tmp := anyURISerialize(v)
-		streamsTemp = append(streamsTemp, tmp)
-	}
-	if streamsTemp != nil {
-		if len(streamsTemp) == 1 {
-			m["streams"] = streamsTemp[0]
-		} else {
-			m["streams"] = streamsTemp
+	if t.present_[0]&(1<<21) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceLocationIntermediateType(t.location); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "location", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.preferredUsername != nil {
-		if v, err := serializePreferredUsernameIntermediateType(t.preferredUsername); err == nil {
-			m["preferredUsername"] = v
-		} else {
+	if t.present_[0]&(1<<22) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSlicePreviewIntermediateType(t.preview); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "preview", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<23) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.published != nil {
+			if v, err := serializePublishedIntermediateType(t.published); err == nil {
+				m["published"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<24) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.replies != nil {
+			if v, err := serializeRepliesIntermediateType(t.replies); err == nil {
+				m["replies"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<25) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.startTime != nil {
+			if v, err := serializeStartTimeIntermediateType(t.startTime); err == nil {
+				m["startTime"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.preferredUsernameMap != nil && len(t.preferredUsernameMap) >= 0 {
-		m["preferredUsernameMap"] = t.preferredUsernameMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.endpoints != nil {
-		if v, err := serializeEndpointsIntermediateType(t.endpoints); err == nil {
-			m["endpoints"] = v
-		} else {
+	if t.present_[0]&(1<<26) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceSummaryIntermediateType(t.summary); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "summary", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	if t.proxyUrl != nil {
-		proxyUrlSerializeFunc := func() (interface{}, error) {
-			v := t.proxyUrl
-			tmp := anyURISerialize(v)
-			return tmp, nil
+
+	if t.present_[0]&(1<<27) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.summaryMap != nil && len(t.summaryMap) >= 0 {
+			m["summaryMap"] = t.summaryMap
 		}
-		proxyUrlResult, err := proxyUrlSerializeFunc()
-		if err == nil {
-			m["proxyUrl"] = proxyUrlResult
-		} else {
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<28) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceTagIntermediateType(t.tag); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "tag", v)
 		}
-	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.oauthAuthorizationEndpoint != nil {
-		oauthAuthorizationEndpointSerializeFunc := func() (interface{}, error) {
-			v := t.oauthAuthorizationEndpoint
-			tmp := anyURISerialize(v)
-			return tmp, nil
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<29) != 0 {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if t.typeName != nil {
+			if len(t.typeName) == 1 {
+				m["type"] = t.typeName[0]
+			} else {
+				m["type"] = t.typeName
+			}
 		}
-		oauthAuthorizationEndpointResult, err := oauthAuthorizationEndpointSerializeFunc()
-		if err == nil {
-			m["oauthAuthorizationEndpoint"] = oauthAuthorizationEndpointResult
-		} else {
-			return m, err
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if t.present_[0]&(1<<30) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.updated != nil {
+			if v, err := serializeUpdatedIntermediateType(t.updated); err == nil {
+				m["updated"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.oauthTokenEndpoint != nil {
-		oauthTokenEndpointSerializeFunc := func() (interface{}, error) {
-			v := t.oauthTokenEndpoint
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		oauthTokenEndpointResult, err := oauthTokenEndpointSerializeFunc()
-		if err == nil {
-			m["oauthTokenEndpoint"] = oauthTokenEndpointResult
-		} else {
+	if t.present_[0]&(1<<31) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceUrlIntermediateType(t.url); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "url", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.provideClientKey != nil {
-		provideClientKeySerializeFunc := func() (interface{}, error) {
-			v := t.provideClientKey
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		provideClientKeyResult, err := provideClientKeySerializeFunc()
-		if err == nil {
-			m["provideClientKey"] = provideClientKeyResult
-		} else {
+	if t.present_[0]&(1<<32) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceToIntermediateType(t.to); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "to", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.signClientKey != nil {
-		signClientKeySerializeFunc := func() (interface{}, error) {
-			v := t.signClientKey
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		signClientKeyResult, err := signClientKeySerializeFunc()
-		if err == nil {
-			m["signClientKey"] = signClientKeyResult
-		} else {
+	if t.present_[0]&(1<<33) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBtoIntermediateType(t.bto); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bto", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.sharedInbox != nil {
-		sharedInboxSerializeFunc := func() (interface{}, error) {
-			v := t.sharedInbox
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		sharedInboxResult, err := sharedInboxSerializeFunc()
-		if err == nil {
-			m["sharedInbox"] = sharedInboxResult
-		} else {
+	if t.present_[0]&(1<<34) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceCcIntermediateType(t.cc); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "cc", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.shares != nil {
-		if v, err := serializeSharesIntermediateType(t.shares); err == nil {
-			m["shares"] = v
-		} else {
+	if t.present_[0]&(1<<35) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBccIntermediateType(t.bcc); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bcc", v)
 		}
-	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	return
-
-}
-
-// Deserialize populates this object from a map[string]interface{}
-func (t *Announce) Deserialize(m map[string]interface{}) (err error) {
-	for k, v := range m {
-		handled := false
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "actor" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeActorIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.actor = []*actorIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.actor, err = deserializeSliceActorIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &actorIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.actor = []*actorIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<36) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.mediaType != nil {
+			if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
+				m["mediaType"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "object" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeObjectIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.object = []*objectIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.object, err = deserializeSliceObjectIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &objectIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.object = []*objectIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<37) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.duration != nil {
+			if v, err := serializeDurationIntermediateType(t.duration); err == nil {
+				m["duration"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "target" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeTargetIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.target = []*targetIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.target, err = deserializeSliceTargetIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &targetIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.target = []*targetIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<38) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.source != nil {
+			if v, err := serializeSourceIntermediateType(t.source); err == nil {
+				m["source"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "result" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeResultIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.result = []*resultIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.result, err = deserializeSliceResultIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &resultIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.result = []*resultIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<39) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.inbox != nil {
+			if v, err := serializeInboxIntermediateType(t.inbox); err == nil {
+				m["inbox"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "origin" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeOriginIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.origin = []*originIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.origin, err = deserializeSliceOriginIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &originIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.origin = []*originIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<40) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.outbox != nil {
+			if v, err := serializeOutboxIntermediateType(t.outbox); err == nil {
+				m["outbox"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "instrument" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeInstrumentIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.instrument = []*instrumentIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.instrument, err = deserializeSliceInstrumentIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &instrumentIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.instrument = []*instrumentIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<41) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.following != nil {
+			if v, err := serializeFollowingIntermediateType(t.following); err == nil {
+				m["following"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "altitude" {
-				t.altitude, err = deserializeAltitudeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<42) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.followers != nil {
+			if v, err := serializeFollowersIntermediateType(t.followers); err == nil {
+				m["followers"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "attachment" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAttachmentIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.attachment = []*attachmentIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.attachment, err = deserializeSliceAttachmentIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &attachmentIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.attachment = []*attachmentIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<43) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.liked != nil {
+			if v, err := serializeLikedIntermediateType(t.liked); err == nil {
+				m["liked"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "attributedTo" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAttributedToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.attributedTo, err = deserializeSliceAttributedToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &attributedToIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<44) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.likes != nil {
+			if v, err := serializeLikesIntermediateType(t.likes); err == nil {
+				m["likes"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "audience" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAudienceIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.audience = []*audienceIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.audience, err = deserializeSliceAudienceIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &audienceIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.audience = []*audienceIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<45) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		var streamsTemp []interface{}
+		for _, v := range t.streams {
+			tmp := anyURISerialize(v)
+			streamsTemp = append(streamsTemp, tmp)
+		}
+		if streamsTemp != nil {
+			if len(streamsTemp) == 1 {
+				m["streams"] = streamsTemp[0]
+			} else {
+				m["streams"] = streamsTemp
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "content" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeContentIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.content = []*contentIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.content, err = deserializeSliceContentIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &contentIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.content = []*contentIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<46) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.preferredUsername != nil {
+			if v, err := serializePreferredUsernameIntermediateType(t.preferredUsername); err == nil {
+				m["preferredUsername"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
 
-			// Begin generation by generateNaturalLanguageMap
-			if k == "contentMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.contentMap = val
-					handled = true
-				}
-			}
-			// End generation by generateNaturalLanguageMap
+	if t.present_[0]&(1<<47) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.preferredUsernameMap != nil && len(t.preferredUsernameMap) >= 0 {
+			m["preferredUsernameMap"] = t.preferredUsernameMap
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "context" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeContextIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.context = []*contextIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.context, err = deserializeSliceContextIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &contextIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.context = []*contextIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<48) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endpoints != nil {
+			if v, err := serializeEndpointsIntermediateType(t.endpoints); err == nil {
+				m["endpoints"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "name" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeNameIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.name, err = deserializeSliceNameIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &nameIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<49) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.proxyUrl != nil {
+			proxyUrlSerializeFunc := func() (interface{}, error) {
+				v := t.proxyUrl
+				tmp := anyURISerialize(v)
+				return tmp, nil
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "nameMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.nameMap = val
-					handled = true
-				}
+			proxyUrlResult, err := proxyUrlSerializeFunc()
+			if err == nil {
+				m["proxyUrl"] = proxyUrlResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNaturalLanguageMap
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "endTime" {
-				t.endTime, err = deserializeEndTimeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<50) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthAuthorizationEndpoint != nil {
+			oauthAuthorizationEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthAuthorizationEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "generator" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeGeneratorIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.generator = []*generatorIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.generator, err = deserializeSliceGeneratorIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &generatorIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.generator = []*generatorIntermediateType{tmp}
-					handled = true
-				}
+			oauthAuthorizationEndpointResult, err := oauthAuthorizationEndpointSerializeFunc()
+			if err == nil {
+				m["oauthAuthorizationEndpoint"] = oauthAuthorizationEndpointResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "icon" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeIconIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.icon = []*iconIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.icon, err = deserializeSliceIconIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &iconIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.icon = []*iconIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<51) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthTokenEndpoint != nil {
+			oauthTokenEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthTokenEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "id" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.id = tmp
-					handled = true
-				}
+			oauthTokenEndpointResult, err := oauthTokenEndpointSerializeFunc()
+			if err == nil {
+				m["oauthTokenEndpoint"] = oauthTokenEndpointResult
+			} else {
+				return m, err
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "image" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeImageIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.image = []*imageIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.image, err = deserializeSliceImageIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &imageIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.image = []*imageIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<52) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.provideClientKey != nil {
+			provideClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.provideClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			provideClientKeyResult, err := provideClientKeySerializeFunc()
+			if err == nil {
+				m["provideClientKey"] = provideClientKeyResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "inReplyTo" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeInReplyToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.inReplyTo = []*inReplyToIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.inReplyTo, err = deserializeSliceInReplyToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &inReplyToIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.inReplyTo = []*inReplyToIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<53) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.signClientKey != nil {
+			signClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.signClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			signClientKeyResult, err := signClientKeySerializeFunc()
+			if err == nil {
+				m["signClientKey"] = signClientKeyResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "location" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeLocationIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.location = []*locationIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.location, err = deserializeSliceLocationIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &locationIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.location = []*locationIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<54) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.sharedInbox != nil {
+			sharedInboxSerializeFunc := func() (interface{}, error) {
+				v := t.sharedInbox
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			sharedInboxResult, err := sharedInboxSerializeFunc()
+			if err == nil {
+				m["sharedInbox"] = sharedInboxResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "preview" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializePreviewIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.preview, err = deserializeSlicePreviewIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &previewIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<55) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.shares != nil {
+			if v, err := serializeSharesIntermediateType(t.shares); err == nil {
+				m["shares"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "published" {
-				t.published, err = deserializePublishedIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	return
+
+}
+
+// Deserialize populates this object from a map[string]interface{}, renaming the properties its '@context' aliases with ResolveAliases
+func (t *Announce) Deserialize(m map[string]interface{}) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	m = ResolveAliases(m)
+	for k, v := range m {
+		if err = t.deserializeProperty_(k, v); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	return
+
+}
+
+// deserializeProperty_ populates the property of this object with the key, or its unknown property if it has none with the key
+func (t *Announce) deserializeProperty_(k string, v interface{}) (err error) {
+	handled := false
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "actor" {
+			t.actor, err = deserializeValuesActorIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "replies" {
-				t.replies, err = deserializeRepliesIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "object" {
+			t.object, err = deserializeValuesObjectIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "startTime" {
-				t.startTime, err = deserializeStartTimeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "target" {
+			t.target, err = deserializeValuesTargetIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "summary" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeSummaryIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.summary, err = deserializeSliceSummaryIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &summaryIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "result" {
+			t.result, err = deserializeValuesResultIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "summaryMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.summaryMap = val
-					handled = true
-				}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "origin" {
+			t.origin, err = deserializeValuesOriginIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNaturalLanguageMap
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "tag" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeTagIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.tag = []*tagIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.tag, err = deserializeSliceTagIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &tagIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.tag = []*tagIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "instrument" {
+			t.instrument, err = deserializeValuesInstrumentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by generateNonFunctionalAnyDefinition
-			if k == "type" {
-				if tmpTypeSlice, ok := v.([]interface{}); ok {
-					t.typeName = tmpTypeSlice
-					handled = true
-				} else {
-					t.typeName = []interface{}{v}
-					handled = true
-				}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "altitude" {
+			t.altitude, err = deserializeAltitudeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalAnyDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "updated" {
-				t.updated, err = deserializeUpdatedIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attachment" {
+			t.attachment, err = deserializeValuesAttachmentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "url" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeUrlIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.url = []*urlIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.url, err = deserializeSliceUrlIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &urlIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.url = []*urlIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attributedTo" {
+			t.attributedTo, err = deserializeValuesAttributedToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "to" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.to = []*toIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.to, err = deserializeSliceToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &toIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.to = []*toIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "audience" {
+			t.audience, err = deserializeValuesAudienceIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "bto" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeBtoIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.bto = []*btoIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.bto, err = deserializeSliceBtoIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &btoIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.bto = []*btoIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "content" {
+			t.content, err = deserializeValuesContentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "cc" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeCcIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.cc = []*ccIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.cc, err = deserializeSliceCcIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &ccIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "contentMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					t.cc = []*ccIntermediateType{tmp}
-					handled = true
 				}
+				t.contentMap = val
+				handled = true
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "bcc" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeBccIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.bcc = []*bccIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.bcc, err = deserializeSliceBccIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &bccIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.bcc = []*bccIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "context" {
+			t.context, err = deserializeValuesContextIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "mediaType" {
-				t.mediaType, err = deserializeMediaTypeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "name" {
+			t.name, err = deserializeValuesNameIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "duration" {
-				t.duration, err = deserializeDurationIntermediateType(v)
-				if err != nil {
-					return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "nameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
 				}
+				t.nameMap = val
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "source" {
-				t.source, err = deserializeSourceIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endTime" {
+			t.endTime, err = deserializeEndTimeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "inbox" {
-				t.inbox, err = deserializeInboxIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "generator" {
+			t.generator, err = deserializeValuesGeneratorIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "outbox" {
-				t.outbox, err = deserializeOutboxIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "icon" {
+			t.icon, err = deserializeValuesIconIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "following" {
-				t.following, err = deserializeFollowingIntermediateType(v)
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "id" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.id = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "followers" {
-				t.followers, err = deserializeFollowersIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "image" {
+			t.image, err = deserializeValuesImageIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "liked" {
-				t.liked, err = deserializeLikedIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "inReplyTo" {
+			t.inReplyTo, err = deserializeValuesInReplyToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "likes" {
-				t.likes, err = deserializeLikesIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "location" {
+			t.location, err = deserializeValuesLocationIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "streams" {
-				if tmpSlice, ok := v.([]interface{}); ok {
-					for _, tmpElem := range tmpSlice {
-						if v, ok := tmpElem.(interface{}); ok {
-							tmp, err := anyURIDeserialize(v)
-							if err != nil {
-								return err
-							}
-							t.streams = append(t.streams, tmp)
-							handled = true
-						}
-					}
-				} else if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "preview" {
+			t.preview, err = deserializeValuesPreviewIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "published" {
+			t.published, err = deserializePublishedIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "replies" {
+			t.replies, err = deserializeRepliesIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "startTime" {
+			t.startTime, err = deserializeStartTimeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "summary" {
+			t.summary, err = deserializeValuesSummaryIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "summaryMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					t.streams = append(t.streams, tmp)
-					handled = true
 				}
+				t.summaryMap = val
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "preferredUsername" {
-				t.preferredUsername, err = deserializePreferredUsernameIntermediateType(v)
-				if err != nil {
-					return err
-				}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "tag" {
+			t.tag, err = deserializeValuesTagIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if k == "type" {
+			if tmpTypeSlice, ok := v.([]interface{}); ok {
+				t.typeName = tmpTypeSlice
+				handled = true
+			} else {
+				t.typeName = []interface{}{v}
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "preferredUsernameMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
+		}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "updated" {
+			t.updated, err = deserializeUpdatedIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "url" {
+			t.url, err = deserializeValuesUrlIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "to" {
+			t.to, err = deserializeValuesToIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bto" {
+			t.bto, err = deserializeValuesBtoIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "cc" {
+			t.cc, err = deserializeValuesCcIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bcc" {
+			t.bcc, err = deserializeValuesBccIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "mediaType" {
+			t.mediaType, err = deserializeMediaTypeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "duration" {
+			t.duration, err = deserializeDurationIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "source" {
+			t.source, err = deserializeSourceIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "inbox" {
+			t.inbox, err = deserializeInboxIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "outbox" {
+			t.outbox, err = deserializeOutboxIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "following" {
+			t.following, err = deserializeFollowingIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "followers" {
+			t.followers, err = deserializeFollowersIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "liked" {
+			t.liked, err = deserializeLikedIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "likes" {
+			t.likes, err = deserializeLikesIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "streams" {
+			if tmpSlice, ok := v.([]interface{}); ok {
+				for idx, tmpElem := range tmpSlice {
+					if v, ok := tmpElem.(interface{}); ok {
+						tmp, err := anyURIDeserialize(v)
+						if err != nil {
+							return deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 						}
+						t.streams = append(t.streams, tmp)
+						handled = true
 					}
-					t.preferredUsernameMap = val
-					handled = true
 				}
-			}
-			// End generation by generateNaturalLanguageMap
-		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "endpoints" {
-				t.endpoints, err = deserializeEndpointsIntermediateType(v)
+			} else if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.streams = append(t.streams, tmp)
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "proxyUrl" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "preferredUsername" {
+			t.preferredUsername, err = deserializePreferredUsernameIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "preferredUsernameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					t.proxyUrl = tmp
-					handled = true
 				}
+				t.preferredUsernameMap = val
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "oauthAuthorizationEndpoint" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.oauthAuthorizationEndpoint = tmp
-					handled = true
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endpoints" {
+			t.endpoints, err = deserializeEndpointsIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "proxyUrl" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.proxyUrl = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "oauthTokenEndpoint" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.oauthTokenEndpoint = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthAuthorizationEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.oauthAuthorizationEndpoint = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "provideClientKey" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.provideClientKey = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthTokenEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.oauthTokenEndpoint = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "signClientKey" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.signClientKey = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "provideClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.provideClientKey = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "sharedInbox" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.sharedInbox = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "signClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.signClientKey = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "shares" {
-				t.shares, err = deserializeSharesIntermediateType(v)
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "sharedInbox" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.sharedInbox = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled && k != "@context" {
-			if t.unknown_ == nil {
-				t.unknown_ = make(map[string]interface{})
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "shares" {
+			t.shares, err = deserializeSharesIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			t.unknown_[k] = unknownValueDeserialize(v)
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled && k != "@context" {
+		if t.unknown_ == nil {
+			t.unknown_ = make(map[string]interface{})
 		}
+		t.unknown_[k] = unknownValueDeserialize(v)
+	}
+	return
+
+}
+
+// MarshalJSON implements json.Marshaler by encoding this Announce as JSON, with its properties in the order of the JSON it was unmarshalled from, if any
+func (t *Announce) MarshalJSON() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	if t.order_ != nil {
+		b, err = encodeOrderedJSON(m, t.order_)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	return
+
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding JSON into this Announce, recording the order of its properties for MarshalJSON
+func (t *Announce) UnmarshalJSON(b []byte) (err error) {
+	m := make(map[string]interface{})
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
 	}
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	t.order_ = scanPropertyOrder(b)
+	return
+
+}
+
+// DecodeJSON populates this Announce from the JSON object read from the decoder, deserializing each property as soon as it is decoded instead of decoding the whole object into a map first, which takes far less memory for large documents. Properties are renamed as Deserialize does, provided the '@context' comes before the properties it aliases. Unlike UnmarshalJSON, it does not record the order of the properties
+func (t *Announce) DecodeJSON(dec *json.Decoder) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	return DecodeJSONProperties(dec, t.deserializeProperty_)
+
+}
+
+// SerializeCBOR encodes this Announce as CBOR, which DeserializeCBOR decodes. The encoding is of the same data as JSON, so values can be exchanged in CBOR internally and in JSON with other servers.
+func (t *Announce) SerializeCBOR() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeCBOR(m)
+
+}
+
+// DeserializeCBOR decodes CBOR, such as that of SerializeCBOR, into this Announce
+func (t *Announce) DeserializeCBOR(b []byte) (err error) {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode %T into Announce", v)
+	}
+	return t.Deserialize(m)
+
+}
+
+// SerializeCanonical encodes this Announce as canonical JSON by RFC 8785, so that equal values always have the same bytes, as signing them or addressing them by their content requires.
+func (t *Announce) SerializeCanonical() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeJCS(m)
+
+}
+
+// Clone returns a deep copy of this Announce, including its unknown properties and the values of all its properties, so that the copy can be modified without affecting the original
+func (t *Announce) Clone() (c *Announce, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	c = &Announce{}
+	err = c.Deserialize(cloneValue(m).(map[string]interface{}))
+	c.order_ = t.order_
 	return
 
 }
 
+// Equals determines whether this Announce and the other one have the same canonical serialized form, such as when the same activity is delivered more than once
+func (t *Announce) Equals(other *Announce) (eq bool) {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return canonicalEquals(t, other)
+
+}
+
+// Hash returns the SHA-256 hash of the canonical serialized form of this Announce, so that equal values have the same hash. It returns the zero hash if this Announce cannot be serialized
+func (t *Announce) Hash() (h [32]byte) {
+	return canonicalHash(t)
+
+}
+
 // IsPublic returns true if the 'to', 'bto', 'cc', or 'bcc' properties address the special Public ActivityPub collection
 func (t *Announce) IsPublic() (b bool) {
 	for i := 0; i < t.ToLen(); i++ {
@@ -7329,3 +8868,3281 @@ func (t *Announce) IsPublic() (b bool) {
 	return false
 
 }
+
+// Kind returns AnnounceKind.
+func (t *Announce) Kind() (k TypeKind) {
+	return AnnounceKind
+
+}
+
+// Validate returns ValidationErrors listing every property required by the specification that this Announce is missing, or nil if it has them all
+func (t *Announce) Validate() (err error) {
+	var errs ValidationErrors
+	if !(len(t.actor) > 0) {
+		errs = append(errs, &MissingPropertyError{Type: "Announce", Property: "actor"})
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// ValidateStrict returns ValidationErrors listing what Validate does, and every unknown property of this Announce, every property with a value of none of the types it takes, and every inlined value that is itself invalid, or nil if there are none. It is meant for rejecting values that are not exactly as specified, such as those posted by clients
+func (t *Announce) ValidateStrict() (err error) {
+	errs, _ := t.Validate().(ValidationErrors)
+	for _, v := range t.actor {
+		errs = v.strict(errs, "Announce", "actor")
+	}
+	for _, v := range t.object {
+		errs = v.strict(errs, "Announce", "object")
+	}
+	for _, v := range t.target {
+		errs = v.strict(errs, "Announce", "target")
+	}
+	for _, v := range t.result {
+		errs = v.strict(errs, "Announce", "result")
+	}
+	for _, v := range t.origin {
+		errs = v.strict(errs, "Announce", "origin")
+	}
+	for _, v := range t.instrument {
+		errs = v.strict(errs, "Announce", "instrument")
+	}
+	if t.altitude != nil {
+		errs = t.altitude.strict(errs, "Announce", "altitude")
+	}
+	for _, v := range t.attachment {
+		errs = v.strict(errs, "Announce", "attachment")
+	}
+	for _, v := range t.attributedTo {
+		errs = v.strict(errs, "Announce", "attributedTo")
+	}
+	for _, v := range t.audience {
+		errs = v.strict(errs, "Announce", "audience")
+	}
+	for _, v := range t.content {
+		errs = v.strict(errs, "Announce", "content")
+	}
+	for _, v := range t.context {
+		errs = v.strict(errs, "Announce", "context")
+	}
+	for _, v := range t.name {
+		errs = v.strict(errs, "Announce", "name")
+	}
+	if t.endTime != nil {
+		errs = t.endTime.strict(errs, "Announce", "endTime")
+	}
+	for _, v := range t.generator {
+		errs = v.strict(errs, "Announce", "generator")
+	}
+	for _, v := range t.icon {
+		errs = v.strict(errs, "Announce", "icon")
+	}
+	for _, v := range t.image {
+		errs = v.strict(errs, "Announce", "image")
+	}
+	for _, v := range t.inReplyTo {
+		errs = v.strict(errs, "Announce", "inReplyTo")
+	}
+	for _, v := range t.location {
+		errs = v.strict(errs, "Announce", "location")
+	}
+	for _, v := range t.preview {
+		errs = v.strict(errs, "Announce", "preview")
+	}
+	if t.published != nil {
+		errs = t.published.strict(errs, "Announce", "published")
+	}
+	if t.replies != nil {
+		errs = t.replies.strict(errs, "Announce", "replies")
+	}
+	if t.startTime != nil {
+		errs = t.startTime.strict(errs, "Announce", "startTime")
+	}
+	for _, v := range t.summary {
+		errs = v.strict(errs, "Announce", "summary")
+	}
+	for _, v := range t.tag {
+		errs = v.strict(errs, "Announce", "tag")
+	}
+	if t.updated != nil {
+		errs = t.updated.strict(errs, "Announce", "updated")
+	}
+	for _, v := range t.url {
+		errs = v.strict(errs, "Announce", "url")
+	}
+	for _, v := range t.to {
+		errs = v.strict(errs, "Announce", "to")
+	}
+	for _, v := range t.bto {
+		errs = v.strict(errs, "Announce", "bto")
+	}
+	for _, v := range t.cc {
+		errs = v.strict(errs, "Announce", "cc")
+	}
+	for _, v := range t.bcc {
+		errs = v.strict(errs, "Announce", "bcc")
+	}
+	if t.mediaType != nil {
+		errs = t.mediaType.strict(errs, "Announce", "mediaType")
+	}
+	if t.duration != nil {
+		errs = t.duration.strict(errs, "Announce", "duration")
+	}
+	if t.source != nil {
+		errs = t.source.strict(errs, "Announce", "source")
+	}
+	if t.inbox != nil {
+		errs = t.inbox.strict(errs, "Announce", "inbox")
+	}
+	if t.outbox != nil {
+		errs = t.outbox.strict(errs, "Announce", "outbox")
+	}
+	if t.following != nil {
+		errs = t.following.strict(errs, "Announce", "following")
+	}
+	if t.followers != nil {
+		errs = t.followers.strict(errs, "Announce", "followers")
+	}
+	if t.liked != nil {
+		errs = t.liked.strict(errs, "Announce", "liked")
+	}
+	if t.likes != nil {
+		errs = t.likes.strict(errs, "Announce", "likes")
+	}
+	if t.preferredUsername != nil {
+		errs = t.preferredUsername.strict(errs, "Announce", "preferredUsername")
+	}
+	if t.endpoints != nil {
+		errs = t.endpoints.strict(errs, "Announce", "endpoints")
+	}
+	if t.shares != nil {
+		errs = t.shares.strict(errs, "Announce", "shares")
+	}
+	keys := make([]string, 0, len(t.unknown_))
+	for k := range t.unknown_ {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch k {
+		case "id", "streams", "proxyUrl", "oauthAuthorizationEndpoint", "oauthTokenEndpoint", "provideClientKey", "signClientKey", "sharedInbox":
+			errs = append(errs, &MismatchedPropertyError{Type: "Announce", Property: k})
+		default:
+			errs = append(errs, &UnknownPropertyError{Type: "Announce", Property: k})
+		}
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// DeserializeStrict populates this Announce from a map[string]interface{} like Deserialize, then returns the problems ValidateStrict finds with it, if any
+func (t *Announce) DeserializeStrict(m map[string]interface{}) (err error) {
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	return t.ValidateStrict()
+
+}
+
+// DeserializeLenient populates this Announce from a map[string]interface{} like Deserialize, but parses the xsd:dateTime values of its properties, and of the values it contains, with ParseDateTimeLenient when ParseDateTimeStrict rejects them. It is meant for values from servers emitting malformed dates, and leaves the map unchanged
+func (t *Announce) DeserializeLenient(m map[string]interface{}) (err error) {
+	return t.Deserialize(lenientDateTimes(m).(map[string]interface{}))
+
+}
+
+// TryGetActorObject returns the value GetActorObject returns, or an AccessorError if IsActorObject returns false
+func (t *Announce) TryGetActorObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ActorLen() || !t.IsActorObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetActorObject", Index: index}
+		return
+	}
+	return t.GetActorObject(index), nil
+
+}
+
+// TryGetActorLink returns the value GetActorLink returns, or an AccessorError if IsActorLink returns false
+func (t *Announce) TryGetActorLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ActorLen() || !t.IsActorLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetActorLink", Index: index}
+		return
+	}
+	return t.GetActorLink(index), nil
+
+}
+
+// TryGetActorIRI returns the value GetActorIRI returns, or an AccessorError if IsActorIRI returns false
+func (t *Announce) TryGetActorIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ActorLen() || !t.IsActorIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetActorIRI", Index: index}
+		return
+	}
+	return t.GetActorIRI(index), nil
+
+}
+
+// TryGetUnknownActor returns the value GetUnknownActor returns, or an AccessorError if HasUnknownActor returns false
+func (t *Announce) TryGetUnknownActor() (v interface{}, err error) {
+	if !t.HasUnknownActor() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownActor", Index: -1}
+		return
+	}
+	return t.GetUnknownActor(), nil
+
+}
+
+// TryGetObject returns the value GetObject returns, or an AccessorError if IsObject returns false
+func (t *Announce) TryGetObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ObjectLen() || !t.IsObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetObject", Index: index}
+		return
+	}
+	return t.GetObject(index), nil
+
+}
+
+// TryGetObjectIRI returns the value GetObjectIRI returns, or an AccessorError if IsObjectIRI returns false
+func (t *Announce) TryGetObjectIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ObjectLen() || !t.IsObjectIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetObjectIRI", Index: index}
+		return
+	}
+	return t.GetObjectIRI(index), nil
+
+}
+
+// TryGetUnknownObject returns the value GetUnknownObject returns, or an AccessorError if HasUnknownObject returns false
+func (t *Announce) TryGetUnknownObject() (v interface{}, err error) {
+	if !t.HasUnknownObject() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownObject", Index: -1}
+		return
+	}
+	return t.GetUnknownObject(), nil
+
+}
+
+// TryGetTargetObject returns the value GetTargetObject returns, or an AccessorError if IsTargetObject returns false
+func (t *Announce) TryGetTargetObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.TargetLen() || !t.IsTargetObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetTargetObject", Index: index}
+		return
+	}
+	return t.GetTargetObject(index), nil
+
+}
+
+// TryGetTargetLink returns the value GetTargetLink returns, or an AccessorError if IsTargetLink returns false
+func (t *Announce) TryGetTargetLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.TargetLen() || !t.IsTargetLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetTargetLink", Index: index}
+		return
+	}
+	return t.GetTargetLink(index), nil
+
+}
+
+// TryGetTargetIRI returns the value GetTargetIRI returns, or an AccessorError if IsTargetIRI returns false
+func (t *Announce) TryGetTargetIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.TargetLen() || !t.IsTargetIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetTargetIRI", Index: index}
+		return
+	}
+	return t.GetTargetIRI(index), nil
+
+}
+
+// TryGetUnknownTarget returns the value GetUnknownTarget returns, or an AccessorError if HasUnknownTarget returns false
+func (t *Announce) TryGetUnknownTarget() (v interface{}, err error) {
+	if !t.HasUnknownTarget() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownTarget", Index: -1}
+		return
+	}
+	return t.GetUnknownTarget(), nil
+
+}
+
+// TryGetResultObject returns the value GetResultObject returns, or an AccessorError if IsResultObject returns false
+func (t *Announce) TryGetResultObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ResultLen() || !t.IsResultObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetResultObject", Index: index}
+		return
+	}
+	return t.GetResultObject(index), nil
+
+}
+
+// TryGetResultLink returns the value GetResultLink returns, or an AccessorError if IsResultLink returns false
+func (t *Announce) TryGetResultLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ResultLen() || !t.IsResultLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetResultLink", Index: index}
+		return
+	}
+	return t.GetResultLink(index), nil
+
+}
+
+// TryGetResultIRI returns the value GetResultIRI returns, or an AccessorError if IsResultIRI returns false
+func (t *Announce) TryGetResultIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ResultLen() || !t.IsResultIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetResultIRI", Index: index}
+		return
+	}
+	return t.GetResultIRI(index), nil
+
+}
+
+// TryGetUnknownResult returns the value GetUnknownResult returns, or an AccessorError if HasUnknownResult returns false
+func (t *Announce) TryGetUnknownResult() (v interface{}, err error) {
+	if !t.HasUnknownResult() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownResult", Index: -1}
+		return
+	}
+	return t.GetUnknownResult(), nil
+
+}
+
+// TryGetOriginObject returns the value GetOriginObject returns, or an AccessorError if IsOriginObject returns false
+func (t *Announce) TryGetOriginObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.OriginLen() || !t.IsOriginObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetOriginObject", Index: index}
+		return
+	}
+	return t.GetOriginObject(index), nil
+
+}
+
+// TryGetOriginLink returns the value GetOriginLink returns, or an AccessorError if IsOriginLink returns false
+func (t *Announce) TryGetOriginLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.OriginLen() || !t.IsOriginLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetOriginLink", Index: index}
+		return
+	}
+	return t.GetOriginLink(index), nil
+
+}
+
+// TryGetOriginIRI returns the value GetOriginIRI returns, or an AccessorError if IsOriginIRI returns false
+func (t *Announce) TryGetOriginIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.OriginLen() || !t.IsOriginIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetOriginIRI", Index: index}
+		return
+	}
+	return t.GetOriginIRI(index), nil
+
+}
+
+// TryGetUnknownOrigin returns the value GetUnknownOrigin returns, or an AccessorError if HasUnknownOrigin returns false
+func (t *Announce) TryGetUnknownOrigin() (v interface{}, err error) {
+	if !t.HasUnknownOrigin() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownOrigin", Index: -1}
+		return
+	}
+	return t.GetUnknownOrigin(), nil
+
+}
+
+// TryGetInstrumentObject returns the value GetInstrumentObject returns, or an AccessorError if IsInstrumentObject returns false
+func (t *Announce) TryGetInstrumentObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.InstrumentLen() || !t.IsInstrumentObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetInstrumentObject", Index: index}
+		return
+	}
+	return t.GetInstrumentObject(index), nil
+
+}
+
+// TryGetInstrumentLink returns the value GetInstrumentLink returns, or an AccessorError if IsInstrumentLink returns false
+func (t *Announce) TryGetInstrumentLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.InstrumentLen() || !t.IsInstrumentLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetInstrumentLink", Index: index}
+		return
+	}
+	return t.GetInstrumentLink(index), nil
+
+}
+
+// TryGetInstrumentIRI returns the value GetInstrumentIRI returns, or an AccessorError if IsInstrumentIRI returns false
+func (t *Announce) TryGetInstrumentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.InstrumentLen() || !t.IsInstrumentIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetInstrumentIRI", Index: index}
+		return
+	}
+	return t.GetInstrumentIRI(index), nil
+
+}
+
+// TryGetUnknownInstrument returns the value GetUnknownInstrument returns, or an AccessorError if HasUnknownInstrument returns false
+func (t *Announce) TryGetUnknownInstrument() (v interface{}, err error) {
+	if !t.HasUnknownInstrument() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownInstrument", Index: -1}
+		return
+	}
+	return t.GetUnknownInstrument(), nil
+
+}
+
+// TryGetAltitude returns the value GetAltitude returns, or an AccessorError if IsAltitude returns false
+func (t *Announce) TryGetAltitude() (v float64, err error) {
+	if !t.IsAltitude() {
+		err = &AccessorError{Type: "Announce", Getter: "GetAltitude", Index: -1}
+		return
+	}
+	return t.GetAltitude(), nil
+
+}
+
+// TryGetAltitudeIRI returns the value GetAltitudeIRI returns, or an AccessorError if IsAltitudeIRI returns false
+func (t *Announce) TryGetAltitudeIRI() (v *url.URL, err error) {
+	if !t.IsAltitudeIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetAltitudeIRI", Index: -1}
+		return
+	}
+	return t.GetAltitudeIRI(), nil
+
+}
+
+// TryGetUnknownAltitude returns the value GetUnknownAltitude returns, or an AccessorError if HasUnknownAltitude returns false
+func (t *Announce) TryGetUnknownAltitude() (v interface{}, err error) {
+	if !t.HasUnknownAltitude() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownAltitude", Index: -1}
+		return
+	}
+	return t.GetUnknownAltitude(), nil
+
+}
+
+// TryGetAttachmentObject returns the value GetAttachmentObject returns, or an AccessorError if IsAttachmentObject returns false
+func (t *Announce) TryGetAttachmentObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAttachmentObject", Index: index}
+		return
+	}
+	return t.GetAttachmentObject(index), nil
+
+}
+
+// TryGetAttachmentLink returns the value GetAttachmentLink returns, or an AccessorError if IsAttachmentLink returns false
+func (t *Announce) TryGetAttachmentLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAttachmentLink", Index: index}
+		return
+	}
+	return t.GetAttachmentLink(index), nil
+
+}
+
+// TryGetAttachmentIRI returns the value GetAttachmentIRI returns, or an AccessorError if IsAttachmentIRI returns false
+func (t *Announce) TryGetAttachmentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAttachmentIRI", Index: index}
+		return
+	}
+	return t.GetAttachmentIRI(index), nil
+
+}
+
+// TryGetUnknownAttachment returns the value GetUnknownAttachment returns, or an AccessorError if HasUnknownAttachment returns false
+func (t *Announce) TryGetUnknownAttachment() (v interface{}, err error) {
+	if !t.HasUnknownAttachment() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownAttachment", Index: -1}
+		return
+	}
+	return t.GetUnknownAttachment(), nil
+
+}
+
+// TryGetAttributedToObject returns the value GetAttributedToObject returns, or an AccessorError if IsAttributedToObject returns false
+func (t *Announce) TryGetAttributedToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAttributedToObject", Index: index}
+		return
+	}
+	return t.GetAttributedToObject(index), nil
+
+}
+
+// TryGetAttributedToLink returns the value GetAttributedToLink returns, or an AccessorError if IsAttributedToLink returns false
+func (t *Announce) TryGetAttributedToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAttributedToLink", Index: index}
+		return
+	}
+	return t.GetAttributedToLink(index), nil
+
+}
+
+// TryGetAttributedToIRI returns the value GetAttributedToIRI returns, or an AccessorError if IsAttributedToIRI returns false
+func (t *Announce) TryGetAttributedToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAttributedToIRI", Index: index}
+		return
+	}
+	return t.GetAttributedToIRI(index), nil
+
+}
+
+// TryGetUnknownAttributedTo returns the value GetUnknownAttributedTo returns, or an AccessorError if HasUnknownAttributedTo returns false
+func (t *Announce) TryGetUnknownAttributedTo() (v interface{}, err error) {
+	if !t.HasUnknownAttributedTo() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownAttributedTo", Index: -1}
+		return
+	}
+	return t.GetUnknownAttributedTo(), nil
+
+}
+
+// TryGetAudienceObject returns the value GetAudienceObject returns, or an AccessorError if IsAudienceObject returns false
+func (t *Announce) TryGetAudienceObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAudienceObject", Index: index}
+		return
+	}
+	return t.GetAudienceObject(index), nil
+
+}
+
+// TryGetAudienceLink returns the value GetAudienceLink returns, or an AccessorError if IsAudienceLink returns false
+func (t *Announce) TryGetAudienceLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAudienceLink", Index: index}
+		return
+	}
+	return t.GetAudienceLink(index), nil
+
+}
+
+// TryGetAudienceIRI returns the value GetAudienceIRI returns, or an AccessorError if IsAudienceIRI returns false
+func (t *Announce) TryGetAudienceIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetAudienceIRI", Index: index}
+		return
+	}
+	return t.GetAudienceIRI(index), nil
+
+}
+
+// TryGetUnknownAudience returns the value GetUnknownAudience returns, or an AccessorError if HasUnknownAudience returns false
+func (t *Announce) TryGetUnknownAudience() (v interface{}, err error) {
+	if !t.HasUnknownAudience() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownAudience", Index: -1}
+		return
+	}
+	return t.GetUnknownAudience(), nil
+
+}
+
+// TryGetContentString returns the value GetContentString returns, or an AccessorError if IsContentString returns false
+func (t *Announce) TryGetContentString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentString(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetContentString", Index: index}
+		return
+	}
+	return t.GetContentString(index), nil
+
+}
+
+// TryGetContentLangString returns the value GetContentLangString returns, or an AccessorError if IsContentLangString returns false
+func (t *Announce) TryGetContentLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentLangString(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetContentLangString", Index: index}
+		return
+	}
+	return t.GetContentLangString(index), nil
+
+}
+
+// TryGetContentIRI returns the value GetContentIRI returns, or an AccessorError if IsContentIRI returns false
+func (t *Announce) TryGetContentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetContentIRI", Index: index}
+		return
+	}
+	return t.GetContentIRI(index), nil
+
+}
+
+// TryGetUnknownContent returns the value GetUnknownContent returns, or an AccessorError if HasUnknownContent returns false
+func (t *Announce) TryGetUnknownContent() (v interface{}, err error) {
+	if !t.HasUnknownContent() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownContent", Index: -1}
+		return
+	}
+	return t.GetUnknownContent(), nil
+
+}
+
+// TryGetContextObject returns the value GetContextObject returns, or an AccessorError if IsContextObject returns false
+func (t *Announce) TryGetContextObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetContextObject", Index: index}
+		return
+	}
+	return t.GetContextObject(index), nil
+
+}
+
+// TryGetContextLink returns the value GetContextLink returns, or an AccessorError if IsContextLink returns false
+func (t *Announce) TryGetContextLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetContextLink", Index: index}
+		return
+	}
+	return t.GetContextLink(index), nil
+
+}
+
+// TryGetContextIRI returns the value GetContextIRI returns, or an AccessorError if IsContextIRI returns false
+func (t *Announce) TryGetContextIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetContextIRI", Index: index}
+		return
+	}
+	return t.GetContextIRI(index), nil
+
+}
+
+// TryGetUnknownContext returns the value GetUnknownContext returns, or an AccessorError if HasUnknownContext returns false
+func (t *Announce) TryGetUnknownContext() (v interface{}, err error) {
+	if !t.HasUnknownContext() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownContext", Index: -1}
+		return
+	}
+	return t.GetUnknownContext(), nil
+
+}
+
+// TryGetNameString returns the value GetNameString returns, or an AccessorError if IsNameString returns false
+func (t *Announce) TryGetNameString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameString(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetNameString", Index: index}
+		return
+	}
+	return t.GetNameString(index), nil
+
+}
+
+// TryGetNameLangString returns the value GetNameLangString returns, or an AccessorError if IsNameLangString returns false
+func (t *Announce) TryGetNameLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameLangString(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetNameLangString", Index: index}
+		return
+	}
+	return t.GetNameLangString(index), nil
+
+}
+
+// TryGetNameIRI returns the value GetNameIRI returns, or an AccessorError if IsNameIRI returns false
+func (t *Announce) TryGetNameIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetNameIRI", Index: index}
+		return
+	}
+	return t.GetNameIRI(index), nil
+
+}
+
+// TryGetUnknownName returns the value GetUnknownName returns, or an AccessorError if HasUnknownName returns false
+func (t *Announce) TryGetUnknownName() (v interface{}, err error) {
+	if !t.HasUnknownName() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownName", Index: -1}
+		return
+	}
+	return t.GetUnknownName(), nil
+
+}
+
+// TryGetEndTime returns the value GetEndTime returns, or an AccessorError if IsEndTime returns false
+func (t *Announce) TryGetEndTime() (v time.Time, err error) {
+	if !t.IsEndTime() {
+		err = &AccessorError{Type: "Announce", Getter: "GetEndTime", Index: -1}
+		return
+	}
+	return t.GetEndTime(), nil
+
+}
+
+// TryGetEndTimeIRI returns the value GetEndTimeIRI returns, or an AccessorError if IsEndTimeIRI returns false
+func (t *Announce) TryGetEndTimeIRI() (v *url.URL, err error) {
+	if !t.IsEndTimeIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetEndTimeIRI", Index: -1}
+		return
+	}
+	return t.GetEndTimeIRI(), nil
+
+}
+
+// TryGetUnknownEndTime returns the value GetUnknownEndTime returns, or an AccessorError if HasUnknownEndTime returns false
+func (t *Announce) TryGetUnknownEndTime() (v interface{}, err error) {
+	if !t.HasUnknownEndTime() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownEndTime", Index: -1}
+		return
+	}
+	return t.GetUnknownEndTime(), nil
+
+}
+
+// TryGetGeneratorObject returns the value GetGeneratorObject returns, or an AccessorError if IsGeneratorObject returns false
+func (t *Announce) TryGetGeneratorObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetGeneratorObject", Index: index}
+		return
+	}
+	return t.GetGeneratorObject(index), nil
+
+}
+
+// TryGetGeneratorLink returns the value GetGeneratorLink returns, or an AccessorError if IsGeneratorLink returns false
+func (t *Announce) TryGetGeneratorLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetGeneratorLink", Index: index}
+		return
+	}
+	return t.GetGeneratorLink(index), nil
+
+}
+
+// TryGetGeneratorIRI returns the value GetGeneratorIRI returns, or an AccessorError if IsGeneratorIRI returns false
+func (t *Announce) TryGetGeneratorIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetGeneratorIRI", Index: index}
+		return
+	}
+	return t.GetGeneratorIRI(index), nil
+
+}
+
+// TryGetUnknownGenerator returns the value GetUnknownGenerator returns, or an AccessorError if HasUnknownGenerator returns false
+func (t *Announce) TryGetUnknownGenerator() (v interface{}, err error) {
+	if !t.HasUnknownGenerator() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownGenerator", Index: -1}
+		return
+	}
+	return t.GetUnknownGenerator(), nil
+
+}
+
+// TryGetIconImage returns the value GetIconImage returns, or an AccessorError if IsIconImage returns false
+func (t *Announce) TryGetIconImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconImage(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetIconImage", Index: index}
+		return
+	}
+	return t.GetIconImage(index), nil
+
+}
+
+// TryGetIconLink returns the value GetIconLink returns, or an AccessorError if IsIconLink returns false
+func (t *Announce) TryGetIconLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetIconLink", Index: index}
+		return
+	}
+	return t.GetIconLink(index), nil
+
+}
+
+// TryGetIconIRI returns the value GetIconIRI returns, or an AccessorError if IsIconIRI returns false
+func (t *Announce) TryGetIconIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetIconIRI", Index: index}
+		return
+	}
+	return t.GetIconIRI(index), nil
+
+}
+
+// TryGetUnknownIcon returns the value GetUnknownIcon returns, or an AccessorError if HasUnknownIcon returns false
+func (t *Announce) TryGetUnknownIcon() (v interface{}, err error) {
+	if !t.HasUnknownIcon() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownIcon", Index: -1}
+		return
+	}
+	return t.GetUnknownIcon(), nil
+
+}
+
+// TryGetId returns the value GetId returns, or an AccessorError if HasId returns false
+func (t *Announce) TryGetId() (v *url.URL, err error) {
+	if !t.HasId() {
+		err = &AccessorError{Type: "Announce", Getter: "GetId", Index: -1}
+		return
+	}
+	return t.GetId(), nil
+
+}
+
+// TryGetUnknownId returns the value GetUnknownId returns, or an AccessorError if HasUnknownId returns false
+func (t *Announce) TryGetUnknownId() (v interface{}, err error) {
+	if !t.HasUnknownId() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownId", Index: -1}
+		return
+	}
+	return t.GetUnknownId(), nil
+
+}
+
+// TryGetImageImage returns the value GetImageImage returns, or an AccessorError if IsImageImage returns false
+func (t *Announce) TryGetImageImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageImage(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetImageImage", Index: index}
+		return
+	}
+	return t.GetImageImage(index), nil
+
+}
+
+// TryGetImageLink returns the value GetImageLink returns, or an AccessorError if IsImageLink returns false
+func (t *Announce) TryGetImageLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetImageLink", Index: index}
+		return
+	}
+	return t.GetImageLink(index), nil
+
+}
+
+// TryGetImageIRI returns the value GetImageIRI returns, or an AccessorError if IsImageIRI returns false
+func (t *Announce) TryGetImageIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetImageIRI", Index: index}
+		return
+	}
+	return t.GetImageIRI(index), nil
+
+}
+
+// TryGetUnknownImage returns the value GetUnknownImage returns, or an AccessorError if HasUnknownImage returns false
+func (t *Announce) TryGetUnknownImage() (v interface{}, err error) {
+	if !t.HasUnknownImage() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownImage", Index: -1}
+		return
+	}
+	return t.GetUnknownImage(), nil
+
+}
+
+// TryGetInReplyToObject returns the value GetInReplyToObject returns, or an AccessorError if IsInReplyToObject returns false
+func (t *Announce) TryGetInReplyToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetInReplyToObject", Index: index}
+		return
+	}
+	return t.GetInReplyToObject(index), nil
+
+}
+
+// TryGetInReplyToLink returns the value GetInReplyToLink returns, or an AccessorError if IsInReplyToLink returns false
+func (t *Announce) TryGetInReplyToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetInReplyToLink", Index: index}
+		return
+	}
+	return t.GetInReplyToLink(index), nil
+
+}
+
+// TryGetInReplyToIRI returns the value GetInReplyToIRI returns, or an AccessorError if IsInReplyToIRI returns false
+func (t *Announce) TryGetInReplyToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetInReplyToIRI", Index: index}
+		return
+	}
+	return t.GetInReplyToIRI(index), nil
+
+}
+
+// TryGetUnknownInReplyTo returns the value GetUnknownInReplyTo returns, or an AccessorError if HasUnknownInReplyTo returns false
+func (t *Announce) TryGetUnknownInReplyTo() (v interface{}, err error) {
+	if !t.HasUnknownInReplyTo() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownInReplyTo", Index: -1}
+		return
+	}
+	return t.GetUnknownInReplyTo(), nil
+
+}
+
+// TryGetLocationObject returns the value GetLocationObject returns, or an AccessorError if IsLocationObject returns false
+func (t *Announce) TryGetLocationObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetLocationObject", Index: index}
+		return
+	}
+	return t.GetLocationObject(index), nil
+
+}
+
+// TryGetLocationLink returns the value GetLocationLink returns, or an AccessorError if IsLocationLink returns false
+func (t *Announce) TryGetLocationLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetLocationLink", Index: index}
+		return
+	}
+	return t.GetLocationLink(index), nil
+
+}
+
+// TryGetLocationIRI returns the value GetLocationIRI returns, or an AccessorError if IsLocationIRI returns false
+func (t *Announce) TryGetLocationIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetLocationIRI", Index: index}
+		return
+	}
+	return t.GetLocationIRI(index), nil
+
+}
+
+// TryGetUnknownLocation returns the value GetUnknownLocation returns, or an AccessorError if HasUnknownLocation returns false
+func (t *Announce) TryGetUnknownLocation() (v interface{}, err error) {
+	if !t.HasUnknownLocation() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownLocation", Index: -1}
+		return
+	}
+	return t.GetUnknownLocation(), nil
+
+}
+
+// TryGetPreviewObject returns the value GetPreviewObject returns, or an AccessorError if IsPreviewObject returns false
+func (t *Announce) TryGetPreviewObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetPreviewObject", Index: index}
+		return
+	}
+	return t.GetPreviewObject(index), nil
+
+}
+
+// TryGetPreviewLink returns the value GetPreviewLink returns, or an AccessorError if IsPreviewLink returns false
+func (t *Announce) TryGetPreviewLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetPreviewLink", Index: index}
+		return
+	}
+	return t.GetPreviewLink(index), nil
+
+}
+
+// TryGetPreviewIRI returns the value GetPreviewIRI returns, or an AccessorError if IsPreviewIRI returns false
+func (t *Announce) TryGetPreviewIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetPreviewIRI", Index: index}
+		return
+	}
+	return t.GetPreviewIRI(index), nil
+
+}
+
+// TryGetUnknownPreview returns the value GetUnknownPreview returns, or an AccessorError if HasUnknownPreview returns false
+func (t *Announce) TryGetUnknownPreview() (v interface{}, err error) {
+	if !t.HasUnknownPreview() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownPreview", Index: -1}
+		return
+	}
+	return t.GetUnknownPreview(), nil
+
+}
+
+// TryGetPublished returns the value GetPublished returns, or an AccessorError if IsPublished returns false
+func (t *Announce) TryGetPublished() (v time.Time, err error) {
+	if !t.IsPublished() {
+		err = &AccessorError{Type: "Announce", Getter: "GetPublished", Index: -1}
+		return
+	}
+	return t.GetPublished(), nil
+
+}
+
+// TryGetPublishedIRI returns the value GetPublishedIRI returns, or an AccessorError if IsPublishedIRI returns false
+func (t *Announce) TryGetPublishedIRI() (v *url.URL, err error) {
+	if !t.IsPublishedIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetPublishedIRI", Index: -1}
+		return
+	}
+	return t.GetPublishedIRI(), nil
+
+}
+
+// TryGetUnknownPublished returns the value GetUnknownPublished returns, or an AccessorError if HasUnknownPublished returns false
+func (t *Announce) TryGetUnknownPublished() (v interface{}, err error) {
+	if !t.HasUnknownPublished() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownPublished", Index: -1}
+		return
+	}
+	return t.GetUnknownPublished(), nil
+
+}
+
+// TryGetReplies returns the value GetReplies returns, or an AccessorError if IsReplies returns false
+func (t *Announce) TryGetReplies() (v CollectionType, err error) {
+	if !t.IsReplies() {
+		err = &AccessorError{Type: "Announce", Getter: "GetReplies", Index: -1}
+		return
+	}
+	return t.GetReplies(), nil
+
+}
+
+// TryGetRepliesIRI returns the value GetRepliesIRI returns, or an AccessorError if IsRepliesIRI returns false
+func (t *Announce) TryGetRepliesIRI() (v *url.URL, err error) {
+	if !t.IsRepliesIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetRepliesIRI", Index: -1}
+		return
+	}
+	return t.GetRepliesIRI(), nil
+
+}
+
+// TryGetUnknownReplies returns the value GetUnknownReplies returns, or an AccessorError if HasUnknownReplies returns false
+func (t *Announce) TryGetUnknownReplies() (v interface{}, err error) {
+	if !t.HasUnknownReplies() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownReplies", Index: -1}
+		return
+	}
+	return t.GetUnknownReplies(), nil
+
+}
+
+// TryGetStartTime returns the value GetStartTime returns, or an AccessorError if IsStartTime returns false
+func (t *Announce) TryGetStartTime() (v time.Time, err error) {
+	if !t.IsStartTime() {
+		err = &AccessorError{Type: "Announce", Getter: "GetStartTime", Index: -1}
+		return
+	}
+	return t.GetStartTime(), nil
+
+}
+
+// TryGetStartTimeIRI returns the value GetStartTimeIRI returns, or an AccessorError if IsStartTimeIRI returns false
+func (t *Announce) TryGetStartTimeIRI() (v *url.URL, err error) {
+	if !t.IsStartTimeIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetStartTimeIRI", Index: -1}
+		return
+	}
+	return t.GetStartTimeIRI(), nil
+
+}
+
+// TryGetUnknownStartTime returns the value GetUnknownStartTime returns, or an AccessorError if HasUnknownStartTime returns false
+func (t *Announce) TryGetUnknownStartTime() (v interface{}, err error) {
+	if !t.HasUnknownStartTime() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownStartTime", Index: -1}
+		return
+	}
+	return t.GetUnknownStartTime(), nil
+
+}
+
+// TryGetSummaryString returns the value GetSummaryString returns, or an AccessorError if IsSummaryString returns false
+func (t *Announce) TryGetSummaryString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryString(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetSummaryString", Index: index}
+		return
+	}
+	return t.GetSummaryString(index), nil
+
+}
+
+// TryGetSummaryLangString returns the value GetSummaryLangString returns, or an AccessorError if IsSummaryLangString returns false
+func (t *Announce) TryGetSummaryLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryLangString(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetSummaryLangString", Index: index}
+		return
+	}
+	return t.GetSummaryLangString(index), nil
+
+}
+
+// TryGetSummaryIRI returns the value GetSummaryIRI returns, or an AccessorError if IsSummaryIRI returns false
+func (t *Announce) TryGetSummaryIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetSummaryIRI", Index: index}
+		return
+	}
+	return t.GetSummaryIRI(index), nil
+
+}
+
+// TryGetUnknownSummary returns the value GetUnknownSummary returns, or an AccessorError if HasUnknownSummary returns false
+func (t *Announce) TryGetUnknownSummary() (v interface{}, err error) {
+	if !t.HasUnknownSummary() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownSummary", Index: -1}
+		return
+	}
+	return t.GetUnknownSummary(), nil
+
+}
+
+// TryGetTagObject returns the value GetTagObject returns, or an AccessorError if IsTagObject returns false
+func (t *Announce) TryGetTagObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetTagObject", Index: index}
+		return
+	}
+	return t.GetTagObject(index), nil
+
+}
+
+// TryGetTagLink returns the value GetTagLink returns, or an AccessorError if IsTagLink returns false
+func (t *Announce) TryGetTagLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetTagLink", Index: index}
+		return
+	}
+	return t.GetTagLink(index), nil
+
+}
+
+// TryGetTagIRI returns the value GetTagIRI returns, or an AccessorError if IsTagIRI returns false
+func (t *Announce) TryGetTagIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetTagIRI", Index: index}
+		return
+	}
+	return t.GetTagIRI(index), nil
+
+}
+
+// TryGetUnknownTag returns the value GetUnknownTag returns, or an AccessorError if HasUnknownTag returns false
+func (t *Announce) TryGetUnknownTag() (v interface{}, err error) {
+	if !t.HasUnknownTag() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownTag", Index: -1}
+		return
+	}
+	return t.GetUnknownTag(), nil
+
+}
+
+// TryGetType returns the value GetType returns, or an AccessorError if the index is out of range
+func (t *Announce) TryGetType(index int) (v interface{}, err error) {
+	if index < 0 || index >= t.TypeLen() {
+		err = &AccessorError{Type: "Announce", Getter: "GetType", Index: index}
+		return
+	}
+	return t.GetType(index), nil
+
+}
+
+// TryGetUpdated returns the value GetUpdated returns, or an AccessorError if IsUpdated returns false
+func (t *Announce) TryGetUpdated() (v time.Time, err error) {
+	if !t.IsUpdated() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUpdated", Index: -1}
+		return
+	}
+	return t.GetUpdated(), nil
+
+}
+
+// TryGetUpdatedIRI returns the value GetUpdatedIRI returns, or an AccessorError if IsUpdatedIRI returns false
+func (t *Announce) TryGetUpdatedIRI() (v *url.URL, err error) {
+	if !t.IsUpdatedIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUpdatedIRI", Index: -1}
+		return
+	}
+	return t.GetUpdatedIRI(), nil
+
+}
+
+// TryGetUnknownUpdated returns the value GetUnknownUpdated returns, or an AccessorError if HasUnknownUpdated returns false
+func (t *Announce) TryGetUnknownUpdated() (v interface{}, err error) {
+	if !t.HasUnknownUpdated() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownUpdated", Index: -1}
+		return
+	}
+	return t.GetUnknownUpdated(), nil
+
+}
+
+// TryGetUrlAnyURI returns the value GetUrlAnyURI returns, or an AccessorError if IsUrlAnyURI returns false
+func (t *Announce) TryGetUrlAnyURI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlAnyURI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetUrlAnyURI", Index: index}
+		return
+	}
+	return t.GetUrlAnyURI(index), nil
+
+}
+
+// TryGetUrlLink returns the value GetUrlLink returns, or an AccessorError if IsUrlLink returns false
+func (t *Announce) TryGetUrlLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetUrlLink", Index: index}
+		return
+	}
+	return t.GetUrlLink(index), nil
+
+}
+
+// TryGetUnknownUrl returns the value GetUnknownUrl returns, or an AccessorError if HasUnknownUrl returns false
+func (t *Announce) TryGetUnknownUrl() (v interface{}, err error) {
+	if !t.HasUnknownUrl() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownUrl(), nil
+
+}
+
+// TryGetToObject returns the value GetToObject returns, or an AccessorError if IsToObject returns false
+func (t *Announce) TryGetToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetToObject", Index: index}
+		return
+	}
+	return t.GetToObject(index), nil
+
+}
+
+// TryGetToLink returns the value GetToLink returns, or an AccessorError if IsToLink returns false
+func (t *Announce) TryGetToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetToLink", Index: index}
+		return
+	}
+	return t.GetToLink(index), nil
+
+}
+
+// TryGetToIRI returns the value GetToIRI returns, or an AccessorError if IsToIRI returns false
+func (t *Announce) TryGetToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetToIRI", Index: index}
+		return
+	}
+	return t.GetToIRI(index), nil
+
+}
+
+// TryGetUnknownTo returns the value GetUnknownTo returns, or an AccessorError if HasUnknownTo returns false
+func (t *Announce) TryGetUnknownTo() (v interface{}, err error) {
+	if !t.HasUnknownTo() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownTo", Index: -1}
+		return
+	}
+	return t.GetUnknownTo(), nil
+
+}
+
+// TryGetBtoObject returns the value GetBtoObject returns, or an AccessorError if IsBtoObject returns false
+func (t *Announce) TryGetBtoObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetBtoObject", Index: index}
+		return
+	}
+	return t.GetBtoObject(index), nil
+
+}
+
+// TryGetBtoLink returns the value GetBtoLink returns, or an AccessorError if IsBtoLink returns false
+func (t *Announce) TryGetBtoLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetBtoLink", Index: index}
+		return
+	}
+	return t.GetBtoLink(index), nil
+
+}
+
+// TryGetBtoIRI returns the value GetBtoIRI returns, or an AccessorError if IsBtoIRI returns false
+func (t *Announce) TryGetBtoIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetBtoIRI", Index: index}
+		return
+	}
+	return t.GetBtoIRI(index), nil
+
+}
+
+// TryGetUnknownBto returns the value GetUnknownBto returns, or an AccessorError if HasUnknownBto returns false
+func (t *Announce) TryGetUnknownBto() (v interface{}, err error) {
+	if !t.HasUnknownBto() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownBto", Index: -1}
+		return
+	}
+	return t.GetUnknownBto(), nil
+
+}
+
+// TryGetCcObject returns the value GetCcObject returns, or an AccessorError if IsCcObject returns false
+func (t *Announce) TryGetCcObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetCcObject", Index: index}
+		return
+	}
+	return t.GetCcObject(index), nil
+
+}
+
+// TryGetCcLink returns the value GetCcLink returns, or an AccessorError if IsCcLink returns false
+func (t *Announce) TryGetCcLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetCcLink", Index: index}
+		return
+	}
+	return t.GetCcLink(index), nil
+
+}
+
+// TryGetCcIRI returns the value GetCcIRI returns, or an AccessorError if IsCcIRI returns false
+func (t *Announce) TryGetCcIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetCcIRI", Index: index}
+		return
+	}
+	return t.GetCcIRI(index), nil
+
+}
+
+// TryGetUnknownCc returns the value GetUnknownCc returns, or an AccessorError if HasUnknownCc returns false
+func (t *Announce) TryGetUnknownCc() (v interface{}, err error) {
+	if !t.HasUnknownCc() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownCc", Index: -1}
+		return
+	}
+	return t.GetUnknownCc(), nil
+
+}
+
+// TryGetBccObject returns the value GetBccObject returns, or an AccessorError if IsBccObject returns false
+func (t *Announce) TryGetBccObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccObject(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetBccObject", Index: index}
+		return
+	}
+	return t.GetBccObject(index), nil
+
+}
+
+// TryGetBccLink returns the value GetBccLink returns, or an AccessorError if IsBccLink returns false
+func (t *Announce) TryGetBccLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccLink(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetBccLink", Index: index}
+		return
+	}
+	return t.GetBccLink(index), nil
+
+}
+
+// TryGetBccIRI returns the value GetBccIRI returns, or an AccessorError if IsBccIRI returns false
+func (t *Announce) TryGetBccIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccIRI(index) {
+		err = &AccessorError{Type: "Announce", Getter: "GetBccIRI", Index: index}
+		return
+	}
+	return t.GetBccIRI(index), nil
+
+}
+
+// TryGetUnknownBcc returns the value GetUnknownBcc returns, or an AccessorError if HasUnknownBcc returns false
+func (t *Announce) TryGetUnknownBcc() (v interface{}, err error) {
+	if !t.HasUnknownBcc() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownBcc", Index: -1}
+		return
+	}
+	return t.GetUnknownBcc(), nil
+
+}
+
+// TryGetMediaType returns the value GetMediaType returns, or an AccessorError if IsMediaType returns false
+func (t *Announce) TryGetMediaType() (v string, err error) {
+	if !t.IsMediaType() {
+		err = &AccessorError{Type: "Announce", Getter: "GetMediaType", Index: -1}
+		return
+	}
+	return t.GetMediaType(), nil
+
+}
+
+// TryGetMediaTypeIRI returns the value GetMediaTypeIRI returns, or an AccessorError if IsMediaTypeIRI returns false
+func (t *Announce) TryGetMediaTypeIRI() (v *url.URL, err error) {
+	if !t.IsMediaTypeIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetMediaTypeIRI", Index: -1}
+		return
+	}
+	return t.GetMediaTypeIRI(), nil
+
+}
+
+// TryGetUnknownMediaType returns the value GetUnknownMediaType returns, or an AccessorError if HasUnknownMediaType returns false
+func (t *Announce) TryGetUnknownMediaType() (v interface{}, err error) {
+	if !t.HasUnknownMediaType() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownMediaType", Index: -1}
+		return
+	}
+	return t.GetUnknownMediaType(), nil
+
+}
+
+// TryGetDuration returns the value GetDuration returns, or an AccessorError if IsDuration returns false
+func (t *Announce) TryGetDuration() (v time.Duration, err error) {
+	if !t.IsDuration() {
+		err = &AccessorError{Type: "Announce", Getter: "GetDuration", Index: -1}
+		return
+	}
+	return t.GetDuration(), nil
+
+}
+
+// TryGetDurationIRI returns the value GetDurationIRI returns, or an AccessorError if IsDurationIRI returns false
+func (t *Announce) TryGetDurationIRI() (v *url.URL, err error) {
+	if !t.IsDurationIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetDurationIRI", Index: -1}
+		return
+	}
+	return t.GetDurationIRI(), nil
+
+}
+
+// TryGetUnknownDuration returns the value GetUnknownDuration returns, or an AccessorError if HasUnknownDuration returns false
+func (t *Announce) TryGetUnknownDuration() (v interface{}, err error) {
+	if !t.HasUnknownDuration() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownDuration", Index: -1}
+		return
+	}
+	return t.GetUnknownDuration(), nil
+
+}
+
+// TryGetSource returns the value GetSource returns, or an AccessorError if IsSource returns false
+func (t *Announce) TryGetSource() (v ObjectType, err error) {
+	if !t.IsSource() {
+		err = &AccessorError{Type: "Announce", Getter: "GetSource", Index: -1}
+		return
+	}
+	return t.GetSource(), nil
+
+}
+
+// TryGetSourceIRI returns the value GetSourceIRI returns, or an AccessorError if IsSourceIRI returns false
+func (t *Announce) TryGetSourceIRI() (v *url.URL, err error) {
+	if !t.IsSourceIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetSourceIRI", Index: -1}
+		return
+	}
+	return t.GetSourceIRI(), nil
+
+}
+
+// TryGetUnknownSource returns the value GetUnknownSource returns, or an AccessorError if HasUnknownSource returns false
+func (t *Announce) TryGetUnknownSource() (v interface{}, err error) {
+	if !t.HasUnknownSource() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownSource", Index: -1}
+		return
+	}
+	return t.GetUnknownSource(), nil
+
+}
+
+// TryGetInboxOrderedCollection returns the value GetInboxOrderedCollection returns, or an AccessorError if IsInboxOrderedCollection returns false
+func (t *Announce) TryGetInboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsInboxOrderedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetInboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetInboxOrderedCollection(), nil
+
+}
+
+// TryGetInboxAnyURI returns the value GetInboxAnyURI returns, or an AccessorError if IsInboxAnyURI returns false
+func (t *Announce) TryGetInboxAnyURI() (v *url.URL, err error) {
+	if !t.IsInboxAnyURI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetInboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetInboxAnyURI(), nil
+
+}
+
+// TryGetUnknownInbox returns the value GetUnknownInbox returns, or an AccessorError if HasUnknownInbox returns false
+func (t *Announce) TryGetUnknownInbox() (v interface{}, err error) {
+	if !t.HasUnknownInbox() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownInbox(), nil
+
+}
+
+// TryGetOutboxOrderedCollection returns the value GetOutboxOrderedCollection returns, or an AccessorError if IsOutboxOrderedCollection returns false
+func (t *Announce) TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsOutboxOrderedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetOutboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetOutboxOrderedCollection(), nil
+
+}
+
+// TryGetOutboxAnyURI returns the value GetOutboxAnyURI returns, or an AccessorError if IsOutboxAnyURI returns false
+func (t *Announce) TryGetOutboxAnyURI() (v *url.URL, err error) {
+	if !t.IsOutboxAnyURI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetOutboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetOutboxAnyURI(), nil
+
+}
+
+// TryGetUnknownOutbox returns the value GetUnknownOutbox returns, or an AccessorError if HasUnknownOutbox returns false
+func (t *Announce) TryGetUnknownOutbox() (v interface{}, err error) {
+	if !t.HasUnknownOutbox() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownOutbox", Index: -1}
+		return
+	}
+	return t.GetUnknownOutbox(), nil
+
+}
+
+// TryGetFollowingCollection returns the value GetFollowingCollection returns, or an AccessorError if IsFollowingCollection returns false
+func (t *Announce) TryGetFollowingCollection() (v CollectionType, err error) {
+	if !t.IsFollowingCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetFollowingCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingCollection(), nil
+
+}
+
+// TryGetFollowingOrderedCollection returns the value GetFollowingOrderedCollection returns, or an AccessorError if IsFollowingOrderedCollection returns false
+func (t *Announce) TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowingOrderedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetFollowingOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingOrderedCollection(), nil
+
+}
+
+// TryGetFollowingAnyURI returns the value GetFollowingAnyURI returns, or an AccessorError if IsFollowingAnyURI returns false
+func (t *Announce) TryGetFollowingAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowingAnyURI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetFollowingAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowingAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowing returns the value GetUnknownFollowing returns, or an AccessorError if HasUnknownFollowing returns false
+func (t *Announce) TryGetUnknownFollowing() (v interface{}, err error) {
+	if !t.HasUnknownFollowing() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownFollowing", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowing(), nil
+
+}
+
+// TryGetFollowersCollection returns the value GetFollowersCollection returns, or an AccessorError if IsFollowersCollection returns false
+func (t *Announce) TryGetFollowersCollection() (v CollectionType, err error) {
+	if !t.IsFollowersCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetFollowersCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersCollection(), nil
+
+}
+
+// TryGetFollowersOrderedCollection returns the value GetFollowersOrderedCollection returns, or an AccessorError if IsFollowersOrderedCollection returns false
+func (t *Announce) TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowersOrderedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetFollowersOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersOrderedCollection(), nil
+
+}
+
+// TryGetFollowersAnyURI returns the value GetFollowersAnyURI returns, or an AccessorError if IsFollowersAnyURI returns false
+func (t *Announce) TryGetFollowersAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowersAnyURI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetFollowersAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowersAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowers returns the value GetUnknownFollowers returns, or an AccessorError if HasUnknownFollowers returns false
+func (t *Announce) TryGetUnknownFollowers() (v interface{}, err error) {
+	if !t.HasUnknownFollowers() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownFollowers", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowers(), nil
+
+}
+
+// TryGetLikedCollection returns the value GetLikedCollection returns, or an AccessorError if IsLikedCollection returns false
+func (t *Announce) TryGetLikedCollection() (v CollectionType, err error) {
+	if !t.IsLikedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetLikedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedCollection(), nil
+
+}
+
+// TryGetLikedOrderedCollection returns the value GetLikedOrderedCollection returns, or an AccessorError if IsLikedOrderedCollection returns false
+func (t *Announce) TryGetLikedOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikedOrderedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetLikedOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedOrderedCollection(), nil
+
+}
+
+// TryGetLikedAnyURI returns the value GetLikedAnyURI returns, or an AccessorError if IsLikedAnyURI returns false
+func (t *Announce) TryGetLikedAnyURI() (v *url.URL, err error) {
+	if !t.IsLikedAnyURI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetLikedAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikedAnyURI(), nil
+
+}
+
+// TryGetUnknownLiked returns the value GetUnknownLiked returns, or an AccessorError if HasUnknownLiked returns false
+func (t *Announce) TryGetUnknownLiked() (v interface{}, err error) {
+	if !t.HasUnknownLiked() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownLiked", Index: -1}
+		return
+	}
+	return t.GetUnknownLiked(), nil
+
+}
+
+// TryGetLikesCollection returns the value GetLikesCollection returns, or an AccessorError if IsLikesCollection returns false
+func (t *Announce) TryGetLikesCollection() (v CollectionType, err error) {
+	if !t.IsLikesCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetLikesCollection", Index: -1}
+		return
+	}
+	return t.GetLikesCollection(), nil
+
+}
+
+// TryGetLikesOrderedCollection returns the value GetLikesOrderedCollection returns, or an AccessorError if IsLikesOrderedCollection returns false
+func (t *Announce) TryGetLikesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikesOrderedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetLikesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikesOrderedCollection(), nil
+
+}
+
+// TryGetLikesAnyURI returns the value GetLikesAnyURI returns, or an AccessorError if IsLikesAnyURI returns false
+func (t *Announce) TryGetLikesAnyURI() (v *url.URL, err error) {
+	if !t.IsLikesAnyURI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetLikesAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikesAnyURI(), nil
+
+}
+
+// TryGetUnknownLikes returns the value GetUnknownLikes returns, or an AccessorError if HasUnknownLikes returns false
+func (t *Announce) TryGetUnknownLikes() (v interface{}, err error) {
+	if !t.HasUnknownLikes() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownLikes", Index: -1}
+		return
+	}
+	return t.GetUnknownLikes(), nil
+
+}
+
+// TryGetStreams returns the value GetStreams returns, or an AccessorError if the index is out of range
+func (t *Announce) TryGetStreams(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.StreamsLen() {
+		err = &AccessorError{Type: "Announce", Getter: "GetStreams", Index: index}
+		return
+	}
+	return t.GetStreams(index), nil
+
+}
+
+// TryGetUnknownStreams returns the value GetUnknownStreams returns, or an AccessorError if HasUnknownStreams returns false
+func (t *Announce) TryGetUnknownStreams() (v interface{}, err error) {
+	if !t.HasUnknownStreams() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownStreams", Index: -1}
+		return
+	}
+	return t.GetUnknownStreams(), nil
+
+}
+
+// TryGetPreferredUsername returns the value GetPreferredUsername returns, or an AccessorError if IsPreferredUsername returns false
+func (t *Announce) TryGetPreferredUsername() (v string, err error) {
+	if !t.IsPreferredUsername() {
+		err = &AccessorError{Type: "Announce", Getter: "GetPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetPreferredUsername(), nil
+
+}
+
+// TryGetPreferredUsernameIRI returns the value GetPreferredUsernameIRI returns, or an AccessorError if IsPreferredUsernameIRI returns false
+func (t *Announce) TryGetPreferredUsernameIRI() (v *url.URL, err error) {
+	if !t.IsPreferredUsernameIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetPreferredUsernameIRI", Index: -1}
+		return
+	}
+	return t.GetPreferredUsernameIRI(), nil
+
+}
+
+// TryGetUnknownPreferredUsername returns the value GetUnknownPreferredUsername returns, or an AccessorError if HasUnknownPreferredUsername returns false
+func (t *Announce) TryGetUnknownPreferredUsername() (v interface{}, err error) {
+	if !t.HasUnknownPreferredUsername() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetUnknownPreferredUsername(), nil
+
+}
+
+// TryGetEndpoints returns the value GetEndpoints returns, or an AccessorError if IsEndpoints returns false
+func (t *Announce) TryGetEndpoints() (v ObjectType, err error) {
+	if !t.IsEndpoints() {
+		err = &AccessorError{Type: "Announce", Getter: "GetEndpoints", Index: -1}
+		return
+	}
+	return t.GetEndpoints(), nil
+
+}
+
+// TryGetEndpointsIRI returns the value GetEndpointsIRI returns, or an AccessorError if IsEndpointsIRI returns false
+func (t *Announce) TryGetEndpointsIRI() (v *url.URL, err error) {
+	if !t.IsEndpointsIRI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetEndpointsIRI", Index: -1}
+		return
+	}
+	return t.GetEndpointsIRI(), nil
+
+}
+
+// TryGetUnknownEndpoints returns the value GetUnknownEndpoints returns, or an AccessorError if HasUnknownEndpoints returns false
+func (t *Announce) TryGetUnknownEndpoints() (v interface{}, err error) {
+	if !t.HasUnknownEndpoints() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownEndpoints", Index: -1}
+		return
+	}
+	return t.GetUnknownEndpoints(), nil
+
+}
+
+// TryGetProxyUrl returns the value GetProxyUrl returns, or an AccessorError if HasProxyUrl returns false
+func (t *Announce) TryGetProxyUrl() (v *url.URL, err error) {
+	if !t.HasProxyUrl() {
+		err = &AccessorError{Type: "Announce", Getter: "GetProxyUrl", Index: -1}
+		return
+	}
+	return t.GetProxyUrl(), nil
+
+}
+
+// TryGetUnknownProxyUrl returns the value GetUnknownProxyUrl returns, or an AccessorError if HasUnknownProxyUrl returns false
+func (t *Announce) TryGetUnknownProxyUrl() (v interface{}, err error) {
+	if !t.HasUnknownProxyUrl() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownProxyUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownProxyUrl(), nil
+
+}
+
+// TryGetOauthAuthorizationEndpoint returns the value GetOauthAuthorizationEndpoint returns, or an AccessorError if HasOauthAuthorizationEndpoint returns false
+func (t *Announce) TryGetOauthAuthorizationEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "Announce", Getter: "GetOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthAuthorizationEndpoint returns the value GetUnknownOauthAuthorizationEndpoint returns, or an AccessorError if HasUnknownOauthAuthorizationEndpoint returns false
+func (t *Announce) TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetOauthTokenEndpoint returns the value GetOauthTokenEndpoint returns, or an AccessorError if HasOauthTokenEndpoint returns false
+func (t *Announce) TryGetOauthTokenEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthTokenEndpoint() {
+		err = &AccessorError{Type: "Announce", Getter: "GetOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthTokenEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthTokenEndpoint returns the value GetUnknownOauthTokenEndpoint returns, or an AccessorError if HasUnknownOauthTokenEndpoint returns false
+func (t *Announce) TryGetUnknownOauthTokenEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthTokenEndpoint() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthTokenEndpoint(), nil
+
+}
+
+// TryGetProvideClientKey returns the value GetProvideClientKey returns, or an AccessorError if HasProvideClientKey returns false
+func (t *Announce) TryGetProvideClientKey() (v *url.URL, err error) {
+	if !t.HasProvideClientKey() {
+		err = &AccessorError{Type: "Announce", Getter: "GetProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetProvideClientKey(), nil
+
+}
+
+// TryGetUnknownProvideClientKey returns the value GetUnknownProvideClientKey returns, or an AccessorError if HasUnknownProvideClientKey returns false
+func (t *Announce) TryGetUnknownProvideClientKey() (v interface{}, err error) {
+	if !t.HasUnknownProvideClientKey() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownProvideClientKey(), nil
+
+}
+
+// TryGetSignClientKey returns the value GetSignClientKey returns, or an AccessorError if HasSignClientKey returns false
+func (t *Announce) TryGetSignClientKey() (v *url.URL, err error) {
+	if !t.HasSignClientKey() {
+		err = &AccessorError{Type: "Announce", Getter: "GetSignClientKey", Index: -1}
+		return
+	}
+	return t.GetSignClientKey(), nil
+
+}
+
+// TryGetUnknownSignClientKey returns the value GetUnknownSignClientKey returns, or an AccessorError if HasUnknownSignClientKey returns false
+func (t *Announce) TryGetUnknownSignClientKey() (v interface{}, err error) {
+	if !t.HasUnknownSignClientKey() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownSignClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownSignClientKey(), nil
+
+}
+
+// TryGetSharedInbox returns the value GetSharedInbox returns, or an AccessorError if HasSharedInbox returns false
+func (t *Announce) TryGetSharedInbox() (v *url.URL, err error) {
+	if !t.HasSharedInbox() {
+		err = &AccessorError{Type: "Announce", Getter: "GetSharedInbox", Index: -1}
+		return
+	}
+	return t.GetSharedInbox(), nil
+
+}
+
+// TryGetUnknownSharedInbox returns the value GetUnknownSharedInbox returns, or an AccessorError if HasUnknownSharedInbox returns false
+func (t *Announce) TryGetUnknownSharedInbox() (v interface{}, err error) {
+	if !t.HasUnknownSharedInbox() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownSharedInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownSharedInbox(), nil
+
+}
+
+// TryGetSharesCollection returns the value GetSharesCollection returns, or an AccessorError if IsSharesCollection returns false
+func (t *Announce) TryGetSharesCollection() (v CollectionType, err error) {
+	if !t.IsSharesCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetSharesCollection", Index: -1}
+		return
+	}
+	return t.GetSharesCollection(), nil
+
+}
+
+// TryGetSharesOrderedCollection returns the value GetSharesOrderedCollection returns, or an AccessorError if IsSharesOrderedCollection returns false
+func (t *Announce) TryGetSharesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsSharesOrderedCollection() {
+		err = &AccessorError{Type: "Announce", Getter: "GetSharesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetSharesOrderedCollection(), nil
+
+}
+
+// TryGetSharesAnyURI returns the value GetSharesAnyURI returns, or an AccessorError if IsSharesAnyURI returns false
+func (t *Announce) TryGetSharesAnyURI() (v *url.URL, err error) {
+	if !t.IsSharesAnyURI() {
+		err = &AccessorError{Type: "Announce", Getter: "GetSharesAnyURI", Index: -1}
+		return
+	}
+	return t.GetSharesAnyURI(), nil
+
+}
+
+// TryGetUnknownShares returns the value GetUnknownShares returns, or an AccessorError if HasUnknownShares returns false
+func (t *Announce) TryGetUnknownShares() (v interface{}, err error) {
+	if !t.HasUnknownShares() {
+		err = &AccessorError{Type: "Announce", Getter: "GetUnknownShares", Index: -1}
+		return
+	}
+	return t.GetUnknownShares(), nil
+
+}
+
+// WithActorObject calls AppendActorObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithActorObject(v ObjectType) *Announce {
+	t.AppendActorObject(v)
+	return t
+
+}
+
+// WithActorLink calls AppendActorLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithActorLink(v LinkType) *Announce {
+	t.AppendActorLink(v)
+	return t
+
+}
+
+// WithActorIRI calls AppendActorIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithActorIRI(v *url.URL) *Announce {
+	t.AppendActorIRI(v)
+	return t
+
+}
+
+// WithUnknownActor calls SetUnknownActor and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownActor(i interface{}) *Announce {
+	t.SetUnknownActor(i)
+	return t
+
+}
+
+// WithObject calls AppendObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithObject(v ObjectType) *Announce {
+	t.AppendObject(v)
+	return t
+
+}
+
+// WithObjectIRI calls AppendObjectIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithObjectIRI(v *url.URL) *Announce {
+	t.AppendObjectIRI(v)
+	return t
+
+}
+
+// WithUnknownObject calls SetUnknownObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownObject(i interface{}) *Announce {
+	t.SetUnknownObject(i)
+	return t
+
+}
+
+// WithTargetObject calls AppendTargetObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithTargetObject(v ObjectType) *Announce {
+	t.AppendTargetObject(v)
+	return t
+
+}
+
+// WithTargetLink calls AppendTargetLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithTargetLink(v LinkType) *Announce {
+	t.AppendTargetLink(v)
+	return t
+
+}
+
+// WithTargetIRI calls AppendTargetIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithTargetIRI(v *url.URL) *Announce {
+	t.AppendTargetIRI(v)
+	return t
+
+}
+
+// WithUnknownTarget calls SetUnknownTarget and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownTarget(i interface{}) *Announce {
+	t.SetUnknownTarget(i)
+	return t
+
+}
+
+// WithResultObject calls AppendResultObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithResultObject(v ObjectType) *Announce {
+	t.AppendResultObject(v)
+	return t
+
+}
+
+// WithResultLink calls AppendResultLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithResultLink(v LinkType) *Announce {
+	t.AppendResultLink(v)
+	return t
+
+}
+
+// WithResultIRI calls AppendResultIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithResultIRI(v *url.URL) *Announce {
+	t.AppendResultIRI(v)
+	return t
+
+}
+
+// WithUnknownResult calls SetUnknownResult and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownResult(i interface{}) *Announce {
+	t.SetUnknownResult(i)
+	return t
+
+}
+
+// WithOriginObject calls AppendOriginObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithOriginObject(v ObjectType) *Announce {
+	t.AppendOriginObject(v)
+	return t
+
+}
+
+// WithOriginLink calls AppendOriginLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithOriginLink(v LinkType) *Announce {
+	t.AppendOriginLink(v)
+	return t
+
+}
+
+// WithOriginIRI calls AppendOriginIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithOriginIRI(v *url.URL) *Announce {
+	t.AppendOriginIRI(v)
+	return t
+
+}
+
+// WithUnknownOrigin calls SetUnknownOrigin and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownOrigin(i interface{}) *Announce {
+	t.SetUnknownOrigin(i)
+	return t
+
+}
+
+// WithInstrumentObject calls AppendInstrumentObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithInstrumentObject(v ObjectType) *Announce {
+	t.AppendInstrumentObject(v)
+	return t
+
+}
+
+// WithInstrumentLink calls AppendInstrumentLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithInstrumentLink(v LinkType) *Announce {
+	t.AppendInstrumentLink(v)
+	return t
+
+}
+
+// WithInstrumentIRI calls AppendInstrumentIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithInstrumentIRI(v *url.URL) *Announce {
+	t.AppendInstrumentIRI(v)
+	return t
+
+}
+
+// WithUnknownInstrument calls SetUnknownInstrument and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownInstrument(i interface{}) *Announce {
+	t.SetUnknownInstrument(i)
+	return t
+
+}
+
+// WithAltitude calls SetAltitude and returns this Announce, so that calls can be chained
+func (t *Announce) WithAltitude(v float64) *Announce {
+	t.SetAltitude(v)
+	return t
+
+}
+
+// WithAltitudeIRI calls SetAltitudeIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithAltitudeIRI(v *url.URL) *Announce {
+	t.SetAltitudeIRI(v)
+	return t
+
+}
+
+// WithUnknownAltitude calls SetUnknownAltitude and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownAltitude(i interface{}) *Announce {
+	t.SetUnknownAltitude(i)
+	return t
+
+}
+
+// WithAttachmentObject calls AppendAttachmentObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithAttachmentObject(v ObjectType) *Announce {
+	t.AppendAttachmentObject(v)
+	return t
+
+}
+
+// WithAttachmentLink calls AppendAttachmentLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithAttachmentLink(v LinkType) *Announce {
+	t.AppendAttachmentLink(v)
+	return t
+
+}
+
+// WithAttachmentIRI calls AppendAttachmentIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithAttachmentIRI(v *url.URL) *Announce {
+	t.AppendAttachmentIRI(v)
+	return t
+
+}
+
+// WithUnknownAttachment calls SetUnknownAttachment and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownAttachment(i interface{}) *Announce {
+	t.SetUnknownAttachment(i)
+	return t
+
+}
+
+// WithAttributedToObject calls AppendAttributedToObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithAttributedToObject(v ObjectType) *Announce {
+	t.AppendAttributedToObject(v)
+	return t
+
+}
+
+// WithAttributedToLink calls AppendAttributedToLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithAttributedToLink(v LinkType) *Announce {
+	t.AppendAttributedToLink(v)
+	return t
+
+}
+
+// WithAttributedToIRI calls AppendAttributedToIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithAttributedToIRI(v *url.URL) *Announce {
+	t.AppendAttributedToIRI(v)
+	return t
+
+}
+
+// WithUnknownAttributedTo calls SetUnknownAttributedTo and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownAttributedTo(i interface{}) *Announce {
+	t.SetUnknownAttributedTo(i)
+	return t
+
+}
+
+// WithAudienceObject calls AppendAudienceObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithAudienceObject(v ObjectType) *Announce {
+	t.AppendAudienceObject(v)
+	return t
+
+}
+
+// WithAudienceLink calls AppendAudienceLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithAudienceLink(v LinkType) *Announce {
+	t.AppendAudienceLink(v)
+	return t
+
+}
+
+// WithAudienceIRI calls AppendAudienceIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithAudienceIRI(v *url.URL) *Announce {
+	t.AppendAudienceIRI(v)
+	return t
+
+}
+
+// WithUnknownAudience calls SetUnknownAudience and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownAudience(i interface{}) *Announce {
+	t.SetUnknownAudience(i)
+	return t
+
+}
+
+// WithContentString calls AppendContentString and returns this Announce, so that calls can be chained
+func (t *Announce) WithContentString(v string) *Announce {
+	t.AppendContentString(v)
+	return t
+
+}
+
+// WithContentLangString calls AppendContentLangString and returns this Announce, so that calls can be chained
+func (t *Announce) WithContentLangString(v string) *Announce {
+	t.AppendContentLangString(v)
+	return t
+
+}
+
+// WithContentIRI calls AppendContentIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithContentIRI(v *url.URL) *Announce {
+	t.AppendContentIRI(v)
+	return t
+
+}
+
+// WithUnknownContent calls SetUnknownContent and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownContent(i interface{}) *Announce {
+	t.SetUnknownContent(i)
+	return t
+
+}
+
+// WithContentMap calls SetContentMap and returns this Announce, so that calls can be chained
+func (t *Announce) WithContentMap(l string, v string) *Announce {
+	t.SetContentMap(l, v)
+	return t
+
+}
+
+// WithContentLanguage calls SetContentLanguage and returns this Announce, so that calls can be chained
+func (t *Announce) WithContentLanguage(tag string, v string) *Announce {
+	t.SetContentLanguage(tag, v)
+	return t
+
+}
+
+// WithContextObject calls AppendContextObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithContextObject(v ObjectType) *Announce {
+	t.AppendContextObject(v)
+	return t
+
+}
+
+// WithContextLink calls AppendContextLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithContextLink(v LinkType) *Announce {
+	t.AppendContextLink(v)
+	return t
+
+}
+
+// WithContextIRI calls AppendContextIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithContextIRI(v *url.URL) *Announce {
+	t.AppendContextIRI(v)
+	return t
+
+}
+
+// WithUnknownContext calls SetUnknownContext and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownContext(i interface{}) *Announce {
+	t.SetUnknownContext(i)
+	return t
+
+}
+
+// WithNameString calls AppendNameString and returns this Announce, so that calls can be chained
+func (t *Announce) WithNameString(v string) *Announce {
+	t.AppendNameString(v)
+	return t
+
+}
+
+// WithNameLangString calls AppendNameLangString and returns this Announce, so that calls can be chained
+func (t *Announce) WithNameLangString(v string) *Announce {
+	t.AppendNameLangString(v)
+	return t
+
+}
+
+// WithNameIRI calls AppendNameIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithNameIRI(v *url.URL) *Announce {
+	t.AppendNameIRI(v)
+	return t
+
+}
+
+// WithUnknownName calls SetUnknownName and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownName(i interface{}) *Announce {
+	t.SetUnknownName(i)
+	return t
+
+}
+
+// WithNameMap calls SetNameMap and returns this Announce, so that calls can be chained
+func (t *Announce) WithNameMap(l string, v string) *Announce {
+	t.SetNameMap(l, v)
+	return t
+
+}
+
+// WithNameLanguage calls SetNameLanguage and returns this Announce, so that calls can be chained
+func (t *Announce) WithNameLanguage(tag string, v string) *Announce {
+	t.SetNameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndTime calls SetEndTime and returns this Announce, so that calls can be chained
+func (t *Announce) WithEndTime(v time.Time) *Announce {
+	t.SetEndTime(v)
+	return t
+
+}
+
+// WithEndTimeIRI calls SetEndTimeIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithEndTimeIRI(v *url.URL) *Announce {
+	t.SetEndTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownEndTime calls SetUnknownEndTime and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownEndTime(i interface{}) *Announce {
+	t.SetUnknownEndTime(i)
+	return t
+
+}
+
+// WithGeneratorObject calls AppendGeneratorObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithGeneratorObject(v ObjectType) *Announce {
+	t.AppendGeneratorObject(v)
+	return t
+
+}
+
+// WithGeneratorLink calls AppendGeneratorLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithGeneratorLink(v LinkType) *Announce {
+	t.AppendGeneratorLink(v)
+	return t
+
+}
+
+// WithGeneratorIRI calls AppendGeneratorIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithGeneratorIRI(v *url.URL) *Announce {
+	t.AppendGeneratorIRI(v)
+	return t
+
+}
+
+// WithUnknownGenerator calls SetUnknownGenerator and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownGenerator(i interface{}) *Announce {
+	t.SetUnknownGenerator(i)
+	return t
+
+}
+
+// WithIconImage calls AppendIconImage and returns this Announce, so that calls can be chained
+func (t *Announce) WithIconImage(v ImageType) *Announce {
+	t.AppendIconImage(v)
+	return t
+
+}
+
+// WithIconLink calls AppendIconLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithIconLink(v LinkType) *Announce {
+	t.AppendIconLink(v)
+	return t
+
+}
+
+// WithIconIRI calls AppendIconIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithIconIRI(v *url.URL) *Announce {
+	t.AppendIconIRI(v)
+	return t
+
+}
+
+// WithUnknownIcon calls SetUnknownIcon and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownIcon(i interface{}) *Announce {
+	t.SetUnknownIcon(i)
+	return t
+
+}
+
+// WithId calls SetId and returns this Announce, so that calls can be chained
+func (t *Announce) WithId(v *url.URL) *Announce {
+	t.SetId(v)
+	return t
+
+}
+
+// WithUnknownId calls SetUnknownId and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownId(i interface{}) *Announce {
+	t.SetUnknownId(i)
+	return t
+
+}
+
+// WithImageImage calls AppendImageImage and returns this Announce, so that calls can be chained
+func (t *Announce) WithImageImage(v ImageType) *Announce {
+	t.AppendImageImage(v)
+	return t
+
+}
+
+// WithImageLink calls AppendImageLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithImageLink(v LinkType) *Announce {
+	t.AppendImageLink(v)
+	return t
+
+}
+
+// WithImageIRI calls AppendImageIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithImageIRI(v *url.URL) *Announce {
+	t.AppendImageIRI(v)
+	return t
+
+}
+
+// WithUnknownImage calls SetUnknownImage and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownImage(i interface{}) *Announce {
+	t.SetUnknownImage(i)
+	return t
+
+}
+
+// WithInReplyToObject calls AppendInReplyToObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithInReplyToObject(v ObjectType) *Announce {
+	t.AppendInReplyToObject(v)
+	return t
+
+}
+
+// WithInReplyToLink calls AppendInReplyToLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithInReplyToLink(v LinkType) *Announce {
+	t.AppendInReplyToLink(v)
+	return t
+
+}
+
+// WithInReplyToIRI calls AppendInReplyToIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithInReplyToIRI(v *url.URL) *Announce {
+	t.AppendInReplyToIRI(v)
+	return t
+
+}
+
+// WithUnknownInReplyTo calls SetUnknownInReplyTo and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownInReplyTo(i interface{}) *Announce {
+	t.SetUnknownInReplyTo(i)
+	return t
+
+}
+
+// WithLocationObject calls AppendLocationObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithLocationObject(v ObjectType) *Announce {
+	t.AppendLocationObject(v)
+	return t
+
+}
+
+// WithLocationLink calls AppendLocationLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithLocationLink(v LinkType) *Announce {
+	t.AppendLocationLink(v)
+	return t
+
+}
+
+// WithLocationIRI calls AppendLocationIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithLocationIRI(v *url.URL) *Announce {
+	t.AppendLocationIRI(v)
+	return t
+
+}
+
+// WithUnknownLocation calls SetUnknownLocation and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownLocation(i interface{}) *Announce {
+	t.SetUnknownLocation(i)
+	return t
+
+}
+
+// WithPreviewObject calls AppendPreviewObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithPreviewObject(v ObjectType) *Announce {
+	t.AppendPreviewObject(v)
+	return t
+
+}
+
+// WithPreviewLink calls AppendPreviewLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithPreviewLink(v LinkType) *Announce {
+	t.AppendPreviewLink(v)
+	return t
+
+}
+
+// WithPreviewIRI calls AppendPreviewIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithPreviewIRI(v *url.URL) *Announce {
+	t.AppendPreviewIRI(v)
+	return t
+
+}
+
+// WithUnknownPreview calls SetUnknownPreview and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownPreview(i interface{}) *Announce {
+	t.SetUnknownPreview(i)
+	return t
+
+}
+
+// WithPublished calls SetPublished and returns this Announce, so that calls can be chained
+func (t *Announce) WithPublished(v time.Time) *Announce {
+	t.SetPublished(v)
+	return t
+
+}
+
+// WithPublishedIRI calls SetPublishedIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithPublishedIRI(v *url.URL) *Announce {
+	t.SetPublishedIRI(v)
+	return t
+
+}
+
+// WithUnknownPublished calls SetUnknownPublished and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownPublished(i interface{}) *Announce {
+	t.SetUnknownPublished(i)
+	return t
+
+}
+
+// WithReplies calls SetReplies and returns this Announce, so that calls can be chained
+func (t *Announce) WithReplies(v CollectionType) *Announce {
+	t.SetReplies(v)
+	return t
+
+}
+
+// WithRepliesIRI calls SetRepliesIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithRepliesIRI(v *url.URL) *Announce {
+	t.SetRepliesIRI(v)
+	return t
+
+}
+
+// WithUnknownReplies calls SetUnknownReplies and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownReplies(i interface{}) *Announce {
+	t.SetUnknownReplies(i)
+	return t
+
+}
+
+// WithStartTime calls SetStartTime and returns this Announce, so that calls can be chained
+func (t *Announce) WithStartTime(v time.Time) *Announce {
+	t.SetStartTime(v)
+	return t
+
+}
+
+// WithStartTimeIRI calls SetStartTimeIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithStartTimeIRI(v *url.URL) *Announce {
+	t.SetStartTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownStartTime calls SetUnknownStartTime and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownStartTime(i interface{}) *Announce {
+	t.SetUnknownStartTime(i)
+	return t
+
+}
+
+// WithSummaryString calls AppendSummaryString and returns this Announce, so that calls can be chained
+func (t *Announce) WithSummaryString(v string) *Announce {
+	t.AppendSummaryString(v)
+	return t
+
+}
+
+// WithSummaryLangString calls AppendSummaryLangString and returns this Announce, so that calls can be chained
+func (t *Announce) WithSummaryLangString(v string) *Announce {
+	t.AppendSummaryLangString(v)
+	return t
+
+}
+
+// WithSummaryIRI calls AppendSummaryIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithSummaryIRI(v *url.URL) *Announce {
+	t.AppendSummaryIRI(v)
+	return t
+
+}
+
+// WithUnknownSummary calls SetUnknownSummary and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownSummary(i interface{}) *Announce {
+	t.SetUnknownSummary(i)
+	return t
+
+}
+
+// WithSummaryMap calls SetSummaryMap and returns this Announce, so that calls can be chained
+func (t *Announce) WithSummaryMap(l string, v string) *Announce {
+	t.SetSummaryMap(l, v)
+	return t
+
+}
+
+// WithSummaryLanguage calls SetSummaryLanguage and returns this Announce, so that calls can be chained
+func (t *Announce) WithSummaryLanguage(tag string, v string) *Announce {
+	t.SetSummaryLanguage(tag, v)
+	return t
+
+}
+
+// WithTagObject calls AppendTagObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithTagObject(v ObjectType) *Announce {
+	t.AppendTagObject(v)
+	return t
+
+}
+
+// WithTagLink calls AppendTagLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithTagLink(v LinkType) *Announce {
+	t.AppendTagLink(v)
+	return t
+
+}
+
+// WithTagIRI calls AppendTagIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithTagIRI(v *url.URL) *Announce {
+	t.AppendTagIRI(v)
+	return t
+
+}
+
+// WithUnknownTag calls SetUnknownTag and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownTag(i interface{}) *Announce {
+	t.SetUnknownTag(i)
+	return t
+
+}
+
+// WithType calls AppendType and returns this Announce, so that calls can be chained
+func (t *Announce) WithType(v interface{}) *Announce {
+	t.AppendType(v)
+	return t
+
+}
+
+// WithUpdated calls SetUpdated and returns this Announce, so that calls can be chained
+func (t *Announce) WithUpdated(v time.Time) *Announce {
+	t.SetUpdated(v)
+	return t
+
+}
+
+// WithUpdatedIRI calls SetUpdatedIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithUpdatedIRI(v *url.URL) *Announce {
+	t.SetUpdatedIRI(v)
+	return t
+
+}
+
+// WithUnknownUpdated calls SetUnknownUpdated and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownUpdated(i interface{}) *Announce {
+	t.SetUnknownUpdated(i)
+	return t
+
+}
+
+// WithUrlAnyURI calls AppendUrlAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithUrlAnyURI(v *url.URL) *Announce {
+	t.AppendUrlAnyURI(v)
+	return t
+
+}
+
+// WithUrlLink calls AppendUrlLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithUrlLink(v LinkType) *Announce {
+	t.AppendUrlLink(v)
+	return t
+
+}
+
+// WithUnknownUrl calls SetUnknownUrl and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownUrl(i interface{}) *Announce {
+	t.SetUnknownUrl(i)
+	return t
+
+}
+
+// WithToObject calls AppendToObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithToObject(v ObjectType) *Announce {
+	t.AppendToObject(v)
+	return t
+
+}
+
+// WithToLink calls AppendToLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithToLink(v LinkType) *Announce {
+	t.AppendToLink(v)
+	return t
+
+}
+
+// WithToIRI calls AppendToIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithToIRI(v *url.URL) *Announce {
+	t.AppendToIRI(v)
+	return t
+
+}
+
+// WithUnknownTo calls SetUnknownTo and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownTo(i interface{}) *Announce {
+	t.SetUnknownTo(i)
+	return t
+
+}
+
+// WithBtoObject calls AppendBtoObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithBtoObject(v ObjectType) *Announce {
+	t.AppendBtoObject(v)
+	return t
+
+}
+
+// WithBtoLink calls AppendBtoLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithBtoLink(v LinkType) *Announce {
+	t.AppendBtoLink(v)
+	return t
+
+}
+
+// WithBtoIRI calls AppendBtoIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithBtoIRI(v *url.URL) *Announce {
+	t.AppendBtoIRI(v)
+	return t
+
+}
+
+// WithUnknownBto calls SetUnknownBto and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownBto(i interface{}) *Announce {
+	t.SetUnknownBto(i)
+	return t
+
+}
+
+// WithCcObject calls AppendCcObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithCcObject(v ObjectType) *Announce {
+	t.AppendCcObject(v)
+	return t
+
+}
+
+// WithCcLink calls AppendCcLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithCcLink(v LinkType) *Announce {
+	t.AppendCcLink(v)
+	return t
+
+}
+
+// WithCcIRI calls AppendCcIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithCcIRI(v *url.URL) *Announce {
+	t.AppendCcIRI(v)
+	return t
+
+}
+
+// WithUnknownCc calls SetUnknownCc and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownCc(i interface{}) *Announce {
+	t.SetUnknownCc(i)
+	return t
+
+}
+
+// WithBccObject calls AppendBccObject and returns this Announce, so that calls can be chained
+func (t *Announce) WithBccObject(v ObjectType) *Announce {
+	t.AppendBccObject(v)
+	return t
+
+}
+
+// WithBccLink calls AppendBccLink and returns this Announce, so that calls can be chained
+func (t *Announce) WithBccLink(v LinkType) *Announce {
+	t.AppendBccLink(v)
+	return t
+
+}
+
+// WithBccIRI calls AppendBccIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithBccIRI(v *url.URL) *Announce {
+	t.AppendBccIRI(v)
+	return t
+
+}
+
+// WithUnknownBcc calls SetUnknownBcc and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownBcc(i interface{}) *Announce {
+	t.SetUnknownBcc(i)
+	return t
+
+}
+
+// WithMediaType calls SetMediaType and returns this Announce, so that calls can be chained
+func (t *Announce) WithMediaType(v string) *Announce {
+	t.SetMediaType(v)
+	return t
+
+}
+
+// WithMediaTypeIRI calls SetMediaTypeIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithMediaTypeIRI(v *url.URL) *Announce {
+	t.SetMediaTypeIRI(v)
+	return t
+
+}
+
+// WithUnknownMediaType calls SetUnknownMediaType and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownMediaType(i interface{}) *Announce {
+	t.SetUnknownMediaType(i)
+	return t
+
+}
+
+// WithDuration calls SetDuration and returns this Announce, so that calls can be chained
+func (t *Announce) WithDuration(v time.Duration) *Announce {
+	t.SetDuration(v)
+	return t
+
+}
+
+// WithDurationIRI calls SetDurationIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithDurationIRI(v *url.URL) *Announce {
+	t.SetDurationIRI(v)
+	return t
+
+}
+
+// WithUnknownDuration calls SetUnknownDuration and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownDuration(i interface{}) *Announce {
+	t.SetUnknownDuration(i)
+	return t
+
+}
+
+// WithSource calls SetSource and returns this Announce, so that calls can be chained
+func (t *Announce) WithSource(v ObjectType) *Announce {
+	t.SetSource(v)
+	return t
+
+}
+
+// WithSourceIRI calls SetSourceIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithSourceIRI(v *url.URL) *Announce {
+	t.SetSourceIRI(v)
+	return t
+
+}
+
+// WithUnknownSource calls SetUnknownSource and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownSource(i interface{}) *Announce {
+	t.SetUnknownSource(i)
+	return t
+
+}
+
+// WithInboxOrderedCollection calls SetInboxOrderedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithInboxOrderedCollection(v OrderedCollectionType) *Announce {
+	t.SetInboxOrderedCollection(v)
+	return t
+
+}
+
+// WithInboxAnyURI calls SetInboxAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithInboxAnyURI(v *url.URL) *Announce {
+	t.SetInboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownInbox calls SetUnknownInbox and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownInbox(i interface{}) *Announce {
+	t.SetUnknownInbox(i)
+	return t
+
+}
+
+// WithOutboxOrderedCollection calls SetOutboxOrderedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithOutboxOrderedCollection(v OrderedCollectionType) *Announce {
+	t.SetOutboxOrderedCollection(v)
+	return t
+
+}
+
+// WithOutboxAnyURI calls SetOutboxAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithOutboxAnyURI(v *url.URL) *Announce {
+	t.SetOutboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownOutbox calls SetUnknownOutbox and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownOutbox(i interface{}) *Announce {
+	t.SetUnknownOutbox(i)
+	return t
+
+}
+
+// WithFollowingCollection calls SetFollowingCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithFollowingCollection(v CollectionType) *Announce {
+	t.SetFollowingCollection(v)
+	return t
+
+}
+
+// WithFollowingOrderedCollection calls SetFollowingOrderedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithFollowingOrderedCollection(v OrderedCollectionType) *Announce {
+	t.SetFollowingOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowingAnyURI calls SetFollowingAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithFollowingAnyURI(v *url.URL) *Announce {
+	t.SetFollowingAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowing calls SetUnknownFollowing and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownFollowing(i interface{}) *Announce {
+	t.SetUnknownFollowing(i)
+	return t
+
+}
+
+// WithFollowersCollection calls SetFollowersCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithFollowersCollection(v CollectionType) *Announce {
+	t.SetFollowersCollection(v)
+	return t
+
+}
+
+// WithFollowersOrderedCollection calls SetFollowersOrderedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithFollowersOrderedCollection(v OrderedCollectionType) *Announce {
+	t.SetFollowersOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowersAnyURI calls SetFollowersAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithFollowersAnyURI(v *url.URL) *Announce {
+	t.SetFollowersAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowers calls SetUnknownFollowers and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownFollowers(i interface{}) *Announce {
+	t.SetUnknownFollowers(i)
+	return t
+
+}
+
+// WithLikedCollection calls SetLikedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithLikedCollection(v CollectionType) *Announce {
+	t.SetLikedCollection(v)
+	return t
+
+}
+
+// WithLikedOrderedCollection calls SetLikedOrderedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithLikedOrderedCollection(v OrderedCollectionType) *Announce {
+	t.SetLikedOrderedCollection(v)
+	return t
+
+}
+
+// WithLikedAnyURI calls SetLikedAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithLikedAnyURI(v *url.URL) *Announce {
+	t.SetLikedAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLiked calls SetUnknownLiked and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownLiked(i interface{}) *Announce {
+	t.SetUnknownLiked(i)
+	return t
+
+}
+
+// WithLikesCollection calls SetLikesCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithLikesCollection(v CollectionType) *Announce {
+	t.SetLikesCollection(v)
+	return t
+
+}
+
+// WithLikesOrderedCollection calls SetLikesOrderedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithLikesOrderedCollection(v OrderedCollectionType) *Announce {
+	t.SetLikesOrderedCollection(v)
+	return t
+
+}
+
+// WithLikesAnyURI calls SetLikesAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithLikesAnyURI(v *url.URL) *Announce {
+	t.SetLikesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLikes calls SetUnknownLikes and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownLikes(i interface{}) *Announce {
+	t.SetUnknownLikes(i)
+	return t
+
+}
+
+// WithStreams calls AppendStreams and returns this Announce, so that calls can be chained
+func (t *Announce) WithStreams(v *url.URL) *Announce {
+	t.AppendStreams(v)
+	return t
+
+}
+
+// WithUnknownStreams calls SetUnknownStreams and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownStreams(i interface{}) *Announce {
+	t.SetUnknownStreams(i)
+	return t
+
+}
+
+// WithPreferredUsername calls SetPreferredUsername and returns this Announce, so that calls can be chained
+func (t *Announce) WithPreferredUsername(v string) *Announce {
+	t.SetPreferredUsername(v)
+	return t
+
+}
+
+// WithPreferredUsernameIRI calls SetPreferredUsernameIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithPreferredUsernameIRI(v *url.URL) *Announce {
+	t.SetPreferredUsernameIRI(v)
+	return t
+
+}
+
+// WithUnknownPreferredUsername calls SetUnknownPreferredUsername and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownPreferredUsername(i interface{}) *Announce {
+	t.SetUnknownPreferredUsername(i)
+	return t
+
+}
+
+// WithPreferredUsernameMap calls SetPreferredUsernameMap and returns this Announce, so that calls can be chained
+func (t *Announce) WithPreferredUsernameMap(l string, v string) *Announce {
+	t.SetPreferredUsernameMap(l, v)
+	return t
+
+}
+
+// WithPreferredUsernameLanguage calls SetPreferredUsernameLanguage and returns this Announce, so that calls can be chained
+func (t *Announce) WithPreferredUsernameLanguage(tag string, v string) *Announce {
+	t.SetPreferredUsernameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndpoints calls SetEndpoints and returns this Announce, so that calls can be chained
+func (t *Announce) WithEndpoints(v ObjectType) *Announce {
+	t.SetEndpoints(v)
+	return t
+
+}
+
+// WithEndpointsIRI calls SetEndpointsIRI and returns this Announce, so that calls can be chained
+func (t *Announce) WithEndpointsIRI(v *url.URL) *Announce {
+	t.SetEndpointsIRI(v)
+	return t
+
+}
+
+// WithUnknownEndpoints calls SetUnknownEndpoints and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownEndpoints(i interface{}) *Announce {
+	t.SetUnknownEndpoints(i)
+	return t
+
+}
+
+// WithProxyUrl calls SetProxyUrl and returns this Announce, so that calls can be chained
+func (t *Announce) WithProxyUrl(v *url.URL) *Announce {
+	t.SetProxyUrl(v)
+	return t
+
+}
+
+// WithUnknownProxyUrl calls SetUnknownProxyUrl and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownProxyUrl(i interface{}) *Announce {
+	t.SetUnknownProxyUrl(i)
+	return t
+
+}
+
+// WithOauthAuthorizationEndpoint calls SetOauthAuthorizationEndpoint and returns this Announce, so that calls can be chained
+func (t *Announce) WithOauthAuthorizationEndpoint(v *url.URL) *Announce {
+	t.SetOauthAuthorizationEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthAuthorizationEndpoint calls SetUnknownOauthAuthorizationEndpoint and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownOauthAuthorizationEndpoint(i interface{}) *Announce {
+	t.SetUnknownOauthAuthorizationEndpoint(i)
+	return t
+
+}
+
+// WithOauthTokenEndpoint calls SetOauthTokenEndpoint and returns this Announce, so that calls can be chained
+func (t *Announce) WithOauthTokenEndpoint(v *url.URL) *Announce {
+	t.SetOauthTokenEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthTokenEndpoint calls SetUnknownOauthTokenEndpoint and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownOauthTokenEndpoint(i interface{}) *Announce {
+	t.SetUnknownOauthTokenEndpoint(i)
+	return t
+
+}
+
+// WithProvideClientKey calls SetProvideClientKey and returns this Announce, so that calls can be chained
+func (t *Announce) WithProvideClientKey(v *url.URL) *Announce {
+	t.SetProvideClientKey(v)
+	return t
+
+}
+
+// WithUnknownProvideClientKey calls SetUnknownProvideClientKey and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownProvideClientKey(i interface{}) *Announce {
+	t.SetUnknownProvideClientKey(i)
+	return t
+
+}
+
+// WithSignClientKey calls SetSignClientKey and returns this Announce, so that calls can be chained
+func (t *Announce) WithSignClientKey(v *url.URL) *Announce {
+	t.SetSignClientKey(v)
+	return t
+
+}
+
+// WithUnknownSignClientKey calls SetUnknownSignClientKey and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownSignClientKey(i interface{}) *Announce {
+	t.SetUnknownSignClientKey(i)
+	return t
+
+}
+
+// WithSharedInbox calls SetSharedInbox and returns this Announce, so that calls can be chained
+func (t *Announce) WithSharedInbox(v *url.URL) *Announce {
+	t.SetSharedInbox(v)
+	return t
+
+}
+
+// WithUnknownSharedInbox calls SetUnknownSharedInbox and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownSharedInbox(i interface{}) *Announce {
+	t.SetUnknownSharedInbox(i)
+	return t
+
+}
+
+// WithSharesCollection calls SetSharesCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithSharesCollection(v CollectionType) *Announce {
+	t.SetSharesCollection(v)
+	return t
+
+}
+
+// WithSharesOrderedCollection calls SetSharesOrderedCollection and returns this Announce, so that calls can be chained
+func (t *Announce) WithSharesOrderedCollection(v OrderedCollectionType) *Announce {
+	t.SetSharesOrderedCollection(v)
+	return t
+
+}
+
+// WithSharesAnyURI calls SetSharesAnyURI and returns this Announce, so that calls can be chained
+func (t *Announce) WithSharesAnyURI(v *url.URL) *Announce {
+	t.SetSharesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownShares calls SetUnknownShares and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownShares(i interface{}) *Announce {
+	t.SetUnknownShares(i)
+	return t
+
+}
+
+// WithUnknownProperty calls SetUnknownProperty and returns this Announce, so that calls can be chained
+func (t *Announce) WithUnknownProperty(k string, i interface{}) *Announce {
+	t.SetUnknownProperty(k, i)
+	return t
+
+}
+
+// markPresent_ sets or clears the bit of a member in the presence bitset.
+func (t *Announce) markPresent_(i uint, set bool) {
+	if set {
+		t.present_[i/64] |= 1 << (i % 64)
+	} else {
+		t.present_[i/64] &^= 1 << (i % 64)
+	}
+
+}
+
+// markAllPresent_ sets the bits of every member in the presence bitset that is set, and clears the others.
+func (t *Announce) markAllPresent_() {
+	t.markPresent_(0, t.actor != nil)
+	t.markPresent_(1, t.object != nil)
+	t.markPresent_(2, t.target != nil)
+	t.markPresent_(3, t.result != nil)
+	t.markPresent_(4, t.origin != nil)
+	t.markPresent_(5, t.instrument != nil)
+	t.markPresent_(6, t.altitude != nil)
+	t.markPresent_(7, t.attachment != nil)
+	t.markPresent_(8, t.attributedTo != nil)
+	t.markPresent_(9, t.audience != nil)
+	t.markPresent_(10, t.content != nil)
+	t.markPresent_(11, t.contentMap != nil)
+	t.markPresent_(12, t.context != nil)
+	t.markPresent_(13, t.name != nil)
+	t.markPresent_(14, t.nameMap != nil)
+	t.markPresent_(15, t.endTime != nil)
+	t.markPresent_(16, t.generator != nil)
+	t.markPresent_(17, t.icon != nil)
+	t.markPresent_(18, t.id != nil)
+	t.markPresent_(19, t.image != nil)
+	t.markPresent_(20, t.inReplyTo != nil)
+	t.markPresent_(21, t.location != nil)
+	t.markPresent_(22, t.preview != nil)
+	t.markPresent_(23, t.published != nil)
+	t.markPresent_(24, t.replies != nil)
+	t.markPresent_(25, t.startTime != nil)
+	t.markPresent_(26, t.summary != nil)
+	t.markPresent_(27, t.summaryMap != nil)
+	t.markPresent_(28, t.tag != nil)
+	t.markPresent_(29, t.typeName != nil)
+	t.markPresent_(30, t.updated != nil)
+	t.markPresent_(31, t.url != nil)
+	t.markPresent_(32, t.to != nil)
+	t.markPresent_(33, t.bto != nil)
+	t.markPresent_(34, t.cc != nil)
+	t.markPresent_(35, t.bcc != nil)
+	t.markPresent_(36, t.mediaType != nil)
+	t.markPresent_(37, t.duration != nil)
+	t.markPresent_(38, t.source != nil)
+	t.markPresent_(39, t.inbox != nil)
+	t.markPresent_(40, t.outbox != nil)
+	t.markPresent_(41, t.following != nil)
+	t.markPresent_(42, t.followers != nil)
+	t.markPresent_(43, t.liked != nil)
+	t.markPresent_(44, t.likes != nil)
+	t.markPresent_(45, t.streams != nil)
+	t.markPresent_(46, t.preferredUsername != nil)
+	t.markPresent_(47, t.preferredUsernameMap != nil)
+	t.markPresent_(48, t.endpoints != nil)
+	t.markPresent_(49, t.proxyUrl != nil)
+	t.markPresent_(50, t.oauthAuthorizationEndpoint != nil)
+	t.markPresent_(51, t.oauthTokenEndpoint != nil)
+	t.markPresent_(52, t.provideClientKey != nil)
+	t.markPresent_(53, t.signClientKey != nil)
+	t.markPresent_(54, t.sharedInbox != nil)
+	t.markPresent_(55, t.shares != nil)
+
+}
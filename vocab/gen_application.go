@@ -2,7 +2,10 @@
 package vocab
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"sort"
 	"time"
 )
 
@@ -25,16 +28,19 @@ type ApplicationType interface {
 	AppendAttachmentObject(v ObjectType)
 	PrependAttachmentObject(v ObjectType)
 	RemoveAttachmentObject(index int)
+	AttachmentObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAttachmentLink(index int) (ok bool)
 	GetAttachmentLink(index int) (v LinkType)
 	AppendAttachmentLink(v LinkType)
 	PrependAttachmentLink(v LinkType)
 	RemoveAttachmentLink(index int)
+	AttachmentLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAttachmentIRI(index int) (ok bool)
 	GetAttachmentIRI(index int) (v *url.URL)
 	AppendAttachmentIRI(v *url.URL)
 	PrependAttachmentIRI(v *url.URL)
 	RemoveAttachmentIRI(index int)
+	AttachmentIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAttachment() (ok bool)
 	GetUnknownAttachment() (v interface{})
 	SetUnknownAttachment(i interface{})
@@ -44,16 +50,19 @@ type ApplicationType interface {
 	AppendAttributedToObject(v ObjectType)
 	PrependAttributedToObject(v ObjectType)
 	RemoveAttributedToObject(index int)
+	AttributedToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAttributedToLink(index int) (ok bool)
 	GetAttributedToLink(index int) (v LinkType)
 	AppendAttributedToLink(v LinkType)
 	PrependAttributedToLink(v LinkType)
 	RemoveAttributedToLink(index int)
+	AttributedToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAttributedToIRI(index int) (ok bool)
 	GetAttributedToIRI(index int) (v *url.URL)
 	AppendAttributedToIRI(v *url.URL)
 	PrependAttributedToIRI(v *url.URL)
 	RemoveAttributedToIRI(index int)
+	AttributedToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAttributedTo() (ok bool)
 	GetUnknownAttributedTo() (v interface{})
 	SetUnknownAttributedTo(i interface{})
@@ -63,16 +72,19 @@ type ApplicationType interface {
 	AppendAudienceObject(v ObjectType)
 	PrependAudienceObject(v ObjectType)
 	RemoveAudienceObject(index int)
+	AudienceObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAudienceLink(index int) (ok bool)
 	GetAudienceLink(index int) (v LinkType)
 	AppendAudienceLink(v LinkType)
 	PrependAudienceLink(v LinkType)
 	RemoveAudienceLink(index int)
+	AudienceLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAudienceIRI(index int) (ok bool)
 	GetAudienceIRI(index int) (v *url.URL)
 	AppendAudienceIRI(v *url.URL)
 	PrependAudienceIRI(v *url.URL)
 	RemoveAudienceIRI(index int)
+	AudienceIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAudience() (ok bool)
 	GetUnknownAudience() (v interface{})
 	SetUnknownAudience(i interface{})
@@ -82,38 +94,47 @@ type ApplicationType interface {
 	AppendContentString(v string)
 	PrependContentString(v string)
 	RemoveContentString(index int)
+	ContentStringValues() (seq func(yield func(v string) bool))
 	IsContentLangString(index int) (ok bool)
 	GetContentLangString(index int) (v string)
 	AppendContentLangString(v string)
 	PrependContentLangString(v string)
 	RemoveContentLangString(index int)
+	ContentLangStringValues() (seq func(yield func(v string) bool))
 	IsContentIRI(index int) (ok bool)
 	GetContentIRI(index int) (v *url.URL)
 	AppendContentIRI(v *url.URL)
 	PrependContentIRI(v *url.URL)
 	RemoveContentIRI(index int)
+	ContentIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownContent() (ok bool)
 	GetUnknownContent() (v interface{})
 	SetUnknownContent(i interface{})
 	ContentMapLanguages() (l []string)
 	GetContentMap(l string) (v string)
 	SetContentMap(l string, v string)
+	GetContentLanguage(tag string) (v string, ok bool)
+	SetContentLanguage(tag string, v string)
+	PreferredContentLanguage(tags ...string) (v string, tag string)
 	ContextLen() (l int)
 	IsContextObject(index int) (ok bool)
 	GetContextObject(index int) (v ObjectType)
 	AppendContextObject(v ObjectType)
 	PrependContextObject(v ObjectType)
 	RemoveContextObject(index int)
+	ContextObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsContextLink(index int) (ok bool)
 	GetContextLink(index int) (v LinkType)
 	AppendContextLink(v LinkType)
 	PrependContextLink(v LinkType)
 	RemoveContextLink(index int)
+	ContextLinkValues() (seq func(yield func(v LinkType) bool))
 	IsContextIRI(index int) (ok bool)
 	GetContextIRI(index int) (v *url.URL)
 	AppendContextIRI(v *url.URL)
 	PrependContextIRI(v *url.URL)
 	RemoveContextIRI(index int)
+	ContextIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownContext() (ok bool)
 	GetUnknownContext() (v interface{})
 	SetUnknownContext(i interface{})
@@ -123,22 +144,28 @@ type ApplicationType interface {
 	AppendNameString(v string)
 	PrependNameString(v string)
 	RemoveNameString(index int)
+	NameStringValues() (seq func(yield func(v string) bool))
 	IsNameLangString(index int) (ok bool)
 	GetNameLangString(index int) (v string)
 	AppendNameLangString(v string)
 	PrependNameLangString(v string)
 	RemoveNameLangString(index int)
+	NameLangStringValues() (seq func(yield func(v string) bool))
 	IsNameIRI(index int) (ok bool)
 	GetNameIRI(index int) (v *url.URL)
 	AppendNameIRI(v *url.URL)
 	PrependNameIRI(v *url.URL)
 	RemoveNameIRI(index int)
+	NameIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownName() (ok bool)
 	GetUnknownName() (v interface{})
 	SetUnknownName(i interface{})
 	NameMapLanguages() (l []string)
 	GetNameMap(l string) (v string)
 	SetNameMap(l string, v string)
+	GetNameLanguage(tag string) (v string, ok bool)
+	SetNameLanguage(tag string, v string)
+	PreferredNameLanguage(tags ...string) (v string, tag string)
 	IsEndTime() (ok bool)
 	GetEndTime() (v time.Time)
 	SetEndTime(v time.Time)
@@ -154,16 +181,19 @@ type ApplicationType interface {
 	AppendGeneratorObject(v ObjectType)
 	PrependGeneratorObject(v ObjectType)
 	RemoveGeneratorObject(index int)
+	GeneratorObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsGeneratorLink(index int) (ok bool)
 	GetGeneratorLink(index int) (v LinkType)
 	AppendGeneratorLink(v LinkType)
 	PrependGeneratorLink(v LinkType)
 	RemoveGeneratorLink(index int)
+	GeneratorLinkValues() (seq func(yield func(v LinkType) bool))
 	IsGeneratorIRI(index int) (ok bool)
 	GetGeneratorIRI(index int) (v *url.URL)
 	AppendGeneratorIRI(v *url.URL)
 	PrependGeneratorIRI(v *url.URL)
 	RemoveGeneratorIRI(index int)
+	GeneratorIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownGenerator() (ok bool)
 	GetUnknownGenerator() (v interface{})
 	SetUnknownGenerator(i interface{})
@@ -173,16 +203,19 @@ type ApplicationType interface {
 	AppendIconImage(v ImageType)
 	PrependIconImage(v ImageType)
 	RemoveIconImage(index int)
+	IconImageValues() (seq func(yield func(v ImageType) bool))
 	IsIconLink(index int) (ok bool)
 	GetIconLink(index int) (v LinkType)
 	AppendIconLink(v LinkType)
 	PrependIconLink(v LinkType)
 	RemoveIconLink(index int)
+	IconLinkValues() (seq func(yield func(v LinkType) bool))
 	IsIconIRI(index int) (ok bool)
 	GetIconIRI(index int) (v *url.URL)
 	AppendIconIRI(v *url.URL)
 	PrependIconIRI(v *url.URL)
 	RemoveIconIRI(index int)
+	IconIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownIcon() (ok bool)
 	GetUnknownIcon() (v interface{})
 	SetUnknownIcon(i interface{})
@@ -198,16 +231,19 @@ type ApplicationType interface {
 	AppendImageImage(v ImageType)
 	PrependImageImage(v ImageType)
 	RemoveImageImage(index int)
+	ImageImageValues() (seq func(yield func(v ImageType) bool))
 	IsImageLink(index int) (ok bool)
 	GetImageLink(index int) (v LinkType)
 	AppendImageLink(v LinkType)
 	PrependImageLink(v LinkType)
 	RemoveImageLink(index int)
+	ImageLinkValues() (seq func(yield func(v LinkType) bool))
 	IsImageIRI(index int) (ok bool)
 	GetImageIRI(index int) (v *url.URL)
 	AppendImageIRI(v *url.URL)
 	PrependImageIRI(v *url.URL)
 	RemoveImageIRI(index int)
+	ImageIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownImage() (ok bool)
 	GetUnknownImage() (v interface{})
 	SetUnknownImage(i interface{})
@@ -217,16 +253,19 @@ type ApplicationType interface {
 	AppendInReplyToObject(v ObjectType)
 	PrependInReplyToObject(v ObjectType)
 	RemoveInReplyToObject(index int)
+	InReplyToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsInReplyToLink(index int) (ok bool)
 	GetInReplyToLink(index int) (v LinkType)
 	AppendInReplyToLink(v LinkType)
 	PrependInReplyToLink(v LinkType)
 	RemoveInReplyToLink(index int)
+	InReplyToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsInReplyToIRI(index int) (ok bool)
 	GetInReplyToIRI(index int) (v *url.URL)
 	AppendInReplyToIRI(v *url.URL)
 	PrependInReplyToIRI(v *url.URL)
 	RemoveInReplyToIRI(index int)
+	InReplyToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownInReplyTo() (ok bool)
 	GetUnknownInReplyTo() (v interface{})
 	SetUnknownInReplyTo(i interface{})
@@ -236,16 +275,19 @@ type ApplicationType interface {
 	AppendLocationObject(v ObjectType)
 	PrependLocationObject(v ObjectType)
 	RemoveLocationObject(index int)
+	LocationObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsLocationLink(index int) (ok bool)
 	GetLocationLink(index int) (v LinkType)
 	AppendLocationLink(v LinkType)
 	PrependLocationLink(v LinkType)
 	RemoveLocationLink(index int)
+	LocationLinkValues() (seq func(yield func(v LinkType) bool))
 	IsLocationIRI(index int) (ok bool)
 	GetLocationIRI(index int) (v *url.URL)
 	AppendLocationIRI(v *url.URL)
 	PrependLocationIRI(v *url.URL)
 	RemoveLocationIRI(index int)
+	LocationIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownLocation() (ok bool)
 	GetUnknownLocation() (v interface{})
 	SetUnknownLocation(i interface{})
@@ -255,16 +297,19 @@ type ApplicationType interface {
 	AppendPreviewObject(v ObjectType)
 	PrependPreviewObject(v ObjectType)
 	RemovePreviewObject(index int)
+	PreviewObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsPreviewLink(index int) (ok bool)
 	GetPreviewLink(index int) (v LinkType)
 	AppendPreviewLink(v LinkType)
 	PrependPreviewLink(v LinkType)
 	RemovePreviewLink(index int)
+	PreviewLinkValues() (seq func(yield func(v LinkType) bool))
 	IsPreviewIRI(index int) (ok bool)
 	GetPreviewIRI(index int) (v *url.URL)
 	AppendPreviewIRI(v *url.URL)
 	PrependPreviewIRI(v *url.URL)
 	RemovePreviewIRI(index int)
+	PreviewIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownPreview() (ok bool)
 	GetUnknownPreview() (v interface{})
 	SetUnknownPreview(i interface{})
@@ -301,38 +346,47 @@ type ApplicationType interface {
 	AppendSummaryString(v string)
 	PrependSummaryString(v string)
 	RemoveSummaryString(index int)
+	SummaryStringValues() (seq func(yield func(v string) bool))
 	IsSummaryLangString(index int) (ok bool)
 	GetSummaryLangString(index int) (v string)
 	AppendSummaryLangString(v string)
 	PrependSummaryLangString(v string)
 	RemoveSummaryLangString(index int)
+	SummaryLangStringValues() (seq func(yield func(v string) bool))
 	IsSummaryIRI(index int) (ok bool)
 	GetSummaryIRI(index int) (v *url.URL)
 	AppendSummaryIRI(v *url.URL)
 	PrependSummaryIRI(v *url.URL)
 	RemoveSummaryIRI(index int)
+	SummaryIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownSummary() (ok bool)
 	GetUnknownSummary() (v interface{})
 	SetUnknownSummary(i interface{})
 	SummaryMapLanguages() (l []string)
 	GetSummaryMap(l string) (v string)
 	SetSummaryMap(l string, v string)
+	GetSummaryLanguage(tag string) (v string, ok bool)
+	SetSummaryLanguage(tag string, v string)
+	PreferredSummaryLanguage(tags ...string) (v string, tag string)
 	TagLen() (l int)
 	IsTagObject(index int) (ok bool)
 	GetTagObject(index int) (v ObjectType)
 	AppendTagObject(v ObjectType)
 	PrependTagObject(v ObjectType)
 	RemoveTagObject(index int)
+	TagObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsTagLink(index int) (ok bool)
 	GetTagLink(index int) (v LinkType)
 	AppendTagLink(v LinkType)
 	PrependTagLink(v LinkType)
 	RemoveTagLink(index int)
+	TagLinkValues() (seq func(yield func(v LinkType) bool))
 	IsTagIRI(index int) (ok bool)
 	GetTagIRI(index int) (v *url.URL)
 	AppendTagIRI(v *url.URL)
 	PrependTagIRI(v *url.URL)
 	RemoveTagIRI(index int)
+	TagIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownTag() (ok bool)
 	GetUnknownTag() (v interface{})
 	SetUnknownTag(i interface{})
@@ -356,11 +410,13 @@ type ApplicationType interface {
 	AppendUrlAnyURI(v *url.URL)
 	PrependUrlAnyURI(v *url.URL)
 	RemoveUrlAnyURI(index int)
+	UrlAnyURIValues() (seq func(yield func(v *url.URL) bool))
 	IsUrlLink(index int) (ok bool)
 	GetUrlLink(index int) (v LinkType)
 	AppendUrlLink(v LinkType)
 	PrependUrlLink(v LinkType)
 	RemoveUrlLink(index int)
+	UrlLinkValues() (seq func(yield func(v LinkType) bool))
 	HasUnknownUrl() (ok bool)
 	GetUnknownUrl() (v interface{})
 	SetUnknownUrl(i interface{})
@@ -370,16 +426,19 @@ type ApplicationType interface {
 	AppendToObject(v ObjectType)
 	PrependToObject(v ObjectType)
 	RemoveToObject(index int)
+	ToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsToLink(index int) (ok bool)
 	GetToLink(index int) (v LinkType)
 	AppendToLink(v LinkType)
 	PrependToLink(v LinkType)
 	RemoveToLink(index int)
+	ToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsToIRI(index int) (ok bool)
 	GetToIRI(index int) (v *url.URL)
 	AppendToIRI(v *url.URL)
 	PrependToIRI(v *url.URL)
 	RemoveToIRI(index int)
+	ToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownTo() (ok bool)
 	GetUnknownTo() (v interface{})
 	SetUnknownTo(i interface{})
@@ -389,16 +448,19 @@ type ApplicationType interface {
 	AppendBtoObject(v ObjectType)
 	PrependBtoObject(v ObjectType)
 	RemoveBtoObject(index int)
+	BtoObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsBtoLink(index int) (ok bool)
 	GetBtoLink(index int) (v LinkType)
 	AppendBtoLink(v LinkType)
 	PrependBtoLink(v LinkType)
 	RemoveBtoLink(index int)
+	BtoLinkValues() (seq func(yield func(v LinkType) bool))
 	IsBtoIRI(index int) (ok bool)
 	GetBtoIRI(index int) (v *url.URL)
 	AppendBtoIRI(v *url.URL)
 	PrependBtoIRI(v *url.URL)
 	RemoveBtoIRI(index int)
+	BtoIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownBto() (ok bool)
 	GetUnknownBto() (v interface{})
 	SetUnknownBto(i interface{})
@@ -408,16 +470,19 @@ type ApplicationType interface {
 	AppendCcObject(v ObjectType)
 	PrependCcObject(v ObjectType)
 	RemoveCcObject(index int)
+	CcObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsCcLink(index int) (ok bool)
 	GetCcLink(index int) (v LinkType)
 	AppendCcLink(v LinkType)
 	PrependCcLink(v LinkType)
 	RemoveCcLink(index int)
+	CcLinkValues() (seq func(yield func(v LinkType) bool))
 	IsCcIRI(index int) (ok bool)
 	GetCcIRI(index int) (v *url.URL)
 	AppendCcIRI(v *url.URL)
 	PrependCcIRI(v *url.URL)
 	RemoveCcIRI(index int)
+	CcIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownCc() (ok bool)
 	GetUnknownCc() (v interface{})
 	SetUnknownCc(i interface{})
@@ -427,16 +492,19 @@ type ApplicationType interface {
 	AppendBccObject(v ObjectType)
 	PrependBccObject(v ObjectType)
 	RemoveBccObject(index int)
+	BccObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsBccLink(index int) (ok bool)
 	GetBccLink(index int) (v LinkType)
 	AppendBccLink(v LinkType)
 	PrependBccLink(v LinkType)
 	RemoveBccLink(index int)
+	BccLinkValues() (seq func(yield func(v LinkType) bool))
 	IsBccIRI(index int) (ok bool)
 	GetBccIRI(index int) (v *url.URL)
 	AppendBccIRI(v *url.URL)
 	PrependBccIRI(v *url.URL)
 	RemoveBccIRI(index int)
+	BccIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownBcc() (ok bool)
 	GetUnknownBcc() (v interface{})
 	SetUnknownBcc(i interface{})
@@ -541,6 +609,7 @@ type ApplicationType interface {
 	HasUnknownStreams() (ok bool)
 	GetUnknownStreams() (v interface{})
 	SetUnknownStreams(i interface{})
+	StreamsValues() (seq func(yield func(v *url.URL) bool))
 	IsPreferredUsername() (ok bool)
 	GetPreferredUsername() (v string)
 	SetPreferredUsername(v string)
@@ -553,6 +622,9 @@ type ApplicationType interface {
 	PreferredUsernameMapLanguages() (l []string)
 	GetPreferredUsernameMap(l string) (v string)
 	SetPreferredUsernameMap(l string, v string)
+	GetPreferredUsernameLanguage(tag string) (v string, ok bool)
+	SetPreferredUsernameLanguage(tag string, v string)
+	PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string)
 	IsEndpoints() (ok bool)
 	GetEndpoints() (v ObjectType)
 	SetEndpoints(v ObjectType)
@@ -611,12 +683,169 @@ type ApplicationType interface {
 	GetUnknownShares() (v interface{})
 	SetUnknownShares(i interface{})
 	IsPublic() (b bool)
-}
+	Validate() (err error)
+	ValidateStrict() (err error)
+	TryGetAltitude() (v float64, err error)
+	TryGetAltitudeIRI() (v *url.URL, err error)
+	TryGetUnknownAltitude() (v interface{}, err error)
+	TryGetAttachmentObject(index int) (v ObjectType, err error)
+	TryGetAttachmentLink(index int) (v LinkType, err error)
+	TryGetAttachmentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttachment() (v interface{}, err error)
+	TryGetAttributedToObject(index int) (v ObjectType, err error)
+	TryGetAttributedToLink(index int) (v LinkType, err error)
+	TryGetAttributedToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttributedTo() (v interface{}, err error)
+	TryGetAudienceObject(index int) (v ObjectType, err error)
+	TryGetAudienceLink(index int) (v LinkType, err error)
+	TryGetAudienceIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAudience() (v interface{}, err error)
+	TryGetContentString(index int) (v string, err error)
+	TryGetContentLangString(index int) (v string, err error)
+	TryGetContentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContent() (v interface{}, err error)
+	TryGetContextObject(index int) (v ObjectType, err error)
+	TryGetContextLink(index int) (v LinkType, err error)
+	TryGetContextIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContext() (v interface{}, err error)
+	TryGetNameString(index int) (v string, err error)
+	TryGetNameLangString(index int) (v string, err error)
+	TryGetNameIRI(index int) (v *url.URL, err error)
+	TryGetUnknownName() (v interface{}, err error)
+	TryGetEndTime() (v time.Time, err error)
+	TryGetEndTimeIRI() (v *url.URL, err error)
+	TryGetUnknownEndTime() (v interface{}, err error)
+	TryGetGeneratorObject(index int) (v ObjectType, err error)
+	TryGetGeneratorLink(index int) (v LinkType, err error)
+	TryGetGeneratorIRI(index int) (v *url.URL, err error)
+	TryGetUnknownGenerator() (v interface{}, err error)
+	TryGetIconImage(index int) (v ImageType, err error)
+	TryGetIconLink(index int) (v LinkType, err error)
+	TryGetIconIRI(index int) (v *url.URL, err error)
+	TryGetUnknownIcon() (v interface{}, err error)
+	TryGetId() (v *url.URL, err error)
+	TryGetUnknownId() (v interface{}, err error)
+	TryGetImageImage(index int) (v ImageType, err error)
+	TryGetImageLink(index int) (v LinkType, err error)
+	TryGetImageIRI(index int) (v *url.URL, err error)
+	TryGetUnknownImage() (v interface{}, err error)
+	TryGetInReplyToObject(index int) (v ObjectType, err error)
+	TryGetInReplyToLink(index int) (v LinkType, err error)
+	TryGetInReplyToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownInReplyTo() (v interface{}, err error)
+	TryGetLocationObject(index int) (v ObjectType, err error)
+	TryGetLocationLink(index int) (v LinkType, err error)
+	TryGetLocationIRI(index int) (v *url.URL, err error)
+	TryGetUnknownLocation() (v interface{}, err error)
+	TryGetPreviewObject(index int) (v ObjectType, err error)
+	TryGetPreviewLink(index int) (v LinkType, err error)
+	TryGetPreviewIRI(index int) (v *url.URL, err error)
+	TryGetUnknownPreview() (v interface{}, err error)
+	TryGetPublished() (v time.Time, err error)
+	TryGetPublishedIRI() (v *url.URL, err error)
+	TryGetUnknownPublished() (v interface{}, err error)
+	TryGetReplies() (v CollectionType, err error)
+	TryGetRepliesIRI() (v *url.URL, err error)
+	TryGetUnknownReplies() (v interface{}, err error)
+	TryGetStartTime() (v time.Time, err error)
+	TryGetStartTimeIRI() (v *url.URL, err error)
+	TryGetUnknownStartTime() (v interface{}, err error)
+	TryGetSummaryString(index int) (v string, err error)
+	TryGetSummaryLangString(index int) (v string, err error)
+	TryGetSummaryIRI(index int) (v *url.URL, err error)
+	TryGetUnknownSummary() (v interface{}, err error)
+	TryGetTagObject(index int) (v ObjectType, err error)
+	TryGetTagLink(index int) (v LinkType, err error)
+	TryGetTagIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTag() (v interface{}, err error)
+	TryGetType(index int) (v interface{}, err error)
+	TryGetUpdated() (v time.Time, err error)
+	TryGetUpdatedIRI() (v *url.URL, err error)
+	TryGetUnknownUpdated() (v interface{}, err error)
+	TryGetUrlAnyURI(index int) (v *url.URL, err error)
+	TryGetUrlLink(index int) (v LinkType, err error)
+	TryGetUnknownUrl() (v interface{}, err error)
+	TryGetToObject(index int) (v ObjectType, err error)
+	TryGetToLink(index int) (v LinkType, err error)
+	TryGetToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTo() (v interface{}, err error)
+	TryGetBtoObject(index int) (v ObjectType, err error)
+	TryGetBtoLink(index int) (v LinkType, err error)
+	TryGetBtoIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBto() (v interface{}, err error)
+	TryGetCcObject(index int) (v ObjectType, err error)
+	TryGetCcLink(index int) (v LinkType, err error)
+	TryGetCcIRI(index int) (v *url.URL, err error)
+	TryGetUnknownCc() (v interface{}, err error)
+	TryGetBccObject(index int) (v ObjectType, err error)
+	TryGetBccLink(index int) (v LinkType, err error)
+	TryGetBccIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBcc() (v interface{}, err error)
+	TryGetMediaType() (v string, err error)
+	TryGetMediaTypeIRI() (v *url.URL, err error)
+	TryGetUnknownMediaType() (v interface{}, err error)
+	TryGetDuration() (v time.Duration, err error)
+	TryGetDurationIRI() (v *url.URL, err error)
+	TryGetUnknownDuration() (v interface{}, err error)
+	TryGetSource() (v ObjectType, err error)
+	TryGetSourceIRI() (v *url.URL, err error)
+	TryGetUnknownSource() (v interface{}, err error)
+	TryGetInboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetInboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownInbox() (v interface{}, err error)
+	TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetOutboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownOutbox() (v interface{}, err error)
+	TryGetFollowingCollection() (v CollectionType, err error)
+	TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowingAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowing() (v interface{}, err error)
+	TryGetFollowersCollection() (v CollectionType, err error)
+	TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowersAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowers() (v interface{}, err error)
+	TryGetLikedCollection() (v CollectionType, err error)
+	TryGetLikedOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikedAnyURI() (v *url.URL, err error)
+	TryGetUnknownLiked() (v interface{}, err error)
+	TryGetLikesCollection() (v CollectionType, err error)
+	TryGetLikesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikesAnyURI() (v *url.URL, err error)
+	TryGetUnknownLikes() (v interface{}, err error)
+	TryGetStreams(index int) (v *url.URL, err error)
+	TryGetUnknownStreams() (v interface{}, err error)
+	TryGetPreferredUsername() (v string, err error)
+	TryGetPreferredUsernameIRI() (v *url.URL, err error)
+	TryGetUnknownPreferredUsername() (v interface{}, err error)
+	TryGetEndpoints() (v ObjectType, err error)
+	TryGetEndpointsIRI() (v *url.URL, err error)
+	TryGetUnknownEndpoints() (v interface{}, err error)
+	TryGetProxyUrl() (v *url.URL, err error)
+	TryGetUnknownProxyUrl() (v interface{}, err error)
+	TryGetOauthAuthorizationEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error)
+	TryGetOauthTokenEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthTokenEndpoint() (v interface{}, err error)
+	TryGetProvideClientKey() (v *url.URL, err error)
+	TryGetUnknownProvideClientKey() (v interface{}, err error)
+	TryGetSignClientKey() (v *url.URL, err error)
+	TryGetUnknownSignClientKey() (v interface{}, err error)
+	TryGetSharedInbox() (v *url.URL, err error)
+	TryGetUnknownSharedInbox() (v interface{}, err error)
+	TryGetSharesCollection() (v CollectionType, err error)
+	TryGetSharesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetSharesAnyURI() (v *url.URL, err error)
+	TryGetUnknownShares() (v interface{}, err error)
+}
+
+var _ ApplicationType = (*Application)(nil)
 
 // Describes a software application.
 type Application struct {
 	// An unknown value.
 	unknown_ map[string]interface{}
+	// The order of the properties of the JSON this was unmarshalled from.
+	order_ *propertyOrder
 	// The functional 'altitude' value could have multiple types, but only a single value
 	altitude *altitudeIntermediateType
 	// The 'attachment' value could have multiple types and values
@@ -717,11 +946,13 @@ type Application struct {
 	sharedInbox *url.URL
 	// The functional 'shares' value could have multiple types, but only a single value
 	shares *sharesIntermediateType
+	// The bits of the members that are set, in the order of the members.
+	present_ [1]uint64
 }
 
 // IsAltitude determines whether the call to GetAltitude is safe
 func (t *Application) IsAltitude() (ok bool) {
-	return t.altitude != nil && t.altitude.float != nil
+	return t.present_[0]&(1<<0) != 0 && t.altitude.float != nil
 
 }
 
@@ -734,12 +965,13 @@ func (t *Application) GetAltitude() (v float64) {
 // SetAltitude sets the value of altitude to be of float64 type
 func (t *Application) SetAltitude(v float64) {
 	t.altitude = &altitudeIntermediateType{float: &v}
+	t.markPresent_(0, true)
 
 }
 
 // IsAltitudeIRI determines whether the call to GetAltitudeIRI is safe
 func (t *Application) IsAltitudeIRI() (ok bool) {
-	return t.altitude != nil && t.altitude.IRI != nil
+	return t.present_[0]&(1<<0) != 0 && t.altitude.IRI != nil
 
 }
 
@@ -752,12 +984,13 @@ func (t *Application) GetAltitudeIRI() (v *url.URL) {
 // SetAltitudeIRI sets the value of altitude to be of *url.URL type
 func (t *Application) SetAltitudeIRI(v *url.URL) {
 	t.altitude = &altitudeIntermediateType{IRI: v}
+	t.markPresent_(0, true)
 
 }
 
 // HasUnknownAltitude determines whether the call to GetUnknownAltitude is safe
 func (t *Application) HasUnknownAltitude() (ok bool) {
-	return t.altitude != nil && t.altitude.unknown_ != nil
+	return t.present_[0]&(1<<0) != 0 && t.altitude.unknown_ != nil
 
 }
 
@@ -775,6 +1008,7 @@ func (t *Application) SetUnknownAltitude(i interface{}) {
 	tmp := &altitudeIntermediateType{}
 	tmp.unknown_ = i
 	t.altitude = tmp
+	t.markPresent_(0, true)
 
 }
 
@@ -799,12 +1033,14 @@ func (t *Application) GetAttachmentObject(index int) (v ObjectType) {
 // AppendAttachmentObject adds to the back of attachment a ObjectType type
 func (t *Application) AppendAttachmentObject(v ObjectType) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{Object: v})
+	t.markPresent_(1, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentObject adds to the front of attachment a ObjectType type
 func (t *Application) PrependAttachmentObject(v ObjectType) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Object: v}}, t.attachment...)
+	t.markPresent_(1, len(t.attachment) > 0)
 
 }
 
@@ -813,6 +1049,22 @@ func (t *Application) RemoveAttachmentObject(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(1, len(t.attachment) > 0)
+
+}
+
+// AttachmentObjectValues returns an iterator over the values that GetAttachmentObject returns for each index where IsAttachmentObject is true, which can be used as an iter.Seq
+func (t *Application) AttachmentObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentObject(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -831,12 +1083,14 @@ func (t *Application) GetAttachmentLink(index int) (v LinkType) {
 // AppendAttachmentLink adds to the back of attachment a LinkType type
 func (t *Application) AppendAttachmentLink(v LinkType) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{Link: v})
+	t.markPresent_(1, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentLink adds to the front of attachment a LinkType type
 func (t *Application) PrependAttachmentLink(v LinkType) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Link: v}}, t.attachment...)
+	t.markPresent_(1, len(t.attachment) > 0)
 
 }
 
@@ -845,6 +1099,22 @@ func (t *Application) RemoveAttachmentLink(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(1, len(t.attachment) > 0)
+
+}
+
+// AttachmentLinkValues returns an iterator over the values that GetAttachmentLink returns for each index where IsAttachmentLink is true, which can be used as an iter.Seq
+func (t *Application) AttachmentLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentLink(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -863,12 +1133,14 @@ func (t *Application) GetAttachmentIRI(index int) (v *url.URL) {
 // AppendAttachmentIRI adds to the back of attachment a *url.URL type
 func (t *Application) AppendAttachmentIRI(v *url.URL) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{IRI: v})
+	t.markPresent_(1, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentIRI adds to the front of attachment a *url.URL type
 func (t *Application) PrependAttachmentIRI(v *url.URL) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{IRI: v}}, t.attachment...)
+	t.markPresent_(1, len(t.attachment) > 0)
 
 }
 
@@ -877,12 +1149,28 @@ func (t *Application) RemoveAttachmentIRI(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(1, len(t.attachment) > 0)
+
+}
+
+// AttachmentIRIValues returns an iterator over the values that GetAttachmentIRI returns for each index where IsAttachmentIRI is true, which can be used as an iter.Seq
+func (t *Application) AttachmentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAttachment determines whether the call to GetUnknownAttachment is safe
 func (t *Application) HasUnknownAttachment() (ok bool) {
-	return t.attachment != nil && t.attachment[0].unknown_ != nil
+	return t.present_[0]&(1<<1) != 0 && t.attachment[0].unknown_ != nil
 
 }
 
@@ -900,6 +1188,7 @@ func (t *Application) SetUnknownAttachment(i interface{}) {
 	tmp := &attachmentIntermediateType{}
 	tmp.unknown_ = i
 	t.attachment = append(t.attachment, tmp)
+	t.markPresent_(1, len(t.attachment) > 0)
 
 }
 
@@ -924,12 +1213,14 @@ func (t *Application) GetAttributedToObject(index int) (v ObjectType) {
 // AppendAttributedToObject adds to the back of attributedTo a ObjectType type
 func (t *Application) AppendAttributedToObject(v ObjectType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Object: v})
+	t.markPresent_(2, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToObject adds to the front of attributedTo a ObjectType type
 func (t *Application) PrependAttributedToObject(v ObjectType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Object: v}}, t.attributedTo...)
+	t.markPresent_(2, len(t.attributedTo) > 0)
 
 }
 
@@ -938,6 +1229,22 @@ func (t *Application) RemoveAttributedToObject(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(2, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToObjectValues returns an iterator over the values that GetAttributedToObject returns for each index where IsAttributedToObject is true, which can be used as an iter.Seq
+func (t *Application) AttributedToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToObject(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -956,12 +1263,14 @@ func (t *Application) GetAttributedToLink(index int) (v LinkType) {
 // AppendAttributedToLink adds to the back of attributedTo a LinkType type
 func (t *Application) AppendAttributedToLink(v LinkType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Link: v})
+	t.markPresent_(2, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToLink adds to the front of attributedTo a LinkType type
 func (t *Application) PrependAttributedToLink(v LinkType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Link: v}}, t.attributedTo...)
+	t.markPresent_(2, len(t.attributedTo) > 0)
 
 }
 
@@ -970,6 +1279,22 @@ func (t *Application) RemoveAttributedToLink(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(2, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToLinkValues returns an iterator over the values that GetAttributedToLink returns for each index where IsAttributedToLink is true, which can be used as an iter.Seq
+func (t *Application) AttributedToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToLink(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -988,12 +1313,14 @@ func (t *Application) GetAttributedToIRI(index int) (v *url.URL) {
 // AppendAttributedToIRI adds to the back of attributedTo a *url.URL type
 func (t *Application) AppendAttributedToIRI(v *url.URL) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{IRI: v})
+	t.markPresent_(2, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToIRI adds to the front of attributedTo a *url.URL type
 func (t *Application) PrependAttributedToIRI(v *url.URL) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{IRI: v}}, t.attributedTo...)
+	t.markPresent_(2, len(t.attributedTo) > 0)
 
 }
 
@@ -1002,12 +1329,28 @@ func (t *Application) RemoveAttributedToIRI(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(2, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToIRIValues returns an iterator over the values that GetAttributedToIRI returns for each index where IsAttributedToIRI is true, which can be used as an iter.Seq
+func (t *Application) AttributedToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAttributedTo determines whether the call to GetUnknownAttributedTo is safe
 func (t *Application) HasUnknownAttributedTo() (ok bool) {
-	return t.attributedTo != nil && t.attributedTo[0].unknown_ != nil
+	return t.present_[0]&(1<<2) != 0 && t.attributedTo[0].unknown_ != nil
 
 }
 
@@ -1025,6 +1368,7 @@ func (t *Application) SetUnknownAttributedTo(i interface{}) {
 	tmp := &attributedToIntermediateType{}
 	tmp.unknown_ = i
 	t.attributedTo = append(t.attributedTo, tmp)
+	t.markPresent_(2, len(t.attributedTo) > 0)
 
 }
 
@@ -1049,12 +1393,14 @@ func (t *Application) GetAudienceObject(index int) (v ObjectType) {
 // AppendAudienceObject adds to the back of audience a ObjectType type
 func (t *Application) AppendAudienceObject(v ObjectType) {
 	t.audience = append(t.audience, &audienceIntermediateType{Object: v})
+	t.markPresent_(3, len(t.audience) > 0)
 
 }
 
 // PrependAudienceObject adds to the front of audience a ObjectType type
 func (t *Application) PrependAudienceObject(v ObjectType) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Object: v}}, t.audience...)
+	t.markPresent_(3, len(t.audience) > 0)
 
 }
 
@@ -1063,6 +1409,22 @@ func (t *Application) RemoveAudienceObject(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(3, len(t.audience) > 0)
+
+}
+
+// AudienceObjectValues returns an iterator over the values that GetAudienceObject returns for each index where IsAudienceObject is true, which can be used as an iter.Seq
+func (t *Application) AudienceObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceObject(i) {
+				continue
+			}
+			if !yield(t.GetAudienceObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1081,12 +1443,14 @@ func (t *Application) GetAudienceLink(index int) (v LinkType) {
 // AppendAudienceLink adds to the back of audience a LinkType type
 func (t *Application) AppendAudienceLink(v LinkType) {
 	t.audience = append(t.audience, &audienceIntermediateType{Link: v})
+	t.markPresent_(3, len(t.audience) > 0)
 
 }
 
 // PrependAudienceLink adds to the front of audience a LinkType type
 func (t *Application) PrependAudienceLink(v LinkType) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Link: v}}, t.audience...)
+	t.markPresent_(3, len(t.audience) > 0)
 
 }
 
@@ -1095,6 +1459,22 @@ func (t *Application) RemoveAudienceLink(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(3, len(t.audience) > 0)
+
+}
+
+// AudienceLinkValues returns an iterator over the values that GetAudienceLink returns for each index where IsAudienceLink is true, which can be used as an iter.Seq
+func (t *Application) AudienceLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceLink(i) {
+				continue
+			}
+			if !yield(t.GetAudienceLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1113,12 +1493,14 @@ func (t *Application) GetAudienceIRI(index int) (v *url.URL) {
 // AppendAudienceIRI adds to the back of audience a *url.URL type
 func (t *Application) AppendAudienceIRI(v *url.URL) {
 	t.audience = append(t.audience, &audienceIntermediateType{IRI: v})
+	t.markPresent_(3, len(t.audience) > 0)
 
 }
 
 // PrependAudienceIRI adds to the front of audience a *url.URL type
 func (t *Application) PrependAudienceIRI(v *url.URL) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{IRI: v}}, t.audience...)
+	t.markPresent_(3, len(t.audience) > 0)
 
 }
 
@@ -1127,12 +1509,28 @@ func (t *Application) RemoveAudienceIRI(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(3, len(t.audience) > 0)
+
+}
+
+// AudienceIRIValues returns an iterator over the values that GetAudienceIRI returns for each index where IsAudienceIRI is true, which can be used as an iter.Seq
+func (t *Application) AudienceIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceIRI(i) {
+				continue
+			}
+			if !yield(t.GetAudienceIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAudience determines whether the call to GetUnknownAudience is safe
 func (t *Application) HasUnknownAudience() (ok bool) {
-	return t.audience != nil && t.audience[0].unknown_ != nil
+	return t.present_[0]&(1<<3) != 0 && t.audience[0].unknown_ != nil
 
 }
 
@@ -1150,6 +1548,7 @@ func (t *Application) SetUnknownAudience(i interface{}) {
 	tmp := &audienceIntermediateType{}
 	tmp.unknown_ = i
 	t.audience = append(t.audience, tmp)
+	t.markPresent_(3, len(t.audience) > 0)
 
 }
 
@@ -1174,12 +1573,14 @@ func (t *Application) GetContentString(index int) (v string) {
 // AppendContentString adds to the back of content a string type
 func (t *Application) AppendContentString(v string) {
 	t.content = append(t.content, &contentIntermediateType{stringName: &v})
+	t.markPresent_(4, len(t.content) > 0)
 
 }
 
 // PrependContentString adds to the front of content a string type
 func (t *Application) PrependContentString(v string) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{stringName: &v}}, t.content...)
+	t.markPresent_(4, len(t.content) > 0)
 
 }
 
@@ -1188,6 +1589,22 @@ func (t *Application) RemoveContentString(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(4, len(t.content) > 0)
+
+}
+
+// ContentStringValues returns an iterator over the values that GetContentString returns for each index where IsContentString is true, which can be used as an iter.Seq
+func (t *Application) ContentStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentString(i) {
+				continue
+			}
+			if !yield(t.GetContentString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1206,12 +1623,14 @@ func (t *Application) GetContentLangString(index int) (v string) {
 // AppendContentLangString adds to the back of content a string type
 func (t *Application) AppendContentLangString(v string) {
 	t.content = append(t.content, &contentIntermediateType{langString: &v})
+	t.markPresent_(4, len(t.content) > 0)
 
 }
 
 // PrependContentLangString adds to the front of content a string type
 func (t *Application) PrependContentLangString(v string) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{langString: &v}}, t.content...)
+	t.markPresent_(4, len(t.content) > 0)
 
 }
 
@@ -1220,6 +1639,22 @@ func (t *Application) RemoveContentLangString(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(4, len(t.content) > 0)
+
+}
+
+// ContentLangStringValues returns an iterator over the values that GetContentLangString returns for each index where IsContentLangString is true, which can be used as an iter.Seq
+func (t *Application) ContentLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentLangString(i) {
+				continue
+			}
+			if !yield(t.GetContentLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1238,12 +1673,14 @@ func (t *Application) GetContentIRI(index int) (v *url.URL) {
 // AppendContentIRI adds to the back of content a *url.URL type
 func (t *Application) AppendContentIRI(v *url.URL) {
 	t.content = append(t.content, &contentIntermediateType{IRI: v})
+	t.markPresent_(4, len(t.content) > 0)
 
 }
 
 // PrependContentIRI adds to the front of content a *url.URL type
 func (t *Application) PrependContentIRI(v *url.URL) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{IRI: v}}, t.content...)
+	t.markPresent_(4, len(t.content) > 0)
 
 }
 
@@ -1252,12 +1689,28 @@ func (t *Application) RemoveContentIRI(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(4, len(t.content) > 0)
+
+}
+
+// ContentIRIValues returns an iterator over the values that GetContentIRI returns for each index where IsContentIRI is true, which can be used as an iter.Seq
+func (t *Application) ContentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentIRI(i) {
+				continue
+			}
+			if !yield(t.GetContentIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownContent determines whether the call to GetUnknownContent is safe
 func (t *Application) HasUnknownContent() (ok bool) {
-	return t.content != nil && t.content[0].unknown_ != nil
+	return t.present_[0]&(1<<4) != 0 && t.content[0].unknown_ != nil
 
 }
 
@@ -1275,6 +1728,7 @@ func (t *Application) SetUnknownContent(i interface{}) {
 	tmp := &contentIntermediateType{}
 	tmp.unknown_ = i
 	t.content = append(t.content, tmp)
+	t.markPresent_(4, len(t.content) > 0)
 
 }
 
@@ -1308,11 +1762,41 @@ func (t *Application) GetContentMap(l string) (v string) {
 func (t *Application) SetContentMap(l string, v string) {
 	if t.contentMap == nil {
 		t.contentMap = make(map[string]string)
+		t.markPresent_(5, true)
 	}
 	t.contentMap[l] = v
 
 }
 
+// GetContentLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Application) GetContentLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.contentMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.contentMap[k], true
+
+}
+
+// SetContentLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Application) SetContentLanguage(tag string, v string) {
+	if t.contentMap == nil {
+		t.contentMap = make(map[string]string)
+		t.markPresent_(5, true)
+	} else if k, ok := languageKey(t.contentMap, tag); ok {
+		delete(t.contentMap, k)
+	}
+	t.contentMap[tag] = v
+
+}
+
+// PreferredContentLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Application) PreferredContentLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.contentMap, tags)
+	return t.contentMap[tag], tag
+
+}
+
 // ContextLen determines the number of elements able to be used for the IsContextObject, GetContextObject, and RemoveContextObject functions
 func (t *Application) ContextLen() (l int) {
 	return len(t.context)
@@ -1334,12 +1818,14 @@ func (t *Application) GetContextObject(index int) (v ObjectType) {
 // AppendContextObject adds to the back of context a ObjectType type
 func (t *Application) AppendContextObject(v ObjectType) {
 	t.context = append(t.context, &contextIntermediateType{Object: v})
+	t.markPresent_(6, len(t.context) > 0)
 
 }
 
 // PrependContextObject adds to the front of context a ObjectType type
 func (t *Application) PrependContextObject(v ObjectType) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{Object: v}}, t.context...)
+	t.markPresent_(6, len(t.context) > 0)
 
 }
 
@@ -1348,6 +1834,22 @@ func (t *Application) RemoveContextObject(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(6, len(t.context) > 0)
+
+}
+
+// ContextObjectValues returns an iterator over the values that GetContextObject returns for each index where IsContextObject is true, which can be used as an iter.Seq
+func (t *Application) ContextObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextObject(i) {
+				continue
+			}
+			if !yield(t.GetContextObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1366,12 +1868,14 @@ func (t *Application) GetContextLink(index int) (v LinkType) {
 // AppendContextLink adds to the back of context a LinkType type
 func (t *Application) AppendContextLink(v LinkType) {
 	t.context = append(t.context, &contextIntermediateType{Link: v})
+	t.markPresent_(6, len(t.context) > 0)
 
 }
 
 // PrependContextLink adds to the front of context a LinkType type
 func (t *Application) PrependContextLink(v LinkType) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{Link: v}}, t.context...)
+	t.markPresent_(6, len(t.context) > 0)
 
 }
 
@@ -1380,6 +1884,22 @@ func (t *Application) RemoveContextLink(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(6, len(t.context) > 0)
+
+}
+
+// ContextLinkValues returns an iterator over the values that GetContextLink returns for each index where IsContextLink is true, which can be used as an iter.Seq
+func (t *Application) ContextLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextLink(i) {
+				continue
+			}
+			if !yield(t.GetContextLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1398,12 +1918,14 @@ func (t *Application) GetContextIRI(index int) (v *url.URL) {
 // AppendContextIRI adds to the back of context a *url.URL type
 func (t *Application) AppendContextIRI(v *url.URL) {
 	t.context = append(t.context, &contextIntermediateType{IRI: v})
+	t.markPresent_(6, len(t.context) > 0)
 
 }
 
 // PrependContextIRI adds to the front of context a *url.URL type
 func (t *Application) PrependContextIRI(v *url.URL) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{IRI: v}}, t.context...)
+	t.markPresent_(6, len(t.context) > 0)
 
 }
 
@@ -1412,12 +1934,28 @@ func (t *Application) RemoveContextIRI(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(6, len(t.context) > 0)
+
+}
+
+// ContextIRIValues returns an iterator over the values that GetContextIRI returns for each index where IsContextIRI is true, which can be used as an iter.Seq
+func (t *Application) ContextIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextIRI(i) {
+				continue
+			}
+			if !yield(t.GetContextIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownContext determines whether the call to GetUnknownContext is safe
 func (t *Application) HasUnknownContext() (ok bool) {
-	return t.context != nil && t.context[0].unknown_ != nil
+	return t.present_[0]&(1<<6) != 0 && t.context[0].unknown_ != nil
 
 }
 
@@ -1435,6 +1973,7 @@ func (t *Application) SetUnknownContext(i interface{}) {
 	tmp := &contextIntermediateType{}
 	tmp.unknown_ = i
 	t.context = append(t.context, tmp)
+	t.markPresent_(6, len(t.context) > 0)
 
 }
 
@@ -1459,12 +1998,14 @@ func (t *Application) GetNameString(index int) (v string) {
 // AppendNameString adds to the back of name a string type
 func (t *Application) AppendNameString(v string) {
 	t.name = append(t.name, &nameIntermediateType{stringName: &v})
+	t.markPresent_(7, len(t.name) > 0)
 
 }
 
 // PrependNameString adds to the front of name a string type
 func (t *Application) PrependNameString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{stringName: &v}}, t.name...)
+	t.markPresent_(7, len(t.name) > 0)
 
 }
 
@@ -1473,6 +2014,22 @@ func (t *Application) RemoveNameString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(7, len(t.name) > 0)
+
+}
+
+// NameStringValues returns an iterator over the values that GetNameString returns for each index where IsNameString is true, which can be used as an iter.Seq
+func (t *Application) NameStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameString(i) {
+				continue
+			}
+			if !yield(t.GetNameString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1491,12 +2048,14 @@ func (t *Application) GetNameLangString(index int) (v string) {
 // AppendNameLangString adds to the back of name a string type
 func (t *Application) AppendNameLangString(v string) {
 	t.name = append(t.name, &nameIntermediateType{langString: &v})
+	t.markPresent_(7, len(t.name) > 0)
 
 }
 
 // PrependNameLangString adds to the front of name a string type
 func (t *Application) PrependNameLangString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{langString: &v}}, t.name...)
+	t.markPresent_(7, len(t.name) > 0)
 
 }
 
@@ -1505,6 +2064,22 @@ func (t *Application) RemoveNameLangString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(7, len(t.name) > 0)
+
+}
+
+// NameLangStringValues returns an iterator over the values that GetNameLangString returns for each index where IsNameLangString is true, which can be used as an iter.Seq
+func (t *Application) NameLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameLangString(i) {
+				continue
+			}
+			if !yield(t.GetNameLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1523,12 +2098,14 @@ func (t *Application) GetNameIRI(index int) (v *url.URL) {
 // AppendNameIRI adds to the back of name a *url.URL type
 func (t *Application) AppendNameIRI(v *url.URL) {
 	t.name = append(t.name, &nameIntermediateType{IRI: v})
+	t.markPresent_(7, len(t.name) > 0)
 
 }
 
 // PrependNameIRI adds to the front of name a *url.URL type
 func (t *Application) PrependNameIRI(v *url.URL) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{IRI: v}}, t.name...)
+	t.markPresent_(7, len(t.name) > 0)
 
 }
 
@@ -1537,12 +2114,28 @@ func (t *Application) RemoveNameIRI(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(7, len(t.name) > 0)
+
+}
+
+// NameIRIValues returns an iterator over the values that GetNameIRI returns for each index where IsNameIRI is true, which can be used as an iter.Seq
+func (t *Application) NameIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameIRI(i) {
+				continue
+			}
+			if !yield(t.GetNameIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownName determines whether the call to GetUnknownName is safe
 func (t *Application) HasUnknownName() (ok bool) {
-	return t.name != nil && t.name[0].unknown_ != nil
+	return t.present_[0]&(1<<7) != 0 && t.name[0].unknown_ != nil
 
 }
 
@@ -1560,6 +2153,7 @@ func (t *Application) SetUnknownName(i interface{}) {
 	tmp := &nameIntermediateType{}
 	tmp.unknown_ = i
 	t.name = append(t.name, tmp)
+	t.markPresent_(7, len(t.name) > 0)
 
 }
 
@@ -1593,14 +2187,44 @@ func (t *Application) GetNameMap(l string) (v string) {
 func (t *Application) SetNameMap(l string, v string) {
 	if t.nameMap == nil {
 		t.nameMap = make(map[string]string)
+		t.markPresent_(8, true)
 	}
 	t.nameMap[l] = v
 
 }
 
+// GetNameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Application) GetNameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.nameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.nameMap[k], true
+
+}
+
+// SetNameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Application) SetNameLanguage(tag string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(8, true)
+	} else if k, ok := languageKey(t.nameMap, tag); ok {
+		delete(t.nameMap, k)
+	}
+	t.nameMap[tag] = v
+
+}
+
+// PreferredNameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Application) PreferredNameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.nameMap, tags)
+	return t.nameMap[tag], tag
+
+}
+
 // IsEndTime determines whether the call to GetEndTime is safe
 func (t *Application) IsEndTime() (ok bool) {
-	return t.endTime != nil && t.endTime.dateTime != nil
+	return t.present_[0]&(1<<9) != 0 && t.endTime.dateTime != nil
 
 }
 
@@ -1613,12 +2237,13 @@ func (t *Application) GetEndTime() (v time.Time) {
 // SetEndTime sets the value of endTime to be of time.Time type
 func (t *Application) SetEndTime(v time.Time) {
 	t.endTime = &endTimeIntermediateType{dateTime: &v}
+	t.markPresent_(9, true)
 
 }
 
 // IsEndTimeIRI determines whether the call to GetEndTimeIRI is safe
 func (t *Application) IsEndTimeIRI() (ok bool) {
-	return t.endTime != nil && t.endTime.IRI != nil
+	return t.present_[0]&(1<<9) != 0 && t.endTime.IRI != nil
 
 }
 
@@ -1631,12 +2256,13 @@ func (t *Application) GetEndTimeIRI() (v *url.URL) {
 // SetEndTimeIRI sets the value of endTime to be of *url.URL type
 func (t *Application) SetEndTimeIRI(v *url.URL) {
 	t.endTime = &endTimeIntermediateType{IRI: v}
+	t.markPresent_(9, true)
 
 }
 
 // HasUnknownEndTime determines whether the call to GetUnknownEndTime is safe
 func (t *Application) HasUnknownEndTime() (ok bool) {
-	return t.endTime != nil && t.endTime.unknown_ != nil
+	return t.present_[0]&(1<<9) != 0 && t.endTime.unknown_ != nil
 
 }
 
@@ -1654,6 +2280,7 @@ func (t *Application) SetUnknownEndTime(i interface{}) {
 	tmp := &endTimeIntermediateType{}
 	tmp.unknown_ = i
 	t.endTime = tmp
+	t.markPresent_(9, true)
 
 }
 
@@ -1678,12 +2305,14 @@ func (t *Application) GetGeneratorObject(index int) (v ObjectType) {
 // AppendGeneratorObject adds to the back of generator a ObjectType type
 func (t *Application) AppendGeneratorObject(v ObjectType) {
 	t.generator = append(t.generator, &generatorIntermediateType{Object: v})
+	t.markPresent_(10, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorObject adds to the front of generator a ObjectType type
 func (t *Application) PrependGeneratorObject(v ObjectType) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Object: v}}, t.generator...)
+	t.markPresent_(10, len(t.generator) > 0)
 
 }
 
@@ -1692,6 +2321,22 @@ func (t *Application) RemoveGeneratorObject(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(10, len(t.generator) > 0)
+
+}
+
+// GeneratorObjectValues returns an iterator over the values that GetGeneratorObject returns for each index where IsGeneratorObject is true, which can be used as an iter.Seq
+func (t *Application) GeneratorObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorObject(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1710,12 +2355,14 @@ func (t *Application) GetGeneratorLink(index int) (v LinkType) {
 // AppendGeneratorLink adds to the back of generator a LinkType type
 func (t *Application) AppendGeneratorLink(v LinkType) {
 	t.generator = append(t.generator, &generatorIntermediateType{Link: v})
+	t.markPresent_(10, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorLink adds to the front of generator a LinkType type
 func (t *Application) PrependGeneratorLink(v LinkType) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Link: v}}, t.generator...)
+	t.markPresent_(10, len(t.generator) > 0)
 
 }
 
@@ -1724,6 +2371,22 @@ func (t *Application) RemoveGeneratorLink(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(10, len(t.generator) > 0)
+
+}
+
+// GeneratorLinkValues returns an iterator over the values that GetGeneratorLink returns for each index where IsGeneratorLink is true, which can be used as an iter.Seq
+func (t *Application) GeneratorLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorLink(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1742,12 +2405,14 @@ func (t *Application) GetGeneratorIRI(index int) (v *url.URL) {
 // AppendGeneratorIRI adds to the back of generator a *url.URL type
 func (t *Application) AppendGeneratorIRI(v *url.URL) {
 	t.generator = append(t.generator, &generatorIntermediateType{IRI: v})
+	t.markPresent_(10, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorIRI adds to the front of generator a *url.URL type
 func (t *Application) PrependGeneratorIRI(v *url.URL) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{IRI: v}}, t.generator...)
+	t.markPresent_(10, len(t.generator) > 0)
 
 }
 
@@ -1756,12 +2421,28 @@ func (t *Application) RemoveGeneratorIRI(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(10, len(t.generator) > 0)
+
+}
+
+// GeneratorIRIValues returns an iterator over the values that GetGeneratorIRI returns for each index where IsGeneratorIRI is true, which can be used as an iter.Seq
+func (t *Application) GeneratorIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorIRI(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownGenerator determines whether the call to GetUnknownGenerator is safe
 func (t *Application) HasUnknownGenerator() (ok bool) {
-	return t.generator != nil && t.generator[0].unknown_ != nil
+	return t.present_[0]&(1<<10) != 0 && t.generator[0].unknown_ != nil
 
 }
 
@@ -1779,6 +2460,7 @@ func (t *Application) SetUnknownGenerator(i interface{}) {
 	tmp := &generatorIntermediateType{}
 	tmp.unknown_ = i
 	t.generator = append(t.generator, tmp)
+	t.markPresent_(10, len(t.generator) > 0)
 
 }
 
@@ -1803,12 +2485,14 @@ func (t *Application) GetIconImage(index int) (v ImageType) {
 // AppendIconImage adds to the back of icon a ImageType type
 func (t *Application) AppendIconImage(v ImageType) {
 	t.icon = append(t.icon, &iconIntermediateType{Image: v})
+	t.markPresent_(11, len(t.icon) > 0)
 
 }
 
 // PrependIconImage adds to the front of icon a ImageType type
 func (t *Application) PrependIconImage(v ImageType) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Image: v}}, t.icon...)
+	t.markPresent_(11, len(t.icon) > 0)
 
 }
 
@@ -1817,6 +2501,22 @@ func (t *Application) RemoveIconImage(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(11, len(t.icon) > 0)
+
+}
+
+// IconImageValues returns an iterator over the values that GetIconImage returns for each index where IsIconImage is true, which can be used as an iter.Seq
+func (t *Application) IconImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconImage(i) {
+				continue
+			}
+			if !yield(t.GetIconImage(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1835,12 +2535,14 @@ func (t *Application) GetIconLink(index int) (v LinkType) {
 // AppendIconLink adds to the back of icon a LinkType type
 func (t *Application) AppendIconLink(v LinkType) {
 	t.icon = append(t.icon, &iconIntermediateType{Link: v})
+	t.markPresent_(11, len(t.icon) > 0)
 
 }
 
 // PrependIconLink adds to the front of icon a LinkType type
 func (t *Application) PrependIconLink(v LinkType) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Link: v}}, t.icon...)
+	t.markPresent_(11, len(t.icon) > 0)
 
 }
 
@@ -1849,6 +2551,22 @@ func (t *Application) RemoveIconLink(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(11, len(t.icon) > 0)
+
+}
+
+// IconLinkValues returns an iterator over the values that GetIconLink returns for each index where IsIconLink is true, which can be used as an iter.Seq
+func (t *Application) IconLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconLink(i) {
+				continue
+			}
+			if !yield(t.GetIconLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1867,12 +2585,14 @@ func (t *Application) GetIconIRI(index int) (v *url.URL) {
 // AppendIconIRI adds to the back of icon a *url.URL type
 func (t *Application) AppendIconIRI(v *url.URL) {
 	t.icon = append(t.icon, &iconIntermediateType{IRI: v})
+	t.markPresent_(11, len(t.icon) > 0)
 
 }
 
 // PrependIconIRI adds to the front of icon a *url.URL type
 func (t *Application) PrependIconIRI(v *url.URL) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{IRI: v}}, t.icon...)
+	t.markPresent_(11, len(t.icon) > 0)
 
 }
 
@@ -1881,12 +2601,28 @@ func (t *Application) RemoveIconIRI(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(11, len(t.icon) > 0)
+
+}
+
+// IconIRIValues returns an iterator over the values that GetIconIRI returns for each index where IsIconIRI is true, which can be used as an iter.Seq
+func (t *Application) IconIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconIRI(i) {
+				continue
+			}
+			if !yield(t.GetIconIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownIcon determines whether the call to GetUnknownIcon is safe
 func (t *Application) HasUnknownIcon() (ok bool) {
-	return t.icon != nil && t.icon[0].unknown_ != nil
+	return t.present_[0]&(1<<11) != 0 && t.icon[0].unknown_ != nil
 
 }
 
@@ -1904,12 +2640,13 @@ func (t *Application) SetUnknownIcon(i interface{}) {
 	tmp := &iconIntermediateType{}
 	tmp.unknown_ = i
 	t.icon = append(t.icon, tmp)
+	t.markPresent_(11, len(t.icon) > 0)
 
 }
 
 // HasId determines whether the call to GetId is safe
 func (t *Application) HasId() (ok bool) {
-	return t.id != nil
+	return t.present_[0]&(1<<12) != 0
 
 }
 
@@ -1922,6 +2659,7 @@ func (t *Application) GetId() (v *url.URL) {
 // SetId sets the value of id
 func (t *Application) SetId(v *url.URL) {
 	t.id = v
+	t.markPresent_(12, true)
 
 }
 
@@ -1967,12 +2705,14 @@ func (t *Application) GetImageImage(index int) (v ImageType) {
 // AppendImageImage adds to the back of image a ImageType type
 func (t *Application) AppendImageImage(v ImageType) {
 	t.image = append(t.image, &imageIntermediateType{Image: v})
+	t.markPresent_(13, len(t.image) > 0)
 
 }
 
 // PrependImageImage adds to the front of image a ImageType type
 func (t *Application) PrependImageImage(v ImageType) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{Image: v}}, t.image...)
+	t.markPresent_(13, len(t.image) > 0)
 
 }
 
@@ -1981,6 +2721,22 @@ func (t *Application) RemoveImageImage(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(13, len(t.image) > 0)
+
+}
+
+// ImageImageValues returns an iterator over the values that GetImageImage returns for each index where IsImageImage is true, which can be used as an iter.Seq
+func (t *Application) ImageImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageImage(i) {
+				continue
+			}
+			if !yield(t.GetImageImage(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1999,12 +2755,14 @@ func (t *Application) GetImageLink(index int) (v LinkType) {
 // AppendImageLink adds to the back of image a LinkType type
 func (t *Application) AppendImageLink(v LinkType) {
 	t.image = append(t.image, &imageIntermediateType{Link: v})
+	t.markPresent_(13, len(t.image) > 0)
 
 }
 
 // PrependImageLink adds to the front of image a LinkType type
 func (t *Application) PrependImageLink(v LinkType) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{Link: v}}, t.image...)
+	t.markPresent_(13, len(t.image) > 0)
 
 }
 
@@ -2013,6 +2771,22 @@ func (t *Application) RemoveImageLink(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(13, len(t.image) > 0)
+
+}
+
+// ImageLinkValues returns an iterator over the values that GetImageLink returns for each index where IsImageLink is true, which can be used as an iter.Seq
+func (t *Application) ImageLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageLink(i) {
+				continue
+			}
+			if !yield(t.GetImageLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2031,12 +2805,14 @@ func (t *Application) GetImageIRI(index int) (v *url.URL) {
 // AppendImageIRI adds to the back of image a *url.URL type
 func (t *Application) AppendImageIRI(v *url.URL) {
 	t.image = append(t.image, &imageIntermediateType{IRI: v})
+	t.markPresent_(13, len(t.image) > 0)
 
 }
 
 // PrependImageIRI adds to the front of image a *url.URL type
 func (t *Application) PrependImageIRI(v *url.URL) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{IRI: v}}, t.image...)
+	t.markPresent_(13, len(t.image) > 0)
 
 }
 
@@ -2045,12 +2821,28 @@ func (t *Application) RemoveImageIRI(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(13, len(t.image) > 0)
+
+}
+
+// ImageIRIValues returns an iterator over the values that GetImageIRI returns for each index where IsImageIRI is true, which can be used as an iter.Seq
+func (t *Application) ImageIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageIRI(i) {
+				continue
+			}
+			if !yield(t.GetImageIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownImage determines whether the call to GetUnknownImage is safe
 func (t *Application) HasUnknownImage() (ok bool) {
-	return t.image != nil && t.image[0].unknown_ != nil
+	return t.present_[0]&(1<<13) != 0 && t.image[0].unknown_ != nil
 
 }
 
@@ -2068,6 +2860,7 @@ func (t *Application) SetUnknownImage(i interface{}) {
 	tmp := &imageIntermediateType{}
 	tmp.unknown_ = i
 	t.image = append(t.image, tmp)
+	t.markPresent_(13, len(t.image) > 0)
 
 }
 
@@ -2092,12 +2885,14 @@ func (t *Application) GetInReplyToObject(index int) (v ObjectType) {
 // AppendInReplyToObject adds to the back of inReplyTo a ObjectType type
 func (t *Application) AppendInReplyToObject(v ObjectType) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Object: v})
+	t.markPresent_(14, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToObject adds to the front of inReplyTo a ObjectType type
 func (t *Application) PrependInReplyToObject(v ObjectType) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Object: v}}, t.inReplyTo...)
+	t.markPresent_(14, len(t.inReplyTo) > 0)
 
 }
 
@@ -2106,6 +2901,22 @@ func (t *Application) RemoveInReplyToObject(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(14, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToObjectValues returns an iterator over the values that GetInReplyToObject returns for each index where IsInReplyToObject is true, which can be used as an iter.Seq
+func (t *Application) InReplyToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToObject(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2124,12 +2935,14 @@ func (t *Application) GetInReplyToLink(index int) (v LinkType) {
 // AppendInReplyToLink adds to the back of inReplyTo a LinkType type
 func (t *Application) AppendInReplyToLink(v LinkType) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Link: v})
+	t.markPresent_(14, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToLink adds to the front of inReplyTo a LinkType type
 func (t *Application) PrependInReplyToLink(v LinkType) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Link: v}}, t.inReplyTo...)
+	t.markPresent_(14, len(t.inReplyTo) > 0)
 
 }
 
@@ -2138,6 +2951,22 @@ func (t *Application) RemoveInReplyToLink(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(14, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToLinkValues returns an iterator over the values that GetInReplyToLink returns for each index where IsInReplyToLink is true, which can be used as an iter.Seq
+func (t *Application) InReplyToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToLink(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2156,12 +2985,14 @@ func (t *Application) GetInReplyToIRI(index int) (v *url.URL) {
 // AppendInReplyToIRI adds to the back of inReplyTo a *url.URL type
 func (t *Application) AppendInReplyToIRI(v *url.URL) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{IRI: v})
+	t.markPresent_(14, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToIRI adds to the front of inReplyTo a *url.URL type
 func (t *Application) PrependInReplyToIRI(v *url.URL) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{IRI: v}}, t.inReplyTo...)
+	t.markPresent_(14, len(t.inReplyTo) > 0)
 
 }
 
@@ -2170,12 +3001,28 @@ func (t *Application) RemoveInReplyToIRI(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(14, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToIRIValues returns an iterator over the values that GetInReplyToIRI returns for each index where IsInReplyToIRI is true, which can be used as an iter.Seq
+func (t *Application) InReplyToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToIRI(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownInReplyTo determines whether the call to GetUnknownInReplyTo is safe
 func (t *Application) HasUnknownInReplyTo() (ok bool) {
-	return t.inReplyTo != nil && t.inReplyTo[0].unknown_ != nil
+	return t.present_[0]&(1<<14) != 0 && t.inReplyTo[0].unknown_ != nil
 
 }
 
@@ -2193,6 +3040,7 @@ func (t *Application) SetUnknownInReplyTo(i interface{}) {
 	tmp := &inReplyToIntermediateType{}
 	tmp.unknown_ = i
 	t.inReplyTo = append(t.inReplyTo, tmp)
+	t.markPresent_(14, len(t.inReplyTo) > 0)
 
 }
 
@@ -2217,12 +3065,14 @@ func (t *Application) GetLocationObject(index int) (v ObjectType) {
 // AppendLocationObject adds to the back of location a ObjectType type
 func (t *Application) AppendLocationObject(v ObjectType) {
 	t.location = append(t.location, &locationIntermediateType{Object: v})
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
 // PrependLocationObject adds to the front of location a ObjectType type
 func (t *Application) PrependLocationObject(v ObjectType) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{Object: v}}, t.location...)
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
@@ -2231,6 +3081,22 @@ func (t *Application) RemoveLocationObject(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(15, len(t.location) > 0)
+
+}
+
+// LocationObjectValues returns an iterator over the values that GetLocationObject returns for each index where IsLocationObject is true, which can be used as an iter.Seq
+func (t *Application) LocationObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationObject(i) {
+				continue
+			}
+			if !yield(t.GetLocationObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2249,12 +3115,14 @@ func (t *Application) GetLocationLink(index int) (v LinkType) {
 // AppendLocationLink adds to the back of location a LinkType type
 func (t *Application) AppendLocationLink(v LinkType) {
 	t.location = append(t.location, &locationIntermediateType{Link: v})
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
 // PrependLocationLink adds to the front of location a LinkType type
 func (t *Application) PrependLocationLink(v LinkType) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{Link: v}}, t.location...)
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
@@ -2263,6 +3131,22 @@ func (t *Application) RemoveLocationLink(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(15, len(t.location) > 0)
+
+}
+
+// LocationLinkValues returns an iterator over the values that GetLocationLink returns for each index where IsLocationLink is true, which can be used as an iter.Seq
+func (t *Application) LocationLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationLink(i) {
+				continue
+			}
+			if !yield(t.GetLocationLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2281,12 +3165,14 @@ func (t *Application) GetLocationIRI(index int) (v *url.URL) {
 // AppendLocationIRI adds to the back of location a *url.URL type
 func (t *Application) AppendLocationIRI(v *url.URL) {
 	t.location = append(t.location, &locationIntermediateType{IRI: v})
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
 // PrependLocationIRI adds to the front of location a *url.URL type
 func (t *Application) PrependLocationIRI(v *url.URL) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{IRI: v}}, t.location...)
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
@@ -2295,13 +3181,29 @@ func (t *Application) RemoveLocationIRI(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
-// HasUnknownLocation determines whether the call to GetUnknownLocation is safe
-func (t *Application) HasUnknownLocation() (ok bool) {
-	return t.location != nil && t.location[0].unknown_ != nil
-
+// LocationIRIValues returns an iterator over the values that GetLocationIRI returns for each index where IsLocationIRI is true, which can be used as an iter.Seq
+func (t *Application) LocationIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationIRI(i) {
+				continue
+			}
+			if !yield(t.GetLocationIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownLocation determines whether the call to GetUnknownLocation is safe
+func (t *Application) HasUnknownLocation() (ok bool) {
+	return t.present_[0]&(1<<15) != 0 && t.location[0].unknown_ != nil
+
 }
 
 // GetUnknownLocation returns the unknown value for location
@@ -2318,6 +3220,7 @@ func (t *Application) SetUnknownLocation(i interface{}) {
 	tmp := &locationIntermediateType{}
 	tmp.unknown_ = i
 	t.location = append(t.location, tmp)
+	t.markPresent_(15, len(t.location) > 0)
 
 }
 
@@ -2342,12 +3245,14 @@ func (t *Application) GetPreviewObject(index int) (v ObjectType) {
 // AppendPreviewObject adds to the back of preview a ObjectType type
 func (t *Application) AppendPreviewObject(v ObjectType) {
 	t.preview = append(t.preview, &previewIntermediateType{Object: v})
+	t.markPresent_(16, len(t.preview) > 0)
 
 }
 
 // PrependPreviewObject adds to the front of preview a ObjectType type
 func (t *Application) PrependPreviewObject(v ObjectType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Object: v}}, t.preview...)
+	t.markPresent_(16, len(t.preview) > 0)
 
 }
 
@@ -2356,6 +3261,22 @@ func (t *Application) RemovePreviewObject(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(16, len(t.preview) > 0)
+
+}
+
+// PreviewObjectValues returns an iterator over the values that GetPreviewObject returns for each index where IsPreviewObject is true, which can be used as an iter.Seq
+func (t *Application) PreviewObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewObject(i) {
+				continue
+			}
+			if !yield(t.GetPreviewObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2374,12 +3295,14 @@ func (t *Application) GetPreviewLink(index int) (v LinkType) {
 // AppendPreviewLink adds to the back of preview a LinkType type
 func (t *Application) AppendPreviewLink(v LinkType) {
 	t.preview = append(t.preview, &previewIntermediateType{Link: v})
+	t.markPresent_(16, len(t.preview) > 0)
 
 }
 
 // PrependPreviewLink adds to the front of preview a LinkType type
 func (t *Application) PrependPreviewLink(v LinkType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Link: v}}, t.preview...)
+	t.markPresent_(16, len(t.preview) > 0)
 
 }
 
@@ -2388,6 +3311,22 @@ func (t *Application) RemovePreviewLink(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(16, len(t.preview) > 0)
+
+}
+
+// PreviewLinkValues returns an iterator over the values that GetPreviewLink returns for each index where IsPreviewLink is true, which can be used as an iter.Seq
+func (t *Application) PreviewLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewLink(i) {
+				continue
+			}
+			if !yield(t.GetPreviewLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2406,12 +3345,14 @@ func (t *Application) GetPreviewIRI(index int) (v *url.URL) {
 // AppendPreviewIRI adds to the back of preview a *url.URL type
 func (t *Application) AppendPreviewIRI(v *url.URL) {
 	t.preview = append(t.preview, &previewIntermediateType{IRI: v})
+	t.markPresent_(16, len(t.preview) > 0)
 
 }
 
 // PrependPreviewIRI adds to the front of preview a *url.URL type
 func (t *Application) PrependPreviewIRI(v *url.URL) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{IRI: v}}, t.preview...)
+	t.markPresent_(16, len(t.preview) > 0)
 
 }
 
@@ -2420,12 +3361,28 @@ func (t *Application) RemovePreviewIRI(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(16, len(t.preview) > 0)
+
+}
+
+// PreviewIRIValues returns an iterator over the values that GetPreviewIRI returns for each index where IsPreviewIRI is true, which can be used as an iter.Seq
+func (t *Application) PreviewIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewIRI(i) {
+				continue
+			}
+			if !yield(t.GetPreviewIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownPreview determines whether the call to GetUnknownPreview is safe
 func (t *Application) HasUnknownPreview() (ok bool) {
-	return t.preview != nil && t.preview[0].unknown_ != nil
+	return t.present_[0]&(1<<16) != 0 && t.preview[0].unknown_ != nil
 
 }
 
@@ -2443,12 +3400,13 @@ func (t *Application) SetUnknownPreview(i interface{}) {
 	tmp := &previewIntermediateType{}
 	tmp.unknown_ = i
 	t.preview = append(t.preview, tmp)
+	t.markPresent_(16, len(t.preview) > 0)
 
 }
 
 // IsPublished determines whether the call to GetPublished is safe
 func (t *Application) IsPublished() (ok bool) {
-	return t.published != nil && t.published.dateTime != nil
+	return t.present_[0]&(1<<17) != 0 && t.published.dateTime != nil
 
 }
 
@@ -2461,12 +3419,13 @@ func (t *Application) GetPublished() (v time.Time) {
 // SetPublished sets the value of published to be of time.Time type
 func (t *Application) SetPublished(v time.Time) {
 	t.published = &publishedIntermediateType{dateTime: &v}
+	t.markPresent_(17, true)
 
 }
 
 // IsPublishedIRI determines whether the call to GetPublishedIRI is safe
 func (t *Application) IsPublishedIRI() (ok bool) {
-	return t.published != nil && t.published.IRI != nil
+	return t.present_[0]&(1<<17) != 0 && t.published.IRI != nil
 
 }
 
@@ -2479,12 +3438,13 @@ func (t *Application) GetPublishedIRI() (v *url.URL) {
 // SetPublishedIRI sets the value of published to be of *url.URL type
 func (t *Application) SetPublishedIRI(v *url.URL) {
 	t.published = &publishedIntermediateType{IRI: v}
+	t.markPresent_(17, true)
 
 }
 
 // HasUnknownPublished determines whether the call to GetUnknownPublished is safe
 func (t *Application) HasUnknownPublished() (ok bool) {
-	return t.published != nil && t.published.unknown_ != nil
+	return t.present_[0]&(1<<17) != 0 && t.published.unknown_ != nil
 
 }
 
@@ -2502,12 +3462,13 @@ func (t *Application) SetUnknownPublished(i interface{}) {
 	tmp := &publishedIntermediateType{}
 	tmp.unknown_ = i
 	t.published = tmp
+	t.markPresent_(17, true)
 
 }
 
 // IsReplies determines whether the call to GetReplies is safe
 func (t *Application) IsReplies() (ok bool) {
-	return t.replies != nil && t.replies.Collection != nil
+	return t.present_[0]&(1<<18) != 0 && t.replies.Collection != nil
 
 }
 
@@ -2520,12 +3481,13 @@ func (t *Application) GetReplies() (v CollectionType) {
 // SetReplies sets the value of replies to be of CollectionType type
 func (t *Application) SetReplies(v CollectionType) {
 	t.replies = &repliesIntermediateType{Collection: v}
+	t.markPresent_(18, true)
 
 }
 
 // IsRepliesIRI determines whether the call to GetRepliesIRI is safe
 func (t *Application) IsRepliesIRI() (ok bool) {
-	return t.replies != nil && t.replies.IRI != nil
+	return t.present_[0]&(1<<18) != 0 && t.replies.IRI != nil
 
 }
 
@@ -2538,12 +3500,13 @@ func (t *Application) GetRepliesIRI() (v *url.URL) {
 // SetRepliesIRI sets the value of replies to be of *url.URL type
 func (t *Application) SetRepliesIRI(v *url.URL) {
 	t.replies = &repliesIntermediateType{IRI: v}
+	t.markPresent_(18, true)
 
 }
 
 // HasUnknownReplies determines whether the call to GetUnknownReplies is safe
 func (t *Application) HasUnknownReplies() (ok bool) {
-	return t.replies != nil && t.replies.unknown_ != nil
+	return t.present_[0]&(1<<18) != 0 && t.replies.unknown_ != nil
 
 }
 
@@ -2561,12 +3524,13 @@ func (t *Application) SetUnknownReplies(i interface{}) {
 	tmp := &repliesIntermediateType{}
 	tmp.unknown_ = i
 	t.replies = tmp
+	t.markPresent_(18, true)
 
 }
 
 // IsStartTime determines whether the call to GetStartTime is safe
 func (t *Application) IsStartTime() (ok bool) {
-	return t.startTime != nil && t.startTime.dateTime != nil
+	return t.present_[0]&(1<<19) != 0 && t.startTime.dateTime != nil
 
 }
 
@@ -2579,12 +3543,13 @@ func (t *Application) GetStartTime() (v time.Time) {
 // SetStartTime sets the value of startTime to be of time.Time type
 func (t *Application) SetStartTime(v time.Time) {
 	t.startTime = &startTimeIntermediateType{dateTime: &v}
+	t.markPresent_(19, true)
 
 }
 
 // IsStartTimeIRI determines whether the call to GetStartTimeIRI is safe
 func (t *Application) IsStartTimeIRI() (ok bool) {
-	return t.startTime != nil && t.startTime.IRI != nil
+	return t.present_[0]&(1<<19) != 0 && t.startTime.IRI != nil
 
 }
 
@@ -2597,12 +3562,13 @@ func (t *Application) GetStartTimeIRI() (v *url.URL) {
 // SetStartTimeIRI sets the value of startTime to be of *url.URL type
 func (t *Application) SetStartTimeIRI(v *url.URL) {
 	t.startTime = &startTimeIntermediateType{IRI: v}
+	t.markPresent_(19, true)
 
 }
 
 // HasUnknownStartTime determines whether the call to GetUnknownStartTime is safe
 func (t *Application) HasUnknownStartTime() (ok bool) {
-	return t.startTime != nil && t.startTime.unknown_ != nil
+	return t.present_[0]&(1<<19) != 0 && t.startTime.unknown_ != nil
 
 }
 
@@ -2620,6 +3586,7 @@ func (t *Application) SetUnknownStartTime(i interface{}) {
 	tmp := &startTimeIntermediateType{}
 	tmp.unknown_ = i
 	t.startTime = tmp
+	t.markPresent_(19, true)
 
 }
 
@@ -2644,12 +3611,14 @@ func (t *Application) GetSummaryString(index int) (v string) {
 // AppendSummaryString adds to the back of summary a string type
 func (t *Application) AppendSummaryString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{stringName: &v})
+	t.markPresent_(20, len(t.summary) > 0)
 
 }
 
 // PrependSummaryString adds to the front of summary a string type
 func (t *Application) PrependSummaryString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{stringName: &v}}, t.summary...)
+	t.markPresent_(20, len(t.summary) > 0)
 
 }
 
@@ -2658,6 +3627,22 @@ func (t *Application) RemoveSummaryString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(20, len(t.summary) > 0)
+
+}
+
+// SummaryStringValues returns an iterator over the values that GetSummaryString returns for each index where IsSummaryString is true, which can be used as an iter.Seq
+func (t *Application) SummaryStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2676,12 +3661,14 @@ func (t *Application) GetSummaryLangString(index int) (v string) {
 // AppendSummaryLangString adds to the back of summary a string type
 func (t *Application) AppendSummaryLangString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{langString: &v})
+	t.markPresent_(20, len(t.summary) > 0)
 
 }
 
 // PrependSummaryLangString adds to the front of summary a string type
 func (t *Application) PrependSummaryLangString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{langString: &v}}, t.summary...)
+	t.markPresent_(20, len(t.summary) > 0)
 
 }
 
@@ -2690,6 +3677,22 @@ func (t *Application) RemoveSummaryLangString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(20, len(t.summary) > 0)
+
+}
+
+// SummaryLangStringValues returns an iterator over the values that GetSummaryLangString returns for each index where IsSummaryLangString is true, which can be used as an iter.Seq
+func (t *Application) SummaryLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryLangString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2708,12 +3711,14 @@ func (t *Application) GetSummaryIRI(index int) (v *url.URL) {
 // AppendSummaryIRI adds to the back of summary a *url.URL type
 func (t *Application) AppendSummaryIRI(v *url.URL) {
 	t.summary = append(t.summary, &summaryIntermediateType{IRI: v})
+	t.markPresent_(20, len(t.summary) > 0)
 
 }
 
 // PrependSummaryIRI adds to the front of summary a *url.URL type
 func (t *Application) PrependSummaryIRI(v *url.URL) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{IRI: v}}, t.summary...)
+	t.markPresent_(20, len(t.summary) > 0)
 
 }
 
@@ -2722,12 +3727,28 @@ func (t *Application) RemoveSummaryIRI(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(20, len(t.summary) > 0)
+
+}
+
+// SummaryIRIValues returns an iterator over the values that GetSummaryIRI returns for each index where IsSummaryIRI is true, which can be used as an iter.Seq
+func (t *Application) SummaryIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryIRI(i) {
+				continue
+			}
+			if !yield(t.GetSummaryIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownSummary determines whether the call to GetUnknownSummary is safe
 func (t *Application) HasUnknownSummary() (ok bool) {
-	return t.summary != nil && t.summary[0].unknown_ != nil
+	return t.present_[0]&(1<<20) != 0 && t.summary[0].unknown_ != nil
 
 }
 
@@ -2745,6 +3766,7 @@ func (t *Application) SetUnknownSummary(i interface{}) {
 	tmp := &summaryIntermediateType{}
 	tmp.unknown_ = i
 	t.summary = append(t.summary, tmp)
+	t.markPresent_(20, len(t.summary) > 0)
 
 }
 
@@ -2778,11 +3800,41 @@ func (t *Application) GetSummaryMap(l string) (v string) {
 func (t *Application) SetSummaryMap(l string, v string) {
 	if t.summaryMap == nil {
 		t.summaryMap = make(map[string]string)
+		t.markPresent_(21, true)
 	}
 	t.summaryMap[l] = v
 
 }
 
+// GetSummaryLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Application) GetSummaryLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.summaryMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.summaryMap[k], true
+
+}
+
+// SetSummaryLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Application) SetSummaryLanguage(tag string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(21, true)
+	} else if k, ok := languageKey(t.summaryMap, tag); ok {
+		delete(t.summaryMap, k)
+	}
+	t.summaryMap[tag] = v
+
+}
+
+// PreferredSummaryLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Application) PreferredSummaryLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.summaryMap, tags)
+	return t.summaryMap[tag], tag
+
+}
+
 // TagLen determines the number of elements able to be used for the IsTagObject, GetTagObject, and RemoveTagObject functions
 func (t *Application) TagLen() (l int) {
 	return len(t.tag)
@@ -2804,12 +3856,14 @@ func (t *Application) GetTagObject(index int) (v ObjectType) {
 // AppendTagObject adds to the back of tag a ObjectType type
 func (t *Application) AppendTagObject(v ObjectType) {
 	t.tag = append(t.tag, &tagIntermediateType{Object: v})
+	t.markPresent_(22, len(t.tag) > 0)
 
 }
 
 // PrependTagObject adds to the front of tag a ObjectType type
 func (t *Application) PrependTagObject(v ObjectType) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Object: v}}, t.tag...)
+	t.markPresent_(22, len(t.tag) > 0)
 
 }
 
@@ -2818,6 +3872,22 @@ func (t *Application) RemoveTagObject(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(22, len(t.tag) > 0)
+
+}
+
+// TagObjectValues returns an iterator over the values that GetTagObject returns for each index where IsTagObject is true, which can be used as an iter.Seq
+func (t *Application) TagObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagObject(i) {
+				continue
+			}
+			if !yield(t.GetTagObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2836,12 +3906,14 @@ func (t *Application) GetTagLink(index int) (v LinkType) {
 // AppendTagLink adds to the back of tag a LinkType type
 func (t *Application) AppendTagLink(v LinkType) {
 	t.tag = append(t.tag, &tagIntermediateType{Link: v})
+	t.markPresent_(22, len(t.tag) > 0)
 
 }
 
 // PrependTagLink adds to the front of tag a LinkType type
 func (t *Application) PrependTagLink(v LinkType) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Link: v}}, t.tag...)
+	t.markPresent_(22, len(t.tag) > 0)
 
 }
 
@@ -2850,6 +3922,22 @@ func (t *Application) RemoveTagLink(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(22, len(t.tag) > 0)
+
+}
+
+// TagLinkValues returns an iterator over the values that GetTagLink returns for each index where IsTagLink is true, which can be used as an iter.Seq
+func (t *Application) TagLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagLink(i) {
+				continue
+			}
+			if !yield(t.GetTagLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2868,12 +3956,14 @@ func (t *Application) GetTagIRI(index int) (v *url.URL) {
 // AppendTagIRI adds to the back of tag a *url.URL type
 func (t *Application) AppendTagIRI(v *url.URL) {
 	t.tag = append(t.tag, &tagIntermediateType{IRI: v})
+	t.markPresent_(22, len(t.tag) > 0)
 
 }
 
 // PrependTagIRI adds to the front of tag a *url.URL type
 func (t *Application) PrependTagIRI(v *url.URL) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{IRI: v}}, t.tag...)
+	t.markPresent_(22, len(t.tag) > 0)
 
 }
 
@@ -2882,12 +3972,28 @@ func (t *Application) RemoveTagIRI(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(22, len(t.tag) > 0)
+
+}
+
+// TagIRIValues returns an iterator over the values that GetTagIRI returns for each index where IsTagIRI is true, which can be used as an iter.Seq
+func (t *Application) TagIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagIRI(i) {
+				continue
+			}
+			if !yield(t.GetTagIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownTag determines whether the call to GetUnknownTag is safe
 func (t *Application) HasUnknownTag() (ok bool) {
-	return t.tag != nil && t.tag[0].unknown_ != nil
+	return t.present_[0]&(1<<22) != 0 && t.tag[0].unknown_ != nil
 
 }
 
@@ -2905,6 +4011,7 @@ func (t *Application) SetUnknownTag(i interface{}) {
 	tmp := &tagIntermediateType{}
 	tmp.unknown_ = i
 	t.tag = append(t.tag, tmp)
+	t.markPresent_(22, len(t.tag) > 0)
 
 }
 
@@ -2923,12 +4030,14 @@ func (t *Application) GetType(index int) (v interface{}) {
 // AppendType adds a value to the back of type
 func (t *Application) AppendType(v interface{}) {
 	t.typeName = append(t.typeName, v)
+	t.markPresent_(23, len(t.typeName) > 0)
 
 }
 
 // PrependType adds a value to the front of type
 func (t *Application) PrependType(v interface{}) {
 	t.typeName = append([]interface{}{v}, t.typeName...)
+	t.markPresent_(23, len(t.typeName) > 0)
 
 }
 
@@ -2937,12 +4046,13 @@ func (t *Application) RemoveType(index int) {
 	copy(t.typeName[index:], t.typeName[index+1:])
 	t.typeName[len(t.typeName)-1] = nil
 	t.typeName = t.typeName[:len(t.typeName)-1]
+	t.markPresent_(23, len(t.typeName) > 0)
 
 }
 
 // IsUpdated determines whether the call to GetUpdated is safe
 func (t *Application) IsUpdated() (ok bool) {
-	return t.updated != nil && t.updated.dateTime != nil
+	return t.present_[0]&(1<<24) != 0 && t.updated.dateTime != nil
 
 }
 
@@ -2955,12 +4065,13 @@ func (t *Application) GetUpdated() (v time.Time) {
 // SetUpdated sets the value of updated to be of time.Time type
 func (t *Application) SetUpdated(v time.Time) {
 	t.updated = &updatedIntermediateType{dateTime: &v}
+	t.markPresent_(24, true)
 
 }
 
 // IsUpdatedIRI determines whether the call to GetUpdatedIRI is safe
 func (t *Application) IsUpdatedIRI() (ok bool) {
-	return t.updated != nil && t.updated.IRI != nil
+	return t.present_[0]&(1<<24) != 0 && t.updated.IRI != nil
 
 }
 
@@ -2973,12 +4084,13 @@ func (t *Application) GetUpdatedIRI() (v *url.URL) {
 // SetUpdatedIRI sets the value of updated to be of *url.URL type
 func (t *Application) SetUpdatedIRI(v *url.URL) {
 	t.updated = &updatedIntermediateType{IRI: v}
+	t.markPresent_(24, true)
 
 }
 
 // HasUnknownUpdated determines whether the call to GetUnknownUpdated is safe
 func (t *Application) HasUnknownUpdated() (ok bool) {
-	return t.updated != nil && t.updated.unknown_ != nil
+	return t.present_[0]&(1<<24) != 0 && t.updated.unknown_ != nil
 
 }
 
@@ -2996,6 +4108,7 @@ func (t *Application) SetUnknownUpdated(i interface{}) {
 	tmp := &updatedIntermediateType{}
 	tmp.unknown_ = i
 	t.updated = tmp
+	t.markPresent_(24, true)
 
 }
 
@@ -3020,12 +4133,14 @@ func (t *Application) GetUrlAnyURI(index int) (v *url.URL) {
 // AppendUrlAnyURI adds to the back of url a *url.URL type
 func (t *Application) AppendUrlAnyURI(v *url.URL) {
 	t.url = append(t.url, &urlIntermediateType{anyURI: v})
+	t.markPresent_(25, len(t.url) > 0)
 
 }
 
 // PrependUrlAnyURI adds to the front of url a *url.URL type
 func (t *Application) PrependUrlAnyURI(v *url.URL) {
 	t.url = append([]*urlIntermediateType{&urlIntermediateType{anyURI: v}}, t.url...)
+	t.markPresent_(25, len(t.url) > 0)
 
 }
 
@@ -3034,6 +4149,22 @@ func (t *Application) RemoveUrlAnyURI(index int) {
 	copy(t.url[index:], t.url[index+1:])
 	t.url[len(t.url)-1] = nil
 	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(25, len(t.url) > 0)
+
+}
+
+// UrlAnyURIValues returns an iterator over the values that GetUrlAnyURI returns for each index where IsUrlAnyURI is true, which can be used as an iter.Seq
+func (t *Application) UrlAnyURIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlAnyURI(i) {
+				continue
+			}
+			if !yield(t.GetUrlAnyURI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3052,12 +4183,14 @@ func (t *Application) GetUrlLink(index int) (v LinkType) {
 // AppendUrlLink adds to the back of url a LinkType type
 func (t *Application) AppendUrlLink(v LinkType) {
 	t.url = append(t.url, &urlIntermediateType{Link: v})
+	t.markPresent_(25, len(t.url) > 0)
 
 }
 
 // PrependUrlLink adds to the front of url a LinkType type
 func (t *Application) PrependUrlLink(v LinkType) {
 	t.url = append([]*urlIntermediateType{&urlIntermediateType{Link: v}}, t.url...)
+	t.markPresent_(25, len(t.url) > 0)
 
 }
 
@@ -3066,12 +4199,28 @@ func (t *Application) RemoveUrlLink(index int) {
 	copy(t.url[index:], t.url[index+1:])
 	t.url[len(t.url)-1] = nil
 	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(25, len(t.url) > 0)
+
+}
+
+// UrlLinkValues returns an iterator over the values that GetUrlLink returns for each index where IsUrlLink is true, which can be used as an iter.Seq
+func (t *Application) UrlLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlLink(i) {
+				continue
+			}
+			if !yield(t.GetUrlLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownUrl determines whether the call to GetUnknownUrl is safe
 func (t *Application) HasUnknownUrl() (ok bool) {
-	return t.url != nil && t.url[0].unknown_ != nil
+	return t.present_[0]&(1<<25) != 0 && t.url[0].unknown_ != nil
 
 }
 
@@ -3089,6 +4238,7 @@ func (t *Application) SetUnknownUrl(i interface{}) {
 	tmp := &urlIntermediateType{}
 	tmp.unknown_ = i
 	t.url = append(t.url, tmp)
+	t.markPresent_(25, len(t.url) > 0)
 
 }
 
@@ -3113,12 +4263,14 @@ func (t *Application) GetToObject(index int) (v ObjectType) {
 // AppendToObject adds to the back of to a ObjectType type
 func (t *Application) AppendToObject(v ObjectType) {
 	t.to = append(t.to, &toIntermediateType{Object: v})
+	t.markPresent_(26, len(t.to) > 0)
 
 }
 
 // PrependToObject adds to the front of to a ObjectType type
 func (t *Application) PrependToObject(v ObjectType) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{Object: v}}, t.to...)
+	t.markPresent_(26, len(t.to) > 0)
 
 }
 
@@ -3127,6 +4279,22 @@ func (t *Application) RemoveToObject(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(26, len(t.to) > 0)
+
+}
+
+// ToObjectValues returns an iterator over the values that GetToObject returns for each index where IsToObject is true, which can be used as an iter.Seq
+func (t *Application) ToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToObject(i) {
+				continue
+			}
+			if !yield(t.GetToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3145,12 +4313,14 @@ func (t *Application) GetToLink(index int) (v LinkType) {
 // AppendToLink adds to the back of to a LinkType type
 func (t *Application) AppendToLink(v LinkType) {
 	t.to = append(t.to, &toIntermediateType{Link: v})
+	t.markPresent_(26, len(t.to) > 0)
 
 }
 
 // PrependToLink adds to the front of to a LinkType type
 func (t *Application) PrependToLink(v LinkType) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{Link: v}}, t.to...)
+	t.markPresent_(26, len(t.to) > 0)
 
 }
 
@@ -3159,6 +4329,22 @@ func (t *Application) RemoveToLink(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(26, len(t.to) > 0)
+
+}
+
+// ToLinkValues returns an iterator over the values that GetToLink returns for each index where IsToLink is true, which can be used as an iter.Seq
+func (t *Application) ToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToLink(i) {
+				continue
+			}
+			if !yield(t.GetToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3177,12 +4363,14 @@ func (t *Application) GetToIRI(index int) (v *url.URL) {
 // AppendToIRI adds to the back of to a *url.URL type
 func (t *Application) AppendToIRI(v *url.URL) {
 	t.to = append(t.to, &toIntermediateType{IRI: v})
+	t.markPresent_(26, len(t.to) > 0)
 
 }
 
 // PrependToIRI adds to the front of to a *url.URL type
 func (t *Application) PrependToIRI(v *url.URL) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{IRI: v}}, t.to...)
+	t.markPresent_(26, len(t.to) > 0)
 
 }
 
@@ -3191,12 +4379,28 @@ func (t *Application) RemoveToIRI(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(26, len(t.to) > 0)
+
+}
+
+// ToIRIValues returns an iterator over the values that GetToIRI returns for each index where IsToIRI is true, which can be used as an iter.Seq
+func (t *Application) ToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToIRI(i) {
+				continue
+			}
+			if !yield(t.GetToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownTo determines whether the call to GetUnknownTo is safe
 func (t *Application) HasUnknownTo() (ok bool) {
-	return t.to != nil && t.to[0].unknown_ != nil
+	return t.present_[0]&(1<<26) != 0 && t.to[0].unknown_ != nil
 
 }
 
@@ -3214,6 +4418,7 @@ func (t *Application) SetUnknownTo(i interface{}) {
 	tmp := &toIntermediateType{}
 	tmp.unknown_ = i
 	t.to = append(t.to, tmp)
+	t.markPresent_(26, len(t.to) > 0)
 
 }
 
@@ -3238,12 +4443,14 @@ func (t *Application) GetBtoObject(index int) (v ObjectType) {
 // AppendBtoObject adds to the back of bto a ObjectType type
 func (t *Application) AppendBtoObject(v ObjectType) {
 	t.bto = append(t.bto, &btoIntermediateType{Object: v})
+	t.markPresent_(27, len(t.bto) > 0)
 
 }
 
 // PrependBtoObject adds to the front of bto a ObjectType type
 func (t *Application) PrependBtoObject(v ObjectType) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Object: v}}, t.bto...)
+	t.markPresent_(27, len(t.bto) > 0)
 
 }
 
@@ -3252,6 +4459,22 @@ func (t *Application) RemoveBtoObject(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(27, len(t.bto) > 0)
+
+}
+
+// BtoObjectValues returns an iterator over the values that GetBtoObject returns for each index where IsBtoObject is true, which can be used as an iter.Seq
+func (t *Application) BtoObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoObject(i) {
+				continue
+			}
+			if !yield(t.GetBtoObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3270,12 +4493,14 @@ func (t *Application) GetBtoLink(index int) (v LinkType) {
 // AppendBtoLink adds to the back of bto a LinkType type
 func (t *Application) AppendBtoLink(v LinkType) {
 	t.bto = append(t.bto, &btoIntermediateType{Link: v})
+	t.markPresent_(27, len(t.bto) > 0)
 
 }
 
 // PrependBtoLink adds to the front of bto a LinkType type
 func (t *Application) PrependBtoLink(v LinkType) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Link: v}}, t.bto...)
+	t.markPresent_(27, len(t.bto) > 0)
 
 }
 
@@ -3284,6 +4509,22 @@ func (t *Application) RemoveBtoLink(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(27, len(t.bto) > 0)
+
+}
+
+// BtoLinkValues returns an iterator over the values that GetBtoLink returns for each index where IsBtoLink is true, which can be used as an iter.Seq
+func (t *Application) BtoLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoLink(i) {
+				continue
+			}
+			if !yield(t.GetBtoLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3302,12 +4543,14 @@ func (t *Application) GetBtoIRI(index int) (v *url.URL) {
 // AppendBtoIRI adds to the back of bto a *url.URL type
 func (t *Application) AppendBtoIRI(v *url.URL) {
 	t.bto = append(t.bto, &btoIntermediateType{IRI: v})
+	t.markPresent_(27, len(t.bto) > 0)
 
 }
 
 // PrependBtoIRI adds to the front of bto a *url.URL type
 func (t *Application) PrependBtoIRI(v *url.URL) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{IRI: v}}, t.bto...)
+	t.markPresent_(27, len(t.bto) > 0)
 
 }
 
@@ -3316,12 +4559,28 @@ func (t *Application) RemoveBtoIRI(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(27, len(t.bto) > 0)
+
+}
+
+// BtoIRIValues returns an iterator over the values that GetBtoIRI returns for each index where IsBtoIRI is true, which can be used as an iter.Seq
+func (t *Application) BtoIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoIRI(i) {
+				continue
+			}
+			if !yield(t.GetBtoIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownBto determines whether the call to GetUnknownBto is safe
 func (t *Application) HasUnknownBto() (ok bool) {
-	return t.bto != nil && t.bto[0].unknown_ != nil
+	return t.present_[0]&(1<<27) != 0 && t.bto[0].unknown_ != nil
 
 }
 
@@ -3339,6 +4598,7 @@ func (t *Application) SetUnknownBto(i interface{}) {
 	tmp := &btoIntermediateType{}
 	tmp.unknown_ = i
 	t.bto = append(t.bto, tmp)
+	t.markPresent_(27, len(t.bto) > 0)
 
 }
 
@@ -3363,12 +4623,14 @@ func (t *Application) GetCcObject(index int) (v ObjectType) {
 // AppendCcObject adds to the back of cc a ObjectType type
 func (t *Application) AppendCcObject(v ObjectType) {
 	t.cc = append(t.cc, &ccIntermediateType{Object: v})
+	t.markPresent_(28, len(t.cc) > 0)
 
 }
 
 // PrependCcObject adds to the front of cc a ObjectType type
 func (t *Application) PrependCcObject(v ObjectType) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Object: v}}, t.cc...)
+	t.markPresent_(28, len(t.cc) > 0)
 
 }
 
@@ -3377,6 +4639,22 @@ func (t *Application) RemoveCcObject(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(28, len(t.cc) > 0)
+
+}
+
+// CcObjectValues returns an iterator over the values that GetCcObject returns for each index where IsCcObject is true, which can be used as an iter.Seq
+func (t *Application) CcObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcObject(i) {
+				continue
+			}
+			if !yield(t.GetCcObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3395,12 +4673,14 @@ func (t *Application) GetCcLink(index int) (v LinkType) {
 // AppendCcLink adds to the back of cc a LinkType type
 func (t *Application) AppendCcLink(v LinkType) {
 	t.cc = append(t.cc, &ccIntermediateType{Link: v})
+	t.markPresent_(28, len(t.cc) > 0)
 
 }
 
 // PrependCcLink adds to the front of cc a LinkType type
 func (t *Application) PrependCcLink(v LinkType) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Link: v}}, t.cc...)
+	t.markPresent_(28, len(t.cc) > 0)
 
 }
 
@@ -3409,6 +4689,22 @@ func (t *Application) RemoveCcLink(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(28, len(t.cc) > 0)
+
+}
+
+// CcLinkValues returns an iterator over the values that GetCcLink returns for each index where IsCcLink is true, which can be used as an iter.Seq
+func (t *Application) CcLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcLink(i) {
+				continue
+			}
+			if !yield(t.GetCcLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3427,12 +4723,14 @@ func (t *Application) GetCcIRI(index int) (v *url.URL) {
 // AppendCcIRI adds to the back of cc a *url.URL type
 func (t *Application) AppendCcIRI(v *url.URL) {
 	t.cc = append(t.cc, &ccIntermediateType{IRI: v})
+	t.markPresent_(28, len(t.cc) > 0)
 
 }
 
 // PrependCcIRI adds to the front of cc a *url.URL type
 func (t *Application) PrependCcIRI(v *url.URL) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{IRI: v}}, t.cc...)
+	t.markPresent_(28, len(t.cc) > 0)
 
 }
 
@@ -3441,12 +4739,28 @@ func (t *Application) RemoveCcIRI(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(28, len(t.cc) > 0)
+
+}
+
+// CcIRIValues returns an iterator over the values that GetCcIRI returns for each index where IsCcIRI is true, which can be used as an iter.Seq
+func (t *Application) CcIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcIRI(i) {
+				continue
+			}
+			if !yield(t.GetCcIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownCc determines whether the call to GetUnknownCc is safe
 func (t *Application) HasUnknownCc() (ok bool) {
-	return t.cc != nil && t.cc[0].unknown_ != nil
+	return t.present_[0]&(1<<28) != 0 && t.cc[0].unknown_ != nil
 
 }
 
@@ -3464,6 +4778,7 @@ func (t *Application) SetUnknownCc(i interface{}) {
 	tmp := &ccIntermediateType{}
 	tmp.unknown_ = i
 	t.cc = append(t.cc, tmp)
+	t.markPresent_(28, len(t.cc) > 0)
 
 }
 
@@ -3488,12 +4803,14 @@ func (t *Application) GetBccObject(index int) (v ObjectType) {
 // AppendBccObject adds to the back of bcc a ObjectType type
 func (t *Application) AppendBccObject(v ObjectType) {
 	t.bcc = append(t.bcc, &bccIntermediateType{Object: v})
+	t.markPresent_(29, len(t.bcc) > 0)
 
 }
 
 // PrependBccObject adds to the front of bcc a ObjectType type
 func (t *Application) PrependBccObject(v ObjectType) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Object: v}}, t.bcc...)
+	t.markPresent_(29, len(t.bcc) > 0)
 
 }
 
@@ -3502,6 +4819,22 @@ func (t *Application) RemoveBccObject(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(29, len(t.bcc) > 0)
+
+}
+
+// BccObjectValues returns an iterator over the values that GetBccObject returns for each index where IsBccObject is true, which can be used as an iter.Seq
+func (t *Application) BccObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccObject(i) {
+				continue
+			}
+			if !yield(t.GetBccObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3520,12 +4853,14 @@ func (t *Application) GetBccLink(index int) (v LinkType) {
 // AppendBccLink adds to the back of bcc a LinkType type
 func (t *Application) AppendBccLink(v LinkType) {
 	t.bcc = append(t.bcc, &bccIntermediateType{Link: v})
+	t.markPresent_(29, len(t.bcc) > 0)
 
 }
 
 // PrependBccLink adds to the front of bcc a LinkType type
 func (t *Application) PrependBccLink(v LinkType) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Link: v}}, t.bcc...)
+	t.markPresent_(29, len(t.bcc) > 0)
 
 }
 
@@ -3534,6 +4869,22 @@ func (t *Application) RemoveBccLink(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(29, len(t.bcc) > 0)
+
+}
+
+// BccLinkValues returns an iterator over the values that GetBccLink returns for each index where IsBccLink is true, which can be used as an iter.Seq
+func (t *Application) BccLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccLink(i) {
+				continue
+			}
+			if !yield(t.GetBccLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3552,12 +4903,14 @@ func (t *Application) GetBccIRI(index int) (v *url.URL) {
 // AppendBccIRI adds to the back of bcc a *url.URL type
 func (t *Application) AppendBccIRI(v *url.URL) {
 	t.bcc = append(t.bcc, &bccIntermediateType{IRI: v})
+	t.markPresent_(29, len(t.bcc) > 0)
 
 }
 
 // PrependBccIRI adds to the front of bcc a *url.URL type
 func (t *Application) PrependBccIRI(v *url.URL) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{IRI: v}}, t.bcc...)
+	t.markPresent_(29, len(t.bcc) > 0)
 
 }
 
@@ -3566,12 +4919,28 @@ func (t *Application) RemoveBccIRI(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(29, len(t.bcc) > 0)
+
+}
+
+// BccIRIValues returns an iterator over the values that GetBccIRI returns for each index where IsBccIRI is true, which can be used as an iter.Seq
+func (t *Application) BccIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccIRI(i) {
+				continue
+			}
+			if !yield(t.GetBccIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownBcc determines whether the call to GetUnknownBcc is safe
 func (t *Application) HasUnknownBcc() (ok bool) {
-	return t.bcc != nil && t.bcc[0].unknown_ != nil
+	return t.present_[0]&(1<<29) != 0 && t.bcc[0].unknown_ != nil
 
 }
 
@@ -3589,12 +4958,13 @@ func (t *Application) SetUnknownBcc(i interface{}) {
 	tmp := &bccIntermediateType{}
 	tmp.unknown_ = i
 	t.bcc = append(t.bcc, tmp)
+	t.markPresent_(29, len(t.bcc) > 0)
 
 }
 
 // IsMediaType determines whether the call to GetMediaType is safe
 func (t *Application) IsMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.mimeMediaTypeValue != nil
+	return t.present_[0]&(1<<30) != 0 && t.mediaType.mimeMediaTypeValue != nil
 
 }
 
@@ -3607,12 +4977,13 @@ func (t *Application) GetMediaType() (v string) {
 // SetMediaType sets the value of mediaType to be of string type
 func (t *Application) SetMediaType(v string) {
 	t.mediaType = &mediaTypeIntermediateType{mimeMediaTypeValue: &v}
+	t.markPresent_(30, true)
 
 }
 
 // IsMediaTypeIRI determines whether the call to GetMediaTypeIRI is safe
 func (t *Application) IsMediaTypeIRI() (ok bool) {
-	return t.mediaType != nil && t.mediaType.IRI != nil
+	return t.present_[0]&(1<<30) != 0 && t.mediaType.IRI != nil
 
 }
 
@@ -3625,12 +4996,13 @@ func (t *Application) GetMediaTypeIRI() (v *url.URL) {
 // SetMediaTypeIRI sets the value of mediaType to be of *url.URL type
 func (t *Application) SetMediaTypeIRI(v *url.URL) {
 	t.mediaType = &mediaTypeIntermediateType{IRI: v}
+	t.markPresent_(30, true)
 
 }
 
 // HasUnknownMediaType determines whether the call to GetUnknownMediaType is safe
 func (t *Application) HasUnknownMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.unknown_ != nil
+	return t.present_[0]&(1<<30) != 0 && t.mediaType.unknown_ != nil
 
 }
 
@@ -3648,12 +5020,13 @@ func (t *Application) SetUnknownMediaType(i interface{}) {
 	tmp := &mediaTypeIntermediateType{}
 	tmp.unknown_ = i
 	t.mediaType = tmp
+	t.markPresent_(30, true)
 
 }
 
 // IsDuration determines whether the call to GetDuration is safe
 func (t *Application) IsDuration() (ok bool) {
-	return t.duration != nil && t.duration.duration != nil
+	return t.present_[0]&(1<<31) != 0 && t.duration.duration != nil
 
 }
 
@@ -3666,12 +5039,13 @@ func (t *Application) GetDuration() (v time.Duration) {
 // SetDuration sets the value of duration to be of time.Duration type
 func (t *Application) SetDuration(v time.Duration) {
 	t.duration = &durationIntermediateType{duration: &v}
+	t.markPresent_(31, true)
 
 }
 
 // IsDurationIRI determines whether the call to GetDurationIRI is safe
 func (t *Application) IsDurationIRI() (ok bool) {
-	return t.duration != nil && t.duration.IRI != nil
+	return t.present_[0]&(1<<31) != 0 && t.duration.IRI != nil
 
 }
 
@@ -3684,12 +5058,13 @@ func (t *Application) GetDurationIRI() (v *url.URL) {
 // SetDurationIRI sets the value of duration to be of *url.URL type
 func (t *Application) SetDurationIRI(v *url.URL) {
 	t.duration = &durationIntermediateType{IRI: v}
+	t.markPresent_(31, true)
 
 }
 
 // HasUnknownDuration determines whether the call to GetUnknownDuration is safe
 func (t *Application) HasUnknownDuration() (ok bool) {
-	return t.duration != nil && t.duration.unknown_ != nil
+	return t.present_[0]&(1<<31) != 0 && t.duration.unknown_ != nil
 
 }
 
@@ -3707,12 +5082,13 @@ func (t *Application) SetUnknownDuration(i interface{}) {
 	tmp := &durationIntermediateType{}
 	tmp.unknown_ = i
 	t.duration = tmp
+	t.markPresent_(31, true)
 
 }
 
 // IsSource determines whether the call to GetSource is safe
 func (t *Application) IsSource() (ok bool) {
-	return t.source != nil && t.source.Object != nil
+	return t.present_[0]&(1<<32) != 0 && t.source.Object != nil
 
 }
 
@@ -3725,12 +5101,13 @@ func (t *Application) GetSource() (v ObjectType) {
 // SetSource sets the value of source to be of ObjectType type
 func (t *Application) SetSource(v ObjectType) {
 	t.source = &sourceIntermediateType{Object: v}
+	t.markPresent_(32, true)
 
 }
 
 // IsSourceIRI determines whether the call to GetSourceIRI is safe
 func (t *Application) IsSourceIRI() (ok bool) {
-	return t.source != nil && t.source.IRI != nil
+	return t.present_[0]&(1<<32) != 0 && t.source.IRI != nil
 
 }
 
@@ -3743,12 +5120,13 @@ func (t *Application) GetSourceIRI() (v *url.URL) {
 // SetSourceIRI sets the value of source to be of *url.URL type
 func (t *Application) SetSourceIRI(v *url.URL) {
 	t.source = &sourceIntermediateType{IRI: v}
+	t.markPresent_(32, true)
 
 }
 
 // HasUnknownSource determines whether the call to GetUnknownSource is safe
 func (t *Application) HasUnknownSource() (ok bool) {
-	return t.source != nil && t.source.unknown_ != nil
+	return t.present_[0]&(1<<32) != 0 && t.source.unknown_ != nil
 
 }
 
@@ -3766,12 +5144,13 @@ func (t *Application) SetUnknownSource(i interface{}) {
 	tmp := &sourceIntermediateType{}
 	tmp.unknown_ = i
 	t.source = tmp
+	t.markPresent_(32, true)
 
 }
 
 // IsInboxOrderedCollection determines whether the call to GetInboxOrderedCollection is safe
 func (t *Application) IsInboxOrderedCollection() (ok bool) {
-	return t.inbox != nil && t.inbox.OrderedCollection != nil
+	return t.present_[0]&(1<<33) != 0 && t.inbox.OrderedCollection != nil
 
 }
 
@@ -3784,12 +5163,13 @@ func (t *Application) GetInboxOrderedCollection() (v OrderedCollectionType) {
 // SetInboxOrderedCollection sets the value of inbox to be of OrderedCollectionType type
 func (t *Application) SetInboxOrderedCollection(v OrderedCollectionType) {
 	t.inbox = &inboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(33, true)
 
 }
 
 // IsInboxAnyURI determines whether the call to GetInboxAnyURI is safe
 func (t *Application) IsInboxAnyURI() (ok bool) {
-	return t.inbox != nil && t.inbox.anyURI != nil
+	return t.present_[0]&(1<<33) != 0 && t.inbox.anyURI != nil
 
 }
 
@@ -3802,12 +5182,13 @@ func (t *Application) GetInboxAnyURI() (v *url.URL) {
 // SetInboxAnyURI sets the value of inbox to be of *url.URL type
 func (t *Application) SetInboxAnyURI(v *url.URL) {
 	t.inbox = &inboxIntermediateType{anyURI: v}
+	t.markPresent_(33, true)
 
 }
 
 // HasUnknownInbox determines whether the call to GetUnknownInbox is safe
 func (t *Application) HasUnknownInbox() (ok bool) {
-	return t.inbox != nil && t.inbox.unknown_ != nil
+	return t.present_[0]&(1<<33) != 0 && t.inbox.unknown_ != nil
 
 }
 
@@ -3825,12 +5206,13 @@ func (t *Application) SetUnknownInbox(i interface{}) {
 	tmp := &inboxIntermediateType{}
 	tmp.unknown_ = i
 	t.inbox = tmp
+	t.markPresent_(33, true)
 
 }
 
 // IsOutboxOrderedCollection determines whether the call to GetOutboxOrderedCollection is safe
 func (t *Application) IsOutboxOrderedCollection() (ok bool) {
-	return t.outbox != nil && t.outbox.OrderedCollection != nil
+	return t.present_[0]&(1<<34) != 0 && t.outbox.OrderedCollection != nil
 
 }
 
@@ -3843,12 +5225,13 @@ func (t *Application) GetOutboxOrderedCollection() (v OrderedCollectionType) {
 // SetOutboxOrderedCollection sets the value of outbox to be of OrderedCollectionType type
 func (t *Application) SetOutboxOrderedCollection(v OrderedCollectionType) {
 	t.outbox = &outboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(34, true)
 
 }
 
 // IsOutboxAnyURI determines whether the call to GetOutboxAnyURI is safe
 func (t *Application) IsOutboxAnyURI() (ok bool) {
-	return t.outbox != nil && t.outbox.anyURI != nil
+	return t.present_[0]&(1<<34) != 0 && t.outbox.anyURI != nil
 
 }
 
@@ -3861,12 +5244,13 @@ func (t *Application) GetOutboxAnyURI() (v *url.URL) {
 // SetOutboxAnyURI sets the value of outbox to be of *url.URL type
 func (t *Application) SetOutboxAnyURI(v *url.URL) {
 	t.outbox = &outboxIntermediateType{anyURI: v}
+	t.markPresent_(34, true)
 
 }
 
 // HasUnknownOutbox determines whether the call to GetUnknownOutbox is safe
 func (t *Application) HasUnknownOutbox() (ok bool) {
-	return t.outbox != nil && t.outbox.unknown_ != nil
+	return t.present_[0]&(1<<34) != 0 && t.outbox.unknown_ != nil
 
 }
 
@@ -3884,12 +5268,13 @@ func (t *Application) SetUnknownOutbox(i interface{}) {
 	tmp := &outboxIntermediateType{}
 	tmp.unknown_ = i
 	t.outbox = tmp
+	t.markPresent_(34, true)
 
 }
 
 // IsFollowingCollection determines whether the call to GetFollowingCollection is safe
 func (t *Application) IsFollowingCollection() (ok bool) {
-	return t.following != nil && t.following.Collection != nil
+	return t.present_[0]&(1<<35) != 0 && t.following.Collection != nil
 
 }
 
@@ -3902,12 +5287,13 @@ func (t *Application) GetFollowingCollection() (v CollectionType) {
 // SetFollowingCollection sets the value of following to be of CollectionType type
 func (t *Application) SetFollowingCollection(v CollectionType) {
 	t.following = &followingIntermediateType{Collection: v}
+	t.markPresent_(35, true)
 
 }
 
 // IsFollowingOrderedCollection determines whether the call to GetFollowingOrderedCollection is safe
 func (t *Application) IsFollowingOrderedCollection() (ok bool) {
-	return t.following != nil && t.following.OrderedCollection != nil
+	return t.present_[0]&(1<<35) != 0 && t.following.OrderedCollection != nil
 
 }
 
@@ -3920,12 +5306,13 @@ func (t *Application) GetFollowingOrderedCollection() (v OrderedCollectionType)
 // SetFollowingOrderedCollection sets the value of following to be of OrderedCollectionType type
 func (t *Application) SetFollowingOrderedCollection(v OrderedCollectionType) {
 	t.following = &followingIntermediateType{OrderedCollection: v}
+	t.markPresent_(35, true)
 
 }
 
 // IsFollowingAnyURI determines whether the call to GetFollowingAnyURI is safe
 func (t *Application) IsFollowingAnyURI() (ok bool) {
-	return t.following != nil && t.following.anyURI != nil
+	return t.present_[0]&(1<<35) != 0 && t.following.anyURI != nil
 
 }
 
@@ -3938,12 +5325,13 @@ func (t *Application) GetFollowingAnyURI() (v *url.URL) {
 // SetFollowingAnyURI sets the value of following to be of *url.URL type
 func (t *Application) SetFollowingAnyURI(v *url.URL) {
 	t.following = &followingIntermediateType{anyURI: v}
+	t.markPresent_(35, true)
 
 }
 
 // HasUnknownFollowing determines whether the call to GetUnknownFollowing is safe
 func (t *Application) HasUnknownFollowing() (ok bool) {
-	return t.following != nil && t.following.unknown_ != nil
+	return t.present_[0]&(1<<35) != 0 && t.following.unknown_ != nil
 
 }
 
@@ -3961,12 +5349,13 @@ func (t *Application) SetUnknownFollowing(i interface{}) {
 	tmp := &followingIntermediateType{}
 	tmp.unknown_ = i
 	t.following = tmp
+	t.markPresent_(35, true)
 
 }
 
 // IsFollowersCollection determines whether the call to GetFollowersCollection is safe
 func (t *Application) IsFollowersCollection() (ok bool) {
-	return t.followers != nil && t.followers.Collection != nil
+	return t.present_[0]&(1<<36) != 0 && t.followers.Collection != nil
 
 }
 
@@ -3979,12 +5368,13 @@ func (t *Application) GetFollowersCollection() (v CollectionType) {
 // SetFollowersCollection sets the value of followers to be of CollectionType type
 func (t *Application) SetFollowersCollection(v CollectionType) {
 	t.followers = &followersIntermediateType{Collection: v}
+	t.markPresent_(36, true)
 
 }
 
 // IsFollowersOrderedCollection determines whether the call to GetFollowersOrderedCollection is safe
 func (t *Application) IsFollowersOrderedCollection() (ok bool) {
-	return t.followers != nil && t.followers.OrderedCollection != nil
+	return t.present_[0]&(1<<36) != 0 && t.followers.OrderedCollection != nil
 
 }
 
@@ -3997,12 +5387,13 @@ func (t *Application) GetFollowersOrderedCollection() (v OrderedCollectionType)
 // SetFollowersOrderedCollection sets the value of followers to be of OrderedCollectionType type
 func (t *Application) SetFollowersOrderedCollection(v OrderedCollectionType) {
 	t.followers = &followersIntermediateType{OrderedCollection: v}
+	t.markPresent_(36, true)
 
 }
 
 // IsFollowersAnyURI determines whether the call to GetFollowersAnyURI is safe
 func (t *Application) IsFollowersAnyURI() (ok bool) {
-	return t.followers != nil && t.followers.anyURI != nil
+	return t.present_[0]&(1<<36) != 0 && t.followers.anyURI != nil
 
 }
 
@@ -4015,12 +5406,13 @@ func (t *Application) GetFollowersAnyURI() (v *url.URL) {
 // SetFollowersAnyURI sets the value of followers to be of *url.URL type
 func (t *Application) SetFollowersAnyURI(v *url.URL) {
 	t.followers = &followersIntermediateType{anyURI: v}
+	t.markPresent_(36, true)
 
 }
 
 // HasUnknownFollowers determines whether the call to GetUnknownFollowers is safe
 func (t *Application) HasUnknownFollowers() (ok bool) {
-	return t.followers != nil && t.followers.unknown_ != nil
+	return t.present_[0]&(1<<36) != 0 && t.followers.unknown_ != nil
 
 }
 
@@ -4038,12 +5430,13 @@ func (t *Application) SetUnknownFollowers(i interface{}) {
 	tmp := &followersIntermediateType{}
 	tmp.unknown_ = i
 	t.followers = tmp
+	t.markPresent_(36, true)
 
 }
 
 // IsLikedCollection determines whether the call to GetLikedCollection is safe
 func (t *Application) IsLikedCollection() (ok bool) {
-	return t.liked != nil && t.liked.Collection != nil
+	return t.present_[0]&(1<<37) != 0 && t.liked.Collection != nil
 
 }
 
@@ -4056,12 +5449,13 @@ func (t *Application) GetLikedCollection() (v CollectionType) {
 // SetLikedCollection sets the value of liked to be of CollectionType type
 func (t *Application) SetLikedCollection(v CollectionType) {
 	t.liked = &likedIntermediateType{Collection: v}
+	t.markPresent_(37, true)
 
 }
 
 // IsLikedOrderedCollection determines whether the call to GetLikedOrderedCollection is safe
 func (t *Application) IsLikedOrderedCollection() (ok bool) {
-	return t.liked != nil && t.liked.OrderedCollection != nil
+	return t.present_[0]&(1<<37) != 0 && t.liked.OrderedCollection != nil
 
 }
 
@@ -4074,12 +5468,13 @@ func (t *Application) GetLikedOrderedCollection() (v OrderedCollectionType) {
 // SetLikedOrderedCollection sets the value of liked to be of OrderedCollectionType type
 func (t *Application) SetLikedOrderedCollection(v OrderedCollectionType) {
 	t.liked = &likedIntermediateType{OrderedCollection: v}
+	t.markPresent_(37, true)
 
 }
 
 // IsLikedAnyURI determines whether the call to GetLikedAnyURI is safe
 func (t *Application) IsLikedAnyURI() (ok bool) {
-	return t.liked != nil && t.liked.anyURI != nil
+	return t.present_[0]&(1<<37) != 0 && t.liked.anyURI != nil
 
 }
 
@@ -4092,12 +5487,13 @@ func (t *Application) GetLikedAnyURI() (v *url.URL) {
 // SetLikedAnyURI sets the value of liked to be of *url.URL type
 func (t *Application) SetLikedAnyURI(v *url.URL) {
 	t.liked = &likedIntermediateType{anyURI: v}
+	t.markPresent_(37, true)
 
 }
 
 // HasUnknownLiked determines whether the call to GetUnknownLiked is safe
 func (t *Application) HasUnknownLiked() (ok bool) {
-	return t.liked != nil && t.liked.unknown_ != nil
+	return t.present_[0]&(1<<37) != 0 && t.liked.unknown_ != nil
 
 }
 
@@ -4115,12 +5511,13 @@ func (t *Application) SetUnknownLiked(i interface{}) {
 	tmp := &likedIntermediateType{}
 	tmp.unknown_ = i
 	t.liked = tmp
+	t.markPresent_(37, true)
 
 }
 
 // IsLikesCollection determines whether the call to GetLikesCollection is safe
 func (t *Application) IsLikesCollection() (ok bool) {
-	return t.likes != nil && t.likes.Collection != nil
+	return t.present_[0]&(1<<38) != 0 && t.likes.Collection != nil
 
 }
 
@@ -4133,12 +5530,13 @@ func (t *Application) GetLikesCollection() (v CollectionType) {
 // SetLikesCollection sets the value of likes to be of CollectionType type
 func (t *Application) SetLikesCollection(v CollectionType) {
 	t.likes = &likesIntermediateType{Collection: v}
+	t.markPresent_(38, true)
 
 }
 
 // IsLikesOrderedCollection determines whether the call to GetLikesOrderedCollection is safe
 func (t *Application) IsLikesOrderedCollection() (ok bool) {
-	return t.likes != nil && t.likes.OrderedCollection != nil
+	return t.present_[0]&(1<<38) != 0 && t.likes.OrderedCollection != nil
 
 }
 
@@ -4151,12 +5549,13 @@ func (t *Application) GetLikesOrderedCollection() (v OrderedCollectionType) {
 // SetLikesOrderedCollection sets the value of likes to be of OrderedCollectionType type
 func (t *Application) SetLikesOrderedCollection(v OrderedCollectionType) {
 	t.likes = &likesIntermediateType{OrderedCollection: v}
+	t.markPresent_(38, true)
 
 }
 
 // IsLikesAnyURI determines whether the call to GetLikesAnyURI is safe
 func (t *Application) IsLikesAnyURI() (ok bool) {
-	return t.likes != nil && t.likes.anyURI != nil
+	return t.present_[0]&(1<<38) != 0 && t.likes.anyURI != nil
 
 }
 
@@ -4169,12 +5568,13 @@ func (t *Application) GetLikesAnyURI() (v *url.URL) {
 // SetLikesAnyURI sets the value of likes to be of *url.URL type
 func (t *Application) SetLikesAnyURI(v *url.URL) {
 	t.likes = &likesIntermediateType{anyURI: v}
+	t.markPresent_(38, true)
 
 }
 
 // HasUnknownLikes determines whether the call to GetUnknownLikes is safe
 func (t *Application) HasUnknownLikes() (ok bool) {
-	return t.likes != nil && t.likes.unknown_ != nil
+	return t.present_[0]&(1<<38) != 0 && t.likes.unknown_ != nil
 
 }
 
@@ -4192,6 +5592,7 @@ func (t *Application) SetUnknownLikes(i interface{}) {
 	tmp := &likesIntermediateType{}
 	tmp.unknown_ = i
 	t.likes = tmp
+	t.markPresent_(38, true)
 
 }
 
@@ -4210,12 +5611,14 @@ func (t *Application) GetStreams(index int) (v *url.URL) {
 // AppendStreams adds a value to the back of streams
 func (t *Application) AppendStreams(v *url.URL) {
 	t.streams = append(t.streams, v)
+	t.markPresent_(39, len(t.streams) > 0)
 
 }
 
 // PrependStreams adds a value to the front of streams
 func (t *Application) PrependStreams(v *url.URL) {
 	t.streams = append([]*url.URL{v}, t.streams...)
+	t.markPresent_(39, len(t.streams) > 0)
 
 }
 
@@ -4224,6 +5627,7 @@ func (t *Application) RemoveStreams(index int) {
 	copy(t.streams[index:], t.streams[index+1:])
 	t.streams[len(t.streams)-1] = nil
 	t.streams = t.streams[:len(t.streams)-1]
+	t.markPresent_(39, len(t.streams) > 0)
 
 }
 
@@ -4248,9 +5652,21 @@ func (t *Application) SetUnknownStreams(i interface{}) {
 
 }
 
+// StreamsValues returns an iterator over the values that GetStreams returns, which can be used as an iter.Seq
+func (t *Application) StreamsValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.StreamsLen(); i++ {
+			if !yield(t.GetStreams(i)) {
+				return
+			}
+		}
+	}
+
+}
+
 // IsPreferredUsername determines whether the call to GetPreferredUsername is safe
 func (t *Application) IsPreferredUsername() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.stringName != nil
+	return t.present_[0]&(1<<40) != 0 && t.preferredUsername.stringName != nil
 
 }
 
@@ -4263,12 +5679,13 @@ func (t *Application) GetPreferredUsername() (v string) {
 // SetPreferredUsername sets the value of preferredUsername to be of string type
 func (t *Application) SetPreferredUsername(v string) {
 	t.preferredUsername = &preferredUsernameIntermediateType{stringName: &v}
+	t.markPresent_(40, true)
 
 }
 
 // IsPreferredUsernameIRI determines whether the call to GetPreferredUsernameIRI is safe
 func (t *Application) IsPreferredUsernameIRI() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.IRI != nil
+	return t.present_[0]&(1<<40) != 0 && t.preferredUsername.IRI != nil
 
 }
 
@@ -4281,12 +5698,13 @@ func (t *Application) GetPreferredUsernameIRI() (v *url.URL) {
 // SetPreferredUsernameIRI sets the value of preferredUsername to be of *url.URL type
 func (t *Application) SetPreferredUsernameIRI(v *url.URL) {
 	t.preferredUsername = &preferredUsernameIntermediateType{IRI: v}
+	t.markPresent_(40, true)
 
 }
 
 // HasUnknownPreferredUsername determines whether the call to GetUnknownPreferredUsername is safe
 func (t *Application) HasUnknownPreferredUsername() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.unknown_ != nil
+	return t.present_[0]&(1<<40) != 0 && t.preferredUsername.unknown_ != nil
 
 }
 
@@ -4304,6 +5722,7 @@ func (t *Application) SetUnknownPreferredUsername(i interface{}) {
 	tmp := &preferredUsernameIntermediateType{}
 	tmp.unknown_ = i
 	t.preferredUsername = tmp
+	t.markPresent_(40, true)
 
 }
 
@@ -4337,14 +5756,44 @@ func (t *Application) GetPreferredUsernameMap(l string) (v string) {
 func (t *Application) SetPreferredUsernameMap(l string, v string) {
 	if t.preferredUsernameMap == nil {
 		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(41, true)
 	}
 	t.preferredUsernameMap[l] = v
 
 }
 
+// GetPreferredUsernameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Application) GetPreferredUsernameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.preferredUsernameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.preferredUsernameMap[k], true
+
+}
+
+// SetPreferredUsernameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Application) SetPreferredUsernameLanguage(tag string, v string) {
+	if t.preferredUsernameMap == nil {
+		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(41, true)
+	} else if k, ok := languageKey(t.preferredUsernameMap, tag); ok {
+		delete(t.preferredUsernameMap, k)
+	}
+	t.preferredUsernameMap[tag] = v
+
+}
+
+// PreferredPreferredUsernameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Application) PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.preferredUsernameMap, tags)
+	return t.preferredUsernameMap[tag], tag
+
+}
+
 // IsEndpoints determines whether the call to GetEndpoints is safe
 func (t *Application) IsEndpoints() (ok bool) {
-	return t.endpoints != nil && t.endpoints.Object != nil
+	return t.present_[0]&(1<<42) != 0 && t.endpoints.Object != nil
 
 }
 
@@ -4357,12 +5806,13 @@ func (t *Application) GetEndpoints() (v ObjectType) {
 // SetEndpoints sets the value of endpoints to be of ObjectType type
 func (t *Application) SetEndpoints(v ObjectType) {
 	t.endpoints = &endpointsIntermediateType{Object: v}
+	t.markPresent_(42, true)
 
 }
 
 // IsEndpointsIRI determines whether the call to GetEndpointsIRI is safe
 func (t *Application) IsEndpointsIRI() (ok bool) {
-	return t.endpoints != nil && t.endpoints.IRI != nil
+	return t.present_[0]&(1<<42) != 0 && t.endpoints.IRI != nil
 
 }
 
@@ -4375,12 +5825,13 @@ func (t *Application) GetEndpointsIRI() (v *url.URL) {
 // SetEndpointsIRI sets the value of endpoints to be of *url.URL type
 func (t *Application) SetEndpointsIRI(v *url.URL) {
 	t.endpoints = &endpointsIntermediateType{IRI: v}
+	t.markPresent_(42, true)
 
 }
 
 // HasUnknownEndpoints determines whether the call to GetUnknownEndpoints is safe
 func (t *Application) HasUnknownEndpoints() (ok bool) {
-	return t.endpoints != nil && t.endpoints.unknown_ != nil
+	return t.present_[0]&(1<<42) != 0 && t.endpoints.unknown_ != nil
 
 }
 
@@ -4398,12 +5849,13 @@ func (t *Application) SetUnknownEndpoints(i interface{}) {
 	tmp := &endpointsIntermediateType{}
 	tmp.unknown_ = i
 	t.endpoints = tmp
+	t.markPresent_(42, true)
 
 }
 
 // HasProxyUrl determines whether the call to GetProxyUrl is safe
 func (t *Application) HasProxyUrl() (ok bool) {
-	return t.proxyUrl != nil
+	return t.present_[0]&(1<<43) != 0
 
 }
 
@@ -4416,6 +5868,7 @@ func (t *Application) GetProxyUrl() (v *url.URL) {
 // SetProxyUrl sets the value of proxyUrl
 func (t *Application) SetProxyUrl(v *url.URL) {
 	t.proxyUrl = v
+	t.markPresent_(43, true)
 
 }
 
@@ -4442,7 +5895,7 @@ func (t *Application) SetUnknownProxyUrl(i interface{}) {
 
 // HasOauthAuthorizationEndpoint determines whether the call to GetOauthAuthorizationEndpoint is safe
 func (t *Application) HasOauthAuthorizationEndpoint() (ok bool) {
-	return t.oauthAuthorizationEndpoint != nil
+	return t.present_[0]&(1<<44) != 0
 
 }
 
@@ -4455,6 +5908,7 @@ func (t *Application) GetOauthAuthorizationEndpoint() (v *url.URL) {
 // SetOauthAuthorizationEndpoint sets the value of oauthAuthorizationEndpoint
 func (t *Application) SetOauthAuthorizationEndpoint(v *url.URL) {
 	t.oauthAuthorizationEndpoint = v
+	t.markPresent_(44, true)
 
 }
 
@@ -4481,7 +5935,7 @@ func (t *Application) SetUnknownOauthAuthorizationEndpoint(i interface{}) {
 
 // HasOauthTokenEndpoint determines whether the call to GetOauthTokenEndpoint is safe
 func (t *Application) HasOauthTokenEndpoint() (ok bool) {
-	return t.oauthTokenEndpoint != nil
+	return t.present_[0]&(1<<45) != 0
 
 }
 
@@ -4494,6 +5948,7 @@ func (t *Application) GetOauthTokenEndpoint() (v *url.URL) {
 // SetOauthTokenEndpoint sets the value of oauthTokenEndpoint
 func (t *Application) SetOauthTokenEndpoint(v *url.URL) {
 	t.oauthTokenEndpoint = v
+	t.markPresent_(45, true)
 
 }
 
@@ -4520,7 +5975,7 @@ func (t *Application) SetUnknownOauthTokenEndpoint(i interface{}) {
 
 // HasProvideClientKey determines whether the call to GetProvideClientKey is safe
 func (t *Application) HasProvideClientKey() (ok bool) {
-	return t.provideClientKey != nil
+	return t.present_[0]&(1<<46) != 0
 
 }
 
@@ -4533,6 +5988,7 @@ func (t *Application) GetProvideClientKey() (v *url.URL) {
 // SetProvideClientKey sets the value of provideClientKey
 func (t *Application) SetProvideClientKey(v *url.URL) {
 	t.provideClientKey = v
+	t.markPresent_(46, true)
 
 }
 
@@ -4559,7 +6015,7 @@ func (t *Application) SetUnknownProvideClientKey(i interface{}) {
 
 // HasSignClientKey determines whether the call to GetSignClientKey is safe
 func (t *Application) HasSignClientKey() (ok bool) {
-	return t.signClientKey != nil
+	return t.present_[0]&(1<<47) != 0
 
 }
 
@@ -4572,6 +6028,7 @@ func (t *Application) GetSignClientKey() (v *url.URL) {
 // SetSignClientKey sets the value of signClientKey
 func (t *Application) SetSignClientKey(v *url.URL) {
 	t.signClientKey = v
+	t.markPresent_(47, true)
 
 }
 
@@ -4598,7 +6055,7 @@ func (t *Application) SetUnknownSignClientKey(i interface{}) {
 
 // HasSharedInbox determines whether the call to GetSharedInbox is safe
 func (t *Application) HasSharedInbox() (ok bool) {
-	return t.sharedInbox != nil
+	return t.present_[0]&(1<<48) != 0
 
 }
 
@@ -4611,6 +6068,7 @@ func (t *Application) GetSharedInbox() (v *url.URL) {
 // SetSharedInbox sets the value of sharedInbox
 func (t *Application) SetSharedInbox(v *url.URL) {
 	t.sharedInbox = v
+	t.markPresent_(48, true)
 
 }
 
@@ -4637,7 +6095,7 @@ func (t *Application) SetUnknownSharedInbox(i interface{}) {
 
 // IsSharesCollection determines whether the call to GetSharesCollection is safe
 func (t *Application) IsSharesCollection() (ok bool) {
-	return t.shares != nil && t.shares.Collection != nil
+	return t.present_[0]&(1<<49) != 0 && t.shares.Collection != nil
 
 }
 
@@ -4650,12 +6108,13 @@ func (t *Application) GetSharesCollection() (v CollectionType) {
 // SetSharesCollection sets the value of shares to be of CollectionType type
 func (t *Application) SetSharesCollection(v CollectionType) {
 	t.shares = &sharesIntermediateType{Collection: v}
+	t.markPresent_(49, true)
 
 }
 
 // IsSharesOrderedCollection determines whether the call to GetSharesOrderedCollection is safe
 func (t *Application) IsSharesOrderedCollection() (ok bool) {
-	return t.shares != nil && t.shares.OrderedCollection != nil
+	return t.present_[0]&(1<<49) != 0 && t.shares.OrderedCollection != nil
 
 }
 
@@ -4668,12 +6127,13 @@ func (t *Application) GetSharesOrderedCollection() (v OrderedCollectionType) {
 // SetSharesOrderedCollection sets the value of shares to be of OrderedCollectionType type
 func (t *Application) SetSharesOrderedCollection(v OrderedCollectionType) {
 	t.shares = &sharesIntermediateType{OrderedCollection: v}
+	t.markPresent_(49, true)
 
 }
 
 // IsSharesAnyURI determines whether the call to GetSharesAnyURI is safe
 func (t *Application) IsSharesAnyURI() (ok bool) {
-	return t.shares != nil && t.shares.anyURI != nil
+	return t.present_[0]&(1<<49) != 0 && t.shares.anyURI != nil
 
 }
 
@@ -4686,12 +6146,13 @@ func (t *Application) GetSharesAnyURI() (v *url.URL) {
 // SetSharesAnyURI sets the value of shares to be of *url.URL type
 func (t *Application) SetSharesAnyURI(v *url.URL) {
 	t.shares = &sharesIntermediateType{anyURI: v}
+	t.markPresent_(49, true)
 
 }
 
 // HasUnknownShares determines whether the call to GetUnknownShares is safe
 func (t *Application) HasUnknownShares() (ok bool) {
-	return t.shares != nil && t.shares.unknown_ != nil
+	return t.present_[0]&(1<<49) != 0 && t.shares.unknown_ != nil
 
 }
 
@@ -4709,6 +6170,7 @@ func (t *Application) SetUnknownShares(i interface{}) {
 	tmp := &sharesIntermediateType{}
 	tmp.unknown_ = i
 	t.shares = tmp
+	t.markPresent_(49, true)
 
 }
 
@@ -4745,6 +6207,32 @@ func (t *Application) GetUnknown(k string) (i interface{}) {
 
 }
 
+// GetUnknownProperties returns a copy of every unknown property of this object by key, such as the extension properties it was deserialized with, or nil if there are none. Serialize writes them back, so they are never discarded.
+func (t *Application) GetUnknownProperties() (m map[string]interface{}) {
+	if len(t.unknown_) == 0 {
+		return nil
+	}
+	m = make(map[string]interface{}, len(t.unknown_))
+	for k, v := range t.unknown_ {
+		m[k] = v
+	}
+	return
+
+}
+
+// SetUnknownProperty sets the unknown property of this object with the specified key, or removes it if i is nil. Unlike AddUnknown, it does not return the object, so that every type shares its signature and extension vocabularies can set their properties of any type.
+func (t *Application) SetUnknownProperty(k string, i interface{}) {
+	if i == nil {
+		delete(t.unknown_, k)
+		return
+	}
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+
+}
+
 // Serialize turns this object into a map[string]interface{}. Note that the "type" property will automatically be populated with "Application" if not manually set by the caller
 func (t *Application) Serialize() (m map[string]interface{}, err error) {
 	m = make(map[string]interface{})
@@ -4762,1475 +6250,1288 @@ func (t *Application) Serialize() (m map[string]interface{}, err error) {
 	}
 	if !typeAlreadySet {
 		t.typeName = append(t.typeName, "Application")
+		t.markPresent_(23, len(t.typeName) > 0)
 	}
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.altitude != nil {
-		if v, err := serializeAltitudeIntermediateType(t.altitude); err == nil {
-			m["altitude"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<0) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.altitude != nil {
+			if v, err := serializeAltitudeIntermediateType(t.altitude); err == nil {
+				m["altitude"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAttachmentIntermediateType(t.attachment); err == nil && v != nil {
-		if len(v) == 1 {
-			m["attachment"] = v[0]
-		} else {
-			m["attachment"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err == nil && v != nil {
-		if len(v) == 1 {
-			m["attributedTo"] = v[0]
-		} else {
-			m["attributedTo"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAudienceIntermediateType(t.audience); err == nil && v != nil {
-		if len(v) == 1 {
-			m["audience"] = v[0]
-		} else {
-			m["audience"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceContentIntermediateType(t.content); err == nil && v != nil {
-		if len(v) == 1 {
-			m["content"] = v[0]
-		} else {
-			m["content"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.contentMap != nil && len(t.contentMap) >= 0 {
-		m["contentMap"] = t.contentMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceContextIntermediateType(t.context); err == nil && v != nil {
-		if len(v) == 1 {
-			m["context"] = v[0]
-		} else {
-			m["context"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceNameIntermediateType(t.name); err == nil && v != nil {
-		if len(v) == 1 {
-			m["name"] = v[0]
-		} else {
-			m["name"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.nameMap != nil && len(t.nameMap) >= 0 {
-		m["nameMap"] = t.nameMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.endTime != nil {
-		if v, err := serializeEndTimeIntermediateType(t.endTime); err == nil {
-			m["endTime"] = v
-		} else {
+	if t.present_[0]&(1<<1) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttachmentIntermediateType(t.attachment); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attachment", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceGeneratorIntermediateType(t.generator); err == nil && v != nil {
-		if len(v) == 1 {
-			m["generator"] = v[0]
-		} else {
-			m["generator"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceIconIntermediateType(t.icon); err == nil && v != nil {
-		if len(v) == 1 {
-			m["icon"] = v[0]
-		} else {
-			m["icon"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	if t.id != nil {
-		idSerializeFunc := func() (interface{}, error) {
-			v := t.id
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		idResult, err := idSerializeFunc()
-		if err == nil {
-			m["id"] = idResult
-		} else {
+	if t.present_[0]&(1<<2) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attributedTo", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceImageIntermediateType(t.image); err == nil && v != nil {
-		if len(v) == 1 {
-			m["image"] = v[0]
-		} else {
-			m["image"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceInReplyToIntermediateType(t.inReplyTo); err == nil && v != nil {
-		if len(v) == 1 {
-			m["inReplyTo"] = v[0]
-		} else {
-			m["inReplyTo"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceLocationIntermediateType(t.location); err == nil && v != nil {
-		if len(v) == 1 {
-			m["location"] = v[0]
-		} else {
-			m["location"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSlicePreviewIntermediateType(t.preview); err == nil && v != nil {
-		if len(v) == 1 {
-			m["preview"] = v[0]
-		} else {
-			m["preview"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.published != nil {
-		if v, err := serializePublishedIntermediateType(t.published); err == nil {
-			m["published"] = v
-		} else {
+	if t.present_[0]&(1<<3) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAudienceIntermediateType(t.audience); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "audience", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.replies != nil {
-		if v, err := serializeRepliesIntermediateType(t.replies); err == nil {
-			m["replies"] = v
-		} else {
+	if t.present_[0]&(1<<4) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContentIntermediateType(t.content); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "content", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.startTime != nil {
-		if v, err := serializeStartTimeIntermediateType(t.startTime); err == nil {
-			m["startTime"] = v
-		} else {
+
+	if t.present_[0]&(1<<5) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.contentMap != nil && len(t.contentMap) >= 0 {
+			m["contentMap"] = t.contentMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<6) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContextIntermediateType(t.context); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "context", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceSummaryIntermediateType(t.summary); err == nil && v != nil {
-		if len(v) == 1 {
-			m["summary"] = v[0]
-		} else {
-			m["summary"] = v
+	if t.present_[0]&(1<<7) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceNameIntermediateType(t.name); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "name", v)
 		}
-	} else if err != nil {
-		return m, err
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
 
-	// Begin generation by generateNaturalLanguageMap
-	if t.summaryMap != nil && len(t.summaryMap) >= 0 {
-		m["summaryMap"] = t.summaryMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceTagIntermediateType(t.tag); err == nil && v != nil {
-		if len(v) == 1 {
-			m["tag"] = v[0]
-		} else {
-			m["tag"] = v
+	if t.present_[0]&(1<<8) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.nameMap != nil && len(t.nameMap) >= 0 {
+			m["nameMap"] = t.nameMap
 		}
-	} else if err != nil {
-		return m, err
+		// End generation by generateNaturalLanguageMap
 	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalAnyDefinition
-	if t.typeName != nil {
-		if len(t.typeName) == 1 {
-			m["type"] = t.typeName[0]
-		} else {
-			m["type"] = t.typeName
+	if t.present_[0]&(1<<9) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endTime != nil {
+			if v, err := serializeEndTimeIntermediateType(t.endTime); err == nil {
+				m["endTime"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalAnyDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.updated != nil {
-		if v, err := serializeUpdatedIntermediateType(t.updated); err == nil {
-			m["updated"] = v
-		} else {
+	if t.present_[0]&(1<<10) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceGeneratorIntermediateType(t.generator); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "generator", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceUrlIntermediateType(t.url); err == nil && v != nil {
-		if len(v) == 1 {
-			m["url"] = v[0]
-		} else {
-			m["url"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceToIntermediateType(t.to); err == nil && v != nil {
-		if len(v) == 1 {
-			m["to"] = v[0]
-		} else {
-			m["to"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceBtoIntermediateType(t.bto); err == nil && v != nil {
-		if len(v) == 1 {
-			m["bto"] = v[0]
-		} else {
-			m["bto"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceCcIntermediateType(t.cc); err == nil && v != nil {
-		if len(v) == 1 {
-			m["cc"] = v[0]
-		} else {
-			m["cc"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceBccIntermediateType(t.bcc); err == nil && v != nil {
-		if len(v) == 1 {
-			m["bcc"] = v[0]
-		} else {
-			m["bcc"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.mediaType != nil {
-		if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
-			m["mediaType"] = v
-		} else {
+	if t.present_[0]&(1<<11) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceIconIntermediateType(t.icon); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "icon", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.duration != nil {
-		if v, err := serializeDurationIntermediateType(t.duration); err == nil {
-			m["duration"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<12) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.id != nil {
+			idSerializeFunc := func() (interface{}, error) {
+				v := t.id
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			idResult, err := idSerializeFunc()
+			if err == nil {
+				m["id"] = idResult
+			} else {
+				return m, err
+			}
 		}
+		// End generation by RangeReference.Serialize for Value
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.source != nil {
-		if v, err := serializeSourceIntermediateType(t.source); err == nil {
-			m["source"] = v
-		} else {
+	if t.present_[0]&(1<<13) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceImageIntermediateType(t.image); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "image", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.inbox != nil {
-		if v, err := serializeInboxIntermediateType(t.inbox); err == nil {
-			m["inbox"] = v
-		} else {
+	if t.present_[0]&(1<<14) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceInReplyToIntermediateType(t.inReplyTo); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "inReplyTo", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.outbox != nil {
-		if v, err := serializeOutboxIntermediateType(t.outbox); err == nil {
-			m["outbox"] = v
-		} else {
+	if t.present_[0]&(1<<15) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceLocationIntermediateType(t.location); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "location", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.following != nil {
-		if v, err := serializeFollowingIntermediateType(t.following); err == nil {
-			m["following"] = v
-		} else {
+	if t.present_[0]&(1<<16) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSlicePreviewIntermediateType(t.preview); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "preview", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.followers != nil {
-		if v, err := serializeFollowersIntermediateType(t.followers); err == nil {
-			m["followers"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<17) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.published != nil {
+			if v, err := serializePublishedIntermediateType(t.published); err == nil {
+				m["published"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.liked != nil {
-		if v, err := serializeLikedIntermediateType(t.liked); err == nil {
-			m["liked"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<18) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.replies != nil {
+			if v, err := serializeRepliesIntermediateType(t.replies); err == nil {
+				m["replies"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.likes != nil {
-		if v, err := serializeLikesIntermediateType(t.likes); err == nil {
-			m["likes"] = v
-		} else {
+	if t.present_[0]&(1<<19) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.startTime != nil {
+			if v, err := serializeStartTimeIntermediateType(t.startTime); err == nil {
+				m["startTime"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<20) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceSummaryIntermediateType(t.summary); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "summary", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	var streamsTemp []interface{}
-	for _, v := range t.streams {
-		tmp := anyURISerialize(v)
-		streamsTemp = append(streamsTemp, tmp)
-	}
-	if streamsTemp != nil {
-		if len(streamsTemp) == 1 {
-			m["streams"] = streamsTemp[0]
-		} else {
-			m["streams"] = streamsTemp
+
+	if t.present_[0]&(1<<21) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.summaryMap != nil && len(t.summaryMap) >= 0 {
+			m["summaryMap"] = t.summaryMap
 		}
+		// End generation by generateNaturalLanguageMap
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.preferredUsername != nil {
-		if v, err := serializePreferredUsernameIntermediateType(t.preferredUsername); err == nil {
-			m["preferredUsername"] = v
-		} else {
+	if t.present_[0]&(1<<22) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceTagIntermediateType(t.tag); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "tag", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.preferredUsernameMap != nil && len(t.preferredUsernameMap) >= 0 {
-		m["preferredUsernameMap"] = t.preferredUsernameMap
+	if t.present_[0]&(1<<23) != 0 {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if t.typeName != nil {
+			if len(t.typeName) == 1 {
+				m["type"] = t.typeName[0]
+			} else {
+				m["type"] = t.typeName
+			}
+		}
+		// End generation by generateNonFunctionalAnyDefinition
 	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.endpoints != nil {
-		if v, err := serializeEndpointsIntermediateType(t.endpoints); err == nil {
-			m["endpoints"] = v
-		} else {
+	if t.present_[0]&(1<<24) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.updated != nil {
+			if v, err := serializeUpdatedIntermediateType(t.updated); err == nil {
+				m["updated"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<25) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceUrlIntermediateType(t.url); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "url", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	if t.proxyUrl != nil {
-		proxyUrlSerializeFunc := func() (interface{}, error) {
-			v := t.proxyUrl
-			tmp := anyURISerialize(v)
-			return tmp, nil
+	if t.present_[0]&(1<<26) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceToIntermediateType(t.to); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "to", v)
 		}
-		proxyUrlResult, err := proxyUrlSerializeFunc()
-		if err == nil {
-			m["proxyUrl"] = proxyUrlResult
-		} else {
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<27) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBtoIntermediateType(t.bto); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bto", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.oauthAuthorizationEndpoint != nil {
-		oauthAuthorizationEndpointSerializeFunc := func() (interface{}, error) {
-			v := t.oauthAuthorizationEndpoint
-			tmp := anyURISerialize(v)
-			return tmp, nil
+	if t.present_[0]&(1<<28) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceCcIntermediateType(t.cc); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "cc", v)
 		}
-		oauthAuthorizationEndpointResult, err := oauthAuthorizationEndpointSerializeFunc()
-		if err == nil {
-			m["oauthAuthorizationEndpoint"] = oauthAuthorizationEndpointResult
-		} else {
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<29) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBccIntermediateType(t.bcc); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bcc", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.oauthTokenEndpoint != nil {
-		oauthTokenEndpointSerializeFunc := func() (interface{}, error) {
-			v := t.oauthTokenEndpoint
-			tmp := anyURISerialize(v)
-			return tmp, nil
+	if t.present_[0]&(1<<30) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.mediaType != nil {
+			if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
+				m["mediaType"] = v
+			} else {
+				return m, err
+			}
 		}
-		oauthTokenEndpointResult, err := oauthTokenEndpointSerializeFunc()
-		if err == nil {
-			m["oauthTokenEndpoint"] = oauthTokenEndpointResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<31) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.duration != nil {
+			if v, err := serializeDurationIntermediateType(t.duration); err == nil {
+				m["duration"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.provideClientKey != nil {
-		provideClientKeySerializeFunc := func() (interface{}, error) {
-			v := t.provideClientKey
-			tmp := anyURISerialize(v)
-			return tmp, nil
+	if t.present_[0]&(1<<32) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.source != nil {
+			if v, err := serializeSourceIntermediateType(t.source); err == nil {
+				m["source"] = v
+			} else {
+				return m, err
+			}
 		}
-		provideClientKeyResult, err := provideClientKeySerializeFunc()
-		if err == nil {
-			m["provideClientKey"] = provideClientKeyResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<33) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.inbox != nil {
+			if v, err := serializeInboxIntermediateType(t.inbox); err == nil {
+				m["inbox"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.signClientKey != nil {
-		signClientKeySerializeFunc := func() (interface{}, error) {
-			v := t.signClientKey
-			tmp := anyURISerialize(v)
-			return tmp, nil
+	if t.present_[0]&(1<<34) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.outbox != nil {
+			if v, err := serializeOutboxIntermediateType(t.outbox); err == nil {
+				m["outbox"] = v
+			} else {
+				return m, err
+			}
 		}
-		signClientKeyResult, err := signClientKeySerializeFunc()
-		if err == nil {
-			m["signClientKey"] = signClientKeyResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<35) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.following != nil {
+			if v, err := serializeFollowingIntermediateType(t.following); err == nil {
+				m["following"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.sharedInbox != nil {
-		sharedInboxSerializeFunc := func() (interface{}, error) {
-			v := t.sharedInbox
-			tmp := anyURISerialize(v)
-			return tmp, nil
+	if t.present_[0]&(1<<36) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.followers != nil {
+			if v, err := serializeFollowersIntermediateType(t.followers); err == nil {
+				m["followers"] = v
+			} else {
+				return m, err
+			}
 		}
-		sharedInboxResult, err := sharedInboxSerializeFunc()
-		if err == nil {
-			m["sharedInbox"] = sharedInboxResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<37) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.liked != nil {
+			if v, err := serializeLikedIntermediateType(t.liked); err == nil {
+				m["liked"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.shares != nil {
-		if v, err := serializeSharesIntermediateType(t.shares); err == nil {
-			m["shares"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<38) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.likes != nil {
+			if v, err := serializeLikesIntermediateType(t.likes); err == nil {
+				m["likes"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	return
-
-}
-
-// Deserialize populates this object from a map[string]interface{}
-func (t *Application) Deserialize(m map[string]interface{}) (err error) {
-	for k, v := range m {
-		handled := false
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "altitude" {
-				t.altitude, err = deserializeAltitudeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+	if t.present_[0]&(1<<39) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		var streamsTemp []interface{}
+		for _, v := range t.streams {
+			tmp := anyURISerialize(v)
+			streamsTemp = append(streamsTemp, tmp)
+		}
+		if streamsTemp != nil {
+			if len(streamsTemp) == 1 {
+				m["streams"] = streamsTemp[0]
+			} else {
+				m["streams"] = streamsTemp
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "attachment" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAttachmentIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.attachment = []*attachmentIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.attachment, err = deserializeSliceAttachmentIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &attachmentIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.attachment = []*attachmentIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<40) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.preferredUsername != nil {
+			if v, err := serializePreferredUsernameIntermediateType(t.preferredUsername); err == nil {
+				m["preferredUsername"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "attributedTo" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAttributedToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.attributedTo, err = deserializeSliceAttributedToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &attributedToIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+
+	if t.present_[0]&(1<<41) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.preferredUsernameMap != nil && len(t.preferredUsernameMap) >= 0 {
+			m["preferredUsernameMap"] = t.preferredUsernameMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<42) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endpoints != nil {
+			if v, err := serializeEndpointsIntermediateType(t.endpoints); err == nil {
+				m["endpoints"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "audience" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAudienceIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.audience = []*audienceIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.audience, err = deserializeSliceAudienceIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &audienceIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.audience = []*audienceIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<43) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.proxyUrl != nil {
+			proxyUrlSerializeFunc := func() (interface{}, error) {
+				v := t.proxyUrl
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			proxyUrlResult, err := proxyUrlSerializeFunc()
+			if err == nil {
+				m["proxyUrl"] = proxyUrlResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "content" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeContentIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.content = []*contentIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.content, err = deserializeSliceContentIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &contentIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.content = []*contentIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<44) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthAuthorizationEndpoint != nil {
+			oauthAuthorizationEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthAuthorizationEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "contentMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.contentMap = val
-					handled = true
-				}
+			oauthAuthorizationEndpointResult, err := oauthAuthorizationEndpointSerializeFunc()
+			if err == nil {
+				m["oauthAuthorizationEndpoint"] = oauthAuthorizationEndpointResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNaturalLanguageMap
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "context" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeContextIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.context = []*contextIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.context, err = deserializeSliceContextIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &contextIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.context = []*contextIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<45) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthTokenEndpoint != nil {
+			oauthTokenEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthTokenEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			oauthTokenEndpointResult, err := oauthTokenEndpointSerializeFunc()
+			if err == nil {
+				m["oauthTokenEndpoint"] = oauthTokenEndpointResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "name" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeNameIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.name, err = deserializeSliceNameIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &nameIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<46) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.provideClientKey != nil {
+			provideClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.provideClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "nameMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.nameMap = val
-					handled = true
-				}
+			provideClientKeyResult, err := provideClientKeySerializeFunc()
+			if err == nil {
+				m["provideClientKey"] = provideClientKeyResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNaturalLanguageMap
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "endTime" {
-				t.endTime, err = deserializeEndTimeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<47) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.signClientKey != nil {
+			signClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.signClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			signClientKeyResult, err := signClientKeySerializeFunc()
+			if err == nil {
+				m["signClientKey"] = signClientKeyResult
+			} else {
+				return m, err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "generator" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeGeneratorIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.generator = []*generatorIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.generator, err = deserializeSliceGeneratorIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &generatorIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.generator = []*generatorIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<48) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.sharedInbox != nil {
+			sharedInboxSerializeFunc := func() (interface{}, error) {
+				v := t.sharedInbox
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			sharedInboxResult, err := sharedInboxSerializeFunc()
+			if err == nil {
+				m["sharedInbox"] = sharedInboxResult
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "icon" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeIconIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.icon = []*iconIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.icon, err = deserializeSliceIconIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &iconIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.icon = []*iconIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<49) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.shares != nil {
+			if v, err := serializeSharesIntermediateType(t.shares); err == nil {
+				m["shares"] = v
+			} else {
+				return m, err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "id" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.id = tmp
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	return
+
+}
+
+// Deserialize populates this object from a map[string]interface{}, renaming the properties its '@context' aliases with ResolveAliases
+func (t *Application) Deserialize(m map[string]interface{}) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	m = ResolveAliases(m)
+	for k, v := range m {
+		if err = t.deserializeProperty_(k, v); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	return
+
+}
+
+// deserializeProperty_ populates the property of this object with the key, or its unknown property if it has none with the key
+func (t *Application) deserializeProperty_(k string, v interface{}) (err error) {
+	handled := false
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "altitude" {
+			t.altitude, err = deserializeAltitudeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by RangeReference.Deserialize for Value
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "image" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeImageIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.image = []*imageIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.image, err = deserializeSliceImageIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &imageIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.image = []*imageIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attachment" {
+			t.attachment, err = deserializeValuesAttachmentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "inReplyTo" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeInReplyToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.inReplyTo = []*inReplyToIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.inReplyTo, err = deserializeSliceInReplyToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &inReplyToIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.inReplyTo = []*inReplyToIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attributedTo" {
+			t.attributedTo, err = deserializeValuesAttributedToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "location" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeLocationIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.location = []*locationIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.location, err = deserializeSliceLocationIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &locationIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.location = []*locationIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "audience" {
+			t.audience, err = deserializeValuesAudienceIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "preview" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializePreviewIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.preview, err = deserializeSlicePreviewIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &previewIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "content" {
+			t.content, err = deserializeValuesContentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "published" {
-				t.published, err = deserializePublishedIntermediateType(v)
-				if err != nil {
-					return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "contentMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
 				}
+				t.contentMap = val
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "replies" {
-				t.replies, err = deserializeRepliesIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "context" {
+			t.context, err = deserializeValuesContextIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "startTime" {
-				t.startTime, err = deserializeStartTimeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "name" {
+			t.name, err = deserializeValuesNameIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "summary" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeSummaryIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.summary, err = deserializeSliceSummaryIntermediateType(tmpSlice)
-					if err != nil {
-						return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "nameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					handled = true
-				} else {
-					tmp := &summaryIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
 				}
+				t.nameMap = val
+				handled = true
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "summaryMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.summaryMap = val
-					handled = true
-				}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endTime" {
+			t.endTime, err = deserializeEndTimeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNaturalLanguageMap
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "tag" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeTagIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.tag = []*tagIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.tag, err = deserializeSliceTagIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &tagIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.tag = []*tagIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "generator" {
+			t.generator, err = deserializeValuesGeneratorIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by generateNonFunctionalAnyDefinition
-			if k == "type" {
-				if tmpTypeSlice, ok := v.([]interface{}); ok {
-					t.typeName = tmpTypeSlice
-					handled = true
-				} else {
-					t.typeName = []interface{}{v}
-					handled = true
-				}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "icon" {
+			t.icon, err = deserializeValuesIconIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalAnyDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "updated" {
-				t.updated, err = deserializeUpdatedIntermediateType(v)
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "id" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.id = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "url" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeUrlIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.url = []*urlIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.url, err = deserializeSliceUrlIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &urlIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.url = []*urlIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "image" {
+			t.image, err = deserializeValuesImageIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "to" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.to = []*toIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.to, err = deserializeSliceToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &toIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.to = []*toIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "inReplyTo" {
+			t.inReplyTo, err = deserializeValuesInReplyToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "bto" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeBtoIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.bto = []*btoIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.bto, err = deserializeSliceBtoIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &btoIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.bto = []*btoIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "location" {
+			t.location, err = deserializeValuesLocationIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "cc" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeCcIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.cc = []*ccIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.cc, err = deserializeSliceCcIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &ccIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.cc = []*ccIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "preview" {
+			t.preview, err = deserializeValuesPreviewIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "bcc" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeBccIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.bcc = []*bccIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.bcc, err = deserializeSliceBccIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &bccIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.bcc = []*bccIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "published" {
+			t.published, err = deserializePublishedIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "mediaType" {
-				t.mediaType, err = deserializeMediaTypeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "replies" {
+			t.replies, err = deserializeRepliesIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "duration" {
-				t.duration, err = deserializeDurationIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "startTime" {
+			t.startTime, err = deserializeStartTimeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "source" {
-				t.source, err = deserializeSourceIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "summary" {
+			t.summary, err = deserializeValuesSummaryIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "inbox" {
-				t.inbox, err = deserializeInboxIntermediateType(v)
-				if err != nil {
-					return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "summaryMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
 				}
+				t.summaryMap = val
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "outbox" {
-				t.outbox, err = deserializeOutboxIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "tag" {
+			t.tag, err = deserializeValuesTagIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "following" {
-				t.following, err = deserializeFollowingIntermediateType(v)
-				if err != nil {
-					return err
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if k == "type" {
+			if tmpTypeSlice, ok := v.([]interface{}); ok {
+				t.typeName = tmpTypeSlice
+				handled = true
+			} else {
+				t.typeName = []interface{}{v}
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "followers" {
-				t.followers, err = deserializeFollowersIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "updated" {
+			t.updated, err = deserializeUpdatedIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "liked" {
-				t.liked, err = deserializeLikedIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "url" {
+			t.url, err = deserializeValuesUrlIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "likes" {
-				t.likes, err = deserializeLikesIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "to" {
+			t.to, err = deserializeValuesToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "streams" {
-				if tmpSlice, ok := v.([]interface{}); ok {
-					for _, tmpElem := range tmpSlice {
-						if v, ok := tmpElem.(interface{}); ok {
-							tmp, err := anyURIDeserialize(v)
-							if err != nil {
-								return err
-							}
-							t.streams = append(t.streams, tmp)
-							handled = true
-						}
-					}
-				} else if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.streams = append(t.streams, tmp)
-					handled = true
-				}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bto" {
+			t.bto, err = deserializeValuesBtoIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by RangeReference.Deserialize for Value
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "preferredUsername" {
-				t.preferredUsername, err = deserializePreferredUsernameIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "cc" {
+			t.cc, err = deserializeValuesCcIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "preferredUsernameMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bcc" {
+			t.bcc, err = deserializeValuesBccIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "mediaType" {
+			t.mediaType, err = deserializeMediaTypeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "duration" {
+			t.duration, err = deserializeDurationIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "source" {
+			t.source, err = deserializeSourceIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "inbox" {
+			t.inbox, err = deserializeInboxIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "outbox" {
+			t.outbox, err = deserializeOutboxIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "following" {
+			t.following, err = deserializeFollowingIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "followers" {
+			t.followers, err = deserializeFollowersIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "liked" {
+			t.liked, err = deserializeLikedIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "likes" {
+			t.likes, err = deserializeLikesIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "streams" {
+			if tmpSlice, ok := v.([]interface{}); ok {
+				for idx, tmpElem := range tmpSlice {
+					if v, ok := tmpElem.(interface{}); ok {
+						tmp, err := anyURIDeserialize(v)
+						if err != nil {
+							return deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 						}
+						t.streams = append(t.streams, tmp)
+						handled = true
 					}
-					t.preferredUsernameMap = val
-					handled = true
 				}
-			}
-			// End generation by generateNaturalLanguageMap
-		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "endpoints" {
-				t.endpoints, err = deserializeEndpointsIntermediateType(v)
+			} else if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.streams = append(t.streams, tmp)
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "proxyUrl" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "preferredUsername" {
+			t.preferredUsername, err = deserializePreferredUsernameIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "preferredUsernameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					t.proxyUrl = tmp
-					handled = true
 				}
+				t.preferredUsernameMap = val
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "oauthAuthorizationEndpoint" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.oauthAuthorizationEndpoint = tmp
-					handled = true
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endpoints" {
+			t.endpoints, err = deserializeEndpointsIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "proxyUrl" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.proxyUrl = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "oauthTokenEndpoint" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.oauthTokenEndpoint = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthAuthorizationEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.oauthAuthorizationEndpoint = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "provideClientKey" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.provideClientKey = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthTokenEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.oauthTokenEndpoint = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "signClientKey" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.signClientKey = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "provideClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.provideClientKey = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "sharedInbox" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.sharedInbox = tmp
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "signClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.signClientKey = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "shares" {
-				t.shares, err = deserializeSharesIntermediateType(v)
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "sharedInbox" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.sharedInbox = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled && k != "@context" {
-			if t.unknown_ == nil {
-				t.unknown_ = make(map[string]interface{})
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "shares" {
+			t.shares, err = deserializeSharesIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			t.unknown_[k] = unknownValueDeserialize(v)
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled && k != "@context" {
+		if t.unknown_ == nil {
+			t.unknown_ = make(map[string]interface{})
 		}
+		t.unknown_[k] = unknownValueDeserialize(v)
+	}
+	return
+
+}
+
+// MarshalJSON implements json.Marshaler by encoding this Application as JSON, with its properties in the order of the JSON it was unmarshalled from, if any
+func (t *Application) MarshalJSON() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	if t.order_ != nil {
+		b, err = encodeOrderedJSON(m, t.order_)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	return
+
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding JSON into this Application, recording the order of its properties for MarshalJSON
+func (t *Application) UnmarshalJSON(b []byte) (err error) {
+	m := make(map[string]interface{})
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	t.order_ = scanPropertyOrder(b)
+	return
+
+}
+
+// DecodeJSON populates this Application from the JSON object read from the decoder, deserializing each property as soon as it is decoded instead of decoding the whole object into a map first, which takes far less memory for large documents. Properties are renamed as Deserialize does, provided the '@context' comes before the properties it aliases. Unlike UnmarshalJSON, it does not record the order of the properties
+func (t *Application) DecodeJSON(dec *json.Decoder) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	return DecodeJSONProperties(dec, t.deserializeProperty_)
+
+}
+
+// SerializeCBOR encodes this Application as CBOR, which DeserializeCBOR decodes. The encoding is of the same data as JSON, so values can be exchanged in CBOR internally and in JSON with other servers.
+func (t *Application) SerializeCBOR() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeCBOR(m)
+
+}
+
+// DeserializeCBOR decodes CBOR, such as that of SerializeCBOR, into this Application
+func (t *Application) DeserializeCBOR(b []byte) (err error) {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode %T into Application", v)
+	}
+	return t.Deserialize(m)
+
+}
+
+// SerializeCanonical encodes this Application as canonical JSON by RFC 8785, so that equal values always have the same bytes, as signing them or addressing them by their content requires.
+func (t *Application) SerializeCanonical() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeJCS(m)
+
+}
+
+// Clone returns a deep copy of this Application, including its unknown properties and the values of all its properties, so that the copy can be modified without affecting the original
+func (t *Application) Clone() (c *Application, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
 	}
+	c = &Application{}
+	err = c.Deserialize(cloneValue(m).(map[string]interface{}))
+	c.order_ = t.order_
 	return
 
 }
 
+// Equals determines whether this Application and the other one have the same canonical serialized form, such as when the same activity is delivered more than once
+func (t *Application) Equals(other *Application) (eq bool) {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return canonicalEquals(t, other)
+
+}
+
+// Hash returns the SHA-256 hash of the canonical serialized form of this Application, so that equal values have the same hash. It returns the zero hash if this Application cannot be serialized
+func (t *Application) Hash() (h [32]byte) {
+	return canonicalHash(t)
+
+}
+
 // IsPublic returns true if the 'to', 'bto', 'cc', or 'bcc' properties address the special Public ActivityPub collection
 func (t *Application) IsPublic() (b bool) {
 	for i := 0; i < t.ToLen(); i++ {
@@ -6256,3 +7557,2859 @@ func (t *Application) IsPublic() (b bool) {
 	return false
 
 }
+
+// Kind returns ApplicationKind.
+func (t *Application) Kind() (k TypeKind) {
+	return ApplicationKind
+
+}
+
+// Validate returns ValidationErrors listing every property required by the specification that this Application is missing, or nil if it has them all
+func (t *Application) Validate() (err error) {
+	return
+
+}
+
+// ValidateStrict returns ValidationErrors listing what Validate does, and every unknown property of this Application, every property with a value of none of the types it takes, and every inlined value that is itself invalid, or nil if there are none. It is meant for rejecting values that are not exactly as specified, such as those posted by clients
+func (t *Application) ValidateStrict() (err error) {
+	errs, _ := t.Validate().(ValidationErrors)
+	if t.altitude != nil {
+		errs = t.altitude.strict(errs, "Application", "altitude")
+	}
+	for _, v := range t.attachment {
+		errs = v.strict(errs, "Application", "attachment")
+	}
+	for _, v := range t.attributedTo {
+		errs = v.strict(errs, "Application", "attributedTo")
+	}
+	for _, v := range t.audience {
+		errs = v.strict(errs, "Application", "audience")
+	}
+	for _, v := range t.content {
+		errs = v.strict(errs, "Application", "content")
+	}
+	for _, v := range t.context {
+		errs = v.strict(errs, "Application", "context")
+	}
+	for _, v := range t.name {
+		errs = v.strict(errs, "Application", "name")
+	}
+	if t.endTime != nil {
+		errs = t.endTime.strict(errs, "Application", "endTime")
+	}
+	for _, v := range t.generator {
+		errs = v.strict(errs, "Application", "generator")
+	}
+	for _, v := range t.icon {
+		errs = v.strict(errs, "Application", "icon")
+	}
+	for _, v := range t.image {
+		errs = v.strict(errs, "Application", "image")
+	}
+	for _, v := range t.inReplyTo {
+		errs = v.strict(errs, "Application", "inReplyTo")
+	}
+	for _, v := range t.location {
+		errs = v.strict(errs, "Application", "location")
+	}
+	for _, v := range t.preview {
+		errs = v.strict(errs, "Application", "preview")
+	}
+	if t.published != nil {
+		errs = t.published.strict(errs, "Application", "published")
+	}
+	if t.replies != nil {
+		errs = t.replies.strict(errs, "Application", "replies")
+	}
+	if t.startTime != nil {
+		errs = t.startTime.strict(errs, "Application", "startTime")
+	}
+	for _, v := range t.summary {
+		errs = v.strict(errs, "Application", "summary")
+	}
+	for _, v := range t.tag {
+		errs = v.strict(errs, "Application", "tag")
+	}
+	if t.updated != nil {
+		errs = t.updated.strict(errs, "Application", "updated")
+	}
+	for _, v := range t.url {
+		errs = v.strict(errs, "Application", "url")
+	}
+	for _, v := range t.to {
+		errs = v.strict(errs, "Application", "to")
+	}
+	for _, v := range t.bto {
+		errs = v.strict(errs, "Application", "bto")
+	}
+	for _, v := range t.cc {
+		errs = v.strict(errs, "Application", "cc")
+	}
+	for _, v := range t.bcc {
+		errs = v.strict(errs, "Application", "bcc")
+	}
+	if t.mediaType != nil {
+		errs = t.mediaType.strict(errs, "Application", "mediaType")
+	}
+	if t.duration != nil {
+		errs = t.duration.strict(errs, "Application", "duration")
+	}
+	if t.source != nil {
+		errs = t.source.strict(errs, "Application", "source")
+	}
+	if t.inbox != nil {
+		errs = t.inbox.strict(errs, "Application", "inbox")
+	}
+	if t.outbox != nil {
+		errs = t.outbox.strict(errs, "Application", "outbox")
+	}
+	if t.following != nil {
+		errs = t.following.strict(errs, "Application", "following")
+	}
+	if t.followers != nil {
+		errs = t.followers.strict(errs, "Application", "followers")
+	}
+	if t.liked != nil {
+		errs = t.liked.strict(errs, "Application", "liked")
+	}
+	if t.likes != nil {
+		errs = t.likes.strict(errs, "Application", "likes")
+	}
+	if t.preferredUsername != nil {
+		errs = t.preferredUsername.strict(errs, "Application", "preferredUsername")
+	}
+	if t.endpoints != nil {
+		errs = t.endpoints.strict(errs, "Application", "endpoints")
+	}
+	if t.shares != nil {
+		errs = t.shares.strict(errs, "Application", "shares")
+	}
+	keys := make([]string, 0, len(t.unknown_))
+	for k := range t.unknown_ {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch k {
+		case "id", "streams", "proxyUrl", "oauthAuthorizationEndpoint", "oauthTokenEndpoint", "provideClientKey", "signClientKey", "sharedInbox":
+			errs = append(errs, &MismatchedPropertyError{Type: "Application", Property: k})
+		default:
+			errs = append(errs, &UnknownPropertyError{Type: "Application", Property: k})
+		}
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// DeserializeStrict populates this Application from a map[string]interface{} like Deserialize, then returns the problems ValidateStrict finds with it, if any
+func (t *Application) DeserializeStrict(m map[string]interface{}) (err error) {
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	return t.ValidateStrict()
+
+}
+
+// DeserializeLenient populates this Application from a map[string]interface{} like Deserialize, but parses the xsd:dateTime values of its properties, and of the values it contains, with ParseDateTimeLenient when ParseDateTimeStrict rejects them. It is meant for values from servers emitting malformed dates, and leaves the map unchanged
+func (t *Application) DeserializeLenient(m map[string]interface{}) (err error) {
+	return t.Deserialize(lenientDateTimes(m).(map[string]interface{}))
+
+}
+
+// TryGetAltitude returns the value GetAltitude returns, or an AccessorError if IsAltitude returns false
+func (t *Application) TryGetAltitude() (v float64, err error) {
+	if !t.IsAltitude() {
+		err = &AccessorError{Type: "Application", Getter: "GetAltitude", Index: -1}
+		return
+	}
+	return t.GetAltitude(), nil
+
+}
+
+// TryGetAltitudeIRI returns the value GetAltitudeIRI returns, or an AccessorError if IsAltitudeIRI returns false
+func (t *Application) TryGetAltitudeIRI() (v *url.URL, err error) {
+	if !t.IsAltitudeIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetAltitudeIRI", Index: -1}
+		return
+	}
+	return t.GetAltitudeIRI(), nil
+
+}
+
+// TryGetUnknownAltitude returns the value GetUnknownAltitude returns, or an AccessorError if HasUnknownAltitude returns false
+func (t *Application) TryGetUnknownAltitude() (v interface{}, err error) {
+	if !t.HasUnknownAltitude() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownAltitude", Index: -1}
+		return
+	}
+	return t.GetUnknownAltitude(), nil
+
+}
+
+// TryGetAttachmentObject returns the value GetAttachmentObject returns, or an AccessorError if IsAttachmentObject returns false
+func (t *Application) TryGetAttachmentObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAttachmentObject", Index: index}
+		return
+	}
+	return t.GetAttachmentObject(index), nil
+
+}
+
+// TryGetAttachmentLink returns the value GetAttachmentLink returns, or an AccessorError if IsAttachmentLink returns false
+func (t *Application) TryGetAttachmentLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAttachmentLink", Index: index}
+		return
+	}
+	return t.GetAttachmentLink(index), nil
+
+}
+
+// TryGetAttachmentIRI returns the value GetAttachmentIRI returns, or an AccessorError if IsAttachmentIRI returns false
+func (t *Application) TryGetAttachmentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAttachmentIRI", Index: index}
+		return
+	}
+	return t.GetAttachmentIRI(index), nil
+
+}
+
+// TryGetUnknownAttachment returns the value GetUnknownAttachment returns, or an AccessorError if HasUnknownAttachment returns false
+func (t *Application) TryGetUnknownAttachment() (v interface{}, err error) {
+	if !t.HasUnknownAttachment() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownAttachment", Index: -1}
+		return
+	}
+	return t.GetUnknownAttachment(), nil
+
+}
+
+// TryGetAttributedToObject returns the value GetAttributedToObject returns, or an AccessorError if IsAttributedToObject returns false
+func (t *Application) TryGetAttributedToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAttributedToObject", Index: index}
+		return
+	}
+	return t.GetAttributedToObject(index), nil
+
+}
+
+// TryGetAttributedToLink returns the value GetAttributedToLink returns, or an AccessorError if IsAttributedToLink returns false
+func (t *Application) TryGetAttributedToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAttributedToLink", Index: index}
+		return
+	}
+	return t.GetAttributedToLink(index), nil
+
+}
+
+// TryGetAttributedToIRI returns the value GetAttributedToIRI returns, or an AccessorError if IsAttributedToIRI returns false
+func (t *Application) TryGetAttributedToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAttributedToIRI", Index: index}
+		return
+	}
+	return t.GetAttributedToIRI(index), nil
+
+}
+
+// TryGetUnknownAttributedTo returns the value GetUnknownAttributedTo returns, or an AccessorError if HasUnknownAttributedTo returns false
+func (t *Application) TryGetUnknownAttributedTo() (v interface{}, err error) {
+	if !t.HasUnknownAttributedTo() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownAttributedTo", Index: -1}
+		return
+	}
+	return t.GetUnknownAttributedTo(), nil
+
+}
+
+// TryGetAudienceObject returns the value GetAudienceObject returns, or an AccessorError if IsAudienceObject returns false
+func (t *Application) TryGetAudienceObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAudienceObject", Index: index}
+		return
+	}
+	return t.GetAudienceObject(index), nil
+
+}
+
+// TryGetAudienceLink returns the value GetAudienceLink returns, or an AccessorError if IsAudienceLink returns false
+func (t *Application) TryGetAudienceLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAudienceLink", Index: index}
+		return
+	}
+	return t.GetAudienceLink(index), nil
+
+}
+
+// TryGetAudienceIRI returns the value GetAudienceIRI returns, or an AccessorError if IsAudienceIRI returns false
+func (t *Application) TryGetAudienceIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetAudienceIRI", Index: index}
+		return
+	}
+	return t.GetAudienceIRI(index), nil
+
+}
+
+// TryGetUnknownAudience returns the value GetUnknownAudience returns, or an AccessorError if HasUnknownAudience returns false
+func (t *Application) TryGetUnknownAudience() (v interface{}, err error) {
+	if !t.HasUnknownAudience() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownAudience", Index: -1}
+		return
+	}
+	return t.GetUnknownAudience(), nil
+
+}
+
+// TryGetContentString returns the value GetContentString returns, or an AccessorError if IsContentString returns false
+func (t *Application) TryGetContentString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentString(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetContentString", Index: index}
+		return
+	}
+	return t.GetContentString(index), nil
+
+}
+
+// TryGetContentLangString returns the value GetContentLangString returns, or an AccessorError if IsContentLangString returns false
+func (t *Application) TryGetContentLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentLangString(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetContentLangString", Index: index}
+		return
+	}
+	return t.GetContentLangString(index), nil
+
+}
+
+// TryGetContentIRI returns the value GetContentIRI returns, or an AccessorError if IsContentIRI returns false
+func (t *Application) TryGetContentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetContentIRI", Index: index}
+		return
+	}
+	return t.GetContentIRI(index), nil
+
+}
+
+// TryGetUnknownContent returns the value GetUnknownContent returns, or an AccessorError if HasUnknownContent returns false
+func (t *Application) TryGetUnknownContent() (v interface{}, err error) {
+	if !t.HasUnknownContent() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownContent", Index: -1}
+		return
+	}
+	return t.GetUnknownContent(), nil
+
+}
+
+// TryGetContextObject returns the value GetContextObject returns, or an AccessorError if IsContextObject returns false
+func (t *Application) TryGetContextObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetContextObject", Index: index}
+		return
+	}
+	return t.GetContextObject(index), nil
+
+}
+
+// TryGetContextLink returns the value GetContextLink returns, or an AccessorError if IsContextLink returns false
+func (t *Application) TryGetContextLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetContextLink", Index: index}
+		return
+	}
+	return t.GetContextLink(index), nil
+
+}
+
+// TryGetContextIRI returns the value GetContextIRI returns, or an AccessorError if IsContextIRI returns false
+func (t *Application) TryGetContextIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetContextIRI", Index: index}
+		return
+	}
+	return t.GetContextIRI(index), nil
+
+}
+
+// TryGetUnknownContext returns the value GetUnknownContext returns, or an AccessorError if HasUnknownContext returns false
+func (t *Application) TryGetUnknownContext() (v interface{}, err error) {
+	if !t.HasUnknownContext() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownContext", Index: -1}
+		return
+	}
+	return t.GetUnknownContext(), nil
+
+}
+
+// TryGetNameString returns the value GetNameString returns, or an AccessorError if IsNameString returns false
+func (t *Application) TryGetNameString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameString(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetNameString", Index: index}
+		return
+	}
+	return t.GetNameString(index), nil
+
+}
+
+// TryGetNameLangString returns the value GetNameLangString returns, or an AccessorError if IsNameLangString returns false
+func (t *Application) TryGetNameLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameLangString(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetNameLangString", Index: index}
+		return
+	}
+	return t.GetNameLangString(index), nil
+
+}
+
+// TryGetNameIRI returns the value GetNameIRI returns, or an AccessorError if IsNameIRI returns false
+func (t *Application) TryGetNameIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetNameIRI", Index: index}
+		return
+	}
+	return t.GetNameIRI(index), nil
+
+}
+
+// TryGetUnknownName returns the value GetUnknownName returns, or an AccessorError if HasUnknownName returns false
+func (t *Application) TryGetUnknownName() (v interface{}, err error) {
+	if !t.HasUnknownName() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownName", Index: -1}
+		return
+	}
+	return t.GetUnknownName(), nil
+
+}
+
+// TryGetEndTime returns the value GetEndTime returns, or an AccessorError if IsEndTime returns false
+func (t *Application) TryGetEndTime() (v time.Time, err error) {
+	if !t.IsEndTime() {
+		err = &AccessorError{Type: "Application", Getter: "GetEndTime", Index: -1}
+		return
+	}
+	return t.GetEndTime(), nil
+
+}
+
+// TryGetEndTimeIRI returns the value GetEndTimeIRI returns, or an AccessorError if IsEndTimeIRI returns false
+func (t *Application) TryGetEndTimeIRI() (v *url.URL, err error) {
+	if !t.IsEndTimeIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetEndTimeIRI", Index: -1}
+		return
+	}
+	return t.GetEndTimeIRI(), nil
+
+}
+
+// TryGetUnknownEndTime returns the value GetUnknownEndTime returns, or an AccessorError if HasUnknownEndTime returns false
+func (t *Application) TryGetUnknownEndTime() (v interface{}, err error) {
+	if !t.HasUnknownEndTime() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownEndTime", Index: -1}
+		return
+	}
+	return t.GetUnknownEndTime(), nil
+
+}
+
+// TryGetGeneratorObject returns the value GetGeneratorObject returns, or an AccessorError if IsGeneratorObject returns false
+func (t *Application) TryGetGeneratorObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetGeneratorObject", Index: index}
+		return
+	}
+	return t.GetGeneratorObject(index), nil
+
+}
+
+// TryGetGeneratorLink returns the value GetGeneratorLink returns, or an AccessorError if IsGeneratorLink returns false
+func (t *Application) TryGetGeneratorLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetGeneratorLink", Index: index}
+		return
+	}
+	return t.GetGeneratorLink(index), nil
+
+}
+
+// TryGetGeneratorIRI returns the value GetGeneratorIRI returns, or an AccessorError if IsGeneratorIRI returns false
+func (t *Application) TryGetGeneratorIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetGeneratorIRI", Index: index}
+		return
+	}
+	return t.GetGeneratorIRI(index), nil
+
+}
+
+// TryGetUnknownGenerator returns the value GetUnknownGenerator returns, or an AccessorError if HasUnknownGenerator returns false
+func (t *Application) TryGetUnknownGenerator() (v interface{}, err error) {
+	if !t.HasUnknownGenerator() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownGenerator", Index: -1}
+		return
+	}
+	return t.GetUnknownGenerator(), nil
+
+}
+
+// TryGetIconImage returns the value GetIconImage returns, or an AccessorError if IsIconImage returns false
+func (t *Application) TryGetIconImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconImage(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetIconImage", Index: index}
+		return
+	}
+	return t.GetIconImage(index), nil
+
+}
+
+// TryGetIconLink returns the value GetIconLink returns, or an AccessorError if IsIconLink returns false
+func (t *Application) TryGetIconLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetIconLink", Index: index}
+		return
+	}
+	return t.GetIconLink(index), nil
+
+}
+
+// TryGetIconIRI returns the value GetIconIRI returns, or an AccessorError if IsIconIRI returns false
+func (t *Application) TryGetIconIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetIconIRI", Index: index}
+		return
+	}
+	return t.GetIconIRI(index), nil
+
+}
+
+// TryGetUnknownIcon returns the value GetUnknownIcon returns, or an AccessorError if HasUnknownIcon returns false
+func (t *Application) TryGetUnknownIcon() (v interface{}, err error) {
+	if !t.HasUnknownIcon() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownIcon", Index: -1}
+		return
+	}
+	return t.GetUnknownIcon(), nil
+
+}
+
+// TryGetId returns the value GetId returns, or an AccessorError if HasId returns false
+func (t *Application) TryGetId() (v *url.URL, err error) {
+	if !t.HasId() {
+		err = &AccessorError{Type: "Application", Getter: "GetId", Index: -1}
+		return
+	}
+	return t.GetId(), nil
+
+}
+
+// TryGetUnknownId returns the value GetUnknownId returns, or an AccessorError if HasUnknownId returns false
+func (t *Application) TryGetUnknownId() (v interface{}, err error) {
+	if !t.HasUnknownId() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownId", Index: -1}
+		return
+	}
+	return t.GetUnknownId(), nil
+
+}
+
+// TryGetImageImage returns the value GetImageImage returns, or an AccessorError if IsImageImage returns false
+func (t *Application) TryGetImageImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageImage(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetImageImage", Index: index}
+		return
+	}
+	return t.GetImageImage(index), nil
+
+}
+
+// TryGetImageLink returns the value GetImageLink returns, or an AccessorError if IsImageLink returns false
+func (t *Application) TryGetImageLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetImageLink", Index: index}
+		return
+	}
+	return t.GetImageLink(index), nil
+
+}
+
+// TryGetImageIRI returns the value GetImageIRI returns, or an AccessorError if IsImageIRI returns false
+func (t *Application) TryGetImageIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetImageIRI", Index: index}
+		return
+	}
+	return t.GetImageIRI(index), nil
+
+}
+
+// TryGetUnknownImage returns the value GetUnknownImage returns, or an AccessorError if HasUnknownImage returns false
+func (t *Application) TryGetUnknownImage() (v interface{}, err error) {
+	if !t.HasUnknownImage() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownImage", Index: -1}
+		return
+	}
+	return t.GetUnknownImage(), nil
+
+}
+
+// TryGetInReplyToObject returns the value GetInReplyToObject returns, or an AccessorError if IsInReplyToObject returns false
+func (t *Application) TryGetInReplyToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetInReplyToObject", Index: index}
+		return
+	}
+	return t.GetInReplyToObject(index), nil
+
+}
+
+// TryGetInReplyToLink returns the value GetInReplyToLink returns, or an AccessorError if IsInReplyToLink returns false
+func (t *Application) TryGetInReplyToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetInReplyToLink", Index: index}
+		return
+	}
+	return t.GetInReplyToLink(index), nil
+
+}
+
+// TryGetInReplyToIRI returns the value GetInReplyToIRI returns, or an AccessorError if IsInReplyToIRI returns false
+func (t *Application) TryGetInReplyToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetInReplyToIRI", Index: index}
+		return
+	}
+	return t.GetInReplyToIRI(index), nil
+
+}
+
+// TryGetUnknownInReplyTo returns the value GetUnknownInReplyTo returns, or an AccessorError if HasUnknownInReplyTo returns false
+func (t *Application) TryGetUnknownInReplyTo() (v interface{}, err error) {
+	if !t.HasUnknownInReplyTo() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownInReplyTo", Index: -1}
+		return
+	}
+	return t.GetUnknownInReplyTo(), nil
+
+}
+
+// TryGetLocationObject returns the value GetLocationObject returns, or an AccessorError if IsLocationObject returns false
+func (t *Application) TryGetLocationObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetLocationObject", Index: index}
+		return
+	}
+	return t.GetLocationObject(index), nil
+
+}
+
+// TryGetLocationLink returns the value GetLocationLink returns, or an AccessorError if IsLocationLink returns false
+func (t *Application) TryGetLocationLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetLocationLink", Index: index}
+		return
+	}
+	return t.GetLocationLink(index), nil
+
+}
+
+// TryGetLocationIRI returns the value GetLocationIRI returns, or an AccessorError if IsLocationIRI returns false
+func (t *Application) TryGetLocationIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetLocationIRI", Index: index}
+		return
+	}
+	return t.GetLocationIRI(index), nil
+
+}
+
+// TryGetUnknownLocation returns the value GetUnknownLocation returns, or an AccessorError if HasUnknownLocation returns false
+func (t *Application) TryGetUnknownLocation() (v interface{}, err error) {
+	if !t.HasUnknownLocation() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownLocation", Index: -1}
+		return
+	}
+	return t.GetUnknownLocation(), nil
+
+}
+
+// TryGetPreviewObject returns the value GetPreviewObject returns, or an AccessorError if IsPreviewObject returns false
+func (t *Application) TryGetPreviewObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetPreviewObject", Index: index}
+		return
+	}
+	return t.GetPreviewObject(index), nil
+
+}
+
+// TryGetPreviewLink returns the value GetPreviewLink returns, or an AccessorError if IsPreviewLink returns false
+func (t *Application) TryGetPreviewLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetPreviewLink", Index: index}
+		return
+	}
+	return t.GetPreviewLink(index), nil
+
+}
+
+// TryGetPreviewIRI returns the value GetPreviewIRI returns, or an AccessorError if IsPreviewIRI returns false
+func (t *Application) TryGetPreviewIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetPreviewIRI", Index: index}
+		return
+	}
+	return t.GetPreviewIRI(index), nil
+
+}
+
+// TryGetUnknownPreview returns the value GetUnknownPreview returns, or an AccessorError if HasUnknownPreview returns false
+func (t *Application) TryGetUnknownPreview() (v interface{}, err error) {
+	if !t.HasUnknownPreview() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownPreview", Index: -1}
+		return
+	}
+	return t.GetUnknownPreview(), nil
+
+}
+
+// TryGetPublished returns the value GetPublished returns, or an AccessorError if IsPublished returns false
+func (t *Application) TryGetPublished() (v time.Time, err error) {
+	if !t.IsPublished() {
+		err = &AccessorError{Type: "Application", Getter: "GetPublished", Index: -1}
+		return
+	}
+	return t.GetPublished(), nil
+
+}
+
+// TryGetPublishedIRI returns the value GetPublishedIRI returns, or an AccessorError if IsPublishedIRI returns false
+func (t *Application) TryGetPublishedIRI() (v *url.URL, err error) {
+	if !t.IsPublishedIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetPublishedIRI", Index: -1}
+		return
+	}
+	return t.GetPublishedIRI(), nil
+
+}
+
+// TryGetUnknownPublished returns the value GetUnknownPublished returns, or an AccessorError if HasUnknownPublished returns false
+func (t *Application) TryGetUnknownPublished() (v interface{}, err error) {
+	if !t.HasUnknownPublished() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownPublished", Index: -1}
+		return
+	}
+	return t.GetUnknownPublished(), nil
+
+}
+
+// TryGetReplies returns the value GetReplies returns, or an AccessorError if IsReplies returns false
+func (t *Application) TryGetReplies() (v CollectionType, err error) {
+	if !t.IsReplies() {
+		err = &AccessorError{Type: "Application", Getter: "GetReplies", Index: -1}
+		return
+	}
+	return t.GetReplies(), nil
+
+}
+
+// TryGetRepliesIRI returns the value GetRepliesIRI returns, or an AccessorError if IsRepliesIRI returns false
+func (t *Application) TryGetRepliesIRI() (v *url.URL, err error) {
+	if !t.IsRepliesIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetRepliesIRI", Index: -1}
+		return
+	}
+	return t.GetRepliesIRI(), nil
+
+}
+
+// TryGetUnknownReplies returns the value GetUnknownReplies returns, or an AccessorError if HasUnknownReplies returns false
+func (t *Application) TryGetUnknownReplies() (v interface{}, err error) {
+	if !t.HasUnknownReplies() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownReplies", Index: -1}
+		return
+	}
+	return t.GetUnknownReplies(), nil
+
+}
+
+// TryGetStartTime returns the value GetStartTime returns, or an AccessorError if IsStartTime returns false
+func (t *Application) TryGetStartTime() (v time.Time, err error) {
+	if !t.IsStartTime() {
+		err = &AccessorError{Type: "Application", Getter: "GetStartTime", Index: -1}
+		return
+	}
+	return t.GetStartTime(), nil
+
+}
+
+// TryGetStartTimeIRI returns the value GetStartTimeIRI returns, or an AccessorError if IsStartTimeIRI returns false
+func (t *Application) TryGetStartTimeIRI() (v *url.URL, err error) {
+	if !t.IsStartTimeIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetStartTimeIRI", Index: -1}
+		return
+	}
+	return t.GetStartTimeIRI(), nil
+
+}
+
+// TryGetUnknownStartTime returns the value GetUnknownStartTime returns, or an AccessorError if HasUnknownStartTime returns false
+func (t *Application) TryGetUnknownStartTime() (v interface{}, err error) {
+	if !t.HasUnknownStartTime() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownStartTime", Index: -1}
+		return
+	}
+	return t.GetUnknownStartTime(), nil
+
+}
+
+// TryGetSummaryString returns the value GetSummaryString returns, or an AccessorError if IsSummaryString returns false
+func (t *Application) TryGetSummaryString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryString(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetSummaryString", Index: index}
+		return
+	}
+	return t.GetSummaryString(index), nil
+
+}
+
+// TryGetSummaryLangString returns the value GetSummaryLangString returns, or an AccessorError if IsSummaryLangString returns false
+func (t *Application) TryGetSummaryLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryLangString(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetSummaryLangString", Index: index}
+		return
+	}
+	return t.GetSummaryLangString(index), nil
+
+}
+
+// TryGetSummaryIRI returns the value GetSummaryIRI returns, or an AccessorError if IsSummaryIRI returns false
+func (t *Application) TryGetSummaryIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetSummaryIRI", Index: index}
+		return
+	}
+	return t.GetSummaryIRI(index), nil
+
+}
+
+// TryGetUnknownSummary returns the value GetUnknownSummary returns, or an AccessorError if HasUnknownSummary returns false
+func (t *Application) TryGetUnknownSummary() (v interface{}, err error) {
+	if !t.HasUnknownSummary() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownSummary", Index: -1}
+		return
+	}
+	return t.GetUnknownSummary(), nil
+
+}
+
+// TryGetTagObject returns the value GetTagObject returns, or an AccessorError if IsTagObject returns false
+func (t *Application) TryGetTagObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetTagObject", Index: index}
+		return
+	}
+	return t.GetTagObject(index), nil
+
+}
+
+// TryGetTagLink returns the value GetTagLink returns, or an AccessorError if IsTagLink returns false
+func (t *Application) TryGetTagLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetTagLink", Index: index}
+		return
+	}
+	return t.GetTagLink(index), nil
+
+}
+
+// TryGetTagIRI returns the value GetTagIRI returns, or an AccessorError if IsTagIRI returns false
+func (t *Application) TryGetTagIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetTagIRI", Index: index}
+		return
+	}
+	return t.GetTagIRI(index), nil
+
+}
+
+// TryGetUnknownTag returns the value GetUnknownTag returns, or an AccessorError if HasUnknownTag returns false
+func (t *Application) TryGetUnknownTag() (v interface{}, err error) {
+	if !t.HasUnknownTag() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownTag", Index: -1}
+		return
+	}
+	return t.GetUnknownTag(), nil
+
+}
+
+// TryGetType returns the value GetType returns, or an AccessorError if the index is out of range
+func (t *Application) TryGetType(index int) (v interface{}, err error) {
+	if index < 0 || index >= t.TypeLen() {
+		err = &AccessorError{Type: "Application", Getter: "GetType", Index: index}
+		return
+	}
+	return t.GetType(index), nil
+
+}
+
+// TryGetUpdated returns the value GetUpdated returns, or an AccessorError if IsUpdated returns false
+func (t *Application) TryGetUpdated() (v time.Time, err error) {
+	if !t.IsUpdated() {
+		err = &AccessorError{Type: "Application", Getter: "GetUpdated", Index: -1}
+		return
+	}
+	return t.GetUpdated(), nil
+
+}
+
+// TryGetUpdatedIRI returns the value GetUpdatedIRI returns, or an AccessorError if IsUpdatedIRI returns false
+func (t *Application) TryGetUpdatedIRI() (v *url.URL, err error) {
+	if !t.IsUpdatedIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetUpdatedIRI", Index: -1}
+		return
+	}
+	return t.GetUpdatedIRI(), nil
+
+}
+
+// TryGetUnknownUpdated returns the value GetUnknownUpdated returns, or an AccessorError if HasUnknownUpdated returns false
+func (t *Application) TryGetUnknownUpdated() (v interface{}, err error) {
+	if !t.HasUnknownUpdated() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownUpdated", Index: -1}
+		return
+	}
+	return t.GetUnknownUpdated(), nil
+
+}
+
+// TryGetUrlAnyURI returns the value GetUrlAnyURI returns, or an AccessorError if IsUrlAnyURI returns false
+func (t *Application) TryGetUrlAnyURI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlAnyURI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetUrlAnyURI", Index: index}
+		return
+	}
+	return t.GetUrlAnyURI(index), nil
+
+}
+
+// TryGetUrlLink returns the value GetUrlLink returns, or an AccessorError if IsUrlLink returns false
+func (t *Application) TryGetUrlLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetUrlLink", Index: index}
+		return
+	}
+	return t.GetUrlLink(index), nil
+
+}
+
+// TryGetUnknownUrl returns the value GetUnknownUrl returns, or an AccessorError if HasUnknownUrl returns false
+func (t *Application) TryGetUnknownUrl() (v interface{}, err error) {
+	if !t.HasUnknownUrl() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownUrl(), nil
+
+}
+
+// TryGetToObject returns the value GetToObject returns, or an AccessorError if IsToObject returns false
+func (t *Application) TryGetToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetToObject", Index: index}
+		return
+	}
+	return t.GetToObject(index), nil
+
+}
+
+// TryGetToLink returns the value GetToLink returns, or an AccessorError if IsToLink returns false
+func (t *Application) TryGetToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetToLink", Index: index}
+		return
+	}
+	return t.GetToLink(index), nil
+
+}
+
+// TryGetToIRI returns the value GetToIRI returns, or an AccessorError if IsToIRI returns false
+func (t *Application) TryGetToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetToIRI", Index: index}
+		return
+	}
+	return t.GetToIRI(index), nil
+
+}
+
+// TryGetUnknownTo returns the value GetUnknownTo returns, or an AccessorError if HasUnknownTo returns false
+func (t *Application) TryGetUnknownTo() (v interface{}, err error) {
+	if !t.HasUnknownTo() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownTo", Index: -1}
+		return
+	}
+	return t.GetUnknownTo(), nil
+
+}
+
+// TryGetBtoObject returns the value GetBtoObject returns, or an AccessorError if IsBtoObject returns false
+func (t *Application) TryGetBtoObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetBtoObject", Index: index}
+		return
+	}
+	return t.GetBtoObject(index), nil
+
+}
+
+// TryGetBtoLink returns the value GetBtoLink returns, or an AccessorError if IsBtoLink returns false
+func (t *Application) TryGetBtoLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetBtoLink", Index: index}
+		return
+	}
+	return t.GetBtoLink(index), nil
+
+}
+
+// TryGetBtoIRI returns the value GetBtoIRI returns, or an AccessorError if IsBtoIRI returns false
+func (t *Application) TryGetBtoIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetBtoIRI", Index: index}
+		return
+	}
+	return t.GetBtoIRI(index), nil
+
+}
+
+// TryGetUnknownBto returns the value GetUnknownBto returns, or an AccessorError if HasUnknownBto returns false
+func (t *Application) TryGetUnknownBto() (v interface{}, err error) {
+	if !t.HasUnknownBto() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownBto", Index: -1}
+		return
+	}
+	return t.GetUnknownBto(), nil
+
+}
+
+// TryGetCcObject returns the value GetCcObject returns, or an AccessorError if IsCcObject returns false
+func (t *Application) TryGetCcObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetCcObject", Index: index}
+		return
+	}
+	return t.GetCcObject(index), nil
+
+}
+
+// TryGetCcLink returns the value GetCcLink returns, or an AccessorError if IsCcLink returns false
+func (t *Application) TryGetCcLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetCcLink", Index: index}
+		return
+	}
+	return t.GetCcLink(index), nil
+
+}
+
+// TryGetCcIRI returns the value GetCcIRI returns, or an AccessorError if IsCcIRI returns false
+func (t *Application) TryGetCcIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetCcIRI", Index: index}
+		return
+	}
+	return t.GetCcIRI(index), nil
+
+}
+
+// TryGetUnknownCc returns the value GetUnknownCc returns, or an AccessorError if HasUnknownCc returns false
+func (t *Application) TryGetUnknownCc() (v interface{}, err error) {
+	if !t.HasUnknownCc() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownCc", Index: -1}
+		return
+	}
+	return t.GetUnknownCc(), nil
+
+}
+
+// TryGetBccObject returns the value GetBccObject returns, or an AccessorError if IsBccObject returns false
+func (t *Application) TryGetBccObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccObject(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetBccObject", Index: index}
+		return
+	}
+	return t.GetBccObject(index), nil
+
+}
+
+// TryGetBccLink returns the value GetBccLink returns, or an AccessorError if IsBccLink returns false
+func (t *Application) TryGetBccLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccLink(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetBccLink", Index: index}
+		return
+	}
+	return t.GetBccLink(index), nil
+
+}
+
+// TryGetBccIRI returns the value GetBccIRI returns, or an AccessorError if IsBccIRI returns false
+func (t *Application) TryGetBccIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccIRI(index) {
+		err = &AccessorError{Type: "Application", Getter: "GetBccIRI", Index: index}
+		return
+	}
+	return t.GetBccIRI(index), nil
+
+}
+
+// TryGetUnknownBcc returns the value GetUnknownBcc returns, or an AccessorError if HasUnknownBcc returns false
+func (t *Application) TryGetUnknownBcc() (v interface{}, err error) {
+	if !t.HasUnknownBcc() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownBcc", Index: -1}
+		return
+	}
+	return t.GetUnknownBcc(), nil
+
+}
+
+// TryGetMediaType returns the value GetMediaType returns, or an AccessorError if IsMediaType returns false
+func (t *Application) TryGetMediaType() (v string, err error) {
+	if !t.IsMediaType() {
+		err = &AccessorError{Type: "Application", Getter: "GetMediaType", Index: -1}
+		return
+	}
+	return t.GetMediaType(), nil
+
+}
+
+// TryGetMediaTypeIRI returns the value GetMediaTypeIRI returns, or an AccessorError if IsMediaTypeIRI returns false
+func (t *Application) TryGetMediaTypeIRI() (v *url.URL, err error) {
+	if !t.IsMediaTypeIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetMediaTypeIRI", Index: -1}
+		return
+	}
+	return t.GetMediaTypeIRI(), nil
+
+}
+
+// TryGetUnknownMediaType returns the value GetUnknownMediaType returns, or an AccessorError if HasUnknownMediaType returns false
+func (t *Application) TryGetUnknownMediaType() (v interface{}, err error) {
+	if !t.HasUnknownMediaType() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownMediaType", Index: -1}
+		return
+	}
+	return t.GetUnknownMediaType(), nil
+
+}
+
+// TryGetDuration returns the value GetDuration returns, or an AccessorError if IsDuration returns false
+func (t *Application) TryGetDuration() (v time.Duration, err error) {
+	if !t.IsDuration() {
+		err = &AccessorError{Type: "Application", Getter: "GetDuration", Index: -1}
+		return
+	}
+	return t.GetDuration(), nil
+
+}
+
+// TryGetDurationIRI returns the value GetDurationIRI returns, or an AccessorError if IsDurationIRI returns false
+func (t *Application) TryGetDurationIRI() (v *url.URL, err error) {
+	if !t.IsDurationIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetDurationIRI", Index: -1}
+		return
+	}
+	return t.GetDurationIRI(), nil
+
+}
+
+// TryGetUnknownDuration returns the value GetUnknownDuration returns, or an AccessorError if HasUnknownDuration returns false
+func (t *Application) TryGetUnknownDuration() (v interface{}, err error) {
+	if !t.HasUnknownDuration() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownDuration", Index: -1}
+		return
+	}
+	return t.GetUnknownDuration(), nil
+
+}
+
+// TryGetSource returns the value GetSource returns, or an AccessorError if IsSource returns false
+func (t *Application) TryGetSource() (v ObjectType, err error) {
+	if !t.IsSource() {
+		err = &AccessorError{Type: "Application", Getter: "GetSource", Index: -1}
+		return
+	}
+	return t.GetSource(), nil
+
+}
+
+// TryGetSourceIRI returns the value GetSourceIRI returns, or an AccessorError if IsSourceIRI returns false
+func (t *Application) TryGetSourceIRI() (v *url.URL, err error) {
+	if !t.IsSourceIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetSourceIRI", Index: -1}
+		return
+	}
+	return t.GetSourceIRI(), nil
+
+}
+
+// TryGetUnknownSource returns the value GetUnknownSource returns, or an AccessorError if HasUnknownSource returns false
+func (t *Application) TryGetUnknownSource() (v interface{}, err error) {
+	if !t.HasUnknownSource() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownSource", Index: -1}
+		return
+	}
+	return t.GetUnknownSource(), nil
+
+}
+
+// TryGetInboxOrderedCollection returns the value GetInboxOrderedCollection returns, or an AccessorError if IsInboxOrderedCollection returns false
+func (t *Application) TryGetInboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsInboxOrderedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetInboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetInboxOrderedCollection(), nil
+
+}
+
+// TryGetInboxAnyURI returns the value GetInboxAnyURI returns, or an AccessorError if IsInboxAnyURI returns false
+func (t *Application) TryGetInboxAnyURI() (v *url.URL, err error) {
+	if !t.IsInboxAnyURI() {
+		err = &AccessorError{Type: "Application", Getter: "GetInboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetInboxAnyURI(), nil
+
+}
+
+// TryGetUnknownInbox returns the value GetUnknownInbox returns, or an AccessorError if HasUnknownInbox returns false
+func (t *Application) TryGetUnknownInbox() (v interface{}, err error) {
+	if !t.HasUnknownInbox() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownInbox(), nil
+
+}
+
+// TryGetOutboxOrderedCollection returns the value GetOutboxOrderedCollection returns, or an AccessorError if IsOutboxOrderedCollection returns false
+func (t *Application) TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsOutboxOrderedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetOutboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetOutboxOrderedCollection(), nil
+
+}
+
+// TryGetOutboxAnyURI returns the value GetOutboxAnyURI returns, or an AccessorError if IsOutboxAnyURI returns false
+func (t *Application) TryGetOutboxAnyURI() (v *url.URL, err error) {
+	if !t.IsOutboxAnyURI() {
+		err = &AccessorError{Type: "Application", Getter: "GetOutboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetOutboxAnyURI(), nil
+
+}
+
+// TryGetUnknownOutbox returns the value GetUnknownOutbox returns, or an AccessorError if HasUnknownOutbox returns false
+func (t *Application) TryGetUnknownOutbox() (v interface{}, err error) {
+	if !t.HasUnknownOutbox() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownOutbox", Index: -1}
+		return
+	}
+	return t.GetUnknownOutbox(), nil
+
+}
+
+// TryGetFollowingCollection returns the value GetFollowingCollection returns, or an AccessorError if IsFollowingCollection returns false
+func (t *Application) TryGetFollowingCollection() (v CollectionType, err error) {
+	if !t.IsFollowingCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetFollowingCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingCollection(), nil
+
+}
+
+// TryGetFollowingOrderedCollection returns the value GetFollowingOrderedCollection returns, or an AccessorError if IsFollowingOrderedCollection returns false
+func (t *Application) TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowingOrderedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetFollowingOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingOrderedCollection(), nil
+
+}
+
+// TryGetFollowingAnyURI returns the value GetFollowingAnyURI returns, or an AccessorError if IsFollowingAnyURI returns false
+func (t *Application) TryGetFollowingAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowingAnyURI() {
+		err = &AccessorError{Type: "Application", Getter: "GetFollowingAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowingAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowing returns the value GetUnknownFollowing returns, or an AccessorError if HasUnknownFollowing returns false
+func (t *Application) TryGetUnknownFollowing() (v interface{}, err error) {
+	if !t.HasUnknownFollowing() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownFollowing", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowing(), nil
+
+}
+
+// TryGetFollowersCollection returns the value GetFollowersCollection returns, or an AccessorError if IsFollowersCollection returns false
+func (t *Application) TryGetFollowersCollection() (v CollectionType, err error) {
+	if !t.IsFollowersCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetFollowersCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersCollection(), nil
+
+}
+
+// TryGetFollowersOrderedCollection returns the value GetFollowersOrderedCollection returns, or an AccessorError if IsFollowersOrderedCollection returns false
+func (t *Application) TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowersOrderedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetFollowersOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersOrderedCollection(), nil
+
+}
+
+// TryGetFollowersAnyURI returns the value GetFollowersAnyURI returns, or an AccessorError if IsFollowersAnyURI returns false
+func (t *Application) TryGetFollowersAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowersAnyURI() {
+		err = &AccessorError{Type: "Application", Getter: "GetFollowersAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowersAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowers returns the value GetUnknownFollowers returns, or an AccessorError if HasUnknownFollowers returns false
+func (t *Application) TryGetUnknownFollowers() (v interface{}, err error) {
+	if !t.HasUnknownFollowers() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownFollowers", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowers(), nil
+
+}
+
+// TryGetLikedCollection returns the value GetLikedCollection returns, or an AccessorError if IsLikedCollection returns false
+func (t *Application) TryGetLikedCollection() (v CollectionType, err error) {
+	if !t.IsLikedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetLikedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedCollection(), nil
+
+}
+
+// TryGetLikedOrderedCollection returns the value GetLikedOrderedCollection returns, or an AccessorError if IsLikedOrderedCollection returns false
+func (t *Application) TryGetLikedOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikedOrderedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetLikedOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedOrderedCollection(), nil
+
+}
+
+// TryGetLikedAnyURI returns the value GetLikedAnyURI returns, or an AccessorError if IsLikedAnyURI returns false
+func (t *Application) TryGetLikedAnyURI() (v *url.URL, err error) {
+	if !t.IsLikedAnyURI() {
+		err = &AccessorError{Type: "Application", Getter: "GetLikedAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikedAnyURI(), nil
+
+}
+
+// TryGetUnknownLiked returns the value GetUnknownLiked returns, or an AccessorError if HasUnknownLiked returns false
+func (t *Application) TryGetUnknownLiked() (v interface{}, err error) {
+	if !t.HasUnknownLiked() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownLiked", Index: -1}
+		return
+	}
+	return t.GetUnknownLiked(), nil
+
+}
+
+// TryGetLikesCollection returns the value GetLikesCollection returns, or an AccessorError if IsLikesCollection returns false
+func (t *Application) TryGetLikesCollection() (v CollectionType, err error) {
+	if !t.IsLikesCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetLikesCollection", Index: -1}
+		return
+	}
+	return t.GetLikesCollection(), nil
+
+}
+
+// TryGetLikesOrderedCollection returns the value GetLikesOrderedCollection returns, or an AccessorError if IsLikesOrderedCollection returns false
+func (t *Application) TryGetLikesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikesOrderedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetLikesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikesOrderedCollection(), nil
+
+}
+
+// TryGetLikesAnyURI returns the value GetLikesAnyURI returns, or an AccessorError if IsLikesAnyURI returns false
+func (t *Application) TryGetLikesAnyURI() (v *url.URL, err error) {
+	if !t.IsLikesAnyURI() {
+		err = &AccessorError{Type: "Application", Getter: "GetLikesAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikesAnyURI(), nil
+
+}
+
+// TryGetUnknownLikes returns the value GetUnknownLikes returns, or an AccessorError if HasUnknownLikes returns false
+func (t *Application) TryGetUnknownLikes() (v interface{}, err error) {
+	if !t.HasUnknownLikes() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownLikes", Index: -1}
+		return
+	}
+	return t.GetUnknownLikes(), nil
+
+}
+
+// TryGetStreams returns the value GetStreams returns, or an AccessorError if the index is out of range
+func (t *Application) TryGetStreams(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.StreamsLen() {
+		err = &AccessorError{Type: "Application", Getter: "GetStreams", Index: index}
+		return
+	}
+	return t.GetStreams(index), nil
+
+}
+
+// TryGetUnknownStreams returns the value GetUnknownStreams returns, or an AccessorError if HasUnknownStreams returns false
+func (t *Application) TryGetUnknownStreams() (v interface{}, err error) {
+	if !t.HasUnknownStreams() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownStreams", Index: -1}
+		return
+	}
+	return t.GetUnknownStreams(), nil
+
+}
+
+// TryGetPreferredUsername returns the value GetPreferredUsername returns, or an AccessorError if IsPreferredUsername returns false
+func (t *Application) TryGetPreferredUsername() (v string, err error) {
+	if !t.IsPreferredUsername() {
+		err = &AccessorError{Type: "Application", Getter: "GetPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetPreferredUsername(), nil
+
+}
+
+// TryGetPreferredUsernameIRI returns the value GetPreferredUsernameIRI returns, or an AccessorError if IsPreferredUsernameIRI returns false
+func (t *Application) TryGetPreferredUsernameIRI() (v *url.URL, err error) {
+	if !t.IsPreferredUsernameIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetPreferredUsernameIRI", Index: -1}
+		return
+	}
+	return t.GetPreferredUsernameIRI(), nil
+
+}
+
+// TryGetUnknownPreferredUsername returns the value GetUnknownPreferredUsername returns, or an AccessorError if HasUnknownPreferredUsername returns false
+func (t *Application) TryGetUnknownPreferredUsername() (v interface{}, err error) {
+	if !t.HasUnknownPreferredUsername() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetUnknownPreferredUsername(), nil
+
+}
+
+// TryGetEndpoints returns the value GetEndpoints returns, or an AccessorError if IsEndpoints returns false
+func (t *Application) TryGetEndpoints() (v ObjectType, err error) {
+	if !t.IsEndpoints() {
+		err = &AccessorError{Type: "Application", Getter: "GetEndpoints", Index: -1}
+		return
+	}
+	return t.GetEndpoints(), nil
+
+}
+
+// TryGetEndpointsIRI returns the value GetEndpointsIRI returns, or an AccessorError if IsEndpointsIRI returns false
+func (t *Application) TryGetEndpointsIRI() (v *url.URL, err error) {
+	if !t.IsEndpointsIRI() {
+		err = &AccessorError{Type: "Application", Getter: "GetEndpointsIRI", Index: -1}
+		return
+	}
+	return t.GetEndpointsIRI(), nil
+
+}
+
+// TryGetUnknownEndpoints returns the value GetUnknownEndpoints returns, or an AccessorError if HasUnknownEndpoints returns false
+func (t *Application) TryGetUnknownEndpoints() (v interface{}, err error) {
+	if !t.HasUnknownEndpoints() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownEndpoints", Index: -1}
+		return
+	}
+	return t.GetUnknownEndpoints(), nil
+
+}
+
+// TryGetProxyUrl returns the value GetProxyUrl returns, or an AccessorError if HasProxyUrl returns false
+func (t *Application) TryGetProxyUrl() (v *url.URL, err error) {
+	if !t.HasProxyUrl() {
+		err = &AccessorError{Type: "Application", Getter: "GetProxyUrl", Index: -1}
+		return
+	}
+	return t.GetProxyUrl(), nil
+
+}
+
+// TryGetUnknownProxyUrl returns the value GetUnknownProxyUrl returns, or an AccessorError if HasUnknownProxyUrl returns false
+func (t *Application) TryGetUnknownProxyUrl() (v interface{}, err error) {
+	if !t.HasUnknownProxyUrl() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownProxyUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownProxyUrl(), nil
+
+}
+
+// TryGetOauthAuthorizationEndpoint returns the value GetOauthAuthorizationEndpoint returns, or an AccessorError if HasOauthAuthorizationEndpoint returns false
+func (t *Application) TryGetOauthAuthorizationEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "Application", Getter: "GetOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthAuthorizationEndpoint returns the value GetUnknownOauthAuthorizationEndpoint returns, or an AccessorError if HasUnknownOauthAuthorizationEndpoint returns false
+func (t *Application) TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetOauthTokenEndpoint returns the value GetOauthTokenEndpoint returns, or an AccessorError if HasOauthTokenEndpoint returns false
+func (t *Application) TryGetOauthTokenEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthTokenEndpoint() {
+		err = &AccessorError{Type: "Application", Getter: "GetOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthTokenEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthTokenEndpoint returns the value GetUnknownOauthTokenEndpoint returns, or an AccessorError if HasUnknownOauthTokenEndpoint returns false
+func (t *Application) TryGetUnknownOauthTokenEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthTokenEndpoint() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthTokenEndpoint(), nil
+
+}
+
+// TryGetProvideClientKey returns the value GetProvideClientKey returns, or an AccessorError if HasProvideClientKey returns false
+func (t *Application) TryGetProvideClientKey() (v *url.URL, err error) {
+	if !t.HasProvideClientKey() {
+		err = &AccessorError{Type: "Application", Getter: "GetProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetProvideClientKey(), nil
+
+}
+
+// TryGetUnknownProvideClientKey returns the value GetUnknownProvideClientKey returns, or an AccessorError if HasUnknownProvideClientKey returns false
+func (t *Application) TryGetUnknownProvideClientKey() (v interface{}, err error) {
+	if !t.HasUnknownProvideClientKey() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownProvideClientKey(), nil
+
+}
+
+// TryGetSignClientKey returns the value GetSignClientKey returns, or an AccessorError if HasSignClientKey returns false
+func (t *Application) TryGetSignClientKey() (v *url.URL, err error) {
+	if !t.HasSignClientKey() {
+		err = &AccessorError{Type: "Application", Getter: "GetSignClientKey", Index: -1}
+		return
+	}
+	return t.GetSignClientKey(), nil
+
+}
+
+// TryGetUnknownSignClientKey returns the value GetUnknownSignClientKey returns, or an AccessorError if HasUnknownSignClientKey returns false
+func (t *Application) TryGetUnknownSignClientKey() (v interface{}, err error) {
+	if !t.HasUnknownSignClientKey() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownSignClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownSignClientKey(), nil
+
+}
+
+// TryGetSharedInbox returns the value GetSharedInbox returns, or an AccessorError if HasSharedInbox returns false
+func (t *Application) TryGetSharedInbox() (v *url.URL, err error) {
+	if !t.HasSharedInbox() {
+		err = &AccessorError{Type: "Application", Getter: "GetSharedInbox", Index: -1}
+		return
+	}
+	return t.GetSharedInbox(), nil
+
+}
+
+// TryGetUnknownSharedInbox returns the value GetUnknownSharedInbox returns, or an AccessorError if HasUnknownSharedInbox returns false
+func (t *Application) TryGetUnknownSharedInbox() (v interface{}, err error) {
+	if !t.HasUnknownSharedInbox() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownSharedInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownSharedInbox(), nil
+
+}
+
+// TryGetSharesCollection returns the value GetSharesCollection returns, or an AccessorError if IsSharesCollection returns false
+func (t *Application) TryGetSharesCollection() (v CollectionType, err error) {
+	if !t.IsSharesCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetSharesCollection", Index: -1}
+		return
+	}
+	return t.GetSharesCollection(), nil
+
+}
+
+// TryGetSharesOrderedCollection returns the value GetSharesOrderedCollection returns, or an AccessorError if IsSharesOrderedCollection returns false
+func (t *Application) TryGetSharesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsSharesOrderedCollection() {
+		err = &AccessorError{Type: "Application", Getter: "GetSharesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetSharesOrderedCollection(), nil
+
+}
+
+// TryGetSharesAnyURI returns the value GetSharesAnyURI returns, or an AccessorError if IsSharesAnyURI returns false
+func (t *Application) TryGetSharesAnyURI() (v *url.URL, err error) {
+	if !t.IsSharesAnyURI() {
+		err = &AccessorError{Type: "Application", Getter: "GetSharesAnyURI", Index: -1}
+		return
+	}
+	return t.GetSharesAnyURI(), nil
+
+}
+
+// TryGetUnknownShares returns the value GetUnknownShares returns, or an AccessorError if HasUnknownShares returns false
+func (t *Application) TryGetUnknownShares() (v interface{}, err error) {
+	if !t.HasUnknownShares() {
+		err = &AccessorError{Type: "Application", Getter: "GetUnknownShares", Index: -1}
+		return
+	}
+	return t.GetUnknownShares(), nil
+
+}
+
+// WithAltitude calls SetAltitude and returns this Application, so that calls can be chained
+func (t *Application) WithAltitude(v float64) *Application {
+	t.SetAltitude(v)
+	return t
+
+}
+
+// WithAltitudeIRI calls SetAltitudeIRI and returns this Application, so that calls can be chained
+func (t *Application) WithAltitudeIRI(v *url.URL) *Application {
+	t.SetAltitudeIRI(v)
+	return t
+
+}
+
+// WithUnknownAltitude calls SetUnknownAltitude and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownAltitude(i interface{}) *Application {
+	t.SetUnknownAltitude(i)
+	return t
+
+}
+
+// WithAttachmentObject calls AppendAttachmentObject and returns this Application, so that calls can be chained
+func (t *Application) WithAttachmentObject(v ObjectType) *Application {
+	t.AppendAttachmentObject(v)
+	return t
+
+}
+
+// WithAttachmentLink calls AppendAttachmentLink and returns this Application, so that calls can be chained
+func (t *Application) WithAttachmentLink(v LinkType) *Application {
+	t.AppendAttachmentLink(v)
+	return t
+
+}
+
+// WithAttachmentIRI calls AppendAttachmentIRI and returns this Application, so that calls can be chained
+func (t *Application) WithAttachmentIRI(v *url.URL) *Application {
+	t.AppendAttachmentIRI(v)
+	return t
+
+}
+
+// WithUnknownAttachment calls SetUnknownAttachment and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownAttachment(i interface{}) *Application {
+	t.SetUnknownAttachment(i)
+	return t
+
+}
+
+// WithAttributedToObject calls AppendAttributedToObject and returns this Application, so that calls can be chained
+func (t *Application) WithAttributedToObject(v ObjectType) *Application {
+	t.AppendAttributedToObject(v)
+	return t
+
+}
+
+// WithAttributedToLink calls AppendAttributedToLink and returns this Application, so that calls can be chained
+func (t *Application) WithAttributedToLink(v LinkType) *Application {
+	t.AppendAttributedToLink(v)
+	return t
+
+}
+
+// WithAttributedToIRI calls AppendAttributedToIRI and returns this Application, so that calls can be chained
+func (t *Application) WithAttributedToIRI(v *url.URL) *Application {
+	t.AppendAttributedToIRI(v)
+	return t
+
+}
+
+// WithUnknownAttributedTo calls SetUnknownAttributedTo and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownAttributedTo(i interface{}) *Application {
+	t.SetUnknownAttributedTo(i)
+	return t
+
+}
+
+// WithAudienceObject calls AppendAudienceObject and returns this Application, so that calls can be chained
+func (t *Application) WithAudienceObject(v ObjectType) *Application {
+	t.AppendAudienceObject(v)
+	return t
+
+}
+
+// WithAudienceLink calls AppendAudienceLink and returns this Application, so that calls can be chained
+func (t *Application) WithAudienceLink(v LinkType) *Application {
+	t.AppendAudienceLink(v)
+	return t
+
+}
+
+// WithAudienceIRI calls AppendAudienceIRI and returns this Application, so that calls can be chained
+func (t *Application) WithAudienceIRI(v *url.URL) *Application {
+	t.AppendAudienceIRI(v)
+	return t
+
+}
+
+// WithUnknownAudience calls SetUnknownAudience and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownAudience(i interface{}) *Application {
+	t.SetUnknownAudience(i)
+	return t
+
+}
+
+// WithContentString calls AppendContentString and returns this Application, so that calls can be chained
+func (t *Application) WithContentString(v string) *Application {
+	t.AppendContentString(v)
+	return t
+
+}
+
+// WithContentLangString calls AppendContentLangString and returns this Application, so that calls can be chained
+func (t *Application) WithContentLangString(v string) *Application {
+	t.AppendContentLangString(v)
+	return t
+
+}
+
+// WithContentIRI calls AppendContentIRI and returns this Application, so that calls can be chained
+func (t *Application) WithContentIRI(v *url.URL) *Application {
+	t.AppendContentIRI(v)
+	return t
+
+}
+
+// WithUnknownContent calls SetUnknownContent and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownContent(i interface{}) *Application {
+	t.SetUnknownContent(i)
+	return t
+
+}
+
+// WithContentMap calls SetContentMap and returns this Application, so that calls can be chained
+func (t *Application) WithContentMap(l string, v string) *Application {
+	t.SetContentMap(l, v)
+	return t
+
+}
+
+// WithContentLanguage calls SetContentLanguage and returns this Application, so that calls can be chained
+func (t *Application) WithContentLanguage(tag string, v string) *Application {
+	t.SetContentLanguage(tag, v)
+	return t
+
+}
+
+// WithContextObject calls AppendContextObject and returns this Application, so that calls can be chained
+func (t *Application) WithContextObject(v ObjectType) *Application {
+	t.AppendContextObject(v)
+	return t
+
+}
+
+// WithContextLink calls AppendContextLink and returns this Application, so that calls can be chained
+func (t *Application) WithContextLink(v LinkType) *Application {
+	t.AppendContextLink(v)
+	return t
+
+}
+
+// WithContextIRI calls AppendContextIRI and returns this Application, so that calls can be chained
+func (t *Application) WithContextIRI(v *url.URL) *Application {
+	t.AppendContextIRI(v)
+	return t
+
+}
+
+// WithUnknownContext calls SetUnknownContext and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownContext(i interface{}) *Application {
+	t.SetUnknownContext(i)
+	return t
+
+}
+
+// WithNameString calls AppendNameString and returns this Application, so that calls can be chained
+func (t *Application) WithNameString(v string) *Application {
+	t.AppendNameString(v)
+	return t
+
+}
+
+// WithNameLangString calls AppendNameLangString and returns this Application, so that calls can be chained
+func (t *Application) WithNameLangString(v string) *Application {
+	t.AppendNameLangString(v)
+	return t
+
+}
+
+// WithNameIRI calls AppendNameIRI and returns this Application, so that calls can be chained
+func (t *Application) WithNameIRI(v *url.URL) *Application {
+	t.AppendNameIRI(v)
+	return t
+
+}
+
+// WithUnknownName calls SetUnknownName and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownName(i interface{}) *Application {
+	t.SetUnknownName(i)
+	return t
+
+}
+
+// WithNameMap calls SetNameMap and returns this Application, so that calls can be chained
+func (t *Application) WithNameMap(l string, v string) *Application {
+	t.SetNameMap(l, v)
+	return t
+
+}
+
+// WithNameLanguage calls SetNameLanguage and returns this Application, so that calls can be chained
+func (t *Application) WithNameLanguage(tag string, v string) *Application {
+	t.SetNameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndTime calls SetEndTime and returns this Application, so that calls can be chained
+func (t *Application) WithEndTime(v time.Time) *Application {
+	t.SetEndTime(v)
+	return t
+
+}
+
+// WithEndTimeIRI calls SetEndTimeIRI and returns this Application, so that calls can be chained
+func (t *Application) WithEndTimeIRI(v *url.URL) *Application {
+	t.SetEndTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownEndTime calls SetUnknownEndTime and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownEndTime(i interface{}) *Application {
+	t.SetUnknownEndTime(i)
+	return t
+
+}
+
+// WithGeneratorObject calls AppendGeneratorObject and returns this Application, so that calls can be chained
+func (t *Application) WithGeneratorObject(v ObjectType) *Application {
+	t.AppendGeneratorObject(v)
+	return t
+
+}
+
+// WithGeneratorLink calls AppendGeneratorLink and returns this Application, so that calls can be chained
+func (t *Application) WithGeneratorLink(v LinkType) *Application {
+	t.AppendGeneratorLink(v)
+	return t
+
+}
+
+// WithGeneratorIRI calls AppendGeneratorIRI and returns this Application, so that calls can be chained
+func (t *Application) WithGeneratorIRI(v *url.URL) *Application {
+	t.AppendGeneratorIRI(v)
+	return t
+
+}
+
+// WithUnknownGenerator calls SetUnknownGenerator and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownGenerator(i interface{}) *Application {
+	t.SetUnknownGenerator(i)
+	return t
+
+}
+
+// WithIconImage calls AppendIconImage and returns this Application, so that calls can be chained
+func (t *Application) WithIconImage(v ImageType) *Application {
+	t.AppendIconImage(v)
+	return t
+
+}
+
+// WithIconLink calls AppendIconLink and returns this Application, so that calls can be chained
+func (t *Application) WithIconLink(v LinkType) *Application {
+	t.AppendIconLink(v)
+	return t
+
+}
+
+// WithIconIRI calls AppendIconIRI and returns this Application, so that calls can be chained
+func (t *Application) WithIconIRI(v *url.URL) *Application {
+	t.AppendIconIRI(v)
+	return t
+
+}
+
+// WithUnknownIcon calls SetUnknownIcon and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownIcon(i interface{}) *Application {
+	t.SetUnknownIcon(i)
+	return t
+
+}
+
+// WithId calls SetId and returns this Application, so that calls can be chained
+func (t *Application) WithId(v *url.URL) *Application {
+	t.SetId(v)
+	return t
+
+}
+
+// WithUnknownId calls SetUnknownId and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownId(i interface{}) *Application {
+	t.SetUnknownId(i)
+	return t
+
+}
+
+// WithImageImage calls AppendImageImage and returns this Application, so that calls can be chained
+func (t *Application) WithImageImage(v ImageType) *Application {
+	t.AppendImageImage(v)
+	return t
+
+}
+
+// WithImageLink calls AppendImageLink and returns this Application, so that calls can be chained
+func (t *Application) WithImageLink(v LinkType) *Application {
+	t.AppendImageLink(v)
+	return t
+
+}
+
+// WithImageIRI calls AppendImageIRI and returns this Application, so that calls can be chained
+func (t *Application) WithImageIRI(v *url.URL) *Application {
+	t.AppendImageIRI(v)
+	return t
+
+}
+
+// WithUnknownImage calls SetUnknownImage and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownImage(i interface{}) *Application {
+	t.SetUnknownImage(i)
+	return t
+
+}
+
+// WithInReplyToObject calls AppendInReplyToObject and returns this Application, so that calls can be chained
+func (t *Application) WithInReplyToObject(v ObjectType) *Application {
+	t.AppendInReplyToObject(v)
+	return t
+
+}
+
+// WithInReplyToLink calls AppendInReplyToLink and returns this Application, so that calls can be chained
+func (t *Application) WithInReplyToLink(v LinkType) *Application {
+	t.AppendInReplyToLink(v)
+	return t
+
+}
+
+// WithInReplyToIRI calls AppendInReplyToIRI and returns this Application, so that calls can be chained
+func (t *Application) WithInReplyToIRI(v *url.URL) *Application {
+	t.AppendInReplyToIRI(v)
+	return t
+
+}
+
+// WithUnknownInReplyTo calls SetUnknownInReplyTo and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownInReplyTo(i interface{}) *Application {
+	t.SetUnknownInReplyTo(i)
+	return t
+
+}
+
+// WithLocationObject calls AppendLocationObject and returns this Application, so that calls can be chained
+func (t *Application) WithLocationObject(v ObjectType) *Application {
+	t.AppendLocationObject(v)
+	return t
+
+}
+
+// WithLocationLink calls AppendLocationLink and returns this Application, so that calls can be chained
+func (t *Application) WithLocationLink(v LinkType) *Application {
+	t.AppendLocationLink(v)
+	return t
+
+}
+
+// WithLocationIRI calls AppendLocationIRI and returns this Application, so that calls can be chained
+func (t *Application) WithLocationIRI(v *url.URL) *Application {
+	t.AppendLocationIRI(v)
+	return t
+
+}
+
+// WithUnknownLocation calls SetUnknownLocation and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownLocation(i interface{}) *Application {
+	t.SetUnknownLocation(i)
+	return t
+
+}
+
+// WithPreviewObject calls AppendPreviewObject and returns this Application, so that calls can be chained
+func (t *Application) WithPreviewObject(v ObjectType) *Application {
+	t.AppendPreviewObject(v)
+	return t
+
+}
+
+// WithPreviewLink calls AppendPreviewLink and returns this Application, so that calls can be chained
+func (t *Application) WithPreviewLink(v LinkType) *Application {
+	t.AppendPreviewLink(v)
+	return t
+
+}
+
+// WithPreviewIRI calls AppendPreviewIRI and returns this Application, so that calls can be chained
+func (t *Application) WithPreviewIRI(v *url.URL) *Application {
+	t.AppendPreviewIRI(v)
+	return t
+
+}
+
+// WithUnknownPreview calls SetUnknownPreview and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownPreview(i interface{}) *Application {
+	t.SetUnknownPreview(i)
+	return t
+
+}
+
+// WithPublished calls SetPublished and returns this Application, so that calls can be chained
+func (t *Application) WithPublished(v time.Time) *Application {
+	t.SetPublished(v)
+	return t
+
+}
+
+// WithPublishedIRI calls SetPublishedIRI and returns this Application, so that calls can be chained
+func (t *Application) WithPublishedIRI(v *url.URL) *Application {
+	t.SetPublishedIRI(v)
+	return t
+
+}
+
+// WithUnknownPublished calls SetUnknownPublished and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownPublished(i interface{}) *Application {
+	t.SetUnknownPublished(i)
+	return t
+
+}
+
+// WithReplies calls SetReplies and returns this Application, so that calls can be chained
+func (t *Application) WithReplies(v CollectionType) *Application {
+	t.SetReplies(v)
+	return t
+
+}
+
+// WithRepliesIRI calls SetRepliesIRI and returns this Application, so that calls can be chained
+func (t *Application) WithRepliesIRI(v *url.URL) *Application {
+	t.SetRepliesIRI(v)
+	return t
+
+}
+
+// WithUnknownReplies calls SetUnknownReplies and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownReplies(i interface{}) *Application {
+	t.SetUnknownReplies(i)
+	return t
+
+}
+
+// WithStartTime calls SetStartTime and returns this Application, so that calls can be chained
+func (t *Application) WithStartTime(v time.Time) *Application {
+	t.SetStartTime(v)
+	return t
+
+}
+
+// WithStartTimeIRI calls SetStartTimeIRI and returns this Application, so that calls can be chained
+func (t *Application) WithStartTimeIRI(v *url.URL) *Application {
+	t.SetStartTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownStartTime calls SetUnknownStartTime and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownStartTime(i interface{}) *Application {
+	t.SetUnknownStartTime(i)
+	return t
+
+}
+
+// WithSummaryString calls AppendSummaryString and returns this Application, so that calls can be chained
+func (t *Application) WithSummaryString(v string) *Application {
+	t.AppendSummaryString(v)
+	return t
+
+}
+
+// WithSummaryLangString calls AppendSummaryLangString and returns this Application, so that calls can be chained
+func (t *Application) WithSummaryLangString(v string) *Application {
+	t.AppendSummaryLangString(v)
+	return t
+
+}
+
+// WithSummaryIRI calls AppendSummaryIRI and returns this Application, so that calls can be chained
+func (t *Application) WithSummaryIRI(v *url.URL) *Application {
+	t.AppendSummaryIRI(v)
+	return t
+
+}
+
+// WithUnknownSummary calls SetUnknownSummary and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownSummary(i interface{}) *Application {
+	t.SetUnknownSummary(i)
+	return t
+
+}
+
+// WithSummaryMap calls SetSummaryMap and returns this Application, so that calls can be chained
+func (t *Application) WithSummaryMap(l string, v string) *Application {
+	t.SetSummaryMap(l, v)
+	return t
+
+}
+
+// WithSummaryLanguage calls SetSummaryLanguage and returns this Application, so that calls can be chained
+func (t *Application) WithSummaryLanguage(tag string, v string) *Application {
+	t.SetSummaryLanguage(tag, v)
+	return t
+
+}
+
+// WithTagObject calls AppendTagObject and returns this Application, so that calls can be chained
+func (t *Application) WithTagObject(v ObjectType) *Application {
+	t.AppendTagObject(v)
+	return t
+
+}
+
+// WithTagLink calls AppendTagLink and returns this Application, so that calls can be chained
+func (t *Application) WithTagLink(v LinkType) *Application {
+	t.AppendTagLink(v)
+	return t
+
+}
+
+// WithTagIRI calls AppendTagIRI and returns this Application, so that calls can be chained
+func (t *Application) WithTagIRI(v *url.URL) *Application {
+	t.AppendTagIRI(v)
+	return t
+
+}
+
+// WithUnknownTag calls SetUnknownTag and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownTag(i interface{}) *Application {
+	t.SetUnknownTag(i)
+	return t
+
+}
+
+// WithType calls AppendType and returns this Application, so that calls can be chained
+func (t *Application) WithType(v interface{}) *Application {
+	t.AppendType(v)
+	return t
+
+}
+
+// WithUpdated calls SetUpdated and returns this Application, so that calls can be chained
+func (t *Application) WithUpdated(v time.Time) *Application {
+	t.SetUpdated(v)
+	return t
+
+}
+
+// WithUpdatedIRI calls SetUpdatedIRI and returns this Application, so that calls can be chained
+func (t *Application) WithUpdatedIRI(v *url.URL) *Application {
+	t.SetUpdatedIRI(v)
+	return t
+
+}
+
+// WithUnknownUpdated calls SetUnknownUpdated and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownUpdated(i interface{}) *Application {
+	t.SetUnknownUpdated(i)
+	return t
+
+}
+
+// WithUrlAnyURI calls AppendUrlAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithUrlAnyURI(v *url.URL) *Application {
+	t.AppendUrlAnyURI(v)
+	return t
+
+}
+
+// WithUrlLink calls AppendUrlLink and returns this Application, so that calls can be chained
+func (t *Application) WithUrlLink(v LinkType) *Application {
+	t.AppendUrlLink(v)
+	return t
+
+}
+
+// WithUnknownUrl calls SetUnknownUrl and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownUrl(i interface{}) *Application {
+	t.SetUnknownUrl(i)
+	return t
+
+}
+
+// WithToObject calls AppendToObject and returns this Application, so that calls can be chained
+func (t *Application) WithToObject(v ObjectType) *Application {
+	t.AppendToObject(v)
+	return t
+
+}
+
+// WithToLink calls AppendToLink and returns this Application, so that calls can be chained
+func (t *Application) WithToLink(v LinkType) *Application {
+	t.AppendToLink(v)
+	return t
+
+}
+
+// WithToIRI calls AppendToIRI and returns this Application, so that calls can be chained
+func (t *Application) WithToIRI(v *url.URL) *Application {
+	t.AppendToIRI(v)
+	return t
+
+}
+
+// WithUnknownTo calls SetUnknownTo and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownTo(i interface{}) *Application {
+	t.SetUnknownTo(i)
+	return t
+
+}
+
+// WithBtoObject calls AppendBtoObject and returns this Application, so that calls can be chained
+func (t *Application) WithBtoObject(v ObjectType) *Application {
+	t.AppendBtoObject(v)
+	return t
+
+}
+
+// WithBtoLink calls AppendBtoLink and returns this Application, so that calls can be chained
+func (t *Application) WithBtoLink(v LinkType) *Application {
+	t.AppendBtoLink(v)
+	return t
+
+}
+
+// WithBtoIRI calls AppendBtoIRI and returns this Application, so that calls can be chained
+func (t *Application) WithBtoIRI(v *url.URL) *Application {
+	t.AppendBtoIRI(v)
+	return t
+
+}
+
+// WithUnknownBto calls SetUnknownBto and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownBto(i interface{}) *Application {
+	t.SetUnknownBto(i)
+	return t
+
+}
+
+// WithCcObject calls AppendCcObject and returns this Application, so that calls can be chained
+func (t *Application) WithCcObject(v ObjectType) *Application {
+	t.AppendCcObject(v)
+	return t
+
+}
+
+// WithCcLink calls AppendCcLink and returns this Application, so that calls can be chained
+func (t *Application) WithCcLink(v LinkType) *Application {
+	t.AppendCcLink(v)
+	return t
+
+}
+
+// WithCcIRI calls AppendCcIRI and returns this Application, so that calls can be chained
+func (t *Application) WithCcIRI(v *url.URL) *Application {
+	t.AppendCcIRI(v)
+	return t
+
+}
+
+// WithUnknownCc calls SetUnknownCc and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownCc(i interface{}) *Application {
+	t.SetUnknownCc(i)
+	return t
+
+}
+
+// WithBccObject calls AppendBccObject and returns this Application, so that calls can be chained
+func (t *Application) WithBccObject(v ObjectType) *Application {
+	t.AppendBccObject(v)
+	return t
+
+}
+
+// WithBccLink calls AppendBccLink and returns this Application, so that calls can be chained
+func (t *Application) WithBccLink(v LinkType) *Application {
+	t.AppendBccLink(v)
+	return t
+
+}
+
+// WithBccIRI calls AppendBccIRI and returns this Application, so that calls can be chained
+func (t *Application) WithBccIRI(v *url.URL) *Application {
+	t.AppendBccIRI(v)
+	return t
+
+}
+
+// WithUnknownBcc calls SetUnknownBcc and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownBcc(i interface{}) *Application {
+	t.SetUnknownBcc(i)
+	return t
+
+}
+
+// WithMediaType calls SetMediaType and returns this Application, so that calls can be chained
+func (t *Application) WithMediaType(v string) *Application {
+	t.SetMediaType(v)
+	return t
+
+}
+
+// WithMediaTypeIRI calls SetMediaTypeIRI and returns this Application, so that calls can be chained
+func (t *Application) WithMediaTypeIRI(v *url.URL) *Application {
+	t.SetMediaTypeIRI(v)
+	return t
+
+}
+
+// WithUnknownMediaType calls SetUnknownMediaType and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownMediaType(i interface{}) *Application {
+	t.SetUnknownMediaType(i)
+	return t
+
+}
+
+// WithDuration calls SetDuration and returns this Application, so that calls can be chained
+func (t *Application) WithDuration(v time.Duration) *Application {
+	t.SetDuration(v)
+	return t
+
+}
+
+// WithDurationIRI calls SetDurationIRI and returns this Application, so that calls can be chained
+func (t *Application) WithDurationIRI(v *url.URL) *Application {
+	t.SetDurationIRI(v)
+	return t
+
+}
+
+// WithUnknownDuration calls SetUnknownDuration and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownDuration(i interface{}) *Application {
+	t.SetUnknownDuration(i)
+	return t
+
+}
+
+// WithSource calls SetSource and returns this Application, so that calls can be chained
+func (t *Application) WithSource(v ObjectType) *Application {
+	t.SetSource(v)
+	return t
+
+}
+
+// WithSourceIRI calls SetSourceIRI and returns this Application, so that calls can be chained
+func (t *Application) WithSourceIRI(v *url.URL) *Application {
+	t.SetSourceIRI(v)
+	return t
+
+}
+
+// WithUnknownSource calls SetUnknownSource and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownSource(i interface{}) *Application {
+	t.SetUnknownSource(i)
+	return t
+
+}
+
+// WithInboxOrderedCollection calls SetInboxOrderedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithInboxOrderedCollection(v OrderedCollectionType) *Application {
+	t.SetInboxOrderedCollection(v)
+	return t
+
+}
+
+// WithInboxAnyURI calls SetInboxAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithInboxAnyURI(v *url.URL) *Application {
+	t.SetInboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownInbox calls SetUnknownInbox and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownInbox(i interface{}) *Application {
+	t.SetUnknownInbox(i)
+	return t
+
+}
+
+// WithOutboxOrderedCollection calls SetOutboxOrderedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithOutboxOrderedCollection(v OrderedCollectionType) *Application {
+	t.SetOutboxOrderedCollection(v)
+	return t
+
+}
+
+// WithOutboxAnyURI calls SetOutboxAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithOutboxAnyURI(v *url.URL) *Application {
+	t.SetOutboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownOutbox calls SetUnknownOutbox and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownOutbox(i interface{}) *Application {
+	t.SetUnknownOutbox(i)
+	return t
+
+}
+
+// WithFollowingCollection calls SetFollowingCollection and returns this Application, so that calls can be chained
+func (t *Application) WithFollowingCollection(v CollectionType) *Application {
+	t.SetFollowingCollection(v)
+	return t
+
+}
+
+// WithFollowingOrderedCollection calls SetFollowingOrderedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithFollowingOrderedCollection(v OrderedCollectionType) *Application {
+	t.SetFollowingOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowingAnyURI calls SetFollowingAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithFollowingAnyURI(v *url.URL) *Application {
+	t.SetFollowingAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowing calls SetUnknownFollowing and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownFollowing(i interface{}) *Application {
+	t.SetUnknownFollowing(i)
+	return t
+
+}
+
+// WithFollowersCollection calls SetFollowersCollection and returns this Application, so that calls can be chained
+func (t *Application) WithFollowersCollection(v CollectionType) *Application {
+	t.SetFollowersCollection(v)
+	return t
+
+}
+
+// WithFollowersOrderedCollection calls SetFollowersOrderedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithFollowersOrderedCollection(v OrderedCollectionType) *Application {
+	t.SetFollowersOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowersAnyURI calls SetFollowersAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithFollowersAnyURI(v *url.URL) *Application {
+	t.SetFollowersAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowers calls SetUnknownFollowers and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownFollowers(i interface{}) *Application {
+	t.SetUnknownFollowers(i)
+	return t
+
+}
+
+// WithLikedCollection calls SetLikedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithLikedCollection(v CollectionType) *Application {
+	t.SetLikedCollection(v)
+	return t
+
+}
+
+// WithLikedOrderedCollection calls SetLikedOrderedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithLikedOrderedCollection(v OrderedCollectionType) *Application {
+	t.SetLikedOrderedCollection(v)
+	return t
+
+}
+
+// WithLikedAnyURI calls SetLikedAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithLikedAnyURI(v *url.URL) *Application {
+	t.SetLikedAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLiked calls SetUnknownLiked and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownLiked(i interface{}) *Application {
+	t.SetUnknownLiked(i)
+	return t
+
+}
+
+// WithLikesCollection calls SetLikesCollection and returns this Application, so that calls can be chained
+func (t *Application) WithLikesCollection(v CollectionType) *Application {
+	t.SetLikesCollection(v)
+	return t
+
+}
+
+// WithLikesOrderedCollection calls SetLikesOrderedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithLikesOrderedCollection(v OrderedCollectionType) *Application {
+	t.SetLikesOrderedCollection(v)
+	return t
+
+}
+
+// WithLikesAnyURI calls SetLikesAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithLikesAnyURI(v *url.URL) *Application {
+	t.SetLikesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLikes calls SetUnknownLikes and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownLikes(i interface{}) *Application {
+	t.SetUnknownLikes(i)
+	return t
+
+}
+
+// WithStreams calls AppendStreams and returns this Application, so that calls can be chained
+func (t *Application) WithStreams(v *url.URL) *Application {
+	t.AppendStreams(v)
+	return t
+
+}
+
+// WithUnknownStreams calls SetUnknownStreams and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownStreams(i interface{}) *Application {
+	t.SetUnknownStreams(i)
+	return t
+
+}
+
+// WithPreferredUsername calls SetPreferredUsername and returns this Application, so that calls can be chained
+func (t *Application) WithPreferredUsername(v string) *Application {
+	t.SetPreferredUsername(v)
+	return t
+
+}
+
+// WithPreferredUsernameIRI calls SetPreferredUsernameIRI and returns this Application, so that calls can be chained
+func (t *Application) WithPreferredUsernameIRI(v *url.URL) *Application {
+	t.SetPreferredUsernameIRI(v)
+	return t
+
+}
+
+// WithUnknownPreferredUsername calls SetUnknownPreferredUsername and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownPreferredUsername(i interface{}) *Application {
+	t.SetUnknownPreferredUsername(i)
+	return t
+
+}
+
+// WithPreferredUsernameMap calls SetPreferredUsernameMap and returns this Application, so that calls can be chained
+func (t *Application) WithPreferredUsernameMap(l string, v string) *Application {
+	t.SetPreferredUsernameMap(l, v)
+	return t
+
+}
+
+// WithPreferredUsernameLanguage calls SetPreferredUsernameLanguage and returns this Application, so that calls can be chained
+func (t *Application) WithPreferredUsernameLanguage(tag string, v string) *Application {
+	t.SetPreferredUsernameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndpoints calls SetEndpoints and returns this Application, so that calls can be chained
+func (t *Application) WithEndpoints(v ObjectType) *Application {
+	t.SetEndpoints(v)
+	return t
+
+}
+
+// WithEndpointsIRI calls SetEndpointsIRI and returns this Application, so that calls can be chained
+func (t *Application) WithEndpointsIRI(v *url.URL) *Application {
+	t.SetEndpointsIRI(v)
+	return t
+
+}
+
+// WithUnknownEndpoints calls SetUnknownEndpoints and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownEndpoints(i interface{}) *Application {
+	t.SetUnknownEndpoints(i)
+	return t
+
+}
+
+// WithProxyUrl calls SetProxyUrl and returns this Application, so that calls can be chained
+func (t *Application) WithProxyUrl(v *url.URL) *Application {
+	t.SetProxyUrl(v)
+	return t
+
+}
+
+// WithUnknownProxyUrl calls SetUnknownProxyUrl and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownProxyUrl(i interface{}) *Application {
+	t.SetUnknownProxyUrl(i)
+	return t
+
+}
+
+// WithOauthAuthorizationEndpoint calls SetOauthAuthorizationEndpoint and returns this Application, so that calls can be chained
+func (t *Application) WithOauthAuthorizationEndpoint(v *url.URL) *Application {
+	t.SetOauthAuthorizationEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthAuthorizationEndpoint calls SetUnknownOauthAuthorizationEndpoint and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownOauthAuthorizationEndpoint(i interface{}) *Application {
+	t.SetUnknownOauthAuthorizationEndpoint(i)
+	return t
+
+}
+
+// WithOauthTokenEndpoint calls SetOauthTokenEndpoint and returns this Application, so that calls can be chained
+func (t *Application) WithOauthTokenEndpoint(v *url.URL) *Application {
+	t.SetOauthTokenEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthTokenEndpoint calls SetUnknownOauthTokenEndpoint and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownOauthTokenEndpoint(i interface{}) *Application {
+	t.SetUnknownOauthTokenEndpoint(i)
+	return t
+
+}
+
+// WithProvideClientKey calls SetProvideClientKey and returns this Application, so that calls can be chained
+func (t *Application) WithProvideClientKey(v *url.URL) *Application {
+	t.SetProvideClientKey(v)
+	return t
+
+}
+
+// WithUnknownProvideClientKey calls SetUnknownProvideClientKey and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownProvideClientKey(i interface{}) *Application {
+	t.SetUnknownProvideClientKey(i)
+	return t
+
+}
+
+// WithSignClientKey calls SetSignClientKey and returns this Application, so that calls can be chained
+func (t *Application) WithSignClientKey(v *url.URL) *Application {
+	t.SetSignClientKey(v)
+	return t
+
+}
+
+// WithUnknownSignClientKey calls SetUnknownSignClientKey and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownSignClientKey(i interface{}) *Application {
+	t.SetUnknownSignClientKey(i)
+	return t
+
+}
+
+// WithSharedInbox calls SetSharedInbox and returns this Application, so that calls can be chained
+func (t *Application) WithSharedInbox(v *url.URL) *Application {
+	t.SetSharedInbox(v)
+	return t
+
+}
+
+// WithUnknownSharedInbox calls SetUnknownSharedInbox and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownSharedInbox(i interface{}) *Application {
+	t.SetUnknownSharedInbox(i)
+	return t
+
+}
+
+// WithSharesCollection calls SetSharesCollection and returns this Application, so that calls can be chained
+func (t *Application) WithSharesCollection(v CollectionType) *Application {
+	t.SetSharesCollection(v)
+	return t
+
+}
+
+// WithSharesOrderedCollection calls SetSharesOrderedCollection and returns this Application, so that calls can be chained
+func (t *Application) WithSharesOrderedCollection(v OrderedCollectionType) *Application {
+	t.SetSharesOrderedCollection(v)
+	return t
+
+}
+
+// WithSharesAnyURI calls SetSharesAnyURI and returns this Application, so that calls can be chained
+func (t *Application) WithSharesAnyURI(v *url.URL) *Application {
+	t.SetSharesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownShares calls SetUnknownShares and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownShares(i interface{}) *Application {
+	t.SetUnknownShares(i)
+	return t
+
+}
+
+// WithUnknownProperty calls SetUnknownProperty and returns this Application, so that calls can be chained
+func (t *Application) WithUnknownProperty(k string, i interface{}) *Application {
+	t.SetUnknownProperty(k, i)
+	return t
+
+}
+
+// markPresent_ sets or clears the bit of a member in the presence bitset.
+func (t *Application) markPresent_(i uint, set bool) {
+	if set {
+		t.present_[i/64] |= 1 << (i % 64)
+	} else {
+		t.present_[i/64] &^= 1 << (i % 64)
+	}
+
+}
+
+// markAllPresent_ sets the bits of every member in the presence bitset that is set, and clears the others.
+func (t *Application) markAllPresent_() {
+	t.markPresent_(0, t.altitude != nil)
+	t.markPresent_(1, t.attachment != nil)
+	t.markPresent_(2, t.attributedTo != nil)
+	t.markPresent_(3, t.audience != nil)
+	t.markPresent_(4, t.content != nil)
+	t.markPresent_(5, t.contentMap != nil)
+	t.markPresent_(6, t.context != nil)
+	t.markPresent_(7, t.name != nil)
+	t.markPresent_(8, t.nameMap != nil)
+	t.markPresent_(9, t.endTime != nil)
+	t.markPresent_(10, t.generator != nil)
+	t.markPresent_(11, t.icon != nil)
+	t.markPresent_(12, t.id != nil)
+	t.markPresent_(13, t.image != nil)
+	t.markPresent_(14, t.inReplyTo != nil)
+	t.markPresent_(15, t.location != nil)
+	t.markPresent_(16, t.preview != nil)
+	t.markPresent_(17, t.published != nil)
+	t.markPresent_(18, t.replies != nil)
+	t.markPresent_(19, t.startTime != nil)
+	t.markPresent_(20, t.summary != nil)
+	t.markPresent_(21, t.summaryMap != nil)
+	t.markPresent_(22, t.tag != nil)
+	t.markPresent_(23, t.typeName != nil)
+	t.markPresent_(24, t.updated != nil)
+	t.markPresent_(25, t.url != nil)
+	t.markPresent_(26, t.to != nil)
+	t.markPresent_(27, t.bto != nil)
+	t.markPresent_(28, t.cc != nil)
+	t.markPresent_(29, t.bcc != nil)
+	t.markPresent_(30, t.mediaType != nil)
+	t.markPresent_(31, t.duration != nil)
+	t.markPresent_(32, t.source != nil)
+	t.markPresent_(33, t.inbox != nil)
+	t.markPresent_(34, t.outbox != nil)
+	t.markPresent_(35, t.following != nil)
+	t.markPresent_(36, t.followers != nil)
+	t.markPresent_(37, t.liked != nil)
+	t.markPresent_(38, t.likes != nil)
+	t.markPresent_(39, t.streams != nil)
+	t.markPresent_(40, t.preferredUsername != nil)
+	t.markPresent_(41, t.preferredUsernameMap != nil)
+	t.markPresent_(42, t.endpoints != nil)
+	t.markPresent_(43, t.proxyUrl != nil)
+	t.markPresent_(44, t.oauthAuthorizationEndpoint != nil)
+	t.markPresent_(45, t.oauthTokenEndpoint != nil)
+	t.markPresent_(46, t.provideClientKey != nil)
+	t.markPresent_(47, t.signClientKey != nil)
+	t.markPresent_(48, t.sharedInbox != nil)
+	t.markPresent_(49, t.shares != nil)
+
+}
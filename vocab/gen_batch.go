@@ -0,0 +1,937 @@
+//
+package vocab
+
+// DeserializeManyObject deserializes each of the maps as a Object. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyObject(ms []map[string]interface{}) (t []*Object, err error) {
+	backing := make([]Object, len(ms))
+	t = make([]*Object, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyLink deserializes each of the maps as a Link. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyLink(ms []map[string]interface{}) (t []*Link, err error) {
+	backing := make([]Link, len(ms))
+	t = make([]*Link, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyActivity deserializes each of the maps as a Activity. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyActivity(ms []map[string]interface{}) (t []*Activity, err error) {
+	backing := make([]Activity, len(ms))
+	t = make([]*Activity, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyIntransitiveActivity deserializes each of the maps as a IntransitiveActivity. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyIntransitiveActivity(ms []map[string]interface{}) (t []*IntransitiveActivity, err error) {
+	backing := make([]IntransitiveActivity, len(ms))
+	t = make([]*IntransitiveActivity, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyCollection deserializes each of the maps as a Collection. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyCollection(ms []map[string]interface{}) (t []*Collection, err error) {
+	backing := make([]Collection, len(ms))
+	t = make([]*Collection, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyOrderedCollection deserializes each of the maps as a OrderedCollection. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyOrderedCollection(ms []map[string]interface{}) (t []*OrderedCollection, err error) {
+	backing := make([]OrderedCollection, len(ms))
+	t = make([]*OrderedCollection, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyCollectionPage deserializes each of the maps as a CollectionPage. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyCollectionPage(ms []map[string]interface{}) (t []*CollectionPage, err error) {
+	backing := make([]CollectionPage, len(ms))
+	t = make([]*CollectionPage, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyOrderedCollectionPage deserializes each of the maps as a OrderedCollectionPage. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyOrderedCollectionPage(ms []map[string]interface{}) (t []*OrderedCollectionPage, err error) {
+	backing := make([]OrderedCollectionPage, len(ms))
+	t = make([]*OrderedCollectionPage, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyAccept deserializes each of the maps as a Accept. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyAccept(ms []map[string]interface{}) (t []*Accept, err error) {
+	backing := make([]Accept, len(ms))
+	t = make([]*Accept, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyTentativeAccept deserializes each of the maps as a TentativeAccept. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyTentativeAccept(ms []map[string]interface{}) (t []*TentativeAccept, err error) {
+	backing := make([]TentativeAccept, len(ms))
+	t = make([]*TentativeAccept, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyAdd deserializes each of the maps as a Add. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyAdd(ms []map[string]interface{}) (t []*Add, err error) {
+	backing := make([]Add, len(ms))
+	t = make([]*Add, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyArrive deserializes each of the maps as a Arrive. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyArrive(ms []map[string]interface{}) (t []*Arrive, err error) {
+	backing := make([]Arrive, len(ms))
+	t = make([]*Arrive, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyCreate deserializes each of the maps as a Create. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyCreate(ms []map[string]interface{}) (t []*Create, err error) {
+	backing := make([]Create, len(ms))
+	t = make([]*Create, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyDelete deserializes each of the maps as a Delete. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyDelete(ms []map[string]interface{}) (t []*Delete, err error) {
+	backing := make([]Delete, len(ms))
+	t = make([]*Delete, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyFollow deserializes each of the maps as a Follow. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyFollow(ms []map[string]interface{}) (t []*Follow, err error) {
+	backing := make([]Follow, len(ms))
+	t = make([]*Follow, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyIgnore deserializes each of the maps as a Ignore. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyIgnore(ms []map[string]interface{}) (t []*Ignore, err error) {
+	backing := make([]Ignore, len(ms))
+	t = make([]*Ignore, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyJoin deserializes each of the maps as a Join. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyJoin(ms []map[string]interface{}) (t []*Join, err error) {
+	backing := make([]Join, len(ms))
+	t = make([]*Join, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyLeave deserializes each of the maps as a Leave. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyLeave(ms []map[string]interface{}) (t []*Leave, err error) {
+	backing := make([]Leave, len(ms))
+	t = make([]*Leave, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyLike deserializes each of the maps as a Like. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyLike(ms []map[string]interface{}) (t []*Like, err error) {
+	backing := make([]Like, len(ms))
+	t = make([]*Like, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyOffer deserializes each of the maps as a Offer. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyOffer(ms []map[string]interface{}) (t []*Offer, err error) {
+	backing := make([]Offer, len(ms))
+	t = make([]*Offer, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyInvite deserializes each of the maps as a Invite. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyInvite(ms []map[string]interface{}) (t []*Invite, err error) {
+	backing := make([]Invite, len(ms))
+	t = make([]*Invite, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyReject deserializes each of the maps as a Reject. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyReject(ms []map[string]interface{}) (t []*Reject, err error) {
+	backing := make([]Reject, len(ms))
+	t = make([]*Reject, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyTentativeReject deserializes each of the maps as a TentativeReject. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyTentativeReject(ms []map[string]interface{}) (t []*TentativeReject, err error) {
+	backing := make([]TentativeReject, len(ms))
+	t = make([]*TentativeReject, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyRemove deserializes each of the maps as a Remove. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyRemove(ms []map[string]interface{}) (t []*Remove, err error) {
+	backing := make([]Remove, len(ms))
+	t = make([]*Remove, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyUndo deserializes each of the maps as a Undo. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyUndo(ms []map[string]interface{}) (t []*Undo, err error) {
+	backing := make([]Undo, len(ms))
+	t = make([]*Undo, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyUpdate deserializes each of the maps as a Update. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyUpdate(ms []map[string]interface{}) (t []*Update, err error) {
+	backing := make([]Update, len(ms))
+	t = make([]*Update, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyView deserializes each of the maps as a View. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyView(ms []map[string]interface{}) (t []*View, err error) {
+	backing := make([]View, len(ms))
+	t = make([]*View, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyListen deserializes each of the maps as a Listen. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyListen(ms []map[string]interface{}) (t []*Listen, err error) {
+	backing := make([]Listen, len(ms))
+	t = make([]*Listen, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyRead deserializes each of the maps as a Read. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyRead(ms []map[string]interface{}) (t []*Read, err error) {
+	backing := make([]Read, len(ms))
+	t = make([]*Read, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyMove deserializes each of the maps as a Move. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyMove(ms []map[string]interface{}) (t []*Move, err error) {
+	backing := make([]Move, len(ms))
+	t = make([]*Move, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyTravel deserializes each of the maps as a Travel. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyTravel(ms []map[string]interface{}) (t []*Travel, err error) {
+	backing := make([]Travel, len(ms))
+	t = make([]*Travel, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyAnnounce deserializes each of the maps as a Announce. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyAnnounce(ms []map[string]interface{}) (t []*Announce, err error) {
+	backing := make([]Announce, len(ms))
+	t = make([]*Announce, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyBlock deserializes each of the maps as a Block. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyBlock(ms []map[string]interface{}) (t []*Block, err error) {
+	backing := make([]Block, len(ms))
+	t = make([]*Block, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyFlag deserializes each of the maps as a Flag. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyFlag(ms []map[string]interface{}) (t []*Flag, err error) {
+	backing := make([]Flag, len(ms))
+	t = make([]*Flag, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyDislike deserializes each of the maps as a Dislike. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyDislike(ms []map[string]interface{}) (t []*Dislike, err error) {
+	backing := make([]Dislike, len(ms))
+	t = make([]*Dislike, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyQuestion deserializes each of the maps as a Question. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyQuestion(ms []map[string]interface{}) (t []*Question, err error) {
+	backing := make([]Question, len(ms))
+	t = make([]*Question, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyApplication deserializes each of the maps as a Application. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyApplication(ms []map[string]interface{}) (t []*Application, err error) {
+	backing := make([]Application, len(ms))
+	t = make([]*Application, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyGroup deserializes each of the maps as a Group. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyGroup(ms []map[string]interface{}) (t []*Group, err error) {
+	backing := make([]Group, len(ms))
+	t = make([]*Group, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyOrganization deserializes each of the maps as a Organization. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyOrganization(ms []map[string]interface{}) (t []*Organization, err error) {
+	backing := make([]Organization, len(ms))
+	t = make([]*Organization, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyPerson deserializes each of the maps as a Person. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyPerson(ms []map[string]interface{}) (t []*Person, err error) {
+	backing := make([]Person, len(ms))
+	t = make([]*Person, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyService deserializes each of the maps as a Service. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyService(ms []map[string]interface{}) (t []*Service, err error) {
+	backing := make([]Service, len(ms))
+	t = make([]*Service, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyRelationship deserializes each of the maps as a Relationship. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyRelationship(ms []map[string]interface{}) (t []*Relationship, err error) {
+	backing := make([]Relationship, len(ms))
+	t = make([]*Relationship, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyArticle deserializes each of the maps as a Article. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyArticle(ms []map[string]interface{}) (t []*Article, err error) {
+	backing := make([]Article, len(ms))
+	t = make([]*Article, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyDocument deserializes each of the maps as a Document. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyDocument(ms []map[string]interface{}) (t []*Document, err error) {
+	backing := make([]Document, len(ms))
+	t = make([]*Document, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyAudio deserializes each of the maps as a Audio. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyAudio(ms []map[string]interface{}) (t []*Audio, err error) {
+	backing := make([]Audio, len(ms))
+	t = make([]*Audio, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyImage deserializes each of the maps as a Image. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyImage(ms []map[string]interface{}) (t []*Image, err error) {
+	backing := make([]Image, len(ms))
+	t = make([]*Image, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyVideo deserializes each of the maps as a Video. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyVideo(ms []map[string]interface{}) (t []*Video, err error) {
+	backing := make([]Video, len(ms))
+	t = make([]*Video, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyNote deserializes each of the maps as a Note. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyNote(ms []map[string]interface{}) (t []*Note, err error) {
+	backing := make([]Note, len(ms))
+	t = make([]*Note, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyPage deserializes each of the maps as a Page. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyPage(ms []map[string]interface{}) (t []*Page, err error) {
+	backing := make([]Page, len(ms))
+	t = make([]*Page, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyEvent deserializes each of the maps as a Event. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyEvent(ms []map[string]interface{}) (t []*Event, err error) {
+	backing := make([]Event, len(ms))
+	t = make([]*Event, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyPlace deserializes each of the maps as a Place. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyPlace(ms []map[string]interface{}) (t []*Place, err error) {
+	backing := make([]Place, len(ms))
+	t = make([]*Place, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyProfile deserializes each of the maps as a Profile. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyProfile(ms []map[string]interface{}) (t []*Profile, err error) {
+	backing := make([]Profile, len(ms))
+	t = make([]*Profile, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyTombstone deserializes each of the maps as a Tombstone. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyTombstone(ms []map[string]interface{}) (t []*Tombstone, err error) {
+	backing := make([]Tombstone, len(ms))
+	t = make([]*Tombstone, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyMention deserializes each of the maps as a Mention. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyMention(ms []map[string]interface{}) (t []*Mention, err error) {
+	backing := make([]Mention, len(ms))
+	t = make([]*Mention, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
+
+// DeserializeManyHashtag deserializes each of the maps as a Hashtag. The values are allocated
+// together in a single slice rather than one at a time, which servers ingesting
+// large pages of collections can use to reduce allocations. If a map cannot be
+// deserialized, the values before it are returned with the error, so the index
+// of the map that failed is the number of values returned.
+func DeserializeManyHashtag(ms []map[string]interface{}) (t []*Hashtag, err error) {
+	backing := make([]Hashtag, len(ms))
+	t = make([]*Hashtag, 0, len(ms))
+	for i, m := range ms {
+		if err = backing[i].Deserialize(m); err != nil {
+			return
+		}
+		t = append(t, &backing[i])
+	}
+	return
+}
@@ -0,0 +1,1681 @@
+//
+package vocab
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// benchmarkDocument returns the JSON document of the random value of the named
+// type that the benchmarks of the type measure, decoded as it is before being
+// deserialized. It is the same in every run, so that the results of runs can be
+// compared.
+func benchmarkDocument(b *testing.B, typeName string) map[string]interface{} {
+	v, err := NewRandom(typeName, 1)
+	if err != nil {
+		b.Fatal(err)
+	}
+	m, err := v.Serialize()
+	if err != nil {
+		b.Fatal(err)
+	}
+	j, err := json.Marshal(m)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err = json.Unmarshal(j, &doc); err != nil {
+		b.Fatal(err)
+	}
+	return doc
+}
+
+// BenchmarkSerializeObject measures serializing a representative Object.
+func BenchmarkSerializeObject(b *testing.B) {
+	v := &Object{}
+	if err := v.Deserialize(benchmarkDocument(b, "Object")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeObject measures deserializing a representative Object.
+func BenchmarkDeserializeObject(b *testing.B) {
+	m := benchmarkDocument(b, "Object")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Object{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeLink measures serializing a representative Link.
+func BenchmarkSerializeLink(b *testing.B) {
+	v := &Link{}
+	if err := v.Deserialize(benchmarkDocument(b, "Link")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeLink measures deserializing a representative Link.
+func BenchmarkDeserializeLink(b *testing.B) {
+	m := benchmarkDocument(b, "Link")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Link{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeActivity measures serializing a representative Activity.
+func BenchmarkSerializeActivity(b *testing.B) {
+	v := &Activity{}
+	if err := v.Deserialize(benchmarkDocument(b, "Activity")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeActivity measures deserializing a representative Activity.
+func BenchmarkDeserializeActivity(b *testing.B) {
+	m := benchmarkDocument(b, "Activity")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Activity{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeIntransitiveActivity measures serializing a representative IntransitiveActivity.
+func BenchmarkSerializeIntransitiveActivity(b *testing.B) {
+	v := &IntransitiveActivity{}
+	if err := v.Deserialize(benchmarkDocument(b, "IntransitiveActivity")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeIntransitiveActivity measures deserializing a representative IntransitiveActivity.
+func BenchmarkDeserializeIntransitiveActivity(b *testing.B) {
+	m := benchmarkDocument(b, "IntransitiveActivity")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &IntransitiveActivity{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeCollection measures serializing a representative Collection.
+func BenchmarkSerializeCollection(b *testing.B) {
+	v := &Collection{}
+	if err := v.Deserialize(benchmarkDocument(b, "Collection")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeCollection measures deserializing a representative Collection.
+func BenchmarkDeserializeCollection(b *testing.B) {
+	m := benchmarkDocument(b, "Collection")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Collection{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeOrderedCollection measures serializing a representative OrderedCollection.
+func BenchmarkSerializeOrderedCollection(b *testing.B) {
+	v := &OrderedCollection{}
+	if err := v.Deserialize(benchmarkDocument(b, "OrderedCollection")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeOrderedCollection measures deserializing a representative OrderedCollection.
+func BenchmarkDeserializeOrderedCollection(b *testing.B) {
+	m := benchmarkDocument(b, "OrderedCollection")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &OrderedCollection{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeCollectionPage measures serializing a representative CollectionPage.
+func BenchmarkSerializeCollectionPage(b *testing.B) {
+	v := &CollectionPage{}
+	if err := v.Deserialize(benchmarkDocument(b, "CollectionPage")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeCollectionPage measures deserializing a representative CollectionPage.
+func BenchmarkDeserializeCollectionPage(b *testing.B) {
+	m := benchmarkDocument(b, "CollectionPage")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &CollectionPage{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeOrderedCollectionPage measures serializing a representative OrderedCollectionPage.
+func BenchmarkSerializeOrderedCollectionPage(b *testing.B) {
+	v := &OrderedCollectionPage{}
+	if err := v.Deserialize(benchmarkDocument(b, "OrderedCollectionPage")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeOrderedCollectionPage measures deserializing a representative OrderedCollectionPage.
+func BenchmarkDeserializeOrderedCollectionPage(b *testing.B) {
+	m := benchmarkDocument(b, "OrderedCollectionPage")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &OrderedCollectionPage{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeAccept measures serializing a representative Accept.
+func BenchmarkSerializeAccept(b *testing.B) {
+	v := &Accept{}
+	if err := v.Deserialize(benchmarkDocument(b, "Accept")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeAccept measures deserializing a representative Accept.
+func BenchmarkDeserializeAccept(b *testing.B) {
+	m := benchmarkDocument(b, "Accept")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Accept{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeTentativeAccept measures serializing a representative TentativeAccept.
+func BenchmarkSerializeTentativeAccept(b *testing.B) {
+	v := &TentativeAccept{}
+	if err := v.Deserialize(benchmarkDocument(b, "TentativeAccept")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeTentativeAccept measures deserializing a representative TentativeAccept.
+func BenchmarkDeserializeTentativeAccept(b *testing.B) {
+	m := benchmarkDocument(b, "TentativeAccept")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &TentativeAccept{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeAdd measures serializing a representative Add.
+func BenchmarkSerializeAdd(b *testing.B) {
+	v := &Add{}
+	if err := v.Deserialize(benchmarkDocument(b, "Add")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeAdd measures deserializing a representative Add.
+func BenchmarkDeserializeAdd(b *testing.B) {
+	m := benchmarkDocument(b, "Add")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Add{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeArrive measures serializing a representative Arrive.
+func BenchmarkSerializeArrive(b *testing.B) {
+	v := &Arrive{}
+	if err := v.Deserialize(benchmarkDocument(b, "Arrive")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeArrive measures deserializing a representative Arrive.
+func BenchmarkDeserializeArrive(b *testing.B) {
+	m := benchmarkDocument(b, "Arrive")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Arrive{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeCreate measures serializing a representative Create.
+func BenchmarkSerializeCreate(b *testing.B) {
+	v := &Create{}
+	if err := v.Deserialize(benchmarkDocument(b, "Create")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeCreate measures deserializing a representative Create.
+func BenchmarkDeserializeCreate(b *testing.B) {
+	m := benchmarkDocument(b, "Create")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Create{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeDelete measures serializing a representative Delete.
+func BenchmarkSerializeDelete(b *testing.B) {
+	v := &Delete{}
+	if err := v.Deserialize(benchmarkDocument(b, "Delete")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeDelete measures deserializing a representative Delete.
+func BenchmarkDeserializeDelete(b *testing.B) {
+	m := benchmarkDocument(b, "Delete")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Delete{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeFollow measures serializing a representative Follow.
+func BenchmarkSerializeFollow(b *testing.B) {
+	v := &Follow{}
+	if err := v.Deserialize(benchmarkDocument(b, "Follow")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeFollow measures deserializing a representative Follow.
+func BenchmarkDeserializeFollow(b *testing.B) {
+	m := benchmarkDocument(b, "Follow")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Follow{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeIgnore measures serializing a representative Ignore.
+func BenchmarkSerializeIgnore(b *testing.B) {
+	v := &Ignore{}
+	if err := v.Deserialize(benchmarkDocument(b, "Ignore")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeIgnore measures deserializing a representative Ignore.
+func BenchmarkDeserializeIgnore(b *testing.B) {
+	m := benchmarkDocument(b, "Ignore")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Ignore{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeJoin measures serializing a representative Join.
+func BenchmarkSerializeJoin(b *testing.B) {
+	v := &Join{}
+	if err := v.Deserialize(benchmarkDocument(b, "Join")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeJoin measures deserializing a representative Join.
+func BenchmarkDeserializeJoin(b *testing.B) {
+	m := benchmarkDocument(b, "Join")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Join{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeLeave measures serializing a representative Leave.
+func BenchmarkSerializeLeave(b *testing.B) {
+	v := &Leave{}
+	if err := v.Deserialize(benchmarkDocument(b, "Leave")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeLeave measures deserializing a representative Leave.
+func BenchmarkDeserializeLeave(b *testing.B) {
+	m := benchmarkDocument(b, "Leave")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Leave{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeLike measures serializing a representative Like.
+func BenchmarkSerializeLike(b *testing.B) {
+	v := &Like{}
+	if err := v.Deserialize(benchmarkDocument(b, "Like")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeLike measures deserializing a representative Like.
+func BenchmarkDeserializeLike(b *testing.B) {
+	m := benchmarkDocument(b, "Like")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Like{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeOffer measures serializing a representative Offer.
+func BenchmarkSerializeOffer(b *testing.B) {
+	v := &Offer{}
+	if err := v.Deserialize(benchmarkDocument(b, "Offer")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeOffer measures deserializing a representative Offer.
+func BenchmarkDeserializeOffer(b *testing.B) {
+	m := benchmarkDocument(b, "Offer")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Offer{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeInvite measures serializing a representative Invite.
+func BenchmarkSerializeInvite(b *testing.B) {
+	v := &Invite{}
+	if err := v.Deserialize(benchmarkDocument(b, "Invite")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeInvite measures deserializing a representative Invite.
+func BenchmarkDeserializeInvite(b *testing.B) {
+	m := benchmarkDocument(b, "Invite")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Invite{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeReject measures serializing a representative Reject.
+func BenchmarkSerializeReject(b *testing.B) {
+	v := &Reject{}
+	if err := v.Deserialize(benchmarkDocument(b, "Reject")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeReject measures deserializing a representative Reject.
+func BenchmarkDeserializeReject(b *testing.B) {
+	m := benchmarkDocument(b, "Reject")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Reject{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeTentativeReject measures serializing a representative TentativeReject.
+func BenchmarkSerializeTentativeReject(b *testing.B) {
+	v := &TentativeReject{}
+	if err := v.Deserialize(benchmarkDocument(b, "TentativeReject")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeTentativeReject measures deserializing a representative TentativeReject.
+func BenchmarkDeserializeTentativeReject(b *testing.B) {
+	m := benchmarkDocument(b, "TentativeReject")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &TentativeReject{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeRemove measures serializing a representative Remove.
+func BenchmarkSerializeRemove(b *testing.B) {
+	v := &Remove{}
+	if err := v.Deserialize(benchmarkDocument(b, "Remove")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeRemove measures deserializing a representative Remove.
+func BenchmarkDeserializeRemove(b *testing.B) {
+	m := benchmarkDocument(b, "Remove")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Remove{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeUndo measures serializing a representative Undo.
+func BenchmarkSerializeUndo(b *testing.B) {
+	v := &Undo{}
+	if err := v.Deserialize(benchmarkDocument(b, "Undo")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeUndo measures deserializing a representative Undo.
+func BenchmarkDeserializeUndo(b *testing.B) {
+	m := benchmarkDocument(b, "Undo")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Undo{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeUpdate measures serializing a representative Update.
+func BenchmarkSerializeUpdate(b *testing.B) {
+	v := &Update{}
+	if err := v.Deserialize(benchmarkDocument(b, "Update")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeUpdate measures deserializing a representative Update.
+func BenchmarkDeserializeUpdate(b *testing.B) {
+	m := benchmarkDocument(b, "Update")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Update{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeView measures serializing a representative View.
+func BenchmarkSerializeView(b *testing.B) {
+	v := &View{}
+	if err := v.Deserialize(benchmarkDocument(b, "View")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeView measures deserializing a representative View.
+func BenchmarkDeserializeView(b *testing.B) {
+	m := benchmarkDocument(b, "View")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &View{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeListen measures serializing a representative Listen.
+func BenchmarkSerializeListen(b *testing.B) {
+	v := &Listen{}
+	if err := v.Deserialize(benchmarkDocument(b, "Listen")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeListen measures deserializing a representative Listen.
+func BenchmarkDeserializeListen(b *testing.B) {
+	m := benchmarkDocument(b, "Listen")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Listen{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeRead measures serializing a representative Read.
+func BenchmarkSerializeRead(b *testing.B) {
+	v := &Read{}
+	if err := v.Deserialize(benchmarkDocument(b, "Read")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeRead measures deserializing a representative Read.
+func BenchmarkDeserializeRead(b *testing.B) {
+	m := benchmarkDocument(b, "Read")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Read{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeMove measures serializing a representative Move.
+func BenchmarkSerializeMove(b *testing.B) {
+	v := &Move{}
+	if err := v.Deserialize(benchmarkDocument(b, "Move")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeMove measures deserializing a representative Move.
+func BenchmarkDeserializeMove(b *testing.B) {
+	m := benchmarkDocument(b, "Move")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Move{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeTravel measures serializing a representative Travel.
+func BenchmarkSerializeTravel(b *testing.B) {
+	v := &Travel{}
+	if err := v.Deserialize(benchmarkDocument(b, "Travel")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeTravel measures deserializing a representative Travel.
+func BenchmarkDeserializeTravel(b *testing.B) {
+	m := benchmarkDocument(b, "Travel")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Travel{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeAnnounce measures serializing a representative Announce.
+func BenchmarkSerializeAnnounce(b *testing.B) {
+	v := &Announce{}
+	if err := v.Deserialize(benchmarkDocument(b, "Announce")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeAnnounce measures deserializing a representative Announce.
+func BenchmarkDeserializeAnnounce(b *testing.B) {
+	m := benchmarkDocument(b, "Announce")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Announce{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeBlock measures serializing a representative Block.
+func BenchmarkSerializeBlock(b *testing.B) {
+	v := &Block{}
+	if err := v.Deserialize(benchmarkDocument(b, "Block")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeBlock measures deserializing a representative Block.
+func BenchmarkDeserializeBlock(b *testing.B) {
+	m := benchmarkDocument(b, "Block")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Block{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeFlag measures serializing a representative Flag.
+func BenchmarkSerializeFlag(b *testing.B) {
+	v := &Flag{}
+	if err := v.Deserialize(benchmarkDocument(b, "Flag")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeFlag measures deserializing a representative Flag.
+func BenchmarkDeserializeFlag(b *testing.B) {
+	m := benchmarkDocument(b, "Flag")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Flag{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeDislike measures serializing a representative Dislike.
+func BenchmarkSerializeDislike(b *testing.B) {
+	v := &Dislike{}
+	if err := v.Deserialize(benchmarkDocument(b, "Dislike")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeDislike measures deserializing a representative Dislike.
+func BenchmarkDeserializeDislike(b *testing.B) {
+	m := benchmarkDocument(b, "Dislike")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Dislike{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeQuestion measures serializing a representative Question.
+func BenchmarkSerializeQuestion(b *testing.B) {
+	v := &Question{}
+	if err := v.Deserialize(benchmarkDocument(b, "Question")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeQuestion measures deserializing a representative Question.
+func BenchmarkDeserializeQuestion(b *testing.B) {
+	m := benchmarkDocument(b, "Question")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Question{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeApplication measures serializing a representative Application.
+func BenchmarkSerializeApplication(b *testing.B) {
+	v := &Application{}
+	if err := v.Deserialize(benchmarkDocument(b, "Application")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeApplication measures deserializing a representative Application.
+func BenchmarkDeserializeApplication(b *testing.B) {
+	m := benchmarkDocument(b, "Application")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Application{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeGroup measures serializing a representative Group.
+func BenchmarkSerializeGroup(b *testing.B) {
+	v := &Group{}
+	if err := v.Deserialize(benchmarkDocument(b, "Group")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeGroup measures deserializing a representative Group.
+func BenchmarkDeserializeGroup(b *testing.B) {
+	m := benchmarkDocument(b, "Group")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Group{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeOrganization measures serializing a representative Organization.
+func BenchmarkSerializeOrganization(b *testing.B) {
+	v := &Organization{}
+	if err := v.Deserialize(benchmarkDocument(b, "Organization")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeOrganization measures deserializing a representative Organization.
+func BenchmarkDeserializeOrganization(b *testing.B) {
+	m := benchmarkDocument(b, "Organization")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Organization{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializePerson measures serializing a representative Person.
+func BenchmarkSerializePerson(b *testing.B) {
+	v := &Person{}
+	if err := v.Deserialize(benchmarkDocument(b, "Person")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializePerson measures deserializing a representative Person.
+func BenchmarkDeserializePerson(b *testing.B) {
+	m := benchmarkDocument(b, "Person")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Person{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeService measures serializing a representative Service.
+func BenchmarkSerializeService(b *testing.B) {
+	v := &Service{}
+	if err := v.Deserialize(benchmarkDocument(b, "Service")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeService measures deserializing a representative Service.
+func BenchmarkDeserializeService(b *testing.B) {
+	m := benchmarkDocument(b, "Service")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Service{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeRelationship measures serializing a representative Relationship.
+func BenchmarkSerializeRelationship(b *testing.B) {
+	v := &Relationship{}
+	if err := v.Deserialize(benchmarkDocument(b, "Relationship")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeRelationship measures deserializing a representative Relationship.
+func BenchmarkDeserializeRelationship(b *testing.B) {
+	m := benchmarkDocument(b, "Relationship")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Relationship{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeArticle measures serializing a representative Article.
+func BenchmarkSerializeArticle(b *testing.B) {
+	v := &Article{}
+	if err := v.Deserialize(benchmarkDocument(b, "Article")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeArticle measures deserializing a representative Article.
+func BenchmarkDeserializeArticle(b *testing.B) {
+	m := benchmarkDocument(b, "Article")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Article{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeDocument measures serializing a representative Document.
+func BenchmarkSerializeDocument(b *testing.B) {
+	v := &Document{}
+	if err := v.Deserialize(benchmarkDocument(b, "Document")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeDocument measures deserializing a representative Document.
+func BenchmarkDeserializeDocument(b *testing.B) {
+	m := benchmarkDocument(b, "Document")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Document{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeAudio measures serializing a representative Audio.
+func BenchmarkSerializeAudio(b *testing.B) {
+	v := &Audio{}
+	if err := v.Deserialize(benchmarkDocument(b, "Audio")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeAudio measures deserializing a representative Audio.
+func BenchmarkDeserializeAudio(b *testing.B) {
+	m := benchmarkDocument(b, "Audio")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Audio{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeImage measures serializing a representative Image.
+func BenchmarkSerializeImage(b *testing.B) {
+	v := &Image{}
+	if err := v.Deserialize(benchmarkDocument(b, "Image")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeImage measures deserializing a representative Image.
+func BenchmarkDeserializeImage(b *testing.B) {
+	m := benchmarkDocument(b, "Image")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Image{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeVideo measures serializing a representative Video.
+func BenchmarkSerializeVideo(b *testing.B) {
+	v := &Video{}
+	if err := v.Deserialize(benchmarkDocument(b, "Video")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeVideo measures deserializing a representative Video.
+func BenchmarkDeserializeVideo(b *testing.B) {
+	m := benchmarkDocument(b, "Video")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Video{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeNote measures serializing a representative Note.
+func BenchmarkSerializeNote(b *testing.B) {
+	v := &Note{}
+	if err := v.Deserialize(benchmarkDocument(b, "Note")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeNote measures deserializing a representative Note.
+func BenchmarkDeserializeNote(b *testing.B) {
+	m := benchmarkDocument(b, "Note")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Note{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializePage measures serializing a representative Page.
+func BenchmarkSerializePage(b *testing.B) {
+	v := &Page{}
+	if err := v.Deserialize(benchmarkDocument(b, "Page")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializePage measures deserializing a representative Page.
+func BenchmarkDeserializePage(b *testing.B) {
+	m := benchmarkDocument(b, "Page")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Page{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeEvent measures serializing a representative Event.
+func BenchmarkSerializeEvent(b *testing.B) {
+	v := &Event{}
+	if err := v.Deserialize(benchmarkDocument(b, "Event")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeEvent measures deserializing a representative Event.
+func BenchmarkDeserializeEvent(b *testing.B) {
+	m := benchmarkDocument(b, "Event")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Event{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializePlace measures serializing a representative Place.
+func BenchmarkSerializePlace(b *testing.B) {
+	v := &Place{}
+	if err := v.Deserialize(benchmarkDocument(b, "Place")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializePlace measures deserializing a representative Place.
+func BenchmarkDeserializePlace(b *testing.B) {
+	m := benchmarkDocument(b, "Place")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Place{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeProfile measures serializing a representative Profile.
+func BenchmarkSerializeProfile(b *testing.B) {
+	v := &Profile{}
+	if err := v.Deserialize(benchmarkDocument(b, "Profile")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeProfile measures deserializing a representative Profile.
+func BenchmarkDeserializeProfile(b *testing.B) {
+	m := benchmarkDocument(b, "Profile")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Profile{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeTombstone measures serializing a representative Tombstone.
+func BenchmarkSerializeTombstone(b *testing.B) {
+	v := &Tombstone{}
+	if err := v.Deserialize(benchmarkDocument(b, "Tombstone")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeTombstone measures deserializing a representative Tombstone.
+func BenchmarkDeserializeTombstone(b *testing.B) {
+	m := benchmarkDocument(b, "Tombstone")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Tombstone{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeMention measures serializing a representative Mention.
+func BenchmarkSerializeMention(b *testing.B) {
+	v := &Mention{}
+	if err := v.Deserialize(benchmarkDocument(b, "Mention")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeMention measures deserializing a representative Mention.
+func BenchmarkDeserializeMention(b *testing.B) {
+	m := benchmarkDocument(b, "Mention")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Mention{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSerializeHashtag measures serializing a representative Hashtag.
+func BenchmarkSerializeHashtag(b *testing.B) {
+	v := &Hashtag{}
+	if err := v.Deserialize(benchmarkDocument(b, "Hashtag")); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := v.Serialize()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = m
+	}
+}
+
+// BenchmarkDeserializeHashtag measures deserializing a representative Hashtag.
+func BenchmarkDeserializeHashtag(b *testing.B) {
+	m := benchmarkDocument(b, "Hashtag")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := &Hashtag{}
+		if err := v.Deserialize(m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
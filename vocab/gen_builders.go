@@ -0,0 +1,448 @@
+//
+package vocab
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AcceptBuilder builds a Accept activity by chaining calls, validating that its
+// 'actor' and 'object' are set when it is built.
+type AcceptBuilder struct {
+	a *Accept
+}
+
+// NewAcceptBuilder creates a builder for a Accept activity.
+func NewAcceptBuilder() *AcceptBuilder {
+	return &AcceptBuilder{a: &Accept{}}
+}
+
+// Id sets the 'id' of the Accept.
+func (b *AcceptBuilder) Id(iri *url.URL) *AcceptBuilder {
+	b.a.SetId(iri)
+	return b
+}
+
+// Actor adds the IRI of an actor of the Accept.
+func (b *AcceptBuilder) Actor(iri *url.URL) *AcceptBuilder {
+	b.a.AppendActorIRI(iri)
+	return b
+}
+
+// ActorObject adds an actor of the Accept.
+func (b *AcceptBuilder) ActorObject(v ObjectType) *AcceptBuilder {
+	b.a.AppendActorObject(v)
+	return b
+}
+
+// Object adds an object of the Accept.
+func (b *AcceptBuilder) Object(v ObjectType) *AcceptBuilder {
+	b.a.AppendObject(v)
+	return b
+}
+
+// ObjectIRI adds the IRI of an object of the Accept.
+func (b *AcceptBuilder) ObjectIRI(iri *url.URL) *AcceptBuilder {
+	b.a.AppendObjectIRI(iri)
+	return b
+}
+
+// To adds a primary recipient of the Accept.
+func (b *AcceptBuilder) To(iri *url.URL) *AcceptBuilder {
+	b.a.AppendToIRI(iri)
+	return b
+}
+
+// Cc adds a secondary recipient of the Accept.
+func (b *AcceptBuilder) Cc(iri *url.URL) *AcceptBuilder {
+	b.a.AppendCcIRI(iri)
+	return b
+}
+
+// Bto adds a private primary recipient of the Accept.
+func (b *AcceptBuilder) Bto(iri *url.URL) *AcceptBuilder {
+	b.a.AppendBtoIRI(iri)
+	return b
+}
+
+// Bcc adds a private secondary recipient of the Accept.
+func (b *AcceptBuilder) Bcc(iri *url.URL) *AcceptBuilder {
+	b.a.AppendBccIRI(iri)
+	return b
+}
+
+// Published sets when the Accept was published.
+func (b *AcceptBuilder) Published(t time.Time) *AcceptBuilder {
+	b.a.SetPublished(t)
+	return b
+}
+
+// Summary adds a summary of the Accept.
+func (b *AcceptBuilder) Summary(s string) *AcceptBuilder {
+	b.a.AppendSummaryString(s)
+	return b
+}
+
+// Build returns the Accept, or an error if its 'actor' or 'object' is not set.
+// The builder must not be used afterwards.
+func (b *AcceptBuilder) Build() (*Accept, error) {
+	if b.a.ActorLen() == 0 {
+		return nil, fmt.Errorf("Accept requires an actor")
+	} else if b.a.ObjectLen() == 0 {
+		return nil, fmt.Errorf("Accept requires an object")
+	}
+	return b.a, nil
+}
+
+// AnnounceBuilder builds a Announce activity by chaining calls, validating that its
+// 'actor' and 'object' are set when it is built.
+type AnnounceBuilder struct {
+	a *Announce
+}
+
+// NewAnnounceBuilder creates a builder for a Announce activity.
+func NewAnnounceBuilder() *AnnounceBuilder {
+	return &AnnounceBuilder{a: &Announce{}}
+}
+
+// Id sets the 'id' of the Announce.
+func (b *AnnounceBuilder) Id(iri *url.URL) *AnnounceBuilder {
+	b.a.SetId(iri)
+	return b
+}
+
+// Actor adds the IRI of an actor of the Announce.
+func (b *AnnounceBuilder) Actor(iri *url.URL) *AnnounceBuilder {
+	b.a.AppendActorIRI(iri)
+	return b
+}
+
+// ActorObject adds an actor of the Announce.
+func (b *AnnounceBuilder) ActorObject(v ObjectType) *AnnounceBuilder {
+	b.a.AppendActorObject(v)
+	return b
+}
+
+// Object adds an object of the Announce.
+func (b *AnnounceBuilder) Object(v ObjectType) *AnnounceBuilder {
+	b.a.AppendObject(v)
+	return b
+}
+
+// ObjectIRI adds the IRI of an object of the Announce.
+func (b *AnnounceBuilder) ObjectIRI(iri *url.URL) *AnnounceBuilder {
+	b.a.AppendObjectIRI(iri)
+	return b
+}
+
+// To adds a primary recipient of the Announce.
+func (b *AnnounceBuilder) To(iri *url.URL) *AnnounceBuilder {
+	b.a.AppendToIRI(iri)
+	return b
+}
+
+// Cc adds a secondary recipient of the Announce.
+func (b *AnnounceBuilder) Cc(iri *url.URL) *AnnounceBuilder {
+	b.a.AppendCcIRI(iri)
+	return b
+}
+
+// Bto adds a private primary recipient of the Announce.
+func (b *AnnounceBuilder) Bto(iri *url.URL) *AnnounceBuilder {
+	b.a.AppendBtoIRI(iri)
+	return b
+}
+
+// Bcc adds a private secondary recipient of the Announce.
+func (b *AnnounceBuilder) Bcc(iri *url.URL) *AnnounceBuilder {
+	b.a.AppendBccIRI(iri)
+	return b
+}
+
+// Published sets when the Announce was published.
+func (b *AnnounceBuilder) Published(t time.Time) *AnnounceBuilder {
+	b.a.SetPublished(t)
+	return b
+}
+
+// Summary adds a summary of the Announce.
+func (b *AnnounceBuilder) Summary(s string) *AnnounceBuilder {
+	b.a.AppendSummaryString(s)
+	return b
+}
+
+// Build returns the Announce, or an error if its 'actor' or 'object' is not set.
+// The builder must not be used afterwards.
+func (b *AnnounceBuilder) Build() (*Announce, error) {
+	if b.a.ActorLen() == 0 {
+		return nil, fmt.Errorf("Announce requires an actor")
+	} else if b.a.ObjectLen() == 0 {
+		return nil, fmt.Errorf("Announce requires an object")
+	}
+	return b.a, nil
+}
+
+// CreateBuilder builds a Create activity by chaining calls, validating that its
+// 'actor' and 'object' are set when it is built.
+type CreateBuilder struct {
+	a *Create
+}
+
+// NewCreateBuilder creates a builder for a Create activity.
+func NewCreateBuilder() *CreateBuilder {
+	return &CreateBuilder{a: &Create{}}
+}
+
+// Id sets the 'id' of the Create.
+func (b *CreateBuilder) Id(iri *url.URL) *CreateBuilder {
+	b.a.SetId(iri)
+	return b
+}
+
+// Actor adds the IRI of an actor of the Create.
+func (b *CreateBuilder) Actor(iri *url.URL) *CreateBuilder {
+	b.a.AppendActorIRI(iri)
+	return b
+}
+
+// ActorObject adds an actor of the Create.
+func (b *CreateBuilder) ActorObject(v ObjectType) *CreateBuilder {
+	b.a.AppendActorObject(v)
+	return b
+}
+
+// Object adds an object of the Create.
+func (b *CreateBuilder) Object(v ObjectType) *CreateBuilder {
+	b.a.AppendObject(v)
+	return b
+}
+
+// ObjectIRI adds the IRI of an object of the Create.
+func (b *CreateBuilder) ObjectIRI(iri *url.URL) *CreateBuilder {
+	b.a.AppendObjectIRI(iri)
+	return b
+}
+
+// To adds a primary recipient of the Create.
+func (b *CreateBuilder) To(iri *url.URL) *CreateBuilder {
+	b.a.AppendToIRI(iri)
+	return b
+}
+
+// Cc adds a secondary recipient of the Create.
+func (b *CreateBuilder) Cc(iri *url.URL) *CreateBuilder {
+	b.a.AppendCcIRI(iri)
+	return b
+}
+
+// Bto adds a private primary recipient of the Create.
+func (b *CreateBuilder) Bto(iri *url.URL) *CreateBuilder {
+	b.a.AppendBtoIRI(iri)
+	return b
+}
+
+// Bcc adds a private secondary recipient of the Create.
+func (b *CreateBuilder) Bcc(iri *url.URL) *CreateBuilder {
+	b.a.AppendBccIRI(iri)
+	return b
+}
+
+// Published sets when the Create was published.
+func (b *CreateBuilder) Published(t time.Time) *CreateBuilder {
+	b.a.SetPublished(t)
+	return b
+}
+
+// Summary adds a summary of the Create.
+func (b *CreateBuilder) Summary(s string) *CreateBuilder {
+	b.a.AppendSummaryString(s)
+	return b
+}
+
+// Build returns the Create, or an error if its 'actor' or 'object' is not set.
+// The builder must not be used afterwards.
+func (b *CreateBuilder) Build() (*Create, error) {
+	if b.a.ActorLen() == 0 {
+		return nil, fmt.Errorf("Create requires an actor")
+	} else if b.a.ObjectLen() == 0 {
+		return nil, fmt.Errorf("Create requires an object")
+	}
+	return b.a, nil
+}
+
+// FollowBuilder builds a Follow activity by chaining calls, validating that its
+// 'actor' and 'object' are set when it is built.
+type FollowBuilder struct {
+	a *Follow
+}
+
+// NewFollowBuilder creates a builder for a Follow activity.
+func NewFollowBuilder() *FollowBuilder {
+	return &FollowBuilder{a: &Follow{}}
+}
+
+// Id sets the 'id' of the Follow.
+func (b *FollowBuilder) Id(iri *url.URL) *FollowBuilder {
+	b.a.SetId(iri)
+	return b
+}
+
+// Actor adds the IRI of an actor of the Follow.
+func (b *FollowBuilder) Actor(iri *url.URL) *FollowBuilder {
+	b.a.AppendActorIRI(iri)
+	return b
+}
+
+// ActorObject adds an actor of the Follow.
+func (b *FollowBuilder) ActorObject(v ObjectType) *FollowBuilder {
+	b.a.AppendActorObject(v)
+	return b
+}
+
+// Object adds an object of the Follow.
+func (b *FollowBuilder) Object(v ObjectType) *FollowBuilder {
+	b.a.AppendObject(v)
+	return b
+}
+
+// ObjectIRI adds the IRI of an object of the Follow.
+func (b *FollowBuilder) ObjectIRI(iri *url.URL) *FollowBuilder {
+	b.a.AppendObjectIRI(iri)
+	return b
+}
+
+// To adds a primary recipient of the Follow.
+func (b *FollowBuilder) To(iri *url.URL) *FollowBuilder {
+	b.a.AppendToIRI(iri)
+	return b
+}
+
+// Cc adds a secondary recipient of the Follow.
+func (b *FollowBuilder) Cc(iri *url.URL) *FollowBuilder {
+	b.a.AppendCcIRI(iri)
+	return b
+}
+
+// Bto adds a private primary recipient of the Follow.
+func (b *FollowBuilder) Bto(iri *url.URL) *FollowBuilder {
+	b.a.AppendBtoIRI(iri)
+	return b
+}
+
+// Bcc adds a private secondary recipient of the Follow.
+func (b *FollowBuilder) Bcc(iri *url.URL) *FollowBuilder {
+	b.a.AppendBccIRI(iri)
+	return b
+}
+
+// Published sets when the Follow was published.
+func (b *FollowBuilder) Published(t time.Time) *FollowBuilder {
+	b.a.SetPublished(t)
+	return b
+}
+
+// Summary adds a summary of the Follow.
+func (b *FollowBuilder) Summary(s string) *FollowBuilder {
+	b.a.AppendSummaryString(s)
+	return b
+}
+
+// Build returns the Follow, or an error if its 'actor' or 'object' is not set.
+// The builder must not be used afterwards.
+func (b *FollowBuilder) Build() (*Follow, error) {
+	if b.a.ActorLen() == 0 {
+		return nil, fmt.Errorf("Follow requires an actor")
+	} else if b.a.ObjectLen() == 0 {
+		return nil, fmt.Errorf("Follow requires an object")
+	}
+	return b.a, nil
+}
+
+// UndoBuilder builds a Undo activity by chaining calls, validating that its
+// 'actor' and 'object' are set when it is built.
+type UndoBuilder struct {
+	a *Undo
+}
+
+// NewUndoBuilder creates a builder for a Undo activity.
+func NewUndoBuilder() *UndoBuilder {
+	return &UndoBuilder{a: &Undo{}}
+}
+
+// Id sets the 'id' of the Undo.
+func (b *UndoBuilder) Id(iri *url.URL) *UndoBuilder {
+	b.a.SetId(iri)
+	return b
+}
+
+// Actor adds the IRI of an actor of the Undo.
+func (b *UndoBuilder) Actor(iri *url.URL) *UndoBuilder {
+	b.a.AppendActorIRI(iri)
+	return b
+}
+
+// ActorObject adds an actor of the Undo.
+func (b *UndoBuilder) ActorObject(v ObjectType) *UndoBuilder {
+	b.a.AppendActorObject(v)
+	return b
+}
+
+// Object adds an object of the Undo.
+func (b *UndoBuilder) Object(v ObjectType) *UndoBuilder {
+	b.a.AppendObject(v)
+	return b
+}
+
+// ObjectIRI adds the IRI of an object of the Undo.
+func (b *UndoBuilder) ObjectIRI(iri *url.URL) *UndoBuilder {
+	b.a.AppendObjectIRI(iri)
+	return b
+}
+
+// To adds a primary recipient of the Undo.
+func (b *UndoBuilder) To(iri *url.URL) *UndoBuilder {
+	b.a.AppendToIRI(iri)
+	return b
+}
+
+// Cc adds a secondary recipient of the Undo.
+func (b *UndoBuilder) Cc(iri *url.URL) *UndoBuilder {
+	b.a.AppendCcIRI(iri)
+	return b
+}
+
+// Bto adds a private primary recipient of the Undo.
+func (b *UndoBuilder) Bto(iri *url.URL) *UndoBuilder {
+	b.a.AppendBtoIRI(iri)
+	return b
+}
+
+// Bcc adds a private secondary recipient of the Undo.
+func (b *UndoBuilder) Bcc(iri *url.URL) *UndoBuilder {
+	b.a.AppendBccIRI(iri)
+	return b
+}
+
+// Published sets when the Undo was published.
+func (b *UndoBuilder) Published(t time.Time) *UndoBuilder {
+	b.a.SetPublished(t)
+	return b
+}
+
+// Summary adds a summary of the Undo.
+func (b *UndoBuilder) Summary(s string) *UndoBuilder {
+	b.a.AppendSummaryString(s)
+	return b
+}
+
+// Build returns the Undo, or an error if its 'actor' or 'object' is not set.
+// The builder must not be used afterwards.
+func (b *UndoBuilder) Build() (*Undo, error) {
+	if b.a.ActorLen() == 0 {
+		return nil, fmt.Errorf("Undo requires an actor")
+	} else if b.a.ObjectLen() == 0 {
+		return nil, fmt.Errorf("Undo requires an object")
+	}
+	return b.a, nil
+}
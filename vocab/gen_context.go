@@ -0,0 +1,438 @@
+//
+package vocab
+
+import (
+	"sort"
+	"strings"
+)
+
+// ActivityStreamsContext is the IRI of the ActivityStreams context, which
+// defines the terms of every type and property of this package.
+const ActivityStreamsContext = "https://www.w3.org/ns/activitystreams"
+
+// activityStreamsNamespaces are the IRIs the terms of the ActivityStreams
+// context are relative to, the latter of which older documents use.
+var activityStreamsNamespaces = []string{
+	"https://www.w3.org/ns/activitystreams#",
+	"http://www.w3.org/ns/activitystreams#",
+}
+
+// activityStreamsTerms are the terms the ActivityStreams context defines.
+var activityStreamsTerms = map[string]bool{
+	"Accept":                     true,
+	"Activity":                   true,
+	"Add":                        true,
+	"Announce":                   true,
+	"Application":                true,
+	"Arrive":                     true,
+	"Article":                    true,
+	"Audio":                      true,
+	"Block":                      true,
+	"Collection":                 true,
+	"CollectionPage":             true,
+	"Create":                     true,
+	"Delete":                     true,
+	"Dislike":                    true,
+	"Document":                   true,
+	"Event":                      true,
+	"Flag":                       true,
+	"Follow":                     true,
+	"Group":                      true,
+	"Hashtag":                    true,
+	"Ignore":                     true,
+	"Image":                      true,
+	"IntransitiveActivity":       true,
+	"Invite":                     true,
+	"Join":                       true,
+	"Leave":                      true,
+	"Like":                       true,
+	"Link":                       true,
+	"Listen":                     true,
+	"Mention":                    true,
+	"Move":                       true,
+	"Note":                       true,
+	"Object":                     true,
+	"Offer":                      true,
+	"OrderedCollection":          true,
+	"OrderedCollectionPage":      true,
+	"Organization":               true,
+	"Page":                       true,
+	"Person":                     true,
+	"Place":                      true,
+	"Profile":                    true,
+	"Question":                   true,
+	"Read":                       true,
+	"Reject":                     true,
+	"Relationship":               true,
+	"Remove":                     true,
+	"Service":                    true,
+	"TentativeAccept":            true,
+	"TentativeReject":            true,
+	"Tombstone":                  true,
+	"Travel":                     true,
+	"Undo":                       true,
+	"Update":                     true,
+	"Video":                      true,
+	"View":                       true,
+	"accuracy":                   true,
+	"actor":                      true,
+	"altitude":                   true,
+	"anyOf":                      true,
+	"attachment":                 true,
+	"attributedTo":               true,
+	"audience":                   true,
+	"bcc":                        true,
+	"bto":                        true,
+	"cc":                         true,
+	"closed":                     true,
+	"content":                    true,
+	"contentMap":                 true,
+	"context":                    true,
+	"current":                    true,
+	"deleted":                    true,
+	"describes":                  true,
+	"duration":                   true,
+	"endTime":                    true,
+	"endpoints":                  true,
+	"first":                      true,
+	"followers":                  true,
+	"following":                  true,
+	"formerType":                 true,
+	"generator":                  true,
+	"height":                     true,
+	"href":                       true,
+	"hreflang":                   true,
+	"icon":                       true,
+	"id":                         true,
+	"image":                      true,
+	"inReplyTo":                  true,
+	"inbox":                      true,
+	"instrument":                 true,
+	"items":                      true,
+	"last":                       true,
+	"latitude":                   true,
+	"liked":                      true,
+	"likes":                      true,
+	"location":                   true,
+	"longitude":                  true,
+	"mediaType":                  true,
+	"name":                       true,
+	"nameMap":                    true,
+	"next":                       true,
+	"oauthAuthorizationEndpoint": true,
+	"oauthTokenEndpoint":         true,
+	"object":                     true,
+	"oneOf":                      true,
+	"orderedItems":               true,
+	"origin":                     true,
+	"outbox":                     true,
+	"partOf":                     true,
+	"preferredUsername":          true,
+	"preferredUsernameMap":       true,
+	"prev":                       true,
+	"preview":                    true,
+	"provideClientKey":           true,
+	"proxyUrl":                   true,
+	"published":                  true,
+	"radius":                     true,
+	"rel":                        true,
+	"relationship":               true,
+	"replies":                    true,
+	"result":                     true,
+	"sharedInbox":                true,
+	"shares":                     true,
+	"signClientKey":              true,
+	"source":                     true,
+	"startIndex":                 true,
+	"startTime":                  true,
+	"streams":                    true,
+	"subject":                    true,
+	"summary":                    true,
+	"summaryMap":                 true,
+	"tag":                        true,
+	"target":                     true,
+	"to":                         true,
+	"totalItems":                 true,
+	"type":                       true,
+	"units":                      true,
+	"updated":                    true,
+	"url":                        true,
+	"width":                      true,
+}
+
+// Vocabulary is a vocabulary other than ActivityStreams whose terms the unknown
+// properties and types of a serialized value may use.
+type Vocabulary struct {
+	// Context is the IRI of a published context defining the Alias and
+	// the Terms, such as "https://w3id.org/security/v1". If it is empty,
+	// they are defined in the '@context' itself from the Namespace.
+	Context string
+	// Alias is the prefix of the vocabulary's compact IRIs, such as "toot"
+	// in "toot:featured".
+	Alias string
+	// Namespace is the IRI the terms of the vocabulary are relative to,
+	// such as "http://joinmastodon.org/ns#".
+	Namespace string
+	// Terms are the terms of the vocabulary used without the Alias, such as
+	// "featured".
+	Terms []string
+}
+
+// ContextManager computes the smallest '@context' defining the terms that a
+// serialized value actually uses, instead of attaching every context an
+// application knows of.
+type ContextManager struct {
+	vocabularies []Vocabulary
+}
+
+// NewContextManager creates a ContextManager knowing the terms of
+// ActivityStreams and of the vocabularies. A term defined by more than one of
+// them belongs to the first.
+func NewContextManager(v ...Vocabulary) *ContextManager {
+	return &ContextManager{vocabularies: append([]Vocabulary(nil), v...)}
+}
+
+// Context returns the smallest '@context' of the serialized value. It is the
+// ActivityStreams context IRI alone when the value uses no other vocabulary,
+// and otherwise an array of the context IRIs the value uses followed, when
+// needed, by an object defining the aliases and terms of the vocabularies
+// without a published context. Terms no vocabulary defines are left as they
+// are.
+func (c *ContextManager) Context(m map[string]interface{}) interface{} {
+	terms := make(map[string]bool)
+	collectContextTerms(m, terms)
+	sorted := make([]string, 0, len(terms))
+	for t := range terms {
+		sorted = append(sorted, t)
+	}
+	sort.Strings(sorted)
+	usedContexts := make(map[string]bool)
+	definitions := make(map[string]interface{})
+	for _, t := range sorted {
+		c.define(t, usedContexts, definitions)
+	}
+	ctx := []interface{}{ActivityStreamsContext}
+	for _, v := range c.vocabularies {
+		if len(v.Context) > 0 && usedContexts[v.Context] {
+			ctx = append(ctx, v.Context)
+			delete(usedContexts, v.Context)
+		}
+	}
+	if len(definitions) > 0 {
+		ctx = append(ctx, definitions)
+	}
+	if len(ctx) == 1 {
+		return ActivityStreamsContext
+	}
+	return ctx
+}
+
+// SetContext sets the smallest '@context' of the serialized value on it.
+func (c *ContextManager) SetContext(m map[string]interface{}) {
+	m["@context"] = c.Context(m)
+}
+
+// define records how the term is defined: by the ActivityStreams context, by
+// the published context of a vocabulary, or by definitions of its own.
+func (c *ContextManager) define(t string, usedContexts map[string]bool, definitions map[string]interface{}) {
+	if i := strings.Index(t, ":"); i > 0 {
+		prefix := t[:i]
+		for _, v := range c.vocabularies {
+			if v.Alias != prefix {
+				continue
+			} else if len(v.Context) > 0 {
+				usedContexts[v.Context] = true
+			} else if len(v.Namespace) > 0 {
+				definitions[prefix] = v.Namespace
+			}
+			return
+		}
+		return
+	}
+	if activityStreamsTerms[t] {
+		return
+	}
+	for _, v := range c.vocabularies {
+		found := false
+		for _, term := range v.Terms {
+			if term == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		} else if len(v.Context) > 0 {
+			usedContexts[v.Context] = true
+		} else if len(v.Alias) > 0 && len(v.Namespace) > 0 {
+			definitions[v.Alias] = v.Namespace
+			definitions[t] = v.Alias + ":" + t
+		} else if len(v.Namespace) > 0 {
+			definitions[t] = v.Namespace + t
+		}
+		return
+	}
+}
+
+// ResolveAliases returns the generic map form of a value with the properties
+// that its '@context' defines as aliases of ActivityStreams terms renamed to
+// those terms, such as "summary2" to "summary" when the '@context' defines
+// "summary2": "as:summary". Only the definitions in the '@context' itself are
+// consulted, since published contexts are not fetched, and a property whose
+// term is already set keeps its alias. The map is returned as is when it has
+// no alias, and is copied otherwise. Deserialize calls it on every value.
+func ResolveAliases(m map[string]interface{}) map[string]interface{} {
+	aliases := contextAliases(m["@context"], nil)
+	if len(aliases) == 0 {
+		return m
+	}
+	return renameAliases(m, aliases).(map[string]interface{})
+}
+
+// contextAliases adds the aliases of ActivityStreams terms the '@context'
+// defines to those of its enclosing values, copying them if there are any.
+func contextAliases(ctx interface{}, aliases map[string]string) map[string]string {
+	var definitions []map[string]interface{}
+	switch x := ctx.(type) {
+	case map[string]interface{}:
+		definitions = append(definitions, x)
+	case []interface{}:
+		for _, e := range x {
+			if d, ok := e.(map[string]interface{}); ok {
+				definitions = append(definitions, d)
+			}
+		}
+	}
+	if len(definitions) == 0 {
+		return aliases
+	}
+	prefixes := map[string]string{"as": activityStreamsNamespaces[0]}
+	for _, d := range definitions {
+		for k, v := range d {
+			if s, ok := v.(string); ok && (strings.HasSuffix(s, "#") || strings.HasSuffix(s, "/")) {
+				prefixes[k] = s
+			}
+		}
+	}
+	copied := false
+	for _, d := range definitions {
+		for k, v := range d {
+			if strings.HasPrefix(k, "@") {
+				continue
+			}
+			var id string
+			language := false
+			switch x := v.(type) {
+			case string:
+				id = x
+			case map[string]interface{}:
+				id, _ = x["@id"].(string)
+				language = x["@container"] == "@language"
+			}
+			term, ok := activityStreamsTerm(id, prefixes)
+			if !ok {
+				continue
+			} else if language && activityStreamsTerms[term+"Map"] {
+				term += "Map"
+			}
+			if term == k {
+				continue
+			}
+			if !copied {
+				c := make(map[string]string, len(aliases)+1)
+				for a, t := range aliases {
+					c[a] = t
+				}
+				aliases = c
+				copied = true
+			}
+			aliases[k] = term
+		}
+	}
+	return aliases
+}
+
+// activityStreamsTerm returns the ActivityStreams term the IRI, which may be
+// compacted with one of the prefixes, stands for.
+func activityStreamsTerm(id string, prefixes map[string]string) (string, bool) {
+	switch id {
+	case "@id":
+		return "id", true
+	case "@type":
+		return "type", true
+	}
+	if i := strings.Index(id, ":"); i > 0 {
+		if ns, ok := prefixes[id[:i]]; ok {
+			id = ns + id[i+1:]
+		}
+	}
+	for _, ns := range activityStreamsNamespaces {
+		if strings.HasPrefix(id, ns) && activityStreamsTerms[id[len(ns):]] {
+			return id[len(ns):], true
+		}
+	}
+	return "", false
+}
+
+// renameAliases copies the value with the aliased properties renamed, including
+// in the values it contains, whose own '@context' may define more aliases.
+func renameAliases(v interface{}, aliases map[string]string) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		aliases = contextAliases(x["@context"], aliases)
+		r := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			if term, ok := aliases[k]; ok {
+				if _, set := x[term]; !set {
+					k = term
+				}
+			}
+			r[k] = renameAliases(e, aliases)
+		}
+		return r
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = renameAliases(e, aliases)
+		}
+		return r
+	}
+	return v
+}
+
+// collectContextTerms collects the terms a serialized value uses as property
+// names or as types, including in the values it contains.
+func collectContextTerms(v interface{}, terms map[string]bool) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, e := range x {
+			if strings.HasPrefix(k, "@") {
+				continue
+			}
+			terms[k] = true
+			if k == "type" {
+				collectTypeTerms(e, terms)
+			} else {
+				collectContextTerms(e, terms)
+			}
+		}
+	case []interface{}:
+		for _, e := range x {
+			collectContextTerms(e, terms)
+		}
+	}
+}
+
+// collectTypeTerms collects the terms used as the types of a value.
+func collectTypeTerms(v interface{}, terms map[string]bool) {
+	switch x := v.(type) {
+	case string:
+		if !strings.HasPrefix(x, "http://") && !strings.HasPrefix(x, "https://") {
+			terms[x] = true
+		}
+	case []interface{}:
+		for _, e := range x {
+			collectTypeTerms(e, terms)
+		}
+	}
+}
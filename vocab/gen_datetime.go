@@ -0,0 +1,159 @@
+//
+package vocab
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseDateTimeStrict parses an xsd:dateTime value as RFC 3339 describes, with
+// or without seconds.
+func ParseDateTimeStrict(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t, err = time.Parse("2006-01-02T15:04Z07:00", s)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s cannot be interpreted as xsd:dateTime", s)
+	}
+	return keepOffset(t), nil
+}
+
+// lenientDateTimeLayouts are the layouts ParseDateTimeLenient tries once the
+// value is normalized.
+var lenientDateTimeLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999Z07",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04Z0700",
+	"2006-01-02T15:04Z07",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.ANSIC,
+}
+
+// ParseDateTimeLenient parses the values ParseDateTimeStrict does, and also
+// those with a space or a lowercase letter between the date and the time, a
+// lowercase 'z', numeric zones without a colon or minutes, a space before the
+// zone, a trailing "UTC" or "GMT", no seconds, no time, or the formats of HTTP
+// dates. Values without a zone are in UTC.
+func ParseDateTimeLenient(s string) (time.Time, error) {
+	if t, err := ParseDateTimeStrict(s); err == nil {
+		return t, nil
+	}
+	n := strings.TrimSpace(s)
+	if len(n) > 10 && n[4] == '-' && n[7] == '-' {
+		if n[10] == ' ' || n[10] == 't' {
+			n = n[:10] + "T" + n[11:]
+		}
+		for _, utc := range []string{"UTC", "GMT"} {
+			n = strings.TrimSpace(strings.TrimSuffix(n, utc))
+		}
+		if strings.HasSuffix(n, "z") {
+			n = n[:len(n)-1] + "Z"
+		}
+		if i := strings.LastIndexAny(n, "+-"); i > 11 && n[i-1] == ' ' {
+			n = n[:i-1] + n[i:]
+		}
+	}
+	for _, layout := range lenientDateTimeLayouts {
+		if t, err := time.Parse(layout, n); err == nil {
+			return keepOffset(t), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s cannot be interpreted as xsd:dateTime, even leniently", s)
+}
+
+// keepOffset returns the parsed time in a zone of the offset it was parsed with,
+// rather than in the Local zone that time.Parse uses when the offsets match, so
+// that FormatDateTime writes it as it was received. Times parsed in UTC, from a
+// 'Z' or from no zone at all, are kept in UTC.
+func keepOffset(t time.Time) time.Time {
+	if t.Location() == time.UTC {
+		return t
+	}
+	_, offset := t.Zone()
+	return t.In(time.FixedZone("", offset))
+}
+
+// FormatDateTime formats a time as an xsd:dateTime value, as RFC 3339 describes,
+// keeping its offset rather than normalizing it to UTC and its fractional
+// seconds, if any. Values parsed by ParseDateTimeStrict or ParseDateTimeLenient
+// are formatted with the offset they were received with: a zero offset is
+// written as "Z" unless it was received as "+00:00", which is kept.
+func FormatDateTime(t time.Time) string {
+	if name, offset := t.Zone(); offset == 0 && len(name) == 0 {
+		return t.Format("2006-01-02T15:04:05.999999999-07:00")
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseDateTime parses an xsd:dateTime value with ParseDateTimeStrict.
+func parseDateTime(s string) (time.Time, error) {
+	return ParseDateTimeStrict(s)
+}
+
+// formatDateTime formats an xsd:dateTime value with FormatDateTime.
+func formatDateTime(t time.Time) string {
+	return FormatDateTime(t)
+}
+
+// dateTimeProperties are the properties taking xsd:dateTime values.
+var dateTimeProperties = map[string]bool{
+	"closed":    true,
+	"deleted":   true,
+	"endTime":   true,
+	"published": true,
+	"startTime": true,
+	"updated":   true,
+}
+
+// lenientDateTimes copies the value with the xsd:dateTime values of its
+// properties, and of the values it contains, that ParseDateTimeStrict rejects
+// but ParseDateTimeLenient parses rewritten as FormatDateTime formats them.
+func lenientDateTimes(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		r := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			if dateTimeProperties[k] {
+				e = lenientDateTime(e)
+			}
+			r[k] = lenientDateTimes(e)
+		}
+		return r
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = lenientDateTimes(e)
+		}
+		return r
+	}
+	return v
+}
+
+// lenientDateTime rewrites the xsd:dateTime value of a property, or each of
+// its values, if only ParseDateTimeLenient parses it.
+func lenientDateTime(v interface{}) interface{} {
+	switch x := v.(type) {
+	case string:
+		if _, err := ParseDateTimeStrict(x); err != nil {
+			if t, err := ParseDateTimeLenient(x); err == nil {
+				return FormatDateTime(t)
+			}
+		}
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = lenientDateTime(e)
+		}
+		return r
+	}
+	return v
+}
@@ -0,0 +1,67 @@
+//
+package vocab
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DecodeJSONProperties reads a JSON object from the decoder token by token,
+// calling fn with each of its properties as soon as it is decoded, so that the
+// object is never held in memory as a whole map. The properties that its
+// '@context' aliases are renamed as ResolveAliases does, which requires the
+// '@context' to come before them, as it usually does. Aliased properties are
+// passed to fn after the others, keeping their alias if the object also has
+// their term. The DecodeJSON methods of the types are built on it.
+func DecodeJSONProperties(dec *json.Decoder, fn func(k string, v interface{}) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	} else if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cannot decode %v as a JSON object", tok)
+	}
+	var aliases map[string]string
+	var aliased []string
+	var aliasedValues []interface{}
+	seen := make(map[string]bool)
+	for dec.More() {
+		if tok, err = dec.Token(); err != nil {
+			return err
+		}
+		k, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("cannot decode %v as a property name", tok)
+		}
+		var v interface{}
+		if err = dec.Decode(&v); err != nil {
+			return err
+		}
+		if k == "@context" {
+			aliases = contextAliases(v, nil)
+		}
+		if len(aliases) > 0 {
+			v = renameAliases(v, aliases)
+			if _, ok := aliases[k]; ok {
+				aliased = append(aliased, k)
+				aliasedValues = append(aliasedValues, v)
+				continue
+			}
+		}
+		seen[k] = true
+		if err = fn(k, v); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	if _, err = dec.Token(); err != nil {
+		return err
+	}
+	for i, k := range aliased {
+		if term := aliases[k]; !seen[term] {
+			k = term
+		}
+		if err = fn(k, aliasedValues[i]); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,541 @@
+//
+package vocab
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// fuzzRoundTrip deserializes the JSON into the first value and, if it is
+// accepted, checks that serializing it as JSON and deserializing that into the
+// second value gives the same canonical form.
+func fuzzRoundTrip(t *testing.T, b []byte, first, second randomValue) {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if err := first.Deserialize(m); err != nil {
+		return
+	}
+	s, err := first.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize deserialized value: %s", err)
+	}
+	if b, err = json.Marshal(s); err != nil {
+		t.Fatalf("Cannot json.Marshal serialized value: %s", err)
+	}
+	m = make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal serialized value: %s", err)
+	}
+	if err := second.Deserialize(m); err != nil {
+		t.Fatalf("Cannot Deserialize serialized value: %s", err)
+	}
+	expected, err := canonicalJSON(first)
+	if err != nil {
+		t.Fatalf("Cannot encode deserialized value: %s", err)
+	}
+	actual, err := canonicalJSON(second)
+	if err != nil {
+		t.Fatalf("Cannot encode round tripped value: %s", err)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Fatalf("Expected round trip to give %s, got %s", expected, actual)
+	}
+}
+
+// FuzzDeserializeObject checks that any Object that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeObject(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Object\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Object{}, &Object{})
+	})
+}
+
+// FuzzDeserializeLink checks that any Link that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeLink(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Link\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Link{}, &Link{})
+	})
+}
+
+// FuzzDeserializeActivity checks that any Activity that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeActivity(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Activity\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Activity{}, &Activity{})
+	})
+}
+
+// FuzzDeserializeIntransitiveActivity checks that any IntransitiveActivity that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeIntransitiveActivity(f *testing.F) {
+	f.Add([]byte("{\"type\": \"IntransitiveActivity\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &IntransitiveActivity{}, &IntransitiveActivity{})
+	})
+}
+
+// FuzzDeserializeCollection checks that any Collection that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeCollection(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Collection\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Collection{}, &Collection{})
+	})
+}
+
+// FuzzDeserializeOrderedCollection checks that any OrderedCollection that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeOrderedCollection(f *testing.F) {
+	f.Add([]byte("{\"type\": \"OrderedCollection\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &OrderedCollection{}, &OrderedCollection{})
+	})
+}
+
+// FuzzDeserializeCollectionPage checks that any CollectionPage that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeCollectionPage(f *testing.F) {
+	f.Add([]byte("{\"type\": \"CollectionPage\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &CollectionPage{}, &CollectionPage{})
+	})
+}
+
+// FuzzDeserializeOrderedCollectionPage checks that any OrderedCollectionPage that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeOrderedCollectionPage(f *testing.F) {
+	f.Add([]byte("{\"type\": \"OrderedCollectionPage\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &OrderedCollectionPage{}, &OrderedCollectionPage{})
+	})
+}
+
+// FuzzDeserializeAccept checks that any Accept that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeAccept(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Accept\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Accept{}, &Accept{})
+	})
+}
+
+// FuzzDeserializeTentativeAccept checks that any TentativeAccept that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeTentativeAccept(f *testing.F) {
+	f.Add([]byte("{\"type\": \"TentativeAccept\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &TentativeAccept{}, &TentativeAccept{})
+	})
+}
+
+// FuzzDeserializeAdd checks that any Add that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeAdd(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Add\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Add{}, &Add{})
+	})
+}
+
+// FuzzDeserializeArrive checks that any Arrive that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeArrive(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Arrive\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Arrive{}, &Arrive{})
+	})
+}
+
+// FuzzDeserializeCreate checks that any Create that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeCreate(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Create\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Create{}, &Create{})
+	})
+}
+
+// FuzzDeserializeDelete checks that any Delete that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeDelete(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Delete\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Delete{}, &Delete{})
+	})
+}
+
+// FuzzDeserializeFollow checks that any Follow that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeFollow(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Follow\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Follow{}, &Follow{})
+	})
+}
+
+// FuzzDeserializeIgnore checks that any Ignore that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeIgnore(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Ignore\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Ignore{}, &Ignore{})
+	})
+}
+
+// FuzzDeserializeJoin checks that any Join that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeJoin(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Join\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Join{}, &Join{})
+	})
+}
+
+// FuzzDeserializeLeave checks that any Leave that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeLeave(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Leave\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Leave{}, &Leave{})
+	})
+}
+
+// FuzzDeserializeLike checks that any Like that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeLike(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Like\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Like{}, &Like{})
+	})
+}
+
+// FuzzDeserializeOffer checks that any Offer that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeOffer(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Offer\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Offer{}, &Offer{})
+	})
+}
+
+// FuzzDeserializeInvite checks that any Invite that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeInvite(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Invite\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Invite{}, &Invite{})
+	})
+}
+
+// FuzzDeserializeReject checks that any Reject that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeReject(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Reject\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Reject{}, &Reject{})
+	})
+}
+
+// FuzzDeserializeTentativeReject checks that any TentativeReject that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeTentativeReject(f *testing.F) {
+	f.Add([]byte("{\"type\": \"TentativeReject\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &TentativeReject{}, &TentativeReject{})
+	})
+}
+
+// FuzzDeserializeRemove checks that any Remove that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeRemove(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Remove\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Remove{}, &Remove{})
+	})
+}
+
+// FuzzDeserializeUndo checks that any Undo that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeUndo(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Undo\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Undo{}, &Undo{})
+	})
+}
+
+// FuzzDeserializeUpdate checks that any Update that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeUpdate(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Update\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Update{}, &Update{})
+	})
+}
+
+// FuzzDeserializeView checks that any View that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeView(f *testing.F) {
+	f.Add([]byte("{\"type\": \"View\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &View{}, &View{})
+	})
+}
+
+// FuzzDeserializeListen checks that any Listen that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeListen(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Listen\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Listen{}, &Listen{})
+	})
+}
+
+// FuzzDeserializeRead checks that any Read that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeRead(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Read\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Read{}, &Read{})
+	})
+}
+
+// FuzzDeserializeMove checks that any Move that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeMove(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Move\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Move{}, &Move{})
+	})
+}
+
+// FuzzDeserializeTravel checks that any Travel that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeTravel(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Travel\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Travel{}, &Travel{})
+	})
+}
+
+// FuzzDeserializeAnnounce checks that any Announce that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeAnnounce(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Announce\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Announce{}, &Announce{})
+	})
+}
+
+// FuzzDeserializeBlock checks that any Block that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeBlock(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Block\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Block{}, &Block{})
+	})
+}
+
+// FuzzDeserializeFlag checks that any Flag that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeFlag(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Flag\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Flag{}, &Flag{})
+	})
+}
+
+// FuzzDeserializeDislike checks that any Dislike that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeDislike(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Dislike\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Dislike{}, &Dislike{})
+	})
+}
+
+// FuzzDeserializeQuestion checks that any Question that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeQuestion(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Question\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Question{}, &Question{})
+	})
+}
+
+// FuzzDeserializeApplication checks that any Application that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeApplication(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Application\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Application{}, &Application{})
+	})
+}
+
+// FuzzDeserializeGroup checks that any Group that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeGroup(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Group\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Group{}, &Group{})
+	})
+}
+
+// FuzzDeserializeOrganization checks that any Organization that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeOrganization(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Organization\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Organization{}, &Organization{})
+	})
+}
+
+// FuzzDeserializePerson checks that any Person that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializePerson(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Person\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Person{}, &Person{})
+	})
+}
+
+// FuzzDeserializeService checks that any Service that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeService(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Service\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Service{}, &Service{})
+	})
+}
+
+// FuzzDeserializeRelationship checks that any Relationship that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeRelationship(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Relationship\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Relationship{}, &Relationship{})
+	})
+}
+
+// FuzzDeserializeArticle checks that any Article that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeArticle(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Article\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Article{}, &Article{})
+	})
+}
+
+// FuzzDeserializeDocument checks that any Document that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeDocument(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Document\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Document{}, &Document{})
+	})
+}
+
+// FuzzDeserializeAudio checks that any Audio that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeAudio(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Audio\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Audio{}, &Audio{})
+	})
+}
+
+// FuzzDeserializeImage checks that any Image that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeImage(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Image\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Image{}, &Image{})
+	})
+}
+
+// FuzzDeserializeVideo checks that any Video that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeVideo(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Video\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Video{}, &Video{})
+	})
+}
+
+// FuzzDeserializeNote checks that any Note that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeNote(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Note\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Note{}, &Note{})
+	})
+}
+
+// FuzzDeserializePage checks that any Page that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializePage(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Page\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Page{}, &Page{})
+	})
+}
+
+// FuzzDeserializeEvent checks that any Event that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeEvent(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Event\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Event{}, &Event{})
+	})
+}
+
+// FuzzDeserializePlace checks that any Place that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializePlace(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Place\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Place{}, &Place{})
+	})
+}
+
+// FuzzDeserializeProfile checks that any Profile that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeProfile(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Profile\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Profile{}, &Profile{})
+	})
+}
+
+// FuzzDeserializeTombstone checks that any Tombstone that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeTombstone(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Tombstone\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Tombstone{}, &Tombstone{})
+	})
+}
+
+// FuzzDeserializeMention checks that any Mention that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeMention(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Mention\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Mention{}, &Mention{})
+	})
+}
+
+// FuzzDeserializeHashtag checks that any Hashtag that can be deserialized survives
+// a round trip through Serialize and Deserialize unchanged.
+func FuzzDeserializeHashtag(f *testing.F) {
+	f.Add([]byte("{\"type\": \"Hashtag\"}"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fuzzRoundTrip(t, b, &Hashtag{}, &Hashtag{})
+	})
+}
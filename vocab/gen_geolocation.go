@@ -0,0 +1,226 @@
+//
+package vocab
+
+import (
+	"fmt"
+	"math"
+)
+
+// The units of the 'radius' and 'altitude' properties of a Place.
+const (
+	UnitsCentimeters = "cm"
+	UnitsFeet        = "feet"
+	UnitsInches      = "inches"
+	UnitsKilometers  = "km"
+	UnitsMeters      = "m"
+	UnitsMiles       = "miles"
+)
+
+// unitsInMeters is the length of each of the units, in meters.
+var unitsInMeters = map[string]float64{
+	UnitsCentimeters: 0.01,
+	UnitsFeet:        0.3048,
+	UnitsInches:      0.0254,
+	UnitsKilometers:  1000,
+	UnitsMeters:      1,
+	UnitsMiles:       1609.344,
+}
+
+// IsUnits returns true if the string is one of the units defined by the
+// ActivityStreams Vocabulary.
+func IsUnits(s string) bool {
+	_, ok := unitsInMeters[s]
+	return ok
+}
+
+// validateCoordinates returns an error if the latitude or longitude is out of
+// range.
+func validateCoordinates(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return fmt.Errorf("latitude %v is not within [-90, 90]", latitude)
+	} else if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return fmt.Errorf("longitude %v is not within [-180, 180]", longitude)
+	}
+	return nil
+}
+
+// Coordinates returns the latitude and longitude of the Place in decimal
+// degrees. It returns false if either is not set as a number.
+func (t *Place) Coordinates() (latitude, longitude float64, ok bool) {
+	if !t.IsLatitude() || !t.IsLongitude() {
+		return 0, 0, false
+	}
+	return t.GetLatitude(), t.GetLongitude(), true
+}
+
+// SetCoordinates sets the latitude and longitude of the Place in decimal
+// degrees. It returns an error and leaves the Place unchanged if either is out
+// of range.
+func (t *Place) SetCoordinates(latitude, longitude float64) error {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return err
+	}
+	t.SetLatitude(latitude)
+	t.SetLongitude(longitude)
+	return nil
+}
+
+// UnitsOrDefault returns the units of the radius and altitude of the Place,
+// which are meters when not set. It returns false if the units are an IRI or
+// otherwise not one of the units defined by the ActivityStreams Vocabulary.
+func (t *Place) UnitsOrDefault() (units string, ok bool) {
+	if t.IsUnitsUnitsValue() {
+		return t.GetUnitsUnitsValue(), true
+	} else if t.IsUnitsAnyURI() || t.HasUnknownUnits() {
+		return "", false
+	}
+	return UnitsMeters, true
+}
+
+// inMeters converts a length in the units of the Place into meters.
+func (t *Place) inMeters(v float64) (float64, bool) {
+	units, ok := t.UnitsOrDefault()
+	if !ok {
+		return 0, false
+	}
+	return v * unitsInMeters[units], true
+}
+
+// RadiusInMeters returns the radius of the Place converted into meters. It
+// returns false if the radius is not set as a number or its units are not
+// known.
+func (t *Place) RadiusInMeters() (float64, bool) {
+	if !t.IsRadius() {
+		return 0, false
+	}
+	return t.inMeters(t.GetRadius())
+}
+
+// AltitudeInMeters returns the altitude of the Place converted into meters.
+// It returns false if the altitude is not set as a number or its units are not
+// known.
+func (t *Place) AltitudeInMeters() (float64, bool) {
+	if !t.IsAltitude() {
+		return 0, false
+	}
+	return t.inMeters(t.GetAltitude())
+}
+
+// ValidateGeolocation returns an error if any of the geolocation properties of
+// the Place are set to a value outside of the range permitted by the
+// ActivityStreams Vocabulary. Properties that are not set are not validated.
+func (t *Place) ValidateGeolocation() error {
+	if t.IsLatitude() {
+		if l := t.GetLatitude(); math.IsNaN(l) || l < -90 || l > 90 {
+			return fmt.Errorf("latitude %v is not within [-90, 90]", l)
+		}
+	}
+	if t.IsLongitude() {
+		if l := t.GetLongitude(); math.IsNaN(l) || l < -180 || l > 180 {
+			return fmt.Errorf("longitude %v is not within [-180, 180]", l)
+		}
+	}
+	if t.IsRadius() {
+		if r := t.GetRadius(); math.IsNaN(r) || r < 0 {
+			return fmt.Errorf("radius %v is negative", r)
+		}
+	}
+	if t.IsAccuracy() {
+		if a := t.GetAccuracy(); math.IsNaN(a) || a < 0 || a > 100 {
+			return fmt.Errorf("accuracy %v is not within [0, 100]", a)
+		}
+	}
+	if t.HasUnknownUnits() {
+		return fmt.Errorf("units %v is not a units value or IRI", t.GetUnknownUnits())
+	}
+	return nil
+}
+
+// GeoJSON converts the Place into a GeoJSON Feature with a Point geometry, as
+// defined in RFC 7946. Its altitude is converted into meters. The name and the
+// radius, in meters, of the Place are kept as properties of the Feature.
+func (t *Place) GeoJSON() (map[string]interface{}, error) {
+	if err := t.ValidateGeolocation(); err != nil {
+		return nil, err
+	}
+	latitude, longitude, ok := t.Coordinates()
+	if !ok {
+		return nil, fmt.Errorf("place does not have a latitude and longitude")
+	}
+	coordinates := []interface{}{longitude, latitude}
+	if t.IsAltitude() {
+		altitude, ok := t.AltitudeInMeters()
+		if !ok {
+			return nil, fmt.Errorf("place altitude has unknown units")
+		}
+		coordinates = append(coordinates, altitude)
+	}
+	properties := make(map[string]interface{})
+	if t.NameLen() > 0 && t.IsNameString(0) {
+		properties["name"] = t.GetNameString(0)
+	}
+	if t.IsRadius() {
+		radius, ok := t.RadiusInMeters()
+		if !ok {
+			return nil, fmt.Errorf("place radius has unknown units")
+		}
+		properties["radius"] = radius
+	}
+	return map[string]interface{}{
+		"type": "Feature",
+		"geometry": map[string]interface{}{
+			"type":        "Point",
+			"coordinates": coordinates,
+		},
+		"properties": properties,
+	}, nil
+}
+
+// PlaceFromGeoJSON creates a Place from a GeoJSON Feature with a Point
+// geometry, or from a Point geometry itself. The name and radius properties of
+// a Feature are kept, with the radius and altitude in meters.
+func PlaceFromGeoJSON(m map[string]interface{}) (*Place, error) {
+	var properties map[string]interface{}
+	if m["type"] == "Feature" {
+		if p, ok := m["properties"].(map[string]interface{}); ok {
+			properties = p
+		}
+		g, ok := m["geometry"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("geojson feature does not have a geometry")
+		}
+		m = g
+	}
+	if m["type"] != "Point" {
+		return nil, fmt.Errorf("geojson geometry %v is not a Point", m["type"])
+	}
+	coordinates, ok := m["coordinates"].([]interface{})
+	if !ok || len(coordinates) < 2 || len(coordinates) > 3 {
+		return nil, fmt.Errorf("geojson point does not have two or three coordinates")
+	}
+	var position []float64
+	for _, c := range coordinates {
+		f, ok := c.(float64)
+		if !ok {
+			return nil, fmt.Errorf("geojson coordinate %v is not a number", c)
+		}
+		position = append(position, f)
+	}
+	t := &Place{}
+	if err := t.SetCoordinates(position[1], position[0]); err != nil {
+		return nil, err
+	}
+	if len(position) == 3 {
+		t.SetAltitude(position[2])
+	}
+	if name, ok := properties["name"].(string); ok {
+		t.AppendNameString(name)
+	}
+	if radius, ok := properties["radius"].(float64); ok {
+		t.SetRadius(radius)
+	}
+	if err := t.ValidateGeolocation(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,227 @@
+//
+package vocab
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update_golden", false, "Write the golden files of TestGoldenExamples instead of comparing against them")
+
+// goldenExamples are the example documents of the specification and the type
+// each one is deserialized into.
+var goldenExamples = []struct {
+	name  string
+	value func() randomValue
+}{
+	{"example1", func() randomValue { return &Object{} }},
+	{"example2", func() randomValue { return &Link{} }},
+	{"example3", func() randomValue { return &Activity{} }},
+	{"example4", func() randomValue { return &Travel{} }},
+	{"example5", func() randomValue { return &Collection{} }},
+	{"example6", func() randomValue { return &OrderedCollection{} }},
+	{"example7", func() randomValue { return &CollectionPage{} }},
+	{"example8", func() randomValue { return &OrderedCollectionPage{} }},
+	{"example9", func() randomValue { return &Accept{} }},
+	{"example10", func() randomValue { return &Accept{} }},
+	{"example11", func() randomValue { return &TentativeAccept{} }},
+	{"example12", func() randomValue { return &Add{} }},
+	{"example13", func() randomValue { return &Add{} }},
+	{"example14", func() randomValue { return &Arrive{} }},
+	{"example15", func() randomValue { return &Create{} }},
+	{"example16", func() randomValue { return &Delete{} }},
+	{"example17", func() randomValue { return &Follow{} }},
+	{"example18", func() randomValue { return &Ignore{} }},
+	{"example19", func() randomValue { return &Join{} }},
+	{"example20", func() randomValue { return &Leave{} }},
+	{"example21", func() randomValue { return &Leave{} }},
+	{"example22", func() randomValue { return &Like{} }},
+	{"example23", func() randomValue { return &Offer{} }},
+	{"example24", func() randomValue { return &Invite{} }},
+	{"example25", func() randomValue { return &Reject{} }},
+	{"example26", func() randomValue { return &TentativeReject{} }},
+	{"example27", func() randomValue { return &Remove{} }},
+	{"example28", func() randomValue { return &Remove{} }},
+	{"example29", func() randomValue { return &Undo{} }},
+	{"example30", func() randomValue { return &Update{} }},
+	{"example31", func() randomValue { return &View{} }},
+	{"example32", func() randomValue { return &Listen{} }},
+	{"example33", func() randomValue { return &Read{} }},
+	{"example34", func() randomValue { return &Move{} }},
+	{"example35", func() randomValue { return &Travel{} }},
+	{"example36", func() randomValue { return &Announce{} }},
+	{"example37", func() randomValue { return &Block{} }},
+	{"example38", func() randomValue { return &Flag{} }},
+	{"example39", func() randomValue { return &Dislike{} }},
+	{"example40", func() randomValue { return &Question{} }},
+	{"example41", func() randomValue { return &Question{} }},
+	{"example42", func() randomValue { return &Application{} }},
+	{"example43", func() randomValue { return &Group{} }},
+	{"example44", func() randomValue { return &Organization{} }},
+	{"example45", func() randomValue { return &Person{} }},
+	{"example46", func() randomValue { return &Service{} }},
+	{"example47", func() randomValue { return &Relationship{} }},
+	{"example48", func() randomValue { return &Article{} }},
+	{"example49", func() randomValue { return &Document{} }},
+	{"example50", func() randomValue { return &Audio{} }},
+	{"example51", func() randomValue { return &Image{} }},
+	{"example52", func() randomValue { return &Video{} }},
+	{"example53", func() randomValue { return &Note{} }},
+	{"example54", func() randomValue { return &Page{} }},
+	{"example55", func() randomValue { return &Event{} }},
+	{"example56", func() randomValue { return &Place{} }},
+	{"example57", func() randomValue { return &Place{} }},
+	{"example58", func() randomValue { return &Mention{} }},
+	{"example59", func() randomValue { return &Profile{} }},
+	{"example60", func() randomValue { return &OrderedCollection{} }},
+	{"example61", func() randomValue { return &Unknown{} }},
+	{"example62", func() randomValue { return &Unknown{} }},
+	{"example63", func() randomValue { return &Offer{} }},
+	{"example64", func() randomValue { return &Offer{} }},
+	{"example65", func() randomValue { return &Offer{} }},
+	{"example66", func() randomValue { return &Note{} }},
+	{"example67", func() randomValue { return &Image{} }},
+	{"example68", func() randomValue { return &Image{} }},
+	{"example69", func() randomValue { return &Note{} }},
+	{"example70", func() randomValue { return &Offer{} }},
+	{"example71", func() randomValue { return &Offer{} }},
+	{"example72", func() randomValue { return &Offer{} }},
+	{"example73", func() randomValue { return &Collection{} }},
+	{"example74", func() randomValue { return &Collection{} }},
+	{"example75", func() randomValue { return &Collection{} }},
+	{"example76", func() randomValue { return &Collection{} }},
+	{"example77", func() randomValue { return &Collection{} }},
+	{"example78", func() randomValue { return &Note{} }},
+	{"example79", func() randomValue { return &Note{} }},
+	{"example80", func() randomValue { return &Note{} }},
+	{"example81", func() randomValue { return &Note{} }},
+	{"example82", func() randomValue { return &Note{} }},
+	{"example83", func() randomValue { return &Note{} }},
+	{"example84", func() randomValue { return &Note{} }},
+	{"example85", func() randomValue { return &Listen{} }},
+	{"example86", func() randomValue { return &Collection{} }},
+	{"example87", func() randomValue { return &Collection{} }},
+	{"example88", func() randomValue { return &Person{} }},
+	{"example89", func() randomValue { return &Collection{} }},
+	{"example90", func() randomValue { return &OrderedCollection{} }},
+	{"example91", func() randomValue { return &Question{} }},
+	{"example92", func() randomValue { return &Question{} }},
+	{"example93", func() randomValue { return &Question{} }},
+	{"example94", func() randomValue { return &Move{} }},
+	{"example95", func() randomValue { return &CollectionPage{} }},
+	{"example96", func() randomValue { return &CollectionPage{} }},
+	{"example97", func() randomValue { return &Like{} }},
+	{"example98", func() randomValue { return &Like{} }},
+	{"example99", func() randomValue { return &Like{} }},
+	{"example100", func() randomValue { return &CollectionPage{} }},
+	{"example101", func() randomValue { return &CollectionPage{} }},
+	{"example102", func() randomValue { return &Video{} }},
+	{"example103", func() randomValue { return &Activity{} }},
+	{"example104", func() randomValue { return &Note{} }},
+	{"example105", func() randomValue { return &Image{} }},
+	{"example106", func() randomValue { return &Offer{} }},
+	{"example107", func() randomValue { return &Offer{} }},
+	{"example108", func() randomValue { return &Offer{} }},
+	{"example109", func() randomValue { return &Document{} }},
+	{"example110", func() randomValue { return &Document{} }},
+	{"example111", func() randomValue { return &Document{} }},
+	{"example112", func() randomValue { return &Place{} }},
+	{"example113", func() randomValue { return &Place{} }},
+	{"example114", func() randomValue { return &Note{} }},
+	{"example115", func() randomValue { return &Note{} }},
+	{"example116", func() randomValue { return &Note{} }},
+	{"example117", func() randomValue { return &Note{} }},
+	{"example118", func() randomValue { return &Note{} }},
+	{"example119", func() randomValue { return &Video{} }},
+	{"example120", func() randomValue { return &Link{} }},
+	{"example121", func() randomValue { return &Link{} }},
+	{"example122", func() randomValue { return &Link{} }},
+	{"example123", func() randomValue { return &CollectionPage{} }},
+	{"example124", func() randomValue { return &Place{} }},
+	{"example125", func() randomValue { return &Place{} }},
+	{"example126", func() randomValue { return &Link{} }},
+	{"example127", func() randomValue { return &Event{} }},
+	{"example128", func() randomValue { return &Note{} }},
+	{"example129", func() randomValue { return &Event{} }},
+	{"example130", func() randomValue { return &Place{} }},
+	{"example131", func() randomValue { return &Link{} }},
+	{"example132", func() randomValue { return &OrderedCollectionPage{} }},
+	{"example133", func() randomValue { return &Note{} }},
+	{"example134", func() randomValue { return &Note{} }},
+	{"example135", func() randomValue { return &Collection{} }},
+	{"example136", func() randomValue { return &Place{} }},
+	{"example137", func() randomValue { return &Note{} }},
+	{"example138", func() randomValue { return &Link{} }},
+	{"example139", func() randomValue { return &Relationship{} }},
+	{"example140", func() randomValue { return &Relationship{} }},
+	{"example141", func() randomValue { return &Profile{} }},
+	{"example142", func() randomValue { return &Tombstone{} }},
+	{"example143", func() randomValue { return &Tombstone{} }},
+	{"example144", func() randomValue { return &Collection{} }},
+	{"example145", func() randomValue { return &Collection{} }},
+	{"example146", func() randomValue { return &Create{} }},
+	{"example147", func() randomValue { return &Offer{} }},
+	{"example148", func() randomValue { return &Collection{} }},
+	{"example149", func() randomValue { return &Place{} }},
+	{"example150", func() randomValue { return &Place{} }},
+	{"example151", func() randomValue { return &Question{} }},
+	{"example152", func() randomValue { return &Question{} }},
+	{"example153", func() randomValue { return &Unknown{} }},
+	{"example154", func() randomValue { return &Question{} }},
+	{"example155", func() randomValue { return &Collection{} }},
+	{"example156", func() randomValue { return &Collection{} }},
+	{"example157", func() randomValue { return &Note{} }},
+	{"example158", func() randomValue { return &Note{} }},
+	{"example159", func() randomValue { return &Move{} }},
+}
+
+// TestGoldenExamples deserializes every example document, serializes it
+// again, and compares the result against its golden file. Run it with
+// -update_golden to write the golden files after an intentional change.
+func TestGoldenExamples(t *testing.T) {
+	for _, r := range goldenExamples {
+		b, err := ioutil.ReadFile(filepath.Join(filepath.FromSlash("testdata/examples"), r.name+".json"))
+		if err != nil {
+			t.Errorf("%s: Cannot read example: %s", r.name, err)
+			continue
+		}
+		m := make(map[string]interface{})
+		if err = json.Unmarshal(b, &m); err != nil {
+			t.Errorf("%s: Cannot json.Unmarshal: %s", r.name, err)
+			continue
+		}
+		v := r.value()
+		if err = v.Deserialize(m); err != nil {
+			t.Errorf("%s: Cannot Deserialize: %s", r.name, err)
+			continue
+		}
+		s, err := v.Serialize()
+		if err != nil {
+			t.Errorf("%s: Cannot Serialize: %s", r.name, err)
+			continue
+		}
+		actual, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			t.Errorf("%s: Cannot json.Marshal: %s", r.name, err)
+			continue
+		}
+		actual = append(actual, '\n')
+		golden := filepath.Join(filepath.FromSlash("testdata/golden"), r.name+".json")
+		if *updateGolden {
+			if err = ioutil.WriteFile(golden, actual, 0644); err != nil {
+				t.Errorf("%s: Cannot write golden file: %s", r.name, err)
+			}
+			continue
+		}
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Errorf("%s: Cannot read golden file: %s", r.name, err)
+		} else if !bytes.Equal(expected, actual) {
+			t.Errorf("%s: Expected serialized example to match %s, got:\n%s", r.name, golden, actual)
+		}
+	}
+}
@@ -0,0 +1,2893 @@
+//
+package vocab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// HashtagType is an interface for accepting types that extend from 'Hashtag'.
+type HashtagType interface {
+	Serializer
+	Deserializer
+	AttributedToLen() (l int)
+	IsAttributedToObject(index int) (ok bool)
+	GetAttributedToObject(index int) (v ObjectType)
+	AppendAttributedToObject(v ObjectType)
+	PrependAttributedToObject(v ObjectType)
+	RemoveAttributedToObject(index int)
+	AttributedToObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsAttributedToLink(index int) (ok bool)
+	GetAttributedToLink(index int) (v LinkType)
+	AppendAttributedToLink(v LinkType)
+	PrependAttributedToLink(v LinkType)
+	RemoveAttributedToLink(index int)
+	AttributedToLinkValues() (seq func(yield func(v LinkType) bool))
+	IsAttributedToIRI(index int) (ok bool)
+	GetAttributedToIRI(index int) (v *url.URL)
+	AppendAttributedToIRI(v *url.URL)
+	PrependAttributedToIRI(v *url.URL)
+	RemoveAttributedToIRI(index int)
+	AttributedToIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownAttributedTo() (ok bool)
+	GetUnknownAttributedTo() (v interface{})
+	SetUnknownAttributedTo(i interface{})
+	HasHref() (ok bool)
+	GetHref() (v *url.URL)
+	SetHref(v *url.URL)
+	HasUnknownHref() (ok bool)
+	GetUnknownHref() (v interface{})
+	SetUnknownHref(i interface{})
+	HasId() (ok bool)
+	GetId() (v *url.URL)
+	SetId(v *url.URL)
+	HasUnknownId() (ok bool)
+	GetUnknownId() (v interface{})
+	SetUnknownId(i interface{})
+	RelLen() (l int)
+	IsRel(index int) (ok bool)
+	GetRel(index int) (v string)
+	AppendRel(v string)
+	PrependRel(v string)
+	RemoveRel(index int)
+	RelValues() (seq func(yield func(v string) bool))
+	IsRelIRI(index int) (ok bool)
+	GetRelIRI(index int) (v *url.URL)
+	AppendRelIRI(v *url.URL)
+	PrependRelIRI(v *url.URL)
+	RemoveRelIRI(index int)
+	RelIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownRel() (ok bool)
+	GetUnknownRel() (v interface{})
+	SetUnknownRel(i interface{})
+	TypeLen() (l int)
+	GetType(index int) (v interface{})
+	AppendType(v interface{})
+	PrependType(v interface{})
+	RemoveType(index int)
+	IsMediaType() (ok bool)
+	GetMediaType() (v string)
+	SetMediaType(v string)
+	IsMediaTypeIRI() (ok bool)
+	GetMediaTypeIRI() (v *url.URL)
+	SetMediaTypeIRI(v *url.URL)
+	HasUnknownMediaType() (ok bool)
+	GetUnknownMediaType() (v interface{})
+	SetUnknownMediaType(i interface{})
+	NameLen() (l int)
+	IsNameString(index int) (ok bool)
+	GetNameString(index int) (v string)
+	AppendNameString(v string)
+	PrependNameString(v string)
+	RemoveNameString(index int)
+	NameStringValues() (seq func(yield func(v string) bool))
+	IsNameLangString(index int) (ok bool)
+	GetNameLangString(index int) (v string)
+	AppendNameLangString(v string)
+	PrependNameLangString(v string)
+	RemoveNameLangString(index int)
+	NameLangStringValues() (seq func(yield func(v string) bool))
+	IsNameIRI(index int) (ok bool)
+	GetNameIRI(index int) (v *url.URL)
+	AppendNameIRI(v *url.URL)
+	PrependNameIRI(v *url.URL)
+	RemoveNameIRI(index int)
+	NameIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownName() (ok bool)
+	GetUnknownName() (v interface{})
+	SetUnknownName(i interface{})
+	NameMapLanguages() (l []string)
+	GetNameMap(l string) (v string)
+	SetNameMap(l string, v string)
+	GetNameLanguage(tag string) (v string, ok bool)
+	SetNameLanguage(tag string, v string)
+	PreferredNameLanguage(tags ...string) (v string, tag string)
+	SummaryLen() (l int)
+	IsSummaryString(index int) (ok bool)
+	GetSummaryString(index int) (v string)
+	AppendSummaryString(v string)
+	PrependSummaryString(v string)
+	RemoveSummaryString(index int)
+	SummaryStringValues() (seq func(yield func(v string) bool))
+	IsSummaryLangString(index int) (ok bool)
+	GetSummaryLangString(index int) (v string)
+	AppendSummaryLangString(v string)
+	PrependSummaryLangString(v string)
+	RemoveSummaryLangString(index int)
+	SummaryLangStringValues() (seq func(yield func(v string) bool))
+	IsSummaryIRI(index int) (ok bool)
+	GetSummaryIRI(index int) (v *url.URL)
+	AppendSummaryIRI(v *url.URL)
+	PrependSummaryIRI(v *url.URL)
+	RemoveSummaryIRI(index int)
+	SummaryIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownSummary() (ok bool)
+	GetUnknownSummary() (v interface{})
+	SetUnknownSummary(i interface{})
+	SummaryMapLanguages() (l []string)
+	GetSummaryMap(l string) (v string)
+	SetSummaryMap(l string, v string)
+	GetSummaryLanguage(tag string) (v string, ok bool)
+	SetSummaryLanguage(tag string, v string)
+	PreferredSummaryLanguage(tags ...string) (v string, tag string)
+	IsHreflang() (ok bool)
+	GetHreflang() (v string)
+	SetHreflang(v string)
+	IsHreflangIRI() (ok bool)
+	GetHreflangIRI() (v *url.URL)
+	SetHreflangIRI(v *url.URL)
+	HasUnknownHreflang() (ok bool)
+	GetUnknownHreflang() (v interface{})
+	SetUnknownHreflang(i interface{})
+	IsHeight() (ok bool)
+	GetHeight() (v int64)
+	SetHeight(v int64)
+	IsHeightIRI() (ok bool)
+	GetHeightIRI() (v *url.URL)
+	SetHeightIRI(v *url.URL)
+	HasUnknownHeight() (ok bool)
+	GetUnknownHeight() (v interface{})
+	SetUnknownHeight(i interface{})
+	IsWidth() (ok bool)
+	GetWidth() (v int64)
+	SetWidth(v int64)
+	IsWidthIRI() (ok bool)
+	GetWidthIRI() (v *url.URL)
+	SetWidthIRI(v *url.URL)
+	HasUnknownWidth() (ok bool)
+	GetUnknownWidth() (v interface{})
+	SetUnknownWidth(i interface{})
+	PreviewLen() (l int)
+	IsPreviewObject(index int) (ok bool)
+	GetPreviewObject(index int) (v ObjectType)
+	AppendPreviewObject(v ObjectType)
+	PrependPreviewObject(v ObjectType)
+	RemovePreviewObject(index int)
+	PreviewObjectValues() (seq func(yield func(v ObjectType) bool))
+	IsPreviewLink(index int) (ok bool)
+	GetPreviewLink(index int) (v LinkType)
+	AppendPreviewLink(v LinkType)
+	PrependPreviewLink(v LinkType)
+	RemovePreviewLink(index int)
+	PreviewLinkValues() (seq func(yield func(v LinkType) bool))
+	IsPreviewIRI(index int) (ok bool)
+	GetPreviewIRI(index int) (v *url.URL)
+	AppendPreviewIRI(v *url.URL)
+	PrependPreviewIRI(v *url.URL)
+	RemovePreviewIRI(index int)
+	PreviewIRIValues() (seq func(yield func(v *url.URL) bool))
+	HasUnknownPreview() (ok bool)
+	GetUnknownPreview() (v interface{})
+	SetUnknownPreview(i interface{})
+	Validate() (err error)
+	ValidateStrict() (err error)
+	TryGetAttributedToObject(index int) (v ObjectType, err error)
+	TryGetAttributedToLink(index int) (v LinkType, err error)
+	TryGetAttributedToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttributedTo() (v interface{}, err error)
+	TryGetHref() (v *url.URL, err error)
+	TryGetUnknownHref() (v interface{}, err error)
+	TryGetId() (v *url.URL, err error)
+	TryGetUnknownId() (v interface{}, err error)
+	TryGetRel(index int) (v string, err error)
+	TryGetRelIRI(index int) (v *url.URL, err error)
+	TryGetUnknownRel() (v interface{}, err error)
+	TryGetType(index int) (v interface{}, err error)
+	TryGetMediaType() (v string, err error)
+	TryGetMediaTypeIRI() (v *url.URL, err error)
+	TryGetUnknownMediaType() (v interface{}, err error)
+	TryGetNameString(index int) (v string, err error)
+	TryGetNameLangString(index int) (v string, err error)
+	TryGetNameIRI(index int) (v *url.URL, err error)
+	TryGetUnknownName() (v interface{}, err error)
+	TryGetSummaryString(index int) (v string, err error)
+	TryGetSummaryLangString(index int) (v string, err error)
+	TryGetSummaryIRI(index int) (v *url.URL, err error)
+	TryGetUnknownSummary() (v interface{}, err error)
+	TryGetHreflang() (v string, err error)
+	TryGetHreflangIRI() (v *url.URL, err error)
+	TryGetUnknownHreflang() (v interface{}, err error)
+	TryGetHeight() (v int64, err error)
+	TryGetHeightIRI() (v *url.URL, err error)
+	TryGetUnknownHeight() (v interface{}, err error)
+	TryGetWidth() (v int64, err error)
+	TryGetWidthIRI() (v *url.URL, err error)
+	TryGetUnknownWidth() (v interface{}, err error)
+	TryGetPreviewObject(index int) (v ObjectType, err error)
+	TryGetPreviewLink(index int) (v LinkType, err error)
+	TryGetPreviewIRI(index int) (v *url.URL, err error)
+	TryGetUnknownPreview() (v interface{}, err error)
+}
+
+var _ HashtagType = (*Hashtag)(nil)
+
+// A specialized Link that represents a #hashtag, whose 'name' is the hashtag and whose 'href' is the page of the objects tagged with it. It is in the namespace but not the specification of the ActivityStreams Vocabulary, and is found in the 'tag' of the objects of nearly every microblogging server.
+type Hashtag struct {
+	// An unknown value.
+	unknown_ map[string]interface{}
+	// The order of the properties of the JSON this was unmarshalled from.
+	order_ *propertyOrder
+	// The 'attributedTo' value could have multiple types and values
+	attributedTo []*attributedToIntermediateType
+	// The functional 'href' value holds a single type and a single value
+	href *url.URL
+	// The functional 'id' value holds a single type and a single value
+	id *url.URL
+	// The 'rel' value could have multiple types and values
+	rel []*relIntermediateType
+	// The 'type' value can hold any type and any number of values
+	typeName []interface{}
+	// The functional 'mediaType' value could have multiple types, but only a single value
+	mediaType *mediaTypeIntermediateType
+	// The 'name' value could have multiple types and values
+	name []*nameIntermediateType
+	// The 'nameMap' value holds language-specific values for property 'name'
+	nameMap map[string]string
+	// The 'summary' value could have multiple types and values
+	summary []*summaryIntermediateType
+	// The 'summaryMap' value holds language-specific values for property 'summary'
+	summaryMap map[string]string
+	// The functional 'hreflang' value could have multiple types, but only a single value
+	hreflang *hreflangIntermediateType
+	// The functional 'height' value could have multiple types, but only a single value
+	height *heightIntermediateType
+	// The functional 'width' value could have multiple types, but only a single value
+	width *widthIntermediateType
+	// The 'preview' value could have multiple types and values
+	preview []*previewIntermediateType
+	// The bits of the members that are set, in the order of the members.
+	present_ [1]uint64
+}
+
+// AttributedToLen determines the number of elements able to be used for the IsAttributedToObject, GetAttributedToObject, and RemoveAttributedToObject functions
+func (t *Hashtag) AttributedToLen() (l int) {
+	return len(t.attributedTo)
+
+}
+
+// IsAttributedToObject determines whether the call to GetAttributedToObject is safe for the specified index
+func (t *Hashtag) IsAttributedToObject(index int) (ok bool) {
+	return t.attributedTo[index].Object != nil
+
+}
+
+// GetAttributedToObject returns the value safely if IsAttributedToObject returned true for the specified index
+func (t *Hashtag) GetAttributedToObject(index int) (v ObjectType) {
+	return t.attributedTo[index].Object
+
+}
+
+// AppendAttributedToObject adds to the back of attributedTo a ObjectType type
+func (t *Hashtag) AppendAttributedToObject(v ObjectType) {
+	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Object: v})
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// PrependAttributedToObject adds to the front of attributedTo a ObjectType type
+func (t *Hashtag) PrependAttributedToObject(v ObjectType) {
+	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Object: v}}, t.attributedTo...)
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// RemoveAttributedToObject deletes the value from the specified index
+func (t *Hashtag) RemoveAttributedToObject(index int) {
+	copy(t.attributedTo[index:], t.attributedTo[index+1:])
+	t.attributedTo[len(t.attributedTo)-1] = nil
+	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToObjectValues returns an iterator over the values that GetAttributedToObject returns for each index where IsAttributedToObject is true, which can be used as an iter.Seq
+func (t *Hashtag) AttributedToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToObject(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAttributedToLink determines whether the call to GetAttributedToLink is safe for the specified index
+func (t *Hashtag) IsAttributedToLink(index int) (ok bool) {
+	return t.attributedTo[index].Link != nil
+
+}
+
+// GetAttributedToLink returns the value safely if IsAttributedToLink returned true for the specified index
+func (t *Hashtag) GetAttributedToLink(index int) (v LinkType) {
+	return t.attributedTo[index].Link
+
+}
+
+// AppendAttributedToLink adds to the back of attributedTo a LinkType type
+func (t *Hashtag) AppendAttributedToLink(v LinkType) {
+	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Link: v})
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// PrependAttributedToLink adds to the front of attributedTo a LinkType type
+func (t *Hashtag) PrependAttributedToLink(v LinkType) {
+	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Link: v}}, t.attributedTo...)
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// RemoveAttributedToLink deletes the value from the specified index
+func (t *Hashtag) RemoveAttributedToLink(index int) {
+	copy(t.attributedTo[index:], t.attributedTo[index+1:])
+	t.attributedTo[len(t.attributedTo)-1] = nil
+	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToLinkValues returns an iterator over the values that GetAttributedToLink returns for each index where IsAttributedToLink is true, which can be used as an iter.Seq
+func (t *Hashtag) AttributedToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToLink(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsAttributedToIRI determines whether the call to GetAttributedToIRI is safe for the specified index
+func (t *Hashtag) IsAttributedToIRI(index int) (ok bool) {
+	return t.attributedTo[index].IRI != nil
+
+}
+
+// GetAttributedToIRI returns the value safely if IsAttributedToIRI returned true for the specified index
+func (t *Hashtag) GetAttributedToIRI(index int) (v *url.URL) {
+	return t.attributedTo[index].IRI
+
+}
+
+// AppendAttributedToIRI adds to the back of attributedTo a *url.URL type
+func (t *Hashtag) AppendAttributedToIRI(v *url.URL) {
+	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{IRI: v})
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// PrependAttributedToIRI adds to the front of attributedTo a *url.URL type
+func (t *Hashtag) PrependAttributedToIRI(v *url.URL) {
+	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{IRI: v}}, t.attributedTo...)
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// RemoveAttributedToIRI deletes the value from the specified index
+func (t *Hashtag) RemoveAttributedToIRI(index int) {
+	copy(t.attributedTo[index:], t.attributedTo[index+1:])
+	t.attributedTo[len(t.attributedTo)-1] = nil
+	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToIRIValues returns an iterator over the values that GetAttributedToIRI returns for each index where IsAttributedToIRI is true, which can be used as an iter.Seq
+func (t *Hashtag) AttributedToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownAttributedTo determines whether the call to GetUnknownAttributedTo is safe
+func (t *Hashtag) HasUnknownAttributedTo() (ok bool) {
+	return t.present_[0]&(1<<0) != 0 && t.attributedTo[0].unknown_ != nil
+
+}
+
+// GetUnknownAttributedTo returns the unknown value for attributedTo
+func (t *Hashtag) GetUnknownAttributedTo() (v interface{}) {
+	return t.attributedTo[0].unknown_
+
+}
+
+// SetUnknownAttributedTo sets the unknown value of attributedTo
+func (t *Hashtag) SetUnknownAttributedTo(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &attributedToIntermediateType{}
+	tmp.unknown_ = i
+	t.attributedTo = append(t.attributedTo, tmp)
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// HasHref determines whether the call to GetHref is safe
+func (t *Hashtag) HasHref() (ok bool) {
+	return t.present_[0]&(1<<1) != 0
+
+}
+
+// GetHref returns the value for href
+func (t *Hashtag) GetHref() (v *url.URL) {
+	return t.href
+
+}
+
+// SetHref sets the value of href
+func (t *Hashtag) SetHref(v *url.URL) {
+	t.href = v
+	t.markPresent_(1, true)
+
+}
+
+// HasUnknownHref determines whether the call to GetUnknownHref is safe
+func (t *Hashtag) HasUnknownHref() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["href"] != nil
+
+}
+
+// GetUnknownHref returns the unknown value for href
+func (t *Hashtag) GetUnknownHref() (v interface{}) {
+	return t.unknown_["href"]
+
+}
+
+// SetUnknownHref sets the unknown value of href
+func (t *Hashtag) SetUnknownHref(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["href"] = i
+
+}
+
+// HasId determines whether the call to GetId is safe
+func (t *Hashtag) HasId() (ok bool) {
+	return t.present_[0]&(1<<2) != 0
+
+}
+
+// GetId returns the value for id
+func (t *Hashtag) GetId() (v *url.URL) {
+	return t.id
+
+}
+
+// SetId sets the value of id
+func (t *Hashtag) SetId(v *url.URL) {
+	t.id = v
+	t.markPresent_(2, true)
+
+}
+
+// HasUnknownId determines whether the call to GetUnknownId is safe
+func (t *Hashtag) HasUnknownId() (ok bool) {
+	return t.unknown_ != nil && t.unknown_["id"] != nil
+
+}
+
+// GetUnknownId returns the unknown value for id
+func (t *Hashtag) GetUnknownId() (v interface{}) {
+	return t.unknown_["id"]
+
+}
+
+// SetUnknownId sets the unknown value of id
+func (t *Hashtag) SetUnknownId(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_["id"] = i
+
+}
+
+// RelLen determines the number of elements able to be used for the IsRel, GetRel, and RemoveRel functions
+func (t *Hashtag) RelLen() (l int) {
+	return len(t.rel)
+
+}
+
+// IsRel determines whether the call to GetRel is safe for the specified index
+func (t *Hashtag) IsRel(index int) (ok bool) {
+	return t.rel[index].linkRelation != nil
+
+}
+
+// GetRel returns the value safely if IsRel returned true for the specified index
+func (t *Hashtag) GetRel(index int) (v string) {
+	return *t.rel[index].linkRelation
+
+}
+
+// AppendRel adds to the back of rel a string type
+func (t *Hashtag) AppendRel(v string) {
+	t.rel = append(t.rel, &relIntermediateType{linkRelation: &v})
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// PrependRel adds to the front of rel a string type
+func (t *Hashtag) PrependRel(v string) {
+	t.rel = append([]*relIntermediateType{&relIntermediateType{linkRelation: &v}}, t.rel...)
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// RemoveRel deletes the value from the specified index
+func (t *Hashtag) RemoveRel(index int) {
+	copy(t.rel[index:], t.rel[index+1:])
+	t.rel[len(t.rel)-1] = nil
+	t.rel = t.rel[:len(t.rel)-1]
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// RelValues returns an iterator over the values that GetRel returns for each index where IsRel is true, which can be used as an iter.Seq
+func (t *Hashtag) RelValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.RelLen(); i++ {
+			if !t.IsRel(i) {
+				continue
+			}
+			if !yield(t.GetRel(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsRelIRI determines whether the call to GetRelIRI is safe for the specified index
+func (t *Hashtag) IsRelIRI(index int) (ok bool) {
+	return t.rel[index].IRI != nil
+
+}
+
+// GetRelIRI returns the value safely if IsRelIRI returned true for the specified index
+func (t *Hashtag) GetRelIRI(index int) (v *url.URL) {
+	return t.rel[index].IRI
+
+}
+
+// AppendRelIRI adds to the back of rel a *url.URL type
+func (t *Hashtag) AppendRelIRI(v *url.URL) {
+	t.rel = append(t.rel, &relIntermediateType{IRI: v})
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// PrependRelIRI adds to the front of rel a *url.URL type
+func (t *Hashtag) PrependRelIRI(v *url.URL) {
+	t.rel = append([]*relIntermediateType{&relIntermediateType{IRI: v}}, t.rel...)
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// RemoveRelIRI deletes the value from the specified index
+func (t *Hashtag) RemoveRelIRI(index int) {
+	copy(t.rel[index:], t.rel[index+1:])
+	t.rel[len(t.rel)-1] = nil
+	t.rel = t.rel[:len(t.rel)-1]
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// RelIRIValues returns an iterator over the values that GetRelIRI returns for each index where IsRelIRI is true, which can be used as an iter.Seq
+func (t *Hashtag) RelIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.RelLen(); i++ {
+			if !t.IsRelIRI(i) {
+				continue
+			}
+			if !yield(t.GetRelIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownRel determines whether the call to GetUnknownRel is safe
+func (t *Hashtag) HasUnknownRel() (ok bool) {
+	return t.present_[0]&(1<<3) != 0 && t.rel[0].unknown_ != nil
+
+}
+
+// GetUnknownRel returns the unknown value for rel
+func (t *Hashtag) GetUnknownRel() (v interface{}) {
+	return t.rel[0].unknown_
+
+}
+
+// SetUnknownRel sets the unknown value of rel
+func (t *Hashtag) SetUnknownRel(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &relIntermediateType{}
+	tmp.unknown_ = i
+	t.rel = append(t.rel, tmp)
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// TypeLen determines the number of elements able to be used for the GetType and RemoveType functions
+func (t *Hashtag) TypeLen() (l int) {
+	return len(t.typeName)
+
+}
+
+// GetType returns the value for the specified index
+func (t *Hashtag) GetType(index int) (v interface{}) {
+	return t.typeName[index]
+
+}
+
+// AppendType adds a value to the back of type
+func (t *Hashtag) AppendType(v interface{}) {
+	t.typeName = append(t.typeName, v)
+	t.markPresent_(4, len(t.typeName) > 0)
+
+}
+
+// PrependType adds a value to the front of type
+func (t *Hashtag) PrependType(v interface{}) {
+	t.typeName = append([]interface{}{v}, t.typeName...)
+	t.markPresent_(4, len(t.typeName) > 0)
+
+}
+
+// RemoveType deletes the value from the specified index
+func (t *Hashtag) RemoveType(index int) {
+	copy(t.typeName[index:], t.typeName[index+1:])
+	t.typeName[len(t.typeName)-1] = nil
+	t.typeName = t.typeName[:len(t.typeName)-1]
+	t.markPresent_(4, len(t.typeName) > 0)
+
+}
+
+// IsMediaType determines whether the call to GetMediaType is safe
+func (t *Hashtag) IsMediaType() (ok bool) {
+	return t.present_[0]&(1<<5) != 0 && t.mediaType.mimeMediaTypeValue != nil
+
+}
+
+// GetMediaType returns the value safely if IsMediaType returned true
+func (t *Hashtag) GetMediaType() (v string) {
+	return *t.mediaType.mimeMediaTypeValue
+
+}
+
+// SetMediaType sets the value of mediaType to be of string type
+func (t *Hashtag) SetMediaType(v string) {
+	t.mediaType = &mediaTypeIntermediateType{mimeMediaTypeValue: &v}
+	t.markPresent_(5, true)
+
+}
+
+// IsMediaTypeIRI determines whether the call to GetMediaTypeIRI is safe
+func (t *Hashtag) IsMediaTypeIRI() (ok bool) {
+	return t.present_[0]&(1<<5) != 0 && t.mediaType.IRI != nil
+
+}
+
+// GetMediaTypeIRI returns the value safely if IsMediaTypeIRI returned true
+func (t *Hashtag) GetMediaTypeIRI() (v *url.URL) {
+	return t.mediaType.IRI
+
+}
+
+// SetMediaTypeIRI sets the value of mediaType to be of *url.URL type
+func (t *Hashtag) SetMediaTypeIRI(v *url.URL) {
+	t.mediaType = &mediaTypeIntermediateType{IRI: v}
+	t.markPresent_(5, true)
+
+}
+
+// HasUnknownMediaType determines whether the call to GetUnknownMediaType is safe
+func (t *Hashtag) HasUnknownMediaType() (ok bool) {
+	return t.present_[0]&(1<<5) != 0 && t.mediaType.unknown_ != nil
+
+}
+
+// GetUnknownMediaType returns the unknown value for mediaType
+func (t *Hashtag) GetUnknownMediaType() (v interface{}) {
+	return t.mediaType.unknown_
+
+}
+
+// SetUnknownMediaType sets the unknown value of mediaType
+func (t *Hashtag) SetUnknownMediaType(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &mediaTypeIntermediateType{}
+	tmp.unknown_ = i
+	t.mediaType = tmp
+	t.markPresent_(5, true)
+
+}
+
+// NameLen determines the number of elements able to be used for the IsNameString, GetNameString, and RemoveNameString functions
+func (t *Hashtag) NameLen() (l int) {
+	return len(t.name)
+
+}
+
+// IsNameString determines whether the call to GetNameString is safe for the specified index
+func (t *Hashtag) IsNameString(index int) (ok bool) {
+	return t.name[index].stringName != nil
+
+}
+
+// GetNameString returns the value safely if IsNameString returned true for the specified index
+func (t *Hashtag) GetNameString(index int) (v string) {
+	return *t.name[index].stringName
+
+}
+
+// AppendNameString adds to the back of name a string type
+func (t *Hashtag) AppendNameString(v string) {
+	t.name = append(t.name, &nameIntermediateType{stringName: &v})
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// PrependNameString adds to the front of name a string type
+func (t *Hashtag) PrependNameString(v string) {
+	t.name = append([]*nameIntermediateType{&nameIntermediateType{stringName: &v}}, t.name...)
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// RemoveNameString deletes the value from the specified index
+func (t *Hashtag) RemoveNameString(index int) {
+	copy(t.name[index:], t.name[index+1:])
+	t.name[len(t.name)-1] = nil
+	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// NameStringValues returns an iterator over the values that GetNameString returns for each index where IsNameString is true, which can be used as an iter.Seq
+func (t *Hashtag) NameStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameString(i) {
+				continue
+			}
+			if !yield(t.GetNameString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsNameLangString determines whether the call to GetNameLangString is safe for the specified index
+func (t *Hashtag) IsNameLangString(index int) (ok bool) {
+	return t.name[index].langString != nil
+
+}
+
+// GetNameLangString returns the value safely if IsNameLangString returned true for the specified index
+func (t *Hashtag) GetNameLangString(index int) (v string) {
+	return *t.name[index].langString
+
+}
+
+// AppendNameLangString adds to the back of name a string type
+func (t *Hashtag) AppendNameLangString(v string) {
+	t.name = append(t.name, &nameIntermediateType{langString: &v})
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// PrependNameLangString adds to the front of name a string type
+func (t *Hashtag) PrependNameLangString(v string) {
+	t.name = append([]*nameIntermediateType{&nameIntermediateType{langString: &v}}, t.name...)
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// RemoveNameLangString deletes the value from the specified index
+func (t *Hashtag) RemoveNameLangString(index int) {
+	copy(t.name[index:], t.name[index+1:])
+	t.name[len(t.name)-1] = nil
+	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// NameLangStringValues returns an iterator over the values that GetNameLangString returns for each index where IsNameLangString is true, which can be used as an iter.Seq
+func (t *Hashtag) NameLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameLangString(i) {
+				continue
+			}
+			if !yield(t.GetNameLangString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsNameIRI determines whether the call to GetNameIRI is safe for the specified index
+func (t *Hashtag) IsNameIRI(index int) (ok bool) {
+	return t.name[index].IRI != nil
+
+}
+
+// GetNameIRI returns the value safely if IsNameIRI returned true for the specified index
+func (t *Hashtag) GetNameIRI(index int) (v *url.URL) {
+	return t.name[index].IRI
+
+}
+
+// AppendNameIRI adds to the back of name a *url.URL type
+func (t *Hashtag) AppendNameIRI(v *url.URL) {
+	t.name = append(t.name, &nameIntermediateType{IRI: v})
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// PrependNameIRI adds to the front of name a *url.URL type
+func (t *Hashtag) PrependNameIRI(v *url.URL) {
+	t.name = append([]*nameIntermediateType{&nameIntermediateType{IRI: v}}, t.name...)
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// RemoveNameIRI deletes the value from the specified index
+func (t *Hashtag) RemoveNameIRI(index int) {
+	copy(t.name[index:], t.name[index+1:])
+	t.name[len(t.name)-1] = nil
+	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// NameIRIValues returns an iterator over the values that GetNameIRI returns for each index where IsNameIRI is true, which can be used as an iter.Seq
+func (t *Hashtag) NameIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameIRI(i) {
+				continue
+			}
+			if !yield(t.GetNameIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownName determines whether the call to GetUnknownName is safe
+func (t *Hashtag) HasUnknownName() (ok bool) {
+	return t.present_[0]&(1<<6) != 0 && t.name[0].unknown_ != nil
+
+}
+
+// GetUnknownName returns the unknown value for name
+func (t *Hashtag) GetUnknownName() (v interface{}) {
+	return t.name[0].unknown_
+
+}
+
+// SetUnknownName sets the unknown value of name
+func (t *Hashtag) SetUnknownName(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &nameIntermediateType{}
+	tmp.unknown_ = i
+	t.name = append(t.name, tmp)
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// NameMapLanguages returns all languages for this property's language mapping, or nil if there are none.
+func (t *Hashtag) NameMapLanguages() (l []string) {
+	if t.nameMap == nil || len(t.nameMap) == 0 {
+		return nil
+	}
+	for k := range t.nameMap {
+		l = append(l, k)
+	}
+	return
+
+}
+
+// GetNameMap retrieves the value of the property for the specified language, or an empty string if it does not exist
+func (t *Hashtag) GetNameMap(l string) (v string) {
+	if t.nameMap == nil {
+		return ""
+	}
+	ok := false
+	v, ok = t.nameMap[l]
+	if !ok {
+		return ""
+	}
+	return v
+
+}
+
+// SetNameMap sets the value of the property for the specified language
+func (t *Hashtag) SetNameMap(l string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(7, true)
+	}
+	t.nameMap[l] = v
+
+}
+
+// GetNameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Hashtag) GetNameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.nameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.nameMap[k], true
+
+}
+
+// SetNameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Hashtag) SetNameLanguage(tag string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(7, true)
+	} else if k, ok := languageKey(t.nameMap, tag); ok {
+		delete(t.nameMap, k)
+	}
+	t.nameMap[tag] = v
+
+}
+
+// PreferredNameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Hashtag) PreferredNameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.nameMap, tags)
+	return t.nameMap[tag], tag
+
+}
+
+// SummaryLen determines the number of elements able to be used for the IsSummaryString, GetSummaryString, and RemoveSummaryString functions
+func (t *Hashtag) SummaryLen() (l int) {
+	return len(t.summary)
+
+}
+
+// IsSummaryString determines whether the call to GetSummaryString is safe for the specified index
+func (t *Hashtag) IsSummaryString(index int) (ok bool) {
+	return t.summary[index].stringName != nil
+
+}
+
+// GetSummaryString returns the value safely if IsSummaryString returned true for the specified index
+func (t *Hashtag) GetSummaryString(index int) (v string) {
+	return *t.summary[index].stringName
+
+}
+
+// AppendSummaryString adds to the back of summary a string type
+func (t *Hashtag) AppendSummaryString(v string) {
+	t.summary = append(t.summary, &summaryIntermediateType{stringName: &v})
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// PrependSummaryString adds to the front of summary a string type
+func (t *Hashtag) PrependSummaryString(v string) {
+	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{stringName: &v}}, t.summary...)
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// RemoveSummaryString deletes the value from the specified index
+func (t *Hashtag) RemoveSummaryString(index int) {
+	copy(t.summary[index:], t.summary[index+1:])
+	t.summary[len(t.summary)-1] = nil
+	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// SummaryStringValues returns an iterator over the values that GetSummaryString returns for each index where IsSummaryString is true, which can be used as an iter.Seq
+func (t *Hashtag) SummaryStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsSummaryLangString determines whether the call to GetSummaryLangString is safe for the specified index
+func (t *Hashtag) IsSummaryLangString(index int) (ok bool) {
+	return t.summary[index].langString != nil
+
+}
+
+// GetSummaryLangString returns the value safely if IsSummaryLangString returned true for the specified index
+func (t *Hashtag) GetSummaryLangString(index int) (v string) {
+	return *t.summary[index].langString
+
+}
+
+// AppendSummaryLangString adds to the back of summary a string type
+func (t *Hashtag) AppendSummaryLangString(v string) {
+	t.summary = append(t.summary, &summaryIntermediateType{langString: &v})
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// PrependSummaryLangString adds to the front of summary a string type
+func (t *Hashtag) PrependSummaryLangString(v string) {
+	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{langString: &v}}, t.summary...)
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// RemoveSummaryLangString deletes the value from the specified index
+func (t *Hashtag) RemoveSummaryLangString(index int) {
+	copy(t.summary[index:], t.summary[index+1:])
+	t.summary[len(t.summary)-1] = nil
+	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// SummaryLangStringValues returns an iterator over the values that GetSummaryLangString returns for each index where IsSummaryLangString is true, which can be used as an iter.Seq
+func (t *Hashtag) SummaryLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryLangString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryLangString(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsSummaryIRI determines whether the call to GetSummaryIRI is safe for the specified index
+func (t *Hashtag) IsSummaryIRI(index int) (ok bool) {
+	return t.summary[index].IRI != nil
+
+}
+
+// GetSummaryIRI returns the value safely if IsSummaryIRI returned true for the specified index
+func (t *Hashtag) GetSummaryIRI(index int) (v *url.URL) {
+	return t.summary[index].IRI
+
+}
+
+// AppendSummaryIRI adds to the back of summary a *url.URL type
+func (t *Hashtag) AppendSummaryIRI(v *url.URL) {
+	t.summary = append(t.summary, &summaryIntermediateType{IRI: v})
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// PrependSummaryIRI adds to the front of summary a *url.URL type
+func (t *Hashtag) PrependSummaryIRI(v *url.URL) {
+	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{IRI: v}}, t.summary...)
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// RemoveSummaryIRI deletes the value from the specified index
+func (t *Hashtag) RemoveSummaryIRI(index int) {
+	copy(t.summary[index:], t.summary[index+1:])
+	t.summary[len(t.summary)-1] = nil
+	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// SummaryIRIValues returns an iterator over the values that GetSummaryIRI returns for each index where IsSummaryIRI is true, which can be used as an iter.Seq
+func (t *Hashtag) SummaryIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryIRI(i) {
+				continue
+			}
+			if !yield(t.GetSummaryIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownSummary determines whether the call to GetUnknownSummary is safe
+func (t *Hashtag) HasUnknownSummary() (ok bool) {
+	return t.present_[0]&(1<<8) != 0 && t.summary[0].unknown_ != nil
+
+}
+
+// GetUnknownSummary returns the unknown value for summary
+func (t *Hashtag) GetUnknownSummary() (v interface{}) {
+	return t.summary[0].unknown_
+
+}
+
+// SetUnknownSummary sets the unknown value of summary
+func (t *Hashtag) SetUnknownSummary(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &summaryIntermediateType{}
+	tmp.unknown_ = i
+	t.summary = append(t.summary, tmp)
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// SummaryMapLanguages returns all languages for this property's language mapping, or nil if there are none.
+func (t *Hashtag) SummaryMapLanguages() (l []string) {
+	if t.summaryMap == nil || len(t.summaryMap) == 0 {
+		return nil
+	}
+	for k := range t.summaryMap {
+		l = append(l, k)
+	}
+	return
+
+}
+
+// GetSummaryMap retrieves the value of the property for the specified language, or an empty string if it does not exist
+func (t *Hashtag) GetSummaryMap(l string) (v string) {
+	if t.summaryMap == nil {
+		return ""
+	}
+	ok := false
+	v, ok = t.summaryMap[l]
+	if !ok {
+		return ""
+	}
+	return v
+
+}
+
+// SetSummaryMap sets the value of the property for the specified language
+func (t *Hashtag) SetSummaryMap(l string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(9, true)
+	}
+	t.summaryMap[l] = v
+
+}
+
+// GetSummaryLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Hashtag) GetSummaryLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.summaryMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.summaryMap[k], true
+
+}
+
+// SetSummaryLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Hashtag) SetSummaryLanguage(tag string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(9, true)
+	} else if k, ok := languageKey(t.summaryMap, tag); ok {
+		delete(t.summaryMap, k)
+	}
+	t.summaryMap[tag] = v
+
+}
+
+// PreferredSummaryLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Hashtag) PreferredSummaryLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.summaryMap, tags)
+	return t.summaryMap[tag], tag
+
+}
+
+// IsHreflang determines whether the call to GetHreflang is safe
+func (t *Hashtag) IsHreflang() (ok bool) {
+	return t.present_[0]&(1<<10) != 0 && t.hreflang.bcp47LanguageTag != nil
+
+}
+
+// GetHreflang returns the value safely if IsHreflang returned true
+func (t *Hashtag) GetHreflang() (v string) {
+	return *t.hreflang.bcp47LanguageTag
+
+}
+
+// SetHreflang sets the value of hreflang to be of string type
+func (t *Hashtag) SetHreflang(v string) {
+	t.hreflang = &hreflangIntermediateType{bcp47LanguageTag: &v}
+	t.markPresent_(10, true)
+
+}
+
+// IsHreflangIRI determines whether the call to GetHreflangIRI is safe
+func (t *Hashtag) IsHreflangIRI() (ok bool) {
+	return t.present_[0]&(1<<10) != 0 && t.hreflang.IRI != nil
+
+}
+
+// GetHreflangIRI returns the value safely if IsHreflangIRI returned true
+func (t *Hashtag) GetHreflangIRI() (v *url.URL) {
+	return t.hreflang.IRI
+
+}
+
+// SetHreflangIRI sets the value of hreflang to be of *url.URL type
+func (t *Hashtag) SetHreflangIRI(v *url.URL) {
+	t.hreflang = &hreflangIntermediateType{IRI: v}
+	t.markPresent_(10, true)
+
+}
+
+// HasUnknownHreflang determines whether the call to GetUnknownHreflang is safe
+func (t *Hashtag) HasUnknownHreflang() (ok bool) {
+	return t.present_[0]&(1<<10) != 0 && t.hreflang.unknown_ != nil
+
+}
+
+// GetUnknownHreflang returns the unknown value for hreflang
+func (t *Hashtag) GetUnknownHreflang() (v interface{}) {
+	return t.hreflang.unknown_
+
+}
+
+// SetUnknownHreflang sets the unknown value of hreflang
+func (t *Hashtag) SetUnknownHreflang(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &hreflangIntermediateType{}
+	tmp.unknown_ = i
+	t.hreflang = tmp
+	t.markPresent_(10, true)
+
+}
+
+// IsHeight determines whether the call to GetHeight is safe
+func (t *Hashtag) IsHeight() (ok bool) {
+	return t.present_[0]&(1<<11) != 0 && t.height.nonNegativeInteger != nil
+
+}
+
+// GetHeight returns the value safely if IsHeight returned true
+func (t *Hashtag) GetHeight() (v int64) {
+	return *t.height.nonNegativeInteger
+
+}
+
+// SetHeight sets the value of height to be of int64 type
+func (t *Hashtag) SetHeight(v int64) {
+	t.height = &heightIntermediateType{nonNegativeInteger: &v}
+	t.markPresent_(11, true)
+
+}
+
+// IsHeightIRI determines whether the call to GetHeightIRI is safe
+func (t *Hashtag) IsHeightIRI() (ok bool) {
+	return t.present_[0]&(1<<11) != 0 && t.height.IRI != nil
+
+}
+
+// GetHeightIRI returns the value safely if IsHeightIRI returned true
+func (t *Hashtag) GetHeightIRI() (v *url.URL) {
+	return t.height.IRI
+
+}
+
+// SetHeightIRI sets the value of height to be of *url.URL type
+func (t *Hashtag) SetHeightIRI(v *url.URL) {
+	t.height = &heightIntermediateType{IRI: v}
+	t.markPresent_(11, true)
+
+}
+
+// HasUnknownHeight determines whether the call to GetUnknownHeight is safe
+func (t *Hashtag) HasUnknownHeight() (ok bool) {
+	return t.present_[0]&(1<<11) != 0 && t.height.unknown_ != nil
+
+}
+
+// GetUnknownHeight returns the unknown value for height
+func (t *Hashtag) GetUnknownHeight() (v interface{}) {
+	return t.height.unknown_
+
+}
+
+// SetUnknownHeight sets the unknown value of height
+func (t *Hashtag) SetUnknownHeight(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &heightIntermediateType{}
+	tmp.unknown_ = i
+	t.height = tmp
+	t.markPresent_(11, true)
+
+}
+
+// IsWidth determines whether the call to GetWidth is safe
+func (t *Hashtag) IsWidth() (ok bool) {
+	return t.present_[0]&(1<<12) != 0 && t.width.nonNegativeInteger != nil
+
+}
+
+// GetWidth returns the value safely if IsWidth returned true
+func (t *Hashtag) GetWidth() (v int64) {
+	return *t.width.nonNegativeInteger
+
+}
+
+// SetWidth sets the value of width to be of int64 type
+func (t *Hashtag) SetWidth(v int64) {
+	t.width = &widthIntermediateType{nonNegativeInteger: &v}
+	t.markPresent_(12, true)
+
+}
+
+// IsWidthIRI determines whether the call to GetWidthIRI is safe
+func (t *Hashtag) IsWidthIRI() (ok bool) {
+	return t.present_[0]&(1<<12) != 0 && t.width.IRI != nil
+
+}
+
+// GetWidthIRI returns the value safely if IsWidthIRI returned true
+func (t *Hashtag) GetWidthIRI() (v *url.URL) {
+	return t.width.IRI
+
+}
+
+// SetWidthIRI sets the value of width to be of *url.URL type
+func (t *Hashtag) SetWidthIRI(v *url.URL) {
+	t.width = &widthIntermediateType{IRI: v}
+	t.markPresent_(12, true)
+
+}
+
+// HasUnknownWidth determines whether the call to GetUnknownWidth is safe
+func (t *Hashtag) HasUnknownWidth() (ok bool) {
+	return t.present_[0]&(1<<12) != 0 && t.width.unknown_ != nil
+
+}
+
+// GetUnknownWidth returns the unknown value for width
+func (t *Hashtag) GetUnknownWidth() (v interface{}) {
+	return t.width.unknown_
+
+}
+
+// SetUnknownWidth sets the unknown value of width
+func (t *Hashtag) SetUnknownWidth(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &widthIntermediateType{}
+	tmp.unknown_ = i
+	t.width = tmp
+	t.markPresent_(12, true)
+
+}
+
+// PreviewLen determines the number of elements able to be used for the IsPreviewObject, GetPreviewObject, and RemovePreviewObject functions
+func (t *Hashtag) PreviewLen() (l int) {
+	return len(t.preview)
+
+}
+
+// IsPreviewObject determines whether the call to GetPreviewObject is safe for the specified index
+func (t *Hashtag) IsPreviewObject(index int) (ok bool) {
+	return t.preview[index].Object != nil
+
+}
+
+// GetPreviewObject returns the value safely if IsPreviewObject returned true for the specified index
+func (t *Hashtag) GetPreviewObject(index int) (v ObjectType) {
+	return t.preview[index].Object
+
+}
+
+// AppendPreviewObject adds to the back of preview a ObjectType type
+func (t *Hashtag) AppendPreviewObject(v ObjectType) {
+	t.preview = append(t.preview, &previewIntermediateType{Object: v})
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PrependPreviewObject adds to the front of preview a ObjectType type
+func (t *Hashtag) PrependPreviewObject(v ObjectType) {
+	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Object: v}}, t.preview...)
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// RemovePreviewObject deletes the value from the specified index
+func (t *Hashtag) RemovePreviewObject(index int) {
+	copy(t.preview[index:], t.preview[index+1:])
+	t.preview[len(t.preview)-1] = nil
+	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PreviewObjectValues returns an iterator over the values that GetPreviewObject returns for each index where IsPreviewObject is true, which can be used as an iter.Seq
+func (t *Hashtag) PreviewObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewObject(i) {
+				continue
+			}
+			if !yield(t.GetPreviewObject(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsPreviewLink determines whether the call to GetPreviewLink is safe for the specified index
+func (t *Hashtag) IsPreviewLink(index int) (ok bool) {
+	return t.preview[index].Link != nil
+
+}
+
+// GetPreviewLink returns the value safely if IsPreviewLink returned true for the specified index
+func (t *Hashtag) GetPreviewLink(index int) (v LinkType) {
+	return t.preview[index].Link
+
+}
+
+// AppendPreviewLink adds to the back of preview a LinkType type
+func (t *Hashtag) AppendPreviewLink(v LinkType) {
+	t.preview = append(t.preview, &previewIntermediateType{Link: v})
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PrependPreviewLink adds to the front of preview a LinkType type
+func (t *Hashtag) PrependPreviewLink(v LinkType) {
+	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Link: v}}, t.preview...)
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// RemovePreviewLink deletes the value from the specified index
+func (t *Hashtag) RemovePreviewLink(index int) {
+	copy(t.preview[index:], t.preview[index+1:])
+	t.preview[len(t.preview)-1] = nil
+	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PreviewLinkValues returns an iterator over the values that GetPreviewLink returns for each index where IsPreviewLink is true, which can be used as an iter.Seq
+func (t *Hashtag) PreviewLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewLink(i) {
+				continue
+			}
+			if !yield(t.GetPreviewLink(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// IsPreviewIRI determines whether the call to GetPreviewIRI is safe for the specified index
+func (t *Hashtag) IsPreviewIRI(index int) (ok bool) {
+	return t.preview[index].IRI != nil
+
+}
+
+// GetPreviewIRI returns the value safely if IsPreviewIRI returned true for the specified index
+func (t *Hashtag) GetPreviewIRI(index int) (v *url.URL) {
+	return t.preview[index].IRI
+
+}
+
+// AppendPreviewIRI adds to the back of preview a *url.URL type
+func (t *Hashtag) AppendPreviewIRI(v *url.URL) {
+	t.preview = append(t.preview, &previewIntermediateType{IRI: v})
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PrependPreviewIRI adds to the front of preview a *url.URL type
+func (t *Hashtag) PrependPreviewIRI(v *url.URL) {
+	t.preview = append([]*previewIntermediateType{&previewIntermediateType{IRI: v}}, t.preview...)
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// RemovePreviewIRI deletes the value from the specified index
+func (t *Hashtag) RemovePreviewIRI(index int) {
+	copy(t.preview[index:], t.preview[index+1:])
+	t.preview[len(t.preview)-1] = nil
+	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PreviewIRIValues returns an iterator over the values that GetPreviewIRI returns for each index where IsPreviewIRI is true, which can be used as an iter.Seq
+func (t *Hashtag) PreviewIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewIRI(i) {
+				continue
+			}
+			if !yield(t.GetPreviewIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownPreview determines whether the call to GetUnknownPreview is safe
+func (t *Hashtag) HasUnknownPreview() (ok bool) {
+	return t.present_[0]&(1<<13) != 0 && t.preview[0].unknown_ != nil
+
+}
+
+// GetUnknownPreview returns the unknown value for preview
+func (t *Hashtag) GetUnknownPreview() (v interface{}) {
+	return t.preview[0].unknown_
+
+}
+
+// SetUnknownPreview sets the unknown value of preview
+func (t *Hashtag) SetUnknownPreview(i interface{}) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	tmp := &previewIntermediateType{}
+	tmp.unknown_ = i
+	t.preview = append(t.preview, tmp)
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// AddUnknown adds an unknown property to this object with the specified key
+func (t *Hashtag) AddUnknown(k string, i interface{}) (this *Hashtag) {
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+	return t
+
+}
+
+// HasUnknown returns true if there is an unknown property with the specified key
+func (t *Hashtag) HasUnknown(k string) (b bool) {
+	if t.unknown_ == nil {
+		return false
+	}
+	_, ok := t.unknown_[k]
+	return ok
+
+}
+
+// RemoveUnknown removes a raw extension from this object with the specified key
+func (t *Hashtag) RemoveUnknown(k string) (this *Hashtag) {
+	delete(t.unknown_, k)
+	return t
+
+}
+
+// GetUnknown fetches an unknown property from this object with the specified key. Note that this will panic if HasUnknown would return false.
+func (t *Hashtag) GetUnknown(k string) (i interface{}) {
+	return t.unknown_[k]
+
+}
+
+// GetUnknownProperties returns a copy of every unknown property of this object by key, such as the extension properties it was deserialized with, or nil if there are none. Serialize writes them back, so they are never discarded.
+func (t *Hashtag) GetUnknownProperties() (m map[string]interface{}) {
+	if len(t.unknown_) == 0 {
+		return nil
+	}
+	m = make(map[string]interface{}, len(t.unknown_))
+	for k, v := range t.unknown_ {
+		m[k] = v
+	}
+	return
+
+}
+
+// SetUnknownProperty sets the unknown property of this object with the specified key, or removes it if i is nil. Unlike AddUnknown, it does not return the object, so that every type shares its signature and extension vocabularies can set their properties of any type.
+func (t *Hashtag) SetUnknownProperty(k string, i interface{}) {
+	if i == nil {
+		delete(t.unknown_, k)
+		return
+	}
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+
+}
+
+// Serialize turns this object into a map[string]interface{}. Note that the "type" property will automatically be populated with "Hashtag" if not manually set by the caller
+func (t *Hashtag) Serialize() (m map[string]interface{}, err error) {
+	m = make(map[string]interface{})
+	for k, v := range t.unknown_ {
+		m[k] = unknownValueSerialize(v)
+	}
+	var typeAlreadySet bool
+	for _, k := range t.typeName {
+		if ks, ok := k.(string); ok {
+			if ks == "Hashtag" {
+				typeAlreadySet = true
+				break
+			}
+		}
+	}
+	if !typeAlreadySet {
+		t.typeName = append(t.typeName, "Hashtag")
+		t.markPresent_(4, len(t.typeName) > 0)
+	}
+	if t.present_[0]&(1<<0) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attributedTo", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<1) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.href != nil {
+			hrefSerializeFunc := func() (interface{}, error) {
+				v := t.href
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			hrefResult, err := hrefSerializeFunc()
+			if err == nil {
+				m["href"] = hrefResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<2) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.id != nil {
+			idSerializeFunc := func() (interface{}, error) {
+				v := t.id
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			idResult, err := idSerializeFunc()
+			if err == nil {
+				m["id"] = idResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<3) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceRelIntermediateType(t.rel); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "rel", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<4) != 0 {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if t.typeName != nil {
+			if len(t.typeName) == 1 {
+				m["type"] = t.typeName[0]
+			} else {
+				m["type"] = t.typeName
+			}
+		}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if t.present_[0]&(1<<5) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.mediaType != nil {
+			if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
+				m["mediaType"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<6) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceNameIntermediateType(t.name); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "name", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+
+	if t.present_[0]&(1<<7) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.nameMap != nil && len(t.nameMap) >= 0 {
+			m["nameMap"] = t.nameMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<8) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceSummaryIntermediateType(t.summary); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "summary", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+
+	if t.present_[0]&(1<<9) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.summaryMap != nil && len(t.summaryMap) >= 0 {
+			m["summaryMap"] = t.summaryMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<10) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.hreflang != nil {
+			if v, err := serializeHreflangIntermediateType(t.hreflang); err == nil {
+				m["hreflang"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<11) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.height != nil {
+			if v, err := serializeHeightIntermediateType(t.height); err == nil {
+				m["height"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<12) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.width != nil {
+			if v, err := serializeWidthIntermediateType(t.width); err == nil {
+				m["width"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<13) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSlicePreviewIntermediateType(t.preview); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "preview", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	return
+
+}
+
+// Deserialize populates this object from a map[string]interface{}, renaming the properties its '@context' aliases with ResolveAliases
+func (t *Hashtag) Deserialize(m map[string]interface{}) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	m = ResolveAliases(m)
+	for k, v := range m {
+		if err = t.deserializeProperty_(k, v); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	return
+
+}
+
+// deserializeProperty_ populates the property of this object with the key, or its unknown property if it has none with the key
+func (t *Hashtag) deserializeProperty_(k string, v interface{}) (err error) {
+	handled := false
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attributedTo" {
+			t.attributedTo, err = deserializeValuesAttributedToIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "href" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.href = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "id" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
+				}
+				t.id = tmp
+				handled = true
+			}
+		}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "rel" {
+			t.rel, err = deserializeValuesRelIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if k == "type" {
+			if tmpTypeSlice, ok := v.([]interface{}); ok {
+				t.typeName = tmpTypeSlice
+				handled = true
+			} else {
+				t.typeName = []interface{}{v}
+				handled = true
+			}
+		}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "mediaType" {
+			t.mediaType, err = deserializeMediaTypeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "name" {
+			t.name, err = deserializeValuesNameIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "nameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
+				}
+				t.nameMap = val
+				handled = true
+			}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "summary" {
+			t.summary, err = deserializeValuesSummaryIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "summaryMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
+				}
+				t.summaryMap = val
+				handled = true
+			}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "hreflang" {
+			t.hreflang, err = deserializeHreflangIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "height" {
+			t.height, err = deserializeHeightIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "width" {
+			t.width, err = deserializeWidthIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "preview" {
+			t.preview, err = deserializeValuesPreviewIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled && k != "@context" {
+		if t.unknown_ == nil {
+			t.unknown_ = make(map[string]interface{})
+		}
+		t.unknown_[k] = unknownValueDeserialize(v)
+	}
+	return
+
+}
+
+// MarshalJSON implements json.Marshaler by encoding this Hashtag as JSON, with its properties in the order of the JSON it was unmarshalled from, if any
+func (t *Hashtag) MarshalJSON() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	if t.order_ != nil {
+		b, err = encodeOrderedJSON(m, t.order_)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	return
+
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding JSON into this Hashtag, recording the order of its properties for MarshalJSON
+func (t *Hashtag) UnmarshalJSON(b []byte) (err error) {
+	m := make(map[string]interface{})
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	t.order_ = scanPropertyOrder(b)
+	return
+
+}
+
+// DecodeJSON populates this Hashtag from the JSON object read from the decoder, deserializing each property as soon as it is decoded instead of decoding the whole object into a map first, which takes far less memory for large documents. Properties are renamed as Deserialize does, provided the '@context' comes before the properties it aliases. Unlike UnmarshalJSON, it does not record the order of the properties
+func (t *Hashtag) DecodeJSON(dec *json.Decoder) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	return DecodeJSONProperties(dec, t.deserializeProperty_)
+
+}
+
+// SerializeCBOR encodes this Hashtag as CBOR, which DeserializeCBOR decodes. The encoding is of the same data as JSON, so values can be exchanged in CBOR internally and in JSON with other servers.
+func (t *Hashtag) SerializeCBOR() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeCBOR(m)
+
+}
+
+// DeserializeCBOR decodes CBOR, such as that of SerializeCBOR, into this Hashtag
+func (t *Hashtag) DeserializeCBOR(b []byte) (err error) {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode %T into Hashtag", v)
+	}
+	return t.Deserialize(m)
+
+}
+
+// SerializeCanonical encodes this Hashtag as canonical JSON by RFC 8785, so that equal values always have the same bytes, as signing them or addressing them by their content requires.
+func (t *Hashtag) SerializeCanonical() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeJCS(m)
+
+}
+
+// Clone returns a deep copy of this Hashtag, including its unknown properties and the values of all its properties, so that the copy can be modified without affecting the original
+func (t *Hashtag) Clone() (c *Hashtag, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	c = &Hashtag{}
+	err = c.Deserialize(cloneValue(m).(map[string]interface{}))
+	c.order_ = t.order_
+	return
+
+}
+
+// Equals determines whether this Hashtag and the other one have the same canonical serialized form, such as when the same activity is delivered more than once
+func (t *Hashtag) Equals(other *Hashtag) (eq bool) {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return canonicalEquals(t, other)
+
+}
+
+// Hash returns the SHA-256 hash of the canonical serialized form of this Hashtag, so that equal values have the same hash. It returns the zero hash if this Hashtag cannot be serialized
+func (t *Hashtag) Hash() (h [32]byte) {
+	return canonicalHash(t)
+
+}
+
+// Kind returns HashtagKind.
+func (t *Hashtag) Kind() (k TypeKind) {
+	return HashtagKind
+
+}
+
+// Validate returns ValidationErrors listing every property required by the specification that this Hashtag is missing, or nil if it has them all
+func (t *Hashtag) Validate() (err error) {
+	var errs ValidationErrors
+	if !(t.href != nil || t.unknown_["href"] != nil) {
+		errs = append(errs, &MissingPropertyError{Type: "Hashtag", Property: "href"})
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// ValidateStrict returns ValidationErrors listing what Validate does, and every unknown property of this Hashtag, every property with a value of none of the types it takes, and every inlined value that is itself invalid, or nil if there are none. It is meant for rejecting values that are not exactly as specified, such as those posted by clients
+func (t *Hashtag) ValidateStrict() (err error) {
+	errs, _ := t.Validate().(ValidationErrors)
+	for _, v := range t.attributedTo {
+		errs = v.strict(errs, "Hashtag", "attributedTo")
+	}
+	for _, v := range t.rel {
+		errs = v.strict(errs, "Hashtag", "rel")
+	}
+	if t.mediaType != nil {
+		errs = t.mediaType.strict(errs, "Hashtag", "mediaType")
+	}
+	for _, v := range t.name {
+		errs = v.strict(errs, "Hashtag", "name")
+	}
+	for _, v := range t.summary {
+		errs = v.strict(errs, "Hashtag", "summary")
+	}
+	if t.hreflang != nil {
+		errs = t.hreflang.strict(errs, "Hashtag", "hreflang")
+	}
+	if t.height != nil {
+		errs = t.height.strict(errs, "Hashtag", "height")
+	}
+	if t.width != nil {
+		errs = t.width.strict(errs, "Hashtag", "width")
+	}
+	for _, v := range t.preview {
+		errs = v.strict(errs, "Hashtag", "preview")
+	}
+	keys := make([]string, 0, len(t.unknown_))
+	for k := range t.unknown_ {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch k {
+		case "href", "id":
+			errs = append(errs, &MismatchedPropertyError{Type: "Hashtag", Property: k})
+		default:
+			errs = append(errs, &UnknownPropertyError{Type: "Hashtag", Property: k})
+		}
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// DeserializeStrict populates this Hashtag from a map[string]interface{} like Deserialize, then returns the problems ValidateStrict finds with it, if any
+func (t *Hashtag) DeserializeStrict(m map[string]interface{}) (err error) {
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	return t.ValidateStrict()
+
+}
+
+// DeserializeLenient populates this Hashtag from a map[string]interface{} like Deserialize, but parses the xsd:dateTime values of its properties, and of the values it contains, with ParseDateTimeLenient when ParseDateTimeStrict rejects them. It is meant for values from servers emitting malformed dates, and leaves the map unchanged
+func (t *Hashtag) DeserializeLenient(m map[string]interface{}) (err error) {
+	return t.Deserialize(lenientDateTimes(m).(map[string]interface{}))
+
+}
+
+// TryGetAttributedToObject returns the value GetAttributedToObject returns, or an AccessorError if IsAttributedToObject returns false
+func (t *Hashtag) TryGetAttributedToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToObject(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetAttributedToObject", Index: index}
+		return
+	}
+	return t.GetAttributedToObject(index), nil
+
+}
+
+// TryGetAttributedToLink returns the value GetAttributedToLink returns, or an AccessorError if IsAttributedToLink returns false
+func (t *Hashtag) TryGetAttributedToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToLink(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetAttributedToLink", Index: index}
+		return
+	}
+	return t.GetAttributedToLink(index), nil
+
+}
+
+// TryGetAttributedToIRI returns the value GetAttributedToIRI returns, or an AccessorError if IsAttributedToIRI returns false
+func (t *Hashtag) TryGetAttributedToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToIRI(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetAttributedToIRI", Index: index}
+		return
+	}
+	return t.GetAttributedToIRI(index), nil
+
+}
+
+// TryGetUnknownAttributedTo returns the value GetUnknownAttributedTo returns, or an AccessorError if HasUnknownAttributedTo returns false
+func (t *Hashtag) TryGetUnknownAttributedTo() (v interface{}, err error) {
+	if !t.HasUnknownAttributedTo() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownAttributedTo", Index: -1}
+		return
+	}
+	return t.GetUnknownAttributedTo(), nil
+
+}
+
+// TryGetHref returns the value GetHref returns, or an AccessorError if HasHref returns false
+func (t *Hashtag) TryGetHref() (v *url.URL, err error) {
+	if !t.HasHref() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetHref", Index: -1}
+		return
+	}
+	return t.GetHref(), nil
+
+}
+
+// TryGetUnknownHref returns the value GetUnknownHref returns, or an AccessorError if HasUnknownHref returns false
+func (t *Hashtag) TryGetUnknownHref() (v interface{}, err error) {
+	if !t.HasUnknownHref() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownHref", Index: -1}
+		return
+	}
+	return t.GetUnknownHref(), nil
+
+}
+
+// TryGetId returns the value GetId returns, or an AccessorError if HasId returns false
+func (t *Hashtag) TryGetId() (v *url.URL, err error) {
+	if !t.HasId() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetId", Index: -1}
+		return
+	}
+	return t.GetId(), nil
+
+}
+
+// TryGetUnknownId returns the value GetUnknownId returns, or an AccessorError if HasUnknownId returns false
+func (t *Hashtag) TryGetUnknownId() (v interface{}, err error) {
+	if !t.HasUnknownId() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownId", Index: -1}
+		return
+	}
+	return t.GetUnknownId(), nil
+
+}
+
+// TryGetRel returns the value GetRel returns, or an AccessorError if IsRel returns false
+func (t *Hashtag) TryGetRel(index int) (v string, err error) {
+	if index < 0 || index >= t.RelLen() || !t.IsRel(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetRel", Index: index}
+		return
+	}
+	return t.GetRel(index), nil
+
+}
+
+// TryGetRelIRI returns the value GetRelIRI returns, or an AccessorError if IsRelIRI returns false
+func (t *Hashtag) TryGetRelIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.RelLen() || !t.IsRelIRI(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetRelIRI", Index: index}
+		return
+	}
+	return t.GetRelIRI(index), nil
+
+}
+
+// TryGetUnknownRel returns the value GetUnknownRel returns, or an AccessorError if HasUnknownRel returns false
+func (t *Hashtag) TryGetUnknownRel() (v interface{}, err error) {
+	if !t.HasUnknownRel() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownRel", Index: -1}
+		return
+	}
+	return t.GetUnknownRel(), nil
+
+}
+
+// TryGetType returns the value GetType returns, or an AccessorError if the index is out of range
+func (t *Hashtag) TryGetType(index int) (v interface{}, err error) {
+	if index < 0 || index >= t.TypeLen() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetType", Index: index}
+		return
+	}
+	return t.GetType(index), nil
+
+}
+
+// TryGetMediaType returns the value GetMediaType returns, or an AccessorError if IsMediaType returns false
+func (t *Hashtag) TryGetMediaType() (v string, err error) {
+	if !t.IsMediaType() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetMediaType", Index: -1}
+		return
+	}
+	return t.GetMediaType(), nil
+
+}
+
+// TryGetMediaTypeIRI returns the value GetMediaTypeIRI returns, or an AccessorError if IsMediaTypeIRI returns false
+func (t *Hashtag) TryGetMediaTypeIRI() (v *url.URL, err error) {
+	if !t.IsMediaTypeIRI() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetMediaTypeIRI", Index: -1}
+		return
+	}
+	return t.GetMediaTypeIRI(), nil
+
+}
+
+// TryGetUnknownMediaType returns the value GetUnknownMediaType returns, or an AccessorError if HasUnknownMediaType returns false
+func (t *Hashtag) TryGetUnknownMediaType() (v interface{}, err error) {
+	if !t.HasUnknownMediaType() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownMediaType", Index: -1}
+		return
+	}
+	return t.GetUnknownMediaType(), nil
+
+}
+
+// TryGetNameString returns the value GetNameString returns, or an AccessorError if IsNameString returns false
+func (t *Hashtag) TryGetNameString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameString(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetNameString", Index: index}
+		return
+	}
+	return t.GetNameString(index), nil
+
+}
+
+// TryGetNameLangString returns the value GetNameLangString returns, or an AccessorError if IsNameLangString returns false
+func (t *Hashtag) TryGetNameLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameLangString(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetNameLangString", Index: index}
+		return
+	}
+	return t.GetNameLangString(index), nil
+
+}
+
+// TryGetNameIRI returns the value GetNameIRI returns, or an AccessorError if IsNameIRI returns false
+func (t *Hashtag) TryGetNameIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameIRI(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetNameIRI", Index: index}
+		return
+	}
+	return t.GetNameIRI(index), nil
+
+}
+
+// TryGetUnknownName returns the value GetUnknownName returns, or an AccessorError if HasUnknownName returns false
+func (t *Hashtag) TryGetUnknownName() (v interface{}, err error) {
+	if !t.HasUnknownName() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownName", Index: -1}
+		return
+	}
+	return t.GetUnknownName(), nil
+
+}
+
+// TryGetSummaryString returns the value GetSummaryString returns, or an AccessorError if IsSummaryString returns false
+func (t *Hashtag) TryGetSummaryString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryString(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetSummaryString", Index: index}
+		return
+	}
+	return t.GetSummaryString(index), nil
+
+}
+
+// TryGetSummaryLangString returns the value GetSummaryLangString returns, or an AccessorError if IsSummaryLangString returns false
+func (t *Hashtag) TryGetSummaryLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryLangString(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetSummaryLangString", Index: index}
+		return
+	}
+	return t.GetSummaryLangString(index), nil
+
+}
+
+// TryGetSummaryIRI returns the value GetSummaryIRI returns, or an AccessorError if IsSummaryIRI returns false
+func (t *Hashtag) TryGetSummaryIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryIRI(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetSummaryIRI", Index: index}
+		return
+	}
+	return t.GetSummaryIRI(index), nil
+
+}
+
+// TryGetUnknownSummary returns the value GetUnknownSummary returns, or an AccessorError if HasUnknownSummary returns false
+func (t *Hashtag) TryGetUnknownSummary() (v interface{}, err error) {
+	if !t.HasUnknownSummary() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownSummary", Index: -1}
+		return
+	}
+	return t.GetUnknownSummary(), nil
+
+}
+
+// TryGetHreflang returns the value GetHreflang returns, or an AccessorError if IsHreflang returns false
+func (t *Hashtag) TryGetHreflang() (v string, err error) {
+	if !t.IsHreflang() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetHreflang", Index: -1}
+		return
+	}
+	return t.GetHreflang(), nil
+
+}
+
+// TryGetHreflangIRI returns the value GetHreflangIRI returns, or an AccessorError if IsHreflangIRI returns false
+func (t *Hashtag) TryGetHreflangIRI() (v *url.URL, err error) {
+	if !t.IsHreflangIRI() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetHreflangIRI", Index: -1}
+		return
+	}
+	return t.GetHreflangIRI(), nil
+
+}
+
+// TryGetUnknownHreflang returns the value GetUnknownHreflang returns, or an AccessorError if HasUnknownHreflang returns false
+func (t *Hashtag) TryGetUnknownHreflang() (v interface{}, err error) {
+	if !t.HasUnknownHreflang() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownHreflang", Index: -1}
+		return
+	}
+	return t.GetUnknownHreflang(), nil
+
+}
+
+// TryGetHeight returns the value GetHeight returns, or an AccessorError if IsHeight returns false
+func (t *Hashtag) TryGetHeight() (v int64, err error) {
+	if !t.IsHeight() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetHeight", Index: -1}
+		return
+	}
+	return t.GetHeight(), nil
+
+}
+
+// TryGetHeightIRI returns the value GetHeightIRI returns, or an AccessorError if IsHeightIRI returns false
+func (t *Hashtag) TryGetHeightIRI() (v *url.URL, err error) {
+	if !t.IsHeightIRI() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetHeightIRI", Index: -1}
+		return
+	}
+	return t.GetHeightIRI(), nil
+
+}
+
+// TryGetUnknownHeight returns the value GetUnknownHeight returns, or an AccessorError if HasUnknownHeight returns false
+func (t *Hashtag) TryGetUnknownHeight() (v interface{}, err error) {
+	if !t.HasUnknownHeight() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownHeight", Index: -1}
+		return
+	}
+	return t.GetUnknownHeight(), nil
+
+}
+
+// TryGetWidth returns the value GetWidth returns, or an AccessorError if IsWidth returns false
+func (t *Hashtag) TryGetWidth() (v int64, err error) {
+	if !t.IsWidth() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetWidth", Index: -1}
+		return
+	}
+	return t.GetWidth(), nil
+
+}
+
+// TryGetWidthIRI returns the value GetWidthIRI returns, or an AccessorError if IsWidthIRI returns false
+func (t *Hashtag) TryGetWidthIRI() (v *url.URL, err error) {
+	if !t.IsWidthIRI() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetWidthIRI", Index: -1}
+		return
+	}
+	return t.GetWidthIRI(), nil
+
+}
+
+// TryGetUnknownWidth returns the value GetUnknownWidth returns, or an AccessorError if HasUnknownWidth returns false
+func (t *Hashtag) TryGetUnknownWidth() (v interface{}, err error) {
+	if !t.HasUnknownWidth() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownWidth", Index: -1}
+		return
+	}
+	return t.GetUnknownWidth(), nil
+
+}
+
+// TryGetPreviewObject returns the value GetPreviewObject returns, or an AccessorError if IsPreviewObject returns false
+func (t *Hashtag) TryGetPreviewObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewObject(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetPreviewObject", Index: index}
+		return
+	}
+	return t.GetPreviewObject(index), nil
+
+}
+
+// TryGetPreviewLink returns the value GetPreviewLink returns, or an AccessorError if IsPreviewLink returns false
+func (t *Hashtag) TryGetPreviewLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewLink(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetPreviewLink", Index: index}
+		return
+	}
+	return t.GetPreviewLink(index), nil
+
+}
+
+// TryGetPreviewIRI returns the value GetPreviewIRI returns, or an AccessorError if IsPreviewIRI returns false
+func (t *Hashtag) TryGetPreviewIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewIRI(index) {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetPreviewIRI", Index: index}
+		return
+	}
+	return t.GetPreviewIRI(index), nil
+
+}
+
+// TryGetUnknownPreview returns the value GetUnknownPreview returns, or an AccessorError if HasUnknownPreview returns false
+func (t *Hashtag) TryGetUnknownPreview() (v interface{}, err error) {
+	if !t.HasUnknownPreview() {
+		err = &AccessorError{Type: "Hashtag", Getter: "GetUnknownPreview", Index: -1}
+		return
+	}
+	return t.GetUnknownPreview(), nil
+
+}
+
+// WithAttributedToObject calls AppendAttributedToObject and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithAttributedToObject(v ObjectType) *Hashtag {
+	t.AppendAttributedToObject(v)
+	return t
+
+}
+
+// WithAttributedToLink calls AppendAttributedToLink and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithAttributedToLink(v LinkType) *Hashtag {
+	t.AppendAttributedToLink(v)
+	return t
+
+}
+
+// WithAttributedToIRI calls AppendAttributedToIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithAttributedToIRI(v *url.URL) *Hashtag {
+	t.AppendAttributedToIRI(v)
+	return t
+
+}
+
+// WithUnknownAttributedTo calls SetUnknownAttributedTo and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownAttributedTo(i interface{}) *Hashtag {
+	t.SetUnknownAttributedTo(i)
+	return t
+
+}
+
+// WithHref calls SetHref and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithHref(v *url.URL) *Hashtag {
+	t.SetHref(v)
+	return t
+
+}
+
+// WithUnknownHref calls SetUnknownHref and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownHref(i interface{}) *Hashtag {
+	t.SetUnknownHref(i)
+	return t
+
+}
+
+// WithId calls SetId and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithId(v *url.URL) *Hashtag {
+	t.SetId(v)
+	return t
+
+}
+
+// WithUnknownId calls SetUnknownId and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownId(i interface{}) *Hashtag {
+	t.SetUnknownId(i)
+	return t
+
+}
+
+// WithRel calls AppendRel and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithRel(v string) *Hashtag {
+	t.AppendRel(v)
+	return t
+
+}
+
+// WithRelIRI calls AppendRelIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithRelIRI(v *url.URL) *Hashtag {
+	t.AppendRelIRI(v)
+	return t
+
+}
+
+// WithUnknownRel calls SetUnknownRel and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownRel(i interface{}) *Hashtag {
+	t.SetUnknownRel(i)
+	return t
+
+}
+
+// WithType calls AppendType and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithType(v interface{}) *Hashtag {
+	t.AppendType(v)
+	return t
+
+}
+
+// WithMediaType calls SetMediaType and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithMediaType(v string) *Hashtag {
+	t.SetMediaType(v)
+	return t
+
+}
+
+// WithMediaTypeIRI calls SetMediaTypeIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithMediaTypeIRI(v *url.URL) *Hashtag {
+	t.SetMediaTypeIRI(v)
+	return t
+
+}
+
+// WithUnknownMediaType calls SetUnknownMediaType and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownMediaType(i interface{}) *Hashtag {
+	t.SetUnknownMediaType(i)
+	return t
+
+}
+
+// WithNameString calls AppendNameString and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithNameString(v string) *Hashtag {
+	t.AppendNameString(v)
+	return t
+
+}
+
+// WithNameLangString calls AppendNameLangString and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithNameLangString(v string) *Hashtag {
+	t.AppendNameLangString(v)
+	return t
+
+}
+
+// WithNameIRI calls AppendNameIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithNameIRI(v *url.URL) *Hashtag {
+	t.AppendNameIRI(v)
+	return t
+
+}
+
+// WithUnknownName calls SetUnknownName and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownName(i interface{}) *Hashtag {
+	t.SetUnknownName(i)
+	return t
+
+}
+
+// WithNameMap calls SetNameMap and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithNameMap(l string, v string) *Hashtag {
+	t.SetNameMap(l, v)
+	return t
+
+}
+
+// WithNameLanguage calls SetNameLanguage and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithNameLanguage(tag string, v string) *Hashtag {
+	t.SetNameLanguage(tag, v)
+	return t
+
+}
+
+// WithSummaryString calls AppendSummaryString and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithSummaryString(v string) *Hashtag {
+	t.AppendSummaryString(v)
+	return t
+
+}
+
+// WithSummaryLangString calls AppendSummaryLangString and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithSummaryLangString(v string) *Hashtag {
+	t.AppendSummaryLangString(v)
+	return t
+
+}
+
+// WithSummaryIRI calls AppendSummaryIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithSummaryIRI(v *url.URL) *Hashtag {
+	t.AppendSummaryIRI(v)
+	return t
+
+}
+
+// WithUnknownSummary calls SetUnknownSummary and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownSummary(i interface{}) *Hashtag {
+	t.SetUnknownSummary(i)
+	return t
+
+}
+
+// WithSummaryMap calls SetSummaryMap and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithSummaryMap(l string, v string) *Hashtag {
+	t.SetSummaryMap(l, v)
+	return t
+
+}
+
+// WithSummaryLanguage calls SetSummaryLanguage and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithSummaryLanguage(tag string, v string) *Hashtag {
+	t.SetSummaryLanguage(tag, v)
+	return t
+
+}
+
+// WithHreflang calls SetHreflang and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithHreflang(v string) *Hashtag {
+	t.SetHreflang(v)
+	return t
+
+}
+
+// WithHreflangIRI calls SetHreflangIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithHreflangIRI(v *url.URL) *Hashtag {
+	t.SetHreflangIRI(v)
+	return t
+
+}
+
+// WithUnknownHreflang calls SetUnknownHreflang and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownHreflang(i interface{}) *Hashtag {
+	t.SetUnknownHreflang(i)
+	return t
+
+}
+
+// WithHeight calls SetHeight and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithHeight(v int64) *Hashtag {
+	t.SetHeight(v)
+	return t
+
+}
+
+// WithHeightIRI calls SetHeightIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithHeightIRI(v *url.URL) *Hashtag {
+	t.SetHeightIRI(v)
+	return t
+
+}
+
+// WithUnknownHeight calls SetUnknownHeight and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownHeight(i interface{}) *Hashtag {
+	t.SetUnknownHeight(i)
+	return t
+
+}
+
+// WithWidth calls SetWidth and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithWidth(v int64) *Hashtag {
+	t.SetWidth(v)
+	return t
+
+}
+
+// WithWidthIRI calls SetWidthIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithWidthIRI(v *url.URL) *Hashtag {
+	t.SetWidthIRI(v)
+	return t
+
+}
+
+// WithUnknownWidth calls SetUnknownWidth and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownWidth(i interface{}) *Hashtag {
+	t.SetUnknownWidth(i)
+	return t
+
+}
+
+// WithPreviewObject calls AppendPreviewObject and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithPreviewObject(v ObjectType) *Hashtag {
+	t.AppendPreviewObject(v)
+	return t
+
+}
+
+// WithPreviewLink calls AppendPreviewLink and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithPreviewLink(v LinkType) *Hashtag {
+	t.AppendPreviewLink(v)
+	return t
+
+}
+
+// WithPreviewIRI calls AppendPreviewIRI and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithPreviewIRI(v *url.URL) *Hashtag {
+	t.AppendPreviewIRI(v)
+	return t
+
+}
+
+// WithUnknownPreview calls SetUnknownPreview and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownPreview(i interface{}) *Hashtag {
+	t.SetUnknownPreview(i)
+	return t
+
+}
+
+// WithUnknownProperty calls SetUnknownProperty and returns this Hashtag, so that calls can be chained
+func (t *Hashtag) WithUnknownProperty(k string, i interface{}) *Hashtag {
+	t.SetUnknownProperty(k, i)
+	return t
+
+}
+
+// markPresent_ sets or clears the bit of a member in the presence bitset.
+func (t *Hashtag) markPresent_(i uint, set bool) {
+	if set {
+		t.present_[i/64] |= 1 << (i % 64)
+	} else {
+		t.present_[i/64] &^= 1 << (i % 64)
+	}
+
+}
+
+// markAllPresent_ sets the bits of every member in the presence bitset that is set, and clears the others.
+func (t *Hashtag) markAllPresent_() {
+	t.markPresent_(0, t.attributedTo != nil)
+	t.markPresent_(1, t.href != nil)
+	t.markPresent_(2, t.id != nil)
+	t.markPresent_(3, t.rel != nil)
+	t.markPresent_(4, t.typeName != nil)
+	t.markPresent_(5, t.mediaType != nil)
+	t.markPresent_(6, t.name != nil)
+	t.markPresent_(7, t.nameMap != nil)
+	t.markPresent_(8, t.summary != nil)
+	t.markPresent_(9, t.summaryMap != nil)
+	t.markPresent_(10, t.hreflang != nil)
+	t.markPresent_(11, t.height != nil)
+	t.markPresent_(12, t.width != nil)
+	t.markPresent_(13, t.preview != nil)
+
+}
@@ -7,18 +7,83 @@ import (
 	"time"
 )
 
-// repliesIntermediateType will only have one of its values set at most
-type repliesIntermediateType struct {
+// accuracyIntermediateType will only have one of its values set at most
+type accuracyIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionType type for replies property
-	Collection CollectionType
-	// Stores possible *url.URL type for replies property
+	// Stores possible *float64 type for accuracy property
+	float *float64
+	// Stores possible *url.URL type for accuracy property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *repliesIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *accuracyIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.float, err = floatDeserialize(i)
+			if err != nil {
+				t.float = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *accuracyIntermediateType) Serialize() (i interface{}, err error) {
+	if t.float != nil {
+		i = floatSerialize(*t.float)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *accuracyIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// actorIntermediateType will only have one of its values set at most
+type actorIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for actor property
+	Object ObjectType
+	// Stores possible LinkType type for actor property
+	Link LinkType
+	// Stores possible *url.URL type for actor property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *actorIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -34,8 +99,17 @@ func (t *repliesIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
-						err = t.Collection.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
 						matched = true
 						break
 					}
@@ -62,9 +136,13 @@ func (t *repliesIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *repliesIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Collection != nil {
-		i, err = t.Collection.Serialize()
+func (t *actorIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
 	if t.IRI != nil {
@@ -75,26 +153,45 @@ func (t *repliesIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// startTimeIntermediateType will only have one of its values set at most
-type startTimeIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *actorIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// altitudeIntermediateType will only have one of its values set at most
+type altitudeIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *time.Time type for startTime property
-	dateTime *time.Time
-	// Stores possible *url.URL type for startTime property
+	// Stores possible *float64 type for altitude property
+	float *float64
+	// Stores possible *url.URL type for altitude property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *startTimeIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *altitudeIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.dateTime, err = dateTimeDeserialize(i)
+			t.float, err = floatDeserialize(i)
 			if err != nil {
-				t.dateTime = nil
+				t.float = nil
 			} else {
 				matched = true
 			}
@@ -116,9 +213,9 @@ func (t *startTimeIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *startTimeIntermediateType) Serialize() (i interface{}, err error) {
-	if t.dateTime != nil {
-		i = dateTimeSerialize(*t.dateTime)
+func (t *altitudeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.float != nil {
+		i = floatSerialize(*t.float)
 		return
 	}
 	if t.IRI != nil {
@@ -129,18 +226,29 @@ func (t *startTimeIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// inboxIntermediateType will only have one of its values set at most
-type inboxIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *altitudeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// anyOfIntermediateType will only have one of its values set at most
+type anyOfIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible OrderedCollectionType type for inbox property
-	OrderedCollection OrderedCollectionType
-	// Stores possible *url.URL type for inbox property
-	anyURI *url.URL
+	// Stores possible ObjectType type for anyOf property
+	Object ObjectType
+	// Stores possible LinkType type for anyOf property
+	Link LinkType
+	// Stores possible *url.URL type for anyOf property
+	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *inboxIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *anyOfIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -156,8 +264,17 @@ func (t *inboxIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
-						err = t.OrderedCollection.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
 						matched = true
 						break
 					}
@@ -168,9 +285,9 @@ func (t *inboxIntermediateType) Deserialize(i interface{}) (err error) {
 		}
 	} else if i != nil {
 		if !matched {
-			t.anyURI, err = anyURIDeserialize(i)
+			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
-				t.anyURI = nil
+				t.IRI = nil
 			} else {
 				matched = true
 			}
@@ -184,33 +301,56 @@ func (t *inboxIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *inboxIntermediateType) Serialize() (i interface{}, err error) {
-	if t.OrderedCollection != nil {
-		i, err = t.OrderedCollection.Serialize()
+func (t *anyOfIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
-	if t.anyURI != nil {
-		i = anyURISerialize(t.anyURI)
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// originIntermediateType will only have one of its values set at most
-type originIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *anyOfIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// attachmentIntermediateType will only have one of its values set at most
+type attachmentIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for origin property
+	// Stores possible ObjectType type for attachment property
 	Object ObjectType
-	// Stores possible LinkType type for origin property
+	// Stores possible LinkType type for attachment property
 	Link LinkType
-	// Stores possible *url.URL type for origin property
+	// Stores possible *url.URL type for attachment property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *originIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *attachmentIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -263,7 +403,7 @@ func (t *originIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *originIntermediateType) Serialize() (i interface{}, err error) {
+func (t *attachmentIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -280,22 +420,39 @@ func (t *originIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// firstIntermediateType will only have one of its values set at most
-type firstIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *attachmentIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// attributedToIntermediateType will only have one of its values set at most
+type attributedToIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionPageType type for first property
-	CollectionPage CollectionPageType
-	// Stores possible LinkType type for first property
+	// Stores possible ObjectType type for attributedTo property
+	Object ObjectType
+	// Stores possible LinkType type for attributedTo property
 	Link LinkType
-	// Stores possible *url.URL type for first property
+	// Stores possible *url.URL type for attributedTo property
 	IRI *url.URL
-	// Stores possible OrderedCollectionPageType type for first property
-	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *firstIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *attributedToIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -311,8 +468,8 @@ func (t *firstIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
-						err = t.CollectionPage.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
 						matched = true
 						break
 					}
@@ -327,15 +484,6 @@ func (t *firstIntermediateType) Deserialize(i interface{}) (err error) {
 					}
 				}
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
-						err = t.OrderedCollectionPage.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
 		} else {
 			t.unknown_ = m
 		}
@@ -357,9 +505,9 @@ func (t *firstIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *firstIntermediateType) Serialize() (i interface{}, err error) {
-	if t.CollectionPage != nil {
-		i, err = t.CollectionPage.Serialize()
+func (t *attributedToIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
 	if t.Link != nil {
@@ -370,28 +518,43 @@ func (t *firstIntermediateType) Serialize() (i interface{}, err error) {
 		i = IRISerialize(t.IRI)
 		return
 	}
-	if t.OrderedCollectionPage != nil {
-		i, err = t.OrderedCollectionPage.Serialize()
-		return
-	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// actorIntermediateType will only have one of its values set at most
-type actorIntermediateType struct {
-	// An unknown value.
-	unknown_ interface{}
-	// Stores possible ObjectType type for actor property
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *attributedToIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// audienceIntermediateType will only have one of its values set at most
+type audienceIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for audience property
 	Object ObjectType
-	// Stores possible LinkType type for actor property
+	// Stores possible LinkType type for audience property
 	Link LinkType
-	// Stores possible *url.URL type for actor property
+	// Stores possible *url.URL type for audience property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *actorIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *audienceIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -444,7 +607,7 @@ func (t *actorIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *actorIntermediateType) Serialize() (i interface{}, err error) {
+func (t *audienceIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -461,22 +624,39 @@ func (t *actorIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// currentIntermediateType will only have one of its values set at most
-type currentIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *audienceIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// bccIntermediateType will only have one of its values set at most
+type bccIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionPageType type for current property
-	CollectionPage CollectionPageType
-	// Stores possible LinkType type for current property
+	// Stores possible ObjectType type for bcc property
+	Object ObjectType
+	// Stores possible LinkType type for bcc property
 	Link LinkType
-	// Stores possible *url.URL type for current property
+	// Stores possible *url.URL type for bcc property
 	IRI *url.URL
-	// Stores possible OrderedCollectionPageType type for current property
-	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *currentIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *bccIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -492,8 +672,8 @@ func (t *currentIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
-						err = t.CollectionPage.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
 						matched = true
 						break
 					}
@@ -508,15 +688,6 @@ func (t *currentIntermediateType) Deserialize(i interface{}) (err error) {
 					}
 				}
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
-						err = t.OrderedCollectionPage.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
 		} else {
 			t.unknown_ = m
 		}
@@ -538,9 +709,9 @@ func (t *currentIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *currentIntermediateType) Serialize() (i interface{}, err error) {
-	if t.CollectionPage != nil {
-		i, err = t.CollectionPage.Serialize()
+func (t *bccIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
 	if t.Link != nil {
@@ -551,82 +722,43 @@ func (t *currentIntermediateType) Serialize() (i interface{}, err error) {
 		i = IRISerialize(t.IRI)
 		return
 	}
-	if t.OrderedCollectionPage != nil {
-		i, err = t.OrderedCollectionPage.Serialize()
-		return
-	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// latitudeIntermediateType will only have one of its values set at most
-type latitudeIntermediateType struct {
-	// An unknown value.
-	unknown_ interface{}
-	// Stores possible *float64 type for latitude property
-	float *float64
-	// Stores possible *url.URL type for latitude property
-	IRI *url.URL
-}
-
-// Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *latitudeIntermediateType) Deserialize(i interface{}) (err error) {
-	matched := false
-	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.float, err = floatDeserialize(i)
-			if err != nil {
-				t.float = nil
-			} else {
-				matched = true
-			}
-		}
-		if !matched {
-			t.IRI, err = IRIDeserialize(i)
-			if err != nil {
-				t.IRI = nil
-			} else {
-				matched = true
-			}
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *bccIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
 		}
 	}
-	if !matched {
-		t.unknown_ = unknownValueDeserialize(i)
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
 	}
-	return
-
-}
+	return errs
 
-// Serialize turns this object into an interface{}.
-func (t *latitudeIntermediateType) Serialize() (i interface{}, err error) {
-	if t.float != nil {
-		i = floatSerialize(*t.float)
-		return
-	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
-		return
-	}
-	i = unknownValueSerialize(t.unknown_)
-	return
 }
 
-// iconIntermediateType will only have one of its values set at most
-type iconIntermediateType struct {
+// btoIntermediateType will only have one of its values set at most
+type btoIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ImageType type for icon property
-	Image ImageType
-	// Stores possible LinkType type for icon property
+	// Stores possible ObjectType type for bto property
+	Object ObjectType
+	// Stores possible LinkType type for bto property
 	Link LinkType
-	// Stores possible *url.URL type for icon property
+	// Stores possible *url.URL type for bto property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *iconIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *btoIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -642,8 +774,8 @@ func (t *iconIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Image, ok = resolveObject(kind).(ImageType); t.Image != nil && ok {
-						err = t.Image.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
 						matched = true
 						break
 					}
@@ -679,9 +811,9 @@ func (t *iconIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *iconIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Image != nil {
-		i, err = t.Image.Serialize()
+func (t *btoIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
 	if t.Link != nil {
@@ -696,20 +828,39 @@ func (t *iconIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// btoIntermediateType will only have one of its values set at most
-type btoIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *btoIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// ccIntermediateType will only have one of its values set at most
+type ccIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for bto property
+	// Stores possible ObjectType type for cc property
 	Object ObjectType
-	// Stores possible LinkType type for bto property
+	// Stores possible LinkType type for cc property
 	Link LinkType
-	// Stores possible *url.URL type for bto property
+	// Stores possible *url.URL type for cc property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *btoIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *ccIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -762,7 +913,7 @@ func (t *btoIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *btoIntermediateType) Serialize() (i interface{}, err error) {
+func (t *ccIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -779,74 +930,43 @@ func (t *btoIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// mediaTypeIntermediateType will only have one of its values set at most
-type mediaTypeIntermediateType struct {
-	// An unknown value.
-	unknown_ interface{}
-	// Stores possible *string type for mediaType property
-	mimeMediaTypeValue *string
-	// Stores possible *url.URL type for mediaType property
-	IRI *url.URL
-}
-
-// Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *mediaTypeIntermediateType) Deserialize(i interface{}) (err error) {
-	matched := false
-	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.mimeMediaTypeValue, err = mimeMediaTypeValueDeserialize(i)
-			if err != nil {
-				t.mimeMediaTypeValue = nil
-			} else {
-				matched = true
-			}
-		}
-		if !matched {
-			t.IRI, err = IRIDeserialize(i)
-			if err != nil {
-				t.IRI = nil
-			} else {
-				matched = true
-			}
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *ccIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
 		}
 	}
-	if !matched {
-		t.unknown_ = unknownValueDeserialize(i)
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
 	}
-	return
-
-}
+	return errs
 
-// Serialize turns this object into an interface{}.
-func (t *mediaTypeIntermediateType) Serialize() (i interface{}, err error) {
-	if t.mimeMediaTypeValue != nil {
-		i = mimeMediaTypeValueSerialize(*t.mimeMediaTypeValue)
-		return
-	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
-		return
-	}
-	i = unknownValueSerialize(t.unknown_)
-	return
 }
 
-// resultIntermediateType will only have one of its values set at most
-type resultIntermediateType struct {
+// closedIntermediateType will only have one of its values set at most
+type closedIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for result property
+	// Stores possible *time.Time type for closed property
+	dateTime *time.Time
+	// Stores possible *bool type for closed property
+	boolean *bool
+	// Stores possible ObjectType type for closed property
 	Object ObjectType
-	// Stores possible LinkType type for result property
+	// Stores possible LinkType type for closed property
 	Link LinkType
-	// Stores possible *url.URL type for result property
+	// Stores possible *url.URL type for closed property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *resultIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *closedIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -882,6 +1002,22 @@ func (t *resultIntermediateType) Deserialize(i interface{}) (err error) {
 			t.unknown_ = m
 		}
 	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.boolean, err = booleanDeserialize(i)
+			if err != nil {
+				t.boolean = nil
+			} else {
+				matched = true
+			}
+		}
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -899,7 +1035,15 @@ func (t *resultIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *resultIntermediateType) Serialize() (i interface{}, err error) {
+func (t *closedIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
+		return
+	}
+	if t.boolean != nil {
+		i = booleanSerialize(*t.boolean)
+		return
+	}
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -916,20 +1060,39 @@ func (t *resultIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// nameIntermediateType will only have one of its values set at most
-type nameIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *closedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// contentIntermediateType will only have one of its values set at most
+type contentIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *string type for name property
+	// Stores possible *string type for content property
 	stringName *string
-	// Stores possible *string type for name property
+	// Stores possible *string type for content property
 	langString *string
-	// Stores possible *url.URL type for name property
+	// Stores possible *url.URL type for content property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *nameIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *contentIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
@@ -967,7 +1130,7 @@ func (t *nameIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *nameIntermediateType) Serialize() (i interface{}, err error) {
+func (t *contentIntermediateType) Serialize() (i interface{}, err error) {
 	if t.stringName != nil {
 		i = stringSerialize(*t.stringName)
 		return
@@ -984,20 +1147,29 @@ func (t *nameIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// itemsIntermediateType will only have one of its values set at most
-type itemsIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *contentIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// contextIntermediateType will only have one of its values set at most
+type contextIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for items property
+	// Stores possible ObjectType type for context property
 	Object ObjectType
-	// Stores possible LinkType type for items property
+	// Stores possible LinkType type for context property
 	Link LinkType
-	// Stores possible *url.URL type for items property
+	// Stores possible *url.URL type for context property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *itemsIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *contextIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -1050,7 +1222,7 @@ func (t *itemsIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *itemsIntermediateType) Serialize() (i interface{}, err error) {
+func (t *contextIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -1067,20 +1239,41 @@ func (t *itemsIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// partOfIntermediateType will only have one of its values set at most
-type partOfIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *contextIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// currentIntermediateType will only have one of its values set at most
+type currentIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible LinkType type for partOf property
+	// Stores possible CollectionPageType type for current property
+	CollectionPage CollectionPageType
+	// Stores possible LinkType type for current property
 	Link LinkType
-	// Stores possible CollectionType type for partOf property
-	Collection CollectionType
-	// Stores possible *url.URL type for partOf property
+	// Stores possible *url.URL type for current property
 	IRI *url.URL
+	// Stores possible OrderedCollectionPageType type for current property
+	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *partOfIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *currentIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -1094,6 +1287,15 @@ func (t *partOfIntermediateType) Deserialize(i interface{}) (err error) {
 			} else if typeString, ok := tv.(string); ok {
 				types = append(types, typeString)
 			}
+			if !matched {
+				for _, kind := range types {
+					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
+						err = t.CollectionPage.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
 			if !matched {
 				for _, kind := range types {
 					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
@@ -1105,8 +1307,8 @@ func (t *partOfIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
-						err = t.Collection.Deserialize(m)
+					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
+						err = t.OrderedCollectionPage.Deserialize(m)
 						matched = true
 						break
 					}
@@ -1133,35 +1335,63 @@ func (t *partOfIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *partOfIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+func (t *currentIntermediateType) Serialize() (i interface{}, err error) {
+	if t.CollectionPage != nil {
+		i, err = t.CollectionPage.Serialize()
 		return
 	}
-	if t.Collection != nil {
-		i, err = t.Collection.Serialize()
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
 	if t.IRI != nil {
 		i = IRISerialize(t.IRI)
 		return
 	}
+	if t.OrderedCollectionPage != nil {
+		i, err = t.OrderedCollectionPage.Serialize()
+		return
+	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// updatedIntermediateType will only have one of its values set at most
-type updatedIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *currentIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.CollectionPage != nil {
+		if err := t.CollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollectionPage != nil {
+		if err := t.OrderedCollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// deletedIntermediateType will only have one of its values set at most
+type deletedIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *time.Time type for updated property
+	// Stores possible *time.Time type for deleted property
 	dateTime *time.Time
-	// Stores possible *url.URL type for updated property
+	// Stores possible *url.URL type for deleted property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *updatedIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *deletedIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
@@ -1191,7 +1421,7 @@ func (t *updatedIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *updatedIntermediateType) Serialize() (i interface{}, err error) {
+func (t *deletedIntermediateType) Serialize() (i interface{}, err error) {
 	if t.dateTime != nil {
 		i = dateTimeSerialize(*t.dateTime)
 		return
@@ -1204,30 +1434,53 @@ func (t *updatedIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// accuracyIntermediateType will only have one of its values set at most
-type accuracyIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *deletedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// describesIntermediateType will only have one of its values set at most
+type describesIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *float64 type for accuracy property
-	float *float64
-	// Stores possible *url.URL type for accuracy property
+	// Stores possible ObjectType type for describes property
+	Object ObjectType
+	// Stores possible *url.URL type for describes property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *accuracyIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *describesIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.float, err = floatDeserialize(i)
-			if err != nil {
-				t.float = nil
-			} else {
-				matched = true
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
 			}
+		} else {
+			t.unknown_ = m
 		}
+	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -1245,9 +1498,9 @@ func (t *accuracyIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *accuracyIntermediateType) Serialize() (i interface{}, err error) {
-	if t.float != nil {
-		i = floatSerialize(*t.float)
+func (t *describesIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
 	if t.IRI != nil {
@@ -1258,26 +1511,40 @@ func (t *accuracyIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// longitudeIntermediateType will only have one of its values set at most
-type longitudeIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *describesIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// durationIntermediateType will only have one of its values set at most
+type durationIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *float64 type for longitude property
-	float *float64
-	// Stores possible *url.URL type for longitude property
+	// Stores possible *time.Duration type for duration property
+	duration *time.Duration
+	// Stores possible *url.URL type for duration property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *longitudeIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *durationIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.float, err = floatDeserialize(i)
+			t.duration, err = durationDeserialize(i)
 			if err != nil {
-				t.float = nil
+				t.duration = nil
 			} else {
 				matched = true
 			}
@@ -1299,9 +1566,9 @@ func (t *longitudeIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *longitudeIntermediateType) Serialize() (i interface{}, err error) {
-	if t.float != nil {
-		i = floatSerialize(*t.float)
+func (t *durationIntermediateType) Serialize() (i interface{}, err error) {
+	if t.duration != nil {
+		i = durationSerialize(*t.duration)
 		return
 	}
 	if t.IRI != nil {
@@ -1312,55 +1579,39 @@ func (t *longitudeIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// generatorIntermediateType will only have one of its values set at most
-type generatorIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *durationIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// endTimeIntermediateType will only have one of its values set at most
+type endTimeIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for generator property
-	Object ObjectType
-	// Stores possible LinkType type for generator property
-	Link LinkType
-	// Stores possible *url.URL type for generator property
+	// Stores possible *time.Time type for endTime property
+	dateTime *time.Time
+	// Stores possible *url.URL type for endTime property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *generatorIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *endTimeIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -1378,13 +1629,9 @@ func (t *generatorIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *generatorIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+func (t *endTimeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
 		return
 	}
 	if t.IRI != nil {
@@ -1395,20 +1642,27 @@ func (t *generatorIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// sharesIntermediateType will only have one of its values set at most
-type sharesIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *endTimeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// endpointsIntermediateType will only have one of its values set at most
+type endpointsIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionType type for shares property
-	Collection CollectionType
-	// Stores possible OrderedCollectionType type for shares property
-	OrderedCollection OrderedCollectionType
-	// Stores possible *url.URL type for shares property
-	anyURI *url.URL
+	// Stores possible ObjectType type for endpoints property
+	Object ObjectType
+	// Stores possible *url.URL type for endpoints property
+	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *sharesIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *endpointsIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -1424,17 +1678,8 @@ func (t *sharesIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
-						err = t.Collection.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
-						err = t.OrderedCollection.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
 						matched = true
 						break
 					}
@@ -1444,64 +1689,6 @@ func (t *sharesIntermediateType) Deserialize(i interface{}) (err error) {
 			t.unknown_ = m
 		}
 	} else if i != nil {
-		if !matched {
-			t.anyURI, err = anyURIDeserialize(i)
-			if err != nil {
-				t.anyURI = nil
-			} else {
-				matched = true
-			}
-		}
-	}
-	if !matched {
-		t.unknown_ = unknownValueDeserialize(i)
-	}
-	return
-
-}
-
-// Serialize turns this object into an interface{}.
-func (t *sharesIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Collection != nil {
-		i, err = t.Collection.Serialize()
-		return
-	}
-	if t.OrderedCollection != nil {
-		i, err = t.OrderedCollection.Serialize()
-		return
-	}
-	if t.anyURI != nil {
-		i = anyURISerialize(t.anyURI)
-		return
-	}
-	i = unknownValueSerialize(t.unknown_)
-	return
-}
-
-// hreflangIntermediateType will only have one of its values set at most
-type hreflangIntermediateType struct {
-	// An unknown value.
-	unknown_ interface{}
-	// Stores possible *string type for hreflang property
-	bcp47LanguageTag *string
-	// Stores possible *url.URL type for hreflang property
-	IRI *url.URL
-}
-
-// Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *hreflangIntermediateType) Deserialize(i interface{}) (err error) {
-	matched := false
-	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.bcp47LanguageTag, err = bcp47LanguageTagDeserialize(i)
-			if err != nil {
-				t.bcp47LanguageTag = nil
-			} else {
-				matched = true
-			}
-		}
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -1519,9 +1706,9 @@ func (t *hreflangIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *hreflangIntermediateType) Serialize() (i interface{}, err error) {
-	if t.bcp47LanguageTag != nil {
-		i = bcp47LanguageTagSerialize(*t.bcp47LanguageTag)
+func (t *endpointsIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
 	if t.IRI != nil {
@@ -1532,74 +1719,36 @@ func (t *hreflangIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// startIndexIntermediateType will only have one of its values set at most
-type startIndexIntermediateType struct {
-	// An unknown value.
-	unknown_ interface{}
-	// Stores possible *int64 type for startIndex property
-	nonNegativeInteger *int64
-	// Stores possible *url.URL type for startIndex property
-	IRI *url.URL
-}
-
-// Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *startIndexIntermediateType) Deserialize(i interface{}) (err error) {
-	matched := false
-	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
-			if err != nil {
-				t.nonNegativeInteger = nil
-			} else {
-				matched = true
-			}
-		}
-		if !matched {
-			t.IRI, err = IRIDeserialize(i)
-			if err != nil {
-				t.IRI = nil
-			} else {
-				matched = true
-			}
-		}
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *endpointsIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
 	}
-	if !matched {
-		t.unknown_ = unknownValueDeserialize(i)
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
 	}
-	return
+	return errs
 
 }
 
-// Serialize turns this object into an interface{}.
-func (t *startIndexIntermediateType) Serialize() (i interface{}, err error) {
-	if t.nonNegativeInteger != nil {
-		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
-		return
-	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
-		return
-	}
-	i = unknownValueSerialize(t.unknown_)
-	return
-}
-
-// anyOfIntermediateType will only have one of its values set at most
-type anyOfIntermediateType struct {
+// firstIntermediateType will only have one of its values set at most
+type firstIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for anyOf property
-	Object ObjectType
-	// Stores possible LinkType type for anyOf property
+	// Stores possible CollectionPageType type for first property
+	CollectionPage CollectionPageType
+	// Stores possible LinkType type for first property
 	Link LinkType
-	// Stores possible *url.URL type for anyOf property
+	// Stores possible *url.URL type for first property
 	IRI *url.URL
+	// Stores possible OrderedCollectionPageType type for first property
+	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *anyOfIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *firstIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -1615,8 +1764,8 @@ func (t *anyOfIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
+					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
+						err = t.CollectionPage.Deserialize(m)
 						matched = true
 						break
 					}
@@ -1631,6 +1780,15 @@ func (t *anyOfIntermediateType) Deserialize(i interface{}) (err error) {
 					}
 				}
 			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
+						err = t.OrderedCollectionPage.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
 		} else {
 			t.unknown_ = m
 		}
@@ -1652,9 +1810,9 @@ func (t *anyOfIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *anyOfIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *firstIntermediateType) Serialize() (i interface{}, err error) {
+	if t.CollectionPage != nil {
+		i, err = t.CollectionPage.Serialize()
 		return
 	}
 	if t.Link != nil {
@@ -1665,22 +1823,52 @@ func (t *anyOfIntermediateType) Serialize() (i interface{}, err error) {
 		i = IRISerialize(t.IRI)
 		return
 	}
+	if t.OrderedCollectionPage != nil {
+		i, err = t.OrderedCollectionPage.Serialize()
+		return
+	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// describesIntermediateType will only have one of its values set at most
-type describesIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *firstIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.CollectionPage != nil {
+		if err := t.CollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollectionPage != nil {
+		if err := t.OrderedCollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// followersIntermediateType will only have one of its values set at most
+type followersIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for describes property
-	Object ObjectType
-	// Stores possible *url.URL type for describes property
-	IRI *url.URL
+	// Stores possible CollectionType type for followers property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for followers property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for followers property
+	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *describesIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *followersIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -1696,8 +1884,17 @@ func (t *describesIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
 						matched = true
 						break
 					}
@@ -1708,9 +1905,9 @@ func (t *describesIntermediateType) Deserialize(i interface{}) (err error) {
 		}
 	} else if i != nil {
 		if !matched {
-			t.IRI, err = IRIDeserialize(i)
+			t.anyURI, err = anyURIDeserialize(i)
 			if err != nil {
-				t.IRI = nil
+				t.anyURI = nil
 			} else {
 				matched = true
 			}
@@ -1724,31 +1921,56 @@ func (t *describesIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *describesIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *followersIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
 		return
 	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// outboxIntermediateType will only have one of its values set at most
-type outboxIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *followersIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// followingIntermediateType will only have one of its values set at most
+type followingIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible OrderedCollectionType type for outbox property
+	// Stores possible CollectionType type for following property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for following property
 	OrderedCollection OrderedCollectionType
-	// Stores possible *url.URL type for outbox property
+	// Stores possible *url.URL type for following property
 	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *outboxIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *followingIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -1762,6 +1984,15 @@ func (t *outboxIntermediateType) Deserialize(i interface{}) (err error) {
 			} else if typeString, ok := tv.(string); ok {
 				types = append(types, typeString)
 			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
 			if !matched {
 				for _, kind := range types {
 					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
@@ -1792,7 +2023,11 @@ func (t *outboxIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *outboxIntermediateType) Serialize() (i interface{}, err error) {
+func (t *followingIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
+		return
+	}
 	if t.OrderedCollection != nil {
 		i, err = t.OrderedCollection.Serialize()
 		return
@@ -1805,34 +2040,77 @@ func (t *outboxIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// unitsIntermediateType will only have one of its values set at most
-type unitsIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *followingIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// formerTypeIntermediateType will only have one of its values set at most
+type formerTypeIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *string type for units property
-	unitsValue *string
-	// Stores possible *url.URL type for units property
-	anyURI *url.URL
+	// Stores possible *string type for formerType property
+	stringName *string
+	// Stores possible ObjectType type for formerType property
+	Object ObjectType
+	// Stores possible *url.URL type for formerType property
+	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *unitsIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *formerTypeIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
 	} else if i != nil {
 		if !matched {
-			t.unitsValue, err = unitsValueDeserialize(i)
+			t.stringName, err = stringDeserialize(i)
 			if err != nil {
-				t.unitsValue = nil
+				t.stringName = nil
 			} else {
 				matched = true
 			}
 		}
 		if !matched {
-			t.anyURI, err = anyURIDeserialize(i)
+			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
-				t.anyURI = nil
+				t.IRI = nil
 			} else {
 				matched = true
 			}
@@ -1846,39 +2124,159 @@ func (t *unitsIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *unitsIntermediateType) Serialize() (i interface{}, err error) {
-	if t.unitsValue != nil {
-		i = unitsValueSerialize(*t.unitsValue)
+func (t *formerTypeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
 		return
 	}
-	if t.anyURI != nil {
-		i = anyURISerialize(t.anyURI)
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// endTimeIntermediateType will only have one of its values set at most
-type endTimeIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *formerTypeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// generatorIntermediateType will only have one of its values set at most
+type generatorIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *time.Time type for endTime property
-	dateTime *time.Time
-	// Stores possible *url.URL type for endTime property
+	// Stores possible ObjectType type for generator property
+	Object ObjectType
+	// Stores possible LinkType type for generator property
+	Link LinkType
+	// Stores possible *url.URL type for generator property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *endTimeIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *generatorIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *generatorIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *generatorIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// heightIntermediateType will only have one of its values set at most
+type heightIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible *int64 type for height property
+	nonNegativeInteger *int64
+	// Stores possible *url.URL type for height property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *heightIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.dateTime, err = dateTimeDeserialize(i)
+			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
 			if err != nil {
-				t.dateTime = nil
+				t.nonNegativeInteger = nil
 			} else {
 				matched = true
 			}
@@ -1900,9 +2298,9 @@ func (t *endTimeIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *endTimeIntermediateType) Serialize() (i interface{}, err error) {
-	if t.dateTime != nil {
-		i = dateTimeSerialize(*t.dateTime)
+func (t *heightIntermediateType) Serialize() (i interface{}, err error) {
+	if t.nonNegativeInteger != nil {
+		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
 		return
 	}
 	if t.IRI != nil {
@@ -1913,30 +2311,331 @@ func (t *endTimeIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// publishedIntermediateType will only have one of its values set at most
-type publishedIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *heightIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// hreflangIntermediateType will only have one of its values set at most
+type hreflangIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *time.Time type for published property
-	dateTime *time.Time
-	// Stores possible *url.URL type for published property
+	// Stores possible *string type for hreflang property
+	bcp47LanguageTag *string
+	// Stores possible *url.URL type for hreflang property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *publishedIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *hreflangIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.dateTime, err = dateTimeDeserialize(i)
+			t.bcp47LanguageTag, err = bcp47LanguageTagDeserialize(i)
 			if err != nil {
-				t.dateTime = nil
+				t.bcp47LanguageTag = nil
+			} else {
+				matched = true
+			}
+		}
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *hreflangIntermediateType) Serialize() (i interface{}, err error) {
+	if t.bcp47LanguageTag != nil {
+		i = bcp47LanguageTagSerialize(*t.bcp47LanguageTag)
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *hreflangIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// iconIntermediateType will only have one of its values set at most
+type iconIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ImageType type for icon property
+	Image ImageType
+	// Stores possible LinkType type for icon property
+	Link LinkType
+	// Stores possible *url.URL type for icon property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *iconIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Image, ok = resolveObject(kind).(ImageType); t.Image != nil && ok {
+						err = t.Image.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
+			} else {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *iconIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Image != nil {
+		i, err = t.Image.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *iconIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Image != nil {
+		if err := t.Image.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// imageIntermediateType will only have one of its values set at most
+type imageIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ImageType type for image property
+	Image ImageType
+	// Stores possible LinkType type for image property
+	Link LinkType
+	// Stores possible *url.URL type for image property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *imageIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Image, ok = resolveObject(kind).(ImageType); t.Image != nil && ok {
+						err = t.Image.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
+		if !matched {
+			t.IRI, err = IRIDeserialize(i)
+			if err != nil {
+				t.IRI = nil
 			} else {
 				matched = true
 			}
 		}
+	}
+	if !matched {
+		t.unknown_ = unknownValueDeserialize(i)
+	}
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *imageIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Image != nil {
+		i, err = t.Image.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
+		return
+	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	i = unknownValueSerialize(t.unknown_)
+	return
+}
+
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *imageIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Image != nil {
+		if err := t.Image.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// inReplyToIntermediateType will only have one of its values set at most
+type inReplyToIntermediateType struct {
+	// An unknown value.
+	unknown_ interface{}
+	// Stores possible ObjectType type for inReplyTo property
+	Object ObjectType
+	// Stores possible LinkType type for inReplyTo property
+	Link LinkType
+	// Stores possible *url.URL type for inReplyTo property
+	IRI *url.URL
+}
+
+// Deserialize takes an interface{} and attempts to create a valid intermediate type.
+func (t *inReplyToIntermediateType) Deserialize(i interface{}) (err error) {
+	matched := false
+	if m, ok := i.(map[string]interface{}); ok {
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+		} else {
+			t.unknown_ = m
+		}
+	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -1954,9 +2653,13 @@ func (t *publishedIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *publishedIntermediateType) Serialize() (i interface{}, err error) {
-	if t.dateTime != nil {
-		i = dateTimeSerialize(*t.dateTime)
+func (t *inReplyToIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
 	if t.IRI != nil {
@@ -1967,20 +2670,37 @@ func (t *publishedIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// followersIntermediateType will only have one of its values set at most
-type followersIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *inReplyToIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// inboxIntermediateType will only have one of its values set at most
+type inboxIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionType type for followers property
-	Collection CollectionType
-	// Stores possible OrderedCollectionType type for followers property
+	// Stores possible OrderedCollectionType type for inbox property
 	OrderedCollection OrderedCollectionType
-	// Stores possible *url.URL type for followers property
+	// Stores possible *url.URL type for inbox property
 	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *followersIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *inboxIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -1994,15 +2714,6 @@ func (t *followersIntermediateType) Deserialize(i interface{}) (err error) {
 			} else if typeString, ok := tv.(string); ok {
 				types = append(types, typeString)
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
-						err = t.Collection.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
 			if !matched {
 				for _, kind := range types {
 					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
@@ -2033,11 +2744,7 @@ func (t *followersIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *followersIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Collection != nil {
-		i, err = t.Collection.Serialize()
-		return
-	}
+func (t *inboxIntermediateType) Serialize() (i interface{}, err error) {
 	if t.OrderedCollection != nil {
 		i, err = t.OrderedCollection.Serialize()
 		return
@@ -2050,24 +2757,34 @@ func (t *followersIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// closedIntermediateType will only have one of its values set at most
-type closedIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *inboxIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// instrumentIntermediateType will only have one of its values set at most
+type instrumentIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *time.Time type for closed property
-	dateTime *time.Time
-	// Stores possible *bool type for closed property
-	boolean *bool
-	// Stores possible ObjectType type for closed property
+	// Stores possible ObjectType type for instrument property
 	Object ObjectType
-	// Stores possible LinkType type for closed property
+	// Stores possible LinkType type for instrument property
 	Link LinkType
-	// Stores possible *url.URL type for closed property
+	// Stores possible *url.URL type for instrument property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *closedIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *instrumentIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -2103,22 +2820,6 @@ func (t *closedIntermediateType) Deserialize(i interface{}) (err error) {
 			t.unknown_ = m
 		}
 	} else if i != nil {
-		if !matched {
-			t.dateTime, err = dateTimeDeserialize(i)
-			if err != nil {
-				t.dateTime = nil
-			} else {
-				matched = true
-			}
-		}
-		if !matched {
-			t.boolean, err = booleanDeserialize(i)
-			if err != nil {
-				t.boolean = nil
-			} else {
-				matched = true
-			}
-		}
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -2136,15 +2837,7 @@ func (t *closedIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *closedIntermediateType) Serialize() (i interface{}, err error) {
-	if t.dateTime != nil {
-		i = dateTimeSerialize(*t.dateTime)
-		return
-	}
-	if t.boolean != nil {
-		i = booleanSerialize(*t.boolean)
-		return
-	}
+func (t *instrumentIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -2161,88 +2854,39 @@ func (t *closedIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// contentIntermediateType will only have one of its values set at most
-type contentIntermediateType struct {
-	// An unknown value.
-	unknown_ interface{}
-	// Stores possible *string type for content property
-	stringName *string
-	// Stores possible *string type for content property
-	langString *string
-	// Stores possible *url.URL type for content property
-	IRI *url.URL
-}
-
-// Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *contentIntermediateType) Deserialize(i interface{}) (err error) {
-	matched := false
-	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.stringName, err = stringDeserialize(i)
-			if err != nil {
-				t.stringName = nil
-			} else {
-				matched = true
-			}
-		}
-		if !matched {
-			t.langString, err = langStringDeserialize(i)
-			if err != nil {
-				t.langString = nil
-			} else {
-				matched = true
-			}
-		}
-		if !matched {
-			t.IRI, err = IRIDeserialize(i)
-			if err != nil {
-				t.IRI = nil
-			} else {
-				matched = true
-			}
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *instrumentIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
 		}
 	}
-	if !matched {
-		t.unknown_ = unknownValueDeserialize(i)
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
 	}
-	return
+	return errs
 
 }
 
-// Serialize turns this object into an interface{}.
-func (t *contentIntermediateType) Serialize() (i interface{}, err error) {
-	if t.stringName != nil {
-		i = stringSerialize(*t.stringName)
-		return
-	}
-	if t.langString != nil {
-		i = langStringSerialize(*t.langString)
-		return
-	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
-		return
-	}
-	i = unknownValueSerialize(t.unknown_)
-	return
-}
-
-// contextIntermediateType will only have one of its values set at most
-type contextIntermediateType struct {
+// itemsIntermediateType will only have one of its values set at most
+type itemsIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for context property
+	// Stores possible ObjectType type for items property
 	Object ObjectType
-	// Stores possible LinkType type for context property
+	// Stores possible LinkType type for items property
 	Link LinkType
-	// Stores possible *url.URL type for context property
+	// Stores possible *url.URL type for items property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *contextIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *itemsIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -2295,7 +2939,7 @@ func (t *contextIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *contextIntermediateType) Serialize() (i interface{}, err error) {
+func (t *itemsIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -2312,20 +2956,41 @@ func (t *contextIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// oneOfIntermediateType will only have one of its values set at most
-type oneOfIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *itemsIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// lastIntermediateType will only have one of its values set at most
+type lastIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for oneOf property
-	Object ObjectType
-	// Stores possible LinkType type for oneOf property
+	// Stores possible CollectionPageType type for last property
+	CollectionPage CollectionPageType
+	// Stores possible LinkType type for last property
 	Link LinkType
-	// Stores possible *url.URL type for oneOf property
+	// Stores possible *url.URL type for last property
 	IRI *url.URL
+	// Stores possible OrderedCollectionPageType type for last property
+	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *oneOfIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *lastIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -2341,8 +3006,8 @@ func (t *oneOfIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
+					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
+						err = t.CollectionPage.Deserialize(m)
 						matched = true
 						break
 					}
@@ -2357,6 +3022,15 @@ func (t *oneOfIntermediateType) Deserialize(i interface{}) (err error) {
 					}
 				}
 			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
+						err = t.OrderedCollectionPage.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
 		} else {
 			t.unknown_ = m
 		}
@@ -2378,9 +3052,9 @@ func (t *oneOfIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *oneOfIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *lastIntermediateType) Serialize() (i interface{}, err error) {
+	if t.CollectionPage != nil {
+		i, err = t.CollectionPage.Serialize()
 		return
 	}
 	if t.Link != nil {
@@ -2391,22 +3065,50 @@ func (t *oneOfIntermediateType) Serialize() (i interface{}, err error) {
 		i = IRISerialize(t.IRI)
 		return
 	}
+	if t.OrderedCollectionPage != nil {
+		i, err = t.OrderedCollectionPage.Serialize()
+		return
+	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// radiusIntermediateType will only have one of its values set at most
-type radiusIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *lastIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.CollectionPage != nil {
+		if err := t.CollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollectionPage != nil {
+		if err := t.OrderedCollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// latitudeIntermediateType will only have one of its values set at most
+type latitudeIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *float64 type for radius property
+	// Stores possible *float64 type for latitude property
 	float *float64
-	// Stores possible *url.URL type for radius property
+	// Stores possible *url.URL type for latitude property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *radiusIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *latitudeIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
@@ -2436,7 +3138,7 @@ func (t *radiusIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *radiusIntermediateType) Serialize() (i interface{}, err error) {
+func (t *latitudeIntermediateType) Serialize() (i interface{}, err error) {
 	if t.float != nil {
 		i = floatSerialize(*t.float)
 		return
@@ -2449,20 +3151,29 @@ func (t *radiusIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// attachmentIntermediateType will only have one of its values set at most
-type attachmentIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *latitudeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// likedIntermediateType will only have one of its values set at most
+type likedIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for attachment property
-	Object ObjectType
-	// Stores possible LinkType type for attachment property
-	Link LinkType
-	// Stores possible *url.URL type for attachment property
-	IRI *url.URL
+	// Stores possible CollectionType type for liked property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for liked property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for liked property
+	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *attachmentIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *likedIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -2478,8 +3189,8 @@ func (t *attachmentIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
 						matched = true
 						break
 					}
@@ -2487,8 +3198,8 @@ func (t *attachmentIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
 						matched = true
 						break
 					}
@@ -2499,9 +3210,9 @@ func (t *attachmentIntermediateType) Deserialize(i interface{}) (err error) {
 		}
 	} else if i != nil {
 		if !matched {
-			t.IRI, err = IRIDeserialize(i)
+			t.anyURI, err = anyURIDeserialize(i)
 			if err != nil {
-				t.IRI = nil
+				t.anyURI = nil
 			} else {
 				matched = true
 			}
@@ -2515,51 +3226,95 @@ func (t *attachmentIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *attachmentIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *likedIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
 		return
 	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
 		return
 	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// widthIntermediateType will only have one of its values set at most
-type widthIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *likedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// likesIntermediateType will only have one of its values set at most
+type likesIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *int64 type for width property
-	nonNegativeInteger *int64
-	// Stores possible *url.URL type for width property
-	IRI *url.URL
+	// Stores possible CollectionType type for likes property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for likes property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for likes property
+	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *widthIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *likesIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
-			if err != nil {
-				t.nonNegativeInteger = nil
-			} else {
-				matched = true
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
 			}
+		} else {
+			t.unknown_ = m
 		}
+	} else if i != nil {
 		if !matched {
-			t.IRI, err = IRIDeserialize(i)
+			t.anyURI, err = anyURIDeserialize(i)
 			if err != nil {
-				t.IRI = nil
+				t.anyURI = nil
 			} else {
 				matched = true
 			}
@@ -2573,33 +3328,56 @@ func (t *widthIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *widthIntermediateType) Serialize() (i interface{}, err error) {
-	if t.nonNegativeInteger != nil {
-		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
+func (t *likesIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
 		return
 	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
+		return
+	}
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// orderedItemsIntermediateType will only have one of its values set at most
-type orderedItemsIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *likesIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// locationIntermediateType will only have one of its values set at most
+type locationIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for orderedItems property
+	// Stores possible ObjectType type for location property
 	Object ObjectType
-	// Stores possible LinkType type for orderedItems property
+	// Stores possible LinkType type for location property
 	Link LinkType
-	// Stores possible *url.URL type for orderedItems property
+	// Stores possible *url.URL type for location property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *orderedItemsIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *locationIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -2652,7 +3430,7 @@ func (t *orderedItemsIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *orderedItemsIntermediateType) Serialize() (i interface{}, err error) {
+func (t *locationIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -2669,66 +3447,49 @@ func (t *orderedItemsIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// prevIntermediateType will only have one of its values set at most
-type prevIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *locationIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// longitudeIntermediateType will only have one of its values set at most
+type longitudeIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionPageType type for prev property
-	CollectionPage CollectionPageType
-	// Stores possible LinkType type for prev property
-	Link LinkType
-	// Stores possible *url.URL type for prev property
+	// Stores possible *float64 type for longitude property
+	float *float64
+	// Stores possible *url.URL type for longitude property
 	IRI *url.URL
-	// Stores possible OrderedCollectionPageType type for prev property
-	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *prevIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *longitudeIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
-						err = t.CollectionPage.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
-						err = t.OrderedCollectionPage.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.float, err = floatDeserialize(i)
+			if err != nil {
+				t.float = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -2746,76 +3507,52 @@ func (t *prevIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *prevIntermediateType) Serialize() (i interface{}, err error) {
-	if t.CollectionPage != nil {
-		i, err = t.CollectionPage.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+func (t *longitudeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.float != nil {
+		i = floatSerialize(*t.float)
 		return
 	}
 	if t.IRI != nil {
 		i = IRISerialize(t.IRI)
 		return
 	}
-	if t.OrderedCollectionPage != nil {
-		i, err = t.OrderedCollectionPage.Serialize()
-		return
-	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// subjectIntermediateType will only have one of its values set at most
-type subjectIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *longitudeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// mediaTypeIntermediateType will only have one of its values set at most
+type mediaTypeIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for subject property
-	Object ObjectType
-	// Stores possible LinkType type for subject property
-	Link LinkType
-	// Stores possible *url.URL type for subject property
+	// Stores possible *string type for mediaType property
+	mimeMediaTypeValue *string
+	// Stores possible *url.URL type for mediaType property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *subjectIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *mediaTypeIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.mimeMediaTypeValue, err = mimeMediaTypeValueDeserialize(i)
+			if err != nil {
+				t.mimeMediaTypeValue = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -2833,13 +3570,9 @@ func (t *subjectIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *subjectIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+func (t *mediaTypeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.mimeMediaTypeValue != nil {
+		i = mimeMediaTypeValueSerialize(*t.mimeMediaTypeValue)
 		return
 	}
 	if t.IRI != nil {
@@ -2850,55 +3583,49 @@ func (t *subjectIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// previewIntermediateType will only have one of its values set at most
-type previewIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *mediaTypeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// nameIntermediateType will only have one of its values set at most
+type nameIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for preview property
-	Object ObjectType
-	// Stores possible LinkType type for preview property
-	Link LinkType
-	// Stores possible *url.URL type for preview property
+	// Stores possible *string type for name property
+	stringName *string
+	// Stores possible *string type for name property
+	langString *string
+	// Stores possible *url.URL type for name property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *previewIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *nameIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.stringName, err = stringDeserialize(i)
+			if err != nil {
+				t.stringName = nil
+			} else {
+				matched = true
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		}
+		if !matched {
+			t.langString, err = langStringDeserialize(i)
+			if err != nil {
+				t.langString = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -2916,13 +3643,13 @@ func (t *previewIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *previewIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *nameIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
 		return
 	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+	if t.langString != nil {
+		i = langStringSerialize(*t.langString)
 		return
 	}
 	if t.IRI != nil {
@@ -2933,18 +3660,31 @@ func (t *previewIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// urlIntermediateType will only have one of its values set at most
-type urlIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *nameIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// nextIntermediateType will only have one of its values set at most
+type nextIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *url.URL type for url property
-	anyURI *url.URL
-	// Stores possible LinkType type for url property
+	// Stores possible CollectionPageType type for next property
+	CollectionPage CollectionPageType
+	// Stores possible LinkType type for next property
 	Link LinkType
+	// Stores possible *url.URL type for next property
+	IRI *url.URL
+	// Stores possible OrderedCollectionPageType type for next property
+	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *urlIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *nextIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -2958,6 +3698,15 @@ func (t *urlIntermediateType) Deserialize(i interface{}) (err error) {
 			} else if typeString, ok := tv.(string); ok {
 				types = append(types, typeString)
 			}
+			if !matched {
+				for _, kind := range types {
+					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
+						err = t.CollectionPage.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
 			if !matched {
 				for _, kind := range types {
 					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
@@ -2967,14 +3716,23 @@ func (t *urlIntermediateType) Deserialize(i interface{}) (err error) {
 					}
 				}
 			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
+						err = t.OrderedCollectionPage.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
 		} else {
 			t.unknown_ = m
 		}
 	} else if i != nil {
 		if !matched {
-			t.anyURI, err = anyURIDeserialize(i)
+			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
-				t.anyURI = nil
+				t.IRI = nil
 			} else {
 				matched = true
 			}
@@ -2988,33 +3746,63 @@ func (t *urlIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *urlIntermediateType) Serialize() (i interface{}, err error) {
-	if t.anyURI != nil {
-		i = anyURISerialize(t.anyURI)
+func (t *nextIntermediateType) Serialize() (i interface{}, err error) {
+	if t.CollectionPage != nil {
+		i, err = t.CollectionPage.Serialize()
 		return
 	}
 	if t.Link != nil {
 		i, err = t.Link.Serialize()
 		return
 	}
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
+		return
+	}
+	if t.OrderedCollectionPage != nil {
+		i, err = t.OrderedCollectionPage.Serialize()
+		return
+	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// toIntermediateType will only have one of its values set at most
-type toIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *nextIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.CollectionPage != nil {
+		if err := t.CollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollectionPage != nil {
+		if err := t.OrderedCollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// objectIntermediateType will only have one of its values set at most
+type objectIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for to property
+	// Stores possible ObjectType type for object property
 	Object ObjectType
-	// Stores possible LinkType type for to property
-	Link LinkType
-	// Stores possible *url.URL type for to property
+	// Stores possible *url.URL type for object property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *toIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *objectIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3037,15 +3825,6 @@ func (t *toIntermediateType) Deserialize(i interface{}) (err error) {
 					}
 				}
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
 		} else {
 			t.unknown_ = m
 		}
@@ -3067,13 +3846,9 @@ func (t *toIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *toIntermediateType) Serialize() (i interface{}, err error) {
+func (t *objectIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
-		i, err = t.Object.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+		i, err = t.Object.Serialize()
 		return
 	}
 	if t.IRI != nil {
@@ -3084,20 +3859,34 @@ func (t *toIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// bccIntermediateType will only have one of its values set at most
-type bccIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *objectIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// oneOfIntermediateType will only have one of its values set at most
+type oneOfIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for bcc property
+	// Stores possible ObjectType type for oneOf property
 	Object ObjectType
-	// Stores possible LinkType type for bcc property
+	// Stores possible LinkType type for oneOf property
 	Link LinkType
-	// Stores possible *url.URL type for bcc property
+	// Stores possible *url.URL type for oneOf property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *bccIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *oneOfIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3150,7 +3939,7 @@ func (t *bccIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *bccIntermediateType) Serialize() (i interface{}, err error) {
+func (t *oneOfIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -3167,20 +3956,39 @@ func (t *bccIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// likesIntermediateType will only have one of its values set at most
-type likesIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *oneOfIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// orderedItemsIntermediateType will only have one of its values set at most
+type orderedItemsIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionType type for likes property
-	Collection CollectionType
-	// Stores possible OrderedCollectionType type for likes property
-	OrderedCollection OrderedCollectionType
-	// Stores possible *url.URL type for likes property
-	anyURI *url.URL
+	// Stores possible ObjectType type for orderedItems property
+	Object ObjectType
+	// Stores possible LinkType type for orderedItems property
+	Link LinkType
+	// Stores possible *url.URL type for orderedItems property
+	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *likesIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *orderedItemsIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3196,8 +4004,8 @@ func (t *likesIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
-						err = t.Collection.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
 						matched = true
 						break
 					}
@@ -3205,8 +4013,8 @@ func (t *likesIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
-						err = t.OrderedCollection.Deserialize(m)
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
 						matched = true
 						break
 					}
@@ -3217,9 +4025,9 @@ func (t *likesIntermediateType) Deserialize(i interface{}) (err error) {
 		}
 	} else if i != nil {
 		if !matched {
-			t.anyURI, err = anyURIDeserialize(i)
+			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
-				t.anyURI = nil
+				t.IRI = nil
 			} else {
 				matched = true
 			}
@@ -3233,37 +4041,56 @@ func (t *likesIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *likesIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Collection != nil {
-		i, err = t.Collection.Serialize()
+func (t *orderedItemsIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
-	if t.OrderedCollection != nil {
-		i, err = t.OrderedCollection.Serialize()
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
-	if t.anyURI != nil {
-		i = anyURISerialize(t.anyURI)
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// targetIntermediateType will only have one of its values set at most
-type targetIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *orderedItemsIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// originIntermediateType will only have one of its values set at most
+type originIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for target property
+	// Stores possible ObjectType type for origin property
 	Object ObjectType
-	// Stores possible LinkType type for target property
+	// Stores possible LinkType type for origin property
 	Link LinkType
-	// Stores possible *url.URL type for target property
+	// Stores possible *url.URL type for origin property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *targetIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *originIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3316,7 +4143,7 @@ func (t *targetIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *targetIntermediateType) Serialize() (i interface{}, err error) {
+func (t *originIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -3333,34 +4160,67 @@ func (t *targetIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// altitudeIntermediateType will only have one of its values set at most
-type altitudeIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *originIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// outboxIntermediateType will only have one of its values set at most
+type outboxIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *float64 type for altitude property
-	float *float64
-	// Stores possible *url.URL type for altitude property
-	IRI *url.URL
+	// Stores possible OrderedCollectionType type for outbox property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for outbox property
+	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *altitudeIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *outboxIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.float, err = floatDeserialize(i)
-			if err != nil {
-				t.float = nil
-			} else {
-				matched = true
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
+						matched = true
+						break
+					}
+				}
 			}
+		} else {
+			t.unknown_ = m
 		}
+	} else if i != nil {
 		if !matched {
-			t.IRI, err = IRIDeserialize(i)
+			t.anyURI, err = anyURIDeserialize(i)
 			if err != nil {
-				t.IRI = nil
+				t.anyURI = nil
 			} else {
 				matched = true
 			}
@@ -3374,33 +4234,47 @@ func (t *altitudeIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *altitudeIntermediateType) Serialize() (i interface{}, err error) {
-	if t.float != nil {
-		i = floatSerialize(*t.float)
+func (t *outboxIntermediateType) Serialize() (i interface{}, err error) {
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
 		return
 	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// likedIntermediateType will only have one of its values set at most
-type likedIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *outboxIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// partOfIntermediateType will only have one of its values set at most
+type partOfIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionType type for liked property
+	// Stores possible LinkType type for partOf property
+	Link LinkType
+	// Stores possible CollectionType type for partOf property
 	Collection CollectionType
-	// Stores possible OrderedCollectionType type for liked property
-	OrderedCollection OrderedCollectionType
-	// Stores possible *url.URL type for liked property
-	anyURI *url.URL
+	// Stores possible *url.URL type for partOf property
+	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *likedIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *partOfIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3416,8 +4290,8 @@ func (t *likedIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
-						err = t.Collection.Deserialize(m)
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
 						matched = true
 						break
 					}
@@ -3425,8 +4299,8 @@ func (t *likedIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
-						err = t.OrderedCollection.Deserialize(m)
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
 						matched = true
 						break
 					}
@@ -3437,9 +4311,9 @@ func (t *likedIntermediateType) Deserialize(i interface{}) (err error) {
 		}
 	} else if i != nil {
 		if !matched {
-			t.anyURI, err = anyURIDeserialize(i)
+			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
-				t.anyURI = nil
+				t.IRI = nil
 			} else {
 				matched = true
 			}
@@ -3453,43 +4327,62 @@ func (t *likedIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *likedIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Collection != nil {
-		i, err = t.Collection.Serialize()
+func (t *partOfIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
-	if t.OrderedCollection != nil {
-		i, err = t.OrderedCollection.Serialize()
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
 		return
 	}
-	if t.anyURI != nil {
-		i = anyURISerialize(t.anyURI)
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// totalItemsIntermediateType will only have one of its values set at most
-type totalItemsIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *partOfIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// preferredUsernameIntermediateType will only have one of its values set at most
+type preferredUsernameIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *int64 type for totalItems property
-	nonNegativeInteger *int64
-	// Stores possible *url.URL type for totalItems property
+	// Stores possible *string type for preferredUsername property
+	stringName *string
+	// Stores possible *url.URL type for preferredUsername property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *totalItemsIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *preferredUsernameIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
+			t.stringName, err = stringDeserialize(i)
 			if err != nil {
-				t.nonNegativeInteger = nil
+				t.stringName = nil
 			} else {
 				matched = true
 			}
@@ -3511,9 +4404,9 @@ func (t *totalItemsIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *totalItemsIntermediateType) Serialize() (i interface{}, err error) {
-	if t.nonNegativeInteger != nil {
-		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
+func (t *preferredUsernameIntermediateType) Serialize() (i interface{}, err error) {
+	if t.stringName != nil {
+		i = stringSerialize(*t.stringName)
 		return
 	}
 	if t.IRI != nil {
@@ -3524,18 +4417,31 @@ func (t *totalItemsIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// relationshipIntermediateType will only have one of its values set at most
-type relationshipIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *preferredUsernameIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// prevIntermediateType will only have one of its values set at most
+type prevIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for relationship property
-	Object ObjectType
-	// Stores possible *url.URL type for relationship property
+	// Stores possible CollectionPageType type for prev property
+	CollectionPage CollectionPageType
+	// Stores possible LinkType type for prev property
+	Link LinkType
+	// Stores possible *url.URL type for prev property
 	IRI *url.URL
+	// Stores possible OrderedCollectionPageType type for prev property
+	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *relationshipIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *prevIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3551,8 +4457,26 @@ func (t *relationshipIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
+					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
+						err = t.CollectionPage.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
+						err = t.OrderedCollectionPage.Deserialize(m)
 						matched = true
 						break
 					}
@@ -3579,33 +4503,65 @@ func (t *relationshipIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *relationshipIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *prevIntermediateType) Serialize() (i interface{}, err error) {
+	if t.CollectionPage != nil {
+		i, err = t.CollectionPage.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
 	if t.IRI != nil {
 		i = IRISerialize(t.IRI)
 		return
 	}
+	if t.OrderedCollectionPage != nil {
+		i, err = t.OrderedCollectionPage.Serialize()
+		return
+	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// tagIntermediateType will only have one of its values set at most
-type tagIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *prevIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.CollectionPage != nil {
+		if err := t.CollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollectionPage != nil {
+		if err := t.OrderedCollectionPage.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// previewIntermediateType will only have one of its values set at most
+type previewIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for tag property
+	// Stores possible ObjectType type for preview property
 	Object ObjectType
-	// Stores possible LinkType type for tag property
+	// Stores possible LinkType type for preview property
 	Link LinkType
-	// Stores possible *url.URL type for tag property
+	// Stores possible *url.URL type for preview property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *tagIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *previewIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3658,7 +4614,7 @@ func (t *tagIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *tagIntermediateType) Serialize() (i interface{}, err error) {
+func (t *previewIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -3675,26 +4631,45 @@ func (t *tagIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// relIntermediateType will only have one of its values set at most
-type relIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *previewIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// publishedIntermediateType will only have one of its values set at most
+type publishedIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *string type for rel property
-	linkRelation *string
-	// Stores possible *url.URL type for rel property
+	// Stores possible *time.Time type for published property
+	dateTime *time.Time
+	// Stores possible *url.URL type for published property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *relIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *publishedIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.linkRelation, err = linkRelationDeserialize(i)
+			t.dateTime, err = dateTimeDeserialize(i)
 			if err != nil {
-				t.linkRelation = nil
+				t.dateTime = nil
 			} else {
 				matched = true
 			}
@@ -3716,9 +4691,9 @@ func (t *relIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *relIntermediateType) Serialize() (i interface{}, err error) {
-	if t.linkRelation != nil {
-		i = linkRelationSerialize(*t.linkRelation)
+func (t *publishedIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
 		return
 	}
 	if t.IRI != nil {
@@ -3729,44 +4704,39 @@ func (t *relIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// objectIntermediateType will only have one of its values set at most
-type objectIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *publishedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// radiusIntermediateType will only have one of its values set at most
+type radiusIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for object property
-	Object ObjectType
-	// Stores possible *url.URL type for object property
+	// Stores possible *float64 type for radius property
+	float *float64
+	// Stores possible *url.URL type for radius property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *objectIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *radiusIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.float, err = floatDeserialize(i)
+			if err != nil {
+				t.float = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -3784,9 +4754,9 @@ func (t *objectIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *objectIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *radiusIntermediateType) Serialize() (i interface{}, err error) {
+	if t.float != nil {
+		i = floatSerialize(*t.float)
 		return
 	}
 	if t.IRI != nil {
@@ -3797,55 +4767,39 @@ func (t *objectIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// instrumentIntermediateType will only have one of its values set at most
-type instrumentIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *radiusIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// relIntermediateType will only have one of its values set at most
+type relIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for instrument property
-	Object ObjectType
-	// Stores possible LinkType type for instrument property
-	Link LinkType
-	// Stores possible *url.URL type for instrument property
+	// Stores possible *string type for rel property
+	linkRelation *string
+	// Stores possible *url.URL type for rel property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *instrumentIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *relIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.linkRelation, err = linkRelationDeserialize(i)
+			if err != nil {
+				t.linkRelation = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -3863,13 +4817,9 @@ func (t *instrumentIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *instrumentIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+func (t *relIntermediateType) Serialize() (i interface{}, err error) {
+	if t.linkRelation != nil {
+		i = linkRelationSerialize(*t.linkRelation)
 		return
 	}
 	if t.IRI != nil {
@@ -3880,46 +4830,44 @@ func (t *instrumentIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// inReplyToIntermediateType will only have one of its values set at most
-type inReplyToIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *relIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// relationshipIntermediateType will only have one of its values set at most
+type relationshipIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for inReplyTo property
+	// Stores possible ObjectType type for relationship property
 	Object ObjectType
-	// Stores possible LinkType type for inReplyTo property
-	Link LinkType
-	// Stores possible *url.URL type for inReplyTo property
+	// Stores possible *url.URL type for relationship property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *inReplyToIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *relationshipIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
 			var types []string
 			if tvs, ok := tv.([]interface{}); ok {
 				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
 					}
 				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
 						matched = true
 						break
 					}
@@ -3946,15 +4894,11 @@ func (t *inReplyToIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *inReplyToIntermediateType) Serialize() (i interface{}, err error) {
+func (t *relationshipIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
 	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
-		return
-	}
 	if t.IRI != nil {
 		i = IRISerialize(t.IRI)
 		return
@@ -3963,20 +4907,32 @@ func (t *inReplyToIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// locationIntermediateType will only have one of its values set at most
-type locationIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *relationshipIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// repliesIntermediateType will only have one of its values set at most
+type repliesIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for location property
-	Object ObjectType
-	// Stores possible LinkType type for location property
-	Link LinkType
-	// Stores possible *url.URL type for location property
+	// Stores possible CollectionType type for replies property
+	Collection CollectionType
+	// Stores possible *url.URL type for replies property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *locationIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *repliesIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -3992,17 +4948,8 @@ func (t *locationIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
 						matched = true
 						break
 					}
@@ -4029,13 +4976,9 @@ func (t *locationIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *locationIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+func (t *repliesIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
 		return
 	}
 	if t.IRI != nil {
@@ -4046,20 +4989,34 @@ func (t *locationIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// ccIntermediateType will only have one of its values set at most
-type ccIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *repliesIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// resultIntermediateType will only have one of its values set at most
+type resultIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for cc property
+	// Stores possible ObjectType type for result property
 	Object ObjectType
-	// Stores possible LinkType type for cc property
+	// Stores possible LinkType type for result property
 	Link LinkType
-	// Stores possible *url.URL type for cc property
+	// Stores possible *url.URL type for result property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *ccIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *resultIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -4112,7 +5069,7 @@ func (t *ccIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *ccIntermediateType) Serialize() (i interface{}, err error) {
+func (t *resultIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -4129,22 +5086,39 @@ func (t *ccIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// nextIntermediateType will only have one of its values set at most
-type nextIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *resultIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// sharesIntermediateType will only have one of its values set at most
+type sharesIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionPageType type for next property
-	CollectionPage CollectionPageType
-	// Stores possible LinkType type for next property
-	Link LinkType
-	// Stores possible *url.URL type for next property
-	IRI *url.URL
-	// Stores possible OrderedCollectionPageType type for next property
-	OrderedCollectionPage OrderedCollectionPageType
+	// Stores possible CollectionType type for shares property
+	Collection CollectionType
+	// Stores possible OrderedCollectionType type for shares property
+	OrderedCollection OrderedCollectionType
+	// Stores possible *url.URL type for shares property
+	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *nextIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *sharesIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -4160,17 +5134,8 @@ func (t *nextIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
-						err = t.CollectionPage.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
+					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
+						err = t.Collection.Deserialize(m)
 						matched = true
 						break
 					}
@@ -4178,8 +5143,8 @@ func (t *nextIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
-						err = t.OrderedCollectionPage.Deserialize(m)
+					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
+						err = t.OrderedCollection.Deserialize(m)
 						matched = true
 						break
 					}
@@ -4190,9 +5155,9 @@ func (t *nextIntermediateType) Deserialize(i interface{}) (err error) {
 		}
 	} else if i != nil {
 		if !matched {
-			t.IRI, err = IRIDeserialize(i)
+			t.anyURI, err = anyURIDeserialize(i)
 			if err != nil {
-				t.IRI = nil
+				t.anyURI = nil
 			} else {
 				matched = true
 			}
@@ -4206,41 +5171,54 @@ func (t *nextIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *nextIntermediateType) Serialize() (i interface{}, err error) {
-	if t.CollectionPage != nil {
-		i, err = t.CollectionPage.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+func (t *sharesIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Collection != nil {
+		i, err = t.Collection.Serialize()
 		return
 	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
+	if t.OrderedCollection != nil {
+		i, err = t.OrderedCollection.Serialize()
 		return
 	}
-	if t.OrderedCollectionPage != nil {
-		i, err = t.OrderedCollectionPage.Serialize()
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// formerTypeIntermediateType will only have one of its values set at most
-type formerTypeIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *sharesIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Collection != nil {
+		if err := t.Collection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.OrderedCollection != nil {
+		if err := t.OrderedCollection.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// sourceIntermediateType will only have one of its values set at most
+type sourceIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *string type for formerType property
-	stringName *string
-	// Stores possible ObjectType type for formerType property
+	// Stores possible ObjectType type for source property
 	Object ObjectType
-	// Stores possible *url.URL type for formerType property
+	// Stores possible *url.URL type for source property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *formerTypeIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *sourceIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -4267,14 +5245,6 @@ func (t *formerTypeIntermediateType) Deserialize(i interface{}) (err error) {
 			t.unknown_ = m
 		}
 	} else if i != nil {
-		if !matched {
-			t.stringName, err = stringDeserialize(i)
-			if err != nil {
-				t.stringName = nil
-			} else {
-				matched = true
-			}
-		}
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -4292,11 +5262,7 @@ func (t *formerTypeIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *formerTypeIntermediateType) Serialize() (i interface{}, err error) {
-	if t.stringName != nil {
-		i = stringSerialize(*t.stringName)
-		return
-	}
+func (t *sourceIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -4309,55 +5275,44 @@ func (t *formerTypeIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// imageIntermediateType will only have one of its values set at most
-type imageIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *sourceIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// startIndexIntermediateType will only have one of its values set at most
+type startIndexIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ImageType type for image property
-	Image ImageType
-	// Stores possible LinkType type for image property
-	Link LinkType
-	// Stores possible *url.URL type for image property
+	// Stores possible *int64 type for startIndex property
+	nonNegativeInteger *int64
+	// Stores possible *url.URL type for startIndex property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *imageIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *startIndexIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Image, ok = resolveObject(kind).(ImageType); t.Image != nil && ok {
-						err = t.Image.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
-						err = t.Link.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
+			if err != nil {
+				t.nonNegativeInteger = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -4370,18 +5325,14 @@ func (t *imageIntermediateType) Deserialize(i interface{}) (err error) {
 	if !matched {
 		t.unknown_ = unknownValueDeserialize(i)
 	}
-	return
-
-}
-
-// Serialize turns this object into an interface{}.
-func (t *imageIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Image != nil {
-		i, err = t.Image.Serialize()
-		return
-	}
-	if t.Link != nil {
-		i, err = t.Link.Serialize()
+	return
+
+}
+
+// Serialize turns this object into an interface{}.
+func (t *startIndexIntermediateType) Serialize() (i interface{}, err error) {
+	if t.nonNegativeInteger != nil {
+		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
 		return
 	}
 	if t.IRI != nil {
@@ -4392,26 +5343,35 @@ func (t *imageIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// durationIntermediateType will only have one of its values set at most
-type durationIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *startIndexIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// startTimeIntermediateType will only have one of its values set at most
+type startTimeIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *time.Duration type for duration property
-	duration *time.Duration
-	// Stores possible *url.URL type for duration property
+	// Stores possible *time.Time type for startTime property
+	dateTime *time.Time
+	// Stores possible *url.URL type for startTime property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *durationIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *startTimeIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.duration, err = durationDeserialize(i)
+			t.dateTime, err = dateTimeDeserialize(i)
 			if err != nil {
-				t.duration = nil
+				t.dateTime = nil
 			} else {
 				matched = true
 			}
@@ -4433,9 +5393,9 @@ func (t *durationIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *durationIntermediateType) Serialize() (i interface{}, err error) {
-	if t.duration != nil {
-		i = durationSerialize(*t.duration)
+func (t *startTimeIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
 		return
 	}
 	if t.IRI != nil {
@@ -4446,20 +5406,29 @@ func (t *durationIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// followingIntermediateType will only have one of its values set at most
-type followingIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *startTimeIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// subjectIntermediateType will only have one of its values set at most
+type subjectIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionType type for following property
-	Collection CollectionType
-	// Stores possible OrderedCollectionType type for following property
-	OrderedCollection OrderedCollectionType
-	// Stores possible *url.URL type for following property
-	anyURI *url.URL
+	// Stores possible ObjectType type for subject property
+	Object ObjectType
+	// Stores possible LinkType type for subject property
+	Link LinkType
+	// Stores possible *url.URL type for subject property
+	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *followingIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *subjectIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -4475,8 +5444,8 @@ func (t *followingIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.Collection, ok = resolveObject(kind).(CollectionType); t.Collection != nil && ok {
-						err = t.Collection.Deserialize(m)
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
 						matched = true
 						break
 					}
@@ -4484,8 +5453,8 @@ func (t *followingIntermediateType) Deserialize(i interface{}) (err error) {
 			}
 			if !matched {
 				for _, kind := range types {
-					if t.OrderedCollection, ok = resolveObject(kind).(OrderedCollectionType); t.OrderedCollection != nil && ok {
-						err = t.OrderedCollection.Deserialize(m)
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
 						matched = true
 						break
 					}
@@ -4496,9 +5465,9 @@ func (t *followingIntermediateType) Deserialize(i interface{}) (err error) {
 		}
 	} else if i != nil {
 		if !matched {
-			t.anyURI, err = anyURIDeserialize(i)
+			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
-				t.anyURI = nil
+				t.IRI = nil
 			} else {
 				matched = true
 			}
@@ -4512,35 +5481,56 @@ func (t *followingIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *followingIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Collection != nil {
-		i, err = t.Collection.Serialize()
+func (t *subjectIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
 		return
 	}
-	if t.OrderedCollection != nil {
-		i, err = t.OrderedCollection.Serialize()
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
-	if t.anyURI != nil {
-		i = anyURISerialize(t.anyURI)
+	if t.IRI != nil {
+		i = IRISerialize(t.IRI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// preferredUsernameIntermediateType will only have one of its values set at most
-type preferredUsernameIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *subjectIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// summaryIntermediateType will only have one of its values set at most
+type summaryIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *string type for preferredUsername property
+	// Stores possible *string type for summary property
 	stringName *string
-	// Stores possible *url.URL type for preferredUsername property
+	// Stores possible *string type for summary property
+	langString *string
+	// Stores possible *url.URL type for summary property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *preferredUsernameIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *summaryIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
@@ -4553,6 +5543,14 @@ func (t *preferredUsernameIntermediateType) Deserialize(i interface{}) (err erro
 				matched = true
 			}
 		}
+		if !matched {
+			t.langString, err = langStringDeserialize(i)
+			if err != nil {
+				t.langString = nil
+			} else {
+				matched = true
+			}
+		}
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -4570,11 +5568,15 @@ func (t *preferredUsernameIntermediateType) Deserialize(i interface{}) (err erro
 }
 
 // Serialize turns this object into an interface{}.
-func (t *preferredUsernameIntermediateType) Serialize() (i interface{}, err error) {
+func (t *summaryIntermediateType) Serialize() (i interface{}, err error) {
 	if t.stringName != nil {
 		i = stringSerialize(*t.stringName)
 		return
 	}
+	if t.langString != nil {
+		i = langStringSerialize(*t.langString)
+		return
+	}
 	if t.IRI != nil {
 		i = IRISerialize(t.IRI)
 		return
@@ -4583,30 +5585,64 @@ func (t *preferredUsernameIntermediateType) Serialize() (i interface{}, err erro
 	return
 }
 
-// heightIntermediateType will only have one of its values set at most
-type heightIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *summaryIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// tagIntermediateType will only have one of its values set at most
+type tagIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *int64 type for height property
-	nonNegativeInteger *int64
-	// Stores possible *url.URL type for height property
+	// Stores possible ObjectType type for tag property
+	Object ObjectType
+	// Stores possible LinkType type for tag property
+	Link LinkType
+	// Stores possible *url.URL type for tag property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *heightIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *tagIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
-	} else if i != nil {
-		if !matched {
-			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
-			if err != nil {
-				t.nonNegativeInteger = nil
-			} else {
-				matched = true
+		if tv, ok := m["type"]; ok {
+			var types []string
+			if tvs, ok := tv.([]interface{}); ok {
+				for _, tvi := range tvs {
+					if typeString, ok := tvi.(string); ok {
+						types = append(types, typeString)
+					}
+				}
+			} else if typeString, ok := tv.(string); ok {
+				types = append(types, typeString)
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
+						err = t.Object.Deserialize(m)
+						matched = true
+						break
+					}
+				}
+			}
+			if !matched {
+				for _, kind := range types {
+					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
+						err = t.Link.Deserialize(m)
+						matched = true
+						break
+					}
+				}
 			}
+		} else {
+			t.unknown_ = m
 		}
+	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -4624,9 +5660,13 @@ func (t *heightIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *heightIntermediateType) Serialize() (i interface{}, err error) {
-	if t.nonNegativeInteger != nil {
-		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
+func (t *tagIntermediateType) Serialize() (i interface{}, err error) {
+	if t.Object != nil {
+		i, err = t.Object.Serialize()
+		return
+	}
+	if t.Link != nil {
+		i, err = t.Link.Serialize()
 		return
 	}
 	if t.IRI != nil {
@@ -4637,20 +5677,39 @@ func (t *heightIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// attributedToIntermediateType will only have one of its values set at most
-type attributedToIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *tagIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// targetIntermediateType will only have one of its values set at most
+type targetIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for attributedTo property
+	// Stores possible ObjectType type for target property
 	Object ObjectType
-	// Stores possible LinkType type for attributedTo property
+	// Stores possible LinkType type for target property
 	Link LinkType
-	// Stores possible *url.URL type for attributedTo property
+	// Stores possible *url.URL type for target property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *attributedToIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *targetIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -4703,7 +5762,7 @@ func (t *attributedToIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *attributedToIntermediateType) Serialize() (i interface{}, err error) {
+func (t *targetIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -4720,20 +5779,39 @@ func (t *attributedToIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// audienceIntermediateType will only have one of its values set at most
-type audienceIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *targetIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// toIntermediateType will only have one of its values set at most
+type toIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for audience property
+	// Stores possible ObjectType type for to property
 	Object ObjectType
-	// Stores possible LinkType type for audience property
+	// Stores possible LinkType type for to property
 	Link LinkType
-	// Stores possible *url.URL type for audience property
+	// Stores possible *url.URL type for to property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *audienceIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *toIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -4786,7 +5864,7 @@ func (t *audienceIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *audienceIntermediateType) Serialize() (i interface{}, err error) {
+func (t *toIntermediateType) Serialize() (i interface{}, err error) {
 	if t.Object != nil {
 		i, err = t.Object.Serialize()
 		return
@@ -4803,36 +5881,45 @@ func (t *audienceIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// summaryIntermediateType will only have one of its values set at most
-type summaryIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *toIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Object != nil {
+		if err := t.Object.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// totalItemsIntermediateType will only have one of its values set at most
+type totalItemsIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *string type for summary property
-	stringName *string
-	// Stores possible *string type for summary property
-	langString *string
-	// Stores possible *url.URL type for summary property
+	// Stores possible *int64 type for totalItems property
+	nonNegativeInteger *int64
+	// Stores possible *url.URL type for totalItems property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *summaryIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *totalItemsIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.stringName, err = stringDeserialize(i)
-			if err != nil {
-				t.stringName = nil
-			} else {
-				matched = true
-			}
-		}
-		if !matched {
-			t.langString, err = langStringDeserialize(i)
+			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
 			if err != nil {
-				t.langString = nil
+				t.nonNegativeInteger = nil
 			} else {
 				matched = true
 			}
@@ -4854,13 +5941,9 @@ func (t *summaryIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *summaryIntermediateType) Serialize() (i interface{}, err error) {
-	if t.stringName != nil {
-		i = stringSerialize(*t.stringName)
-		return
-	}
-	if t.langString != nil {
-		i = langStringSerialize(*t.langString)
+func (t *totalItemsIntermediateType) Serialize() (i interface{}, err error) {
+	if t.nonNegativeInteger != nil {
+		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
 		return
 	}
 	if t.IRI != nil {
@@ -4871,48 +5954,43 @@ func (t *summaryIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// sourceIntermediateType will only have one of its values set at most
-type sourceIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *totalItemsIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// unitsIntermediateType will only have one of its values set at most
+type unitsIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for source property
-	Object ObjectType
-	// Stores possible *url.URL type for source property
-	IRI *url.URL
+	// Stores possible *string type for units property
+	unitsValue *string
+	// Stores possible *url.URL type for units property
+	anyURI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *sourceIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *unitsIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.unitsValue, err = unitsValueDeserialize(i)
+			if err != nil {
+				t.unitsValue = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
-			t.IRI, err = IRIDeserialize(i)
+			t.anyURI, err = anyURIDeserialize(i)
 			if err != nil {
-				t.IRI = nil
+				t.anyURI = nil
 			} else {
 				matched = true
 			}
@@ -4926,57 +6004,52 @@ func (t *sourceIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *sourceIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *unitsIntermediateType) Serialize() (i interface{}, err error) {
+	if t.unitsValue != nil {
+		i = unitsValueSerialize(*t.unitsValue)
 		return
 	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
 		return
 	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// endpointsIntermediateType will only have one of its values set at most
-type endpointsIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *unitsIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// updatedIntermediateType will only have one of its values set at most
+type updatedIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible ObjectType type for endpoints property
-	Object ObjectType
-	// Stores possible *url.URL type for endpoints property
+	// Stores possible *time.Time type for updated property
+	dateTime *time.Time
+	// Stores possible *url.URL type for updated property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *endpointsIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *updatedIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
-		if tv, ok := m["type"]; ok {
-			var types []string
-			if tvs, ok := tv.([]interface{}); ok {
-				for _, tvi := range tvs {
-					if typeString, ok := tvi.(string); ok {
-						types = append(types, typeString)
-					}
-				}
-			} else if typeString, ok := tv.(string); ok {
-				types = append(types, typeString)
-			}
-			if !matched {
-				for _, kind := range types {
-					if t.Object, ok = resolveObject(kind).(ObjectType); t.Object != nil && ok {
-						err = t.Object.Deserialize(m)
-						matched = true
-						break
-					}
-				}
+		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
+	} else if i != nil {
+		if !matched {
+			t.dateTime, err = dateTimeDeserialize(i)
+			if err != nil {
+				t.dateTime = nil
+			} else {
+				matched = true
 			}
-		} else {
-			t.unknown_ = m
 		}
-	} else if i != nil {
 		if !matched {
 			t.IRI, err = IRIDeserialize(i)
 			if err != nil {
@@ -4994,9 +6067,9 @@ func (t *endpointsIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *endpointsIntermediateType) Serialize() (i interface{}, err error) {
-	if t.Object != nil {
-		i, err = t.Object.Serialize()
+func (t *updatedIntermediateType) Serialize() (i interface{}, err error) {
+	if t.dateTime != nil {
+		i = dateTimeSerialize(*t.dateTime)
 		return
 	}
 	if t.IRI != nil {
@@ -5007,22 +6080,27 @@ func (t *endpointsIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// lastIntermediateType will only have one of its values set at most
-type lastIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *updatedIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// urlIntermediateType will only have one of its values set at most
+type urlIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible CollectionPageType type for last property
-	CollectionPage CollectionPageType
-	// Stores possible LinkType type for last property
+	// Stores possible *url.URL type for url property
+	anyURI *url.URL
+	// Stores possible LinkType type for url property
 	Link LinkType
-	// Stores possible *url.URL type for last property
-	IRI *url.URL
-	// Stores possible OrderedCollectionPageType type for last property
-	OrderedCollectionPage OrderedCollectionPageType
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *lastIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *urlIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		if tv, ok := m["type"]; ok {
@@ -5036,15 +6114,6 @@ func (t *lastIntermediateType) Deserialize(i interface{}) (err error) {
 			} else if typeString, ok := tv.(string); ok {
 				types = append(types, typeString)
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.CollectionPage, ok = resolveObject(kind).(CollectionPageType); t.CollectionPage != nil && ok {
-						err = t.CollectionPage.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
 			if !matched {
 				for _, kind := range types {
 					if t.Link, ok = resolveLink(kind).(LinkType); t.Link != nil && ok {
@@ -5054,23 +6123,14 @@ func (t *lastIntermediateType) Deserialize(i interface{}) (err error) {
 					}
 				}
 			}
-			if !matched {
-				for _, kind := range types {
-					if t.OrderedCollectionPage, ok = resolveObject(kind).(OrderedCollectionPageType); t.OrderedCollectionPage != nil && ok {
-						err = t.OrderedCollectionPage.Deserialize(m)
-						matched = true
-						break
-					}
-				}
-			}
 		} else {
 			t.unknown_ = m
 		}
 	} else if i != nil {
 		if !matched {
-			t.IRI, err = IRIDeserialize(i)
+			t.anyURI, err = anyURIDeserialize(i)
 			if err != nil {
-				t.IRI = nil
+				t.anyURI = nil
 			} else {
 				matched = true
 			}
@@ -5084,47 +6144,53 @@ func (t *lastIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *lastIntermediateType) Serialize() (i interface{}, err error) {
-	if t.CollectionPage != nil {
-		i, err = t.CollectionPage.Serialize()
+func (t *urlIntermediateType) Serialize() (i interface{}, err error) {
+	if t.anyURI != nil {
+		i = anyURISerialize(t.anyURI)
 		return
 	}
 	if t.Link != nil {
 		i, err = t.Link.Serialize()
 		return
 	}
-	if t.IRI != nil {
-		i = IRISerialize(t.IRI)
-		return
-	}
-	if t.OrderedCollectionPage != nil {
-		i, err = t.OrderedCollectionPage.Serialize()
-		return
-	}
 	i = unknownValueSerialize(t.unknown_)
 	return
 }
 
-// deletedIntermediateType will only have one of its values set at most
-type deletedIntermediateType struct {
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *urlIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	if t.Link != nil {
+		if err := t.Link.ValidateStrict(); err != nil {
+			errs = append(errs, &InvalidPropertyError{Type: typeName, Property: property, Err: err})
+		}
+	}
+	return errs
+
+}
+
+// widthIntermediateType will only have one of its values set at most
+type widthIntermediateType struct {
 	// An unknown value.
 	unknown_ interface{}
-	// Stores possible *time.Time type for deleted property
-	dateTime *time.Time
-	// Stores possible *url.URL type for deleted property
+	// Stores possible *int64 type for width property
+	nonNegativeInteger *int64
+	// Stores possible *url.URL type for width property
 	IRI *url.URL
 }
 
 // Deserialize takes an interface{} and attempts to create a valid intermediate type.
-func (t *deletedIntermediateType) Deserialize(i interface{}) (err error) {
+func (t *widthIntermediateType) Deserialize(i interface{}) (err error) {
 	matched := false
 	if m, ok := i.(map[string]interface{}); ok {
 		err = fmt.Errorf("Given map but nothing to do with it for this type: %v", m)
 	} else if i != nil {
 		if !matched {
-			t.dateTime, err = dateTimeDeserialize(i)
+			t.nonNegativeInteger, err = nonNegativeIntegerDeserialize(i)
 			if err != nil {
-				t.dateTime = nil
+				t.nonNegativeInteger = nil
 			} else {
 				matched = true
 			}
@@ -5146,9 +6212,9 @@ func (t *deletedIntermediateType) Deserialize(i interface{}) (err error) {
 }
 
 // Serialize turns this object into an interface{}.
-func (t *deletedIntermediateType) Serialize() (i interface{}, err error) {
-	if t.dateTime != nil {
-		i = dateTimeSerialize(*t.dateTime)
+func (t *widthIntermediateType) Serialize() (i interface{}, err error) {
+	if t.nonNegativeInteger != nil {
+		i = nonNegativeIntegerSerialize(*t.nonNegativeInteger)
 		return
 	}
 	if t.IRI != nil {
@@ -5159,20 +6225,35 @@ func (t *deletedIntermediateType) Serialize() (i interface{}, err error) {
 	return
 }
 
-// deserializerepliesIntermediateType will accept a map to create a repliesIntermediateType
-func deserializeRepliesIntermediateType(in interface{}) (t *repliesIntermediateType, err error) {
-	tmp := &repliesIntermediateType{}
+// strict appends to the errors that this value of the property of the type has none of the types the property takes, or that it is an inlined value which is itself invalid.
+func (t *widthIntermediateType) strict(errs ValidationErrors, typeName string, property string) (out ValidationErrors) {
+	if t.unknown_ != nil {
+		return append(errs, &MismatchedPropertyError{Type: typeName, Property: property})
+	}
+	return errs
+
+}
+
+// deserializeaccuracyIntermediateType will accept a map to create a accuracyIntermediateType
+func deserializeAccuracyIntermediateType(in interface{}) (t *accuracyIntermediateType, err error) {
+	tmp := &accuracyIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice repliesIntermediateType will accept a slice to create a slice of repliesIntermediateType
-func deserializeSliceRepliesIntermediateType(in []interface{}) (t []*repliesIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &repliesIntermediateType{}
+// deserializeSlice accuracyIntermediateType will accept a slice to create a slice of accuracyIntermediateType
+func deserializeSliceAccuracyIntermediateType(in []interface{}) (t []*accuracyIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]accuracyIntermediateType, len(in))
+	t = make([]*accuracyIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5181,15 +6262,28 @@ func deserializeSliceRepliesIntermediateType(in []interface{}) (t []*repliesInte
 
 }
 
-// serializerepliesIntermediateType will accept a repliesIntermediateType to create a map
-func serializeRepliesIntermediateType(t *repliesIntermediateType) (i interface{}, err error) {
+// deserializeValuesaccuracyIntermediateType will accept a single value or a slice of them to create a slice of accuracyIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAccuracyIntermediateType(in interface{}) (t []*accuracyIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAccuracyIntermediateType(s)
+	}
+	tmp, err := deserializeAccuracyIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*accuracyIntermediateType{tmp}, nil
+
+}
+
+// serializeaccuracyIntermediateType will accept a accuracyIntermediateType to create a map
+func serializeAccuracyIntermediateType(t *accuracyIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicerepliesIntermediateType will accept a slice of repliesIntermediateType to create a slice result
-func serializeSliceRepliesIntermediateType(s []*repliesIntermediateType) (out []interface{}, err error) {
+// serializeSliceaccuracyIntermediateType will accept a slice of accuracyIntermediateType to create a slice result
+func serializeSliceAccuracyIntermediateType(s []*accuracyIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5201,20 +6295,26 @@ func serializeSliceRepliesIntermediateType(s []*repliesIntermediateType) (out []
 
 }
 
-// deserializestartTimeIntermediateType will accept a map to create a startTimeIntermediateType
-func deserializeStartTimeIntermediateType(in interface{}) (t *startTimeIntermediateType, err error) {
-	tmp := &startTimeIntermediateType{}
+// deserializeactorIntermediateType will accept a map to create a actorIntermediateType
+func deserializeActorIntermediateType(in interface{}) (t *actorIntermediateType, err error) {
+	tmp := &actorIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice startTimeIntermediateType will accept a slice to create a slice of startTimeIntermediateType
-func deserializeSliceStartTimeIntermediateType(in []interface{}) (t []*startTimeIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &startTimeIntermediateType{}
+// deserializeSlice actorIntermediateType will accept a slice to create a slice of actorIntermediateType
+func deserializeSliceActorIntermediateType(in []interface{}) (t []*actorIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]actorIntermediateType, len(in))
+	t = make([]*actorIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5223,15 +6323,28 @@ func deserializeSliceStartTimeIntermediateType(in []interface{}) (t []*startTime
 
 }
 
-// serializestartTimeIntermediateType will accept a startTimeIntermediateType to create a map
-func serializeStartTimeIntermediateType(t *startTimeIntermediateType) (i interface{}, err error) {
+// deserializeValuesactorIntermediateType will accept a single value or a slice of them to create a slice of actorIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesActorIntermediateType(in interface{}) (t []*actorIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceActorIntermediateType(s)
+	}
+	tmp, err := deserializeActorIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*actorIntermediateType{tmp}, nil
+
+}
+
+// serializeactorIntermediateType will accept a actorIntermediateType to create a map
+func serializeActorIntermediateType(t *actorIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicestartTimeIntermediateType will accept a slice of startTimeIntermediateType to create a slice result
-func serializeSliceStartTimeIntermediateType(s []*startTimeIntermediateType) (out []interface{}, err error) {
+// serializeSliceactorIntermediateType will accept a slice of actorIntermediateType to create a slice result
+func serializeSliceActorIntermediateType(s []*actorIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5243,20 +6356,26 @@ func serializeSliceStartTimeIntermediateType(s []*startTimeIntermediateType) (ou
 
 }
 
-// deserializeinboxIntermediateType will accept a map to create a inboxIntermediateType
-func deserializeInboxIntermediateType(in interface{}) (t *inboxIntermediateType, err error) {
-	tmp := &inboxIntermediateType{}
+// deserializealtitudeIntermediateType will accept a map to create a altitudeIntermediateType
+func deserializeAltitudeIntermediateType(in interface{}) (t *altitudeIntermediateType, err error) {
+	tmp := &altitudeIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice inboxIntermediateType will accept a slice to create a slice of inboxIntermediateType
-func deserializeSliceInboxIntermediateType(in []interface{}) (t []*inboxIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &inboxIntermediateType{}
+// deserializeSlice altitudeIntermediateType will accept a slice to create a slice of altitudeIntermediateType
+func deserializeSliceAltitudeIntermediateType(in []interface{}) (t []*altitudeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]altitudeIntermediateType, len(in))
+	t = make([]*altitudeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5265,15 +6384,28 @@ func deserializeSliceInboxIntermediateType(in []interface{}) (t []*inboxIntermed
 
 }
 
-// serializeinboxIntermediateType will accept a inboxIntermediateType to create a map
-func serializeInboxIntermediateType(t *inboxIntermediateType) (i interface{}, err error) {
+// deserializeValuesaltitudeIntermediateType will accept a single value or a slice of them to create a slice of altitudeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAltitudeIntermediateType(in interface{}) (t []*altitudeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAltitudeIntermediateType(s)
+	}
+	tmp, err := deserializeAltitudeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*altitudeIntermediateType{tmp}, nil
+
+}
+
+// serializealtitudeIntermediateType will accept a altitudeIntermediateType to create a map
+func serializeAltitudeIntermediateType(t *altitudeIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceinboxIntermediateType will accept a slice of inboxIntermediateType to create a slice result
-func serializeSliceInboxIntermediateType(s []*inboxIntermediateType) (out []interface{}, err error) {
+// serializeSlicealtitudeIntermediateType will accept a slice of altitudeIntermediateType to create a slice result
+func serializeSliceAltitudeIntermediateType(s []*altitudeIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5285,20 +6417,26 @@ func serializeSliceInboxIntermediateType(s []*inboxIntermediateType) (out []inte
 
 }
 
-// deserializeoriginIntermediateType will accept a map to create a originIntermediateType
-func deserializeOriginIntermediateType(in interface{}) (t *originIntermediateType, err error) {
-	tmp := &originIntermediateType{}
+// deserializeanyOfIntermediateType will accept a map to create a anyOfIntermediateType
+func deserializeAnyOfIntermediateType(in interface{}) (t *anyOfIntermediateType, err error) {
+	tmp := &anyOfIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice originIntermediateType will accept a slice to create a slice of originIntermediateType
-func deserializeSliceOriginIntermediateType(in []interface{}) (t []*originIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &originIntermediateType{}
+// deserializeSlice anyOfIntermediateType will accept a slice to create a slice of anyOfIntermediateType
+func deserializeSliceAnyOfIntermediateType(in []interface{}) (t []*anyOfIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]anyOfIntermediateType, len(in))
+	t = make([]*anyOfIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5307,15 +6445,28 @@ func deserializeSliceOriginIntermediateType(in []interface{}) (t []*originInterm
 
 }
 
-// serializeoriginIntermediateType will accept a originIntermediateType to create a map
-func serializeOriginIntermediateType(t *originIntermediateType) (i interface{}, err error) {
+// deserializeValuesanyOfIntermediateType will accept a single value or a slice of them to create a slice of anyOfIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAnyOfIntermediateType(in interface{}) (t []*anyOfIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAnyOfIntermediateType(s)
+	}
+	tmp, err := deserializeAnyOfIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*anyOfIntermediateType{tmp}, nil
+
+}
+
+// serializeanyOfIntermediateType will accept a anyOfIntermediateType to create a map
+func serializeAnyOfIntermediateType(t *anyOfIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceoriginIntermediateType will accept a slice of originIntermediateType to create a slice result
-func serializeSliceOriginIntermediateType(s []*originIntermediateType) (out []interface{}, err error) {
+// serializeSliceanyOfIntermediateType will accept a slice of anyOfIntermediateType to create a slice result
+func serializeSliceAnyOfIntermediateType(s []*anyOfIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5327,20 +6478,26 @@ func serializeSliceOriginIntermediateType(s []*originIntermediateType) (out []in
 
 }
 
-// deserializefirstIntermediateType will accept a map to create a firstIntermediateType
-func deserializeFirstIntermediateType(in interface{}) (t *firstIntermediateType, err error) {
-	tmp := &firstIntermediateType{}
+// deserializeattachmentIntermediateType will accept a map to create a attachmentIntermediateType
+func deserializeAttachmentIntermediateType(in interface{}) (t *attachmentIntermediateType, err error) {
+	tmp := &attachmentIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice firstIntermediateType will accept a slice to create a slice of firstIntermediateType
-func deserializeSliceFirstIntermediateType(in []interface{}) (t []*firstIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &firstIntermediateType{}
+// deserializeSlice attachmentIntermediateType will accept a slice to create a slice of attachmentIntermediateType
+func deserializeSliceAttachmentIntermediateType(in []interface{}) (t []*attachmentIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]attachmentIntermediateType, len(in))
+	t = make([]*attachmentIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5349,15 +6506,28 @@ func deserializeSliceFirstIntermediateType(in []interface{}) (t []*firstIntermed
 
 }
 
-// serializefirstIntermediateType will accept a firstIntermediateType to create a map
-func serializeFirstIntermediateType(t *firstIntermediateType) (i interface{}, err error) {
+// deserializeValuesattachmentIntermediateType will accept a single value or a slice of them to create a slice of attachmentIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAttachmentIntermediateType(in interface{}) (t []*attachmentIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAttachmentIntermediateType(s)
+	}
+	tmp, err := deserializeAttachmentIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*attachmentIntermediateType{tmp}, nil
+
+}
+
+// serializeattachmentIntermediateType will accept a attachmentIntermediateType to create a map
+func serializeAttachmentIntermediateType(t *attachmentIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicefirstIntermediateType will accept a slice of firstIntermediateType to create a slice result
-func serializeSliceFirstIntermediateType(s []*firstIntermediateType) (out []interface{}, err error) {
+// serializeSliceattachmentIntermediateType will accept a slice of attachmentIntermediateType to create a slice result
+func serializeSliceAttachmentIntermediateType(s []*attachmentIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5369,20 +6539,26 @@ func serializeSliceFirstIntermediateType(s []*firstIntermediateType) (out []inte
 
 }
 
-// deserializeactorIntermediateType will accept a map to create a actorIntermediateType
-func deserializeActorIntermediateType(in interface{}) (t *actorIntermediateType, err error) {
-	tmp := &actorIntermediateType{}
+// deserializeattributedToIntermediateType will accept a map to create a attributedToIntermediateType
+func deserializeAttributedToIntermediateType(in interface{}) (t *attributedToIntermediateType, err error) {
+	tmp := &attributedToIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice actorIntermediateType will accept a slice to create a slice of actorIntermediateType
-func deserializeSliceActorIntermediateType(in []interface{}) (t []*actorIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &actorIntermediateType{}
+// deserializeSlice attributedToIntermediateType will accept a slice to create a slice of attributedToIntermediateType
+func deserializeSliceAttributedToIntermediateType(in []interface{}) (t []*attributedToIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]attributedToIntermediateType, len(in))
+	t = make([]*attributedToIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5391,15 +6567,28 @@ func deserializeSliceActorIntermediateType(in []interface{}) (t []*actorIntermed
 
 }
 
-// serializeactorIntermediateType will accept a actorIntermediateType to create a map
-func serializeActorIntermediateType(t *actorIntermediateType) (i interface{}, err error) {
+// deserializeValuesattributedToIntermediateType will accept a single value or a slice of them to create a slice of attributedToIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAttributedToIntermediateType(in interface{}) (t []*attributedToIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAttributedToIntermediateType(s)
+	}
+	tmp, err := deserializeAttributedToIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*attributedToIntermediateType{tmp}, nil
+
+}
+
+// serializeattributedToIntermediateType will accept a attributedToIntermediateType to create a map
+func serializeAttributedToIntermediateType(t *attributedToIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceactorIntermediateType will accept a slice of actorIntermediateType to create a slice result
-func serializeSliceActorIntermediateType(s []*actorIntermediateType) (out []interface{}, err error) {
+// serializeSliceattributedToIntermediateType will accept a slice of attributedToIntermediateType to create a slice result
+func serializeSliceAttributedToIntermediateType(s []*attributedToIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5411,20 +6600,26 @@ func serializeSliceActorIntermediateType(s []*actorIntermediateType) (out []inte
 
 }
 
-// deserializecurrentIntermediateType will accept a map to create a currentIntermediateType
-func deserializeCurrentIntermediateType(in interface{}) (t *currentIntermediateType, err error) {
-	tmp := &currentIntermediateType{}
+// deserializeaudienceIntermediateType will accept a map to create a audienceIntermediateType
+func deserializeAudienceIntermediateType(in interface{}) (t *audienceIntermediateType, err error) {
+	tmp := &audienceIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice currentIntermediateType will accept a slice to create a slice of currentIntermediateType
-func deserializeSliceCurrentIntermediateType(in []interface{}) (t []*currentIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &currentIntermediateType{}
+// deserializeSlice audienceIntermediateType will accept a slice to create a slice of audienceIntermediateType
+func deserializeSliceAudienceIntermediateType(in []interface{}) (t []*audienceIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]audienceIntermediateType, len(in))
+	t = make([]*audienceIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5433,15 +6628,28 @@ func deserializeSliceCurrentIntermediateType(in []interface{}) (t []*currentInte
 
 }
 
-// serializecurrentIntermediateType will accept a currentIntermediateType to create a map
-func serializeCurrentIntermediateType(t *currentIntermediateType) (i interface{}, err error) {
+// deserializeValuesaudienceIntermediateType will accept a single value or a slice of them to create a slice of audienceIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesAudienceIntermediateType(in interface{}) (t []*audienceIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceAudienceIntermediateType(s)
+	}
+	tmp, err := deserializeAudienceIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*audienceIntermediateType{tmp}, nil
+
+}
+
+// serializeaudienceIntermediateType will accept a audienceIntermediateType to create a map
+func serializeAudienceIntermediateType(t *audienceIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicecurrentIntermediateType will accept a slice of currentIntermediateType to create a slice result
-func serializeSliceCurrentIntermediateType(s []*currentIntermediateType) (out []interface{}, err error) {
+// serializeSliceaudienceIntermediateType will accept a slice of audienceIntermediateType to create a slice result
+func serializeSliceAudienceIntermediateType(s []*audienceIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5453,20 +6661,26 @@ func serializeSliceCurrentIntermediateType(s []*currentIntermediateType) (out []
 
 }
 
-// deserializelatitudeIntermediateType will accept a map to create a latitudeIntermediateType
-func deserializeLatitudeIntermediateType(in interface{}) (t *latitudeIntermediateType, err error) {
-	tmp := &latitudeIntermediateType{}
+// deserializebccIntermediateType will accept a map to create a bccIntermediateType
+func deserializeBccIntermediateType(in interface{}) (t *bccIntermediateType, err error) {
+	tmp := &bccIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice latitudeIntermediateType will accept a slice to create a slice of latitudeIntermediateType
-func deserializeSliceLatitudeIntermediateType(in []interface{}) (t []*latitudeIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &latitudeIntermediateType{}
+// deserializeSlice bccIntermediateType will accept a slice to create a slice of bccIntermediateType
+func deserializeSliceBccIntermediateType(in []interface{}) (t []*bccIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]bccIntermediateType, len(in))
+	t = make([]*bccIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5475,15 +6689,28 @@ func deserializeSliceLatitudeIntermediateType(in []interface{}) (t []*latitudeIn
 
 }
 
-// serializelatitudeIntermediateType will accept a latitudeIntermediateType to create a map
-func serializeLatitudeIntermediateType(t *latitudeIntermediateType) (i interface{}, err error) {
+// deserializeValuesbccIntermediateType will accept a single value or a slice of them to create a slice of bccIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesBccIntermediateType(in interface{}) (t []*bccIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceBccIntermediateType(s)
+	}
+	tmp, err := deserializeBccIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*bccIntermediateType{tmp}, nil
+
+}
+
+// serializebccIntermediateType will accept a bccIntermediateType to create a map
+func serializeBccIntermediateType(t *bccIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicelatitudeIntermediateType will accept a slice of latitudeIntermediateType to create a slice result
-func serializeSliceLatitudeIntermediateType(s []*latitudeIntermediateType) (out []interface{}, err error) {
+// serializeSlicebccIntermediateType will accept a slice of bccIntermediateType to create a slice result
+func serializeSliceBccIntermediateType(s []*bccIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5495,20 +6722,26 @@ func serializeSliceLatitudeIntermediateType(s []*latitudeIntermediateType) (out
 
 }
 
-// deserializeiconIntermediateType will accept a map to create a iconIntermediateType
-func deserializeIconIntermediateType(in interface{}) (t *iconIntermediateType, err error) {
-	tmp := &iconIntermediateType{}
+// deserializebtoIntermediateType will accept a map to create a btoIntermediateType
+func deserializeBtoIntermediateType(in interface{}) (t *btoIntermediateType, err error) {
+	tmp := &btoIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice iconIntermediateType will accept a slice to create a slice of iconIntermediateType
-func deserializeSliceIconIntermediateType(in []interface{}) (t []*iconIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &iconIntermediateType{}
+// deserializeSlice btoIntermediateType will accept a slice to create a slice of btoIntermediateType
+func deserializeSliceBtoIntermediateType(in []interface{}) (t []*btoIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]btoIntermediateType, len(in))
+	t = make([]*btoIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5517,15 +6750,28 @@ func deserializeSliceIconIntermediateType(in []interface{}) (t []*iconIntermedia
 
 }
 
-// serializeiconIntermediateType will accept a iconIntermediateType to create a map
-func serializeIconIntermediateType(t *iconIntermediateType) (i interface{}, err error) {
+// deserializeValuesbtoIntermediateType will accept a single value or a slice of them to create a slice of btoIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesBtoIntermediateType(in interface{}) (t []*btoIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceBtoIntermediateType(s)
+	}
+	tmp, err := deserializeBtoIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*btoIntermediateType{tmp}, nil
+
+}
+
+// serializebtoIntermediateType will accept a btoIntermediateType to create a map
+func serializeBtoIntermediateType(t *btoIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceiconIntermediateType will accept a slice of iconIntermediateType to create a slice result
-func serializeSliceIconIntermediateType(s []*iconIntermediateType) (out []interface{}, err error) {
+// serializeSlicebtoIntermediateType will accept a slice of btoIntermediateType to create a slice result
+func serializeSliceBtoIntermediateType(s []*btoIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5537,20 +6783,26 @@ func serializeSliceIconIntermediateType(s []*iconIntermediateType) (out []interf
 
 }
 
-// deserializebtoIntermediateType will accept a map to create a btoIntermediateType
-func deserializeBtoIntermediateType(in interface{}) (t *btoIntermediateType, err error) {
-	tmp := &btoIntermediateType{}
+// deserializeccIntermediateType will accept a map to create a ccIntermediateType
+func deserializeCcIntermediateType(in interface{}) (t *ccIntermediateType, err error) {
+	tmp := &ccIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice btoIntermediateType will accept a slice to create a slice of btoIntermediateType
-func deserializeSliceBtoIntermediateType(in []interface{}) (t []*btoIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &btoIntermediateType{}
+// deserializeSlice ccIntermediateType will accept a slice to create a slice of ccIntermediateType
+func deserializeSliceCcIntermediateType(in []interface{}) (t []*ccIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]ccIntermediateType, len(in))
+	t = make([]*ccIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5559,15 +6811,28 @@ func deserializeSliceBtoIntermediateType(in []interface{}) (t []*btoIntermediate
 
 }
 
-// serializebtoIntermediateType will accept a btoIntermediateType to create a map
-func serializeBtoIntermediateType(t *btoIntermediateType) (i interface{}, err error) {
+// deserializeValuesccIntermediateType will accept a single value or a slice of them to create a slice of ccIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesCcIntermediateType(in interface{}) (t []*ccIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceCcIntermediateType(s)
+	}
+	tmp, err := deserializeCcIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*ccIntermediateType{tmp}, nil
+
+}
+
+// serializeccIntermediateType will accept a ccIntermediateType to create a map
+func serializeCcIntermediateType(t *ccIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicebtoIntermediateType will accept a slice of btoIntermediateType to create a slice result
-func serializeSliceBtoIntermediateType(s []*btoIntermediateType) (out []interface{}, err error) {
+// serializeSliceccIntermediateType will accept a slice of ccIntermediateType to create a slice result
+func serializeSliceCcIntermediateType(s []*ccIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5579,20 +6844,26 @@ func serializeSliceBtoIntermediateType(s []*btoIntermediateType) (out []interfac
 
 }
 
-// deserializemediaTypeIntermediateType will accept a map to create a mediaTypeIntermediateType
-func deserializeMediaTypeIntermediateType(in interface{}) (t *mediaTypeIntermediateType, err error) {
-	tmp := &mediaTypeIntermediateType{}
+// deserializeclosedIntermediateType will accept a map to create a closedIntermediateType
+func deserializeClosedIntermediateType(in interface{}) (t *closedIntermediateType, err error) {
+	tmp := &closedIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice mediaTypeIntermediateType will accept a slice to create a slice of mediaTypeIntermediateType
-func deserializeSliceMediaTypeIntermediateType(in []interface{}) (t []*mediaTypeIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &mediaTypeIntermediateType{}
+// deserializeSlice closedIntermediateType will accept a slice to create a slice of closedIntermediateType
+func deserializeSliceClosedIntermediateType(in []interface{}) (t []*closedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]closedIntermediateType, len(in))
+	t = make([]*closedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5601,15 +6872,28 @@ func deserializeSliceMediaTypeIntermediateType(in []interface{}) (t []*mediaType
 
 }
 
-// serializemediaTypeIntermediateType will accept a mediaTypeIntermediateType to create a map
-func serializeMediaTypeIntermediateType(t *mediaTypeIntermediateType) (i interface{}, err error) {
+// deserializeValuesclosedIntermediateType will accept a single value or a slice of them to create a slice of closedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesClosedIntermediateType(in interface{}) (t []*closedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceClosedIntermediateType(s)
+	}
+	tmp, err := deserializeClosedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*closedIntermediateType{tmp}, nil
+
+}
+
+// serializeclosedIntermediateType will accept a closedIntermediateType to create a map
+func serializeClosedIntermediateType(t *closedIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicemediaTypeIntermediateType will accept a slice of mediaTypeIntermediateType to create a slice result
-func serializeSliceMediaTypeIntermediateType(s []*mediaTypeIntermediateType) (out []interface{}, err error) {
+// serializeSliceclosedIntermediateType will accept a slice of closedIntermediateType to create a slice result
+func serializeSliceClosedIntermediateType(s []*closedIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5621,20 +6905,26 @@ func serializeSliceMediaTypeIntermediateType(s []*mediaTypeIntermediateType) (ou
 
 }
 
-// deserializeresultIntermediateType will accept a map to create a resultIntermediateType
-func deserializeResultIntermediateType(in interface{}) (t *resultIntermediateType, err error) {
-	tmp := &resultIntermediateType{}
+// deserializecontentIntermediateType will accept a map to create a contentIntermediateType
+func deserializeContentIntermediateType(in interface{}) (t *contentIntermediateType, err error) {
+	tmp := &contentIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice resultIntermediateType will accept a slice to create a slice of resultIntermediateType
-func deserializeSliceResultIntermediateType(in []interface{}) (t []*resultIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &resultIntermediateType{}
+// deserializeSlice contentIntermediateType will accept a slice to create a slice of contentIntermediateType
+func deserializeSliceContentIntermediateType(in []interface{}) (t []*contentIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]contentIntermediateType, len(in))
+	t = make([]*contentIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5643,15 +6933,28 @@ func deserializeSliceResultIntermediateType(in []interface{}) (t []*resultInterm
 
 }
 
-// serializeresultIntermediateType will accept a resultIntermediateType to create a map
-func serializeResultIntermediateType(t *resultIntermediateType) (i interface{}, err error) {
+// deserializeValuescontentIntermediateType will accept a single value or a slice of them to create a slice of contentIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesContentIntermediateType(in interface{}) (t []*contentIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceContentIntermediateType(s)
+	}
+	tmp, err := deserializeContentIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*contentIntermediateType{tmp}, nil
+
+}
+
+// serializecontentIntermediateType will accept a contentIntermediateType to create a map
+func serializeContentIntermediateType(t *contentIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceresultIntermediateType will accept a slice of resultIntermediateType to create a slice result
-func serializeSliceResultIntermediateType(s []*resultIntermediateType) (out []interface{}, err error) {
+// serializeSlicecontentIntermediateType will accept a slice of contentIntermediateType to create a slice result
+func serializeSliceContentIntermediateType(s []*contentIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5663,20 +6966,26 @@ func serializeSliceResultIntermediateType(s []*resultIntermediateType) (out []in
 
 }
 
-// deserializenameIntermediateType will accept a map to create a nameIntermediateType
-func deserializeNameIntermediateType(in interface{}) (t *nameIntermediateType, err error) {
-	tmp := &nameIntermediateType{}
+// deserializecontextIntermediateType will accept a map to create a contextIntermediateType
+func deserializeContextIntermediateType(in interface{}) (t *contextIntermediateType, err error) {
+	tmp := &contextIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice nameIntermediateType will accept a slice to create a slice of nameIntermediateType
-func deserializeSliceNameIntermediateType(in []interface{}) (t []*nameIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &nameIntermediateType{}
+// deserializeSlice contextIntermediateType will accept a slice to create a slice of contextIntermediateType
+func deserializeSliceContextIntermediateType(in []interface{}) (t []*contextIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]contextIntermediateType, len(in))
+	t = make([]*contextIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5685,15 +6994,28 @@ func deserializeSliceNameIntermediateType(in []interface{}) (t []*nameIntermedia
 
 }
 
-// serializenameIntermediateType will accept a nameIntermediateType to create a map
-func serializeNameIntermediateType(t *nameIntermediateType) (i interface{}, err error) {
+// deserializeValuescontextIntermediateType will accept a single value or a slice of them to create a slice of contextIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesContextIntermediateType(in interface{}) (t []*contextIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceContextIntermediateType(s)
+	}
+	tmp, err := deserializeContextIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*contextIntermediateType{tmp}, nil
+
+}
+
+// serializecontextIntermediateType will accept a contextIntermediateType to create a map
+func serializeContextIntermediateType(t *contextIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicenameIntermediateType will accept a slice of nameIntermediateType to create a slice result
-func serializeSliceNameIntermediateType(s []*nameIntermediateType) (out []interface{}, err error) {
+// serializeSlicecontextIntermediateType will accept a slice of contextIntermediateType to create a slice result
+func serializeSliceContextIntermediateType(s []*contextIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5705,20 +7027,26 @@ func serializeSliceNameIntermediateType(s []*nameIntermediateType) (out []interf
 
 }
 
-// deserializeitemsIntermediateType will accept a map to create a itemsIntermediateType
-func deserializeItemsIntermediateType(in interface{}) (t *itemsIntermediateType, err error) {
-	tmp := &itemsIntermediateType{}
+// deserializecurrentIntermediateType will accept a map to create a currentIntermediateType
+func deserializeCurrentIntermediateType(in interface{}) (t *currentIntermediateType, err error) {
+	tmp := &currentIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice itemsIntermediateType will accept a slice to create a slice of itemsIntermediateType
-func deserializeSliceItemsIntermediateType(in []interface{}) (t []*itemsIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &itemsIntermediateType{}
+// deserializeSlice currentIntermediateType will accept a slice to create a slice of currentIntermediateType
+func deserializeSliceCurrentIntermediateType(in []interface{}) (t []*currentIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]currentIntermediateType, len(in))
+	t = make([]*currentIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5727,15 +7055,28 @@ func deserializeSliceItemsIntermediateType(in []interface{}) (t []*itemsIntermed
 
 }
 
-// serializeitemsIntermediateType will accept a itemsIntermediateType to create a map
-func serializeItemsIntermediateType(t *itemsIntermediateType) (i interface{}, err error) {
+// deserializeValuescurrentIntermediateType will accept a single value or a slice of them to create a slice of currentIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesCurrentIntermediateType(in interface{}) (t []*currentIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceCurrentIntermediateType(s)
+	}
+	tmp, err := deserializeCurrentIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*currentIntermediateType{tmp}, nil
+
+}
+
+// serializecurrentIntermediateType will accept a currentIntermediateType to create a map
+func serializeCurrentIntermediateType(t *currentIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceitemsIntermediateType will accept a slice of itemsIntermediateType to create a slice result
-func serializeSliceItemsIntermediateType(s []*itemsIntermediateType) (out []interface{}, err error) {
+// serializeSlicecurrentIntermediateType will accept a slice of currentIntermediateType to create a slice result
+func serializeSliceCurrentIntermediateType(s []*currentIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5747,20 +7088,26 @@ func serializeSliceItemsIntermediateType(s []*itemsIntermediateType) (out []inte
 
 }
 
-// deserializepartOfIntermediateType will accept a map to create a partOfIntermediateType
-func deserializePartOfIntermediateType(in interface{}) (t *partOfIntermediateType, err error) {
-	tmp := &partOfIntermediateType{}
+// deserializedeletedIntermediateType will accept a map to create a deletedIntermediateType
+func deserializeDeletedIntermediateType(in interface{}) (t *deletedIntermediateType, err error) {
+	tmp := &deletedIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice partOfIntermediateType will accept a slice to create a slice of partOfIntermediateType
-func deserializeSlicePartOfIntermediateType(in []interface{}) (t []*partOfIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &partOfIntermediateType{}
+// deserializeSlice deletedIntermediateType will accept a slice to create a slice of deletedIntermediateType
+func deserializeSliceDeletedIntermediateType(in []interface{}) (t []*deletedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]deletedIntermediateType, len(in))
+	t = make([]*deletedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5769,15 +7116,28 @@ func deserializeSlicePartOfIntermediateType(in []interface{}) (t []*partOfInterm
 
 }
 
-// serializepartOfIntermediateType will accept a partOfIntermediateType to create a map
-func serializePartOfIntermediateType(t *partOfIntermediateType) (i interface{}, err error) {
+// deserializeValuesdeletedIntermediateType will accept a single value or a slice of them to create a slice of deletedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesDeletedIntermediateType(in interface{}) (t []*deletedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceDeletedIntermediateType(s)
+	}
+	tmp, err := deserializeDeletedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*deletedIntermediateType{tmp}, nil
+
+}
+
+// serializedeletedIntermediateType will accept a deletedIntermediateType to create a map
+func serializeDeletedIntermediateType(t *deletedIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicepartOfIntermediateType will accept a slice of partOfIntermediateType to create a slice result
-func serializeSlicePartOfIntermediateType(s []*partOfIntermediateType) (out []interface{}, err error) {
+// serializeSlicedeletedIntermediateType will accept a slice of deletedIntermediateType to create a slice result
+func serializeSliceDeletedIntermediateType(s []*deletedIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5789,20 +7149,26 @@ func serializeSlicePartOfIntermediateType(s []*partOfIntermediateType) (out []in
 
 }
 
-// deserializeupdatedIntermediateType will accept a map to create a updatedIntermediateType
-func deserializeUpdatedIntermediateType(in interface{}) (t *updatedIntermediateType, err error) {
-	tmp := &updatedIntermediateType{}
+// deserializedescribesIntermediateType will accept a map to create a describesIntermediateType
+func deserializeDescribesIntermediateType(in interface{}) (t *describesIntermediateType, err error) {
+	tmp := &describesIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice updatedIntermediateType will accept a slice to create a slice of updatedIntermediateType
-func deserializeSliceUpdatedIntermediateType(in []interface{}) (t []*updatedIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &updatedIntermediateType{}
+// deserializeSlice describesIntermediateType will accept a slice to create a slice of describesIntermediateType
+func deserializeSliceDescribesIntermediateType(in []interface{}) (t []*describesIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]describesIntermediateType, len(in))
+	t = make([]*describesIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5811,15 +7177,28 @@ func deserializeSliceUpdatedIntermediateType(in []interface{}) (t []*updatedInte
 
 }
 
-// serializeupdatedIntermediateType will accept a updatedIntermediateType to create a map
-func serializeUpdatedIntermediateType(t *updatedIntermediateType) (i interface{}, err error) {
+// deserializeValuesdescribesIntermediateType will accept a single value or a slice of them to create a slice of describesIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesDescribesIntermediateType(in interface{}) (t []*describesIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceDescribesIntermediateType(s)
+	}
+	tmp, err := deserializeDescribesIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*describesIntermediateType{tmp}, nil
+
+}
+
+// serializedescribesIntermediateType will accept a describesIntermediateType to create a map
+func serializeDescribesIntermediateType(t *describesIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceupdatedIntermediateType will accept a slice of updatedIntermediateType to create a slice result
-func serializeSliceUpdatedIntermediateType(s []*updatedIntermediateType) (out []interface{}, err error) {
+// serializeSlicedescribesIntermediateType will accept a slice of describesIntermediateType to create a slice result
+func serializeSliceDescribesIntermediateType(s []*describesIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5831,20 +7210,26 @@ func serializeSliceUpdatedIntermediateType(s []*updatedIntermediateType) (out []
 
 }
 
-// deserializeaccuracyIntermediateType will accept a map to create a accuracyIntermediateType
-func deserializeAccuracyIntermediateType(in interface{}) (t *accuracyIntermediateType, err error) {
-	tmp := &accuracyIntermediateType{}
+// deserializedurationIntermediateType will accept a map to create a durationIntermediateType
+func deserializeDurationIntermediateType(in interface{}) (t *durationIntermediateType, err error) {
+	tmp := &durationIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice accuracyIntermediateType will accept a slice to create a slice of accuracyIntermediateType
-func deserializeSliceAccuracyIntermediateType(in []interface{}) (t []*accuracyIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &accuracyIntermediateType{}
+// deserializeSlice durationIntermediateType will accept a slice to create a slice of durationIntermediateType
+func deserializeSliceDurationIntermediateType(in []interface{}) (t []*durationIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]durationIntermediateType, len(in))
+	t = make([]*durationIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5853,15 +7238,28 @@ func deserializeSliceAccuracyIntermediateType(in []interface{}) (t []*accuracyIn
 
 }
 
-// serializeaccuracyIntermediateType will accept a accuracyIntermediateType to create a map
-func serializeAccuracyIntermediateType(t *accuracyIntermediateType) (i interface{}, err error) {
+// deserializeValuesdurationIntermediateType will accept a single value or a slice of them to create a slice of durationIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesDurationIntermediateType(in interface{}) (t []*durationIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceDurationIntermediateType(s)
+	}
+	tmp, err := deserializeDurationIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*durationIntermediateType{tmp}, nil
+
+}
+
+// serializedurationIntermediateType will accept a durationIntermediateType to create a map
+func serializeDurationIntermediateType(t *durationIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceaccuracyIntermediateType will accept a slice of accuracyIntermediateType to create a slice result
-func serializeSliceAccuracyIntermediateType(s []*accuracyIntermediateType) (out []interface{}, err error) {
+// serializeSlicedurationIntermediateType will accept a slice of durationIntermediateType to create a slice result
+func serializeSliceDurationIntermediateType(s []*durationIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5873,20 +7271,26 @@ func serializeSliceAccuracyIntermediateType(s []*accuracyIntermediateType) (out
 
 }
 
-// deserializelongitudeIntermediateType will accept a map to create a longitudeIntermediateType
-func deserializeLongitudeIntermediateType(in interface{}) (t *longitudeIntermediateType, err error) {
-	tmp := &longitudeIntermediateType{}
+// deserializeendTimeIntermediateType will accept a map to create a endTimeIntermediateType
+func deserializeEndTimeIntermediateType(in interface{}) (t *endTimeIntermediateType, err error) {
+	tmp := &endTimeIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice longitudeIntermediateType will accept a slice to create a slice of longitudeIntermediateType
-func deserializeSliceLongitudeIntermediateType(in []interface{}) (t []*longitudeIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &longitudeIntermediateType{}
+// deserializeSlice endTimeIntermediateType will accept a slice to create a slice of endTimeIntermediateType
+func deserializeSliceEndTimeIntermediateType(in []interface{}) (t []*endTimeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]endTimeIntermediateType, len(in))
+	t = make([]*endTimeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5895,15 +7299,28 @@ func deserializeSliceLongitudeIntermediateType(in []interface{}) (t []*longitude
 
 }
 
-// serializelongitudeIntermediateType will accept a longitudeIntermediateType to create a map
-func serializeLongitudeIntermediateType(t *longitudeIntermediateType) (i interface{}, err error) {
+// deserializeValuesendTimeIntermediateType will accept a single value or a slice of them to create a slice of endTimeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesEndTimeIntermediateType(in interface{}) (t []*endTimeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceEndTimeIntermediateType(s)
+	}
+	tmp, err := deserializeEndTimeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*endTimeIntermediateType{tmp}, nil
+
+}
+
+// serializeendTimeIntermediateType will accept a endTimeIntermediateType to create a map
+func serializeEndTimeIntermediateType(t *endTimeIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicelongitudeIntermediateType will accept a slice of longitudeIntermediateType to create a slice result
-func serializeSliceLongitudeIntermediateType(s []*longitudeIntermediateType) (out []interface{}, err error) {
+// serializeSliceendTimeIntermediateType will accept a slice of endTimeIntermediateType to create a slice result
+func serializeSliceEndTimeIntermediateType(s []*endTimeIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5915,20 +7332,26 @@ func serializeSliceLongitudeIntermediateType(s []*longitudeIntermediateType) (ou
 
 }
 
-// deserializegeneratorIntermediateType will accept a map to create a generatorIntermediateType
-func deserializeGeneratorIntermediateType(in interface{}) (t *generatorIntermediateType, err error) {
-	tmp := &generatorIntermediateType{}
+// deserializeendpointsIntermediateType will accept a map to create a endpointsIntermediateType
+func deserializeEndpointsIntermediateType(in interface{}) (t *endpointsIntermediateType, err error) {
+	tmp := &endpointsIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice generatorIntermediateType will accept a slice to create a slice of generatorIntermediateType
-func deserializeSliceGeneratorIntermediateType(in []interface{}) (t []*generatorIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &generatorIntermediateType{}
+// deserializeSlice endpointsIntermediateType will accept a slice to create a slice of endpointsIntermediateType
+func deserializeSliceEndpointsIntermediateType(in []interface{}) (t []*endpointsIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]endpointsIntermediateType, len(in))
+	t = make([]*endpointsIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5937,15 +7360,28 @@ func deserializeSliceGeneratorIntermediateType(in []interface{}) (t []*generator
 
 }
 
-// serializegeneratorIntermediateType will accept a generatorIntermediateType to create a map
-func serializeGeneratorIntermediateType(t *generatorIntermediateType) (i interface{}, err error) {
+// deserializeValuesendpointsIntermediateType will accept a single value or a slice of them to create a slice of endpointsIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesEndpointsIntermediateType(in interface{}) (t []*endpointsIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceEndpointsIntermediateType(s)
+	}
+	tmp, err := deserializeEndpointsIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*endpointsIntermediateType{tmp}, nil
+
+}
+
+// serializeendpointsIntermediateType will accept a endpointsIntermediateType to create a map
+func serializeEndpointsIntermediateType(t *endpointsIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicegeneratorIntermediateType will accept a slice of generatorIntermediateType to create a slice result
-func serializeSliceGeneratorIntermediateType(s []*generatorIntermediateType) (out []interface{}, err error) {
+// serializeSliceendpointsIntermediateType will accept a slice of endpointsIntermediateType to create a slice result
+func serializeSliceEndpointsIntermediateType(s []*endpointsIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5957,20 +7393,26 @@ func serializeSliceGeneratorIntermediateType(s []*generatorIntermediateType) (ou
 
 }
 
-// deserializesharesIntermediateType will accept a map to create a sharesIntermediateType
-func deserializeSharesIntermediateType(in interface{}) (t *sharesIntermediateType, err error) {
-	tmp := &sharesIntermediateType{}
+// deserializefirstIntermediateType will accept a map to create a firstIntermediateType
+func deserializeFirstIntermediateType(in interface{}) (t *firstIntermediateType, err error) {
+	tmp := &firstIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice sharesIntermediateType will accept a slice to create a slice of sharesIntermediateType
-func deserializeSliceSharesIntermediateType(in []interface{}) (t []*sharesIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &sharesIntermediateType{}
+// deserializeSlice firstIntermediateType will accept a slice to create a slice of firstIntermediateType
+func deserializeSliceFirstIntermediateType(in []interface{}) (t []*firstIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]firstIntermediateType, len(in))
+	t = make([]*firstIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -5979,15 +7421,28 @@ func deserializeSliceSharesIntermediateType(in []interface{}) (t []*sharesInterm
 
 }
 
-// serializesharesIntermediateType will accept a sharesIntermediateType to create a map
-func serializeSharesIntermediateType(t *sharesIntermediateType) (i interface{}, err error) {
+// deserializeValuesfirstIntermediateType will accept a single value or a slice of them to create a slice of firstIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesFirstIntermediateType(in interface{}) (t []*firstIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceFirstIntermediateType(s)
+	}
+	tmp, err := deserializeFirstIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*firstIntermediateType{tmp}, nil
+
+}
+
+// serializefirstIntermediateType will accept a firstIntermediateType to create a map
+func serializeFirstIntermediateType(t *firstIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicesharesIntermediateType will accept a slice of sharesIntermediateType to create a slice result
-func serializeSliceSharesIntermediateType(s []*sharesIntermediateType) (out []interface{}, err error) {
+// serializeSlicefirstIntermediateType will accept a slice of firstIntermediateType to create a slice result
+func serializeSliceFirstIntermediateType(s []*firstIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -5999,20 +7454,26 @@ func serializeSliceSharesIntermediateType(s []*sharesIntermediateType) (out []in
 
 }
 
-// deserializehreflangIntermediateType will accept a map to create a hreflangIntermediateType
-func deserializeHreflangIntermediateType(in interface{}) (t *hreflangIntermediateType, err error) {
-	tmp := &hreflangIntermediateType{}
+// deserializefollowersIntermediateType will accept a map to create a followersIntermediateType
+func deserializeFollowersIntermediateType(in interface{}) (t *followersIntermediateType, err error) {
+	tmp := &followersIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice hreflangIntermediateType will accept a slice to create a slice of hreflangIntermediateType
-func deserializeSliceHreflangIntermediateType(in []interface{}) (t []*hreflangIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &hreflangIntermediateType{}
+// deserializeSlice followersIntermediateType will accept a slice to create a slice of followersIntermediateType
+func deserializeSliceFollowersIntermediateType(in []interface{}) (t []*followersIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]followersIntermediateType, len(in))
+	t = make([]*followersIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6021,15 +7482,28 @@ func deserializeSliceHreflangIntermediateType(in []interface{}) (t []*hreflangIn
 
 }
 
-// serializehreflangIntermediateType will accept a hreflangIntermediateType to create a map
-func serializeHreflangIntermediateType(t *hreflangIntermediateType) (i interface{}, err error) {
+// deserializeValuesfollowersIntermediateType will accept a single value or a slice of them to create a slice of followersIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesFollowersIntermediateType(in interface{}) (t []*followersIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceFollowersIntermediateType(s)
+	}
+	tmp, err := deserializeFollowersIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*followersIntermediateType{tmp}, nil
+
+}
+
+// serializefollowersIntermediateType will accept a followersIntermediateType to create a map
+func serializeFollowersIntermediateType(t *followersIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicehreflangIntermediateType will accept a slice of hreflangIntermediateType to create a slice result
-func serializeSliceHreflangIntermediateType(s []*hreflangIntermediateType) (out []interface{}, err error) {
+// serializeSlicefollowersIntermediateType will accept a slice of followersIntermediateType to create a slice result
+func serializeSliceFollowersIntermediateType(s []*followersIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6041,20 +7515,26 @@ func serializeSliceHreflangIntermediateType(s []*hreflangIntermediateType) (out
 
 }
 
-// deserializestartIndexIntermediateType will accept a map to create a startIndexIntermediateType
-func deserializeStartIndexIntermediateType(in interface{}) (t *startIndexIntermediateType, err error) {
-	tmp := &startIndexIntermediateType{}
+// deserializefollowingIntermediateType will accept a map to create a followingIntermediateType
+func deserializeFollowingIntermediateType(in interface{}) (t *followingIntermediateType, err error) {
+	tmp := &followingIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice startIndexIntermediateType will accept a slice to create a slice of startIndexIntermediateType
-func deserializeSliceStartIndexIntermediateType(in []interface{}) (t []*startIndexIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &startIndexIntermediateType{}
+// deserializeSlice followingIntermediateType will accept a slice to create a slice of followingIntermediateType
+func deserializeSliceFollowingIntermediateType(in []interface{}) (t []*followingIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]followingIntermediateType, len(in))
+	t = make([]*followingIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6063,15 +7543,28 @@ func deserializeSliceStartIndexIntermediateType(in []interface{}) (t []*startInd
 
 }
 
-// serializestartIndexIntermediateType will accept a startIndexIntermediateType to create a map
-func serializeStartIndexIntermediateType(t *startIndexIntermediateType) (i interface{}, err error) {
+// deserializeValuesfollowingIntermediateType will accept a single value or a slice of them to create a slice of followingIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesFollowingIntermediateType(in interface{}) (t []*followingIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceFollowingIntermediateType(s)
+	}
+	tmp, err := deserializeFollowingIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*followingIntermediateType{tmp}, nil
+
+}
+
+// serializefollowingIntermediateType will accept a followingIntermediateType to create a map
+func serializeFollowingIntermediateType(t *followingIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicestartIndexIntermediateType will accept a slice of startIndexIntermediateType to create a slice result
-func serializeSliceStartIndexIntermediateType(s []*startIndexIntermediateType) (out []interface{}, err error) {
+// serializeSlicefollowingIntermediateType will accept a slice of followingIntermediateType to create a slice result
+func serializeSliceFollowingIntermediateType(s []*followingIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6083,20 +7576,26 @@ func serializeSliceStartIndexIntermediateType(s []*startIndexIntermediateType) (
 
 }
 
-// deserializeanyOfIntermediateType will accept a map to create a anyOfIntermediateType
-func deserializeAnyOfIntermediateType(in interface{}) (t *anyOfIntermediateType, err error) {
-	tmp := &anyOfIntermediateType{}
+// deserializeformerTypeIntermediateType will accept a map to create a formerTypeIntermediateType
+func deserializeFormerTypeIntermediateType(in interface{}) (t *formerTypeIntermediateType, err error) {
+	tmp := &formerTypeIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice anyOfIntermediateType will accept a slice to create a slice of anyOfIntermediateType
-func deserializeSliceAnyOfIntermediateType(in []interface{}) (t []*anyOfIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &anyOfIntermediateType{}
+// deserializeSlice formerTypeIntermediateType will accept a slice to create a slice of formerTypeIntermediateType
+func deserializeSliceFormerTypeIntermediateType(in []interface{}) (t []*formerTypeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]formerTypeIntermediateType, len(in))
+	t = make([]*formerTypeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6105,15 +7604,28 @@ func deserializeSliceAnyOfIntermediateType(in []interface{}) (t []*anyOfIntermed
 
 }
 
-// serializeanyOfIntermediateType will accept a anyOfIntermediateType to create a map
-func serializeAnyOfIntermediateType(t *anyOfIntermediateType) (i interface{}, err error) {
+// deserializeValuesformerTypeIntermediateType will accept a single value or a slice of them to create a slice of formerTypeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesFormerTypeIntermediateType(in interface{}) (t []*formerTypeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceFormerTypeIntermediateType(s)
+	}
+	tmp, err := deserializeFormerTypeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*formerTypeIntermediateType{tmp}, nil
+
+}
+
+// serializeformerTypeIntermediateType will accept a formerTypeIntermediateType to create a map
+func serializeFormerTypeIntermediateType(t *formerTypeIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceanyOfIntermediateType will accept a slice of anyOfIntermediateType to create a slice result
-func serializeSliceAnyOfIntermediateType(s []*anyOfIntermediateType) (out []interface{}, err error) {
+// serializeSliceformerTypeIntermediateType will accept a slice of formerTypeIntermediateType to create a slice result
+func serializeSliceFormerTypeIntermediateType(s []*formerTypeIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6125,20 +7637,26 @@ func serializeSliceAnyOfIntermediateType(s []*anyOfIntermediateType) (out []inte
 
 }
 
-// deserializedescribesIntermediateType will accept a map to create a describesIntermediateType
-func deserializeDescribesIntermediateType(in interface{}) (t *describesIntermediateType, err error) {
-	tmp := &describesIntermediateType{}
+// deserializegeneratorIntermediateType will accept a map to create a generatorIntermediateType
+func deserializeGeneratorIntermediateType(in interface{}) (t *generatorIntermediateType, err error) {
+	tmp := &generatorIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice describesIntermediateType will accept a slice to create a slice of describesIntermediateType
-func deserializeSliceDescribesIntermediateType(in []interface{}) (t []*describesIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &describesIntermediateType{}
+// deserializeSlice generatorIntermediateType will accept a slice to create a slice of generatorIntermediateType
+func deserializeSliceGeneratorIntermediateType(in []interface{}) (t []*generatorIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]generatorIntermediateType, len(in))
+	t = make([]*generatorIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6147,15 +7665,28 @@ func deserializeSliceDescribesIntermediateType(in []interface{}) (t []*describes
 
 }
 
-// serializedescribesIntermediateType will accept a describesIntermediateType to create a map
-func serializeDescribesIntermediateType(t *describesIntermediateType) (i interface{}, err error) {
+// deserializeValuesgeneratorIntermediateType will accept a single value or a slice of them to create a slice of generatorIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesGeneratorIntermediateType(in interface{}) (t []*generatorIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceGeneratorIntermediateType(s)
+	}
+	tmp, err := deserializeGeneratorIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*generatorIntermediateType{tmp}, nil
+
+}
+
+// serializegeneratorIntermediateType will accept a generatorIntermediateType to create a map
+func serializeGeneratorIntermediateType(t *generatorIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicedescribesIntermediateType will accept a slice of describesIntermediateType to create a slice result
-func serializeSliceDescribesIntermediateType(s []*describesIntermediateType) (out []interface{}, err error) {
+// serializeSlicegeneratorIntermediateType will accept a slice of generatorIntermediateType to create a slice result
+func serializeSliceGeneratorIntermediateType(s []*generatorIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6167,20 +7698,26 @@ func serializeSliceDescribesIntermediateType(s []*describesIntermediateType) (ou
 
 }
 
-// deserializeoutboxIntermediateType will accept a map to create a outboxIntermediateType
-func deserializeOutboxIntermediateType(in interface{}) (t *outboxIntermediateType, err error) {
-	tmp := &outboxIntermediateType{}
+// deserializeheightIntermediateType will accept a map to create a heightIntermediateType
+func deserializeHeightIntermediateType(in interface{}) (t *heightIntermediateType, err error) {
+	tmp := &heightIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice outboxIntermediateType will accept a slice to create a slice of outboxIntermediateType
-func deserializeSliceOutboxIntermediateType(in []interface{}) (t []*outboxIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &outboxIntermediateType{}
+// deserializeSlice heightIntermediateType will accept a slice to create a slice of heightIntermediateType
+func deserializeSliceHeightIntermediateType(in []interface{}) (t []*heightIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]heightIntermediateType, len(in))
+	t = make([]*heightIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6189,15 +7726,28 @@ func deserializeSliceOutboxIntermediateType(in []interface{}) (t []*outboxInterm
 
 }
 
-// serializeoutboxIntermediateType will accept a outboxIntermediateType to create a map
-func serializeOutboxIntermediateType(t *outboxIntermediateType) (i interface{}, err error) {
+// deserializeValuesheightIntermediateType will accept a single value or a slice of them to create a slice of heightIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesHeightIntermediateType(in interface{}) (t []*heightIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceHeightIntermediateType(s)
+	}
+	tmp, err := deserializeHeightIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*heightIntermediateType{tmp}, nil
+
+}
+
+// serializeheightIntermediateType will accept a heightIntermediateType to create a map
+func serializeHeightIntermediateType(t *heightIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceoutboxIntermediateType will accept a slice of outboxIntermediateType to create a slice result
-func serializeSliceOutboxIntermediateType(s []*outboxIntermediateType) (out []interface{}, err error) {
+// serializeSliceheightIntermediateType will accept a slice of heightIntermediateType to create a slice result
+func serializeSliceHeightIntermediateType(s []*heightIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6209,20 +7759,26 @@ func serializeSliceOutboxIntermediateType(s []*outboxIntermediateType) (out []in
 
 }
 
-// deserializeunitsIntermediateType will accept a map to create a unitsIntermediateType
-func deserializeUnitsIntermediateType(in interface{}) (t *unitsIntermediateType, err error) {
-	tmp := &unitsIntermediateType{}
+// deserializehreflangIntermediateType will accept a map to create a hreflangIntermediateType
+func deserializeHreflangIntermediateType(in interface{}) (t *hreflangIntermediateType, err error) {
+	tmp := &hreflangIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice unitsIntermediateType will accept a slice to create a slice of unitsIntermediateType
-func deserializeSliceUnitsIntermediateType(in []interface{}) (t []*unitsIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &unitsIntermediateType{}
+// deserializeSlice hreflangIntermediateType will accept a slice to create a slice of hreflangIntermediateType
+func deserializeSliceHreflangIntermediateType(in []interface{}) (t []*hreflangIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]hreflangIntermediateType, len(in))
+	t = make([]*hreflangIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6231,15 +7787,28 @@ func deserializeSliceUnitsIntermediateType(in []interface{}) (t []*unitsIntermed
 
 }
 
-// serializeunitsIntermediateType will accept a unitsIntermediateType to create a map
-func serializeUnitsIntermediateType(t *unitsIntermediateType) (i interface{}, err error) {
+// deserializeValueshreflangIntermediateType will accept a single value or a slice of them to create a slice of hreflangIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesHreflangIntermediateType(in interface{}) (t []*hreflangIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceHreflangIntermediateType(s)
+	}
+	tmp, err := deserializeHreflangIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*hreflangIntermediateType{tmp}, nil
+
+}
+
+// serializehreflangIntermediateType will accept a hreflangIntermediateType to create a map
+func serializeHreflangIntermediateType(t *hreflangIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceunitsIntermediateType will accept a slice of unitsIntermediateType to create a slice result
-func serializeSliceUnitsIntermediateType(s []*unitsIntermediateType) (out []interface{}, err error) {
+// serializeSlicehreflangIntermediateType will accept a slice of hreflangIntermediateType to create a slice result
+func serializeSliceHreflangIntermediateType(s []*hreflangIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6251,20 +7820,26 @@ func serializeSliceUnitsIntermediateType(s []*unitsIntermediateType) (out []inte
 
 }
 
-// deserializeendTimeIntermediateType will accept a map to create a endTimeIntermediateType
-func deserializeEndTimeIntermediateType(in interface{}) (t *endTimeIntermediateType, err error) {
-	tmp := &endTimeIntermediateType{}
+// deserializeiconIntermediateType will accept a map to create a iconIntermediateType
+func deserializeIconIntermediateType(in interface{}) (t *iconIntermediateType, err error) {
+	tmp := &iconIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice endTimeIntermediateType will accept a slice to create a slice of endTimeIntermediateType
-func deserializeSliceEndTimeIntermediateType(in []interface{}) (t []*endTimeIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &endTimeIntermediateType{}
+// deserializeSlice iconIntermediateType will accept a slice to create a slice of iconIntermediateType
+func deserializeSliceIconIntermediateType(in []interface{}) (t []*iconIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]iconIntermediateType, len(in))
+	t = make([]*iconIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6273,15 +7848,28 @@ func deserializeSliceEndTimeIntermediateType(in []interface{}) (t []*endTimeInte
 
 }
 
-// serializeendTimeIntermediateType will accept a endTimeIntermediateType to create a map
-func serializeEndTimeIntermediateType(t *endTimeIntermediateType) (i interface{}, err error) {
+// deserializeValuesiconIntermediateType will accept a single value or a slice of them to create a slice of iconIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesIconIntermediateType(in interface{}) (t []*iconIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceIconIntermediateType(s)
+	}
+	tmp, err := deserializeIconIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*iconIntermediateType{tmp}, nil
+
+}
+
+// serializeiconIntermediateType will accept a iconIntermediateType to create a map
+func serializeIconIntermediateType(t *iconIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceendTimeIntermediateType will accept a slice of endTimeIntermediateType to create a slice result
-func serializeSliceEndTimeIntermediateType(s []*endTimeIntermediateType) (out []interface{}, err error) {
+// serializeSliceiconIntermediateType will accept a slice of iconIntermediateType to create a slice result
+func serializeSliceIconIntermediateType(s []*iconIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6293,20 +7881,26 @@ func serializeSliceEndTimeIntermediateType(s []*endTimeIntermediateType) (out []
 
 }
 
-// deserializepublishedIntermediateType will accept a map to create a publishedIntermediateType
-func deserializePublishedIntermediateType(in interface{}) (t *publishedIntermediateType, err error) {
-	tmp := &publishedIntermediateType{}
+// deserializeimageIntermediateType will accept a map to create a imageIntermediateType
+func deserializeImageIntermediateType(in interface{}) (t *imageIntermediateType, err error) {
+	tmp := &imageIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice publishedIntermediateType will accept a slice to create a slice of publishedIntermediateType
-func deserializeSlicePublishedIntermediateType(in []interface{}) (t []*publishedIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &publishedIntermediateType{}
+// deserializeSlice imageIntermediateType will accept a slice to create a slice of imageIntermediateType
+func deserializeSliceImageIntermediateType(in []interface{}) (t []*imageIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]imageIntermediateType, len(in))
+	t = make([]*imageIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6315,15 +7909,28 @@ func deserializeSlicePublishedIntermediateType(in []interface{}) (t []*published
 
 }
 
-// serializepublishedIntermediateType will accept a publishedIntermediateType to create a map
-func serializePublishedIntermediateType(t *publishedIntermediateType) (i interface{}, err error) {
+// deserializeValuesimageIntermediateType will accept a single value or a slice of them to create a slice of imageIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesImageIntermediateType(in interface{}) (t []*imageIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceImageIntermediateType(s)
+	}
+	tmp, err := deserializeImageIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*imageIntermediateType{tmp}, nil
+
+}
+
+// serializeimageIntermediateType will accept a imageIntermediateType to create a map
+func serializeImageIntermediateType(t *imageIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicepublishedIntermediateType will accept a slice of publishedIntermediateType to create a slice result
-func serializeSlicePublishedIntermediateType(s []*publishedIntermediateType) (out []interface{}, err error) {
+// serializeSliceimageIntermediateType will accept a slice of imageIntermediateType to create a slice result
+func serializeSliceImageIntermediateType(s []*imageIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6335,20 +7942,26 @@ func serializeSlicePublishedIntermediateType(s []*publishedIntermediateType) (ou
 
 }
 
-// deserializefollowersIntermediateType will accept a map to create a followersIntermediateType
-func deserializeFollowersIntermediateType(in interface{}) (t *followersIntermediateType, err error) {
-	tmp := &followersIntermediateType{}
+// deserializeinReplyToIntermediateType will accept a map to create a inReplyToIntermediateType
+func deserializeInReplyToIntermediateType(in interface{}) (t *inReplyToIntermediateType, err error) {
+	tmp := &inReplyToIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice followersIntermediateType will accept a slice to create a slice of followersIntermediateType
-func deserializeSliceFollowersIntermediateType(in []interface{}) (t []*followersIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &followersIntermediateType{}
+// deserializeSlice inReplyToIntermediateType will accept a slice to create a slice of inReplyToIntermediateType
+func deserializeSliceInReplyToIntermediateType(in []interface{}) (t []*inReplyToIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]inReplyToIntermediateType, len(in))
+	t = make([]*inReplyToIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6357,15 +7970,28 @@ func deserializeSliceFollowersIntermediateType(in []interface{}) (t []*followers
 
 }
 
-// serializefollowersIntermediateType will accept a followersIntermediateType to create a map
-func serializeFollowersIntermediateType(t *followersIntermediateType) (i interface{}, err error) {
+// deserializeValuesinReplyToIntermediateType will accept a single value or a slice of them to create a slice of inReplyToIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesInReplyToIntermediateType(in interface{}) (t []*inReplyToIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceInReplyToIntermediateType(s)
+	}
+	tmp, err := deserializeInReplyToIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*inReplyToIntermediateType{tmp}, nil
+
+}
+
+// serializeinReplyToIntermediateType will accept a inReplyToIntermediateType to create a map
+func serializeInReplyToIntermediateType(t *inReplyToIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicefollowersIntermediateType will accept a slice of followersIntermediateType to create a slice result
-func serializeSliceFollowersIntermediateType(s []*followersIntermediateType) (out []interface{}, err error) {
+// serializeSliceinReplyToIntermediateType will accept a slice of inReplyToIntermediateType to create a slice result
+func serializeSliceInReplyToIntermediateType(s []*inReplyToIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6377,20 +8003,26 @@ func serializeSliceFollowersIntermediateType(s []*followersIntermediateType) (ou
 
 }
 
-// deserializeclosedIntermediateType will accept a map to create a closedIntermediateType
-func deserializeClosedIntermediateType(in interface{}) (t *closedIntermediateType, err error) {
-	tmp := &closedIntermediateType{}
+// deserializeinboxIntermediateType will accept a map to create a inboxIntermediateType
+func deserializeInboxIntermediateType(in interface{}) (t *inboxIntermediateType, err error) {
+	tmp := &inboxIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice closedIntermediateType will accept a slice to create a slice of closedIntermediateType
-func deserializeSliceClosedIntermediateType(in []interface{}) (t []*closedIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &closedIntermediateType{}
+// deserializeSlice inboxIntermediateType will accept a slice to create a slice of inboxIntermediateType
+func deserializeSliceInboxIntermediateType(in []interface{}) (t []*inboxIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]inboxIntermediateType, len(in))
+	t = make([]*inboxIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6399,15 +8031,28 @@ func deserializeSliceClosedIntermediateType(in []interface{}) (t []*closedInterm
 
 }
 
-// serializeclosedIntermediateType will accept a closedIntermediateType to create a map
-func serializeClosedIntermediateType(t *closedIntermediateType) (i interface{}, err error) {
+// deserializeValuesinboxIntermediateType will accept a single value or a slice of them to create a slice of inboxIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesInboxIntermediateType(in interface{}) (t []*inboxIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceInboxIntermediateType(s)
+	}
+	tmp, err := deserializeInboxIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*inboxIntermediateType{tmp}, nil
+
+}
+
+// serializeinboxIntermediateType will accept a inboxIntermediateType to create a map
+func serializeInboxIntermediateType(t *inboxIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceclosedIntermediateType will accept a slice of closedIntermediateType to create a slice result
-func serializeSliceClosedIntermediateType(s []*closedIntermediateType) (out []interface{}, err error) {
+// serializeSliceinboxIntermediateType will accept a slice of inboxIntermediateType to create a slice result
+func serializeSliceInboxIntermediateType(s []*inboxIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6419,37 +8064,56 @@ func serializeSliceClosedIntermediateType(s []*closedIntermediateType) (out []in
 
 }
 
-// deserializecontentIntermediateType will accept a map to create a contentIntermediateType
-func deserializeContentIntermediateType(in interface{}) (t *contentIntermediateType, err error) {
-	tmp := &contentIntermediateType{}
+// deserializeinstrumentIntermediateType will accept a map to create a instrumentIntermediateType
+func deserializeInstrumentIntermediateType(in interface{}) (t *instrumentIntermediateType, err error) {
+	tmp := &instrumentIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice contentIntermediateType will accept a slice to create a slice of contentIntermediateType
-func deserializeSliceContentIntermediateType(in []interface{}) (t []*contentIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &contentIntermediateType{}
+// deserializeSlice instrumentIntermediateType will accept a slice to create a slice of instrumentIntermediateType
+func deserializeSliceInstrumentIntermediateType(in []interface{}) (t []*instrumentIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]instrumentIntermediateType, len(in))
+	t = make([]*instrumentIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
 	}
-	return
+	return
+
+}
+
+// deserializeValuesinstrumentIntermediateType will accept a single value or a slice of them to create a slice of instrumentIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesInstrumentIntermediateType(in interface{}) (t []*instrumentIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceInstrumentIntermediateType(s)
+	}
+	tmp, err := deserializeInstrumentIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*instrumentIntermediateType{tmp}, nil
 
 }
 
-// serializecontentIntermediateType will accept a contentIntermediateType to create a map
-func serializeContentIntermediateType(t *contentIntermediateType) (i interface{}, err error) {
+// serializeinstrumentIntermediateType will accept a instrumentIntermediateType to create a map
+func serializeInstrumentIntermediateType(t *instrumentIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicecontentIntermediateType will accept a slice of contentIntermediateType to create a slice result
-func serializeSliceContentIntermediateType(s []*contentIntermediateType) (out []interface{}, err error) {
+// serializeSliceinstrumentIntermediateType will accept a slice of instrumentIntermediateType to create a slice result
+func serializeSliceInstrumentIntermediateType(s []*instrumentIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6461,20 +8125,26 @@ func serializeSliceContentIntermediateType(s []*contentIntermediateType) (out []
 
 }
 
-// deserializecontextIntermediateType will accept a map to create a contextIntermediateType
-func deserializeContextIntermediateType(in interface{}) (t *contextIntermediateType, err error) {
-	tmp := &contextIntermediateType{}
+// deserializeitemsIntermediateType will accept a map to create a itemsIntermediateType
+func deserializeItemsIntermediateType(in interface{}) (t *itemsIntermediateType, err error) {
+	tmp := &itemsIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice contextIntermediateType will accept a slice to create a slice of contextIntermediateType
-func deserializeSliceContextIntermediateType(in []interface{}) (t []*contextIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &contextIntermediateType{}
+// deserializeSlice itemsIntermediateType will accept a slice to create a slice of itemsIntermediateType
+func deserializeSliceItemsIntermediateType(in []interface{}) (t []*itemsIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]itemsIntermediateType, len(in))
+	t = make([]*itemsIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6483,15 +8153,28 @@ func deserializeSliceContextIntermediateType(in []interface{}) (t []*contextInte
 
 }
 
-// serializecontextIntermediateType will accept a contextIntermediateType to create a map
-func serializeContextIntermediateType(t *contextIntermediateType) (i interface{}, err error) {
+// deserializeValuesitemsIntermediateType will accept a single value or a slice of them to create a slice of itemsIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesItemsIntermediateType(in interface{}) (t []*itemsIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceItemsIntermediateType(s)
+	}
+	tmp, err := deserializeItemsIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*itemsIntermediateType{tmp}, nil
+
+}
+
+// serializeitemsIntermediateType will accept a itemsIntermediateType to create a map
+func serializeItemsIntermediateType(t *itemsIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicecontextIntermediateType will accept a slice of contextIntermediateType to create a slice result
-func serializeSliceContextIntermediateType(s []*contextIntermediateType) (out []interface{}, err error) {
+// serializeSliceitemsIntermediateType will accept a slice of itemsIntermediateType to create a slice result
+func serializeSliceItemsIntermediateType(s []*itemsIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6503,20 +8186,26 @@ func serializeSliceContextIntermediateType(s []*contextIntermediateType) (out []
 
 }
 
-// deserializeoneOfIntermediateType will accept a map to create a oneOfIntermediateType
-func deserializeOneOfIntermediateType(in interface{}) (t *oneOfIntermediateType, err error) {
-	tmp := &oneOfIntermediateType{}
+// deserializelastIntermediateType will accept a map to create a lastIntermediateType
+func deserializeLastIntermediateType(in interface{}) (t *lastIntermediateType, err error) {
+	tmp := &lastIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice oneOfIntermediateType will accept a slice to create a slice of oneOfIntermediateType
-func deserializeSliceOneOfIntermediateType(in []interface{}) (t []*oneOfIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &oneOfIntermediateType{}
+// deserializeSlice lastIntermediateType will accept a slice to create a slice of lastIntermediateType
+func deserializeSliceLastIntermediateType(in []interface{}) (t []*lastIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]lastIntermediateType, len(in))
+	t = make([]*lastIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6525,15 +8214,28 @@ func deserializeSliceOneOfIntermediateType(in []interface{}) (t []*oneOfIntermed
 
 }
 
-// serializeoneOfIntermediateType will accept a oneOfIntermediateType to create a map
-func serializeOneOfIntermediateType(t *oneOfIntermediateType) (i interface{}, err error) {
+// deserializeValueslastIntermediateType will accept a single value or a slice of them to create a slice of lastIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLastIntermediateType(in interface{}) (t []*lastIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLastIntermediateType(s)
+	}
+	tmp, err := deserializeLastIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*lastIntermediateType{tmp}, nil
+
+}
+
+// serializelastIntermediateType will accept a lastIntermediateType to create a map
+func serializeLastIntermediateType(t *lastIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceoneOfIntermediateType will accept a slice of oneOfIntermediateType to create a slice result
-func serializeSliceOneOfIntermediateType(s []*oneOfIntermediateType) (out []interface{}, err error) {
+// serializeSlicelastIntermediateType will accept a slice of lastIntermediateType to create a slice result
+func serializeSliceLastIntermediateType(s []*lastIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6545,20 +8247,26 @@ func serializeSliceOneOfIntermediateType(s []*oneOfIntermediateType) (out []inte
 
 }
 
-// deserializeradiusIntermediateType will accept a map to create a radiusIntermediateType
-func deserializeRadiusIntermediateType(in interface{}) (t *radiusIntermediateType, err error) {
-	tmp := &radiusIntermediateType{}
+// deserializelatitudeIntermediateType will accept a map to create a latitudeIntermediateType
+func deserializeLatitudeIntermediateType(in interface{}) (t *latitudeIntermediateType, err error) {
+	tmp := &latitudeIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice radiusIntermediateType will accept a slice to create a slice of radiusIntermediateType
-func deserializeSliceRadiusIntermediateType(in []interface{}) (t []*radiusIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &radiusIntermediateType{}
+// deserializeSlice latitudeIntermediateType will accept a slice to create a slice of latitudeIntermediateType
+func deserializeSliceLatitudeIntermediateType(in []interface{}) (t []*latitudeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]latitudeIntermediateType, len(in))
+	t = make([]*latitudeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6567,15 +8275,28 @@ func deserializeSliceRadiusIntermediateType(in []interface{}) (t []*radiusInterm
 
 }
 
-// serializeradiusIntermediateType will accept a radiusIntermediateType to create a map
-func serializeRadiusIntermediateType(t *radiusIntermediateType) (i interface{}, err error) {
+// deserializeValueslatitudeIntermediateType will accept a single value or a slice of them to create a slice of latitudeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLatitudeIntermediateType(in interface{}) (t []*latitudeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLatitudeIntermediateType(s)
+	}
+	tmp, err := deserializeLatitudeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*latitudeIntermediateType{tmp}, nil
+
+}
+
+// serializelatitudeIntermediateType will accept a latitudeIntermediateType to create a map
+func serializeLatitudeIntermediateType(t *latitudeIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceradiusIntermediateType will accept a slice of radiusIntermediateType to create a slice result
-func serializeSliceRadiusIntermediateType(s []*radiusIntermediateType) (out []interface{}, err error) {
+// serializeSlicelatitudeIntermediateType will accept a slice of latitudeIntermediateType to create a slice result
+func serializeSliceLatitudeIntermediateType(s []*latitudeIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6587,20 +8308,26 @@ func serializeSliceRadiusIntermediateType(s []*radiusIntermediateType) (out []in
 
 }
 
-// deserializeattachmentIntermediateType will accept a map to create a attachmentIntermediateType
-func deserializeAttachmentIntermediateType(in interface{}) (t *attachmentIntermediateType, err error) {
-	tmp := &attachmentIntermediateType{}
+// deserializelikedIntermediateType will accept a map to create a likedIntermediateType
+func deserializeLikedIntermediateType(in interface{}) (t *likedIntermediateType, err error) {
+	tmp := &likedIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice attachmentIntermediateType will accept a slice to create a slice of attachmentIntermediateType
-func deserializeSliceAttachmentIntermediateType(in []interface{}) (t []*attachmentIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &attachmentIntermediateType{}
+// deserializeSlice likedIntermediateType will accept a slice to create a slice of likedIntermediateType
+func deserializeSliceLikedIntermediateType(in []interface{}) (t []*likedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]likedIntermediateType, len(in))
+	t = make([]*likedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6609,15 +8336,28 @@ func deserializeSliceAttachmentIntermediateType(in []interface{}) (t []*attachme
 
 }
 
-// serializeattachmentIntermediateType will accept a attachmentIntermediateType to create a map
-func serializeAttachmentIntermediateType(t *attachmentIntermediateType) (i interface{}, err error) {
+// deserializeValueslikedIntermediateType will accept a single value or a slice of them to create a slice of likedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLikedIntermediateType(in interface{}) (t []*likedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLikedIntermediateType(s)
+	}
+	tmp, err := deserializeLikedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*likedIntermediateType{tmp}, nil
+
+}
+
+// serializelikedIntermediateType will accept a likedIntermediateType to create a map
+func serializeLikedIntermediateType(t *likedIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceattachmentIntermediateType will accept a slice of attachmentIntermediateType to create a slice result
-func serializeSliceAttachmentIntermediateType(s []*attachmentIntermediateType) (out []interface{}, err error) {
+// serializeSlicelikedIntermediateType will accept a slice of likedIntermediateType to create a slice result
+func serializeSliceLikedIntermediateType(s []*likedIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6629,20 +8369,26 @@ func serializeSliceAttachmentIntermediateType(s []*attachmentIntermediateType) (
 
 }
 
-// deserializewidthIntermediateType will accept a map to create a widthIntermediateType
-func deserializeWidthIntermediateType(in interface{}) (t *widthIntermediateType, err error) {
-	tmp := &widthIntermediateType{}
+// deserializelikesIntermediateType will accept a map to create a likesIntermediateType
+func deserializeLikesIntermediateType(in interface{}) (t *likesIntermediateType, err error) {
+	tmp := &likesIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice widthIntermediateType will accept a slice to create a slice of widthIntermediateType
-func deserializeSliceWidthIntermediateType(in []interface{}) (t []*widthIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &widthIntermediateType{}
+// deserializeSlice likesIntermediateType will accept a slice to create a slice of likesIntermediateType
+func deserializeSliceLikesIntermediateType(in []interface{}) (t []*likesIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]likesIntermediateType, len(in))
+	t = make([]*likesIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6651,15 +8397,28 @@ func deserializeSliceWidthIntermediateType(in []interface{}) (t []*widthIntermed
 
 }
 
-// serializewidthIntermediateType will accept a widthIntermediateType to create a map
-func serializeWidthIntermediateType(t *widthIntermediateType) (i interface{}, err error) {
+// deserializeValueslikesIntermediateType will accept a single value or a slice of them to create a slice of likesIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLikesIntermediateType(in interface{}) (t []*likesIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLikesIntermediateType(s)
+	}
+	tmp, err := deserializeLikesIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*likesIntermediateType{tmp}, nil
+
+}
+
+// serializelikesIntermediateType will accept a likesIntermediateType to create a map
+func serializeLikesIntermediateType(t *likesIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicewidthIntermediateType will accept a slice of widthIntermediateType to create a slice result
-func serializeSliceWidthIntermediateType(s []*widthIntermediateType) (out []interface{}, err error) {
+// serializeSlicelikesIntermediateType will accept a slice of likesIntermediateType to create a slice result
+func serializeSliceLikesIntermediateType(s []*likesIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6671,20 +8430,26 @@ func serializeSliceWidthIntermediateType(s []*widthIntermediateType) (out []inte
 
 }
 
-// deserializeorderedItemsIntermediateType will accept a map to create a orderedItemsIntermediateType
-func deserializeOrderedItemsIntermediateType(in interface{}) (t *orderedItemsIntermediateType, err error) {
-	tmp := &orderedItemsIntermediateType{}
+// deserializelocationIntermediateType will accept a map to create a locationIntermediateType
+func deserializeLocationIntermediateType(in interface{}) (t *locationIntermediateType, err error) {
+	tmp := &locationIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice orderedItemsIntermediateType will accept a slice to create a slice of orderedItemsIntermediateType
-func deserializeSliceOrderedItemsIntermediateType(in []interface{}) (t []*orderedItemsIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &orderedItemsIntermediateType{}
+// deserializeSlice locationIntermediateType will accept a slice to create a slice of locationIntermediateType
+func deserializeSliceLocationIntermediateType(in []interface{}) (t []*locationIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]locationIntermediateType, len(in))
+	t = make([]*locationIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6693,15 +8458,28 @@ func deserializeSliceOrderedItemsIntermediateType(in []interface{}) (t []*ordere
 
 }
 
-// serializeorderedItemsIntermediateType will accept a orderedItemsIntermediateType to create a map
-func serializeOrderedItemsIntermediateType(t *orderedItemsIntermediateType) (i interface{}, err error) {
+// deserializeValueslocationIntermediateType will accept a single value or a slice of them to create a slice of locationIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLocationIntermediateType(in interface{}) (t []*locationIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLocationIntermediateType(s)
+	}
+	tmp, err := deserializeLocationIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*locationIntermediateType{tmp}, nil
+
+}
+
+// serializelocationIntermediateType will accept a locationIntermediateType to create a map
+func serializeLocationIntermediateType(t *locationIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceorderedItemsIntermediateType will accept a slice of orderedItemsIntermediateType to create a slice result
-func serializeSliceOrderedItemsIntermediateType(s []*orderedItemsIntermediateType) (out []interface{}, err error) {
+// serializeSlicelocationIntermediateType will accept a slice of locationIntermediateType to create a slice result
+func serializeSliceLocationIntermediateType(s []*locationIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6713,20 +8491,26 @@ func serializeSliceOrderedItemsIntermediateType(s []*orderedItemsIntermediateTyp
 
 }
 
-// deserializeprevIntermediateType will accept a map to create a prevIntermediateType
-func deserializePrevIntermediateType(in interface{}) (t *prevIntermediateType, err error) {
-	tmp := &prevIntermediateType{}
+// deserializelongitudeIntermediateType will accept a map to create a longitudeIntermediateType
+func deserializeLongitudeIntermediateType(in interface{}) (t *longitudeIntermediateType, err error) {
+	tmp := &longitudeIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice prevIntermediateType will accept a slice to create a slice of prevIntermediateType
-func deserializeSlicePrevIntermediateType(in []interface{}) (t []*prevIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &prevIntermediateType{}
+// deserializeSlice longitudeIntermediateType will accept a slice to create a slice of longitudeIntermediateType
+func deserializeSliceLongitudeIntermediateType(in []interface{}) (t []*longitudeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]longitudeIntermediateType, len(in))
+	t = make([]*longitudeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6735,15 +8519,28 @@ func deserializeSlicePrevIntermediateType(in []interface{}) (t []*prevIntermedia
 
 }
 
-// serializeprevIntermediateType will accept a prevIntermediateType to create a map
-func serializePrevIntermediateType(t *prevIntermediateType) (i interface{}, err error) {
+// deserializeValueslongitudeIntermediateType will accept a single value or a slice of them to create a slice of longitudeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesLongitudeIntermediateType(in interface{}) (t []*longitudeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceLongitudeIntermediateType(s)
+	}
+	tmp, err := deserializeLongitudeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*longitudeIntermediateType{tmp}, nil
+
+}
+
+// serializelongitudeIntermediateType will accept a longitudeIntermediateType to create a map
+func serializeLongitudeIntermediateType(t *longitudeIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceprevIntermediateType will accept a slice of prevIntermediateType to create a slice result
-func serializeSlicePrevIntermediateType(s []*prevIntermediateType) (out []interface{}, err error) {
+// serializeSlicelongitudeIntermediateType will accept a slice of longitudeIntermediateType to create a slice result
+func serializeSliceLongitudeIntermediateType(s []*longitudeIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6755,20 +8552,26 @@ func serializeSlicePrevIntermediateType(s []*prevIntermediateType) (out []interf
 
 }
 
-// deserializesubjectIntermediateType will accept a map to create a subjectIntermediateType
-func deserializeSubjectIntermediateType(in interface{}) (t *subjectIntermediateType, err error) {
-	tmp := &subjectIntermediateType{}
+// deserializemediaTypeIntermediateType will accept a map to create a mediaTypeIntermediateType
+func deserializeMediaTypeIntermediateType(in interface{}) (t *mediaTypeIntermediateType, err error) {
+	tmp := &mediaTypeIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice subjectIntermediateType will accept a slice to create a slice of subjectIntermediateType
-func deserializeSliceSubjectIntermediateType(in []interface{}) (t []*subjectIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &subjectIntermediateType{}
+// deserializeSlice mediaTypeIntermediateType will accept a slice to create a slice of mediaTypeIntermediateType
+func deserializeSliceMediaTypeIntermediateType(in []interface{}) (t []*mediaTypeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]mediaTypeIntermediateType, len(in))
+	t = make([]*mediaTypeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6777,15 +8580,28 @@ func deserializeSliceSubjectIntermediateType(in []interface{}) (t []*subjectInte
 
 }
 
-// serializesubjectIntermediateType will accept a subjectIntermediateType to create a map
-func serializeSubjectIntermediateType(t *subjectIntermediateType) (i interface{}, err error) {
+// deserializeValuesmediaTypeIntermediateType will accept a single value or a slice of them to create a slice of mediaTypeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesMediaTypeIntermediateType(in interface{}) (t []*mediaTypeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceMediaTypeIntermediateType(s)
+	}
+	tmp, err := deserializeMediaTypeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*mediaTypeIntermediateType{tmp}, nil
+
+}
+
+// serializemediaTypeIntermediateType will accept a mediaTypeIntermediateType to create a map
+func serializeMediaTypeIntermediateType(t *mediaTypeIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicesubjectIntermediateType will accept a slice of subjectIntermediateType to create a slice result
-func serializeSliceSubjectIntermediateType(s []*subjectIntermediateType) (out []interface{}, err error) {
+// serializeSlicemediaTypeIntermediateType will accept a slice of mediaTypeIntermediateType to create a slice result
+func serializeSliceMediaTypeIntermediateType(s []*mediaTypeIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6797,20 +8613,26 @@ func serializeSliceSubjectIntermediateType(s []*subjectIntermediateType) (out []
 
 }
 
-// deserializepreviewIntermediateType will accept a map to create a previewIntermediateType
-func deserializePreviewIntermediateType(in interface{}) (t *previewIntermediateType, err error) {
-	tmp := &previewIntermediateType{}
+// deserializenameIntermediateType will accept a map to create a nameIntermediateType
+func deserializeNameIntermediateType(in interface{}) (t *nameIntermediateType, err error) {
+	tmp := &nameIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice previewIntermediateType will accept a slice to create a slice of previewIntermediateType
-func deserializeSlicePreviewIntermediateType(in []interface{}) (t []*previewIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &previewIntermediateType{}
+// deserializeSlice nameIntermediateType will accept a slice to create a slice of nameIntermediateType
+func deserializeSliceNameIntermediateType(in []interface{}) (t []*nameIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]nameIntermediateType, len(in))
+	t = make([]*nameIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6819,15 +8641,28 @@ func deserializeSlicePreviewIntermediateType(in []interface{}) (t []*previewInte
 
 }
 
-// serializepreviewIntermediateType will accept a previewIntermediateType to create a map
-func serializePreviewIntermediateType(t *previewIntermediateType) (i interface{}, err error) {
+// deserializeValuesnameIntermediateType will accept a single value or a slice of them to create a slice of nameIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesNameIntermediateType(in interface{}) (t []*nameIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceNameIntermediateType(s)
+	}
+	tmp, err := deserializeNameIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*nameIntermediateType{tmp}, nil
+
+}
+
+// serializenameIntermediateType will accept a nameIntermediateType to create a map
+func serializeNameIntermediateType(t *nameIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicepreviewIntermediateType will accept a slice of previewIntermediateType to create a slice result
-func serializeSlicePreviewIntermediateType(s []*previewIntermediateType) (out []interface{}, err error) {
+// serializeSlicenameIntermediateType will accept a slice of nameIntermediateType to create a slice result
+func serializeSliceNameIntermediateType(s []*nameIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6839,20 +8674,26 @@ func serializeSlicePreviewIntermediateType(s []*previewIntermediateType) (out []
 
 }
 
-// deserializeurlIntermediateType will accept a map to create a urlIntermediateType
-func deserializeUrlIntermediateType(in interface{}) (t *urlIntermediateType, err error) {
-	tmp := &urlIntermediateType{}
+// deserializenextIntermediateType will accept a map to create a nextIntermediateType
+func deserializeNextIntermediateType(in interface{}) (t *nextIntermediateType, err error) {
+	tmp := &nextIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice urlIntermediateType will accept a slice to create a slice of urlIntermediateType
-func deserializeSliceUrlIntermediateType(in []interface{}) (t []*urlIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &urlIntermediateType{}
+// deserializeSlice nextIntermediateType will accept a slice to create a slice of nextIntermediateType
+func deserializeSliceNextIntermediateType(in []interface{}) (t []*nextIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]nextIntermediateType, len(in))
+	t = make([]*nextIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6861,15 +8702,28 @@ func deserializeSliceUrlIntermediateType(in []interface{}) (t []*urlIntermediate
 
 }
 
-// serializeurlIntermediateType will accept a urlIntermediateType to create a map
-func serializeUrlIntermediateType(t *urlIntermediateType) (i interface{}, err error) {
+// deserializeValuesnextIntermediateType will accept a single value or a slice of them to create a slice of nextIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesNextIntermediateType(in interface{}) (t []*nextIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceNextIntermediateType(s)
+	}
+	tmp, err := deserializeNextIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*nextIntermediateType{tmp}, nil
+
+}
+
+// serializenextIntermediateType will accept a nextIntermediateType to create a map
+func serializeNextIntermediateType(t *nextIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceurlIntermediateType will accept a slice of urlIntermediateType to create a slice result
-func serializeSliceUrlIntermediateType(s []*urlIntermediateType) (out []interface{}, err error) {
+// serializeSlicenextIntermediateType will accept a slice of nextIntermediateType to create a slice result
+func serializeSliceNextIntermediateType(s []*nextIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6881,20 +8735,26 @@ func serializeSliceUrlIntermediateType(s []*urlIntermediateType) (out []interfac
 
 }
 
-// deserializetoIntermediateType will accept a map to create a toIntermediateType
-func deserializeToIntermediateType(in interface{}) (t *toIntermediateType, err error) {
-	tmp := &toIntermediateType{}
+// deserializeobjectIntermediateType will accept a map to create a objectIntermediateType
+func deserializeObjectIntermediateType(in interface{}) (t *objectIntermediateType, err error) {
+	tmp := &objectIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice toIntermediateType will accept a slice to create a slice of toIntermediateType
-func deserializeSliceToIntermediateType(in []interface{}) (t []*toIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &toIntermediateType{}
+// deserializeSlice objectIntermediateType will accept a slice to create a slice of objectIntermediateType
+func deserializeSliceObjectIntermediateType(in []interface{}) (t []*objectIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]objectIntermediateType, len(in))
+	t = make([]*objectIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6903,15 +8763,28 @@ func deserializeSliceToIntermediateType(in []interface{}) (t []*toIntermediateTy
 
 }
 
-// serializetoIntermediateType will accept a toIntermediateType to create a map
-func serializeToIntermediateType(t *toIntermediateType) (i interface{}, err error) {
+// deserializeValuesobjectIntermediateType will accept a single value or a slice of them to create a slice of objectIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesObjectIntermediateType(in interface{}) (t []*objectIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceObjectIntermediateType(s)
+	}
+	tmp, err := deserializeObjectIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*objectIntermediateType{tmp}, nil
+
+}
+
+// serializeobjectIntermediateType will accept a objectIntermediateType to create a map
+func serializeObjectIntermediateType(t *objectIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicetoIntermediateType will accept a slice of toIntermediateType to create a slice result
-func serializeSliceToIntermediateType(s []*toIntermediateType) (out []interface{}, err error) {
+// serializeSliceobjectIntermediateType will accept a slice of objectIntermediateType to create a slice result
+func serializeSliceObjectIntermediateType(s []*objectIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6923,20 +8796,26 @@ func serializeSliceToIntermediateType(s []*toIntermediateType) (out []interface{
 
 }
 
-// deserializebccIntermediateType will accept a map to create a bccIntermediateType
-func deserializeBccIntermediateType(in interface{}) (t *bccIntermediateType, err error) {
-	tmp := &bccIntermediateType{}
+// deserializeoneOfIntermediateType will accept a map to create a oneOfIntermediateType
+func deserializeOneOfIntermediateType(in interface{}) (t *oneOfIntermediateType, err error) {
+	tmp := &oneOfIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice bccIntermediateType will accept a slice to create a slice of bccIntermediateType
-func deserializeSliceBccIntermediateType(in []interface{}) (t []*bccIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &bccIntermediateType{}
+// deserializeSlice oneOfIntermediateType will accept a slice to create a slice of oneOfIntermediateType
+func deserializeSliceOneOfIntermediateType(in []interface{}) (t []*oneOfIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]oneOfIntermediateType, len(in))
+	t = make([]*oneOfIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6945,15 +8824,28 @@ func deserializeSliceBccIntermediateType(in []interface{}) (t []*bccIntermediate
 
 }
 
-// serializebccIntermediateType will accept a bccIntermediateType to create a map
-func serializeBccIntermediateType(t *bccIntermediateType) (i interface{}, err error) {
+// deserializeValuesoneOfIntermediateType will accept a single value or a slice of them to create a slice of oneOfIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesOneOfIntermediateType(in interface{}) (t []*oneOfIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceOneOfIntermediateType(s)
+	}
+	tmp, err := deserializeOneOfIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*oneOfIntermediateType{tmp}, nil
+
+}
+
+// serializeoneOfIntermediateType will accept a oneOfIntermediateType to create a map
+func serializeOneOfIntermediateType(t *oneOfIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicebccIntermediateType will accept a slice of bccIntermediateType to create a slice result
-func serializeSliceBccIntermediateType(s []*bccIntermediateType) (out []interface{}, err error) {
+// serializeSliceoneOfIntermediateType will accept a slice of oneOfIntermediateType to create a slice result
+func serializeSliceOneOfIntermediateType(s []*oneOfIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -6965,20 +8857,26 @@ func serializeSliceBccIntermediateType(s []*bccIntermediateType) (out []interfac
 
 }
 
-// deserializelikesIntermediateType will accept a map to create a likesIntermediateType
-func deserializeLikesIntermediateType(in interface{}) (t *likesIntermediateType, err error) {
-	tmp := &likesIntermediateType{}
+// deserializeorderedItemsIntermediateType will accept a map to create a orderedItemsIntermediateType
+func deserializeOrderedItemsIntermediateType(in interface{}) (t *orderedItemsIntermediateType, err error) {
+	tmp := &orderedItemsIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice likesIntermediateType will accept a slice to create a slice of likesIntermediateType
-func deserializeSliceLikesIntermediateType(in []interface{}) (t []*likesIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &likesIntermediateType{}
+// deserializeSlice orderedItemsIntermediateType will accept a slice to create a slice of orderedItemsIntermediateType
+func deserializeSliceOrderedItemsIntermediateType(in []interface{}) (t []*orderedItemsIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]orderedItemsIntermediateType, len(in))
+	t = make([]*orderedItemsIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -6987,15 +8885,28 @@ func deserializeSliceLikesIntermediateType(in []interface{}) (t []*likesIntermed
 
 }
 
-// serializelikesIntermediateType will accept a likesIntermediateType to create a map
-func serializeLikesIntermediateType(t *likesIntermediateType) (i interface{}, err error) {
+// deserializeValuesorderedItemsIntermediateType will accept a single value or a slice of them to create a slice of orderedItemsIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesOrderedItemsIntermediateType(in interface{}) (t []*orderedItemsIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceOrderedItemsIntermediateType(s)
+	}
+	tmp, err := deserializeOrderedItemsIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*orderedItemsIntermediateType{tmp}, nil
+
+}
+
+// serializeorderedItemsIntermediateType will accept a orderedItemsIntermediateType to create a map
+func serializeOrderedItemsIntermediateType(t *orderedItemsIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicelikesIntermediateType will accept a slice of likesIntermediateType to create a slice result
-func serializeSliceLikesIntermediateType(s []*likesIntermediateType) (out []interface{}, err error) {
+// serializeSliceorderedItemsIntermediateType will accept a slice of orderedItemsIntermediateType to create a slice result
+func serializeSliceOrderedItemsIntermediateType(s []*orderedItemsIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7007,20 +8918,26 @@ func serializeSliceLikesIntermediateType(s []*likesIntermediateType) (out []inte
 
 }
 
-// deserializetargetIntermediateType will accept a map to create a targetIntermediateType
-func deserializeTargetIntermediateType(in interface{}) (t *targetIntermediateType, err error) {
-	tmp := &targetIntermediateType{}
+// deserializeoriginIntermediateType will accept a map to create a originIntermediateType
+func deserializeOriginIntermediateType(in interface{}) (t *originIntermediateType, err error) {
+	tmp := &originIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice targetIntermediateType will accept a slice to create a slice of targetIntermediateType
-func deserializeSliceTargetIntermediateType(in []interface{}) (t []*targetIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &targetIntermediateType{}
+// deserializeSlice originIntermediateType will accept a slice to create a slice of originIntermediateType
+func deserializeSliceOriginIntermediateType(in []interface{}) (t []*originIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]originIntermediateType, len(in))
+	t = make([]*originIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7029,15 +8946,28 @@ func deserializeSliceTargetIntermediateType(in []interface{}) (t []*targetInterm
 
 }
 
-// serializetargetIntermediateType will accept a targetIntermediateType to create a map
-func serializeTargetIntermediateType(t *targetIntermediateType) (i interface{}, err error) {
+// deserializeValuesoriginIntermediateType will accept a single value or a slice of them to create a slice of originIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesOriginIntermediateType(in interface{}) (t []*originIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceOriginIntermediateType(s)
+	}
+	tmp, err := deserializeOriginIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*originIntermediateType{tmp}, nil
+
+}
+
+// serializeoriginIntermediateType will accept a originIntermediateType to create a map
+func serializeOriginIntermediateType(t *originIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicetargetIntermediateType will accept a slice of targetIntermediateType to create a slice result
-func serializeSliceTargetIntermediateType(s []*targetIntermediateType) (out []interface{}, err error) {
+// serializeSliceoriginIntermediateType will accept a slice of originIntermediateType to create a slice result
+func serializeSliceOriginIntermediateType(s []*originIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7049,20 +8979,26 @@ func serializeSliceTargetIntermediateType(s []*targetIntermediateType) (out []in
 
 }
 
-// deserializealtitudeIntermediateType will accept a map to create a altitudeIntermediateType
-func deserializeAltitudeIntermediateType(in interface{}) (t *altitudeIntermediateType, err error) {
-	tmp := &altitudeIntermediateType{}
+// deserializeoutboxIntermediateType will accept a map to create a outboxIntermediateType
+func deserializeOutboxIntermediateType(in interface{}) (t *outboxIntermediateType, err error) {
+	tmp := &outboxIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice altitudeIntermediateType will accept a slice to create a slice of altitudeIntermediateType
-func deserializeSliceAltitudeIntermediateType(in []interface{}) (t []*altitudeIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &altitudeIntermediateType{}
+// deserializeSlice outboxIntermediateType will accept a slice to create a slice of outboxIntermediateType
+func deserializeSliceOutboxIntermediateType(in []interface{}) (t []*outboxIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]outboxIntermediateType, len(in))
+	t = make([]*outboxIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7071,15 +9007,28 @@ func deserializeSliceAltitudeIntermediateType(in []interface{}) (t []*altitudeIn
 
 }
 
-// serializealtitudeIntermediateType will accept a altitudeIntermediateType to create a map
-func serializeAltitudeIntermediateType(t *altitudeIntermediateType) (i interface{}, err error) {
+// deserializeValuesoutboxIntermediateType will accept a single value or a slice of them to create a slice of outboxIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesOutboxIntermediateType(in interface{}) (t []*outboxIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceOutboxIntermediateType(s)
+	}
+	tmp, err := deserializeOutboxIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*outboxIntermediateType{tmp}, nil
+
+}
+
+// serializeoutboxIntermediateType will accept a outboxIntermediateType to create a map
+func serializeOutboxIntermediateType(t *outboxIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicealtitudeIntermediateType will accept a slice of altitudeIntermediateType to create a slice result
-func serializeSliceAltitudeIntermediateType(s []*altitudeIntermediateType) (out []interface{}, err error) {
+// serializeSliceoutboxIntermediateType will accept a slice of outboxIntermediateType to create a slice result
+func serializeSliceOutboxIntermediateType(s []*outboxIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7091,20 +9040,26 @@ func serializeSliceAltitudeIntermediateType(s []*altitudeIntermediateType) (out
 
 }
 
-// deserializelikedIntermediateType will accept a map to create a likedIntermediateType
-func deserializeLikedIntermediateType(in interface{}) (t *likedIntermediateType, err error) {
-	tmp := &likedIntermediateType{}
+// deserializepartOfIntermediateType will accept a map to create a partOfIntermediateType
+func deserializePartOfIntermediateType(in interface{}) (t *partOfIntermediateType, err error) {
+	tmp := &partOfIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice likedIntermediateType will accept a slice to create a slice of likedIntermediateType
-func deserializeSliceLikedIntermediateType(in []interface{}) (t []*likedIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &likedIntermediateType{}
+// deserializeSlice partOfIntermediateType will accept a slice to create a slice of partOfIntermediateType
+func deserializeSlicePartOfIntermediateType(in []interface{}) (t []*partOfIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]partOfIntermediateType, len(in))
+	t = make([]*partOfIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7113,15 +9068,28 @@ func deserializeSliceLikedIntermediateType(in []interface{}) (t []*likedIntermed
 
 }
 
-// serializelikedIntermediateType will accept a likedIntermediateType to create a map
-func serializeLikedIntermediateType(t *likedIntermediateType) (i interface{}, err error) {
+// deserializeValuespartOfIntermediateType will accept a single value or a slice of them to create a slice of partOfIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPartOfIntermediateType(in interface{}) (t []*partOfIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePartOfIntermediateType(s)
+	}
+	tmp, err := deserializePartOfIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*partOfIntermediateType{tmp}, nil
+
+}
+
+// serializepartOfIntermediateType will accept a partOfIntermediateType to create a map
+func serializePartOfIntermediateType(t *partOfIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicelikedIntermediateType will accept a slice of likedIntermediateType to create a slice result
-func serializeSliceLikedIntermediateType(s []*likedIntermediateType) (out []interface{}, err error) {
+// serializeSlicepartOfIntermediateType will accept a slice of partOfIntermediateType to create a slice result
+func serializeSlicePartOfIntermediateType(s []*partOfIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7133,20 +9101,26 @@ func serializeSliceLikedIntermediateType(s []*likedIntermediateType) (out []inte
 
 }
 
-// deserializetotalItemsIntermediateType will accept a map to create a totalItemsIntermediateType
-func deserializeTotalItemsIntermediateType(in interface{}) (t *totalItemsIntermediateType, err error) {
-	tmp := &totalItemsIntermediateType{}
+// deserializepreferredUsernameIntermediateType will accept a map to create a preferredUsernameIntermediateType
+func deserializePreferredUsernameIntermediateType(in interface{}) (t *preferredUsernameIntermediateType, err error) {
+	tmp := &preferredUsernameIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice totalItemsIntermediateType will accept a slice to create a slice of totalItemsIntermediateType
-func deserializeSliceTotalItemsIntermediateType(in []interface{}) (t []*totalItemsIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &totalItemsIntermediateType{}
+// deserializeSlice preferredUsernameIntermediateType will accept a slice to create a slice of preferredUsernameIntermediateType
+func deserializeSlicePreferredUsernameIntermediateType(in []interface{}) (t []*preferredUsernameIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]preferredUsernameIntermediateType, len(in))
+	t = make([]*preferredUsernameIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7155,15 +9129,28 @@ func deserializeSliceTotalItemsIntermediateType(in []interface{}) (t []*totalIte
 
 }
 
-// serializetotalItemsIntermediateType will accept a totalItemsIntermediateType to create a map
-func serializeTotalItemsIntermediateType(t *totalItemsIntermediateType) (i interface{}, err error) {
+// deserializeValuespreferredUsernameIntermediateType will accept a single value or a slice of them to create a slice of preferredUsernameIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPreferredUsernameIntermediateType(in interface{}) (t []*preferredUsernameIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePreferredUsernameIntermediateType(s)
+	}
+	tmp, err := deserializePreferredUsernameIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*preferredUsernameIntermediateType{tmp}, nil
+
+}
+
+// serializepreferredUsernameIntermediateType will accept a preferredUsernameIntermediateType to create a map
+func serializePreferredUsernameIntermediateType(t *preferredUsernameIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicetotalItemsIntermediateType will accept a slice of totalItemsIntermediateType to create a slice result
-func serializeSliceTotalItemsIntermediateType(s []*totalItemsIntermediateType) (out []interface{}, err error) {
+// serializeSlicepreferredUsernameIntermediateType will accept a slice of preferredUsernameIntermediateType to create a slice result
+func serializeSlicePreferredUsernameIntermediateType(s []*preferredUsernameIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7175,20 +9162,26 @@ func serializeSliceTotalItemsIntermediateType(s []*totalItemsIntermediateType) (
 
 }
 
-// deserializerelationshipIntermediateType will accept a map to create a relationshipIntermediateType
-func deserializeRelationshipIntermediateType(in interface{}) (t *relationshipIntermediateType, err error) {
-	tmp := &relationshipIntermediateType{}
+// deserializeprevIntermediateType will accept a map to create a prevIntermediateType
+func deserializePrevIntermediateType(in interface{}) (t *prevIntermediateType, err error) {
+	tmp := &prevIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice relationshipIntermediateType will accept a slice to create a slice of relationshipIntermediateType
-func deserializeSliceRelationshipIntermediateType(in []interface{}) (t []*relationshipIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &relationshipIntermediateType{}
+// deserializeSlice prevIntermediateType will accept a slice to create a slice of prevIntermediateType
+func deserializeSlicePrevIntermediateType(in []interface{}) (t []*prevIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]prevIntermediateType, len(in))
+	t = make([]*prevIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7197,15 +9190,28 @@ func deserializeSliceRelationshipIntermediateType(in []interface{}) (t []*relati
 
 }
 
-// serializerelationshipIntermediateType will accept a relationshipIntermediateType to create a map
-func serializeRelationshipIntermediateType(t *relationshipIntermediateType) (i interface{}, err error) {
+// deserializeValuesprevIntermediateType will accept a single value or a slice of them to create a slice of prevIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPrevIntermediateType(in interface{}) (t []*prevIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePrevIntermediateType(s)
+	}
+	tmp, err := deserializePrevIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*prevIntermediateType{tmp}, nil
+
+}
+
+// serializeprevIntermediateType will accept a prevIntermediateType to create a map
+func serializePrevIntermediateType(t *prevIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicerelationshipIntermediateType will accept a slice of relationshipIntermediateType to create a slice result
-func serializeSliceRelationshipIntermediateType(s []*relationshipIntermediateType) (out []interface{}, err error) {
+// serializeSliceprevIntermediateType will accept a slice of prevIntermediateType to create a slice result
+func serializeSlicePrevIntermediateType(s []*prevIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7217,20 +9223,26 @@ func serializeSliceRelationshipIntermediateType(s []*relationshipIntermediateTyp
 
 }
 
-// deserializetagIntermediateType will accept a map to create a tagIntermediateType
-func deserializeTagIntermediateType(in interface{}) (t *tagIntermediateType, err error) {
-	tmp := &tagIntermediateType{}
+// deserializepreviewIntermediateType will accept a map to create a previewIntermediateType
+func deserializePreviewIntermediateType(in interface{}) (t *previewIntermediateType, err error) {
+	tmp := &previewIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice tagIntermediateType will accept a slice to create a slice of tagIntermediateType
-func deserializeSliceTagIntermediateType(in []interface{}) (t []*tagIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &tagIntermediateType{}
+// deserializeSlice previewIntermediateType will accept a slice to create a slice of previewIntermediateType
+func deserializeSlicePreviewIntermediateType(in []interface{}) (t []*previewIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]previewIntermediateType, len(in))
+	t = make([]*previewIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7239,15 +9251,28 @@ func deserializeSliceTagIntermediateType(in []interface{}) (t []*tagIntermediate
 
 }
 
-// serializetagIntermediateType will accept a tagIntermediateType to create a map
-func serializeTagIntermediateType(t *tagIntermediateType) (i interface{}, err error) {
+// deserializeValuespreviewIntermediateType will accept a single value or a slice of them to create a slice of previewIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPreviewIntermediateType(in interface{}) (t []*previewIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePreviewIntermediateType(s)
+	}
+	tmp, err := deserializePreviewIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*previewIntermediateType{tmp}, nil
+
+}
+
+// serializepreviewIntermediateType will accept a previewIntermediateType to create a map
+func serializePreviewIntermediateType(t *previewIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicetagIntermediateType will accept a slice of tagIntermediateType to create a slice result
-func serializeSliceTagIntermediateType(s []*tagIntermediateType) (out []interface{}, err error) {
+// serializeSlicepreviewIntermediateType will accept a slice of previewIntermediateType to create a slice result
+func serializeSlicePreviewIntermediateType(s []*previewIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7259,20 +9284,26 @@ func serializeSliceTagIntermediateType(s []*tagIntermediateType) (out []interfac
 
 }
 
-// deserializerelIntermediateType will accept a map to create a relIntermediateType
-func deserializeRelIntermediateType(in interface{}) (t *relIntermediateType, err error) {
-	tmp := &relIntermediateType{}
+// deserializepublishedIntermediateType will accept a map to create a publishedIntermediateType
+func deserializePublishedIntermediateType(in interface{}) (t *publishedIntermediateType, err error) {
+	tmp := &publishedIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice relIntermediateType will accept a slice to create a slice of relIntermediateType
-func deserializeSliceRelIntermediateType(in []interface{}) (t []*relIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &relIntermediateType{}
+// deserializeSlice publishedIntermediateType will accept a slice to create a slice of publishedIntermediateType
+func deserializeSlicePublishedIntermediateType(in []interface{}) (t []*publishedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]publishedIntermediateType, len(in))
+	t = make([]*publishedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7281,15 +9312,28 @@ func deserializeSliceRelIntermediateType(in []interface{}) (t []*relIntermediate
 
 }
 
-// serializerelIntermediateType will accept a relIntermediateType to create a map
-func serializeRelIntermediateType(t *relIntermediateType) (i interface{}, err error) {
+// deserializeValuespublishedIntermediateType will accept a single value or a slice of them to create a slice of publishedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesPublishedIntermediateType(in interface{}) (t []*publishedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSlicePublishedIntermediateType(s)
+	}
+	tmp, err := deserializePublishedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*publishedIntermediateType{tmp}, nil
+
+}
+
+// serializepublishedIntermediateType will accept a publishedIntermediateType to create a map
+func serializePublishedIntermediateType(t *publishedIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicerelIntermediateType will accept a slice of relIntermediateType to create a slice result
-func serializeSliceRelIntermediateType(s []*relIntermediateType) (out []interface{}, err error) {
+// serializeSlicepublishedIntermediateType will accept a slice of publishedIntermediateType to create a slice result
+func serializeSlicePublishedIntermediateType(s []*publishedIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7301,20 +9345,26 @@ func serializeSliceRelIntermediateType(s []*relIntermediateType) (out []interfac
 
 }
 
-// deserializeobjectIntermediateType will accept a map to create a objectIntermediateType
-func deserializeObjectIntermediateType(in interface{}) (t *objectIntermediateType, err error) {
-	tmp := &objectIntermediateType{}
+// deserializeradiusIntermediateType will accept a map to create a radiusIntermediateType
+func deserializeRadiusIntermediateType(in interface{}) (t *radiusIntermediateType, err error) {
+	tmp := &radiusIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice objectIntermediateType will accept a slice to create a slice of objectIntermediateType
-func deserializeSliceObjectIntermediateType(in []interface{}) (t []*objectIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &objectIntermediateType{}
+// deserializeSlice radiusIntermediateType will accept a slice to create a slice of radiusIntermediateType
+func deserializeSliceRadiusIntermediateType(in []interface{}) (t []*radiusIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]radiusIntermediateType, len(in))
+	t = make([]*radiusIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7323,15 +9373,28 @@ func deserializeSliceObjectIntermediateType(in []interface{}) (t []*objectInterm
 
 }
 
-// serializeobjectIntermediateType will accept a objectIntermediateType to create a map
-func serializeObjectIntermediateType(t *objectIntermediateType) (i interface{}, err error) {
+// deserializeValuesradiusIntermediateType will accept a single value or a slice of them to create a slice of radiusIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesRadiusIntermediateType(in interface{}) (t []*radiusIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceRadiusIntermediateType(s)
+	}
+	tmp, err := deserializeRadiusIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*radiusIntermediateType{tmp}, nil
+
+}
+
+// serializeradiusIntermediateType will accept a radiusIntermediateType to create a map
+func serializeRadiusIntermediateType(t *radiusIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceobjectIntermediateType will accept a slice of objectIntermediateType to create a slice result
-func serializeSliceObjectIntermediateType(s []*objectIntermediateType) (out []interface{}, err error) {
+// serializeSliceradiusIntermediateType will accept a slice of radiusIntermediateType to create a slice result
+func serializeSliceRadiusIntermediateType(s []*radiusIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7343,20 +9406,26 @@ func serializeSliceObjectIntermediateType(s []*objectIntermediateType) (out []in
 
 }
 
-// deserializeinstrumentIntermediateType will accept a map to create a instrumentIntermediateType
-func deserializeInstrumentIntermediateType(in interface{}) (t *instrumentIntermediateType, err error) {
-	tmp := &instrumentIntermediateType{}
+// deserializerelIntermediateType will accept a map to create a relIntermediateType
+func deserializeRelIntermediateType(in interface{}) (t *relIntermediateType, err error) {
+	tmp := &relIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice instrumentIntermediateType will accept a slice to create a slice of instrumentIntermediateType
-func deserializeSliceInstrumentIntermediateType(in []interface{}) (t []*instrumentIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &instrumentIntermediateType{}
+// deserializeSlice relIntermediateType will accept a slice to create a slice of relIntermediateType
+func deserializeSliceRelIntermediateType(in []interface{}) (t []*relIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]relIntermediateType, len(in))
+	t = make([]*relIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7365,15 +9434,28 @@ func deserializeSliceInstrumentIntermediateType(in []interface{}) (t []*instrume
 
 }
 
-// serializeinstrumentIntermediateType will accept a instrumentIntermediateType to create a map
-func serializeInstrumentIntermediateType(t *instrumentIntermediateType) (i interface{}, err error) {
+// deserializeValuesrelIntermediateType will accept a single value or a slice of them to create a slice of relIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesRelIntermediateType(in interface{}) (t []*relIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceRelIntermediateType(s)
+	}
+	tmp, err := deserializeRelIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*relIntermediateType{tmp}, nil
+
+}
+
+// serializerelIntermediateType will accept a relIntermediateType to create a map
+func serializeRelIntermediateType(t *relIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceinstrumentIntermediateType will accept a slice of instrumentIntermediateType to create a slice result
-func serializeSliceInstrumentIntermediateType(s []*instrumentIntermediateType) (out []interface{}, err error) {
+// serializeSlicerelIntermediateType will accept a slice of relIntermediateType to create a slice result
+func serializeSliceRelIntermediateType(s []*relIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7385,20 +9467,26 @@ func serializeSliceInstrumentIntermediateType(s []*instrumentIntermediateType) (
 
 }
 
-// deserializeinReplyToIntermediateType will accept a map to create a inReplyToIntermediateType
-func deserializeInReplyToIntermediateType(in interface{}) (t *inReplyToIntermediateType, err error) {
-	tmp := &inReplyToIntermediateType{}
+// deserializerelationshipIntermediateType will accept a map to create a relationshipIntermediateType
+func deserializeRelationshipIntermediateType(in interface{}) (t *relationshipIntermediateType, err error) {
+	tmp := &relationshipIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice inReplyToIntermediateType will accept a slice to create a slice of inReplyToIntermediateType
-func deserializeSliceInReplyToIntermediateType(in []interface{}) (t []*inReplyToIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &inReplyToIntermediateType{}
+// deserializeSlice relationshipIntermediateType will accept a slice to create a slice of relationshipIntermediateType
+func deserializeSliceRelationshipIntermediateType(in []interface{}) (t []*relationshipIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]relationshipIntermediateType, len(in))
+	t = make([]*relationshipIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7407,15 +9495,28 @@ func deserializeSliceInReplyToIntermediateType(in []interface{}) (t []*inReplyTo
 
 }
 
-// serializeinReplyToIntermediateType will accept a inReplyToIntermediateType to create a map
-func serializeInReplyToIntermediateType(t *inReplyToIntermediateType) (i interface{}, err error) {
+// deserializeValuesrelationshipIntermediateType will accept a single value or a slice of them to create a slice of relationshipIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesRelationshipIntermediateType(in interface{}) (t []*relationshipIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceRelationshipIntermediateType(s)
+	}
+	tmp, err := deserializeRelationshipIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*relationshipIntermediateType{tmp}, nil
+
+}
+
+// serializerelationshipIntermediateType will accept a relationshipIntermediateType to create a map
+func serializeRelationshipIntermediateType(t *relationshipIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceinReplyToIntermediateType will accept a slice of inReplyToIntermediateType to create a slice result
-func serializeSliceInReplyToIntermediateType(s []*inReplyToIntermediateType) (out []interface{}, err error) {
+// serializeSlicerelationshipIntermediateType will accept a slice of relationshipIntermediateType to create a slice result
+func serializeSliceRelationshipIntermediateType(s []*relationshipIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7427,20 +9528,26 @@ func serializeSliceInReplyToIntermediateType(s []*inReplyToIntermediateType) (ou
 
 }
 
-// deserializelocationIntermediateType will accept a map to create a locationIntermediateType
-func deserializeLocationIntermediateType(in interface{}) (t *locationIntermediateType, err error) {
-	tmp := &locationIntermediateType{}
+// deserializerepliesIntermediateType will accept a map to create a repliesIntermediateType
+func deserializeRepliesIntermediateType(in interface{}) (t *repliesIntermediateType, err error) {
+	tmp := &repliesIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice locationIntermediateType will accept a slice to create a slice of locationIntermediateType
-func deserializeSliceLocationIntermediateType(in []interface{}) (t []*locationIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &locationIntermediateType{}
+// deserializeSlice repliesIntermediateType will accept a slice to create a slice of repliesIntermediateType
+func deserializeSliceRepliesIntermediateType(in []interface{}) (t []*repliesIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]repliesIntermediateType, len(in))
+	t = make([]*repliesIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7449,15 +9556,28 @@ func deserializeSliceLocationIntermediateType(in []interface{}) (t []*locationIn
 
 }
 
-// serializelocationIntermediateType will accept a locationIntermediateType to create a map
-func serializeLocationIntermediateType(t *locationIntermediateType) (i interface{}, err error) {
+// deserializeValuesrepliesIntermediateType will accept a single value or a slice of them to create a slice of repliesIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesRepliesIntermediateType(in interface{}) (t []*repliesIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceRepliesIntermediateType(s)
+	}
+	tmp, err := deserializeRepliesIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*repliesIntermediateType{tmp}, nil
+
+}
+
+// serializerepliesIntermediateType will accept a repliesIntermediateType to create a map
+func serializeRepliesIntermediateType(t *repliesIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicelocationIntermediateType will accept a slice of locationIntermediateType to create a slice result
-func serializeSliceLocationIntermediateType(s []*locationIntermediateType) (out []interface{}, err error) {
+// serializeSlicerepliesIntermediateType will accept a slice of repliesIntermediateType to create a slice result
+func serializeSliceRepliesIntermediateType(s []*repliesIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7469,20 +9589,26 @@ func serializeSliceLocationIntermediateType(s []*locationIntermediateType) (out
 
 }
 
-// deserializeccIntermediateType will accept a map to create a ccIntermediateType
-func deserializeCcIntermediateType(in interface{}) (t *ccIntermediateType, err error) {
-	tmp := &ccIntermediateType{}
+// deserializeresultIntermediateType will accept a map to create a resultIntermediateType
+func deserializeResultIntermediateType(in interface{}) (t *resultIntermediateType, err error) {
+	tmp := &resultIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice ccIntermediateType will accept a slice to create a slice of ccIntermediateType
-func deserializeSliceCcIntermediateType(in []interface{}) (t []*ccIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &ccIntermediateType{}
+// deserializeSlice resultIntermediateType will accept a slice to create a slice of resultIntermediateType
+func deserializeSliceResultIntermediateType(in []interface{}) (t []*resultIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]resultIntermediateType, len(in))
+	t = make([]*resultIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7491,15 +9617,28 @@ func deserializeSliceCcIntermediateType(in []interface{}) (t []*ccIntermediateTy
 
 }
 
-// serializeccIntermediateType will accept a ccIntermediateType to create a map
-func serializeCcIntermediateType(t *ccIntermediateType) (i interface{}, err error) {
+// deserializeValuesresultIntermediateType will accept a single value or a slice of them to create a slice of resultIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesResultIntermediateType(in interface{}) (t []*resultIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceResultIntermediateType(s)
+	}
+	tmp, err := deserializeResultIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*resultIntermediateType{tmp}, nil
+
+}
+
+// serializeresultIntermediateType will accept a resultIntermediateType to create a map
+func serializeResultIntermediateType(t *resultIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceccIntermediateType will accept a slice of ccIntermediateType to create a slice result
-func serializeSliceCcIntermediateType(s []*ccIntermediateType) (out []interface{}, err error) {
+// serializeSliceresultIntermediateType will accept a slice of resultIntermediateType to create a slice result
+func serializeSliceResultIntermediateType(s []*resultIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7511,20 +9650,26 @@ func serializeSliceCcIntermediateType(s []*ccIntermediateType) (out []interface{
 
 }
 
-// deserializenextIntermediateType will accept a map to create a nextIntermediateType
-func deserializeNextIntermediateType(in interface{}) (t *nextIntermediateType, err error) {
-	tmp := &nextIntermediateType{}
+// deserializesharesIntermediateType will accept a map to create a sharesIntermediateType
+func deserializeSharesIntermediateType(in interface{}) (t *sharesIntermediateType, err error) {
+	tmp := &sharesIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice nextIntermediateType will accept a slice to create a slice of nextIntermediateType
-func deserializeSliceNextIntermediateType(in []interface{}) (t []*nextIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &nextIntermediateType{}
+// deserializeSlice sharesIntermediateType will accept a slice to create a slice of sharesIntermediateType
+func deserializeSliceSharesIntermediateType(in []interface{}) (t []*sharesIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]sharesIntermediateType, len(in))
+	t = make([]*sharesIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7533,15 +9678,28 @@ func deserializeSliceNextIntermediateType(in []interface{}) (t []*nextIntermedia
 
 }
 
-// serializenextIntermediateType will accept a nextIntermediateType to create a map
-func serializeNextIntermediateType(t *nextIntermediateType) (i interface{}, err error) {
+// deserializeValuessharesIntermediateType will accept a single value or a slice of them to create a slice of sharesIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesSharesIntermediateType(in interface{}) (t []*sharesIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceSharesIntermediateType(s)
+	}
+	tmp, err := deserializeSharesIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*sharesIntermediateType{tmp}, nil
+
+}
+
+// serializesharesIntermediateType will accept a sharesIntermediateType to create a map
+func serializeSharesIntermediateType(t *sharesIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicenextIntermediateType will accept a slice of nextIntermediateType to create a slice result
-func serializeSliceNextIntermediateType(s []*nextIntermediateType) (out []interface{}, err error) {
+// serializeSlicesharesIntermediateType will accept a slice of sharesIntermediateType to create a slice result
+func serializeSliceSharesIntermediateType(s []*sharesIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7553,20 +9711,26 @@ func serializeSliceNextIntermediateType(s []*nextIntermediateType) (out []interf
 
 }
 
-// deserializeformerTypeIntermediateType will accept a map to create a formerTypeIntermediateType
-func deserializeFormerTypeIntermediateType(in interface{}) (t *formerTypeIntermediateType, err error) {
-	tmp := &formerTypeIntermediateType{}
+// deserializesourceIntermediateType will accept a map to create a sourceIntermediateType
+func deserializeSourceIntermediateType(in interface{}) (t *sourceIntermediateType, err error) {
+	tmp := &sourceIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice formerTypeIntermediateType will accept a slice to create a slice of formerTypeIntermediateType
-func deserializeSliceFormerTypeIntermediateType(in []interface{}) (t []*formerTypeIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &formerTypeIntermediateType{}
+// deserializeSlice sourceIntermediateType will accept a slice to create a slice of sourceIntermediateType
+func deserializeSliceSourceIntermediateType(in []interface{}) (t []*sourceIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]sourceIntermediateType, len(in))
+	t = make([]*sourceIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7575,15 +9739,28 @@ func deserializeSliceFormerTypeIntermediateType(in []interface{}) (t []*formerTy
 
 }
 
-// serializeformerTypeIntermediateType will accept a formerTypeIntermediateType to create a map
-func serializeFormerTypeIntermediateType(t *formerTypeIntermediateType) (i interface{}, err error) {
+// deserializeValuessourceIntermediateType will accept a single value or a slice of them to create a slice of sourceIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesSourceIntermediateType(in interface{}) (t []*sourceIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceSourceIntermediateType(s)
+	}
+	tmp, err := deserializeSourceIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*sourceIntermediateType{tmp}, nil
+
+}
+
+// serializesourceIntermediateType will accept a sourceIntermediateType to create a map
+func serializeSourceIntermediateType(t *sourceIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceformerTypeIntermediateType will accept a slice of formerTypeIntermediateType to create a slice result
-func serializeSliceFormerTypeIntermediateType(s []*formerTypeIntermediateType) (out []interface{}, err error) {
+// serializeSlicesourceIntermediateType will accept a slice of sourceIntermediateType to create a slice result
+func serializeSliceSourceIntermediateType(s []*sourceIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7595,20 +9772,26 @@ func serializeSliceFormerTypeIntermediateType(s []*formerTypeIntermediateType) (
 
 }
 
-// deserializeimageIntermediateType will accept a map to create a imageIntermediateType
-func deserializeImageIntermediateType(in interface{}) (t *imageIntermediateType, err error) {
-	tmp := &imageIntermediateType{}
+// deserializestartIndexIntermediateType will accept a map to create a startIndexIntermediateType
+func deserializeStartIndexIntermediateType(in interface{}) (t *startIndexIntermediateType, err error) {
+	tmp := &startIndexIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice imageIntermediateType will accept a slice to create a slice of imageIntermediateType
-func deserializeSliceImageIntermediateType(in []interface{}) (t []*imageIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &imageIntermediateType{}
+// deserializeSlice startIndexIntermediateType will accept a slice to create a slice of startIndexIntermediateType
+func deserializeSliceStartIndexIntermediateType(in []interface{}) (t []*startIndexIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]startIndexIntermediateType, len(in))
+	t = make([]*startIndexIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7617,15 +9800,28 @@ func deserializeSliceImageIntermediateType(in []interface{}) (t []*imageIntermed
 
 }
 
-// serializeimageIntermediateType will accept a imageIntermediateType to create a map
-func serializeImageIntermediateType(t *imageIntermediateType) (i interface{}, err error) {
+// deserializeValuesstartIndexIntermediateType will accept a single value or a slice of them to create a slice of startIndexIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesStartIndexIntermediateType(in interface{}) (t []*startIndexIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceStartIndexIntermediateType(s)
+	}
+	tmp, err := deserializeStartIndexIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*startIndexIntermediateType{tmp}, nil
+
+}
+
+// serializestartIndexIntermediateType will accept a startIndexIntermediateType to create a map
+func serializeStartIndexIntermediateType(t *startIndexIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceimageIntermediateType will accept a slice of imageIntermediateType to create a slice result
-func serializeSliceImageIntermediateType(s []*imageIntermediateType) (out []interface{}, err error) {
+// serializeSlicestartIndexIntermediateType will accept a slice of startIndexIntermediateType to create a slice result
+func serializeSliceStartIndexIntermediateType(s []*startIndexIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7637,20 +9833,26 @@ func serializeSliceImageIntermediateType(s []*imageIntermediateType) (out []inte
 
 }
 
-// deserializedurationIntermediateType will accept a map to create a durationIntermediateType
-func deserializeDurationIntermediateType(in interface{}) (t *durationIntermediateType, err error) {
-	tmp := &durationIntermediateType{}
+// deserializestartTimeIntermediateType will accept a map to create a startTimeIntermediateType
+func deserializeStartTimeIntermediateType(in interface{}) (t *startTimeIntermediateType, err error) {
+	tmp := &startTimeIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice durationIntermediateType will accept a slice to create a slice of durationIntermediateType
-func deserializeSliceDurationIntermediateType(in []interface{}) (t []*durationIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &durationIntermediateType{}
+// deserializeSlice startTimeIntermediateType will accept a slice to create a slice of startTimeIntermediateType
+func deserializeSliceStartTimeIntermediateType(in []interface{}) (t []*startTimeIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]startTimeIntermediateType, len(in))
+	t = make([]*startTimeIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7659,15 +9861,28 @@ func deserializeSliceDurationIntermediateType(in []interface{}) (t []*durationIn
 
 }
 
-// serializedurationIntermediateType will accept a durationIntermediateType to create a map
-func serializeDurationIntermediateType(t *durationIntermediateType) (i interface{}, err error) {
+// deserializeValuesstartTimeIntermediateType will accept a single value or a slice of them to create a slice of startTimeIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesStartTimeIntermediateType(in interface{}) (t []*startTimeIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceStartTimeIntermediateType(s)
+	}
+	tmp, err := deserializeStartTimeIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*startTimeIntermediateType{tmp}, nil
+
+}
+
+// serializestartTimeIntermediateType will accept a startTimeIntermediateType to create a map
+func serializeStartTimeIntermediateType(t *startTimeIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicedurationIntermediateType will accept a slice of durationIntermediateType to create a slice result
-func serializeSliceDurationIntermediateType(s []*durationIntermediateType) (out []interface{}, err error) {
+// serializeSlicestartTimeIntermediateType will accept a slice of startTimeIntermediateType to create a slice result
+func serializeSliceStartTimeIntermediateType(s []*startTimeIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7679,20 +9894,26 @@ func serializeSliceDurationIntermediateType(s []*durationIntermediateType) (out
 
 }
 
-// deserializefollowingIntermediateType will accept a map to create a followingIntermediateType
-func deserializeFollowingIntermediateType(in interface{}) (t *followingIntermediateType, err error) {
-	tmp := &followingIntermediateType{}
+// deserializesubjectIntermediateType will accept a map to create a subjectIntermediateType
+func deserializeSubjectIntermediateType(in interface{}) (t *subjectIntermediateType, err error) {
+	tmp := &subjectIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice followingIntermediateType will accept a slice to create a slice of followingIntermediateType
-func deserializeSliceFollowingIntermediateType(in []interface{}) (t []*followingIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &followingIntermediateType{}
+// deserializeSlice subjectIntermediateType will accept a slice to create a slice of subjectIntermediateType
+func deserializeSliceSubjectIntermediateType(in []interface{}) (t []*subjectIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]subjectIntermediateType, len(in))
+	t = make([]*subjectIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7701,15 +9922,28 @@ func deserializeSliceFollowingIntermediateType(in []interface{}) (t []*following
 
 }
 
-// serializefollowingIntermediateType will accept a followingIntermediateType to create a map
-func serializeFollowingIntermediateType(t *followingIntermediateType) (i interface{}, err error) {
+// deserializeValuessubjectIntermediateType will accept a single value or a slice of them to create a slice of subjectIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesSubjectIntermediateType(in interface{}) (t []*subjectIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceSubjectIntermediateType(s)
+	}
+	tmp, err := deserializeSubjectIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*subjectIntermediateType{tmp}, nil
+
+}
+
+// serializesubjectIntermediateType will accept a subjectIntermediateType to create a map
+func serializeSubjectIntermediateType(t *subjectIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicefollowingIntermediateType will accept a slice of followingIntermediateType to create a slice result
-func serializeSliceFollowingIntermediateType(s []*followingIntermediateType) (out []interface{}, err error) {
+// serializeSlicesubjectIntermediateType will accept a slice of subjectIntermediateType to create a slice result
+func serializeSliceSubjectIntermediateType(s []*subjectIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7721,20 +9955,26 @@ func serializeSliceFollowingIntermediateType(s []*followingIntermediateType) (ou
 
 }
 
-// deserializepreferredUsernameIntermediateType will accept a map to create a preferredUsernameIntermediateType
-func deserializePreferredUsernameIntermediateType(in interface{}) (t *preferredUsernameIntermediateType, err error) {
-	tmp := &preferredUsernameIntermediateType{}
+// deserializesummaryIntermediateType will accept a map to create a summaryIntermediateType
+func deserializeSummaryIntermediateType(in interface{}) (t *summaryIntermediateType, err error) {
+	tmp := &summaryIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice preferredUsernameIntermediateType will accept a slice to create a slice of preferredUsernameIntermediateType
-func deserializeSlicePreferredUsernameIntermediateType(in []interface{}) (t []*preferredUsernameIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &preferredUsernameIntermediateType{}
+// deserializeSlice summaryIntermediateType will accept a slice to create a slice of summaryIntermediateType
+func deserializeSliceSummaryIntermediateType(in []interface{}) (t []*summaryIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]summaryIntermediateType, len(in))
+	t = make([]*summaryIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7743,15 +9983,28 @@ func deserializeSlicePreferredUsernameIntermediateType(in []interface{}) (t []*p
 
 }
 
-// serializepreferredUsernameIntermediateType will accept a preferredUsernameIntermediateType to create a map
-func serializePreferredUsernameIntermediateType(t *preferredUsernameIntermediateType) (i interface{}, err error) {
+// deserializeValuessummaryIntermediateType will accept a single value or a slice of them to create a slice of summaryIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesSummaryIntermediateType(in interface{}) (t []*summaryIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceSummaryIntermediateType(s)
+	}
+	tmp, err := deserializeSummaryIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*summaryIntermediateType{tmp}, nil
+
+}
+
+// serializesummaryIntermediateType will accept a summaryIntermediateType to create a map
+func serializeSummaryIntermediateType(t *summaryIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicepreferredUsernameIntermediateType will accept a slice of preferredUsernameIntermediateType to create a slice result
-func serializeSlicePreferredUsernameIntermediateType(s []*preferredUsernameIntermediateType) (out []interface{}, err error) {
+// serializeSlicesummaryIntermediateType will accept a slice of summaryIntermediateType to create a slice result
+func serializeSliceSummaryIntermediateType(s []*summaryIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7763,20 +10016,26 @@ func serializeSlicePreferredUsernameIntermediateType(s []*preferredUsernameInter
 
 }
 
-// deserializeheightIntermediateType will accept a map to create a heightIntermediateType
-func deserializeHeightIntermediateType(in interface{}) (t *heightIntermediateType, err error) {
-	tmp := &heightIntermediateType{}
+// deserializetagIntermediateType will accept a map to create a tagIntermediateType
+func deserializeTagIntermediateType(in interface{}) (t *tagIntermediateType, err error) {
+	tmp := &tagIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice heightIntermediateType will accept a slice to create a slice of heightIntermediateType
-func deserializeSliceHeightIntermediateType(in []interface{}) (t []*heightIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &heightIntermediateType{}
+// deserializeSlice tagIntermediateType will accept a slice to create a slice of tagIntermediateType
+func deserializeSliceTagIntermediateType(in []interface{}) (t []*tagIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]tagIntermediateType, len(in))
+	t = make([]*tagIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7785,15 +10044,28 @@ func deserializeSliceHeightIntermediateType(in []interface{}) (t []*heightInterm
 
 }
 
-// serializeheightIntermediateType will accept a heightIntermediateType to create a map
-func serializeHeightIntermediateType(t *heightIntermediateType) (i interface{}, err error) {
+// deserializeValuestagIntermediateType will accept a single value or a slice of them to create a slice of tagIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesTagIntermediateType(in interface{}) (t []*tagIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceTagIntermediateType(s)
+	}
+	tmp, err := deserializeTagIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*tagIntermediateType{tmp}, nil
+
+}
+
+// serializetagIntermediateType will accept a tagIntermediateType to create a map
+func serializeTagIntermediateType(t *tagIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceheightIntermediateType will accept a slice of heightIntermediateType to create a slice result
-func serializeSliceHeightIntermediateType(s []*heightIntermediateType) (out []interface{}, err error) {
+// serializeSlicetagIntermediateType will accept a slice of tagIntermediateType to create a slice result
+func serializeSliceTagIntermediateType(s []*tagIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7805,20 +10077,26 @@ func serializeSliceHeightIntermediateType(s []*heightIntermediateType) (out []in
 
 }
 
-// deserializeattributedToIntermediateType will accept a map to create a attributedToIntermediateType
-func deserializeAttributedToIntermediateType(in interface{}) (t *attributedToIntermediateType, err error) {
-	tmp := &attributedToIntermediateType{}
+// deserializetargetIntermediateType will accept a map to create a targetIntermediateType
+func deserializeTargetIntermediateType(in interface{}) (t *targetIntermediateType, err error) {
+	tmp := &targetIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice attributedToIntermediateType will accept a slice to create a slice of attributedToIntermediateType
-func deserializeSliceAttributedToIntermediateType(in []interface{}) (t []*attributedToIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &attributedToIntermediateType{}
+// deserializeSlice targetIntermediateType will accept a slice to create a slice of targetIntermediateType
+func deserializeSliceTargetIntermediateType(in []interface{}) (t []*targetIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]targetIntermediateType, len(in))
+	t = make([]*targetIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7827,15 +10105,28 @@ func deserializeSliceAttributedToIntermediateType(in []interface{}) (t []*attrib
 
 }
 
-// serializeattributedToIntermediateType will accept a attributedToIntermediateType to create a map
-func serializeAttributedToIntermediateType(t *attributedToIntermediateType) (i interface{}, err error) {
+// deserializeValuestargetIntermediateType will accept a single value or a slice of them to create a slice of targetIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesTargetIntermediateType(in interface{}) (t []*targetIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceTargetIntermediateType(s)
+	}
+	tmp, err := deserializeTargetIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*targetIntermediateType{tmp}, nil
+
+}
+
+// serializetargetIntermediateType will accept a targetIntermediateType to create a map
+func serializeTargetIntermediateType(t *targetIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceattributedToIntermediateType will accept a slice of attributedToIntermediateType to create a slice result
-func serializeSliceAttributedToIntermediateType(s []*attributedToIntermediateType) (out []interface{}, err error) {
+// serializeSlicetargetIntermediateType will accept a slice of targetIntermediateType to create a slice result
+func serializeSliceTargetIntermediateType(s []*targetIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7847,20 +10138,26 @@ func serializeSliceAttributedToIntermediateType(s []*attributedToIntermediateTyp
 
 }
 
-// deserializeaudienceIntermediateType will accept a map to create a audienceIntermediateType
-func deserializeAudienceIntermediateType(in interface{}) (t *audienceIntermediateType, err error) {
-	tmp := &audienceIntermediateType{}
+// deserializetoIntermediateType will accept a map to create a toIntermediateType
+func deserializeToIntermediateType(in interface{}) (t *toIntermediateType, err error) {
+	tmp := &toIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice audienceIntermediateType will accept a slice to create a slice of audienceIntermediateType
-func deserializeSliceAudienceIntermediateType(in []interface{}) (t []*audienceIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &audienceIntermediateType{}
+// deserializeSlice toIntermediateType will accept a slice to create a slice of toIntermediateType
+func deserializeSliceToIntermediateType(in []interface{}) (t []*toIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]toIntermediateType, len(in))
+	t = make([]*toIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7869,15 +10166,28 @@ func deserializeSliceAudienceIntermediateType(in []interface{}) (t []*audienceIn
 
 }
 
-// serializeaudienceIntermediateType will accept a audienceIntermediateType to create a map
-func serializeAudienceIntermediateType(t *audienceIntermediateType) (i interface{}, err error) {
+// deserializeValuestoIntermediateType will accept a single value or a slice of them to create a slice of toIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesToIntermediateType(in interface{}) (t []*toIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceToIntermediateType(s)
+	}
+	tmp, err := deserializeToIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*toIntermediateType{tmp}, nil
+
+}
+
+// serializetoIntermediateType will accept a toIntermediateType to create a map
+func serializeToIntermediateType(t *toIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceaudienceIntermediateType will accept a slice of audienceIntermediateType to create a slice result
-func serializeSliceAudienceIntermediateType(s []*audienceIntermediateType) (out []interface{}, err error) {
+// serializeSlicetoIntermediateType will accept a slice of toIntermediateType to create a slice result
+func serializeSliceToIntermediateType(s []*toIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7889,20 +10199,26 @@ func serializeSliceAudienceIntermediateType(s []*audienceIntermediateType) (out
 
 }
 
-// deserializesummaryIntermediateType will accept a map to create a summaryIntermediateType
-func deserializeSummaryIntermediateType(in interface{}) (t *summaryIntermediateType, err error) {
-	tmp := &summaryIntermediateType{}
+// deserializetotalItemsIntermediateType will accept a map to create a totalItemsIntermediateType
+func deserializeTotalItemsIntermediateType(in interface{}) (t *totalItemsIntermediateType, err error) {
+	tmp := &totalItemsIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice summaryIntermediateType will accept a slice to create a slice of summaryIntermediateType
-func deserializeSliceSummaryIntermediateType(in []interface{}) (t []*summaryIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &summaryIntermediateType{}
+// deserializeSlice totalItemsIntermediateType will accept a slice to create a slice of totalItemsIntermediateType
+func deserializeSliceTotalItemsIntermediateType(in []interface{}) (t []*totalItemsIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]totalItemsIntermediateType, len(in))
+	t = make([]*totalItemsIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7911,15 +10227,28 @@ func deserializeSliceSummaryIntermediateType(in []interface{}) (t []*summaryInte
 
 }
 
-// serializesummaryIntermediateType will accept a summaryIntermediateType to create a map
-func serializeSummaryIntermediateType(t *summaryIntermediateType) (i interface{}, err error) {
+// deserializeValuestotalItemsIntermediateType will accept a single value or a slice of them to create a slice of totalItemsIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesTotalItemsIntermediateType(in interface{}) (t []*totalItemsIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceTotalItemsIntermediateType(s)
+	}
+	tmp, err := deserializeTotalItemsIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*totalItemsIntermediateType{tmp}, nil
+
+}
+
+// serializetotalItemsIntermediateType will accept a totalItemsIntermediateType to create a map
+func serializeTotalItemsIntermediateType(t *totalItemsIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicesummaryIntermediateType will accept a slice of summaryIntermediateType to create a slice result
-func serializeSliceSummaryIntermediateType(s []*summaryIntermediateType) (out []interface{}, err error) {
+// serializeSlicetotalItemsIntermediateType will accept a slice of totalItemsIntermediateType to create a slice result
+func serializeSliceTotalItemsIntermediateType(s []*totalItemsIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7931,20 +10260,26 @@ func serializeSliceSummaryIntermediateType(s []*summaryIntermediateType) (out []
 
 }
 
-// deserializesourceIntermediateType will accept a map to create a sourceIntermediateType
-func deserializeSourceIntermediateType(in interface{}) (t *sourceIntermediateType, err error) {
-	tmp := &sourceIntermediateType{}
+// deserializeunitsIntermediateType will accept a map to create a unitsIntermediateType
+func deserializeUnitsIntermediateType(in interface{}) (t *unitsIntermediateType, err error) {
+	tmp := &unitsIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice sourceIntermediateType will accept a slice to create a slice of sourceIntermediateType
-func deserializeSliceSourceIntermediateType(in []interface{}) (t []*sourceIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &sourceIntermediateType{}
+// deserializeSlice unitsIntermediateType will accept a slice to create a slice of unitsIntermediateType
+func deserializeSliceUnitsIntermediateType(in []interface{}) (t []*unitsIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]unitsIntermediateType, len(in))
+	t = make([]*unitsIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7953,15 +10288,28 @@ func deserializeSliceSourceIntermediateType(in []interface{}) (t []*sourceInterm
 
 }
 
-// serializesourceIntermediateType will accept a sourceIntermediateType to create a map
-func serializeSourceIntermediateType(t *sourceIntermediateType) (i interface{}, err error) {
+// deserializeValuesunitsIntermediateType will accept a single value or a slice of them to create a slice of unitsIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesUnitsIntermediateType(in interface{}) (t []*unitsIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceUnitsIntermediateType(s)
+	}
+	tmp, err := deserializeUnitsIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*unitsIntermediateType{tmp}, nil
+
+}
+
+// serializeunitsIntermediateType will accept a unitsIntermediateType to create a map
+func serializeUnitsIntermediateType(t *unitsIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicesourceIntermediateType will accept a slice of sourceIntermediateType to create a slice result
-func serializeSliceSourceIntermediateType(s []*sourceIntermediateType) (out []interface{}, err error) {
+// serializeSliceunitsIntermediateType will accept a slice of unitsIntermediateType to create a slice result
+func serializeSliceUnitsIntermediateType(s []*unitsIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -7973,20 +10321,26 @@ func serializeSliceSourceIntermediateType(s []*sourceIntermediateType) (out []in
 
 }
 
-// deserializeendpointsIntermediateType will accept a map to create a endpointsIntermediateType
-func deserializeEndpointsIntermediateType(in interface{}) (t *endpointsIntermediateType, err error) {
-	tmp := &endpointsIntermediateType{}
+// deserializeupdatedIntermediateType will accept a map to create a updatedIntermediateType
+func deserializeUpdatedIntermediateType(in interface{}) (t *updatedIntermediateType, err error) {
+	tmp := &updatedIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice endpointsIntermediateType will accept a slice to create a slice of endpointsIntermediateType
-func deserializeSliceEndpointsIntermediateType(in []interface{}) (t []*endpointsIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &endpointsIntermediateType{}
+// deserializeSlice updatedIntermediateType will accept a slice to create a slice of updatedIntermediateType
+func deserializeSliceUpdatedIntermediateType(in []interface{}) (t []*updatedIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]updatedIntermediateType, len(in))
+	t = make([]*updatedIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -7995,15 +10349,28 @@ func deserializeSliceEndpointsIntermediateType(in []interface{}) (t []*endpoints
 
 }
 
-// serializeendpointsIntermediateType will accept a endpointsIntermediateType to create a map
-func serializeEndpointsIntermediateType(t *endpointsIntermediateType) (i interface{}, err error) {
+// deserializeValuesupdatedIntermediateType will accept a single value or a slice of them to create a slice of updatedIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesUpdatedIntermediateType(in interface{}) (t []*updatedIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceUpdatedIntermediateType(s)
+	}
+	tmp, err := deserializeUpdatedIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*updatedIntermediateType{tmp}, nil
+
+}
+
+// serializeupdatedIntermediateType will accept a updatedIntermediateType to create a map
+func serializeUpdatedIntermediateType(t *updatedIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSliceendpointsIntermediateType will accept a slice of endpointsIntermediateType to create a slice result
-func serializeSliceEndpointsIntermediateType(s []*endpointsIntermediateType) (out []interface{}, err error) {
+// serializeSliceupdatedIntermediateType will accept a slice of updatedIntermediateType to create a slice result
+func serializeSliceUpdatedIntermediateType(s []*updatedIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -8015,20 +10382,26 @@ func serializeSliceEndpointsIntermediateType(s []*endpointsIntermediateType) (ou
 
 }
 
-// deserializelastIntermediateType will accept a map to create a lastIntermediateType
-func deserializeLastIntermediateType(in interface{}) (t *lastIntermediateType, err error) {
-	tmp := &lastIntermediateType{}
+// deserializeurlIntermediateType will accept a map to create a urlIntermediateType
+func deserializeUrlIntermediateType(in interface{}) (t *urlIntermediateType, err error) {
+	tmp := &urlIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice lastIntermediateType will accept a slice to create a slice of lastIntermediateType
-func deserializeSliceLastIntermediateType(in []interface{}) (t []*lastIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &lastIntermediateType{}
+// deserializeSlice urlIntermediateType will accept a slice to create a slice of urlIntermediateType
+func deserializeSliceUrlIntermediateType(in []interface{}) (t []*urlIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]urlIntermediateType, len(in))
+	t = make([]*urlIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -8037,15 +10410,28 @@ func deserializeSliceLastIntermediateType(in []interface{}) (t []*lastIntermedia
 
 }
 
-// serializelastIntermediateType will accept a lastIntermediateType to create a map
-func serializeLastIntermediateType(t *lastIntermediateType) (i interface{}, err error) {
+// deserializeValuesurlIntermediateType will accept a single value or a slice of them to create a slice of urlIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesUrlIntermediateType(in interface{}) (t []*urlIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceUrlIntermediateType(s)
+	}
+	tmp, err := deserializeUrlIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*urlIntermediateType{tmp}, nil
+
+}
+
+// serializeurlIntermediateType will accept a urlIntermediateType to create a map
+func serializeUrlIntermediateType(t *urlIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicelastIntermediateType will accept a slice of lastIntermediateType to create a slice result
-func serializeSliceLastIntermediateType(s []*lastIntermediateType) (out []interface{}, err error) {
+// serializeSliceurlIntermediateType will accept a slice of urlIntermediateType to create a slice result
+func serializeSliceUrlIntermediateType(s []*urlIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
@@ -8057,20 +10443,26 @@ func serializeSliceLastIntermediateType(s []*lastIntermediateType) (out []interf
 
 }
 
-// deserializedeletedIntermediateType will accept a map to create a deletedIntermediateType
-func deserializeDeletedIntermediateType(in interface{}) (t *deletedIntermediateType, err error) {
-	tmp := &deletedIntermediateType{}
+// deserializewidthIntermediateType will accept a map to create a widthIntermediateType
+func deserializeWidthIntermediateType(in interface{}) (t *widthIntermediateType, err error) {
+	tmp := &widthIntermediateType{}
 	err = tmp.Deserialize(in)
 	return tmp, err
 
 }
 
-// deserializeSlice deletedIntermediateType will accept a slice to create a slice of deletedIntermediateType
-func deserializeSliceDeletedIntermediateType(in []interface{}) (t []*deletedIntermediateType, err error) {
-	for _, i := range in {
-		tmp := &deletedIntermediateType{}
+// deserializeSlice widthIntermediateType will accept a slice to create a slice of widthIntermediateType
+func deserializeSliceWidthIntermediateType(in []interface{}) (t []*widthIntermediateType, err error) {
+	if len(in) == 0 {
+		return
+	}
+	backing := make([]widthIntermediateType, len(in))
+	t = make([]*widthIntermediateType, 0, len(in))
+	for idx, i := range in {
+		tmp := &backing[idx]
 		err = tmp.Deserialize(i)
 		if err != nil {
+			err = deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
 			return
 		}
 		t = append(t, tmp)
@@ -8079,15 +10471,28 @@ func deserializeSliceDeletedIntermediateType(in []interface{}) (t []*deletedInte
 
 }
 
-// serializedeletedIntermediateType will accept a deletedIntermediateType to create a map
-func serializeDeletedIntermediateType(t *deletedIntermediateType) (i interface{}, err error) {
+// deserializeValueswidthIntermediateType will accept a single value or a slice of them to create a slice of widthIntermediateType, shared by the Deserialize functions of every type with the property
+func deserializeValuesWidthIntermediateType(in interface{}) (t []*widthIntermediateType, err error) {
+	if s, ok := in.([]interface{}); ok {
+		return deserializeSliceWidthIntermediateType(s)
+	}
+	tmp, err := deserializeWidthIntermediateType(in)
+	if err != nil {
+		return
+	}
+	return []*widthIntermediateType{tmp}, nil
+
+}
+
+// serializewidthIntermediateType will accept a widthIntermediateType to create a map
+func serializeWidthIntermediateType(t *widthIntermediateType) (i interface{}, err error) {
 	i, err = t.Serialize()
 	return
 
 }
 
-// serializeSlicedeletedIntermediateType will accept a slice of deletedIntermediateType to create a slice result
-func serializeSliceDeletedIntermediateType(s []*deletedIntermediateType) (out []interface{}, err error) {
+// serializeSlicewidthIntermediateType will accept a slice of widthIntermediateType to create a slice result
+func serializeSliceWidthIntermediateType(s []*widthIntermediateType) (out []interface{}, err error) {
 	for _, t := range s {
 		v, err := t.Serialize()
 		if err != nil {
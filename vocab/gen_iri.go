@@ -0,0 +1,234 @@
+//
+package vocab
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// InvalidIRIError is returned by an IRIPolicy for an IRI it does not allow.
+type InvalidIRIError struct {
+	// Path is the path of property names to the IRI, as in a
+	// DeserializeError.
+	Path string
+	// IRI is the IRI that is not allowed.
+	IRI string
+	// Reason is why the IRI is not allowed.
+	Reason string
+}
+
+// Error describes the IRI and why it is not allowed.
+func (e *InvalidIRIError) Error() string {
+	return fmt.Sprintf("%s: IRI %q %s", e.Path, e.IRI, e.Reason)
+}
+
+// IRIPolicy is the schemes and structure of the IRIs that an application
+// accepts from other servers, so that a hostile server cannot have it follow
+// or display an IRI such as "javascript:..", "data:..", or "file:///etc". The
+// zero IRIPolicy allows every IRI.
+type IRIPolicy struct {
+	// Schemes are the schemes IRIs may have, such as "https". Any scheme
+	// is allowed if it is empty.
+	Schemes []string
+	// HTTPS are the properties whose IRIs must have the https scheme
+	// whatever the Schemes, such as "inbox".
+	HTTPS []string
+	// Absolute is whether IRIs must have a scheme and a host, and must not
+	// have user information.
+	Absolute bool
+	// Properties are the properties that Validate checks besides the
+	// properties of this package whose values may be IRIs and the HTTPS
+	// properties, such as those of extension vocabularies.
+	Properties []string
+}
+
+// DefaultIRIPolicy allows absolute http and https IRIs, requiring https for
+// the properties that federating deliveries and signatures rely on. The
+// "publicKeyId" property is the keyId of HTTP Signatures.
+var DefaultIRIPolicy = IRIPolicy{
+	Schemes:  []string{"https", "http"},
+	HTTPS:    []string{"inbox", "outbox", "sharedInbox", "publicKeyId"},
+	Absolute: true,
+}
+
+// iriProperties are the properties of this package whose values may be IRIs.
+var iriProperties = map[string]bool{
+	"actor":                      true,
+	"anyOf":                      true,
+	"attachment":                 true,
+	"attributedTo":               true,
+	"audience":                   true,
+	"bcc":                        true,
+	"bto":                        true,
+	"cc":                         true,
+	"context":                    true,
+	"current":                    true,
+	"describes":                  true,
+	"endpoints":                  true,
+	"first":                      true,
+	"followers":                  true,
+	"following":                  true,
+	"generator":                  true,
+	"href":                       true,
+	"icon":                       true,
+	"id":                         true,
+	"image":                      true,
+	"inReplyTo":                  true,
+	"inbox":                      true,
+	"instrument":                 true,
+	"items":                      true,
+	"last":                       true,
+	"liked":                      true,
+	"likes":                      true,
+	"location":                   true,
+	"next":                       true,
+	"oauthAuthorizationEndpoint": true,
+	"oauthTokenEndpoint":         true,
+	"object":                     true,
+	"oneOf":                      true,
+	"orderedItems":               true,
+	"origin":                     true,
+	"outbox":                     true,
+	"partOf":                     true,
+	"prev":                       true,
+	"preview":                    true,
+	"provideClientKey":           true,
+	"proxyUrl":                   true,
+	"relationship":               true,
+	"replies":                    true,
+	"result":                     true,
+	"sharedInbox":                true,
+	"shares":                     true,
+	"signClientKey":              true,
+	"source":                     true,
+	"streams":                    true,
+	"subject":                    true,
+	"tag":                        true,
+	"target":                     true,
+	"to":                         true,
+	"url":                        true,
+}
+
+// PublicIRI is the IRI of the special Public collection. Addressing a value to
+// it makes the value visible to everyone, without delivering it to anyone.
+const PublicIRI = "https://www.w3.org/ns/activitystreams#Public"
+
+// publicIRIs are the spellings of the IRI of the Public collection: in full,
+// compacted with the "as" prefix of the ActivityStreams context, and as the
+// bare term, which all expand to the same IRI.
+var publicIRIs = map[string]bool{
+	PublicIRI:   true,
+	"as:Public": true,
+	"Public":    true,
+}
+
+// IsPublicIRI returns true if the string is the IRI of the Public collection,
+// in any of its spellings. Validate allows them all whatever the policy.
+func IsPublicIRI(s string) bool {
+	return publicIRIs[s]
+}
+
+// ValidateIRI returns an InvalidIRIError if the policy does not allow the IRI
+// as a value of the property.
+func (p IRIPolicy) ValidateIRI(property string, iri *url.URL) error {
+	invalid := func(reason string) error {
+		return &InvalidIRIError{Path: property, IRI: iri.String(), Reason: reason}
+	}
+	scheme := strings.ToLower(iri.Scheme)
+	if len(p.Schemes) > 0 && !containsFold(p.Schemes, scheme) {
+		return invalid(fmt.Sprintf("has scheme %q, not one of %s", scheme, strings.Join(p.Schemes, ", ")))
+	} else if containsFold(p.HTTPS, property) && scheme != "https" {
+		return invalid("must have scheme \"https\"")
+	} else if p.Absolute && (len(scheme) == 0 || len(iri.Host) == 0) {
+		return invalid("is not absolute")
+	} else if p.Absolute && iri.User != nil {
+		return invalid("has user information")
+	}
+	return nil
+}
+
+// Validate returns ValidationErrors listing every IRI of the serialized value
+// that the policy does not allow, or nil if it allows them all. It checks the
+// string values of the properties whose values may be IRIs, including those of
+// the objects nested in the value, but not the '@context'. A property named by
+// an alias its '@context' defines, by a compacted or absolute IRI such as
+// "as:inbox", or by '@id' is checked as the term it stands for. Blank node
+// identifiers, such as "_:b0", are allowed whatever the policy, since they are
+// never dereferenced.
+func (p IRIPolicy) Validate(m map[string]interface{}) error {
+	var errs ValidationErrors
+	p.validateObject("", m, nil, &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateObject validates the IRIs of the properties of an object at a path,
+// with the aliases of the '@context' of the values enclosing it.
+func (p IRIPolicy) validateObject(path string, m map[string]interface{}, aliases map[string]string, errs *ValidationErrors) {
+	aliases = contextAliases(m["@context"], aliases)
+	for k, v := range m {
+		if k == "@context" {
+			continue
+		}
+		at := k
+		if len(path) > 0 {
+			at = path + "." + k
+		}
+		term := propertyTerm(k, aliases)
+		iri := iriProperties[term] || containsFold(p.HTTPS, term) || containsFold(p.Properties, term) || containsFold(p.Properties, k)
+		p.validateValue(term, at, v, aliases, iri, errs)
+	}
+}
+
+// propertyTerm returns the term a property name stands for, resolved as
+// Deserialize resolves it: the term of an alias of the '@context', or the
+// ActivityStreams term of a compacted or absolute IRI or of '@id'.
+func propertyTerm(k string, aliases map[string]string) string {
+	if term, ok := aliases[k]; ok {
+		return term
+	} else if term, ok := activityStreamsTerm(k, termPrefixes); ok {
+		return term
+	}
+	return k
+}
+
+// termPrefixes are the prefixes of the compacted IRIs that propertyTerm expands
+// without a '@context' defining them.
+var termPrefixes = map[string]string{"as": activityStreamsNamespaces[0]}
+
+// validateValue validates the IRIs of the value of a property at a path, which
+// are the strings if the property may have IRIs as values.
+func (p IRIPolicy) validateValue(property, path string, v interface{}, aliases map[string]string, iri bool, errs *ValidationErrors) {
+	switch x := v.(type) {
+	case string:
+		if !iri || IsPublicIRI(x) || strings.HasPrefix(x, "_:") {
+			return
+		}
+		u, err := url.Parse(x)
+		if err != nil {
+			*errs = append(*errs, &InvalidIRIError{Path: path, IRI: x, Reason: "cannot be parsed"})
+		} else if err = p.ValidateIRI(property, u); err != nil {
+			err.(*InvalidIRIError).Path = path
+			*errs = append(*errs, err)
+		}
+	case map[string]interface{}:
+		p.validateObject(path, x, aliases, errs)
+	case []interface{}:
+		for idx, elem := range x {
+			p.validateValue(property, fmt.Sprintf("%s[%d]", path, idx), elem, aliases, iri, errs)
+		}
+	}
+}
+
+// containsFold is whether the strings contain s, ignoring case.
+func containsFold(strs []string, s string) bool {
+	for _, str := range strs {
+		if strings.EqualFold(str, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+//
+package vocab
+
+import (
+	"fmt"
+)
+
+// TypeKind enumerates the types of this package, so that code dispatching on
+// the type of a value can switch over integers instead of comparing strings or
+// asserting each type in turn.
+type TypeKind int
+
+const (
+	// UnknownKind is the TypeKind of values that are not a type of this
+	// package.
+	UnknownKind TypeKind = iota
+	ObjectKind
+	LinkKind
+	ActivityKind
+	IntransitiveActivityKind
+	CollectionKind
+	OrderedCollectionKind
+	CollectionPageKind
+	OrderedCollectionPageKind
+	AcceptKind
+	TentativeAcceptKind
+	AddKind
+	ArriveKind
+	CreateKind
+	DeleteKind
+	FollowKind
+	IgnoreKind
+	JoinKind
+	LeaveKind
+	LikeKind
+	OfferKind
+	InviteKind
+	RejectKind
+	TentativeRejectKind
+	RemoveKind
+	UndoKind
+	UpdateKind
+	ViewKind
+	ListenKind
+	ReadKind
+	MoveKind
+	TravelKind
+	AnnounceKind
+	BlockKind
+	FlagKind
+	DislikeKind
+	QuestionKind
+	ApplicationKind
+	GroupKind
+	OrganizationKind
+	PersonKind
+	ServiceKind
+	RelationshipKind
+	ArticleKind
+	DocumentKind
+	AudioKind
+	ImageKind
+	VideoKind
+	NoteKind
+	PageKind
+	EventKind
+	PlaceKind
+	ProfileKind
+	TombstoneKind
+	MentionKind
+	HashtagKind
+)
+
+// kindNames are the names of the types of each TypeKind.
+var kindNames = [...]string{
+	UnknownKind:               "Unknown",
+	ObjectKind:                "Object",
+	LinkKind:                  "Link",
+	ActivityKind:              "Activity",
+	IntransitiveActivityKind:  "IntransitiveActivity",
+	CollectionKind:            "Collection",
+	OrderedCollectionKind:     "OrderedCollection",
+	CollectionPageKind:        "CollectionPage",
+	OrderedCollectionPageKind: "OrderedCollectionPage",
+	AcceptKind:                "Accept",
+	TentativeAcceptKind:       "TentativeAccept",
+	AddKind:                   "Add",
+	ArriveKind:                "Arrive",
+	CreateKind:                "Create",
+	DeleteKind:                "Delete",
+	FollowKind:                "Follow",
+	IgnoreKind:                "Ignore",
+	JoinKind:                  "Join",
+	LeaveKind:                 "Leave",
+	LikeKind:                  "Like",
+	OfferKind:                 "Offer",
+	InviteKind:                "Invite",
+	RejectKind:                "Reject",
+	TentativeRejectKind:       "TentativeReject",
+	RemoveKind:                "Remove",
+	UndoKind:                  "Undo",
+	UpdateKind:                "Update",
+	ViewKind:                  "View",
+	ListenKind:                "Listen",
+	ReadKind:                  "Read",
+	MoveKind:                  "Move",
+	TravelKind:                "Travel",
+	AnnounceKind:              "Announce",
+	BlockKind:                 "Block",
+	FlagKind:                  "Flag",
+	DislikeKind:               "Dislike",
+	QuestionKind:              "Question",
+	ApplicationKind:           "Application",
+	GroupKind:                 "Group",
+	OrganizationKind:          "Organization",
+	PersonKind:                "Person",
+	ServiceKind:               "Service",
+	RelationshipKind:          "Relationship",
+	ArticleKind:               "Article",
+	DocumentKind:              "Document",
+	AudioKind:                 "Audio",
+	ImageKind:                 "Image",
+	VideoKind:                 "Video",
+	NoteKind:                  "Note",
+	PageKind:                  "Page",
+	EventKind:                 "Event",
+	PlaceKind:                 "Place",
+	ProfileKind:               "Profile",
+	TombstoneKind:             "Tombstone",
+	MentionKind:               "Mention",
+	HashtagKind:               "Hashtag",
+}
+
+// String returns the name of the type of the TypeKind.
+func (k TypeKind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return fmt.Sprintf("TypeKind(%d)", int(k))
+	}
+	return kindNames[k]
+}
+
+// Type is implemented by every type of this package.
+type Type interface {
+	Serializer
+	Deserializer
+	// Kind returns the TypeKind of the type.
+	Kind() TypeKind
+}
+
+// KindOf returns the TypeKind of the value, or UnknownKind if it is nil.
+func KindOf(t Type) TypeKind {
+	if t == nil {
+		return UnknownKind
+	}
+	return t.Kind()
+}
+
+// ExtensionTypes maps the names of the types of extension vocabularies to
+// functions creating one, so that the properties of the types of this package
+// deserialize them rather than keeping them as unknown values. The packages
+// generated for extensions add their types when they are imported. A type
+// extending Object is resolved where objects are taken, and one extending Link
+// where links are.
+var ExtensionTypes = map[string]func() Type{}
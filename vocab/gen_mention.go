@@ -2,7 +2,10 @@
 package vocab
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"sort"
 )
 
 // MentionType is an interface for accepting types that extend from 'Mention'.
@@ -15,16 +18,19 @@ type MentionType interface {
 	AppendAttributedToObject(v ObjectType)
 	PrependAttributedToObject(v ObjectType)
 	RemoveAttributedToObject(index int)
+	AttributedToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAttributedToLink(index int) (ok bool)
 	GetAttributedToLink(index int) (v LinkType)
 	AppendAttributedToLink(v LinkType)
 	PrependAttributedToLink(v LinkType)
 	RemoveAttributedToLink(index int)
+	AttributedToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAttributedToIRI(index int) (ok bool)
 	GetAttributedToIRI(index int) (v *url.URL)
 	AppendAttributedToIRI(v *url.URL)
 	PrependAttributedToIRI(v *url.URL)
 	RemoveAttributedToIRI(index int)
+	AttributedToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAttributedTo() (ok bool)
 	GetUnknownAttributedTo() (v interface{})
 	SetUnknownAttributedTo(i interface{})
@@ -46,11 +52,13 @@ type MentionType interface {
 	AppendRel(v string)
 	PrependRel(v string)
 	RemoveRel(index int)
+	RelValues() (seq func(yield func(v string) bool))
 	IsRelIRI(index int) (ok bool)
 	GetRelIRI(index int) (v *url.URL)
 	AppendRelIRI(v *url.URL)
 	PrependRelIRI(v *url.URL)
 	RemoveRelIRI(index int)
+	RelIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownRel() (ok bool)
 	GetUnknownRel() (v interface{})
 	SetUnknownRel(i interface{})
@@ -74,44 +82,56 @@ type MentionType interface {
 	AppendNameString(v string)
 	PrependNameString(v string)
 	RemoveNameString(index int)
+	NameStringValues() (seq func(yield func(v string) bool))
 	IsNameLangString(index int) (ok bool)
 	GetNameLangString(index int) (v string)
 	AppendNameLangString(v string)
 	PrependNameLangString(v string)
 	RemoveNameLangString(index int)
+	NameLangStringValues() (seq func(yield func(v string) bool))
 	IsNameIRI(index int) (ok bool)
 	GetNameIRI(index int) (v *url.URL)
 	AppendNameIRI(v *url.URL)
 	PrependNameIRI(v *url.URL)
 	RemoveNameIRI(index int)
+	NameIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownName() (ok bool)
 	GetUnknownName() (v interface{})
 	SetUnknownName(i interface{})
 	NameMapLanguages() (l []string)
 	GetNameMap(l string) (v string)
 	SetNameMap(l string, v string)
+	GetNameLanguage(tag string) (v string, ok bool)
+	SetNameLanguage(tag string, v string)
+	PreferredNameLanguage(tags ...string) (v string, tag string)
 	SummaryLen() (l int)
 	IsSummaryString(index int) (ok bool)
 	GetSummaryString(index int) (v string)
 	AppendSummaryString(v string)
 	PrependSummaryString(v string)
 	RemoveSummaryString(index int)
+	SummaryStringValues() (seq func(yield func(v string) bool))
 	IsSummaryLangString(index int) (ok bool)
 	GetSummaryLangString(index int) (v string)
 	AppendSummaryLangString(v string)
 	PrependSummaryLangString(v string)
 	RemoveSummaryLangString(index int)
+	SummaryLangStringValues() (seq func(yield func(v string) bool))
 	IsSummaryIRI(index int) (ok bool)
 	GetSummaryIRI(index int) (v *url.URL)
 	AppendSummaryIRI(v *url.URL)
 	PrependSummaryIRI(v *url.URL)
 	RemoveSummaryIRI(index int)
+	SummaryIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownSummary() (ok bool)
 	GetUnknownSummary() (v interface{})
 	SetUnknownSummary(i interface{})
 	SummaryMapLanguages() (l []string)
 	GetSummaryMap(l string) (v string)
 	SetSummaryMap(l string, v string)
+	GetSummaryLanguage(tag string) (v string, ok bool)
+	SetSummaryLanguage(tag string, v string)
+	PreferredSummaryLanguage(tags ...string) (v string, tag string)
 	IsHreflang() (ok bool)
 	GetHreflang() (v string)
 	SetHreflang(v string)
@@ -145,25 +165,70 @@ type MentionType interface {
 	AppendPreviewObject(v ObjectType)
 	PrependPreviewObject(v ObjectType)
 	RemovePreviewObject(index int)
+	PreviewObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsPreviewLink(index int) (ok bool)
 	GetPreviewLink(index int) (v LinkType)
 	AppendPreviewLink(v LinkType)
 	PrependPreviewLink(v LinkType)
 	RemovePreviewLink(index int)
+	PreviewLinkValues() (seq func(yield func(v LinkType) bool))
 	IsPreviewIRI(index int) (ok bool)
 	GetPreviewIRI(index int) (v *url.URL)
 	AppendPreviewIRI(v *url.URL)
 	PrependPreviewIRI(v *url.URL)
 	RemovePreviewIRI(index int)
+	PreviewIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownPreview() (ok bool)
 	GetUnknownPreview() (v interface{})
 	SetUnknownPreview(i interface{})
-}
+	Validate() (err error)
+	ValidateStrict() (err error)
+	TryGetAttributedToObject(index int) (v ObjectType, err error)
+	TryGetAttributedToLink(index int) (v LinkType, err error)
+	TryGetAttributedToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttributedTo() (v interface{}, err error)
+	TryGetHref() (v *url.URL, err error)
+	TryGetUnknownHref() (v interface{}, err error)
+	TryGetId() (v *url.URL, err error)
+	TryGetUnknownId() (v interface{}, err error)
+	TryGetRel(index int) (v string, err error)
+	TryGetRelIRI(index int) (v *url.URL, err error)
+	TryGetUnknownRel() (v interface{}, err error)
+	TryGetType(index int) (v interface{}, err error)
+	TryGetMediaType() (v string, err error)
+	TryGetMediaTypeIRI() (v *url.URL, err error)
+	TryGetUnknownMediaType() (v interface{}, err error)
+	TryGetNameString(index int) (v string, err error)
+	TryGetNameLangString(index int) (v string, err error)
+	TryGetNameIRI(index int) (v *url.URL, err error)
+	TryGetUnknownName() (v interface{}, err error)
+	TryGetSummaryString(index int) (v string, err error)
+	TryGetSummaryLangString(index int) (v string, err error)
+	TryGetSummaryIRI(index int) (v *url.URL, err error)
+	TryGetUnknownSummary() (v interface{}, err error)
+	TryGetHreflang() (v string, err error)
+	TryGetHreflangIRI() (v *url.URL, err error)
+	TryGetUnknownHreflang() (v interface{}, err error)
+	TryGetHeight() (v int64, err error)
+	TryGetHeightIRI() (v *url.URL, err error)
+	TryGetUnknownHeight() (v interface{}, err error)
+	TryGetWidth() (v int64, err error)
+	TryGetWidthIRI() (v *url.URL, err error)
+	TryGetUnknownWidth() (v interface{}, err error)
+	TryGetPreviewObject(index int) (v ObjectType, err error)
+	TryGetPreviewLink(index int) (v LinkType, err error)
+	TryGetPreviewIRI(index int) (v *url.URL, err error)
+	TryGetUnknownPreview() (v interface{}, err error)
+}
+
+var _ MentionType = (*Mention)(nil)
 
 // A specialized Link that represents an @mention.
 type Mention struct {
 	// An unknown value.
 	unknown_ map[string]interface{}
+	// The order of the properties of the JSON this was unmarshalled from.
+	order_ *propertyOrder
 	// The 'attributedTo' value could have multiple types and values
 	attributedTo []*attributedToIntermediateType
 	// The functional 'href' value holds a single type and a single value
@@ -192,6 +257,8 @@ type Mention struct {
 	width *widthIntermediateType
 	// The 'preview' value could have multiple types and values
 	preview []*previewIntermediateType
+	// The bits of the members that are set, in the order of the members.
+	present_ [1]uint64
 }
 
 // AttributedToLen determines the number of elements able to be used for the IsAttributedToObject, GetAttributedToObject, and RemoveAttributedToObject functions
@@ -215,12 +282,14 @@ func (t *Mention) GetAttributedToObject(index int) (v ObjectType) {
 // AppendAttributedToObject adds to the back of attributedTo a ObjectType type
 func (t *Mention) AppendAttributedToObject(v ObjectType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Object: v})
+	t.markPresent_(0, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToObject adds to the front of attributedTo a ObjectType type
 func (t *Mention) PrependAttributedToObject(v ObjectType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Object: v}}, t.attributedTo...)
+	t.markPresent_(0, len(t.attributedTo) > 0)
 
 }
 
@@ -229,6 +298,22 @@ func (t *Mention) RemoveAttributedToObject(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToObjectValues returns an iterator over the values that GetAttributedToObject returns for each index where IsAttributedToObject is true, which can be used as an iter.Seq
+func (t *Mention) AttributedToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToObject(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -247,12 +332,14 @@ func (t *Mention) GetAttributedToLink(index int) (v LinkType) {
 // AppendAttributedToLink adds to the back of attributedTo a LinkType type
 func (t *Mention) AppendAttributedToLink(v LinkType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Link: v})
+	t.markPresent_(0, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToLink adds to the front of attributedTo a LinkType type
 func (t *Mention) PrependAttributedToLink(v LinkType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Link: v}}, t.attributedTo...)
+	t.markPresent_(0, len(t.attributedTo) > 0)
 
 }
 
@@ -261,6 +348,22 @@ func (t *Mention) RemoveAttributedToLink(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToLinkValues returns an iterator over the values that GetAttributedToLink returns for each index where IsAttributedToLink is true, which can be used as an iter.Seq
+func (t *Mention) AttributedToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToLink(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -279,12 +382,14 @@ func (t *Mention) GetAttributedToIRI(index int) (v *url.URL) {
 // AppendAttributedToIRI adds to the back of attributedTo a *url.URL type
 func (t *Mention) AppendAttributedToIRI(v *url.URL) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{IRI: v})
+	t.markPresent_(0, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToIRI adds to the front of attributedTo a *url.URL type
 func (t *Mention) PrependAttributedToIRI(v *url.URL) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{IRI: v}}, t.attributedTo...)
+	t.markPresent_(0, len(t.attributedTo) > 0)
 
 }
 
@@ -293,12 +398,28 @@ func (t *Mention) RemoveAttributedToIRI(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(0, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToIRIValues returns an iterator over the values that GetAttributedToIRI returns for each index where IsAttributedToIRI is true, which can be used as an iter.Seq
+func (t *Mention) AttributedToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAttributedTo determines whether the call to GetUnknownAttributedTo is safe
 func (t *Mention) HasUnknownAttributedTo() (ok bool) {
-	return t.attributedTo != nil && t.attributedTo[0].unknown_ != nil
+	return t.present_[0]&(1<<0) != 0 && t.attributedTo[0].unknown_ != nil
 
 }
 
@@ -316,12 +437,13 @@ func (t *Mention) SetUnknownAttributedTo(i interface{}) {
 	tmp := &attributedToIntermediateType{}
 	tmp.unknown_ = i
 	t.attributedTo = append(t.attributedTo, tmp)
+	t.markPresent_(0, len(t.attributedTo) > 0)
 
 }
 
 // HasHref determines whether the call to GetHref is safe
 func (t *Mention) HasHref() (ok bool) {
-	return t.href != nil
+	return t.present_[0]&(1<<1) != 0
 
 }
 
@@ -334,6 +456,7 @@ func (t *Mention) GetHref() (v *url.URL) {
 // SetHref sets the value of href
 func (t *Mention) SetHref(v *url.URL) {
 	t.href = v
+	t.markPresent_(1, true)
 
 }
 
@@ -360,7 +483,7 @@ func (t *Mention) SetUnknownHref(i interface{}) {
 
 // HasId determines whether the call to GetId is safe
 func (t *Mention) HasId() (ok bool) {
-	return t.id != nil
+	return t.present_[0]&(1<<2) != 0
 
 }
 
@@ -373,6 +496,7 @@ func (t *Mention) GetId() (v *url.URL) {
 // SetId sets the value of id
 func (t *Mention) SetId(v *url.URL) {
 	t.id = v
+	t.markPresent_(2, true)
 
 }
 
@@ -418,12 +542,14 @@ func (t *Mention) GetRel(index int) (v string) {
 // AppendRel adds to the back of rel a string type
 func (t *Mention) AppendRel(v string) {
 	t.rel = append(t.rel, &relIntermediateType{linkRelation: &v})
+	t.markPresent_(3, len(t.rel) > 0)
 
 }
 
 // PrependRel adds to the front of rel a string type
 func (t *Mention) PrependRel(v string) {
 	t.rel = append([]*relIntermediateType{&relIntermediateType{linkRelation: &v}}, t.rel...)
+	t.markPresent_(3, len(t.rel) > 0)
 
 }
 
@@ -432,6 +558,22 @@ func (t *Mention) RemoveRel(index int) {
 	copy(t.rel[index:], t.rel[index+1:])
 	t.rel[len(t.rel)-1] = nil
 	t.rel = t.rel[:len(t.rel)-1]
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// RelValues returns an iterator over the values that GetRel returns for each index where IsRel is true, which can be used as an iter.Seq
+func (t *Mention) RelValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.RelLen(); i++ {
+			if !t.IsRel(i) {
+				continue
+			}
+			if !yield(t.GetRel(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -450,12 +592,14 @@ func (t *Mention) GetRelIRI(index int) (v *url.URL) {
 // AppendRelIRI adds to the back of rel a *url.URL type
 func (t *Mention) AppendRelIRI(v *url.URL) {
 	t.rel = append(t.rel, &relIntermediateType{IRI: v})
+	t.markPresent_(3, len(t.rel) > 0)
 
 }
 
 // PrependRelIRI adds to the front of rel a *url.URL type
 func (t *Mention) PrependRelIRI(v *url.URL) {
 	t.rel = append([]*relIntermediateType{&relIntermediateType{IRI: v}}, t.rel...)
+	t.markPresent_(3, len(t.rel) > 0)
 
 }
 
@@ -464,12 +608,28 @@ func (t *Mention) RemoveRelIRI(index int) {
 	copy(t.rel[index:], t.rel[index+1:])
 	t.rel[len(t.rel)-1] = nil
 	t.rel = t.rel[:len(t.rel)-1]
+	t.markPresent_(3, len(t.rel) > 0)
+
+}
+
+// RelIRIValues returns an iterator over the values that GetRelIRI returns for each index where IsRelIRI is true, which can be used as an iter.Seq
+func (t *Mention) RelIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.RelLen(); i++ {
+			if !t.IsRelIRI(i) {
+				continue
+			}
+			if !yield(t.GetRelIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownRel determines whether the call to GetUnknownRel is safe
 func (t *Mention) HasUnknownRel() (ok bool) {
-	return t.rel != nil && t.rel[0].unknown_ != nil
+	return t.present_[0]&(1<<3) != 0 && t.rel[0].unknown_ != nil
 
 }
 
@@ -487,6 +647,7 @@ func (t *Mention) SetUnknownRel(i interface{}) {
 	tmp := &relIntermediateType{}
 	tmp.unknown_ = i
 	t.rel = append(t.rel, tmp)
+	t.markPresent_(3, len(t.rel) > 0)
 
 }
 
@@ -505,12 +666,14 @@ func (t *Mention) GetType(index int) (v interface{}) {
 // AppendType adds a value to the back of type
 func (t *Mention) AppendType(v interface{}) {
 	t.typeName = append(t.typeName, v)
+	t.markPresent_(4, len(t.typeName) > 0)
 
 }
 
 // PrependType adds a value to the front of type
 func (t *Mention) PrependType(v interface{}) {
 	t.typeName = append([]interface{}{v}, t.typeName...)
+	t.markPresent_(4, len(t.typeName) > 0)
 
 }
 
@@ -519,12 +682,13 @@ func (t *Mention) RemoveType(index int) {
 	copy(t.typeName[index:], t.typeName[index+1:])
 	t.typeName[len(t.typeName)-1] = nil
 	t.typeName = t.typeName[:len(t.typeName)-1]
+	t.markPresent_(4, len(t.typeName) > 0)
 
 }
 
 // IsMediaType determines whether the call to GetMediaType is safe
 func (t *Mention) IsMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.mimeMediaTypeValue != nil
+	return t.present_[0]&(1<<5) != 0 && t.mediaType.mimeMediaTypeValue != nil
 
 }
 
@@ -537,12 +701,13 @@ func (t *Mention) GetMediaType() (v string) {
 // SetMediaType sets the value of mediaType to be of string type
 func (t *Mention) SetMediaType(v string) {
 	t.mediaType = &mediaTypeIntermediateType{mimeMediaTypeValue: &v}
+	t.markPresent_(5, true)
 
 }
 
 // IsMediaTypeIRI determines whether the call to GetMediaTypeIRI is safe
 func (t *Mention) IsMediaTypeIRI() (ok bool) {
-	return t.mediaType != nil && t.mediaType.IRI != nil
+	return t.present_[0]&(1<<5) != 0 && t.mediaType.IRI != nil
 
 }
 
@@ -555,12 +720,13 @@ func (t *Mention) GetMediaTypeIRI() (v *url.URL) {
 // SetMediaTypeIRI sets the value of mediaType to be of *url.URL type
 func (t *Mention) SetMediaTypeIRI(v *url.URL) {
 	t.mediaType = &mediaTypeIntermediateType{IRI: v}
+	t.markPresent_(5, true)
 
 }
 
 // HasUnknownMediaType determines whether the call to GetUnknownMediaType is safe
 func (t *Mention) HasUnknownMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.unknown_ != nil
+	return t.present_[0]&(1<<5) != 0 && t.mediaType.unknown_ != nil
 
 }
 
@@ -578,6 +744,7 @@ func (t *Mention) SetUnknownMediaType(i interface{}) {
 	tmp := &mediaTypeIntermediateType{}
 	tmp.unknown_ = i
 	t.mediaType = tmp
+	t.markPresent_(5, true)
 
 }
 
@@ -602,12 +769,14 @@ func (t *Mention) GetNameString(index int) (v string) {
 // AppendNameString adds to the back of name a string type
 func (t *Mention) AppendNameString(v string) {
 	t.name = append(t.name, &nameIntermediateType{stringName: &v})
+	t.markPresent_(6, len(t.name) > 0)
 
 }
 
 // PrependNameString adds to the front of name a string type
 func (t *Mention) PrependNameString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{stringName: &v}}, t.name...)
+	t.markPresent_(6, len(t.name) > 0)
 
 }
 
@@ -616,6 +785,22 @@ func (t *Mention) RemoveNameString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// NameStringValues returns an iterator over the values that GetNameString returns for each index where IsNameString is true, which can be used as an iter.Seq
+func (t *Mention) NameStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameString(i) {
+				continue
+			}
+			if !yield(t.GetNameString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -634,12 +819,14 @@ func (t *Mention) GetNameLangString(index int) (v string) {
 // AppendNameLangString adds to the back of name a string type
 func (t *Mention) AppendNameLangString(v string) {
 	t.name = append(t.name, &nameIntermediateType{langString: &v})
+	t.markPresent_(6, len(t.name) > 0)
 
 }
 
 // PrependNameLangString adds to the front of name a string type
 func (t *Mention) PrependNameLangString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{langString: &v}}, t.name...)
+	t.markPresent_(6, len(t.name) > 0)
 
 }
 
@@ -648,6 +835,22 @@ func (t *Mention) RemoveNameLangString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// NameLangStringValues returns an iterator over the values that GetNameLangString returns for each index where IsNameLangString is true, which can be used as an iter.Seq
+func (t *Mention) NameLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameLangString(i) {
+				continue
+			}
+			if !yield(t.GetNameLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -666,12 +869,14 @@ func (t *Mention) GetNameIRI(index int) (v *url.URL) {
 // AppendNameIRI adds to the back of name a *url.URL type
 func (t *Mention) AppendNameIRI(v *url.URL) {
 	t.name = append(t.name, &nameIntermediateType{IRI: v})
+	t.markPresent_(6, len(t.name) > 0)
 
 }
 
 // PrependNameIRI adds to the front of name a *url.URL type
 func (t *Mention) PrependNameIRI(v *url.URL) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{IRI: v}}, t.name...)
+	t.markPresent_(6, len(t.name) > 0)
 
 }
 
@@ -680,12 +885,28 @@ func (t *Mention) RemoveNameIRI(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(6, len(t.name) > 0)
+
+}
+
+// NameIRIValues returns an iterator over the values that GetNameIRI returns for each index where IsNameIRI is true, which can be used as an iter.Seq
+func (t *Mention) NameIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameIRI(i) {
+				continue
+			}
+			if !yield(t.GetNameIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownName determines whether the call to GetUnknownName is safe
 func (t *Mention) HasUnknownName() (ok bool) {
-	return t.name != nil && t.name[0].unknown_ != nil
+	return t.present_[0]&(1<<6) != 0 && t.name[0].unknown_ != nil
 
 }
 
@@ -703,6 +924,7 @@ func (t *Mention) SetUnknownName(i interface{}) {
 	tmp := &nameIntermediateType{}
 	tmp.unknown_ = i
 	t.name = append(t.name, tmp)
+	t.markPresent_(6, len(t.name) > 0)
 
 }
 
@@ -736,11 +958,41 @@ func (t *Mention) GetNameMap(l string) (v string) {
 func (t *Mention) SetNameMap(l string, v string) {
 	if t.nameMap == nil {
 		t.nameMap = make(map[string]string)
+		t.markPresent_(7, true)
 	}
 	t.nameMap[l] = v
 
 }
 
+// GetNameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Mention) GetNameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.nameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.nameMap[k], true
+
+}
+
+// SetNameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Mention) SetNameLanguage(tag string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(7, true)
+	} else if k, ok := languageKey(t.nameMap, tag); ok {
+		delete(t.nameMap, k)
+	}
+	t.nameMap[tag] = v
+
+}
+
+// PreferredNameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Mention) PreferredNameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.nameMap, tags)
+	return t.nameMap[tag], tag
+
+}
+
 // SummaryLen determines the number of elements able to be used for the IsSummaryString, GetSummaryString, and RemoveSummaryString functions
 func (t *Mention) SummaryLen() (l int) {
 	return len(t.summary)
@@ -762,12 +1014,14 @@ func (t *Mention) GetSummaryString(index int) (v string) {
 // AppendSummaryString adds to the back of summary a string type
 func (t *Mention) AppendSummaryString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{stringName: &v})
+	t.markPresent_(8, len(t.summary) > 0)
 
 }
 
 // PrependSummaryString adds to the front of summary a string type
 func (t *Mention) PrependSummaryString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{stringName: &v}}, t.summary...)
+	t.markPresent_(8, len(t.summary) > 0)
 
 }
 
@@ -776,6 +1030,22 @@ func (t *Mention) RemoveSummaryString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// SummaryStringValues returns an iterator over the values that GetSummaryString returns for each index where IsSummaryString is true, which can be used as an iter.Seq
+func (t *Mention) SummaryStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -794,12 +1064,14 @@ func (t *Mention) GetSummaryLangString(index int) (v string) {
 // AppendSummaryLangString adds to the back of summary a string type
 func (t *Mention) AppendSummaryLangString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{langString: &v})
+	t.markPresent_(8, len(t.summary) > 0)
 
 }
 
 // PrependSummaryLangString adds to the front of summary a string type
 func (t *Mention) PrependSummaryLangString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{langString: &v}}, t.summary...)
+	t.markPresent_(8, len(t.summary) > 0)
 
 }
 
@@ -808,6 +1080,22 @@ func (t *Mention) RemoveSummaryLangString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// SummaryLangStringValues returns an iterator over the values that GetSummaryLangString returns for each index where IsSummaryLangString is true, which can be used as an iter.Seq
+func (t *Mention) SummaryLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryLangString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -826,12 +1114,14 @@ func (t *Mention) GetSummaryIRI(index int) (v *url.URL) {
 // AppendSummaryIRI adds to the back of summary a *url.URL type
 func (t *Mention) AppendSummaryIRI(v *url.URL) {
 	t.summary = append(t.summary, &summaryIntermediateType{IRI: v})
+	t.markPresent_(8, len(t.summary) > 0)
 
 }
 
 // PrependSummaryIRI adds to the front of summary a *url.URL type
 func (t *Mention) PrependSummaryIRI(v *url.URL) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{IRI: v}}, t.summary...)
+	t.markPresent_(8, len(t.summary) > 0)
 
 }
 
@@ -840,12 +1130,28 @@ func (t *Mention) RemoveSummaryIRI(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(8, len(t.summary) > 0)
+
+}
+
+// SummaryIRIValues returns an iterator over the values that GetSummaryIRI returns for each index where IsSummaryIRI is true, which can be used as an iter.Seq
+func (t *Mention) SummaryIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryIRI(i) {
+				continue
+			}
+			if !yield(t.GetSummaryIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownSummary determines whether the call to GetUnknownSummary is safe
 func (t *Mention) HasUnknownSummary() (ok bool) {
-	return t.summary != nil && t.summary[0].unknown_ != nil
+	return t.present_[0]&(1<<8) != 0 && t.summary[0].unknown_ != nil
 
 }
 
@@ -863,6 +1169,7 @@ func (t *Mention) SetUnknownSummary(i interface{}) {
 	tmp := &summaryIntermediateType{}
 	tmp.unknown_ = i
 	t.summary = append(t.summary, tmp)
+	t.markPresent_(8, len(t.summary) > 0)
 
 }
 
@@ -896,14 +1203,44 @@ func (t *Mention) GetSummaryMap(l string) (v string) {
 func (t *Mention) SetSummaryMap(l string, v string) {
 	if t.summaryMap == nil {
 		t.summaryMap = make(map[string]string)
+		t.markPresent_(9, true)
 	}
 	t.summaryMap[l] = v
 
 }
 
+// GetSummaryLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *Mention) GetSummaryLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.summaryMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.summaryMap[k], true
+
+}
+
+// SetSummaryLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *Mention) SetSummaryLanguage(tag string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(9, true)
+	} else if k, ok := languageKey(t.summaryMap, tag); ok {
+		delete(t.summaryMap, k)
+	}
+	t.summaryMap[tag] = v
+
+}
+
+// PreferredSummaryLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *Mention) PreferredSummaryLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.summaryMap, tags)
+	return t.summaryMap[tag], tag
+
+}
+
 // IsHreflang determines whether the call to GetHreflang is safe
 func (t *Mention) IsHreflang() (ok bool) {
-	return t.hreflang != nil && t.hreflang.bcp47LanguageTag != nil
+	return t.present_[0]&(1<<10) != 0 && t.hreflang.bcp47LanguageTag != nil
 
 }
 
@@ -916,12 +1253,13 @@ func (t *Mention) GetHreflang() (v string) {
 // SetHreflang sets the value of hreflang to be of string type
 func (t *Mention) SetHreflang(v string) {
 	t.hreflang = &hreflangIntermediateType{bcp47LanguageTag: &v}
+	t.markPresent_(10, true)
 
 }
 
 // IsHreflangIRI determines whether the call to GetHreflangIRI is safe
 func (t *Mention) IsHreflangIRI() (ok bool) {
-	return t.hreflang != nil && t.hreflang.IRI != nil
+	return t.present_[0]&(1<<10) != 0 && t.hreflang.IRI != nil
 
 }
 
@@ -934,12 +1272,13 @@ func (t *Mention) GetHreflangIRI() (v *url.URL) {
 // SetHreflangIRI sets the value of hreflang to be of *url.URL type
 func (t *Mention) SetHreflangIRI(v *url.URL) {
 	t.hreflang = &hreflangIntermediateType{IRI: v}
+	t.markPresent_(10, true)
 
 }
 
 // HasUnknownHreflang determines whether the call to GetUnknownHreflang is safe
 func (t *Mention) HasUnknownHreflang() (ok bool) {
-	return t.hreflang != nil && t.hreflang.unknown_ != nil
+	return t.present_[0]&(1<<10) != 0 && t.hreflang.unknown_ != nil
 
 }
 
@@ -957,12 +1296,13 @@ func (t *Mention) SetUnknownHreflang(i interface{}) {
 	tmp := &hreflangIntermediateType{}
 	tmp.unknown_ = i
 	t.hreflang = tmp
+	t.markPresent_(10, true)
 
 }
 
 // IsHeight determines whether the call to GetHeight is safe
 func (t *Mention) IsHeight() (ok bool) {
-	return t.height != nil && t.height.nonNegativeInteger != nil
+	return t.present_[0]&(1<<11) != 0 && t.height.nonNegativeInteger != nil
 
 }
 
@@ -975,12 +1315,13 @@ func (t *Mention) GetHeight() (v int64) {
 // SetHeight sets the value of height to be of int64 type
 func (t *Mention) SetHeight(v int64) {
 	t.height = &heightIntermediateType{nonNegativeInteger: &v}
+	t.markPresent_(11, true)
 
 }
 
 // IsHeightIRI determines whether the call to GetHeightIRI is safe
 func (t *Mention) IsHeightIRI() (ok bool) {
-	return t.height != nil && t.height.IRI != nil
+	return t.present_[0]&(1<<11) != 0 && t.height.IRI != nil
 
 }
 
@@ -993,12 +1334,13 @@ func (t *Mention) GetHeightIRI() (v *url.URL) {
 // SetHeightIRI sets the value of height to be of *url.URL type
 func (t *Mention) SetHeightIRI(v *url.URL) {
 	t.height = &heightIntermediateType{IRI: v}
+	t.markPresent_(11, true)
 
 }
 
 // HasUnknownHeight determines whether the call to GetUnknownHeight is safe
 func (t *Mention) HasUnknownHeight() (ok bool) {
-	return t.height != nil && t.height.unknown_ != nil
+	return t.present_[0]&(1<<11) != 0 && t.height.unknown_ != nil
 
 }
 
@@ -1016,12 +1358,13 @@ func (t *Mention) SetUnknownHeight(i interface{}) {
 	tmp := &heightIntermediateType{}
 	tmp.unknown_ = i
 	t.height = tmp
+	t.markPresent_(11, true)
 
 }
 
 // IsWidth determines whether the call to GetWidth is safe
 func (t *Mention) IsWidth() (ok bool) {
-	return t.width != nil && t.width.nonNegativeInteger != nil
+	return t.present_[0]&(1<<12) != 0 && t.width.nonNegativeInteger != nil
 
 }
 
@@ -1034,12 +1377,13 @@ func (t *Mention) GetWidth() (v int64) {
 // SetWidth sets the value of width to be of int64 type
 func (t *Mention) SetWidth(v int64) {
 	t.width = &widthIntermediateType{nonNegativeInteger: &v}
+	t.markPresent_(12, true)
 
 }
 
 // IsWidthIRI determines whether the call to GetWidthIRI is safe
 func (t *Mention) IsWidthIRI() (ok bool) {
-	return t.width != nil && t.width.IRI != nil
+	return t.present_[0]&(1<<12) != 0 && t.width.IRI != nil
 
 }
 
@@ -1052,12 +1396,13 @@ func (t *Mention) GetWidthIRI() (v *url.URL) {
 // SetWidthIRI sets the value of width to be of *url.URL type
 func (t *Mention) SetWidthIRI(v *url.URL) {
 	t.width = &widthIntermediateType{IRI: v}
+	t.markPresent_(12, true)
 
 }
 
 // HasUnknownWidth determines whether the call to GetUnknownWidth is safe
 func (t *Mention) HasUnknownWidth() (ok bool) {
-	return t.width != nil && t.width.unknown_ != nil
+	return t.present_[0]&(1<<12) != 0 && t.width.unknown_ != nil
 
 }
 
@@ -1075,6 +1420,7 @@ func (t *Mention) SetUnknownWidth(i interface{}) {
 	tmp := &widthIntermediateType{}
 	tmp.unknown_ = i
 	t.width = tmp
+	t.markPresent_(12, true)
 
 }
 
@@ -1099,12 +1445,14 @@ func (t *Mention) GetPreviewObject(index int) (v ObjectType) {
 // AppendPreviewObject adds to the back of preview a ObjectType type
 func (t *Mention) AppendPreviewObject(v ObjectType) {
 	t.preview = append(t.preview, &previewIntermediateType{Object: v})
+	t.markPresent_(13, len(t.preview) > 0)
 
 }
 
 // PrependPreviewObject adds to the front of preview a ObjectType type
 func (t *Mention) PrependPreviewObject(v ObjectType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Object: v}}, t.preview...)
+	t.markPresent_(13, len(t.preview) > 0)
 
 }
 
@@ -1113,6 +1461,22 @@ func (t *Mention) RemovePreviewObject(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PreviewObjectValues returns an iterator over the values that GetPreviewObject returns for each index where IsPreviewObject is true, which can be used as an iter.Seq
+func (t *Mention) PreviewObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewObject(i) {
+				continue
+			}
+			if !yield(t.GetPreviewObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1131,12 +1495,14 @@ func (t *Mention) GetPreviewLink(index int) (v LinkType) {
 // AppendPreviewLink adds to the back of preview a LinkType type
 func (t *Mention) AppendPreviewLink(v LinkType) {
 	t.preview = append(t.preview, &previewIntermediateType{Link: v})
+	t.markPresent_(13, len(t.preview) > 0)
 
 }
 
 // PrependPreviewLink adds to the front of preview a LinkType type
 func (t *Mention) PrependPreviewLink(v LinkType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Link: v}}, t.preview...)
+	t.markPresent_(13, len(t.preview) > 0)
 
 }
 
@@ -1145,6 +1511,22 @@ func (t *Mention) RemovePreviewLink(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PreviewLinkValues returns an iterator over the values that GetPreviewLink returns for each index where IsPreviewLink is true, which can be used as an iter.Seq
+func (t *Mention) PreviewLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewLink(i) {
+				continue
+			}
+			if !yield(t.GetPreviewLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1163,12 +1545,14 @@ func (t *Mention) GetPreviewIRI(index int) (v *url.URL) {
 // AppendPreviewIRI adds to the back of preview a *url.URL type
 func (t *Mention) AppendPreviewIRI(v *url.URL) {
 	t.preview = append(t.preview, &previewIntermediateType{IRI: v})
+	t.markPresent_(13, len(t.preview) > 0)
 
 }
 
 // PrependPreviewIRI adds to the front of preview a *url.URL type
 func (t *Mention) PrependPreviewIRI(v *url.URL) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{IRI: v}}, t.preview...)
+	t.markPresent_(13, len(t.preview) > 0)
 
 }
 
@@ -1177,12 +1561,28 @@ func (t *Mention) RemovePreviewIRI(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(13, len(t.preview) > 0)
+
+}
+
+// PreviewIRIValues returns an iterator over the values that GetPreviewIRI returns for each index where IsPreviewIRI is true, which can be used as an iter.Seq
+func (t *Mention) PreviewIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewIRI(i) {
+				continue
+			}
+			if !yield(t.GetPreviewIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownPreview determines whether the call to GetUnknownPreview is safe
 func (t *Mention) HasUnknownPreview() (ok bool) {
-	return t.preview != nil && t.preview[0].unknown_ != nil
+	return t.present_[0]&(1<<13) != 0 && t.preview[0].unknown_ != nil
 
 }
 
@@ -1200,6 +1600,7 @@ func (t *Mention) SetUnknownPreview(i interface{}) {
 	tmp := &previewIntermediateType{}
 	tmp.unknown_ = i
 	t.preview = append(t.preview, tmp)
+	t.markPresent_(13, len(t.preview) > 0)
 
 }
 
@@ -1236,6 +1637,32 @@ func (t *Mention) GetUnknown(k string) (i interface{}) {
 
 }
 
+// GetUnknownProperties returns a copy of every unknown property of this object by key, such as the extension properties it was deserialized with, or nil if there are none. Serialize writes them back, so they are never discarded.
+func (t *Mention) GetUnknownProperties() (m map[string]interface{}) {
+	if len(t.unknown_) == 0 {
+		return nil
+	}
+	m = make(map[string]interface{}, len(t.unknown_))
+	for k, v := range t.unknown_ {
+		m[k] = v
+	}
+	return
+
+}
+
+// SetUnknownProperty sets the unknown property of this object with the specified key, or removes it if i is nil. Unlike AddUnknown, it does not return the object, so that every type shares its signature and extension vocabularies can set their properties of any type.
+func (t *Mention) SetUnknownProperty(k string, i interface{}) {
+	if i == nil {
+		delete(t.unknown_, k)
+		return
+	}
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+
+}
+
 // Serialize turns this object into a map[string]interface{}. Note that the "type" property will automatically be populated with "Mention" if not manually set by the caller
 func (t *Mention) Serialize() (m map[string]interface{}, err error) {
 	m = make(map[string]interface{})
@@ -1253,419 +1680,1214 @@ func (t *Mention) Serialize() (m map[string]interface{}, err error) {
 	}
 	if !typeAlreadySet {
 		t.typeName = append(t.typeName, "Mention")
+		t.markPresent_(4, len(t.typeName) > 0)
 	}
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err == nil && v != nil {
-		if len(v) == 1 {
-			m["attributedTo"] = v[0]
-		} else {
-			m["attributedTo"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	if t.href != nil {
-		hrefSerializeFunc := func() (interface{}, error) {
-			v := t.href
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		hrefResult, err := hrefSerializeFunc()
-		if err == nil {
-			m["href"] = hrefResult
-		} else {
+	if t.present_[0]&(1<<0) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attributedTo", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<1) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.href != nil {
+			hrefSerializeFunc := func() (interface{}, error) {
+				v := t.href
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			hrefResult, err := hrefSerializeFunc()
+			if err == nil {
+				m["href"] = hrefResult
+			} else {
+				return m, err
+			}
 		}
+		// End generation by RangeReference.Serialize for Value
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.id != nil {
-		idSerializeFunc := func() (interface{}, error) {
-			v := t.id
-			tmp := anyURISerialize(v)
-			return tmp, nil
+	if t.present_[0]&(1<<2) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.id != nil {
+			idSerializeFunc := func() (interface{}, error) {
+				v := t.id
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			idResult, err := idSerializeFunc()
+			if err == nil {
+				m["id"] = idResult
+			} else {
+				return m, err
+			}
 		}
-		idResult, err := idSerializeFunc()
-		if err == nil {
-			m["id"] = idResult
-		} else {
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<3) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceRelIntermediateType(t.rel); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "rel", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceRelIntermediateType(t.rel); err == nil && v != nil {
-		if len(v) == 1 {
-			m["rel"] = v[0]
-		} else {
-			m["rel"] = v
+	if t.present_[0]&(1<<4) != 0 {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if t.typeName != nil {
+			if len(t.typeName) == 1 {
+				m["type"] = t.typeName[0]
+			} else {
+				m["type"] = t.typeName
+			}
 		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalAnyDefinition
-	if t.typeName != nil {
-		if len(t.typeName) == 1 {
-			m["type"] = t.typeName[0]
-		} else {
-			m["type"] = t.typeName
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if t.present_[0]&(1<<5) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.mediaType != nil {
+			if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
+				m["mediaType"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalAnyDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.mediaType != nil {
-		if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
-			m["mediaType"] = v
-		} else {
+	if t.present_[0]&(1<<6) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceNameIntermediateType(t.name); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "name", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceNameIntermediateType(t.name); err == nil && v != nil {
-		if len(v) == 1 {
-			m["name"] = v[0]
-		} else {
-			m["name"] = v
+
+	if t.present_[0]&(1<<7) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.nameMap != nil && len(t.nameMap) >= 0 {
+			m["nameMap"] = t.nameMap
 		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.nameMap != nil && len(t.nameMap) >= 0 {
-		m["nameMap"] = t.nameMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceSummaryIntermediateType(t.summary); err == nil && v != nil {
-		if len(v) == 1 {
-			m["summary"] = v[0]
-		} else {
-			m["summary"] = v
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<8) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceSummaryIntermediateType(t.summary); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "summary", v)
 		}
-	} else if err != nil {
-		return m, err
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
 
-	// Begin generation by generateNaturalLanguageMap
-	if t.summaryMap != nil && len(t.summaryMap) >= 0 {
-		m["summaryMap"] = t.summaryMap
+	if t.present_[0]&(1<<9) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.summaryMap != nil && len(t.summaryMap) >= 0 {
+			m["summaryMap"] = t.summaryMap
+		}
+		// End generation by generateNaturalLanguageMap
 	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.hreflang != nil {
-		if v, err := serializeHreflangIntermediateType(t.hreflang); err == nil {
-			m["hreflang"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<10) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.hreflang != nil {
+			if v, err := serializeHreflangIntermediateType(t.hreflang); err == nil {
+				m["hreflang"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.height != nil {
-		if v, err := serializeHeightIntermediateType(t.height); err == nil {
-			m["height"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<11) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.height != nil {
+			if v, err := serializeHeightIntermediateType(t.height); err == nil {
+				m["height"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.width != nil {
-		if v, err := serializeWidthIntermediateType(t.width); err == nil {
-			m["width"] = v
-		} else {
-			return m, err
+	if t.present_[0]&(1<<12) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.width != nil {
+			if v, err := serializeWidthIntermediateType(t.width); err == nil {
+				m["width"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSlicePreviewIntermediateType(t.preview); err == nil && v != nil {
-		if len(v) == 1 {
-			m["preview"] = v[0]
-		} else {
-			m["preview"] = v
+	if t.present_[0]&(1<<13) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSlicePreviewIntermediateType(t.preview); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "preview", v)
 		}
-	} else if err != nil {
-		return m, err
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
 	return
 
 }
 
-// Deserialize populates this object from a map[string]interface{}
+// Deserialize populates this object from a map[string]interface{}, renaming the properties its '@context' aliases with ResolveAliases
 func (t *Mention) Deserialize(m map[string]interface{}) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	m = ResolveAliases(m)
 	for k, v := range m {
-		handled := false
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "attributedTo" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAttributedToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.attributedTo, err = deserializeSliceAttributedToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &attributedToIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				}
-			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+		if err = t.deserializeProperty_(k, v); err != nil {
+			return deserializeErrorAt(k, err)
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "href" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.href = tmp
-					handled = true
-				}
+	}
+	return
+
+}
+
+// deserializeProperty_ populates the property of this object with the key, or its unknown property if it has none with the key
+func (t *Mention) deserializeProperty_(k string, v interface{}) (err error) {
+	handled := false
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attributedTo" {
+			t.attributedTo, err = deserializeValuesAttributedToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by RangeReference.Deserialize for Value
+			handled = true
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "id" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.id = tmp
-					handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "href" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.href = tmp
+				handled = true
 			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "rel" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeRelIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.rel = []*relIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.rel, err = deserializeSliceRelIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &relIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.rel = []*relIntermediateType{tmp}
-					handled = true
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "id" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
+				if err != nil {
+					return err
 				}
+				t.id = tmp
+				handled = true
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalAnyDefinition
-			if k == "type" {
-				if tmpTypeSlice, ok := v.([]interface{}); ok {
-					t.typeName = tmpTypeSlice
-					handled = true
-				} else {
-					t.typeName = []interface{}{v}
-					handled = true
-				}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "rel" {
+			t.rel, err = deserializeValuesRelIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalAnyDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "mediaType" {
-				t.mediaType, err = deserializeMediaTypeIntermediateType(v)
-				if err != nil {
-					return err
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if k == "type" {
+			if tmpTypeSlice, ok := v.([]interface{}); ok {
+				t.typeName = tmpTypeSlice
+				handled = true
+			} else {
+				t.typeName = []interface{}{v}
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "name" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeNameIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.name, err = deserializeSliceNameIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &nameIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "mediaType" {
+			t.mediaType, err = deserializeMediaTypeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "nameMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.nameMap = val
-					handled = true
-				}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "name" {
+			t.name, err = deserializeValuesNameIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNaturalLanguageMap
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "summary" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeSummaryIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.summary, err = deserializeSliceSummaryIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &summaryIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "nameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
 				}
+				t.nameMap = val
+				handled = true
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "summaryMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.summaryMap = val
-					handled = true
-				}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "summary" {
+			t.summary, err = deserializeValuesSummaryIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNaturalLanguageMap
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "hreflang" {
-				t.hreflang, err = deserializeHreflangIntermediateType(v)
-				if err != nil {
-					return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "summaryMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
 				}
+				t.summaryMap = val
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "height" {
-				t.height, err = deserializeHeightIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "hreflang" {
+			t.hreflang, err = deserializeHreflangIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "width" {
-				t.width, err = deserializeWidthIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "height" {
+			t.height, err = deserializeHeightIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "preview" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializePreviewIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.preview, err = deserializeSlicePreviewIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &previewIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "width" {
+			t.width, err = deserializeWidthIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled && k != "@context" {
-			if t.unknown_ == nil {
-				t.unknown_ = make(map[string]interface{})
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "preview" {
+			t.preview, err = deserializeValuesPreviewIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			t.unknown_[k] = unknownValueDeserialize(v)
+			handled = true
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled && k != "@context" {
+		if t.unknown_ == nil {
+			t.unknown_ = make(map[string]interface{})
+		}
+		t.unknown_[k] = unknownValueDeserialize(v)
+	}
+	return
+
+}
+
+// MarshalJSON implements json.Marshaler by encoding this Mention as JSON, with its properties in the order of the JSON it was unmarshalled from, if any
+func (t *Mention) MarshalJSON() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	if t.order_ != nil {
+		b, err = encodeOrderedJSON(m, t.order_)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	return
+
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding JSON into this Mention, recording the order of its properties for MarshalJSON
+func (t *Mention) UnmarshalJSON(b []byte) (err error) {
+	m := make(map[string]interface{})
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if err = t.Deserialize(m); err != nil {
+		return
 	}
+	t.order_ = scanPropertyOrder(b)
 	return
 
 }
+
+// DecodeJSON populates this Mention from the JSON object read from the decoder, deserializing each property as soon as it is decoded instead of decoding the whole object into a map first, which takes far less memory for large documents. Properties are renamed as Deserialize does, provided the '@context' comes before the properties it aliases. Unlike UnmarshalJSON, it does not record the order of the properties
+func (t *Mention) DecodeJSON(dec *json.Decoder) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	return DecodeJSONProperties(dec, t.deserializeProperty_)
+
+}
+
+// SerializeCBOR encodes this Mention as CBOR, which DeserializeCBOR decodes. The encoding is of the same data as JSON, so values can be exchanged in CBOR internally and in JSON with other servers.
+func (t *Mention) SerializeCBOR() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeCBOR(m)
+
+}
+
+// DeserializeCBOR decodes CBOR, such as that of SerializeCBOR, into this Mention
+func (t *Mention) DeserializeCBOR(b []byte) (err error) {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode %T into Mention", v)
+	}
+	return t.Deserialize(m)
+
+}
+
+// SerializeCanonical encodes this Mention as canonical JSON by RFC 8785, so that equal values always have the same bytes, as signing them or addressing them by their content requires.
+func (t *Mention) SerializeCanonical() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeJCS(m)
+
+}
+
+// Clone returns a deep copy of this Mention, including its unknown properties and the values of all its properties, so that the copy can be modified without affecting the original
+func (t *Mention) Clone() (c *Mention, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	c = &Mention{}
+	err = c.Deserialize(cloneValue(m).(map[string]interface{}))
+	c.order_ = t.order_
+	return
+
+}
+
+// Equals determines whether this Mention and the other one have the same canonical serialized form, such as when the same activity is delivered more than once
+func (t *Mention) Equals(other *Mention) (eq bool) {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return canonicalEquals(t, other)
+
+}
+
+// Hash returns the SHA-256 hash of the canonical serialized form of this Mention, so that equal values have the same hash. It returns the zero hash if this Mention cannot be serialized
+func (t *Mention) Hash() (h [32]byte) {
+	return canonicalHash(t)
+
+}
+
+// Kind returns MentionKind.
+func (t *Mention) Kind() (k TypeKind) {
+	return MentionKind
+
+}
+
+// Validate returns ValidationErrors listing every property required by the specification that this Mention is missing, or nil if it has them all
+func (t *Mention) Validate() (err error) {
+	var errs ValidationErrors
+	if !(t.href != nil || t.unknown_["href"] != nil) {
+		errs = append(errs, &MissingPropertyError{Type: "Mention", Property: "href"})
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// ValidateStrict returns ValidationErrors listing what Validate does, and every unknown property of this Mention, every property with a value of none of the types it takes, and every inlined value that is itself invalid, or nil if there are none. It is meant for rejecting values that are not exactly as specified, such as those posted by clients
+func (t *Mention) ValidateStrict() (err error) {
+	errs, _ := t.Validate().(ValidationErrors)
+	for _, v := range t.attributedTo {
+		errs = v.strict(errs, "Mention", "attributedTo")
+	}
+	for _, v := range t.rel {
+		errs = v.strict(errs, "Mention", "rel")
+	}
+	if t.mediaType != nil {
+		errs = t.mediaType.strict(errs, "Mention", "mediaType")
+	}
+	for _, v := range t.name {
+		errs = v.strict(errs, "Mention", "name")
+	}
+	for _, v := range t.summary {
+		errs = v.strict(errs, "Mention", "summary")
+	}
+	if t.hreflang != nil {
+		errs = t.hreflang.strict(errs, "Mention", "hreflang")
+	}
+	if t.height != nil {
+		errs = t.height.strict(errs, "Mention", "height")
+	}
+	if t.width != nil {
+		errs = t.width.strict(errs, "Mention", "width")
+	}
+	for _, v := range t.preview {
+		errs = v.strict(errs, "Mention", "preview")
+	}
+	keys := make([]string, 0, len(t.unknown_))
+	for k := range t.unknown_ {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch k {
+		case "href", "id":
+			errs = append(errs, &MismatchedPropertyError{Type: "Mention", Property: k})
+		default:
+			errs = append(errs, &UnknownPropertyError{Type: "Mention", Property: k})
+		}
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// DeserializeStrict populates this Mention from a map[string]interface{} like Deserialize, then returns the problems ValidateStrict finds with it, if any
+func (t *Mention) DeserializeStrict(m map[string]interface{}) (err error) {
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	return t.ValidateStrict()
+
+}
+
+// DeserializeLenient populates this Mention from a map[string]interface{} like Deserialize, but parses the xsd:dateTime values of its properties, and of the values it contains, with ParseDateTimeLenient when ParseDateTimeStrict rejects them. It is meant for values from servers emitting malformed dates, and leaves the map unchanged
+func (t *Mention) DeserializeLenient(m map[string]interface{}) (err error) {
+	return t.Deserialize(lenientDateTimes(m).(map[string]interface{}))
+
+}
+
+// TryGetAttributedToObject returns the value GetAttributedToObject returns, or an AccessorError if IsAttributedToObject returns false
+func (t *Mention) TryGetAttributedToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToObject(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetAttributedToObject", Index: index}
+		return
+	}
+	return t.GetAttributedToObject(index), nil
+
+}
+
+// TryGetAttributedToLink returns the value GetAttributedToLink returns, or an AccessorError if IsAttributedToLink returns false
+func (t *Mention) TryGetAttributedToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToLink(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetAttributedToLink", Index: index}
+		return
+	}
+	return t.GetAttributedToLink(index), nil
+
+}
+
+// TryGetAttributedToIRI returns the value GetAttributedToIRI returns, or an AccessorError if IsAttributedToIRI returns false
+func (t *Mention) TryGetAttributedToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToIRI(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetAttributedToIRI", Index: index}
+		return
+	}
+	return t.GetAttributedToIRI(index), nil
+
+}
+
+// TryGetUnknownAttributedTo returns the value GetUnknownAttributedTo returns, or an AccessorError if HasUnknownAttributedTo returns false
+func (t *Mention) TryGetUnknownAttributedTo() (v interface{}, err error) {
+	if !t.HasUnknownAttributedTo() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownAttributedTo", Index: -1}
+		return
+	}
+	return t.GetUnknownAttributedTo(), nil
+
+}
+
+// TryGetHref returns the value GetHref returns, or an AccessorError if HasHref returns false
+func (t *Mention) TryGetHref() (v *url.URL, err error) {
+	if !t.HasHref() {
+		err = &AccessorError{Type: "Mention", Getter: "GetHref", Index: -1}
+		return
+	}
+	return t.GetHref(), nil
+
+}
+
+// TryGetUnknownHref returns the value GetUnknownHref returns, or an AccessorError if HasUnknownHref returns false
+func (t *Mention) TryGetUnknownHref() (v interface{}, err error) {
+	if !t.HasUnknownHref() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownHref", Index: -1}
+		return
+	}
+	return t.GetUnknownHref(), nil
+
+}
+
+// TryGetId returns the value GetId returns, or an AccessorError if HasId returns false
+func (t *Mention) TryGetId() (v *url.URL, err error) {
+	if !t.HasId() {
+		err = &AccessorError{Type: "Mention", Getter: "GetId", Index: -1}
+		return
+	}
+	return t.GetId(), nil
+
+}
+
+// TryGetUnknownId returns the value GetUnknownId returns, or an AccessorError if HasUnknownId returns false
+func (t *Mention) TryGetUnknownId() (v interface{}, err error) {
+	if !t.HasUnknownId() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownId", Index: -1}
+		return
+	}
+	return t.GetUnknownId(), nil
+
+}
+
+// TryGetRel returns the value GetRel returns, or an AccessorError if IsRel returns false
+func (t *Mention) TryGetRel(index int) (v string, err error) {
+	if index < 0 || index >= t.RelLen() || !t.IsRel(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetRel", Index: index}
+		return
+	}
+	return t.GetRel(index), nil
+
+}
+
+// TryGetRelIRI returns the value GetRelIRI returns, or an AccessorError if IsRelIRI returns false
+func (t *Mention) TryGetRelIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.RelLen() || !t.IsRelIRI(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetRelIRI", Index: index}
+		return
+	}
+	return t.GetRelIRI(index), nil
+
+}
+
+// TryGetUnknownRel returns the value GetUnknownRel returns, or an AccessorError if HasUnknownRel returns false
+func (t *Mention) TryGetUnknownRel() (v interface{}, err error) {
+	if !t.HasUnknownRel() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownRel", Index: -1}
+		return
+	}
+	return t.GetUnknownRel(), nil
+
+}
+
+// TryGetType returns the value GetType returns, or an AccessorError if the index is out of range
+func (t *Mention) TryGetType(index int) (v interface{}, err error) {
+	if index < 0 || index >= t.TypeLen() {
+		err = &AccessorError{Type: "Mention", Getter: "GetType", Index: index}
+		return
+	}
+	return t.GetType(index), nil
+
+}
+
+// TryGetMediaType returns the value GetMediaType returns, or an AccessorError if IsMediaType returns false
+func (t *Mention) TryGetMediaType() (v string, err error) {
+	if !t.IsMediaType() {
+		err = &AccessorError{Type: "Mention", Getter: "GetMediaType", Index: -1}
+		return
+	}
+	return t.GetMediaType(), nil
+
+}
+
+// TryGetMediaTypeIRI returns the value GetMediaTypeIRI returns, or an AccessorError if IsMediaTypeIRI returns false
+func (t *Mention) TryGetMediaTypeIRI() (v *url.URL, err error) {
+	if !t.IsMediaTypeIRI() {
+		err = &AccessorError{Type: "Mention", Getter: "GetMediaTypeIRI", Index: -1}
+		return
+	}
+	return t.GetMediaTypeIRI(), nil
+
+}
+
+// TryGetUnknownMediaType returns the value GetUnknownMediaType returns, or an AccessorError if HasUnknownMediaType returns false
+func (t *Mention) TryGetUnknownMediaType() (v interface{}, err error) {
+	if !t.HasUnknownMediaType() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownMediaType", Index: -1}
+		return
+	}
+	return t.GetUnknownMediaType(), nil
+
+}
+
+// TryGetNameString returns the value GetNameString returns, or an AccessorError if IsNameString returns false
+func (t *Mention) TryGetNameString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameString(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetNameString", Index: index}
+		return
+	}
+	return t.GetNameString(index), nil
+
+}
+
+// TryGetNameLangString returns the value GetNameLangString returns, or an AccessorError if IsNameLangString returns false
+func (t *Mention) TryGetNameLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameLangString(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetNameLangString", Index: index}
+		return
+	}
+	return t.GetNameLangString(index), nil
+
+}
+
+// TryGetNameIRI returns the value GetNameIRI returns, or an AccessorError if IsNameIRI returns false
+func (t *Mention) TryGetNameIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameIRI(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetNameIRI", Index: index}
+		return
+	}
+	return t.GetNameIRI(index), nil
+
+}
+
+// TryGetUnknownName returns the value GetUnknownName returns, or an AccessorError if HasUnknownName returns false
+func (t *Mention) TryGetUnknownName() (v interface{}, err error) {
+	if !t.HasUnknownName() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownName", Index: -1}
+		return
+	}
+	return t.GetUnknownName(), nil
+
+}
+
+// TryGetSummaryString returns the value GetSummaryString returns, or an AccessorError if IsSummaryString returns false
+func (t *Mention) TryGetSummaryString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryString(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetSummaryString", Index: index}
+		return
+	}
+	return t.GetSummaryString(index), nil
+
+}
+
+// TryGetSummaryLangString returns the value GetSummaryLangString returns, or an AccessorError if IsSummaryLangString returns false
+func (t *Mention) TryGetSummaryLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryLangString(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetSummaryLangString", Index: index}
+		return
+	}
+	return t.GetSummaryLangString(index), nil
+
+}
+
+// TryGetSummaryIRI returns the value GetSummaryIRI returns, or an AccessorError if IsSummaryIRI returns false
+func (t *Mention) TryGetSummaryIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryIRI(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetSummaryIRI", Index: index}
+		return
+	}
+	return t.GetSummaryIRI(index), nil
+
+}
+
+// TryGetUnknownSummary returns the value GetUnknownSummary returns, or an AccessorError if HasUnknownSummary returns false
+func (t *Mention) TryGetUnknownSummary() (v interface{}, err error) {
+	if !t.HasUnknownSummary() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownSummary", Index: -1}
+		return
+	}
+	return t.GetUnknownSummary(), nil
+
+}
+
+// TryGetHreflang returns the value GetHreflang returns, or an AccessorError if IsHreflang returns false
+func (t *Mention) TryGetHreflang() (v string, err error) {
+	if !t.IsHreflang() {
+		err = &AccessorError{Type: "Mention", Getter: "GetHreflang", Index: -1}
+		return
+	}
+	return t.GetHreflang(), nil
+
+}
+
+// TryGetHreflangIRI returns the value GetHreflangIRI returns, or an AccessorError if IsHreflangIRI returns false
+func (t *Mention) TryGetHreflangIRI() (v *url.URL, err error) {
+	if !t.IsHreflangIRI() {
+		err = &AccessorError{Type: "Mention", Getter: "GetHreflangIRI", Index: -1}
+		return
+	}
+	return t.GetHreflangIRI(), nil
+
+}
+
+// TryGetUnknownHreflang returns the value GetUnknownHreflang returns, or an AccessorError if HasUnknownHreflang returns false
+func (t *Mention) TryGetUnknownHreflang() (v interface{}, err error) {
+	if !t.HasUnknownHreflang() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownHreflang", Index: -1}
+		return
+	}
+	return t.GetUnknownHreflang(), nil
+
+}
+
+// TryGetHeight returns the value GetHeight returns, or an AccessorError if IsHeight returns false
+func (t *Mention) TryGetHeight() (v int64, err error) {
+	if !t.IsHeight() {
+		err = &AccessorError{Type: "Mention", Getter: "GetHeight", Index: -1}
+		return
+	}
+	return t.GetHeight(), nil
+
+}
+
+// TryGetHeightIRI returns the value GetHeightIRI returns, or an AccessorError if IsHeightIRI returns false
+func (t *Mention) TryGetHeightIRI() (v *url.URL, err error) {
+	if !t.IsHeightIRI() {
+		err = &AccessorError{Type: "Mention", Getter: "GetHeightIRI", Index: -1}
+		return
+	}
+	return t.GetHeightIRI(), nil
+
+}
+
+// TryGetUnknownHeight returns the value GetUnknownHeight returns, or an AccessorError if HasUnknownHeight returns false
+func (t *Mention) TryGetUnknownHeight() (v interface{}, err error) {
+	if !t.HasUnknownHeight() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownHeight", Index: -1}
+		return
+	}
+	return t.GetUnknownHeight(), nil
+
+}
+
+// TryGetWidth returns the value GetWidth returns, or an AccessorError if IsWidth returns false
+func (t *Mention) TryGetWidth() (v int64, err error) {
+	if !t.IsWidth() {
+		err = &AccessorError{Type: "Mention", Getter: "GetWidth", Index: -1}
+		return
+	}
+	return t.GetWidth(), nil
+
+}
+
+// TryGetWidthIRI returns the value GetWidthIRI returns, or an AccessorError if IsWidthIRI returns false
+func (t *Mention) TryGetWidthIRI() (v *url.URL, err error) {
+	if !t.IsWidthIRI() {
+		err = &AccessorError{Type: "Mention", Getter: "GetWidthIRI", Index: -1}
+		return
+	}
+	return t.GetWidthIRI(), nil
+
+}
+
+// TryGetUnknownWidth returns the value GetUnknownWidth returns, or an AccessorError if HasUnknownWidth returns false
+func (t *Mention) TryGetUnknownWidth() (v interface{}, err error) {
+	if !t.HasUnknownWidth() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownWidth", Index: -1}
+		return
+	}
+	return t.GetUnknownWidth(), nil
+
+}
+
+// TryGetPreviewObject returns the value GetPreviewObject returns, or an AccessorError if IsPreviewObject returns false
+func (t *Mention) TryGetPreviewObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewObject(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetPreviewObject", Index: index}
+		return
+	}
+	return t.GetPreviewObject(index), nil
+
+}
+
+// TryGetPreviewLink returns the value GetPreviewLink returns, or an AccessorError if IsPreviewLink returns false
+func (t *Mention) TryGetPreviewLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewLink(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetPreviewLink", Index: index}
+		return
+	}
+	return t.GetPreviewLink(index), nil
+
+}
+
+// TryGetPreviewIRI returns the value GetPreviewIRI returns, or an AccessorError if IsPreviewIRI returns false
+func (t *Mention) TryGetPreviewIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewIRI(index) {
+		err = &AccessorError{Type: "Mention", Getter: "GetPreviewIRI", Index: index}
+		return
+	}
+	return t.GetPreviewIRI(index), nil
+
+}
+
+// TryGetUnknownPreview returns the value GetUnknownPreview returns, or an AccessorError if HasUnknownPreview returns false
+func (t *Mention) TryGetUnknownPreview() (v interface{}, err error) {
+	if !t.HasUnknownPreview() {
+		err = &AccessorError{Type: "Mention", Getter: "GetUnknownPreview", Index: -1}
+		return
+	}
+	return t.GetUnknownPreview(), nil
+
+}
+
+// WithAttributedToObject calls AppendAttributedToObject and returns this Mention, so that calls can be chained
+func (t *Mention) WithAttributedToObject(v ObjectType) *Mention {
+	t.AppendAttributedToObject(v)
+	return t
+
+}
+
+// WithAttributedToLink calls AppendAttributedToLink and returns this Mention, so that calls can be chained
+func (t *Mention) WithAttributedToLink(v LinkType) *Mention {
+	t.AppendAttributedToLink(v)
+	return t
+
+}
+
+// WithAttributedToIRI calls AppendAttributedToIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithAttributedToIRI(v *url.URL) *Mention {
+	t.AppendAttributedToIRI(v)
+	return t
+
+}
+
+// WithUnknownAttributedTo calls SetUnknownAttributedTo and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownAttributedTo(i interface{}) *Mention {
+	t.SetUnknownAttributedTo(i)
+	return t
+
+}
+
+// WithHref calls SetHref and returns this Mention, so that calls can be chained
+func (t *Mention) WithHref(v *url.URL) *Mention {
+	t.SetHref(v)
+	return t
+
+}
+
+// WithUnknownHref calls SetUnknownHref and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownHref(i interface{}) *Mention {
+	t.SetUnknownHref(i)
+	return t
+
+}
+
+// WithId calls SetId and returns this Mention, so that calls can be chained
+func (t *Mention) WithId(v *url.URL) *Mention {
+	t.SetId(v)
+	return t
+
+}
+
+// WithUnknownId calls SetUnknownId and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownId(i interface{}) *Mention {
+	t.SetUnknownId(i)
+	return t
+
+}
+
+// WithRel calls AppendRel and returns this Mention, so that calls can be chained
+func (t *Mention) WithRel(v string) *Mention {
+	t.AppendRel(v)
+	return t
+
+}
+
+// WithRelIRI calls AppendRelIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithRelIRI(v *url.URL) *Mention {
+	t.AppendRelIRI(v)
+	return t
+
+}
+
+// WithUnknownRel calls SetUnknownRel and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownRel(i interface{}) *Mention {
+	t.SetUnknownRel(i)
+	return t
+
+}
+
+// WithType calls AppendType and returns this Mention, so that calls can be chained
+func (t *Mention) WithType(v interface{}) *Mention {
+	t.AppendType(v)
+	return t
+
+}
+
+// WithMediaType calls SetMediaType and returns this Mention, so that calls can be chained
+func (t *Mention) WithMediaType(v string) *Mention {
+	t.SetMediaType(v)
+	return t
+
+}
+
+// WithMediaTypeIRI calls SetMediaTypeIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithMediaTypeIRI(v *url.URL) *Mention {
+	t.SetMediaTypeIRI(v)
+	return t
+
+}
+
+// WithUnknownMediaType calls SetUnknownMediaType and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownMediaType(i interface{}) *Mention {
+	t.SetUnknownMediaType(i)
+	return t
+
+}
+
+// WithNameString calls AppendNameString and returns this Mention, so that calls can be chained
+func (t *Mention) WithNameString(v string) *Mention {
+	t.AppendNameString(v)
+	return t
+
+}
+
+// WithNameLangString calls AppendNameLangString and returns this Mention, so that calls can be chained
+func (t *Mention) WithNameLangString(v string) *Mention {
+	t.AppendNameLangString(v)
+	return t
+
+}
+
+// WithNameIRI calls AppendNameIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithNameIRI(v *url.URL) *Mention {
+	t.AppendNameIRI(v)
+	return t
+
+}
+
+// WithUnknownName calls SetUnknownName and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownName(i interface{}) *Mention {
+	t.SetUnknownName(i)
+	return t
+
+}
+
+// WithNameMap calls SetNameMap and returns this Mention, so that calls can be chained
+func (t *Mention) WithNameMap(l string, v string) *Mention {
+	t.SetNameMap(l, v)
+	return t
+
+}
+
+// WithNameLanguage calls SetNameLanguage and returns this Mention, so that calls can be chained
+func (t *Mention) WithNameLanguage(tag string, v string) *Mention {
+	t.SetNameLanguage(tag, v)
+	return t
+
+}
+
+// WithSummaryString calls AppendSummaryString and returns this Mention, so that calls can be chained
+func (t *Mention) WithSummaryString(v string) *Mention {
+	t.AppendSummaryString(v)
+	return t
+
+}
+
+// WithSummaryLangString calls AppendSummaryLangString and returns this Mention, so that calls can be chained
+func (t *Mention) WithSummaryLangString(v string) *Mention {
+	t.AppendSummaryLangString(v)
+	return t
+
+}
+
+// WithSummaryIRI calls AppendSummaryIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithSummaryIRI(v *url.URL) *Mention {
+	t.AppendSummaryIRI(v)
+	return t
+
+}
+
+// WithUnknownSummary calls SetUnknownSummary and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownSummary(i interface{}) *Mention {
+	t.SetUnknownSummary(i)
+	return t
+
+}
+
+// WithSummaryMap calls SetSummaryMap and returns this Mention, so that calls can be chained
+func (t *Mention) WithSummaryMap(l string, v string) *Mention {
+	t.SetSummaryMap(l, v)
+	return t
+
+}
+
+// WithSummaryLanguage calls SetSummaryLanguage and returns this Mention, so that calls can be chained
+func (t *Mention) WithSummaryLanguage(tag string, v string) *Mention {
+	t.SetSummaryLanguage(tag, v)
+	return t
+
+}
+
+// WithHreflang calls SetHreflang and returns this Mention, so that calls can be chained
+func (t *Mention) WithHreflang(v string) *Mention {
+	t.SetHreflang(v)
+	return t
+
+}
+
+// WithHreflangIRI calls SetHreflangIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithHreflangIRI(v *url.URL) *Mention {
+	t.SetHreflangIRI(v)
+	return t
+
+}
+
+// WithUnknownHreflang calls SetUnknownHreflang and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownHreflang(i interface{}) *Mention {
+	t.SetUnknownHreflang(i)
+	return t
+
+}
+
+// WithHeight calls SetHeight and returns this Mention, so that calls can be chained
+func (t *Mention) WithHeight(v int64) *Mention {
+	t.SetHeight(v)
+	return t
+
+}
+
+// WithHeightIRI calls SetHeightIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithHeightIRI(v *url.URL) *Mention {
+	t.SetHeightIRI(v)
+	return t
+
+}
+
+// WithUnknownHeight calls SetUnknownHeight and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownHeight(i interface{}) *Mention {
+	t.SetUnknownHeight(i)
+	return t
+
+}
+
+// WithWidth calls SetWidth and returns this Mention, so that calls can be chained
+func (t *Mention) WithWidth(v int64) *Mention {
+	t.SetWidth(v)
+	return t
+
+}
+
+// WithWidthIRI calls SetWidthIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithWidthIRI(v *url.URL) *Mention {
+	t.SetWidthIRI(v)
+	return t
+
+}
+
+// WithUnknownWidth calls SetUnknownWidth and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownWidth(i interface{}) *Mention {
+	t.SetUnknownWidth(i)
+	return t
+
+}
+
+// WithPreviewObject calls AppendPreviewObject and returns this Mention, so that calls can be chained
+func (t *Mention) WithPreviewObject(v ObjectType) *Mention {
+	t.AppendPreviewObject(v)
+	return t
+
+}
+
+// WithPreviewLink calls AppendPreviewLink and returns this Mention, so that calls can be chained
+func (t *Mention) WithPreviewLink(v LinkType) *Mention {
+	t.AppendPreviewLink(v)
+	return t
+
+}
+
+// WithPreviewIRI calls AppendPreviewIRI and returns this Mention, so that calls can be chained
+func (t *Mention) WithPreviewIRI(v *url.URL) *Mention {
+	t.AppendPreviewIRI(v)
+	return t
+
+}
+
+// WithUnknownPreview calls SetUnknownPreview and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownPreview(i interface{}) *Mention {
+	t.SetUnknownPreview(i)
+	return t
+
+}
+
+// WithUnknownProperty calls SetUnknownProperty and returns this Mention, so that calls can be chained
+func (t *Mention) WithUnknownProperty(k string, i interface{}) *Mention {
+	t.SetUnknownProperty(k, i)
+	return t
+
+}
+
+// markPresent_ sets or clears the bit of a member in the presence bitset.
+func (t *Mention) markPresent_(i uint, set bool) {
+	if set {
+		t.present_[i/64] |= 1 << (i % 64)
+	} else {
+		t.present_[i/64] &^= 1 << (i % 64)
+	}
+
+}
+
+// markAllPresent_ sets the bits of every member in the presence bitset that is set, and clears the others.
+func (t *Mention) markAllPresent_() {
+	t.markPresent_(0, t.attributedTo != nil)
+	t.markPresent_(1, t.href != nil)
+	t.markPresent_(2, t.id != nil)
+	t.markPresent_(3, t.rel != nil)
+	t.markPresent_(4, t.typeName != nil)
+	t.markPresent_(5, t.mediaType != nil)
+	t.markPresent_(6, t.name != nil)
+	t.markPresent_(7, t.nameMap != nil)
+	t.markPresent_(8, t.summary != nil)
+	t.markPresent_(9, t.summaryMap != nil)
+	t.markPresent_(10, t.hreflang != nil)
+	t.markPresent_(11, t.height != nil)
+	t.markPresent_(12, t.width != nil)
+	t.markPresent_(13, t.preview != nil)
+
+}
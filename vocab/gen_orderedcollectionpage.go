@@ -2,7 +2,10 @@
 package vocab
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"sort"
 	"time"
 )
 
@@ -49,16 +52,19 @@ type OrderedCollectionPageType interface {
 	AppendOrderedItemsObject(v ObjectType)
 	PrependOrderedItemsObject(v ObjectType)
 	RemoveOrderedItemsObject(index int)
+	OrderedItemsObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsOrderedItemsLink(index int) (ok bool)
 	GetOrderedItemsLink(index int) (v LinkType)
 	AppendOrderedItemsLink(v LinkType)
 	PrependOrderedItemsLink(v LinkType)
 	RemoveOrderedItemsLink(index int)
+	OrderedItemsLinkValues() (seq func(yield func(v LinkType) bool))
 	IsOrderedItemsIRI(index int) (ok bool)
 	GetOrderedItemsIRI(index int) (v *url.URL)
 	AppendOrderedItemsIRI(v *url.URL)
 	PrependOrderedItemsIRI(v *url.URL)
 	RemoveOrderedItemsIRI(index int)
+	OrderedItemsIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownOrderedItems() (ok bool)
 	GetUnknownOrderedItems() (v interface{})
 	SetUnknownOrderedItems(i interface{})
@@ -122,16 +128,19 @@ type OrderedCollectionPageType interface {
 	AppendAttachmentObject(v ObjectType)
 	PrependAttachmentObject(v ObjectType)
 	RemoveAttachmentObject(index int)
+	AttachmentObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAttachmentLink(index int) (ok bool)
 	GetAttachmentLink(index int) (v LinkType)
 	AppendAttachmentLink(v LinkType)
 	PrependAttachmentLink(v LinkType)
 	RemoveAttachmentLink(index int)
+	AttachmentLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAttachmentIRI(index int) (ok bool)
 	GetAttachmentIRI(index int) (v *url.URL)
 	AppendAttachmentIRI(v *url.URL)
 	PrependAttachmentIRI(v *url.URL)
 	RemoveAttachmentIRI(index int)
+	AttachmentIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAttachment() (ok bool)
 	GetUnknownAttachment() (v interface{})
 	SetUnknownAttachment(i interface{})
@@ -141,16 +150,19 @@ type OrderedCollectionPageType interface {
 	AppendAttributedToObject(v ObjectType)
 	PrependAttributedToObject(v ObjectType)
 	RemoveAttributedToObject(index int)
+	AttributedToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAttributedToLink(index int) (ok bool)
 	GetAttributedToLink(index int) (v LinkType)
 	AppendAttributedToLink(v LinkType)
 	PrependAttributedToLink(v LinkType)
 	RemoveAttributedToLink(index int)
+	AttributedToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAttributedToIRI(index int) (ok bool)
 	GetAttributedToIRI(index int) (v *url.URL)
 	AppendAttributedToIRI(v *url.URL)
 	PrependAttributedToIRI(v *url.URL)
 	RemoveAttributedToIRI(index int)
+	AttributedToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAttributedTo() (ok bool)
 	GetUnknownAttributedTo() (v interface{})
 	SetUnknownAttributedTo(i interface{})
@@ -160,16 +172,19 @@ type OrderedCollectionPageType interface {
 	AppendAudienceObject(v ObjectType)
 	PrependAudienceObject(v ObjectType)
 	RemoveAudienceObject(index int)
+	AudienceObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsAudienceLink(index int) (ok bool)
 	GetAudienceLink(index int) (v LinkType)
 	AppendAudienceLink(v LinkType)
 	PrependAudienceLink(v LinkType)
 	RemoveAudienceLink(index int)
+	AudienceLinkValues() (seq func(yield func(v LinkType) bool))
 	IsAudienceIRI(index int) (ok bool)
 	GetAudienceIRI(index int) (v *url.URL)
 	AppendAudienceIRI(v *url.URL)
 	PrependAudienceIRI(v *url.URL)
 	RemoveAudienceIRI(index int)
+	AudienceIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownAudience() (ok bool)
 	GetUnknownAudience() (v interface{})
 	SetUnknownAudience(i interface{})
@@ -179,38 +194,47 @@ type OrderedCollectionPageType interface {
 	AppendContentString(v string)
 	PrependContentString(v string)
 	RemoveContentString(index int)
+	ContentStringValues() (seq func(yield func(v string) bool))
 	IsContentLangString(index int) (ok bool)
 	GetContentLangString(index int) (v string)
 	AppendContentLangString(v string)
 	PrependContentLangString(v string)
 	RemoveContentLangString(index int)
+	ContentLangStringValues() (seq func(yield func(v string) bool))
 	IsContentIRI(index int) (ok bool)
 	GetContentIRI(index int) (v *url.URL)
 	AppendContentIRI(v *url.URL)
 	PrependContentIRI(v *url.URL)
 	RemoveContentIRI(index int)
+	ContentIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownContent() (ok bool)
 	GetUnknownContent() (v interface{})
 	SetUnknownContent(i interface{})
 	ContentMapLanguages() (l []string)
 	GetContentMap(l string) (v string)
 	SetContentMap(l string, v string)
+	GetContentLanguage(tag string) (v string, ok bool)
+	SetContentLanguage(tag string, v string)
+	PreferredContentLanguage(tags ...string) (v string, tag string)
 	ContextLen() (l int)
 	IsContextObject(index int) (ok bool)
 	GetContextObject(index int) (v ObjectType)
 	AppendContextObject(v ObjectType)
 	PrependContextObject(v ObjectType)
 	RemoveContextObject(index int)
+	ContextObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsContextLink(index int) (ok bool)
 	GetContextLink(index int) (v LinkType)
 	AppendContextLink(v LinkType)
 	PrependContextLink(v LinkType)
 	RemoveContextLink(index int)
+	ContextLinkValues() (seq func(yield func(v LinkType) bool))
 	IsContextIRI(index int) (ok bool)
 	GetContextIRI(index int) (v *url.URL)
 	AppendContextIRI(v *url.URL)
 	PrependContextIRI(v *url.URL)
 	RemoveContextIRI(index int)
+	ContextIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownContext() (ok bool)
 	GetUnknownContext() (v interface{})
 	SetUnknownContext(i interface{})
@@ -220,22 +244,28 @@ type OrderedCollectionPageType interface {
 	AppendNameString(v string)
 	PrependNameString(v string)
 	RemoveNameString(index int)
+	NameStringValues() (seq func(yield func(v string) bool))
 	IsNameLangString(index int) (ok bool)
 	GetNameLangString(index int) (v string)
 	AppendNameLangString(v string)
 	PrependNameLangString(v string)
 	RemoveNameLangString(index int)
+	NameLangStringValues() (seq func(yield func(v string) bool))
 	IsNameIRI(index int) (ok bool)
 	GetNameIRI(index int) (v *url.URL)
 	AppendNameIRI(v *url.URL)
 	PrependNameIRI(v *url.URL)
 	RemoveNameIRI(index int)
+	NameIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownName() (ok bool)
 	GetUnknownName() (v interface{})
 	SetUnknownName(i interface{})
 	NameMapLanguages() (l []string)
 	GetNameMap(l string) (v string)
 	SetNameMap(l string, v string)
+	GetNameLanguage(tag string) (v string, ok bool)
+	SetNameLanguage(tag string, v string)
+	PreferredNameLanguage(tags ...string) (v string, tag string)
 	IsEndTime() (ok bool)
 	GetEndTime() (v time.Time)
 	SetEndTime(v time.Time)
@@ -251,16 +281,19 @@ type OrderedCollectionPageType interface {
 	AppendGeneratorObject(v ObjectType)
 	PrependGeneratorObject(v ObjectType)
 	RemoveGeneratorObject(index int)
+	GeneratorObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsGeneratorLink(index int) (ok bool)
 	GetGeneratorLink(index int) (v LinkType)
 	AppendGeneratorLink(v LinkType)
 	PrependGeneratorLink(v LinkType)
 	RemoveGeneratorLink(index int)
+	GeneratorLinkValues() (seq func(yield func(v LinkType) bool))
 	IsGeneratorIRI(index int) (ok bool)
 	GetGeneratorIRI(index int) (v *url.URL)
 	AppendGeneratorIRI(v *url.URL)
 	PrependGeneratorIRI(v *url.URL)
 	RemoveGeneratorIRI(index int)
+	GeneratorIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownGenerator() (ok bool)
 	GetUnknownGenerator() (v interface{})
 	SetUnknownGenerator(i interface{})
@@ -270,16 +303,19 @@ type OrderedCollectionPageType interface {
 	AppendIconImage(v ImageType)
 	PrependIconImage(v ImageType)
 	RemoveIconImage(index int)
+	IconImageValues() (seq func(yield func(v ImageType) bool))
 	IsIconLink(index int) (ok bool)
 	GetIconLink(index int) (v LinkType)
 	AppendIconLink(v LinkType)
 	PrependIconLink(v LinkType)
 	RemoveIconLink(index int)
+	IconLinkValues() (seq func(yield func(v LinkType) bool))
 	IsIconIRI(index int) (ok bool)
 	GetIconIRI(index int) (v *url.URL)
 	AppendIconIRI(v *url.URL)
 	PrependIconIRI(v *url.URL)
 	RemoveIconIRI(index int)
+	IconIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownIcon() (ok bool)
 	GetUnknownIcon() (v interface{})
 	SetUnknownIcon(i interface{})
@@ -295,16 +331,19 @@ type OrderedCollectionPageType interface {
 	AppendImageImage(v ImageType)
 	PrependImageImage(v ImageType)
 	RemoveImageImage(index int)
+	ImageImageValues() (seq func(yield func(v ImageType) bool))
 	IsImageLink(index int) (ok bool)
 	GetImageLink(index int) (v LinkType)
 	AppendImageLink(v LinkType)
 	PrependImageLink(v LinkType)
 	RemoveImageLink(index int)
+	ImageLinkValues() (seq func(yield func(v LinkType) bool))
 	IsImageIRI(index int) (ok bool)
 	GetImageIRI(index int) (v *url.URL)
 	AppendImageIRI(v *url.URL)
 	PrependImageIRI(v *url.URL)
 	RemoveImageIRI(index int)
+	ImageIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownImage() (ok bool)
 	GetUnknownImage() (v interface{})
 	SetUnknownImage(i interface{})
@@ -314,16 +353,19 @@ type OrderedCollectionPageType interface {
 	AppendInReplyToObject(v ObjectType)
 	PrependInReplyToObject(v ObjectType)
 	RemoveInReplyToObject(index int)
+	InReplyToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsInReplyToLink(index int) (ok bool)
 	GetInReplyToLink(index int) (v LinkType)
 	AppendInReplyToLink(v LinkType)
 	PrependInReplyToLink(v LinkType)
 	RemoveInReplyToLink(index int)
+	InReplyToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsInReplyToIRI(index int) (ok bool)
 	GetInReplyToIRI(index int) (v *url.URL)
 	AppendInReplyToIRI(v *url.URL)
 	PrependInReplyToIRI(v *url.URL)
 	RemoveInReplyToIRI(index int)
+	InReplyToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownInReplyTo() (ok bool)
 	GetUnknownInReplyTo() (v interface{})
 	SetUnknownInReplyTo(i interface{})
@@ -333,16 +375,19 @@ type OrderedCollectionPageType interface {
 	AppendLocationObject(v ObjectType)
 	PrependLocationObject(v ObjectType)
 	RemoveLocationObject(index int)
+	LocationObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsLocationLink(index int) (ok bool)
 	GetLocationLink(index int) (v LinkType)
 	AppendLocationLink(v LinkType)
 	PrependLocationLink(v LinkType)
 	RemoveLocationLink(index int)
+	LocationLinkValues() (seq func(yield func(v LinkType) bool))
 	IsLocationIRI(index int) (ok bool)
 	GetLocationIRI(index int) (v *url.URL)
 	AppendLocationIRI(v *url.URL)
 	PrependLocationIRI(v *url.URL)
 	RemoveLocationIRI(index int)
+	LocationIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownLocation() (ok bool)
 	GetUnknownLocation() (v interface{})
 	SetUnknownLocation(i interface{})
@@ -352,16 +397,19 @@ type OrderedCollectionPageType interface {
 	AppendPreviewObject(v ObjectType)
 	PrependPreviewObject(v ObjectType)
 	RemovePreviewObject(index int)
+	PreviewObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsPreviewLink(index int) (ok bool)
 	GetPreviewLink(index int) (v LinkType)
 	AppendPreviewLink(v LinkType)
 	PrependPreviewLink(v LinkType)
 	RemovePreviewLink(index int)
+	PreviewLinkValues() (seq func(yield func(v LinkType) bool))
 	IsPreviewIRI(index int) (ok bool)
 	GetPreviewIRI(index int) (v *url.URL)
 	AppendPreviewIRI(v *url.URL)
 	PrependPreviewIRI(v *url.URL)
 	RemovePreviewIRI(index int)
+	PreviewIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownPreview() (ok bool)
 	GetUnknownPreview() (v interface{})
 	SetUnknownPreview(i interface{})
@@ -398,38 +446,47 @@ type OrderedCollectionPageType interface {
 	AppendSummaryString(v string)
 	PrependSummaryString(v string)
 	RemoveSummaryString(index int)
+	SummaryStringValues() (seq func(yield func(v string) bool))
 	IsSummaryLangString(index int) (ok bool)
 	GetSummaryLangString(index int) (v string)
 	AppendSummaryLangString(v string)
 	PrependSummaryLangString(v string)
 	RemoveSummaryLangString(index int)
+	SummaryLangStringValues() (seq func(yield func(v string) bool))
 	IsSummaryIRI(index int) (ok bool)
 	GetSummaryIRI(index int) (v *url.URL)
 	AppendSummaryIRI(v *url.URL)
 	PrependSummaryIRI(v *url.URL)
 	RemoveSummaryIRI(index int)
+	SummaryIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownSummary() (ok bool)
 	GetUnknownSummary() (v interface{})
 	SetUnknownSummary(i interface{})
 	SummaryMapLanguages() (l []string)
 	GetSummaryMap(l string) (v string)
 	SetSummaryMap(l string, v string)
+	GetSummaryLanguage(tag string) (v string, ok bool)
+	SetSummaryLanguage(tag string, v string)
+	PreferredSummaryLanguage(tags ...string) (v string, tag string)
 	TagLen() (l int)
 	IsTagObject(index int) (ok bool)
 	GetTagObject(index int) (v ObjectType)
 	AppendTagObject(v ObjectType)
 	PrependTagObject(v ObjectType)
 	RemoveTagObject(index int)
+	TagObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsTagLink(index int) (ok bool)
 	GetTagLink(index int) (v LinkType)
 	AppendTagLink(v LinkType)
 	PrependTagLink(v LinkType)
 	RemoveTagLink(index int)
+	TagLinkValues() (seq func(yield func(v LinkType) bool))
 	IsTagIRI(index int) (ok bool)
 	GetTagIRI(index int) (v *url.URL)
 	AppendTagIRI(v *url.URL)
 	PrependTagIRI(v *url.URL)
 	RemoveTagIRI(index int)
+	TagIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownTag() (ok bool)
 	GetUnknownTag() (v interface{})
 	SetUnknownTag(i interface{})
@@ -453,11 +510,13 @@ type OrderedCollectionPageType interface {
 	AppendUrlAnyURI(v *url.URL)
 	PrependUrlAnyURI(v *url.URL)
 	RemoveUrlAnyURI(index int)
+	UrlAnyURIValues() (seq func(yield func(v *url.URL) bool))
 	IsUrlLink(index int) (ok bool)
 	GetUrlLink(index int) (v LinkType)
 	AppendUrlLink(v LinkType)
 	PrependUrlLink(v LinkType)
 	RemoveUrlLink(index int)
+	UrlLinkValues() (seq func(yield func(v LinkType) bool))
 	HasUnknownUrl() (ok bool)
 	GetUnknownUrl() (v interface{})
 	SetUnknownUrl(i interface{})
@@ -467,16 +526,19 @@ type OrderedCollectionPageType interface {
 	AppendToObject(v ObjectType)
 	PrependToObject(v ObjectType)
 	RemoveToObject(index int)
+	ToObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsToLink(index int) (ok bool)
 	GetToLink(index int) (v LinkType)
 	AppendToLink(v LinkType)
 	PrependToLink(v LinkType)
 	RemoveToLink(index int)
+	ToLinkValues() (seq func(yield func(v LinkType) bool))
 	IsToIRI(index int) (ok bool)
 	GetToIRI(index int) (v *url.URL)
 	AppendToIRI(v *url.URL)
 	PrependToIRI(v *url.URL)
 	RemoveToIRI(index int)
+	ToIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownTo() (ok bool)
 	GetUnknownTo() (v interface{})
 	SetUnknownTo(i interface{})
@@ -486,16 +548,19 @@ type OrderedCollectionPageType interface {
 	AppendBtoObject(v ObjectType)
 	PrependBtoObject(v ObjectType)
 	RemoveBtoObject(index int)
+	BtoObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsBtoLink(index int) (ok bool)
 	GetBtoLink(index int) (v LinkType)
 	AppendBtoLink(v LinkType)
 	PrependBtoLink(v LinkType)
 	RemoveBtoLink(index int)
+	BtoLinkValues() (seq func(yield func(v LinkType) bool))
 	IsBtoIRI(index int) (ok bool)
 	GetBtoIRI(index int) (v *url.URL)
 	AppendBtoIRI(v *url.URL)
 	PrependBtoIRI(v *url.URL)
 	RemoveBtoIRI(index int)
+	BtoIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownBto() (ok bool)
 	GetUnknownBto() (v interface{})
 	SetUnknownBto(i interface{})
@@ -505,16 +570,19 @@ type OrderedCollectionPageType interface {
 	AppendCcObject(v ObjectType)
 	PrependCcObject(v ObjectType)
 	RemoveCcObject(index int)
+	CcObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsCcLink(index int) (ok bool)
 	GetCcLink(index int) (v LinkType)
 	AppendCcLink(v LinkType)
 	PrependCcLink(v LinkType)
 	RemoveCcLink(index int)
+	CcLinkValues() (seq func(yield func(v LinkType) bool))
 	IsCcIRI(index int) (ok bool)
 	GetCcIRI(index int) (v *url.URL)
 	AppendCcIRI(v *url.URL)
 	PrependCcIRI(v *url.URL)
 	RemoveCcIRI(index int)
+	CcIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownCc() (ok bool)
 	GetUnknownCc() (v interface{})
 	SetUnknownCc(i interface{})
@@ -524,16 +592,19 @@ type OrderedCollectionPageType interface {
 	AppendBccObject(v ObjectType)
 	PrependBccObject(v ObjectType)
 	RemoveBccObject(index int)
+	BccObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsBccLink(index int) (ok bool)
 	GetBccLink(index int) (v LinkType)
 	AppendBccLink(v LinkType)
 	PrependBccLink(v LinkType)
 	RemoveBccLink(index int)
+	BccLinkValues() (seq func(yield func(v LinkType) bool))
 	IsBccIRI(index int) (ok bool)
 	GetBccIRI(index int) (v *url.URL)
 	AppendBccIRI(v *url.URL)
 	PrependBccIRI(v *url.URL)
 	RemoveBccIRI(index int)
+	BccIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownBcc() (ok bool)
 	GetUnknownBcc() (v interface{})
 	SetUnknownBcc(i interface{})
@@ -638,6 +709,7 @@ type OrderedCollectionPageType interface {
 	HasUnknownStreams() (ok bool)
 	GetUnknownStreams() (v interface{})
 	SetUnknownStreams(i interface{})
+	StreamsValues() (seq func(yield func(v *url.URL) bool))
 	IsPreferredUsername() (ok bool)
 	GetPreferredUsername() (v string)
 	SetPreferredUsername(v string)
@@ -650,6 +722,9 @@ type OrderedCollectionPageType interface {
 	PreferredUsernameMapLanguages() (l []string)
 	GetPreferredUsernameMap(l string) (v string)
 	SetPreferredUsernameMap(l string, v string)
+	GetPreferredUsernameLanguage(tag string) (v string, ok bool)
+	SetPreferredUsernameLanguage(tag string, v string)
+	PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string)
 	IsEndpoints() (ok bool)
 	GetEndpoints() (v ObjectType)
 	SetEndpoints(v ObjectType)
@@ -725,26 +800,224 @@ type OrderedCollectionPageType interface {
 	AppendItemsObject(v ObjectType)
 	PrependItemsObject(v ObjectType)
 	RemoveItemsObject(index int)
+	ItemsObjectValues() (seq func(yield func(v ObjectType) bool))
 	IsItemsLink(index int) (ok bool)
 	GetItemsLink(index int) (v LinkType)
 	AppendItemsLink(v LinkType)
 	PrependItemsLink(v LinkType)
 	RemoveItemsLink(index int)
+	ItemsLinkValues() (seq func(yield func(v LinkType) bool))
 	IsItemsIRI(index int) (ok bool)
 	GetItemsIRI(index int) (v *url.URL)
 	AppendItemsIRI(v *url.URL)
 	PrependItemsIRI(v *url.URL)
 	RemoveItemsIRI(index int)
+	ItemsIRIValues() (seq func(yield func(v *url.URL) bool))
 	HasUnknownItems() (ok bool)
 	GetUnknownItems() (v interface{})
 	SetUnknownItems(i interface{})
 	IsPublic() (b bool)
-}
+	Validate() (err error)
+	ValidateStrict() (err error)
+	TryGetStartIndex() (v int64, err error)
+	TryGetStartIndexIRI() (v *url.URL, err error)
+	TryGetUnknownStartIndex() (v interface{}, err error)
+	TryGetNextOrderedCollectionPage() (v OrderedCollectionPageType, err error)
+	TryGetNextLink() (v LinkType, err error)
+	TryGetNextIRI() (v *url.URL, err error)
+	TryGetUnknownNext() (v interface{}, err error)
+	TryGetPrevOrderedCollectionPage() (v OrderedCollectionPageType, err error)
+	TryGetPrevLink() (v LinkType, err error)
+	TryGetPrevIRI() (v *url.URL, err error)
+	TryGetUnknownPrev() (v interface{}, err error)
+	TryGetOrderedItemsObject(index int) (v ObjectType, err error)
+	TryGetOrderedItemsLink(index int) (v LinkType, err error)
+	TryGetOrderedItemsIRI(index int) (v *url.URL, err error)
+	TryGetUnknownOrderedItems() (v interface{}, err error)
+	TryGetCurrentOrderedCollectionPage() (v OrderedCollectionPageType, err error)
+	TryGetCurrentLink() (v LinkType, err error)
+	TryGetCurrentIRI() (v *url.URL, err error)
+	TryGetUnknownCurrent() (v interface{}, err error)
+	TryGetFirstOrderedCollectionPage() (v OrderedCollectionPageType, err error)
+	TryGetFirstLink() (v LinkType, err error)
+	TryGetFirstIRI() (v *url.URL, err error)
+	TryGetUnknownFirst() (v interface{}, err error)
+	TryGetLastOrderedCollectionPage() (v OrderedCollectionPageType, err error)
+	TryGetLastLink() (v LinkType, err error)
+	TryGetLastIRI() (v *url.URL, err error)
+	TryGetUnknownLast() (v interface{}, err error)
+	TryGetTotalItems() (v int64, err error)
+	TryGetTotalItemsIRI() (v *url.URL, err error)
+	TryGetUnknownTotalItems() (v interface{}, err error)
+	TryGetAltitude() (v float64, err error)
+	TryGetAltitudeIRI() (v *url.URL, err error)
+	TryGetUnknownAltitude() (v interface{}, err error)
+	TryGetAttachmentObject(index int) (v ObjectType, err error)
+	TryGetAttachmentLink(index int) (v LinkType, err error)
+	TryGetAttachmentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttachment() (v interface{}, err error)
+	TryGetAttributedToObject(index int) (v ObjectType, err error)
+	TryGetAttributedToLink(index int) (v LinkType, err error)
+	TryGetAttributedToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAttributedTo() (v interface{}, err error)
+	TryGetAudienceObject(index int) (v ObjectType, err error)
+	TryGetAudienceLink(index int) (v LinkType, err error)
+	TryGetAudienceIRI(index int) (v *url.URL, err error)
+	TryGetUnknownAudience() (v interface{}, err error)
+	TryGetContentString(index int) (v string, err error)
+	TryGetContentLangString(index int) (v string, err error)
+	TryGetContentIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContent() (v interface{}, err error)
+	TryGetContextObject(index int) (v ObjectType, err error)
+	TryGetContextLink(index int) (v LinkType, err error)
+	TryGetContextIRI(index int) (v *url.URL, err error)
+	TryGetUnknownContext() (v interface{}, err error)
+	TryGetNameString(index int) (v string, err error)
+	TryGetNameLangString(index int) (v string, err error)
+	TryGetNameIRI(index int) (v *url.URL, err error)
+	TryGetUnknownName() (v interface{}, err error)
+	TryGetEndTime() (v time.Time, err error)
+	TryGetEndTimeIRI() (v *url.URL, err error)
+	TryGetUnknownEndTime() (v interface{}, err error)
+	TryGetGeneratorObject(index int) (v ObjectType, err error)
+	TryGetGeneratorLink(index int) (v LinkType, err error)
+	TryGetGeneratorIRI(index int) (v *url.URL, err error)
+	TryGetUnknownGenerator() (v interface{}, err error)
+	TryGetIconImage(index int) (v ImageType, err error)
+	TryGetIconLink(index int) (v LinkType, err error)
+	TryGetIconIRI(index int) (v *url.URL, err error)
+	TryGetUnknownIcon() (v interface{}, err error)
+	TryGetId() (v *url.URL, err error)
+	TryGetUnknownId() (v interface{}, err error)
+	TryGetImageImage(index int) (v ImageType, err error)
+	TryGetImageLink(index int) (v LinkType, err error)
+	TryGetImageIRI(index int) (v *url.URL, err error)
+	TryGetUnknownImage() (v interface{}, err error)
+	TryGetInReplyToObject(index int) (v ObjectType, err error)
+	TryGetInReplyToLink(index int) (v LinkType, err error)
+	TryGetInReplyToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownInReplyTo() (v interface{}, err error)
+	TryGetLocationObject(index int) (v ObjectType, err error)
+	TryGetLocationLink(index int) (v LinkType, err error)
+	TryGetLocationIRI(index int) (v *url.URL, err error)
+	TryGetUnknownLocation() (v interface{}, err error)
+	TryGetPreviewObject(index int) (v ObjectType, err error)
+	TryGetPreviewLink(index int) (v LinkType, err error)
+	TryGetPreviewIRI(index int) (v *url.URL, err error)
+	TryGetUnknownPreview() (v interface{}, err error)
+	TryGetPublished() (v time.Time, err error)
+	TryGetPublishedIRI() (v *url.URL, err error)
+	TryGetUnknownPublished() (v interface{}, err error)
+	TryGetReplies() (v CollectionType, err error)
+	TryGetRepliesIRI() (v *url.URL, err error)
+	TryGetUnknownReplies() (v interface{}, err error)
+	TryGetStartTime() (v time.Time, err error)
+	TryGetStartTimeIRI() (v *url.URL, err error)
+	TryGetUnknownStartTime() (v interface{}, err error)
+	TryGetSummaryString(index int) (v string, err error)
+	TryGetSummaryLangString(index int) (v string, err error)
+	TryGetSummaryIRI(index int) (v *url.URL, err error)
+	TryGetUnknownSummary() (v interface{}, err error)
+	TryGetTagObject(index int) (v ObjectType, err error)
+	TryGetTagLink(index int) (v LinkType, err error)
+	TryGetTagIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTag() (v interface{}, err error)
+	TryGetType(index int) (v interface{}, err error)
+	TryGetUpdated() (v time.Time, err error)
+	TryGetUpdatedIRI() (v *url.URL, err error)
+	TryGetUnknownUpdated() (v interface{}, err error)
+	TryGetUrlAnyURI(index int) (v *url.URL, err error)
+	TryGetUrlLink(index int) (v LinkType, err error)
+	TryGetUnknownUrl() (v interface{}, err error)
+	TryGetToObject(index int) (v ObjectType, err error)
+	TryGetToLink(index int) (v LinkType, err error)
+	TryGetToIRI(index int) (v *url.URL, err error)
+	TryGetUnknownTo() (v interface{}, err error)
+	TryGetBtoObject(index int) (v ObjectType, err error)
+	TryGetBtoLink(index int) (v LinkType, err error)
+	TryGetBtoIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBto() (v interface{}, err error)
+	TryGetCcObject(index int) (v ObjectType, err error)
+	TryGetCcLink(index int) (v LinkType, err error)
+	TryGetCcIRI(index int) (v *url.URL, err error)
+	TryGetUnknownCc() (v interface{}, err error)
+	TryGetBccObject(index int) (v ObjectType, err error)
+	TryGetBccLink(index int) (v LinkType, err error)
+	TryGetBccIRI(index int) (v *url.URL, err error)
+	TryGetUnknownBcc() (v interface{}, err error)
+	TryGetMediaType() (v string, err error)
+	TryGetMediaTypeIRI() (v *url.URL, err error)
+	TryGetUnknownMediaType() (v interface{}, err error)
+	TryGetDuration() (v time.Duration, err error)
+	TryGetDurationIRI() (v *url.URL, err error)
+	TryGetUnknownDuration() (v interface{}, err error)
+	TryGetSource() (v ObjectType, err error)
+	TryGetSourceIRI() (v *url.URL, err error)
+	TryGetUnknownSource() (v interface{}, err error)
+	TryGetInboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetInboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownInbox() (v interface{}, err error)
+	TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetOutboxAnyURI() (v *url.URL, err error)
+	TryGetUnknownOutbox() (v interface{}, err error)
+	TryGetFollowingCollection() (v CollectionType, err error)
+	TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowingAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowing() (v interface{}, err error)
+	TryGetFollowersCollection() (v CollectionType, err error)
+	TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetFollowersAnyURI() (v *url.URL, err error)
+	TryGetUnknownFollowers() (v interface{}, err error)
+	TryGetLikedCollection() (v CollectionType, err error)
+	TryGetLikedOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikedAnyURI() (v *url.URL, err error)
+	TryGetUnknownLiked() (v interface{}, err error)
+	TryGetLikesCollection() (v CollectionType, err error)
+	TryGetLikesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetLikesAnyURI() (v *url.URL, err error)
+	TryGetUnknownLikes() (v interface{}, err error)
+	TryGetStreams(index int) (v *url.URL, err error)
+	TryGetUnknownStreams() (v interface{}, err error)
+	TryGetPreferredUsername() (v string, err error)
+	TryGetPreferredUsernameIRI() (v *url.URL, err error)
+	TryGetUnknownPreferredUsername() (v interface{}, err error)
+	TryGetEndpoints() (v ObjectType, err error)
+	TryGetEndpointsIRI() (v *url.URL, err error)
+	TryGetUnknownEndpoints() (v interface{}, err error)
+	TryGetProxyUrl() (v *url.URL, err error)
+	TryGetUnknownProxyUrl() (v interface{}, err error)
+	TryGetOauthAuthorizationEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error)
+	TryGetOauthTokenEndpoint() (v *url.URL, err error)
+	TryGetUnknownOauthTokenEndpoint() (v interface{}, err error)
+	TryGetProvideClientKey() (v *url.URL, err error)
+	TryGetUnknownProvideClientKey() (v interface{}, err error)
+	TryGetSignClientKey() (v *url.URL, err error)
+	TryGetUnknownSignClientKey() (v interface{}, err error)
+	TryGetSharedInbox() (v *url.URL, err error)
+	TryGetUnknownSharedInbox() (v interface{}, err error)
+	TryGetSharesCollection() (v CollectionType, err error)
+	TryGetSharesOrderedCollection() (v OrderedCollectionType, err error)
+	TryGetSharesAnyURI() (v *url.URL, err error)
+	TryGetUnknownShares() (v interface{}, err error)
+	TryGetPartOfLink() (v LinkType, err error)
+	TryGetPartOfCollection() (v CollectionType, err error)
+	TryGetPartOfIRI() (v *url.URL, err error)
+	TryGetUnknownPartOf() (v interface{}, err error)
+	TryGetItemsObject(index int) (v ObjectType, err error)
+	TryGetItemsLink(index int) (v LinkType, err error)
+	TryGetItemsIRI(index int) (v *url.URL, err error)
+	TryGetUnknownItems() (v interface{}, err error)
+}
+
+var _ OrderedCollectionPageType = (*OrderedCollectionPage)(nil)
 
 // Used to represent ordered subsets of items from an OrderedCollection. Refer to the Activity Streams 2.0 Core for a complete description of the OrderedCollectionPage object.
 type OrderedCollectionPage struct {
 	// An unknown value.
 	unknown_ map[string]interface{}
+	// The order of the properties of the JSON this was unmarshalled from.
+	order_ *propertyOrder
 	// The functional 'startIndex' value could have multiple types, but only a single value
 	startIndex *startIndexIntermediateType
 	// The functional 'next' value could have multiple types, but only a single value
@@ -863,11 +1136,13 @@ type OrderedCollectionPage struct {
 	shares *sharesIntermediateType
 	// The functional 'partOf' value could have multiple types, but only a single value
 	partOf *partOfIntermediateType
+	// The bits of the members that are set, in the order of the members.
+	present_ [1]uint64
 }
 
 // IsStartIndex determines whether the call to GetStartIndex is safe
 func (t *OrderedCollectionPage) IsStartIndex() (ok bool) {
-	return t.startIndex != nil && t.startIndex.nonNegativeInteger != nil
+	return t.present_[0]&(1<<0) != 0 && t.startIndex.nonNegativeInteger != nil
 
 }
 
@@ -880,12 +1155,13 @@ func (t *OrderedCollectionPage) GetStartIndex() (v int64) {
 // SetStartIndex sets the value of startIndex to be of int64 type
 func (t *OrderedCollectionPage) SetStartIndex(v int64) {
 	t.startIndex = &startIndexIntermediateType{nonNegativeInteger: &v}
+	t.markPresent_(0, true)
 
 }
 
 // IsStartIndexIRI determines whether the call to GetStartIndexIRI is safe
 func (t *OrderedCollectionPage) IsStartIndexIRI() (ok bool) {
-	return t.startIndex != nil && t.startIndex.IRI != nil
+	return t.present_[0]&(1<<0) != 0 && t.startIndex.IRI != nil
 
 }
 
@@ -898,12 +1174,13 @@ func (t *OrderedCollectionPage) GetStartIndexIRI() (v *url.URL) {
 // SetStartIndexIRI sets the value of startIndex to be of *url.URL type
 func (t *OrderedCollectionPage) SetStartIndexIRI(v *url.URL) {
 	t.startIndex = &startIndexIntermediateType{IRI: v}
+	t.markPresent_(0, true)
 
 }
 
 // HasUnknownStartIndex determines whether the call to GetUnknownStartIndex is safe
 func (t *OrderedCollectionPage) HasUnknownStartIndex() (ok bool) {
-	return t.startIndex != nil && t.startIndex.unknown_ != nil
+	return t.present_[0]&(1<<0) != 0 && t.startIndex.unknown_ != nil
 
 }
 
@@ -921,12 +1198,13 @@ func (t *OrderedCollectionPage) SetUnknownStartIndex(i interface{}) {
 	tmp := &startIndexIntermediateType{}
 	tmp.unknown_ = i
 	t.startIndex = tmp
+	t.markPresent_(0, true)
 
 }
 
 // IsNextOrderedCollectionPage determines whether the call to GetNextOrderedCollectionPage is safe
 func (t *OrderedCollectionPage) IsNextOrderedCollectionPage() (ok bool) {
-	return t.next != nil && t.next.OrderedCollectionPage != nil
+	return t.present_[0]&(1<<1) != 0 && t.next.OrderedCollectionPage != nil
 
 }
 
@@ -939,12 +1217,13 @@ func (t *OrderedCollectionPage) GetNextOrderedCollectionPage() (v OrderedCollect
 // SetNextOrderedCollectionPage sets the value of next to be of OrderedCollectionPageType type
 func (t *OrderedCollectionPage) SetNextOrderedCollectionPage(v OrderedCollectionPageType) {
 	t.next = &nextIntermediateType{OrderedCollectionPage: v}
+	t.markPresent_(1, true)
 
 }
 
 // IsNextLink determines whether the call to GetNextLink is safe
 func (t *OrderedCollectionPage) IsNextLink() (ok bool) {
-	return t.next != nil && t.next.Link != nil
+	return t.present_[0]&(1<<1) != 0 && t.next.Link != nil
 
 }
 
@@ -957,12 +1236,13 @@ func (t *OrderedCollectionPage) GetNextLink() (v LinkType) {
 // SetNextLink sets the value of next to be of LinkType type
 func (t *OrderedCollectionPage) SetNextLink(v LinkType) {
 	t.next = &nextIntermediateType{Link: v}
+	t.markPresent_(1, true)
 
 }
 
 // IsNextIRI determines whether the call to GetNextIRI is safe
 func (t *OrderedCollectionPage) IsNextIRI() (ok bool) {
-	return t.next != nil && t.next.IRI != nil
+	return t.present_[0]&(1<<1) != 0 && t.next.IRI != nil
 
 }
 
@@ -975,12 +1255,13 @@ func (t *OrderedCollectionPage) GetNextIRI() (v *url.URL) {
 // SetNextIRI sets the value of next to be of *url.URL type
 func (t *OrderedCollectionPage) SetNextIRI(v *url.URL) {
 	t.next = &nextIntermediateType{IRI: v}
+	t.markPresent_(1, true)
 
 }
 
 // HasUnknownNext determines whether the call to GetUnknownNext is safe
 func (t *OrderedCollectionPage) HasUnknownNext() (ok bool) {
-	return t.next != nil && t.next.unknown_ != nil
+	return t.present_[0]&(1<<1) != 0 && t.next.unknown_ != nil
 
 }
 
@@ -998,12 +1279,13 @@ func (t *OrderedCollectionPage) SetUnknownNext(i interface{}) {
 	tmp := &nextIntermediateType{}
 	tmp.unknown_ = i
 	t.next = tmp
+	t.markPresent_(1, true)
 
 }
 
 // IsPrevOrderedCollectionPage determines whether the call to GetPrevOrderedCollectionPage is safe
 func (t *OrderedCollectionPage) IsPrevOrderedCollectionPage() (ok bool) {
-	return t.prev != nil && t.prev.OrderedCollectionPage != nil
+	return t.present_[0]&(1<<2) != 0 && t.prev.OrderedCollectionPage != nil
 
 }
 
@@ -1016,12 +1298,13 @@ func (t *OrderedCollectionPage) GetPrevOrderedCollectionPage() (v OrderedCollect
 // SetPrevOrderedCollectionPage sets the value of prev to be of OrderedCollectionPageType type
 func (t *OrderedCollectionPage) SetPrevOrderedCollectionPage(v OrderedCollectionPageType) {
 	t.prev = &prevIntermediateType{OrderedCollectionPage: v}
+	t.markPresent_(2, true)
 
 }
 
 // IsPrevLink determines whether the call to GetPrevLink is safe
 func (t *OrderedCollectionPage) IsPrevLink() (ok bool) {
-	return t.prev != nil && t.prev.Link != nil
+	return t.present_[0]&(1<<2) != 0 && t.prev.Link != nil
 
 }
 
@@ -1034,12 +1317,13 @@ func (t *OrderedCollectionPage) GetPrevLink() (v LinkType) {
 // SetPrevLink sets the value of prev to be of LinkType type
 func (t *OrderedCollectionPage) SetPrevLink(v LinkType) {
 	t.prev = &prevIntermediateType{Link: v}
+	t.markPresent_(2, true)
 
 }
 
 // IsPrevIRI determines whether the call to GetPrevIRI is safe
 func (t *OrderedCollectionPage) IsPrevIRI() (ok bool) {
-	return t.prev != nil && t.prev.IRI != nil
+	return t.present_[0]&(1<<2) != 0 && t.prev.IRI != nil
 
 }
 
@@ -1052,12 +1336,13 @@ func (t *OrderedCollectionPage) GetPrevIRI() (v *url.URL) {
 // SetPrevIRI sets the value of prev to be of *url.URL type
 func (t *OrderedCollectionPage) SetPrevIRI(v *url.URL) {
 	t.prev = &prevIntermediateType{IRI: v}
+	t.markPresent_(2, true)
 
 }
 
 // HasUnknownPrev determines whether the call to GetUnknownPrev is safe
 func (t *OrderedCollectionPage) HasUnknownPrev() (ok bool) {
-	return t.prev != nil && t.prev.unknown_ != nil
+	return t.present_[0]&(1<<2) != 0 && t.prev.unknown_ != nil
 
 }
 
@@ -1075,6 +1360,7 @@ func (t *OrderedCollectionPage) SetUnknownPrev(i interface{}) {
 	tmp := &prevIntermediateType{}
 	tmp.unknown_ = i
 	t.prev = tmp
+	t.markPresent_(2, true)
 
 }
 
@@ -1099,12 +1385,14 @@ func (t *OrderedCollectionPage) GetOrderedItemsObject(index int) (v ObjectType)
 // AppendOrderedItemsObject adds to the back of orderedItems a ObjectType type
 func (t *OrderedCollectionPage) AppendOrderedItemsObject(v ObjectType) {
 	t.orderedItems = append(t.orderedItems, &orderedItemsIntermediateType{Object: v})
+	t.markPresent_(3, len(t.orderedItems) > 0)
 
 }
 
 // PrependOrderedItemsObject adds to the front of orderedItems a ObjectType type
 func (t *OrderedCollectionPage) PrependOrderedItemsObject(v ObjectType) {
 	t.orderedItems = append([]*orderedItemsIntermediateType{&orderedItemsIntermediateType{Object: v}}, t.orderedItems...)
+	t.markPresent_(3, len(t.orderedItems) > 0)
 
 }
 
@@ -1113,6 +1401,22 @@ func (t *OrderedCollectionPage) RemoveOrderedItemsObject(index int) {
 	copy(t.orderedItems[index:], t.orderedItems[index+1:])
 	t.orderedItems[len(t.orderedItems)-1] = nil
 	t.orderedItems = t.orderedItems[:len(t.orderedItems)-1]
+	t.markPresent_(3, len(t.orderedItems) > 0)
+
+}
+
+// OrderedItemsObjectValues returns an iterator over the values that GetOrderedItemsObject returns for each index where IsOrderedItemsObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) OrderedItemsObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.OrderedItemsLen(); i++ {
+			if !t.IsOrderedItemsObject(i) {
+				continue
+			}
+			if !yield(t.GetOrderedItemsObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1131,12 +1435,14 @@ func (t *OrderedCollectionPage) GetOrderedItemsLink(index int) (v LinkType) {
 // AppendOrderedItemsLink adds to the back of orderedItems a LinkType type
 func (t *OrderedCollectionPage) AppendOrderedItemsLink(v LinkType) {
 	t.orderedItems = append(t.orderedItems, &orderedItemsIntermediateType{Link: v})
+	t.markPresent_(3, len(t.orderedItems) > 0)
 
 }
 
 // PrependOrderedItemsLink adds to the front of orderedItems a LinkType type
 func (t *OrderedCollectionPage) PrependOrderedItemsLink(v LinkType) {
 	t.orderedItems = append([]*orderedItemsIntermediateType{&orderedItemsIntermediateType{Link: v}}, t.orderedItems...)
+	t.markPresent_(3, len(t.orderedItems) > 0)
 
 }
 
@@ -1145,6 +1451,22 @@ func (t *OrderedCollectionPage) RemoveOrderedItemsLink(index int) {
 	copy(t.orderedItems[index:], t.orderedItems[index+1:])
 	t.orderedItems[len(t.orderedItems)-1] = nil
 	t.orderedItems = t.orderedItems[:len(t.orderedItems)-1]
+	t.markPresent_(3, len(t.orderedItems) > 0)
+
+}
+
+// OrderedItemsLinkValues returns an iterator over the values that GetOrderedItemsLink returns for each index where IsOrderedItemsLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) OrderedItemsLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.OrderedItemsLen(); i++ {
+			if !t.IsOrderedItemsLink(i) {
+				continue
+			}
+			if !yield(t.GetOrderedItemsLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1163,12 +1485,14 @@ func (t *OrderedCollectionPage) GetOrderedItemsIRI(index int) (v *url.URL) {
 // AppendOrderedItemsIRI adds to the back of orderedItems a *url.URL type
 func (t *OrderedCollectionPage) AppendOrderedItemsIRI(v *url.URL) {
 	t.orderedItems = append(t.orderedItems, &orderedItemsIntermediateType{IRI: v})
+	t.markPresent_(3, len(t.orderedItems) > 0)
 
 }
 
 // PrependOrderedItemsIRI adds to the front of orderedItems a *url.URL type
 func (t *OrderedCollectionPage) PrependOrderedItemsIRI(v *url.URL) {
 	t.orderedItems = append([]*orderedItemsIntermediateType{&orderedItemsIntermediateType{IRI: v}}, t.orderedItems...)
+	t.markPresent_(3, len(t.orderedItems) > 0)
 
 }
 
@@ -1177,12 +1501,28 @@ func (t *OrderedCollectionPage) RemoveOrderedItemsIRI(index int) {
 	copy(t.orderedItems[index:], t.orderedItems[index+1:])
 	t.orderedItems[len(t.orderedItems)-1] = nil
 	t.orderedItems = t.orderedItems[:len(t.orderedItems)-1]
+	t.markPresent_(3, len(t.orderedItems) > 0)
+
+}
+
+// OrderedItemsIRIValues returns an iterator over the values that GetOrderedItemsIRI returns for each index where IsOrderedItemsIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) OrderedItemsIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.OrderedItemsLen(); i++ {
+			if !t.IsOrderedItemsIRI(i) {
+				continue
+			}
+			if !yield(t.GetOrderedItemsIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownOrderedItems determines whether the call to GetUnknownOrderedItems is safe
 func (t *OrderedCollectionPage) HasUnknownOrderedItems() (ok bool) {
-	return t.orderedItems != nil && t.orderedItems[0].unknown_ != nil
+	return t.present_[0]&(1<<3) != 0 && t.orderedItems[0].unknown_ != nil
 
 }
 
@@ -1200,12 +1540,13 @@ func (t *OrderedCollectionPage) SetUnknownOrderedItems(i interface{}) {
 	tmp := &orderedItemsIntermediateType{}
 	tmp.unknown_ = i
 	t.orderedItems = append(t.orderedItems, tmp)
+	t.markPresent_(3, len(t.orderedItems) > 0)
 
 }
 
 // IsCurrentOrderedCollectionPage determines whether the call to GetCurrentOrderedCollectionPage is safe
 func (t *OrderedCollectionPage) IsCurrentOrderedCollectionPage() (ok bool) {
-	return t.current != nil && t.current.OrderedCollectionPage != nil
+	return t.present_[0]&(1<<4) != 0 && t.current.OrderedCollectionPage != nil
 
 }
 
@@ -1218,12 +1559,13 @@ func (t *OrderedCollectionPage) GetCurrentOrderedCollectionPage() (v OrderedColl
 // SetCurrentOrderedCollectionPage sets the value of current to be of OrderedCollectionPageType type
 func (t *OrderedCollectionPage) SetCurrentOrderedCollectionPage(v OrderedCollectionPageType) {
 	t.current = &currentIntermediateType{OrderedCollectionPage: v}
+	t.markPresent_(4, true)
 
 }
 
 // IsCurrentLink determines whether the call to GetCurrentLink is safe
 func (t *OrderedCollectionPage) IsCurrentLink() (ok bool) {
-	return t.current != nil && t.current.Link != nil
+	return t.present_[0]&(1<<4) != 0 && t.current.Link != nil
 
 }
 
@@ -1236,12 +1578,13 @@ func (t *OrderedCollectionPage) GetCurrentLink() (v LinkType) {
 // SetCurrentLink sets the value of current to be of LinkType type
 func (t *OrderedCollectionPage) SetCurrentLink(v LinkType) {
 	t.current = &currentIntermediateType{Link: v}
+	t.markPresent_(4, true)
 
 }
 
 // IsCurrentIRI determines whether the call to GetCurrentIRI is safe
 func (t *OrderedCollectionPage) IsCurrentIRI() (ok bool) {
-	return t.current != nil && t.current.IRI != nil
+	return t.present_[0]&(1<<4) != 0 && t.current.IRI != nil
 
 }
 
@@ -1254,12 +1597,13 @@ func (t *OrderedCollectionPage) GetCurrentIRI() (v *url.URL) {
 // SetCurrentIRI sets the value of current to be of *url.URL type
 func (t *OrderedCollectionPage) SetCurrentIRI(v *url.URL) {
 	t.current = &currentIntermediateType{IRI: v}
+	t.markPresent_(4, true)
 
 }
 
 // HasUnknownCurrent determines whether the call to GetUnknownCurrent is safe
 func (t *OrderedCollectionPage) HasUnknownCurrent() (ok bool) {
-	return t.current != nil && t.current.unknown_ != nil
+	return t.present_[0]&(1<<4) != 0 && t.current.unknown_ != nil
 
 }
 
@@ -1277,12 +1621,13 @@ func (t *OrderedCollectionPage) SetUnknownCurrent(i interface{}) {
 	tmp := &currentIntermediateType{}
 	tmp.unknown_ = i
 	t.current = tmp
+	t.markPresent_(4, true)
 
 }
 
 // IsFirstOrderedCollectionPage determines whether the call to GetFirstOrderedCollectionPage is safe
 func (t *OrderedCollectionPage) IsFirstOrderedCollectionPage() (ok bool) {
-	return t.first != nil && t.first.OrderedCollectionPage != nil
+	return t.present_[0]&(1<<5) != 0 && t.first.OrderedCollectionPage != nil
 
 }
 
@@ -1295,12 +1640,13 @@ func (t *OrderedCollectionPage) GetFirstOrderedCollectionPage() (v OrderedCollec
 // SetFirstOrderedCollectionPage sets the value of first to be of OrderedCollectionPageType type
 func (t *OrderedCollectionPage) SetFirstOrderedCollectionPage(v OrderedCollectionPageType) {
 	t.first = &firstIntermediateType{OrderedCollectionPage: v}
+	t.markPresent_(5, true)
 
 }
 
 // IsFirstLink determines whether the call to GetFirstLink is safe
 func (t *OrderedCollectionPage) IsFirstLink() (ok bool) {
-	return t.first != nil && t.first.Link != nil
+	return t.present_[0]&(1<<5) != 0 && t.first.Link != nil
 
 }
 
@@ -1313,12 +1659,13 @@ func (t *OrderedCollectionPage) GetFirstLink() (v LinkType) {
 // SetFirstLink sets the value of first to be of LinkType type
 func (t *OrderedCollectionPage) SetFirstLink(v LinkType) {
 	t.first = &firstIntermediateType{Link: v}
+	t.markPresent_(5, true)
 
 }
 
 // IsFirstIRI determines whether the call to GetFirstIRI is safe
 func (t *OrderedCollectionPage) IsFirstIRI() (ok bool) {
-	return t.first != nil && t.first.IRI != nil
+	return t.present_[0]&(1<<5) != 0 && t.first.IRI != nil
 
 }
 
@@ -1331,12 +1678,13 @@ func (t *OrderedCollectionPage) GetFirstIRI() (v *url.URL) {
 // SetFirstIRI sets the value of first to be of *url.URL type
 func (t *OrderedCollectionPage) SetFirstIRI(v *url.URL) {
 	t.first = &firstIntermediateType{IRI: v}
+	t.markPresent_(5, true)
 
 }
 
 // HasUnknownFirst determines whether the call to GetUnknownFirst is safe
 func (t *OrderedCollectionPage) HasUnknownFirst() (ok bool) {
-	return t.first != nil && t.first.unknown_ != nil
+	return t.present_[0]&(1<<5) != 0 && t.first.unknown_ != nil
 
 }
 
@@ -1354,12 +1702,13 @@ func (t *OrderedCollectionPage) SetUnknownFirst(i interface{}) {
 	tmp := &firstIntermediateType{}
 	tmp.unknown_ = i
 	t.first = tmp
+	t.markPresent_(5, true)
 
 }
 
 // IsLastOrderedCollectionPage determines whether the call to GetLastOrderedCollectionPage is safe
 func (t *OrderedCollectionPage) IsLastOrderedCollectionPage() (ok bool) {
-	return t.last != nil && t.last.OrderedCollectionPage != nil
+	return t.present_[0]&(1<<6) != 0 && t.last.OrderedCollectionPage != nil
 
 }
 
@@ -1372,12 +1721,13 @@ func (t *OrderedCollectionPage) GetLastOrderedCollectionPage() (v OrderedCollect
 // SetLastOrderedCollectionPage sets the value of last to be of OrderedCollectionPageType type
 func (t *OrderedCollectionPage) SetLastOrderedCollectionPage(v OrderedCollectionPageType) {
 	t.last = &lastIntermediateType{OrderedCollectionPage: v}
+	t.markPresent_(6, true)
 
 }
 
 // IsLastLink determines whether the call to GetLastLink is safe
 func (t *OrderedCollectionPage) IsLastLink() (ok bool) {
-	return t.last != nil && t.last.Link != nil
+	return t.present_[0]&(1<<6) != 0 && t.last.Link != nil
 
 }
 
@@ -1390,12 +1740,13 @@ func (t *OrderedCollectionPage) GetLastLink() (v LinkType) {
 // SetLastLink sets the value of last to be of LinkType type
 func (t *OrderedCollectionPage) SetLastLink(v LinkType) {
 	t.last = &lastIntermediateType{Link: v}
+	t.markPresent_(6, true)
 
 }
 
 // IsLastIRI determines whether the call to GetLastIRI is safe
 func (t *OrderedCollectionPage) IsLastIRI() (ok bool) {
-	return t.last != nil && t.last.IRI != nil
+	return t.present_[0]&(1<<6) != 0 && t.last.IRI != nil
 
 }
 
@@ -1408,12 +1759,13 @@ func (t *OrderedCollectionPage) GetLastIRI() (v *url.URL) {
 // SetLastIRI sets the value of last to be of *url.URL type
 func (t *OrderedCollectionPage) SetLastIRI(v *url.URL) {
 	t.last = &lastIntermediateType{IRI: v}
+	t.markPresent_(6, true)
 
 }
 
 // HasUnknownLast determines whether the call to GetUnknownLast is safe
 func (t *OrderedCollectionPage) HasUnknownLast() (ok bool) {
-	return t.last != nil && t.last.unknown_ != nil
+	return t.present_[0]&(1<<6) != 0 && t.last.unknown_ != nil
 
 }
 
@@ -1431,12 +1783,13 @@ func (t *OrderedCollectionPage) SetUnknownLast(i interface{}) {
 	tmp := &lastIntermediateType{}
 	tmp.unknown_ = i
 	t.last = tmp
+	t.markPresent_(6, true)
 
 }
 
 // IsTotalItems determines whether the call to GetTotalItems is safe
 func (t *OrderedCollectionPage) IsTotalItems() (ok bool) {
-	return t.totalItems != nil && t.totalItems.nonNegativeInteger != nil
+	return t.present_[0]&(1<<7) != 0 && t.totalItems.nonNegativeInteger != nil
 
 }
 
@@ -1449,12 +1802,13 @@ func (t *OrderedCollectionPage) GetTotalItems() (v int64) {
 // SetTotalItems sets the value of totalItems to be of int64 type
 func (t *OrderedCollectionPage) SetTotalItems(v int64) {
 	t.totalItems = &totalItemsIntermediateType{nonNegativeInteger: &v}
+	t.markPresent_(7, true)
 
 }
 
 // IsTotalItemsIRI determines whether the call to GetTotalItemsIRI is safe
 func (t *OrderedCollectionPage) IsTotalItemsIRI() (ok bool) {
-	return t.totalItems != nil && t.totalItems.IRI != nil
+	return t.present_[0]&(1<<7) != 0 && t.totalItems.IRI != nil
 
 }
 
@@ -1467,12 +1821,13 @@ func (t *OrderedCollectionPage) GetTotalItemsIRI() (v *url.URL) {
 // SetTotalItemsIRI sets the value of totalItems to be of *url.URL type
 func (t *OrderedCollectionPage) SetTotalItemsIRI(v *url.URL) {
 	t.totalItems = &totalItemsIntermediateType{IRI: v}
+	t.markPresent_(7, true)
 
 }
 
 // HasUnknownTotalItems determines whether the call to GetUnknownTotalItems is safe
 func (t *OrderedCollectionPage) HasUnknownTotalItems() (ok bool) {
-	return t.totalItems != nil && t.totalItems.unknown_ != nil
+	return t.present_[0]&(1<<7) != 0 && t.totalItems.unknown_ != nil
 
 }
 
@@ -1490,12 +1845,13 @@ func (t *OrderedCollectionPage) SetUnknownTotalItems(i interface{}) {
 	tmp := &totalItemsIntermediateType{}
 	tmp.unknown_ = i
 	t.totalItems = tmp
+	t.markPresent_(7, true)
 
 }
 
 // IsAltitude determines whether the call to GetAltitude is safe
 func (t *OrderedCollectionPage) IsAltitude() (ok bool) {
-	return t.altitude != nil && t.altitude.float != nil
+	return t.present_[0]&(1<<8) != 0 && t.altitude.float != nil
 
 }
 
@@ -1508,12 +1864,13 @@ func (t *OrderedCollectionPage) GetAltitude() (v float64) {
 // SetAltitude sets the value of altitude to be of float64 type
 func (t *OrderedCollectionPage) SetAltitude(v float64) {
 	t.altitude = &altitudeIntermediateType{float: &v}
+	t.markPresent_(8, true)
 
 }
 
 // IsAltitudeIRI determines whether the call to GetAltitudeIRI is safe
 func (t *OrderedCollectionPage) IsAltitudeIRI() (ok bool) {
-	return t.altitude != nil && t.altitude.IRI != nil
+	return t.present_[0]&(1<<8) != 0 && t.altitude.IRI != nil
 
 }
 
@@ -1526,12 +1883,13 @@ func (t *OrderedCollectionPage) GetAltitudeIRI() (v *url.URL) {
 // SetAltitudeIRI sets the value of altitude to be of *url.URL type
 func (t *OrderedCollectionPage) SetAltitudeIRI(v *url.URL) {
 	t.altitude = &altitudeIntermediateType{IRI: v}
+	t.markPresent_(8, true)
 
 }
 
 // HasUnknownAltitude determines whether the call to GetUnknownAltitude is safe
 func (t *OrderedCollectionPage) HasUnknownAltitude() (ok bool) {
-	return t.altitude != nil && t.altitude.unknown_ != nil
+	return t.present_[0]&(1<<8) != 0 && t.altitude.unknown_ != nil
 
 }
 
@@ -1549,6 +1907,7 @@ func (t *OrderedCollectionPage) SetUnknownAltitude(i interface{}) {
 	tmp := &altitudeIntermediateType{}
 	tmp.unknown_ = i
 	t.altitude = tmp
+	t.markPresent_(8, true)
 
 }
 
@@ -1573,12 +1932,14 @@ func (t *OrderedCollectionPage) GetAttachmentObject(index int) (v ObjectType) {
 // AppendAttachmentObject adds to the back of attachment a ObjectType type
 func (t *OrderedCollectionPage) AppendAttachmentObject(v ObjectType) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{Object: v})
+	t.markPresent_(9, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentObject adds to the front of attachment a ObjectType type
 func (t *OrderedCollectionPage) PrependAttachmentObject(v ObjectType) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Object: v}}, t.attachment...)
+	t.markPresent_(9, len(t.attachment) > 0)
 
 }
 
@@ -1587,6 +1948,22 @@ func (t *OrderedCollectionPage) RemoveAttachmentObject(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(9, len(t.attachment) > 0)
+
+}
+
+// AttachmentObjectValues returns an iterator over the values that GetAttachmentObject returns for each index where IsAttachmentObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AttachmentObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentObject(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1605,12 +1982,14 @@ func (t *OrderedCollectionPage) GetAttachmentLink(index int) (v LinkType) {
 // AppendAttachmentLink adds to the back of attachment a LinkType type
 func (t *OrderedCollectionPage) AppendAttachmentLink(v LinkType) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{Link: v})
+	t.markPresent_(9, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentLink adds to the front of attachment a LinkType type
 func (t *OrderedCollectionPage) PrependAttachmentLink(v LinkType) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{Link: v}}, t.attachment...)
+	t.markPresent_(9, len(t.attachment) > 0)
 
 }
 
@@ -1619,6 +1998,22 @@ func (t *OrderedCollectionPage) RemoveAttachmentLink(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(9, len(t.attachment) > 0)
+
+}
+
+// AttachmentLinkValues returns an iterator over the values that GetAttachmentLink returns for each index where IsAttachmentLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AttachmentLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentLink(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1637,12 +2032,14 @@ func (t *OrderedCollectionPage) GetAttachmentIRI(index int) (v *url.URL) {
 // AppendAttachmentIRI adds to the back of attachment a *url.URL type
 func (t *OrderedCollectionPage) AppendAttachmentIRI(v *url.URL) {
 	t.attachment = append(t.attachment, &attachmentIntermediateType{IRI: v})
+	t.markPresent_(9, len(t.attachment) > 0)
 
 }
 
 // PrependAttachmentIRI adds to the front of attachment a *url.URL type
 func (t *OrderedCollectionPage) PrependAttachmentIRI(v *url.URL) {
 	t.attachment = append([]*attachmentIntermediateType{&attachmentIntermediateType{IRI: v}}, t.attachment...)
+	t.markPresent_(9, len(t.attachment) > 0)
 
 }
 
@@ -1651,12 +2048,28 @@ func (t *OrderedCollectionPage) RemoveAttachmentIRI(index int) {
 	copy(t.attachment[index:], t.attachment[index+1:])
 	t.attachment[len(t.attachment)-1] = nil
 	t.attachment = t.attachment[:len(t.attachment)-1]
+	t.markPresent_(9, len(t.attachment) > 0)
+
+}
+
+// AttachmentIRIValues returns an iterator over the values that GetAttachmentIRI returns for each index where IsAttachmentIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AttachmentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttachmentLen(); i++ {
+			if !t.IsAttachmentIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttachmentIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAttachment determines whether the call to GetUnknownAttachment is safe
 func (t *OrderedCollectionPage) HasUnknownAttachment() (ok bool) {
-	return t.attachment != nil && t.attachment[0].unknown_ != nil
+	return t.present_[0]&(1<<9) != 0 && t.attachment[0].unknown_ != nil
 
 }
 
@@ -1674,6 +2087,7 @@ func (t *OrderedCollectionPage) SetUnknownAttachment(i interface{}) {
 	tmp := &attachmentIntermediateType{}
 	tmp.unknown_ = i
 	t.attachment = append(t.attachment, tmp)
+	t.markPresent_(9, len(t.attachment) > 0)
 
 }
 
@@ -1698,12 +2112,14 @@ func (t *OrderedCollectionPage) GetAttributedToObject(index int) (v ObjectType)
 // AppendAttributedToObject adds to the back of attributedTo a ObjectType type
 func (t *OrderedCollectionPage) AppendAttributedToObject(v ObjectType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Object: v})
+	t.markPresent_(10, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToObject adds to the front of attributedTo a ObjectType type
 func (t *OrderedCollectionPage) PrependAttributedToObject(v ObjectType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Object: v}}, t.attributedTo...)
+	t.markPresent_(10, len(t.attributedTo) > 0)
 
 }
 
@@ -1712,6 +2128,22 @@ func (t *OrderedCollectionPage) RemoveAttributedToObject(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(10, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToObjectValues returns an iterator over the values that GetAttributedToObject returns for each index where IsAttributedToObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AttributedToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToObject(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1730,12 +2162,14 @@ func (t *OrderedCollectionPage) GetAttributedToLink(index int) (v LinkType) {
 // AppendAttributedToLink adds to the back of attributedTo a LinkType type
 func (t *OrderedCollectionPage) AppendAttributedToLink(v LinkType) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{Link: v})
+	t.markPresent_(10, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToLink adds to the front of attributedTo a LinkType type
 func (t *OrderedCollectionPage) PrependAttributedToLink(v LinkType) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{Link: v}}, t.attributedTo...)
+	t.markPresent_(10, len(t.attributedTo) > 0)
 
 }
 
@@ -1744,6 +2178,22 @@ func (t *OrderedCollectionPage) RemoveAttributedToLink(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(10, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToLinkValues returns an iterator over the values that GetAttributedToLink returns for each index where IsAttributedToLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AttributedToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToLink(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1762,12 +2212,14 @@ func (t *OrderedCollectionPage) GetAttributedToIRI(index int) (v *url.URL) {
 // AppendAttributedToIRI adds to the back of attributedTo a *url.URL type
 func (t *OrderedCollectionPage) AppendAttributedToIRI(v *url.URL) {
 	t.attributedTo = append(t.attributedTo, &attributedToIntermediateType{IRI: v})
+	t.markPresent_(10, len(t.attributedTo) > 0)
 
 }
 
 // PrependAttributedToIRI adds to the front of attributedTo a *url.URL type
 func (t *OrderedCollectionPage) PrependAttributedToIRI(v *url.URL) {
 	t.attributedTo = append([]*attributedToIntermediateType{&attributedToIntermediateType{IRI: v}}, t.attributedTo...)
+	t.markPresent_(10, len(t.attributedTo) > 0)
 
 }
 
@@ -1776,12 +2228,28 @@ func (t *OrderedCollectionPage) RemoveAttributedToIRI(index int) {
 	copy(t.attributedTo[index:], t.attributedTo[index+1:])
 	t.attributedTo[len(t.attributedTo)-1] = nil
 	t.attributedTo = t.attributedTo[:len(t.attributedTo)-1]
+	t.markPresent_(10, len(t.attributedTo) > 0)
+
+}
+
+// AttributedToIRIValues returns an iterator over the values that GetAttributedToIRI returns for each index where IsAttributedToIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AttributedToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AttributedToLen(); i++ {
+			if !t.IsAttributedToIRI(i) {
+				continue
+			}
+			if !yield(t.GetAttributedToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAttributedTo determines whether the call to GetUnknownAttributedTo is safe
 func (t *OrderedCollectionPage) HasUnknownAttributedTo() (ok bool) {
-	return t.attributedTo != nil && t.attributedTo[0].unknown_ != nil
+	return t.present_[0]&(1<<10) != 0 && t.attributedTo[0].unknown_ != nil
 
 }
 
@@ -1799,6 +2267,7 @@ func (t *OrderedCollectionPage) SetUnknownAttributedTo(i interface{}) {
 	tmp := &attributedToIntermediateType{}
 	tmp.unknown_ = i
 	t.attributedTo = append(t.attributedTo, tmp)
+	t.markPresent_(10, len(t.attributedTo) > 0)
 
 }
 
@@ -1823,12 +2292,14 @@ func (t *OrderedCollectionPage) GetAudienceObject(index int) (v ObjectType) {
 // AppendAudienceObject adds to the back of audience a ObjectType type
 func (t *OrderedCollectionPage) AppendAudienceObject(v ObjectType) {
 	t.audience = append(t.audience, &audienceIntermediateType{Object: v})
+	t.markPresent_(11, len(t.audience) > 0)
 
 }
 
 // PrependAudienceObject adds to the front of audience a ObjectType type
 func (t *OrderedCollectionPage) PrependAudienceObject(v ObjectType) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Object: v}}, t.audience...)
+	t.markPresent_(11, len(t.audience) > 0)
 
 }
 
@@ -1837,6 +2308,22 @@ func (t *OrderedCollectionPage) RemoveAudienceObject(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(11, len(t.audience) > 0)
+
+}
+
+// AudienceObjectValues returns an iterator over the values that GetAudienceObject returns for each index where IsAudienceObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AudienceObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceObject(i) {
+				continue
+			}
+			if !yield(t.GetAudienceObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1855,12 +2342,14 @@ func (t *OrderedCollectionPage) GetAudienceLink(index int) (v LinkType) {
 // AppendAudienceLink adds to the back of audience a LinkType type
 func (t *OrderedCollectionPage) AppendAudienceLink(v LinkType) {
 	t.audience = append(t.audience, &audienceIntermediateType{Link: v})
+	t.markPresent_(11, len(t.audience) > 0)
 
 }
 
 // PrependAudienceLink adds to the front of audience a LinkType type
 func (t *OrderedCollectionPage) PrependAudienceLink(v LinkType) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{Link: v}}, t.audience...)
+	t.markPresent_(11, len(t.audience) > 0)
 
 }
 
@@ -1869,6 +2358,22 @@ func (t *OrderedCollectionPage) RemoveAudienceLink(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(11, len(t.audience) > 0)
+
+}
+
+// AudienceLinkValues returns an iterator over the values that GetAudienceLink returns for each index where IsAudienceLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AudienceLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceLink(i) {
+				continue
+			}
+			if !yield(t.GetAudienceLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1887,12 +2392,14 @@ func (t *OrderedCollectionPage) GetAudienceIRI(index int) (v *url.URL) {
 // AppendAudienceIRI adds to the back of audience a *url.URL type
 func (t *OrderedCollectionPage) AppendAudienceIRI(v *url.URL) {
 	t.audience = append(t.audience, &audienceIntermediateType{IRI: v})
+	t.markPresent_(11, len(t.audience) > 0)
 
 }
 
 // PrependAudienceIRI adds to the front of audience a *url.URL type
 func (t *OrderedCollectionPage) PrependAudienceIRI(v *url.URL) {
 	t.audience = append([]*audienceIntermediateType{&audienceIntermediateType{IRI: v}}, t.audience...)
+	t.markPresent_(11, len(t.audience) > 0)
 
 }
 
@@ -1901,12 +2408,28 @@ func (t *OrderedCollectionPage) RemoveAudienceIRI(index int) {
 	copy(t.audience[index:], t.audience[index+1:])
 	t.audience[len(t.audience)-1] = nil
 	t.audience = t.audience[:len(t.audience)-1]
+	t.markPresent_(11, len(t.audience) > 0)
+
+}
+
+// AudienceIRIValues returns an iterator over the values that GetAudienceIRI returns for each index where IsAudienceIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) AudienceIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.AudienceLen(); i++ {
+			if !t.IsAudienceIRI(i) {
+				continue
+			}
+			if !yield(t.GetAudienceIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownAudience determines whether the call to GetUnknownAudience is safe
 func (t *OrderedCollectionPage) HasUnknownAudience() (ok bool) {
-	return t.audience != nil && t.audience[0].unknown_ != nil
+	return t.present_[0]&(1<<11) != 0 && t.audience[0].unknown_ != nil
 
 }
 
@@ -1924,6 +2447,7 @@ func (t *OrderedCollectionPage) SetUnknownAudience(i interface{}) {
 	tmp := &audienceIntermediateType{}
 	tmp.unknown_ = i
 	t.audience = append(t.audience, tmp)
+	t.markPresent_(11, len(t.audience) > 0)
 
 }
 
@@ -1948,12 +2472,14 @@ func (t *OrderedCollectionPage) GetContentString(index int) (v string) {
 // AppendContentString adds to the back of content a string type
 func (t *OrderedCollectionPage) AppendContentString(v string) {
 	t.content = append(t.content, &contentIntermediateType{stringName: &v})
+	t.markPresent_(12, len(t.content) > 0)
 
 }
 
 // PrependContentString adds to the front of content a string type
 func (t *OrderedCollectionPage) PrependContentString(v string) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{stringName: &v}}, t.content...)
+	t.markPresent_(12, len(t.content) > 0)
 
 }
 
@@ -1962,6 +2488,22 @@ func (t *OrderedCollectionPage) RemoveContentString(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(12, len(t.content) > 0)
+
+}
+
+// ContentStringValues returns an iterator over the values that GetContentString returns for each index where IsContentString is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ContentStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentString(i) {
+				continue
+			}
+			if !yield(t.GetContentString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -1980,12 +2522,14 @@ func (t *OrderedCollectionPage) GetContentLangString(index int) (v string) {
 // AppendContentLangString adds to the back of content a string type
 func (t *OrderedCollectionPage) AppendContentLangString(v string) {
 	t.content = append(t.content, &contentIntermediateType{langString: &v})
+	t.markPresent_(12, len(t.content) > 0)
 
 }
 
 // PrependContentLangString adds to the front of content a string type
 func (t *OrderedCollectionPage) PrependContentLangString(v string) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{langString: &v}}, t.content...)
+	t.markPresent_(12, len(t.content) > 0)
 
 }
 
@@ -1994,6 +2538,22 @@ func (t *OrderedCollectionPage) RemoveContentLangString(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(12, len(t.content) > 0)
+
+}
+
+// ContentLangStringValues returns an iterator over the values that GetContentLangString returns for each index where IsContentLangString is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ContentLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentLangString(i) {
+				continue
+			}
+			if !yield(t.GetContentLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2012,12 +2572,14 @@ func (t *OrderedCollectionPage) GetContentIRI(index int) (v *url.URL) {
 // AppendContentIRI adds to the back of content a *url.URL type
 func (t *OrderedCollectionPage) AppendContentIRI(v *url.URL) {
 	t.content = append(t.content, &contentIntermediateType{IRI: v})
+	t.markPresent_(12, len(t.content) > 0)
 
 }
 
 // PrependContentIRI adds to the front of content a *url.URL type
 func (t *OrderedCollectionPage) PrependContentIRI(v *url.URL) {
 	t.content = append([]*contentIntermediateType{&contentIntermediateType{IRI: v}}, t.content...)
+	t.markPresent_(12, len(t.content) > 0)
 
 }
 
@@ -2026,12 +2588,28 @@ func (t *OrderedCollectionPage) RemoveContentIRI(index int) {
 	copy(t.content[index:], t.content[index+1:])
 	t.content[len(t.content)-1] = nil
 	t.content = t.content[:len(t.content)-1]
+	t.markPresent_(12, len(t.content) > 0)
+
+}
+
+// ContentIRIValues returns an iterator over the values that GetContentIRI returns for each index where IsContentIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ContentIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContentLen(); i++ {
+			if !t.IsContentIRI(i) {
+				continue
+			}
+			if !yield(t.GetContentIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownContent determines whether the call to GetUnknownContent is safe
 func (t *OrderedCollectionPage) HasUnknownContent() (ok bool) {
-	return t.content != nil && t.content[0].unknown_ != nil
+	return t.present_[0]&(1<<12) != 0 && t.content[0].unknown_ != nil
 
 }
 
@@ -2049,6 +2627,7 @@ func (t *OrderedCollectionPage) SetUnknownContent(i interface{}) {
 	tmp := &contentIntermediateType{}
 	tmp.unknown_ = i
 	t.content = append(t.content, tmp)
+	t.markPresent_(12, len(t.content) > 0)
 
 }
 
@@ -2082,11 +2661,41 @@ func (t *OrderedCollectionPage) GetContentMap(l string) (v string) {
 func (t *OrderedCollectionPage) SetContentMap(l string, v string) {
 	if t.contentMap == nil {
 		t.contentMap = make(map[string]string)
+		t.markPresent_(13, true)
 	}
 	t.contentMap[l] = v
 
 }
 
+// GetContentLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *OrderedCollectionPage) GetContentLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.contentMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.contentMap[k], true
+
+}
+
+// SetContentLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *OrderedCollectionPage) SetContentLanguage(tag string, v string) {
+	if t.contentMap == nil {
+		t.contentMap = make(map[string]string)
+		t.markPresent_(13, true)
+	} else if k, ok := languageKey(t.contentMap, tag); ok {
+		delete(t.contentMap, k)
+	}
+	t.contentMap[tag] = v
+
+}
+
+// PreferredContentLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *OrderedCollectionPage) PreferredContentLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.contentMap, tags)
+	return t.contentMap[tag], tag
+
+}
+
 // ContextLen determines the number of elements able to be used for the IsContextObject, GetContextObject, and RemoveContextObject functions
 func (t *OrderedCollectionPage) ContextLen() (l int) {
 	return len(t.context)
@@ -2108,12 +2717,14 @@ func (t *OrderedCollectionPage) GetContextObject(index int) (v ObjectType) {
 // AppendContextObject adds to the back of context a ObjectType type
 func (t *OrderedCollectionPage) AppendContextObject(v ObjectType) {
 	t.context = append(t.context, &contextIntermediateType{Object: v})
+	t.markPresent_(14, len(t.context) > 0)
 
 }
 
 // PrependContextObject adds to the front of context a ObjectType type
 func (t *OrderedCollectionPage) PrependContextObject(v ObjectType) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{Object: v}}, t.context...)
+	t.markPresent_(14, len(t.context) > 0)
 
 }
 
@@ -2122,6 +2733,22 @@ func (t *OrderedCollectionPage) RemoveContextObject(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(14, len(t.context) > 0)
+
+}
+
+// ContextObjectValues returns an iterator over the values that GetContextObject returns for each index where IsContextObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ContextObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextObject(i) {
+				continue
+			}
+			if !yield(t.GetContextObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2140,12 +2767,14 @@ func (t *OrderedCollectionPage) GetContextLink(index int) (v LinkType) {
 // AppendContextLink adds to the back of context a LinkType type
 func (t *OrderedCollectionPage) AppendContextLink(v LinkType) {
 	t.context = append(t.context, &contextIntermediateType{Link: v})
+	t.markPresent_(14, len(t.context) > 0)
 
 }
 
 // PrependContextLink adds to the front of context a LinkType type
 func (t *OrderedCollectionPage) PrependContextLink(v LinkType) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{Link: v}}, t.context...)
+	t.markPresent_(14, len(t.context) > 0)
 
 }
 
@@ -2154,6 +2783,22 @@ func (t *OrderedCollectionPage) RemoveContextLink(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(14, len(t.context) > 0)
+
+}
+
+// ContextLinkValues returns an iterator over the values that GetContextLink returns for each index where IsContextLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ContextLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextLink(i) {
+				continue
+			}
+			if !yield(t.GetContextLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2172,12 +2817,14 @@ func (t *OrderedCollectionPage) GetContextIRI(index int) (v *url.URL) {
 // AppendContextIRI adds to the back of context a *url.URL type
 func (t *OrderedCollectionPage) AppendContextIRI(v *url.URL) {
 	t.context = append(t.context, &contextIntermediateType{IRI: v})
+	t.markPresent_(14, len(t.context) > 0)
 
 }
 
 // PrependContextIRI adds to the front of context a *url.URL type
 func (t *OrderedCollectionPage) PrependContextIRI(v *url.URL) {
 	t.context = append([]*contextIntermediateType{&contextIntermediateType{IRI: v}}, t.context...)
+	t.markPresent_(14, len(t.context) > 0)
 
 }
 
@@ -2186,12 +2833,28 @@ func (t *OrderedCollectionPage) RemoveContextIRI(index int) {
 	copy(t.context[index:], t.context[index+1:])
 	t.context[len(t.context)-1] = nil
 	t.context = t.context[:len(t.context)-1]
+	t.markPresent_(14, len(t.context) > 0)
+
+}
+
+// ContextIRIValues returns an iterator over the values that GetContextIRI returns for each index where IsContextIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ContextIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ContextLen(); i++ {
+			if !t.IsContextIRI(i) {
+				continue
+			}
+			if !yield(t.GetContextIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownContext determines whether the call to GetUnknownContext is safe
 func (t *OrderedCollectionPage) HasUnknownContext() (ok bool) {
-	return t.context != nil && t.context[0].unknown_ != nil
+	return t.present_[0]&(1<<14) != 0 && t.context[0].unknown_ != nil
 
 }
 
@@ -2209,6 +2872,7 @@ func (t *OrderedCollectionPage) SetUnknownContext(i interface{}) {
 	tmp := &contextIntermediateType{}
 	tmp.unknown_ = i
 	t.context = append(t.context, tmp)
+	t.markPresent_(14, len(t.context) > 0)
 
 }
 
@@ -2233,12 +2897,14 @@ func (t *OrderedCollectionPage) GetNameString(index int) (v string) {
 // AppendNameString adds to the back of name a string type
 func (t *OrderedCollectionPage) AppendNameString(v string) {
 	t.name = append(t.name, &nameIntermediateType{stringName: &v})
+	t.markPresent_(15, len(t.name) > 0)
 
 }
 
 // PrependNameString adds to the front of name a string type
 func (t *OrderedCollectionPage) PrependNameString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{stringName: &v}}, t.name...)
+	t.markPresent_(15, len(t.name) > 0)
 
 }
 
@@ -2247,6 +2913,22 @@ func (t *OrderedCollectionPage) RemoveNameString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(15, len(t.name) > 0)
+
+}
+
+// NameStringValues returns an iterator over the values that GetNameString returns for each index where IsNameString is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) NameStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameString(i) {
+				continue
+			}
+			if !yield(t.GetNameString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2265,12 +2947,14 @@ func (t *OrderedCollectionPage) GetNameLangString(index int) (v string) {
 // AppendNameLangString adds to the back of name a string type
 func (t *OrderedCollectionPage) AppendNameLangString(v string) {
 	t.name = append(t.name, &nameIntermediateType{langString: &v})
+	t.markPresent_(15, len(t.name) > 0)
 
 }
 
 // PrependNameLangString adds to the front of name a string type
 func (t *OrderedCollectionPage) PrependNameLangString(v string) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{langString: &v}}, t.name...)
+	t.markPresent_(15, len(t.name) > 0)
 
 }
 
@@ -2279,6 +2963,22 @@ func (t *OrderedCollectionPage) RemoveNameLangString(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(15, len(t.name) > 0)
+
+}
+
+// NameLangStringValues returns an iterator over the values that GetNameLangString returns for each index where IsNameLangString is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) NameLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameLangString(i) {
+				continue
+			}
+			if !yield(t.GetNameLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2297,12 +2997,14 @@ func (t *OrderedCollectionPage) GetNameIRI(index int) (v *url.URL) {
 // AppendNameIRI adds to the back of name a *url.URL type
 func (t *OrderedCollectionPage) AppendNameIRI(v *url.URL) {
 	t.name = append(t.name, &nameIntermediateType{IRI: v})
+	t.markPresent_(15, len(t.name) > 0)
 
 }
 
 // PrependNameIRI adds to the front of name a *url.URL type
 func (t *OrderedCollectionPage) PrependNameIRI(v *url.URL) {
 	t.name = append([]*nameIntermediateType{&nameIntermediateType{IRI: v}}, t.name...)
+	t.markPresent_(15, len(t.name) > 0)
 
 }
 
@@ -2311,12 +3013,28 @@ func (t *OrderedCollectionPage) RemoveNameIRI(index int) {
 	copy(t.name[index:], t.name[index+1:])
 	t.name[len(t.name)-1] = nil
 	t.name = t.name[:len(t.name)-1]
+	t.markPresent_(15, len(t.name) > 0)
+
+}
+
+// NameIRIValues returns an iterator over the values that GetNameIRI returns for each index where IsNameIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) NameIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.NameLen(); i++ {
+			if !t.IsNameIRI(i) {
+				continue
+			}
+			if !yield(t.GetNameIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownName determines whether the call to GetUnknownName is safe
 func (t *OrderedCollectionPage) HasUnknownName() (ok bool) {
-	return t.name != nil && t.name[0].unknown_ != nil
+	return t.present_[0]&(1<<15) != 0 && t.name[0].unknown_ != nil
 
 }
 
@@ -2334,6 +3052,7 @@ func (t *OrderedCollectionPage) SetUnknownName(i interface{}) {
 	tmp := &nameIntermediateType{}
 	tmp.unknown_ = i
 	t.name = append(t.name, tmp)
+	t.markPresent_(15, len(t.name) > 0)
 
 }
 
@@ -2367,14 +3086,44 @@ func (t *OrderedCollectionPage) GetNameMap(l string) (v string) {
 func (t *OrderedCollectionPage) SetNameMap(l string, v string) {
 	if t.nameMap == nil {
 		t.nameMap = make(map[string]string)
+		t.markPresent_(16, true)
 	}
 	t.nameMap[l] = v
 
 }
 
+// GetNameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *OrderedCollectionPage) GetNameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.nameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.nameMap[k], true
+
+}
+
+// SetNameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *OrderedCollectionPage) SetNameLanguage(tag string, v string) {
+	if t.nameMap == nil {
+		t.nameMap = make(map[string]string)
+		t.markPresent_(16, true)
+	} else if k, ok := languageKey(t.nameMap, tag); ok {
+		delete(t.nameMap, k)
+	}
+	t.nameMap[tag] = v
+
+}
+
+// PreferredNameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *OrderedCollectionPage) PreferredNameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.nameMap, tags)
+	return t.nameMap[tag], tag
+
+}
+
 // IsEndTime determines whether the call to GetEndTime is safe
 func (t *OrderedCollectionPage) IsEndTime() (ok bool) {
-	return t.endTime != nil && t.endTime.dateTime != nil
+	return t.present_[0]&(1<<17) != 0 && t.endTime.dateTime != nil
 
 }
 
@@ -2387,12 +3136,13 @@ func (t *OrderedCollectionPage) GetEndTime() (v time.Time) {
 // SetEndTime sets the value of endTime to be of time.Time type
 func (t *OrderedCollectionPage) SetEndTime(v time.Time) {
 	t.endTime = &endTimeIntermediateType{dateTime: &v}
+	t.markPresent_(17, true)
 
 }
 
 // IsEndTimeIRI determines whether the call to GetEndTimeIRI is safe
 func (t *OrderedCollectionPage) IsEndTimeIRI() (ok bool) {
-	return t.endTime != nil && t.endTime.IRI != nil
+	return t.present_[0]&(1<<17) != 0 && t.endTime.IRI != nil
 
 }
 
@@ -2405,12 +3155,13 @@ func (t *OrderedCollectionPage) GetEndTimeIRI() (v *url.URL) {
 // SetEndTimeIRI sets the value of endTime to be of *url.URL type
 func (t *OrderedCollectionPage) SetEndTimeIRI(v *url.URL) {
 	t.endTime = &endTimeIntermediateType{IRI: v}
+	t.markPresent_(17, true)
 
 }
 
 // HasUnknownEndTime determines whether the call to GetUnknownEndTime is safe
 func (t *OrderedCollectionPage) HasUnknownEndTime() (ok bool) {
-	return t.endTime != nil && t.endTime.unknown_ != nil
+	return t.present_[0]&(1<<17) != 0 && t.endTime.unknown_ != nil
 
 }
 
@@ -2428,6 +3179,7 @@ func (t *OrderedCollectionPage) SetUnknownEndTime(i interface{}) {
 	tmp := &endTimeIntermediateType{}
 	tmp.unknown_ = i
 	t.endTime = tmp
+	t.markPresent_(17, true)
 
 }
 
@@ -2452,12 +3204,14 @@ func (t *OrderedCollectionPage) GetGeneratorObject(index int) (v ObjectType) {
 // AppendGeneratorObject adds to the back of generator a ObjectType type
 func (t *OrderedCollectionPage) AppendGeneratorObject(v ObjectType) {
 	t.generator = append(t.generator, &generatorIntermediateType{Object: v})
+	t.markPresent_(18, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorObject adds to the front of generator a ObjectType type
 func (t *OrderedCollectionPage) PrependGeneratorObject(v ObjectType) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Object: v}}, t.generator...)
+	t.markPresent_(18, len(t.generator) > 0)
 
 }
 
@@ -2466,6 +3220,22 @@ func (t *OrderedCollectionPage) RemoveGeneratorObject(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(18, len(t.generator) > 0)
+
+}
+
+// GeneratorObjectValues returns an iterator over the values that GetGeneratorObject returns for each index where IsGeneratorObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) GeneratorObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorObject(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2484,12 +3254,14 @@ func (t *OrderedCollectionPage) GetGeneratorLink(index int) (v LinkType) {
 // AppendGeneratorLink adds to the back of generator a LinkType type
 func (t *OrderedCollectionPage) AppendGeneratorLink(v LinkType) {
 	t.generator = append(t.generator, &generatorIntermediateType{Link: v})
+	t.markPresent_(18, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorLink adds to the front of generator a LinkType type
 func (t *OrderedCollectionPage) PrependGeneratorLink(v LinkType) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{Link: v}}, t.generator...)
+	t.markPresent_(18, len(t.generator) > 0)
 
 }
 
@@ -2498,6 +3270,22 @@ func (t *OrderedCollectionPage) RemoveGeneratorLink(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(18, len(t.generator) > 0)
+
+}
+
+// GeneratorLinkValues returns an iterator over the values that GetGeneratorLink returns for each index where IsGeneratorLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) GeneratorLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorLink(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2516,12 +3304,14 @@ func (t *OrderedCollectionPage) GetGeneratorIRI(index int) (v *url.URL) {
 // AppendGeneratorIRI adds to the back of generator a *url.URL type
 func (t *OrderedCollectionPage) AppendGeneratorIRI(v *url.URL) {
 	t.generator = append(t.generator, &generatorIntermediateType{IRI: v})
+	t.markPresent_(18, len(t.generator) > 0)
 
 }
 
 // PrependGeneratorIRI adds to the front of generator a *url.URL type
 func (t *OrderedCollectionPage) PrependGeneratorIRI(v *url.URL) {
 	t.generator = append([]*generatorIntermediateType{&generatorIntermediateType{IRI: v}}, t.generator...)
+	t.markPresent_(18, len(t.generator) > 0)
 
 }
 
@@ -2530,12 +3320,28 @@ func (t *OrderedCollectionPage) RemoveGeneratorIRI(index int) {
 	copy(t.generator[index:], t.generator[index+1:])
 	t.generator[len(t.generator)-1] = nil
 	t.generator = t.generator[:len(t.generator)-1]
+	t.markPresent_(18, len(t.generator) > 0)
+
+}
+
+// GeneratorIRIValues returns an iterator over the values that GetGeneratorIRI returns for each index where IsGeneratorIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) GeneratorIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.GeneratorLen(); i++ {
+			if !t.IsGeneratorIRI(i) {
+				continue
+			}
+			if !yield(t.GetGeneratorIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownGenerator determines whether the call to GetUnknownGenerator is safe
 func (t *OrderedCollectionPage) HasUnknownGenerator() (ok bool) {
-	return t.generator != nil && t.generator[0].unknown_ != nil
+	return t.present_[0]&(1<<18) != 0 && t.generator[0].unknown_ != nil
 
 }
 
@@ -2553,6 +3359,7 @@ func (t *OrderedCollectionPage) SetUnknownGenerator(i interface{}) {
 	tmp := &generatorIntermediateType{}
 	tmp.unknown_ = i
 	t.generator = append(t.generator, tmp)
+	t.markPresent_(18, len(t.generator) > 0)
 
 }
 
@@ -2577,12 +3384,14 @@ func (t *OrderedCollectionPage) GetIconImage(index int) (v ImageType) {
 // AppendIconImage adds to the back of icon a ImageType type
 func (t *OrderedCollectionPage) AppendIconImage(v ImageType) {
 	t.icon = append(t.icon, &iconIntermediateType{Image: v})
+	t.markPresent_(19, len(t.icon) > 0)
 
 }
 
 // PrependIconImage adds to the front of icon a ImageType type
 func (t *OrderedCollectionPage) PrependIconImage(v ImageType) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Image: v}}, t.icon...)
+	t.markPresent_(19, len(t.icon) > 0)
 
 }
 
@@ -2591,6 +3400,22 @@ func (t *OrderedCollectionPage) RemoveIconImage(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(19, len(t.icon) > 0)
+
+}
+
+// IconImageValues returns an iterator over the values that GetIconImage returns for each index where IsIconImage is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) IconImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconImage(i) {
+				continue
+			}
+			if !yield(t.GetIconImage(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2609,12 +3434,14 @@ func (t *OrderedCollectionPage) GetIconLink(index int) (v LinkType) {
 // AppendIconLink adds to the back of icon a LinkType type
 func (t *OrderedCollectionPage) AppendIconLink(v LinkType) {
 	t.icon = append(t.icon, &iconIntermediateType{Link: v})
+	t.markPresent_(19, len(t.icon) > 0)
 
 }
 
 // PrependIconLink adds to the front of icon a LinkType type
 func (t *OrderedCollectionPage) PrependIconLink(v LinkType) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{Link: v}}, t.icon...)
+	t.markPresent_(19, len(t.icon) > 0)
 
 }
 
@@ -2623,6 +3450,22 @@ func (t *OrderedCollectionPage) RemoveIconLink(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(19, len(t.icon) > 0)
+
+}
+
+// IconLinkValues returns an iterator over the values that GetIconLink returns for each index where IsIconLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) IconLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconLink(i) {
+				continue
+			}
+			if !yield(t.GetIconLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2641,12 +3484,14 @@ func (t *OrderedCollectionPage) GetIconIRI(index int) (v *url.URL) {
 // AppendIconIRI adds to the back of icon a *url.URL type
 func (t *OrderedCollectionPage) AppendIconIRI(v *url.URL) {
 	t.icon = append(t.icon, &iconIntermediateType{IRI: v})
+	t.markPresent_(19, len(t.icon) > 0)
 
 }
 
 // PrependIconIRI adds to the front of icon a *url.URL type
 func (t *OrderedCollectionPage) PrependIconIRI(v *url.URL) {
 	t.icon = append([]*iconIntermediateType{&iconIntermediateType{IRI: v}}, t.icon...)
+	t.markPresent_(19, len(t.icon) > 0)
 
 }
 
@@ -2655,12 +3500,28 @@ func (t *OrderedCollectionPage) RemoveIconIRI(index int) {
 	copy(t.icon[index:], t.icon[index+1:])
 	t.icon[len(t.icon)-1] = nil
 	t.icon = t.icon[:len(t.icon)-1]
+	t.markPresent_(19, len(t.icon) > 0)
+
+}
+
+// IconIRIValues returns an iterator over the values that GetIconIRI returns for each index where IsIconIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) IconIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.IconLen(); i++ {
+			if !t.IsIconIRI(i) {
+				continue
+			}
+			if !yield(t.GetIconIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownIcon determines whether the call to GetUnknownIcon is safe
 func (t *OrderedCollectionPage) HasUnknownIcon() (ok bool) {
-	return t.icon != nil && t.icon[0].unknown_ != nil
+	return t.present_[0]&(1<<19) != 0 && t.icon[0].unknown_ != nil
 
 }
 
@@ -2678,12 +3539,13 @@ func (t *OrderedCollectionPage) SetUnknownIcon(i interface{}) {
 	tmp := &iconIntermediateType{}
 	tmp.unknown_ = i
 	t.icon = append(t.icon, tmp)
+	t.markPresent_(19, len(t.icon) > 0)
 
 }
 
 // HasId determines whether the call to GetId is safe
 func (t *OrderedCollectionPage) HasId() (ok bool) {
-	return t.id != nil
+	return t.present_[0]&(1<<20) != 0
 
 }
 
@@ -2696,6 +3558,7 @@ func (t *OrderedCollectionPage) GetId() (v *url.URL) {
 // SetId sets the value of id
 func (t *OrderedCollectionPage) SetId(v *url.URL) {
 	t.id = v
+	t.markPresent_(20, true)
 
 }
 
@@ -2741,12 +3604,14 @@ func (t *OrderedCollectionPage) GetImageImage(index int) (v ImageType) {
 // AppendImageImage adds to the back of image a ImageType type
 func (t *OrderedCollectionPage) AppendImageImage(v ImageType) {
 	t.image = append(t.image, &imageIntermediateType{Image: v})
+	t.markPresent_(21, len(t.image) > 0)
 
 }
 
 // PrependImageImage adds to the front of image a ImageType type
 func (t *OrderedCollectionPage) PrependImageImage(v ImageType) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{Image: v}}, t.image...)
+	t.markPresent_(21, len(t.image) > 0)
 
 }
 
@@ -2755,6 +3620,22 @@ func (t *OrderedCollectionPage) RemoveImageImage(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(21, len(t.image) > 0)
+
+}
+
+// ImageImageValues returns an iterator over the values that GetImageImage returns for each index where IsImageImage is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ImageImageValues() (seq func(yield func(v ImageType) bool)) {
+	return func(yield func(v ImageType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageImage(i) {
+				continue
+			}
+			if !yield(t.GetImageImage(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2773,12 +3654,14 @@ func (t *OrderedCollectionPage) GetImageLink(index int) (v LinkType) {
 // AppendImageLink adds to the back of image a LinkType type
 func (t *OrderedCollectionPage) AppendImageLink(v LinkType) {
 	t.image = append(t.image, &imageIntermediateType{Link: v})
+	t.markPresent_(21, len(t.image) > 0)
 
 }
 
 // PrependImageLink adds to the front of image a LinkType type
 func (t *OrderedCollectionPage) PrependImageLink(v LinkType) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{Link: v}}, t.image...)
+	t.markPresent_(21, len(t.image) > 0)
 
 }
 
@@ -2787,6 +3670,22 @@ func (t *OrderedCollectionPage) RemoveImageLink(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(21, len(t.image) > 0)
+
+}
+
+// ImageLinkValues returns an iterator over the values that GetImageLink returns for each index where IsImageLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ImageLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageLink(i) {
+				continue
+			}
+			if !yield(t.GetImageLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2805,12 +3704,14 @@ func (t *OrderedCollectionPage) GetImageIRI(index int) (v *url.URL) {
 // AppendImageIRI adds to the back of image a *url.URL type
 func (t *OrderedCollectionPage) AppendImageIRI(v *url.URL) {
 	t.image = append(t.image, &imageIntermediateType{IRI: v})
+	t.markPresent_(21, len(t.image) > 0)
 
 }
 
 // PrependImageIRI adds to the front of image a *url.URL type
 func (t *OrderedCollectionPage) PrependImageIRI(v *url.URL) {
 	t.image = append([]*imageIntermediateType{&imageIntermediateType{IRI: v}}, t.image...)
+	t.markPresent_(21, len(t.image) > 0)
 
 }
 
@@ -2819,12 +3720,28 @@ func (t *OrderedCollectionPage) RemoveImageIRI(index int) {
 	copy(t.image[index:], t.image[index+1:])
 	t.image[len(t.image)-1] = nil
 	t.image = t.image[:len(t.image)-1]
+	t.markPresent_(21, len(t.image) > 0)
+
+}
+
+// ImageIRIValues returns an iterator over the values that GetImageIRI returns for each index where IsImageIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ImageIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ImageLen(); i++ {
+			if !t.IsImageIRI(i) {
+				continue
+			}
+			if !yield(t.GetImageIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownImage determines whether the call to GetUnknownImage is safe
 func (t *OrderedCollectionPage) HasUnknownImage() (ok bool) {
-	return t.image != nil && t.image[0].unknown_ != nil
+	return t.present_[0]&(1<<21) != 0 && t.image[0].unknown_ != nil
 
 }
 
@@ -2842,6 +3759,7 @@ func (t *OrderedCollectionPage) SetUnknownImage(i interface{}) {
 	tmp := &imageIntermediateType{}
 	tmp.unknown_ = i
 	t.image = append(t.image, tmp)
+	t.markPresent_(21, len(t.image) > 0)
 
 }
 
@@ -2866,12 +3784,14 @@ func (t *OrderedCollectionPage) GetInReplyToObject(index int) (v ObjectType) {
 // AppendInReplyToObject adds to the back of inReplyTo a ObjectType type
 func (t *OrderedCollectionPage) AppendInReplyToObject(v ObjectType) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Object: v})
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToObject adds to the front of inReplyTo a ObjectType type
 func (t *OrderedCollectionPage) PrependInReplyToObject(v ObjectType) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Object: v}}, t.inReplyTo...)
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
@@ -2880,6 +3800,22 @@ func (t *OrderedCollectionPage) RemoveInReplyToObject(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(22, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToObjectValues returns an iterator over the values that GetInReplyToObject returns for each index where IsInReplyToObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) InReplyToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToObject(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2898,12 +3834,14 @@ func (t *OrderedCollectionPage) GetInReplyToLink(index int) (v LinkType) {
 // AppendInReplyToLink adds to the back of inReplyTo a LinkType type
 func (t *OrderedCollectionPage) AppendInReplyToLink(v LinkType) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{Link: v})
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToLink adds to the front of inReplyTo a LinkType type
 func (t *OrderedCollectionPage) PrependInReplyToLink(v LinkType) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{Link: v}}, t.inReplyTo...)
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
@@ -2912,6 +3850,22 @@ func (t *OrderedCollectionPage) RemoveInReplyToLink(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(22, len(t.inReplyTo) > 0)
+
+}
+
+// InReplyToLinkValues returns an iterator over the values that GetInReplyToLink returns for each index where IsInReplyToLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) InReplyToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToLink(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -2930,12 +3884,14 @@ func (t *OrderedCollectionPage) GetInReplyToIRI(index int) (v *url.URL) {
 // AppendInReplyToIRI adds to the back of inReplyTo a *url.URL type
 func (t *OrderedCollectionPage) AppendInReplyToIRI(v *url.URL) {
 	t.inReplyTo = append(t.inReplyTo, &inReplyToIntermediateType{IRI: v})
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
 // PrependInReplyToIRI adds to the front of inReplyTo a *url.URL type
 func (t *OrderedCollectionPage) PrependInReplyToIRI(v *url.URL) {
 	t.inReplyTo = append([]*inReplyToIntermediateType{&inReplyToIntermediateType{IRI: v}}, t.inReplyTo...)
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
@@ -2944,13 +3900,29 @@ func (t *OrderedCollectionPage) RemoveInReplyToIRI(index int) {
 	copy(t.inReplyTo[index:], t.inReplyTo[index+1:])
 	t.inReplyTo[len(t.inReplyTo)-1] = nil
 	t.inReplyTo = t.inReplyTo[:len(t.inReplyTo)-1]
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
-// HasUnknownInReplyTo determines whether the call to GetUnknownInReplyTo is safe
-func (t *OrderedCollectionPage) HasUnknownInReplyTo() (ok bool) {
-	return t.inReplyTo != nil && t.inReplyTo[0].unknown_ != nil
-
+// InReplyToIRIValues returns an iterator over the values that GetInReplyToIRI returns for each index where IsInReplyToIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) InReplyToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.InReplyToLen(); i++ {
+			if !t.IsInReplyToIRI(i) {
+				continue
+			}
+			if !yield(t.GetInReplyToIRI(i)) {
+				return
+			}
+		}
+	}
+
+}
+
+// HasUnknownInReplyTo determines whether the call to GetUnknownInReplyTo is safe
+func (t *OrderedCollectionPage) HasUnknownInReplyTo() (ok bool) {
+	return t.present_[0]&(1<<22) != 0 && t.inReplyTo[0].unknown_ != nil
+
 }
 
 // GetUnknownInReplyTo returns the unknown value for inReplyTo
@@ -2967,6 +3939,7 @@ func (t *OrderedCollectionPage) SetUnknownInReplyTo(i interface{}) {
 	tmp := &inReplyToIntermediateType{}
 	tmp.unknown_ = i
 	t.inReplyTo = append(t.inReplyTo, tmp)
+	t.markPresent_(22, len(t.inReplyTo) > 0)
 
 }
 
@@ -2991,12 +3964,14 @@ func (t *OrderedCollectionPage) GetLocationObject(index int) (v ObjectType) {
 // AppendLocationObject adds to the back of location a ObjectType type
 func (t *OrderedCollectionPage) AppendLocationObject(v ObjectType) {
 	t.location = append(t.location, &locationIntermediateType{Object: v})
+	t.markPresent_(23, len(t.location) > 0)
 
 }
 
 // PrependLocationObject adds to the front of location a ObjectType type
 func (t *OrderedCollectionPage) PrependLocationObject(v ObjectType) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{Object: v}}, t.location...)
+	t.markPresent_(23, len(t.location) > 0)
 
 }
 
@@ -3005,6 +3980,22 @@ func (t *OrderedCollectionPage) RemoveLocationObject(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(23, len(t.location) > 0)
+
+}
+
+// LocationObjectValues returns an iterator over the values that GetLocationObject returns for each index where IsLocationObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) LocationObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationObject(i) {
+				continue
+			}
+			if !yield(t.GetLocationObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3023,12 +4014,14 @@ func (t *OrderedCollectionPage) GetLocationLink(index int) (v LinkType) {
 // AppendLocationLink adds to the back of location a LinkType type
 func (t *OrderedCollectionPage) AppendLocationLink(v LinkType) {
 	t.location = append(t.location, &locationIntermediateType{Link: v})
+	t.markPresent_(23, len(t.location) > 0)
 
 }
 
 // PrependLocationLink adds to the front of location a LinkType type
 func (t *OrderedCollectionPage) PrependLocationLink(v LinkType) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{Link: v}}, t.location...)
+	t.markPresent_(23, len(t.location) > 0)
 
 }
 
@@ -3037,6 +4030,22 @@ func (t *OrderedCollectionPage) RemoveLocationLink(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(23, len(t.location) > 0)
+
+}
+
+// LocationLinkValues returns an iterator over the values that GetLocationLink returns for each index where IsLocationLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) LocationLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationLink(i) {
+				continue
+			}
+			if !yield(t.GetLocationLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3055,12 +4064,14 @@ func (t *OrderedCollectionPage) GetLocationIRI(index int) (v *url.URL) {
 // AppendLocationIRI adds to the back of location a *url.URL type
 func (t *OrderedCollectionPage) AppendLocationIRI(v *url.URL) {
 	t.location = append(t.location, &locationIntermediateType{IRI: v})
+	t.markPresent_(23, len(t.location) > 0)
 
 }
 
 // PrependLocationIRI adds to the front of location a *url.URL type
 func (t *OrderedCollectionPage) PrependLocationIRI(v *url.URL) {
 	t.location = append([]*locationIntermediateType{&locationIntermediateType{IRI: v}}, t.location...)
+	t.markPresent_(23, len(t.location) > 0)
 
 }
 
@@ -3069,12 +4080,28 @@ func (t *OrderedCollectionPage) RemoveLocationIRI(index int) {
 	copy(t.location[index:], t.location[index+1:])
 	t.location[len(t.location)-1] = nil
 	t.location = t.location[:len(t.location)-1]
+	t.markPresent_(23, len(t.location) > 0)
+
+}
+
+// LocationIRIValues returns an iterator over the values that GetLocationIRI returns for each index where IsLocationIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) LocationIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.LocationLen(); i++ {
+			if !t.IsLocationIRI(i) {
+				continue
+			}
+			if !yield(t.GetLocationIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownLocation determines whether the call to GetUnknownLocation is safe
 func (t *OrderedCollectionPage) HasUnknownLocation() (ok bool) {
-	return t.location != nil && t.location[0].unknown_ != nil
+	return t.present_[0]&(1<<23) != 0 && t.location[0].unknown_ != nil
 
 }
 
@@ -3092,6 +4119,7 @@ func (t *OrderedCollectionPage) SetUnknownLocation(i interface{}) {
 	tmp := &locationIntermediateType{}
 	tmp.unknown_ = i
 	t.location = append(t.location, tmp)
+	t.markPresent_(23, len(t.location) > 0)
 
 }
 
@@ -3116,12 +4144,14 @@ func (t *OrderedCollectionPage) GetPreviewObject(index int) (v ObjectType) {
 // AppendPreviewObject adds to the back of preview a ObjectType type
 func (t *OrderedCollectionPage) AppendPreviewObject(v ObjectType) {
 	t.preview = append(t.preview, &previewIntermediateType{Object: v})
+	t.markPresent_(24, len(t.preview) > 0)
 
 }
 
 // PrependPreviewObject adds to the front of preview a ObjectType type
 func (t *OrderedCollectionPage) PrependPreviewObject(v ObjectType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Object: v}}, t.preview...)
+	t.markPresent_(24, len(t.preview) > 0)
 
 }
 
@@ -3130,6 +4160,22 @@ func (t *OrderedCollectionPage) RemovePreviewObject(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(24, len(t.preview) > 0)
+
+}
+
+// PreviewObjectValues returns an iterator over the values that GetPreviewObject returns for each index where IsPreviewObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) PreviewObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewObject(i) {
+				continue
+			}
+			if !yield(t.GetPreviewObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3148,12 +4194,14 @@ func (t *OrderedCollectionPage) GetPreviewLink(index int) (v LinkType) {
 // AppendPreviewLink adds to the back of preview a LinkType type
 func (t *OrderedCollectionPage) AppendPreviewLink(v LinkType) {
 	t.preview = append(t.preview, &previewIntermediateType{Link: v})
+	t.markPresent_(24, len(t.preview) > 0)
 
 }
 
 // PrependPreviewLink adds to the front of preview a LinkType type
 func (t *OrderedCollectionPage) PrependPreviewLink(v LinkType) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{Link: v}}, t.preview...)
+	t.markPresent_(24, len(t.preview) > 0)
 
 }
 
@@ -3162,6 +4210,22 @@ func (t *OrderedCollectionPage) RemovePreviewLink(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(24, len(t.preview) > 0)
+
+}
+
+// PreviewLinkValues returns an iterator over the values that GetPreviewLink returns for each index where IsPreviewLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) PreviewLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewLink(i) {
+				continue
+			}
+			if !yield(t.GetPreviewLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3180,12 +4244,14 @@ func (t *OrderedCollectionPage) GetPreviewIRI(index int) (v *url.URL) {
 // AppendPreviewIRI adds to the back of preview a *url.URL type
 func (t *OrderedCollectionPage) AppendPreviewIRI(v *url.URL) {
 	t.preview = append(t.preview, &previewIntermediateType{IRI: v})
+	t.markPresent_(24, len(t.preview) > 0)
 
 }
 
 // PrependPreviewIRI adds to the front of preview a *url.URL type
 func (t *OrderedCollectionPage) PrependPreviewIRI(v *url.URL) {
 	t.preview = append([]*previewIntermediateType{&previewIntermediateType{IRI: v}}, t.preview...)
+	t.markPresent_(24, len(t.preview) > 0)
 
 }
 
@@ -3194,12 +4260,28 @@ func (t *OrderedCollectionPage) RemovePreviewIRI(index int) {
 	copy(t.preview[index:], t.preview[index+1:])
 	t.preview[len(t.preview)-1] = nil
 	t.preview = t.preview[:len(t.preview)-1]
+	t.markPresent_(24, len(t.preview) > 0)
+
+}
+
+// PreviewIRIValues returns an iterator over the values that GetPreviewIRI returns for each index where IsPreviewIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) PreviewIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.PreviewLen(); i++ {
+			if !t.IsPreviewIRI(i) {
+				continue
+			}
+			if !yield(t.GetPreviewIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownPreview determines whether the call to GetUnknownPreview is safe
 func (t *OrderedCollectionPage) HasUnknownPreview() (ok bool) {
-	return t.preview != nil && t.preview[0].unknown_ != nil
+	return t.present_[0]&(1<<24) != 0 && t.preview[0].unknown_ != nil
 
 }
 
@@ -3217,12 +4299,13 @@ func (t *OrderedCollectionPage) SetUnknownPreview(i interface{}) {
 	tmp := &previewIntermediateType{}
 	tmp.unknown_ = i
 	t.preview = append(t.preview, tmp)
+	t.markPresent_(24, len(t.preview) > 0)
 
 }
 
 // IsPublished determines whether the call to GetPublished is safe
 func (t *OrderedCollectionPage) IsPublished() (ok bool) {
-	return t.published != nil && t.published.dateTime != nil
+	return t.present_[0]&(1<<25) != 0 && t.published.dateTime != nil
 
 }
 
@@ -3235,12 +4318,13 @@ func (t *OrderedCollectionPage) GetPublished() (v time.Time) {
 // SetPublished sets the value of published to be of time.Time type
 func (t *OrderedCollectionPage) SetPublished(v time.Time) {
 	t.published = &publishedIntermediateType{dateTime: &v}
+	t.markPresent_(25, true)
 
 }
 
 // IsPublishedIRI determines whether the call to GetPublishedIRI is safe
 func (t *OrderedCollectionPage) IsPublishedIRI() (ok bool) {
-	return t.published != nil && t.published.IRI != nil
+	return t.present_[0]&(1<<25) != 0 && t.published.IRI != nil
 
 }
 
@@ -3253,12 +4337,13 @@ func (t *OrderedCollectionPage) GetPublishedIRI() (v *url.URL) {
 // SetPublishedIRI sets the value of published to be of *url.URL type
 func (t *OrderedCollectionPage) SetPublishedIRI(v *url.URL) {
 	t.published = &publishedIntermediateType{IRI: v}
+	t.markPresent_(25, true)
 
 }
 
 // HasUnknownPublished determines whether the call to GetUnknownPublished is safe
 func (t *OrderedCollectionPage) HasUnknownPublished() (ok bool) {
-	return t.published != nil && t.published.unknown_ != nil
+	return t.present_[0]&(1<<25) != 0 && t.published.unknown_ != nil
 
 }
 
@@ -3276,12 +4361,13 @@ func (t *OrderedCollectionPage) SetUnknownPublished(i interface{}) {
 	tmp := &publishedIntermediateType{}
 	tmp.unknown_ = i
 	t.published = tmp
+	t.markPresent_(25, true)
 
 }
 
 // IsReplies determines whether the call to GetReplies is safe
 func (t *OrderedCollectionPage) IsReplies() (ok bool) {
-	return t.replies != nil && t.replies.Collection != nil
+	return t.present_[0]&(1<<26) != 0 && t.replies.Collection != nil
 
 }
 
@@ -3294,12 +4380,13 @@ func (t *OrderedCollectionPage) GetReplies() (v CollectionType) {
 // SetReplies sets the value of replies to be of CollectionType type
 func (t *OrderedCollectionPage) SetReplies(v CollectionType) {
 	t.replies = &repliesIntermediateType{Collection: v}
+	t.markPresent_(26, true)
 
 }
 
 // IsRepliesIRI determines whether the call to GetRepliesIRI is safe
 func (t *OrderedCollectionPage) IsRepliesIRI() (ok bool) {
-	return t.replies != nil && t.replies.IRI != nil
+	return t.present_[0]&(1<<26) != 0 && t.replies.IRI != nil
 
 }
 
@@ -3312,12 +4399,13 @@ func (t *OrderedCollectionPage) GetRepliesIRI() (v *url.URL) {
 // SetRepliesIRI sets the value of replies to be of *url.URL type
 func (t *OrderedCollectionPage) SetRepliesIRI(v *url.URL) {
 	t.replies = &repliesIntermediateType{IRI: v}
+	t.markPresent_(26, true)
 
 }
 
 // HasUnknownReplies determines whether the call to GetUnknownReplies is safe
 func (t *OrderedCollectionPage) HasUnknownReplies() (ok bool) {
-	return t.replies != nil && t.replies.unknown_ != nil
+	return t.present_[0]&(1<<26) != 0 && t.replies.unknown_ != nil
 
 }
 
@@ -3335,12 +4423,13 @@ func (t *OrderedCollectionPage) SetUnknownReplies(i interface{}) {
 	tmp := &repliesIntermediateType{}
 	tmp.unknown_ = i
 	t.replies = tmp
+	t.markPresent_(26, true)
 
 }
 
 // IsStartTime determines whether the call to GetStartTime is safe
 func (t *OrderedCollectionPage) IsStartTime() (ok bool) {
-	return t.startTime != nil && t.startTime.dateTime != nil
+	return t.present_[0]&(1<<27) != 0 && t.startTime.dateTime != nil
 
 }
 
@@ -3353,12 +4442,13 @@ func (t *OrderedCollectionPage) GetStartTime() (v time.Time) {
 // SetStartTime sets the value of startTime to be of time.Time type
 func (t *OrderedCollectionPage) SetStartTime(v time.Time) {
 	t.startTime = &startTimeIntermediateType{dateTime: &v}
+	t.markPresent_(27, true)
 
 }
 
 // IsStartTimeIRI determines whether the call to GetStartTimeIRI is safe
 func (t *OrderedCollectionPage) IsStartTimeIRI() (ok bool) {
-	return t.startTime != nil && t.startTime.IRI != nil
+	return t.present_[0]&(1<<27) != 0 && t.startTime.IRI != nil
 
 }
 
@@ -3371,12 +4461,13 @@ func (t *OrderedCollectionPage) GetStartTimeIRI() (v *url.URL) {
 // SetStartTimeIRI sets the value of startTime to be of *url.URL type
 func (t *OrderedCollectionPage) SetStartTimeIRI(v *url.URL) {
 	t.startTime = &startTimeIntermediateType{IRI: v}
+	t.markPresent_(27, true)
 
 }
 
 // HasUnknownStartTime determines whether the call to GetUnknownStartTime is safe
 func (t *OrderedCollectionPage) HasUnknownStartTime() (ok bool) {
-	return t.startTime != nil && t.startTime.unknown_ != nil
+	return t.present_[0]&(1<<27) != 0 && t.startTime.unknown_ != nil
 
 }
 
@@ -3394,6 +4485,7 @@ func (t *OrderedCollectionPage) SetUnknownStartTime(i interface{}) {
 	tmp := &startTimeIntermediateType{}
 	tmp.unknown_ = i
 	t.startTime = tmp
+	t.markPresent_(27, true)
 
 }
 
@@ -3418,12 +4510,14 @@ func (t *OrderedCollectionPage) GetSummaryString(index int) (v string) {
 // AppendSummaryString adds to the back of summary a string type
 func (t *OrderedCollectionPage) AppendSummaryString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{stringName: &v})
+	t.markPresent_(28, len(t.summary) > 0)
 
 }
 
 // PrependSummaryString adds to the front of summary a string type
 func (t *OrderedCollectionPage) PrependSummaryString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{stringName: &v}}, t.summary...)
+	t.markPresent_(28, len(t.summary) > 0)
 
 }
 
@@ -3432,6 +4526,22 @@ func (t *OrderedCollectionPage) RemoveSummaryString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(28, len(t.summary) > 0)
+
+}
+
+// SummaryStringValues returns an iterator over the values that GetSummaryString returns for each index where IsSummaryString is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) SummaryStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3450,12 +4560,14 @@ func (t *OrderedCollectionPage) GetSummaryLangString(index int) (v string) {
 // AppendSummaryLangString adds to the back of summary a string type
 func (t *OrderedCollectionPage) AppendSummaryLangString(v string) {
 	t.summary = append(t.summary, &summaryIntermediateType{langString: &v})
+	t.markPresent_(28, len(t.summary) > 0)
 
 }
 
 // PrependSummaryLangString adds to the front of summary a string type
 func (t *OrderedCollectionPage) PrependSummaryLangString(v string) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{langString: &v}}, t.summary...)
+	t.markPresent_(28, len(t.summary) > 0)
 
 }
 
@@ -3464,6 +4576,22 @@ func (t *OrderedCollectionPage) RemoveSummaryLangString(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(28, len(t.summary) > 0)
+
+}
+
+// SummaryLangStringValues returns an iterator over the values that GetSummaryLangString returns for each index where IsSummaryLangString is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) SummaryLangStringValues() (seq func(yield func(v string) bool)) {
+	return func(yield func(v string) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryLangString(i) {
+				continue
+			}
+			if !yield(t.GetSummaryLangString(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3482,12 +4610,14 @@ func (t *OrderedCollectionPage) GetSummaryIRI(index int) (v *url.URL) {
 // AppendSummaryIRI adds to the back of summary a *url.URL type
 func (t *OrderedCollectionPage) AppendSummaryIRI(v *url.URL) {
 	t.summary = append(t.summary, &summaryIntermediateType{IRI: v})
+	t.markPresent_(28, len(t.summary) > 0)
 
 }
 
 // PrependSummaryIRI adds to the front of summary a *url.URL type
 func (t *OrderedCollectionPage) PrependSummaryIRI(v *url.URL) {
 	t.summary = append([]*summaryIntermediateType{&summaryIntermediateType{IRI: v}}, t.summary...)
+	t.markPresent_(28, len(t.summary) > 0)
 
 }
 
@@ -3496,12 +4626,28 @@ func (t *OrderedCollectionPage) RemoveSummaryIRI(index int) {
 	copy(t.summary[index:], t.summary[index+1:])
 	t.summary[len(t.summary)-1] = nil
 	t.summary = t.summary[:len(t.summary)-1]
+	t.markPresent_(28, len(t.summary) > 0)
+
+}
+
+// SummaryIRIValues returns an iterator over the values that GetSummaryIRI returns for each index where IsSummaryIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) SummaryIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.SummaryLen(); i++ {
+			if !t.IsSummaryIRI(i) {
+				continue
+			}
+			if !yield(t.GetSummaryIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownSummary determines whether the call to GetUnknownSummary is safe
 func (t *OrderedCollectionPage) HasUnknownSummary() (ok bool) {
-	return t.summary != nil && t.summary[0].unknown_ != nil
+	return t.present_[0]&(1<<28) != 0 && t.summary[0].unknown_ != nil
 
 }
 
@@ -3519,6 +4665,7 @@ func (t *OrderedCollectionPage) SetUnknownSummary(i interface{}) {
 	tmp := &summaryIntermediateType{}
 	tmp.unknown_ = i
 	t.summary = append(t.summary, tmp)
+	t.markPresent_(28, len(t.summary) > 0)
 
 }
 
@@ -3552,11 +4699,41 @@ func (t *OrderedCollectionPage) GetSummaryMap(l string) (v string) {
 func (t *OrderedCollectionPage) SetSummaryMap(l string, v string) {
 	if t.summaryMap == nil {
 		t.summaryMap = make(map[string]string)
+		t.markPresent_(29, true)
 	}
 	t.summaryMap[l] = v
 
 }
 
+// GetSummaryLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *OrderedCollectionPage) GetSummaryLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.summaryMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.summaryMap[k], true
+
+}
+
+// SetSummaryLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *OrderedCollectionPage) SetSummaryLanguage(tag string, v string) {
+	if t.summaryMap == nil {
+		t.summaryMap = make(map[string]string)
+		t.markPresent_(29, true)
+	} else if k, ok := languageKey(t.summaryMap, tag); ok {
+		delete(t.summaryMap, k)
+	}
+	t.summaryMap[tag] = v
+
+}
+
+// PreferredSummaryLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *OrderedCollectionPage) PreferredSummaryLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.summaryMap, tags)
+	return t.summaryMap[tag], tag
+
+}
+
 // TagLen determines the number of elements able to be used for the IsTagObject, GetTagObject, and RemoveTagObject functions
 func (t *OrderedCollectionPage) TagLen() (l int) {
 	return len(t.tag)
@@ -3578,12 +4755,14 @@ func (t *OrderedCollectionPage) GetTagObject(index int) (v ObjectType) {
 // AppendTagObject adds to the back of tag a ObjectType type
 func (t *OrderedCollectionPage) AppendTagObject(v ObjectType) {
 	t.tag = append(t.tag, &tagIntermediateType{Object: v})
+	t.markPresent_(30, len(t.tag) > 0)
 
 }
 
 // PrependTagObject adds to the front of tag a ObjectType type
 func (t *OrderedCollectionPage) PrependTagObject(v ObjectType) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Object: v}}, t.tag...)
+	t.markPresent_(30, len(t.tag) > 0)
 
 }
 
@@ -3592,6 +4771,22 @@ func (t *OrderedCollectionPage) RemoveTagObject(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(30, len(t.tag) > 0)
+
+}
+
+// TagObjectValues returns an iterator over the values that GetTagObject returns for each index where IsTagObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) TagObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagObject(i) {
+				continue
+			}
+			if !yield(t.GetTagObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3610,12 +4805,14 @@ func (t *OrderedCollectionPage) GetTagLink(index int) (v LinkType) {
 // AppendTagLink adds to the back of tag a LinkType type
 func (t *OrderedCollectionPage) AppendTagLink(v LinkType) {
 	t.tag = append(t.tag, &tagIntermediateType{Link: v})
+	t.markPresent_(30, len(t.tag) > 0)
 
 }
 
 // PrependTagLink adds to the front of tag a LinkType type
 func (t *OrderedCollectionPage) PrependTagLink(v LinkType) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{Link: v}}, t.tag...)
+	t.markPresent_(30, len(t.tag) > 0)
 
 }
 
@@ -3624,6 +4821,22 @@ func (t *OrderedCollectionPage) RemoveTagLink(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(30, len(t.tag) > 0)
+
+}
+
+// TagLinkValues returns an iterator over the values that GetTagLink returns for each index where IsTagLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) TagLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagLink(i) {
+				continue
+			}
+			if !yield(t.GetTagLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3642,12 +4855,14 @@ func (t *OrderedCollectionPage) GetTagIRI(index int) (v *url.URL) {
 // AppendTagIRI adds to the back of tag a *url.URL type
 func (t *OrderedCollectionPage) AppendTagIRI(v *url.URL) {
 	t.tag = append(t.tag, &tagIntermediateType{IRI: v})
+	t.markPresent_(30, len(t.tag) > 0)
 
 }
 
 // PrependTagIRI adds to the front of tag a *url.URL type
 func (t *OrderedCollectionPage) PrependTagIRI(v *url.URL) {
 	t.tag = append([]*tagIntermediateType{&tagIntermediateType{IRI: v}}, t.tag...)
+	t.markPresent_(30, len(t.tag) > 0)
 
 }
 
@@ -3656,12 +4871,28 @@ func (t *OrderedCollectionPage) RemoveTagIRI(index int) {
 	copy(t.tag[index:], t.tag[index+1:])
 	t.tag[len(t.tag)-1] = nil
 	t.tag = t.tag[:len(t.tag)-1]
+	t.markPresent_(30, len(t.tag) > 0)
+
+}
+
+// TagIRIValues returns an iterator over the values that GetTagIRI returns for each index where IsTagIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) TagIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.TagLen(); i++ {
+			if !t.IsTagIRI(i) {
+				continue
+			}
+			if !yield(t.GetTagIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownTag determines whether the call to GetUnknownTag is safe
 func (t *OrderedCollectionPage) HasUnknownTag() (ok bool) {
-	return t.tag != nil && t.tag[0].unknown_ != nil
+	return t.present_[0]&(1<<30) != 0 && t.tag[0].unknown_ != nil
 
 }
 
@@ -3679,6 +4910,7 @@ func (t *OrderedCollectionPage) SetUnknownTag(i interface{}) {
 	tmp := &tagIntermediateType{}
 	tmp.unknown_ = i
 	t.tag = append(t.tag, tmp)
+	t.markPresent_(30, len(t.tag) > 0)
 
 }
 
@@ -3697,12 +4929,14 @@ func (t *OrderedCollectionPage) GetType(index int) (v interface{}) {
 // AppendType adds a value to the back of type
 func (t *OrderedCollectionPage) AppendType(v interface{}) {
 	t.typeName = append(t.typeName, v)
+	t.markPresent_(31, len(t.typeName) > 0)
 
 }
 
 // PrependType adds a value to the front of type
 func (t *OrderedCollectionPage) PrependType(v interface{}) {
 	t.typeName = append([]interface{}{v}, t.typeName...)
+	t.markPresent_(31, len(t.typeName) > 0)
 
 }
 
@@ -3711,12 +4945,13 @@ func (t *OrderedCollectionPage) RemoveType(index int) {
 	copy(t.typeName[index:], t.typeName[index+1:])
 	t.typeName[len(t.typeName)-1] = nil
 	t.typeName = t.typeName[:len(t.typeName)-1]
+	t.markPresent_(31, len(t.typeName) > 0)
 
 }
 
 // IsUpdated determines whether the call to GetUpdated is safe
 func (t *OrderedCollectionPage) IsUpdated() (ok bool) {
-	return t.updated != nil && t.updated.dateTime != nil
+	return t.present_[0]&(1<<32) != 0 && t.updated.dateTime != nil
 
 }
 
@@ -3729,12 +4964,13 @@ func (t *OrderedCollectionPage) GetUpdated() (v time.Time) {
 // SetUpdated sets the value of updated to be of time.Time type
 func (t *OrderedCollectionPage) SetUpdated(v time.Time) {
 	t.updated = &updatedIntermediateType{dateTime: &v}
+	t.markPresent_(32, true)
 
 }
 
 // IsUpdatedIRI determines whether the call to GetUpdatedIRI is safe
 func (t *OrderedCollectionPage) IsUpdatedIRI() (ok bool) {
-	return t.updated != nil && t.updated.IRI != nil
+	return t.present_[0]&(1<<32) != 0 && t.updated.IRI != nil
 
 }
 
@@ -3747,12 +4983,13 @@ func (t *OrderedCollectionPage) GetUpdatedIRI() (v *url.URL) {
 // SetUpdatedIRI sets the value of updated to be of *url.URL type
 func (t *OrderedCollectionPage) SetUpdatedIRI(v *url.URL) {
 	t.updated = &updatedIntermediateType{IRI: v}
+	t.markPresent_(32, true)
 
 }
 
 // HasUnknownUpdated determines whether the call to GetUnknownUpdated is safe
 func (t *OrderedCollectionPage) HasUnknownUpdated() (ok bool) {
-	return t.updated != nil && t.updated.unknown_ != nil
+	return t.present_[0]&(1<<32) != 0 && t.updated.unknown_ != nil
 
 }
 
@@ -3770,6 +5007,7 @@ func (t *OrderedCollectionPage) SetUnknownUpdated(i interface{}) {
 	tmp := &updatedIntermediateType{}
 	tmp.unknown_ = i
 	t.updated = tmp
+	t.markPresent_(32, true)
 
 }
 
@@ -3794,12 +5032,14 @@ func (t *OrderedCollectionPage) GetUrlAnyURI(index int) (v *url.URL) {
 // AppendUrlAnyURI adds to the back of url a *url.URL type
 func (t *OrderedCollectionPage) AppendUrlAnyURI(v *url.URL) {
 	t.url = append(t.url, &urlIntermediateType{anyURI: v})
+	t.markPresent_(33, len(t.url) > 0)
 
 }
 
 // PrependUrlAnyURI adds to the front of url a *url.URL type
 func (t *OrderedCollectionPage) PrependUrlAnyURI(v *url.URL) {
 	t.url = append([]*urlIntermediateType{&urlIntermediateType{anyURI: v}}, t.url...)
+	t.markPresent_(33, len(t.url) > 0)
 
 }
 
@@ -3808,6 +5048,22 @@ func (t *OrderedCollectionPage) RemoveUrlAnyURI(index int) {
 	copy(t.url[index:], t.url[index+1:])
 	t.url[len(t.url)-1] = nil
 	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(33, len(t.url) > 0)
+
+}
+
+// UrlAnyURIValues returns an iterator over the values that GetUrlAnyURI returns for each index where IsUrlAnyURI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) UrlAnyURIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlAnyURI(i) {
+				continue
+			}
+			if !yield(t.GetUrlAnyURI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3826,12 +5082,14 @@ func (t *OrderedCollectionPage) GetUrlLink(index int) (v LinkType) {
 // AppendUrlLink adds to the back of url a LinkType type
 func (t *OrderedCollectionPage) AppendUrlLink(v LinkType) {
 	t.url = append(t.url, &urlIntermediateType{Link: v})
+	t.markPresent_(33, len(t.url) > 0)
 
 }
 
 // PrependUrlLink adds to the front of url a LinkType type
 func (t *OrderedCollectionPage) PrependUrlLink(v LinkType) {
 	t.url = append([]*urlIntermediateType{&urlIntermediateType{Link: v}}, t.url...)
+	t.markPresent_(33, len(t.url) > 0)
 
 }
 
@@ -3840,12 +5098,28 @@ func (t *OrderedCollectionPage) RemoveUrlLink(index int) {
 	copy(t.url[index:], t.url[index+1:])
 	t.url[len(t.url)-1] = nil
 	t.url = t.url[:len(t.url)-1]
+	t.markPresent_(33, len(t.url) > 0)
+
+}
+
+// UrlLinkValues returns an iterator over the values that GetUrlLink returns for each index where IsUrlLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) UrlLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.UrlLen(); i++ {
+			if !t.IsUrlLink(i) {
+				continue
+			}
+			if !yield(t.GetUrlLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownUrl determines whether the call to GetUnknownUrl is safe
 func (t *OrderedCollectionPage) HasUnknownUrl() (ok bool) {
-	return t.url != nil && t.url[0].unknown_ != nil
+	return t.present_[0]&(1<<33) != 0 && t.url[0].unknown_ != nil
 
 }
 
@@ -3863,6 +5137,7 @@ func (t *OrderedCollectionPage) SetUnknownUrl(i interface{}) {
 	tmp := &urlIntermediateType{}
 	tmp.unknown_ = i
 	t.url = append(t.url, tmp)
+	t.markPresent_(33, len(t.url) > 0)
 
 }
 
@@ -3887,12 +5162,14 @@ func (t *OrderedCollectionPage) GetToObject(index int) (v ObjectType) {
 // AppendToObject adds to the back of to a ObjectType type
 func (t *OrderedCollectionPage) AppendToObject(v ObjectType) {
 	t.to = append(t.to, &toIntermediateType{Object: v})
+	t.markPresent_(34, len(t.to) > 0)
 
 }
 
 // PrependToObject adds to the front of to a ObjectType type
 func (t *OrderedCollectionPage) PrependToObject(v ObjectType) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{Object: v}}, t.to...)
+	t.markPresent_(34, len(t.to) > 0)
 
 }
 
@@ -3901,6 +5178,22 @@ func (t *OrderedCollectionPage) RemoveToObject(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(34, len(t.to) > 0)
+
+}
+
+// ToObjectValues returns an iterator over the values that GetToObject returns for each index where IsToObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ToObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToObject(i) {
+				continue
+			}
+			if !yield(t.GetToObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3919,12 +5212,14 @@ func (t *OrderedCollectionPage) GetToLink(index int) (v LinkType) {
 // AppendToLink adds to the back of to a LinkType type
 func (t *OrderedCollectionPage) AppendToLink(v LinkType) {
 	t.to = append(t.to, &toIntermediateType{Link: v})
+	t.markPresent_(34, len(t.to) > 0)
 
 }
 
 // PrependToLink adds to the front of to a LinkType type
 func (t *OrderedCollectionPage) PrependToLink(v LinkType) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{Link: v}}, t.to...)
+	t.markPresent_(34, len(t.to) > 0)
 
 }
 
@@ -3933,6 +5228,22 @@ func (t *OrderedCollectionPage) RemoveToLink(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(34, len(t.to) > 0)
+
+}
+
+// ToLinkValues returns an iterator over the values that GetToLink returns for each index where IsToLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ToLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToLink(i) {
+				continue
+			}
+			if !yield(t.GetToLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -3951,12 +5262,14 @@ func (t *OrderedCollectionPage) GetToIRI(index int) (v *url.URL) {
 // AppendToIRI adds to the back of to a *url.URL type
 func (t *OrderedCollectionPage) AppendToIRI(v *url.URL) {
 	t.to = append(t.to, &toIntermediateType{IRI: v})
+	t.markPresent_(34, len(t.to) > 0)
 
 }
 
 // PrependToIRI adds to the front of to a *url.URL type
 func (t *OrderedCollectionPage) PrependToIRI(v *url.URL) {
 	t.to = append([]*toIntermediateType{&toIntermediateType{IRI: v}}, t.to...)
+	t.markPresent_(34, len(t.to) > 0)
 
 }
 
@@ -3965,12 +5278,28 @@ func (t *OrderedCollectionPage) RemoveToIRI(index int) {
 	copy(t.to[index:], t.to[index+1:])
 	t.to[len(t.to)-1] = nil
 	t.to = t.to[:len(t.to)-1]
+	t.markPresent_(34, len(t.to) > 0)
+
+}
+
+// ToIRIValues returns an iterator over the values that GetToIRI returns for each index where IsToIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) ToIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.ToLen(); i++ {
+			if !t.IsToIRI(i) {
+				continue
+			}
+			if !yield(t.GetToIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownTo determines whether the call to GetUnknownTo is safe
 func (t *OrderedCollectionPage) HasUnknownTo() (ok bool) {
-	return t.to != nil && t.to[0].unknown_ != nil
+	return t.present_[0]&(1<<34) != 0 && t.to[0].unknown_ != nil
 
 }
 
@@ -3988,6 +5317,7 @@ func (t *OrderedCollectionPage) SetUnknownTo(i interface{}) {
 	tmp := &toIntermediateType{}
 	tmp.unknown_ = i
 	t.to = append(t.to, tmp)
+	t.markPresent_(34, len(t.to) > 0)
 
 }
 
@@ -4012,12 +5342,14 @@ func (t *OrderedCollectionPage) GetBtoObject(index int) (v ObjectType) {
 // AppendBtoObject adds to the back of bto a ObjectType type
 func (t *OrderedCollectionPage) AppendBtoObject(v ObjectType) {
 	t.bto = append(t.bto, &btoIntermediateType{Object: v})
+	t.markPresent_(35, len(t.bto) > 0)
 
 }
 
 // PrependBtoObject adds to the front of bto a ObjectType type
 func (t *OrderedCollectionPage) PrependBtoObject(v ObjectType) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Object: v}}, t.bto...)
+	t.markPresent_(35, len(t.bto) > 0)
 
 }
 
@@ -4026,6 +5358,22 @@ func (t *OrderedCollectionPage) RemoveBtoObject(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(35, len(t.bto) > 0)
+
+}
+
+// BtoObjectValues returns an iterator over the values that GetBtoObject returns for each index where IsBtoObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) BtoObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoObject(i) {
+				continue
+			}
+			if !yield(t.GetBtoObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4044,12 +5392,14 @@ func (t *OrderedCollectionPage) GetBtoLink(index int) (v LinkType) {
 // AppendBtoLink adds to the back of bto a LinkType type
 func (t *OrderedCollectionPage) AppendBtoLink(v LinkType) {
 	t.bto = append(t.bto, &btoIntermediateType{Link: v})
+	t.markPresent_(35, len(t.bto) > 0)
 
 }
 
 // PrependBtoLink adds to the front of bto a LinkType type
 func (t *OrderedCollectionPage) PrependBtoLink(v LinkType) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{Link: v}}, t.bto...)
+	t.markPresent_(35, len(t.bto) > 0)
 
 }
 
@@ -4058,6 +5408,22 @@ func (t *OrderedCollectionPage) RemoveBtoLink(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(35, len(t.bto) > 0)
+
+}
+
+// BtoLinkValues returns an iterator over the values that GetBtoLink returns for each index where IsBtoLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) BtoLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoLink(i) {
+				continue
+			}
+			if !yield(t.GetBtoLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4076,12 +5442,14 @@ func (t *OrderedCollectionPage) GetBtoIRI(index int) (v *url.URL) {
 // AppendBtoIRI adds to the back of bto a *url.URL type
 func (t *OrderedCollectionPage) AppendBtoIRI(v *url.URL) {
 	t.bto = append(t.bto, &btoIntermediateType{IRI: v})
+	t.markPresent_(35, len(t.bto) > 0)
 
 }
 
 // PrependBtoIRI adds to the front of bto a *url.URL type
 func (t *OrderedCollectionPage) PrependBtoIRI(v *url.URL) {
 	t.bto = append([]*btoIntermediateType{&btoIntermediateType{IRI: v}}, t.bto...)
+	t.markPresent_(35, len(t.bto) > 0)
 
 }
 
@@ -4090,12 +5458,28 @@ func (t *OrderedCollectionPage) RemoveBtoIRI(index int) {
 	copy(t.bto[index:], t.bto[index+1:])
 	t.bto[len(t.bto)-1] = nil
 	t.bto = t.bto[:len(t.bto)-1]
+	t.markPresent_(35, len(t.bto) > 0)
+
+}
+
+// BtoIRIValues returns an iterator over the values that GetBtoIRI returns for each index where IsBtoIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) BtoIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BtoLen(); i++ {
+			if !t.IsBtoIRI(i) {
+				continue
+			}
+			if !yield(t.GetBtoIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownBto determines whether the call to GetUnknownBto is safe
 func (t *OrderedCollectionPage) HasUnknownBto() (ok bool) {
-	return t.bto != nil && t.bto[0].unknown_ != nil
+	return t.present_[0]&(1<<35) != 0 && t.bto[0].unknown_ != nil
 
 }
 
@@ -4113,6 +5497,7 @@ func (t *OrderedCollectionPage) SetUnknownBto(i interface{}) {
 	tmp := &btoIntermediateType{}
 	tmp.unknown_ = i
 	t.bto = append(t.bto, tmp)
+	t.markPresent_(35, len(t.bto) > 0)
 
 }
 
@@ -4137,12 +5522,14 @@ func (t *OrderedCollectionPage) GetCcObject(index int) (v ObjectType) {
 // AppendCcObject adds to the back of cc a ObjectType type
 func (t *OrderedCollectionPage) AppendCcObject(v ObjectType) {
 	t.cc = append(t.cc, &ccIntermediateType{Object: v})
+	t.markPresent_(36, len(t.cc) > 0)
 
 }
 
 // PrependCcObject adds to the front of cc a ObjectType type
 func (t *OrderedCollectionPage) PrependCcObject(v ObjectType) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Object: v}}, t.cc...)
+	t.markPresent_(36, len(t.cc) > 0)
 
 }
 
@@ -4151,6 +5538,22 @@ func (t *OrderedCollectionPage) RemoveCcObject(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(36, len(t.cc) > 0)
+
+}
+
+// CcObjectValues returns an iterator over the values that GetCcObject returns for each index where IsCcObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) CcObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcObject(i) {
+				continue
+			}
+			if !yield(t.GetCcObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4169,12 +5572,14 @@ func (t *OrderedCollectionPage) GetCcLink(index int) (v LinkType) {
 // AppendCcLink adds to the back of cc a LinkType type
 func (t *OrderedCollectionPage) AppendCcLink(v LinkType) {
 	t.cc = append(t.cc, &ccIntermediateType{Link: v})
+	t.markPresent_(36, len(t.cc) > 0)
 
 }
 
 // PrependCcLink adds to the front of cc a LinkType type
 func (t *OrderedCollectionPage) PrependCcLink(v LinkType) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{Link: v}}, t.cc...)
+	t.markPresent_(36, len(t.cc) > 0)
 
 }
 
@@ -4183,6 +5588,22 @@ func (t *OrderedCollectionPage) RemoveCcLink(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(36, len(t.cc) > 0)
+
+}
+
+// CcLinkValues returns an iterator over the values that GetCcLink returns for each index where IsCcLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) CcLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcLink(i) {
+				continue
+			}
+			if !yield(t.GetCcLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4201,12 +5622,14 @@ func (t *OrderedCollectionPage) GetCcIRI(index int) (v *url.URL) {
 // AppendCcIRI adds to the back of cc a *url.URL type
 func (t *OrderedCollectionPage) AppendCcIRI(v *url.URL) {
 	t.cc = append(t.cc, &ccIntermediateType{IRI: v})
+	t.markPresent_(36, len(t.cc) > 0)
 
 }
 
 // PrependCcIRI adds to the front of cc a *url.URL type
 func (t *OrderedCollectionPage) PrependCcIRI(v *url.URL) {
 	t.cc = append([]*ccIntermediateType{&ccIntermediateType{IRI: v}}, t.cc...)
+	t.markPresent_(36, len(t.cc) > 0)
 
 }
 
@@ -4215,12 +5638,28 @@ func (t *OrderedCollectionPage) RemoveCcIRI(index int) {
 	copy(t.cc[index:], t.cc[index+1:])
 	t.cc[len(t.cc)-1] = nil
 	t.cc = t.cc[:len(t.cc)-1]
+	t.markPresent_(36, len(t.cc) > 0)
+
+}
+
+// CcIRIValues returns an iterator over the values that GetCcIRI returns for each index where IsCcIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) CcIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.CcLen(); i++ {
+			if !t.IsCcIRI(i) {
+				continue
+			}
+			if !yield(t.GetCcIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownCc determines whether the call to GetUnknownCc is safe
 func (t *OrderedCollectionPage) HasUnknownCc() (ok bool) {
-	return t.cc != nil && t.cc[0].unknown_ != nil
+	return t.present_[0]&(1<<36) != 0 && t.cc[0].unknown_ != nil
 
 }
 
@@ -4238,6 +5677,7 @@ func (t *OrderedCollectionPage) SetUnknownCc(i interface{}) {
 	tmp := &ccIntermediateType{}
 	tmp.unknown_ = i
 	t.cc = append(t.cc, tmp)
+	t.markPresent_(36, len(t.cc) > 0)
 
 }
 
@@ -4262,12 +5702,14 @@ func (t *OrderedCollectionPage) GetBccObject(index int) (v ObjectType) {
 // AppendBccObject adds to the back of bcc a ObjectType type
 func (t *OrderedCollectionPage) AppendBccObject(v ObjectType) {
 	t.bcc = append(t.bcc, &bccIntermediateType{Object: v})
+	t.markPresent_(37, len(t.bcc) > 0)
 
 }
 
 // PrependBccObject adds to the front of bcc a ObjectType type
 func (t *OrderedCollectionPage) PrependBccObject(v ObjectType) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Object: v}}, t.bcc...)
+	t.markPresent_(37, len(t.bcc) > 0)
 
 }
 
@@ -4276,6 +5718,22 @@ func (t *OrderedCollectionPage) RemoveBccObject(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(37, len(t.bcc) > 0)
+
+}
+
+// BccObjectValues returns an iterator over the values that GetBccObject returns for each index where IsBccObject is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) BccObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return func(yield func(v ObjectType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccObject(i) {
+				continue
+			}
+			if !yield(t.GetBccObject(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4294,12 +5752,14 @@ func (t *OrderedCollectionPage) GetBccLink(index int) (v LinkType) {
 // AppendBccLink adds to the back of bcc a LinkType type
 func (t *OrderedCollectionPage) AppendBccLink(v LinkType) {
 	t.bcc = append(t.bcc, &bccIntermediateType{Link: v})
+	t.markPresent_(37, len(t.bcc) > 0)
 
 }
 
 // PrependBccLink adds to the front of bcc a LinkType type
 func (t *OrderedCollectionPage) PrependBccLink(v LinkType) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{Link: v}}, t.bcc...)
+	t.markPresent_(37, len(t.bcc) > 0)
 
 }
 
@@ -4308,6 +5768,22 @@ func (t *OrderedCollectionPage) RemoveBccLink(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(37, len(t.bcc) > 0)
+
+}
+
+// BccLinkValues returns an iterator over the values that GetBccLink returns for each index where IsBccLink is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) BccLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return func(yield func(v LinkType) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccLink(i) {
+				continue
+			}
+			if !yield(t.GetBccLink(i)) {
+				return
+			}
+		}
+	}
 
 }
 
@@ -4326,12 +5802,14 @@ func (t *OrderedCollectionPage) GetBccIRI(index int) (v *url.URL) {
 // AppendBccIRI adds to the back of bcc a *url.URL type
 func (t *OrderedCollectionPage) AppendBccIRI(v *url.URL) {
 	t.bcc = append(t.bcc, &bccIntermediateType{IRI: v})
+	t.markPresent_(37, len(t.bcc) > 0)
 
 }
 
 // PrependBccIRI adds to the front of bcc a *url.URL type
 func (t *OrderedCollectionPage) PrependBccIRI(v *url.URL) {
 	t.bcc = append([]*bccIntermediateType{&bccIntermediateType{IRI: v}}, t.bcc...)
+	t.markPresent_(37, len(t.bcc) > 0)
 
 }
 
@@ -4340,12 +5818,28 @@ func (t *OrderedCollectionPage) RemoveBccIRI(index int) {
 	copy(t.bcc[index:], t.bcc[index+1:])
 	t.bcc[len(t.bcc)-1] = nil
 	t.bcc = t.bcc[:len(t.bcc)-1]
+	t.markPresent_(37, len(t.bcc) > 0)
+
+}
+
+// BccIRIValues returns an iterator over the values that GetBccIRI returns for each index where IsBccIRI is true, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) BccIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.BccLen(); i++ {
+			if !t.IsBccIRI(i) {
+				continue
+			}
+			if !yield(t.GetBccIRI(i)) {
+				return
+			}
+		}
+	}
 
 }
 
 // HasUnknownBcc determines whether the call to GetUnknownBcc is safe
 func (t *OrderedCollectionPage) HasUnknownBcc() (ok bool) {
-	return t.bcc != nil && t.bcc[0].unknown_ != nil
+	return t.present_[0]&(1<<37) != 0 && t.bcc[0].unknown_ != nil
 
 }
 
@@ -4363,12 +5857,13 @@ func (t *OrderedCollectionPage) SetUnknownBcc(i interface{}) {
 	tmp := &bccIntermediateType{}
 	tmp.unknown_ = i
 	t.bcc = append(t.bcc, tmp)
+	t.markPresent_(37, len(t.bcc) > 0)
 
 }
 
 // IsMediaType determines whether the call to GetMediaType is safe
 func (t *OrderedCollectionPage) IsMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.mimeMediaTypeValue != nil
+	return t.present_[0]&(1<<38) != 0 && t.mediaType.mimeMediaTypeValue != nil
 
 }
 
@@ -4381,12 +5876,13 @@ func (t *OrderedCollectionPage) GetMediaType() (v string) {
 // SetMediaType sets the value of mediaType to be of string type
 func (t *OrderedCollectionPage) SetMediaType(v string) {
 	t.mediaType = &mediaTypeIntermediateType{mimeMediaTypeValue: &v}
+	t.markPresent_(38, true)
 
 }
 
 // IsMediaTypeIRI determines whether the call to GetMediaTypeIRI is safe
 func (t *OrderedCollectionPage) IsMediaTypeIRI() (ok bool) {
-	return t.mediaType != nil && t.mediaType.IRI != nil
+	return t.present_[0]&(1<<38) != 0 && t.mediaType.IRI != nil
 
 }
 
@@ -4399,12 +5895,13 @@ func (t *OrderedCollectionPage) GetMediaTypeIRI() (v *url.URL) {
 // SetMediaTypeIRI sets the value of mediaType to be of *url.URL type
 func (t *OrderedCollectionPage) SetMediaTypeIRI(v *url.URL) {
 	t.mediaType = &mediaTypeIntermediateType{IRI: v}
+	t.markPresent_(38, true)
 
 }
 
 // HasUnknownMediaType determines whether the call to GetUnknownMediaType is safe
 func (t *OrderedCollectionPage) HasUnknownMediaType() (ok bool) {
-	return t.mediaType != nil && t.mediaType.unknown_ != nil
+	return t.present_[0]&(1<<38) != 0 && t.mediaType.unknown_ != nil
 
 }
 
@@ -4422,12 +5919,13 @@ func (t *OrderedCollectionPage) SetUnknownMediaType(i interface{}) {
 	tmp := &mediaTypeIntermediateType{}
 	tmp.unknown_ = i
 	t.mediaType = tmp
+	t.markPresent_(38, true)
 
 }
 
 // IsDuration determines whether the call to GetDuration is safe
 func (t *OrderedCollectionPage) IsDuration() (ok bool) {
-	return t.duration != nil && t.duration.duration != nil
+	return t.present_[0]&(1<<39) != 0 && t.duration.duration != nil
 
 }
 
@@ -4440,12 +5938,13 @@ func (t *OrderedCollectionPage) GetDuration() (v time.Duration) {
 // SetDuration sets the value of duration to be of time.Duration type
 func (t *OrderedCollectionPage) SetDuration(v time.Duration) {
 	t.duration = &durationIntermediateType{duration: &v}
+	t.markPresent_(39, true)
 
 }
 
 // IsDurationIRI determines whether the call to GetDurationIRI is safe
 func (t *OrderedCollectionPage) IsDurationIRI() (ok bool) {
-	return t.duration != nil && t.duration.IRI != nil
+	return t.present_[0]&(1<<39) != 0 && t.duration.IRI != nil
 
 }
 
@@ -4458,12 +5957,13 @@ func (t *OrderedCollectionPage) GetDurationIRI() (v *url.URL) {
 // SetDurationIRI sets the value of duration to be of *url.URL type
 func (t *OrderedCollectionPage) SetDurationIRI(v *url.URL) {
 	t.duration = &durationIntermediateType{IRI: v}
+	t.markPresent_(39, true)
 
 }
 
 // HasUnknownDuration determines whether the call to GetUnknownDuration is safe
 func (t *OrderedCollectionPage) HasUnknownDuration() (ok bool) {
-	return t.duration != nil && t.duration.unknown_ != nil
+	return t.present_[0]&(1<<39) != 0 && t.duration.unknown_ != nil
 
 }
 
@@ -4481,12 +5981,13 @@ func (t *OrderedCollectionPage) SetUnknownDuration(i interface{}) {
 	tmp := &durationIntermediateType{}
 	tmp.unknown_ = i
 	t.duration = tmp
+	t.markPresent_(39, true)
 
 }
 
 // IsSource determines whether the call to GetSource is safe
 func (t *OrderedCollectionPage) IsSource() (ok bool) {
-	return t.source != nil && t.source.Object != nil
+	return t.present_[0]&(1<<40) != 0 && t.source.Object != nil
 
 }
 
@@ -4499,12 +6000,13 @@ func (t *OrderedCollectionPage) GetSource() (v ObjectType) {
 // SetSource sets the value of source to be of ObjectType type
 func (t *OrderedCollectionPage) SetSource(v ObjectType) {
 	t.source = &sourceIntermediateType{Object: v}
+	t.markPresent_(40, true)
 
 }
 
 // IsSourceIRI determines whether the call to GetSourceIRI is safe
 func (t *OrderedCollectionPage) IsSourceIRI() (ok bool) {
-	return t.source != nil && t.source.IRI != nil
+	return t.present_[0]&(1<<40) != 0 && t.source.IRI != nil
 
 }
 
@@ -4517,12 +6019,13 @@ func (t *OrderedCollectionPage) GetSourceIRI() (v *url.URL) {
 // SetSourceIRI sets the value of source to be of *url.URL type
 func (t *OrderedCollectionPage) SetSourceIRI(v *url.URL) {
 	t.source = &sourceIntermediateType{IRI: v}
+	t.markPresent_(40, true)
 
 }
 
 // HasUnknownSource determines whether the call to GetUnknownSource is safe
 func (t *OrderedCollectionPage) HasUnknownSource() (ok bool) {
-	return t.source != nil && t.source.unknown_ != nil
+	return t.present_[0]&(1<<40) != 0 && t.source.unknown_ != nil
 
 }
 
@@ -4540,12 +6043,13 @@ func (t *OrderedCollectionPage) SetUnknownSource(i interface{}) {
 	tmp := &sourceIntermediateType{}
 	tmp.unknown_ = i
 	t.source = tmp
+	t.markPresent_(40, true)
 
 }
 
 // IsInboxOrderedCollection determines whether the call to GetInboxOrderedCollection is safe
 func (t *OrderedCollectionPage) IsInboxOrderedCollection() (ok bool) {
-	return t.inbox != nil && t.inbox.OrderedCollection != nil
+	return t.present_[0]&(1<<41) != 0 && t.inbox.OrderedCollection != nil
 
 }
 
@@ -4558,12 +6062,13 @@ func (t *OrderedCollectionPage) GetInboxOrderedCollection() (v OrderedCollection
 // SetInboxOrderedCollection sets the value of inbox to be of OrderedCollectionType type
 func (t *OrderedCollectionPage) SetInboxOrderedCollection(v OrderedCollectionType) {
 	t.inbox = &inboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(41, true)
 
 }
 
 // IsInboxAnyURI determines whether the call to GetInboxAnyURI is safe
 func (t *OrderedCollectionPage) IsInboxAnyURI() (ok bool) {
-	return t.inbox != nil && t.inbox.anyURI != nil
+	return t.present_[0]&(1<<41) != 0 && t.inbox.anyURI != nil
 
 }
 
@@ -4576,12 +6081,13 @@ func (t *OrderedCollectionPage) GetInboxAnyURI() (v *url.URL) {
 // SetInboxAnyURI sets the value of inbox to be of *url.URL type
 func (t *OrderedCollectionPage) SetInboxAnyURI(v *url.URL) {
 	t.inbox = &inboxIntermediateType{anyURI: v}
+	t.markPresent_(41, true)
 
 }
 
 // HasUnknownInbox determines whether the call to GetUnknownInbox is safe
 func (t *OrderedCollectionPage) HasUnknownInbox() (ok bool) {
-	return t.inbox != nil && t.inbox.unknown_ != nil
+	return t.present_[0]&(1<<41) != 0 && t.inbox.unknown_ != nil
 
 }
 
@@ -4599,12 +6105,13 @@ func (t *OrderedCollectionPage) SetUnknownInbox(i interface{}) {
 	tmp := &inboxIntermediateType{}
 	tmp.unknown_ = i
 	t.inbox = tmp
+	t.markPresent_(41, true)
 
 }
 
 // IsOutboxOrderedCollection determines whether the call to GetOutboxOrderedCollection is safe
 func (t *OrderedCollectionPage) IsOutboxOrderedCollection() (ok bool) {
-	return t.outbox != nil && t.outbox.OrderedCollection != nil
+	return t.present_[0]&(1<<42) != 0 && t.outbox.OrderedCollection != nil
 
 }
 
@@ -4617,12 +6124,13 @@ func (t *OrderedCollectionPage) GetOutboxOrderedCollection() (v OrderedCollectio
 // SetOutboxOrderedCollection sets the value of outbox to be of OrderedCollectionType type
 func (t *OrderedCollectionPage) SetOutboxOrderedCollection(v OrderedCollectionType) {
 	t.outbox = &outboxIntermediateType{OrderedCollection: v}
+	t.markPresent_(42, true)
 
 }
 
 // IsOutboxAnyURI determines whether the call to GetOutboxAnyURI is safe
 func (t *OrderedCollectionPage) IsOutboxAnyURI() (ok bool) {
-	return t.outbox != nil && t.outbox.anyURI != nil
+	return t.present_[0]&(1<<42) != 0 && t.outbox.anyURI != nil
 
 }
 
@@ -4635,12 +6143,13 @@ func (t *OrderedCollectionPage) GetOutboxAnyURI() (v *url.URL) {
 // SetOutboxAnyURI sets the value of outbox to be of *url.URL type
 func (t *OrderedCollectionPage) SetOutboxAnyURI(v *url.URL) {
 	t.outbox = &outboxIntermediateType{anyURI: v}
+	t.markPresent_(42, true)
 
 }
 
 // HasUnknownOutbox determines whether the call to GetUnknownOutbox is safe
 func (t *OrderedCollectionPage) HasUnknownOutbox() (ok bool) {
-	return t.outbox != nil && t.outbox.unknown_ != nil
+	return t.present_[0]&(1<<42) != 0 && t.outbox.unknown_ != nil
 
 }
 
@@ -4658,12 +6167,13 @@ func (t *OrderedCollectionPage) SetUnknownOutbox(i interface{}) {
 	tmp := &outboxIntermediateType{}
 	tmp.unknown_ = i
 	t.outbox = tmp
+	t.markPresent_(42, true)
 
 }
 
 // IsFollowingCollection determines whether the call to GetFollowingCollection is safe
 func (t *OrderedCollectionPage) IsFollowingCollection() (ok bool) {
-	return t.following != nil && t.following.Collection != nil
+	return t.present_[0]&(1<<43) != 0 && t.following.Collection != nil
 
 }
 
@@ -4676,12 +6186,13 @@ func (t *OrderedCollectionPage) GetFollowingCollection() (v CollectionType) {
 // SetFollowingCollection sets the value of following to be of CollectionType type
 func (t *OrderedCollectionPage) SetFollowingCollection(v CollectionType) {
 	t.following = &followingIntermediateType{Collection: v}
+	t.markPresent_(43, true)
 
 }
 
 // IsFollowingOrderedCollection determines whether the call to GetFollowingOrderedCollection is safe
 func (t *OrderedCollectionPage) IsFollowingOrderedCollection() (ok bool) {
-	return t.following != nil && t.following.OrderedCollection != nil
+	return t.present_[0]&(1<<43) != 0 && t.following.OrderedCollection != nil
 
 }
 
@@ -4694,12 +6205,13 @@ func (t *OrderedCollectionPage) GetFollowingOrderedCollection() (v OrderedCollec
 // SetFollowingOrderedCollection sets the value of following to be of OrderedCollectionType type
 func (t *OrderedCollectionPage) SetFollowingOrderedCollection(v OrderedCollectionType) {
 	t.following = &followingIntermediateType{OrderedCollection: v}
+	t.markPresent_(43, true)
 
 }
 
 // IsFollowingAnyURI determines whether the call to GetFollowingAnyURI is safe
 func (t *OrderedCollectionPage) IsFollowingAnyURI() (ok bool) {
-	return t.following != nil && t.following.anyURI != nil
+	return t.present_[0]&(1<<43) != 0 && t.following.anyURI != nil
 
 }
 
@@ -4712,12 +6224,13 @@ func (t *OrderedCollectionPage) GetFollowingAnyURI() (v *url.URL) {
 // SetFollowingAnyURI sets the value of following to be of *url.URL type
 func (t *OrderedCollectionPage) SetFollowingAnyURI(v *url.URL) {
 	t.following = &followingIntermediateType{anyURI: v}
+	t.markPresent_(43, true)
 
 }
 
 // HasUnknownFollowing determines whether the call to GetUnknownFollowing is safe
 func (t *OrderedCollectionPage) HasUnknownFollowing() (ok bool) {
-	return t.following != nil && t.following.unknown_ != nil
+	return t.present_[0]&(1<<43) != 0 && t.following.unknown_ != nil
 
 }
 
@@ -4735,12 +6248,13 @@ func (t *OrderedCollectionPage) SetUnknownFollowing(i interface{}) {
 	tmp := &followingIntermediateType{}
 	tmp.unknown_ = i
 	t.following = tmp
+	t.markPresent_(43, true)
 
 }
 
 // IsFollowersCollection determines whether the call to GetFollowersCollection is safe
 func (t *OrderedCollectionPage) IsFollowersCollection() (ok bool) {
-	return t.followers != nil && t.followers.Collection != nil
+	return t.present_[0]&(1<<44) != 0 && t.followers.Collection != nil
 
 }
 
@@ -4753,12 +6267,13 @@ func (t *OrderedCollectionPage) GetFollowersCollection() (v CollectionType) {
 // SetFollowersCollection sets the value of followers to be of CollectionType type
 func (t *OrderedCollectionPage) SetFollowersCollection(v CollectionType) {
 	t.followers = &followersIntermediateType{Collection: v}
+	t.markPresent_(44, true)
 
 }
 
 // IsFollowersOrderedCollection determines whether the call to GetFollowersOrderedCollection is safe
 func (t *OrderedCollectionPage) IsFollowersOrderedCollection() (ok bool) {
-	return t.followers != nil && t.followers.OrderedCollection != nil
+	return t.present_[0]&(1<<44) != 0 && t.followers.OrderedCollection != nil
 
 }
 
@@ -4771,12 +6286,13 @@ func (t *OrderedCollectionPage) GetFollowersOrderedCollection() (v OrderedCollec
 // SetFollowersOrderedCollection sets the value of followers to be of OrderedCollectionType type
 func (t *OrderedCollectionPage) SetFollowersOrderedCollection(v OrderedCollectionType) {
 	t.followers = &followersIntermediateType{OrderedCollection: v}
+	t.markPresent_(44, true)
 
 }
 
 // IsFollowersAnyURI determines whether the call to GetFollowersAnyURI is safe
 func (t *OrderedCollectionPage) IsFollowersAnyURI() (ok bool) {
-	return t.followers != nil && t.followers.anyURI != nil
+	return t.present_[0]&(1<<44) != 0 && t.followers.anyURI != nil
 
 }
 
@@ -4789,12 +6305,13 @@ func (t *OrderedCollectionPage) GetFollowersAnyURI() (v *url.URL) {
 // SetFollowersAnyURI sets the value of followers to be of *url.URL type
 func (t *OrderedCollectionPage) SetFollowersAnyURI(v *url.URL) {
 	t.followers = &followersIntermediateType{anyURI: v}
+	t.markPresent_(44, true)
 
 }
 
 // HasUnknownFollowers determines whether the call to GetUnknownFollowers is safe
 func (t *OrderedCollectionPage) HasUnknownFollowers() (ok bool) {
-	return t.followers != nil && t.followers.unknown_ != nil
+	return t.present_[0]&(1<<44) != 0 && t.followers.unknown_ != nil
 
 }
 
@@ -4812,12 +6329,13 @@ func (t *OrderedCollectionPage) SetUnknownFollowers(i interface{}) {
 	tmp := &followersIntermediateType{}
 	tmp.unknown_ = i
 	t.followers = tmp
+	t.markPresent_(44, true)
 
 }
 
 // IsLikedCollection determines whether the call to GetLikedCollection is safe
 func (t *OrderedCollectionPage) IsLikedCollection() (ok bool) {
-	return t.liked != nil && t.liked.Collection != nil
+	return t.present_[0]&(1<<45) != 0 && t.liked.Collection != nil
 
 }
 
@@ -4830,12 +6348,13 @@ func (t *OrderedCollectionPage) GetLikedCollection() (v CollectionType) {
 // SetLikedCollection sets the value of liked to be of CollectionType type
 func (t *OrderedCollectionPage) SetLikedCollection(v CollectionType) {
 	t.liked = &likedIntermediateType{Collection: v}
+	t.markPresent_(45, true)
 
 }
 
 // IsLikedOrderedCollection determines whether the call to GetLikedOrderedCollection is safe
 func (t *OrderedCollectionPage) IsLikedOrderedCollection() (ok bool) {
-	return t.liked != nil && t.liked.OrderedCollection != nil
+	return t.present_[0]&(1<<45) != 0 && t.liked.OrderedCollection != nil
 
 }
 
@@ -4848,12 +6367,13 @@ func (t *OrderedCollectionPage) GetLikedOrderedCollection() (v OrderedCollection
 // SetLikedOrderedCollection sets the value of liked to be of OrderedCollectionType type
 func (t *OrderedCollectionPage) SetLikedOrderedCollection(v OrderedCollectionType) {
 	t.liked = &likedIntermediateType{OrderedCollection: v}
+	t.markPresent_(45, true)
 
 }
 
 // IsLikedAnyURI determines whether the call to GetLikedAnyURI is safe
 func (t *OrderedCollectionPage) IsLikedAnyURI() (ok bool) {
-	return t.liked != nil && t.liked.anyURI != nil
+	return t.present_[0]&(1<<45) != 0 && t.liked.anyURI != nil
 
 }
 
@@ -4866,12 +6386,13 @@ func (t *OrderedCollectionPage) GetLikedAnyURI() (v *url.URL) {
 // SetLikedAnyURI sets the value of liked to be of *url.URL type
 func (t *OrderedCollectionPage) SetLikedAnyURI(v *url.URL) {
 	t.liked = &likedIntermediateType{anyURI: v}
+	t.markPresent_(45, true)
 
 }
 
 // HasUnknownLiked determines whether the call to GetUnknownLiked is safe
 func (t *OrderedCollectionPage) HasUnknownLiked() (ok bool) {
-	return t.liked != nil && t.liked.unknown_ != nil
+	return t.present_[0]&(1<<45) != 0 && t.liked.unknown_ != nil
 
 }
 
@@ -4889,12 +6410,13 @@ func (t *OrderedCollectionPage) SetUnknownLiked(i interface{}) {
 	tmp := &likedIntermediateType{}
 	tmp.unknown_ = i
 	t.liked = tmp
+	t.markPresent_(45, true)
 
 }
 
 // IsLikesCollection determines whether the call to GetLikesCollection is safe
 func (t *OrderedCollectionPage) IsLikesCollection() (ok bool) {
-	return t.likes != nil && t.likes.Collection != nil
+	return t.present_[0]&(1<<46) != 0 && t.likes.Collection != nil
 
 }
 
@@ -4907,12 +6429,13 @@ func (t *OrderedCollectionPage) GetLikesCollection() (v CollectionType) {
 // SetLikesCollection sets the value of likes to be of CollectionType type
 func (t *OrderedCollectionPage) SetLikesCollection(v CollectionType) {
 	t.likes = &likesIntermediateType{Collection: v}
+	t.markPresent_(46, true)
 
 }
 
 // IsLikesOrderedCollection determines whether the call to GetLikesOrderedCollection is safe
 func (t *OrderedCollectionPage) IsLikesOrderedCollection() (ok bool) {
-	return t.likes != nil && t.likes.OrderedCollection != nil
+	return t.present_[0]&(1<<46) != 0 && t.likes.OrderedCollection != nil
 
 }
 
@@ -4925,12 +6448,13 @@ func (t *OrderedCollectionPage) GetLikesOrderedCollection() (v OrderedCollection
 // SetLikesOrderedCollection sets the value of likes to be of OrderedCollectionType type
 func (t *OrderedCollectionPage) SetLikesOrderedCollection(v OrderedCollectionType) {
 	t.likes = &likesIntermediateType{OrderedCollection: v}
+	t.markPresent_(46, true)
 
 }
 
 // IsLikesAnyURI determines whether the call to GetLikesAnyURI is safe
 func (t *OrderedCollectionPage) IsLikesAnyURI() (ok bool) {
-	return t.likes != nil && t.likes.anyURI != nil
+	return t.present_[0]&(1<<46) != 0 && t.likes.anyURI != nil
 
 }
 
@@ -4943,12 +6467,13 @@ func (t *OrderedCollectionPage) GetLikesAnyURI() (v *url.URL) {
 // SetLikesAnyURI sets the value of likes to be of *url.URL type
 func (t *OrderedCollectionPage) SetLikesAnyURI(v *url.URL) {
 	t.likes = &likesIntermediateType{anyURI: v}
+	t.markPresent_(46, true)
 
 }
 
 // HasUnknownLikes determines whether the call to GetUnknownLikes is safe
 func (t *OrderedCollectionPage) HasUnknownLikes() (ok bool) {
-	return t.likes != nil && t.likes.unknown_ != nil
+	return t.present_[0]&(1<<46) != 0 && t.likes.unknown_ != nil
 
 }
 
@@ -4966,6 +6491,7 @@ func (t *OrderedCollectionPage) SetUnknownLikes(i interface{}) {
 	tmp := &likesIntermediateType{}
 	tmp.unknown_ = i
 	t.likes = tmp
+	t.markPresent_(46, true)
 
 }
 
@@ -4984,12 +6510,14 @@ func (t *OrderedCollectionPage) GetStreams(index int) (v *url.URL) {
 // AppendStreams adds a value to the back of streams
 func (t *OrderedCollectionPage) AppendStreams(v *url.URL) {
 	t.streams = append(t.streams, v)
+	t.markPresent_(47, len(t.streams) > 0)
 
 }
 
 // PrependStreams adds a value to the front of streams
 func (t *OrderedCollectionPage) PrependStreams(v *url.URL) {
 	t.streams = append([]*url.URL{v}, t.streams...)
+	t.markPresent_(47, len(t.streams) > 0)
 
 }
 
@@ -4998,6 +6526,7 @@ func (t *OrderedCollectionPage) RemoveStreams(index int) {
 	copy(t.streams[index:], t.streams[index+1:])
 	t.streams[len(t.streams)-1] = nil
 	t.streams = t.streams[:len(t.streams)-1]
+	t.markPresent_(47, len(t.streams) > 0)
 
 }
 
@@ -5022,9 +6551,21 @@ func (t *OrderedCollectionPage) SetUnknownStreams(i interface{}) {
 
 }
 
+// StreamsValues returns an iterator over the values that GetStreams returns, which can be used as an iter.Seq
+func (t *OrderedCollectionPage) StreamsValues() (seq func(yield func(v *url.URL) bool)) {
+	return func(yield func(v *url.URL) bool) {
+		for i := 0; i < t.StreamsLen(); i++ {
+			if !yield(t.GetStreams(i)) {
+				return
+			}
+		}
+	}
+
+}
+
 // IsPreferredUsername determines whether the call to GetPreferredUsername is safe
 func (t *OrderedCollectionPage) IsPreferredUsername() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.stringName != nil
+	return t.present_[0]&(1<<48) != 0 && t.preferredUsername.stringName != nil
 
 }
 
@@ -5037,12 +6578,13 @@ func (t *OrderedCollectionPage) GetPreferredUsername() (v string) {
 // SetPreferredUsername sets the value of preferredUsername to be of string type
 func (t *OrderedCollectionPage) SetPreferredUsername(v string) {
 	t.preferredUsername = &preferredUsernameIntermediateType{stringName: &v}
+	t.markPresent_(48, true)
 
 }
 
 // IsPreferredUsernameIRI determines whether the call to GetPreferredUsernameIRI is safe
 func (t *OrderedCollectionPage) IsPreferredUsernameIRI() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.IRI != nil
+	return t.present_[0]&(1<<48) != 0 && t.preferredUsername.IRI != nil
 
 }
 
@@ -5055,12 +6597,13 @@ func (t *OrderedCollectionPage) GetPreferredUsernameIRI() (v *url.URL) {
 // SetPreferredUsernameIRI sets the value of preferredUsername to be of *url.URL type
 func (t *OrderedCollectionPage) SetPreferredUsernameIRI(v *url.URL) {
 	t.preferredUsername = &preferredUsernameIntermediateType{IRI: v}
+	t.markPresent_(48, true)
 
 }
 
 // HasUnknownPreferredUsername determines whether the call to GetUnknownPreferredUsername is safe
 func (t *OrderedCollectionPage) HasUnknownPreferredUsername() (ok bool) {
-	return t.preferredUsername != nil && t.preferredUsername.unknown_ != nil
+	return t.present_[0]&(1<<48) != 0 && t.preferredUsername.unknown_ != nil
 
 }
 
@@ -5078,6 +6621,7 @@ func (t *OrderedCollectionPage) SetUnknownPreferredUsername(i interface{}) {
 	tmp := &preferredUsernameIntermediateType{}
 	tmp.unknown_ = i
 	t.preferredUsername = tmp
+	t.markPresent_(48, true)
 
 }
 
@@ -5111,14 +6655,44 @@ func (t *OrderedCollectionPage) GetPreferredUsernameMap(l string) (v string) {
 func (t *OrderedCollectionPage) SetPreferredUsernameMap(l string, v string) {
 	if t.preferredUsernameMap == nil {
 		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(49, true)
 	}
 	t.preferredUsernameMap[l] = v
 
 }
 
+// GetPreferredUsernameLanguage returns the value of the property in the language of the tag, compared without regard to case, and whether there is one.
+func (t *OrderedCollectionPage) GetPreferredUsernameLanguage(tag string) (v string, ok bool) {
+	k, ok := languageKey(t.preferredUsernameMap, tag)
+	if !ok {
+		return "", false
+	}
+	return t.preferredUsernameMap[k], true
+
+}
+
+// SetPreferredUsernameLanguage sets the value of the property in the language of the tag, replacing any value in the same language whose tag differs in case.
+func (t *OrderedCollectionPage) SetPreferredUsernameLanguage(tag string, v string) {
+	if t.preferredUsernameMap == nil {
+		t.preferredUsernameMap = make(map[string]string)
+		t.markPresent_(49, true)
+	} else if k, ok := languageKey(t.preferredUsernameMap, tag); ok {
+		delete(t.preferredUsernameMap, k)
+	}
+	t.preferredUsernameMap[tag] = v
+
+}
+
+// PreferredPreferredUsernameLanguage returns the value of the property in the language best matching the tags, which are in order of preference, and the tag of that language. A tag such as "en-GB" also matches "en". If no language matches, the value in the first language in sorted order is returned, and the tag is empty only if the property has no language-specific values.
+func (t *OrderedCollectionPage) PreferredPreferredUsernameLanguage(tags ...string) (v string, tag string) {
+	tag, _ = preferredLanguage(t.preferredUsernameMap, tags)
+	return t.preferredUsernameMap[tag], tag
+
+}
+
 // IsEndpoints determines whether the call to GetEndpoints is safe
 func (t *OrderedCollectionPage) IsEndpoints() (ok bool) {
-	return t.endpoints != nil && t.endpoints.Object != nil
+	return t.present_[0]&(1<<50) != 0 && t.endpoints.Object != nil
 
 }
 
@@ -5131,12 +6705,13 @@ func (t *OrderedCollectionPage) GetEndpoints() (v ObjectType) {
 // SetEndpoints sets the value of endpoints to be of ObjectType type
 func (t *OrderedCollectionPage) SetEndpoints(v ObjectType) {
 	t.endpoints = &endpointsIntermediateType{Object: v}
+	t.markPresent_(50, true)
 
 }
 
 // IsEndpointsIRI determines whether the call to GetEndpointsIRI is safe
 func (t *OrderedCollectionPage) IsEndpointsIRI() (ok bool) {
-	return t.endpoints != nil && t.endpoints.IRI != nil
+	return t.present_[0]&(1<<50) != 0 && t.endpoints.IRI != nil
 
 }
 
@@ -5149,12 +6724,13 @@ func (t *OrderedCollectionPage) GetEndpointsIRI() (v *url.URL) {
 // SetEndpointsIRI sets the value of endpoints to be of *url.URL type
 func (t *OrderedCollectionPage) SetEndpointsIRI(v *url.URL) {
 	t.endpoints = &endpointsIntermediateType{IRI: v}
+	t.markPresent_(50, true)
 
 }
 
 // HasUnknownEndpoints determines whether the call to GetUnknownEndpoints is safe
 func (t *OrderedCollectionPage) HasUnknownEndpoints() (ok bool) {
-	return t.endpoints != nil && t.endpoints.unknown_ != nil
+	return t.present_[0]&(1<<50) != 0 && t.endpoints.unknown_ != nil
 
 }
 
@@ -5172,12 +6748,13 @@ func (t *OrderedCollectionPage) SetUnknownEndpoints(i interface{}) {
 	tmp := &endpointsIntermediateType{}
 	tmp.unknown_ = i
 	t.endpoints = tmp
+	t.markPresent_(50, true)
 
 }
 
 // HasProxyUrl determines whether the call to GetProxyUrl is safe
 func (t *OrderedCollectionPage) HasProxyUrl() (ok bool) {
-	return t.proxyUrl != nil
+	return t.present_[0]&(1<<51) != 0
 
 }
 
@@ -5190,6 +6767,7 @@ func (t *OrderedCollectionPage) GetProxyUrl() (v *url.URL) {
 // SetProxyUrl sets the value of proxyUrl
 func (t *OrderedCollectionPage) SetProxyUrl(v *url.URL) {
 	t.proxyUrl = v
+	t.markPresent_(51, true)
 
 }
 
@@ -5216,7 +6794,7 @@ func (t *OrderedCollectionPage) SetUnknownProxyUrl(i interface{}) {
 
 // HasOauthAuthorizationEndpoint determines whether the call to GetOauthAuthorizationEndpoint is safe
 func (t *OrderedCollectionPage) HasOauthAuthorizationEndpoint() (ok bool) {
-	return t.oauthAuthorizationEndpoint != nil
+	return t.present_[0]&(1<<52) != 0
 
 }
 
@@ -5229,6 +6807,7 @@ func (t *OrderedCollectionPage) GetOauthAuthorizationEndpoint() (v *url.URL) {
 // SetOauthAuthorizationEndpoint sets the value of oauthAuthorizationEndpoint
 func (t *OrderedCollectionPage) SetOauthAuthorizationEndpoint(v *url.URL) {
 	t.oauthAuthorizationEndpoint = v
+	t.markPresent_(52, true)
 
 }
 
@@ -5255,7 +6834,7 @@ func (t *OrderedCollectionPage) SetUnknownOauthAuthorizationEndpoint(i interface
 
 // HasOauthTokenEndpoint determines whether the call to GetOauthTokenEndpoint is safe
 func (t *OrderedCollectionPage) HasOauthTokenEndpoint() (ok bool) {
-	return t.oauthTokenEndpoint != nil
+	return t.present_[0]&(1<<53) != 0
 
 }
 
@@ -5268,6 +6847,7 @@ func (t *OrderedCollectionPage) GetOauthTokenEndpoint() (v *url.URL) {
 // SetOauthTokenEndpoint sets the value of oauthTokenEndpoint
 func (t *OrderedCollectionPage) SetOauthTokenEndpoint(v *url.URL) {
 	t.oauthTokenEndpoint = v
+	t.markPresent_(53, true)
 
 }
 
@@ -5294,7 +6874,7 @@ func (t *OrderedCollectionPage) SetUnknownOauthTokenEndpoint(i interface{}) {
 
 // HasProvideClientKey determines whether the call to GetProvideClientKey is safe
 func (t *OrderedCollectionPage) HasProvideClientKey() (ok bool) {
-	return t.provideClientKey != nil
+	return t.present_[0]&(1<<54) != 0
 
 }
 
@@ -5307,6 +6887,7 @@ func (t *OrderedCollectionPage) GetProvideClientKey() (v *url.URL) {
 // SetProvideClientKey sets the value of provideClientKey
 func (t *OrderedCollectionPage) SetProvideClientKey(v *url.URL) {
 	t.provideClientKey = v
+	t.markPresent_(54, true)
 
 }
 
@@ -5333,7 +6914,7 @@ func (t *OrderedCollectionPage) SetUnknownProvideClientKey(i interface{}) {
 
 // HasSignClientKey determines whether the call to GetSignClientKey is safe
 func (t *OrderedCollectionPage) HasSignClientKey() (ok bool) {
-	return t.signClientKey != nil
+	return t.present_[0]&(1<<55) != 0
 
 }
 
@@ -5346,6 +6927,7 @@ func (t *OrderedCollectionPage) GetSignClientKey() (v *url.URL) {
 // SetSignClientKey sets the value of signClientKey
 func (t *OrderedCollectionPage) SetSignClientKey(v *url.URL) {
 	t.signClientKey = v
+	t.markPresent_(55, true)
 
 }
 
@@ -5372,7 +6954,7 @@ func (t *OrderedCollectionPage) SetUnknownSignClientKey(i interface{}) {
 
 // HasSharedInbox determines whether the call to GetSharedInbox is safe
 func (t *OrderedCollectionPage) HasSharedInbox() (ok bool) {
-	return t.sharedInbox != nil
+	return t.present_[0]&(1<<56) != 0
 
 }
 
@@ -5385,6 +6967,7 @@ func (t *OrderedCollectionPage) GetSharedInbox() (v *url.URL) {
 // SetSharedInbox sets the value of sharedInbox
 func (t *OrderedCollectionPage) SetSharedInbox(v *url.URL) {
 	t.sharedInbox = v
+	t.markPresent_(56, true)
 
 }
 
@@ -5411,7 +6994,7 @@ func (t *OrderedCollectionPage) SetUnknownSharedInbox(i interface{}) {
 
 // IsSharesCollection determines whether the call to GetSharesCollection is safe
 func (t *OrderedCollectionPage) IsSharesCollection() (ok bool) {
-	return t.shares != nil && t.shares.Collection != nil
+	return t.present_[0]&(1<<57) != 0 && t.shares.Collection != nil
 
 }
 
@@ -5424,12 +7007,13 @@ func (t *OrderedCollectionPage) GetSharesCollection() (v CollectionType) {
 // SetSharesCollection sets the value of shares to be of CollectionType type
 func (t *OrderedCollectionPage) SetSharesCollection(v CollectionType) {
 	t.shares = &sharesIntermediateType{Collection: v}
+	t.markPresent_(57, true)
 
 }
 
 // IsSharesOrderedCollection determines whether the call to GetSharesOrderedCollection is safe
 func (t *OrderedCollectionPage) IsSharesOrderedCollection() (ok bool) {
-	return t.shares != nil && t.shares.OrderedCollection != nil
+	return t.present_[0]&(1<<57) != 0 && t.shares.OrderedCollection != nil
 
 }
 
@@ -5442,12 +7026,13 @@ func (t *OrderedCollectionPage) GetSharesOrderedCollection() (v OrderedCollectio
 // SetSharesOrderedCollection sets the value of shares to be of OrderedCollectionType type
 func (t *OrderedCollectionPage) SetSharesOrderedCollection(v OrderedCollectionType) {
 	t.shares = &sharesIntermediateType{OrderedCollection: v}
+	t.markPresent_(57, true)
 
 }
 
 // IsSharesAnyURI determines whether the call to GetSharesAnyURI is safe
 func (t *OrderedCollectionPage) IsSharesAnyURI() (ok bool) {
-	return t.shares != nil && t.shares.anyURI != nil
+	return t.present_[0]&(1<<57) != 0 && t.shares.anyURI != nil
 
 }
 
@@ -5460,12 +7045,13 @@ func (t *OrderedCollectionPage) GetSharesAnyURI() (v *url.URL) {
 // SetSharesAnyURI sets the value of shares to be of *url.URL type
 func (t *OrderedCollectionPage) SetSharesAnyURI(v *url.URL) {
 	t.shares = &sharesIntermediateType{anyURI: v}
+	t.markPresent_(57, true)
 
 }
 
 // HasUnknownShares determines whether the call to GetUnknownShares is safe
 func (t *OrderedCollectionPage) HasUnknownShares() (ok bool) {
-	return t.shares != nil && t.shares.unknown_ != nil
+	return t.present_[0]&(1<<57) != 0 && t.shares.unknown_ != nil
 
 }
 
@@ -5483,12 +7069,13 @@ func (t *OrderedCollectionPage) SetUnknownShares(i interface{}) {
 	tmp := &sharesIntermediateType{}
 	tmp.unknown_ = i
 	t.shares = tmp
+	t.markPresent_(57, true)
 
 }
 
 // IsPartOfLink determines whether the call to GetPartOfLink is safe
 func (t *OrderedCollectionPage) IsPartOfLink() (ok bool) {
-	return t.partOf != nil && t.partOf.Link != nil
+	return t.present_[0]&(1<<58) != 0 && t.partOf.Link != nil
 
 }
 
@@ -5501,12 +7088,13 @@ func (t *OrderedCollectionPage) GetPartOfLink() (v LinkType) {
 // SetPartOfLink sets the value of partOf to be of LinkType type
 func (t *OrderedCollectionPage) SetPartOfLink(v LinkType) {
 	t.partOf = &partOfIntermediateType{Link: v}
+	t.markPresent_(58, true)
 
 }
 
 // IsPartOfCollection determines whether the call to GetPartOfCollection is safe
 func (t *OrderedCollectionPage) IsPartOfCollection() (ok bool) {
-	return t.partOf != nil && t.partOf.Collection != nil
+	return t.present_[0]&(1<<58) != 0 && t.partOf.Collection != nil
 
 }
 
@@ -5519,12 +7107,13 @@ func (t *OrderedCollectionPage) GetPartOfCollection() (v CollectionType) {
 // SetPartOfCollection sets the value of partOf to be of CollectionType type
 func (t *OrderedCollectionPage) SetPartOfCollection(v CollectionType) {
 	t.partOf = &partOfIntermediateType{Collection: v}
+	t.markPresent_(58, true)
 
 }
 
 // IsPartOfIRI determines whether the call to GetPartOfIRI is safe
 func (t *OrderedCollectionPage) IsPartOfIRI() (ok bool) {
-	return t.partOf != nil && t.partOf.IRI != nil
+	return t.present_[0]&(1<<58) != 0 && t.partOf.IRI != nil
 
 }
 
@@ -5537,12 +7126,13 @@ func (t *OrderedCollectionPage) GetPartOfIRI() (v *url.URL) {
 // SetPartOfIRI sets the value of partOf to be of *url.URL type
 func (t *OrderedCollectionPage) SetPartOfIRI(v *url.URL) {
 	t.partOf = &partOfIntermediateType{IRI: v}
+	t.markPresent_(58, true)
 
 }
 
 // HasUnknownPartOf determines whether the call to GetUnknownPartOf is safe
 func (t *OrderedCollectionPage) HasUnknownPartOf() (ok bool) {
-	return t.partOf != nil && t.partOf.unknown_ != nil
+	return t.present_[0]&(1<<58) != 0 && t.partOf.unknown_ != nil
 
 }
 
@@ -5560,6 +7150,7 @@ func (t *OrderedCollectionPage) SetUnknownPartOf(i interface{}) {
 	tmp := &partOfIntermediateType{}
 	tmp.unknown_ = i
 	t.partOf = tmp
+	t.markPresent_(58, true)
 
 }
 
@@ -5596,6 +7187,12 @@ func (t *OrderedCollectionPage) RemoveItemsObject(index int) {
 
 }
 
+// ItemsObjectValues is NOT a valid property for this type; calling its associated methods will always yield an empty-equivalent value such as false, nil, or empty string. This includes instances where it should return itself. This ugliness is a symptom of the fundamental design of the ActivityStream vocabulary as instead of 'W-is-a-X' relationships it contains the notion of 'W-is-a-X-except-for-Y'.
+func (t *OrderedCollectionPage) ItemsObjectValues() (seq func(yield func(v ObjectType) bool)) {
+	return nil
+
+}
+
 // IsItemsLink is NOT a valid property for this type; calling its associated methods will always yield an empty-equivalent value such as false, nil, or empty string. This includes instances where it should return itself. This ugliness is a symptom of the fundamental design of the ActivityStream vocabulary as instead of 'W-is-a-X' relationships it contains the notion of 'W-is-a-X-except-for-Y'.
 func (t *OrderedCollectionPage) IsItemsLink(index int) (ok bool) {
 	return false
@@ -5623,6 +7220,12 @@ func (t *OrderedCollectionPage) RemoveItemsLink(index int) {
 
 }
 
+// ItemsLinkValues is NOT a valid property for this type; calling its associated methods will always yield an empty-equivalent value such as false, nil, or empty string. This includes instances where it should return itself. This ugliness is a symptom of the fundamental design of the ActivityStream vocabulary as instead of 'W-is-a-X' relationships it contains the notion of 'W-is-a-X-except-for-Y'.
+func (t *OrderedCollectionPage) ItemsLinkValues() (seq func(yield func(v LinkType) bool)) {
+	return nil
+
+}
+
 // IsItemsIRI is NOT a valid property for this type; calling its associated methods will always yield an empty-equivalent value such as false, nil, or empty string. This includes instances where it should return itself. This ugliness is a symptom of the fundamental design of the ActivityStream vocabulary as instead of 'W-is-a-X' relationships it contains the notion of 'W-is-a-X-except-for-Y'.
 func (t *OrderedCollectionPage) IsItemsIRI(index int) (ok bool) {
 	return false
@@ -5650,6 +7253,12 @@ func (t *OrderedCollectionPage) RemoveItemsIRI(index int) {
 
 }
 
+// ItemsIRIValues is NOT a valid property for this type; calling its associated methods will always yield an empty-equivalent value such as false, nil, or empty string. This includes instances where it should return itself. This ugliness is a symptom of the fundamental design of the ActivityStream vocabulary as instead of 'W-is-a-X' relationships it contains the notion of 'W-is-a-X-except-for-Y'.
+func (t *OrderedCollectionPage) ItemsIRIValues() (seq func(yield func(v *url.URL) bool)) {
+	return nil
+
+}
+
 // HasUnknownItems is NOT a valid property for this type; calling its associated methods will always yield an empty-equivalent value such as false, nil, or empty string. This includes instances where it should return itself. This ugliness is a symptom of the fundamental design of the ActivityStream vocabulary as instead of 'W-is-a-X' relationships it contains the notion of 'W-is-a-X-except-for-Y'.
 func (t *OrderedCollectionPage) HasUnknownItems() (ok bool) {
 	return false
@@ -5700,6 +7309,32 @@ func (t *OrderedCollectionPage) GetUnknown(k string) (i interface{}) {
 
 }
 
+// GetUnknownProperties returns a copy of every unknown property of this object by key, such as the extension properties it was deserialized with, or nil if there are none. Serialize writes them back, so they are never discarded.
+func (t *OrderedCollectionPage) GetUnknownProperties() (m map[string]interface{}) {
+	if len(t.unknown_) == 0 {
+		return nil
+	}
+	m = make(map[string]interface{}, len(t.unknown_))
+	for k, v := range t.unknown_ {
+		m[k] = v
+	}
+	return
+
+}
+
+// SetUnknownProperty sets the unknown property of this object with the specified key, or removes it if i is nil. Unlike AddUnknown, it does not return the object, so that every type shares its signature and extension vocabularies can set their properties of any type.
+func (t *OrderedCollectionPage) SetUnknownProperty(k string, i interface{}) {
+	if i == nil {
+		delete(t.unknown_, k)
+		return
+	}
+	if t.unknown_ == nil {
+		t.unknown_ = make(map[string]interface{})
+	}
+	t.unknown_[k] = i
+
+}
+
 // Serialize turns this object into a map[string]interface{}. Note that the "type" property will automatically be populated with "OrderedCollectionPage" if not manually set by the caller
 func (t *OrderedCollectionPage) Serialize() (m map[string]interface{}, err error) {
 	m = make(map[string]interface{})
@@ -5717,1674 +7352,1484 @@ func (t *OrderedCollectionPage) Serialize() (m map[string]interface{}, err error
 	}
 	if !typeAlreadySet {
 		t.typeName = append(t.typeName, "OrderedCollectionPage")
-	}
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.startIndex != nil {
-		if v, err := serializeStartIndexIntermediateType(t.startIndex); err == nil {
-			m["startIndex"] = v
-		} else {
-			return m, err
+		t.markPresent_(31, len(t.typeName) > 0)
+	}
+	if t.present_[0]&(1<<0) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.startIndex != nil {
+			if v, err := serializeStartIndexIntermediateType(t.startIndex); err == nil {
+				m["startIndex"] = v
+			} else {
+				return m, err
+			}
 		}
-	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.next != nil {
-		if v, err := serializeNextIntermediateType(t.next); err == nil {
-			m["next"] = v
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<1) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.next != nil {
+			if v, err := serializeNextIntermediateType(t.next); err == nil {
+				m["next"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<2) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.prev != nil {
+			if v, err := serializePrevIntermediateType(t.prev); err == nil {
+				m["prev"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.prev != nil {
-		if v, err := serializePrevIntermediateType(t.prev); err == nil {
-			m["prev"] = v
-		} else {
+	if t.present_[0]&(1<<3) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceOrderedItemsIntermediateType(t.orderedItems); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "orderedItems", v)
 		}
-	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceOrderedItemsIntermediateType(t.orderedItems); err == nil && v != nil {
-		if len(v) == 1 {
-			m["orderedItems"] = v[0]
-		} else {
-			m["orderedItems"] = v
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<4) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.current != nil {
+			if v, err := serializeCurrentIntermediateType(t.current); err == nil {
+				m["current"] = v
+			} else {
+				return m, err
+			}
 		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.current != nil {
-		if v, err := serializeCurrentIntermediateType(t.current); err == nil {
-			m["current"] = v
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<5) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.first != nil {
+			if v, err := serializeFirstIntermediateType(t.first); err == nil {
+				m["first"] = v
+			} else {
+				return m, err
+			}
 		}
-	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.first != nil {
-		if v, err := serializeFirstIntermediateType(t.first); err == nil {
-			m["first"] = v
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<6) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.last != nil {
+			if v, err := serializeLastIntermediateType(t.last); err == nil {
+				m["last"] = v
+			} else {
+				return m, err
+			}
 		}
-	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.last != nil {
-		if v, err := serializeLastIntermediateType(t.last); err == nil {
-			m["last"] = v
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<7) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.totalItems != nil {
+			if v, err := serializeTotalItemsIntermediateType(t.totalItems); err == nil {
+				m["totalItems"] = v
+			} else {
+				return m, err
+			}
 		}
-	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.totalItems != nil {
-		if v, err := serializeTotalItemsIntermediateType(t.totalItems); err == nil {
-			m["totalItems"] = v
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<8) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.altitude != nil {
+			if v, err := serializeAltitudeIntermediateType(t.altitude); err == nil {
+				m["altitude"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.altitude != nil {
-		if v, err := serializeAltitudeIntermediateType(t.altitude); err == nil {
-			m["altitude"] = v
-		} else {
+	if t.present_[0]&(1<<9) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttachmentIntermediateType(t.attachment); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attachment", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAttachmentIntermediateType(t.attachment); err == nil && v != nil {
-		if len(v) == 1 {
-			m["attachment"] = v[0]
-		} else {
-			m["attachment"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err == nil && v != nil {
-		if len(v) == 1 {
-			m["attributedTo"] = v[0]
-		} else {
-			m["attributedTo"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceAudienceIntermediateType(t.audience); err == nil && v != nil {
-		if len(v) == 1 {
-			m["audience"] = v[0]
-		} else {
-			m["audience"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceContentIntermediateType(t.content); err == nil && v != nil {
-		if len(v) == 1 {
-			m["content"] = v[0]
-		} else {
-			m["content"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.contentMap != nil && len(t.contentMap) >= 0 {
-		m["contentMap"] = t.contentMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceContextIntermediateType(t.context); err == nil && v != nil {
-		if len(v) == 1 {
-			m["context"] = v[0]
-		} else {
-			m["context"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceNameIntermediateType(t.name); err == nil && v != nil {
-		if len(v) == 1 {
-			m["name"] = v[0]
-		} else {
-			m["name"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.nameMap != nil && len(t.nameMap) >= 0 {
-		m["nameMap"] = t.nameMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.endTime != nil {
-		if v, err := serializeEndTimeIntermediateType(t.endTime); err == nil {
-			m["endTime"] = v
-		} else {
+	if t.present_[0]&(1<<10) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAttributedToIntermediateType(t.attributedTo); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "attributedTo", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceGeneratorIntermediateType(t.generator); err == nil && v != nil {
-		if len(v) == 1 {
-			m["generator"] = v[0]
-		} else {
-			m["generator"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceIconIntermediateType(t.icon); err == nil && v != nil {
-		if len(v) == 1 {
-			m["icon"] = v[0]
-		} else {
-			m["icon"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	if t.id != nil {
-		idSerializeFunc := func() (interface{}, error) {
-			v := t.id
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		idResult, err := idSerializeFunc()
-		if err == nil {
-			m["id"] = idResult
-		} else {
+	if t.present_[0]&(1<<11) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceAudienceIntermediateType(t.audience); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "audience", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceImageIntermediateType(t.image); err == nil && v != nil {
-		if len(v) == 1 {
-			m["image"] = v[0]
-		} else {
-			m["image"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceInReplyToIntermediateType(t.inReplyTo); err == nil && v != nil {
-		if len(v) == 1 {
-			m["inReplyTo"] = v[0]
-		} else {
-			m["inReplyTo"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceLocationIntermediateType(t.location); err == nil && v != nil {
-		if len(v) == 1 {
-			m["location"] = v[0]
-		} else {
-			m["location"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSlicePreviewIntermediateType(t.preview); err == nil && v != nil {
-		if len(v) == 1 {
-			m["preview"] = v[0]
-		} else {
-			m["preview"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.published != nil {
-		if v, err := serializePublishedIntermediateType(t.published); err == nil {
-			m["published"] = v
-		} else {
+	if t.present_[0]&(1<<12) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContentIntermediateType(t.content); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "content", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.replies != nil {
-		if v, err := serializeRepliesIntermediateType(t.replies); err == nil {
-			m["replies"] = v
-		} else {
-			return m, err
+
+	if t.present_[0]&(1<<13) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.contentMap != nil && len(t.contentMap) >= 0 {
+			m["contentMap"] = t.contentMap
 		}
+		// End generation by generateNaturalLanguageMap
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.startTime != nil {
-		if v, err := serializeStartTimeIntermediateType(t.startTime); err == nil {
-			m["startTime"] = v
-		} else {
+	if t.present_[0]&(1<<14) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceContextIntermediateType(t.context); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "context", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceSummaryIntermediateType(t.summary); err == nil && v != nil {
-		if len(v) == 1 {
-			m["summary"] = v[0]
-		} else {
-			m["summary"] = v
+	if t.present_[0]&(1<<15) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceNameIntermediateType(t.name); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "name", v)
 		}
-	} else if err != nil {
-		return m, err
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
 
-	// Begin generation by generateNaturalLanguageMap
-	if t.summaryMap != nil && len(t.summaryMap) >= 0 {
-		m["summaryMap"] = t.summaryMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceTagIntermediateType(t.tag); err == nil && v != nil {
-		if len(v) == 1 {
-			m["tag"] = v[0]
-		} else {
-			m["tag"] = v
+	if t.present_[0]&(1<<16) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.nameMap != nil && len(t.nameMap) >= 0 {
+			m["nameMap"] = t.nameMap
 		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalAnyDefinition
-	if t.typeName != nil {
-		if len(t.typeName) == 1 {
-			m["type"] = t.typeName[0]
-		} else {
-			m["type"] = t.typeName
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<17) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endTime != nil {
+			if v, err := serializeEndTimeIntermediateType(t.endTime); err == nil {
+				m["endTime"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateNonFunctionalAnyDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.updated != nil {
-		if v, err := serializeUpdatedIntermediateType(t.updated); err == nil {
-			m["updated"] = v
-		} else {
+	if t.present_[0]&(1<<18) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceGeneratorIntermediateType(t.generator); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "generator", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceUrlIntermediateType(t.url); err == nil && v != nil {
-		if len(v) == 1 {
-			m["url"] = v[0]
-		} else {
-			m["url"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceToIntermediateType(t.to); err == nil && v != nil {
-		if len(v) == 1 {
-			m["to"] = v[0]
-		} else {
-			m["to"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceBtoIntermediateType(t.bto); err == nil && v != nil {
-		if len(v) == 1 {
-			m["bto"] = v[0]
-		} else {
-			m["bto"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceCcIntermediateType(t.cc); err == nil && v != nil {
-		if len(v) == 1 {
-			m["cc"] = v[0]
-		} else {
-			m["cc"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateNonFunctionalMultiTypeDefinition
-	if v, err := serializeSliceBccIntermediateType(t.bcc); err == nil && v != nil {
-		if len(v) == 1 {
-			m["bcc"] = v[0]
-		} else {
-			m["bcc"] = v
-		}
-	} else if err != nil {
-		return m, err
-	}
-	// End generation by generateNonFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.mediaType != nil {
-		if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
-			m["mediaType"] = v
-		} else {
+	if t.present_[0]&(1<<19) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceIconIntermediateType(t.icon); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "icon", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<20) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.id != nil {
+			idSerializeFunc := func() (interface{}, error) {
+				v := t.id
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			idResult, err := idSerializeFunc()
+			if err == nil {
+				m["id"] = idResult
+			} else {
+				return m, err
+			}
 		}
+		// End generation by RangeReference.Serialize for Value
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.duration != nil {
-		if v, err := serializeDurationIntermediateType(t.duration); err == nil {
-			m["duration"] = v
-		} else {
+	if t.present_[0]&(1<<21) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceImageIntermediateType(t.image); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "image", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.source != nil {
-		if v, err := serializeSourceIntermediateType(t.source); err == nil {
-			m["source"] = v
-		} else {
+	if t.present_[0]&(1<<22) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceInReplyToIntermediateType(t.inReplyTo); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "inReplyTo", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.inbox != nil {
-		if v, err := serializeInboxIntermediateType(t.inbox); err == nil {
-			m["inbox"] = v
-		} else {
+	if t.present_[0]&(1<<23) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceLocationIntermediateType(t.location); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "location", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.outbox != nil {
-		if v, err := serializeOutboxIntermediateType(t.outbox); err == nil {
-			m["outbox"] = v
-		} else {
+	if t.present_[0]&(1<<24) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSlicePreviewIntermediateType(t.preview); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "preview", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<25) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.published != nil {
+			if v, err := serializePublishedIntermediateType(t.published); err == nil {
+				m["published"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<26) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.replies != nil {
+			if v, err := serializeRepliesIntermediateType(t.replies); err == nil {
+				m["replies"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<27) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.startTime != nil {
+			if v, err := serializeStartTimeIntermediateType(t.startTime); err == nil {
+				m["startTime"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.following != nil {
-		if v, err := serializeFollowingIntermediateType(t.following); err == nil {
-			m["following"] = v
-		} else {
+	if t.present_[0]&(1<<28) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceSummaryIntermediateType(t.summary); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "summary", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.followers != nil {
-		if v, err := serializeFollowersIntermediateType(t.followers); err == nil {
-			m["followers"] = v
-		} else {
-			return m, err
+
+	if t.present_[0]&(1<<29) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.summaryMap != nil && len(t.summaryMap) >= 0 {
+			m["summaryMap"] = t.summaryMap
 		}
+		// End generation by generateNaturalLanguageMap
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.liked != nil {
-		if v, err := serializeLikedIntermediateType(t.liked); err == nil {
-			m["liked"] = v
-		} else {
+	if t.present_[0]&(1<<30) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceTagIntermediateType(t.tag); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "tag", v)
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<31) != 0 {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if t.typeName != nil {
+			if len(t.typeName) == 1 {
+				m["type"] = t.typeName[0]
+			} else {
+				m["type"] = t.typeName
+			}
+		}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if t.present_[0]&(1<<32) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.updated != nil {
+			if v, err := serializeUpdatedIntermediateType(t.updated); err == nil {
+				m["updated"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.likes != nil {
-		if v, err := serializeLikesIntermediateType(t.likes); err == nil {
-			m["likes"] = v
-		} else {
+	if t.present_[0]&(1<<33) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceUrlIntermediateType(t.url); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "url", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	var streamsTemp []interface{}
-	for _, v := range t.streams {
-		tmp := anyURISerialize(v)
-		streamsTemp = append(streamsTemp, tmp)
-	}
-	if streamsTemp != nil {
-		if len(streamsTemp) == 1 {
-			m["streams"] = streamsTemp[0]
-		} else {
-			m["streams"] = streamsTemp
+	if t.present_[0]&(1<<34) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceToIntermediateType(t.to); err != nil {
+			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "to", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.preferredUsername != nil {
-		if v, err := serializePreferredUsernameIntermediateType(t.preferredUsername); err == nil {
-			m["preferredUsername"] = v
-		} else {
+	if t.present_[0]&(1<<35) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBtoIntermediateType(t.bto); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bto", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-
-	// Begin generation by generateNaturalLanguageMap
-	if t.preferredUsernameMap != nil && len(t.preferredUsernameMap) >= 0 {
-		m["preferredUsernameMap"] = t.preferredUsernameMap
-	}
-	// End generation by generateNaturalLanguageMap
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.endpoints != nil {
-		if v, err := serializeEndpointsIntermediateType(t.endpoints); err == nil {
-			m["endpoints"] = v
-		} else {
+	if t.present_[0]&(1<<36) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceCcIntermediateType(t.cc); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "cc", v)
 		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by RangeReference.Serialize for Value
-	if t.proxyUrl != nil {
-		proxyUrlSerializeFunc := func() (interface{}, error) {
-			v := t.proxyUrl
-			tmp := anyURISerialize(v)
-			return tmp, nil
-		}
-		proxyUrlResult, err := proxyUrlSerializeFunc()
-		if err == nil {
-			m["proxyUrl"] = proxyUrlResult
-		} else {
+	if t.present_[0]&(1<<37) != 0 {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if v, err := serializeSliceBccIntermediateType(t.bcc); err != nil {
 			return m, err
+		} else if v != nil {
+			setSerializedValues(m, "bcc", v)
 		}
-	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.oauthAuthorizationEndpoint != nil {
-		oauthAuthorizationEndpointSerializeFunc := func() (interface{}, error) {
-			v := t.oauthAuthorizationEndpoint
-			tmp := anyURISerialize(v)
-			return tmp, nil
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<38) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.mediaType != nil {
+			if v, err := serializeMediaTypeIntermediateType(t.mediaType); err == nil {
+				m["mediaType"] = v
+			} else {
+				return m, err
+			}
 		}
-		oauthAuthorizationEndpointResult, err := oauthAuthorizationEndpointSerializeFunc()
-		if err == nil {
-			m["oauthAuthorizationEndpoint"] = oauthAuthorizationEndpointResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<39) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.duration != nil {
+			if v, err := serializeDurationIntermediateType(t.duration); err == nil {
+				m["duration"] = v
+			} else {
+				return m, err
+			}
 		}
-	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.oauthTokenEndpoint != nil {
-		oauthTokenEndpointSerializeFunc := func() (interface{}, error) {
-			v := t.oauthTokenEndpoint
-			tmp := anyURISerialize(v)
-			return tmp, nil
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<40) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.source != nil {
+			if v, err := serializeSourceIntermediateType(t.source); err == nil {
+				m["source"] = v
+			} else {
+				return m, err
+			}
 		}
-		oauthTokenEndpointResult, err := oauthTokenEndpointSerializeFunc()
-		if err == nil {
-			m["oauthTokenEndpoint"] = oauthTokenEndpointResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<41) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.inbox != nil {
+			if v, err := serializeInboxIntermediateType(t.inbox); err == nil {
+				m["inbox"] = v
+			} else {
+				return m, err
+			}
 		}
-	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.provideClientKey != nil {
-		provideClientKeySerializeFunc := func() (interface{}, error) {
-			v := t.provideClientKey
-			tmp := anyURISerialize(v)
-			return tmp, nil
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<42) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.outbox != nil {
+			if v, err := serializeOutboxIntermediateType(t.outbox); err == nil {
+				m["outbox"] = v
+			} else {
+				return m, err
+			}
 		}
-		provideClientKeyResult, err := provideClientKeySerializeFunc()
-		if err == nil {
-			m["provideClientKey"] = provideClientKeyResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<43) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.following != nil {
+			if v, err := serializeFollowingIntermediateType(t.following); err == nil {
+				m["following"] = v
+			} else {
+				return m, err
+			}
 		}
-	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.signClientKey != nil {
-		signClientKeySerializeFunc := func() (interface{}, error) {
-			v := t.signClientKey
-			tmp := anyURISerialize(v)
-			return tmp, nil
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<44) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.followers != nil {
+			if v, err := serializeFollowersIntermediateType(t.followers); err == nil {
+				m["followers"] = v
+			} else {
+				return m, err
+			}
 		}
-		signClientKeyResult, err := signClientKeySerializeFunc()
-		if err == nil {
-			m["signClientKey"] = signClientKeyResult
-		} else {
-			return m, err
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<45) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.liked != nil {
+			if v, err := serializeLikedIntermediateType(t.liked); err == nil {
+				m["liked"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<46) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.likes != nil {
+			if v, err := serializeLikesIntermediateType(t.likes); err == nil {
+				m["likes"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by RangeReference.Serialize for Value
-	if t.sharedInbox != nil {
-		sharedInboxSerializeFunc := func() (interface{}, error) {
-			v := t.sharedInbox
+	if t.present_[0]&(1<<47) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		var streamsTemp []interface{}
+		for _, v := range t.streams {
 			tmp := anyURISerialize(v)
-			return tmp, nil
+			streamsTemp = append(streamsTemp, tmp)
 		}
-		sharedInboxResult, err := sharedInboxSerializeFunc()
-		if err == nil {
-			m["sharedInbox"] = sharedInboxResult
-		} else {
-			return m, err
+		if streamsTemp != nil {
+			if len(streamsTemp) == 1 {
+				m["streams"] = streamsTemp[0]
+			} else {
+				m["streams"] = streamsTemp
+			}
 		}
-	}
-	// End generation by RangeReference.Serialize for Value
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.shares != nil {
-		if v, err := serializeSharesIntermediateType(t.shares); err == nil {
-			m["shares"] = v
-		} else {
-			return m, err
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<48) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.preferredUsername != nil {
+			if v, err := serializePreferredUsernameIntermediateType(t.preferredUsername); err == nil {
+				m["preferredUsername"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
-	// Begin generation by generateFunctionalMultiTypeDefinition
-	if t.partOf != nil {
-		if v, err := serializePartOfIntermediateType(t.partOf); err == nil {
-			m["partOf"] = v
-		} else {
-			return m, err
+
+	if t.present_[0]&(1<<49) != 0 {
+		// Begin generation by generateNaturalLanguageMap
+		if t.preferredUsernameMap != nil && len(t.preferredUsernameMap) >= 0 {
+			m["preferredUsernameMap"] = t.preferredUsernameMap
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if t.present_[0]&(1<<50) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.endpoints != nil {
+			if v, err := serializeEndpointsIntermediateType(t.endpoints); err == nil {
+				m["endpoints"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<51) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.proxyUrl != nil {
+			proxyUrlSerializeFunc := func() (interface{}, error) {
+				v := t.proxyUrl
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			proxyUrlResult, err := proxyUrlSerializeFunc()
+			if err == nil {
+				m["proxyUrl"] = proxyUrlResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<52) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthAuthorizationEndpoint != nil {
+			oauthAuthorizationEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthAuthorizationEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			oauthAuthorizationEndpointResult, err := oauthAuthorizationEndpointSerializeFunc()
+			if err == nil {
+				m["oauthAuthorizationEndpoint"] = oauthAuthorizationEndpointResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<53) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.oauthTokenEndpoint != nil {
+			oauthTokenEndpointSerializeFunc := func() (interface{}, error) {
+				v := t.oauthTokenEndpoint
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			oauthTokenEndpointResult, err := oauthTokenEndpointSerializeFunc()
+			if err == nil {
+				m["oauthTokenEndpoint"] = oauthTokenEndpointResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<54) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.provideClientKey != nil {
+			provideClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.provideClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			provideClientKeyResult, err := provideClientKeySerializeFunc()
+			if err == nil {
+				m["provideClientKey"] = provideClientKeyResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<55) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.signClientKey != nil {
+			signClientKeySerializeFunc := func() (interface{}, error) {
+				v := t.signClientKey
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			signClientKeyResult, err := signClientKeySerializeFunc()
+			if err == nil {
+				m["signClientKey"] = signClientKeyResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<56) != 0 {
+		// Begin generation by RangeReference.Serialize for Value
+		if t.sharedInbox != nil {
+			sharedInboxSerializeFunc := func() (interface{}, error) {
+				v := t.sharedInbox
+				tmp := anyURISerialize(v)
+				return tmp, nil
+			}
+			sharedInboxResult, err := sharedInboxSerializeFunc()
+			if err == nil {
+				m["sharedInbox"] = sharedInboxResult
+			} else {
+				return m, err
+			}
+		}
+		// End generation by RangeReference.Serialize for Value
+	}
+	if t.present_[0]&(1<<57) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.shares != nil {
+			if v, err := serializeSharesIntermediateType(t.shares); err == nil {
+				m["shares"] = v
+			} else {
+				return m, err
+			}
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if t.present_[0]&(1<<58) != 0 {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if t.partOf != nil {
+			if v, err := serializePartOfIntermediateType(t.partOf); err == nil {
+				m["partOf"] = v
+			} else {
+				return m, err
+			}
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
 	}
-	// End generation by generateFunctionalMultiTypeDefinition
 	return
 
 }
 
-// Deserialize populates this object from a map[string]interface{}
+// Deserialize populates this object from a map[string]interface{}, renaming the properties its '@context' aliases with ResolveAliases
 func (t *OrderedCollectionPage) Deserialize(m map[string]interface{}) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	m = ResolveAliases(m)
 	for k, v := range m {
-		handled := false
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "startIndex" {
-				t.startIndex, err = deserializeStartIndexIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		if err = t.deserializeProperty_(k, v); err != nil {
+			return deserializeErrorAt(k, err)
+		}
+	}
+	return
+
+}
+
+// deserializeProperty_ populates the property of this object with the key, or its unknown property if it has none with the key
+func (t *OrderedCollectionPage) deserializeProperty_(k string, v interface{}) (err error) {
+	handled := false
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "startIndex" {
+			t.startIndex, err = deserializeStartIndexIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "next" {
-				t.next, err = deserializeNextIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "next" {
+			t.next, err = deserializeNextIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "prev" {
-				t.prev, err = deserializePrevIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "prev" {
+			t.prev, err = deserializePrevIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "orderedItems" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeOrderedItemsIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.orderedItems = []*orderedItemsIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.orderedItems, err = deserializeSliceOrderedItemsIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &orderedItemsIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.orderedItems = []*orderedItemsIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "orderedItems" {
+			t.orderedItems, err = deserializeValuesOrderedItemsIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "current" {
-				t.current, err = deserializeCurrentIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "current" {
+			t.current, err = deserializeCurrentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "first" {
-				t.first, err = deserializeFirstIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "first" {
+			t.first, err = deserializeFirstIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "last" {
-				t.last, err = deserializeLastIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "last" {
+			t.last, err = deserializeLastIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "totalItems" {
-				t.totalItems, err = deserializeTotalItemsIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "totalItems" {
+			t.totalItems, err = deserializeTotalItemsIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "altitude" {
-				t.altitude, err = deserializeAltitudeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "altitude" {
+			t.altitude, err = deserializeAltitudeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "attachment" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAttachmentIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.attachment = []*attachmentIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.attachment, err = deserializeSliceAttachmentIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &attachmentIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.attachment = []*attachmentIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attachment" {
+			t.attachment, err = deserializeValuesAttachmentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "attributedTo" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAttributedToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.attributedTo, err = deserializeSliceAttributedToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &attributedToIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.attributedTo = []*attributedToIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "attributedTo" {
+			t.attributedTo, err = deserializeValuesAttributedToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "audience" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeAudienceIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.audience = []*audienceIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.audience, err = deserializeSliceAudienceIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &audienceIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.audience = []*audienceIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "audience" {
+			t.audience, err = deserializeValuesAudienceIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "content" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeContentIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.content = []*contentIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.content, err = deserializeSliceContentIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &contentIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.content = []*contentIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "content" {
+			t.content, err = deserializeValuesContentIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "contentMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "contentMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					t.contentMap = val
-					handled = true
 				}
+				t.contentMap = val
+				handled = true
 			}
-			// End generation by generateNaturalLanguageMap
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "context" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeContextIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.context = []*contextIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.context, err = deserializeSliceContextIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &contextIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.context = []*contextIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "context" {
+			t.context, err = deserializeValuesContextIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "name" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeNameIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.name, err = deserializeSliceNameIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &nameIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.name = []*nameIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "name" {
+			t.name, err = deserializeValuesNameIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "nameMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "nameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
 					}
-					t.nameMap = val
-					handled = true
 				}
+				t.nameMap = val
+				handled = true
+			}
+		}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endTime" {
+			t.endTime, err = deserializeEndTimeIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "generator" {
+			t.generator, err = deserializeValuesGeneratorIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "icon" {
+			t.icon, err = deserializeValuesIconIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNaturalLanguageMap
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "endTime" {
-				t.endTime, err = deserializeEndTimeIntermediateType(v)
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "id" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.id = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "generator" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeGeneratorIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.generator = []*generatorIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.generator, err = deserializeSliceGeneratorIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &generatorIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.generator = []*generatorIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "image" {
+			t.image, err = deserializeValuesImageIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "icon" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeIconIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.icon = []*iconIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.icon, err = deserializeSliceIconIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &iconIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.icon = []*iconIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "inReplyTo" {
+			t.inReplyTo, err = deserializeValuesInReplyToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "id" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.id = tmp
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "location" {
+			t.location, err = deserializeValuesLocationIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by RangeReference.Deserialize for Value
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "image" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeImageIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.image = []*imageIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.image, err = deserializeSliceImageIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &imageIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.image = []*imageIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "preview" {
+			t.preview, err = deserializeValuesPreviewIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "inReplyTo" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeInReplyToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.inReplyTo = []*inReplyToIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.inReplyTo, err = deserializeSliceInReplyToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &inReplyToIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.inReplyTo = []*inReplyToIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "published" {
+			t.published, err = deserializePublishedIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "location" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeLocationIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.location = []*locationIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.location, err = deserializeSliceLocationIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &locationIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.location = []*locationIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "replies" {
+			t.replies, err = deserializeRepliesIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "preview" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializePreviewIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.preview, err = deserializeSlicePreviewIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &previewIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.preview = []*previewIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "startTime" {
+			t.startTime, err = deserializeStartTimeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "published" {
-				t.published, err = deserializePublishedIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "summary" {
+			t.summary, err = deserializeValuesSummaryIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "replies" {
-				t.replies, err = deserializeRepliesIntermediateType(v)
-				if err != nil {
-					return err
+		// End generation by generateNonFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "summaryMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
 				}
+				t.summaryMap = val
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "startTime" {
-				t.startTime, err = deserializeStartTimeIntermediateType(v)
-				if err != nil {
-					return err
-				}
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "tag" {
+			t.tag, err = deserializeValuesTagIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalAnyDefinition
+		if k == "type" {
+			if tmpTypeSlice, ok := v.([]interface{}); ok {
+				t.typeName = tmpTypeSlice
+				handled = true
+			} else {
+				t.typeName = []interface{}{v}
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "summary" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeSummaryIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.summary, err = deserializeSliceSummaryIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &summaryIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.summary = []*summaryIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalAnyDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "updated" {
+			t.updated, err = deserializeUpdatedIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "summaryMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.summaryMap = val
-					handled = true
-				}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "url" {
+			t.url, err = deserializeValuesUrlIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNaturalLanguageMap
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "tag" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeTagIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.tag = []*tagIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.tag, err = deserializeSliceTagIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &tagIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.tag = []*tagIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "to" {
+			t.to, err = deserializeValuesToIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by generateNonFunctionalAnyDefinition
-			if k == "type" {
-				if tmpTypeSlice, ok := v.([]interface{}); ok {
-					t.typeName = tmpTypeSlice
-					handled = true
-				} else {
-					t.typeName = []interface{}{v}
-					handled = true
-				}
+			handled = true
+		}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bto" {
+			t.bto, err = deserializeValuesBtoIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalAnyDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "updated" {
-				t.updated, err = deserializeUpdatedIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "cc" {
+			t.cc, err = deserializeValuesCcIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "url" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeUrlIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.url = []*urlIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.url, err = deserializeSliceUrlIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &urlIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.url = []*urlIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateNonFunctionalMultiTypeDefinition
+		if k == "bcc" {
+			t.bcc, err = deserializeValuesBccIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "to" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeToIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.to = []*toIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.to, err = deserializeSliceToIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &toIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.to = []*toIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateNonFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "mediaType" {
+			t.mediaType, err = deserializeMediaTypeIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "bto" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeBtoIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.bto = []*btoIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.bto, err = deserializeSliceBtoIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &btoIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.bto = []*btoIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "duration" {
+			t.duration, err = deserializeDurationIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "cc" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeCcIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.cc = []*ccIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.cc, err = deserializeSliceCcIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &ccIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.cc = []*ccIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "source" {
+			t.source, err = deserializeSourceIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateNonFunctionalMultiTypeDefinition
-			if k == "bcc" {
-				if tmpMap, ok := v.(map[string]interface{}); ok {
-					tmp, err := deserializeBccIntermediateType(tmpMap)
-					if err != nil {
-						return err
-					}
-					t.bcc = []*bccIntermediateType{tmp}
-					handled = true
-				} else if tmpSlice, ok := v.([]interface{}); ok {
-					t.bcc, err = deserializeSliceBccIntermediateType(tmpSlice)
-					if err != nil {
-						return err
-					}
-					handled = true
-				} else {
-					tmp := &bccIntermediateType{}
-					err = tmp.Deserialize(v)
-					if err != nil {
-						return err
-					}
-					t.bcc = []*bccIntermediateType{tmp}
-					handled = true
-				}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "inbox" {
+			t.inbox, err = deserializeInboxIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateNonFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "mediaType" {
-				t.mediaType, err = deserializeMediaTypeIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "outbox" {
+			t.outbox, err = deserializeOutboxIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "duration" {
-				t.duration, err = deserializeDurationIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "following" {
+			t.following, err = deserializeFollowingIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "source" {
-				t.source, err = deserializeSourceIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "followers" {
+			t.followers, err = deserializeFollowersIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "inbox" {
-				t.inbox, err = deserializeInboxIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "liked" {
+			t.liked, err = deserializeLikedIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "outbox" {
-				t.outbox, err = deserializeOutboxIntermediateType(v)
-				if err != nil {
-					return err
-				}
-				handled = true
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "likes" {
+			t.likes, err = deserializeLikesIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
+			handled = true
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "following" {
-				t.following, err = deserializeFollowingIntermediateType(v)
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "streams" {
+			if tmpSlice, ok := v.([]interface{}); ok {
+				for idx, tmpElem := range tmpSlice {
+					if v, ok := tmpElem.(interface{}); ok {
+						tmp, err := anyURIDeserialize(v)
+						if err != nil {
+							return deserializeErrorAt(fmt.Sprintf("[%d]", idx), err)
+						}
+						t.streams = append(t.streams, tmp)
+						handled = true
+					}
+				}
+			} else if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.streams = append(t.streams, tmp)
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "followers" {
-				t.followers, err = deserializeFollowersIntermediateType(v)
-				if err != nil {
-					return err
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "preferredUsername" {
+			t.preferredUsername, err = deserializePreferredUsernameIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+
+		// Begin generation by generateNaturalLanguageMap
+		if k == "preferredUsernameMap" {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				val := make(map[string]string)
+				for k, iVal := range vMap {
+					if sVal, ok := iVal.(string); ok {
+						val[k] = sVal
+					}
 				}
+				t.preferredUsernameMap = val
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "liked" {
-				t.liked, err = deserializeLikedIntermediateType(v)
+		// End generation by generateNaturalLanguageMap
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "endpoints" {
+			t.endpoints, err = deserializeEndpointsIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "proxyUrl" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.proxyUrl = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "likes" {
-				t.likes, err = deserializeLikesIntermediateType(v)
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthAuthorizationEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.oauthAuthorizationEndpoint = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "streams" {
-				if tmpSlice, ok := v.([]interface{}); ok {
-					for _, tmpElem := range tmpSlice {
-						if v, ok := tmpElem.(interface{}); ok {
-							tmp, err := anyURIDeserialize(v)
-							if err != nil {
-								return err
-							}
-							t.streams = append(t.streams, tmp)
-							handled = true
-						}
-					}
-				} else if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.streams = append(t.streams, tmp)
-					handled = true
-				}
-			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "preferredUsername" {
-				t.preferredUsername, err = deserializePreferredUsernameIntermediateType(v)
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "oauthTokenEndpoint" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.oauthTokenEndpoint = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-
-			// Begin generation by generateNaturalLanguageMap
-			if k == "preferredUsernameMap" {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					val := make(map[string]string)
-					for k, iVal := range vMap {
-						if sVal, ok := iVal.(string); ok {
-							val[k] = sVal
-						}
-					}
-					t.preferredUsernameMap = val
-					handled = true
-				}
-			}
-			// End generation by generateNaturalLanguageMap
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "endpoints" {
-				t.endpoints, err = deserializeEndpointsIntermediateType(v)
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "provideClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.provideClientKey = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "proxyUrl" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.proxyUrl = tmp
-					handled = true
-				}
-			}
-			// End generation by RangeReference.Deserialize for Value
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "oauthAuthorizationEndpoint" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.oauthAuthorizationEndpoint = tmp
-					handled = true
-				}
-			}
-			// End generation by RangeReference.Deserialize for Value
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "oauthTokenEndpoint" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.oauthTokenEndpoint = tmp
-					handled = true
-				}
-			}
-			// End generation by RangeReference.Deserialize for Value
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "provideClientKey" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.provideClientKey = tmp
-					handled = true
-				}
-			}
-			// End generation by RangeReference.Deserialize for Value
-		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "signClientKey" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.signClientKey = tmp
-					handled = true
-				}
-			}
-			// End generation by RangeReference.Deserialize for Value
 		}
-		if !handled {
-			// Begin generation by RangeReference.Deserialize for Value
-			if k == "sharedInbox" {
-				if v, ok := v.(interface{}); ok {
-					tmp, err := anyURIDeserialize(v)
-					if err != nil {
-						return err
-					}
-					t.sharedInbox = tmp
-					handled = true
-				}
-			}
-			// End generation by RangeReference.Deserialize for Value
-		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "shares" {
-				t.shares, err = deserializeSharesIntermediateType(v)
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "signClientKey" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.signClientKey = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled {
-			// Begin generation by generateFunctionalMultiTypeDefinition
-			if k == "partOf" {
-				t.partOf, err = deserializePartOfIntermediateType(v)
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by RangeReference.Deserialize for Value
+		if k == "sharedInbox" {
+			if v, ok := v.(interface{}); ok {
+				tmp, err := anyURIDeserialize(v)
 				if err != nil {
 					return err
 				}
+				t.sharedInbox = tmp
 				handled = true
 			}
-			// End generation by generateFunctionalMultiTypeDefinition
 		}
-		if !handled && k != "@context" {
-			if t.unknown_ == nil {
-				t.unknown_ = make(map[string]interface{})
+		// End generation by RangeReference.Deserialize for Value
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "shares" {
+			t.shares, err = deserializeSharesIntermediateType(v)
+			if err != nil {
+				return err
+			}
+			handled = true
+		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled {
+		// Begin generation by generateFunctionalMultiTypeDefinition
+		if k == "partOf" {
+			t.partOf, err = deserializePartOfIntermediateType(v)
+			if err != nil {
+				return err
 			}
-			t.unknown_[k] = unknownValueDeserialize(v)
+			handled = true
 		}
+		// End generation by generateFunctionalMultiTypeDefinition
+	}
+	if !handled && k != "@context" {
+		if t.unknown_ == nil {
+			t.unknown_ = make(map[string]interface{})
+		}
+		t.unknown_[k] = unknownValueDeserialize(v)
+	}
+	return
+
+}
+
+// MarshalJSON implements json.Marshaler by encoding this OrderedCollectionPage as JSON, with its properties in the order of the JSON it was unmarshalled from, if any
+func (t *OrderedCollectionPage) MarshalJSON() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	if t.order_ != nil {
+		b, err = encodeOrderedJSON(m, t.order_)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	return
+
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding JSON into this OrderedCollectionPage, recording the order of its properties for MarshalJSON
+func (t *OrderedCollectionPage) UnmarshalJSON(b []byte) (err error) {
+	m := make(map[string]interface{})
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	t.order_ = scanPropertyOrder(b)
+	return
+
+}
+
+// DecodeJSON populates this OrderedCollectionPage from the JSON object read from the decoder, deserializing each property as soon as it is decoded instead of decoding the whole object into a map first, which takes far less memory for large documents. Properties are renamed as Deserialize does, provided the '@context' comes before the properties it aliases. Unlike UnmarshalJSON, it does not record the order of the properties
+func (t *OrderedCollectionPage) DecodeJSON(dec *json.Decoder) (err error) {
+	defer t.markAllPresent_()
+	t.order_ = nil
+	return DecodeJSONProperties(dec, t.deserializeProperty_)
+
+}
+
+// SerializeCBOR encodes this OrderedCollectionPage as CBOR, which DeserializeCBOR decodes. The encoding is of the same data as JSON, so values can be exchanged in CBOR internally and in JSON with other servers.
+func (t *OrderedCollectionPage) SerializeCBOR() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	return encodeCBOR(m)
+
+}
+
+// DeserializeCBOR decodes CBOR, such as that of SerializeCBOR, into this OrderedCollectionPage
+func (t *OrderedCollectionPage) DeserializeCBOR(b []byte) (err error) {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode %T into OrderedCollectionPage", v)
+	}
+	return t.Deserialize(m)
+
+}
+
+// SerializeCanonical encodes this OrderedCollectionPage as canonical JSON by RFC 8785, so that equal values always have the same bytes, as signing them or addressing them by their content requires.
+func (t *OrderedCollectionPage) SerializeCanonical() (b []byte, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
 	}
+	return encodeJCS(m)
+
+}
+
+// Clone returns a deep copy of this OrderedCollectionPage, including its unknown properties and the values of all its properties, so that the copy can be modified without affecting the original
+func (t *OrderedCollectionPage) Clone() (c *OrderedCollectionPage, err error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return
+	}
+	c = &OrderedCollectionPage{}
+	err = c.Deserialize(cloneValue(m).(map[string]interface{}))
+	c.order_ = t.order_
 	return
 
 }
 
+// Equals determines whether this OrderedCollectionPage and the other one have the same canonical serialized form, such as when the same activity is delivered more than once
+func (t *OrderedCollectionPage) Equals(other *OrderedCollectionPage) (eq bool) {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return canonicalEquals(t, other)
+
+}
+
+// Hash returns the SHA-256 hash of the canonical serialized form of this OrderedCollectionPage, so that equal values have the same hash. It returns the zero hash if this OrderedCollectionPage cannot be serialized
+func (t *OrderedCollectionPage) Hash() (h [32]byte) {
+	return canonicalHash(t)
+
+}
+
 // IsPublic returns true if the 'to', 'bto', 'cc', or 'bcc' properties address the special Public ActivityPub collection
 func (t *OrderedCollectionPage) IsPublic() (b bool) {
 	for i := 0; i < t.ToLen(); i++ {
@@ -7410,3 +8855,3541 @@ func (t *OrderedCollectionPage) IsPublic() (b bool) {
 	return false
 
 }
+
+// Kind returns OrderedCollectionPageKind.
+func (t *OrderedCollectionPage) Kind() (k TypeKind) {
+	return OrderedCollectionPageKind
+
+}
+
+// Validate returns ValidationErrors listing every property required by the specification that this OrderedCollectionPage is missing, or nil if it has them all
+func (t *OrderedCollectionPage) Validate() (err error) {
+	return
+
+}
+
+// ValidateStrict returns ValidationErrors listing what Validate does, and every unknown property of this OrderedCollectionPage, every property with a value of none of the types it takes, and every inlined value that is itself invalid, or nil if there are none. It is meant for rejecting values that are not exactly as specified, such as those posted by clients
+func (t *OrderedCollectionPage) ValidateStrict() (err error) {
+	errs, _ := t.Validate().(ValidationErrors)
+	if t.startIndex != nil {
+		errs = t.startIndex.strict(errs, "OrderedCollectionPage", "startIndex")
+	}
+	if t.next != nil {
+		errs = t.next.strict(errs, "OrderedCollectionPage", "next")
+	}
+	if t.prev != nil {
+		errs = t.prev.strict(errs, "OrderedCollectionPage", "prev")
+	}
+	for _, v := range t.orderedItems {
+		errs = v.strict(errs, "OrderedCollectionPage", "orderedItems")
+	}
+	if t.current != nil {
+		errs = t.current.strict(errs, "OrderedCollectionPage", "current")
+	}
+	if t.first != nil {
+		errs = t.first.strict(errs, "OrderedCollectionPage", "first")
+	}
+	if t.last != nil {
+		errs = t.last.strict(errs, "OrderedCollectionPage", "last")
+	}
+	if t.totalItems != nil {
+		errs = t.totalItems.strict(errs, "OrderedCollectionPage", "totalItems")
+	}
+	if t.altitude != nil {
+		errs = t.altitude.strict(errs, "OrderedCollectionPage", "altitude")
+	}
+	for _, v := range t.attachment {
+		errs = v.strict(errs, "OrderedCollectionPage", "attachment")
+	}
+	for _, v := range t.attributedTo {
+		errs = v.strict(errs, "OrderedCollectionPage", "attributedTo")
+	}
+	for _, v := range t.audience {
+		errs = v.strict(errs, "OrderedCollectionPage", "audience")
+	}
+	for _, v := range t.content {
+		errs = v.strict(errs, "OrderedCollectionPage", "content")
+	}
+	for _, v := range t.context {
+		errs = v.strict(errs, "OrderedCollectionPage", "context")
+	}
+	for _, v := range t.name {
+		errs = v.strict(errs, "OrderedCollectionPage", "name")
+	}
+	if t.endTime != nil {
+		errs = t.endTime.strict(errs, "OrderedCollectionPage", "endTime")
+	}
+	for _, v := range t.generator {
+		errs = v.strict(errs, "OrderedCollectionPage", "generator")
+	}
+	for _, v := range t.icon {
+		errs = v.strict(errs, "OrderedCollectionPage", "icon")
+	}
+	for _, v := range t.image {
+		errs = v.strict(errs, "OrderedCollectionPage", "image")
+	}
+	for _, v := range t.inReplyTo {
+		errs = v.strict(errs, "OrderedCollectionPage", "inReplyTo")
+	}
+	for _, v := range t.location {
+		errs = v.strict(errs, "OrderedCollectionPage", "location")
+	}
+	for _, v := range t.preview {
+		errs = v.strict(errs, "OrderedCollectionPage", "preview")
+	}
+	if t.published != nil {
+		errs = t.published.strict(errs, "OrderedCollectionPage", "published")
+	}
+	if t.replies != nil {
+		errs = t.replies.strict(errs, "OrderedCollectionPage", "replies")
+	}
+	if t.startTime != nil {
+		errs = t.startTime.strict(errs, "OrderedCollectionPage", "startTime")
+	}
+	for _, v := range t.summary {
+		errs = v.strict(errs, "OrderedCollectionPage", "summary")
+	}
+	for _, v := range t.tag {
+		errs = v.strict(errs, "OrderedCollectionPage", "tag")
+	}
+	if t.updated != nil {
+		errs = t.updated.strict(errs, "OrderedCollectionPage", "updated")
+	}
+	for _, v := range t.url {
+		errs = v.strict(errs, "OrderedCollectionPage", "url")
+	}
+	for _, v := range t.to {
+		errs = v.strict(errs, "OrderedCollectionPage", "to")
+	}
+	for _, v := range t.bto {
+		errs = v.strict(errs, "OrderedCollectionPage", "bto")
+	}
+	for _, v := range t.cc {
+		errs = v.strict(errs, "OrderedCollectionPage", "cc")
+	}
+	for _, v := range t.bcc {
+		errs = v.strict(errs, "OrderedCollectionPage", "bcc")
+	}
+	if t.mediaType != nil {
+		errs = t.mediaType.strict(errs, "OrderedCollectionPage", "mediaType")
+	}
+	if t.duration != nil {
+		errs = t.duration.strict(errs, "OrderedCollectionPage", "duration")
+	}
+	if t.source != nil {
+		errs = t.source.strict(errs, "OrderedCollectionPage", "source")
+	}
+	if t.inbox != nil {
+		errs = t.inbox.strict(errs, "OrderedCollectionPage", "inbox")
+	}
+	if t.outbox != nil {
+		errs = t.outbox.strict(errs, "OrderedCollectionPage", "outbox")
+	}
+	if t.following != nil {
+		errs = t.following.strict(errs, "OrderedCollectionPage", "following")
+	}
+	if t.followers != nil {
+		errs = t.followers.strict(errs, "OrderedCollectionPage", "followers")
+	}
+	if t.liked != nil {
+		errs = t.liked.strict(errs, "OrderedCollectionPage", "liked")
+	}
+	if t.likes != nil {
+		errs = t.likes.strict(errs, "OrderedCollectionPage", "likes")
+	}
+	if t.preferredUsername != nil {
+		errs = t.preferredUsername.strict(errs, "OrderedCollectionPage", "preferredUsername")
+	}
+	if t.endpoints != nil {
+		errs = t.endpoints.strict(errs, "OrderedCollectionPage", "endpoints")
+	}
+	if t.shares != nil {
+		errs = t.shares.strict(errs, "OrderedCollectionPage", "shares")
+	}
+	if t.partOf != nil {
+		errs = t.partOf.strict(errs, "OrderedCollectionPage", "partOf")
+	}
+	keys := make([]string, 0, len(t.unknown_))
+	for k := range t.unknown_ {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch k {
+		case "id", "streams", "proxyUrl", "oauthAuthorizationEndpoint", "oauthTokenEndpoint", "provideClientKey", "signClientKey", "sharedInbox":
+			errs = append(errs, &MismatchedPropertyError{Type: "OrderedCollectionPage", Property: k})
+		default:
+			errs = append(errs, &UnknownPropertyError{Type: "OrderedCollectionPage", Property: k})
+		}
+	}
+	if len(errs) > 0 {
+		err = errs
+	}
+	return
+
+}
+
+// DeserializeStrict populates this OrderedCollectionPage from a map[string]interface{} like Deserialize, then returns the problems ValidateStrict finds with it, if any
+func (t *OrderedCollectionPage) DeserializeStrict(m map[string]interface{}) (err error) {
+	if err = t.Deserialize(m); err != nil {
+		return
+	}
+	return t.ValidateStrict()
+
+}
+
+// DeserializeLenient populates this OrderedCollectionPage from a map[string]interface{} like Deserialize, but parses the xsd:dateTime values of its properties, and of the values it contains, with ParseDateTimeLenient when ParseDateTimeStrict rejects them. It is meant for values from servers emitting malformed dates, and leaves the map unchanged
+func (t *OrderedCollectionPage) DeserializeLenient(m map[string]interface{}) (err error) {
+	return t.Deserialize(lenientDateTimes(m).(map[string]interface{}))
+
+}
+
+// TryGetStartIndex returns the value GetStartIndex returns, or an AccessorError if IsStartIndex returns false
+func (t *OrderedCollectionPage) TryGetStartIndex() (v int64, err error) {
+	if !t.IsStartIndex() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetStartIndex", Index: -1}
+		return
+	}
+	return t.GetStartIndex(), nil
+
+}
+
+// TryGetStartIndexIRI returns the value GetStartIndexIRI returns, or an AccessorError if IsStartIndexIRI returns false
+func (t *OrderedCollectionPage) TryGetStartIndexIRI() (v *url.URL, err error) {
+	if !t.IsStartIndexIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetStartIndexIRI", Index: -1}
+		return
+	}
+	return t.GetStartIndexIRI(), nil
+
+}
+
+// TryGetUnknownStartIndex returns the value GetUnknownStartIndex returns, or an AccessorError if HasUnknownStartIndex returns false
+func (t *OrderedCollectionPage) TryGetUnknownStartIndex() (v interface{}, err error) {
+	if !t.HasUnknownStartIndex() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownStartIndex", Index: -1}
+		return
+	}
+	return t.GetUnknownStartIndex(), nil
+
+}
+
+// TryGetNextOrderedCollectionPage returns the value GetNextOrderedCollectionPage returns, or an AccessorError if IsNextOrderedCollectionPage returns false
+func (t *OrderedCollectionPage) TryGetNextOrderedCollectionPage() (v OrderedCollectionPageType, err error) {
+	if !t.IsNextOrderedCollectionPage() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetNextOrderedCollectionPage", Index: -1}
+		return
+	}
+	return t.GetNextOrderedCollectionPage(), nil
+
+}
+
+// TryGetNextLink returns the value GetNextLink returns, or an AccessorError if IsNextLink returns false
+func (t *OrderedCollectionPage) TryGetNextLink() (v LinkType, err error) {
+	if !t.IsNextLink() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetNextLink", Index: -1}
+		return
+	}
+	return t.GetNextLink(), nil
+
+}
+
+// TryGetNextIRI returns the value GetNextIRI returns, or an AccessorError if IsNextIRI returns false
+func (t *OrderedCollectionPage) TryGetNextIRI() (v *url.URL, err error) {
+	if !t.IsNextIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetNextIRI", Index: -1}
+		return
+	}
+	return t.GetNextIRI(), nil
+
+}
+
+// TryGetUnknownNext returns the value GetUnknownNext returns, or an AccessorError if HasUnknownNext returns false
+func (t *OrderedCollectionPage) TryGetUnknownNext() (v interface{}, err error) {
+	if !t.HasUnknownNext() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownNext", Index: -1}
+		return
+	}
+	return t.GetUnknownNext(), nil
+
+}
+
+// TryGetPrevOrderedCollectionPage returns the value GetPrevOrderedCollectionPage returns, or an AccessorError if IsPrevOrderedCollectionPage returns false
+func (t *OrderedCollectionPage) TryGetPrevOrderedCollectionPage() (v OrderedCollectionPageType, err error) {
+	if !t.IsPrevOrderedCollectionPage() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPrevOrderedCollectionPage", Index: -1}
+		return
+	}
+	return t.GetPrevOrderedCollectionPage(), nil
+
+}
+
+// TryGetPrevLink returns the value GetPrevLink returns, or an AccessorError if IsPrevLink returns false
+func (t *OrderedCollectionPage) TryGetPrevLink() (v LinkType, err error) {
+	if !t.IsPrevLink() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPrevLink", Index: -1}
+		return
+	}
+	return t.GetPrevLink(), nil
+
+}
+
+// TryGetPrevIRI returns the value GetPrevIRI returns, or an AccessorError if IsPrevIRI returns false
+func (t *OrderedCollectionPage) TryGetPrevIRI() (v *url.URL, err error) {
+	if !t.IsPrevIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPrevIRI", Index: -1}
+		return
+	}
+	return t.GetPrevIRI(), nil
+
+}
+
+// TryGetUnknownPrev returns the value GetUnknownPrev returns, or an AccessorError if HasUnknownPrev returns false
+func (t *OrderedCollectionPage) TryGetUnknownPrev() (v interface{}, err error) {
+	if !t.HasUnknownPrev() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownPrev", Index: -1}
+		return
+	}
+	return t.GetUnknownPrev(), nil
+
+}
+
+// TryGetOrderedItemsObject returns the value GetOrderedItemsObject returns, or an AccessorError if IsOrderedItemsObject returns false
+func (t *OrderedCollectionPage) TryGetOrderedItemsObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.OrderedItemsLen() || !t.IsOrderedItemsObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetOrderedItemsObject", Index: index}
+		return
+	}
+	return t.GetOrderedItemsObject(index), nil
+
+}
+
+// TryGetOrderedItemsLink returns the value GetOrderedItemsLink returns, or an AccessorError if IsOrderedItemsLink returns false
+func (t *OrderedCollectionPage) TryGetOrderedItemsLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.OrderedItemsLen() || !t.IsOrderedItemsLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetOrderedItemsLink", Index: index}
+		return
+	}
+	return t.GetOrderedItemsLink(index), nil
+
+}
+
+// TryGetOrderedItemsIRI returns the value GetOrderedItemsIRI returns, or an AccessorError if IsOrderedItemsIRI returns false
+func (t *OrderedCollectionPage) TryGetOrderedItemsIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.OrderedItemsLen() || !t.IsOrderedItemsIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetOrderedItemsIRI", Index: index}
+		return
+	}
+	return t.GetOrderedItemsIRI(index), nil
+
+}
+
+// TryGetUnknownOrderedItems returns the value GetUnknownOrderedItems returns, or an AccessorError if HasUnknownOrderedItems returns false
+func (t *OrderedCollectionPage) TryGetUnknownOrderedItems() (v interface{}, err error) {
+	if !t.HasUnknownOrderedItems() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownOrderedItems", Index: -1}
+		return
+	}
+	return t.GetUnknownOrderedItems(), nil
+
+}
+
+// TryGetCurrentOrderedCollectionPage returns the value GetCurrentOrderedCollectionPage returns, or an AccessorError if IsCurrentOrderedCollectionPage returns false
+func (t *OrderedCollectionPage) TryGetCurrentOrderedCollectionPage() (v OrderedCollectionPageType, err error) {
+	if !t.IsCurrentOrderedCollectionPage() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetCurrentOrderedCollectionPage", Index: -1}
+		return
+	}
+	return t.GetCurrentOrderedCollectionPage(), nil
+
+}
+
+// TryGetCurrentLink returns the value GetCurrentLink returns, or an AccessorError if IsCurrentLink returns false
+func (t *OrderedCollectionPage) TryGetCurrentLink() (v LinkType, err error) {
+	if !t.IsCurrentLink() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetCurrentLink", Index: -1}
+		return
+	}
+	return t.GetCurrentLink(), nil
+
+}
+
+// TryGetCurrentIRI returns the value GetCurrentIRI returns, or an AccessorError if IsCurrentIRI returns false
+func (t *OrderedCollectionPage) TryGetCurrentIRI() (v *url.URL, err error) {
+	if !t.IsCurrentIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetCurrentIRI", Index: -1}
+		return
+	}
+	return t.GetCurrentIRI(), nil
+
+}
+
+// TryGetUnknownCurrent returns the value GetUnknownCurrent returns, or an AccessorError if HasUnknownCurrent returns false
+func (t *OrderedCollectionPage) TryGetUnknownCurrent() (v interface{}, err error) {
+	if !t.HasUnknownCurrent() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownCurrent", Index: -1}
+		return
+	}
+	return t.GetUnknownCurrent(), nil
+
+}
+
+// TryGetFirstOrderedCollectionPage returns the value GetFirstOrderedCollectionPage returns, or an AccessorError if IsFirstOrderedCollectionPage returns false
+func (t *OrderedCollectionPage) TryGetFirstOrderedCollectionPage() (v OrderedCollectionPageType, err error) {
+	if !t.IsFirstOrderedCollectionPage() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFirstOrderedCollectionPage", Index: -1}
+		return
+	}
+	return t.GetFirstOrderedCollectionPage(), nil
+
+}
+
+// TryGetFirstLink returns the value GetFirstLink returns, or an AccessorError if IsFirstLink returns false
+func (t *OrderedCollectionPage) TryGetFirstLink() (v LinkType, err error) {
+	if !t.IsFirstLink() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFirstLink", Index: -1}
+		return
+	}
+	return t.GetFirstLink(), nil
+
+}
+
+// TryGetFirstIRI returns the value GetFirstIRI returns, or an AccessorError if IsFirstIRI returns false
+func (t *OrderedCollectionPage) TryGetFirstIRI() (v *url.URL, err error) {
+	if !t.IsFirstIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFirstIRI", Index: -1}
+		return
+	}
+	return t.GetFirstIRI(), nil
+
+}
+
+// TryGetUnknownFirst returns the value GetUnknownFirst returns, or an AccessorError if HasUnknownFirst returns false
+func (t *OrderedCollectionPage) TryGetUnknownFirst() (v interface{}, err error) {
+	if !t.HasUnknownFirst() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownFirst", Index: -1}
+		return
+	}
+	return t.GetUnknownFirst(), nil
+
+}
+
+// TryGetLastOrderedCollectionPage returns the value GetLastOrderedCollectionPage returns, or an AccessorError if IsLastOrderedCollectionPage returns false
+func (t *OrderedCollectionPage) TryGetLastOrderedCollectionPage() (v OrderedCollectionPageType, err error) {
+	if !t.IsLastOrderedCollectionPage() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLastOrderedCollectionPage", Index: -1}
+		return
+	}
+	return t.GetLastOrderedCollectionPage(), nil
+
+}
+
+// TryGetLastLink returns the value GetLastLink returns, or an AccessorError if IsLastLink returns false
+func (t *OrderedCollectionPage) TryGetLastLink() (v LinkType, err error) {
+	if !t.IsLastLink() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLastLink", Index: -1}
+		return
+	}
+	return t.GetLastLink(), nil
+
+}
+
+// TryGetLastIRI returns the value GetLastIRI returns, or an AccessorError if IsLastIRI returns false
+func (t *OrderedCollectionPage) TryGetLastIRI() (v *url.URL, err error) {
+	if !t.IsLastIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLastIRI", Index: -1}
+		return
+	}
+	return t.GetLastIRI(), nil
+
+}
+
+// TryGetUnknownLast returns the value GetUnknownLast returns, or an AccessorError if HasUnknownLast returns false
+func (t *OrderedCollectionPage) TryGetUnknownLast() (v interface{}, err error) {
+	if !t.HasUnknownLast() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownLast", Index: -1}
+		return
+	}
+	return t.GetUnknownLast(), nil
+
+}
+
+// TryGetTotalItems returns the value GetTotalItems returns, or an AccessorError if IsTotalItems returns false
+func (t *OrderedCollectionPage) TryGetTotalItems() (v int64, err error) {
+	if !t.IsTotalItems() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetTotalItems", Index: -1}
+		return
+	}
+	return t.GetTotalItems(), nil
+
+}
+
+// TryGetTotalItemsIRI returns the value GetTotalItemsIRI returns, or an AccessorError if IsTotalItemsIRI returns false
+func (t *OrderedCollectionPage) TryGetTotalItemsIRI() (v *url.URL, err error) {
+	if !t.IsTotalItemsIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetTotalItemsIRI", Index: -1}
+		return
+	}
+	return t.GetTotalItemsIRI(), nil
+
+}
+
+// TryGetUnknownTotalItems returns the value GetUnknownTotalItems returns, or an AccessorError if HasUnknownTotalItems returns false
+func (t *OrderedCollectionPage) TryGetUnknownTotalItems() (v interface{}, err error) {
+	if !t.HasUnknownTotalItems() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownTotalItems", Index: -1}
+		return
+	}
+	return t.GetUnknownTotalItems(), nil
+
+}
+
+// TryGetAltitude returns the value GetAltitude returns, or an AccessorError if IsAltitude returns false
+func (t *OrderedCollectionPage) TryGetAltitude() (v float64, err error) {
+	if !t.IsAltitude() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAltitude", Index: -1}
+		return
+	}
+	return t.GetAltitude(), nil
+
+}
+
+// TryGetAltitudeIRI returns the value GetAltitudeIRI returns, or an AccessorError if IsAltitudeIRI returns false
+func (t *OrderedCollectionPage) TryGetAltitudeIRI() (v *url.URL, err error) {
+	if !t.IsAltitudeIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAltitudeIRI", Index: -1}
+		return
+	}
+	return t.GetAltitudeIRI(), nil
+
+}
+
+// TryGetUnknownAltitude returns the value GetUnknownAltitude returns, or an AccessorError if HasUnknownAltitude returns false
+func (t *OrderedCollectionPage) TryGetUnknownAltitude() (v interface{}, err error) {
+	if !t.HasUnknownAltitude() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownAltitude", Index: -1}
+		return
+	}
+	return t.GetUnknownAltitude(), nil
+
+}
+
+// TryGetAttachmentObject returns the value GetAttachmentObject returns, or an AccessorError if IsAttachmentObject returns false
+func (t *OrderedCollectionPage) TryGetAttachmentObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAttachmentObject", Index: index}
+		return
+	}
+	return t.GetAttachmentObject(index), nil
+
+}
+
+// TryGetAttachmentLink returns the value GetAttachmentLink returns, or an AccessorError if IsAttachmentLink returns false
+func (t *OrderedCollectionPage) TryGetAttachmentLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAttachmentLink", Index: index}
+		return
+	}
+	return t.GetAttachmentLink(index), nil
+
+}
+
+// TryGetAttachmentIRI returns the value GetAttachmentIRI returns, or an AccessorError if IsAttachmentIRI returns false
+func (t *OrderedCollectionPage) TryGetAttachmentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttachmentLen() || !t.IsAttachmentIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAttachmentIRI", Index: index}
+		return
+	}
+	return t.GetAttachmentIRI(index), nil
+
+}
+
+// TryGetUnknownAttachment returns the value GetUnknownAttachment returns, or an AccessorError if HasUnknownAttachment returns false
+func (t *OrderedCollectionPage) TryGetUnknownAttachment() (v interface{}, err error) {
+	if !t.HasUnknownAttachment() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownAttachment", Index: -1}
+		return
+	}
+	return t.GetUnknownAttachment(), nil
+
+}
+
+// TryGetAttributedToObject returns the value GetAttributedToObject returns, or an AccessorError if IsAttributedToObject returns false
+func (t *OrderedCollectionPage) TryGetAttributedToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAttributedToObject", Index: index}
+		return
+	}
+	return t.GetAttributedToObject(index), nil
+
+}
+
+// TryGetAttributedToLink returns the value GetAttributedToLink returns, or an AccessorError if IsAttributedToLink returns false
+func (t *OrderedCollectionPage) TryGetAttributedToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAttributedToLink", Index: index}
+		return
+	}
+	return t.GetAttributedToLink(index), nil
+
+}
+
+// TryGetAttributedToIRI returns the value GetAttributedToIRI returns, or an AccessorError if IsAttributedToIRI returns false
+func (t *OrderedCollectionPage) TryGetAttributedToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AttributedToLen() || !t.IsAttributedToIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAttributedToIRI", Index: index}
+		return
+	}
+	return t.GetAttributedToIRI(index), nil
+
+}
+
+// TryGetUnknownAttributedTo returns the value GetUnknownAttributedTo returns, or an AccessorError if HasUnknownAttributedTo returns false
+func (t *OrderedCollectionPage) TryGetUnknownAttributedTo() (v interface{}, err error) {
+	if !t.HasUnknownAttributedTo() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownAttributedTo", Index: -1}
+		return
+	}
+	return t.GetUnknownAttributedTo(), nil
+
+}
+
+// TryGetAudienceObject returns the value GetAudienceObject returns, or an AccessorError if IsAudienceObject returns false
+func (t *OrderedCollectionPage) TryGetAudienceObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAudienceObject", Index: index}
+		return
+	}
+	return t.GetAudienceObject(index), nil
+
+}
+
+// TryGetAudienceLink returns the value GetAudienceLink returns, or an AccessorError if IsAudienceLink returns false
+func (t *OrderedCollectionPage) TryGetAudienceLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAudienceLink", Index: index}
+		return
+	}
+	return t.GetAudienceLink(index), nil
+
+}
+
+// TryGetAudienceIRI returns the value GetAudienceIRI returns, or an AccessorError if IsAudienceIRI returns false
+func (t *OrderedCollectionPage) TryGetAudienceIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.AudienceLen() || !t.IsAudienceIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetAudienceIRI", Index: index}
+		return
+	}
+	return t.GetAudienceIRI(index), nil
+
+}
+
+// TryGetUnknownAudience returns the value GetUnknownAudience returns, or an AccessorError if HasUnknownAudience returns false
+func (t *OrderedCollectionPage) TryGetUnknownAudience() (v interface{}, err error) {
+	if !t.HasUnknownAudience() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownAudience", Index: -1}
+		return
+	}
+	return t.GetUnknownAudience(), nil
+
+}
+
+// TryGetContentString returns the value GetContentString returns, or an AccessorError if IsContentString returns false
+func (t *OrderedCollectionPage) TryGetContentString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentString(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetContentString", Index: index}
+		return
+	}
+	return t.GetContentString(index), nil
+
+}
+
+// TryGetContentLangString returns the value GetContentLangString returns, or an AccessorError if IsContentLangString returns false
+func (t *OrderedCollectionPage) TryGetContentLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentLangString(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetContentLangString", Index: index}
+		return
+	}
+	return t.GetContentLangString(index), nil
+
+}
+
+// TryGetContentIRI returns the value GetContentIRI returns, or an AccessorError if IsContentIRI returns false
+func (t *OrderedCollectionPage) TryGetContentIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContentLen() || !t.IsContentIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetContentIRI", Index: index}
+		return
+	}
+	return t.GetContentIRI(index), nil
+
+}
+
+// TryGetUnknownContent returns the value GetUnknownContent returns, or an AccessorError if HasUnknownContent returns false
+func (t *OrderedCollectionPage) TryGetUnknownContent() (v interface{}, err error) {
+	if !t.HasUnknownContent() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownContent", Index: -1}
+		return
+	}
+	return t.GetUnknownContent(), nil
+
+}
+
+// TryGetContextObject returns the value GetContextObject returns, or an AccessorError if IsContextObject returns false
+func (t *OrderedCollectionPage) TryGetContextObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetContextObject", Index: index}
+		return
+	}
+	return t.GetContextObject(index), nil
+
+}
+
+// TryGetContextLink returns the value GetContextLink returns, or an AccessorError if IsContextLink returns false
+func (t *OrderedCollectionPage) TryGetContextLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetContextLink", Index: index}
+		return
+	}
+	return t.GetContextLink(index), nil
+
+}
+
+// TryGetContextIRI returns the value GetContextIRI returns, or an AccessorError if IsContextIRI returns false
+func (t *OrderedCollectionPage) TryGetContextIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ContextLen() || !t.IsContextIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetContextIRI", Index: index}
+		return
+	}
+	return t.GetContextIRI(index), nil
+
+}
+
+// TryGetUnknownContext returns the value GetUnknownContext returns, or an AccessorError if HasUnknownContext returns false
+func (t *OrderedCollectionPage) TryGetUnknownContext() (v interface{}, err error) {
+	if !t.HasUnknownContext() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownContext", Index: -1}
+		return
+	}
+	return t.GetUnknownContext(), nil
+
+}
+
+// TryGetNameString returns the value GetNameString returns, or an AccessorError if IsNameString returns false
+func (t *OrderedCollectionPage) TryGetNameString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameString(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetNameString", Index: index}
+		return
+	}
+	return t.GetNameString(index), nil
+
+}
+
+// TryGetNameLangString returns the value GetNameLangString returns, or an AccessorError if IsNameLangString returns false
+func (t *OrderedCollectionPage) TryGetNameLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameLangString(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetNameLangString", Index: index}
+		return
+	}
+	return t.GetNameLangString(index), nil
+
+}
+
+// TryGetNameIRI returns the value GetNameIRI returns, or an AccessorError if IsNameIRI returns false
+func (t *OrderedCollectionPage) TryGetNameIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.NameLen() || !t.IsNameIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetNameIRI", Index: index}
+		return
+	}
+	return t.GetNameIRI(index), nil
+
+}
+
+// TryGetUnknownName returns the value GetUnknownName returns, or an AccessorError if HasUnknownName returns false
+func (t *OrderedCollectionPage) TryGetUnknownName() (v interface{}, err error) {
+	if !t.HasUnknownName() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownName", Index: -1}
+		return
+	}
+	return t.GetUnknownName(), nil
+
+}
+
+// TryGetEndTime returns the value GetEndTime returns, or an AccessorError if IsEndTime returns false
+func (t *OrderedCollectionPage) TryGetEndTime() (v time.Time, err error) {
+	if !t.IsEndTime() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetEndTime", Index: -1}
+		return
+	}
+	return t.GetEndTime(), nil
+
+}
+
+// TryGetEndTimeIRI returns the value GetEndTimeIRI returns, or an AccessorError if IsEndTimeIRI returns false
+func (t *OrderedCollectionPage) TryGetEndTimeIRI() (v *url.URL, err error) {
+	if !t.IsEndTimeIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetEndTimeIRI", Index: -1}
+		return
+	}
+	return t.GetEndTimeIRI(), nil
+
+}
+
+// TryGetUnknownEndTime returns the value GetUnknownEndTime returns, or an AccessorError if HasUnknownEndTime returns false
+func (t *OrderedCollectionPage) TryGetUnknownEndTime() (v interface{}, err error) {
+	if !t.HasUnknownEndTime() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownEndTime", Index: -1}
+		return
+	}
+	return t.GetUnknownEndTime(), nil
+
+}
+
+// TryGetGeneratorObject returns the value GetGeneratorObject returns, or an AccessorError if IsGeneratorObject returns false
+func (t *OrderedCollectionPage) TryGetGeneratorObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetGeneratorObject", Index: index}
+		return
+	}
+	return t.GetGeneratorObject(index), nil
+
+}
+
+// TryGetGeneratorLink returns the value GetGeneratorLink returns, or an AccessorError if IsGeneratorLink returns false
+func (t *OrderedCollectionPage) TryGetGeneratorLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetGeneratorLink", Index: index}
+		return
+	}
+	return t.GetGeneratorLink(index), nil
+
+}
+
+// TryGetGeneratorIRI returns the value GetGeneratorIRI returns, or an AccessorError if IsGeneratorIRI returns false
+func (t *OrderedCollectionPage) TryGetGeneratorIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.GeneratorLen() || !t.IsGeneratorIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetGeneratorIRI", Index: index}
+		return
+	}
+	return t.GetGeneratorIRI(index), nil
+
+}
+
+// TryGetUnknownGenerator returns the value GetUnknownGenerator returns, or an AccessorError if HasUnknownGenerator returns false
+func (t *OrderedCollectionPage) TryGetUnknownGenerator() (v interface{}, err error) {
+	if !t.HasUnknownGenerator() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownGenerator", Index: -1}
+		return
+	}
+	return t.GetUnknownGenerator(), nil
+
+}
+
+// TryGetIconImage returns the value GetIconImage returns, or an AccessorError if IsIconImage returns false
+func (t *OrderedCollectionPage) TryGetIconImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconImage(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetIconImage", Index: index}
+		return
+	}
+	return t.GetIconImage(index), nil
+
+}
+
+// TryGetIconLink returns the value GetIconLink returns, or an AccessorError if IsIconLink returns false
+func (t *OrderedCollectionPage) TryGetIconLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetIconLink", Index: index}
+		return
+	}
+	return t.GetIconLink(index), nil
+
+}
+
+// TryGetIconIRI returns the value GetIconIRI returns, or an AccessorError if IsIconIRI returns false
+func (t *OrderedCollectionPage) TryGetIconIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.IconLen() || !t.IsIconIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetIconIRI", Index: index}
+		return
+	}
+	return t.GetIconIRI(index), nil
+
+}
+
+// TryGetUnknownIcon returns the value GetUnknownIcon returns, or an AccessorError if HasUnknownIcon returns false
+func (t *OrderedCollectionPage) TryGetUnknownIcon() (v interface{}, err error) {
+	if !t.HasUnknownIcon() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownIcon", Index: -1}
+		return
+	}
+	return t.GetUnknownIcon(), nil
+
+}
+
+// TryGetId returns the value GetId returns, or an AccessorError if HasId returns false
+func (t *OrderedCollectionPage) TryGetId() (v *url.URL, err error) {
+	if !t.HasId() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetId", Index: -1}
+		return
+	}
+	return t.GetId(), nil
+
+}
+
+// TryGetUnknownId returns the value GetUnknownId returns, or an AccessorError if HasUnknownId returns false
+func (t *OrderedCollectionPage) TryGetUnknownId() (v interface{}, err error) {
+	if !t.HasUnknownId() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownId", Index: -1}
+		return
+	}
+	return t.GetUnknownId(), nil
+
+}
+
+// TryGetImageImage returns the value GetImageImage returns, or an AccessorError if IsImageImage returns false
+func (t *OrderedCollectionPage) TryGetImageImage(index int) (v ImageType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageImage(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetImageImage", Index: index}
+		return
+	}
+	return t.GetImageImage(index), nil
+
+}
+
+// TryGetImageLink returns the value GetImageLink returns, or an AccessorError if IsImageLink returns false
+func (t *OrderedCollectionPage) TryGetImageLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetImageLink", Index: index}
+		return
+	}
+	return t.GetImageLink(index), nil
+
+}
+
+// TryGetImageIRI returns the value GetImageIRI returns, or an AccessorError if IsImageIRI returns false
+func (t *OrderedCollectionPage) TryGetImageIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ImageLen() || !t.IsImageIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetImageIRI", Index: index}
+		return
+	}
+	return t.GetImageIRI(index), nil
+
+}
+
+// TryGetUnknownImage returns the value GetUnknownImage returns, or an AccessorError if HasUnknownImage returns false
+func (t *OrderedCollectionPage) TryGetUnknownImage() (v interface{}, err error) {
+	if !t.HasUnknownImage() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownImage", Index: -1}
+		return
+	}
+	return t.GetUnknownImage(), nil
+
+}
+
+// TryGetInReplyToObject returns the value GetInReplyToObject returns, or an AccessorError if IsInReplyToObject returns false
+func (t *OrderedCollectionPage) TryGetInReplyToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetInReplyToObject", Index: index}
+		return
+	}
+	return t.GetInReplyToObject(index), nil
+
+}
+
+// TryGetInReplyToLink returns the value GetInReplyToLink returns, or an AccessorError if IsInReplyToLink returns false
+func (t *OrderedCollectionPage) TryGetInReplyToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetInReplyToLink", Index: index}
+		return
+	}
+	return t.GetInReplyToLink(index), nil
+
+}
+
+// TryGetInReplyToIRI returns the value GetInReplyToIRI returns, or an AccessorError if IsInReplyToIRI returns false
+func (t *OrderedCollectionPage) TryGetInReplyToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.InReplyToLen() || !t.IsInReplyToIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetInReplyToIRI", Index: index}
+		return
+	}
+	return t.GetInReplyToIRI(index), nil
+
+}
+
+// TryGetUnknownInReplyTo returns the value GetUnknownInReplyTo returns, or an AccessorError if HasUnknownInReplyTo returns false
+func (t *OrderedCollectionPage) TryGetUnknownInReplyTo() (v interface{}, err error) {
+	if !t.HasUnknownInReplyTo() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownInReplyTo", Index: -1}
+		return
+	}
+	return t.GetUnknownInReplyTo(), nil
+
+}
+
+// TryGetLocationObject returns the value GetLocationObject returns, or an AccessorError if IsLocationObject returns false
+func (t *OrderedCollectionPage) TryGetLocationObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLocationObject", Index: index}
+		return
+	}
+	return t.GetLocationObject(index), nil
+
+}
+
+// TryGetLocationLink returns the value GetLocationLink returns, or an AccessorError if IsLocationLink returns false
+func (t *OrderedCollectionPage) TryGetLocationLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLocationLink", Index: index}
+		return
+	}
+	return t.GetLocationLink(index), nil
+
+}
+
+// TryGetLocationIRI returns the value GetLocationIRI returns, or an AccessorError if IsLocationIRI returns false
+func (t *OrderedCollectionPage) TryGetLocationIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.LocationLen() || !t.IsLocationIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLocationIRI", Index: index}
+		return
+	}
+	return t.GetLocationIRI(index), nil
+
+}
+
+// TryGetUnknownLocation returns the value GetUnknownLocation returns, or an AccessorError if HasUnknownLocation returns false
+func (t *OrderedCollectionPage) TryGetUnknownLocation() (v interface{}, err error) {
+	if !t.HasUnknownLocation() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownLocation", Index: -1}
+		return
+	}
+	return t.GetUnknownLocation(), nil
+
+}
+
+// TryGetPreviewObject returns the value GetPreviewObject returns, or an AccessorError if IsPreviewObject returns false
+func (t *OrderedCollectionPage) TryGetPreviewObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPreviewObject", Index: index}
+		return
+	}
+	return t.GetPreviewObject(index), nil
+
+}
+
+// TryGetPreviewLink returns the value GetPreviewLink returns, or an AccessorError if IsPreviewLink returns false
+func (t *OrderedCollectionPage) TryGetPreviewLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPreviewLink", Index: index}
+		return
+	}
+	return t.GetPreviewLink(index), nil
+
+}
+
+// TryGetPreviewIRI returns the value GetPreviewIRI returns, or an AccessorError if IsPreviewIRI returns false
+func (t *OrderedCollectionPage) TryGetPreviewIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.PreviewLen() || !t.IsPreviewIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPreviewIRI", Index: index}
+		return
+	}
+	return t.GetPreviewIRI(index), nil
+
+}
+
+// TryGetUnknownPreview returns the value GetUnknownPreview returns, or an AccessorError if HasUnknownPreview returns false
+func (t *OrderedCollectionPage) TryGetUnknownPreview() (v interface{}, err error) {
+	if !t.HasUnknownPreview() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownPreview", Index: -1}
+		return
+	}
+	return t.GetUnknownPreview(), nil
+
+}
+
+// TryGetPublished returns the value GetPublished returns, or an AccessorError if IsPublished returns false
+func (t *OrderedCollectionPage) TryGetPublished() (v time.Time, err error) {
+	if !t.IsPublished() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPublished", Index: -1}
+		return
+	}
+	return t.GetPublished(), nil
+
+}
+
+// TryGetPublishedIRI returns the value GetPublishedIRI returns, or an AccessorError if IsPublishedIRI returns false
+func (t *OrderedCollectionPage) TryGetPublishedIRI() (v *url.URL, err error) {
+	if !t.IsPublishedIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPublishedIRI", Index: -1}
+		return
+	}
+	return t.GetPublishedIRI(), nil
+
+}
+
+// TryGetUnknownPublished returns the value GetUnknownPublished returns, or an AccessorError if HasUnknownPublished returns false
+func (t *OrderedCollectionPage) TryGetUnknownPublished() (v interface{}, err error) {
+	if !t.HasUnknownPublished() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownPublished", Index: -1}
+		return
+	}
+	return t.GetUnknownPublished(), nil
+
+}
+
+// TryGetReplies returns the value GetReplies returns, or an AccessorError if IsReplies returns false
+func (t *OrderedCollectionPage) TryGetReplies() (v CollectionType, err error) {
+	if !t.IsReplies() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetReplies", Index: -1}
+		return
+	}
+	return t.GetReplies(), nil
+
+}
+
+// TryGetRepliesIRI returns the value GetRepliesIRI returns, or an AccessorError if IsRepliesIRI returns false
+func (t *OrderedCollectionPage) TryGetRepliesIRI() (v *url.URL, err error) {
+	if !t.IsRepliesIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetRepliesIRI", Index: -1}
+		return
+	}
+	return t.GetRepliesIRI(), nil
+
+}
+
+// TryGetUnknownReplies returns the value GetUnknownReplies returns, or an AccessorError if HasUnknownReplies returns false
+func (t *OrderedCollectionPage) TryGetUnknownReplies() (v interface{}, err error) {
+	if !t.HasUnknownReplies() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownReplies", Index: -1}
+		return
+	}
+	return t.GetUnknownReplies(), nil
+
+}
+
+// TryGetStartTime returns the value GetStartTime returns, or an AccessorError if IsStartTime returns false
+func (t *OrderedCollectionPage) TryGetStartTime() (v time.Time, err error) {
+	if !t.IsStartTime() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetStartTime", Index: -1}
+		return
+	}
+	return t.GetStartTime(), nil
+
+}
+
+// TryGetStartTimeIRI returns the value GetStartTimeIRI returns, or an AccessorError if IsStartTimeIRI returns false
+func (t *OrderedCollectionPage) TryGetStartTimeIRI() (v *url.URL, err error) {
+	if !t.IsStartTimeIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetStartTimeIRI", Index: -1}
+		return
+	}
+	return t.GetStartTimeIRI(), nil
+
+}
+
+// TryGetUnknownStartTime returns the value GetUnknownStartTime returns, or an AccessorError if HasUnknownStartTime returns false
+func (t *OrderedCollectionPage) TryGetUnknownStartTime() (v interface{}, err error) {
+	if !t.HasUnknownStartTime() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownStartTime", Index: -1}
+		return
+	}
+	return t.GetUnknownStartTime(), nil
+
+}
+
+// TryGetSummaryString returns the value GetSummaryString returns, or an AccessorError if IsSummaryString returns false
+func (t *OrderedCollectionPage) TryGetSummaryString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryString(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSummaryString", Index: index}
+		return
+	}
+	return t.GetSummaryString(index), nil
+
+}
+
+// TryGetSummaryLangString returns the value GetSummaryLangString returns, or an AccessorError if IsSummaryLangString returns false
+func (t *OrderedCollectionPage) TryGetSummaryLangString(index int) (v string, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryLangString(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSummaryLangString", Index: index}
+		return
+	}
+	return t.GetSummaryLangString(index), nil
+
+}
+
+// TryGetSummaryIRI returns the value GetSummaryIRI returns, or an AccessorError if IsSummaryIRI returns false
+func (t *OrderedCollectionPage) TryGetSummaryIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.SummaryLen() || !t.IsSummaryIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSummaryIRI", Index: index}
+		return
+	}
+	return t.GetSummaryIRI(index), nil
+
+}
+
+// TryGetUnknownSummary returns the value GetUnknownSummary returns, or an AccessorError if HasUnknownSummary returns false
+func (t *OrderedCollectionPage) TryGetUnknownSummary() (v interface{}, err error) {
+	if !t.HasUnknownSummary() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownSummary", Index: -1}
+		return
+	}
+	return t.GetUnknownSummary(), nil
+
+}
+
+// TryGetTagObject returns the value GetTagObject returns, or an AccessorError if IsTagObject returns false
+func (t *OrderedCollectionPage) TryGetTagObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetTagObject", Index: index}
+		return
+	}
+	return t.GetTagObject(index), nil
+
+}
+
+// TryGetTagLink returns the value GetTagLink returns, or an AccessorError if IsTagLink returns false
+func (t *OrderedCollectionPage) TryGetTagLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetTagLink", Index: index}
+		return
+	}
+	return t.GetTagLink(index), nil
+
+}
+
+// TryGetTagIRI returns the value GetTagIRI returns, or an AccessorError if IsTagIRI returns false
+func (t *OrderedCollectionPage) TryGetTagIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.TagLen() || !t.IsTagIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetTagIRI", Index: index}
+		return
+	}
+	return t.GetTagIRI(index), nil
+
+}
+
+// TryGetUnknownTag returns the value GetUnknownTag returns, or an AccessorError if HasUnknownTag returns false
+func (t *OrderedCollectionPage) TryGetUnknownTag() (v interface{}, err error) {
+	if !t.HasUnknownTag() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownTag", Index: -1}
+		return
+	}
+	return t.GetUnknownTag(), nil
+
+}
+
+// TryGetType returns the value GetType returns, or an AccessorError if the index is out of range
+func (t *OrderedCollectionPage) TryGetType(index int) (v interface{}, err error) {
+	if index < 0 || index >= t.TypeLen() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetType", Index: index}
+		return
+	}
+	return t.GetType(index), nil
+
+}
+
+// TryGetUpdated returns the value GetUpdated returns, or an AccessorError if IsUpdated returns false
+func (t *OrderedCollectionPage) TryGetUpdated() (v time.Time, err error) {
+	if !t.IsUpdated() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUpdated", Index: -1}
+		return
+	}
+	return t.GetUpdated(), nil
+
+}
+
+// TryGetUpdatedIRI returns the value GetUpdatedIRI returns, or an AccessorError if IsUpdatedIRI returns false
+func (t *OrderedCollectionPage) TryGetUpdatedIRI() (v *url.URL, err error) {
+	if !t.IsUpdatedIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUpdatedIRI", Index: -1}
+		return
+	}
+	return t.GetUpdatedIRI(), nil
+
+}
+
+// TryGetUnknownUpdated returns the value GetUnknownUpdated returns, or an AccessorError if HasUnknownUpdated returns false
+func (t *OrderedCollectionPage) TryGetUnknownUpdated() (v interface{}, err error) {
+	if !t.HasUnknownUpdated() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownUpdated", Index: -1}
+		return
+	}
+	return t.GetUnknownUpdated(), nil
+
+}
+
+// TryGetUrlAnyURI returns the value GetUrlAnyURI returns, or an AccessorError if IsUrlAnyURI returns false
+func (t *OrderedCollectionPage) TryGetUrlAnyURI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlAnyURI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUrlAnyURI", Index: index}
+		return
+	}
+	return t.GetUrlAnyURI(index), nil
+
+}
+
+// TryGetUrlLink returns the value GetUrlLink returns, or an AccessorError if IsUrlLink returns false
+func (t *OrderedCollectionPage) TryGetUrlLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.UrlLen() || !t.IsUrlLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUrlLink", Index: index}
+		return
+	}
+	return t.GetUrlLink(index), nil
+
+}
+
+// TryGetUnknownUrl returns the value GetUnknownUrl returns, or an AccessorError if HasUnknownUrl returns false
+func (t *OrderedCollectionPage) TryGetUnknownUrl() (v interface{}, err error) {
+	if !t.HasUnknownUrl() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownUrl(), nil
+
+}
+
+// TryGetToObject returns the value GetToObject returns, or an AccessorError if IsToObject returns false
+func (t *OrderedCollectionPage) TryGetToObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetToObject", Index: index}
+		return
+	}
+	return t.GetToObject(index), nil
+
+}
+
+// TryGetToLink returns the value GetToLink returns, or an AccessorError if IsToLink returns false
+func (t *OrderedCollectionPage) TryGetToLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetToLink", Index: index}
+		return
+	}
+	return t.GetToLink(index), nil
+
+}
+
+// TryGetToIRI returns the value GetToIRI returns, or an AccessorError if IsToIRI returns false
+func (t *OrderedCollectionPage) TryGetToIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ToLen() || !t.IsToIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetToIRI", Index: index}
+		return
+	}
+	return t.GetToIRI(index), nil
+
+}
+
+// TryGetUnknownTo returns the value GetUnknownTo returns, or an AccessorError if HasUnknownTo returns false
+func (t *OrderedCollectionPage) TryGetUnknownTo() (v interface{}, err error) {
+	if !t.HasUnknownTo() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownTo", Index: -1}
+		return
+	}
+	return t.GetUnknownTo(), nil
+
+}
+
+// TryGetBtoObject returns the value GetBtoObject returns, or an AccessorError if IsBtoObject returns false
+func (t *OrderedCollectionPage) TryGetBtoObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetBtoObject", Index: index}
+		return
+	}
+	return t.GetBtoObject(index), nil
+
+}
+
+// TryGetBtoLink returns the value GetBtoLink returns, or an AccessorError if IsBtoLink returns false
+func (t *OrderedCollectionPage) TryGetBtoLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetBtoLink", Index: index}
+		return
+	}
+	return t.GetBtoLink(index), nil
+
+}
+
+// TryGetBtoIRI returns the value GetBtoIRI returns, or an AccessorError if IsBtoIRI returns false
+func (t *OrderedCollectionPage) TryGetBtoIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BtoLen() || !t.IsBtoIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetBtoIRI", Index: index}
+		return
+	}
+	return t.GetBtoIRI(index), nil
+
+}
+
+// TryGetUnknownBto returns the value GetUnknownBto returns, or an AccessorError if HasUnknownBto returns false
+func (t *OrderedCollectionPage) TryGetUnknownBto() (v interface{}, err error) {
+	if !t.HasUnknownBto() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownBto", Index: -1}
+		return
+	}
+	return t.GetUnknownBto(), nil
+
+}
+
+// TryGetCcObject returns the value GetCcObject returns, or an AccessorError if IsCcObject returns false
+func (t *OrderedCollectionPage) TryGetCcObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetCcObject", Index: index}
+		return
+	}
+	return t.GetCcObject(index), nil
+
+}
+
+// TryGetCcLink returns the value GetCcLink returns, or an AccessorError if IsCcLink returns false
+func (t *OrderedCollectionPage) TryGetCcLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetCcLink", Index: index}
+		return
+	}
+	return t.GetCcLink(index), nil
+
+}
+
+// TryGetCcIRI returns the value GetCcIRI returns, or an AccessorError if IsCcIRI returns false
+func (t *OrderedCollectionPage) TryGetCcIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.CcLen() || !t.IsCcIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetCcIRI", Index: index}
+		return
+	}
+	return t.GetCcIRI(index), nil
+
+}
+
+// TryGetUnknownCc returns the value GetUnknownCc returns, or an AccessorError if HasUnknownCc returns false
+func (t *OrderedCollectionPage) TryGetUnknownCc() (v interface{}, err error) {
+	if !t.HasUnknownCc() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownCc", Index: -1}
+		return
+	}
+	return t.GetUnknownCc(), nil
+
+}
+
+// TryGetBccObject returns the value GetBccObject returns, or an AccessorError if IsBccObject returns false
+func (t *OrderedCollectionPage) TryGetBccObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetBccObject", Index: index}
+		return
+	}
+	return t.GetBccObject(index), nil
+
+}
+
+// TryGetBccLink returns the value GetBccLink returns, or an AccessorError if IsBccLink returns false
+func (t *OrderedCollectionPage) TryGetBccLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetBccLink", Index: index}
+		return
+	}
+	return t.GetBccLink(index), nil
+
+}
+
+// TryGetBccIRI returns the value GetBccIRI returns, or an AccessorError if IsBccIRI returns false
+func (t *OrderedCollectionPage) TryGetBccIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.BccLen() || !t.IsBccIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetBccIRI", Index: index}
+		return
+	}
+	return t.GetBccIRI(index), nil
+
+}
+
+// TryGetUnknownBcc returns the value GetUnknownBcc returns, or an AccessorError if HasUnknownBcc returns false
+func (t *OrderedCollectionPage) TryGetUnknownBcc() (v interface{}, err error) {
+	if !t.HasUnknownBcc() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownBcc", Index: -1}
+		return
+	}
+	return t.GetUnknownBcc(), nil
+
+}
+
+// TryGetMediaType returns the value GetMediaType returns, or an AccessorError if IsMediaType returns false
+func (t *OrderedCollectionPage) TryGetMediaType() (v string, err error) {
+	if !t.IsMediaType() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetMediaType", Index: -1}
+		return
+	}
+	return t.GetMediaType(), nil
+
+}
+
+// TryGetMediaTypeIRI returns the value GetMediaTypeIRI returns, or an AccessorError if IsMediaTypeIRI returns false
+func (t *OrderedCollectionPage) TryGetMediaTypeIRI() (v *url.URL, err error) {
+	if !t.IsMediaTypeIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetMediaTypeIRI", Index: -1}
+		return
+	}
+	return t.GetMediaTypeIRI(), nil
+
+}
+
+// TryGetUnknownMediaType returns the value GetUnknownMediaType returns, or an AccessorError if HasUnknownMediaType returns false
+func (t *OrderedCollectionPage) TryGetUnknownMediaType() (v interface{}, err error) {
+	if !t.HasUnknownMediaType() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownMediaType", Index: -1}
+		return
+	}
+	return t.GetUnknownMediaType(), nil
+
+}
+
+// TryGetDuration returns the value GetDuration returns, or an AccessorError if IsDuration returns false
+func (t *OrderedCollectionPage) TryGetDuration() (v time.Duration, err error) {
+	if !t.IsDuration() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetDuration", Index: -1}
+		return
+	}
+	return t.GetDuration(), nil
+
+}
+
+// TryGetDurationIRI returns the value GetDurationIRI returns, or an AccessorError if IsDurationIRI returns false
+func (t *OrderedCollectionPage) TryGetDurationIRI() (v *url.URL, err error) {
+	if !t.IsDurationIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetDurationIRI", Index: -1}
+		return
+	}
+	return t.GetDurationIRI(), nil
+
+}
+
+// TryGetUnknownDuration returns the value GetUnknownDuration returns, or an AccessorError if HasUnknownDuration returns false
+func (t *OrderedCollectionPage) TryGetUnknownDuration() (v interface{}, err error) {
+	if !t.HasUnknownDuration() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownDuration", Index: -1}
+		return
+	}
+	return t.GetUnknownDuration(), nil
+
+}
+
+// TryGetSource returns the value GetSource returns, or an AccessorError if IsSource returns false
+func (t *OrderedCollectionPage) TryGetSource() (v ObjectType, err error) {
+	if !t.IsSource() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSource", Index: -1}
+		return
+	}
+	return t.GetSource(), nil
+
+}
+
+// TryGetSourceIRI returns the value GetSourceIRI returns, or an AccessorError if IsSourceIRI returns false
+func (t *OrderedCollectionPage) TryGetSourceIRI() (v *url.URL, err error) {
+	if !t.IsSourceIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSourceIRI", Index: -1}
+		return
+	}
+	return t.GetSourceIRI(), nil
+
+}
+
+// TryGetUnknownSource returns the value GetUnknownSource returns, or an AccessorError if HasUnknownSource returns false
+func (t *OrderedCollectionPage) TryGetUnknownSource() (v interface{}, err error) {
+	if !t.HasUnknownSource() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownSource", Index: -1}
+		return
+	}
+	return t.GetUnknownSource(), nil
+
+}
+
+// TryGetInboxOrderedCollection returns the value GetInboxOrderedCollection returns, or an AccessorError if IsInboxOrderedCollection returns false
+func (t *OrderedCollectionPage) TryGetInboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsInboxOrderedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetInboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetInboxOrderedCollection(), nil
+
+}
+
+// TryGetInboxAnyURI returns the value GetInboxAnyURI returns, or an AccessorError if IsInboxAnyURI returns false
+func (t *OrderedCollectionPage) TryGetInboxAnyURI() (v *url.URL, err error) {
+	if !t.IsInboxAnyURI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetInboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetInboxAnyURI(), nil
+
+}
+
+// TryGetUnknownInbox returns the value GetUnknownInbox returns, or an AccessorError if HasUnknownInbox returns false
+func (t *OrderedCollectionPage) TryGetUnknownInbox() (v interface{}, err error) {
+	if !t.HasUnknownInbox() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownInbox(), nil
+
+}
+
+// TryGetOutboxOrderedCollection returns the value GetOutboxOrderedCollection returns, or an AccessorError if IsOutboxOrderedCollection returns false
+func (t *OrderedCollectionPage) TryGetOutboxOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsOutboxOrderedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetOutboxOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetOutboxOrderedCollection(), nil
+
+}
+
+// TryGetOutboxAnyURI returns the value GetOutboxAnyURI returns, or an AccessorError if IsOutboxAnyURI returns false
+func (t *OrderedCollectionPage) TryGetOutboxAnyURI() (v *url.URL, err error) {
+	if !t.IsOutboxAnyURI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetOutboxAnyURI", Index: -1}
+		return
+	}
+	return t.GetOutboxAnyURI(), nil
+
+}
+
+// TryGetUnknownOutbox returns the value GetUnknownOutbox returns, or an AccessorError if HasUnknownOutbox returns false
+func (t *OrderedCollectionPage) TryGetUnknownOutbox() (v interface{}, err error) {
+	if !t.HasUnknownOutbox() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownOutbox", Index: -1}
+		return
+	}
+	return t.GetUnknownOutbox(), nil
+
+}
+
+// TryGetFollowingCollection returns the value GetFollowingCollection returns, or an AccessorError if IsFollowingCollection returns false
+func (t *OrderedCollectionPage) TryGetFollowingCollection() (v CollectionType, err error) {
+	if !t.IsFollowingCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFollowingCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingCollection(), nil
+
+}
+
+// TryGetFollowingOrderedCollection returns the value GetFollowingOrderedCollection returns, or an AccessorError if IsFollowingOrderedCollection returns false
+func (t *OrderedCollectionPage) TryGetFollowingOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowingOrderedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFollowingOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowingOrderedCollection(), nil
+
+}
+
+// TryGetFollowingAnyURI returns the value GetFollowingAnyURI returns, or an AccessorError if IsFollowingAnyURI returns false
+func (t *OrderedCollectionPage) TryGetFollowingAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowingAnyURI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFollowingAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowingAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowing returns the value GetUnknownFollowing returns, or an AccessorError if HasUnknownFollowing returns false
+func (t *OrderedCollectionPage) TryGetUnknownFollowing() (v interface{}, err error) {
+	if !t.HasUnknownFollowing() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownFollowing", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowing(), nil
+
+}
+
+// TryGetFollowersCollection returns the value GetFollowersCollection returns, or an AccessorError if IsFollowersCollection returns false
+func (t *OrderedCollectionPage) TryGetFollowersCollection() (v CollectionType, err error) {
+	if !t.IsFollowersCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFollowersCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersCollection(), nil
+
+}
+
+// TryGetFollowersOrderedCollection returns the value GetFollowersOrderedCollection returns, or an AccessorError if IsFollowersOrderedCollection returns false
+func (t *OrderedCollectionPage) TryGetFollowersOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsFollowersOrderedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFollowersOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetFollowersOrderedCollection(), nil
+
+}
+
+// TryGetFollowersAnyURI returns the value GetFollowersAnyURI returns, or an AccessorError if IsFollowersAnyURI returns false
+func (t *OrderedCollectionPage) TryGetFollowersAnyURI() (v *url.URL, err error) {
+	if !t.IsFollowersAnyURI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetFollowersAnyURI", Index: -1}
+		return
+	}
+	return t.GetFollowersAnyURI(), nil
+
+}
+
+// TryGetUnknownFollowers returns the value GetUnknownFollowers returns, or an AccessorError if HasUnknownFollowers returns false
+func (t *OrderedCollectionPage) TryGetUnknownFollowers() (v interface{}, err error) {
+	if !t.HasUnknownFollowers() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownFollowers", Index: -1}
+		return
+	}
+	return t.GetUnknownFollowers(), nil
+
+}
+
+// TryGetLikedCollection returns the value GetLikedCollection returns, or an AccessorError if IsLikedCollection returns false
+func (t *OrderedCollectionPage) TryGetLikedCollection() (v CollectionType, err error) {
+	if !t.IsLikedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLikedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedCollection(), nil
+
+}
+
+// TryGetLikedOrderedCollection returns the value GetLikedOrderedCollection returns, or an AccessorError if IsLikedOrderedCollection returns false
+func (t *OrderedCollectionPage) TryGetLikedOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikedOrderedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLikedOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikedOrderedCollection(), nil
+
+}
+
+// TryGetLikedAnyURI returns the value GetLikedAnyURI returns, or an AccessorError if IsLikedAnyURI returns false
+func (t *OrderedCollectionPage) TryGetLikedAnyURI() (v *url.URL, err error) {
+	if !t.IsLikedAnyURI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLikedAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikedAnyURI(), nil
+
+}
+
+// TryGetUnknownLiked returns the value GetUnknownLiked returns, or an AccessorError if HasUnknownLiked returns false
+func (t *OrderedCollectionPage) TryGetUnknownLiked() (v interface{}, err error) {
+	if !t.HasUnknownLiked() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownLiked", Index: -1}
+		return
+	}
+	return t.GetUnknownLiked(), nil
+
+}
+
+// TryGetLikesCollection returns the value GetLikesCollection returns, or an AccessorError if IsLikesCollection returns false
+func (t *OrderedCollectionPage) TryGetLikesCollection() (v CollectionType, err error) {
+	if !t.IsLikesCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLikesCollection", Index: -1}
+		return
+	}
+	return t.GetLikesCollection(), nil
+
+}
+
+// TryGetLikesOrderedCollection returns the value GetLikesOrderedCollection returns, or an AccessorError if IsLikesOrderedCollection returns false
+func (t *OrderedCollectionPage) TryGetLikesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsLikesOrderedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLikesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetLikesOrderedCollection(), nil
+
+}
+
+// TryGetLikesAnyURI returns the value GetLikesAnyURI returns, or an AccessorError if IsLikesAnyURI returns false
+func (t *OrderedCollectionPage) TryGetLikesAnyURI() (v *url.URL, err error) {
+	if !t.IsLikesAnyURI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetLikesAnyURI", Index: -1}
+		return
+	}
+	return t.GetLikesAnyURI(), nil
+
+}
+
+// TryGetUnknownLikes returns the value GetUnknownLikes returns, or an AccessorError if HasUnknownLikes returns false
+func (t *OrderedCollectionPage) TryGetUnknownLikes() (v interface{}, err error) {
+	if !t.HasUnknownLikes() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownLikes", Index: -1}
+		return
+	}
+	return t.GetUnknownLikes(), nil
+
+}
+
+// TryGetStreams returns the value GetStreams returns, or an AccessorError if the index is out of range
+func (t *OrderedCollectionPage) TryGetStreams(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.StreamsLen() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetStreams", Index: index}
+		return
+	}
+	return t.GetStreams(index), nil
+
+}
+
+// TryGetUnknownStreams returns the value GetUnknownStreams returns, or an AccessorError if HasUnknownStreams returns false
+func (t *OrderedCollectionPage) TryGetUnknownStreams() (v interface{}, err error) {
+	if !t.HasUnknownStreams() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownStreams", Index: -1}
+		return
+	}
+	return t.GetUnknownStreams(), nil
+
+}
+
+// TryGetPreferredUsername returns the value GetPreferredUsername returns, or an AccessorError if IsPreferredUsername returns false
+func (t *OrderedCollectionPage) TryGetPreferredUsername() (v string, err error) {
+	if !t.IsPreferredUsername() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetPreferredUsername(), nil
+
+}
+
+// TryGetPreferredUsernameIRI returns the value GetPreferredUsernameIRI returns, or an AccessorError if IsPreferredUsernameIRI returns false
+func (t *OrderedCollectionPage) TryGetPreferredUsernameIRI() (v *url.URL, err error) {
+	if !t.IsPreferredUsernameIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPreferredUsernameIRI", Index: -1}
+		return
+	}
+	return t.GetPreferredUsernameIRI(), nil
+
+}
+
+// TryGetUnknownPreferredUsername returns the value GetUnknownPreferredUsername returns, or an AccessorError if HasUnknownPreferredUsername returns false
+func (t *OrderedCollectionPage) TryGetUnknownPreferredUsername() (v interface{}, err error) {
+	if !t.HasUnknownPreferredUsername() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownPreferredUsername", Index: -1}
+		return
+	}
+	return t.GetUnknownPreferredUsername(), nil
+
+}
+
+// TryGetEndpoints returns the value GetEndpoints returns, or an AccessorError if IsEndpoints returns false
+func (t *OrderedCollectionPage) TryGetEndpoints() (v ObjectType, err error) {
+	if !t.IsEndpoints() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetEndpoints", Index: -1}
+		return
+	}
+	return t.GetEndpoints(), nil
+
+}
+
+// TryGetEndpointsIRI returns the value GetEndpointsIRI returns, or an AccessorError if IsEndpointsIRI returns false
+func (t *OrderedCollectionPage) TryGetEndpointsIRI() (v *url.URL, err error) {
+	if !t.IsEndpointsIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetEndpointsIRI", Index: -1}
+		return
+	}
+	return t.GetEndpointsIRI(), nil
+
+}
+
+// TryGetUnknownEndpoints returns the value GetUnknownEndpoints returns, or an AccessorError if HasUnknownEndpoints returns false
+func (t *OrderedCollectionPage) TryGetUnknownEndpoints() (v interface{}, err error) {
+	if !t.HasUnknownEndpoints() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownEndpoints", Index: -1}
+		return
+	}
+	return t.GetUnknownEndpoints(), nil
+
+}
+
+// TryGetProxyUrl returns the value GetProxyUrl returns, or an AccessorError if HasProxyUrl returns false
+func (t *OrderedCollectionPage) TryGetProxyUrl() (v *url.URL, err error) {
+	if !t.HasProxyUrl() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetProxyUrl", Index: -1}
+		return
+	}
+	return t.GetProxyUrl(), nil
+
+}
+
+// TryGetUnknownProxyUrl returns the value GetUnknownProxyUrl returns, or an AccessorError if HasUnknownProxyUrl returns false
+func (t *OrderedCollectionPage) TryGetUnknownProxyUrl() (v interface{}, err error) {
+	if !t.HasUnknownProxyUrl() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownProxyUrl", Index: -1}
+		return
+	}
+	return t.GetUnknownProxyUrl(), nil
+
+}
+
+// TryGetOauthAuthorizationEndpoint returns the value GetOauthAuthorizationEndpoint returns, or an AccessorError if HasOauthAuthorizationEndpoint returns false
+func (t *OrderedCollectionPage) TryGetOauthAuthorizationEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthAuthorizationEndpoint returns the value GetUnknownOauthAuthorizationEndpoint returns, or an AccessorError if HasUnknownOauthAuthorizationEndpoint returns false
+func (t *OrderedCollectionPage) TryGetUnknownOauthAuthorizationEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthAuthorizationEndpoint() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownOauthAuthorizationEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthAuthorizationEndpoint(), nil
+
+}
+
+// TryGetOauthTokenEndpoint returns the value GetOauthTokenEndpoint returns, or an AccessorError if HasOauthTokenEndpoint returns false
+func (t *OrderedCollectionPage) TryGetOauthTokenEndpoint() (v *url.URL, err error) {
+	if !t.HasOauthTokenEndpoint() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetOauthTokenEndpoint(), nil
+
+}
+
+// TryGetUnknownOauthTokenEndpoint returns the value GetUnknownOauthTokenEndpoint returns, or an AccessorError if HasUnknownOauthTokenEndpoint returns false
+func (t *OrderedCollectionPage) TryGetUnknownOauthTokenEndpoint() (v interface{}, err error) {
+	if !t.HasUnknownOauthTokenEndpoint() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownOauthTokenEndpoint", Index: -1}
+		return
+	}
+	return t.GetUnknownOauthTokenEndpoint(), nil
+
+}
+
+// TryGetProvideClientKey returns the value GetProvideClientKey returns, or an AccessorError if HasProvideClientKey returns false
+func (t *OrderedCollectionPage) TryGetProvideClientKey() (v *url.URL, err error) {
+	if !t.HasProvideClientKey() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetProvideClientKey(), nil
+
+}
+
+// TryGetUnknownProvideClientKey returns the value GetUnknownProvideClientKey returns, or an AccessorError if HasUnknownProvideClientKey returns false
+func (t *OrderedCollectionPage) TryGetUnknownProvideClientKey() (v interface{}, err error) {
+	if !t.HasUnknownProvideClientKey() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownProvideClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownProvideClientKey(), nil
+
+}
+
+// TryGetSignClientKey returns the value GetSignClientKey returns, or an AccessorError if HasSignClientKey returns false
+func (t *OrderedCollectionPage) TryGetSignClientKey() (v *url.URL, err error) {
+	if !t.HasSignClientKey() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSignClientKey", Index: -1}
+		return
+	}
+	return t.GetSignClientKey(), nil
+
+}
+
+// TryGetUnknownSignClientKey returns the value GetUnknownSignClientKey returns, or an AccessorError if HasUnknownSignClientKey returns false
+func (t *OrderedCollectionPage) TryGetUnknownSignClientKey() (v interface{}, err error) {
+	if !t.HasUnknownSignClientKey() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownSignClientKey", Index: -1}
+		return
+	}
+	return t.GetUnknownSignClientKey(), nil
+
+}
+
+// TryGetSharedInbox returns the value GetSharedInbox returns, or an AccessorError if HasSharedInbox returns false
+func (t *OrderedCollectionPage) TryGetSharedInbox() (v *url.URL, err error) {
+	if !t.HasSharedInbox() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSharedInbox", Index: -1}
+		return
+	}
+	return t.GetSharedInbox(), nil
+
+}
+
+// TryGetUnknownSharedInbox returns the value GetUnknownSharedInbox returns, or an AccessorError if HasUnknownSharedInbox returns false
+func (t *OrderedCollectionPage) TryGetUnknownSharedInbox() (v interface{}, err error) {
+	if !t.HasUnknownSharedInbox() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownSharedInbox", Index: -1}
+		return
+	}
+	return t.GetUnknownSharedInbox(), nil
+
+}
+
+// TryGetSharesCollection returns the value GetSharesCollection returns, or an AccessorError if IsSharesCollection returns false
+func (t *OrderedCollectionPage) TryGetSharesCollection() (v CollectionType, err error) {
+	if !t.IsSharesCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSharesCollection", Index: -1}
+		return
+	}
+	return t.GetSharesCollection(), nil
+
+}
+
+// TryGetSharesOrderedCollection returns the value GetSharesOrderedCollection returns, or an AccessorError if IsSharesOrderedCollection returns false
+func (t *OrderedCollectionPage) TryGetSharesOrderedCollection() (v OrderedCollectionType, err error) {
+	if !t.IsSharesOrderedCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSharesOrderedCollection", Index: -1}
+		return
+	}
+	return t.GetSharesOrderedCollection(), nil
+
+}
+
+// TryGetSharesAnyURI returns the value GetSharesAnyURI returns, or an AccessorError if IsSharesAnyURI returns false
+func (t *OrderedCollectionPage) TryGetSharesAnyURI() (v *url.URL, err error) {
+	if !t.IsSharesAnyURI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetSharesAnyURI", Index: -1}
+		return
+	}
+	return t.GetSharesAnyURI(), nil
+
+}
+
+// TryGetUnknownShares returns the value GetUnknownShares returns, or an AccessorError if HasUnknownShares returns false
+func (t *OrderedCollectionPage) TryGetUnknownShares() (v interface{}, err error) {
+	if !t.HasUnknownShares() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownShares", Index: -1}
+		return
+	}
+	return t.GetUnknownShares(), nil
+
+}
+
+// TryGetPartOfLink returns the value GetPartOfLink returns, or an AccessorError if IsPartOfLink returns false
+func (t *OrderedCollectionPage) TryGetPartOfLink() (v LinkType, err error) {
+	if !t.IsPartOfLink() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPartOfLink", Index: -1}
+		return
+	}
+	return t.GetPartOfLink(), nil
+
+}
+
+// TryGetPartOfCollection returns the value GetPartOfCollection returns, or an AccessorError if IsPartOfCollection returns false
+func (t *OrderedCollectionPage) TryGetPartOfCollection() (v CollectionType, err error) {
+	if !t.IsPartOfCollection() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPartOfCollection", Index: -1}
+		return
+	}
+	return t.GetPartOfCollection(), nil
+
+}
+
+// TryGetPartOfIRI returns the value GetPartOfIRI returns, or an AccessorError if IsPartOfIRI returns false
+func (t *OrderedCollectionPage) TryGetPartOfIRI() (v *url.URL, err error) {
+	if !t.IsPartOfIRI() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetPartOfIRI", Index: -1}
+		return
+	}
+	return t.GetPartOfIRI(), nil
+
+}
+
+// TryGetUnknownPartOf returns the value GetUnknownPartOf returns, or an AccessorError if HasUnknownPartOf returns false
+func (t *OrderedCollectionPage) TryGetUnknownPartOf() (v interface{}, err error) {
+	if !t.HasUnknownPartOf() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownPartOf", Index: -1}
+		return
+	}
+	return t.GetUnknownPartOf(), nil
+
+}
+
+// TryGetItemsObject returns the value GetItemsObject returns, or an AccessorError if IsItemsObject returns false
+func (t *OrderedCollectionPage) TryGetItemsObject(index int) (v ObjectType, err error) {
+	if index < 0 || index >= t.ItemsLen() || !t.IsItemsObject(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetItemsObject", Index: index}
+		return
+	}
+	return t.GetItemsObject(index), nil
+
+}
+
+// TryGetItemsLink returns the value GetItemsLink returns, or an AccessorError if IsItemsLink returns false
+func (t *OrderedCollectionPage) TryGetItemsLink(index int) (v LinkType, err error) {
+	if index < 0 || index >= t.ItemsLen() || !t.IsItemsLink(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetItemsLink", Index: index}
+		return
+	}
+	return t.GetItemsLink(index), nil
+
+}
+
+// TryGetItemsIRI returns the value GetItemsIRI returns, or an AccessorError if IsItemsIRI returns false
+func (t *OrderedCollectionPage) TryGetItemsIRI(index int) (v *url.URL, err error) {
+	if index < 0 || index >= t.ItemsLen() || !t.IsItemsIRI(index) {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetItemsIRI", Index: index}
+		return
+	}
+	return t.GetItemsIRI(index), nil
+
+}
+
+// TryGetUnknownItems returns the value GetUnknownItems returns, or an AccessorError if HasUnknownItems returns false
+func (t *OrderedCollectionPage) TryGetUnknownItems() (v interface{}, err error) {
+	if !t.HasUnknownItems() {
+		err = &AccessorError{Type: "OrderedCollectionPage", Getter: "GetUnknownItems", Index: -1}
+		return
+	}
+	return t.GetUnknownItems(), nil
+
+}
+
+// WithStartIndex calls SetStartIndex and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithStartIndex(v int64) *OrderedCollectionPage {
+	t.SetStartIndex(v)
+	return t
+
+}
+
+// WithStartIndexIRI calls SetStartIndexIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithStartIndexIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetStartIndexIRI(v)
+	return t
+
+}
+
+// WithUnknownStartIndex calls SetUnknownStartIndex and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownStartIndex(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownStartIndex(i)
+	return t
+
+}
+
+// WithNextOrderedCollectionPage calls SetNextOrderedCollectionPage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNextOrderedCollectionPage(v OrderedCollectionPageType) *OrderedCollectionPage {
+	t.SetNextOrderedCollectionPage(v)
+	return t
+
+}
+
+// WithNextLink calls SetNextLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNextLink(v LinkType) *OrderedCollectionPage {
+	t.SetNextLink(v)
+	return t
+
+}
+
+// WithNextIRI calls SetNextIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNextIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetNextIRI(v)
+	return t
+
+}
+
+// WithUnknownNext calls SetUnknownNext and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownNext(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownNext(i)
+	return t
+
+}
+
+// WithPrevOrderedCollectionPage calls SetPrevOrderedCollectionPage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPrevOrderedCollectionPage(v OrderedCollectionPageType) *OrderedCollectionPage {
+	t.SetPrevOrderedCollectionPage(v)
+	return t
+
+}
+
+// WithPrevLink calls SetPrevLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPrevLink(v LinkType) *OrderedCollectionPage {
+	t.SetPrevLink(v)
+	return t
+
+}
+
+// WithPrevIRI calls SetPrevIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPrevIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetPrevIRI(v)
+	return t
+
+}
+
+// WithUnknownPrev calls SetUnknownPrev and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownPrev(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownPrev(i)
+	return t
+
+}
+
+// WithOrderedItemsObject calls AppendOrderedItemsObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithOrderedItemsObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendOrderedItemsObject(v)
+	return t
+
+}
+
+// WithOrderedItemsLink calls AppendOrderedItemsLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithOrderedItemsLink(v LinkType) *OrderedCollectionPage {
+	t.AppendOrderedItemsLink(v)
+	return t
+
+}
+
+// WithOrderedItemsIRI calls AppendOrderedItemsIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithOrderedItemsIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendOrderedItemsIRI(v)
+	return t
+
+}
+
+// WithUnknownOrderedItems calls SetUnknownOrderedItems and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownOrderedItems(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownOrderedItems(i)
+	return t
+
+}
+
+// WithCurrentOrderedCollectionPage calls SetCurrentOrderedCollectionPage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithCurrentOrderedCollectionPage(v OrderedCollectionPageType) *OrderedCollectionPage {
+	t.SetCurrentOrderedCollectionPage(v)
+	return t
+
+}
+
+// WithCurrentLink calls SetCurrentLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithCurrentLink(v LinkType) *OrderedCollectionPage {
+	t.SetCurrentLink(v)
+	return t
+
+}
+
+// WithCurrentIRI calls SetCurrentIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithCurrentIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetCurrentIRI(v)
+	return t
+
+}
+
+// WithUnknownCurrent calls SetUnknownCurrent and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownCurrent(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownCurrent(i)
+	return t
+
+}
+
+// WithFirstOrderedCollectionPage calls SetFirstOrderedCollectionPage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFirstOrderedCollectionPage(v OrderedCollectionPageType) *OrderedCollectionPage {
+	t.SetFirstOrderedCollectionPage(v)
+	return t
+
+}
+
+// WithFirstLink calls SetFirstLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFirstLink(v LinkType) *OrderedCollectionPage {
+	t.SetFirstLink(v)
+	return t
+
+}
+
+// WithFirstIRI calls SetFirstIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFirstIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetFirstIRI(v)
+	return t
+
+}
+
+// WithUnknownFirst calls SetUnknownFirst and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownFirst(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownFirst(i)
+	return t
+
+}
+
+// WithLastOrderedCollectionPage calls SetLastOrderedCollectionPage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLastOrderedCollectionPage(v OrderedCollectionPageType) *OrderedCollectionPage {
+	t.SetLastOrderedCollectionPage(v)
+	return t
+
+}
+
+// WithLastLink calls SetLastLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLastLink(v LinkType) *OrderedCollectionPage {
+	t.SetLastLink(v)
+	return t
+
+}
+
+// WithLastIRI calls SetLastIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLastIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetLastIRI(v)
+	return t
+
+}
+
+// WithUnknownLast calls SetUnknownLast and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownLast(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownLast(i)
+	return t
+
+}
+
+// WithTotalItems calls SetTotalItems and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithTotalItems(v int64) *OrderedCollectionPage {
+	t.SetTotalItems(v)
+	return t
+
+}
+
+// WithTotalItemsIRI calls SetTotalItemsIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithTotalItemsIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetTotalItemsIRI(v)
+	return t
+
+}
+
+// WithUnknownTotalItems calls SetUnknownTotalItems and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownTotalItems(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownTotalItems(i)
+	return t
+
+}
+
+// WithAltitude calls SetAltitude and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAltitude(v float64) *OrderedCollectionPage {
+	t.SetAltitude(v)
+	return t
+
+}
+
+// WithAltitudeIRI calls SetAltitudeIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAltitudeIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetAltitudeIRI(v)
+	return t
+
+}
+
+// WithUnknownAltitude calls SetUnknownAltitude and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownAltitude(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownAltitude(i)
+	return t
+
+}
+
+// WithAttachmentObject calls AppendAttachmentObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAttachmentObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendAttachmentObject(v)
+	return t
+
+}
+
+// WithAttachmentLink calls AppendAttachmentLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAttachmentLink(v LinkType) *OrderedCollectionPage {
+	t.AppendAttachmentLink(v)
+	return t
+
+}
+
+// WithAttachmentIRI calls AppendAttachmentIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAttachmentIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendAttachmentIRI(v)
+	return t
+
+}
+
+// WithUnknownAttachment calls SetUnknownAttachment and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownAttachment(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownAttachment(i)
+	return t
+
+}
+
+// WithAttributedToObject calls AppendAttributedToObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAttributedToObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendAttributedToObject(v)
+	return t
+
+}
+
+// WithAttributedToLink calls AppendAttributedToLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAttributedToLink(v LinkType) *OrderedCollectionPage {
+	t.AppendAttributedToLink(v)
+	return t
+
+}
+
+// WithAttributedToIRI calls AppendAttributedToIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAttributedToIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendAttributedToIRI(v)
+	return t
+
+}
+
+// WithUnknownAttributedTo calls SetUnknownAttributedTo and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownAttributedTo(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownAttributedTo(i)
+	return t
+
+}
+
+// WithAudienceObject calls AppendAudienceObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAudienceObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendAudienceObject(v)
+	return t
+
+}
+
+// WithAudienceLink calls AppendAudienceLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAudienceLink(v LinkType) *OrderedCollectionPage {
+	t.AppendAudienceLink(v)
+	return t
+
+}
+
+// WithAudienceIRI calls AppendAudienceIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithAudienceIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendAudienceIRI(v)
+	return t
+
+}
+
+// WithUnknownAudience calls SetUnknownAudience and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownAudience(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownAudience(i)
+	return t
+
+}
+
+// WithContentString calls AppendContentString and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContentString(v string) *OrderedCollectionPage {
+	t.AppendContentString(v)
+	return t
+
+}
+
+// WithContentLangString calls AppendContentLangString and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContentLangString(v string) *OrderedCollectionPage {
+	t.AppendContentLangString(v)
+	return t
+
+}
+
+// WithContentIRI calls AppendContentIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContentIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendContentIRI(v)
+	return t
+
+}
+
+// WithUnknownContent calls SetUnknownContent and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownContent(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownContent(i)
+	return t
+
+}
+
+// WithContentMap calls SetContentMap and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContentMap(l string, v string) *OrderedCollectionPage {
+	t.SetContentMap(l, v)
+	return t
+
+}
+
+// WithContentLanguage calls SetContentLanguage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContentLanguage(tag string, v string) *OrderedCollectionPage {
+	t.SetContentLanguage(tag, v)
+	return t
+
+}
+
+// WithContextObject calls AppendContextObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContextObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendContextObject(v)
+	return t
+
+}
+
+// WithContextLink calls AppendContextLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContextLink(v LinkType) *OrderedCollectionPage {
+	t.AppendContextLink(v)
+	return t
+
+}
+
+// WithContextIRI calls AppendContextIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithContextIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendContextIRI(v)
+	return t
+
+}
+
+// WithUnknownContext calls SetUnknownContext and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownContext(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownContext(i)
+	return t
+
+}
+
+// WithNameString calls AppendNameString and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNameString(v string) *OrderedCollectionPage {
+	t.AppendNameString(v)
+	return t
+
+}
+
+// WithNameLangString calls AppendNameLangString and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNameLangString(v string) *OrderedCollectionPage {
+	t.AppendNameLangString(v)
+	return t
+
+}
+
+// WithNameIRI calls AppendNameIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNameIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendNameIRI(v)
+	return t
+
+}
+
+// WithUnknownName calls SetUnknownName and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownName(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownName(i)
+	return t
+
+}
+
+// WithNameMap calls SetNameMap and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNameMap(l string, v string) *OrderedCollectionPage {
+	t.SetNameMap(l, v)
+	return t
+
+}
+
+// WithNameLanguage calls SetNameLanguage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithNameLanguage(tag string, v string) *OrderedCollectionPage {
+	t.SetNameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndTime calls SetEndTime and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithEndTime(v time.Time) *OrderedCollectionPage {
+	t.SetEndTime(v)
+	return t
+
+}
+
+// WithEndTimeIRI calls SetEndTimeIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithEndTimeIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetEndTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownEndTime calls SetUnknownEndTime and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownEndTime(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownEndTime(i)
+	return t
+
+}
+
+// WithGeneratorObject calls AppendGeneratorObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithGeneratorObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendGeneratorObject(v)
+	return t
+
+}
+
+// WithGeneratorLink calls AppendGeneratorLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithGeneratorLink(v LinkType) *OrderedCollectionPage {
+	t.AppendGeneratorLink(v)
+	return t
+
+}
+
+// WithGeneratorIRI calls AppendGeneratorIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithGeneratorIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendGeneratorIRI(v)
+	return t
+
+}
+
+// WithUnknownGenerator calls SetUnknownGenerator and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownGenerator(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownGenerator(i)
+	return t
+
+}
+
+// WithIconImage calls AppendIconImage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithIconImage(v ImageType) *OrderedCollectionPage {
+	t.AppendIconImage(v)
+	return t
+
+}
+
+// WithIconLink calls AppendIconLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithIconLink(v LinkType) *OrderedCollectionPage {
+	t.AppendIconLink(v)
+	return t
+
+}
+
+// WithIconIRI calls AppendIconIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithIconIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendIconIRI(v)
+	return t
+
+}
+
+// WithUnknownIcon calls SetUnknownIcon and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownIcon(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownIcon(i)
+	return t
+
+}
+
+// WithId calls SetId and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithId(v *url.URL) *OrderedCollectionPage {
+	t.SetId(v)
+	return t
+
+}
+
+// WithUnknownId calls SetUnknownId and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownId(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownId(i)
+	return t
+
+}
+
+// WithImageImage calls AppendImageImage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithImageImage(v ImageType) *OrderedCollectionPage {
+	t.AppendImageImage(v)
+	return t
+
+}
+
+// WithImageLink calls AppendImageLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithImageLink(v LinkType) *OrderedCollectionPage {
+	t.AppendImageLink(v)
+	return t
+
+}
+
+// WithImageIRI calls AppendImageIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithImageIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendImageIRI(v)
+	return t
+
+}
+
+// WithUnknownImage calls SetUnknownImage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownImage(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownImage(i)
+	return t
+
+}
+
+// WithInReplyToObject calls AppendInReplyToObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithInReplyToObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendInReplyToObject(v)
+	return t
+
+}
+
+// WithInReplyToLink calls AppendInReplyToLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithInReplyToLink(v LinkType) *OrderedCollectionPage {
+	t.AppendInReplyToLink(v)
+	return t
+
+}
+
+// WithInReplyToIRI calls AppendInReplyToIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithInReplyToIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendInReplyToIRI(v)
+	return t
+
+}
+
+// WithUnknownInReplyTo calls SetUnknownInReplyTo and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownInReplyTo(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownInReplyTo(i)
+	return t
+
+}
+
+// WithLocationObject calls AppendLocationObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLocationObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendLocationObject(v)
+	return t
+
+}
+
+// WithLocationLink calls AppendLocationLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLocationLink(v LinkType) *OrderedCollectionPage {
+	t.AppendLocationLink(v)
+	return t
+
+}
+
+// WithLocationIRI calls AppendLocationIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLocationIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendLocationIRI(v)
+	return t
+
+}
+
+// WithUnknownLocation calls SetUnknownLocation and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownLocation(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownLocation(i)
+	return t
+
+}
+
+// WithPreviewObject calls AppendPreviewObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPreviewObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendPreviewObject(v)
+	return t
+
+}
+
+// WithPreviewLink calls AppendPreviewLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPreviewLink(v LinkType) *OrderedCollectionPage {
+	t.AppendPreviewLink(v)
+	return t
+
+}
+
+// WithPreviewIRI calls AppendPreviewIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPreviewIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendPreviewIRI(v)
+	return t
+
+}
+
+// WithUnknownPreview calls SetUnknownPreview and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownPreview(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownPreview(i)
+	return t
+
+}
+
+// WithPublished calls SetPublished and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPublished(v time.Time) *OrderedCollectionPage {
+	t.SetPublished(v)
+	return t
+
+}
+
+// WithPublishedIRI calls SetPublishedIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPublishedIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetPublishedIRI(v)
+	return t
+
+}
+
+// WithUnknownPublished calls SetUnknownPublished and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownPublished(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownPublished(i)
+	return t
+
+}
+
+// WithReplies calls SetReplies and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithReplies(v CollectionType) *OrderedCollectionPage {
+	t.SetReplies(v)
+	return t
+
+}
+
+// WithRepliesIRI calls SetRepliesIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithRepliesIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetRepliesIRI(v)
+	return t
+
+}
+
+// WithUnknownReplies calls SetUnknownReplies and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownReplies(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownReplies(i)
+	return t
+
+}
+
+// WithStartTime calls SetStartTime and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithStartTime(v time.Time) *OrderedCollectionPage {
+	t.SetStartTime(v)
+	return t
+
+}
+
+// WithStartTimeIRI calls SetStartTimeIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithStartTimeIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetStartTimeIRI(v)
+	return t
+
+}
+
+// WithUnknownStartTime calls SetUnknownStartTime and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownStartTime(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownStartTime(i)
+	return t
+
+}
+
+// WithSummaryString calls AppendSummaryString and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSummaryString(v string) *OrderedCollectionPage {
+	t.AppendSummaryString(v)
+	return t
+
+}
+
+// WithSummaryLangString calls AppendSummaryLangString and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSummaryLangString(v string) *OrderedCollectionPage {
+	t.AppendSummaryLangString(v)
+	return t
+
+}
+
+// WithSummaryIRI calls AppendSummaryIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSummaryIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendSummaryIRI(v)
+	return t
+
+}
+
+// WithUnknownSummary calls SetUnknownSummary and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownSummary(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownSummary(i)
+	return t
+
+}
+
+// WithSummaryMap calls SetSummaryMap and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSummaryMap(l string, v string) *OrderedCollectionPage {
+	t.SetSummaryMap(l, v)
+	return t
+
+}
+
+// WithSummaryLanguage calls SetSummaryLanguage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSummaryLanguage(tag string, v string) *OrderedCollectionPage {
+	t.SetSummaryLanguage(tag, v)
+	return t
+
+}
+
+// WithTagObject calls AppendTagObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithTagObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendTagObject(v)
+	return t
+
+}
+
+// WithTagLink calls AppendTagLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithTagLink(v LinkType) *OrderedCollectionPage {
+	t.AppendTagLink(v)
+	return t
+
+}
+
+// WithTagIRI calls AppendTagIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithTagIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendTagIRI(v)
+	return t
+
+}
+
+// WithUnknownTag calls SetUnknownTag and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownTag(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownTag(i)
+	return t
+
+}
+
+// WithType calls AppendType and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithType(v interface{}) *OrderedCollectionPage {
+	t.AppendType(v)
+	return t
+
+}
+
+// WithUpdated calls SetUpdated and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUpdated(v time.Time) *OrderedCollectionPage {
+	t.SetUpdated(v)
+	return t
+
+}
+
+// WithUpdatedIRI calls SetUpdatedIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUpdatedIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetUpdatedIRI(v)
+	return t
+
+}
+
+// WithUnknownUpdated calls SetUnknownUpdated and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownUpdated(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownUpdated(i)
+	return t
+
+}
+
+// WithUrlAnyURI calls AppendUrlAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUrlAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.AppendUrlAnyURI(v)
+	return t
+
+}
+
+// WithUrlLink calls AppendUrlLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUrlLink(v LinkType) *OrderedCollectionPage {
+	t.AppendUrlLink(v)
+	return t
+
+}
+
+// WithUnknownUrl calls SetUnknownUrl and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownUrl(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownUrl(i)
+	return t
+
+}
+
+// WithToObject calls AppendToObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithToObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendToObject(v)
+	return t
+
+}
+
+// WithToLink calls AppendToLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithToLink(v LinkType) *OrderedCollectionPage {
+	t.AppendToLink(v)
+	return t
+
+}
+
+// WithToIRI calls AppendToIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithToIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendToIRI(v)
+	return t
+
+}
+
+// WithUnknownTo calls SetUnknownTo and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownTo(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownTo(i)
+	return t
+
+}
+
+// WithBtoObject calls AppendBtoObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithBtoObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendBtoObject(v)
+	return t
+
+}
+
+// WithBtoLink calls AppendBtoLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithBtoLink(v LinkType) *OrderedCollectionPage {
+	t.AppendBtoLink(v)
+	return t
+
+}
+
+// WithBtoIRI calls AppendBtoIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithBtoIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendBtoIRI(v)
+	return t
+
+}
+
+// WithUnknownBto calls SetUnknownBto and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownBto(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownBto(i)
+	return t
+
+}
+
+// WithCcObject calls AppendCcObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithCcObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendCcObject(v)
+	return t
+
+}
+
+// WithCcLink calls AppendCcLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithCcLink(v LinkType) *OrderedCollectionPage {
+	t.AppendCcLink(v)
+	return t
+
+}
+
+// WithCcIRI calls AppendCcIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithCcIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendCcIRI(v)
+	return t
+
+}
+
+// WithUnknownCc calls SetUnknownCc and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownCc(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownCc(i)
+	return t
+
+}
+
+// WithBccObject calls AppendBccObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithBccObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendBccObject(v)
+	return t
+
+}
+
+// WithBccLink calls AppendBccLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithBccLink(v LinkType) *OrderedCollectionPage {
+	t.AppendBccLink(v)
+	return t
+
+}
+
+// WithBccIRI calls AppendBccIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithBccIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendBccIRI(v)
+	return t
+
+}
+
+// WithUnknownBcc calls SetUnknownBcc and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownBcc(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownBcc(i)
+	return t
+
+}
+
+// WithMediaType calls SetMediaType and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithMediaType(v string) *OrderedCollectionPage {
+	t.SetMediaType(v)
+	return t
+
+}
+
+// WithMediaTypeIRI calls SetMediaTypeIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithMediaTypeIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetMediaTypeIRI(v)
+	return t
+
+}
+
+// WithUnknownMediaType calls SetUnknownMediaType and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownMediaType(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownMediaType(i)
+	return t
+
+}
+
+// WithDuration calls SetDuration and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithDuration(v time.Duration) *OrderedCollectionPage {
+	t.SetDuration(v)
+	return t
+
+}
+
+// WithDurationIRI calls SetDurationIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithDurationIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetDurationIRI(v)
+	return t
+
+}
+
+// WithUnknownDuration calls SetUnknownDuration and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownDuration(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownDuration(i)
+	return t
+
+}
+
+// WithSource calls SetSource and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSource(v ObjectType) *OrderedCollectionPage {
+	t.SetSource(v)
+	return t
+
+}
+
+// WithSourceIRI calls SetSourceIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSourceIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetSourceIRI(v)
+	return t
+
+}
+
+// WithUnknownSource calls SetUnknownSource and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownSource(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownSource(i)
+	return t
+
+}
+
+// WithInboxOrderedCollection calls SetInboxOrderedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithInboxOrderedCollection(v OrderedCollectionType) *OrderedCollectionPage {
+	t.SetInboxOrderedCollection(v)
+	return t
+
+}
+
+// WithInboxAnyURI calls SetInboxAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithInboxAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.SetInboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownInbox calls SetUnknownInbox and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownInbox(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownInbox(i)
+	return t
+
+}
+
+// WithOutboxOrderedCollection calls SetOutboxOrderedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithOutboxOrderedCollection(v OrderedCollectionType) *OrderedCollectionPage {
+	t.SetOutboxOrderedCollection(v)
+	return t
+
+}
+
+// WithOutboxAnyURI calls SetOutboxAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithOutboxAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.SetOutboxAnyURI(v)
+	return t
+
+}
+
+// WithUnknownOutbox calls SetUnknownOutbox and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownOutbox(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownOutbox(i)
+	return t
+
+}
+
+// WithFollowingCollection calls SetFollowingCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFollowingCollection(v CollectionType) *OrderedCollectionPage {
+	t.SetFollowingCollection(v)
+	return t
+
+}
+
+// WithFollowingOrderedCollection calls SetFollowingOrderedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFollowingOrderedCollection(v OrderedCollectionType) *OrderedCollectionPage {
+	t.SetFollowingOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowingAnyURI calls SetFollowingAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFollowingAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.SetFollowingAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowing calls SetUnknownFollowing and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownFollowing(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownFollowing(i)
+	return t
+
+}
+
+// WithFollowersCollection calls SetFollowersCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFollowersCollection(v CollectionType) *OrderedCollectionPage {
+	t.SetFollowersCollection(v)
+	return t
+
+}
+
+// WithFollowersOrderedCollection calls SetFollowersOrderedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFollowersOrderedCollection(v OrderedCollectionType) *OrderedCollectionPage {
+	t.SetFollowersOrderedCollection(v)
+	return t
+
+}
+
+// WithFollowersAnyURI calls SetFollowersAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithFollowersAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.SetFollowersAnyURI(v)
+	return t
+
+}
+
+// WithUnknownFollowers calls SetUnknownFollowers and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownFollowers(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownFollowers(i)
+	return t
+
+}
+
+// WithLikedCollection calls SetLikedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLikedCollection(v CollectionType) *OrderedCollectionPage {
+	t.SetLikedCollection(v)
+	return t
+
+}
+
+// WithLikedOrderedCollection calls SetLikedOrderedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLikedOrderedCollection(v OrderedCollectionType) *OrderedCollectionPage {
+	t.SetLikedOrderedCollection(v)
+	return t
+
+}
+
+// WithLikedAnyURI calls SetLikedAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLikedAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.SetLikedAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLiked calls SetUnknownLiked and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownLiked(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownLiked(i)
+	return t
+
+}
+
+// WithLikesCollection calls SetLikesCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLikesCollection(v CollectionType) *OrderedCollectionPage {
+	t.SetLikesCollection(v)
+	return t
+
+}
+
+// WithLikesOrderedCollection calls SetLikesOrderedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLikesOrderedCollection(v OrderedCollectionType) *OrderedCollectionPage {
+	t.SetLikesOrderedCollection(v)
+	return t
+
+}
+
+// WithLikesAnyURI calls SetLikesAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithLikesAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.SetLikesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownLikes calls SetUnknownLikes and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownLikes(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownLikes(i)
+	return t
+
+}
+
+// WithStreams calls AppendStreams and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithStreams(v *url.URL) *OrderedCollectionPage {
+	t.AppendStreams(v)
+	return t
+
+}
+
+// WithUnknownStreams calls SetUnknownStreams and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownStreams(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownStreams(i)
+	return t
+
+}
+
+// WithPreferredUsername calls SetPreferredUsername and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPreferredUsername(v string) *OrderedCollectionPage {
+	t.SetPreferredUsername(v)
+	return t
+
+}
+
+// WithPreferredUsernameIRI calls SetPreferredUsernameIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPreferredUsernameIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetPreferredUsernameIRI(v)
+	return t
+
+}
+
+// WithUnknownPreferredUsername calls SetUnknownPreferredUsername and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownPreferredUsername(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownPreferredUsername(i)
+	return t
+
+}
+
+// WithPreferredUsernameMap calls SetPreferredUsernameMap and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPreferredUsernameMap(l string, v string) *OrderedCollectionPage {
+	t.SetPreferredUsernameMap(l, v)
+	return t
+
+}
+
+// WithPreferredUsernameLanguage calls SetPreferredUsernameLanguage and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPreferredUsernameLanguage(tag string, v string) *OrderedCollectionPage {
+	t.SetPreferredUsernameLanguage(tag, v)
+	return t
+
+}
+
+// WithEndpoints calls SetEndpoints and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithEndpoints(v ObjectType) *OrderedCollectionPage {
+	t.SetEndpoints(v)
+	return t
+
+}
+
+// WithEndpointsIRI calls SetEndpointsIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithEndpointsIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetEndpointsIRI(v)
+	return t
+
+}
+
+// WithUnknownEndpoints calls SetUnknownEndpoints and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownEndpoints(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownEndpoints(i)
+	return t
+
+}
+
+// WithProxyUrl calls SetProxyUrl and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithProxyUrl(v *url.URL) *OrderedCollectionPage {
+	t.SetProxyUrl(v)
+	return t
+
+}
+
+// WithUnknownProxyUrl calls SetUnknownProxyUrl and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownProxyUrl(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownProxyUrl(i)
+	return t
+
+}
+
+// WithOauthAuthorizationEndpoint calls SetOauthAuthorizationEndpoint and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithOauthAuthorizationEndpoint(v *url.URL) *OrderedCollectionPage {
+	t.SetOauthAuthorizationEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthAuthorizationEndpoint calls SetUnknownOauthAuthorizationEndpoint and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownOauthAuthorizationEndpoint(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownOauthAuthorizationEndpoint(i)
+	return t
+
+}
+
+// WithOauthTokenEndpoint calls SetOauthTokenEndpoint and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithOauthTokenEndpoint(v *url.URL) *OrderedCollectionPage {
+	t.SetOauthTokenEndpoint(v)
+	return t
+
+}
+
+// WithUnknownOauthTokenEndpoint calls SetUnknownOauthTokenEndpoint and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownOauthTokenEndpoint(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownOauthTokenEndpoint(i)
+	return t
+
+}
+
+// WithProvideClientKey calls SetProvideClientKey and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithProvideClientKey(v *url.URL) *OrderedCollectionPage {
+	t.SetProvideClientKey(v)
+	return t
+
+}
+
+// WithUnknownProvideClientKey calls SetUnknownProvideClientKey and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownProvideClientKey(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownProvideClientKey(i)
+	return t
+
+}
+
+// WithSignClientKey calls SetSignClientKey and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSignClientKey(v *url.URL) *OrderedCollectionPage {
+	t.SetSignClientKey(v)
+	return t
+
+}
+
+// WithUnknownSignClientKey calls SetUnknownSignClientKey and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownSignClientKey(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownSignClientKey(i)
+	return t
+
+}
+
+// WithSharedInbox calls SetSharedInbox and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSharedInbox(v *url.URL) *OrderedCollectionPage {
+	t.SetSharedInbox(v)
+	return t
+
+}
+
+// WithUnknownSharedInbox calls SetUnknownSharedInbox and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownSharedInbox(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownSharedInbox(i)
+	return t
+
+}
+
+// WithSharesCollection calls SetSharesCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSharesCollection(v CollectionType) *OrderedCollectionPage {
+	t.SetSharesCollection(v)
+	return t
+
+}
+
+// WithSharesOrderedCollection calls SetSharesOrderedCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSharesOrderedCollection(v OrderedCollectionType) *OrderedCollectionPage {
+	t.SetSharesOrderedCollection(v)
+	return t
+
+}
+
+// WithSharesAnyURI calls SetSharesAnyURI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithSharesAnyURI(v *url.URL) *OrderedCollectionPage {
+	t.SetSharesAnyURI(v)
+	return t
+
+}
+
+// WithUnknownShares calls SetUnknownShares and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownShares(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownShares(i)
+	return t
+
+}
+
+// WithPartOfLink calls SetPartOfLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPartOfLink(v LinkType) *OrderedCollectionPage {
+	t.SetPartOfLink(v)
+	return t
+
+}
+
+// WithPartOfCollection calls SetPartOfCollection and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPartOfCollection(v CollectionType) *OrderedCollectionPage {
+	t.SetPartOfCollection(v)
+	return t
+
+}
+
+// WithPartOfIRI calls SetPartOfIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithPartOfIRI(v *url.URL) *OrderedCollectionPage {
+	t.SetPartOfIRI(v)
+	return t
+
+}
+
+// WithUnknownPartOf calls SetUnknownPartOf and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownPartOf(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownPartOf(i)
+	return t
+
+}
+
+// WithItemsObject calls AppendItemsObject and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithItemsObject(v ObjectType) *OrderedCollectionPage {
+	t.AppendItemsObject(v)
+	return t
+
+}
+
+// WithItemsLink calls AppendItemsLink and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithItemsLink(v LinkType) *OrderedCollectionPage {
+	t.AppendItemsLink(v)
+	return t
+
+}
+
+// WithItemsIRI calls AppendItemsIRI and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithItemsIRI(v *url.URL) *OrderedCollectionPage {
+	t.AppendItemsIRI(v)
+	return t
+
+}
+
+// WithUnknownItems calls SetUnknownItems and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownItems(i interface{}) *OrderedCollectionPage {
+	t.SetUnknownItems(i)
+	return t
+
+}
+
+// WithUnknownProperty calls SetUnknownProperty and returns this OrderedCollectionPage, so that calls can be chained
+func (t *OrderedCollectionPage) WithUnknownProperty(k string, i interface{}) *OrderedCollectionPage {
+	t.SetUnknownProperty(k, i)
+	return t
+
+}
+
+// markPresent_ sets or clears the bit of a member in the presence bitset.
+func (t *OrderedCollectionPage) markPresent_(i uint, set bool) {
+	if set {
+		t.present_[i/64] |= 1 << (i % 64)
+	} else {
+		t.present_[i/64] &^= 1 << (i % 64)
+	}
+
+}
+
+// markAllPresent_ sets the bits of every member in the presence bitset that is set, and clears the others.
+func (t *OrderedCollectionPage) markAllPresent_() {
+	t.markPresent_(0, t.startIndex != nil)
+	t.markPresent_(1, t.next != nil)
+	t.markPresent_(2, t.prev != nil)
+	t.markPresent_(3, t.orderedItems != nil)
+	t.markPresent_(4, t.current != nil)
+	t.markPresent_(5, t.first != nil)
+	t.markPresent_(6, t.last != nil)
+	t.markPresent_(7, t.totalItems != nil)
+	t.markPresent_(8, t.altitude != nil)
+	t.markPresent_(9, t.attachment != nil)
+	t.markPresent_(10, t.attributedTo != nil)
+	t.markPresent_(11, t.audience != nil)
+	t.markPresent_(12, t.content != nil)
+	t.markPresent_(13, t.contentMap != nil)
+	t.markPresent_(14, t.context != nil)
+	t.markPresent_(15, t.name != nil)
+	t.markPresent_(16, t.nameMap != nil)
+	t.markPresent_(17, t.endTime != nil)
+	t.markPresent_(18, t.generator != nil)
+	t.markPresent_(19, t.icon != nil)
+	t.markPresent_(20, t.id != nil)
+	t.markPresent_(21, t.image != nil)
+	t.markPresent_(22, t.inReplyTo != nil)
+	t.markPresent_(23, t.location != nil)
+	t.markPresent_(24, t.preview != nil)
+	t.markPresent_(25, t.published != nil)
+	t.markPresent_(26, t.replies != nil)
+	t.markPresent_(27, t.startTime != nil)
+	t.markPresent_(28, t.summary != nil)
+	t.markPresent_(29, t.summaryMap != nil)
+	t.markPresent_(30, t.tag != nil)
+	t.markPresent_(31, t.typeName != nil)
+	t.markPresent_(32, t.updated != nil)
+	t.markPresent_(33, t.url != nil)
+	t.markPresent_(34, t.to != nil)
+	t.markPresent_(35, t.bto != nil)
+	t.markPresent_(36, t.cc != nil)
+	t.markPresent_(37, t.bcc != nil)
+	t.markPresent_(38, t.mediaType != nil)
+	t.markPresent_(39, t.duration != nil)
+	t.markPresent_(40, t.source != nil)
+	t.markPresent_(41, t.inbox != nil)
+	t.markPresent_(42, t.outbox != nil)
+	t.markPresent_(43, t.following != nil)
+	t.markPresent_(44, t.followers != nil)
+	t.markPresent_(45, t.liked != nil)
+	t.markPresent_(46, t.likes != nil)
+	t.markPresent_(47, t.streams != nil)
+	t.markPresent_(48, t.preferredUsername != nil)
+	t.markPresent_(49, t.preferredUsernameMap != nil)
+	t.markPresent_(50, t.endpoints != nil)
+	t.markPresent_(51, t.proxyUrl != nil)
+	t.markPresent_(52, t.oauthAuthorizationEndpoint != nil)
+	t.markPresent_(53, t.oauthTokenEndpoint != nil)
+	t.markPresent_(54, t.provideClientKey != nil)
+	t.markPresent_(55, t.signClientKey != nil)
+	t.markPresent_(56, t.sharedInbox != nil)
+	t.markPresent_(57, t.shares != nil)
+	t.markPresent_(58, t.partOf != nil)
+
+}
@@ -0,0 +1,2909 @@
+//
+package vocab
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// randomMaxDepth limits how deeply random values are nested within each
+// other. Beyond it, only IRIs and other non-type values are generated.
+const randomMaxDepth = 2
+
+var (
+	randomLanguages  = []string{"en", "fr", "de", "ja", "es"}
+	randomMediaTypes = []string{"text/html", "text/markdown", "image/png", "video/mp4"}
+	randomRelations  = []string{"canonical", "preview", "alternate"}
+	randomUnits      = []string{"cm", "feet", "inches", "km", "m", "miles"}
+)
+
+// randomProperty describes a property of a type for generating random values.
+type randomProperty struct {
+	name               string
+	functional         bool
+	naturalLanguageMap bool
+	// ranges are the names of the types and values the property may hold.
+	ranges []string
+}
+
+// randomValue is a type that can be generated randomly.
+type randomValue interface {
+	Serializer
+	Deserializer
+}
+
+// NewRandom creates a random but valid value of the named type. It respects
+// the range of each property and whether it is functional, and always sets
+// the 'id' of an Object and the 'href' of a Link. The same seed always
+// creates the same value, which makes it useful for property-based testing.
+func NewRandom(typeName string, seed int64) (Serializer, error) {
+	return newRandom(rand.New(rand.NewSource(seed)), typeName)
+}
+
+// newRandom creates a random value of the named type using the provided
+// source of randomness.
+func newRandom(r *rand.Rand, typeName string) (Serializer, error) {
+	i := resolveObject(typeName)
+	if i == nil {
+		i = resolveLink(typeName)
+	}
+	v, ok := i.(randomValue)
+	if !ok {
+		return nil, fmt.Errorf("cannot generate random value of unknown type %q", typeName)
+	}
+	if err := v.Deserialize(randomMap(r, typeName, 0)); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// randomMap creates the generic map form of a random value of the named type.
+func randomMap(r *rand.Rand, typeName string, depth int) map[string]interface{} {
+	m := map[string]interface{}{"type": typeName}
+	for _, p := range randomTypes[typeName] {
+		if p.name == "type" {
+			continue
+		} else if p.name == "id" || p.name == "href" {
+			m[p.name] = randomIRI(r)
+			continue
+		} else if r.Intn(depth+2) != 0 {
+			continue
+		}
+		if p.naturalLanguageMap && r.Intn(2) == 0 {
+			m[p.name+"Map"] = map[string]interface{}{
+				randomLanguages[r.Intn(len(randomLanguages))]: randomString(r),
+			}
+		} else if p.functional {
+			m[p.name] = randomRange(r, p, depth)
+		} else if n := r.Intn(3); n == 0 {
+			m[p.name] = randomRange(r, p, depth)
+		} else {
+			s := make([]interface{}, n+1)
+			for i := range s {
+				s[i] = randomRange(r, p, depth)
+			}
+			m[p.name] = s
+		}
+	}
+	return m
+}
+
+// randomRange creates a random value for one of the property's ranges.
+func randomRange(r *rand.Rand, p randomProperty, depth int) interface{} {
+	ranges := p.ranges
+	if depth >= randomMaxDepth {
+		ranges = nil
+		for _, name := range p.ranges {
+			if _, ok := randomTypes[name]; !ok {
+				ranges = append(ranges, name)
+			}
+		}
+	}
+	if len(ranges) == 0 {
+		return randomIRI(r)
+	}
+	name := ranges[r.Intn(len(ranges))]
+	if _, ok := randomTypes[name]; ok {
+		return randomMap(r, name, depth+1)
+	}
+	switch name {
+	case "dateTime":
+		return time.Unix(r.Int63n(4000000000), 0).UTC().Format(time.RFC3339)
+	case "boolean":
+		return r.Intn(2) == 0
+	case "float":
+		return float64(r.Intn(2000000)-1000000) / 1000
+	case "duration":
+		return fmt.Sprintf("PT%dS", 1+r.Intn(86400))
+	case "nonNegativeInteger":
+		return float64(r.Intn(1000))
+	case "bcp47LanguageTag":
+		return randomLanguages[r.Intn(len(randomLanguages))]
+	case "mimeMediaTypeValue":
+		return randomMediaTypes[r.Intn(len(randomMediaTypes))]
+	case "linkRelation":
+		return randomRelations[r.Intn(len(randomRelations))]
+	case "unitsValue":
+		return randomUnits[r.Intn(len(randomUnits))]
+	case "string", "langString":
+		return randomString(r)
+	default:
+		return randomIRI(r)
+	}
+}
+
+// randomIRI creates a random IRI.
+func randomIRI(r *rand.Rand) string {
+	return fmt.Sprintf("https://example.com/%d", r.Int63())
+}
+
+// randomString creates a random non-empty string of lowercase letters.
+func randomString(r *rand.Rand) string {
+	b := make([]byte, 1+r.Intn(16))
+	for i := range b {
+		b[i] = byte('a' + r.Intn(26))
+	}
+	return string(b)
+}
+
+// randomTypes describes the properties of each type for generating random values.
+var randomTypes = map[string][]randomProperty{
+	"Accept": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Activity": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Add": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Announce": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Application": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Arrive": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Article": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Audio": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Block": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Collection": {
+		{"totalItems", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"current", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"first", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"last", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"items", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"CollectionPage": {
+		{"partOf", true, false, []string{"Link", "Collection", "IRI"}},
+		{"next", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"prev", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"totalItems", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"current", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"first", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"last", true, false, []string{"CollectionPage", "Link", "IRI"}},
+		{"items", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Create": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Delete": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Dislike": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Document": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Event": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Flag": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Follow": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Group": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Hashtag": {
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"href", true, false, []string{"anyURI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"rel", false, false, []string{"linkRelation", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"hreflang", true, false, []string{"bcp47LanguageTag", "IRI"}},
+		{"height", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"width", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+	},
+	"Ignore": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Image": {
+		{"height", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"width", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"IntransitiveActivity": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Invite": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Join": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Leave": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Like": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Link": {
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"href", true, false, []string{"anyURI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"rel", false, false, []string{"linkRelation", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"hreflang", true, false, []string{"bcp47LanguageTag", "IRI"}},
+		{"height", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"width", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+	},
+	"Listen": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Mention": {
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"href", true, false, []string{"anyURI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"rel", false, false, []string{"linkRelation", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"hreflang", true, false, []string{"bcp47LanguageTag", "IRI"}},
+		{"height", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"width", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+	},
+	"Move": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Note": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Object": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Offer": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"OrderedCollection": {
+		{"orderedItems", false, false, []string{"Object", "Link", "IRI"}},
+		{"current", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"first", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"last", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"totalItems", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"OrderedCollectionPage": {
+		{"startIndex", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"next", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"prev", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"orderedItems", false, false, []string{"Object", "Link", "IRI"}},
+		{"current", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"first", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"last", true, false, []string{"OrderedCollectionPage", "Link", "IRI"}},
+		{"totalItems", true, false, []string{"nonNegativeInteger", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"partOf", true, false, []string{"Link", "Collection", "IRI"}},
+	},
+	"Organization": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Page": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Person": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Place": {
+		{"accuracy", true, false, []string{"float", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"latitude", true, false, []string{"float", "IRI"}},
+		{"longitude", true, false, []string{"float", "IRI"}},
+		{"radius", true, false, []string{"float", "IRI"}},
+		{"units", true, false, []string{"unitsValue", "anyURI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Profile": {
+		{"describes", true, false, []string{"Object", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Question": {
+		{"oneOf", false, false, []string{"Object", "Link", "IRI"}},
+		{"anyOf", false, false, []string{"Object", "Link", "IRI"}},
+		{"closed", false, false, []string{"dateTime", "boolean", "Object", "Link", "IRI"}},
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Read": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Reject": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Relationship": {
+		{"subject", true, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"relationship", true, false, []string{"Object", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Remove": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Service": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"TentativeAccept": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"TentativeReject": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Tombstone": {
+		{"formerType", false, false, []string{"string", "Object", "IRI"}},
+		{"deleted", true, false, []string{"dateTime", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Travel": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Undo": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Update": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"Video": {
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+	"View": {
+		{"actor", false, false, []string{"Object", "Link", "IRI"}},
+		{"object", false, false, []string{"Object", "IRI"}},
+		{"target", false, false, []string{"Object", "Link", "IRI"}},
+		{"result", false, false, []string{"Object", "Link", "IRI"}},
+		{"origin", false, false, []string{"Object", "Link", "IRI"}},
+		{"instrument", false, false, []string{"Object", "Link", "IRI"}},
+		{"altitude", true, false, []string{"float", "IRI"}},
+		{"attachment", false, false, []string{"Object", "Link", "IRI"}},
+		{"attributedTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"audience", false, false, []string{"Object", "Link", "IRI"}},
+		{"content", false, true, []string{"string", "langString", "IRI"}},
+		{"context", false, false, []string{"Object", "Link", "IRI"}},
+		{"name", false, true, []string{"string", "langString", "IRI"}},
+		{"endTime", true, false, []string{"dateTime", "IRI"}},
+		{"generator", false, false, []string{"Object", "Link", "IRI"}},
+		{"icon", false, false, []string{"Image", "Link", "IRI"}},
+		{"id", true, false, []string{"anyURI"}},
+		{"image", false, false, []string{"Image", "Link", "IRI"}},
+		{"inReplyTo", false, false, []string{"Object", "Link", "IRI"}},
+		{"location", false, false, []string{"Object", "Link", "IRI"}},
+		{"preview", false, false, []string{"Object", "Link", "IRI"}},
+		{"published", true, false, []string{"dateTime", "IRI"}},
+		{"replies", true, false, []string{"Collection", "IRI"}},
+		{"startTime", true, false, []string{"dateTime", "IRI"}},
+		{"summary", false, true, []string{"string", "langString", "IRI"}},
+		{"tag", false, false, []string{"Object", "Link", "IRI"}},
+		{"type", false, false, []string{"IRI"}},
+		{"updated", true, false, []string{"dateTime", "IRI"}},
+		{"url", false, false, []string{"anyURI", "Link"}},
+		{"to", false, false, []string{"Object", "Link", "IRI"}},
+		{"bto", false, false, []string{"Object", "Link", "IRI"}},
+		{"cc", false, false, []string{"Object", "Link", "IRI"}},
+		{"bcc", false, false, []string{"Object", "Link", "IRI"}},
+		{"mediaType", true, false, []string{"mimeMediaTypeValue", "IRI"}},
+		{"duration", true, false, []string{"duration", "IRI"}},
+		{"source", true, false, []string{"Object", "IRI"}},
+		{"inbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"outbox", true, false, []string{"OrderedCollection", "anyURI"}},
+		{"following", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"followers", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"liked", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"likes", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+		{"streams", false, false, []string{"anyURI"}},
+		{"preferredUsername", true, true, []string{"string", "IRI"}},
+		{"endpoints", true, false, []string{"Object", "IRI"}},
+		{"proxyUrl", true, false, []string{"anyURI"}},
+		{"oauthAuthorizationEndpoint", true, false, []string{"anyURI"}},
+		{"oauthTokenEndpoint", true, false, []string{"anyURI"}},
+		{"provideClientKey", true, false, []string{"anyURI"}},
+		{"signClientKey", true, false, []string{"anyURI"}},
+		{"sharedInbox", true, false, []string{"anyURI"}},
+		{"shares", true, false, []string{"Collection", "OrderedCollection", "anyURI"}},
+	},
+}
@@ -0,0 +1,264 @@
+//
+package vocab
+
+import (
+	"strings"
+)
+
+// SerializeOption configures how SerializeWith serializes a value.
+type SerializeOption func(o *serializeOptions)
+
+// serializeOptions are the options SerializeWith applies.
+type serializeOptions struct {
+	omitContext  bool
+	omitHidden   bool
+	compact      bool
+	vocabularies []Vocabulary
+	context      []interface{}
+}
+
+// OmitContext leaves the '@context' out of the serialized value, as values
+// embedded in another one should not carry their own.
+func OmitContext() SerializeOption {
+	return func(o *serializeOptions) {
+		o.omitContext = true
+	}
+}
+
+// OmitHiddenRecipients leaves the 'bto' and 'bcc' out of the serialized value
+// and of the objects its properties contain, which must not disclose them once
+// delivered. They are recognized as IRIPolicy recognizes properties, whether
+// named by an alias its '@context' defines, or by a compacted or absolute IRI
+// such as "as:bto".
+func OmitHiddenRecipients() SerializeOption {
+	return func(o *serializeOptions) {
+		o.omitHidden = true
+	}
+}
+
+// CompactIRIs replaces the property names and types of the serialized value,
+// and of the values it contains, that are absolute IRIs of ActivityStreams or
+// of the vocabularies with their terms, such as
+// "https://www.w3.org/ns/activitystreams#Note" with "Note" and
+// "http://joinmastodon.org/ns#featured" with "toot:featured". The '@context'
+// is computed knowing the vocabularies.
+func CompactIRIs(v ...Vocabulary) SerializeOption {
+	return func(o *serializeOptions) {
+		o.compact = true
+		o.vocabularies = append(o.vocabularies, v...)
+	}
+}
+
+// WithContext adds the entries, such as the IRI of a published context or an
+// object defining terms, to the '@context' of the serialized value after those
+// it needs.
+func WithContext(entries ...interface{}) SerializeOption {
+	return func(o *serializeOptions) {
+		o.context = append(o.context, entries...)
+	}
+}
+
+// SerializeWith serializes the value with its Serialize method and sets its
+// '@context' to the smallest one it needs, as a ContextManager computes it,
+// unless the options configure otherwise. Without options it is the
+// ActivityStreams context IRI, or a larger one when the value uses other
+// vocabularies.
+func SerializeWith(s Serializer, opts ...SerializeOption) (map[string]interface{}, error) {
+	o := &serializeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	m, err := s.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	if o.compact {
+		m = compactIRIs(m, o.vocabularies).(map[string]interface{})
+	}
+	if o.omitHidden {
+		m = omitHiddenRecipients(m, nil)
+	}
+	if o.omitContext {
+		delete(m, "@context")
+		return m, nil
+	}
+	ctx := NewContextManager(o.vocabularies...).Context(m)
+	if len(o.context) > 0 {
+		entries, ok := ctx.([]interface{})
+		if !ok {
+			entries = []interface{}{ctx}
+		}
+		ctx = append(entries, o.context...)
+	}
+	m["@context"] = ctx
+	return m, nil
+}
+
+// EncodeCanonical encodes the generic map form of a value, such as one that
+// Serialize or SerializeWith returns, as canonical JSON by RFC 8785, like the
+// SerializeCanonical method of the types.
+func EncodeCanonical(v interface{}) ([]byte, error) {
+	return encodeJCS(v)
+}
+
+// compactIRIs copies the value with the property names and types that are
+// absolute IRIs of known terms compacted. A property whose compacted name is
+// already set keeps its IRI.
+func compactIRIs(v interface{}, vocabularies []Vocabulary) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		r := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			if k == "type" {
+				r[k] = compactTypeIRIs(e, vocabularies)
+				continue
+			}
+			if c, ok := compactIRI(k, vocabularies); ok {
+				if _, set := x[c]; !set {
+					k = c
+				}
+			}
+			r[k] = compactIRIs(e, vocabularies)
+		}
+		return r
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = compactIRIs(e, vocabularies)
+		}
+		return r
+	}
+	return v
+}
+
+// objectProperties are the properties of this package whose values may be
+// objects, which may have hidden recipients of their own.
+var objectProperties = map[string]bool{
+	"actor":        true,
+	"anyOf":        true,
+	"attachment":   true,
+	"attributedTo": true,
+	"audience":     true,
+	"bcc":          true,
+	"bto":          true,
+	"cc":           true,
+	"closed":       true,
+	"context":      true,
+	"current":      true,
+	"describes":    true,
+	"endpoints":    true,
+	"first":        true,
+	"followers":    true,
+	"following":    true,
+	"formerType":   true,
+	"generator":    true,
+	"icon":         true,
+	"image":        true,
+	"inReplyTo":    true,
+	"inbox":        true,
+	"instrument":   true,
+	"items":        true,
+	"last":         true,
+	"liked":        true,
+	"likes":        true,
+	"location":     true,
+	"next":         true,
+	"object":       true,
+	"oneOf":        true,
+	"orderedItems": true,
+	"origin":       true,
+	"outbox":       true,
+	"partOf":       true,
+	"prev":         true,
+	"preview":      true,
+	"relationship": true,
+	"replies":      true,
+	"result":       true,
+	"shares":       true,
+	"source":       true,
+	"subject":      true,
+	"tag":          true,
+	"target":       true,
+	"to":           true,
+	"type":         true,
+	"url":          true,
+}
+
+// omitHiddenRecipients copies the object, with the aliases of the '@context' of
+// the values enclosing it, without its 'bto' and 'bcc' and those of the objects
+// its properties contain. The values of other properties, such as those of
+// extensions, are kept as they are.
+func omitHiddenRecipients(m map[string]interface{}, aliases map[string]string) map[string]interface{} {
+	aliases = contextAliases(m["@context"], aliases)
+	r := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		switch term := propertyTerm(k, aliases); {
+		case term == "bto" || term == "bcc":
+		case objectProperties[term]:
+			r[k] = omitObjectHiddenRecipients(v, aliases)
+		default:
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// omitObjectHiddenRecipients copies the value of a property whose values may be
+// objects without the hidden recipients of those objects.
+func omitObjectHiddenRecipients(v interface{}, aliases map[string]string) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		return omitHiddenRecipients(x, aliases)
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = omitObjectHiddenRecipients(e, aliases)
+		}
+		return r
+	}
+	return v
+}
+
+// compactTypeIRIs compacts the types of a value that are absolute IRIs of
+// known terms.
+func compactTypeIRIs(v interface{}, vocabularies []Vocabulary) interface{} {
+	switch x := v.(type) {
+	case string:
+		if c, ok := compactIRI(x, vocabularies); ok {
+			return c
+		}
+	case []interface{}:
+		r := make([]interface{}, len(x))
+		for i, e := range x {
+			r[i] = compactTypeIRIs(e, vocabularies)
+		}
+		return r
+	}
+	return v
+}
+
+// compactIRI returns the term the IRI stands for: an ActivityStreams term, a
+// term of a vocabulary used without its alias, or a compact IRI with the alias
+// of the vocabulary whose namespace the IRI is relative to.
+func compactIRI(iri string, vocabularies []Vocabulary) (string, bool) {
+	for _, ns := range activityStreamsNamespaces {
+		if strings.HasPrefix(iri, ns) && activityStreamsTerms[iri[len(ns):]] {
+			return iri[len(ns):], true
+		}
+	}
+	for _, v := range vocabularies {
+		if len(v.Namespace) == 0 || !strings.HasPrefix(iri, v.Namespace) || len(iri) == len(v.Namespace) {
+			continue
+		}
+		t := iri[len(v.Namespace):]
+		for _, term := range v.Terms {
+			if term == t {
+				return t, true
+			}
+		}
+		if len(v.Alias) > 0 {
+			return v.Alias + ":" + t, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,204 @@
+//
+package vocab
+
+import (
+	"time"
+)
+
+// DateTime is a dateTime value, like those of the properties of the types, that
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, so that it can
+// be read from configuration files and written by logging libraries, and a
+// pointer to it implements flag.Value, so that it can be set by flags.
+type DateTime time.Time
+
+// MarshalText returns the serialized form of the DateTime.
+func (v DateTime) MarshalText() ([]byte, error) {
+	return []byte(dateTimeSerialize(time.Time(v))), nil
+}
+
+// UnmarshalText deserializes the DateTime from the text, returning an error if it
+// is not a dateTime value.
+func (v *DateTime) UnmarshalText(b []byte) error {
+	return v.Set(string(b))
+}
+
+// String returns the serialized form of the DateTime.
+func (v DateTime) String() string {
+	return dateTimeSerialize(time.Time(v))
+}
+
+// Set deserializes the DateTime from the string, returning an error if it is not
+// a dateTime value.
+func (v *DateTime) Set(s string) error {
+	d, err := dateTimeDeserialize(s)
+	if err != nil {
+		return err
+	}
+	*v = DateTime(*d)
+	return nil
+}
+
+// Duration is a duration value, like those of the properties of the types, that
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, so that it can
+// be read from configuration files and written by logging libraries, and a
+// pointer to it implements flag.Value, so that it can be set by flags.
+type Duration time.Duration
+
+// MarshalText returns the serialized form of the Duration.
+func (v Duration) MarshalText() ([]byte, error) {
+	return []byte(durationSerialize(time.Duration(v))), nil
+}
+
+// UnmarshalText deserializes the Duration from the text, returning an error if it
+// is not a duration value.
+func (v *Duration) UnmarshalText(b []byte) error {
+	return v.Set(string(b))
+}
+
+// String returns the serialized form of the Duration.
+func (v Duration) String() string {
+	return durationSerialize(time.Duration(v))
+}
+
+// Set deserializes the Duration from the string, returning an error if it is not
+// a duration value.
+func (v *Duration) Set(s string) error {
+	d, err := durationDeserialize(s)
+	if err != nil {
+		return err
+	}
+	*v = Duration(*d)
+	return nil
+}
+
+// LanguageTag is a bcp47LanguageTag value, like those of the properties of the types, that
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, so that it can
+// be read from configuration files and written by logging libraries, and a
+// pointer to it implements flag.Value, so that it can be set by flags.
+type LanguageTag string
+
+// MarshalText returns the serialized form of the LanguageTag.
+func (v LanguageTag) MarshalText() ([]byte, error) {
+	return []byte(bcp47LanguageTagSerialize(string(v))), nil
+}
+
+// UnmarshalText deserializes the LanguageTag from the text, returning an error if it
+// is not a bcp47LanguageTag value.
+func (v *LanguageTag) UnmarshalText(b []byte) error {
+	return v.Set(string(b))
+}
+
+// String returns the serialized form of the LanguageTag.
+func (v LanguageTag) String() string {
+	return bcp47LanguageTagSerialize(string(v))
+}
+
+// Set deserializes the LanguageTag from the string, returning an error if it is not
+// a bcp47LanguageTag value.
+func (v *LanguageTag) Set(s string) error {
+	d, err := bcp47LanguageTagDeserialize(s)
+	if err != nil {
+		return err
+	}
+	*v = LanguageTag(*d)
+	return nil
+}
+
+// LinkRelation is a linkRelation value, like those of the properties of the types, that
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, so that it can
+// be read from configuration files and written by logging libraries, and a
+// pointer to it implements flag.Value, so that it can be set by flags.
+type LinkRelation string
+
+// MarshalText returns the serialized form of the LinkRelation.
+func (v LinkRelation) MarshalText() ([]byte, error) {
+	return []byte(linkRelationSerialize(string(v))), nil
+}
+
+// UnmarshalText deserializes the LinkRelation from the text, returning an error if it
+// is not a linkRelation value.
+func (v *LinkRelation) UnmarshalText(b []byte) error {
+	return v.Set(string(b))
+}
+
+// String returns the serialized form of the LinkRelation.
+func (v LinkRelation) String() string {
+	return linkRelationSerialize(string(v))
+}
+
+// Set deserializes the LinkRelation from the string, returning an error if it is not
+// a linkRelation value.
+func (v *LinkRelation) Set(s string) error {
+	d, err := linkRelationDeserialize(s)
+	if err != nil {
+		return err
+	}
+	*v = LinkRelation(*d)
+	return nil
+}
+
+// MediaType is a mimeMediaTypeValue value, like those of the properties of the types, that
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, so that it can
+// be read from configuration files and written by logging libraries, and a
+// pointer to it implements flag.Value, so that it can be set by flags.
+type MediaType string
+
+// MarshalText returns the serialized form of the MediaType.
+func (v MediaType) MarshalText() ([]byte, error) {
+	return []byte(mimeMediaTypeValueSerialize(string(v))), nil
+}
+
+// UnmarshalText deserializes the MediaType from the text, returning an error if it
+// is not a mimeMediaTypeValue value.
+func (v *MediaType) UnmarshalText(b []byte) error {
+	return v.Set(string(b))
+}
+
+// String returns the serialized form of the MediaType.
+func (v MediaType) String() string {
+	return mimeMediaTypeValueSerialize(string(v))
+}
+
+// Set deserializes the MediaType from the string, returning an error if it is not
+// a mimeMediaTypeValue value.
+func (v *MediaType) Set(s string) error {
+	d, err := mimeMediaTypeValueDeserialize(s)
+	if err != nil {
+		return err
+	}
+	*v = MediaType(*d)
+	return nil
+}
+
+// Units is a unitsValue value, like those of the properties of the types, that
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, so that it can
+// be read from configuration files and written by logging libraries, and a
+// pointer to it implements flag.Value, so that it can be set by flags.
+type Units string
+
+// MarshalText returns the serialized form of the Units.
+func (v Units) MarshalText() ([]byte, error) {
+	return []byte(unitsValueSerialize(string(v))), nil
+}
+
+// UnmarshalText deserializes the Units from the text, returning an error if it
+// is not a unitsValue value.
+func (v *Units) UnmarshalText(b []byte) error {
+	return v.Set(string(b))
+}
+
+// String returns the serialized form of the Units.
+func (v Units) String() string {
+	return unitsValueSerialize(string(v))
+}
+
+// Set deserializes the Units from the string, returning an error if it is not
+// a unitsValue value.
+func (v *Units) Set(s string) error {
+	d, err := unitsValueDeserialize(s)
+	if err != nil {
+		return err
+	}
+	*v = Units(*d)
+	return nil
+}
@@ -2,14 +2,175 @@
 package vocab
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/url"
-	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// MissingPropertyError is returned by Validate when a type lacks a property
+// required by the specification.
+type MissingPropertyError struct {
+	// Type is the name of the type missing the property.
+	Type string
+	// Property is the name of the missing property.
+	Property string
+}
+
+// Error describes the missing property.
+func (e *MissingPropertyError) Error() string {
+	return fmt.Sprintf("%s is missing required property '%s'", e.Type, e.Property)
+}
+
+// UnknownPropertyError is returned by ValidateStrict when a type has a property
+// that is not one of its own.
+type UnknownPropertyError struct {
+	// Type is the name of the type with the property.
+	Type string
+	// Property is the name of the unknown property.
+	Property string
+}
+
+// Error describes the unknown property.
+func (e *UnknownPropertyError) Error() string {
+	return fmt.Sprintf("%s has unknown property '%s'", e.Type, e.Property)
+}
+
+// MismatchedPropertyError is returned by ValidateStrict when a property of a
+// type has a value of none of the types it takes.
+type MismatchedPropertyError struct {
+	// Type is the name of the type with the property.
+	Type string
+	// Property is the name of the property with the mismatched value.
+	Property string
+}
+
+// Error describes the mismatched property.
+func (e *MismatchedPropertyError) Error() string {
+	return fmt.Sprintf("%s property '%s' has a value of the wrong type", e.Type, e.Property)
+}
+
+// InvalidPropertyError is returned by ValidateStrict when a property of a type
+// has an inlined value which is itself invalid.
+type InvalidPropertyError struct {
+	// Type is the name of the type with the property.
+	Type string
+	// Property is the name of the property with the invalid value.
+	Property string
+	// Err are the problems of the value.
+	Err error
+}
+
+// Error describes the invalid property and the problems of its value.
+func (e *InvalidPropertyError) Error() string {
+	return fmt.Sprintf("%s property '%s' is invalid: %s", e.Type, e.Property, e.Err)
+}
+
+// ValidationErrors are all the problems Validate found with a type.
+type ValidationErrors []error
+
+// Error describes all the problems.
+func (v ValidationErrors) Error() string {
+	var b bytes.Buffer
+	for i, err := range v {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// AccessorError is returned by a TryGet method when the property has no value of
+// the requested kind, either because it is not set or because its value is of
+// another kind.
+type AccessorError struct {
+	// Type is the name of the type whose property was accessed.
+	Type string
+	// Getter is the name of the method that would have panicked or returned a
+	// zero value, such as 'GetNameString'.
+	Getter string
+	// Index is the index of the value of a non-functional property, or -1
+	// for a functional property.
+	Index int
+}
+
+// Error describes the missing value.
+func (e *AccessorError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("%s has no value for %s", e.Type, e.Getter)
+	}
+	return fmt.Sprintf("%s has no value for %s at index %d", e.Type, e.Getter, e.Index)
+}
+
+// DeserializeError is returned by Deserialize and DecodeJSON when a value
+// cannot be deserialized, locating the property where it failed, such as
+// "object.attachment[2].url" for the 'url' of the third attachment of the
+// object of an activity.
+type DeserializeError struct {
+	// Path is the path of property names to the value that could not be
+	// deserialized, with the index of the value among the values of the
+	// properties that have several.
+	Path string
+	// Err is the reason the value could not be deserialized.
+	Err error
+}
+
+// Error describes where the value could not be deserialized, and why.
+func (e *DeserializeError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the reason the value could not be deserialized.
+func (e *DeserializeError) Unwrap() error {
+	return e.Err
+}
+
+// deserializeErrorAt returns the error deserializing the value of a property,
+// or of the element of an array at an index such as "[2]", as a
+// DeserializeError whose path begins with it.
+func deserializeErrorAt(k string, err error) error {
+	e, ok := err.(*DeserializeError)
+	if !ok {
+		return &DeserializeError{Path: k, Err: err}
+	} else if strings.HasPrefix(e.Path, "[") {
+		return &DeserializeError{Path: k + e.Path, Err: e.Err}
+	}
+	return &DeserializeError{Path: k + "." + e.Path, Err: e.Err}
+}
+
+// AcceptStringScalars is whether the xsd:boolean, xsd:float, and
+// xsd:nonNegativeInteger values of properties are also accepted as JSON
+// strings when they are deserialized, such as "true" or "12", as some servers
+// serialize them. It is true by default. Applications that require the values
+// to be JSON booleans and numbers may set it to false before they deserialize
+// any value. Values are always serialized as JSON booleans and numbers.
+var AcceptStringScalars = true
+
+// stringScalar returns the JSON boolean or number that a string holds, if
+// AcceptStringScalars allows it, or the value as is.
+func stringScalar(v interface{}) interface{} {
+	s, ok := v.(string)
+	if !ok || !AcceptStringScalars {
+		return v
+	}
+	switch s = strings.TrimSpace(s); s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	return v
+}
+
 // Serializer implementations can serialize themselves to a generic map form.
 type Serializer interface {
 	Serialize() (m map[string]interface{}, e error)
@@ -69,19 +230,12 @@ func (t *Unknown) SetField(f string, i interface{}) (this *Unknown) {
 
 // dateTimeDeserialize turns a string into a time.
 func dateTimeDeserialize(v interface{}) (t *time.Time, err error) {
-	var tmp time.Time
 	if s, ok := v.(string); ok {
-		tmp, err = time.Parse(time.RFC3339, s)
+		tmp, err := parseDateTime(s)
 		if err != nil {
-			tmp, err = time.Parse("2006-01-02T15:04Z07:00", s)
-			if err != nil {
-				err = fmt.Errorf("%s cannot be interpreted as xsd:dateTime", s)
-			} else {
-				t = &tmp
-			}
-		} else {
-			t = &tmp
+			return nil, err
 		}
+		t = &tmp
 	} else {
 		err = fmt.Errorf("%v cannot be interpreted as a string for xsd:dateTime", v)
 	}
@@ -91,13 +245,14 @@ func dateTimeDeserialize(v interface{}) (t *time.Time, err error) {
 
 // dateTimeSerialize turns a time into a string
 func dateTimeSerialize(t time.Time) (s string) {
-	s = t.Format(time.RFC3339)
+	s = formatDateTime(t)
 	return
 
 }
 
 // booleanDeserialize turns a interface{} into a bool.
 func booleanDeserialize(v interface{}) (b *bool, err error) {
+	v = stringScalar(v)
 	if bv, ok := v.(bool); ok {
 		b = &bv
 	} else if bv, ok := v.(float64); ok {
@@ -147,6 +302,7 @@ func anyURISerialize(u *url.URL) (s string) {
 
 // floatDeserialize turns a interface{} into a float64.
 func floatDeserialize(v interface{}) (f *float64, err error) {
+	v = stringScalar(v)
 	if fv, ok := v.(float64); ok {
 		f = &fv
 	} else {
@@ -202,74 +358,9 @@ func langStringSerialize(s string) (r string) {
 // durationDeserialize turns a interface{} into a time.Duration.
 func durationDeserialize(v interface{}) (d *time.Duration, err error) {
 	if sv, ok := v.(string); ok {
-		isNeg := false
-		if sv[0] == '-' {
-			isNeg = true
-			sv = sv[1:]
-		}
-		if sv[0] != 'P' {
-			err = fmt.Errorf("'%s' malformed: missing 'P' for xsd:duration", sv)
-			return
-		}
-		re := regexp.MustCompile("P(\\d*Y)?(\\d*M)?(\\d*D)?(T(\\d*H)?(\\d*M)?(\\d*S)?)?")
-		res := re.FindStringSubmatch(sv)
-		var dur time.Duration
-		nYear := res[1]
-		if len(nYear) > 0 {
-			nYear = nYear[:len(nYear)-1]
-			vYear, err := strconv.ParseInt(nYear, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			dur += time.Duration(vYear) * time.Hour * 8760 // Hours per 365 days -- no way to count leap years here.
-		}
-		nMonth := res[2]
-		if len(nMonth) > 0 {
-			nMonth = nMonth[:len(nMonth)-1]
-			vMonth, err := strconv.ParseInt(nMonth, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			dur += time.Duration(vMonth) * time.Hour * 720 // Hours per 30 days -- no way to tell if these months span 31 days, 28, or 29 each.
-		}
-		nDay := res[3]
-		if len(nDay) > 0 {
-			nDay = nDay[:len(nDay)-1]
-			vDay, err := strconv.ParseInt(nDay, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			dur += time.Duration(vDay) * time.Hour * 24
-		}
-		nHour := res[5]
-		if len(nHour) > 0 {
-			nHour = nHour[:len(nHour)-1]
-			vHour, err := strconv.ParseInt(nHour, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			dur += time.Duration(vHour) * time.Hour
-		}
-		nMinute := res[6]
-		if len(nMinute) > 0 {
-			nMinute = nMinute[:len(nMinute)-1]
-			vMinute, err := strconv.ParseInt(nMinute, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			dur += time.Duration(vMinute) * time.Minute
-		}
-		nSecond := res[7]
-		if len(nSecond) > 0 {
-			nSecond = nSecond[:len(nSecond)-1]
-			vSecond, err := strconv.ParseInt(nSecond, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			dur += time.Duration(vSecond) * time.Second
-		}
-		if isNeg {
-			dur *= -1
+		dur, err := parseDuration(sv)
+		if err != nil {
+			return nil, err
 		}
 		d = &dur
 	} else {
@@ -281,51 +372,13 @@ func durationDeserialize(v interface{}) (d *time.Duration, err error) {
 
 // durationSerialize returns the duration as a string.
 func durationSerialize(d time.Duration) (s string) {
-	s = "P"
-	if d < 0 {
-		s = "-P"
-		d = -1 * d
-	}
-	var tally time.Duration
-	if years := d.Hours() / 8760.0; years >= 1 {
-		nYears := int64(math.Floor(years))
-		tally += time.Duration(nYears) * 8760 * time.Hour
-		s = fmt.Sprintf("%s%dY", s, nYears)
-	}
-	if months := (d.Hours() - tally.Hours()) / 720.0; months >= 1 {
-		nMonths := int64(math.Floor(months))
-		tally += time.Duration(nMonths) * 720 * time.Hour
-		s = fmt.Sprintf("%s%dM", s, nMonths)
-	}
-	if days := (d.Hours() - tally.Hours()) / 24.0; days >= 1 {
-		nDays := int64(math.Floor(days))
-		tally += time.Duration(nDays) * 24 * time.Hour
-		s = fmt.Sprintf("%s%dD", s, nDays)
-	}
-	if tally < d {
-		s = fmt.Sprintf("%sT", s)
-		if hours := d.Hours() - tally.Hours(); hours >= 1 {
-			nHours := int64(math.Floor(hours))
-			tally += time.Duration(nHours) * time.Hour
-			s = fmt.Sprintf("%s%dH", s, nHours)
-		}
-		if minutes := d.Minutes() - tally.Minutes(); minutes >= 1 {
-			nMinutes := int64(math.Floor(minutes))
-			tally += time.Duration(nMinutes) * time.Minute
-			s = fmt.Sprintf("%s%dM", s, nMinutes)
-		}
-		if seconds := d.Seconds() - tally.Seconds(); seconds >= 1 {
-			nSeconds := int64(math.Floor(seconds))
-			tally += time.Duration(nSeconds) * time.Second
-			s = fmt.Sprintf("%s%dS", s, nSeconds)
-		}
-	}
-	return
+	return formatDuration(d)
 
 }
 
 // nonNegativeIntegerDeserialize turns a interface{} into a positive int64 value.
 func nonNegativeIntegerDeserialize(v interface{}) (i *int64, err error) {
+	v = stringScalar(v)
 	if fv, ok := v.(float64); ok {
 		iv := int64(fv)
 		if iv >= 0 {
@@ -401,12 +454,14 @@ func linkRelationSerialize(s string) (r string) {
 
 }
 
-// unitsValueDeserialize turns a interface{} into a string.
+// unitsValueDeserialize turns a interface{} into a string, if it is one of the units defined by the specification.
 func unitsValueDeserialize(v interface{}) (s *string, err error) {
-	if sv, ok := v.(string); ok {
-		s = &sv
-	} else {
+	if sv, ok := v.(string); !ok {
 		err = fmt.Errorf("%v cannot be interpreted as a string for units value", v)
+	} else if sv != "cm" && sv != "feet" && sv != "inches" && sv != "km" && sv != "m" && sv != "miles" {
+		err = fmt.Errorf("%v is not a units value", v)
+	} else {
+		s = &sv
 	}
 	return
 
@@ -1196,6 +1251,20 @@ func HasTypeMention(t Typer) (b bool) {
 
 }
 
+// HasTypeHashtag returns true if the Typer has a type of Hashtag.
+func HasTypeHashtag(t Typer) (b bool) {
+	for i := 0; i < t.TypeLen(); i++ {
+		v := t.GetType(i)
+		if s, ok := v.(string); ok {
+			if s == "Hashtag" {
+				return true
+			}
+		}
+	}
+	return false
+
+}
+
 // Returns true if the provided Typer is an Activity.
 func IsActivityType(t Typer) (b bool) {
 	var activityTypes = []string{"IntransitiveActivity", "Accept", "TentativeAccept", "Add", "Arrive", "Create", "Delete", "Follow", "Ignore", "Join", "Leave", "Like", "Offer", "Invite", "Reject", "TentativeReject", "Remove", "Undo", "Update", "View", "Listen", "Read", "Move", "Travel", "Announce", "Block", "Flag", "Dislike", "Question"}
@@ -1215,7 +1284,7 @@ func IsActivityType(t Typer) (b bool) {
 
 }
 
-// resolveObject turns a string type that extends Object into a concrete type.
+// resolveObject turns a string type that extends Object into a concrete type, including those of the ExtensionTypes.
 func resolveObject(s string) (i interface{}) {
 	if s == "Object" {
 		return &Object{}
@@ -1373,11 +1442,14 @@ func resolveObject(s string) (i interface{}) {
 	if s == "Tombstone" {
 		return &Tombstone{}
 	}
+	if fn, ok := ExtensionTypes[s]; ok {
+		return fn()
+	}
 	return nil
 
 }
 
-// resolveLink turns a string type that extends Link into a concrete type.
+// resolveLink turns a string type that extends Link into a concrete type, including those of the ExtensionTypes.
 func resolveLink(s string) (i interface{}) {
 	if s == "Link" {
 		return &Link{}
@@ -1385,6 +1457,12 @@ func resolveLink(s string) (i interface{}) {
 	if s == "Mention" {
 		return &Mention{}
 	}
+	if s == "Hashtag" {
+		return &Hashtag{}
+	}
+	if fn, ok := ExtensionTypes[s]; ok {
+		return fn()
+	}
 	return nil
 
 }
@@ -1402,3 +1480,69 @@ func unknownValueSerialize(v interface{}) (o interface{}) {
 	return
 
 }
+
+// cloneValue deeply copies the generic maps and slices of a value in its map[string]interface{} form.
+func cloneValue(v interface{}) (o interface{}) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			m[k] = cloneValue(e)
+		}
+		o = m
+	case []interface{}:
+		s := make([]interface{}, len(x))
+		for i, e := range x {
+			s[i] = cloneValue(e)
+		}
+		o = s
+	default:
+		o = v
+	}
+	return
+
+}
+
+// setSerializedValues sets the serialized values of a property that is not functional, as the only value if there is one and as an array otherwise.
+func setSerializedValues(m map[string]interface{}, k string, v []interface{}) {
+	if len(v) == 1 {
+		m[k] = v[0]
+	} else {
+		m[k] = v
+	}
+
+}
+
+// canonicalJSON encodes the serialized form of a value as JSON with sorted keys, so that equal values always have the same encoding.
+func canonicalJSON(s Serializer) (b []byte, err error) {
+	m, err := s.Serialize()
+	if err != nil {
+		return
+	}
+	return json.Marshal(m)
+
+}
+
+// canonicalEquals determines whether two values have the same canonical encoding. Values that cannot be serialized are never equal.
+func canonicalEquals(a Serializer, o Serializer) (eq bool) {
+	ab, err := canonicalJSON(a)
+	if err != nil {
+		return false
+	}
+	ob, err := canonicalJSON(o)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ab, ob)
+
+}
+
+// canonicalHash is the SHA-256 hash of the canonical encoding of a value, or the zero hash if it cannot be serialized.
+func canonicalHash(s Serializer) (h [32]byte) {
+	c, err := canonicalJSON(s)
+	if err != nil {
+		return
+	}
+	return sha256.Sum256(c)
+
+}